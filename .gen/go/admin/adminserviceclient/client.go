@@ -130,6 +130,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListWorkflowTasks(
+		ctx context.Context,
+		Request *admin.ListWorkflowTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListWorkflowTasksResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -196,6 +202,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RetryTask(
+		ctx context.Context,
+		Request *admin.RetryTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UpdateDynamicConfig(
 		ctx context.Context,
 		Request *admin.UpdateDynamicConfigRequest,
@@ -651,6 +663,34 @@ func (c client) ListDynamicConfig(
 	return
 }
 
+func (c client) ListWorkflowTasks(
+	ctx context.Context,
+	_Request *admin.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListWorkflowTasksResponse, err error) {
+
+	var result admin.AdminService_ListWorkflowTasks_Result
+	args := admin.AdminService_ListWorkflowTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListWorkflowTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MaintainCorruptWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminMaintainWorkflowRequest,
@@ -959,6 +999,34 @@ func (c client) RestoreDynamicConfig(
 	return
 }
 
+func (c client) RetryTask(
+	ctx context.Context,
+	_Request *admin.RetryTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_RetryTask_Result
+	args := admin.AdminService_RetryTask_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_RetryTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDynamicConfig(
 	ctx context.Context,
 	_Request *admin.UpdateDynamicConfigRequest,
@@ -44,14 +44,14 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "cadence",
 	Package:  "github.com/uber/cadence/.gen/go/cadence",
 	FilePath: "cadence.thrift",
-	SHA1:     "39303bb2ea5213cb3398e89b39efa71f957b279c",
+	SHA1:     "825d86c9ee0b35694e6bc8186f0cb2f85b2b9c28",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence\n\n/**\n* WorkflowService API is exposed to provide support for long running applications.  Application is expected to call\n* StartWorkflowExecution to create an instance for each instance of long running workflow.  Such applications are expected\n* to have a worker which regularly polls for DecisionTask and ActivityTask from the WorkflowService.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.  Worker is expected to regularly heartbeat while activity task is running.\n**/\nservice WorkflowService {\n  /**\n  * RegisterDomain creates a new domain which can be used as a container for all resources.  Domain is a top level\n  * entity within Cadence, used as a container for all resources like workflow executions, tasklists, etc.  Domain\n  * acts as a sandbox and provides isolation for all resources within the domain.  All resources belongs to exactly one\n  * domain.\n  **/\n  void RegisterDomain(1: shared.RegisterDomainRequest registerRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.DomainAlreadyExistsError domainExistsError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * DescribeDomain returns the information and configuration for a registered domain.\n  **/\n  shared.DescribeDomainResponse DescribeDomain(1: shared.DescribeDomainRequest describeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n    * ListDomains returns the information and configuration for all domains.\n    **/\n    shared.ListDomainsResponse ListDomains(1: shared.ListDomainsRequest listRequest)\n      throws (\n        1: shared.BadRequestError badRequestError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n        5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      )\n\n  /**\n  * UpdateDomain is used to update the information and configuration for a registered domain.\n  **/\n  shared.UpdateDomainResponse UpdateDomain(1: shared.UpdateDomainRequest updateRequest)\n      throws (\n        1: shared.BadRequestError badRequestError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n        5: shared.DomainNotActiveError domainNotActiveError,\n        6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      )\n\n  /**\n  * DeprecateDomain us used to update status of a registered domain to DEPRECATED.  Once the domain is deprecated\n  * it cannot be used to start new workflow executions.  Existing workflow executions will continue to run on\n  * deprecated domains.\n  **/\n  void DeprecateDomain(1: shared.DeprecateDomainRequest deprecateRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * RestartWorkflowExecution restarts a previous workflow\n  * If the workflow is currently running it will terminate and restart\n  **/\n  shared.RestartWorkflowExecutionResponse RestartWorkflowExecution(1: shared.RestartWorkflowExecutionRequest restartRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.DomainNotActiveError domainNotActiveError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.EntityNotExistsError entityNotExistError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n  /**\n  * StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with\n  * 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the\n  * first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already\n  * exists with same workflowId.\n  **/\n  shared.StartWorkflowExecutionResponse StartWorkflowExecution(1: shared.StartWorkflowExecutionRequest startRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.EntityNotExistsError entityNotExistError,\n      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * Returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  shared.GetWorkflowExecutionHistoryResponse GetWorkflowExecutionHistory(1: shared.GetWorkflowExecutionHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * PollForDecisionTask is called by application worker to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  * Application is then expected to call 'RespondDecisionTaskCompleted' API when it is done processing the DecisionTask.\n  * It will also create a 'DecisionTaskStarted' event in the history for that session before handing off DecisionTask to\n  * application worker.\n  **/\n  shared.PollForDecisionTaskResponse PollForDecisionTask(1: shared.PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.EntityNotExistsError entityNotExistError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * RespondDecisionTaskCompleted is called by application worker to complete a DecisionTask handed as a result of\n  * 'PollForDecisionTask' API call.  Completing a DecisionTask will result in new events for the workflow execution and\n  * potentially new ActivityTask being created for corresponding decisions.  It will also create a DecisionTaskCompleted\n  * event in the history for that session.  Use the 'taskToken' provided as response of PollForDecisionTask API call\n  * for completing the DecisionTask.\n  * The response could contain a new decision task if there is one or if the request asking for one.\n  **/\n  shared.RespondDecisionTaskCompletedResponse RespondDecisionTaskCompleted(1: shared.RespondDecisionTaskCompletedRequest completeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondDecisionTaskFailed is called by application worker to indicate failure.  This results in\n  * DecisionTaskFailedEvent written to the history and a new DecisionTask created.  This API can be used by client to\n  * either clear sticky tasklist or report any panics during DecisionTask processing.  Cadence will only append first\n  * DecisionTaskFailed event to the history of workflow execution for consecutive failures.\n  **/\n  void RespondDecisionTaskFailed(1: shared.RespondDecisionTaskFailedRequest failedRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * PollForActivityTask is called by application worker to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  * Application is expected to call 'RespondActivityTaskCompleted' or 'RespondActivityTaskFailed' once it is done\n  * processing the task.\n  * Application also needs to call 'RecordActivityTaskHeartbeat' API within 'heartbeatTimeoutSeconds' interval to\n  * prevent the task from getting timed out.  An event 'ActivityTaskStarted' event is also written to workflow execution\n  * history before the ActivityTask is dispatched to application worker.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: shared.PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.EntityNotExistsError entityNotExistError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails\n  * to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and\n  * 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will\n  * fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of\n  * PollForActivityTask API call for heartbeating.\n  **/\n  shared.RecordActivityTaskHeartbeatResponse RecordActivityTaskHeartbeat(1: shared.RecordActivityTaskHeartbeatRequest heartbeatRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RecordActivityTaskHeartbeatByID is called by application worker while it is processing an ActivityTask.  If worker fails\n  * to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and\n  * 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeatByID' will\n  * fail with 'EntityNotExistsError' in such situations.  Instead of using 'taskToken' like in RecordActivityTaskHeartbeat,\n  * use Domain, WorkflowID and ActivityID\n  **/\n  shared.RecordActivityTaskHeartbeatResponse RecordActivityTaskHeartbeatByID(1: shared.RecordActivityTaskHeartbeatByIDRequest heartbeatRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will\n  * result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask\n  * created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of\n  * PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid\n  * anymore due to activity timeout.\n  **/\n  void  RespondActivityTaskCompleted(1: shared.RespondActivityTaskCompletedRequest completeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskCompletedByID is called by application worker when it is done processing an ActivityTask.\n  * It will result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask\n  * created for the workflow so new decisions could be made.  Similar to RespondActivityTaskCompleted but use Domain,\n  * WorkflowID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'\n  * if the these IDs are not valid anymore due to activity timeout.\n  **/\n  void  RespondActivityTaskCompletedByID(1: shared.RespondActivityTaskCompletedByIDRequest completeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will\n  * result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of\n  * PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid\n  * anymore due to activity timeout.\n  **/\n  void  RespondActivityTaskFailed(1: shared.RespondActivityTaskFailedRequest failRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskFailedByID is called by application worker when it is done processing an ActivityTask.\n  * It will result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskFailed but use\n  * Domain, WorkflowID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'\n  * if the these IDs are not valid anymore due to activity timeout.\n  **/\n  void  RespondActivityTaskFailedByID(1: shared.RespondActivityTaskFailedByIDRequest failRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will\n  * result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of\n  * PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid\n  * anymore due to activity timeout.\n  **/\n  void RespondActivityTaskCanceled(1: shared.RespondActivityTaskCanceledRequest canceledRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskCanceledByID is called by application worker when it is successfully canceled an ActivityTask.\n  * It will result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskCanceled but use\n  * Domain, WorkflowID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'\n  * if the these IDs are not valid anymore due to activity timeout.\n  **/\n  void RespondActivityTaskCanceledByID(1: shared.RespondActivityTaskCanceledByIDRequest canceledRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.\n  * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid\n  * anymore due to completion or doesn't exist.\n  **/\n  void RequestCancelWorkflowExecution(1: shared.RequestCancelWorkflowExecutionRequest cancelRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.CancellationAlreadyRequestedError cancellationAlreadyRequestedError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.LimitExceededError limitExceededError,\n      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      9: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in\n  * WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.\n  **/\n  void SignalWorkflowExecution(1: shared.SignalWorkflowExecutionRequest signalRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.\n  * If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history\n  * and a decision task being created for the execution.\n  * If the workflow is not running or not found, this results in WorkflowExecutionStarted and WorkflowExecutionSignaled\n  * events being recorded in history, and a decision task being created for the execution\n  **/\n  shared.StartWorkflowExecutionResponse SignalWithStartWorkflowExecution(1: shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,\n      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n    * ResetWorkflowExecution reset an existing workflow execution to DecisionTaskCompleted event(exclusive).\n    * And it will immediately terminating the current execution instance.\n    **/\n  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: shared.ResetWorkflowExecutionRequest resetRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event\n  * in the history and immediately terminating the execution instance.\n  **/\n  void TerminateWorkflowExecution(1: shared.TerminateWorkflowExecutionRequest terminateRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.\n  **/\n  shared.ListOpenWorkflowExecutionsResponse ListOpenWorkflowExecutions(1: shared.ListOpenWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * ListClosedWorkflowExecutions is a visibility API to list the closed executions in a specific domain.\n  **/\n  shared.ListClosedWorkflowExecutionsResponse ListClosedWorkflowExecutions(1: shared.ListClosedWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * ListWorkflowExecutions is a visibility API to list workflow executions in a specific domain.\n  **/\n  shared.ListWorkflowExecutionsResponse ListWorkflowExecutions(1: shared.ListWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * ListArchivedWorkflowExecutions is a visibility API to list archived workflow executions in a specific domain.\n  **/\n  shared.ListArchivedWorkflowExecutionsResponse ListArchivedWorkflowExecutions(1: shared.ListArchivedWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * ScanWorkflowExecutions is a visibility API to list large amount of workflow executions in a specific domain without order.\n  **/\n  shared.ListWorkflowExecutionsResponse ScanWorkflowExecutions(1: shared.ListWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * CountWorkflowExecutions is a visibility API to count of workflow executions in a specific domain.\n  **/\n  shared.CountWorkflowExecutionsResponse CountWorkflowExecutions(1: shared.CountWorkflowExecutionsRequest countRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * GetSearchAttributes is a visibility API to get all legal keys that could be used in list APIs\n  **/\n  shared.GetSearchAttributesResponse GetSearchAttributes()\n    throws (\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)\n  * as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'\n  * API and return the query result to client as a response to 'QueryWorkflow' API call.\n  **/\n  void RespondQueryTaskCompleted(1: shared.RespondQueryTaskCompletedRequest completeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * Reset the sticky tasklist related information in mutable state of a given workflow.\n  * Things cleared are:\n  * 1. StickyTaskList\n  * 2. StickyScheduleToStartTimeout\n  * 3. ClientLibraryVersion\n  * 4. ClientFeatureVersion\n  * 5. ClientImpl\n  **/\n  shared.ResetStickyTaskListResponse ResetStickyTaskList(1: shared.ResetStickyTaskListRequest resetRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * QueryWorkflow returns query result for a specified workflow execution\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: shared.QueryWorkflowRequest queryRequest)\n\tthrows (\n\t  1: shared.BadRequestError badRequestError,\n\t  3: shared.EntityNotExistsError entityNotExistError,\n\t  4: shared.QueryFailedError queryFailedError,\n\t  5: shared.LimitExceededError limitExceededError,\n\t  6: shared.ServiceBusyError serviceBusyError,\n\t  7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n\t)\n\n  /**\n  * DescribeWorkflowExecution returns information about the specified workflow execution.\n  **/\n  shared.DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: shared.DescribeWorkflowExecutionRequest describeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: shared.DescribeTaskListRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * GetClusterInfo returns information about cadence cluster\n  **/\n  shared.ClusterInfo GetClusterInfo()\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetTaskListsByDomain returns the list of all the task lists for a domainName.\n  **/\n  shared.GetTaskListsByDomainResponse GetTaskListsByDomain(1: shared.GetTaskListsByDomainRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.EntityNotExistsError entityNotExistError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n   /**\n   * ReapplyEvents applies stale events to the current workflow and current run\n   **/\n  shared.ListTaskListPartitionsResponse ListTaskListPartitions(1: shared.ListTaskListPartitionsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RefreshWorkflowTasks refreshes all tasks of a workflow\n  **/\n  void RefreshWorkflowTasks(1: shared.RefreshWorkflowTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence\n\n/**\n* WorkflowService API is exposed to provide support for long running applications.  Application is expected to call\n* StartWorkflowExecution to create an instance for each instance of long running workflow.  Such applications are expected\n* to have a worker which regularly polls for DecisionTask and ActivityTask from the WorkflowService.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.  Worker is expected to regularly heartbeat while activity task is running.\n**/\nservice WorkflowService {\n  /**\n  * RegisterDomain creates a new domain which can be used as a container for all resources.  Domain is a top level\n  * entity within Cadence, used as a container for all resources like workflow executions, tasklists, etc.  Domain\n  * acts as a sandbox and provides isolation for all resources within the domain.  All resources belongs to exactly one\n  * domain.\n  **/\n  void RegisterDomain(1: shared.RegisterDomainRequest registerRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.DomainAlreadyExistsError domainExistsError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * DescribeDomain returns the information and configuration for a registered domain.\n  **/\n  shared.DescribeDomainResponse DescribeDomain(1: shared.DescribeDomainRequest describeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n    * ListDomains returns the information and configuration for all domains.\n    **/\n    shared.ListDomainsResponse ListDomains(1: shared.ListDomainsRequest listRequest)\n      throws (\n        1: shared.BadRequestError badRequestError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n        5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      )\n\n  /**\n  * UpdateDomain is used to update the information and configuration for a registered domain.\n  **/\n  shared.UpdateDomainResponse UpdateDomain(1: shared.UpdateDomainRequest updateRequest)\n      throws (\n        1: shared.BadRequestError badRequestError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n        5: shared.DomainNotActiveError domainNotActiveError,\n        6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      )\n\n  /**\n  * DeprecateDomain us used to update status of a registered domain to DEPRECATED.  Once the domain is deprecated\n  * it cannot be used to start new workflow executions.  Existing workflow executions will continue to run on\n  * deprecated domains.\n  **/\n  void DeprecateDomain(1: shared.DeprecateDomainRequest deprecateRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * RestartWorkflowExecution restarts a previous workflow\n  * If the workflow is currently running it will terminate and restart\n  **/\n  shared.RestartWorkflowExecutionResponse RestartWorkflowExecution(1: shared.RestartWorkflowExecutionRequest restartRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.DomainNotActiveError domainNotActiveError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.EntityNotExistsError entityNotExistError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n  /**\n  * StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with\n  * 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the\n  * first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already\n  * exists with same workflowId.\n  **/\n  shared.StartWorkflowExecutionResponse StartWorkflowExecution(1: shared.StartWorkflowExecutionRequest startRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.EntityNotExistsError entityNotExistError,\n      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * StartWorkflowExecutionAsync validates a StartWorkflowExecutionRequest and publishes it to the domain's async\n  * workflow queue. It returns once the request is accepted by the queue; the workflow is started later by the\n  * async workflow consumer in the worker service.\n  **/\n  shared.StartWorkflowExecutionAsyncResponse StartWorkflowExecutionAsync(1: shared.StartWorkflowExecutionAsyncRequest startRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.EntityNotExistsError entityNotExistError,\n      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * Returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  shared.GetWorkflowExecutionHistoryResponse GetWorkflowExecutionHistory(1: shared.GetWorkflowExecutionHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * PollForDecisionTask is called by application worker to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  * Application is then expected to call 'RespondDecisionTaskCompleted' API when it is done processing the DecisionTask.\n  * It will also create a 'DecisionTaskStarted' event in the history for that session before handing off DecisionTask to\n  * application worker.\n  **/\n  shared.PollForDecisionTaskResponse PollForDecisionTask(1: shared.PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.EntityNotExistsError entityNotExistError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * RespondDecisionTaskCompleted is called by application worker to complete a DecisionTask handed as a result of\n  * 'PollForDecisionTask' API call.  Completing a DecisionTask will result in new events for the workflow execution and\n  * potentially new ActivityTask being created for corresponding decisions.  It will also create a DecisionTaskCompleted\n  * event in the history for that session.  Use the 'taskToken' provided as response of PollForDecisionTask API call\n  * for completing the DecisionTask.\n  * The response could contain a new decision task if there is one or if the request asking for one.\n  **/\n  shared.RespondDecisionTaskCompletedResponse RespondDecisionTaskCompleted(1: shared.RespondDecisionTaskCompletedRequest completeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondDecisionTaskFailed is called by application worker to indicate failure.  This results in\n  * DecisionTaskFailedEvent written to the history and a new DecisionTask created.  This API can be used by client to\n  * either clear sticky tasklist or report any panics during DecisionTask processing.  Cadence will only append first\n  * DecisionTaskFailed event to the history of workflow execution for consecutive failures.\n  **/\n  void RespondDecisionTaskFailed(1: shared.RespondDecisionTaskFailedRequest failedRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * PollForActivityTask is called by application worker to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  * Application is expected to call 'RespondActivityTaskCompleted' or 'RespondActivityTaskFailed' once it is done\n  * processing the task.\n  * Application also needs to call 'RecordActivityTaskHeartbeat' API within 'heartbeatTimeoutSeconds' interval to\n  * prevent the task from getting timed out.  An event 'ActivityTaskStarted' event is also written to workflow execution\n  * history before the ActivityTask is dispatched to application worker.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: shared.PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.EntityNotExistsError entityNotExistError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails\n  * to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and\n  * 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will\n  * fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of\n  * PollForActivityTask API call for heartbeating.\n  **/\n  shared.RecordActivityTaskHeartbeatResponse RecordActivityTaskHeartbeat(1: shared.RecordActivityTaskHeartbeatRequest heartbeatRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RecordActivityTaskHeartbeatByID is called by application worker while it is processing an ActivityTask.  If worker fails\n  * to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and\n  * 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeatByID' will\n  * fail with 'EntityNotExistsError' in such situations.  Instead of using 'taskToken' like in RecordActivityTaskHeartbeat,\n  * use Domain, WorkflowID and ActivityID\n  **/\n  shared.RecordActivityTaskHeartbeatResponse RecordActivityTaskHeartbeatByID(1: shared.RecordActivityTaskHeartbeatByIDRequest heartbeatRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will\n  * result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask\n  * created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of\n  * PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid\n  * anymore due to activity timeout.\n  **/\n  void  RespondActivityTaskCompleted(1: shared.RespondActivityTaskCompletedRequest completeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskCompletedByID is called by application worker when it is done processing an ActivityTask.\n  * It will result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask\n  * created for the workflow so new decisions could be made.  Similar to RespondActivityTaskCompleted but use Domain,\n  * WorkflowID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'\n  * if the these IDs are not valid anymore due to activity timeout.\n  **/\n  void  RespondActivityTaskCompletedByID(1: shared.RespondActivityTaskCompletedByIDRequest completeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will\n  * result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of\n  * PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid\n  * anymore due to activity timeout.\n  **/\n  void  RespondActivityTaskFailed(1: shared.RespondActivityTaskFailedRequest failRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskFailedByID is called by application worker when it is done processing an ActivityTask.\n  * It will result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskFailed but use\n  * Domain, WorkflowID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'\n  * if the these IDs are not valid anymore due to activity timeout.\n  **/\n  void  RespondActivityTaskFailedByID(1: shared.RespondActivityTaskFailedByIDRequest failRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will\n  * result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of\n  * PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid\n  * anymore due to activity timeout.\n  **/\n  void RespondActivityTaskCanceled(1: shared.RespondActivityTaskCanceledRequest canceledRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RespondActivityTaskCanceledByID is called by application worker when it is successfully canceled an ActivityTask.\n  * It will result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskCanceled but use\n  * Domain, WorkflowID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'\n  * if the these IDs are not valid anymore due to activity timeout.\n  **/\n  void RespondActivityTaskCanceledByID(1: shared.RespondActivityTaskCanceledByIDRequest canceledRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.DomainNotActiveError domainNotActiveError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.\n  * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask\n  * created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid\n  * anymore due to completion or doesn't exist.\n  **/\n  void RequestCancelWorkflowExecution(1: shared.RequestCancelWorkflowExecutionRequest cancelRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.CancellationAlreadyRequestedError cancellationAlreadyRequestedError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.LimitExceededError limitExceededError,\n      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      9: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in\n  * WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.\n  **/\n  void SignalWorkflowExecution(1: shared.SignalWorkflowExecutionRequest signalRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.\n  * If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history\n  * and a decision task being created for the execution.\n  * If the workflow is not running or not found, this results in WorkflowExecutionStarted and WorkflowExecutionSignaled\n  * events being recorded in history, and a decision task being created for the execution\n  **/\n  shared.StartWorkflowExecutionResponse SignalWithStartWorkflowExecution(1: shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,\n      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * SignalWithStartWorkflowExecutionAsync validates a SignalWithStartWorkflowExecutionRequest and publishes it to the\n  * domain's async workflow queue. It returns once the request is accepted by the queue; the signal with start is\n  * executed later by the async workflow consumer in the worker service.\n  **/\n  shared.SignalWithStartWorkflowExecutionAsyncResponse SignalWithStartWorkflowExecutionAsync(1: shared.SignalWithStartWorkflowExecutionAsyncRequest signalWithStartRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.EntityNotExistsError entityNotExistError,\n      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n    * ResetWorkflowExecution reset an existing workflow execution to DecisionTaskCompleted event(exclusive).\n    * And it will immediately terminating the current execution instance.\n    **/\n  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: shared.ResetWorkflowExecutionRequest resetRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event\n  * in the history and immediately terminating the execution instance.\n  **/\n  void TerminateWorkflowExecution(1: shared.TerminateWorkflowExecutionRequest terminateRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.\n  **/\n  shared.ListOpenWorkflowExecutionsResponse ListOpenWorkflowExecutions(1: shared.ListOpenWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * ListClosedWorkflowExecutions is a visibility API to list the closed executions in a specific domain.\n  **/\n  shared.ListClosedWorkflowExecutionsResponse ListClosedWorkflowExecutions(1: shared.ListClosedWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * ListWorkflowExecutions is a visibility API to list workflow executions in a specific domain.\n  **/\n  shared.ListWorkflowExecutionsResponse ListWorkflowExecutions(1: shared.ListWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * ListArchivedWorkflowExecutions is a visibility API to list archived workflow executions in a specific domain.\n  **/\n  shared.ListArchivedWorkflowExecutionsResponse ListArchivedWorkflowExecutions(1: shared.ListArchivedWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * ScanWorkflowExecutions is a visibility API to list large amount of workflow executions in a specific domain without order.\n  **/\n  shared.ListWorkflowExecutionsResponse ScanWorkflowExecutions(1: shared.ListWorkflowExecutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * CountWorkflowExecutions is a visibility API to count of workflow executions in a specific domain.\n  **/\n  shared.CountWorkflowExecutionsResponse CountWorkflowExecutions(1: shared.CountWorkflowExecutionsRequest countRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * GetSearchAttributes is a visibility API to get all legal keys that could be used in list APIs\n  **/\n  shared.GetSearchAttributesResponse GetSearchAttributes()\n    throws (\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)\n  * as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'\n  * API and return the query result to client as a response to 'QueryWorkflow' API call.\n  **/\n  void RespondQueryTaskCompleted(1: shared.RespondQueryTaskCompletedRequest completeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * Reset the sticky tasklist related information in mutable state of a given workflow.\n  * Things cleared are:\n  * 1. StickyTaskList\n  * 2. StickyScheduleToStartTimeout\n  * 3. ClientLibraryVersion\n  * 4. ClientFeatureVersion\n  * 5. ClientImpl\n  **/\n  shared.ResetStickyTaskListResponse ResetStickyTaskList(1: shared.ResetStickyTaskListRequest resetRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.DomainNotActiveError domainNotActiveError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * QueryWorkflow returns query result for a specified workflow execution\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: shared.QueryWorkflowRequest queryRequest)\n\tthrows (\n\t  1: shared.BadRequestError badRequestError,\n\t  3: shared.EntityNotExistsError entityNotExistError,\n\t  4: shared.QueryFailedError queryFailedError,\n\t  5: shared.LimitExceededError limitExceededError,\n\t  6: shared.ServiceBusyError serviceBusyError,\n\t  7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n\t)\n\n  /**\n  * UpdateWorkflowExecution delivers an update to a running workflow execution and waits for the workflow to\n  * answer it. This results in WorkflowExecutionUpdateRequested and WorkflowExecutionUpdateCompleted events\n  * recorded in the history. An update retried with the same update ID is answered from history.\n  **/\n  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: shared.UpdateWorkflowExecutionRequest updateRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.LimitExceededError limitExceededError,\n      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n      8: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * DescribeWorkflowExecution returns information about the specified workflow execution.\n  **/\n  shared.DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: shared.DescribeWorkflowExecutionRequest describeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: shared.DescribeTaskListRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  /**\n  * GetClusterInfo returns information about cadence cluster\n  **/\n  shared.ClusterInfo GetClusterInfo()\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetTaskListsByDomain returns the list of all the task lists for a domainName.\n  **/\n  shared.GetTaskListsByDomainResponse GetTaskListsByDomain(1: shared.GetTaskListsByDomainRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.EntityNotExistsError entityNotExistError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n   /**\n   * ReapplyEvents applies stale events to the current workflow and current run\n   **/\n  shared.ListTaskListPartitionsResponse ListTaskListPartitions(1: shared.ListTaskListPartitionsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RefreshWorkflowTasks refreshes all tasks of a workflow\n  **/\n  void RefreshWorkflowTasks(1: shared.RefreshWorkflowTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n}\n"
 
 // WorkflowService_CountWorkflowExecutions_Args represents the arguments for the WorkflowService.CountWorkflowExecutions function.
 //
@@ -36753,14 +36753,14 @@ func (v *WorkflowService_SignalWithStartWorkflowExecution_Result) EnvelopeType()
 	return wire.Reply
 }
 
-// WorkflowService_SignalWorkflowExecution_Args represents the arguments for the WorkflowService.SignalWorkflowExecution function.
+// WorkflowService_SignalWithStartWorkflowExecutionAsync_Args represents the arguments for the WorkflowService.SignalWithStartWorkflowExecutionAsync function.
 //
-// The arguments for SignalWorkflowExecution are sent and received over the wire as this struct.
-type WorkflowService_SignalWorkflowExecution_Args struct {
-	SignalRequest *shared.SignalWorkflowExecutionRequest `json:"signalRequest,omitempty"`
+// The arguments for SignalWithStartWorkflowExecutionAsync are sent and received over the wire as this struct.
+type WorkflowService_SignalWithStartWorkflowExecutionAsync_Args struct {
+	SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionAsyncRequest `json:"signalWithStartRequest,omitempty"`
 }
 
-// ToWire translates a WorkflowService_SignalWorkflowExecution_Args struct into a Thrift-level intermediate
+// ToWire translates a WorkflowService_SignalWithStartWorkflowExecutionAsync_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -36775,7 +36775,7 @@ type WorkflowService_SignalWorkflowExecution_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowService_SignalWorkflowExecution_Args) ToWire() (wire.Value, error) {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -36783,8 +36783,8 @@ func (v *WorkflowService_SignalWorkflowExecution_Args) ToWire() (wire.Value, err
 		err    error
 	)
 
-	if v.SignalRequest != nil {
-		w, err = v.SignalRequest.ToWire()
+	if v.SignalWithStartRequest != nil {
+		w, err = v.SignalWithStartRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -36795,17 +36795,17 @@ func (v *WorkflowService_SignalWorkflowExecution_Args) ToWire() (wire.Value, err
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowExecutionRequest, error) {
-	var v shared.SignalWorkflowExecutionRequest
+func _SignalWithStartWorkflowExecutionAsyncRequest_Read(w wire.Value) (*shared.SignalWithStartWorkflowExecutionAsyncRequest, error) {
+	var v shared.SignalWithStartWorkflowExecutionAsyncRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a WorkflowService_SignalWorkflowExecution_Args struct from its Thrift-level
+// FromWire deserializes a WorkflowService_SignalWithStartWorkflowExecutionAsync_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowService_SignalWorkflowExecution_Args struct
+// An error is returned if we were unable to build a WorkflowService_SignalWithStartWorkflowExecutionAsync_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -36813,19 +36813,19 @@ func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowE
 //     return nil, err
 //   }
 //
-//   var v WorkflowService_SignalWorkflowExecution_Args
+//   var v WorkflowService_SignalWithStartWorkflowExecutionAsync_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowService_SignalWorkflowExecution_Args) FromWire(w wire.Value) error {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.SignalRequest, err = _SignalWorkflowExecutionRequest_Read(field.Value)
+				v.SignalWithStartRequest, err = _SignalWithStartWorkflowExecutionAsyncRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -36837,20 +36837,20 @@ func (v *WorkflowService_SignalWorkflowExecution_Args) FromWire(w wire.Value) er
 	return nil
 }
 
-// Encode serializes a WorkflowService_SignalWorkflowExecution_Args struct directly into bytes, without going
+// Encode serializes a WorkflowService_SignalWithStartWorkflowExecutionAsync_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowService_SignalWorkflowExecution_Args struct could not be encoded.
-func (v *WorkflowService_SignalWorkflowExecution_Args) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowService_SignalWithStartWorkflowExecutionAsync_Args struct could not be encoded.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.SignalRequest != nil {
+	if v.SignalWithStartRequest != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.SignalRequest.Encode(sw); err != nil {
+		if err := v.SignalWithStartRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -36861,18 +36861,18 @@ func (v *WorkflowService_SignalWorkflowExecution_Args) Encode(sw stream.Writer)
 	return sw.WriteStructEnd()
 }
 
-func _SignalWorkflowExecutionRequest_Decode(sr stream.Reader) (*shared.SignalWorkflowExecutionRequest, error) {
-	var v shared.SignalWorkflowExecutionRequest
+func _SignalWithStartWorkflowExecutionAsyncRequest_Decode(sr stream.Reader) (*shared.SignalWithStartWorkflowExecutionAsyncRequest, error) {
+	var v shared.SignalWithStartWorkflowExecutionAsyncRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a WorkflowService_SignalWorkflowExecution_Args struct directly from its Thrift-level
+// Decode deserializes a WorkflowService_SignalWithStartWorkflowExecutionAsync_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowService_SignalWorkflowExecution_Args struct could not be generated from the wire
+// An error is returned if a WorkflowService_SignalWithStartWorkflowExecutionAsync_Args struct could not be generated from the wire
 // representation.
-func (v *WorkflowService_SignalWorkflowExecution_Args) Decode(sr stream.Reader) error {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -36886,7 +36886,7 @@ func (v *WorkflowService_SignalWorkflowExecution_Args) Decode(sr stream.Reader)
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.SignalRequest, err = _SignalWorkflowExecutionRequest_Decode(sr)
+			v.SignalWithStartRequest, err = _SignalWithStartWorkflowExecutionAsyncRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -36913,34 +36913,34 @@ func (v *WorkflowService_SignalWorkflowExecution_Args) Decode(sr stream.Reader)
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowService_SignalWorkflowExecution_Args
+// String returns a readable string representation of a WorkflowService_SignalWithStartWorkflowExecutionAsync_Args
 // struct.
-func (v *WorkflowService_SignalWorkflowExecution_Args) String() string {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.SignalRequest != nil {
-		fields[i] = fmt.Sprintf("SignalRequest: %v", v.SignalRequest)
+	if v.SignalWithStartRequest != nil {
+		fields[i] = fmt.Sprintf("SignalWithStartRequest: %v", v.SignalWithStartRequest)
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowService_SignalWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowService_SignalWithStartWorkflowExecutionAsync_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowService_SignalWorkflowExecution_Args match the
-// provided WorkflowService_SignalWorkflowExecution_Args.
+// Equals returns true if all the fields of this WorkflowService_SignalWithStartWorkflowExecutionAsync_Args match the
+// provided WorkflowService_SignalWithStartWorkflowExecutionAsync_Args.
 //
 // This function performs a deep comparison.
-func (v *WorkflowService_SignalWorkflowExecution_Args) Equals(rhs *WorkflowService_SignalWorkflowExecution_Args) bool {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) Equals(rhs *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.SignalRequest == nil && rhs.SignalRequest == nil) || (v.SignalRequest != nil && rhs.SignalRequest != nil && v.SignalRequest.Equals(rhs.SignalRequest))) {
+	if !((v.SignalWithStartRequest == nil && rhs.SignalWithStartRequest == nil) || (v.SignalWithStartRequest != nil && rhs.SignalWithStartRequest != nil && v.SignalWithStartRequest.Equals(rhs.SignalWithStartRequest))) {
 		return false
 	}
 
@@ -36948,177 +36948,165 @@ func (v *WorkflowService_SignalWorkflowExecution_Args) Equals(rhs *WorkflowServi
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowService_SignalWorkflowExecution_Args.
-func (v *WorkflowService_SignalWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowService_SignalWithStartWorkflowExecutionAsync_Args.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.SignalRequest != nil {
-		err = multierr.Append(err, enc.AddObject("signalRequest", v.SignalRequest))
+	if v.SignalWithStartRequest != nil {
+		err = multierr.Append(err, enc.AddObject("signalWithStartRequest", v.SignalWithStartRequest))
 	}
 	return err
 }
 
-// GetSignalRequest returns the value of SignalRequest if it is set or its
+// GetSignalWithStartRequest returns the value of SignalWithStartRequest if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_SignalWorkflowExecution_Args) GetSignalRequest() (o *shared.SignalWorkflowExecutionRequest) {
-	if v != nil && v.SignalRequest != nil {
-		return v.SignalRequest
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) GetSignalWithStartRequest() (o *shared.SignalWithStartWorkflowExecutionAsyncRequest) {
+	if v != nil && v.SignalWithStartRequest != nil {
+		return v.SignalWithStartRequest
 	}
 
 	return
 }
 
-// IsSetSignalRequest returns true if SignalRequest is not nil.
-func (v *WorkflowService_SignalWorkflowExecution_Args) IsSetSignalRequest() bool {
-	return v != nil && v.SignalRequest != nil
+// IsSetSignalWithStartRequest returns true if SignalWithStartRequest is not nil.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) IsSetSignalWithStartRequest() bool {
+	return v != nil && v.SignalWithStartRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "SignalWorkflowExecution" for this struct.
-func (v *WorkflowService_SignalWorkflowExecution_Args) MethodName() string {
-	return "SignalWorkflowExecution"
+// This will always be "SignalWithStartWorkflowExecutionAsync" for this struct.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) MethodName() string {
+	return "SignalWithStartWorkflowExecutionAsync"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *WorkflowService_SignalWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// WorkflowService_SignalWorkflowExecution_Helper provides functions that aid in handling the
-// parameters and return values of the WorkflowService.SignalWorkflowExecution
+// WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper provides functions that aid in handling the
+// parameters and return values of the WorkflowService.SignalWithStartWorkflowExecutionAsync
 // function.
-var WorkflowService_SignalWorkflowExecution_Helper = struct {
-	// Args accepts the parameters of SignalWorkflowExecution in-order and returns
+var WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper = struct {
+	// Args accepts the parameters of SignalWithStartWorkflowExecutionAsync in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		signalRequest *shared.SignalWorkflowExecutionRequest,
-	) *WorkflowService_SignalWorkflowExecution_Args
+		signalWithStartRequest *shared.SignalWithStartWorkflowExecutionAsyncRequest,
+	) *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args
 
 	// IsException returns true if the given error can be thrown
-	// by SignalWorkflowExecution.
+	// by SignalWithStartWorkflowExecutionAsync.
 	//
-	// An error can be thrown by SignalWorkflowExecution only if the
+	// An error can be thrown by SignalWithStartWorkflowExecutionAsync only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for SignalWorkflowExecution
-	// given the error returned by it. The provided error may
-	// be nil if SignalWorkflowExecution did not fail.
+	// WrapResponse returns the result struct for SignalWithStartWorkflowExecutionAsync
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by SignalWorkflowExecution into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// SignalWorkflowExecution
+	// This allows mapping values and errors returned by
+	// SignalWithStartWorkflowExecutionAsync into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by SignalWithStartWorkflowExecutionAsync
 	//
-	//   err := SignalWorkflowExecution(args)
-	//   result, err := WorkflowService_SignalWorkflowExecution_Helper.WrapResponse(err)
+	//   value, err := SignalWithStartWorkflowExecutionAsync(args)
+	//   result, err := WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from SignalWorkflowExecution: %v", err)
+	//     return fmt.Errorf("unexpected error from SignalWithStartWorkflowExecutionAsync: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*WorkflowService_SignalWorkflowExecution_Result, error)
+	WrapResponse func(*shared.SignalWithStartWorkflowExecutionAsyncResponse, error) (*WorkflowService_SignalWithStartWorkflowExecutionAsync_Result, error)
 
-	// UnwrapResponse takes the result struct for SignalWorkflowExecution
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for SignalWithStartWorkflowExecutionAsync
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if SignalWorkflowExecution threw an
+	// The error is non-nil only if SignalWithStartWorkflowExecutionAsync threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := WorkflowService_SignalWorkflowExecution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*WorkflowService_SignalWorkflowExecution_Result) error
+	//   value, err := WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) (*shared.SignalWithStartWorkflowExecutionAsyncResponse, error)
 }{}
 
 func init() {
-	WorkflowService_SignalWorkflowExecution_Helper.Args = func(
-		signalRequest *shared.SignalWorkflowExecutionRequest,
-	) *WorkflowService_SignalWorkflowExecution_Args {
-		return &WorkflowService_SignalWorkflowExecution_Args{
-			SignalRequest: signalRequest,
+	WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.Args = func(
+		signalWithStartRequest *shared.SignalWithStartWorkflowExecutionAsyncRequest,
+	) *WorkflowService_SignalWithStartWorkflowExecutionAsync_Args {
+		return &WorkflowService_SignalWithStartWorkflowExecutionAsync_Args{
+			SignalWithStartRequest: signalWithStartRequest,
 		}
 	}
 
-	WorkflowService_SignalWorkflowExecution_Helper.IsException = func(err error) bool {
+	WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
 		case *shared.DomainNotActiveError:
 			return true
 		case *shared.LimitExceededError:
 			return true
-		case *shared.ClientVersionNotSupportedError:
+		case *shared.EntityNotExistsError:
 			return true
-		case *shared.WorkflowExecutionAlreadyCompletedError:
+		case *shared.ClientVersionNotSupportedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	WorkflowService_SignalWorkflowExecution_Helper.WrapResponse = func(err error) (*WorkflowService_SignalWorkflowExecution_Result, error) {
+	WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.WrapResponse = func(success *shared.SignalWithStartWorkflowExecutionAsyncResponse, err error) (*WorkflowService_SignalWithStartWorkflowExecutionAsync_Result, error) {
 		if err == nil {
-			return &WorkflowService_SignalWorkflowExecution_Result{}, nil
+			return &WorkflowService_SignalWithStartWorkflowExecutionAsync_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.BadRequestError")
-			}
-			return &WorkflowService_SignalWorkflowExecution_Result{BadRequestError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWithStartWorkflowExecutionAsync_Result.BadRequestError")
 			}
-			return &WorkflowService_SignalWorkflowExecution_Result{EntityNotExistError: e}, nil
+			return &WorkflowService_SignalWithStartWorkflowExecutionAsync_Result{BadRequestError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWithStartWorkflowExecutionAsync_Result.ServiceBusyError")
 			}
-			return &WorkflowService_SignalWorkflowExecution_Result{ServiceBusyError: e}, nil
+			return &WorkflowService_SignalWithStartWorkflowExecutionAsync_Result{ServiceBusyError: e}, nil
 		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWithStartWorkflowExecutionAsync_Result.DomainNotActiveError")
 			}
-			return &WorkflowService_SignalWorkflowExecution_Result{DomainNotActiveError: e}, nil
+			return &WorkflowService_SignalWithStartWorkflowExecutionAsync_Result{DomainNotActiveError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWithStartWorkflowExecutionAsync_Result.LimitExceededError")
 			}
-			return &WorkflowService_SignalWorkflowExecution_Result{LimitExceededError: e}, nil
-		case *shared.ClientVersionNotSupportedError:
+			return &WorkflowService_SignalWithStartWorkflowExecutionAsync_Result{LimitExceededError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.ClientVersionNotSupportedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWithStartWorkflowExecutionAsync_Result.EntityNotExistError")
 			}
-			return &WorkflowService_SignalWorkflowExecution_Result{ClientVersionNotSupportedError: e}, nil
-		case *shared.WorkflowExecutionAlreadyCompletedError:
+			return &WorkflowService_SignalWithStartWorkflowExecutionAsync_Result{EntityNotExistError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.WorkflowExecutionAlreadyCompletedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWithStartWorkflowExecutionAsync_Result.ClientVersionNotSupportedError")
 			}
-			return &WorkflowService_SignalWorkflowExecution_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
+			return &WorkflowService_SignalWithStartWorkflowExecutionAsync_Result{ClientVersionNotSupportedError: e}, nil
 		}
 
 		return nil, err
 	}
-	WorkflowService_SignalWorkflowExecution_Helper.UnwrapResponse = func(result *WorkflowService_SignalWorkflowExecution_Result) (err error) {
+	WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.UnwrapResponse = func(result *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) (success *shared.SignalWithStartWorkflowExecutionAsyncResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -37131,33 +37119,43 @@ func init() {
 			err = result.LimitExceededError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 		if result.ClientVersionNotSupportedError != nil {
 			err = result.ClientVersionNotSupportedError
 			return
 		}
-		if result.WorkflowExecutionAlreadyCompletedError != nil {
-			err = result.WorkflowExecutionAlreadyCompletedError
+
+		if result.Success != nil {
+			success = result.Success
 			return
 		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// WorkflowService_SignalWorkflowExecution_Result represents the result of a WorkflowService.SignalWorkflowExecution function call.
+// WorkflowService_SignalWithStartWorkflowExecutionAsync_Result represents the result of a WorkflowService.SignalWithStartWorkflowExecutionAsync function call.
 //
-// The result of a SignalWorkflowExecution execution is sent and received over the wire as this struct.
-type WorkflowService_SignalWorkflowExecution_Result struct {
-	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
-	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
-	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
-	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
-	LimitExceededError                     *shared.LimitExceededError                     `json:"limitExceededError,omitempty"`
-	ClientVersionNotSupportedError         *shared.ClientVersionNotSupportedError         `json:"clientVersionNotSupportedError,omitempty"`
-	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
-}
-
-// ToWire translates a WorkflowService_SignalWorkflowExecution_Result struct into a Thrift-level intermediate
+// The result of a SignalWithStartWorkflowExecutionAsync execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type WorkflowService_SignalWithStartWorkflowExecutionAsync_Result struct {
+	// Value returned by SignalWithStartWorkflowExecutionAsync after a successful execution.
+	Success                        *shared.SignalWithStartWorkflowExecutionAsyncResponse `json:"success,omitempty"`
+	BadRequestError                *shared.BadRequestError                               `json:"badRequestError,omitempty"`
+	ServiceBusyError               *shared.ServiceBusyError                              `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError           *shared.DomainNotActiveError                          `json:"domainNotActiveError,omitempty"`
+	LimitExceededError             *shared.LimitExceededError                            `json:"limitExceededError,omitempty"`
+	EntityNotExistError            *shared.EntityNotExistsError                          `json:"entityNotExistError,omitempty"`
+	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError                `json:"clientVersionNotSupportedError,omitempty"`
+}
+
+// ToWire translates a WorkflowService_SignalWithStartWorkflowExecutionAsync_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -37172,7 +37170,7 @@ type WorkflowService_SignalWorkflowExecution_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowService_SignalWorkflowExecution_Result) ToWire() (wire.Value, error) {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [7]wire.Field
 		i      int = 0
@@ -37180,20 +37178,20 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) ToWire() (wire.Value, e
 		err    error
 	)
 
-	if v.BadRequestError != nil {
-		w, err = v.BadRequestError.ToWire()
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
+		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -37220,16 +37218,16 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) ToWire() (wire.Value, e
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		w, err = v.ClientVersionNotSupportedError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 7, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -37237,18 +37235,24 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) ToWire() (wire.Value, e
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("WorkflowService_SignalWorkflowExecution_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("WorkflowService_SignalWithStartWorkflowExecutionAsync_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowService_SignalWorkflowExecution_Result struct from its Thrift-level
+func _SignalWithStartWorkflowExecutionAsyncResponse_Read(w wire.Value) (*shared.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+	var v shared.SignalWithStartWorkflowExecutionAsyncResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a WorkflowService_SignalWithStartWorkflowExecutionAsync_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowService_SignalWorkflowExecution_Result struct
+// An error is returned if we were unable to build a WorkflowService_SignalWithStartWorkflowExecutionAsync_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -37256,27 +37260,27 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) ToWire() (wire.Value, e
 //     return nil, err
 //   }
 //
-//   var v WorkflowService_SignalWorkflowExecution_Result
+//   var v WorkflowService_SignalWithStartWorkflowExecutionAsync_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowService_SignalWorkflowExecution_Result) FromWire(w wire.Value) error {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				v.Success, err = _SignalWithStartWorkflowExecutionAsyncResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 3:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -37308,7 +37312,7 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) FromWire(w wire.Value)
 			}
 		case 7:
 			if field.Value.Type() == wire.TStruct {
-				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -37316,7 +37320,7 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) FromWire(w wire.Value)
 			}
 		case 8:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
+				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -37326,10 +37330,10 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) FromWire(w wire.Value)
 	}
 
 	count := 0
-	if v.BadRequestError != nil {
+	if v.Success != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -37341,33 +37345,33 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) FromWire(w wire.Value)
 	if v.LimitExceededError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("WorkflowService_SignalWorkflowExecution_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_SignalWithStartWorkflowExecutionAsync_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a WorkflowService_SignalWorkflowExecution_Result struct directly into bytes, without going
+// Encode serializes a WorkflowService_SignalWithStartWorkflowExecutionAsync_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowService_SignalWorkflowExecution_Result struct could not be encoded.
-func (v *WorkflowService_SignalWorkflowExecution_Result) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowService_SignalWithStartWorkflowExecutionAsync_Result struct could not be encoded.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.BadRequestError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.BadRequestError.Encode(sw); err != nil {
+		if err := v.Success.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37375,11 +37379,11 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Encode(sw stream.Writer
 		}
 	}
 
-	if v.EntityNotExistError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37423,11 +37427,11 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Encode(sw stream.Writer
 		}
 	}
 
-	if v.ClientVersionNotSupportedError != nil {
+	if v.EntityNotExistError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37435,11 +37439,11 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Encode(sw stream.Writer
 		}
 	}
 
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
+	if v.ClientVersionNotSupportedError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
+		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37448,10 +37452,10 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Encode(sw stream.Writer
 	}
 
 	count := 0
-	if v.BadRequestError != nil {
+	if v.Success != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -37463,26 +37467,32 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Encode(sw stream.Writer
 	if v.LimitExceededError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
 
-	if count > 1 {
-		return fmt.Errorf("WorkflowService_SignalWorkflowExecution_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_SignalWithStartWorkflowExecutionAsync_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowService_SignalWorkflowExecution_Result struct directly from its Thrift-level
+func _SignalWithStartWorkflowExecutionAsyncResponse_Decode(sr stream.Reader) (*shared.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+	var v shared.SignalWithStartWorkflowExecutionAsyncResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a WorkflowService_SignalWithStartWorkflowExecutionAsync_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowService_SignalWorkflowExecution_Result struct could not be generated from the wire
+// An error is returned if a WorkflowService_SignalWithStartWorkflowExecutionAsync_Result struct could not be generated from the wire
 // representation.
-func (v *WorkflowService_SignalWorkflowExecution_Result) Decode(sr stream.Reader) error {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -37495,14 +37505,14 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Decode(sr stream.Reader
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.BadRequestError, err = _BadRequestError_Decode(sr)
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _SignalWithStartWorkflowExecutionAsyncResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -37526,13 +37536,13 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Decode(sr stream.Reader
 			}
 
 		case fh.ID == 7 && fh.Type == wire.TStruct:
-			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 8 && fh.Type == wire.TStruct:
-			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
+			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -37557,10 +37567,10 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Decode(sr stream.Reader
 	}
 
 	count := 0
-	if v.BadRequestError != nil {
+	if v.Success != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -37572,34 +37582,34 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Decode(sr stream.Reader
 	if v.LimitExceededError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("WorkflowService_SignalWorkflowExecution_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_SignalWithStartWorkflowExecutionAsync_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowService_SignalWorkflowExecution_Result
+// String returns a readable string representation of a WorkflowService_SignalWithStartWorkflowExecutionAsync_Result
 // struct.
-func (v *WorkflowService_SignalWorkflowExecution_Result) String() string {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [7]string
 	i := 0
-	if v.BadRequestError != nil {
-		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -37614,32 +37624,32 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) String() string {
 		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
+	if v.ClientVersionNotSupportedError != nil {
+		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowService_SignalWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowService_SignalWithStartWorkflowExecutionAsync_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowService_SignalWorkflowExecution_Result match the
-// provided WorkflowService_SignalWorkflowExecution_Result.
+// Equals returns true if all the fields of this WorkflowService_SignalWithStartWorkflowExecutionAsync_Result match the
+// provided WorkflowService_SignalWithStartWorkflowExecutionAsync_Result.
 //
 // This function performs a deep comparison.
-func (v *WorkflowService_SignalWorkflowExecution_Result) Equals(rhs *WorkflowService_SignalWorkflowExecution_Result) bool {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) Equals(rhs *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
@@ -37651,10 +37661,10 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Equals(rhs *WorkflowSer
 	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
-	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
+	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
 		return false
 	}
 
@@ -37662,17 +37672,17 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) Equals(rhs *WorkflowSer
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowService_SignalWorkflowExecution_Result.
-func (v *WorkflowService_SignalWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowService_SignalWithStartWorkflowExecutionAsync_Result.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -37682,48 +37692,48 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) MarshalLogObject(enc za
 	if v.LimitExceededError != nil {
 		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ClientVersionNotSupportedError != nil {
 		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
-	}
 	return err
 }
 
-// GetBadRequestError returns the value of BadRequestError if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_SignalWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
-	if v != nil && v.BadRequestError != nil {
-		return v.BadRequestError
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) GetSuccess() (o *shared.SignalWithStartWorkflowExecutionAsyncResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
 
 	return
 }
 
-// IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetBadRequestError() bool {
-	return v != nil && v.BadRequestError != nil
+// IsSetSuccess returns true if Success is not nil.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_SignalWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_SignalWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -37732,13 +37742,13 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) GetServiceBusyError() (
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetServiceBusyError() bool {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_SignalWorkflowExecution_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
 	if v != nil && v.DomainNotActiveError != nil {
 		return v.DomainNotActiveError
 	}
@@ -37747,13 +37757,13 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) GetDomainNotActiveError
 }
 
 // IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetDomainNotActiveError() bool {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) IsSetDomainNotActiveError() bool {
 	return v != nil && v.DomainNotActiveError != nil
 }
 
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_SignalWorkflowExecution_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -37762,63 +37772,63 @@ func (v *WorkflowService_SignalWorkflowExecution_Result) GetLimitExceededError()
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetLimitExceededError() bool {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
-// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_SignalWorkflowExecution_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
-	if v != nil && v.ClientVersionNotSupportedError != nil {
-		return v.ClientVersionNotSupportedError
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
-func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetClientVersionNotSupportedError() bool {
-	return v != nil && v.ClientVersionNotSupportedError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
-// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
+// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_SignalWorkflowExecution_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
-	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
-		return v.WorkflowExecutionAlreadyCompletedError
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+	if v != nil && v.ClientVersionNotSupportedError != nil {
+		return v.ClientVersionNotSupportedError
 	}
 
 	return
 }
 
-// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
-func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
-	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
+// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) IsSetClientVersionNotSupportedError() bool {
+	return v != nil && v.ClientVersionNotSupportedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "SignalWorkflowExecution" for this struct.
-func (v *WorkflowService_SignalWorkflowExecution_Result) MethodName() string {
-	return "SignalWorkflowExecution"
+// This will always be "SignalWithStartWorkflowExecutionAsync" for this struct.
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) MethodName() string {
+	return "SignalWithStartWorkflowExecutionAsync"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *WorkflowService_SignalWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *WorkflowService_SignalWithStartWorkflowExecutionAsync_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// WorkflowService_StartWorkflowExecution_Args represents the arguments for the WorkflowService.StartWorkflowExecution function.
+// WorkflowService_SignalWorkflowExecution_Args represents the arguments for the WorkflowService.SignalWorkflowExecution function.
 //
-// The arguments for StartWorkflowExecution are sent and received over the wire as this struct.
-type WorkflowService_StartWorkflowExecution_Args struct {
-	StartRequest *shared.StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
+// The arguments for SignalWorkflowExecution are sent and received over the wire as this struct.
+type WorkflowService_SignalWorkflowExecution_Args struct {
+	SignalRequest *shared.SignalWorkflowExecutionRequest `json:"signalRequest,omitempty"`
 }
 
-// ToWire translates a WorkflowService_StartWorkflowExecution_Args struct into a Thrift-level intermediate
+// ToWire translates a WorkflowService_SignalWorkflowExecution_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -37833,7 +37843,7 @@ type WorkflowService_StartWorkflowExecution_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowService_StartWorkflowExecution_Args) ToWire() (wire.Value, error) {
+func (v *WorkflowService_SignalWorkflowExecution_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -37841,8 +37851,8 @@ func (v *WorkflowService_StartWorkflowExecution_Args) ToWire() (wire.Value, erro
 		err    error
 	)
 
-	if v.StartRequest != nil {
-		w, err = v.StartRequest.ToWire()
+	if v.SignalRequest != nil {
+		w, err = v.SignalRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -37853,17 +37863,17 @@ func (v *WorkflowService_StartWorkflowExecution_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartWorkflowExecutionRequest_Read(w wire.Value) (*shared.StartWorkflowExecutionRequest, error) {
-	var v shared.StartWorkflowExecutionRequest
+func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowExecutionRequest, error) {
+	var v shared.SignalWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a WorkflowService_StartWorkflowExecution_Args struct from its Thrift-level
+// FromWire deserializes a WorkflowService_SignalWorkflowExecution_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowService_StartWorkflowExecution_Args struct
+// An error is returned if we were unable to build a WorkflowService_SignalWorkflowExecution_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -37871,19 +37881,19 @@ func _StartWorkflowExecutionRequest_Read(w wire.Value) (*shared.StartWorkflowExe
 //     return nil, err
 //   }
 //
-//   var v WorkflowService_StartWorkflowExecution_Args
+//   var v WorkflowService_SignalWorkflowExecution_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowService_StartWorkflowExecution_Args) FromWire(w wire.Value) error {
+func (v *WorkflowService_SignalWorkflowExecution_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.StartRequest, err = _StartWorkflowExecutionRequest_Read(field.Value)
+				v.SignalRequest, err = _SignalWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -37895,20 +37905,20 @@ func (v *WorkflowService_StartWorkflowExecution_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// Encode serializes a WorkflowService_StartWorkflowExecution_Args struct directly into bytes, without going
+// Encode serializes a WorkflowService_SignalWorkflowExecution_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowService_StartWorkflowExecution_Args struct could not be encoded.
-func (v *WorkflowService_StartWorkflowExecution_Args) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowService_SignalWorkflowExecution_Args struct could not be encoded.
+func (v *WorkflowService_SignalWorkflowExecution_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.StartRequest != nil {
+	if v.SignalRequest != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.StartRequest.Encode(sw); err != nil {
+		if err := v.SignalRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37919,18 +37929,18 @@ func (v *WorkflowService_StartWorkflowExecution_Args) Encode(sw stream.Writer) e
 	return sw.WriteStructEnd()
 }
 
-func _StartWorkflowExecutionRequest_Decode(sr stream.Reader) (*shared.StartWorkflowExecutionRequest, error) {
-	var v shared.StartWorkflowExecutionRequest
+func _SignalWorkflowExecutionRequest_Decode(sr stream.Reader) (*shared.SignalWorkflowExecutionRequest, error) {
+	var v shared.SignalWorkflowExecutionRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a WorkflowService_StartWorkflowExecution_Args struct directly from its Thrift-level
+// Decode deserializes a WorkflowService_SignalWorkflowExecution_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowService_StartWorkflowExecution_Args struct could not be generated from the wire
+// An error is returned if a WorkflowService_SignalWorkflowExecution_Args struct could not be generated from the wire
 // representation.
-func (v *WorkflowService_StartWorkflowExecution_Args) Decode(sr stream.Reader) error {
+func (v *WorkflowService_SignalWorkflowExecution_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -37944,7 +37954,7 @@ func (v *WorkflowService_StartWorkflowExecution_Args) Decode(sr stream.Reader) e
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.StartRequest, err = _StartWorkflowExecutionRequest_Decode(sr)
+			v.SignalRequest, err = _SignalWorkflowExecutionRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -37971,34 +37981,34 @@ func (v *WorkflowService_StartWorkflowExecution_Args) Decode(sr stream.Reader) e
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowService_StartWorkflowExecution_Args
+// String returns a readable string representation of a WorkflowService_SignalWorkflowExecution_Args
 // struct.
-func (v *WorkflowService_StartWorkflowExecution_Args) String() string {
+func (v *WorkflowService_SignalWorkflowExecution_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.StartRequest != nil {
-		fields[i] = fmt.Sprintf("StartRequest: %v", v.StartRequest)
+	if v.SignalRequest != nil {
+		fields[i] = fmt.Sprintf("SignalRequest: %v", v.SignalRequest)
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowService_StartWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowService_SignalWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowService_StartWorkflowExecution_Args match the
-// provided WorkflowService_StartWorkflowExecution_Args.
+// Equals returns true if all the fields of this WorkflowService_SignalWorkflowExecution_Args match the
+// provided WorkflowService_SignalWorkflowExecution_Args.
 //
 // This function performs a deep comparison.
-func (v *WorkflowService_StartWorkflowExecution_Args) Equals(rhs *WorkflowService_StartWorkflowExecution_Args) bool {
+func (v *WorkflowService_SignalWorkflowExecution_Args) Equals(rhs *WorkflowService_SignalWorkflowExecution_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.StartRequest == nil && rhs.StartRequest == nil) || (v.StartRequest != nil && rhs.StartRequest != nil && v.StartRequest.Equals(rhs.StartRequest))) {
+	if !((v.SignalRequest == nil && rhs.SignalRequest == nil) || (v.SignalRequest != nil && rhs.SignalRequest != nil && v.SignalRequest.Equals(rhs.SignalRequest))) {
 		return false
 	}
 
@@ -38006,106 +38016,107 @@ func (v *WorkflowService_StartWorkflowExecution_Args) Equals(rhs *WorkflowServic
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowService_StartWorkflowExecution_Args.
-func (v *WorkflowService_StartWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowService_SignalWorkflowExecution_Args.
+func (v *WorkflowService_SignalWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.StartRequest != nil {
-		err = multierr.Append(err, enc.AddObject("startRequest", v.StartRequest))
+	if v.SignalRequest != nil {
+		err = multierr.Append(err, enc.AddObject("signalRequest", v.SignalRequest))
 	}
 	return err
 }
 
-// GetStartRequest returns the value of StartRequest if it is set or its
+// GetSignalRequest returns the value of SignalRequest if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Args) GetStartRequest() (o *shared.StartWorkflowExecutionRequest) {
-	if v != nil && v.StartRequest != nil {
-		return v.StartRequest
+func (v *WorkflowService_SignalWorkflowExecution_Args) GetSignalRequest() (o *shared.SignalWorkflowExecutionRequest) {
+	if v != nil && v.SignalRequest != nil {
+		return v.SignalRequest
 	}
 
 	return
 }
 
-// IsSetStartRequest returns true if StartRequest is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Args) IsSetStartRequest() bool {
-	return v != nil && v.StartRequest != nil
+// IsSetSignalRequest returns true if SignalRequest is not nil.
+func (v *WorkflowService_SignalWorkflowExecution_Args) IsSetSignalRequest() bool {
+	return v != nil && v.SignalRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "StartWorkflowExecution" for this struct.
-func (v *WorkflowService_StartWorkflowExecution_Args) MethodName() string {
-	return "StartWorkflowExecution"
+// This will always be "SignalWorkflowExecution" for this struct.
+func (v *WorkflowService_SignalWorkflowExecution_Args) MethodName() string {
+	return "SignalWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *WorkflowService_StartWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *WorkflowService_SignalWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// WorkflowService_StartWorkflowExecution_Helper provides functions that aid in handling the
-// parameters and return values of the WorkflowService.StartWorkflowExecution
+// WorkflowService_SignalWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the WorkflowService.SignalWorkflowExecution
 // function.
-var WorkflowService_StartWorkflowExecution_Helper = struct {
-	// Args accepts the parameters of StartWorkflowExecution in-order and returns
+var WorkflowService_SignalWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of SignalWorkflowExecution in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		startRequest *shared.StartWorkflowExecutionRequest,
-	) *WorkflowService_StartWorkflowExecution_Args
+		signalRequest *shared.SignalWorkflowExecutionRequest,
+	) *WorkflowService_SignalWorkflowExecution_Args
 
 	// IsException returns true if the given error can be thrown
-	// by StartWorkflowExecution.
+	// by SignalWorkflowExecution.
 	//
-	// An error can be thrown by StartWorkflowExecution only if the
+	// An error can be thrown by SignalWorkflowExecution only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for StartWorkflowExecution
-	// given its return value and error.
+	// WrapResponse returns the result struct for SignalWorkflowExecution
+	// given the error returned by it. The provided error may
+	// be nil if SignalWorkflowExecution did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// StartWorkflowExecution into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by StartWorkflowExecution
+	// This allows mapping errors returned by SignalWorkflowExecution into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// SignalWorkflowExecution
 	//
-	//   value, err := StartWorkflowExecution(args)
-	//   result, err := WorkflowService_StartWorkflowExecution_Helper.WrapResponse(value, err)
+	//   err := SignalWorkflowExecution(args)
+	//   result, err := WorkflowService_SignalWorkflowExecution_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from StartWorkflowExecution: %v", err)
+	//     return fmt.Errorf("unexpected error from SignalWorkflowExecution: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.StartWorkflowExecutionResponse, error) (*WorkflowService_StartWorkflowExecution_Result, error)
+	WrapResponse func(error) (*WorkflowService_SignalWorkflowExecution_Result, error)
 
-	// UnwrapResponse takes the result struct for StartWorkflowExecution
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for SignalWorkflowExecution
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if StartWorkflowExecution threw an
+	// The error is non-nil only if SignalWorkflowExecution threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := WorkflowService_StartWorkflowExecution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*WorkflowService_StartWorkflowExecution_Result) (*shared.StartWorkflowExecutionResponse, error)
+	//   err := WorkflowService_SignalWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*WorkflowService_SignalWorkflowExecution_Result) error
 }{}
 
 func init() {
-	WorkflowService_StartWorkflowExecution_Helper.Args = func(
-		startRequest *shared.StartWorkflowExecutionRequest,
-	) *WorkflowService_StartWorkflowExecution_Args {
-		return &WorkflowService_StartWorkflowExecution_Args{
-			StartRequest: startRequest,
+	WorkflowService_SignalWorkflowExecution_Helper.Args = func(
+		signalRequest *shared.SignalWorkflowExecutionRequest,
+	) *WorkflowService_SignalWorkflowExecution_Args {
+		return &WorkflowService_SignalWorkflowExecution_Args{
+			SignalRequest: signalRequest,
 		}
 	}
 
-	WorkflowService_StartWorkflowExecution_Helper.IsException = func(err error) bool {
+	WorkflowService_SignalWorkflowExecution_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.WorkflowExecutionAlreadyStartedError:
+		case *shared.EntityNotExistsError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
@@ -38113,67 +38124,67 @@ func init() {
 			return true
 		case *shared.LimitExceededError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.ClientVersionNotSupportedError:
 			return true
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	WorkflowService_StartWorkflowExecution_Helper.WrapResponse = func(success *shared.StartWorkflowExecutionResponse, err error) (*WorkflowService_StartWorkflowExecution_Result, error) {
+	WorkflowService_SignalWorkflowExecution_Helper.WrapResponse = func(err error) (*WorkflowService_SignalWorkflowExecution_Result, error) {
 		if err == nil {
-			return &WorkflowService_StartWorkflowExecution_Result{Success: success}, nil
+			return &WorkflowService_SignalWorkflowExecution_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.BadRequestError")
 			}
-			return &WorkflowService_StartWorkflowExecution_Result{BadRequestError: e}, nil
-		case *shared.WorkflowExecutionAlreadyStartedError:
+			return &WorkflowService_SignalWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.SessionAlreadyExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.EntityNotExistError")
 			}
-			return &WorkflowService_StartWorkflowExecution_Result{SessionAlreadyExistError: e}, nil
+			return &WorkflowService_SignalWorkflowExecution_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.ServiceBusyError")
 			}
-			return &WorkflowService_StartWorkflowExecution_Result{ServiceBusyError: e}, nil
+			return &WorkflowService_SignalWorkflowExecution_Result{ServiceBusyError: e}, nil
 		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.DomainNotActiveError")
 			}
-			return &WorkflowService_StartWorkflowExecution_Result{DomainNotActiveError: e}, nil
+			return &WorkflowService_SignalWorkflowExecution_Result{DomainNotActiveError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.LimitExceededError")
 			}
-			return &WorkflowService_StartWorkflowExecution_Result{LimitExceededError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &WorkflowService_SignalWorkflowExecution_Result{LimitExceededError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.ClientVersionNotSupportedError")
 			}
-			return &WorkflowService_StartWorkflowExecution_Result{EntityNotExistError: e}, nil
-		case *shared.ClientVersionNotSupportedError:
+			return &WorkflowService_SignalWorkflowExecution_Result{ClientVersionNotSupportedError: e}, nil
+		case *shared.WorkflowExecutionAlreadyCompletedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.ClientVersionNotSupportedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWorkflowExecution_Result.WorkflowExecutionAlreadyCompletedError")
 			}
-			return &WorkflowService_StartWorkflowExecution_Result{ClientVersionNotSupportedError: e}, nil
+			return &WorkflowService_SignalWorkflowExecution_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
 		}
 
 		return nil, err
 	}
-	WorkflowService_StartWorkflowExecution_Helper.UnwrapResponse = func(result *WorkflowService_StartWorkflowExecution_Result) (success *shared.StartWorkflowExecutionResponse, err error) {
+	WorkflowService_SignalWorkflowExecution_Helper.UnwrapResponse = func(result *WorkflowService_SignalWorkflowExecution_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.SessionAlreadyExistError != nil {
-			err = result.SessionAlreadyExistError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
 		if result.ServiceBusyError != nil {
@@ -38188,44 +38199,33 @@ func init() {
 			err = result.LimitExceededError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.ClientVersionNotSupportedError != nil {
 			err = result.ClientVersionNotSupportedError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.WorkflowExecutionAlreadyCompletedError != nil {
+			err = result.WorkflowExecutionAlreadyCompletedError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// WorkflowService_StartWorkflowExecution_Result represents the result of a WorkflowService.StartWorkflowExecution function call.
-//
-// The result of a StartWorkflowExecution execution is sent and received over the wire as this struct.
+// WorkflowService_SignalWorkflowExecution_Result represents the result of a WorkflowService.SignalWorkflowExecution function call.
 //
-// Success is set only if the function did not throw an exception.
-type WorkflowService_StartWorkflowExecution_Result struct {
-	// Value returned by StartWorkflowExecution after a successful execution.
-	Success                        *shared.StartWorkflowExecutionResponse       `json:"success,omitempty"`
-	BadRequestError                *shared.BadRequestError                      `json:"badRequestError,omitempty"`
-	SessionAlreadyExistError       *shared.WorkflowExecutionAlreadyStartedError `json:"sessionAlreadyExistError,omitempty"`
-	ServiceBusyError               *shared.ServiceBusyError                     `json:"serviceBusyError,omitempty"`
-	DomainNotActiveError           *shared.DomainNotActiveError                 `json:"domainNotActiveError,omitempty"`
-	LimitExceededError             *shared.LimitExceededError                   `json:"limitExceededError,omitempty"`
-	EntityNotExistError            *shared.EntityNotExistsError                 `json:"entityNotExistError,omitempty"`
-	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError       `json:"clientVersionNotSupportedError,omitempty"`
+// The result of a SignalWorkflowExecution execution is sent and received over the wire as this struct.
+type WorkflowService_SignalWorkflowExecution_Result struct {
+	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
+	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
+	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
+	LimitExceededError                     *shared.LimitExceededError                     `json:"limitExceededError,omitempty"`
+	ClientVersionNotSupportedError         *shared.ClientVersionNotSupportedError         `json:"clientVersionNotSupportedError,omitempty"`
+	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
 }
 
-// ToWire translates a WorkflowService_StartWorkflowExecution_Result struct into a Thrift-level intermediate
+// ToWire translates a WorkflowService_SignalWorkflowExecution_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -38240,22 +38240,14 @@ type WorkflowService_StartWorkflowExecution_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowService_StartWorkflowExecution_Result) ToWire() (wire.Value, error) {
+func (v *WorkflowService_SignalWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -38264,8 +38256,8 @@ func (v *WorkflowService_StartWorkflowExecution_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.SessionAlreadyExistError != nil {
-		w, err = v.SessionAlreadyExistError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -38296,16 +38288,16 @@ func (v *WorkflowService_StartWorkflowExecution_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 7, Value: w}
 		i++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		w, err = v.ClientVersionNotSupportedError.ToWire()
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -38313,18 +38305,18 @@ func (v *WorkflowService_StartWorkflowExecution_Result) ToWire() (wire.Value, er
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("WorkflowService_StartWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("WorkflowService_SignalWorkflowExecution_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowService_StartWorkflowExecution_Result struct from its Thrift-level
+// FromWire deserializes a WorkflowService_SignalWorkflowExecution_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowService_StartWorkflowExecution_Result struct
+// An error is returned if we were unable to build a WorkflowService_SignalWorkflowExecution_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -38332,24 +38324,16 @@ func (v *WorkflowService_StartWorkflowExecution_Result) ToWire() (wire.Value, er
 //     return nil, err
 //   }
 //
-//   var v WorkflowService_StartWorkflowExecution_Result
+//   var v WorkflowService_SignalWorkflowExecution_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowService_StartWorkflowExecution_Result) FromWire(w wire.Value) error {
+func (v *WorkflowService_SignalWorkflowExecution_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _StartWorkflowExecutionResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -38360,7 +38344,7 @@ func (v *WorkflowService_StartWorkflowExecution_Result) FromWire(w wire.Value) e
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.SessionAlreadyExistError, err = _WorkflowExecutionAlreadyStartedError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -38392,7 +38376,7 @@ func (v *WorkflowService_StartWorkflowExecution_Result) FromWire(w wire.Value) e
 			}
 		case 7:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -38400,7 +38384,7 @@ func (v *WorkflowService_StartWorkflowExecution_Result) FromWire(w wire.Value) e
 			}
 		case 8:
 			if field.Value.Type() == wire.TStruct {
-				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
+				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -38410,13 +38394,10 @@ func (v *WorkflowService_StartWorkflowExecution_Result) FromWire(w wire.Value) e
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.SessionAlreadyExistError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -38428,40 +38409,28 @@ func (v *WorkflowService_StartWorkflowExecution_Result) FromWire(w wire.Value) e
 	if v.LimitExceededError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("WorkflowService_StartWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("WorkflowService_SignalWorkflowExecution_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a WorkflowService_StartWorkflowExecution_Result struct directly into bytes, without going
+// Encode serializes a WorkflowService_SignalWorkflowExecution_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowService_StartWorkflowExecution_Result struct could not be encoded.
-func (v *WorkflowService_StartWorkflowExecution_Result) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowService_SignalWorkflowExecution_Result struct could not be encoded.
+func (v *WorkflowService_SignalWorkflowExecution_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Success.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
@@ -38474,11 +38443,11 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Encode(sw stream.Writer)
 		}
 	}
 
-	if v.SessionAlreadyExistError != nil {
+	if v.EntityNotExistError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.SessionAlreadyExistError.Encode(sw); err != nil {
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -38522,11 +38491,11 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Encode(sw stream.Writer)
 		}
 	}
 
-	if v.EntityNotExistError != nil {
+	if v.ClientVersionNotSupportedError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
+		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -38534,11 +38503,11 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Encode(sw stream.Writer)
 		}
 	}
 
-	if v.ClientVersionNotSupportedError != nil {
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -38547,13 +38516,10 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Encode(sw stream.Writer)
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.SessionAlreadyExistError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -38565,26 +38531,26 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Encode(sw stream.Writer)
 	if v.LimitExceededError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
 
-	if count != 1 {
-		return fmt.Errorf("WorkflowService_StartWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("WorkflowService_SignalWorkflowExecution_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowService_StartWorkflowExecution_Result struct directly from its Thrift-level
+// Decode deserializes a WorkflowService_SignalWorkflowExecution_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowService_StartWorkflowExecution_Result struct could not be generated from the wire
+// An error is returned if a WorkflowService_SignalWorkflowExecution_Result struct could not be generated from the wire
 // representation.
-func (v *WorkflowService_StartWorkflowExecution_Result) Decode(sr stream.Reader) error {
+func (v *WorkflowService_SignalWorkflowExecution_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -38597,12 +38563,6 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Decode(sr stream.Reader)
 
 	for ok {
 		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _StartWorkflowExecutionResponse_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -38610,7 +38570,7 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Decode(sr stream.Reader)
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.SessionAlreadyExistError, err = _WorkflowExecutionAlreadyStartedError_Decode(sr)
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -38634,13 +38594,13 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Decode(sr stream.Reader)
 			}
 
 		case fh.ID == 7 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 8 && fh.Type == wire.TStruct:
-			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -38665,13 +38625,10 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Decode(sr stream.Reader)
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.SessionAlreadyExistError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -38683,38 +38640,34 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Decode(sr stream.Reader)
 	if v.LimitExceededError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("WorkflowService_StartWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("WorkflowService_SignalWorkflowExecution_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowService_StartWorkflowExecution_Result
+// String returns a readable string representation of a WorkflowService_SignalWorkflowExecution_Result
 // struct.
-func (v *WorkflowService_StartWorkflowExecution_Result) String() string {
+func (v *WorkflowService_SignalWorkflowExecution_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [7]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.SessionAlreadyExistError != nil {
-		fields[i] = fmt.Sprintf("SessionAlreadyExistError: %v", v.SessionAlreadyExistError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -38729,35 +38682,32 @@ func (v *WorkflowService_StartWorkflowExecution_Result) String() string {
 		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
 	if v.ClientVersionNotSupportedError != nil {
 		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
 		i++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
+		i++
+	}
 
-	return fmt.Sprintf("WorkflowService_StartWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowService_SignalWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowService_StartWorkflowExecution_Result match the
-// provided WorkflowService_StartWorkflowExecution_Result.
+// Equals returns true if all the fields of this WorkflowService_SignalWorkflowExecution_Result match the
+// provided WorkflowService_SignalWorkflowExecution_Result.
 //
 // This function performs a deep comparison.
-func (v *WorkflowService_StartWorkflowExecution_Result) Equals(rhs *WorkflowService_StartWorkflowExecution_Result) bool {
+func (v *WorkflowService_SignalWorkflowExecution_Result) Equals(rhs *WorkflowService_SignalWorkflowExecution_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.SessionAlreadyExistError == nil && rhs.SessionAlreadyExistError == nil) || (v.SessionAlreadyExistError != nil && rhs.SessionAlreadyExistError != nil && v.SessionAlreadyExistError.Equals(rhs.SessionAlreadyExistError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
@@ -38769,10 +38719,10 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Equals(rhs *WorkflowServ
 	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
 		return false
 	}
-	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
 		return false
 	}
 
@@ -38780,19 +38730,16 @@ func (v *WorkflowService_StartWorkflowExecution_Result) Equals(rhs *WorkflowServ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowService_StartWorkflowExecution_Result.
-func (v *WorkflowService_StartWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowService_SignalWorkflowExecution_Result.
+func (v *WorkflowService_SignalWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.SessionAlreadyExistError != nil {
-		err = multierr.Append(err, enc.AddObject("sessionAlreadyExistError", v.SessionAlreadyExistError))
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
@@ -38803,33 +38750,18 @@ func (v *WorkflowService_StartWorkflowExecution_Result) MarshalLogObject(enc zap
 	if v.LimitExceededError != nil {
 		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.ClientVersionNotSupportedError != nil {
 		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
 	}
-	return err
-}
-
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Result) GetSuccess() (o *shared.StartWorkflowExecutionResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
 	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *WorkflowService_SignalWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -38838,28 +38770,28 @@ func (v *WorkflowService_StartWorkflowExecution_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Result) IsSetBadRequestError() bool {
+func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetSessionAlreadyExistError returns the value of SessionAlreadyExistError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Result) GetSessionAlreadyExistError() (o *shared.WorkflowExecutionAlreadyStartedError) {
-	if v != nil && v.SessionAlreadyExistError != nil {
-		return v.SessionAlreadyExistError
+func (v *WorkflowService_SignalWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetSessionAlreadyExistError returns true if SessionAlreadyExistError is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Result) IsSetSessionAlreadyExistError() bool {
-	return v != nil && v.SessionAlreadyExistError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *WorkflowService_SignalWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -38868,13 +38800,13 @@ func (v *WorkflowService_StartWorkflowExecution_Result) GetServiceBusyError() (o
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Result) IsSetServiceBusyError() bool {
+func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+func (v *WorkflowService_SignalWorkflowExecution_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
 	if v != nil && v.DomainNotActiveError != nil {
 		return v.DomainNotActiveError
 	}
@@ -38883,13 +38815,13 @@ func (v *WorkflowService_StartWorkflowExecution_Result) GetDomainNotActiveError(
 }
 
 // IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Result) IsSetDomainNotActiveError() bool {
+func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetDomainNotActiveError() bool {
 	return v != nil && v.DomainNotActiveError != nil
 }
 
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *WorkflowService_SignalWorkflowExecution_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -38898,63 +38830,63 @@ func (v *WorkflowService_StartWorkflowExecution_Result) GetLimitExceededError()
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Result) IsSetLimitExceededError() bool {
+func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *WorkflowService_SignalWorkflowExecution_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+	if v != nil && v.ClientVersionNotSupportedError != nil {
+		return v.ClientVersionNotSupportedError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
+func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetClientVersionNotSupportedError() bool {
+	return v != nil && v.ClientVersionNotSupportedError != nil
 }
 
-// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_StartWorkflowExecution_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
-	if v != nil && v.ClientVersionNotSupportedError != nil {
-		return v.ClientVersionNotSupportedError
+func (v *WorkflowService_SignalWorkflowExecution_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
+	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
+		return v.WorkflowExecutionAlreadyCompletedError
 	}
 
 	return
 }
 
-// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
-func (v *WorkflowService_StartWorkflowExecution_Result) IsSetClientVersionNotSupportedError() bool {
-	return v != nil && v.ClientVersionNotSupportedError != nil
+// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
+func (v *WorkflowService_SignalWorkflowExecution_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
+	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "StartWorkflowExecution" for this struct.
-func (v *WorkflowService_StartWorkflowExecution_Result) MethodName() string {
-	return "StartWorkflowExecution"
+// This will always be "SignalWorkflowExecution" for this struct.
+func (v *WorkflowService_SignalWorkflowExecution_Result) MethodName() string {
+	return "SignalWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *WorkflowService_StartWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *WorkflowService_SignalWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// WorkflowService_TerminateWorkflowExecution_Args represents the arguments for the WorkflowService.TerminateWorkflowExecution function.
+// WorkflowService_StartWorkflowExecution_Args represents the arguments for the WorkflowService.StartWorkflowExecution function.
 //
-// The arguments for TerminateWorkflowExecution are sent and received over the wire as this struct.
-type WorkflowService_TerminateWorkflowExecution_Args struct {
-	TerminateRequest *shared.TerminateWorkflowExecutionRequest `json:"terminateRequest,omitempty"`
+// The arguments for StartWorkflowExecution are sent and received over the wire as this struct.
+type WorkflowService_StartWorkflowExecution_Args struct {
+	StartRequest *shared.StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
 }
 
-// ToWire translates a WorkflowService_TerminateWorkflowExecution_Args struct into a Thrift-level intermediate
+// ToWire translates a WorkflowService_StartWorkflowExecution_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -38969,7 +38901,7 @@ type WorkflowService_TerminateWorkflowExecution_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowService_TerminateWorkflowExecution_Args) ToWire() (wire.Value, error) {
+func (v *WorkflowService_StartWorkflowExecution_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -38977,8 +38909,8 @@ func (v *WorkflowService_TerminateWorkflowExecution_Args) ToWire() (wire.Value,
 		err    error
 	)
 
-	if v.TerminateRequest != nil {
-		w, err = v.TerminateRequest.ToWire()
+	if v.StartRequest != nil {
+		w, err = v.StartRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -38989,17 +38921,17 @@ func (v *WorkflowService_TerminateWorkflowExecution_Args) ToWire() (wire.Value,
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWorkflowExecutionRequest, error) {
-	var v shared.TerminateWorkflowExecutionRequest
+func _StartWorkflowExecutionRequest_Read(w wire.Value) (*shared.StartWorkflowExecutionRequest, error) {
+	var v shared.StartWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a WorkflowService_TerminateWorkflowExecution_Args struct from its Thrift-level
+// FromWire deserializes a WorkflowService_StartWorkflowExecution_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowService_TerminateWorkflowExecution_Args struct
+// An error is returned if we were unable to build a WorkflowService_StartWorkflowExecution_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -39007,19 +38939,19 @@ func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWor
 //     return nil, err
 //   }
 //
-//   var v WorkflowService_TerminateWorkflowExecution_Args
+//   var v WorkflowService_StartWorkflowExecution_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowService_TerminateWorkflowExecution_Args) FromWire(w wire.Value) error {
+func (v *WorkflowService_StartWorkflowExecution_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.TerminateRequest, err = _TerminateWorkflowExecutionRequest_Read(field.Value)
+				v.StartRequest, err = _StartWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -39031,20 +38963,20 @@ func (v *WorkflowService_TerminateWorkflowExecution_Args) FromWire(w wire.Value)
 	return nil
 }
 
-// Encode serializes a WorkflowService_TerminateWorkflowExecution_Args struct directly into bytes, without going
+// Encode serializes a WorkflowService_StartWorkflowExecution_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowService_TerminateWorkflowExecution_Args struct could not be encoded.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowService_StartWorkflowExecution_Args struct could not be encoded.
+func (v *WorkflowService_StartWorkflowExecution_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.TerminateRequest != nil {
+	if v.StartRequest != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.TerminateRequest.Encode(sw); err != nil {
+		if err := v.StartRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -39055,18 +38987,18 @@ func (v *WorkflowService_TerminateWorkflowExecution_Args) Encode(sw stream.Write
 	return sw.WriteStructEnd()
 }
 
-func _TerminateWorkflowExecutionRequest_Decode(sr stream.Reader) (*shared.TerminateWorkflowExecutionRequest, error) {
-	var v shared.TerminateWorkflowExecutionRequest
+func _StartWorkflowExecutionRequest_Decode(sr stream.Reader) (*shared.StartWorkflowExecutionRequest, error) {
+	var v shared.StartWorkflowExecutionRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a WorkflowService_TerminateWorkflowExecution_Args struct directly from its Thrift-level
+// Decode deserializes a WorkflowService_StartWorkflowExecution_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowService_TerminateWorkflowExecution_Args struct could not be generated from the wire
+// An error is returned if a WorkflowService_StartWorkflowExecution_Args struct could not be generated from the wire
 // representation.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) Decode(sr stream.Reader) error {
+func (v *WorkflowService_StartWorkflowExecution_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -39080,7 +39012,7 @@ func (v *WorkflowService_TerminateWorkflowExecution_Args) Decode(sr stream.Reade
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.TerminateRequest, err = _TerminateWorkflowExecutionRequest_Decode(sr)
+			v.StartRequest, err = _StartWorkflowExecutionRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -39107,34 +39039,34 @@ func (v *WorkflowService_TerminateWorkflowExecution_Args) Decode(sr stream.Reade
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowService_TerminateWorkflowExecution_Args
+// String returns a readable string representation of a WorkflowService_StartWorkflowExecution_Args
 // struct.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) String() string {
+func (v *WorkflowService_StartWorkflowExecution_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.TerminateRequest != nil {
-		fields[i] = fmt.Sprintf("TerminateRequest: %v", v.TerminateRequest)
+	if v.StartRequest != nil {
+		fields[i] = fmt.Sprintf("StartRequest: %v", v.StartRequest)
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowService_TerminateWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowService_StartWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowService_TerminateWorkflowExecution_Args match the
-// provided WorkflowService_TerminateWorkflowExecution_Args.
+// Equals returns true if all the fields of this WorkflowService_StartWorkflowExecution_Args match the
+// provided WorkflowService_StartWorkflowExecution_Args.
 //
 // This function performs a deep comparison.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) Equals(rhs *WorkflowService_TerminateWorkflowExecution_Args) bool {
+func (v *WorkflowService_StartWorkflowExecution_Args) Equals(rhs *WorkflowService_StartWorkflowExecution_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TerminateRequest == nil && rhs.TerminateRequest == nil) || (v.TerminateRequest != nil && rhs.TerminateRequest != nil && v.TerminateRequest.Equals(rhs.TerminateRequest))) {
+	if !((v.StartRequest == nil && rhs.StartRequest == nil) || (v.StartRequest != nil && rhs.StartRequest != nil && v.StartRequest.Equals(rhs.StartRequest))) {
 		return false
 	}
 
@@ -39142,107 +39074,106 @@ func (v *WorkflowService_TerminateWorkflowExecution_Args) Equals(rhs *WorkflowSe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowService_TerminateWorkflowExecution_Args.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowService_StartWorkflowExecution_Args.
+func (v *WorkflowService_StartWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TerminateRequest != nil {
-		err = multierr.Append(err, enc.AddObject("terminateRequest", v.TerminateRequest))
+	if v.StartRequest != nil {
+		err = multierr.Append(err, enc.AddObject("startRequest", v.StartRequest))
 	}
 	return err
 }
 
-// GetTerminateRequest returns the value of TerminateRequest if it is set or its
+// GetStartRequest returns the value of StartRequest if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) GetTerminateRequest() (o *shared.TerminateWorkflowExecutionRequest) {
-	if v != nil && v.TerminateRequest != nil {
-		return v.TerminateRequest
+func (v *WorkflowService_StartWorkflowExecution_Args) GetStartRequest() (o *shared.StartWorkflowExecutionRequest) {
+	if v != nil && v.StartRequest != nil {
+		return v.StartRequest
 	}
 
 	return
 }
 
-// IsSetTerminateRequest returns true if TerminateRequest is not nil.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) IsSetTerminateRequest() bool {
-	return v != nil && v.TerminateRequest != nil
+// IsSetStartRequest returns true if StartRequest is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Args) IsSetStartRequest() bool {
+	return v != nil && v.StartRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "TerminateWorkflowExecution" for this struct.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) MethodName() string {
-	return "TerminateWorkflowExecution"
+// This will always be "StartWorkflowExecution" for this struct.
+func (v *WorkflowService_StartWorkflowExecution_Args) MethodName() string {
+	return "StartWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *WorkflowService_TerminateWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *WorkflowService_StartWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// WorkflowService_TerminateWorkflowExecution_Helper provides functions that aid in handling the
-// parameters and return values of the WorkflowService.TerminateWorkflowExecution
+// WorkflowService_StartWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the WorkflowService.StartWorkflowExecution
 // function.
-var WorkflowService_TerminateWorkflowExecution_Helper = struct {
-	// Args accepts the parameters of TerminateWorkflowExecution in-order and returns
+var WorkflowService_StartWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of StartWorkflowExecution in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		terminateRequest *shared.TerminateWorkflowExecutionRequest,
-	) *WorkflowService_TerminateWorkflowExecution_Args
+		startRequest *shared.StartWorkflowExecutionRequest,
+	) *WorkflowService_StartWorkflowExecution_Args
 
 	// IsException returns true if the given error can be thrown
-	// by TerminateWorkflowExecution.
+	// by StartWorkflowExecution.
 	//
-	// An error can be thrown by TerminateWorkflowExecution only if the
+	// An error can be thrown by StartWorkflowExecution only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for TerminateWorkflowExecution
-	// given the error returned by it. The provided error may
-	// be nil if TerminateWorkflowExecution did not fail.
+	// WrapResponse returns the result struct for StartWorkflowExecution
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by TerminateWorkflowExecution into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// TerminateWorkflowExecution
+	// This allows mapping values and errors returned by
+	// StartWorkflowExecution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by StartWorkflowExecution
 	//
-	//   err := TerminateWorkflowExecution(args)
-	//   result, err := WorkflowService_TerminateWorkflowExecution_Helper.WrapResponse(err)
+	//   value, err := StartWorkflowExecution(args)
+	//   result, err := WorkflowService_StartWorkflowExecution_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from TerminateWorkflowExecution: %v", err)
+	//     return fmt.Errorf("unexpected error from StartWorkflowExecution: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*WorkflowService_TerminateWorkflowExecution_Result, error)
+	WrapResponse func(*shared.StartWorkflowExecutionResponse, error) (*WorkflowService_StartWorkflowExecution_Result, error)
 
-	// UnwrapResponse takes the result struct for TerminateWorkflowExecution
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for StartWorkflowExecution
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if TerminateWorkflowExecution threw an
+	// The error is non-nil only if StartWorkflowExecution threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := WorkflowService_TerminateWorkflowExecution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*WorkflowService_TerminateWorkflowExecution_Result) error
+	//   value, err := WorkflowService_StartWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*WorkflowService_StartWorkflowExecution_Result) (*shared.StartWorkflowExecutionResponse, error)
 }{}
 
 func init() {
-	WorkflowService_TerminateWorkflowExecution_Helper.Args = func(
-		terminateRequest *shared.TerminateWorkflowExecutionRequest,
-	) *WorkflowService_TerminateWorkflowExecution_Args {
-		return &WorkflowService_TerminateWorkflowExecution_Args{
-			TerminateRequest: terminateRequest,
+	WorkflowService_StartWorkflowExecution_Helper.Args = func(
+		startRequest *shared.StartWorkflowExecutionRequest,
+	) *WorkflowService_StartWorkflowExecution_Args {
+		return &WorkflowService_StartWorkflowExecution_Args{
+			StartRequest: startRequest,
 		}
 	}
 
-	WorkflowService_TerminateWorkflowExecution_Helper.IsException = func(err error) bool {
+	WorkflowService_StartWorkflowExecution_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.EntityNotExistsError:
+		case *shared.WorkflowExecutionAlreadyStartedError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
@@ -39250,67 +39181,67 @@ func init() {
 			return true
 		case *shared.LimitExceededError:
 			return true
-		case *shared.ClientVersionNotSupportedError:
+		case *shared.EntityNotExistsError:
 			return true
-		case *shared.WorkflowExecutionAlreadyCompletedError:
+		case *shared.ClientVersionNotSupportedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	WorkflowService_TerminateWorkflowExecution_Helper.WrapResponse = func(err error) (*WorkflowService_TerminateWorkflowExecution_Result, error) {
+	WorkflowService_StartWorkflowExecution_Helper.WrapResponse = func(success *shared.StartWorkflowExecutionResponse, err error) (*WorkflowService_StartWorkflowExecution_Result, error) {
 		if err == nil {
-			return &WorkflowService_TerminateWorkflowExecution_Result{}, nil
+			return &WorkflowService_StartWorkflowExecution_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.BadRequestError")
 			}
-			return &WorkflowService_TerminateWorkflowExecution_Result{BadRequestError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &WorkflowService_StartWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.WorkflowExecutionAlreadyStartedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.SessionAlreadyExistError")
 			}
-			return &WorkflowService_TerminateWorkflowExecution_Result{EntityNotExistError: e}, nil
+			return &WorkflowService_StartWorkflowExecution_Result{SessionAlreadyExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.ServiceBusyError")
 			}
-			return &WorkflowService_TerminateWorkflowExecution_Result{ServiceBusyError: e}, nil
+			return &WorkflowService_StartWorkflowExecution_Result{ServiceBusyError: e}, nil
 		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.DomainNotActiveError")
 			}
-			return &WorkflowService_TerminateWorkflowExecution_Result{DomainNotActiveError: e}, nil
+			return &WorkflowService_StartWorkflowExecution_Result{DomainNotActiveError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.LimitExceededError")
 			}
-			return &WorkflowService_TerminateWorkflowExecution_Result{LimitExceededError: e}, nil
-		case *shared.ClientVersionNotSupportedError:
+			return &WorkflowService_StartWorkflowExecution_Result{LimitExceededError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.ClientVersionNotSupportedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.EntityNotExistError")
 			}
-			return &WorkflowService_TerminateWorkflowExecution_Result{ClientVersionNotSupportedError: e}, nil
-		case *shared.WorkflowExecutionAlreadyCompletedError:
+			return &WorkflowService_StartWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.WorkflowExecutionAlreadyCompletedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecution_Result.ClientVersionNotSupportedError")
 			}
-			return &WorkflowService_TerminateWorkflowExecution_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
+			return &WorkflowService_StartWorkflowExecution_Result{ClientVersionNotSupportedError: e}, nil
 		}
 
 		return nil, err
 	}
-	WorkflowService_TerminateWorkflowExecution_Helper.UnwrapResponse = func(result *WorkflowService_TerminateWorkflowExecution_Result) (err error) {
+	WorkflowService_StartWorkflowExecution_Helper.UnwrapResponse = func(result *WorkflowService_StartWorkflowExecution_Result) (success *shared.StartWorkflowExecutionResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
+		if result.SessionAlreadyExistError != nil {
+			err = result.SessionAlreadyExistError
 			return
 		}
 		if result.ServiceBusyError != nil {
@@ -39325,33 +39256,44 @@ func init() {
 			err = result.LimitExceededError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 		if result.ClientVersionNotSupportedError != nil {
 			err = result.ClientVersionNotSupportedError
 			return
 		}
-		if result.WorkflowExecutionAlreadyCompletedError != nil {
-			err = result.WorkflowExecutionAlreadyCompletedError
+
+		if result.Success != nil {
+			success = result.Success
 			return
 		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// WorkflowService_TerminateWorkflowExecution_Result represents the result of a WorkflowService.TerminateWorkflowExecution function call.
+// WorkflowService_StartWorkflowExecution_Result represents the result of a WorkflowService.StartWorkflowExecution function call.
 //
-// The result of a TerminateWorkflowExecution execution is sent and received over the wire as this struct.
-type WorkflowService_TerminateWorkflowExecution_Result struct {
-	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
-	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
-	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
-	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
-	LimitExceededError                     *shared.LimitExceededError                     `json:"limitExceededError,omitempty"`
-	ClientVersionNotSupportedError         *shared.ClientVersionNotSupportedError         `json:"clientVersionNotSupportedError,omitempty"`
-	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
+// The result of a StartWorkflowExecution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type WorkflowService_StartWorkflowExecution_Result struct {
+	// Value returned by StartWorkflowExecution after a successful execution.
+	Success                        *shared.StartWorkflowExecutionResponse       `json:"success,omitempty"`
+	BadRequestError                *shared.BadRequestError                      `json:"badRequestError,omitempty"`
+	SessionAlreadyExistError       *shared.WorkflowExecutionAlreadyStartedError `json:"sessionAlreadyExistError,omitempty"`
+	ServiceBusyError               *shared.ServiceBusyError                     `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError           *shared.DomainNotActiveError                 `json:"domainNotActiveError,omitempty"`
+	LimitExceededError             *shared.LimitExceededError                   `json:"limitExceededError,omitempty"`
+	EntityNotExistError            *shared.EntityNotExistsError                 `json:"entityNotExistError,omitempty"`
+	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError       `json:"clientVersionNotSupportedError,omitempty"`
 }
 
-// ToWire translates a WorkflowService_TerminateWorkflowExecution_Result struct into a Thrift-level intermediate
+// ToWire translates a WorkflowService_StartWorkflowExecution_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -39366,14 +39308,22 @@ type WorkflowService_TerminateWorkflowExecution_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowService_TerminateWorkflowExecution_Result) ToWire() (wire.Value, error) {
+func (v *WorkflowService_StartWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -39382,8 +39332,8 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) ToWire() (wire.Value
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.SessionAlreadyExistError != nil {
+		w, err = v.SessionAlreadyExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -39414,16 +39364,16 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) ToWire() (wire.Value
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		w, err = v.ClientVersionNotSupportedError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 7, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -39431,18 +39381,18 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) ToWire() (wire.Value
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("WorkflowService_TerminateWorkflowExecution_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("WorkflowService_StartWorkflowExecution_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowService_TerminateWorkflowExecution_Result struct from its Thrift-level
+// FromWire deserializes a WorkflowService_StartWorkflowExecution_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowService_TerminateWorkflowExecution_Result struct
+// An error is returned if we were unable to build a WorkflowService_StartWorkflowExecution_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -39450,16 +39400,3252 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) ToWire() (wire.Value
 //     return nil, err
 //   }
 //
-//   var v WorkflowService_TerminateWorkflowExecution_Result
+//   var v WorkflowService_StartWorkflowExecution_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowService_TerminateWorkflowExecution_Result) FromWire(w wire.Value) error {
+func (v *WorkflowService_StartWorkflowExecution_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _StartWorkflowExecutionResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.SessionAlreadyExistError, err = _WorkflowExecutionAlreadyStartedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TStruct {
+				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.SessionAlreadyExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_StartWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowService_StartWorkflowExecution_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowService_StartWorkflowExecution_Result struct could not be encoded.
+func (v *WorkflowService_StartWorkflowExecution_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SessionAlreadyExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.SessionAlreadyExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainNotActiveError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClientVersionNotSupportedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.SessionAlreadyExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_StartWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowService_StartWorkflowExecution_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowService_StartWorkflowExecution_Result struct could not be generated from the wire
+// representation.
+func (v *WorkflowService_StartWorkflowExecution_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _StartWorkflowExecutionResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.SessionAlreadyExistError, err = _WorkflowExecutionAlreadyStartedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TStruct:
+			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.SessionAlreadyExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_StartWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowService_StartWorkflowExecution_Result
+// struct.
+func (v *WorkflowService_StartWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.SessionAlreadyExistError != nil {
+		fields[i] = fmt.Sprintf("SessionAlreadyExistError: %v", v.SessionAlreadyExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowService_StartWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowService_StartWorkflowExecution_Result match the
+// provided WorkflowService_StartWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *WorkflowService_StartWorkflowExecution_Result) Equals(rhs *WorkflowService_StartWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.SessionAlreadyExistError == nil && rhs.SessionAlreadyExistError == nil) || (v.SessionAlreadyExistError != nil && rhs.SessionAlreadyExistError != nil && v.SessionAlreadyExistError.Equals(rhs.SessionAlreadyExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowService_StartWorkflowExecution_Result.
+func (v *WorkflowService_StartWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.SessionAlreadyExistError != nil {
+		err = multierr.Append(err, enc.AddObject("sessionAlreadyExistError", v.SessionAlreadyExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecution_Result) GetSuccess() (o *shared.StartWorkflowExecutionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetSessionAlreadyExistError returns the value of SessionAlreadyExistError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecution_Result) GetSessionAlreadyExistError() (o *shared.WorkflowExecutionAlreadyStartedError) {
+	if v != nil && v.SessionAlreadyExistError != nil {
+		return v.SessionAlreadyExistError
+	}
+
+	return
+}
+
+// IsSetSessionAlreadyExistError returns true if SessionAlreadyExistError is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Result) IsSetSessionAlreadyExistError() bool {
+	return v != nil && v.SessionAlreadyExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecution_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecution_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecution_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+	if v != nil && v.ClientVersionNotSupportedError != nil {
+		return v.ClientVersionNotSupportedError
+	}
+
+	return
+}
+
+// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
+func (v *WorkflowService_StartWorkflowExecution_Result) IsSetClientVersionNotSupportedError() bool {
+	return v != nil && v.ClientVersionNotSupportedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "StartWorkflowExecution" for this struct.
+func (v *WorkflowService_StartWorkflowExecution_Result) MethodName() string {
+	return "StartWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *WorkflowService_StartWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// WorkflowService_StartWorkflowExecutionAsync_Args represents the arguments for the WorkflowService.StartWorkflowExecutionAsync function.
+//
+// The arguments for StartWorkflowExecutionAsync are sent and received over the wire as this struct.
+type WorkflowService_StartWorkflowExecutionAsync_Args struct {
+	StartRequest *shared.StartWorkflowExecutionAsyncRequest `json:"startRequest,omitempty"`
+}
+
+// ToWire translates a WorkflowService_StartWorkflowExecutionAsync_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.StartRequest != nil {
+		w, err = v.StartRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartWorkflowExecutionAsyncRequest_Read(w wire.Value) (*shared.StartWorkflowExecutionAsyncRequest, error) {
+	var v shared.StartWorkflowExecutionAsyncRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a WorkflowService_StartWorkflowExecutionAsync_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowService_StartWorkflowExecutionAsync_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowService_StartWorkflowExecutionAsync_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.StartRequest, err = _StartWorkflowExecutionAsyncRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowService_StartWorkflowExecutionAsync_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowService_StartWorkflowExecutionAsync_Args struct could not be encoded.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.StartRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.StartRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _StartWorkflowExecutionAsyncRequest_Decode(sr stream.Reader) (*shared.StartWorkflowExecutionAsyncRequest, error) {
+	var v shared.StartWorkflowExecutionAsyncRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a WorkflowService_StartWorkflowExecutionAsync_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowService_StartWorkflowExecutionAsync_Args struct could not be generated from the wire
+// representation.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.StartRequest, err = _StartWorkflowExecutionAsyncRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowService_StartWorkflowExecutionAsync_Args
+// struct.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.StartRequest != nil {
+		fields[i] = fmt.Sprintf("StartRequest: %v", v.StartRequest)
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowService_StartWorkflowExecutionAsync_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowService_StartWorkflowExecutionAsync_Args match the
+// provided WorkflowService_StartWorkflowExecutionAsync_Args.
+//
+// This function performs a deep comparison.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) Equals(rhs *WorkflowService_StartWorkflowExecutionAsync_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.StartRequest == nil && rhs.StartRequest == nil) || (v.StartRequest != nil && rhs.StartRequest != nil && v.StartRequest.Equals(rhs.StartRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowService_StartWorkflowExecutionAsync_Args.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.StartRequest != nil {
+		err = multierr.Append(err, enc.AddObject("startRequest", v.StartRequest))
+	}
+	return err
+}
+
+// GetStartRequest returns the value of StartRequest if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) GetStartRequest() (o *shared.StartWorkflowExecutionAsyncRequest) {
+	if v != nil && v.StartRequest != nil {
+		return v.StartRequest
+	}
+
+	return
+}
+
+// IsSetStartRequest returns true if StartRequest is not nil.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) IsSetStartRequest() bool {
+	return v != nil && v.StartRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "StartWorkflowExecutionAsync" for this struct.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) MethodName() string {
+	return "StartWorkflowExecutionAsync"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// WorkflowService_StartWorkflowExecutionAsync_Helper provides functions that aid in handling the
+// parameters and return values of the WorkflowService.StartWorkflowExecutionAsync
+// function.
+var WorkflowService_StartWorkflowExecutionAsync_Helper = struct {
+	// Args accepts the parameters of StartWorkflowExecutionAsync in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		startRequest *shared.StartWorkflowExecutionAsyncRequest,
+	) *WorkflowService_StartWorkflowExecutionAsync_Args
+
+	// IsException returns true if the given error can be thrown
+	// by StartWorkflowExecutionAsync.
+	//
+	// An error can be thrown by StartWorkflowExecutionAsync only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for StartWorkflowExecutionAsync
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// StartWorkflowExecutionAsync into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by StartWorkflowExecutionAsync
+	//
+	//   value, err := StartWorkflowExecutionAsync(args)
+	//   result, err := WorkflowService_StartWorkflowExecutionAsync_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from StartWorkflowExecutionAsync: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.StartWorkflowExecutionAsyncResponse, error) (*WorkflowService_StartWorkflowExecutionAsync_Result, error)
+
+	// UnwrapResponse takes the result struct for StartWorkflowExecutionAsync
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if StartWorkflowExecutionAsync threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := WorkflowService_StartWorkflowExecutionAsync_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*WorkflowService_StartWorkflowExecutionAsync_Result) (*shared.StartWorkflowExecutionAsyncResponse, error)
+}{}
+
+func init() {
+	WorkflowService_StartWorkflowExecutionAsync_Helper.Args = func(
+		startRequest *shared.StartWorkflowExecutionAsyncRequest,
+	) *WorkflowService_StartWorkflowExecutionAsync_Args {
+		return &WorkflowService_StartWorkflowExecutionAsync_Args{
+			StartRequest: startRequest,
+		}
+	}
+
+	WorkflowService_StartWorkflowExecutionAsync_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ClientVersionNotSupportedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	WorkflowService_StartWorkflowExecutionAsync_Helper.WrapResponse = func(success *shared.StartWorkflowExecutionAsyncResponse, err error) (*WorkflowService_StartWorkflowExecutionAsync_Result, error) {
+		if err == nil {
+			return &WorkflowService_StartWorkflowExecutionAsync_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecutionAsync_Result.BadRequestError")
+			}
+			return &WorkflowService_StartWorkflowExecutionAsync_Result{BadRequestError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecutionAsync_Result.ServiceBusyError")
+			}
+			return &WorkflowService_StartWorkflowExecutionAsync_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecutionAsync_Result.DomainNotActiveError")
+			}
+			return &WorkflowService_StartWorkflowExecutionAsync_Result{DomainNotActiveError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecutionAsync_Result.LimitExceededError")
+			}
+			return &WorkflowService_StartWorkflowExecutionAsync_Result{LimitExceededError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecutionAsync_Result.EntityNotExistError")
+			}
+			return &WorkflowService_StartWorkflowExecutionAsync_Result{EntityNotExistError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_StartWorkflowExecutionAsync_Result.ClientVersionNotSupportedError")
+			}
+			return &WorkflowService_StartWorkflowExecutionAsync_Result{ClientVersionNotSupportedError: e}, nil
+		}
+
+		return nil, err
+	}
+	WorkflowService_StartWorkflowExecutionAsync_Helper.UnwrapResponse = func(result *WorkflowService_StartWorkflowExecutionAsync_Result) (success *shared.StartWorkflowExecutionAsyncResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ClientVersionNotSupportedError != nil {
+			err = result.ClientVersionNotSupportedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// WorkflowService_StartWorkflowExecutionAsync_Result represents the result of a WorkflowService.StartWorkflowExecutionAsync function call.
+//
+// The result of a StartWorkflowExecutionAsync execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type WorkflowService_StartWorkflowExecutionAsync_Result struct {
+	// Value returned by StartWorkflowExecutionAsync after a successful execution.
+	Success                        *shared.StartWorkflowExecutionAsyncResponse `json:"success,omitempty"`
+	BadRequestError                *shared.BadRequestError                     `json:"badRequestError,omitempty"`
+	ServiceBusyError               *shared.ServiceBusyError                    `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError           *shared.DomainNotActiveError                `json:"domainNotActiveError,omitempty"`
+	LimitExceededError             *shared.LimitExceededError                  `json:"limitExceededError,omitempty"`
+	EntityNotExistError            *shared.EntityNotExistsError                `json:"entityNotExistError,omitempty"`
+	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError      `json:"clientVersionNotSupportedError,omitempty"`
+}
+
+// ToWire translates a WorkflowService_StartWorkflowExecutionAsync_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("WorkflowService_StartWorkflowExecutionAsync_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartWorkflowExecutionAsyncResponse_Read(w wire.Value) (*shared.StartWorkflowExecutionAsyncResponse, error) {
+	var v shared.StartWorkflowExecutionAsyncResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a WorkflowService_StartWorkflowExecutionAsync_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowService_StartWorkflowExecutionAsync_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowService_StartWorkflowExecutionAsync_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _StartWorkflowExecutionAsyncResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TStruct {
+				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_StartWorkflowExecutionAsync_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowService_StartWorkflowExecutionAsync_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowService_StartWorkflowExecutionAsync_Result struct could not be encoded.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainNotActiveError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClientVersionNotSupportedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_StartWorkflowExecutionAsync_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _StartWorkflowExecutionAsyncResponse_Decode(sr stream.Reader) (*shared.StartWorkflowExecutionAsyncResponse, error) {
+	var v shared.StartWorkflowExecutionAsyncResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a WorkflowService_StartWorkflowExecutionAsync_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowService_StartWorkflowExecutionAsync_Result struct could not be generated from the wire
+// representation.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _StartWorkflowExecutionAsyncResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TStruct:
+			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_StartWorkflowExecutionAsync_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowService_StartWorkflowExecutionAsync_Result
+// struct.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowService_StartWorkflowExecutionAsync_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowService_StartWorkflowExecutionAsync_Result match the
+// provided WorkflowService_StartWorkflowExecutionAsync_Result.
+//
+// This function performs a deep comparison.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) Equals(rhs *WorkflowService_StartWorkflowExecutionAsync_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowService_StartWorkflowExecutionAsync_Result.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) GetSuccess() (o *shared.StartWorkflowExecutionAsyncResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+	if v != nil && v.ClientVersionNotSupportedError != nil {
+		return v.ClientVersionNotSupportedError
+	}
+
+	return
+}
+
+// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) IsSetClientVersionNotSupportedError() bool {
+	return v != nil && v.ClientVersionNotSupportedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "StartWorkflowExecutionAsync" for this struct.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) MethodName() string {
+	return "StartWorkflowExecutionAsync"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *WorkflowService_StartWorkflowExecutionAsync_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// WorkflowService_TerminateWorkflowExecution_Args represents the arguments for the WorkflowService.TerminateWorkflowExecution function.
+//
+// The arguments for TerminateWorkflowExecution are sent and received over the wire as this struct.
+type WorkflowService_TerminateWorkflowExecution_Args struct {
+	TerminateRequest *shared.TerminateWorkflowExecutionRequest `json:"terminateRequest,omitempty"`
+}
+
+// ToWire translates a WorkflowService_TerminateWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowService_TerminateWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TerminateRequest != nil {
+		w, err = v.TerminateRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWorkflowExecutionRequest, error) {
+	var v shared.TerminateWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a WorkflowService_TerminateWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowService_TerminateWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowService_TerminateWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowService_TerminateWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.TerminateRequest, err = _TerminateWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowService_TerminateWorkflowExecution_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowService_TerminateWorkflowExecution_Args struct could not be encoded.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TerminateRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TerminateRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _TerminateWorkflowExecutionRequest_Decode(sr stream.Reader) (*shared.TerminateWorkflowExecutionRequest, error) {
+	var v shared.TerminateWorkflowExecutionRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a WorkflowService_TerminateWorkflowExecution_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowService_TerminateWorkflowExecution_Args struct could not be generated from the wire
+// representation.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.TerminateRequest, err = _TerminateWorkflowExecutionRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowService_TerminateWorkflowExecution_Args
+// struct.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.TerminateRequest != nil {
+		fields[i] = fmt.Sprintf("TerminateRequest: %v", v.TerminateRequest)
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowService_TerminateWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowService_TerminateWorkflowExecution_Args match the
+// provided WorkflowService_TerminateWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) Equals(rhs *WorkflowService_TerminateWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TerminateRequest == nil && rhs.TerminateRequest == nil) || (v.TerminateRequest != nil && rhs.TerminateRequest != nil && v.TerminateRequest.Equals(rhs.TerminateRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowService_TerminateWorkflowExecution_Args.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TerminateRequest != nil {
+		err = multierr.Append(err, enc.AddObject("terminateRequest", v.TerminateRequest))
+	}
+	return err
+}
+
+// GetTerminateRequest returns the value of TerminateRequest if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) GetTerminateRequest() (o *shared.TerminateWorkflowExecutionRequest) {
+	if v != nil && v.TerminateRequest != nil {
+		return v.TerminateRequest
+	}
+
+	return
+}
+
+// IsSetTerminateRequest returns true if TerminateRequest is not nil.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) IsSetTerminateRequest() bool {
+	return v != nil && v.TerminateRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "TerminateWorkflowExecution" for this struct.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) MethodName() string {
+	return "TerminateWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *WorkflowService_TerminateWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// WorkflowService_TerminateWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the WorkflowService.TerminateWorkflowExecution
+// function.
+var WorkflowService_TerminateWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of TerminateWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		terminateRequest *shared.TerminateWorkflowExecutionRequest,
+	) *WorkflowService_TerminateWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by TerminateWorkflowExecution.
+	//
+	// An error can be thrown by TerminateWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for TerminateWorkflowExecution
+	// given the error returned by it. The provided error may
+	// be nil if TerminateWorkflowExecution did not fail.
+	//
+	// This allows mapping errors returned by TerminateWorkflowExecution into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// TerminateWorkflowExecution
+	//
+	//   err := TerminateWorkflowExecution(args)
+	//   result, err := WorkflowService_TerminateWorkflowExecution_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from TerminateWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*WorkflowService_TerminateWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for TerminateWorkflowExecution
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if TerminateWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := WorkflowService_TerminateWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*WorkflowService_TerminateWorkflowExecution_Result) error
+}{}
+
+func init() {
+	WorkflowService_TerminateWorkflowExecution_Helper.Args = func(
+		terminateRequest *shared.TerminateWorkflowExecutionRequest,
+	) *WorkflowService_TerminateWorkflowExecution_Args {
+		return &WorkflowService_TerminateWorkflowExecution_Args{
+			TerminateRequest: terminateRequest,
+		}
+	}
+
+	WorkflowService_TerminateWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.ClientVersionNotSupportedError:
+			return true
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	WorkflowService_TerminateWorkflowExecution_Helper.WrapResponse = func(err error) (*WorkflowService_TerminateWorkflowExecution_Result, error) {
+		if err == nil {
+			return &WorkflowService_TerminateWorkflowExecution_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.BadRequestError")
+			}
+			return &WorkflowService_TerminateWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &WorkflowService_TerminateWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.ServiceBusyError")
+			}
+			return &WorkflowService_TerminateWorkflowExecution_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.DomainNotActiveError")
+			}
+			return &WorkflowService_TerminateWorkflowExecution_Result{DomainNotActiveError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.LimitExceededError")
+			}
+			return &WorkflowService_TerminateWorkflowExecution_Result{LimitExceededError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.ClientVersionNotSupportedError")
+			}
+			return &WorkflowService_TerminateWorkflowExecution_Result{ClientVersionNotSupportedError: e}, nil
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_TerminateWorkflowExecution_Result.WorkflowExecutionAlreadyCompletedError")
+			}
+			return &WorkflowService_TerminateWorkflowExecution_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
+		}
+
+		return nil, err
+	}
+	WorkflowService_TerminateWorkflowExecution_Helper.UnwrapResponse = func(result *WorkflowService_TerminateWorkflowExecution_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.ClientVersionNotSupportedError != nil {
+			err = result.ClientVersionNotSupportedError
+			return
+		}
+		if result.WorkflowExecutionAlreadyCompletedError != nil {
+			err = result.WorkflowExecutionAlreadyCompletedError
+			return
+		}
+		return
+	}
+
+}
+
+// WorkflowService_TerminateWorkflowExecution_Result represents the result of a WorkflowService.TerminateWorkflowExecution function call.
+//
+// The result of a TerminateWorkflowExecution execution is sent and received over the wire as this struct.
+type WorkflowService_TerminateWorkflowExecution_Result struct {
+	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
+	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
+	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
+	LimitExceededError                     *shared.LimitExceededError                     `json:"limitExceededError,omitempty"`
+	ClientVersionNotSupportedError         *shared.ClientVersionNotSupportedError         `json:"clientVersionNotSupportedError,omitempty"`
+	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
+}
+
+// ToWire translates a WorkflowService_TerminateWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowService_TerminateWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("WorkflowService_TerminateWorkflowExecution_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowService_TerminateWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowService_TerminateWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowService_TerminateWorkflowExecution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowService_TerminateWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("WorkflowService_TerminateWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowService_TerminateWorkflowExecution_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowService_TerminateWorkflowExecution_Result struct could not be encoded.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainNotActiveError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClientVersionNotSupportedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
+
+	if count > 1 {
+		return fmt.Errorf("WorkflowService_TerminateWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowService_TerminateWorkflowExecution_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowService_TerminateWorkflowExecution_Result struct could not be generated from the wire
+// representation.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("WorkflowService_TerminateWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowService_TerminateWorkflowExecution_Result
+// struct.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
+		i++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowService_TerminateWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowService_TerminateWorkflowExecution_Result match the
+// provided WorkflowService_TerminateWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) Equals(rhs *WorkflowService_TerminateWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+		return false
+	}
+	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowService_TerminateWorkflowExecution_Result.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+	if v != nil && v.ClientVersionNotSupportedError != nil {
+		return v.ClientVersionNotSupportedError
+	}
+
+	return
+}
+
+// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetClientVersionNotSupportedError() bool {
+	return v != nil && v.ClientVersionNotSupportedError != nil
+}
+
+// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
+	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
+		return v.WorkflowExecutionAlreadyCompletedError
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
+	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "TerminateWorkflowExecution" for this struct.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) MethodName() string {
+	return "TerminateWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *WorkflowService_TerminateWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// WorkflowService_UpdateDomain_Args represents the arguments for the WorkflowService.UpdateDomain function.
+//
+// The arguments for UpdateDomain are sent and received over the wire as this struct.
+type WorkflowService_UpdateDomain_Args struct {
+	UpdateRequest *shared.UpdateDomainRequest `json:"updateRequest,omitempty"`
+}
+
+// ToWire translates a WorkflowService_UpdateDomain_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowService_UpdateDomain_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.UpdateRequest != nil {
+		w, err = v.UpdateRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UpdateDomainRequest_Read(w wire.Value) (*shared.UpdateDomainRequest, error) {
+	var v shared.UpdateDomainRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a WorkflowService_UpdateDomain_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowService_UpdateDomain_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowService_UpdateDomain_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowService_UpdateDomain_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.UpdateRequest, err = _UpdateDomainRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowService_UpdateDomain_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowService_UpdateDomain_Args struct could not be encoded.
+func (v *WorkflowService_UpdateDomain_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.UpdateRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.UpdateRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _UpdateDomainRequest_Decode(sr stream.Reader) (*shared.UpdateDomainRequest, error) {
+	var v shared.UpdateDomainRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a WorkflowService_UpdateDomain_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowService_UpdateDomain_Args struct could not be generated from the wire
+// representation.
+func (v *WorkflowService_UpdateDomain_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.UpdateRequest, err = _UpdateDomainRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowService_UpdateDomain_Args
+// struct.
+func (v *WorkflowService_UpdateDomain_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.UpdateRequest != nil {
+		fields[i] = fmt.Sprintf("UpdateRequest: %v", v.UpdateRequest)
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowService_UpdateDomain_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowService_UpdateDomain_Args match the
+// provided WorkflowService_UpdateDomain_Args.
+//
+// This function performs a deep comparison.
+func (v *WorkflowService_UpdateDomain_Args) Equals(rhs *WorkflowService_UpdateDomain_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.UpdateRequest == nil && rhs.UpdateRequest == nil) || (v.UpdateRequest != nil && rhs.UpdateRequest != nil && v.UpdateRequest.Equals(rhs.UpdateRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowService_UpdateDomain_Args.
+func (v *WorkflowService_UpdateDomain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.UpdateRequest != nil {
+		err = multierr.Append(err, enc.AddObject("updateRequest", v.UpdateRequest))
+	}
+	return err
+}
+
+// GetUpdateRequest returns the value of UpdateRequest if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_UpdateDomain_Args) GetUpdateRequest() (o *shared.UpdateDomainRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+
+	return
+}
+
+// IsSetUpdateRequest returns true if UpdateRequest is not nil.
+func (v *WorkflowService_UpdateDomain_Args) IsSetUpdateRequest() bool {
+	return v != nil && v.UpdateRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "UpdateDomain" for this struct.
+func (v *WorkflowService_UpdateDomain_Args) MethodName() string {
+	return "UpdateDomain"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *WorkflowService_UpdateDomain_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// WorkflowService_UpdateDomain_Helper provides functions that aid in handling the
+// parameters and return values of the WorkflowService.UpdateDomain
+// function.
+var WorkflowService_UpdateDomain_Helper = struct {
+	// Args accepts the parameters of UpdateDomain in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		updateRequest *shared.UpdateDomainRequest,
+	) *WorkflowService_UpdateDomain_Args
+
+	// IsException returns true if the given error can be thrown
+	// by UpdateDomain.
+	//
+	// An error can be thrown by UpdateDomain only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for UpdateDomain
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// UpdateDomain into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by UpdateDomain
+	//
+	//   value, err := UpdateDomain(args)
+	//   result, err := WorkflowService_UpdateDomain_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from UpdateDomain: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.UpdateDomainResponse, error) (*WorkflowService_UpdateDomain_Result, error)
+
+	// UnwrapResponse takes the result struct for UpdateDomain
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if UpdateDomain threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := WorkflowService_UpdateDomain_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*WorkflowService_UpdateDomain_Result) (*shared.UpdateDomainResponse, error)
+}{}
+
+func init() {
+	WorkflowService_UpdateDomain_Helper.Args = func(
+		updateRequest *shared.UpdateDomainRequest,
+	) *WorkflowService_UpdateDomain_Args {
+		return &WorkflowService_UpdateDomain_Args{
+			UpdateRequest: updateRequest,
+		}
+	}
+
+	WorkflowService_UpdateDomain_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.ClientVersionNotSupportedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	WorkflowService_UpdateDomain_Helper.WrapResponse = func(success *shared.UpdateDomainResponse, err error) (*WorkflowService_UpdateDomain_Result, error) {
+		if err == nil {
+			return &WorkflowService_UpdateDomain_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.BadRequestError")
+			}
+			return &WorkflowService_UpdateDomain_Result{BadRequestError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.EntityNotExistError")
+			}
+			return &WorkflowService_UpdateDomain_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.ServiceBusyError")
+			}
+			return &WorkflowService_UpdateDomain_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.DomainNotActiveError")
+			}
+			return &WorkflowService_UpdateDomain_Result{DomainNotActiveError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.ClientVersionNotSupportedError")
+			}
+			return &WorkflowService_UpdateDomain_Result{ClientVersionNotSupportedError: e}, nil
+		}
+
+		return nil, err
+	}
+	WorkflowService_UpdateDomain_Helper.UnwrapResponse = func(result *WorkflowService_UpdateDomain_Result) (success *shared.UpdateDomainResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.ClientVersionNotSupportedError != nil {
+			err = result.ClientVersionNotSupportedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// WorkflowService_UpdateDomain_Result represents the result of a WorkflowService.UpdateDomain function call.
+//
+// The result of a UpdateDomain execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type WorkflowService_UpdateDomain_Result struct {
+	// Value returned by UpdateDomain after a successful execution.
+	Success                        *shared.UpdateDomainResponse           `json:"success,omitempty"`
+	BadRequestError                *shared.BadRequestError                `json:"badRequestError,omitempty"`
+	EntityNotExistError            *shared.EntityNotExistsError           `json:"entityNotExistError,omitempty"`
+	ServiceBusyError               *shared.ServiceBusyError               `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError           *shared.DomainNotActiveError           `json:"domainNotActiveError,omitempty"`
+	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError `json:"clientVersionNotSupportedError,omitempty"`
+}
+
+// ToWire translates a WorkflowService_UpdateDomain_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowService_UpdateDomain_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("WorkflowService_UpdateDomain_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UpdateDomainResponse_Read(w wire.Value) (*shared.UpdateDomainResponse, error) {
+	var v shared.UpdateDomainResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a WorkflowService_UpdateDomain_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowService_UpdateDomain_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowService_UpdateDomain_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowService_UpdateDomain_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _UpdateDomainResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -39493,33 +42679,20 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) FromWire(w wire.Valu
 
 			}
 		case 6:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 7:
 			if field.Value.Type() == wire.TStruct {
 				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
-			}
-		case 8:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -39532,31 +42705,37 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) FromWire(w wire.Valu
 	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		count++
-	}
-	if count > 1 {
-		return fmt.Errorf("WorkflowService_TerminateWorkflowExecution_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_UpdateDomain_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a WorkflowService_TerminateWorkflowExecution_Result struct directly into bytes, without going
+// Encode serializes a WorkflowService_UpdateDomain_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowService_TerminateWorkflowExecution_Result struct could not be encoded.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowService_UpdateDomain_Result struct could not be encoded.
+func (v *WorkflowService_UpdateDomain_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
@@ -39605,20 +42784,8 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) Encode(sw stream.Wri
 		}
 	}
 
-	if v.LimitExceededError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.LimitExceededError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.ClientVersionNotSupportedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
@@ -39629,19 +42796,10 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) Encode(sw stream.Wri
 		}
 	}
 
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -39654,29 +42812,29 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) Encode(sw stream.Wri
 	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		count++
-	}
 
-	if count > 1 {
-		return fmt.Errorf("WorkflowService_TerminateWorkflowExecution_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_UpdateDomain_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowService_TerminateWorkflowExecution_Result struct directly from its Thrift-level
+func _UpdateDomainResponse_Decode(sr stream.Reader) (*shared.UpdateDomainResponse, error) {
+	var v shared.UpdateDomainResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a WorkflowService_UpdateDomain_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowService_TerminateWorkflowExecution_Result struct could not be generated from the wire
+// An error is returned if a WorkflowService_UpdateDomain_Result struct could not be generated from the wire
 // representation.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) Decode(sr stream.Reader) error {
+func (v *WorkflowService_UpdateDomain_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -39689,6 +42847,12 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) Decode(sr stream.Rea
 
 	for ok {
 		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _UpdateDomainResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -39714,23 +42878,11 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) Decode(sr stream.Rea
 			}
 
 		case fh.ID == 6 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 7 && fh.Type == wire.TStruct:
 			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 8 && fh.Type == wire.TStruct:
-			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -39751,6 +42903,9 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) Decode(sr stream.Rea
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -39763,31 +42918,29 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) Decode(sr stream.Rea
 	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		count++
-	}
-	if count > 1 {
-		return fmt.Errorf("WorkflowService_TerminateWorkflowExecution_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("WorkflowService_UpdateDomain_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowService_TerminateWorkflowExecution_Result
+// String returns a readable string representation of a WorkflowService_UpdateDomain_Result
 // struct.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) String() string {
+func (v *WorkflowService_UpdateDomain_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [6]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -39804,32 +42957,27 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) String() string {
 		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
 	if v.ClientVersionNotSupportedError != nil {
 		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
 		i++
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
-		i++
-	}
 
-	return fmt.Sprintf("WorkflowService_TerminateWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowService_UpdateDomain_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowService_TerminateWorkflowExecution_Result match the
-// provided WorkflowService_TerminateWorkflowExecution_Result.
+// Equals returns true if all the fields of this WorkflowService_UpdateDomain_Result match the
+// provided WorkflowService_UpdateDomain_Result.
 //
 // This function performs a deep comparison.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) Equals(rhs *WorkflowService_TerminateWorkflowExecution_Result) bool {
+func (v *WorkflowService_UpdateDomain_Result) Equals(rhs *WorkflowService_UpdateDomain_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -39842,25 +42990,22 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) Equals(rhs *Workflow
 	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
 	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
 		return false
 	}
-	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowService_TerminateWorkflowExecution_Result.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowService_UpdateDomain_Result.
+func (v *WorkflowService_UpdateDomain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -39873,21 +43018,30 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) MarshalLogObject(enc
 	if v.DomainNotActiveError != nil {
 		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
 	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
 	if v.ClientVersionNotSupportedError != nil {
 		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
 	}
-	if v.WorkflowExecutionAlreadyCompletedError != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
-	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_UpdateDomain_Result) GetSuccess() (o *shared.UpdateDomainResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *WorkflowService_UpdateDomain_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *WorkflowService_UpdateDomain_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -39896,13 +43050,13 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) GetBadRequestError()
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetBadRequestError() bool {
+func (v *WorkflowService_UpdateDomain_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *WorkflowService_UpdateDomain_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -39911,13 +43065,13 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) GetEntityNotExistErr
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+func (v *WorkflowService_UpdateDomain_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *WorkflowService_UpdateDomain_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -39926,13 +43080,13 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) GetServiceBusyError(
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetServiceBusyError() bool {
+func (v *WorkflowService_UpdateDomain_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+func (v *WorkflowService_UpdateDomain_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
 	if v != nil && v.DomainNotActiveError != nil {
 		return v.DomainNotActiveError
 	}
@@ -39941,28 +43095,13 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) GetDomainNotActiveEr
 }
 
 // IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetDomainNotActiveError() bool {
+func (v *WorkflowService_UpdateDomain_Result) IsSetDomainNotActiveError() bool {
 	return v != nil && v.DomainNotActiveError != nil
 }
 
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
 // GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+func (v *WorkflowService_UpdateDomain_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
 	if v != nil && v.ClientVersionNotSupportedError != nil {
 		return v.ClientVersionNotSupportedError
 	}
@@ -39971,48 +43110,33 @@ func (v *WorkflowService_TerminateWorkflowExecution_Result) GetClientVersionNotS
 }
 
 // IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetClientVersionNotSupportedError() bool {
+func (v *WorkflowService_UpdateDomain_Result) IsSetClientVersionNotSupportedError() bool {
 	return v != nil && v.ClientVersionNotSupportedError != nil
 }
 
-// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
-// zero value if it is unset.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
-	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
-		return v.WorkflowExecutionAlreadyCompletedError
-	}
-
-	return
-}
-
-// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
-	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
-}
-
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "TerminateWorkflowExecution" for this struct.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) MethodName() string {
-	return "TerminateWorkflowExecution"
+// This will always be "UpdateDomain" for this struct.
+func (v *WorkflowService_UpdateDomain_Result) MethodName() string {
+	return "UpdateDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *WorkflowService_TerminateWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *WorkflowService_UpdateDomain_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// WorkflowService_UpdateDomain_Args represents the arguments for the WorkflowService.UpdateDomain function.
+// WorkflowService_UpdateWorkflowExecution_Args represents the arguments for the WorkflowService.UpdateWorkflowExecution function.
 //
-// The arguments for UpdateDomain are sent and received over the wire as this struct.
-type WorkflowService_UpdateDomain_Args struct {
-	UpdateRequest *shared.UpdateDomainRequest `json:"updateRequest,omitempty"`
+// The arguments for UpdateWorkflowExecution are sent and received over the wire as this struct.
+type WorkflowService_UpdateWorkflowExecution_Args struct {
+	UpdateRequest *shared.UpdateWorkflowExecutionRequest `json:"updateRequest,omitempty"`
 }
 
-// ToWire translates a WorkflowService_UpdateDomain_Args struct into a Thrift-level intermediate
+// ToWire translates a WorkflowService_UpdateWorkflowExecution_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -40027,7 +43151,7 @@ type WorkflowService_UpdateDomain_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowService_UpdateDomain_Args) ToWire() (wire.Value, error) {
+func (v *WorkflowService_UpdateWorkflowExecution_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -40047,17 +43171,17 @@ func (v *WorkflowService_UpdateDomain_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _UpdateDomainRequest_Read(w wire.Value) (*shared.UpdateDomainRequest, error) {
-	var v shared.UpdateDomainRequest
+func _UpdateWorkflowExecutionRequest_Read(w wire.Value) (*shared.UpdateWorkflowExecutionRequest, error) {
+	var v shared.UpdateWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a WorkflowService_UpdateDomain_Args struct from its Thrift-level
+// FromWire deserializes a WorkflowService_UpdateWorkflowExecution_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowService_UpdateDomain_Args struct
+// An error is returned if we were unable to build a WorkflowService_UpdateWorkflowExecution_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -40065,19 +43189,19 @@ func _UpdateDomainRequest_Read(w wire.Value) (*shared.UpdateDomainRequest, error
 //     return nil, err
 //   }
 //
-//   var v WorkflowService_UpdateDomain_Args
+//   var v WorkflowService_UpdateWorkflowExecution_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowService_UpdateDomain_Args) FromWire(w wire.Value) error {
+func (v *WorkflowService_UpdateWorkflowExecution_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.UpdateRequest, err = _UpdateDomainRequest_Read(field.Value)
+				v.UpdateRequest, err = _UpdateWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -40089,11 +43213,11 @@ func (v *WorkflowService_UpdateDomain_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a WorkflowService_UpdateDomain_Args struct directly into bytes, without going
+// Encode serializes a WorkflowService_UpdateWorkflowExecution_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowService_UpdateDomain_Args struct could not be encoded.
-func (v *WorkflowService_UpdateDomain_Args) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowService_UpdateWorkflowExecution_Args struct could not be encoded.
+func (v *WorkflowService_UpdateWorkflowExecution_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -40113,18 +43237,18 @@ func (v *WorkflowService_UpdateDomain_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _UpdateDomainRequest_Decode(sr stream.Reader) (*shared.UpdateDomainRequest, error) {
-	var v shared.UpdateDomainRequest
+func _UpdateWorkflowExecutionRequest_Decode(sr stream.Reader) (*shared.UpdateWorkflowExecutionRequest, error) {
+	var v shared.UpdateWorkflowExecutionRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a WorkflowService_UpdateDomain_Args struct directly from its Thrift-level
+// Decode deserializes a WorkflowService_UpdateWorkflowExecution_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowService_UpdateDomain_Args struct could not be generated from the wire
+// An error is returned if a WorkflowService_UpdateWorkflowExecution_Args struct could not be generated from the wire
 // representation.
-func (v *WorkflowService_UpdateDomain_Args) Decode(sr stream.Reader) error {
+func (v *WorkflowService_UpdateWorkflowExecution_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -40138,7 +43262,7 @@ func (v *WorkflowService_UpdateDomain_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.UpdateRequest, err = _UpdateDomainRequest_Decode(sr)
+			v.UpdateRequest, err = _UpdateWorkflowExecutionRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -40165,9 +43289,9 @@ func (v *WorkflowService_UpdateDomain_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowService_UpdateDomain_Args
+// String returns a readable string representation of a WorkflowService_UpdateWorkflowExecution_Args
 // struct.
-func (v *WorkflowService_UpdateDomain_Args) String() string {
+func (v *WorkflowService_UpdateWorkflowExecution_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -40179,14 +43303,14 @@ func (v *WorkflowService_UpdateDomain_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowService_UpdateDomain_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowService_UpdateWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowService_UpdateDomain_Args match the
-// provided WorkflowService_UpdateDomain_Args.
+// Equals returns true if all the fields of this WorkflowService_UpdateWorkflowExecution_Args match the
+// provided WorkflowService_UpdateWorkflowExecution_Args.
 //
 // This function performs a deep comparison.
-func (v *WorkflowService_UpdateDomain_Args) Equals(rhs *WorkflowService_UpdateDomain_Args) bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Args) Equals(rhs *WorkflowService_UpdateWorkflowExecution_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -40200,8 +43324,8 @@ func (v *WorkflowService_UpdateDomain_Args) Equals(rhs *WorkflowService_UpdateDo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowService_UpdateDomain_Args.
-func (v *WorkflowService_UpdateDomain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowService_UpdateWorkflowExecution_Args.
+func (v *WorkflowService_UpdateWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -40213,7 +43337,7 @@ func (v *WorkflowService_UpdateDomain_Args) MarshalLogObject(enc zapcore.ObjectE
 
 // GetUpdateRequest returns the value of UpdateRequest if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_UpdateDomain_Args) GetUpdateRequest() (o *shared.UpdateDomainRequest) {
+func (v *WorkflowService_UpdateWorkflowExecution_Args) GetUpdateRequest() (o *shared.UpdateWorkflowExecutionRequest) {
 	if v != nil && v.UpdateRequest != nil {
 		return v.UpdateRequest
 	}
@@ -40222,80 +43346,80 @@ func (v *WorkflowService_UpdateDomain_Args) GetUpdateRequest() (o *shared.Update
 }
 
 // IsSetUpdateRequest returns true if UpdateRequest is not nil.
-func (v *WorkflowService_UpdateDomain_Args) IsSetUpdateRequest() bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Args) IsSetUpdateRequest() bool {
 	return v != nil && v.UpdateRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "UpdateDomain" for this struct.
-func (v *WorkflowService_UpdateDomain_Args) MethodName() string {
-	return "UpdateDomain"
+// This will always be "UpdateWorkflowExecution" for this struct.
+func (v *WorkflowService_UpdateWorkflowExecution_Args) MethodName() string {
+	return "UpdateWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *WorkflowService_UpdateDomain_Args) EnvelopeType() wire.EnvelopeType {
+func (v *WorkflowService_UpdateWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// WorkflowService_UpdateDomain_Helper provides functions that aid in handling the
-// parameters and return values of the WorkflowService.UpdateDomain
+// WorkflowService_UpdateWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the WorkflowService.UpdateWorkflowExecution
 // function.
-var WorkflowService_UpdateDomain_Helper = struct {
-	// Args accepts the parameters of UpdateDomain in-order and returns
+var WorkflowService_UpdateWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of UpdateWorkflowExecution in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		updateRequest *shared.UpdateDomainRequest,
-	) *WorkflowService_UpdateDomain_Args
+		updateRequest *shared.UpdateWorkflowExecutionRequest,
+	) *WorkflowService_UpdateWorkflowExecution_Args
 
 	// IsException returns true if the given error can be thrown
-	// by UpdateDomain.
+	// by UpdateWorkflowExecution.
 	//
-	// An error can be thrown by UpdateDomain only if the
+	// An error can be thrown by UpdateWorkflowExecution only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for UpdateDomain
+	// WrapResponse returns the result struct for UpdateWorkflowExecution
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// UpdateDomain into a serializable result struct.
+	// UpdateWorkflowExecution into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by UpdateDomain
+	// error cannot be thrown by UpdateWorkflowExecution
 	//
-	//   value, err := UpdateDomain(args)
-	//   result, err := WorkflowService_UpdateDomain_Helper.WrapResponse(value, err)
+	//   value, err := UpdateWorkflowExecution(args)
+	//   result, err := WorkflowService_UpdateWorkflowExecution_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from UpdateDomain: %v", err)
+	//     return fmt.Errorf("unexpected error from UpdateWorkflowExecution: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.UpdateDomainResponse, error) (*WorkflowService_UpdateDomain_Result, error)
+	WrapResponse func(*shared.UpdateWorkflowExecutionResponse, error) (*WorkflowService_UpdateWorkflowExecution_Result, error)
 
-	// UnwrapResponse takes the result struct for UpdateDomain
+	// UnwrapResponse takes the result struct for UpdateWorkflowExecution
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if UpdateDomain threw an
+	// The error is non-nil only if UpdateWorkflowExecution threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := WorkflowService_UpdateDomain_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*WorkflowService_UpdateDomain_Result) (*shared.UpdateDomainResponse, error)
+	//   value, err := WorkflowService_UpdateWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*WorkflowService_UpdateWorkflowExecution_Result) (*shared.UpdateWorkflowExecutionResponse, error)
 }{}
 
 func init() {
-	WorkflowService_UpdateDomain_Helper.Args = func(
-		updateRequest *shared.UpdateDomainRequest,
-	) *WorkflowService_UpdateDomain_Args {
-		return &WorkflowService_UpdateDomain_Args{
+	WorkflowService_UpdateWorkflowExecution_Helper.Args = func(
+		updateRequest *shared.UpdateWorkflowExecutionRequest,
+	) *WorkflowService_UpdateWorkflowExecution_Args {
+		return &WorkflowService_UpdateWorkflowExecution_Args{
 			UpdateRequest: updateRequest,
 		}
 	}
 
-	WorkflowService_UpdateDomain_Helper.IsException = func(err error) bool {
+	WorkflowService_UpdateWorkflowExecution_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -40305,49 +43429,63 @@ func init() {
 			return true
 		case *shared.DomainNotActiveError:
 			return true
+		case *shared.LimitExceededError:
+			return true
 		case *shared.ClientVersionNotSupportedError:
 			return true
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	WorkflowService_UpdateDomain_Helper.WrapResponse = func(success *shared.UpdateDomainResponse, err error) (*WorkflowService_UpdateDomain_Result, error) {
+	WorkflowService_UpdateWorkflowExecution_Helper.WrapResponse = func(success *shared.UpdateWorkflowExecutionResponse, err error) (*WorkflowService_UpdateWorkflowExecution_Result, error) {
 		if err == nil {
-			return &WorkflowService_UpdateDomain_Result{Success: success}, nil
+			return &WorkflowService_UpdateWorkflowExecution_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateWorkflowExecution_Result.BadRequestError")
 			}
-			return &WorkflowService_UpdateDomain_Result{BadRequestError: e}, nil
+			return &WorkflowService_UpdateWorkflowExecution_Result{BadRequestError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateWorkflowExecution_Result.EntityNotExistError")
 			}
-			return &WorkflowService_UpdateDomain_Result{EntityNotExistError: e}, nil
+			return &WorkflowService_UpdateWorkflowExecution_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateWorkflowExecution_Result.ServiceBusyError")
 			}
-			return &WorkflowService_UpdateDomain_Result{ServiceBusyError: e}, nil
+			return &WorkflowService_UpdateWorkflowExecution_Result{ServiceBusyError: e}, nil
 		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateWorkflowExecution_Result.DomainNotActiveError")
 			}
-			return &WorkflowService_UpdateDomain_Result{DomainNotActiveError: e}, nil
+			return &WorkflowService_UpdateWorkflowExecution_Result{DomainNotActiveError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateWorkflowExecution_Result.LimitExceededError")
+			}
+			return &WorkflowService_UpdateWorkflowExecution_Result{LimitExceededError: e}, nil
 		case *shared.ClientVersionNotSupportedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateDomain_Result.ClientVersionNotSupportedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateWorkflowExecution_Result.ClientVersionNotSupportedError")
 			}
-			return &WorkflowService_UpdateDomain_Result{ClientVersionNotSupportedError: e}, nil
+			return &WorkflowService_UpdateWorkflowExecution_Result{ClientVersionNotSupportedError: e}, nil
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_UpdateWorkflowExecution_Result.WorkflowExecutionAlreadyCompletedError")
+			}
+			return &WorkflowService_UpdateWorkflowExecution_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
 		}
 
 		return nil, err
 	}
-	WorkflowService_UpdateDomain_Helper.UnwrapResponse = func(result *WorkflowService_UpdateDomain_Result) (success *shared.UpdateDomainResponse, err error) {
+	WorkflowService_UpdateWorkflowExecution_Helper.UnwrapResponse = func(result *WorkflowService_UpdateWorkflowExecution_Result) (success *shared.UpdateWorkflowExecutionResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -40364,10 +43502,18 @@ func init() {
 			err = result.DomainNotActiveError
 			return
 		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
 		if result.ClientVersionNotSupportedError != nil {
 			err = result.ClientVersionNotSupportedError
 			return
 		}
+		if result.WorkflowExecutionAlreadyCompletedError != nil {
+			err = result.WorkflowExecutionAlreadyCompletedError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -40380,22 +43526,24 @@ func init() {
 
 }
 
-// WorkflowService_UpdateDomain_Result represents the result of a WorkflowService.UpdateDomain function call.
+// WorkflowService_UpdateWorkflowExecution_Result represents the result of a WorkflowService.UpdateWorkflowExecution function call.
 //
-// The result of a UpdateDomain execution is sent and received over the wire as this struct.
+// The result of a UpdateWorkflowExecution execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type WorkflowService_UpdateDomain_Result struct {
-	// Value returned by UpdateDomain after a successful execution.
-	Success                        *shared.UpdateDomainResponse           `json:"success,omitempty"`
-	BadRequestError                *shared.BadRequestError                `json:"badRequestError,omitempty"`
-	EntityNotExistError            *shared.EntityNotExistsError           `json:"entityNotExistError,omitempty"`
-	ServiceBusyError               *shared.ServiceBusyError               `json:"serviceBusyError,omitempty"`
-	DomainNotActiveError           *shared.DomainNotActiveError           `json:"domainNotActiveError,omitempty"`
-	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError `json:"clientVersionNotSupportedError,omitempty"`
+type WorkflowService_UpdateWorkflowExecution_Result struct {
+	// Value returned by UpdateWorkflowExecution after a successful execution.
+	Success                                *shared.UpdateWorkflowExecutionResponse        `json:"success,omitempty"`
+	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
+	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
+	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
+	LimitExceededError                     *shared.LimitExceededError                     `json:"limitExceededError,omitempty"`
+	ClientVersionNotSupportedError         *shared.ClientVersionNotSupportedError         `json:"clientVersionNotSupportedError,omitempty"`
+	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
 }
 
-// ToWire translates a WorkflowService_UpdateDomain_Result struct into a Thrift-level intermediate
+// ToWire translates a WorkflowService_UpdateWorkflowExecution_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -40410,9 +43558,9 @@ type WorkflowService_UpdateDomain_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowService_UpdateDomain_Result) ToWire() (wire.Value, error) {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -40458,33 +43606,49 @@ func (v *WorkflowService_UpdateDomain_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
 	if v.ClientVersionNotSupportedError != nil {
 		w, err = v.ClientVersionNotSupportedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("WorkflowService_UpdateDomain_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("WorkflowService_UpdateWorkflowExecution_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _UpdateDomainResponse_Read(w wire.Value) (*shared.UpdateDomainResponse, error) {
-	var v shared.UpdateDomainResponse
+func _UpdateWorkflowExecutionResponse_Read(w wire.Value) (*shared.UpdateWorkflowExecutionResponse, error) {
+	var v shared.UpdateWorkflowExecutionResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a WorkflowService_UpdateDomain_Result struct from its Thrift-level
+// FromWire deserializes a WorkflowService_UpdateWorkflowExecution_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowService_UpdateDomain_Result struct
+// An error is returned if we were unable to build a WorkflowService_UpdateWorkflowExecution_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -40492,19 +43656,19 @@ func _UpdateDomainResponse_Read(w wire.Value) (*shared.UpdateDomainResponse, err
 //     return nil, err
 //   }
 //
-//   var v WorkflowService_UpdateDomain_Result
+//   var v WorkflowService_UpdateWorkflowExecution_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowService_UpdateDomain_Result) FromWire(w wire.Value) error {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _UpdateDomainResponse_Read(field.Value)
+				v.Success, err = _UpdateWorkflowExecutionResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -40543,12 +43707,28 @@ func (v *WorkflowService_UpdateDomain_Result) FromWire(w wire.Value) error {
 
 			}
 		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
 			if field.Value.Type() == wire.TStruct {
 				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
+			}
+		case 8:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -40569,21 +43749,27 @@ func (v *WorkflowService_UpdateDomain_Result) FromWire(w wire.Value) error {
 	if v.DomainNotActiveError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("WorkflowService_UpdateDomain_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("WorkflowService_UpdateWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a WorkflowService_UpdateDomain_Result struct directly into bytes, without going
+// Encode serializes a WorkflowService_UpdateWorkflowExecution_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowService_UpdateDomain_Result struct could not be encoded.
-func (v *WorkflowService_UpdateDomain_Result) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowService_UpdateWorkflowExecution_Result struct could not be encoded.
+func (v *WorkflowService_UpdateWorkflowExecution_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -40648,10 +43834,22 @@ func (v *WorkflowService_UpdateDomain_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ClientVersionNotSupportedError != nil {
+	if v.LimitExceededError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
 			return err
 		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClientVersionNotSupportedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+			return err
+		}
 		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
 			return err
 		}
@@ -40660,6 +43858,18 @@ func (v *WorkflowService_UpdateDomain_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	count := 0
 	if v.Success != nil {
 		count++
@@ -40676,29 +43886,35 @@ func (v *WorkflowService_UpdateDomain_Result) Encode(sw stream.Writer) error {
 	if v.DomainNotActiveError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
 
 	if count != 1 {
-		return fmt.Errorf("WorkflowService_UpdateDomain_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("WorkflowService_UpdateWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _UpdateDomainResponse_Decode(sr stream.Reader) (*shared.UpdateDomainResponse, error) {
-	var v shared.UpdateDomainResponse
+func _UpdateWorkflowExecutionResponse_Decode(sr stream.Reader) (*shared.UpdateWorkflowExecutionResponse, error) {
+	var v shared.UpdateWorkflowExecutionResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a WorkflowService_UpdateDomain_Result struct directly from its Thrift-level
+// Decode deserializes a WorkflowService_UpdateWorkflowExecution_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowService_UpdateDomain_Result struct could not be generated from the wire
+// An error is returned if a WorkflowService_UpdateWorkflowExecution_Result struct could not be generated from the wire
 // representation.
-func (v *WorkflowService_UpdateDomain_Result) Decode(sr stream.Reader) error {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -40712,7 +43928,7 @@ func (v *WorkflowService_UpdateDomain_Result) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _UpdateDomainResponse_Decode(sr)
+			v.Success, err = _UpdateWorkflowExecutionResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -40742,11 +43958,23 @@ func (v *WorkflowService_UpdateDomain_Result) Decode(sr stream.Reader) error {
 			}
 
 		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TStruct:
 			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
+		case fh.ID == 8 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -40782,24 +44010,30 @@ func (v *WorkflowService_UpdateDomain_Result) Decode(sr stream.Reader) error {
 	if v.DomainNotActiveError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("WorkflowService_UpdateDomain_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("WorkflowService_UpdateWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowService_UpdateDomain_Result
+// String returns a readable string representation of a WorkflowService_UpdateWorkflowExecution_Result
 // struct.
-func (v *WorkflowService_UpdateDomain_Result) String() string {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [8]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -40821,19 +44055,27 @@ func (v *WorkflowService_UpdateDomain_Result) String() string {
 		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
 	if v.ClientVersionNotSupportedError != nil {
 		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
 		i++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
+		i++
+	}
 
-	return fmt.Sprintf("WorkflowService_UpdateDomain_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowService_UpdateWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowService_UpdateDomain_Result match the
-// provided WorkflowService_UpdateDomain_Result.
+// Equals returns true if all the fields of this WorkflowService_UpdateWorkflowExecution_Result match the
+// provided WorkflowService_UpdateWorkflowExecution_Result.
 //
 // This function performs a deep comparison.
-func (v *WorkflowService_UpdateDomain_Result) Equals(rhs *WorkflowService_UpdateDomain_Result) bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) Equals(rhs *WorkflowService_UpdateWorkflowExecution_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -40854,16 +44096,22 @@ func (v *WorkflowService_UpdateDomain_Result) Equals(rhs *WorkflowService_Update
 	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
 	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
 		return false
 	}
+	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowService_UpdateDomain_Result.
-func (v *WorkflowService_UpdateDomain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowService_UpdateWorkflowExecution_Result.
+func (v *WorkflowService_UpdateWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -40882,15 +44130,21 @@ func (v *WorkflowService_UpdateDomain_Result) MarshalLogObject(enc zapcore.Objec
 	if v.DomainNotActiveError != nil {
 		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
 	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
 	if v.ClientVersionNotSupportedError != nil {
 		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_UpdateDomain_Result) GetSuccess() (o *shared.UpdateDomainResponse) {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) GetSuccess() (o *shared.UpdateWorkflowExecutionResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -40899,13 +44153,13 @@ func (v *WorkflowService_UpdateDomain_Result) GetSuccess() (o *shared.UpdateDoma
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *WorkflowService_UpdateDomain_Result) IsSetSuccess() bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_UpdateDomain_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -40914,13 +44168,13 @@ func (v *WorkflowService_UpdateDomain_Result) GetBadRequestError() (o *shared.Ba
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *WorkflowService_UpdateDomain_Result) IsSetBadRequestError() bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_UpdateDomain_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -40929,13 +44183,13 @@ func (v *WorkflowService_UpdateDomain_Result) GetEntityNotExistError() (o *share
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *WorkflowService_UpdateDomain_Result) IsSetEntityNotExistError() bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_UpdateDomain_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -40944,13 +44198,13 @@ func (v *WorkflowService_UpdateDomain_Result) GetServiceBusyError() (o *shared.S
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *WorkflowService_UpdateDomain_Result) IsSetServiceBusyError() bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_UpdateDomain_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
 	if v != nil && v.DomainNotActiveError != nil {
 		return v.DomainNotActiveError
 	}
@@ -40959,13 +44213,28 @@ func (v *WorkflowService_UpdateDomain_Result) GetDomainNotActiveError() (o *shar
 }
 
 // IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *WorkflowService_UpdateDomain_Result) IsSetDomainNotActiveError() bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) IsSetDomainNotActiveError() bool {
 	return v != nil && v.DomainNotActiveError != nil
 }
 
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_UpdateWorkflowExecution_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *WorkflowService_UpdateWorkflowExecution_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
 // GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
 // zero value if it is unset.
-func (v *WorkflowService_UpdateDomain_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
 	if v != nil && v.ClientVersionNotSupportedError != nil {
 		return v.ClientVersionNotSupportedError
 	}
@@ -40974,21 +44243,36 @@ func (v *WorkflowService_UpdateDomain_Result) GetClientVersionNotSupportedError(
 }
 
 // IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
-func (v *WorkflowService_UpdateDomain_Result) IsSetClientVersionNotSupportedError() bool {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) IsSetClientVersionNotSupportedError() bool {
 	return v != nil && v.ClientVersionNotSupportedError != nil
 }
 
+// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_UpdateWorkflowExecution_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
+	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
+		return v.WorkflowExecutionAlreadyCompletedError
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
+func (v *WorkflowService_UpdateWorkflowExecution_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
+	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "UpdateDomain" for this struct.
-func (v *WorkflowService_UpdateDomain_Result) MethodName() string {
-	return "UpdateDomain"
+// This will always be "UpdateWorkflowExecution" for this struct.
+func (v *WorkflowService_UpdateWorkflowExecution_Result) MethodName() string {
+	return "UpdateWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *WorkflowService_UpdateDomain_Result) EnvelopeType() wire.EnvelopeType {
+func (v *WorkflowService_UpdateWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
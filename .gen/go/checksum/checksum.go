@@ -38,56 +38,20 @@ import (
 	shared "github.com/uber/cadence/.gen/go/shared"
 )
 
-type MutableStateChecksumPayload struct {
-	CancelRequested              *bool                    `json:"cancelRequested,omitempty"`
-	State                        *int16                   `json:"state,omitempty"`
-	CloseStatus                  *int16                   `json:"closeStatus,omitempty"`
-	LastWriteVersion             *int64                   `json:"lastWriteVersion,omitempty"`
-	LastWriteEventID             *int64                   `json:"lastWriteEventID,omitempty"`
-	LastFirstEventID             *int64                   `json:"lastFirstEventID,omitempty"`
-	NextEventID                  *int64                   `json:"nextEventID,omitempty"`
-	LastProcessedEventID         *int64                   `json:"lastProcessedEventID,omitempty"`
-	SignalCount                  *int64                   `json:"signalCount,omitempty"`
-	DecisionAttempt              *int32                   `json:"decisionAttempt,omitempty"`
-	DecisionVersion              *int64                   `json:"decisionVersion,omitempty"`
-	DecisionScheduledID          *int64                   `json:"decisionScheduledID,omitempty"`
-	DecisionStartedID            *int64                   `json:"decisionStartedID,omitempty"`
-	PendingTimerStartedIDs       []int64                  `json:"pendingTimerStartedIDs,omitempty"`
-	PendingActivityScheduledIDs  []int64                  `json:"pendingActivityScheduledIDs,omitempty"`
-	PendingSignalInitiatedIDs    []int64                  `json:"pendingSignalInitiatedIDs,omitempty"`
-	PendingReqCancelInitiatedIDs []int64                  `json:"pendingReqCancelInitiatedIDs,omitempty"`
-	PendingChildInitiatedIDs     []int64                  `json:"pendingChildInitiatedIDs,omitempty"`
-	StickyTaskListName           *string                  `json:"stickyTaskListName,omitempty"`
-	VersionHistories             *shared.VersionHistories `json:"VersionHistories,omitempty"`
-}
-
-type _List_I64_ValueList []int64
-
-func (v _List_I64_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueI64(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_I64_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_I64_ValueList) ValueType() wire.Type {
-	return wire.TI64
+type ActivityChecksumInfo struct {
+	ScheduleID             *int64  `json:"scheduleID,omitempty"`
+	ActivityID             *string `json:"activityID,omitempty"`
+	TaskList               *string `json:"taskList,omitempty"`
+	ScheduleToStartTimeout *int32  `json:"scheduleToStartTimeout,omitempty"`
+	ScheduleToCloseTimeout *int32  `json:"scheduleToCloseTimeout,omitempty"`
+	StartToCloseTimeout    *int32  `json:"startToCloseTimeout,omitempty"`
+	HeartbeatTimeout       *int32  `json:"heartbeatTimeout,omitempty"`
+	CancelRequested        *bool   `json:"cancelRequested,omitempty"`
+	CancelRequestID        *int64  `json:"cancelRequestID,omitempty"`
+	Version                *int64  `json:"version,omitempty"`
 }
 
-func (_List_I64_ValueList) Close() {}
-
-// ToWire translates a MutableStateChecksumPayload struct into a Thrift-level intermediate
+// ToWire translates a ActivityChecksumInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -102,207 +66,103 @@ func (_List_I64_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MutableStateChecksumPayload) ToWire() (wire.Value, error) {
+func (v *ActivityChecksumInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [20]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.CancelRequested != nil {
-		w, err = wire.NewValueBool(*(v.CancelRequested)), error(nil)
+	if v.ScheduleID != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.State != nil {
-		w, err = wire.NewValueI16(*(v.State)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 15, Value: w}
-		i++
-	}
-	if v.CloseStatus != nil {
-		w, err = wire.NewValueI16(*(v.CloseStatus)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 16, Value: w}
-		i++
-	}
-	if v.LastWriteVersion != nil {
-		w, err = wire.NewValueI64(*(v.LastWriteVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 21, Value: w}
-		i++
-	}
-	if v.LastWriteEventID != nil {
-		w, err = wire.NewValueI64(*(v.LastWriteEventID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 22, Value: w}
-		i++
-	}
-	if v.LastFirstEventID != nil {
-		w, err = wire.NewValueI64(*(v.LastFirstEventID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 23, Value: w}
-		i++
-	}
-	if v.NextEventID != nil {
-		w, err = wire.NewValueI64(*(v.NextEventID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 24, Value: w}
-		i++
-	}
-	if v.LastProcessedEventID != nil {
-		w, err = wire.NewValueI64(*(v.LastProcessedEventID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 25, Value: w}
-		i++
-	}
-	if v.SignalCount != nil {
-		w, err = wire.NewValueI64(*(v.SignalCount)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 26, Value: w}
-		i++
-	}
-	if v.DecisionAttempt != nil {
-		w, err = wire.NewValueI32(*(v.DecisionAttempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 35, Value: w}
-		i++
-	}
-	if v.DecisionVersion != nil {
-		w, err = wire.NewValueI64(*(v.DecisionVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 36, Value: w}
-		i++
-	}
-	if v.DecisionScheduledID != nil {
-		w, err = wire.NewValueI64(*(v.DecisionScheduledID)), error(nil)
+	if v.ActivityID != nil {
+		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 37, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.DecisionStartedID != nil {
-		w, err = wire.NewValueI64(*(v.DecisionStartedID)), error(nil)
+	if v.TaskList != nil {
+		w, err = wire.NewValueString(*(v.TaskList)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 38, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.PendingTimerStartedIDs != nil {
-		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingTimerStartedIDs)), error(nil)
+	if v.ScheduleToStartTimeout != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeout)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 45, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.PendingActivityScheduledIDs != nil {
-		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingActivityScheduledIDs)), error(nil)
+	if v.ScheduleToCloseTimeout != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToCloseTimeout)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 46, Value: w}
+		fields[i] = wire.Field{ID: 41, Value: w}
 		i++
 	}
-	if v.PendingSignalInitiatedIDs != nil {
-		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingSignalInitiatedIDs)), error(nil)
+	if v.StartToCloseTimeout != nil {
+		w, err = wire.NewValueI32(*(v.StartToCloseTimeout)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 47, Value: w}
+		fields[i] = wire.Field{ID: 42, Value: w}
 		i++
 	}
-	if v.PendingReqCancelInitiatedIDs != nil {
-		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingReqCancelInitiatedIDs)), error(nil)
+	if v.HeartbeatTimeout != nil {
+		w, err = wire.NewValueI32(*(v.HeartbeatTimeout)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 48, Value: w}
+		fields[i] = wire.Field{ID: 43, Value: w}
 		i++
 	}
-	if v.PendingChildInitiatedIDs != nil {
-		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingChildInitiatedIDs)), error(nil)
+	if v.CancelRequested != nil {
+		w, err = wire.NewValueBool(*(v.CancelRequested)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 49, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.StickyTaskListName != nil {
-		w, err = wire.NewValueString(*(v.StickyTaskListName)), error(nil)
+	if v.CancelRequestID != nil {
+		w, err = wire.NewValueI64(*(v.CancelRequestID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 55, Value: w}
+		fields[i] = wire.Field{ID: 51, Value: w}
 		i++
 	}
-	if v.VersionHistories != nil {
-		w, err = v.VersionHistories.ToWire()
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 56, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_I64_Read(l wire.ValueList) ([]int64, error) {
-	if l.ValueType() != wire.TI64 {
-		return nil, nil
-	}
-
-	o := make([]int64, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetI64(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func _VersionHistories_Read(w wire.Value) (*shared.VersionHistories, error) {
-	var v shared.VersionHistories
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MutableStateChecksumPayload struct from its Thrift-level
+// FromWire deserializes a ActivityChecksumInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MutableStateChecksumPayload struct
+// An error is returned if we were unable to build a ActivityChecksumInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -310,199 +170,111 @@ func _VersionHistories_Read(w wire.Value) (*shared.VersionHistories, error) {
 //     return nil, err
 //   }
 //
-//   var v MutableStateChecksumPayload
+//   var v ActivityChecksumInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MutableStateChecksumPayload) FromWire(w wire.Value) error {
+func (v *ActivityChecksumInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.CancelRequested = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 15:
-			if field.Value.Type() == wire.TI16 {
-				var x int16
-				x, err = field.Value.GetI16(), error(nil)
-				v.State = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 16:
-			if field.Value.Type() == wire.TI16 {
-				var x int16
-				x, err = field.Value.GetI16(), error(nil)
-				v.CloseStatus = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 21:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.LastWriteVersion = &x
+				v.ScheduleID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 22:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastWriteEventID = &x
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 23:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastFirstEventID = &x
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskList = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 24:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventID = &x
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToStartTimeout = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 25:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastProcessedEventID = &x
+		case 41:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToCloseTimeout = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 26:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.SignalCount = &x
+		case 42:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StartToCloseTimeout = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 35:
+		case 43:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.DecisionAttempt = &x
+				v.HeartbeatTimeout = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 36:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionVersion = &x
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.CancelRequested = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 37:
+		case 51:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionScheduledID = &x
+				v.CancelRequestID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 38:
+		case 60:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionStartedID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 45:
-			if field.Value.Type() == wire.TList {
-				v.PendingTimerStartedIDs, err = _List_I64_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 46:
-			if field.Value.Type() == wire.TList {
-				v.PendingActivityScheduledIDs, err = _List_I64_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 47:
-			if field.Value.Type() == wire.TList {
-				v.PendingSignalInitiatedIDs, err = _List_I64_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 48:
-			if field.Value.Type() == wire.TList {
-				v.PendingReqCancelInitiatedIDs, err = _List_I64_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 49:
-			if field.Value.Type() == wire.TList {
-				v.PendingChildInitiatedIDs, err = _List_I64_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 55:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.StickyTaskListName = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 56:
-			if field.Value.Type() == wire.TStruct {
-				v.VersionHistories, err = _VersionHistories_Read(field.Value)
+				v.Version = &x
 				if err != nil {
 					return err
 				}
@@ -514,38 +286,20 @@ func (v *MutableStateChecksumPayload) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_I64_Encode(val []int64, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TI64,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for _, v := range val {
-		if err := sw.WriteInt64(v); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a MutableStateChecksumPayload struct directly into bytes, without going
+// Encode serializes a ActivityChecksumInfo struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MutableStateChecksumPayload struct could not be encoded.
-func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
+// An error is returned if a ActivityChecksumInfo struct could not be encoded.
+func (v *ActivityChecksumInfo) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.CancelRequested != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBool}); err != nil {
+	if v.ScheduleID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteBool(*(v.CancelRequested)); err != nil {
+		if err := sw.WriteInt64(*(v.ScheduleID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -553,11 +307,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.State != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 15, Type: wire.TI16}); err != nil {
+	if v.ActivityID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt16(*(v.State)); err != nil {
+		if err := sw.WriteString(*(v.ActivityID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -565,11 +319,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.CloseStatus != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 16, Type: wire.TI16}); err != nil {
+	if v.TaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt16(*(v.CloseStatus)); err != nil {
+		if err := sw.WriteString(*(v.TaskList)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -577,11 +331,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastWriteVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 21, Type: wire.TI64}); err != nil {
+	if v.ScheduleToStartTimeout != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.LastWriteVersion)); err != nil {
+		if err := sw.WriteInt32(*(v.ScheduleToStartTimeout)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -589,11 +343,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastWriteEventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 22, Type: wire.TI64}); err != nil {
+	if v.ScheduleToCloseTimeout != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 41, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.LastWriteEventID)); err != nil {
+		if err := sw.WriteInt32(*(v.ScheduleToCloseTimeout)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -601,11 +355,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastFirstEventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 23, Type: wire.TI64}); err != nil {
+	if v.StartToCloseTimeout != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 42, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.LastFirstEventID)); err != nil {
+		if err := sw.WriteInt32(*(v.StartToCloseTimeout)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -613,11 +367,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.NextEventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 24, Type: wire.TI64}); err != nil {
+	if v.HeartbeatTimeout != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 43, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.NextEventID)); err != nil {
+		if err := sw.WriteInt32(*(v.HeartbeatTimeout)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -625,11 +379,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastProcessedEventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 25, Type: wire.TI64}); err != nil {
+	if v.CancelRequested != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.LastProcessedEventID)); err != nil {
+		if err := sw.WriteBool(*(v.CancelRequested)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -637,11 +391,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.SignalCount != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 26, Type: wire.TI64}); err != nil {
+	if v.CancelRequestID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 51, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.SignalCount)); err != nil {
+		if err := sw.WriteInt64(*(v.CancelRequestID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -649,11 +403,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.DecisionAttempt != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 35, Type: wire.TI32}); err != nil {
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.DecisionAttempt)); err != nil {
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -661,107 +415,3745 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.DecisionVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 36, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.DecisionVersion)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+	return sw.WriteStructEnd()
+}
 
-	if v.DecisionScheduledID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 37, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.DecisionScheduledID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// Decode deserializes a ActivityChecksumInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ActivityChecksumInfo struct could not be generated from the wire
+// representation.
+func (v *ActivityChecksumInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	if v.DecisionStartedID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 38, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.DecisionStartedID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	if v.PendingTimerStartedIDs != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 45, Type: wire.TList}); err != nil {
-			return err
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduleID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ActivityID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TaskList = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ScheduleToStartTimeout = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 41 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ScheduleToCloseTimeout = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 42 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.StartToCloseTimeout = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 43 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.HeartbeatTimeout = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.CancelRequested = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 51 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CancelRequestID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
 		}
-		if err := _List_I64_Encode(v.PendingTimerStartedIDs, sw); err != nil {
+
+		if err := sr.ReadFieldEnd(); err != nil {
 			return err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
 			return err
 		}
 	}
 
-	if v.PendingActivityScheduledIDs != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 46, Type: wire.TList}); err != nil {
-			return err
-		}
-		if err := _List_I64_Encode(v.PendingActivityScheduledIDs, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
 
-	if v.PendingSignalInitiatedIDs != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 47, Type: wire.TList}); err != nil {
-			return err
-		}
-		if err := _List_I64_Encode(v.PendingSignalInitiatedIDs, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	return nil
+}
+
+// String returns a readable string representation of a ActivityChecksumInfo
+// struct.
+func (v *ActivityChecksumInfo) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	if v.PendingReqCancelInitiatedIDs != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 48, Type: wire.TList}); err != nil {
-			return err
+	var fields [10]string
+	i := 0
+	if v.ScheduleID != nil {
+		fields[i] = fmt.Sprintf("ScheduleID: %v", *(v.ScheduleID))
+		i++
+	}
+	if v.ActivityID != nil {
+		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", *(v.TaskList))
+		i++
+	}
+	if v.ScheduleToStartTimeout != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeout: %v", *(v.ScheduleToStartTimeout))
+		i++
+	}
+	if v.ScheduleToCloseTimeout != nil {
+		fields[i] = fmt.Sprintf("ScheduleToCloseTimeout: %v", *(v.ScheduleToCloseTimeout))
+		i++
+	}
+	if v.StartToCloseTimeout != nil {
+		fields[i] = fmt.Sprintf("StartToCloseTimeout: %v", *(v.StartToCloseTimeout))
+		i++
+	}
+	if v.HeartbeatTimeout != nil {
+		fields[i] = fmt.Sprintf("HeartbeatTimeout: %v", *(v.HeartbeatTimeout))
+		i++
+	}
+	if v.CancelRequested != nil {
+		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
+		i++
+	}
+	if v.CancelRequestID != nil {
+		fields[i] = fmt.Sprintf("CancelRequestID: %v", *(v.CancelRequestID))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+
+	return fmt.Sprintf("ActivityChecksumInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ActivityChecksumInfo match the
+// provided ActivityChecksumInfo.
+//
+// This function performs a deep comparison.
+func (v *ActivityChecksumInfo) Equals(rhs *ActivityChecksumInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleID, rhs.ScheduleID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskList, rhs.TaskList) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeout, rhs.ScheduleToStartTimeout) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToCloseTimeout, rhs.ScheduleToCloseTimeout) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StartToCloseTimeout, rhs.StartToCloseTimeout) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.HeartbeatTimeout, rhs.HeartbeatTimeout) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CancelRequestID, rhs.CancelRequestID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ActivityChecksumInfo.
+func (v *ActivityChecksumInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ScheduleID != nil {
+		enc.AddInt64("scheduleID", *v.ScheduleID)
+	}
+	if v.ActivityID != nil {
+		enc.AddString("activityID", *v.ActivityID)
+	}
+	if v.TaskList != nil {
+		enc.AddString("taskList", *v.TaskList)
+	}
+	if v.ScheduleToStartTimeout != nil {
+		enc.AddInt32("scheduleToStartTimeout", *v.ScheduleToStartTimeout)
+	}
+	if v.ScheduleToCloseTimeout != nil {
+		enc.AddInt32("scheduleToCloseTimeout", *v.ScheduleToCloseTimeout)
+	}
+	if v.StartToCloseTimeout != nil {
+		enc.AddInt32("startToCloseTimeout", *v.StartToCloseTimeout)
+	}
+	if v.HeartbeatTimeout != nil {
+		enc.AddInt32("heartbeatTimeout", *v.HeartbeatTimeout)
+	}
+	if v.CancelRequested != nil {
+		enc.AddBool("cancelRequested", *v.CancelRequested)
+	}
+	if v.CancelRequestID != nil {
+		enc.AddInt64("cancelRequestID", *v.CancelRequestID)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	return err
+}
+
+// GetScheduleID returns the value of ScheduleID if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetScheduleID() (o int64) {
+	if v != nil && v.ScheduleID != nil {
+		return *v.ScheduleID
+	}
+
+	return
+}
+
+// IsSetScheduleID returns true if ScheduleID is not nil.
+func (v *ActivityChecksumInfo) IsSetScheduleID() bool {
+	return v != nil && v.ScheduleID != nil
+}
+
+// GetActivityID returns the value of ActivityID if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetActivityID() (o string) {
+	if v != nil && v.ActivityID != nil {
+		return *v.ActivityID
+	}
+
+	return
+}
+
+// IsSetActivityID returns true if ActivityID is not nil.
+func (v *ActivityChecksumInfo) IsSetActivityID() bool {
+	return v != nil && v.ActivityID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetTaskList() (o string) {
+	if v != nil && v.TaskList != nil {
+		return *v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *ActivityChecksumInfo) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetScheduleToStartTimeout returns the value of ScheduleToStartTimeout if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetScheduleToStartTimeout() (o int32) {
+	if v != nil && v.ScheduleToStartTimeout != nil {
+		return *v.ScheduleToStartTimeout
+	}
+
+	return
+}
+
+// IsSetScheduleToStartTimeout returns true if ScheduleToStartTimeout is not nil.
+func (v *ActivityChecksumInfo) IsSetScheduleToStartTimeout() bool {
+	return v != nil && v.ScheduleToStartTimeout != nil
+}
+
+// GetScheduleToCloseTimeout returns the value of ScheduleToCloseTimeout if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetScheduleToCloseTimeout() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeout != nil {
+		return *v.ScheduleToCloseTimeout
+	}
+
+	return
+}
+
+// IsSetScheduleToCloseTimeout returns true if ScheduleToCloseTimeout is not nil.
+func (v *ActivityChecksumInfo) IsSetScheduleToCloseTimeout() bool {
+	return v != nil && v.ScheduleToCloseTimeout != nil
+}
+
+// GetStartToCloseTimeout returns the value of StartToCloseTimeout if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetStartToCloseTimeout() (o int32) {
+	if v != nil && v.StartToCloseTimeout != nil {
+		return *v.StartToCloseTimeout
+	}
+
+	return
+}
+
+// IsSetStartToCloseTimeout returns true if StartToCloseTimeout is not nil.
+func (v *ActivityChecksumInfo) IsSetStartToCloseTimeout() bool {
+	return v != nil && v.StartToCloseTimeout != nil
+}
+
+// GetHeartbeatTimeout returns the value of HeartbeatTimeout if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetHeartbeatTimeout() (o int32) {
+	if v != nil && v.HeartbeatTimeout != nil {
+		return *v.HeartbeatTimeout
+	}
+
+	return
+}
+
+// IsSetHeartbeatTimeout returns true if HeartbeatTimeout is not nil.
+func (v *ActivityChecksumInfo) IsSetHeartbeatTimeout() bool {
+	return v != nil && v.HeartbeatTimeout != nil
+}
+
+// GetCancelRequested returns the value of CancelRequested if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetCancelRequested() (o bool) {
+	if v != nil && v.CancelRequested != nil {
+		return *v.CancelRequested
+	}
+
+	return
+}
+
+// IsSetCancelRequested returns true if CancelRequested is not nil.
+func (v *ActivityChecksumInfo) IsSetCancelRequested() bool {
+	return v != nil && v.CancelRequested != nil
+}
+
+// GetCancelRequestID returns the value of CancelRequestID if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetCancelRequestID() (o int64) {
+	if v != nil && v.CancelRequestID != nil {
+		return *v.CancelRequestID
+	}
+
+	return
+}
+
+// IsSetCancelRequestID returns true if CancelRequestID is not nil.
+func (v *ActivityChecksumInfo) IsSetCancelRequestID() bool {
+	return v != nil && v.CancelRequestID != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ActivityChecksumInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *ActivityChecksumInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+type ChildExecutionChecksumInfo struct {
+	InitiatedID       *int64  `json:"initiatedID,omitempty"`
+	StartedID         *int64  `json:"startedID,omitempty"`
+	StartedWorkflowID *string `json:"startedWorkflowID,omitempty"`
+	StartedRunID      *string `json:"startedRunID,omitempty"`
+	DomainID          *string `json:"domainID,omitempty"`
+	WorkflowTypeName  *string `json:"workflowTypeName,omitempty"`
+	ParentClosePolicy *int32  `json:"parentClosePolicy,omitempty"`
+	Version           *int64  `json:"version,omitempty"`
+}
+
+// ToWire translates a ChildExecutionChecksumInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ChildExecutionChecksumInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.InitiatedID != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedID)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := _List_I64_Encode(v.PendingReqCancelInitiatedIDs, sw); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.StartedID != nil {
+		w, err = wire.NewValueI64(*(v.StartedID)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartedWorkflowID != nil {
+		w, err = wire.NewValueString(*(v.StartedWorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 21, Value: w}
+		i++
+	}
+	if v.StartedRunID != nil {
+		w, err = wire.NewValueString(*(v.StartedRunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.WorkflowTypeName != nil {
+		w, err = wire.NewValueString(*(v.WorkflowTypeName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 31, Value: w}
+		i++
+	}
+	if v.ParentClosePolicy != nil {
+		w, err = wire.NewValueI32(*(v.ParentClosePolicy)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 32, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ChildExecutionChecksumInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ChildExecutionChecksumInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ChildExecutionChecksumInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ChildExecutionChecksumInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 21:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StartedWorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 22:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StartedRunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 31:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowTypeName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 32:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ParentClosePolicy = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ChildExecutionChecksumInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ChildExecutionChecksumInfo struct could not be encoded.
+func (v *ChildExecutionChecksumInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.InitiatedID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.InitiatedID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedWorkflowID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 21, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.StartedWorkflowID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedRunID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 22, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.StartedRunID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowTypeName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 31, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.WorkflowTypeName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ParentClosePolicy != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 32, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ParentClosePolicy)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ChildExecutionChecksumInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ChildExecutionChecksumInfo struct could not be generated from the wire
+// representation.
+func (v *ChildExecutionChecksumInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.InitiatedID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 21 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StartedWorkflowID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 22 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StartedRunID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 31 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowTypeName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 32 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ParentClosePolicy = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ChildExecutionChecksumInfo
+// struct.
+func (v *ChildExecutionChecksumInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.InitiatedID != nil {
+		fields[i] = fmt.Sprintf("InitiatedID: %v", *(v.InitiatedID))
+		i++
+	}
+	if v.StartedID != nil {
+		fields[i] = fmt.Sprintf("StartedID: %v", *(v.StartedID))
+		i++
+	}
+	if v.StartedWorkflowID != nil {
+		fields[i] = fmt.Sprintf("StartedWorkflowID: %v", *(v.StartedWorkflowID))
+		i++
+	}
+	if v.StartedRunID != nil {
+		fields[i] = fmt.Sprintf("StartedRunID: %v", *(v.StartedRunID))
+		i++
+	}
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.WorkflowTypeName != nil {
+		fields[i] = fmt.Sprintf("WorkflowTypeName: %v", *(v.WorkflowTypeName))
+		i++
+	}
+	if v.ParentClosePolicy != nil {
+		fields[i] = fmt.Sprintf("ParentClosePolicy: %v", *(v.ParentClosePolicy))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+
+	return fmt.Sprintf("ChildExecutionChecksumInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ChildExecutionChecksumInfo match the
+// provided ChildExecutionChecksumInfo.
+//
+// This function performs a deep comparison.
+func (v *ChildExecutionChecksumInfo) Equals(rhs *ChildExecutionChecksumInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitiatedID, rhs.InitiatedID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedID, rhs.StartedID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StartedWorkflowID, rhs.StartedWorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StartedRunID, rhs.StartedRunID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowTypeName, rhs.WorkflowTypeName) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ParentClosePolicy, rhs.ParentClosePolicy) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ChildExecutionChecksumInfo.
+func (v *ChildExecutionChecksumInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.InitiatedID != nil {
+		enc.AddInt64("initiatedID", *v.InitiatedID)
+	}
+	if v.StartedID != nil {
+		enc.AddInt64("startedID", *v.StartedID)
+	}
+	if v.StartedWorkflowID != nil {
+		enc.AddString("startedWorkflowID", *v.StartedWorkflowID)
+	}
+	if v.StartedRunID != nil {
+		enc.AddString("startedRunID", *v.StartedRunID)
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.WorkflowTypeName != nil {
+		enc.AddString("workflowTypeName", *v.WorkflowTypeName)
+	}
+	if v.ParentClosePolicy != nil {
+		enc.AddInt32("parentClosePolicy", *v.ParentClosePolicy)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	return err
+}
+
+// GetInitiatedID returns the value of InitiatedID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionChecksumInfo) GetInitiatedID() (o int64) {
+	if v != nil && v.InitiatedID != nil {
+		return *v.InitiatedID
+	}
+
+	return
+}
+
+// IsSetInitiatedID returns true if InitiatedID is not nil.
+func (v *ChildExecutionChecksumInfo) IsSetInitiatedID() bool {
+	return v != nil && v.InitiatedID != nil
+}
+
+// GetStartedID returns the value of StartedID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionChecksumInfo) GetStartedID() (o int64) {
+	if v != nil && v.StartedID != nil {
+		return *v.StartedID
+	}
+
+	return
+}
+
+// IsSetStartedID returns true if StartedID is not nil.
+func (v *ChildExecutionChecksumInfo) IsSetStartedID() bool {
+	return v != nil && v.StartedID != nil
+}
+
+// GetStartedWorkflowID returns the value of StartedWorkflowID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionChecksumInfo) GetStartedWorkflowID() (o string) {
+	if v != nil && v.StartedWorkflowID != nil {
+		return *v.StartedWorkflowID
+	}
+
+	return
+}
+
+// IsSetStartedWorkflowID returns true if StartedWorkflowID is not nil.
+func (v *ChildExecutionChecksumInfo) IsSetStartedWorkflowID() bool {
+	return v != nil && v.StartedWorkflowID != nil
+}
+
+// GetStartedRunID returns the value of StartedRunID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionChecksumInfo) GetStartedRunID() (o string) {
+	if v != nil && v.StartedRunID != nil {
+		return *v.StartedRunID
+	}
+
+	return
+}
+
+// IsSetStartedRunID returns true if StartedRunID is not nil.
+func (v *ChildExecutionChecksumInfo) IsSetStartedRunID() bool {
+	return v != nil && v.StartedRunID != nil
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionChecksumInfo) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *ChildExecutionChecksumInfo) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetWorkflowTypeName returns the value of WorkflowTypeName if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionChecksumInfo) GetWorkflowTypeName() (o string) {
+	if v != nil && v.WorkflowTypeName != nil {
+		return *v.WorkflowTypeName
+	}
+
+	return
+}
+
+// IsSetWorkflowTypeName returns true if WorkflowTypeName is not nil.
+func (v *ChildExecutionChecksumInfo) IsSetWorkflowTypeName() bool {
+	return v != nil && v.WorkflowTypeName != nil
+}
+
+// GetParentClosePolicy returns the value of ParentClosePolicy if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionChecksumInfo) GetParentClosePolicy() (o int32) {
+	if v != nil && v.ParentClosePolicy != nil {
+		return *v.ParentClosePolicy
+	}
+
+	return
+}
+
+// IsSetParentClosePolicy returns true if ParentClosePolicy is not nil.
+func (v *ChildExecutionChecksumInfo) IsSetParentClosePolicy() bool {
+	return v != nil && v.ParentClosePolicy != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionChecksumInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *ChildExecutionChecksumInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+type MutableStateChecksumPayload struct {
+	CancelRequested              *bool                         `json:"cancelRequested,omitempty"`
+	State                        *int16                        `json:"state,omitempty"`
+	CloseStatus                  *int16                        `json:"closeStatus,omitempty"`
+	LastWriteVersion             *int64                        `json:"lastWriteVersion,omitempty"`
+	LastWriteEventID             *int64                        `json:"lastWriteEventID,omitempty"`
+	LastFirstEventID             *int64                        `json:"lastFirstEventID,omitempty"`
+	NextEventID                  *int64                        `json:"nextEventID,omitempty"`
+	LastProcessedEventID         *int64                        `json:"lastProcessedEventID,omitempty"`
+	SignalCount                  *int64                        `json:"signalCount,omitempty"`
+	DecisionAttempt              *int32                        `json:"decisionAttempt,omitempty"`
+	DecisionVersion              *int64                        `json:"decisionVersion,omitempty"`
+	DecisionScheduledID          *int64                        `json:"decisionScheduledID,omitempty"`
+	DecisionStartedID            *int64                        `json:"decisionStartedID,omitempty"`
+	PendingTimerStartedIDs       []int64                       `json:"pendingTimerStartedIDs,omitempty"`
+	PendingActivityScheduledIDs  []int64                       `json:"pendingActivityScheduledIDs,omitempty"`
+	PendingSignalInitiatedIDs    []int64                       `json:"pendingSignalInitiatedIDs,omitempty"`
+	PendingReqCancelInitiatedIDs []int64                       `json:"pendingReqCancelInitiatedIDs,omitempty"`
+	PendingChildInitiatedIDs     []int64                       `json:"pendingChildInitiatedIDs,omitempty"`
+	StickyTaskListName           *string                       `json:"stickyTaskListName,omitempty"`
+	VersionHistories             *shared.VersionHistories      `json:"VersionHistories,omitempty"`
+	PendingTimers                []*TimerChecksumInfo          `json:"pendingTimers,omitempty"`
+	PendingActivities            []*ActivityChecksumInfo       `json:"pendingActivities,omitempty"`
+	PendingSignals               []*SignalChecksumInfo         `json:"pendingSignals,omitempty"`
+	PendingRequestCancels        []*RequestCancelChecksumInfo  `json:"pendingRequestCancels,omitempty"`
+	PendingChildren              []*ChildExecutionChecksumInfo `json:"pendingChildren,omitempty"`
+}
+
+type _List_I64_ValueList []int64
+
+func (v _List_I64_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI64(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I64_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I64_ValueList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_List_I64_ValueList) Close() {}
+
+type _List_TimerChecksumInfo_ValueList []*TimerChecksumInfo
+
+func (v _List_TimerChecksumInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*TimerChecksumInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_TimerChecksumInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_TimerChecksumInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_TimerChecksumInfo_ValueList) Close() {}
+
+type _List_ActivityChecksumInfo_ValueList []*ActivityChecksumInfo
+
+func (v _List_ActivityChecksumInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ActivityChecksumInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ActivityChecksumInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ActivityChecksumInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ActivityChecksumInfo_ValueList) Close() {}
+
+type _List_SignalChecksumInfo_ValueList []*SignalChecksumInfo
+
+func (v _List_SignalChecksumInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*SignalChecksumInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_SignalChecksumInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_SignalChecksumInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_SignalChecksumInfo_ValueList) Close() {}
+
+type _List_RequestCancelChecksumInfo_ValueList []*RequestCancelChecksumInfo
+
+func (v _List_RequestCancelChecksumInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*RequestCancelChecksumInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_RequestCancelChecksumInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_RequestCancelChecksumInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_RequestCancelChecksumInfo_ValueList) Close() {}
+
+type _List_ChildExecutionChecksumInfo_ValueList []*ChildExecutionChecksumInfo
+
+func (v _List_ChildExecutionChecksumInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ChildExecutionChecksumInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ChildExecutionChecksumInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ChildExecutionChecksumInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ChildExecutionChecksumInfo_ValueList) Close() {}
+
+// ToWire translates a MutableStateChecksumPayload struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MutableStateChecksumPayload) ToWire() (wire.Value, error) {
+	var (
+		fields [25]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.CancelRequested != nil {
+		w, err = wire.NewValueBool(*(v.CancelRequested)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.State != nil {
+		w, err = wire.NewValueI16(*(v.State)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.CloseStatus != nil {
+		w, err = wire.NewValueI16(*(v.CloseStatus)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+	if v.LastWriteVersion != nil {
+		w, err = wire.NewValueI64(*(v.LastWriteVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 21, Value: w}
+		i++
+	}
+	if v.LastWriteEventID != nil {
+		w, err = wire.NewValueI64(*(v.LastWriteEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
+	if v.LastFirstEventID != nil {
+		w, err = wire.NewValueI64(*(v.LastFirstEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 23, Value: w}
+		i++
+	}
+	if v.NextEventID != nil {
+		w, err = wire.NewValueI64(*(v.NextEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 24, Value: w}
+		i++
+	}
+	if v.LastProcessedEventID != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 25, Value: w}
+		i++
+	}
+	if v.SignalCount != nil {
+		w, err = wire.NewValueI64(*(v.SignalCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 26, Value: w}
+		i++
+	}
+	if v.DecisionAttempt != nil {
+		w, err = wire.NewValueI32(*(v.DecisionAttempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 35, Value: w}
+		i++
+	}
+	if v.DecisionVersion != nil {
+		w, err = wire.NewValueI64(*(v.DecisionVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 36, Value: w}
+		i++
+	}
+	if v.DecisionScheduledID != nil {
+		w, err = wire.NewValueI64(*(v.DecisionScheduledID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 37, Value: w}
+		i++
+	}
+	if v.DecisionStartedID != nil {
+		w, err = wire.NewValueI64(*(v.DecisionStartedID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 38, Value: w}
+		i++
+	}
+	if v.PendingTimerStartedIDs != nil {
+		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingTimerStartedIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 45, Value: w}
+		i++
+	}
+	if v.PendingActivityScheduledIDs != nil {
+		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingActivityScheduledIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 46, Value: w}
+		i++
+	}
+	if v.PendingSignalInitiatedIDs != nil {
+		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingSignalInitiatedIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 47, Value: w}
+		i++
+	}
+	if v.PendingReqCancelInitiatedIDs != nil {
+		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingReqCancelInitiatedIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 48, Value: w}
+		i++
+	}
+	if v.PendingChildInitiatedIDs != nil {
+		w, err = wire.NewValueList(_List_I64_ValueList(v.PendingChildInitiatedIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 49, Value: w}
+		i++
+	}
+	if v.StickyTaskListName != nil {
+		w, err = wire.NewValueString(*(v.StickyTaskListName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 55, Value: w}
+		i++
+	}
+	if v.VersionHistories != nil {
+		w, err = v.VersionHistories.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 56, Value: w}
+		i++
+	}
+	if v.PendingTimers != nil {
+		w, err = wire.NewValueList(_List_TimerChecksumInfo_ValueList(v.PendingTimers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.PendingActivities != nil {
+		w, err = wire.NewValueList(_List_ActivityChecksumInfo_ValueList(v.PendingActivities)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 61, Value: w}
+		i++
+	}
+	if v.PendingSignals != nil {
+		w, err = wire.NewValueList(_List_SignalChecksumInfo_ValueList(v.PendingSignals)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 62, Value: w}
+		i++
+	}
+	if v.PendingRequestCancels != nil {
+		w, err = wire.NewValueList(_List_RequestCancelChecksumInfo_ValueList(v.PendingRequestCancels)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 63, Value: w}
+		i++
+	}
+	if v.PendingChildren != nil {
+		w, err = wire.NewValueList(_List_ChildExecutionChecksumInfo_ValueList(v.PendingChildren)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 64, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_I64_Read(l wire.ValueList) ([]int64, error) {
+	if l.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make([]int64, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _VersionHistories_Read(w wire.Value) (*shared.VersionHistories, error) {
+	var v shared.VersionHistories
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TimerChecksumInfo_Read(w wire.Value) (*TimerChecksumInfo, error) {
+	var v TimerChecksumInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_TimerChecksumInfo_Read(l wire.ValueList) ([]*TimerChecksumInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*TimerChecksumInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _TimerChecksumInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _ActivityChecksumInfo_Read(w wire.Value) (*ActivityChecksumInfo, error) {
+	var v ActivityChecksumInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ActivityChecksumInfo_Read(l wire.ValueList) ([]*ActivityChecksumInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ActivityChecksumInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ActivityChecksumInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _SignalChecksumInfo_Read(w wire.Value) (*SignalChecksumInfo, error) {
+	var v SignalChecksumInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_SignalChecksumInfo_Read(l wire.ValueList) ([]*SignalChecksumInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*SignalChecksumInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _SignalChecksumInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _RequestCancelChecksumInfo_Read(w wire.Value) (*RequestCancelChecksumInfo, error) {
+	var v RequestCancelChecksumInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_RequestCancelChecksumInfo_Read(l wire.ValueList) ([]*RequestCancelChecksumInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*RequestCancelChecksumInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _RequestCancelChecksumInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _ChildExecutionChecksumInfo_Read(w wire.Value) (*ChildExecutionChecksumInfo, error) {
+	var v ChildExecutionChecksumInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ChildExecutionChecksumInfo_Read(l wire.ValueList) ([]*ChildExecutionChecksumInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ChildExecutionChecksumInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ChildExecutionChecksumInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a MutableStateChecksumPayload struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MutableStateChecksumPayload struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MutableStateChecksumPayload
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MutableStateChecksumPayload) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.CancelRequested = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 15:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.State = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 16:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.CloseStatus = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 21:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastWriteVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 22:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastWriteEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 23:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastFirstEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 24:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 25:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastProcessedEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 26:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SignalCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 35:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.DecisionAttempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 36:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 37:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionScheduledID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 38:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionStartedID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 45:
+			if field.Value.Type() == wire.TList {
+				v.PendingTimerStartedIDs, err = _List_I64_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 46:
+			if field.Value.Type() == wire.TList {
+				v.PendingActivityScheduledIDs, err = _List_I64_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 47:
+			if field.Value.Type() == wire.TList {
+				v.PendingSignalInitiatedIDs, err = _List_I64_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 48:
+			if field.Value.Type() == wire.TList {
+				v.PendingReqCancelInitiatedIDs, err = _List_I64_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 49:
+			if field.Value.Type() == wire.TList {
+				v.PendingChildInitiatedIDs, err = _List_I64_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 55:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StickyTaskListName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 56:
+			if field.Value.Type() == wire.TStruct {
+				v.VersionHistories, err = _VersionHistories_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.PendingTimers, err = _List_TimerChecksumInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 61:
+			if field.Value.Type() == wire.TList {
+				v.PendingActivities, err = _List_ActivityChecksumInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 62:
+			if field.Value.Type() == wire.TList {
+				v.PendingSignals, err = _List_SignalChecksumInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 63:
+			if field.Value.Type() == wire.TList {
+				v.PendingRequestCancels, err = _List_RequestCancelChecksumInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 64:
+			if field.Value.Type() == wire.TList {
+				v.PendingChildren, err = _List_ChildExecutionChecksumInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_I64_Encode(val []int64, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI64,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_TimerChecksumInfo_Encode(val []*TimerChecksumInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*TimerChecksumInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_ActivityChecksumInfo_Encode(val []*ActivityChecksumInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ActivityChecksumInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_SignalChecksumInfo_Encode(val []*SignalChecksumInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*SignalChecksumInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_RequestCancelChecksumInfo_Encode(val []*RequestCancelChecksumInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*RequestCancelChecksumInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_ChildExecutionChecksumInfo_Encode(val []*ChildExecutionChecksumInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ChildExecutionChecksumInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a MutableStateChecksumPayload struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a MutableStateChecksumPayload struct could not be encoded.
+func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.CancelRequested != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.CancelRequested)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.State != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 15, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(*(v.State)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CloseStatus != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 16, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(*(v.CloseStatus)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastWriteVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 21, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastWriteVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastWriteEventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 22, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastWriteEventID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastFirstEventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 23, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastFirstEventID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NextEventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 24, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.NextEventID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastProcessedEventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 25, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastProcessedEventID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SignalCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 26, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.SignalCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DecisionAttempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 35, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.DecisionAttempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DecisionVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 36, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.DecisionVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DecisionScheduledID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 37, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.DecisionScheduledID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DecisionStartedID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 38, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.DecisionStartedID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingTimerStartedIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 45, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I64_Encode(v.PendingTimerStartedIDs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingActivityScheduledIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 46, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I64_Encode(v.PendingActivityScheduledIDs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingSignalInitiatedIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 47, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I64_Encode(v.PendingSignalInitiatedIDs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingReqCancelInitiatedIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 48, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I64_Encode(v.PendingReqCancelInitiatedIDs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingChildInitiatedIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 49, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I64_Encode(v.PendingChildInitiatedIDs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StickyTaskListName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 55, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.StickyTaskListName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VersionHistories != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 56, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.VersionHistories.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingTimers != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_TimerChecksumInfo_Encode(v.PendingTimers, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingActivities != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 61, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_ActivityChecksumInfo_Encode(v.PendingActivities, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingSignals != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 62, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_SignalChecksumInfo_Encode(v.PendingSignals, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingRequestCancels != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 63, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_RequestCancelChecksumInfo_Encode(v.PendingRequestCancels, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingChildren != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 64, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_ChildExecutionChecksumInfo_Encode(v.PendingChildren, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_I64_Decode(sr stream.Reader) ([]int64, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI64 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]int64, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _VersionHistories_Decode(sr stream.Reader) (*shared.VersionHistories, error) {
+	var v shared.VersionHistories
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _TimerChecksumInfo_Decode(sr stream.Reader) (*TimerChecksumInfo, error) {
+	var v TimerChecksumInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_TimerChecksumInfo_Decode(sr stream.Reader) ([]*TimerChecksumInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*TimerChecksumInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _TimerChecksumInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _ActivityChecksumInfo_Decode(sr stream.Reader) (*ActivityChecksumInfo, error) {
+	var v ActivityChecksumInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ActivityChecksumInfo_Decode(sr stream.Reader) ([]*ActivityChecksumInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ActivityChecksumInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ActivityChecksumInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _SignalChecksumInfo_Decode(sr stream.Reader) (*SignalChecksumInfo, error) {
+	var v SignalChecksumInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_SignalChecksumInfo_Decode(sr stream.Reader) ([]*SignalChecksumInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*SignalChecksumInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _SignalChecksumInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _RequestCancelChecksumInfo_Decode(sr stream.Reader) (*RequestCancelChecksumInfo, error) {
+	var v RequestCancelChecksumInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_RequestCancelChecksumInfo_Decode(sr stream.Reader) ([]*RequestCancelChecksumInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*RequestCancelChecksumInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _RequestCancelChecksumInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _ChildExecutionChecksumInfo_Decode(sr stream.Reader) (*ChildExecutionChecksumInfo, error) {
+	var v ChildExecutionChecksumInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ChildExecutionChecksumInfo_Decode(sr stream.Reader) ([]*ChildExecutionChecksumInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ChildExecutionChecksumInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ChildExecutionChecksumInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a MutableStateChecksumPayload struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a MutableStateChecksumPayload struct could not be generated from the wire
+// representation.
+func (v *MutableStateChecksumPayload) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.CancelRequested = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 15 && fh.Type == wire.TI16:
+			var x int16
+			x, err = sr.ReadInt16()
+			v.State = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 16 && fh.Type == wire.TI16:
+			var x int16
+			x, err = sr.ReadInt16()
+			v.CloseStatus = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 21 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastWriteVersion = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 22 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastWriteEventID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 23 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastFirstEventID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 24 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.NextEventID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 25 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastProcessedEventID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 26 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.SignalCount = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 35 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.DecisionAttempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 36 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionVersion = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 37 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionScheduledID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 38 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionStartedID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 45 && fh.Type == wire.TList:
+			v.PendingTimerStartedIDs, err = _List_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 46 && fh.Type == wire.TList:
+			v.PendingActivityScheduledIDs, err = _List_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 47 && fh.Type == wire.TList:
+			v.PendingSignalInitiatedIDs, err = _List_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 48 && fh.Type == wire.TList:
+			v.PendingReqCancelInitiatedIDs, err = _List_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 49 && fh.Type == wire.TList:
+			v.PendingChildInitiatedIDs, err = _List_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 55 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StickyTaskListName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 56 && fh.Type == wire.TStruct:
+			v.VersionHistories, err = _VersionHistories_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TList:
+			v.PendingTimers, err = _List_TimerChecksumInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 61 && fh.Type == wire.TList:
+			v.PendingActivities, err = _List_ActivityChecksumInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 62 && fh.Type == wire.TList:
+			v.PendingSignals, err = _List_SignalChecksumInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 63 && fh.Type == wire.TList:
+			v.PendingRequestCancels, err = _List_RequestCancelChecksumInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 64 && fh.Type == wire.TList:
+			v.PendingChildren, err = _List_ChildExecutionChecksumInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MutableStateChecksumPayload
+// struct.
+func (v *MutableStateChecksumPayload) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [25]string
+	i := 0
+	if v.CancelRequested != nil {
+		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
+		i++
+	}
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+		i++
+	}
+	if v.CloseStatus != nil {
+		fields[i] = fmt.Sprintf("CloseStatus: %v", *(v.CloseStatus))
+		i++
+	}
+	if v.LastWriteVersion != nil {
+		fields[i] = fmt.Sprintf("LastWriteVersion: %v", *(v.LastWriteVersion))
+		i++
+	}
+	if v.LastWriteEventID != nil {
+		fields[i] = fmt.Sprintf("LastWriteEventID: %v", *(v.LastWriteEventID))
+		i++
+	}
+	if v.LastFirstEventID != nil {
+		fields[i] = fmt.Sprintf("LastFirstEventID: %v", *(v.LastFirstEventID))
+		i++
+	}
+	if v.NextEventID != nil {
+		fields[i] = fmt.Sprintf("NextEventID: %v", *(v.NextEventID))
+		i++
+	}
+	if v.LastProcessedEventID != nil {
+		fields[i] = fmt.Sprintf("LastProcessedEventID: %v", *(v.LastProcessedEventID))
+		i++
+	}
+	if v.SignalCount != nil {
+		fields[i] = fmt.Sprintf("SignalCount: %v", *(v.SignalCount))
+		i++
+	}
+	if v.DecisionAttempt != nil {
+		fields[i] = fmt.Sprintf("DecisionAttempt: %v", *(v.DecisionAttempt))
+		i++
+	}
+	if v.DecisionVersion != nil {
+		fields[i] = fmt.Sprintf("DecisionVersion: %v", *(v.DecisionVersion))
+		i++
+	}
+	if v.DecisionScheduledID != nil {
+		fields[i] = fmt.Sprintf("DecisionScheduledID: %v", *(v.DecisionScheduledID))
+		i++
+	}
+	if v.DecisionStartedID != nil {
+		fields[i] = fmt.Sprintf("DecisionStartedID: %v", *(v.DecisionStartedID))
+		i++
+	}
+	if v.PendingTimerStartedIDs != nil {
+		fields[i] = fmt.Sprintf("PendingTimerStartedIDs: %v", v.PendingTimerStartedIDs)
+		i++
+	}
+	if v.PendingActivityScheduledIDs != nil {
+		fields[i] = fmt.Sprintf("PendingActivityScheduledIDs: %v", v.PendingActivityScheduledIDs)
+		i++
+	}
+	if v.PendingSignalInitiatedIDs != nil {
+		fields[i] = fmt.Sprintf("PendingSignalInitiatedIDs: %v", v.PendingSignalInitiatedIDs)
+		i++
+	}
+	if v.PendingReqCancelInitiatedIDs != nil {
+		fields[i] = fmt.Sprintf("PendingReqCancelInitiatedIDs: %v", v.PendingReqCancelInitiatedIDs)
+		i++
+	}
+	if v.PendingChildInitiatedIDs != nil {
+		fields[i] = fmt.Sprintf("PendingChildInitiatedIDs: %v", v.PendingChildInitiatedIDs)
+		i++
+	}
+	if v.StickyTaskListName != nil {
+		fields[i] = fmt.Sprintf("StickyTaskListName: %v", *(v.StickyTaskListName))
+		i++
+	}
+	if v.VersionHistories != nil {
+		fields[i] = fmt.Sprintf("VersionHistories: %v", v.VersionHistories)
+		i++
+	}
+	if v.PendingTimers != nil {
+		fields[i] = fmt.Sprintf("PendingTimers: %v", v.PendingTimers)
+		i++
+	}
+	if v.PendingActivities != nil {
+		fields[i] = fmt.Sprintf("PendingActivities: %v", v.PendingActivities)
+		i++
+	}
+	if v.PendingSignals != nil {
+		fields[i] = fmt.Sprintf("PendingSignals: %v", v.PendingSignals)
+		i++
+	}
+	if v.PendingRequestCancels != nil {
+		fields[i] = fmt.Sprintf("PendingRequestCancels: %v", v.PendingRequestCancels)
+		i++
+	}
+	if v.PendingChildren != nil {
+		fields[i] = fmt.Sprintf("PendingChildren: %v", v.PendingChildren)
+		i++
+	}
+
+	return fmt.Sprintf("MutableStateChecksumPayload{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_I64_Equals(lhs, rhs []int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_TimerChecksumInfo_Equals(lhs, rhs []*TimerChecksumInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_ActivityChecksumInfo_Equals(lhs, rhs []*ActivityChecksumInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_SignalChecksumInfo_Equals(lhs, rhs []*SignalChecksumInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_RequestCancelChecksumInfo_Equals(lhs, rhs []*RequestCancelChecksumInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_ChildExecutionChecksumInfo_Equals(lhs, rhs []*ChildExecutionChecksumInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this MutableStateChecksumPayload match the
+// provided MutableStateChecksumPayload.
+//
+// This function performs a deep comparison.
+func (v *MutableStateChecksumPayload) Equals(rhs *MutableStateChecksumPayload) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.State, rhs.State) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.CloseStatus, rhs.CloseStatus) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastWriteVersion, rhs.LastWriteVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastWriteEventID, rhs.LastWriteEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastFirstEventID, rhs.LastFirstEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventID, rhs.NextEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastProcessedEventID, rhs.LastProcessedEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SignalCount, rhs.SignalCount) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.DecisionAttempt, rhs.DecisionAttempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionVersion, rhs.DecisionVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionScheduledID, rhs.DecisionScheduledID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionStartedID, rhs.DecisionStartedID) {
+		return false
+	}
+	if !((v.PendingTimerStartedIDs == nil && rhs.PendingTimerStartedIDs == nil) || (v.PendingTimerStartedIDs != nil && rhs.PendingTimerStartedIDs != nil && _List_I64_Equals(v.PendingTimerStartedIDs, rhs.PendingTimerStartedIDs))) {
+		return false
+	}
+	if !((v.PendingActivityScheduledIDs == nil && rhs.PendingActivityScheduledIDs == nil) || (v.PendingActivityScheduledIDs != nil && rhs.PendingActivityScheduledIDs != nil && _List_I64_Equals(v.PendingActivityScheduledIDs, rhs.PendingActivityScheduledIDs))) {
+		return false
+	}
+	if !((v.PendingSignalInitiatedIDs == nil && rhs.PendingSignalInitiatedIDs == nil) || (v.PendingSignalInitiatedIDs != nil && rhs.PendingSignalInitiatedIDs != nil && _List_I64_Equals(v.PendingSignalInitiatedIDs, rhs.PendingSignalInitiatedIDs))) {
+		return false
+	}
+	if !((v.PendingReqCancelInitiatedIDs == nil && rhs.PendingReqCancelInitiatedIDs == nil) || (v.PendingReqCancelInitiatedIDs != nil && rhs.PendingReqCancelInitiatedIDs != nil && _List_I64_Equals(v.PendingReqCancelInitiatedIDs, rhs.PendingReqCancelInitiatedIDs))) {
+		return false
+	}
+	if !((v.PendingChildInitiatedIDs == nil && rhs.PendingChildInitiatedIDs == nil) || (v.PendingChildInitiatedIDs != nil && rhs.PendingChildInitiatedIDs != nil && _List_I64_Equals(v.PendingChildInitiatedIDs, rhs.PendingChildInitiatedIDs))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StickyTaskListName, rhs.StickyTaskListName) {
+		return false
+	}
+	if !((v.VersionHistories == nil && rhs.VersionHistories == nil) || (v.VersionHistories != nil && rhs.VersionHistories != nil && v.VersionHistories.Equals(rhs.VersionHistories))) {
+		return false
+	}
+	if !((v.PendingTimers == nil && rhs.PendingTimers == nil) || (v.PendingTimers != nil && rhs.PendingTimers != nil && _List_TimerChecksumInfo_Equals(v.PendingTimers, rhs.PendingTimers))) {
+		return false
+	}
+	if !((v.PendingActivities == nil && rhs.PendingActivities == nil) || (v.PendingActivities != nil && rhs.PendingActivities != nil && _List_ActivityChecksumInfo_Equals(v.PendingActivities, rhs.PendingActivities))) {
+		return false
+	}
+	if !((v.PendingSignals == nil && rhs.PendingSignals == nil) || (v.PendingSignals != nil && rhs.PendingSignals != nil && _List_SignalChecksumInfo_Equals(v.PendingSignals, rhs.PendingSignals))) {
+		return false
+	}
+	if !((v.PendingRequestCancels == nil && rhs.PendingRequestCancels == nil) || (v.PendingRequestCancels != nil && rhs.PendingRequestCancels != nil && _List_RequestCancelChecksumInfo_Equals(v.PendingRequestCancels, rhs.PendingRequestCancels))) {
+		return false
+	}
+	if !((v.PendingChildren == nil && rhs.PendingChildren == nil) || (v.PendingChildren != nil && rhs.PendingChildren != nil && _List_ChildExecutionChecksumInfo_Equals(v.PendingChildren, rhs.PendingChildren))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_I64_Zapper []int64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I64_Zapper.
+func (l _List_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt64(v)
+	}
+	return err
+}
+
+type _List_TimerChecksumInfo_Zapper []*TimerChecksumInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_TimerChecksumInfo_Zapper.
+func (l _List_TimerChecksumInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_ActivityChecksumInfo_Zapper []*ActivityChecksumInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ActivityChecksumInfo_Zapper.
+func (l _List_ActivityChecksumInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_SignalChecksumInfo_Zapper []*SignalChecksumInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_SignalChecksumInfo_Zapper.
+func (l _List_SignalChecksumInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_RequestCancelChecksumInfo_Zapper []*RequestCancelChecksumInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_RequestCancelChecksumInfo_Zapper.
+func (l _List_RequestCancelChecksumInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_ChildExecutionChecksumInfo_Zapper []*ChildExecutionChecksumInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ChildExecutionChecksumInfo_Zapper.
+func (l _List_ChildExecutionChecksumInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MutableStateChecksumPayload.
+func (v *MutableStateChecksumPayload) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.CancelRequested != nil {
+		enc.AddBool("cancelRequested", *v.CancelRequested)
+	}
+	if v.State != nil {
+		enc.AddInt16("state", *v.State)
+	}
+	if v.CloseStatus != nil {
+		enc.AddInt16("closeStatus", *v.CloseStatus)
+	}
+	if v.LastWriteVersion != nil {
+		enc.AddInt64("lastWriteVersion", *v.LastWriteVersion)
+	}
+	if v.LastWriteEventID != nil {
+		enc.AddInt64("lastWriteEventID", *v.LastWriteEventID)
+	}
+	if v.LastFirstEventID != nil {
+		enc.AddInt64("lastFirstEventID", *v.LastFirstEventID)
+	}
+	if v.NextEventID != nil {
+		enc.AddInt64("nextEventID", *v.NextEventID)
+	}
+	if v.LastProcessedEventID != nil {
+		enc.AddInt64("lastProcessedEventID", *v.LastProcessedEventID)
+	}
+	if v.SignalCount != nil {
+		enc.AddInt64("signalCount", *v.SignalCount)
+	}
+	if v.DecisionAttempt != nil {
+		enc.AddInt32("decisionAttempt", *v.DecisionAttempt)
+	}
+	if v.DecisionVersion != nil {
+		enc.AddInt64("decisionVersion", *v.DecisionVersion)
+	}
+	if v.DecisionScheduledID != nil {
+		enc.AddInt64("decisionScheduledID", *v.DecisionScheduledID)
+	}
+	if v.DecisionStartedID != nil {
+		enc.AddInt64("decisionStartedID", *v.DecisionStartedID)
+	}
+	if v.PendingTimerStartedIDs != nil {
+		err = multierr.Append(err, enc.AddArray("pendingTimerStartedIDs", (_List_I64_Zapper)(v.PendingTimerStartedIDs)))
+	}
+	if v.PendingActivityScheduledIDs != nil {
+		err = multierr.Append(err, enc.AddArray("pendingActivityScheduledIDs", (_List_I64_Zapper)(v.PendingActivityScheduledIDs)))
+	}
+	if v.PendingSignalInitiatedIDs != nil {
+		err = multierr.Append(err, enc.AddArray("pendingSignalInitiatedIDs", (_List_I64_Zapper)(v.PendingSignalInitiatedIDs)))
+	}
+	if v.PendingReqCancelInitiatedIDs != nil {
+		err = multierr.Append(err, enc.AddArray("pendingReqCancelInitiatedIDs", (_List_I64_Zapper)(v.PendingReqCancelInitiatedIDs)))
+	}
+	if v.PendingChildInitiatedIDs != nil {
+		err = multierr.Append(err, enc.AddArray("pendingChildInitiatedIDs", (_List_I64_Zapper)(v.PendingChildInitiatedIDs)))
+	}
+	if v.StickyTaskListName != nil {
+		enc.AddString("stickyTaskListName", *v.StickyTaskListName)
+	}
+	if v.VersionHistories != nil {
+		err = multierr.Append(err, enc.AddObject("VersionHistories", v.VersionHistories))
+	}
+	if v.PendingTimers != nil {
+		err = multierr.Append(err, enc.AddArray("pendingTimers", (_List_TimerChecksumInfo_Zapper)(v.PendingTimers)))
+	}
+	if v.PendingActivities != nil {
+		err = multierr.Append(err, enc.AddArray("pendingActivities", (_List_ActivityChecksumInfo_Zapper)(v.PendingActivities)))
+	}
+	if v.PendingSignals != nil {
+		err = multierr.Append(err, enc.AddArray("pendingSignals", (_List_SignalChecksumInfo_Zapper)(v.PendingSignals)))
+	}
+	if v.PendingRequestCancels != nil {
+		err = multierr.Append(err, enc.AddArray("pendingRequestCancels", (_List_RequestCancelChecksumInfo_Zapper)(v.PendingRequestCancels)))
+	}
+	if v.PendingChildren != nil {
+		err = multierr.Append(err, enc.AddArray("pendingChildren", (_List_ChildExecutionChecksumInfo_Zapper)(v.PendingChildren)))
+	}
+	return err
+}
+
+// GetCancelRequested returns the value of CancelRequested if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetCancelRequested() (o bool) {
+	if v != nil && v.CancelRequested != nil {
+		return *v.CancelRequested
+	}
+
+	return
+}
+
+// IsSetCancelRequested returns true if CancelRequested is not nil.
+func (v *MutableStateChecksumPayload) IsSetCancelRequested() bool {
+	return v != nil && v.CancelRequested != nil
+}
+
+// GetState returns the value of State if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetState() (o int16) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+
+	return
+}
+
+// IsSetState returns true if State is not nil.
+func (v *MutableStateChecksumPayload) IsSetState() bool {
+	return v != nil && v.State != nil
+}
+
+// GetCloseStatus returns the value of CloseStatus if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetCloseStatus() (o int16) {
+	if v != nil && v.CloseStatus != nil {
+		return *v.CloseStatus
+	}
+
+	return
+}
+
+// IsSetCloseStatus returns true if CloseStatus is not nil.
+func (v *MutableStateChecksumPayload) IsSetCloseStatus() bool {
+	return v != nil && v.CloseStatus != nil
+}
+
+// GetLastWriteVersion returns the value of LastWriteVersion if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetLastWriteVersion() (o int64) {
+	if v != nil && v.LastWriteVersion != nil {
+		return *v.LastWriteVersion
+	}
+
+	return
+}
+
+// IsSetLastWriteVersion returns true if LastWriteVersion is not nil.
+func (v *MutableStateChecksumPayload) IsSetLastWriteVersion() bool {
+	return v != nil && v.LastWriteVersion != nil
+}
+
+// GetLastWriteEventID returns the value of LastWriteEventID if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetLastWriteEventID() (o int64) {
+	if v != nil && v.LastWriteEventID != nil {
+		return *v.LastWriteEventID
+	}
+
+	return
+}
+
+// IsSetLastWriteEventID returns true if LastWriteEventID is not nil.
+func (v *MutableStateChecksumPayload) IsSetLastWriteEventID() bool {
+	return v != nil && v.LastWriteEventID != nil
+}
+
+// GetLastFirstEventID returns the value of LastFirstEventID if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetLastFirstEventID() (o int64) {
+	if v != nil && v.LastFirstEventID != nil {
+		return *v.LastFirstEventID
+	}
+
+	return
+}
+
+// IsSetLastFirstEventID returns true if LastFirstEventID is not nil.
+func (v *MutableStateChecksumPayload) IsSetLastFirstEventID() bool {
+	return v != nil && v.LastFirstEventID != nil
+}
+
+// GetNextEventID returns the value of NextEventID if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetNextEventID() (o int64) {
+	if v != nil && v.NextEventID != nil {
+		return *v.NextEventID
+	}
+
+	return
+}
+
+// IsSetNextEventID returns true if NextEventID is not nil.
+func (v *MutableStateChecksumPayload) IsSetNextEventID() bool {
+	return v != nil && v.NextEventID != nil
+}
+
+// GetLastProcessedEventID returns the value of LastProcessedEventID if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetLastProcessedEventID() (o int64) {
+	if v != nil && v.LastProcessedEventID != nil {
+		return *v.LastProcessedEventID
+	}
+
+	return
+}
+
+// IsSetLastProcessedEventID returns true if LastProcessedEventID is not nil.
+func (v *MutableStateChecksumPayload) IsSetLastProcessedEventID() bool {
+	return v != nil && v.LastProcessedEventID != nil
+}
+
+// GetSignalCount returns the value of SignalCount if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetSignalCount() (o int64) {
+	if v != nil && v.SignalCount != nil {
+		return *v.SignalCount
+	}
+
+	return
+}
+
+// IsSetSignalCount returns true if SignalCount is not nil.
+func (v *MutableStateChecksumPayload) IsSetSignalCount() bool {
+	return v != nil && v.SignalCount != nil
+}
+
+// GetDecisionAttempt returns the value of DecisionAttempt if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetDecisionAttempt() (o int32) {
+	if v != nil && v.DecisionAttempt != nil {
+		return *v.DecisionAttempt
+	}
+
+	return
+}
+
+// IsSetDecisionAttempt returns true if DecisionAttempt is not nil.
+func (v *MutableStateChecksumPayload) IsSetDecisionAttempt() bool {
+	return v != nil && v.DecisionAttempt != nil
+}
+
+// GetDecisionVersion returns the value of DecisionVersion if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetDecisionVersion() (o int64) {
+	if v != nil && v.DecisionVersion != nil {
+		return *v.DecisionVersion
+	}
+
+	return
+}
+
+// IsSetDecisionVersion returns true if DecisionVersion is not nil.
+func (v *MutableStateChecksumPayload) IsSetDecisionVersion() bool {
+	return v != nil && v.DecisionVersion != nil
+}
+
+// GetDecisionScheduledID returns the value of DecisionScheduledID if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetDecisionScheduledID() (o int64) {
+	if v != nil && v.DecisionScheduledID != nil {
+		return *v.DecisionScheduledID
 	}
 
-	if v.PendingChildInitiatedIDs != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 49, Type: wire.TList}); err != nil {
-			return err
+	return
+}
+
+// IsSetDecisionScheduledID returns true if DecisionScheduledID is not nil.
+func (v *MutableStateChecksumPayload) IsSetDecisionScheduledID() bool {
+	return v != nil && v.DecisionScheduledID != nil
+}
+
+// GetDecisionStartedID returns the value of DecisionStartedID if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetDecisionStartedID() (o int64) {
+	if v != nil && v.DecisionStartedID != nil {
+		return *v.DecisionStartedID
+	}
+
+	return
+}
+
+// IsSetDecisionStartedID returns true if DecisionStartedID is not nil.
+func (v *MutableStateChecksumPayload) IsSetDecisionStartedID() bool {
+	return v != nil && v.DecisionStartedID != nil
+}
+
+// GetPendingTimerStartedIDs returns the value of PendingTimerStartedIDs if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingTimerStartedIDs() (o []int64) {
+	if v != nil && v.PendingTimerStartedIDs != nil {
+		return v.PendingTimerStartedIDs
+	}
+
+	return
+}
+
+// IsSetPendingTimerStartedIDs returns true if PendingTimerStartedIDs is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingTimerStartedIDs() bool {
+	return v != nil && v.PendingTimerStartedIDs != nil
+}
+
+// GetPendingActivityScheduledIDs returns the value of PendingActivityScheduledIDs if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingActivityScheduledIDs() (o []int64) {
+	if v != nil && v.PendingActivityScheduledIDs != nil {
+		return v.PendingActivityScheduledIDs
+	}
+
+	return
+}
+
+// IsSetPendingActivityScheduledIDs returns true if PendingActivityScheduledIDs is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingActivityScheduledIDs() bool {
+	return v != nil && v.PendingActivityScheduledIDs != nil
+}
+
+// GetPendingSignalInitiatedIDs returns the value of PendingSignalInitiatedIDs if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingSignalInitiatedIDs() (o []int64) {
+	if v != nil && v.PendingSignalInitiatedIDs != nil {
+		return v.PendingSignalInitiatedIDs
+	}
+
+	return
+}
+
+// IsSetPendingSignalInitiatedIDs returns true if PendingSignalInitiatedIDs is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingSignalInitiatedIDs() bool {
+	return v != nil && v.PendingSignalInitiatedIDs != nil
+}
+
+// GetPendingReqCancelInitiatedIDs returns the value of PendingReqCancelInitiatedIDs if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingReqCancelInitiatedIDs() (o []int64) {
+	if v != nil && v.PendingReqCancelInitiatedIDs != nil {
+		return v.PendingReqCancelInitiatedIDs
+	}
+
+	return
+}
+
+// IsSetPendingReqCancelInitiatedIDs returns true if PendingReqCancelInitiatedIDs is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingReqCancelInitiatedIDs() bool {
+	return v != nil && v.PendingReqCancelInitiatedIDs != nil
+}
+
+// GetPendingChildInitiatedIDs returns the value of PendingChildInitiatedIDs if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingChildInitiatedIDs() (o []int64) {
+	if v != nil && v.PendingChildInitiatedIDs != nil {
+		return v.PendingChildInitiatedIDs
+	}
+
+	return
+}
+
+// IsSetPendingChildInitiatedIDs returns true if PendingChildInitiatedIDs is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingChildInitiatedIDs() bool {
+	return v != nil && v.PendingChildInitiatedIDs != nil
+}
+
+// GetStickyTaskListName returns the value of StickyTaskListName if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetStickyTaskListName() (o string) {
+	if v != nil && v.StickyTaskListName != nil {
+		return *v.StickyTaskListName
+	}
+
+	return
+}
+
+// IsSetStickyTaskListName returns true if StickyTaskListName is not nil.
+func (v *MutableStateChecksumPayload) IsSetStickyTaskListName() bool {
+	return v != nil && v.StickyTaskListName != nil
+}
+
+// GetVersionHistories returns the value of VersionHistories if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetVersionHistories() (o *shared.VersionHistories) {
+	if v != nil && v.VersionHistories != nil {
+		return v.VersionHistories
+	}
+
+	return
+}
+
+// IsSetVersionHistories returns true if VersionHistories is not nil.
+func (v *MutableStateChecksumPayload) IsSetVersionHistories() bool {
+	return v != nil && v.VersionHistories != nil
+}
+
+// GetPendingTimers returns the value of PendingTimers if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingTimers() (o []*TimerChecksumInfo) {
+	if v != nil && v.PendingTimers != nil {
+		return v.PendingTimers
+	}
+
+	return
+}
+
+// IsSetPendingTimers returns true if PendingTimers is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingTimers() bool {
+	return v != nil && v.PendingTimers != nil
+}
+
+// GetPendingActivities returns the value of PendingActivities if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingActivities() (o []*ActivityChecksumInfo) {
+	if v != nil && v.PendingActivities != nil {
+		return v.PendingActivities
+	}
+
+	return
+}
+
+// IsSetPendingActivities returns true if PendingActivities is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingActivities() bool {
+	return v != nil && v.PendingActivities != nil
+}
+
+// GetPendingSignals returns the value of PendingSignals if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingSignals() (o []*SignalChecksumInfo) {
+	if v != nil && v.PendingSignals != nil {
+		return v.PendingSignals
+	}
+
+	return
+}
+
+// IsSetPendingSignals returns true if PendingSignals is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingSignals() bool {
+	return v != nil && v.PendingSignals != nil
+}
+
+// GetPendingRequestCancels returns the value of PendingRequestCancels if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingRequestCancels() (o []*RequestCancelChecksumInfo) {
+	if v != nil && v.PendingRequestCancels != nil {
+		return v.PendingRequestCancels
+	}
+
+	return
+}
+
+// IsSetPendingRequestCancels returns true if PendingRequestCancels is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingRequestCancels() bool {
+	return v != nil && v.PendingRequestCancels != nil
+}
+
+// GetPendingChildren returns the value of PendingChildren if it is set or its
+// zero value if it is unset.
+func (v *MutableStateChecksumPayload) GetPendingChildren() (o []*ChildExecutionChecksumInfo) {
+	if v != nil && v.PendingChildren != nil {
+		return v.PendingChildren
+	}
+
+	return
+}
+
+// IsSetPendingChildren returns true if PendingChildren is not nil.
+func (v *MutableStateChecksumPayload) IsSetPendingChildren() bool {
+	return v != nil && v.PendingChildren != nil
+}
+
+type RequestCancelChecksumInfo struct {
+	InitiatedID *int64 `json:"initiatedID,omitempty"`
+	Version     *int64 `json:"version,omitempty"`
+}
+
+// ToWire translates a RequestCancelChecksumInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RequestCancelChecksumInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.InitiatedID != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedID)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := _List_I64_Encode(v.PendingChildInitiatedIDs, sw); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RequestCancelChecksumInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RequestCancelChecksumInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RequestCancelChecksumInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RequestCancelChecksumInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
-	if v.StickyTaskListName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 55, Type: wire.TBinary}); err != nil {
+	return nil
+}
+
+// Encode serializes a RequestCancelChecksumInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a RequestCancelChecksumInfo struct could not be encoded.
+func (v *RequestCancelChecksumInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.InitiatedID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.StickyTaskListName)); err != nil {
+		if err := sw.WriteInt64(*(v.InitiatedID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -769,11 +4161,11 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.VersionHistories != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 56, Type: wire.TStruct}); err != nil {
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := v.VersionHistories.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -784,48 +4176,12 @@ func (v *MutableStateChecksumPayload) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _List_I64_Decode(sr stream.Reader) ([]int64, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TI64 {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]int64, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := sr.ReadInt64()
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-func _VersionHistories_Decode(sr stream.Reader) (*shared.VersionHistories, error) {
-	var v shared.VersionHistories
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a MutableStateChecksumPayload struct directly from its Thrift-level
+// Decode deserializes a RequestCancelChecksumInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MutableStateChecksumPayload struct could not be generated from the wire
+// An error is returned if a RequestCancelChecksumInfo struct could not be generated from the wire
 // representation.
-func (v *MutableStateChecksumPayload) Decode(sr stream.Reader) error {
+func (v *RequestCancelChecksumInfo) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -838,150 +4194,18 @@ func (v *MutableStateChecksumPayload) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.CancelRequested = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 15 && fh.Type == wire.TI16:
-			var x int16
-			x, err = sr.ReadInt16()
-			v.State = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 16 && fh.Type == wire.TI16:
-			var x int16
-			x, err = sr.ReadInt16()
-			v.CloseStatus = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 21 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.LastWriteVersion = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 22 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.LastWriteEventID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 23 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.LastFirstEventID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 24 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.NextEventID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 25 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.LastProcessedEventID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 26 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.SignalCount = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 35 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.DecisionAttempt = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 36 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionVersion = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 37 && fh.Type == wire.TI64:
+		case fh.ID == 10 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.DecisionScheduledID = &x
+			v.InitiatedID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 38 && fh.Type == wire.TI64:
+		case fh.ID == 20 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.DecisionStartedID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 45 && fh.Type == wire.TList:
-			v.PendingTimerStartedIDs, err = _List_I64_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 46 && fh.Type == wire.TList:
-			v.PendingActivityScheduledIDs, err = _List_I64_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 47 && fh.Type == wire.TList:
-			v.PendingSignalInitiatedIDs, err = _List_I64_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 48 && fh.Type == wire.TList:
-			v.PendingReqCancelInitiatedIDs, err = _List_I64_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 49 && fh.Type == wire.TList:
-			v.PendingChildInitiatedIDs, err = _List_I64_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 55 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.StickyTaskListName = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 56 && fh.Type == wire.TStruct:
-			v.VersionHistories, err = _VersionHistories_Decode(sr)
+			v.Version = &x
 			if err != nil {
 				return err
 			}
@@ -1008,616 +4232,830 @@ func (v *MutableStateChecksumPayload) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MutableStateChecksumPayload
+// String returns a readable string representation of a RequestCancelChecksumInfo
 // struct.
-func (v *MutableStateChecksumPayload) String() string {
+func (v *RequestCancelChecksumInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [20]string
-	i := 0
-	if v.CancelRequested != nil {
-		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
-		i++
-	}
-	if v.State != nil {
-		fields[i] = fmt.Sprintf("State: %v", *(v.State))
-		i++
-	}
-	if v.CloseStatus != nil {
-		fields[i] = fmt.Sprintf("CloseStatus: %v", *(v.CloseStatus))
-		i++
-	}
-	if v.LastWriteVersion != nil {
-		fields[i] = fmt.Sprintf("LastWriteVersion: %v", *(v.LastWriteVersion))
-		i++
-	}
-	if v.LastWriteEventID != nil {
-		fields[i] = fmt.Sprintf("LastWriteEventID: %v", *(v.LastWriteEventID))
-		i++
-	}
-	if v.LastFirstEventID != nil {
-		fields[i] = fmt.Sprintf("LastFirstEventID: %v", *(v.LastFirstEventID))
-		i++
-	}
-	if v.NextEventID != nil {
-		fields[i] = fmt.Sprintf("NextEventID: %v", *(v.NextEventID))
-		i++
-	}
-	if v.LastProcessedEventID != nil {
-		fields[i] = fmt.Sprintf("LastProcessedEventID: %v", *(v.LastProcessedEventID))
-		i++
-	}
-	if v.SignalCount != nil {
-		fields[i] = fmt.Sprintf("SignalCount: %v", *(v.SignalCount))
-		i++
-	}
-	if v.DecisionAttempt != nil {
-		fields[i] = fmt.Sprintf("DecisionAttempt: %v", *(v.DecisionAttempt))
-		i++
-	}
-	if v.DecisionVersion != nil {
-		fields[i] = fmt.Sprintf("DecisionVersion: %v", *(v.DecisionVersion))
-		i++
-	}
-	if v.DecisionScheduledID != nil {
-		fields[i] = fmt.Sprintf("DecisionScheduledID: %v", *(v.DecisionScheduledID))
-		i++
-	}
-	if v.DecisionStartedID != nil {
-		fields[i] = fmt.Sprintf("DecisionStartedID: %v", *(v.DecisionStartedID))
-		i++
-	}
-	if v.PendingTimerStartedIDs != nil {
-		fields[i] = fmt.Sprintf("PendingTimerStartedIDs: %v", v.PendingTimerStartedIDs)
-		i++
-	}
-	if v.PendingActivityScheduledIDs != nil {
-		fields[i] = fmt.Sprintf("PendingActivityScheduledIDs: %v", v.PendingActivityScheduledIDs)
-		i++
-	}
-	if v.PendingSignalInitiatedIDs != nil {
-		fields[i] = fmt.Sprintf("PendingSignalInitiatedIDs: %v", v.PendingSignalInitiatedIDs)
-		i++
-	}
-	if v.PendingReqCancelInitiatedIDs != nil {
-		fields[i] = fmt.Sprintf("PendingReqCancelInitiatedIDs: %v", v.PendingReqCancelInitiatedIDs)
-		i++
-	}
-	if v.PendingChildInitiatedIDs != nil {
-		fields[i] = fmt.Sprintf("PendingChildInitiatedIDs: %v", v.PendingChildInitiatedIDs)
-		i++
-	}
-	if v.StickyTaskListName != nil {
-		fields[i] = fmt.Sprintf("StickyTaskListName: %v", *(v.StickyTaskListName))
+	var fields [2]string
+	i := 0
+	if v.InitiatedID != nil {
+		fields[i] = fmt.Sprintf("InitiatedID: %v", *(v.InitiatedID))
 		i++
 	}
-	if v.VersionHistories != nil {
-		fields[i] = fmt.Sprintf("VersionHistories: %v", v.VersionHistories)
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
 		i++
 	}
 
-	return fmt.Sprintf("MutableStateChecksumPayload{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelChecksumInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// Equals returns true if all the fields of this RequestCancelChecksumInfo match the
+// provided RequestCancelChecksumInfo.
+//
+// This function performs a deep comparison.
+func (v *RequestCancelChecksumInfo) Equals(rhs *RequestCancelChecksumInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	return lhs == nil && rhs == nil
+	if !_I64_EqualsPtr(v.InitiatedID, rhs.InitiatedID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+
+	return true
 }
 
-func _I16_EqualsPtr(lhs, rhs *int16) bool {
-	if lhs != nil && rhs != nil {
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RequestCancelChecksumInfo.
+func (v *RequestCancelChecksumInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.InitiatedID != nil {
+		enc.AddInt64("initiatedID", *v.InitiatedID)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	return err
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// GetInitiatedID returns the value of InitiatedID if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelChecksumInfo) GetInitiatedID() (o int64) {
+	if v != nil && v.InitiatedID != nil {
+		return *v.InitiatedID
 	}
-	return lhs == nil && rhs == nil
+
+	return
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
+// IsSetInitiatedID returns true if InitiatedID is not nil.
+func (v *RequestCancelChecksumInfo) IsSetInitiatedID() bool {
+	return v != nil && v.InitiatedID != nil
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelChecksumInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
-	return lhs == nil && rhs == nil
+
+	return
 }
 
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
+// IsSetVersion returns true if Version is not nil.
+func (v *RequestCancelChecksumInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+type SignalChecksumInfo struct {
+	InitiatedID *int64  `json:"initiatedID,omitempty"`
+	SignalName  *string `json:"signalName,omitempty"`
+	Version     *int64  `json:"version,omitempty"`
 }
 
-func _List_I64_Equals(lhs, rhs []int64) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
+// ToWire translates a SignalChecksumInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SignalChecksumInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
+	if v.InitiatedID != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SignalName != nil {
+		w, err = wire.NewValueString(*(v.SignalName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
 
-	return true
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
+// FromWire deserializes a SignalChecksumInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SignalChecksumInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SignalChecksumInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SignalChecksumInfo) FromWire(w wire.Value) error {
+	var err error
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SignalName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
-	return lhs == nil && rhs == nil
+
+	return nil
 }
 
-// Equals returns true if all the fields of this MutableStateChecksumPayload match the
-// provided MutableStateChecksumPayload.
+// Encode serializes a SignalChecksumInfo struct directly into bytes, without going
+// through an intermediary type.
 //
-// This function performs a deep comparison.
-func (v *MutableStateChecksumPayload) Equals(rhs *MutableStateChecksumPayload) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
-		return false
-	}
-	if !_I16_EqualsPtr(v.State, rhs.State) {
-		return false
-	}
-	if !_I16_EqualsPtr(v.CloseStatus, rhs.CloseStatus) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastWriteVersion, rhs.LastWriteVersion) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastWriteEventID, rhs.LastWriteEventID) {
-		return false
+// An error is returned if a SignalChecksumInfo struct could not be encoded.
+func (v *SignalChecksumInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
-	if !_I64_EqualsPtr(v.LastFirstEventID, rhs.LastFirstEventID) {
-		return false
+
+	if v.InitiatedID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.InitiatedID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if !_I64_EqualsPtr(v.NextEventID, rhs.NextEventID) {
-		return false
+
+	if v.SignalName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.SignalName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if !_I64_EqualsPtr(v.LastProcessedEventID, rhs.LastProcessedEventID) {
-		return false
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if !_I64_EqualsPtr(v.SignalCount, rhs.SignalCount) {
-		return false
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a SignalChecksumInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a SignalChecksumInfo struct could not be generated from the wire
+// representation.
+func (v *SignalChecksumInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	if !_I32_EqualsPtr(v.DecisionAttempt, rhs.DecisionAttempt) {
-		return false
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
-	if !_I64_EqualsPtr(v.DecisionVersion, rhs.DecisionVersion) {
-		return false
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.InitiatedID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.SignalName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	if !_I64_EqualsPtr(v.DecisionScheduledID, rhs.DecisionScheduledID) {
-		return false
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
-	if !_I64_EqualsPtr(v.DecisionStartedID, rhs.DecisionStartedID) {
-		return false
+
+	return nil
+}
+
+// String returns a readable string representation of a SignalChecksumInfo
+// struct.
+func (v *SignalChecksumInfo) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if !((v.PendingTimerStartedIDs == nil && rhs.PendingTimerStartedIDs == nil) || (v.PendingTimerStartedIDs != nil && rhs.PendingTimerStartedIDs != nil && _List_I64_Equals(v.PendingTimerStartedIDs, rhs.PendingTimerStartedIDs))) {
-		return false
+
+	var fields [3]string
+	i := 0
+	if v.InitiatedID != nil {
+		fields[i] = fmt.Sprintf("InitiatedID: %v", *(v.InitiatedID))
+		i++
 	}
-	if !((v.PendingActivityScheduledIDs == nil && rhs.PendingActivityScheduledIDs == nil) || (v.PendingActivityScheduledIDs != nil && rhs.PendingActivityScheduledIDs != nil && _List_I64_Equals(v.PendingActivityScheduledIDs, rhs.PendingActivityScheduledIDs))) {
-		return false
+	if v.SignalName != nil {
+		fields[i] = fmt.Sprintf("SignalName: %v", *(v.SignalName))
+		i++
 	}
-	if !((v.PendingSignalInitiatedIDs == nil && rhs.PendingSignalInitiatedIDs == nil) || (v.PendingSignalInitiatedIDs != nil && rhs.PendingSignalInitiatedIDs != nil && _List_I64_Equals(v.PendingSignalInitiatedIDs, rhs.PendingSignalInitiatedIDs))) {
-		return false
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
 	}
-	if !((v.PendingReqCancelInitiatedIDs == nil && rhs.PendingReqCancelInitiatedIDs == nil) || (v.PendingReqCancelInitiatedIDs != nil && rhs.PendingReqCancelInitiatedIDs != nil && _List_I64_Equals(v.PendingReqCancelInitiatedIDs, rhs.PendingReqCancelInitiatedIDs))) {
+
+	return fmt.Sprintf("SignalChecksumInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SignalChecksumInfo match the
+// provided SignalChecksumInfo.
+//
+// This function performs a deep comparison.
+func (v *SignalChecksumInfo) Equals(rhs *SignalChecksumInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
 		return false
 	}
-	if !((v.PendingChildInitiatedIDs == nil && rhs.PendingChildInitiatedIDs == nil) || (v.PendingChildInitiatedIDs != nil && rhs.PendingChildInitiatedIDs != nil && _List_I64_Equals(v.PendingChildInitiatedIDs, rhs.PendingChildInitiatedIDs))) {
+	if !_I64_EqualsPtr(v.InitiatedID, rhs.InitiatedID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.StickyTaskListName, rhs.StickyTaskListName) {
+	if !_String_EqualsPtr(v.SignalName, rhs.SignalName) {
 		return false
 	}
-	if !((v.VersionHistories == nil && rhs.VersionHistories == nil) || (v.VersionHistories != nil && rhs.VersionHistories != nil && v.VersionHistories.Equals(rhs.VersionHistories))) {
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
 		return false
 	}
 
 	return true
 }
 
-type _List_I64_Zapper []int64
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_I64_Zapper.
-func (l _List_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendInt64(v)
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MutableStateChecksumPayload.
-func (v *MutableStateChecksumPayload) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of SignalChecksumInfo.
+func (v *SignalChecksumInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.CancelRequested != nil {
-		enc.AddBool("cancelRequested", *v.CancelRequested)
-	}
-	if v.State != nil {
-		enc.AddInt16("state", *v.State)
-	}
-	if v.CloseStatus != nil {
-		enc.AddInt16("closeStatus", *v.CloseStatus)
-	}
-	if v.LastWriteVersion != nil {
-		enc.AddInt64("lastWriteVersion", *v.LastWriteVersion)
-	}
-	if v.LastWriteEventID != nil {
-		enc.AddInt64("lastWriteEventID", *v.LastWriteEventID)
-	}
-	if v.LastFirstEventID != nil {
-		enc.AddInt64("lastFirstEventID", *v.LastFirstEventID)
-	}
-	if v.NextEventID != nil {
-		enc.AddInt64("nextEventID", *v.NextEventID)
-	}
-	if v.LastProcessedEventID != nil {
-		enc.AddInt64("lastProcessedEventID", *v.LastProcessedEventID)
-	}
-	if v.SignalCount != nil {
-		enc.AddInt64("signalCount", *v.SignalCount)
-	}
-	if v.DecisionAttempt != nil {
-		enc.AddInt32("decisionAttempt", *v.DecisionAttempt)
-	}
-	if v.DecisionVersion != nil {
-		enc.AddInt64("decisionVersion", *v.DecisionVersion)
-	}
-	if v.DecisionScheduledID != nil {
-		enc.AddInt64("decisionScheduledID", *v.DecisionScheduledID)
-	}
-	if v.DecisionStartedID != nil {
-		enc.AddInt64("decisionStartedID", *v.DecisionStartedID)
-	}
-	if v.PendingTimerStartedIDs != nil {
-		err = multierr.Append(err, enc.AddArray("pendingTimerStartedIDs", (_List_I64_Zapper)(v.PendingTimerStartedIDs)))
-	}
-	if v.PendingActivityScheduledIDs != nil {
-		err = multierr.Append(err, enc.AddArray("pendingActivityScheduledIDs", (_List_I64_Zapper)(v.PendingActivityScheduledIDs)))
-	}
-	if v.PendingSignalInitiatedIDs != nil {
-		err = multierr.Append(err, enc.AddArray("pendingSignalInitiatedIDs", (_List_I64_Zapper)(v.PendingSignalInitiatedIDs)))
-	}
-	if v.PendingReqCancelInitiatedIDs != nil {
-		err = multierr.Append(err, enc.AddArray("pendingReqCancelInitiatedIDs", (_List_I64_Zapper)(v.PendingReqCancelInitiatedIDs)))
-	}
-	if v.PendingChildInitiatedIDs != nil {
-		err = multierr.Append(err, enc.AddArray("pendingChildInitiatedIDs", (_List_I64_Zapper)(v.PendingChildInitiatedIDs)))
+	if v.InitiatedID != nil {
+		enc.AddInt64("initiatedID", *v.InitiatedID)
 	}
-	if v.StickyTaskListName != nil {
-		enc.AddString("stickyTaskListName", *v.StickyTaskListName)
+	if v.SignalName != nil {
+		enc.AddString("signalName", *v.SignalName)
 	}
-	if v.VersionHistories != nil {
-		err = multierr.Append(err, enc.AddObject("VersionHistories", v.VersionHistories))
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
 	}
 	return err
 }
 
-// GetCancelRequested returns the value of CancelRequested if it is set or its
+// GetInitiatedID returns the value of InitiatedID if it is set or its
 // zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetCancelRequested() (o bool) {
-	if v != nil && v.CancelRequested != nil {
-		return *v.CancelRequested
+func (v *SignalChecksumInfo) GetInitiatedID() (o int64) {
+	if v != nil && v.InitiatedID != nil {
+		return *v.InitiatedID
 	}
 
 	return
 }
 
-// IsSetCancelRequested returns true if CancelRequested is not nil.
-func (v *MutableStateChecksumPayload) IsSetCancelRequested() bool {
-	return v != nil && v.CancelRequested != nil
+// IsSetInitiatedID returns true if InitiatedID is not nil.
+func (v *SignalChecksumInfo) IsSetInitiatedID() bool {
+	return v != nil && v.InitiatedID != nil
 }
 
-// GetState returns the value of State if it is set or its
+// GetSignalName returns the value of SignalName if it is set or its
 // zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetState() (o int16) {
-	if v != nil && v.State != nil {
-		return *v.State
+func (v *SignalChecksumInfo) GetSignalName() (o string) {
+	if v != nil && v.SignalName != nil {
+		return *v.SignalName
 	}
 
 	return
 }
 
-// IsSetState returns true if State is not nil.
-func (v *MutableStateChecksumPayload) IsSetState() bool {
-	return v != nil && v.State != nil
+// IsSetSignalName returns true if SignalName is not nil.
+func (v *SignalChecksumInfo) IsSetSignalName() bool {
+	return v != nil && v.SignalName != nil
 }
 
-// GetCloseStatus returns the value of CloseStatus if it is set or its
+// GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetCloseStatus() (o int16) {
-	if v != nil && v.CloseStatus != nil {
-		return *v.CloseStatus
+func (v *SignalChecksumInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
 	return
 }
 
-// IsSetCloseStatus returns true if CloseStatus is not nil.
-func (v *MutableStateChecksumPayload) IsSetCloseStatus() bool {
-	return v != nil && v.CloseStatus != nil
+// IsSetVersion returns true if Version is not nil.
+func (v *SignalChecksumInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
-// GetLastWriteVersion returns the value of LastWriteVersion if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetLastWriteVersion() (o int64) {
-	if v != nil && v.LastWriteVersion != nil {
-		return *v.LastWriteVersion
-	}
-
-	return
+type TimerChecksumInfo struct {
+	TimerID         *string `json:"timerID,omitempty"`
+	StartedID       *int64  `json:"startedID,omitempty"`
+	ExpiryTimeNanos *int64  `json:"expiryTimeNanos,omitempty"`
+	Version         *int64  `json:"version,omitempty"`
 }
 
-// IsSetLastWriteVersion returns true if LastWriteVersion is not nil.
-func (v *MutableStateChecksumPayload) IsSetLastWriteVersion() bool {
-	return v != nil && v.LastWriteVersion != nil
-}
+// ToWire translates a TimerChecksumInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TimerChecksumInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// GetLastWriteEventID returns the value of LastWriteEventID if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetLastWriteEventID() (o int64) {
-	if v != nil && v.LastWriteEventID != nil {
-		return *v.LastWriteEventID
+	if v.TimerID != nil {
+		w, err = wire.NewValueString(*(v.TimerID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-
-	return
-}
-
-// IsSetLastWriteEventID returns true if LastWriteEventID is not nil.
-func (v *MutableStateChecksumPayload) IsSetLastWriteEventID() bool {
-	return v != nil && v.LastWriteEventID != nil
-}
-
-// GetLastFirstEventID returns the value of LastFirstEventID if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetLastFirstEventID() (o int64) {
-	if v != nil && v.LastFirstEventID != nil {
-		return *v.LastFirstEventID
+	if v.StartedID != nil {
+		w, err = wire.NewValueI64(*(v.StartedID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ExpiryTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.ExpiryTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// IsSetLastFirstEventID returns true if LastFirstEventID is not nil.
-func (v *MutableStateChecksumPayload) IsSetLastFirstEventID() bool {
-	return v != nil && v.LastFirstEventID != nil
-}
+// FromWire deserializes a TimerChecksumInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TimerChecksumInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TimerChecksumInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TimerChecksumInfo) FromWire(w wire.Value) error {
+	var err error
 
-// GetNextEventID returns the value of NextEventID if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetNextEventID() (o int64) {
-	if v != nil && v.NextEventID != nil {
-		return *v.NextEventID
-	}
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TimerID = &x
+				if err != nil {
+					return err
+				}
 
-	return
-}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpiryTimeNanos = &x
+				if err != nil {
+					return err
+				}
 
-// IsSetNextEventID returns true if NextEventID is not nil.
-func (v *MutableStateChecksumPayload) IsSetNextEventID() bool {
-	return v != nil && v.NextEventID != nil
-}
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
 
-// GetLastProcessedEventID returns the value of LastProcessedEventID if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetLastProcessedEventID() (o int64) {
-	if v != nil && v.LastProcessedEventID != nil {
-		return *v.LastProcessedEventID
+			}
+		}
 	}
 
-	return
+	return nil
 }
 
-// IsSetLastProcessedEventID returns true if LastProcessedEventID is not nil.
-func (v *MutableStateChecksumPayload) IsSetLastProcessedEventID() bool {
-	return v != nil && v.LastProcessedEventID != nil
-}
+// Encode serializes a TimerChecksumInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TimerChecksumInfo struct could not be encoded.
+func (v *TimerChecksumInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
 
-// GetSignalCount returns the value of SignalCount if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetSignalCount() (o int64) {
-	if v != nil && v.SignalCount != nil {
-		return *v.SignalCount
+	if v.TimerID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.TimerID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return
-}
+	if v.StartedID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-// IsSetSignalCount returns true if SignalCount is not nil.
-func (v *MutableStateChecksumPayload) IsSetSignalCount() bool {
-	return v != nil && v.SignalCount != nil
-}
+	if v.ExpiryTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ExpiryTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-// GetDecisionAttempt returns the value of DecisionAttempt if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetDecisionAttempt() (o int32) {
-	if v != nil && v.DecisionAttempt != nil {
-		return *v.DecisionAttempt
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return
+	return sw.WriteStructEnd()
 }
 
-// IsSetDecisionAttempt returns true if DecisionAttempt is not nil.
-func (v *MutableStateChecksumPayload) IsSetDecisionAttempt() bool {
-	return v != nil && v.DecisionAttempt != nil
-}
+// Decode deserializes a TimerChecksumInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TimerChecksumInfo struct could not be generated from the wire
+// representation.
+func (v *TimerChecksumInfo) Decode(sr stream.Reader) error {
 
-// GetDecisionVersion returns the value of DecisionVersion if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetDecisionVersion() (o int64) {
-	if v != nil && v.DecisionVersion != nil {
-		return *v.DecisionVersion
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	return
-}
-
-// IsSetDecisionVersion returns true if DecisionVersion is not nil.
-func (v *MutableStateChecksumPayload) IsSetDecisionVersion() bool {
-	return v != nil && v.DecisionVersion != nil
-}
-
-// GetDecisionScheduledID returns the value of DecisionScheduledID if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetDecisionScheduledID() (o int64) {
-	if v != nil && v.DecisionScheduledID != nil {
-		return *v.DecisionScheduledID
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return
-}
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TimerID = &x
+			if err != nil {
+				return err
+			}
 
-// IsSetDecisionScheduledID returns true if DecisionScheduledID is not nil.
-func (v *MutableStateChecksumPayload) IsSetDecisionScheduledID() bool {
-	return v != nil && v.DecisionScheduledID != nil
-}
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedID = &x
+			if err != nil {
+				return err
+			}
 
-// GetDecisionStartedID returns the value of DecisionStartedID if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetDecisionStartedID() (o int64) {
-	if v != nil && v.DecisionStartedID != nil {
-		return *v.DecisionStartedID
-	}
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ExpiryTimeNanos = &x
+			if err != nil {
+				return err
+			}
 
-	return
-}
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
 
-// IsSetDecisionStartedID returns true if DecisionStartedID is not nil.
-func (v *MutableStateChecksumPayload) IsSetDecisionStartedID() bool {
-	return v != nil && v.DecisionStartedID != nil
-}
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-// GetPendingTimerStartedIDs returns the value of PendingTimerStartedIDs if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetPendingTimerStartedIDs() (o []int64) {
-	if v != nil && v.PendingTimerStartedIDs != nil {
-		return v.PendingTimerStartedIDs
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
 
-	return
-}
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
 
-// IsSetPendingTimerStartedIDs returns true if PendingTimerStartedIDs is not nil.
-func (v *MutableStateChecksumPayload) IsSetPendingTimerStartedIDs() bool {
-	return v != nil && v.PendingTimerStartedIDs != nil
+	return nil
 }
 
-// GetPendingActivityScheduledIDs returns the value of PendingActivityScheduledIDs if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetPendingActivityScheduledIDs() (o []int64) {
-	if v != nil && v.PendingActivityScheduledIDs != nil {
-		return v.PendingActivityScheduledIDs
+// String returns a readable string representation of a TimerChecksumInfo
+// struct.
+func (v *TimerChecksumInfo) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [4]string
+	i := 0
+	if v.TimerID != nil {
+		fields[i] = fmt.Sprintf("TimerID: %v", *(v.TimerID))
+		i++
+	}
+	if v.StartedID != nil {
+		fields[i] = fmt.Sprintf("StartedID: %v", *(v.StartedID))
+		i++
+	}
+	if v.ExpiryTimeNanos != nil {
+		fields[i] = fmt.Sprintf("ExpiryTimeNanos: %v", *(v.ExpiryTimeNanos))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
 
-// IsSetPendingActivityScheduledIDs returns true if PendingActivityScheduledIDs is not nil.
-func (v *MutableStateChecksumPayload) IsSetPendingActivityScheduledIDs() bool {
-	return v != nil && v.PendingActivityScheduledIDs != nil
+	return fmt.Sprintf("TimerChecksumInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetPendingSignalInitiatedIDs returns the value of PendingSignalInitiatedIDs if it is set or its
-// zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetPendingSignalInitiatedIDs() (o []int64) {
-	if v != nil && v.PendingSignalInitiatedIDs != nil {
-		return v.PendingSignalInitiatedIDs
+// Equals returns true if all the fields of this TimerChecksumInfo match the
+// provided TimerChecksumInfo.
+//
+// This function performs a deep comparison.
+func (v *TimerChecksumInfo) Equals(rhs *TimerChecksumInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.TimerID, rhs.TimerID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedID, rhs.StartedID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpiryTimeNanos, rhs.ExpiryTimeNanos) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetPendingSignalInitiatedIDs returns true if PendingSignalInitiatedIDs is not nil.
-func (v *MutableStateChecksumPayload) IsSetPendingSignalInitiatedIDs() bool {
-	return v != nil && v.PendingSignalInitiatedIDs != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TimerChecksumInfo.
+func (v *TimerChecksumInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TimerID != nil {
+		enc.AddString("timerID", *v.TimerID)
+	}
+	if v.StartedID != nil {
+		enc.AddInt64("startedID", *v.StartedID)
+	}
+	if v.ExpiryTimeNanos != nil {
+		enc.AddInt64("expiryTimeNanos", *v.ExpiryTimeNanos)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	return err
 }
 
-// GetPendingReqCancelInitiatedIDs returns the value of PendingReqCancelInitiatedIDs if it is set or its
+// GetTimerID returns the value of TimerID if it is set or its
 // zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetPendingReqCancelInitiatedIDs() (o []int64) {
-	if v != nil && v.PendingReqCancelInitiatedIDs != nil {
-		return v.PendingReqCancelInitiatedIDs
+func (v *TimerChecksumInfo) GetTimerID() (o string) {
+	if v != nil && v.TimerID != nil {
+		return *v.TimerID
 	}
 
 	return
 }
 
-// IsSetPendingReqCancelInitiatedIDs returns true if PendingReqCancelInitiatedIDs is not nil.
-func (v *MutableStateChecksumPayload) IsSetPendingReqCancelInitiatedIDs() bool {
-	return v != nil && v.PendingReqCancelInitiatedIDs != nil
+// IsSetTimerID returns true if TimerID is not nil.
+func (v *TimerChecksumInfo) IsSetTimerID() bool {
+	return v != nil && v.TimerID != nil
 }
 
-// GetPendingChildInitiatedIDs returns the value of PendingChildInitiatedIDs if it is set or its
+// GetStartedID returns the value of StartedID if it is set or its
 // zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetPendingChildInitiatedIDs() (o []int64) {
-	if v != nil && v.PendingChildInitiatedIDs != nil {
-		return v.PendingChildInitiatedIDs
+func (v *TimerChecksumInfo) GetStartedID() (o int64) {
+	if v != nil && v.StartedID != nil {
+		return *v.StartedID
 	}
 
 	return
 }
 
-// IsSetPendingChildInitiatedIDs returns true if PendingChildInitiatedIDs is not nil.
-func (v *MutableStateChecksumPayload) IsSetPendingChildInitiatedIDs() bool {
-	return v != nil && v.PendingChildInitiatedIDs != nil
+// IsSetStartedID returns true if StartedID is not nil.
+func (v *TimerChecksumInfo) IsSetStartedID() bool {
+	return v != nil && v.StartedID != nil
 }
 
-// GetStickyTaskListName returns the value of StickyTaskListName if it is set or its
+// GetExpiryTimeNanos returns the value of ExpiryTimeNanos if it is set or its
 // zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetStickyTaskListName() (o string) {
-	if v != nil && v.StickyTaskListName != nil {
-		return *v.StickyTaskListName
+func (v *TimerChecksumInfo) GetExpiryTimeNanos() (o int64) {
+	if v != nil && v.ExpiryTimeNanos != nil {
+		return *v.ExpiryTimeNanos
 	}
 
 	return
 }
 
-// IsSetStickyTaskListName returns true if StickyTaskListName is not nil.
-func (v *MutableStateChecksumPayload) IsSetStickyTaskListName() bool {
-	return v != nil && v.StickyTaskListName != nil
+// IsSetExpiryTimeNanos returns true if ExpiryTimeNanos is not nil.
+func (v *TimerChecksumInfo) IsSetExpiryTimeNanos() bool {
+	return v != nil && v.ExpiryTimeNanos != nil
 }
 
-// GetVersionHistories returns the value of VersionHistories if it is set or its
+// GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *MutableStateChecksumPayload) GetVersionHistories() (o *shared.VersionHistories) {
-	if v != nil && v.VersionHistories != nil {
-		return v.VersionHistories
+func (v *TimerChecksumInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
 	return
 }
 
-// IsSetVersionHistories returns true if VersionHistories is not nil.
-func (v *MutableStateChecksumPayload) IsSetVersionHistories() bool {
-	return v != nil && v.VersionHistories != nil
+// IsSetVersion returns true if Version is not nil.
+func (v *TimerChecksumInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
 // ThriftModule represents the IDL file used to generate this package.
@@ -1625,11 +5063,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "checksum",
 	Package:  "github.com/uber/cadence/.gen/go/checksum",
 	FilePath: "checksum.thrift",
-	SHA1:     "c3ee77b53c2e06c35a3296cfeeeadf140711ed95",
+	SHA1:     "02837d4284e6af06059e2947fb5c48ff02ca0eeb",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2019 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence\n\nstruct MutableStateChecksumPayload {\n    10: optional bool cancelRequested\n    15: optional i16 state\n    16: optional i16 closeStatus\n\n    21: optional i64 (js.type = \"Long\") lastWriteVersion\n    22: optional i64 (js.type = \"Long\") lastWriteEventID\n    23: optional i64 (js.type = \"Long\") lastFirstEventID\n    24: optional i64 (js.type = \"Long\") nextEventID\n    25: optional i64 (js.type = \"Long\") lastProcessedEventID\n    26: optional i64 (js.type = \"Long\") signalCount\n\n    35: optional i32 decisionAttempt\n    36: optional i64 (js.type = \"Long\") decisionVersion\n    37: optional i64 (js.type = \"Long\") decisionScheduledID\n    38: optional i64 (js.type = \"Long\") decisionStartedID\n\n    45: optional list<i64> pendingTimerStartedIDs\n    46: optional list<i64> pendingActivityScheduledIDs\n    47: optional list<i64> pendingSignalInitiatedIDs\n    48: optional list<i64> pendingReqCancelInitiatedIDs\n    49: optional list<i64> pendingChildInitiatedIDs\n\n    55: optional string stickyTaskListName\n    56: optional shared.VersionHistories VersionHistories\n}\n"
+const rawIDL = "// Copyright (c) 2019 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence\n\nstruct TimerChecksumInfo {\n    10: optional string timerID\n    20: optional i64 (js.type = \"Long\") startedID\n    30: optional i64 (js.type = \"Long\") expiryTimeNanos\n    40: optional i64 (js.type = \"Long\") version\n}\n\nstruct ActivityChecksumInfo {\n    10: optional i64 (js.type = \"Long\") scheduleID\n    20: optional string activityID\n    30: optional string taskList\n    40: optional i32 scheduleToStartTimeout\n    41: optional i32 scheduleToCloseTimeout\n    42: optional i32 startToCloseTimeout\n    43: optional i32 heartbeatTimeout\n    50: optional bool cancelRequested\n    51: optional i64 (js.type = \"Long\") cancelRequestID\n    60: optional i64 (js.type = \"Long\") version\n}\n\nstruct ChildExecutionChecksumInfo {\n    10: optional i64 (js.type = \"Long\") initiatedID\n    20: optional i64 (js.type = \"Long\") startedID\n    21: optional string startedWorkflowID\n    22: optional string startedRunID\n    30: optional string domainID\n    31: optional string workflowTypeName\n    32: optional i32 parentClosePolicy\n    40: optional i64 (js.type = \"Long\") version\n}\n\nstruct RequestCancelChecksumInfo {\n    10: optional i64 (js.type = \"Long\") initiatedID\n    20: optional i64 (js.type = \"Long\") version\n}\n\nstruct SignalChecksumInfo {\n    10: optional i64 (js.type = \"Long\") initiatedID\n    20: optional string signalName\n    30: optional i64 (js.type = \"Long\") version\n}\n\nstruct MutableStateChecksumPayload {\n    10: optional bool cancelRequested\n    15: optional i16 state\n    16: optional i16 closeStatus\n\n    21: optional i64 (js.type = \"Long\") lastWriteVersion\n    22: optional i64 (js.type = \"Long\") lastWriteEventID\n    23: optional i64 (js.type = \"Long\") lastFirstEventID\n    24: optional i64 (js.type = \"Long\") nextEventID\n    25: optional i64 (js.type = \"Long\") lastProcessedEventID\n    26: optional i64 (js.type = \"Long\") signalCount\n\n    35: optional i32 decisionAttempt\n    36: optional i64 (js.type = \"Long\") decisionVersion\n    37: optional i64 (js.type = \"Long\") decisionScheduledID\n    38: optional i64 (js.type = \"Long\") decisionStartedID\n\n    45: optional list<i64> pendingTimerStartedIDs\n    46: optional list<i64> pendingActivityScheduledIDs\n    47: optional list<i64> pendingSignalInitiatedIDs\n    48: optional list<i64> pendingReqCancelInitiatedIDs\n    49: optional list<i64> pendingChildInitiatedIDs\n\n    55: optional string stickyTaskListName\n    56: optional shared.VersionHistories VersionHistories\n\n    // the following fields are only populated by payload version 2 and above\n    60: optional list<TimerChecksumInfo> pendingTimers\n    61: optional list<ActivityChecksumInfo> pendingActivities\n    62: optional list<SignalChecksumInfo> pendingSignals\n    63: optional list<RequestCancelChecksumInfo> pendingRequestCancels\n    64: optional list<ChildExecutionChecksumInfo> pendingChildren\n}\n"
@@ -2348,6 +2348,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventId  *int64        `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
 }
 
 // ToWire translates a ActivityTaskScheduledEventAttributes struct into a Thrift-level intermediate
@@ -2367,7 +2368,7 @@ type ActivityTaskScheduledEventAttributes struct {
 //   }
 func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2469,6 +2470,14 @@ func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2628,6 +2637,16 @@ func (v *ActivityTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 130:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2788,6 +2807,18 @@ func (v *ActivityTaskScheduledEventAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -2919,6 +2950,14 @@ func (v *ActivityTaskScheduledEventAttributes) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 130 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -2948,7 +2987,7 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [13]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -2998,6 +3037,10 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -3058,6 +3101,9 @@ func (v *ActivityTaskScheduledEventAttributes) Equals(rhs *ActivityTaskScheduled
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -3104,6 +3150,9 @@ func (v *ActivityTaskScheduledEventAttributes) MarshalLogObject(enc zapcore.Obje
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -3287,6 +3336,21 @@ func (v *ActivityTaskScheduledEventAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ActivityTaskScheduledEventAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventId   *int64  `json:"scheduledEventId,omitempty"`
 	Identity           *string `json:"identity,omitempty"`
@@ -29679,8 +29743,9 @@ func (v *DescribeTaskListRequest) IsSetIncludeTaskListStatus() bool {
 }
 
 type DescribeTaskListResponse struct {
-	Pollers        []*PollerInfo   `json:"pollers,omitempty"`
-	TaskListStatus *TaskListStatus `json:"taskListStatus,omitempty"`
+	Pollers         []*PollerInfo            `json:"pollers,omitempty"`
+	TaskListStatus  *TaskListStatus          `json:"taskListStatus,omitempty"`
+	PartitionConfig *TaskListPartitionConfig `json:"partitionConfig,omitempty"`
 }
 
 type _List_PollerInfo_ValueList []*PollerInfo
@@ -29729,7 +29794,7 @@ func (_List_PollerInfo_ValueList) Close() {}
 //   }
 func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -29751,6 +29816,14 @@ func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.PartitionConfig != nil {
+		w, err = v.PartitionConfig.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -29785,6 +29858,12 @@ func _TaskListStatus_Read(w wire.Value) (*TaskListStatus, error) {
 	return &v, err
 }
 
+func _TaskListPartitionConfig_Read(w wire.Value) (*TaskListPartitionConfig, error) {
+	var v TaskListPartitionConfig
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a DescribeTaskListResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -29822,6 +29901,14 @@ func (v *DescribeTaskListResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.PartitionConfig, err = _TaskListPartitionConfig_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -29883,6 +29970,18 @@ func (v *DescribeTaskListResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.PartitionConfig != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.PartitionConfig.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -29928,6 +30027,12 @@ func _TaskListStatus_Decode(sr stream.Reader) (*TaskListStatus, error) {
 	return &v, err
 }
 
+func _TaskListPartitionConfig_Decode(sr stream.Reader) (*TaskListPartitionConfig, error) {
+	var v TaskListPartitionConfig
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a DescribeTaskListResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -29958,6 +30063,12 @@ func (v *DescribeTaskListResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.PartitionConfig, err = _TaskListPartitionConfig_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -29987,7 +30098,7 @@ func (v *DescribeTaskListResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Pollers != nil {
 		fields[i] = fmt.Sprintf("Pollers: %v", v.Pollers)
@@ -29997,6 +30108,10 @@ func (v *DescribeTaskListResponse) String() string {
 		fields[i] = fmt.Sprintf("TaskListStatus: %v", v.TaskListStatus)
 		i++
 	}
+	if v.PartitionConfig != nil {
+		fields[i] = fmt.Sprintf("PartitionConfig: %v", v.PartitionConfig)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeTaskListResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -30032,6 +30147,9 @@ func (v *DescribeTaskListResponse) Equals(rhs *DescribeTaskListResponse) bool {
 	if !((v.TaskListStatus == nil && rhs.TaskListStatus == nil) || (v.TaskListStatus != nil && rhs.TaskListStatus != nil && v.TaskListStatus.Equals(rhs.TaskListStatus))) {
 		return false
 	}
+	if !((v.PartitionConfig == nil && rhs.PartitionConfig == nil) || (v.PartitionConfig != nil && rhs.PartitionConfig != nil && v.PartitionConfig.Equals(rhs.PartitionConfig))) {
+		return false
+	}
 
 	return true
 }
@@ -30059,6 +30177,9 @@ func (v *DescribeTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (
 	if v.TaskListStatus != nil {
 		err = multierr.Append(err, enc.AddObject("taskListStatus", v.TaskListStatus))
 	}
+	if v.PartitionConfig != nil {
+		err = multierr.Append(err, enc.AddObject("partitionConfig", v.PartitionConfig))
+	}
 	return err
 }
 
@@ -30092,6 +30213,21 @@ func (v *DescribeTaskListResponse) IsSetTaskListStatus() bool {
 	return v != nil && v.TaskListStatus != nil
 }
 
+// GetPartitionConfig returns the value of PartitionConfig if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListResponse) GetPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
+	}
+
+	return
+}
+
+// IsSetPartitionConfig returns true if PartitionConfig is not nil.
+func (v *DescribeTaskListResponse) IsSetPartitionConfig() bool {
+	return v != nil && v.PartitionConfig != nil
+}
+
 type DescribeWorkflowExecutionRequest struct {
 	Domain    *string            `json:"domain,omitempty"`
 	Execution *WorkflowExecution `json:"execution,omitempty"`
@@ -34519,6 +34655,10 @@ const (
 	EventTypeSignalExternalWorkflowExecutionFailed           EventType = 39
 	EventTypeExternalWorkflowExecutionSignaled               EventType = 40
 	EventTypeUpsertWorkflowSearchAttributes                  EventType = 41
+	EventTypeWorkflowExecutionUpdateRequested                EventType = 42
+	EventTypeWorkflowExecutionUpdateCompleted                EventType = 43
+	EventTypeWorkflowExecutionPaused                         EventType = 44
+	EventTypeWorkflowExecutionUnpaused                       EventType = 45
 )
 
 // EventType_Values returns all recognized values of EventType.
@@ -34566,6 +34706,10 @@ func EventType_Values() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeWorkflowExecutionUpdateRequested,
+		EventTypeWorkflowExecutionUpdateCompleted,
+		EventTypeWorkflowExecutionPaused,
+		EventTypeWorkflowExecutionUnpaused,
 	}
 }
 
@@ -34702,6 +34846,18 @@ func (v *EventType) UnmarshalText(value []byte) error {
 	case "UpsertWorkflowSearchAttributes":
 		*v = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WorkflowExecutionUpdateRequested":
+		*v = EventTypeWorkflowExecutionUpdateRequested
+		return nil
+	case "WorkflowExecutionUpdateCompleted":
+		*v = EventTypeWorkflowExecutionUpdateCompleted
+		return nil
+	case "WorkflowExecutionPaused":
+		*v = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WorkflowExecutionUnpaused":
+		*v = EventTypeWorkflowExecutionUnpaused
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -34804,6 +34960,14 @@ func (v EventType) MarshalText() ([]byte, error) {
 		return []byte("ExternalWorkflowExecutionSignaled"), nil
 	case 41:
 		return []byte("UpsertWorkflowSearchAttributes"), nil
+	case 42:
+		return []byte("WorkflowExecutionUpdateRequested"), nil
+	case 43:
+		return []byte("WorkflowExecutionUpdateCompleted"), nil
+	case 44:
+		return []byte("WorkflowExecutionPaused"), nil
+	case 45:
+		return []byte("WorkflowExecutionUnpaused"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -34899,6 +35063,14 @@ func (v EventType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "ExternalWorkflowExecutionSignaled")
 	case 41:
 		enc.AddString("name", "UpsertWorkflowSearchAttributes")
+	case 42:
+		enc.AddString("name", "WorkflowExecutionUpdateRequested")
+	case 43:
+		enc.AddString("name", "WorkflowExecutionUpdateCompleted")
+	case 44:
+		enc.AddString("name", "WorkflowExecutionPaused")
+	case 45:
+		enc.AddString("name", "WorkflowExecutionUnpaused")
 	}
 	return nil
 }
@@ -35051,6 +35223,14 @@ func (v EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionUpdateRequested"
+	case 43:
+		return "WorkflowExecutionUpdateCompleted"
+	case 44:
+		return "WorkflowExecutionPaused"
+	case 45:
+		return "WorkflowExecutionUnpaused"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -35153,6 +35333,14 @@ func (v EventType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"ExternalWorkflowExecutionSignaled\""), nil
 	case 41:
 		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
+	case 42:
+		return ([]byte)("\"WorkflowExecutionUpdateRequested\""), nil
+	case 43:
+		return ([]byte)("\"WorkflowExecutionUpdateCompleted\""), nil
+	case 44:
+		return ([]byte)("\"WorkflowExecutionPaused\""), nil
+	case 45:
+		return ([]byte)("\"WorkflowExecutionUnpaused\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -42044,6 +42232,10 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionUpdateRequestedEventAttributes                *WorkflowExecutionUpdateRequestedEventAttributes                `json:"workflowExecutionUpdateRequestedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateCompletedEventAttributes                *WorkflowExecutionUpdateCompletedEventAttributes                `json:"workflowExecutionUpdateCompletedEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionUnpausedEventAttributes                       *WorkflowExecutionUnpausedEventAttributes                       `json:"workflowExecutionUnpausedEventAttributes,omitempty"`
 }
 
 // ToWire translates a HistoryEvent struct into a Thrift-level intermediate
@@ -42063,7 +42255,7 @@ type HistoryEvent struct {
 //   }
 func (v *HistoryEvent) ToWire() (wire.Value, error) {
 	var (
-		fields [47]wire.Field
+		fields [51]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -42445,6 +42637,38 @@ func (v *HistoryEvent) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 450, Value: w}
 		i++
 	}
+	if v.WorkflowExecutionUpdateRequestedEventAttributes != nil {
+		w, err = v.WorkflowExecutionUpdateRequestedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 460, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		w, err = v.WorkflowExecutionUpdateCompletedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 470, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		w, err = v.WorkflowExecutionPausedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 480, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionUnpausedEventAttributes != nil {
+		w, err = v.WorkflowExecutionUnpausedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 490, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -42701,6 +42925,30 @@ func _UpsertWorkflowSearchAttributesEventAttributes_Read(w wire.Value) (*UpsertW
 	return &v, err
 }
 
+func _WorkflowExecutionUpdateRequestedEventAttributes_Read(w wire.Value) (*WorkflowExecutionUpdateRequestedEventAttributes, error) {
+	var v WorkflowExecutionUpdateRequestedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionUpdateCompletedEventAttributes_Read(w wire.Value) (*WorkflowExecutionUpdateCompletedEventAttributes, error) {
+	var v WorkflowExecutionUpdateCompletedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionPausedEventAttributes_Read(w wire.Value) (*WorkflowExecutionPausedEventAttributes, error) {
+	var v WorkflowExecutionPausedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionUnpausedEventAttributes_Read(w wire.Value) (*WorkflowExecutionUnpausedEventAttributes, error) {
+	var v WorkflowExecutionUnpausedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a HistoryEvent struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -43108,6 +43356,38 @@ func (v *HistoryEvent) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 460:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionUpdateRequestedEventAttributes, err = _WorkflowExecutionUpdateRequestedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 470:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionUpdateCompletedEventAttributes, err = _WorkflowExecutionUpdateCompletedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 480:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionPausedEventAttributes, err = _WorkflowExecutionPausedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 490:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionUnpausedEventAttributes, err = _WorkflowExecutionUnpausedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -43688,6 +43968,54 @@ func (v *HistoryEvent) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.WorkflowExecutionUpdateRequestedEventAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 460, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionUpdateRequestedEventAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 470, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionUpdateCompletedEventAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 480, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionPausedEventAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionUnpausedEventAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 490, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionUnpausedEventAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -43943,6 +44271,30 @@ func _UpsertWorkflowSearchAttributesEventAttributes_Decode(sr stream.Reader) (*U
 	return &v, err
 }
 
+func _WorkflowExecutionUpdateRequestedEventAttributes_Decode(sr stream.Reader) (*WorkflowExecutionUpdateRequestedEventAttributes, error) {
+	var v WorkflowExecutionUpdateRequestedEventAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowExecutionUpdateCompletedEventAttributes_Decode(sr stream.Reader) (*WorkflowExecutionUpdateCompletedEventAttributes, error) {
+	var v WorkflowExecutionUpdateCompletedEventAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowExecutionPausedEventAttributes_Decode(sr stream.Reader) (*WorkflowExecutionPausedEventAttributes, error) {
+	var v WorkflowExecutionPausedEventAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowExecutionUnpausedEventAttributes_Decode(sr stream.Reader) (*WorkflowExecutionUnpausedEventAttributes, error) {
+	var v WorkflowExecutionUnpausedEventAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a HistoryEvent struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -44253,6 +44605,30 @@ func (v *HistoryEvent) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 460 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionUpdateRequestedEventAttributes, err = _WorkflowExecutionUpdateRequestedEventAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 470 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionUpdateCompletedEventAttributes, err = _WorkflowExecutionUpdateCompletedEventAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 480 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionPausedEventAttributes, err = _WorkflowExecutionPausedEventAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 490 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionUnpausedEventAttributes, err = _WorkflowExecutionUnpausedEventAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -44282,7 +44658,7 @@ func (v *HistoryEvent) String() string {
 		return "<nil>"
 	}
 
-	var fields [47]string
+	var fields [51]string
 	i := 0
 	if v.EventId != nil {
 		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
@@ -44472,6 +44848,22 @@ func (v *HistoryEvent) String() string {
 		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes: %v", v.UpsertWorkflowSearchAttributesEventAttributes)
 		i++
 	}
+	if v.WorkflowExecutionUpdateRequestedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionUpdateRequestedEventAttributes: %v", v.WorkflowExecutionUpdateRequestedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionUpdateCompletedEventAttributes: %v", v.WorkflowExecutionUpdateCompletedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionPausedEventAttributes: %v", v.WorkflowExecutionPausedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionUnpausedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionUnpausedEventAttributes: %v", v.WorkflowExecutionUnpausedEventAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
 }
@@ -44637,6 +45029,18 @@ func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
 	if !((v.UpsertWorkflowSearchAttributesEventAttributes == nil && rhs.UpsertWorkflowSearchAttributesEventAttributes == nil) || (v.UpsertWorkflowSearchAttributesEventAttributes != nil && rhs.UpsertWorkflowSearchAttributesEventAttributes != nil && v.UpsertWorkflowSearchAttributesEventAttributes.Equals(rhs.UpsertWorkflowSearchAttributesEventAttributes))) {
 		return false
 	}
+	if !((v.WorkflowExecutionUpdateRequestedEventAttributes == nil && rhs.WorkflowExecutionUpdateRequestedEventAttributes == nil) || (v.WorkflowExecutionUpdateRequestedEventAttributes != nil && rhs.WorkflowExecutionUpdateRequestedEventAttributes != nil && v.WorkflowExecutionUpdateRequestedEventAttributes.Equals(rhs.WorkflowExecutionUpdateRequestedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionUpdateCompletedEventAttributes == nil && rhs.WorkflowExecutionUpdateCompletedEventAttributes == nil) || (v.WorkflowExecutionUpdateCompletedEventAttributes != nil && rhs.WorkflowExecutionUpdateCompletedEventAttributes != nil && v.WorkflowExecutionUpdateCompletedEventAttributes.Equals(rhs.WorkflowExecutionUpdateCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionPausedEventAttributes == nil && rhs.WorkflowExecutionPausedEventAttributes == nil) || (v.WorkflowExecutionPausedEventAttributes != nil && rhs.WorkflowExecutionPausedEventAttributes != nil && v.WorkflowExecutionPausedEventAttributes.Equals(rhs.WorkflowExecutionPausedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionUnpausedEventAttributes == nil && rhs.WorkflowExecutionUnpausedEventAttributes == nil) || (v.WorkflowExecutionUnpausedEventAttributes != nil && rhs.WorkflowExecutionUnpausedEventAttributes != nil && v.WorkflowExecutionUnpausedEventAttributes.Equals(rhs.WorkflowExecutionUnpausedEventAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -44788,6 +45192,18 @@ func (v *HistoryEvent) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("upsertWorkflowSearchAttributesEventAttributes", v.UpsertWorkflowSearchAttributesEventAttributes))
 	}
+	if v.WorkflowExecutionUpdateRequestedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionUpdateRequestedEventAttributes", v.WorkflowExecutionUpdateRequestedEventAttributes))
+	}
+	if v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionUpdateCompletedEventAttributes", v.WorkflowExecutionUpdateCompletedEventAttributes))
+	}
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionPausedEventAttributes", v.WorkflowExecutionPausedEventAttributes))
+	}
+	if v.WorkflowExecutionUnpausedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionUnpausedEventAttributes", v.WorkflowExecutionUnpausedEventAttributes))
+	}
 	return err
 }
 
@@ -45496,6 +45912,66 @@ func (v *HistoryEvent) IsSetUpsertWorkflowSearchAttributesEventAttributes() bool
 	return v != nil && v.UpsertWorkflowSearchAttributesEventAttributes != nil
 }
 
+// GetWorkflowExecutionUpdateRequestedEventAttributes returns the value of WorkflowExecutionUpdateRequestedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionUpdateRequestedEventAttributes() (o *WorkflowExecutionUpdateRequestedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateRequestedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateRequestedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionUpdateRequestedEventAttributes returns true if WorkflowExecutionUpdateRequestedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionUpdateRequestedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionUpdateRequestedEventAttributes != nil
+}
+
+// GetWorkflowExecutionUpdateCompletedEventAttributes returns the value of WorkflowExecutionUpdateCompletedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionUpdateCompletedEventAttributes() (o *WorkflowExecutionUpdateCompletedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateCompletedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionUpdateCompletedEventAttributes returns true if WorkflowExecutionUpdateCompletedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionUpdateCompletedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionUpdateCompletedEventAttributes != nil
+}
+
+// GetWorkflowExecutionPausedEventAttributes returns the value of WorkflowExecutionPausedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionPausedEventAttributes returns true if WorkflowExecutionPausedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionPausedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionPausedEventAttributes != nil
+}
+
+// GetWorkflowExecutionUnpausedEventAttributes returns the value of WorkflowExecutionUnpausedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() (o *WorkflowExecutionUnpausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil {
+		return v.WorkflowExecutionUnpausedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionUnpausedEventAttributes returns true if WorkflowExecutionUnpausedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionUnpausedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil
+}
+
 type HistoryEventFilterType int32
 
 const (
@@ -76128,6 +76604,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          *bool         `json:"requestLocalDispatch,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
 }
 
 // ToWire translates a ScheduleActivityTaskDecisionAttributes struct into a Thrift-level intermediate
@@ -76147,7 +76624,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 //   }
 func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -76249,6 +76726,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -76384,6 +76869,16 @@ func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -76544,6 +77039,18 @@ func (v *ScheduleActivityTaskDecisionAttributes) Encode(sw stream.Writer) error
 		}
 	}
 
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -76651,6 +77158,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) Decode(sr stream.Reader) error
 				return err
 			}
 
+		case fh.ID == 100 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -76680,7 +77195,7 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [13]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -76730,6 +77245,10 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("RequestLocalDispatch: %v", *(v.RequestLocalDispatch))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("ScheduleActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -76780,6 +77299,9 @@ func (v *ScheduleActivityTaskDecisionAttributes) Equals(rhs *ScheduleActivityTas
 	if !_Bool_EqualsPtr(v.RequestLocalDispatch, rhs.RequestLocalDispatch) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -76826,6 +77348,9 @@ func (v *ScheduleActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.Ob
 	if v.RequestLocalDispatch != nil {
 		enc.AddBool("requestLocalDispatch", *v.RequestLocalDispatch)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -77009,6 +77534,21 @@ func (v *ScheduleActivityTaskDecisionAttributes) IsSetRequestLocalDispatch() boo
 	return v != nil && v.RequestLocalDispatch != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ScheduleActivityTaskDecisionAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
 }
@@ -79259,27 +79799,388 @@ func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) IsSetChildWork
 	return v != nil && v.ChildWorkflowOnly != nil
 }
 
+type SignalWithStartWorkflowExecutionAsyncRequest struct {
+	Request *SignalWithStartWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a SignalWithStartWorkflowExecutionAsyncRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _SignalWithStartWorkflowExecutionRequest_Read(w wire.Value) (*SignalWithStartWorkflowExecutionRequest, error) {
+	var v SignalWithStartWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a SignalWithStartWorkflowExecutionAsyncRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SignalWithStartWorkflowExecutionAsyncRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SignalWithStartWorkflowExecutionAsyncRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _SignalWithStartWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a SignalWithStartWorkflowExecutionAsyncRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a SignalWithStartWorkflowExecutionAsyncRequest struct could not be encoded.
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _SignalWithStartWorkflowExecutionRequest_Decode(sr stream.Reader) (*SignalWithStartWorkflowExecutionRequest, error) {
+	var v SignalWithStartWorkflowExecutionRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a SignalWithStartWorkflowExecutionAsyncRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a SignalWithStartWorkflowExecutionAsyncRequest struct could not be generated from the wire
+// representation.
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.Request, err = _SignalWithStartWorkflowExecutionRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SignalWithStartWorkflowExecutionAsyncRequest
+// struct.
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("SignalWithStartWorkflowExecutionAsyncRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SignalWithStartWorkflowExecutionAsyncRequest match the
+// provided SignalWithStartWorkflowExecutionAsyncRequest.
+//
+// This function performs a deep comparison.
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) Equals(rhs *SignalWithStartWorkflowExecutionAsyncRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SignalWithStartWorkflowExecutionAsyncRequest.
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) GetRequest() (o *SignalWithStartWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+type SignalWithStartWorkflowExecutionAsyncResponse struct {
+}
+
+// ToWire translates a SignalWithStartWorkflowExecutionAsyncResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SignalWithStartWorkflowExecutionAsyncResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SignalWithStartWorkflowExecutionAsyncResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SignalWithStartWorkflowExecutionAsyncResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SignalWithStartWorkflowExecutionAsyncResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SignalWithStartWorkflowExecutionAsyncResponse) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a SignalWithStartWorkflowExecutionAsyncResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a SignalWithStartWorkflowExecutionAsyncResponse struct could not be encoded.
+func (v *SignalWithStartWorkflowExecutionAsyncResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a SignalWithStartWorkflowExecutionAsyncResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a SignalWithStartWorkflowExecutionAsyncResponse struct could not be generated from the wire
+// representation.
+func (v *SignalWithStartWorkflowExecutionAsyncResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SignalWithStartWorkflowExecutionAsyncResponse
+// struct.
+func (v *SignalWithStartWorkflowExecutionAsyncResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("SignalWithStartWorkflowExecutionAsyncResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SignalWithStartWorkflowExecutionAsyncResponse match the
+// provided SignalWithStartWorkflowExecutionAsyncResponse.
+//
+// This function performs a deep comparison.
+func (v *SignalWithStartWorkflowExecutionAsyncResponse) Equals(rhs *SignalWithStartWorkflowExecutionAsyncResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SignalWithStartWorkflowExecutionAsyncResponse.
+func (v *SignalWithStartWorkflowExecutionAsyncResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
 type SignalWithStartWorkflowExecutionRequest struct {
-	Domain                              *string                `json:"domain,omitempty"`
-	WorkflowId                          *string                `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            *string                `json:"identity,omitempty"`
-	RequestId                           *string                `json:"requestId,omitempty"`
-	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	SignalName                          *string                `json:"signalName,omitempty"`
-	SignalInput                         []byte                 `json:"signalInput,omitempty"`
-	Control                             []byte                 `json:"control,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
-	JitterStartSeconds                  *int32                 `json:"jitterStartSeconds,omitempty"`
+	Domain                              *string                   `json:"domain,omitempty"`
+	WorkflowId                          *string                   `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            *string                   `json:"identity,omitempty"`
+	RequestId                           *string                   `json:"requestId,omitempty"`
+	WorkflowIdReusePolicy               *WorkflowIdReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	SignalName                          *string                   `json:"signalName,omitempty"`
+	SignalInput                         []byte                    `json:"signalInput,omitempty"`
+	Control                             []byte                    `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        *string                   `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	JitterStartSeconds                  *int32                    `json:"jitterStartSeconds,omitempty"`
+	WorkflowIdConflictPolicy            *WorkflowIdConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -79299,7 +80200,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [20]wire.Field
+		fields [21]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -79465,6 +80366,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 190, Value: w}
 		i++
 	}
+	if v.WorkflowIdConflictPolicy != nil {
+		w, err = v.WorkflowIdConflictPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 200, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -79475,6 +80384,12 @@ func _WorkflowIdReusePolicy_Read(w wire.Value) (WorkflowIdReusePolicy, error) {
 	return v, err
 }
 
+func _WorkflowIdConflictPolicy_Read(w wire.Value) (WorkflowIdConflictPolicy, error) {
+	var v WorkflowIdConflictPolicy
+	err := v.FromWire(w)
+	return v, err
+}
+
 // FromWire deserializes a SignalWithStartWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -79678,6 +80593,16 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 200:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowIdConflictPolicy
+				x, err = _WorkflowIdConflictPolicy_Read(field.Value)
+				v.WorkflowIdConflictPolicy = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -79934,6 +80859,18 @@ func (v *SignalWithStartWorkflowExecutionRequest) Encode(sw stream.Writer) error
 		}
 	}
 
+	if v.WorkflowIdConflictPolicy != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 200, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.WorkflowIdConflictPolicy.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -79943,6 +80880,12 @@ func _WorkflowIdReusePolicy_Decode(sr stream.Reader) (WorkflowIdReusePolicy, err
 	return v, err
 }
 
+func _WorkflowIdConflictPolicy_Decode(sr stream.Reader) (WorkflowIdConflictPolicy, error) {
+	var v WorkflowIdConflictPolicy
+	err := v.Decode(sr)
+	return v, err
+}
+
 // Decode deserializes a SignalWithStartWorkflowExecutionRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -80103,6 +81046,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) Decode(sr stream.Reader) error
 				return err
 			}
 
+		case fh.ID == 200 && fh.Type == wire.TI32:
+			var x WorkflowIdConflictPolicy
+			x, err = _WorkflowIdConflictPolicy_Decode(sr)
+			v.WorkflowIdConflictPolicy = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -80132,7 +81083,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [20]string
+	var fields [21]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -80214,6 +81165,10 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("JitterStartSeconds: %v", *(v.JitterStartSeconds))
 		i++
 	}
+	if v.WorkflowIdConflictPolicy != nil {
+		fields[i] = fmt.Sprintf("WorkflowIdConflictPolicy: %v", *(v.WorkflowIdConflictPolicy))
+		i++
+	}
 
 	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -80228,6 +81183,16 @@ func _WorkflowIdReusePolicy_EqualsPtr(lhs, rhs *WorkflowIdReusePolicy) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _WorkflowIdConflictPolicy_EqualsPtr(lhs, rhs *WorkflowIdConflictPolicy) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this SignalWithStartWorkflowExecutionRequest match the
 // provided SignalWithStartWorkflowExecutionRequest.
 //
@@ -80298,6 +81263,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !_I32_EqualsPtr(v.JitterStartSeconds, rhs.JitterStartSeconds) {
 		return false
 	}
+	if !_WorkflowIdConflictPolicy_EqualsPtr(v.WorkflowIdConflictPolicy, rhs.WorkflowIdConflictPolicy) {
+		return false
+	}
 
 	return true
 }
@@ -80368,6 +81336,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.O
 	if v.JitterStartSeconds != nil {
 		enc.AddInt32("jitterStartSeconds", *v.JitterStartSeconds)
 	}
+	if v.WorkflowIdConflictPolicy != nil {
+		err = multierr.Append(err, enc.AddObject("workflowIdConflictPolicy", *v.WorkflowIdConflictPolicy))
+	}
 	return err
 }
 
@@ -80671,6 +81642,21 @@ func (v *SignalWithStartWorkflowExecutionRequest) IsSetJitterStartSeconds() bool
 	return v != nil && v.JitterStartSeconds != nil
 }
 
+// GetWorkflowIdConflictPolicy returns the value of WorkflowIdConflictPolicy if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() (o WorkflowIdConflictPolicy) {
+	if v != nil && v.WorkflowIdConflictPolicy != nil {
+		return *v.WorkflowIdConflictPolicy
+	}
+
+	return
+}
+
+// IsSetWorkflowIdConflictPolicy returns true if WorkflowIdConflictPolicy is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetWorkflowIdConflictPolicy() bool {
+	return v != nil && v.WorkflowIdConflictPolicy != nil
+}
+
 type SignalWorkflowExecutionRequest struct {
 	Domain            *string            `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -84749,24 +85735,386 @@ func (v *StartTimerDecisionAttributes) IsSetStartToFireTimeoutSeconds() bool {
 	return v != nil && v.StartToFireTimeoutSeconds != nil
 }
 
+type StartWorkflowExecutionAsyncRequest struct {
+	Request *StartWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a StartWorkflowExecutionAsyncRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StartWorkflowExecutionAsyncRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartWorkflowExecutionRequest_Read(w wire.Value) (*StartWorkflowExecutionRequest, error) {
+	var v StartWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a StartWorkflowExecutionAsyncRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StartWorkflowExecutionAsyncRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StartWorkflowExecutionAsyncRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StartWorkflowExecutionAsyncRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _StartWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a StartWorkflowExecutionAsyncRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a StartWorkflowExecutionAsyncRequest struct could not be encoded.
+func (v *StartWorkflowExecutionAsyncRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _StartWorkflowExecutionRequest_Decode(sr stream.Reader) (*StartWorkflowExecutionRequest, error) {
+	var v StartWorkflowExecutionRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a StartWorkflowExecutionAsyncRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a StartWorkflowExecutionAsyncRequest struct could not be generated from the wire
+// representation.
+func (v *StartWorkflowExecutionAsyncRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.Request, err = _StartWorkflowExecutionRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StartWorkflowExecutionAsyncRequest
+// struct.
+func (v *StartWorkflowExecutionAsyncRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("StartWorkflowExecutionAsyncRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionAsyncRequest match the
+// provided StartWorkflowExecutionAsyncRequest.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionAsyncRequest) Equals(rhs *StartWorkflowExecutionAsyncRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StartWorkflowExecutionAsyncRequest.
+func (v *StartWorkflowExecutionAsyncRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionAsyncRequest) GetRequest() (o *StartWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *StartWorkflowExecutionAsyncRequest) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+type StartWorkflowExecutionAsyncResponse struct {
+}
+
+// ToWire translates a StartWorkflowExecutionAsyncResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StartWorkflowExecutionAsyncResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a StartWorkflowExecutionAsyncResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StartWorkflowExecutionAsyncResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StartWorkflowExecutionAsyncResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StartWorkflowExecutionAsyncResponse) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a StartWorkflowExecutionAsyncResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a StartWorkflowExecutionAsyncResponse struct could not be encoded.
+func (v *StartWorkflowExecutionAsyncResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a StartWorkflowExecutionAsyncResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a StartWorkflowExecutionAsyncResponse struct could not be generated from the wire
+// representation.
+func (v *StartWorkflowExecutionAsyncResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StartWorkflowExecutionAsyncResponse
+// struct.
+func (v *StartWorkflowExecutionAsyncResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("StartWorkflowExecutionAsyncResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionAsyncResponse match the
+// provided StartWorkflowExecutionAsyncResponse.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionAsyncResponse) Equals(rhs *StartWorkflowExecutionAsyncResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StartWorkflowExecutionAsyncResponse.
+func (v *StartWorkflowExecutionAsyncResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
 type StartWorkflowExecutionRequest struct {
-	Domain                              *string                `json:"domain,omitempty"`
-	WorkflowId                          *string                `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            *string                `json:"identity,omitempty"`
-	RequestId                           *string                `json:"requestId,omitempty"`
-	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
-	JitterStartSeconds                  *int32                 `json:"jitterStartSeconds,omitempty"`
+	Domain                              *string                   `json:"domain,omitempty"`
+	WorkflowId                          *string                   `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            *string                   `json:"identity,omitempty"`
+	RequestId                           *string                   `json:"requestId,omitempty"`
+	WorkflowIdReusePolicy               *WorkflowIdReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        *string                   `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	JitterStartSeconds                  *int32                    `json:"jitterStartSeconds,omitempty"`
+	WorkflowIdConflictPolicy            *WorkflowIdConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
+	Priority                            *int32                    `json:"priority,omitempty"`
 }
 
 // ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -84786,7 +86134,7 @@ type StartWorkflowExecutionRequest struct {
 //   }
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [17]wire.Field
+		fields [19]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -84928,6 +86276,22 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 170, Value: w}
 		i++
 	}
+	if v.WorkflowIdConflictPolicy != nil {
+		w, err = v.WorkflowIdConflictPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -85109,6 +86473,26 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 180:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowIdConflictPolicy
+				x, err = _WorkflowIdConflictPolicy_Read(field.Value)
+				v.WorkflowIdConflictPolicy = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 190:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -85329,6 +86713,30 @@ func (v *StartWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.WorkflowIdConflictPolicy != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 180, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.WorkflowIdConflictPolicy.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 190, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -85472,6 +86880,22 @@ func (v *StartWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 180 && fh.Type == wire.TI32:
+			var x WorkflowIdConflictPolicy
+			x, err = _WorkflowIdConflictPolicy_Decode(sr)
+			v.WorkflowIdConflictPolicy = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 190 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -85501,7 +86925,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [17]string
+	var fields [19]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -85571,6 +86995,14 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("JitterStartSeconds: %v", *(v.JitterStartSeconds))
 		i++
 	}
+	if v.WorkflowIdConflictPolicy != nil {
+		fields[i] = fmt.Sprintf("WorkflowIdConflictPolicy: %v", *(v.WorkflowIdConflictPolicy))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -85636,6 +87068,12 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !_I32_EqualsPtr(v.JitterStartSeconds, rhs.JitterStartSeconds) {
 		return false
 	}
+	if !_WorkflowIdConflictPolicy_EqualsPtr(v.WorkflowIdConflictPolicy, rhs.WorkflowIdConflictPolicy) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -85697,6 +87135,12 @@ func (v *StartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncod
 	if v.JitterStartSeconds != nil {
 		enc.AddInt32("jitterStartSeconds", *v.JitterStartSeconds)
 	}
+	if v.WorkflowIdConflictPolicy != nil {
+		err = multierr.Append(err, enc.AddObject("workflowIdConflictPolicy", *v.WorkflowIdConflictPolicy))
+	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -85955,6 +87399,36 @@ func (v *StartWorkflowExecutionRequest) IsSetJitterStartSeconds() bool {
 	return v != nil && v.JitterStartSeconds != nil
 }
 
+// GetWorkflowIdConflictPolicy returns the value of WorkflowIdConflictPolicy if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() (o WorkflowIdConflictPolicy) {
+	if v != nil && v.WorkflowIdConflictPolicy != nil {
+		return *v.WorkflowIdConflictPolicy
+	}
+
+	return
+}
+
+// IsSetWorkflowIdConflictPolicy returns true if WorkflowIdConflictPolicy is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetWorkflowIdConflictPolicy() bool {
+	return v != nil && v.WorkflowIdConflictPolicy != nil
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId *string `json:"runId,omitempty"`
 }
@@ -87902,6 +89376,344 @@ func (v *TaskListMetadata) IsSetMaxTasksPerSecond() bool {
 	return v != nil && v.MaxTasksPerSecond != nil
 }
 
+type TaskListPartitionConfig struct {
+	Version            *int64 `json:"version,omitempty"`
+	NumReadPartitions  *int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions *int32 `json:"numWritePartitions,omitempty"`
+}
+
+// ToWire translates a TaskListPartitionConfig struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TaskListPartitionConfig) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NumReadPartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumReadPartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.NumWritePartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumWritePartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskListPartitionConfig struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskListPartitionConfig struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TaskListPartitionConfig
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TaskListPartitionConfig) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumReadPartitions = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumWritePartitions = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a TaskListPartitionConfig struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TaskListPartitionConfig struct could not be encoded.
+func (v *TaskListPartitionConfig) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NumReadPartitions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.NumReadPartitions)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NumWritePartitions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.NumWritePartitions)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a TaskListPartitionConfig struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TaskListPartitionConfig struct could not be generated from the wire
+// representation.
+func (v *TaskListPartitionConfig) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.NumReadPartitions = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.NumWritePartitions = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskListPartitionConfig
+// struct.
+func (v *TaskListPartitionConfig) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.NumReadPartitions != nil {
+		fields[i] = fmt.Sprintf("NumReadPartitions: %v", *(v.NumReadPartitions))
+		i++
+	}
+	if v.NumWritePartitions != nil {
+		fields[i] = fmt.Sprintf("NumWritePartitions: %v", *(v.NumWritePartitions))
+		i++
+	}
+
+	return fmt.Sprintf("TaskListPartitionConfig{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TaskListPartitionConfig match the
+// provided TaskListPartitionConfig.
+//
+// This function performs a deep comparison.
+func (v *TaskListPartitionConfig) Equals(rhs *TaskListPartitionConfig) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumReadPartitions, rhs.NumReadPartitions) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumWritePartitions, rhs.NumWritePartitions) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskListPartitionConfig.
+func (v *TaskListPartitionConfig) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.NumReadPartitions != nil {
+		enc.AddInt32("numReadPartitions", *v.NumReadPartitions)
+	}
+	if v.NumWritePartitions != nil {
+		enc.AddInt32("numWritePartitions", *v.NumWritePartitions)
+	}
+	return err
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *TaskListPartitionConfig) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetNumReadPartitions returns the value of NumReadPartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetNumReadPartitions() (o int32) {
+	if v != nil && v.NumReadPartitions != nil {
+		return *v.NumReadPartitions
+	}
+
+	return
+}
+
+// IsSetNumReadPartitions returns true if NumReadPartitions is not nil.
+func (v *TaskListPartitionConfig) IsSetNumReadPartitions() bool {
+	return v != nil && v.NumReadPartitions != nil
+}
+
+// GetNumWritePartitions returns the value of NumWritePartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetNumWritePartitions() (o int32) {
+	if v != nil && v.NumWritePartitions != nil {
+		return *v.NumWritePartitions
+	}
+
+	return
+}
+
+// IsSetNumWritePartitions returns true if NumWritePartitions is not nil.
+func (v *TaskListPartitionConfig) IsSetNumWritePartitions() bool {
+	return v != nil && v.NumWritePartitions != nil
+}
+
 type TaskListPartitionMetadata struct {
 	Key           *string `json:"key,omitempty"`
 	OwnerHostName *string `json:"ownerHostName,omitempty"`
@@ -88177,13 +89989,49 @@ func (v *TaskListPartitionMetadata) IsSetOwnerHostName() bool {
 }
 
 type TaskListStatus struct {
-	BacklogCountHint *int64       `json:"backlogCountHint,omitempty"`
-	ReadLevel        *int64       `json:"readLevel,omitempty"`
-	AckLevel         *int64       `json:"ackLevel,omitempty"`
-	RatePerSecond    *float64     `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint           *int64          `json:"backlogCountHint,omitempty"`
+	ReadLevel                  *int64          `json:"readLevel,omitempty"`
+	AckLevel                   *int64          `json:"ackLevel,omitempty"`
+	RatePerSecond              *float64        `json:"ratePerSecond,omitempty"`
+	TaskIDBlock                *TaskIDBlock    `json:"taskIDBlock,omitempty"`
+	BacklogCountHintByPriority map[int32]int64 `json:"backlogCountHintByPriority,omitempty"`
 }
 
+type _Map_I32_I64_MapItemList map[int32]int64
+
+func (m _Map_I32_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_I64_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_I32_I64_MapItemList) Close() {}
+
 // ToWire translates a TaskListStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -88201,7 +90049,7 @@ type TaskListStatus struct {
 //   }
 func (v *TaskListStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -88247,6 +90095,14 @@ func (v *TaskListStatus) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.BacklogCountHintByPriority != nil {
+		w, err = wire.NewValueMap(_Map_I32_I64_MapItemList(v.BacklogCountHintByPriority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -88257,6 +90113,34 @@ func _TaskIDBlock_Read(w wire.Value) (*TaskIDBlock, error) {
 	return &v, err
 }
 
+func _Map_I32_I64_Read(m wire.MapItemList) (map[int32]int64, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[int32]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a TaskListStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -88326,6 +90210,14 @@ func (v *TaskListStatus) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TMap {
+				v.BacklogCountHintByPriority, err = _Map_I32_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -88333,6 +90225,29 @@ func (v *TaskListStatus) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _Map_I32_I64_Encode(val map[int32]int64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteInt32(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
 // Encode serializes a TaskListStatus struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -88402,6 +90317,18 @@ func (v *TaskListStatus) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.BacklogCountHintByPriority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_I32_I64_Encode(v.BacklogCountHintByPriority, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -88411,6 +90338,46 @@ func _TaskIDBlock_Decode(sr stream.Reader) (*TaskIDBlock, error) {
 	return &v, err
 }
 
+func _Map_I32_I64_Decode(sr stream.Reader) (map[int32]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[int32]int64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a TaskListStatus struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -88467,6 +90434,12 @@ func (v *TaskListStatus) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 50 && fh.Type == wire.TMap:
+			v.BacklogCountHintByPriority, err = _Map_I32_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -88496,7 +90469,7 @@ func (v *TaskListStatus) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.BacklogCountHint != nil {
 		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
@@ -88518,10 +90491,31 @@ func (v *TaskListStatus) String() string {
 		fields[i] = fmt.Sprintf("TaskIDBlock: %v", v.TaskIDBlock)
 		i++
 	}
+	if v.BacklogCountHintByPriority != nil {
+		fields[i] = fmt.Sprintf("BacklogCountHintByPriority: %v", v.BacklogCountHintByPriority)
+		i++
+	}
 
 	return fmt.Sprintf("TaskListStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _Map_I32_I64_Equals(lhs, rhs map[int32]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this TaskListStatus match the
 // provided TaskListStatus.
 //
@@ -88547,10 +90541,37 @@ func (v *TaskListStatus) Equals(rhs *TaskListStatus) bool {
 	if !((v.TaskIDBlock == nil && rhs.TaskIDBlock == nil) || (v.TaskIDBlock != nil && rhs.TaskIDBlock != nil && v.TaskIDBlock.Equals(rhs.TaskIDBlock))) {
 		return false
 	}
+	if !((v.BacklogCountHintByPriority == nil && rhs.BacklogCountHintByPriority == nil) || (v.BacklogCountHintByPriority != nil && rhs.BacklogCountHintByPriority != nil && _Map_I32_I64_Equals(v.BacklogCountHintByPriority, rhs.BacklogCountHintByPriority))) {
+		return false
+	}
 
 	return true
 }
 
+type _Map_I32_I64_Item_Zapper struct {
+	Key   int32
+	Value int64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_I64_Item_Zapper.
+func (v _Map_I32_I64_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	enc.AddInt64("value", v.Value)
+	return err
+}
+
+type _Map_I32_I64_Zapper map[int32]int64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_I64_Zapper.
+func (m _Map_I32_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_I64_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of TaskListStatus.
 func (v *TaskListStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -88572,6 +90593,9 @@ func (v *TaskListStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	if v.TaskIDBlock != nil {
 		err = multierr.Append(err, enc.AddObject("taskIDBlock", v.TaskIDBlock))
 	}
+	if v.BacklogCountHintByPriority != nil {
+		err = multierr.Append(err, enc.AddArray("backlogCountHintByPriority", (_Map_I32_I64_Zapper)(v.BacklogCountHintByPriority)))
+	}
 	return err
 }
 
@@ -88650,6 +90674,21 @@ func (v *TaskListStatus) IsSetTaskIDBlock() bool {
 	return v != nil && v.TaskIDBlock != nil
 }
 
+// GetBacklogCountHintByPriority returns the value of BacklogCountHintByPriority if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetBacklogCountHintByPriority() (o map[int32]int64) {
+	if v != nil && v.BacklogCountHintByPriority != nil {
+		return v.BacklogCountHintByPriority
+	}
+
+	return
+}
+
+// IsSetBacklogCountHintByPriority returns true if BacklogCountHintByPriority is not nil.
+func (v *TaskListStatus) IsSetBacklogCountHintByPriority() bool {
+	return v != nil && v.BacklogCountHintByPriority != nil
+}
+
 type TaskListType int32
 
 const (
@@ -92258,11 +94297,17 @@ func (v *UpdateDomainResponse) IsSetIsGlobalDomain() bool {
 	return v != nil && v.IsGlobalDomain != nil
 }
 
-type UpsertWorkflowSearchAttributesDecisionAttributes struct {
-	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
+type UpdateWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	UpdateID          *string            `json:"updateID,omitempty"`
+	UpdateName        *string            `json:"updateName,omitempty"`
+	Input             []byte             `json:"input,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
 }
 
-// ToWire translates a UpsertWorkflowSearchAttributesDecisionAttributes struct into a Thrift-level intermediate
+// ToWire translates a UpdateWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -92277,31 +94322,79 @@ type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *UpdateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.SearchAttributes != nil {
-		w, err = v.SearchAttributes.ToWire()
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.UpdateID != nil {
+		w, err = wire.NewValueString(*(v.UpdateID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.UpdateName != nil {
+		w, err = wire.NewValueString(*(v.UpdateName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a UpsertWorkflowSearchAttributesDecisionAttributes struct from its Thrift-level
+// FromWire deserializes a UpdateWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpsertWorkflowSearchAttributesDecisionAttributes struct
+// An error is returned if we were unable to build a UpdateWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -92309,19 +94402,77 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) ToWire() (wire.Value,
 //     return nil, err
 //   }
 //
-//   var v UpsertWorkflowSearchAttributesDecisionAttributes
+//   var v UpdateWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) FromWire(w wire.Value) error {
+func (v *UpdateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
 				if err != nil {
 					return err
 				}
@@ -92333,20 +94484,92 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) FromWire(w wire.Value
 	return nil
 }
 
-// Encode serializes a UpsertWorkflowSearchAttributesDecisionAttributes struct directly into bytes, without going
+// Encode serializes a UpdateWorkflowExecutionRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a UpsertWorkflowSearchAttributesDecisionAttributes struct could not be encoded.
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a UpdateWorkflowExecutionRequest struct could not be encoded.
+func (v *UpdateWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.SearchAttributes != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.SearchAttributes.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.UpdateID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.UpdateID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.UpdateName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.UpdateName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Input != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Input); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RequestId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RequestId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -92357,12 +94580,12 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Encode(sw stream.Writ
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a UpsertWorkflowSearchAttributesDecisionAttributes struct directly from its Thrift-level
+// Decode deserializes a UpdateWorkflowExecutionRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a UpsertWorkflowSearchAttributesDecisionAttributes struct could not be generated from the wire
+// An error is returned if a UpdateWorkflowExecutionRequest struct could not be generated from the wire
 // representation.
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Decode(sr stream.Reader) error {
+func (v *UpdateWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -92375,8 +94598,54 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Decode(sr stream.Read
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TStruct:
-			v.SearchAttributes, err = _SearchAttributes_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.UpdateID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.UpdateName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBinary:
+			v.Input, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RequestId = &x
 			if err != nil {
 				return err
 			}
@@ -92403,34 +94672,76 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Decode(sr stream.Read
 	return nil
 }
 
-// String returns a readable string representation of a UpsertWorkflowSearchAttributesDecisionAttributes
+// String returns a readable string representation of a UpdateWorkflowExecutionRequest
 // struct.
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) String() string {
+func (v *UpdateWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [7]string
 	i := 0
-	if v.SearchAttributes != nil {
-		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.UpdateID != nil {
+		fields[i] = fmt.Sprintf("UpdateID: %v", *(v.UpdateID))
+		i++
+	}
+	if v.UpdateName != nil {
+		fields[i] = fmt.Sprintf("UpdateName: %v", *(v.UpdateName))
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
 
-	return fmt.Sprintf("UpsertWorkflowSearchAttributesDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpsertWorkflowSearchAttributesDecisionAttributes match the
-// provided UpsertWorkflowSearchAttributesDecisionAttributes.
+// Equals returns true if all the fields of this UpdateWorkflowExecutionRequest match the
+// provided UpdateWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Equals(rhs *UpsertWorkflowSearchAttributesDecisionAttributes) bool {
+func (v *UpdateWorkflowExecutionRequest) Equals(rhs *UpdateWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateID, rhs.UpdateID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateName, rhs.UpdateName) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
 
@@ -92438,38 +94749,146 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Equals(rhs *UpsertWor
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of UpsertWorkflowSearchAttributesDecisionAttributes.
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpdateWorkflowExecutionRequest.
+func (v *UpdateWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.SearchAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.UpdateID != nil {
+		enc.AddString("updateID", *v.UpdateID)
+	}
+	if v.UpdateName != nil {
+		enc.AddString("updateName", *v.UpdateName)
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
 	}
 	return err
 }
 
-// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
+func (v *UpdateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetSearchAttributes returns true if SearchAttributes is not nil.
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) IsSetSearchAttributes() bool {
-	return v != nil && v.SearchAttributes != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-type UpsertWorkflowSearchAttributesEventAttributes struct {
-	DecisionTaskCompletedEventId *int64            `json:"decisionTaskCompletedEventId,omitempty"`
-	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
 }
 
-// ToWire translates a UpsertWorkflowSearchAttributesEventAttributes struct into a Thrift-level intermediate
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetUpdateID returns the value of UpdateID if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetUpdateID() (o string) {
+	if v != nil && v.UpdateID != nil {
+		return *v.UpdateID
+	}
+
+	return
+}
+
+// IsSetUpdateID returns true if UpdateID is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetUpdateID() bool {
+	return v != nil && v.UpdateID != nil
+}
+
+// GetUpdateName returns the value of UpdateName if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetUpdateName() (o string) {
+	if v != nil && v.UpdateName != nil {
+		return *v.UpdateName
+	}
+
+	return
+}
+
+// IsSetUpdateName returns true if UpdateName is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetUpdateName() bool {
+	return v != nil && v.UpdateName != nil
+}
+
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
+}
+
+// IsSetInput returns true if Input is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetInput() bool {
+	return v != nil && v.Input != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
+type UpdateWorkflowExecutionResponse struct {
+	Result         []byte  `json:"result,omitempty"`
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// ToWire translates a UpdateWorkflowExecutionResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -92484,7 +94903,7 @@ type UpsertWorkflowSearchAttributesEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, error) {
+func (v *UpdateWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -92492,16 +94911,16 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, er
 		err    error
 	)
 
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.SearchAttributes != nil {
-		w, err = v.SearchAttributes.ToWire()
+	if v.FailureMessage != nil {
+		w, err = wire.NewValueString(*(v.FailureMessage)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -92512,11 +94931,11 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, er
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a UpsertWorkflowSearchAttributesEventAttributes struct from its Thrift-level
+// FromWire deserializes a UpdateWorkflowExecutionResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpsertWorkflowSearchAttributesEventAttributes struct
+// An error is returned if we were unable to build a UpdateWorkflowExecutionResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -92524,29 +94943,29 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, er
 //     return nil, err
 //   }
 //
-//   var v UpsertWorkflowSearchAttributesEventAttributes
+//   var v UpdateWorkflowExecutionResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *UpsertWorkflowSearchAttributesEventAttributes) FromWire(w wire.Value) error {
+func (v *UpdateWorkflowExecutionResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				v.Result, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailureMessage = &x
 				if err != nil {
 					return err
 				}
@@ -92558,20 +94977,20 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) FromWire(w wire.Value) e
 	return nil
 }
 
-// Encode serializes a UpsertWorkflowSearchAttributesEventAttributes struct directly into bytes, without going
+// Encode serializes a UpdateWorkflowExecutionResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a UpsertWorkflowSearchAttributesEventAttributes struct could not be encoded.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a UpdateWorkflowExecutionResponse struct could not be encoded.
+func (v *UpdateWorkflowExecutionResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+	if v.Result != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+		if err := sw.WriteBinary(v.Result); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -92579,11 +94998,11 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) Encode(sw stream.Writer)
 		}
 	}
 
-	if v.SearchAttributes != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.FailureMessage != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.SearchAttributes.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.FailureMessage)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -92594,12 +95013,12 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) Encode(sw stream.Writer)
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a UpsertWorkflowSearchAttributesEventAttributes struct directly from its Thrift-level
+// Decode deserializes a UpdateWorkflowExecutionResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a UpsertWorkflowSearchAttributesEventAttributes struct could not be generated from the wire
+// An error is returned if a UpdateWorkflowExecutionResponse struct could not be generated from the wire
 // representation.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) Decode(sr stream.Reader) error {
+func (v *UpdateWorkflowExecutionResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -92612,16 +95031,16 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) Decode(sr stream.Reader)
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			v.Result, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.SearchAttributes, err = _SearchAttributes_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FailureMessage = &x
 			if err != nil {
 				return err
 			}
@@ -92648,41 +95067,41 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) Decode(sr stream.Reader)
 	return nil
 }
 
-// String returns a readable string representation of a UpsertWorkflowSearchAttributesEventAttributes
+// String returns a readable string representation of a UpdateWorkflowExecutionResponse
 // struct.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) String() string {
+func (v *UpdateWorkflowExecutionResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
 		i++
 	}
-	if v.SearchAttributes != nil {
-		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+	if v.FailureMessage != nil {
+		fields[i] = fmt.Sprintf("FailureMessage: %v", *(v.FailureMessage))
 		i++
 	}
 
-	return fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpsertWorkflowSearchAttributesEventAttributes match the
-// provided UpsertWorkflowSearchAttributesEventAttributes.
+// Equals returns true if all the fields of this UpdateWorkflowExecutionResponse match the
+// provided UpdateWorkflowExecutionResponse.
 //
 // This function performs a deep comparison.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) Equals(rhs *UpsertWorkflowSearchAttributesEventAttributes) bool {
+func (v *UpdateWorkflowExecutionResponse) Equals(rhs *UpdateWorkflowExecutionResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
 		return false
 	}
-	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+	if !_String_EqualsPtr(v.FailureMessage, rhs.FailureMessage) {
 		return false
 	}
 
@@ -92690,85 +95109,55 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) Equals(rhs *UpsertWorkfl
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of UpsertWorkflowSearchAttributesEventAttributes.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpdateWorkflowExecutionResponse.
+func (v *UpdateWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	if v.Result != nil {
+		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
 	}
-	if v.SearchAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
+	if v.FailureMessage != nil {
+		enc.AddString("failureMessage", *v.FailureMessage)
 	}
 	return err
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetResult returns the value of Result if it is set or its
 // zero value if it is unset.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *UpdateWorkflowExecutionResponse) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
 	}
 
 	return
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+// IsSetResult returns true if Result is not nil.
+func (v *UpdateWorkflowExecutionResponse) IsSetResult() bool {
+	return v != nil && v.Result != nil
 }
 
-// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// GetFailureMessage returns the value of FailureMessage if it is set or its
 // zero value if it is unset.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
+func (v *UpdateWorkflowExecutionResponse) GetFailureMessage() (o string) {
+	if v != nil && v.FailureMessage != nil {
+		return *v.FailureMessage
 	}
 
 	return
 }
 
-// IsSetSearchAttributes returns true if SearchAttributes is not nil.
-func (v *UpsertWorkflowSearchAttributesEventAttributes) IsSetSearchAttributes() bool {
-	return v != nil && v.SearchAttributes != nil
-}
-
-type VersionHistories struct {
-	CurrentVersionHistoryIndex *int32            `json:"currentVersionHistoryIndex,omitempty"`
-	Histories                  []*VersionHistory `json:"histories,omitempty"`
-}
-
-type _List_VersionHistory_ValueList []*VersionHistory
-
-func (v _List_VersionHistory_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*VersionHistory', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_VersionHistory_ValueList) Size() int {
-	return len(v)
+// IsSetFailureMessage returns true if FailureMessage is not nil.
+func (v *UpdateWorkflowExecutionResponse) IsSetFailureMessage() bool {
+	return v != nil && v.FailureMessage != nil
 }
 
-func (_List_VersionHistory_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+type UpsertWorkflowSearchAttributesDecisionAttributes struct {
+	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
 }
 
-func (_List_VersionHistory_ValueList) Close() {}
-
-// ToWire translates a VersionHistories struct into a Thrift-level intermediate
+// ToWire translates a UpsertWorkflowSearchAttributesDecisionAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -92783,63 +95172,31 @@ func (_List_VersionHistory_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *VersionHistories) ToWire() (wire.Value, error) {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.CurrentVersionHistoryIndex != nil {
-		w, err = wire.NewValueI32(*(v.CurrentVersionHistoryIndex)), error(nil)
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Histories != nil {
-		w, err = wire.NewValueList(_List_VersionHistory_ValueList(v.Histories)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _VersionHistory_Read(w wire.Value) (*VersionHistory, error) {
-	var v VersionHistory
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_VersionHistory_Read(l wire.ValueList) ([]*VersionHistory, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*VersionHistory, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _VersionHistory_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a VersionHistories struct from its Thrift-level
+// FromWire deserializes a UpsertWorkflowSearchAttributesDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a VersionHistories struct
+// An error is returned if we were unable to build a UpsertWorkflowSearchAttributesDecisionAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -92847,29 +95204,19 @@ func _List_VersionHistory_Read(l wire.ValueList) ([]*VersionHistory, error) {
 //     return nil, err
 //   }
 //
-//   var v VersionHistories
+//   var v UpsertWorkflowSearchAttributesDecisionAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *VersionHistories) FromWire(w wire.Value) error {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.CurrentVersionHistoryIndex = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Histories, err = _List_VersionHistory_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -92881,53 +95228,20 @@ func (v *VersionHistories) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_VersionHistory_Encode(val []*VersionHistory, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*VersionHistory', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a VersionHistories struct directly into bytes, without going
+// Encode serializes a UpsertWorkflowSearchAttributesDecisionAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a VersionHistories struct could not be encoded.
-func (v *VersionHistories) Encode(sw stream.Writer) error {
+// An error is returned if a UpsertWorkflowSearchAttributesDecisionAttributes struct could not be encoded.
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.CurrentVersionHistoryIndex != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.CurrentVersionHistoryIndex)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Histories != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.SearchAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _List_VersionHistory_Encode(v.Histories, sw); err != nil {
+		if err := v.SearchAttributes.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -92938,48 +95252,12 @@ func (v *VersionHistories) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _VersionHistory_Decode(sr stream.Reader) (*VersionHistory, error) {
-	var v VersionHistory
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_VersionHistory_Decode(sr stream.Reader) ([]*VersionHistory, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*VersionHistory, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _VersionHistory_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-// Decode deserializes a VersionHistories struct directly from its Thrift-level
+// Decode deserializes a UpsertWorkflowSearchAttributesDecisionAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a VersionHistories struct could not be generated from the wire
+// An error is returned if a UpsertWorkflowSearchAttributesDecisionAttributes struct could not be generated from the wire
 // representation.
-func (v *VersionHistories) Decode(sr stream.Reader) error {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -92992,16 +95270,8 @@ func (v *VersionHistories) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.CurrentVersionHistoryIndex = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.Histories, err = _List_VersionHistory_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.SearchAttributes, err = _SearchAttributes_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -93028,153 +95298,73 @@ func (v *VersionHistories) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a VersionHistories
+// String returns a readable string representation of a UpsertWorkflowSearchAttributesDecisionAttributes
 // struct.
-func (v *VersionHistories) String() string {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.CurrentVersionHistoryIndex != nil {
-		fields[i] = fmt.Sprintf("CurrentVersionHistoryIndex: %v", *(v.CurrentVersionHistoryIndex))
-		i++
-	}
-	if v.Histories != nil {
-		fields[i] = fmt.Sprintf("Histories: %v", v.Histories)
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
 
-	return fmt.Sprintf("VersionHistories{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_VersionHistory_Equals(lhs, rhs []*VersionHistory) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
+	return fmt.Sprintf("UpsertWorkflowSearchAttributesDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this VersionHistories match the
-// provided VersionHistories.
+// Equals returns true if all the fields of this UpsertWorkflowSearchAttributesDecisionAttributes match the
+// provided UpsertWorkflowSearchAttributesDecisionAttributes.
 //
 // This function performs a deep comparison.
-func (v *VersionHistories) Equals(rhs *VersionHistories) bool {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Equals(rhs *UpsertWorkflowSearchAttributesDecisionAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I32_EqualsPtr(v.CurrentVersionHistoryIndex, rhs.CurrentVersionHistoryIndex) {
-		return false
-	}
-	if !((v.Histories == nil && rhs.Histories == nil) || (v.Histories != nil && rhs.Histories != nil && _List_VersionHistory_Equals(v.Histories, rhs.Histories))) {
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_VersionHistory_Zapper []*VersionHistory
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_VersionHistory_Zapper.
-func (l _List_VersionHistory_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of VersionHistories.
-func (v *VersionHistories) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpsertWorkflowSearchAttributesDecisionAttributes.
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.CurrentVersionHistoryIndex != nil {
-		enc.AddInt32("currentVersionHistoryIndex", *v.CurrentVersionHistoryIndex)
-	}
-	if v.Histories != nil {
-		err = multierr.Append(err, enc.AddArray("histories", (_List_VersionHistory_Zapper)(v.Histories)))
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
 	}
 	return err
 }
 
-// GetCurrentVersionHistoryIndex returns the value of CurrentVersionHistoryIndex if it is set or its
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
 // zero value if it is unset.
-func (v *VersionHistories) GetCurrentVersionHistoryIndex() (o int32) {
-	if v != nil && v.CurrentVersionHistoryIndex != nil {
-		return *v.CurrentVersionHistoryIndex
-	}
-
-	return
-}
-
-// IsSetCurrentVersionHistoryIndex returns true if CurrentVersionHistoryIndex is not nil.
-func (v *VersionHistories) IsSetCurrentVersionHistoryIndex() bool {
-	return v != nil && v.CurrentVersionHistoryIndex != nil
-}
-
-// GetHistories returns the value of Histories if it is set or its
-// zero value if it is unset.
-func (v *VersionHistories) GetHistories() (o []*VersionHistory) {
-	if v != nil && v.Histories != nil {
-		return v.Histories
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
 	}
 
 	return
 }
 
-// IsSetHistories returns true if Histories is not nil.
-func (v *VersionHistories) IsSetHistories() bool {
-	return v != nil && v.Histories != nil
-}
-
-type VersionHistory struct {
-	BranchToken []byte                `json:"branchToken,omitempty"`
-	Items       []*VersionHistoryItem `json:"items,omitempty"`
-}
-
-type _List_VersionHistoryItem_ValueList []*VersionHistoryItem
-
-func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*VersionHistoryItem', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_VersionHistoryItem_ValueList) Size() int {
-	return len(v)
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
 }
 
-func (_List_VersionHistoryItem_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+type UpsertWorkflowSearchAttributesEventAttributes struct {
+	DecisionTaskCompletedEventId *int64            `json:"decisionTaskCompletedEventId,omitempty"`
+	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
 }
 
-func (_List_VersionHistoryItem_ValueList) Close() {}
-
-// ToWire translates a VersionHistory struct into a Thrift-level intermediate
+// ToWire translates a UpsertWorkflowSearchAttributesEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -93189,7 +95379,7 @@ func (_List_VersionHistoryItem_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *VersionHistory) ToWire() (wire.Value, error) {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -93197,16 +95387,16 @@ func (v *VersionHistory) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.BranchToken != nil {
-		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Items != nil {
-		w, err = wire.NewValueList(_List_VersionHistoryItem_ValueList(v.Items)), error(nil)
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -93217,35 +95407,11 @@ func (v *VersionHistory) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _VersionHistoryItem_Read(w wire.Value) (*VersionHistoryItem, error) {
-	var v VersionHistoryItem
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*VersionHistoryItem, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*VersionHistoryItem, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _VersionHistoryItem_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a VersionHistory struct from its Thrift-level
+// FromWire deserializes a UpsertWorkflowSearchAttributesEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a VersionHistory struct
+// An error is returned if we were unable to build a UpsertWorkflowSearchAttributesEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -93253,27 +95419,29 @@ func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*VersionHistoryItem, err
 //     return nil, err
 //   }
 //
-//   var v VersionHistory
+//   var v UpsertWorkflowSearchAttributesEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *VersionHistory) FromWire(w wire.Value) error {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.BranchToken, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Items, err = _List_VersionHistoryItem_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -93285,41 +95453,20 @@ func (v *VersionHistory) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_VersionHistoryItem_Encode(val []*VersionHistoryItem, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*VersionHistoryItem', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a VersionHistory struct directly into bytes, without going
+// Encode serializes a UpsertWorkflowSearchAttributesEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a VersionHistory struct could not be encoded.
-func (v *VersionHistory) Encode(sw stream.Writer) error {
+// An error is returned if a UpsertWorkflowSearchAttributesEventAttributes struct could not be encoded.
+func (v *UpsertWorkflowSearchAttributesEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.BranchToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.BranchToken); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -93327,11 +95474,11 @@ func (v *VersionHistory) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Items != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.SearchAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _List_VersionHistoryItem_Encode(v.Items, sw); err != nil {
+		if err := v.SearchAttributes.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -93342,48 +95489,12 @@ func (v *VersionHistory) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _VersionHistoryItem_Decode(sr stream.Reader) (*VersionHistoryItem, error) {
-	var v VersionHistoryItem
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_VersionHistoryItem_Decode(sr stream.Reader) ([]*VersionHistoryItem, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*VersionHistoryItem, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _VersionHistoryItem_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-// Decode deserializes a VersionHistory struct directly from its Thrift-level
+// Decode deserializes a UpsertWorkflowSearchAttributesEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a VersionHistory struct could not be generated from the wire
+// An error is returned if a UpsertWorkflowSearchAttributesEventAttributes struct could not be generated from the wire
 // representation.
-func (v *VersionHistory) Decode(sr stream.Reader) error {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -93396,14 +95507,16 @@ func (v *VersionHistory) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			v.BranchToken, err = sr.ReadBinary()
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.Items, err = _List_VersionHistoryItem_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.SearchAttributes, err = _SearchAttributes_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -93430,124 +95543,127 @@ func (v *VersionHistory) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a VersionHistory
+// String returns a readable string representation of a UpsertWorkflowSearchAttributesEventAttributes
 // struct.
-func (v *VersionHistory) String() string {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.BranchToken != nil {
-		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.Items != nil {
-		fields[i] = fmt.Sprintf("Items: %v", v.Items)
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
 
-	return fmt.Sprintf("VersionHistory{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_VersionHistoryItem_Equals(lhs, rhs []*VersionHistoryItem) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
+	return fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this VersionHistory match the
-// provided VersionHistory.
+// Equals returns true if all the fields of this UpsertWorkflowSearchAttributesEventAttributes match the
+// provided UpsertWorkflowSearchAttributesEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *VersionHistory) Equals(rhs *VersionHistory) bool {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) Equals(rhs *UpsertWorkflowSearchAttributesEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !((v.Items == nil && rhs.Items == nil) || (v.Items != nil && rhs.Items != nil && _List_VersionHistoryItem_Equals(v.Items, rhs.Items))) {
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_VersionHistoryItem_Zapper []*VersionHistoryItem
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_VersionHistoryItem_Zapper.
-func (l _List_VersionHistoryItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of VersionHistory.
-func (v *VersionHistory) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpsertWorkflowSearchAttributesEventAttributes.
+func (v *UpsertWorkflowSearchAttributesEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.BranchToken != nil {
-		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
-	if v.Items != nil {
-		err = multierr.Append(err, enc.AddArray("items", (_List_VersionHistoryItem_Zapper)(v.Items)))
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
 	}
 	return err
 }
 
-// GetBranchToken returns the value of BranchToken if it is set or its
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *VersionHistory) GetBranchToken() (o []byte) {
-	if v != nil && v.BranchToken != nil {
-		return v.BranchToken
+func (v *UpsertWorkflowSearchAttributesEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
 }
 
-// IsSetBranchToken returns true if BranchToken is not nil.
-func (v *VersionHistory) IsSetBranchToken() bool {
-	return v != nil && v.BranchToken != nil
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *UpsertWorkflowSearchAttributesEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-// GetItems returns the value of Items if it is set or its
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
 // zero value if it is unset.
-func (v *VersionHistory) GetItems() (o []*VersionHistoryItem) {
-	if v != nil && v.Items != nil {
-		return v.Items
+func (v *UpsertWorkflowSearchAttributesEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
 	}
 
 	return
 }
 
-// IsSetItems returns true if Items is not nil.
-func (v *VersionHistory) IsSetItems() bool {
-	return v != nil && v.Items != nil
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *UpsertWorkflowSearchAttributesEventAttributes) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
 }
 
-type VersionHistoryItem struct {
-	EventID *int64 `json:"eventID,omitempty"`
-	Version *int64 `json:"version,omitempty"`
+type VersionHistories struct {
+	CurrentVersionHistoryIndex *int32            `json:"currentVersionHistoryIndex,omitempty"`
+	Histories                  []*VersionHistory `json:"histories,omitempty"`
 }
 
-// ToWire translates a VersionHistoryItem struct into a Thrift-level intermediate
+type _List_VersionHistory_ValueList []*VersionHistory
+
+func (v _List_VersionHistory_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*VersionHistory', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_VersionHistory_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_VersionHistory_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_VersionHistory_ValueList) Close() {}
+
+// ToWire translates a VersionHistories struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -93562,7 +95678,7 @@ type VersionHistoryItem struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *VersionHistoryItem) ToWire() (wire.Value, error) {
+func (v *VersionHistories) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -93570,16 +95686,16 @@ func (v *VersionHistoryItem) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.EventID != nil {
-		w, err = wire.NewValueI64(*(v.EventID)), error(nil)
+	if v.CurrentVersionHistoryIndex != nil {
+		w, err = wire.NewValueI32(*(v.CurrentVersionHistoryIndex)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+	if v.Histories != nil {
+		w, err = wire.NewValueList(_List_VersionHistory_ValueList(v.Histories)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -93590,11 +95706,35 @@ func (v *VersionHistoryItem) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a VersionHistoryItem struct from its Thrift-level
+func _VersionHistory_Read(w wire.Value) (*VersionHistory, error) {
+	var v VersionHistory
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_VersionHistory_Read(l wire.ValueList) ([]*VersionHistory, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*VersionHistory, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _VersionHistory_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a VersionHistories struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a VersionHistoryItem struct
+// An error is returned if we were unable to build a VersionHistories struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -93602,31 +95742,29 @@ func (v *VersionHistoryItem) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v VersionHistoryItem
+//   var v VersionHistories
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *VersionHistoryItem) FromWire(w wire.Value) error {
+func (v *VersionHistories) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.EventID = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.CurrentVersionHistoryIndex = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
+			if field.Value.Type() == wire.TList {
+				v.Histories, err = _List_VersionHistory_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -93638,20 +95776,41 @@ func (v *VersionHistoryItem) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a VersionHistoryItem struct directly into bytes, without going
+func _List_VersionHistory_Encode(val []*VersionHistory, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*VersionHistory', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a VersionHistories struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a VersionHistoryItem struct could not be encoded.
-func (v *VersionHistoryItem) Encode(sw stream.Writer) error {
+// An error is returned if a VersionHistories struct could not be encoded.
+func (v *VersionHistories) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.EventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+	if v.CurrentVersionHistoryIndex != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.EventID)); err != nil {
+		if err := sw.WriteInt32(*(v.CurrentVersionHistoryIndex)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -93659,11 +95818,11 @@ func (v *VersionHistoryItem) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Version != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+	if v.Histories != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.Version)); err != nil {
+		if err := _List_VersionHistory_Encode(v.Histories, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -93674,12 +95833,48 @@ func (v *VersionHistoryItem) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a VersionHistoryItem struct directly from its Thrift-level
+func _VersionHistory_Decode(sr stream.Reader) (*VersionHistory, error) {
+	var v VersionHistory
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_VersionHistory_Decode(sr stream.Reader) ([]*VersionHistory, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*VersionHistory, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _VersionHistory_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a VersionHistories struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a VersionHistoryItem struct could not be generated from the wire
+// An error is returned if a VersionHistories struct could not be generated from the wire
 // representation.
-func (v *VersionHistoryItem) Decode(sr stream.Reader) error {
+func (v *VersionHistories) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -93692,18 +95887,16 @@ func (v *VersionHistoryItem) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.EventID = &x
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.CurrentVersionHistoryIndex = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.Version = &x
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Histories, err = _List_VersionHistory_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -93730,98 +95923,153 @@ func (v *VersionHistoryItem) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a VersionHistoryItem
+// String returns a readable string representation of a VersionHistories
 // struct.
-func (v *VersionHistoryItem) String() string {
+func (v *VersionHistories) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.EventID != nil {
-		fields[i] = fmt.Sprintf("EventID: %v", *(v.EventID))
+	if v.CurrentVersionHistoryIndex != nil {
+		fields[i] = fmt.Sprintf("CurrentVersionHistoryIndex: %v", *(v.CurrentVersionHistoryIndex))
 		i++
 	}
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+	if v.Histories != nil {
+		fields[i] = fmt.Sprintf("Histories: %v", v.Histories)
 		i++
 	}
 
-	return fmt.Sprintf("VersionHistoryItem{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("VersionHistories{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this VersionHistoryItem match the
-// provided VersionHistoryItem.
-//
-// This function performs a deep comparison.
-func (v *VersionHistoryItem) Equals(rhs *VersionHistoryItem) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_I64_EqualsPtr(v.EventID, rhs.EventID) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+func _List_VersionHistory_Equals(lhs, rhs []*VersionHistory) bool {
+	if len(lhs) != len(rhs) {
 		return false
 	}
 
-	return true
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this VersionHistories match the
+// provided VersionHistories.
+//
+// This function performs a deep comparison.
+func (v *VersionHistories) Equals(rhs *VersionHistories) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.CurrentVersionHistoryIndex, rhs.CurrentVersionHistoryIndex) {
+		return false
+	}
+	if !((v.Histories == nil && rhs.Histories == nil) || (v.Histories != nil && rhs.Histories != nil && _List_VersionHistory_Equals(v.Histories, rhs.Histories))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_VersionHistory_Zapper []*VersionHistory
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_VersionHistory_Zapper.
+func (l _List_VersionHistory_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of VersionHistoryItem.
-func (v *VersionHistoryItem) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of VersionHistories.
+func (v *VersionHistories) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.EventID != nil {
-		enc.AddInt64("eventID", *v.EventID)
+	if v.CurrentVersionHistoryIndex != nil {
+		enc.AddInt32("currentVersionHistoryIndex", *v.CurrentVersionHistoryIndex)
 	}
-	if v.Version != nil {
-		enc.AddInt64("version", *v.Version)
+	if v.Histories != nil {
+		err = multierr.Append(err, enc.AddArray("histories", (_List_VersionHistory_Zapper)(v.Histories)))
 	}
 	return err
 }
 
-// GetEventID returns the value of EventID if it is set or its
+// GetCurrentVersionHistoryIndex returns the value of CurrentVersionHistoryIndex if it is set or its
 // zero value if it is unset.
-func (v *VersionHistoryItem) GetEventID() (o int64) {
-	if v != nil && v.EventID != nil {
-		return *v.EventID
+func (v *VersionHistories) GetCurrentVersionHistoryIndex() (o int32) {
+	if v != nil && v.CurrentVersionHistoryIndex != nil {
+		return *v.CurrentVersionHistoryIndex
 	}
 
 	return
 }
 
-// IsSetEventID returns true if EventID is not nil.
-func (v *VersionHistoryItem) IsSetEventID() bool {
-	return v != nil && v.EventID != nil
+// IsSetCurrentVersionHistoryIndex returns true if CurrentVersionHistoryIndex is not nil.
+func (v *VersionHistories) IsSetCurrentVersionHistoryIndex() bool {
+	return v != nil && v.CurrentVersionHistoryIndex != nil
 }
 
-// GetVersion returns the value of Version if it is set or its
+// GetHistories returns the value of Histories if it is set or its
 // zero value if it is unset.
-func (v *VersionHistoryItem) GetVersion() (o int64) {
-	if v != nil && v.Version != nil {
-		return *v.Version
+func (v *VersionHistories) GetHistories() (o []*VersionHistory) {
+	if v != nil && v.Histories != nil {
+		return v.Histories
 	}
 
 	return
 }
 
-// IsSetVersion returns true if Version is not nil.
-func (v *VersionHistoryItem) IsSetVersion() bool {
-	return v != nil && v.Version != nil
+// IsSetHistories returns true if Histories is not nil.
+func (v *VersionHistories) IsSetHistories() bool {
+	return v != nil && v.Histories != nil
 }
 
-type WorkerVersionInfo struct {
-	Impl           *string `json:"impl,omitempty"`
-	FeatureVersion *string `json:"featureVersion,omitempty"`
+type VersionHistory struct {
+	BranchToken []byte                `json:"branchToken,omitempty"`
+	Items       []*VersionHistoryItem `json:"items,omitempty"`
 }
 
-// ToWire translates a WorkerVersionInfo struct into a Thrift-level intermediate
+type _List_VersionHistoryItem_ValueList []*VersionHistoryItem
+
+func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*VersionHistoryItem', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_VersionHistoryItem_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_VersionHistoryItem_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_VersionHistoryItem_ValueList) Close() {}
+
+// ToWire translates a VersionHistory struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -93836,7 +96084,7 @@ type WorkerVersionInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkerVersionInfo) ToWire() (wire.Value, error) {
+func (v *VersionHistory) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -93844,16 +96092,16 @@ func (v *WorkerVersionInfo) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Impl != nil {
-		w, err = wire.NewValueString(*(v.Impl)), error(nil)
+	if v.BranchToken != nil {
+		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.FeatureVersion != nil {
-		w, err = wire.NewValueString(*(v.FeatureVersion)), error(nil)
+	if v.Items != nil {
+		w, err = wire.NewValueList(_List_VersionHistoryItem_ValueList(v.Items)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -93864,11 +96112,35 @@ func (v *WorkerVersionInfo) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkerVersionInfo struct from its Thrift-level
+func _VersionHistoryItem_Read(w wire.Value) (*VersionHistoryItem, error) {
+	var v VersionHistoryItem
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*VersionHistoryItem, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*VersionHistoryItem, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _VersionHistoryItem_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a VersionHistory struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkerVersionInfo struct
+// An error is returned if we were unable to build a VersionHistory struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -93876,31 +96148,27 @@ func (v *WorkerVersionInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v WorkerVersionInfo
+//   var v VersionHistory
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkerVersionInfo) FromWire(w wire.Value) error {
+func (v *VersionHistory) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Impl = &x
+				v.BranchToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.FeatureVersion = &x
+			if field.Value.Type() == wire.TList {
+				v.Items, err = _List_VersionHistoryItem_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -93912,20 +96180,41 @@ func (v *WorkerVersionInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a WorkerVersionInfo struct directly into bytes, without going
+func _List_VersionHistoryItem_Encode(val []*VersionHistoryItem, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*VersionHistoryItem', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a VersionHistory struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkerVersionInfo struct could not be encoded.
-func (v *WorkerVersionInfo) Encode(sw stream.Writer) error {
+// An error is returned if a VersionHistory struct could not be encoded.
+func (v *VersionHistory) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Impl != nil {
+	if v.BranchToken != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Impl)); err != nil {
+		if err := sw.WriteBinary(v.BranchToken); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -93933,11 +96222,11 @@ func (v *WorkerVersionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.FeatureVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.Items != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.FeatureVersion)); err != nil {
+		if err := _List_VersionHistoryItem_Encode(v.Items, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -93948,12 +96237,48 @@ func (v *WorkerVersionInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkerVersionInfo struct directly from its Thrift-level
+func _VersionHistoryItem_Decode(sr stream.Reader) (*VersionHistoryItem, error) {
+	var v VersionHistoryItem
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_VersionHistoryItem_Decode(sr stream.Reader) ([]*VersionHistoryItem, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*VersionHistoryItem, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _VersionHistoryItem_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a VersionHistory struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkerVersionInfo struct could not be generated from the wire
+// An error is returned if a VersionHistory struct could not be generated from the wire
 // representation.
-func (v *WorkerVersionInfo) Decode(sr stream.Reader) error {
+func (v *VersionHistory) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -93967,17 +96292,13 @@ func (v *WorkerVersionInfo) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Impl = &x
+			v.BranchToken, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.FeatureVersion = &x
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Items, err = _List_VersionHistoryItem_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -94004,98 +96325,124 @@ func (v *WorkerVersionInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a WorkerVersionInfo
+// String returns a readable string representation of a VersionHistory
 // struct.
-func (v *WorkerVersionInfo) String() string {
+func (v *VersionHistory) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.Impl != nil {
-		fields[i] = fmt.Sprintf("Impl: %v", *(v.Impl))
+	if v.BranchToken != nil {
+		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
 		i++
 	}
-	if v.FeatureVersion != nil {
-		fields[i] = fmt.Sprintf("FeatureVersion: %v", *(v.FeatureVersion))
+	if v.Items != nil {
+		fields[i] = fmt.Sprintf("Items: %v", v.Items)
 		i++
 	}
 
-	return fmt.Sprintf("WorkerVersionInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("VersionHistory{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkerVersionInfo match the
-// provided WorkerVersionInfo.
+func _List_VersionHistoryItem_Equals(lhs, rhs []*VersionHistoryItem) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this VersionHistory match the
+// provided VersionHistory.
 //
 // This function performs a deep comparison.
-func (v *WorkerVersionInfo) Equals(rhs *WorkerVersionInfo) bool {
+func (v *VersionHistory) Equals(rhs *VersionHistory) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Impl, rhs.Impl) {
+	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.FeatureVersion, rhs.FeatureVersion) {
+	if !((v.Items == nil && rhs.Items == nil) || (v.Items != nil && rhs.Items != nil && _List_VersionHistoryItem_Equals(v.Items, rhs.Items))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_VersionHistoryItem_Zapper []*VersionHistoryItem
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_VersionHistoryItem_Zapper.
+func (l _List_VersionHistoryItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkerVersionInfo.
-func (v *WorkerVersionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of VersionHistory.
+func (v *VersionHistory) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Impl != nil {
-		enc.AddString("impl", *v.Impl)
+	if v.BranchToken != nil {
+		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
 	}
-	if v.FeatureVersion != nil {
-		enc.AddString("featureVersion", *v.FeatureVersion)
+	if v.Items != nil {
+		err = multierr.Append(err, enc.AddArray("items", (_List_VersionHistoryItem_Zapper)(v.Items)))
 	}
 	return err
 }
 
-// GetImpl returns the value of Impl if it is set or its
+// GetBranchToken returns the value of BranchToken if it is set or its
 // zero value if it is unset.
-func (v *WorkerVersionInfo) GetImpl() (o string) {
-	if v != nil && v.Impl != nil {
-		return *v.Impl
+func (v *VersionHistory) GetBranchToken() (o []byte) {
+	if v != nil && v.BranchToken != nil {
+		return v.BranchToken
 	}
 
 	return
 }
 
-// IsSetImpl returns true if Impl is not nil.
-func (v *WorkerVersionInfo) IsSetImpl() bool {
-	return v != nil && v.Impl != nil
+// IsSetBranchToken returns true if BranchToken is not nil.
+func (v *VersionHistory) IsSetBranchToken() bool {
+	return v != nil && v.BranchToken != nil
 }
 
-// GetFeatureVersion returns the value of FeatureVersion if it is set or its
+// GetItems returns the value of Items if it is set or its
 // zero value if it is unset.
-func (v *WorkerVersionInfo) GetFeatureVersion() (o string) {
-	if v != nil && v.FeatureVersion != nil {
-		return *v.FeatureVersion
+func (v *VersionHistory) GetItems() (o []*VersionHistoryItem) {
+	if v != nil && v.Items != nil {
+		return v.Items
 	}
 
 	return
 }
 
-// IsSetFeatureVersion returns true if FeatureVersion is not nil.
-func (v *WorkerVersionInfo) IsSetFeatureVersion() bool {
-	return v != nil && v.FeatureVersion != nil
+// IsSetItems returns true if Items is not nil.
+func (v *VersionHistory) IsSetItems() bool {
+	return v != nil && v.Items != nil
 }
 
-type WorkflowExecution struct {
-	WorkflowId *string `json:"workflowId,omitempty"`
-	RunId      *string `json:"runId,omitempty"`
+type VersionHistoryItem struct {
+	EventID *int64 `json:"eventID,omitempty"`
+	Version *int64 `json:"version,omitempty"`
 }
 
-// ToWire translates a WorkflowExecution struct into a Thrift-level intermediate
+// ToWire translates a VersionHistoryItem struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -94110,7 +96457,7 @@ type WorkflowExecution struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecution) ToWire() (wire.Value, error) {
+func (v *VersionHistoryItem) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -94118,16 +96465,16 @@ func (v *WorkflowExecution) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.EventID != nil {
+		w, err = wire.NewValueI64(*(v.EventID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -94138,11 +96485,11 @@ func (v *WorkflowExecution) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecution struct from its Thrift-level
+// FromWire deserializes a VersionHistoryItem struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecution struct
+// An error is returned if we were unable to build a VersionHistoryItem struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -94150,31 +96497,31 @@ func (v *WorkflowExecution) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecution
+//   var v VersionHistoryItem
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecution) FromWire(w wire.Value) error {
+func (v *VersionHistoryItem) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EventID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
 				if err != nil {
 					return err
 				}
@@ -94186,20 +96533,20 @@ func (v *WorkflowExecution) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a WorkflowExecution struct directly into bytes, without going
+// Encode serializes a VersionHistoryItem struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecution struct could not be encoded.
-func (v *WorkflowExecution) Encode(sw stream.Writer) error {
+// An error is returned if a VersionHistoryItem struct could not be encoded.
+func (v *VersionHistoryItem) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.WorkflowId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.EventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+		if err := sw.WriteInt64(*(v.EventID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -94207,11 +96554,11 @@ func (v *WorkflowExecution) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RunId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunId)); err != nil {
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -94222,12 +96569,12 @@ func (v *WorkflowExecution) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecution struct directly from its Thrift-level
+// Decode deserializes a VersionHistoryItem struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecution struct could not be generated from the wire
+// An error is returned if a VersionHistoryItem struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecution) Decode(sr stream.Reader) error {
+func (v *VersionHistoryItem) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -94240,18 +96587,18 @@ func (v *WorkflowExecution) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.WorkflowId = &x
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.EventID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RunId = &x
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
 			if err != nil {
 				return err
 			}
@@ -94278,41 +96625,41 @@ func (v *WorkflowExecution) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecution
+// String returns a readable string representation of a VersionHistoryItem
 // struct.
-func (v *WorkflowExecution) String() string {
+func (v *VersionHistoryItem) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+	if v.EventID != nil {
+		fields[i] = fmt.Sprintf("EventID: %v", *(v.EventID))
 		i++
 	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowExecution{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("VersionHistoryItem{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecution match the
-// provided WorkflowExecution.
+// Equals returns true if all the fields of this VersionHistoryItem match the
+// provided VersionHistoryItem.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecution) Equals(rhs *WorkflowExecution) bool {
+func (v *VersionHistoryItem) Equals(rhs *VersionHistoryItem) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+	if !_I64_EqualsPtr(v.EventID, rhs.EventID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
 		return false
 	}
 
@@ -94320,55 +96667,56 @@ func (v *WorkflowExecution) Equals(rhs *WorkflowExecution) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecution.
-func (v *WorkflowExecution) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of VersionHistoryItem.
+func (v *VersionHistoryItem) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
+	if v.EventID != nil {
+		enc.AddInt64("eventID", *v.EventID)
 	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
 	}
 	return err
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
+// GetEventID returns the value of EventID if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecution) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
+func (v *VersionHistoryItem) GetEventID() (o int64) {
+	if v != nil && v.EventID != nil {
+		return *v.EventID
 	}
 
 	return
 }
 
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *WorkflowExecution) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
+// IsSetEventID returns true if EventID is not nil.
+func (v *VersionHistoryItem) IsSetEventID() bool {
+	return v != nil && v.EventID != nil
 }
 
-// GetRunId returns the value of RunId if it is set or its
+// GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecution) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+func (v *VersionHistoryItem) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
 	return
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *WorkflowExecution) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+// IsSetVersion returns true if Version is not nil.
+func (v *VersionHistoryItem) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
-type WorkflowExecutionAlreadyCompletedError struct {
-	Message string `json:"message,required"`
+type WorkerVersionInfo struct {
+	Impl           *string `json:"impl,omitempty"`
+	FeatureVersion *string `json:"featureVersion,omitempty"`
 }
 
-// ToWire translates a WorkflowExecutionAlreadyCompletedError struct into a Thrift-level intermediate
+// ToWire translates a WorkerVersionInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -94383,29 +96731,39 @@ type WorkflowExecutionAlreadyCompletedError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionAlreadyCompletedError) ToWire() (wire.Value, error) {
+func (v *WorkerVersionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	if v.Impl != nil {
+		w, err = wire.NewValueString(*(v.Impl)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.FeatureVersion != nil {
+		w, err = wire.NewValueString(*(v.FeatureVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionAlreadyCompletedError struct from its Thrift-level
+// FromWire deserializes a WorkerVersionInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionAlreadyCompletedError struct
+// An error is returned if we were unable to build a WorkerVersionInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -94413,66 +96771,84 @@ func (v *WorkflowExecutionAlreadyCompletedError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionAlreadyCompletedError
+//   var v WorkerVersionInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionAlreadyCompletedError) FromWire(w wire.Value) error {
+func (v *WorkerVersionInfo) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Impl = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
-		}
-	}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FeatureVersion = &x
+				if err != nil {
+					return err
+				}
 
-	if !messageIsSet {
-		return errors.New("field Message of WorkflowExecutionAlreadyCompletedError is required")
+			}
+		}
 	}
 
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionAlreadyCompletedError struct directly into bytes, without going
+// Encode serializes a WorkerVersionInfo struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionAlreadyCompletedError struct could not be encoded.
-func (v *WorkflowExecutionAlreadyCompletedError) Encode(sw stream.Writer) error {
+// An error is returned if a WorkerVersionInfo struct could not be encoded.
+func (v *WorkerVersionInfo) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Message); err != nil {
-		return err
+	if v.Impl != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Impl)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+
+	if v.FeatureVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.FeatureVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionAlreadyCompletedError struct directly from its Thrift-level
+// Decode deserializes a WorkerVersionInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionAlreadyCompletedError struct could not be generated from the wire
+// An error is returned if a WorkerVersionInfo struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionAlreadyCompletedError) Decode(sr stream.Reader) error {
-
-	messageIsSet := false
+func (v *WorkerVersionInfo) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -94485,12 +96861,22 @@ func (v *WorkflowExecutionAlreadyCompletedError) Decode(sr stream.Reader) error
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Message, err = sr.ReadString()
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Impl = &x
 			if err != nil {
 				return err
 			}
-			messageIsSet = true
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FeatureVersion = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -94510,45 +96896,44 @@ func (v *WorkflowExecutionAlreadyCompletedError) Decode(sr stream.Reader) error
 		return err
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of WorkflowExecutionAlreadyCompletedError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionAlreadyCompletedError
+// String returns a readable string representation of a WorkerVersionInfo
 // struct.
-func (v *WorkflowExecutionAlreadyCompletedError) String() string {
+func (v *WorkerVersionInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-
-	return fmt.Sprintf("WorkflowExecutionAlreadyCompletedError{%v}", strings.Join(fields[:i], ", "))
-}
+	if v.Impl != nil {
+		fields[i] = fmt.Sprintf("Impl: %v", *(v.Impl))
+		i++
+	}
+	if v.FeatureVersion != nil {
+		fields[i] = fmt.Sprintf("FeatureVersion: %v", *(v.FeatureVersion))
+		i++
+	}
 
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*WorkflowExecutionAlreadyCompletedError) ErrorName() string {
-	return "WorkflowExecutionAlreadyCompletedError"
+	return fmt.Sprintf("WorkerVersionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionAlreadyCompletedError match the
-// provided WorkflowExecutionAlreadyCompletedError.
+// Equals returns true if all the fields of this WorkerVersionInfo match the
+// provided WorkerVersionInfo.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionAlreadyCompletedError) Equals(rhs *WorkflowExecutionAlreadyCompletedError) bool {
+func (v *WorkerVersionInfo) Equals(rhs *WorkerVersionInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
+	if !_String_EqualsPtr(v.Impl, rhs.Impl) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FeatureVersion, rhs.FeatureVersion) {
 		return false
 	}
 
@@ -94556,35 +96941,56 @@ func (v *WorkflowExecutionAlreadyCompletedError) Equals(rhs *WorkflowExecutionAl
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionAlreadyCompletedError.
-func (v *WorkflowExecutionAlreadyCompletedError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkerVersionInfo.
+func (v *WorkerVersionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
+	if v.Impl != nil {
+		enc.AddString("impl", *v.Impl)
+	}
+	if v.FeatureVersion != nil {
+		enc.AddString("featureVersion", *v.FeatureVersion)
+	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetImpl returns the value of Impl if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionAlreadyCompletedError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+func (v *WorkerVersionInfo) GetImpl() (o string) {
+	if v != nil && v.Impl != nil {
+		return *v.Impl
 	}
+
 	return
 }
 
-func (v *WorkflowExecutionAlreadyCompletedError) Error() string {
-	return v.String()
+// IsSetImpl returns true if Impl is not nil.
+func (v *WorkerVersionInfo) IsSetImpl() bool {
+	return v != nil && v.Impl != nil
 }
 
-type WorkflowExecutionAlreadyStartedError struct {
-	Message        *string `json:"message,omitempty"`
-	StartRequestId *string `json:"startRequestId,omitempty"`
-	RunId          *string `json:"runId,omitempty"`
+// GetFeatureVersion returns the value of FeatureVersion if it is set or its
+// zero value if it is unset.
+func (v *WorkerVersionInfo) GetFeatureVersion() (o string) {
+	if v != nil && v.FeatureVersion != nil {
+		return *v.FeatureVersion
+	}
+
+	return
 }
 
-// ToWire translates a WorkflowExecutionAlreadyStartedError struct into a Thrift-level intermediate
+// IsSetFeatureVersion returns true if FeatureVersion is not nil.
+func (v *WorkerVersionInfo) IsSetFeatureVersion() bool {
+	return v != nil && v.FeatureVersion != nil
+}
+
+type WorkflowExecution struct {
+	WorkflowId *string `json:"workflowId,omitempty"`
+	RunId      *string `json:"runId,omitempty"`
+}
+
+// ToWire translates a WorkflowExecution struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -94599,47 +97005,39 @@ type WorkflowExecutionAlreadyStartedError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionAlreadyStartedError) ToWire() (wire.Value, error) {
+func (v *WorkflowExecution) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Message != nil {
-		w, err = wire.NewValueString(*(v.Message)), error(nil)
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartRequestId != nil {
-		w, err = wire.NewValueString(*(v.StartRequestId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 	if v.RunId != nil {
 		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionAlreadyStartedError struct from its Thrift-level
+// FromWire deserializes a WorkflowExecution struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionAlreadyStartedError struct
+// An error is returned if we were unable to build a WorkflowExecution struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -94647,12 +97045,12 @@ func (v *WorkflowExecutionAlreadyStartedError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionAlreadyStartedError
+//   var v WorkflowExecution
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionAlreadyStartedError) FromWire(w wire.Value) error {
+func (v *WorkflowExecution) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -94661,23 +97059,13 @@ func (v *WorkflowExecutionAlreadyStartedError) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Message = &x
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.StartRequestId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -94693,32 +97081,20 @@ func (v *WorkflowExecutionAlreadyStartedError) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionAlreadyStartedError struct directly into bytes, without going
+// Encode serializes a WorkflowExecution struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionAlreadyStartedError struct could not be encoded.
-func (v *WorkflowExecutionAlreadyStartedError) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecution struct could not be encoded.
+func (v *WorkflowExecution) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Message != nil {
+	if v.WorkflowId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Message)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.StartRequestId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.StartRequestId)); err != nil {
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -94727,7 +97103,7 @@ func (v *WorkflowExecutionAlreadyStartedError) Encode(sw stream.Writer) error {
 	}
 
 	if v.RunId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
 		if err := sw.WriteString(*(v.RunId)); err != nil {
@@ -94741,12 +97117,12 @@ func (v *WorkflowExecutionAlreadyStartedError) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionAlreadyStartedError struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecution struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionAlreadyStartedError struct could not be generated from the wire
+// An error is returned if a WorkflowExecution struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionAlreadyStartedError) Decode(sr stream.Reader) error {
+func (v *WorkflowExecution) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -94762,20 +97138,12 @@ func (v *WorkflowExecutionAlreadyStartedError) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Message = &x
+			v.WorkflowId = &x
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.StartRequestId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
 			v.RunId = &x
@@ -94805,21 +97173,17 @@ func (v *WorkflowExecutionAlreadyStartedError) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionAlreadyStartedError
+// String returns a readable string representation of a WorkflowExecution
 // struct.
-func (v *WorkflowExecutionAlreadyStartedError) String() string {
+func (v *WorkflowExecution) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
-	if v.Message != nil {
-		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
-		i++
-	}
-	if v.StartRequestId != nil {
-		fields[i] = fmt.Sprintf("StartRequestId: %v", *(v.StartRequestId))
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
 	if v.RunId != nil {
@@ -94827,29 +97191,20 @@ func (v *WorkflowExecutionAlreadyStartedError) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowExecutionAlreadyStartedError{%v}", strings.Join(fields[:i], ", "))
-}
-
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*WorkflowExecutionAlreadyStartedError) ErrorName() string {
-	return "WorkflowExecutionAlreadyStartedError"
+	return fmt.Sprintf("WorkflowExecution{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionAlreadyStartedError match the
-// provided WorkflowExecutionAlreadyStartedError.
+// Equals returns true if all the fields of this WorkflowExecution match the
+// provided WorkflowExecution.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionAlreadyStartedError) Equals(rhs *WorkflowExecutionAlreadyStartedError) bool {
+func (v *WorkflowExecution) Equals(rhs *WorkflowExecution) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Message, rhs.Message) {
-		return false
-	}
-	if !_String_EqualsPtr(v.StartRequestId, rhs.StartRequestId) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
 	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
@@ -94860,16 +97215,13 @@ func (v *WorkflowExecutionAlreadyStartedError) Equals(rhs *WorkflowExecutionAlre
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionAlreadyStartedError.
-func (v *WorkflowExecutionAlreadyStartedError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecution.
+func (v *WorkflowExecution) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Message != nil {
-		enc.AddString("message", *v.Message)
-	}
-	if v.StartRequestId != nil {
-		enc.AddString("startRequestId", *v.StartRequestId)
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
 	}
 	if v.RunId != nil {
 		enc.AddString("runId", *v.RunId)
@@ -94877,39 +97229,24 @@ func (v *WorkflowExecutionAlreadyStartedError) MarshalLogObject(enc zapcore.Obje
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionAlreadyStartedError) GetMessage() (o string) {
-	if v != nil && v.Message != nil {
-		return *v.Message
-	}
-
-	return
-}
-
-// IsSetMessage returns true if Message is not nil.
-func (v *WorkflowExecutionAlreadyStartedError) IsSetMessage() bool {
-	return v != nil && v.Message != nil
-}
-
-// GetStartRequestId returns the value of StartRequestId if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionAlreadyStartedError) GetStartRequestId() (o string) {
-	if v != nil && v.StartRequestId != nil {
-		return *v.StartRequestId
+func (v *WorkflowExecution) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetStartRequestId returns true if StartRequestId is not nil.
-func (v *WorkflowExecutionAlreadyStartedError) IsSetStartRequestId() bool {
-	return v != nil && v.StartRequestId != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *WorkflowExecution) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
 // GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionAlreadyStartedError) GetRunId() (o string) {
+func (v *WorkflowExecution) GetRunId() (o string) {
 	if v != nil && v.RunId != nil {
 		return *v.RunId
 	}
@@ -94918,22 +97255,15 @@ func (v *WorkflowExecutionAlreadyStartedError) GetRunId() (o string) {
 }
 
 // IsSetRunId returns true if RunId is not nil.
-func (v *WorkflowExecutionAlreadyStartedError) IsSetRunId() bool {
+func (v *WorkflowExecution) IsSetRunId() bool {
 	return v != nil && v.RunId != nil
 }
 
-func (v *WorkflowExecutionAlreadyStartedError) Error() string {
-	return v.String()
-}
-
-type WorkflowExecutionCancelRequestedEventAttributes struct {
-	Cause                     *string            `json:"cause,omitempty"`
-	ExternalInitiatedEventId  *int64             `json:"externalInitiatedEventId,omitempty"`
-	ExternalWorkflowExecution *WorkflowExecution `json:"externalWorkflowExecution,omitempty"`
-	Identity                  *string            `json:"identity,omitempty"`
+type WorkflowExecutionAlreadyCompletedError struct {
+	Message string `json:"message,required"`
 }
 
-// ToWire translates a WorkflowExecutionCancelRequestedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a WorkflowExecutionAlreadyCompletedError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -94948,55 +97278,29 @@ type WorkflowExecutionCancelRequestedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionAlreadyCompletedError) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Cause != nil {
-		w, err = wire.NewValueString(*(v.Cause)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.ExternalInitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.ExternalInitiatedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.ExternalWorkflowExecution != nil {
-		w, err = v.ExternalWorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionCancelRequestedEventAttributes struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionAlreadyCompletedError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionCancelRequestedEventAttributes struct
+// An error is returned if we were unable to build a WorkflowExecutionAlreadyCompletedError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -95004,126 +97308,66 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value,
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionCancelRequestedEventAttributes
+//   var v WorkflowExecutionAlreadyCompletedError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionCancelRequestedEventAttributes) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionAlreadyCompletedError) FromWire(w wire.Value) error {
 	var err error
 
+	messageIsSet := false
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Cause = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ExternalInitiatedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
+		case 1:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.Message, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				messageIsSet = true
 			}
 		}
 	}
 
+	if !messageIsSet {
+		return errors.New("field Message of WorkflowExecutionAlreadyCompletedError is required")
+	}
+
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionCancelRequestedEventAttributes struct directly into bytes, without going
+// Encode serializes a WorkflowExecutionAlreadyCompletedError struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionCancelRequestedEventAttributes struct could not be encoded.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecutionAlreadyCompletedError struct could not be encoded.
+func (v *WorkflowExecutionAlreadyCompletedError) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Cause != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Cause)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ExternalInitiatedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.ExternalInitiatedEventId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
 	}
-
-	if v.ExternalWorkflowExecution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.ExternalWorkflowExecution.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
 	}
-
-	if v.Identity != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Identity)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionCancelRequestedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecutionAlreadyCompletedError struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionCancelRequestedEventAttributes struct could not be generated from the wire
+// An error is returned if a WorkflowExecutionAlreadyCompletedError struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) Decode(sr stream.Reader) error {
+func (v *WorkflowExecutionAlreadyCompletedError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -95136,36 +97380,12 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) Decode(sr stream.Reade
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Cause = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ExternalInitiatedEventId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.ExternalWorkflowExecution, err = _WorkflowExecution_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Identity = &x
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
 			if err != nil {
 				return err
 			}
-
+			messageIsSet = true
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -95185,58 +97405,45 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) Decode(sr stream.Reade
 		return err
 	}
 
+	if !messageIsSet {
+		return errors.New("field Message of WorkflowExecutionAlreadyCompletedError is required")
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionCancelRequestedEventAttributes
+// String returns a readable string representation of a WorkflowExecutionAlreadyCompletedError
 // struct.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) String() string {
+func (v *WorkflowExecutionAlreadyCompletedError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [1]string
 	i := 0
-	if v.Cause != nil {
-		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
-		i++
-	}
-	if v.ExternalInitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("ExternalInitiatedEventId: %v", *(v.ExternalInitiatedEventId))
-		i++
-	}
-	if v.ExternalWorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
-		i++
-	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
-		i++
-	}
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
 
-	return fmt.Sprintf("WorkflowExecutionCancelRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionAlreadyCompletedError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionCancelRequestedEventAttributes match the
-// provided WorkflowExecutionCancelRequestedEventAttributes.
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*WorkflowExecutionAlreadyCompletedError) ErrorName() string {
+	return "WorkflowExecutionAlreadyCompletedError"
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionAlreadyCompletedError match the
+// provided WorkflowExecutionAlreadyCompletedError.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) Equals(rhs *WorkflowExecutionCancelRequestedEventAttributes) bool {
+func (v *WorkflowExecutionAlreadyCompletedError) Equals(rhs *WorkflowExecutionAlreadyCompletedError) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Cause, rhs.Cause) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ExternalInitiatedEventId, rhs.ExternalInitiatedEventId) {
-		return false
-	}
-	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
 
@@ -95244,92 +97451,35 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) Equals(rhs *WorkflowEx
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionCancelRequestedEventAttributes.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecutionAlreadyCompletedError.
+func (v *WorkflowExecutionAlreadyCompletedError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Cause != nil {
-		enc.AddString("cause", *v.Cause)
-	}
-	if v.ExternalInitiatedEventId != nil {
-		enc.AddInt64("externalInitiatedEventId", *v.ExternalInitiatedEventId)
-	}
-	if v.ExternalWorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("externalWorkflowExecution", v.ExternalWorkflowExecution))
-	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
-	}
+	enc.AddString("message", v.Message)
 	return err
 }
 
-// GetCause returns the value of Cause if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) GetCause() (o string) {
-	if v != nil && v.Cause != nil {
-		return *v.Cause
-	}
-
-	return
-}
-
-// IsSetCause returns true if Cause is not nil.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetCause() bool {
-	return v != nil && v.Cause != nil
-}
-
-// GetExternalInitiatedEventId returns the value of ExternalInitiatedEventId if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) GetExternalInitiatedEventId() (o int64) {
-	if v != nil && v.ExternalInitiatedEventId != nil {
-		return *v.ExternalInitiatedEventId
-	}
-
-	return
-}
-
-// IsSetExternalInitiatedEventId returns true if ExternalInitiatedEventId is not nil.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetExternalInitiatedEventId() bool {
-	return v != nil && v.ExternalInitiatedEventId != nil
-}
-
-// GetExternalWorkflowExecution returns the value of ExternalWorkflowExecution if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) GetExternalWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.ExternalWorkflowExecution != nil {
-		return v.ExternalWorkflowExecution
-	}
-
-	return
-}
-
-// IsSetExternalWorkflowExecution returns true if ExternalWorkflowExecution is not nil.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetExternalWorkflowExecution() bool {
-	return v != nil && v.ExternalWorkflowExecution != nil
-}
-
-// GetIdentity returns the value of Identity if it is set or its
+// GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *WorkflowExecutionAlreadyCompletedError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
 	}
-
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+func (v *WorkflowExecutionAlreadyCompletedError) Error() string {
+	return v.String()
 }
 
-type WorkflowExecutionCanceledEventAttributes struct {
-	DecisionTaskCompletedEventId *int64 `json:"decisionTaskCompletedEventId,omitempty"`
-	Details                      []byte `json:"details,omitempty"`
+type WorkflowExecutionAlreadyStartedError struct {
+	Message        *string `json:"message,omitempty"`
+	StartRequestId *string `json:"startRequestId,omitempty"`
+	RunId          *string `json:"runId,omitempty"`
 }
 
-// ToWire translates a WorkflowExecutionCanceledEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a WorkflowExecutionAlreadyStartedError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -95344,39 +97494,47 @@ type WorkflowExecutionCanceledEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionCanceledEventAttributes) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionAlreadyStartedError) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.StartRequestId != nil {
+		w, err = wire.NewValueString(*(v.StartRequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionCanceledEventAttributes struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionAlreadyStartedError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionCanceledEventAttributes struct
+// An error is returned if we were unable to build a WorkflowExecutionAlreadyStartedError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -95384,21 +97542,21 @@ func (v *WorkflowExecutionCanceledEventAttributes) ToWire() (wire.Value, error)
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionCanceledEventAttributes
+//   var v WorkflowExecutionAlreadyStartedError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionCanceledEventAttributes) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionAlreadyStartedError) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
 				if err != nil {
 					return err
 				}
@@ -95406,7 +97564,19 @@ func (v *WorkflowExecutionCanceledEventAttributes) FromWire(w wire.Value) error
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StartRequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
@@ -95418,20 +97588,20 @@ func (v *WorkflowExecutionCanceledEventAttributes) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionCanceledEventAttributes struct directly into bytes, without going
+// Encode serializes a WorkflowExecutionAlreadyStartedError struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionCanceledEventAttributes struct could not be encoded.
-func (v *WorkflowExecutionCanceledEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecutionAlreadyStartedError struct could not be encoded.
+func (v *WorkflowExecutionAlreadyStartedError) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+	if v.Message != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+		if err := sw.WriteString(*(v.Message)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -95439,11 +97609,23 @@ func (v *WorkflowExecutionCanceledEventAttributes) Encode(sw stream.Writer) erro
 		}
 	}
 
-	if v.Details != nil {
+	if v.StartRequestId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.Details); err != nil {
+		if err := sw.WriteString(*(v.StartRequestId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RunId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -95454,12 +97636,12 @@ func (v *WorkflowExecutionCanceledEventAttributes) Encode(sw stream.Writer) erro
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionCanceledEventAttributes struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecutionAlreadyStartedError struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionCanceledEventAttributes struct could not be generated from the wire
+// An error is returned if a WorkflowExecutionAlreadyStartedError struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionCanceledEventAttributes) Decode(sr stream.Reader) error {
+func (v *WorkflowExecutionAlreadyStartedError) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -95472,16 +97654,26 @@ func (v *WorkflowExecutionCanceledEventAttributes) Decode(sr stream.Reader) erro
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 20 && fh.Type == wire.TBinary:
-			v.Details, err = sr.ReadBinary()
+			var x string
+			x, err = sr.ReadString()
+			v.StartRequestId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunId = &x
 			if err != nil {
 				return err
 			}
@@ -95508,41 +97700,54 @@ func (v *WorkflowExecutionCanceledEventAttributes) Decode(sr stream.Reader) erro
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionCanceledEventAttributes
+// String returns a readable string representation of a WorkflowExecutionAlreadyStartedError
 // struct.
-func (v *WorkflowExecutionCanceledEventAttributes) String() string {
+func (v *WorkflowExecutionAlreadyStartedError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.StartRequestId != nil {
+		fields[i] = fmt.Sprintf("StartRequestId: %v", *(v.StartRequestId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowExecutionCanceledEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionAlreadyStartedError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionCanceledEventAttributes match the
-// provided WorkflowExecutionCanceledEventAttributes.
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*WorkflowExecutionAlreadyStartedError) ErrorName() string {
+	return "WorkflowExecutionAlreadyStartedError"
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionAlreadyStartedError match the
+// provided WorkflowExecutionAlreadyStartedError.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionCanceledEventAttributes) Equals(rhs *WorkflowExecutionCanceledEventAttributes) bool {
+func (v *WorkflowExecutionAlreadyStartedError) Equals(rhs *WorkflowExecutionAlreadyStartedError) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_String_EqualsPtr(v.StartRequestId, rhs.StartRequestId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
 
@@ -95550,354 +97755,143 @@ func (v *WorkflowExecutionCanceledEventAttributes) Equals(rhs *WorkflowExecution
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionCanceledEventAttributes.
-func (v *WorkflowExecutionCanceledEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecutionAlreadyStartedError.
+func (v *WorkflowExecutionAlreadyStartedError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.StartRequestId != nil {
+		enc.AddString("startRequestId", *v.StartRequestId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
 	}
 	return err
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionCanceledEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *WorkflowExecutionAlreadyStartedError) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
 	}
 
 	return
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *WorkflowExecutionCanceledEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+// IsSetMessage returns true if Message is not nil.
+func (v *WorkflowExecutionAlreadyStartedError) IsSetMessage() bool {
+	return v != nil && v.Message != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetStartRequestId returns the value of StartRequestId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionCanceledEventAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *WorkflowExecutionAlreadyStartedError) GetStartRequestId() (o string) {
+	if v != nil && v.StartRequestId != nil {
+		return *v.StartRequestId
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *WorkflowExecutionCanceledEventAttributes) IsSetDetails() bool {
-	return v != nil && v.Details != nil
-}
-
-type WorkflowExecutionCloseStatus int32
-
-const (
-	WorkflowExecutionCloseStatusCompleted      WorkflowExecutionCloseStatus = 0
-	WorkflowExecutionCloseStatusFailed         WorkflowExecutionCloseStatus = 1
-	WorkflowExecutionCloseStatusCanceled       WorkflowExecutionCloseStatus = 2
-	WorkflowExecutionCloseStatusTerminated     WorkflowExecutionCloseStatus = 3
-	WorkflowExecutionCloseStatusContinuedAsNew WorkflowExecutionCloseStatus = 4
-	WorkflowExecutionCloseStatusTimedOut       WorkflowExecutionCloseStatus = 5
-)
-
-// WorkflowExecutionCloseStatus_Values returns all recognized values of WorkflowExecutionCloseStatus.
-func WorkflowExecutionCloseStatus_Values() []WorkflowExecutionCloseStatus {
-	return []WorkflowExecutionCloseStatus{
-		WorkflowExecutionCloseStatusCompleted,
-		WorkflowExecutionCloseStatusFailed,
-		WorkflowExecutionCloseStatusCanceled,
-		WorkflowExecutionCloseStatusTerminated,
-		WorkflowExecutionCloseStatusContinuedAsNew,
-		WorkflowExecutionCloseStatusTimedOut,
-	}
+// IsSetStartRequestId returns true if StartRequestId is not nil.
+func (v *WorkflowExecutionAlreadyStartedError) IsSetStartRequestId() bool {
+	return v != nil && v.StartRequestId != nil
 }
 
-// UnmarshalText tries to decode WorkflowExecutionCloseStatus from a byte slice
-// containing its name.
-//
-//   var v WorkflowExecutionCloseStatus
-//   err := v.UnmarshalText([]byte("COMPLETED"))
-func (v *WorkflowExecutionCloseStatus) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "COMPLETED":
-		*v = WorkflowExecutionCloseStatusCompleted
-		return nil
-	case "FAILED":
-		*v = WorkflowExecutionCloseStatusFailed
-		return nil
-	case "CANCELED":
-		*v = WorkflowExecutionCloseStatusCanceled
-		return nil
-	case "TERMINATED":
-		*v = WorkflowExecutionCloseStatusTerminated
-		return nil
-	case "CONTINUED_AS_NEW":
-		*v = WorkflowExecutionCloseStatusContinuedAsNew
-		return nil
-	case "TIMED_OUT":
-		*v = WorkflowExecutionCloseStatusTimedOut
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowExecutionCloseStatus", err)
-		}
-		*v = WorkflowExecutionCloseStatus(val)
-		return nil
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionAlreadyStartedError) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
-}
 
-// MarshalText encodes WorkflowExecutionCloseStatus to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v WorkflowExecutionCloseStatus) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("COMPLETED"), nil
-	case 1:
-		return []byte("FAILED"), nil
-	case 2:
-		return []byte("CANCELED"), nil
-	case 3:
-		return []byte("TERMINATED"), nil
-	case 4:
-		return []byte("CONTINUED_AS_NEW"), nil
-	case 5:
-		return []byte("TIMED_OUT"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionCloseStatus.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v WorkflowExecutionCloseStatus) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "COMPLETED")
-	case 1:
-		enc.AddString("name", "FAILED")
-	case 2:
-		enc.AddString("name", "CANCELED")
-	case 3:
-		enc.AddString("name", "TERMINATED")
-	case 4:
-		enc.AddString("name", "CONTINUED_AS_NEW")
-	case 5:
-		enc.AddString("name", "TIMED_OUT")
-	}
-	return nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *WorkflowExecutionAlreadyStartedError) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
 }
 
-// Ptr returns a pointer to this enum value.
-func (v WorkflowExecutionCloseStatus) Ptr() *WorkflowExecutionCloseStatus {
-	return &v
+func (v *WorkflowExecutionAlreadyStartedError) Error() string {
+	return v.String()
 }
 
-// Encode encodes WorkflowExecutionCloseStatus directly to bytes.
-//
-//   sWriter := BinaryStreamer.Writer(writer)
-//
-//   var v WorkflowExecutionCloseStatus
-//   return v.Encode(sWriter)
-func (v WorkflowExecutionCloseStatus) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
+type WorkflowExecutionCancelRequestedEventAttributes struct {
+	Cause                     *string            `json:"cause,omitempty"`
+	ExternalInitiatedEventId  *int64             `json:"externalInitiatedEventId,omitempty"`
+	ExternalWorkflowExecution *WorkflowExecution `json:"externalWorkflowExecution,omitempty"`
+	Identity                  *string            `json:"identity,omitempty"`
 }
 
-// ToWire translates WorkflowExecutionCloseStatus into a Thrift-level intermediate
+// ToWire translates a WorkflowExecutionCancelRequestedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v WorkflowExecutionCloseStatus) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes WorkflowExecutionCloseStatus from its Thrift-level
-// representation.
+// An error is returned if the struct or any of its fields failed to
+// validate.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   x, err := v.ToWire()
 //   if err != nil {
-//     return WorkflowExecutionCloseStatus(0), err
+//     return err
 //   }
 //
-//   var v WorkflowExecutionCloseStatus
-//   if err := v.FromWire(x); err != nil {
-//     return WorkflowExecutionCloseStatus(0), err
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
 //   }
-//   return v, nil
-func (v *WorkflowExecutionCloseStatus) FromWire(w wire.Value) error {
-	*v = (WorkflowExecutionCloseStatus)(w.GetI32())
-	return nil
-}
-
-// Decode reads off the encoded WorkflowExecutionCloseStatus directly off of the wire.
-//
-//   sReader := BinaryStreamer.Reader(reader)
-//
-//   var v WorkflowExecutionCloseStatus
-//   if err := v.Decode(sReader); err != nil {
-//     return WorkflowExecutionCloseStatus(0), err
-//   }
-//   return v, nil
-func (v *WorkflowExecutionCloseStatus) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
-		return err
-	}
-	*v = (WorkflowExecutionCloseStatus)(i)
-	return nil
-}
-
-// String returns a readable string representation of WorkflowExecutionCloseStatus.
-func (v WorkflowExecutionCloseStatus) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "COMPLETED"
-	case 1:
-		return "FAILED"
-	case 2:
-		return "CANCELED"
-	case 3:
-		return "TERMINATED"
-	case 4:
-		return "CONTINUED_AS_NEW"
-	case 5:
-		return "TIMED_OUT"
-	}
-	return fmt.Sprintf("WorkflowExecutionCloseStatus(%d)", w)
-}
-
-// Equals returns true if this WorkflowExecutionCloseStatus value matches the provided
-// value.
-func (v WorkflowExecutionCloseStatus) Equals(rhs WorkflowExecutionCloseStatus) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes WorkflowExecutionCloseStatus into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v WorkflowExecutionCloseStatus) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"COMPLETED\""), nil
-	case 1:
-		return ([]byte)("\"FAILED\""), nil
-	case 2:
-		return ([]byte)("\"CANCELED\""), nil
-	case 3:
-		return ([]byte)("\"TERMINATED\""), nil
-	case 4:
-		return ([]byte)("\"CONTINUED_AS_NEW\""), nil
-	case 5:
-		return ([]byte)("\"TIMED_OUT\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// UnmarshalJSON attempts to decode WorkflowExecutionCloseStatus from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *WorkflowExecutionCloseStatus) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "WorkflowExecutionCloseStatus")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "WorkflowExecutionCloseStatus")
-		}
-		*v = (WorkflowExecutionCloseStatus)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "WorkflowExecutionCloseStatus")
-	}
-}
-
-type WorkflowExecutionCompletedEventAttributes struct {
-	Result                       []byte `json:"result,omitempty"`
-	DecisionTaskCompletedEventId *int64 `json:"decisionTaskCompletedEventId,omitempty"`
-}
-
-// ToWire translates a WorkflowExecutionCompletedEventAttributes struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *WorkflowExecutionCompletedEventAttributes) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Result != nil {
-		w, err = wire.NewValueBinary(v.Result), error(nil)
+	if v.Cause != nil {
+		w, err = wire.NewValueString(*(v.Cause)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.ExternalInitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.ExternalInitiatedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ExternalWorkflowExecution != nil {
+		w, err = v.ExternalWorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionCompletedEventAttributes struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionCancelRequestedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionCompletedEventAttributes struct
+// An error is returned if we were unable to build a WorkflowExecutionCancelRequestedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -95905,19 +97899,21 @@ func (v *WorkflowExecutionCompletedEventAttributes) ToWire() (wire.Value, error)
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionCompletedEventAttributes
+//   var v WorkflowExecutionCancelRequestedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionCompletedEventAttributes) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionCancelRequestedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.Result, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
@@ -95927,7 +97923,25 @@ func (v *WorkflowExecutionCompletedEventAttributes) FromWire(w wire.Value) error
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+				v.ExternalInitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -95939,20 +97953,20 @@ func (v *WorkflowExecutionCompletedEventAttributes) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionCompletedEventAttributes struct directly into bytes, without going
+// Encode serializes a WorkflowExecutionCancelRequestedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionCompletedEventAttributes struct could not be encoded.
-func (v *WorkflowExecutionCompletedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecutionCancelRequestedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Result != nil {
+	if v.Cause != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.Result); err != nil {
+		if err := sw.WriteString(*(v.Cause)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -95960,11 +97974,35 @@ func (v *WorkflowExecutionCompletedEventAttributes) Encode(sw stream.Writer) err
 		}
 	}
 
-	if v.DecisionTaskCompletedEventId != nil {
+	if v.ExternalInitiatedEventId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+		if err := sw.WriteInt64(*(v.ExternalInitiatedEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ExternalWorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ExternalWorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -95975,12 +98013,12 @@ func (v *WorkflowExecutionCompletedEventAttributes) Encode(sw stream.Writer) err
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionCompletedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecutionCancelRequestedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionCompletedEventAttributes struct could not be generated from the wire
+// An error is returned if a WorkflowExecutionCancelRequestedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionCompletedEventAttributes) Decode(sr stream.Reader) error {
+func (v *WorkflowExecutionCancelRequestedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -95994,7 +98032,9 @@ func (v *WorkflowExecutionCompletedEventAttributes) Decode(sr stream.Reader) err
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
-			v.Result, err = sr.ReadBinary()
+			var x string
+			x, err = sr.ReadString()
+			v.Cause = &x
 			if err != nil {
 				return err
 			}
@@ -96002,7 +98042,21 @@ func (v *WorkflowExecutionCompletedEventAttributes) Decode(sr stream.Reader) err
 		case fh.ID == 20 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
+			v.ExternalInitiatedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.ExternalWorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
 			if err != nil {
 				return err
 			}
@@ -96029,41 +98083,55 @@ func (v *WorkflowExecutionCompletedEventAttributes) Decode(sr stream.Reader) err
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionCompletedEventAttributes
+// String returns a readable string representation of a WorkflowExecutionCancelRequestedEventAttributes
 // struct.
-func (v *WorkflowExecutionCompletedEventAttributes) String() string {
+func (v *WorkflowExecutionCancelRequestedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.Result != nil {
-		fields[i] = fmt.Sprintf("Result: %v", v.Result)
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.ExternalInitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("ExternalInitiatedEventId: %v", *(v.ExternalInitiatedEventId))
+		i++
+	}
+	if v.ExternalWorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowExecutionCompletedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionCancelRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionCompletedEventAttributes match the
-// provided WorkflowExecutionCompletedEventAttributes.
+// Equals returns true if all the fields of this WorkflowExecutionCancelRequestedEventAttributes match the
+// provided WorkflowExecutionCancelRequestedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionCompletedEventAttributes) Equals(rhs *WorkflowExecutionCompletedEventAttributes) bool {
+func (v *WorkflowExecutionCancelRequestedEventAttributes) Equals(rhs *WorkflowExecutionCancelRequestedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+	if !_String_EqualsPtr(v.Cause, rhs.Cause) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_I64_EqualsPtr(v.ExternalInitiatedEventId, rhs.ExternalInitiatedEventId) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -96071,57 +98139,92 @@ func (v *WorkflowExecutionCompletedEventAttributes) Equals(rhs *WorkflowExecutio
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionCompletedEventAttributes.
-func (v *WorkflowExecutionCompletedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecutionCancelRequestedEventAttributes.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Result != nil {
-		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
+	if v.Cause != nil {
+		enc.AddString("cause", *v.Cause)
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	if v.ExternalInitiatedEventId != nil {
+		enc.AddInt64("externalInitiatedEventId", *v.ExternalInitiatedEventId)
+	}
+	if v.ExternalWorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("externalWorkflowExecution", v.ExternalWorkflowExecution))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
 	return err
 }
 
-// GetResult returns the value of Result if it is set or its
+// GetCause returns the value of Cause if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionCompletedEventAttributes) GetResult() (o []byte) {
-	if v != nil && v.Result != nil {
-		return v.Result
+func (v *WorkflowExecutionCancelRequestedEventAttributes) GetCause() (o string) {
+	if v != nil && v.Cause != nil {
+		return *v.Cause
 	}
 
 	return
 }
 
-// IsSetResult returns true if Result is not nil.
-func (v *WorkflowExecutionCompletedEventAttributes) IsSetResult() bool {
-	return v != nil && v.Result != nil
+// IsSetCause returns true if Cause is not nil.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetCause() bool {
+	return v != nil && v.Cause != nil
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetExternalInitiatedEventId returns the value of ExternalInitiatedEventId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionCompletedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *WorkflowExecutionCancelRequestedEventAttributes) GetExternalInitiatedEventId() (o int64) {
+	if v != nil && v.ExternalInitiatedEventId != nil {
+		return *v.ExternalInitiatedEventId
 	}
 
 	return
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *WorkflowExecutionCompletedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+// IsSetExternalInitiatedEventId returns true if ExternalInitiatedEventId is not nil.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetExternalInitiatedEventId() bool {
+	return v != nil && v.ExternalInitiatedEventId != nil
 }
 
-type WorkflowExecutionConfiguration struct {
-	TaskList                            *TaskList `json:"taskList,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+// GetExternalWorkflowExecution returns the value of ExternalWorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) GetExternalWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.ExternalWorkflowExecution != nil {
+		return v.ExternalWorkflowExecution
+	}
+
+	return
 }
 
-// ToWire translates a WorkflowExecutionConfiguration struct into a Thrift-level intermediate
+// IsSetExternalWorkflowExecution returns true if ExternalWorkflowExecution is not nil.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetExternalWorkflowExecution() bool {
+	return v != nil && v.ExternalWorkflowExecution != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type WorkflowExecutionCanceledEventAttributes struct {
+	DecisionTaskCompletedEventId *int64 `json:"decisionTaskCompletedEventId,omitempty"`
+	Details                      []byte `json:"details,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionCanceledEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -96136,47 +98239,39 @@ type WorkflowExecutionConfiguration struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionConfiguration) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionCanceledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ExecutionStartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ExecutionStartToCloseTimeoutSeconds)), error(nil)
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.TaskStartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.TaskStartToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionConfiguration struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionCanceledEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionConfiguration struct
+// An error is returned if we were unable to build a WorkflowExecutionCanceledEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -96184,39 +98279,29 @@ func (v *WorkflowExecutionConfiguration) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionConfiguration
+//   var v WorkflowExecutionCanceledEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionConfiguration) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionCanceledEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ExecutionStartToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.TaskStartToCloseTimeoutSeconds = &x
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -96228,32 +98313,20 @@ func (v *WorkflowExecutionConfiguration) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionConfiguration struct directly into bytes, without going
+// Encode serializes a WorkflowExecutionCanceledEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionConfiguration struct could not be encoded.
-func (v *WorkflowExecutionConfiguration) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecutionCanceledEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionCanceledEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.TaskList != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.TaskList.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ExecutionStartToCloseTimeoutSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.ExecutionStartToCloseTimeoutSeconds)); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -96261,11 +98334,11 @@ func (v *WorkflowExecutionConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.TaskStartToCloseTimeoutSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+	if v.Details != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.TaskStartToCloseTimeoutSeconds)); err != nil {
+		if err := sw.WriteBinary(v.Details); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -96276,12 +98349,12 @@ func (v *WorkflowExecutionConfiguration) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionConfiguration struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecutionCanceledEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionConfiguration struct could not be generated from the wire
+// An error is returned if a WorkflowExecutionCanceledEventAttributes struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionConfiguration) Decode(sr stream.Reader) error {
+func (v *WorkflowExecutionCanceledEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -96294,24 +98367,16 @@ func (v *WorkflowExecutionConfiguration) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TStruct:
-			v.TaskList, err = _TaskList_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ExecutionStartToCloseTimeoutSeconds = &x
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.TaskStartToCloseTimeoutSeconds = &x
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			v.Details, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
@@ -96338,48 +98403,41 @@ func (v *WorkflowExecutionConfiguration) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionConfiguration
+// String returns a readable string representation of a WorkflowExecutionCanceledEventAttributes
 // struct.
-func (v *WorkflowExecutionConfiguration) String() string {
+func (v *WorkflowExecutionCanceledEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.ExecutionStartToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ExecutionStartToCloseTimeoutSeconds: %v", *(v.ExecutionStartToCloseTimeoutSeconds))
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.TaskStartToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowExecutionConfiguration{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionCanceledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionConfiguration match the
-// provided WorkflowExecutionConfiguration.
+// Equals returns true if all the fields of this WorkflowExecutionCanceledEventAttributes match the
+// provided WorkflowExecutionCanceledEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionConfiguration) Equals(rhs *WorkflowExecutionConfiguration) bool {
+func (v *WorkflowExecutionCanceledEventAttributes) Equals(rhs *WorkflowExecutionCanceledEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.ExecutionStartToCloseTimeoutSeconds, rhs.ExecutionStartToCloseTimeoutSeconds) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
 
@@ -96387,87 +98445,307 @@ func (v *WorkflowExecutionConfiguration) Equals(rhs *WorkflowExecutionConfigurat
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionConfiguration.
-func (v *WorkflowExecutionConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecutionCanceledEventAttributes.
+func (v *WorkflowExecutionCanceledEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	if v.ExecutionStartToCloseTimeoutSeconds != nil {
-		enc.AddInt32("executionStartToCloseTimeoutSeconds", *v.ExecutionStartToCloseTimeoutSeconds)
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
-	if v.TaskStartToCloseTimeoutSeconds != nil {
-		enc.AddInt32("taskStartToCloseTimeoutSeconds", *v.TaskStartToCloseTimeoutSeconds)
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
 	}
 	return err
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionConfiguration) GetTaskList() (o *TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+func (v *WorkflowExecutionCanceledEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
 }
 
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *WorkflowExecutionConfiguration) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *WorkflowExecutionCanceledEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-// GetExecutionStartToCloseTimeoutSeconds returns the value of ExecutionStartToCloseTimeoutSeconds if it is set or its
+// GetDetails returns the value of Details if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionConfiguration) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
-		return *v.ExecutionStartToCloseTimeoutSeconds
+func (v *WorkflowExecutionCanceledEventAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 
 	return
 }
 
-// IsSetExecutionStartToCloseTimeoutSeconds returns true if ExecutionStartToCloseTimeoutSeconds is not nil.
-func (v *WorkflowExecutionConfiguration) IsSetExecutionStartToCloseTimeoutSeconds() bool {
-	return v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil
+// IsSetDetails returns true if Details is not nil.
+func (v *WorkflowExecutionCanceledEventAttributes) IsSetDetails() bool {
+	return v != nil && v.Details != nil
 }
 
-// GetTaskStartToCloseTimeoutSeconds returns the value of TaskStartToCloseTimeoutSeconds if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionConfiguration) GetTaskStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
-		return *v.TaskStartToCloseTimeoutSeconds
+type WorkflowExecutionCloseStatus int32
+
+const (
+	WorkflowExecutionCloseStatusCompleted      WorkflowExecutionCloseStatus = 0
+	WorkflowExecutionCloseStatusFailed         WorkflowExecutionCloseStatus = 1
+	WorkflowExecutionCloseStatusCanceled       WorkflowExecutionCloseStatus = 2
+	WorkflowExecutionCloseStatusTerminated     WorkflowExecutionCloseStatus = 3
+	WorkflowExecutionCloseStatusContinuedAsNew WorkflowExecutionCloseStatus = 4
+	WorkflowExecutionCloseStatusTimedOut       WorkflowExecutionCloseStatus = 5
+)
+
+// WorkflowExecutionCloseStatus_Values returns all recognized values of WorkflowExecutionCloseStatus.
+func WorkflowExecutionCloseStatus_Values() []WorkflowExecutionCloseStatus {
+	return []WorkflowExecutionCloseStatus{
+		WorkflowExecutionCloseStatusCompleted,
+		WorkflowExecutionCloseStatusFailed,
+		WorkflowExecutionCloseStatusCanceled,
+		WorkflowExecutionCloseStatusTerminated,
+		WorkflowExecutionCloseStatusContinuedAsNew,
+		WorkflowExecutionCloseStatusTimedOut,
 	}
+}
 
-	return
+// UnmarshalText tries to decode WorkflowExecutionCloseStatus from a byte slice
+// containing its name.
+//
+//   var v WorkflowExecutionCloseStatus
+//   err := v.UnmarshalText([]byte("COMPLETED"))
+func (v *WorkflowExecutionCloseStatus) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "COMPLETED":
+		*v = WorkflowExecutionCloseStatusCompleted
+		return nil
+	case "FAILED":
+		*v = WorkflowExecutionCloseStatusFailed
+		return nil
+	case "CANCELED":
+		*v = WorkflowExecutionCloseStatusCanceled
+		return nil
+	case "TERMINATED":
+		*v = WorkflowExecutionCloseStatusTerminated
+		return nil
+	case "CONTINUED_AS_NEW":
+		*v = WorkflowExecutionCloseStatusContinuedAsNew
+		return nil
+	case "TIMED_OUT":
+		*v = WorkflowExecutionCloseStatusTimedOut
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowExecutionCloseStatus", err)
+		}
+		*v = WorkflowExecutionCloseStatus(val)
+		return nil
+	}
 }
 
-// IsSetTaskStartToCloseTimeoutSeconds returns true if TaskStartToCloseTimeoutSeconds is not nil.
-func (v *WorkflowExecutionConfiguration) IsSetTaskStartToCloseTimeoutSeconds() bool {
-	return v != nil && v.TaskStartToCloseTimeoutSeconds != nil
+// MarshalText encodes WorkflowExecutionCloseStatus to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v WorkflowExecutionCloseStatus) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("COMPLETED"), nil
+	case 1:
+		return []byte("FAILED"), nil
+	case 2:
+		return []byte("CANCELED"), nil
+	case 3:
+		return []byte("TERMINATED"), nil
+	case 4:
+		return []byte("CONTINUED_AS_NEW"), nil
+	case 5:
+		return []byte("TIMED_OUT"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
-type WorkflowExecutionContinuedAsNewEventAttributes struct {
-	NewExecutionRunId                   *string                 `json:"newExecutionRunId,omitempty"`
-	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
-	TaskList                            *TaskList               `json:"taskList,omitempty"`
-	Input                               []byte                  `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	DecisionTaskCompletedEventId        *int64                  `json:"decisionTaskCompletedEventId,omitempty"`
-	BackoffStartIntervalInSeconds       *int32                  `json:"backoffStartIntervalInSeconds,omitempty"`
-	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
-	FailureReason                       *string                 `json:"failureReason,omitempty"`
-	FailureDetails                      []byte                  `json:"failureDetails,omitempty"`
-	LastCompletionResult                []byte                  `json:"lastCompletionResult,omitempty"`
-	Header                              *Header                 `json:"header,omitempty"`
-	Memo                                *Memo                   `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionCloseStatus.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v WorkflowExecutionCloseStatus) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "COMPLETED")
+	case 1:
+		enc.AddString("name", "FAILED")
+	case 2:
+		enc.AddString("name", "CANCELED")
+	case 3:
+		enc.AddString("name", "TERMINATED")
+	case 4:
+		enc.AddString("name", "CONTINUED_AS_NEW")
+	case 5:
+		enc.AddString("name", "TIMED_OUT")
+	}
+	return nil
 }
 
-// ToWire translates a WorkflowExecutionContinuedAsNewEventAttributes struct into a Thrift-level intermediate
+// Ptr returns a pointer to this enum value.
+func (v WorkflowExecutionCloseStatus) Ptr() *WorkflowExecutionCloseStatus {
+	return &v
+}
+
+// Encode encodes WorkflowExecutionCloseStatus directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v WorkflowExecutionCloseStatus
+//   return v.Encode(sWriter)
+func (v WorkflowExecutionCloseStatus) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates WorkflowExecutionCloseStatus into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v WorkflowExecutionCloseStatus) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes WorkflowExecutionCloseStatus from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return WorkflowExecutionCloseStatus(0), err
+//   }
+//
+//   var v WorkflowExecutionCloseStatus
+//   if err := v.FromWire(x); err != nil {
+//     return WorkflowExecutionCloseStatus(0), err
+//   }
+//   return v, nil
+func (v *WorkflowExecutionCloseStatus) FromWire(w wire.Value) error {
+	*v = (WorkflowExecutionCloseStatus)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded WorkflowExecutionCloseStatus directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v WorkflowExecutionCloseStatus
+//   if err := v.Decode(sReader); err != nil {
+//     return WorkflowExecutionCloseStatus(0), err
+//   }
+//   return v, nil
+func (v *WorkflowExecutionCloseStatus) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (WorkflowExecutionCloseStatus)(i)
+	return nil
+}
+
+// String returns a readable string representation of WorkflowExecutionCloseStatus.
+func (v WorkflowExecutionCloseStatus) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "COMPLETED"
+	case 1:
+		return "FAILED"
+	case 2:
+		return "CANCELED"
+	case 3:
+		return "TERMINATED"
+	case 4:
+		return "CONTINUED_AS_NEW"
+	case 5:
+		return "TIMED_OUT"
+	}
+	return fmt.Sprintf("WorkflowExecutionCloseStatus(%d)", w)
+}
+
+// Equals returns true if this WorkflowExecutionCloseStatus value matches the provided
+// value.
+func (v WorkflowExecutionCloseStatus) Equals(rhs WorkflowExecutionCloseStatus) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes WorkflowExecutionCloseStatus into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v WorkflowExecutionCloseStatus) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"COMPLETED\""), nil
+	case 1:
+		return ([]byte)("\"FAILED\""), nil
+	case 2:
+		return ([]byte)("\"CANCELED\""), nil
+	case 3:
+		return ([]byte)("\"TERMINATED\""), nil
+	case 4:
+		return ([]byte)("\"CONTINUED_AS_NEW\""), nil
+	case 5:
+		return ([]byte)("\"TIMED_OUT\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode WorkflowExecutionCloseStatus from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *WorkflowExecutionCloseStatus) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "WorkflowExecutionCloseStatus")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "WorkflowExecutionCloseStatus")
+		}
+		*v = (WorkflowExecutionCloseStatus)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "WorkflowExecutionCloseStatus")
+	}
+}
+
+type WorkflowExecutionCompletedEventAttributes struct {
+	Result                       []byte `json:"result,omitempty"`
+	DecisionTaskCompletedEventId *int64 `json:"decisionTaskCompletedEventId,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionCompletedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -96482,143 +98760,39 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionCompletedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.NewExecutionRunId != nil {
-		w, err = wire.NewValueString(*(v.NewExecutionRunId)), error(nil)
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Input != nil {
-		w, err = wire.NewValueBinary(v.Input), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ExecutionStartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ExecutionStartToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.TaskStartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.TaskStartToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 	if v.DecisionTaskCompletedEventId != nil {
 		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.BackoffStartIntervalInSeconds != nil {
-		w, err = wire.NewValueI32(*(v.BackoffStartIntervalInSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.Initiator != nil {
-		w, err = v.Initiator.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.FailureReason != nil {
-		w, err = wire.NewValueString(*(v.FailureReason)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.FailureDetails != nil {
-		w, err = wire.NewValueBinary(v.FailureDetails), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
-	if v.LastCompletionResult != nil {
-		w, err = wire.NewValueBinary(v.LastCompletionResult), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
-	}
-	if v.Header != nil {
-		w, err = v.Header.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 130, Value: w}
-		i++
-	}
-	if v.Memo != nil {
-		w, err = v.Memo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 140, Value: w}
-		i++
-	}
-	if v.SearchAttributes != nil {
-		w, err = v.SearchAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 150, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionContinuedAsNewEventAttributes struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionCompletedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionContinuedAsNewEventAttributes struct
+// An error is returned if we were unable to build a WorkflowExecutionCompletedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -96626,71 +98800,25 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, e
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionContinuedAsNewEventAttributes
+//   var v WorkflowExecutionCompletedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionCompletedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.NewExecutionRunId = &x
+				v.Result, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				v.Input, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ExecutionStartToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.TaskStartToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
@@ -96700,96 +98828,26 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) FromWire(w wire.Value)
 				}
 
 			}
-		case 80:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.BackoffStartIntervalInSeconds = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 90:
-			if field.Value.Type() == wire.TI32 {
-				var x ContinueAsNewInitiator
-				x, err = _ContinueAsNewInitiator_Read(field.Value)
-				v.Initiator = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 100:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.FailureReason = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TBinary {
-				v.FailureDetails, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 120:
-			if field.Value.Type() == wire.TBinary {
-				v.LastCompletionResult, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 130:
-			if field.Value.Type() == wire.TStruct {
-				v.Header, err = _Header_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 140:
-			if field.Value.Type() == wire.TStruct {
-				v.Memo, err = _Memo_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 150:
-			if field.Value.Type() == wire.TStruct {
-				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// Encode serializes a WorkflowExecutionContinuedAsNewEventAttributes struct directly into bytes, without going
+// Encode serializes a WorkflowExecutionCompletedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionContinuedAsNewEventAttributes struct could not be encoded.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecutionCompletedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionCompletedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.NewExecutionRunId != nil {
+	if v.Result != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.NewExecutionRunId)); err != nil {
+		if err := sw.WriteBinary(v.Result); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -96797,11 +98855,11 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Encode(sw stream.Writer
 		}
 	}
 
-	if v.WorkflowType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := v.WorkflowType.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -96809,131 +98867,276 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Encode(sw stream.Writer
 		}
 	}
 
-	if v.TaskList != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.TaskList.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionCompletedEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionCompletedEventAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionCompletedEventAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	if v.Input != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.Input); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	if v.ExecutionStartToCloseTimeoutSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
-			return err
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			v.Result, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
 		}
-		if err := sw.WriteInt32(*(v.ExecutionStartToCloseTimeoutSeconds)); err != nil {
+
+		if err := sr.ReadFieldEnd(); err != nil {
 			return err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
 			return err
 		}
 	}
 
-	if v.TaskStartToCloseTimeoutSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.TaskStartToCloseTimeoutSeconds)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionCompletedEventAttributes
+// struct.
+func (v *WorkflowExecutionCompletedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
+	var fields [2]string
+	i := 0
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
+		i++
+	}
 	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
 	}
 
-	if v.BackoffStartIntervalInSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.BackoffStartIntervalInSeconds)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	return fmt.Sprintf("WorkflowExecutionCompletedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionCompletedEventAttributes match the
+// provided WorkflowExecutionCompletedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionCompletedEventAttributes) Equals(rhs *WorkflowExecutionCompletedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
 	}
 
-	if v.Initiator != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := v.Initiator.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionCompletedEventAttributes.
+func (v *WorkflowExecutionCompletedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Result != nil {
+		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
+	return err
+}
 
-	if v.FailureReason != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.FailureReason)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// GetResult returns the value of Result if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionCompletedEventAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
 	}
 
-	if v.FailureDetails != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
-			return err
+	return
+}
+
+// IsSetResult returns true if Result is not nil.
+func (v *WorkflowExecutionCompletedEventAttributes) IsSetResult() bool {
+	return v != nil && v.Result != nil
+}
+
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionCompletedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
+}
+
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *WorkflowExecutionCompletedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
+}
+
+type WorkflowExecutionConfiguration struct {
+	TaskList                            *TaskList `json:"taskList,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionConfiguration struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionConfiguration) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteBinary(v.FailureDetails); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ExecutionStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.TaskStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
 
-	if v.LastCompletionResult != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.LastCompletionResult); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionConfiguration struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionConfiguration struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionConfiguration
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionConfiguration) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExecutionStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
-	if v.Header != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TStruct}); err != nil {
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionConfiguration struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionConfiguration struct could not be encoded.
+func (v *WorkflowExecutionConfiguration) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Header.Encode(sw); err != nil {
+		if err := v.TaskList.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -96941,11 +99144,11 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Encode(sw stream.Writer
 		}
 	}
 
-	if v.Memo != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TStruct}); err != nil {
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.Memo.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.ExecutionStartToCloseTimeoutSeconds)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -96953,11 +99156,11 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Encode(sw stream.Writer
 		}
 	}
 
-	if v.SearchAttributes != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TStruct}); err != nil {
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.SearchAttributes.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.TaskStartToCloseTimeoutSeconds)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -96968,12 +99171,12 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Encode(sw stream.Writer
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionContinuedAsNewEventAttributes struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecutionConfiguration struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionContinuedAsNewEventAttributes struct could not be generated from the wire
+// An error is returned if a WorkflowExecutionConfiguration struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) Decode(sr stream.Reader) error {
+func (v *WorkflowExecutionConfiguration) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -96986,33 +99189,13 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Decode(sr stream.Reader
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.NewExecutionRunId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.WorkflowType, err = _WorkflowType_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TStruct:
+		case fh.ID == 10 && fh.Type == wire.TStruct:
 			v.TaskList, err = _TaskList_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			v.Input, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TI32:
+		case fh.ID == 20 && fh.Type == wire.TI32:
 			var x int32
 			x, err = sr.ReadInt32()
 			v.ExecutionStartToCloseTimeoutSeconds = &x
@@ -97020,7 +99203,7 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Decode(sr stream.Reader
 				return err
 			}
 
-		case fh.ID == 60 && fh.Type == wire.TI32:
+		case fh.ID == 30 && fh.Type == wire.TI32:
 			var x int32
 			x, err = sr.ReadInt32()
 			v.TaskStartToCloseTimeoutSeconds = &x
@@ -97028,68 +99211,6 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Decode(sr stream.Reader
 				return err
 			}
 
-		case fh.ID == 70 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 80 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.BackoffStartIntervalInSeconds = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 90 && fh.Type == wire.TI32:
-			var x ContinueAsNewInitiator
-			x, err = _ContinueAsNewInitiator_Decode(sr)
-			v.Initiator = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 100 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.FailureReason = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 110 && fh.Type == wire.TBinary:
-			v.FailureDetails, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 120 && fh.Type == wire.TBinary:
-			v.LastCompletionResult, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 130 && fh.Type == wire.TStruct:
-			v.Header, err = _Header_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 140 && fh.Type == wire.TStruct:
-			v.Memo, err = _Memo_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 150 && fh.Type == wire.TStruct:
-			v.SearchAttributes, err = _SearchAttributes_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -97112,31 +99233,19 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Decode(sr stream.Reader
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionContinuedAsNewEventAttributes
+// String returns a readable string representation of a WorkflowExecutionConfiguration
 // struct.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
+func (v *WorkflowExecutionConfiguration) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [15]string
+	var fields [3]string
 	i := 0
-	if v.NewExecutionRunId != nil {
-		fields[i] = fmt.Sprintf("NewExecutionRunId: %v", *(v.NewExecutionRunId))
-		i++
-	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
 	if v.TaskList != nil {
 		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
 		i++
 	}
-	if v.Input != nil {
-		fields[i] = fmt.Sprintf("Input: %v", v.Input)
-		i++
-	}
 	if v.ExecutionStartToCloseTimeoutSeconds != nil {
 		fields[i] = fmt.Sprintf("ExecutionStartToCloseTimeoutSeconds: %v", *(v.ExecutionStartToCloseTimeoutSeconds))
 		i++
@@ -97145,192 +99254,54 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
-		i++
-	}
-	if v.BackoffStartIntervalInSeconds != nil {
-		fields[i] = fmt.Sprintf("BackoffStartIntervalInSeconds: %v", *(v.BackoffStartIntervalInSeconds))
-		i++
-	}
-	if v.Initiator != nil {
-		fields[i] = fmt.Sprintf("Initiator: %v", *(v.Initiator))
-		i++
-	}
-	if v.FailureReason != nil {
-		fields[i] = fmt.Sprintf("FailureReason: %v", *(v.FailureReason))
-		i++
-	}
-	if v.FailureDetails != nil {
-		fields[i] = fmt.Sprintf("FailureDetails: %v", v.FailureDetails)
-		i++
-	}
-	if v.LastCompletionResult != nil {
-		fields[i] = fmt.Sprintf("LastCompletionResult: %v", v.LastCompletionResult)
-		i++
-	}
-	if v.Header != nil {
-		fields[i] = fmt.Sprintf("Header: %v", v.Header)
-		i++
-	}
-	if v.Memo != nil {
-		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
-		i++
-	}
-	if v.SearchAttributes != nil {
-		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
-		i++
-	}
 
-	return fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionContinuedAsNewEventAttributes match the
-// provided WorkflowExecutionContinuedAsNewEventAttributes.
+// Equals returns true if all the fields of this WorkflowExecutionConfiguration match the
+// provided WorkflowExecutionConfiguration.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) Equals(rhs *WorkflowExecutionContinuedAsNewEventAttributes) bool {
+func (v *WorkflowExecutionConfiguration) Equals(rhs *WorkflowExecutionConfiguration) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.NewExecutionRunId, rhs.NewExecutionRunId) {
-		return false
-	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
-		return false
-	}
 	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
 		return false
 	}
-	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
-		return false
-	}
 	if !_I32_EqualsPtr(v.ExecutionStartToCloseTimeoutSeconds, rhs.ExecutionStartToCloseTimeoutSeconds) {
 		return false
 	}
 	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.BackoffStartIntervalInSeconds, rhs.BackoffStartIntervalInSeconds) {
-		return false
-	}
-	if !_ContinueAsNewInitiator_EqualsPtr(v.Initiator, rhs.Initiator) {
-		return false
-	}
-	if !_String_EqualsPtr(v.FailureReason, rhs.FailureReason) {
-		return false
-	}
-	if !((v.FailureDetails == nil && rhs.FailureDetails == nil) || (v.FailureDetails != nil && rhs.FailureDetails != nil && bytes.Equal(v.FailureDetails, rhs.FailureDetails))) {
-		return false
-	}
-	if !((v.LastCompletionResult == nil && rhs.LastCompletionResult == nil) || (v.LastCompletionResult != nil && rhs.LastCompletionResult != nil && bytes.Equal(v.LastCompletionResult, rhs.LastCompletionResult))) {
-		return false
-	}
-	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
-		return false
-	}
-	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && v.Memo.Equals(rhs.Memo))) {
-		return false
-	}
-	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionContinuedAsNewEventAttributes.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecutionConfiguration.
+func (v *WorkflowExecutionConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.NewExecutionRunId != nil {
-		enc.AddString("newExecutionRunId", *v.NewExecutionRunId)
-	}
-	if v.WorkflowType != nil {
-		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
-	}
 	if v.TaskList != nil {
 		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
 	}
-	if v.Input != nil {
-		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
-	}
 	if v.ExecutionStartToCloseTimeoutSeconds != nil {
 		enc.AddInt32("executionStartToCloseTimeoutSeconds", *v.ExecutionStartToCloseTimeoutSeconds)
 	}
 	if v.TaskStartToCloseTimeoutSeconds != nil {
 		enc.AddInt32("taskStartToCloseTimeoutSeconds", *v.TaskStartToCloseTimeoutSeconds)
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
-	}
-	if v.BackoffStartIntervalInSeconds != nil {
-		enc.AddInt32("backoffStartIntervalInSeconds", *v.BackoffStartIntervalInSeconds)
-	}
-	if v.Initiator != nil {
-		err = multierr.Append(err, enc.AddObject("initiator", *v.Initiator))
-	}
-	if v.FailureReason != nil {
-		enc.AddString("failureReason", *v.FailureReason)
-	}
-	if v.FailureDetails != nil {
-		enc.AddString("failureDetails", base64.StdEncoding.EncodeToString(v.FailureDetails))
-	}
-	if v.LastCompletionResult != nil {
-		enc.AddString("lastCompletionResult", base64.StdEncoding.EncodeToString(v.LastCompletionResult))
-	}
-	if v.Header != nil {
-		err = multierr.Append(err, enc.AddObject("header", v.Header))
-	}
-	if v.Memo != nil {
-		err = multierr.Append(err, enc.AddObject("memo", v.Memo))
-	}
-	if v.SearchAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
-	}
 	return err
 }
 
-// GetNewExecutionRunId returns the value of NewExecutionRunId if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetNewExecutionRunId() (o string) {
-	if v != nil && v.NewExecutionRunId != nil {
-		return *v.NewExecutionRunId
-	}
-
-	return
-}
-
-// IsSetNewExecutionRunId returns true if NewExecutionRunId is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetNewExecutionRunId() bool {
-	return v != nil && v.NewExecutionRunId != nil
-}
-
-// GetWorkflowType returns the value of WorkflowType if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetWorkflowType() (o *WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
-	}
-
-	return
-}
-
-// IsSetWorkflowType returns true if WorkflowType is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetWorkflowType() bool {
-	return v != nil && v.WorkflowType != nil
-}
-
 // GetTaskList returns the value of TaskList if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetTaskList() (o *TaskList) {
+func (v *WorkflowExecutionConfiguration) GetTaskList() (o *TaskList) {
 	if v != nil && v.TaskList != nil {
 		return v.TaskList
 	}
@@ -97339,28 +99310,13 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetTaskList() (o *TaskL
 }
 
 // IsSetTaskList returns true if TaskList is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetTaskList() bool {
+func (v *WorkflowExecutionConfiguration) IsSetTaskList() bool {
 	return v != nil && v.TaskList != nil
 }
 
-// GetInput returns the value of Input if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetInput() (o []byte) {
-	if v != nil && v.Input != nil {
-		return v.Input
-	}
-
-	return
-}
-
-// IsSetInput returns true if Input is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetInput() bool {
-	return v != nil && v.Input != nil
-}
-
 // GetExecutionStartToCloseTimeoutSeconds returns the value of ExecutionStartToCloseTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+func (v *WorkflowExecutionConfiguration) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
 	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
 		return *v.ExecutionStartToCloseTimeoutSeconds
 	}
@@ -97369,13 +99325,13 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetExecutionStartToClos
 }
 
 // IsSetExecutionStartToCloseTimeoutSeconds returns true if ExecutionStartToCloseTimeoutSeconds is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetExecutionStartToCloseTimeoutSeconds() bool {
+func (v *WorkflowExecutionConfiguration) IsSetExecutionStartToCloseTimeoutSeconds() bool {
 	return v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil
 }
 
 // GetTaskStartToCloseTimeoutSeconds returns the value of TaskStartToCloseTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+func (v *WorkflowExecutionConfiguration) GetTaskStartToCloseTimeoutSeconds() (o int32) {
 	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
 		return *v.TaskStartToCloseTimeoutSeconds
 	}
@@ -97384,152 +99340,29 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetTaskStartToCloseTime
 }
 
 // IsSetTaskStartToCloseTimeoutSeconds returns true if TaskStartToCloseTimeoutSeconds is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetTaskStartToCloseTimeoutSeconds() bool {
+func (v *WorkflowExecutionConfiguration) IsSetTaskStartToCloseTimeoutSeconds() bool {
 	return v != nil && v.TaskStartToCloseTimeoutSeconds != nil
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
-	}
-
-	return
-}
-
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
-}
-
-// GetBackoffStartIntervalInSeconds returns the value of BackoffStartIntervalInSeconds if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetBackoffStartIntervalInSeconds() (o int32) {
-	if v != nil && v.BackoffStartIntervalInSeconds != nil {
-		return *v.BackoffStartIntervalInSeconds
-	}
-
-	return
-}
-
-// IsSetBackoffStartIntervalInSeconds returns true if BackoffStartIntervalInSeconds is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetBackoffStartIntervalInSeconds() bool {
-	return v != nil && v.BackoffStartIntervalInSeconds != nil
-}
-
-// GetInitiator returns the value of Initiator if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
-	if v != nil && v.Initiator != nil {
-		return *v.Initiator
-	}
-
-	return
-}
-
-// IsSetInitiator returns true if Initiator is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetInitiator() bool {
-	return v != nil && v.Initiator != nil
-}
-
-// GetFailureReason returns the value of FailureReason if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetFailureReason() (o string) {
-	if v != nil && v.FailureReason != nil {
-		return *v.FailureReason
-	}
-
-	return
-}
-
-// IsSetFailureReason returns true if FailureReason is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetFailureReason() bool {
-	return v != nil && v.FailureReason != nil
-}
-
-// GetFailureDetails returns the value of FailureDetails if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetFailureDetails() (o []byte) {
-	if v != nil && v.FailureDetails != nil {
-		return v.FailureDetails
-	}
-
-	return
-}
-
-// IsSetFailureDetails returns true if FailureDetails is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetFailureDetails() bool {
-	return v != nil && v.FailureDetails != nil
-}
-
-// GetLastCompletionResult returns the value of LastCompletionResult if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetLastCompletionResult() (o []byte) {
-	if v != nil && v.LastCompletionResult != nil {
-		return v.LastCompletionResult
-	}
-
-	return
-}
-
-// IsSetLastCompletionResult returns true if LastCompletionResult is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetLastCompletionResult() bool {
-	return v != nil && v.LastCompletionResult != nil
-}
-
-// GetHeader returns the value of Header if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetHeader() (o *Header) {
-	if v != nil && v.Header != nil {
-		return v.Header
-	}
-
-	return
-}
-
-// IsSetHeader returns true if Header is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetHeader() bool {
-	return v != nil && v.Header != nil
-}
-
-// GetMemo returns the value of Memo if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetMemo() (o *Memo) {
-	if v != nil && v.Memo != nil {
-		return v.Memo
-	}
-
-	return
-}
-
-// IsSetMemo returns true if Memo is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetMemo() bool {
-	return v != nil && v.Memo != nil
-}
-
-// GetSearchAttributes returns the value of SearchAttributes if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
-	}
-
-	return
-}
-
-// IsSetSearchAttributes returns true if SearchAttributes is not nil.
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetSearchAttributes() bool {
-	return v != nil && v.SearchAttributes != nil
-}
-
-type WorkflowExecutionFailedEventAttributes struct {
-	Reason                       *string `json:"reason,omitempty"`
-	Details                      []byte  `json:"details,omitempty"`
-	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+type WorkflowExecutionContinuedAsNewEventAttributes struct {
+	NewExecutionRunId                   *string                 `json:"newExecutionRunId,omitempty"`
+	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
+	TaskList                            *TaskList               `json:"taskList,omitempty"`
+	Input                               []byte                  `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	DecisionTaskCompletedEventId        *int64                  `json:"decisionTaskCompletedEventId,omitempty"`
+	BackoffStartIntervalInSeconds       *int32                  `json:"backoffStartIntervalInSeconds,omitempty"`
+	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
+	FailureReason                       *string                 `json:"failureReason,omitempty"`
+	FailureDetails                      []byte                  `json:"failureDetails,omitempty"`
+	LastCompletionResult                []byte                  `json:"lastCompletionResult,omitempty"`
+	Header                              *Header                 `json:"header,omitempty"`
+	Memo                                *Memo                   `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 }
 
-// ToWire translates a WorkflowExecutionFailedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a WorkflowExecutionContinuedAsNewEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -97544,47 +99377,143 @@ type WorkflowExecutionFailedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [15]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+	if v.NewExecutionRunId != nil {
+		w, err = wire.NewValueString(*(v.NewExecutionRunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ExecutionStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.TaskStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 	if v.DecisionTaskCompletedEventId != nil {
 		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.BackoffStartIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.Initiator != nil {
+		w, err = v.Initiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.FailureReason != nil {
+		w, err = wire.NewValueString(*(v.FailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.FailureDetails != nil {
+		w, err = wire.NewValueBinary(v.FailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.LastCompletionResult != nil {
+		w, err = wire.NewValueBinary(v.LastCompletionResult), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.Header != nil {
+		w, err = v.Header.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.Memo != nil {
+		w, err = v.Memo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionFailedEventAttributes struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionContinuedAsNewEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionFailedEventAttributes struct
+// An error is returned if we were unable to build a WorkflowExecutionContinuedAsNewEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -97592,12 +99521,12 @@ func (v *WorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionFailedEventAttributes
+//   var v WorkflowExecutionContinuedAsNewEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -97606,21 +99535,57 @@ func (v *WorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
+				v.NewExecutionRunId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExecutionStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
@@ -97630,26 +99595,96 @@ func (v *WorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
 				}
 
 			}
-		}
-	}
-
-	return nil
-}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.BackoffStartIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
 
-// Encode serializes a WorkflowExecutionFailedEventAttributes struct directly into bytes, without going
+			}
+		case 90:
+			if field.Value.Type() == wire.TI32 {
+				var x ContinueAsNewInitiator
+				x, err = _ContinueAsNewInitiator_Read(field.Value)
+				v.Initiator = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				v.FailureDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBinary {
+				v.LastCompletionResult, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TStruct {
+				v.Header, err = _Header_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TStruct {
+				v.Memo, err = _Memo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 150:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionContinuedAsNewEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionFailedEventAttributes struct could not be encoded.
-func (v *WorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecutionContinuedAsNewEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Reason != nil {
+	if v.NewExecutionRunId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Reason)); err != nil {
+		if err := sw.WriteString(*(v.NewExecutionRunId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -97657,11 +99692,59 @@ func (v *WorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error
 		}
 	}
 
-	if v.Details != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.WorkflowType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.Details); err != nil {
+		if err := v.WorkflowType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TaskList.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Input != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Input); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ExecutionStartToCloseTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.TaskStartToCloseTimeoutSeconds)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -97670,7 +99753,7 @@ func (v *WorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error
 	}
 
 	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI64}); err != nil {
 			return err
 		}
 		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
@@ -97681,15 +99764,111 @@ func (v *WorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error
 		}
 	}
 
+	if v.BackoffStartIntervalInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.BackoffStartIntervalInSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Initiator != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Initiator.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FailureReason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.FailureReason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FailureDetails != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.FailureDetails); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastCompletionResult != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.LastCompletionResult); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Header != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Header.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Memo != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Memo.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SearchAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.SearchAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionFailedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecutionContinuedAsNewEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionFailedEventAttributes struct could not be generated from the wire
+// An error is returned if a WorkflowExecutionContinuedAsNewEventAttributes struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error {
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -97705,18 +99884,46 @@ func (v *WorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Reason = &x
+			v.NewExecutionRunId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			v.Details, err = sr.ReadBinary()
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowType, err = _WorkflowType_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TI64:
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.TaskList, err = _TaskList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			v.Input, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ExecutionStartToCloseTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.TaskStartToCloseTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
 			v.DecisionTaskCompletedEventId = &x
@@ -97724,6 +99931,60 @@ func (v *WorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error
 				return err
 			}
 
+		case fh.ID == 80 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.BackoffStartIntervalInSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TI32:
+			var x ContinueAsNewInitiator
+			x, err = _ContinueAsNewInitiator_Decode(sr)
+			v.Initiator = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FailureReason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TBinary:
+			v.FailureDetails, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 120 && fh.Type == wire.TBinary:
+			v.LastCompletionResult, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 130 && fh.Type == wire.TStruct:
+			v.Header, err = _Header_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 140 && fh.Type == wire.TStruct:
+			v.Memo, err = _Memo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 150 && fh.Type == wire.TStruct:
+			v.SearchAttributes, err = _SearchAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -97746,105 +100007,285 @@ func (v *WorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionFailedEventAttributes
+// String returns a readable string representation of a WorkflowExecutionContinuedAsNewEventAttributes
 // struct.
-func (v *WorkflowExecutionFailedEventAttributes) String() string {
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [15]string
 	i := 0
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+	if v.NewExecutionRunId != nil {
+		fields[i] = fmt.Sprintf("NewExecutionRunId: %v", *(v.NewExecutionRunId))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ExecutionStartToCloseTimeoutSeconds: %v", *(v.ExecutionStartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
 		i++
 	}
 	if v.DecisionTaskCompletedEventId != nil {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("BackoffStartIntervalInSeconds: %v", *(v.BackoffStartIntervalInSeconds))
+		i++
+	}
+	if v.Initiator != nil {
+		fields[i] = fmt.Sprintf("Initiator: %v", *(v.Initiator))
+		i++
+	}
+	if v.FailureReason != nil {
+		fields[i] = fmt.Sprintf("FailureReason: %v", *(v.FailureReason))
+		i++
+	}
+	if v.FailureDetails != nil {
+		fields[i] = fmt.Sprintf("FailureDetails: %v", v.FailureDetails)
+		i++
+	}
+	if v.LastCompletionResult != nil {
+		fields[i] = fmt.Sprintf("LastCompletionResult: %v", v.LastCompletionResult)
+		i++
+	}
+	if v.Header != nil {
+		fields[i] = fmt.Sprintf("Header: %v", v.Header)
+		i++
+	}
+	if v.Memo != nil {
+		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
-	return fmt.Sprintf("WorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionFailedEventAttributes match the
-// provided WorkflowExecutionFailedEventAttributes.
+// Equals returns true if all the fields of this WorkflowExecutionContinuedAsNewEventAttributes match the
+// provided WorkflowExecutionContinuedAsNewEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionFailedEventAttributes) Equals(rhs *WorkflowExecutionFailedEventAttributes) bool {
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) Equals(rhs *WorkflowExecutionContinuedAsNewEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+	if !_String_EqualsPtr(v.NewExecutionRunId, rhs.NewExecutionRunId) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ExecutionStartToCloseTimeoutSeconds, rhs.ExecutionStartToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
 		return false
 	}
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.BackoffStartIntervalInSeconds, rhs.BackoffStartIntervalInSeconds) {
+		return false
+	}
+	if !_ContinueAsNewInitiator_EqualsPtr(v.Initiator, rhs.Initiator) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailureReason, rhs.FailureReason) {
+		return false
+	}
+	if !((v.FailureDetails == nil && rhs.FailureDetails == nil) || (v.FailureDetails != nil && rhs.FailureDetails != nil && bytes.Equal(v.FailureDetails, rhs.FailureDetails))) {
+		return false
+	}
+	if !((v.LastCompletionResult == nil && rhs.LastCompletionResult == nil) || (v.LastCompletionResult != nil && rhs.LastCompletionResult != nil && bytes.Equal(v.LastCompletionResult, rhs.LastCompletionResult))) {
+		return false
+	}
+	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
+		return false
+	}
+	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && v.Memo.Equals(rhs.Memo))) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionFailedEventAttributes.
-func (v *WorkflowExecutionFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecutionContinuedAsNewEventAttributes.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Reason != nil {
-		enc.AddString("reason", *v.Reason)
+	if v.NewExecutionRunId != nil {
+		enc.AddString("newExecutionRunId", *v.NewExecutionRunId)
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.WorkflowType != nil {
+		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
+	}
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		enc.AddInt32("executionStartToCloseTimeoutSeconds", *v.ExecutionStartToCloseTimeoutSeconds)
+	}
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		enc.AddInt32("taskStartToCloseTimeoutSeconds", *v.TaskStartToCloseTimeoutSeconds)
 	}
 	if v.DecisionTaskCompletedEventId != nil {
 		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		enc.AddInt32("backoffStartIntervalInSeconds", *v.BackoffStartIntervalInSeconds)
+	}
+	if v.Initiator != nil {
+		err = multierr.Append(err, enc.AddObject("initiator", *v.Initiator))
+	}
+	if v.FailureReason != nil {
+		enc.AddString("failureReason", *v.FailureReason)
+	}
+	if v.FailureDetails != nil {
+		enc.AddString("failureDetails", base64.StdEncoding.EncodeToString(v.FailureDetails))
+	}
+	if v.LastCompletionResult != nil {
+		enc.AddString("lastCompletionResult", base64.StdEncoding.EncodeToString(v.LastCompletionResult))
+	}
+	if v.Header != nil {
+		err = multierr.Append(err, enc.AddObject("header", v.Header))
+	}
+	if v.Memo != nil {
+		err = multierr.Append(err, enc.AddObject("memo", v.Memo))
+	}
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
+	}
 	return err
 }
 
-// GetReason returns the value of Reason if it is set or its
+// GetNewExecutionRunId returns the value of NewExecutionRunId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionFailedEventAttributes) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetNewExecutionRunId() (o string) {
+	if v != nil && v.NewExecutionRunId != nil {
+		return *v.NewExecutionRunId
 	}
 
 	return
 }
 
-// IsSetReason returns true if Reason is not nil.
-func (v *WorkflowExecutionFailedEventAttributes) IsSetReason() bool {
-	return v != nil && v.Reason != nil
+// IsSetNewExecutionRunId returns true if NewExecutionRunId is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetNewExecutionRunId() bool {
+	return v != nil && v.NewExecutionRunId != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetWorkflowType returns the value of WorkflowType if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionFailedEventAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
-	}
-
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *WorkflowExecutionFailedEventAttributes) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetWorkflowType returns true if WorkflowType is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetWorkflowType() bool {
+	return v != nil && v.WorkflowType != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
+}
+
+// IsSetInput returns true if Input is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetInput() bool {
+	return v != nil && v.Input != nil
+}
+
+// GetExecutionStartToCloseTimeoutSeconds returns the value of ExecutionStartToCloseTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetExecutionStartToCloseTimeoutSeconds returns true if ExecutionStartToCloseTimeoutSeconds is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetExecutionStartToCloseTimeoutSeconds() bool {
+	return v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil
+}
+
+// GetTaskStartToCloseTimeoutSeconds returns the value of TaskStartToCloseTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetTaskStartToCloseTimeoutSeconds returns true if TaskStartToCloseTimeoutSeconds is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetTaskStartToCloseTimeoutSeconds() bool {
+	return v != nil && v.TaskStartToCloseTimeoutSeconds != nil
 }
 
 // GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
 	if v != nil && v.DecisionTaskCompletedEventId != nil {
 		return *v.DecisionTaskCompletedEventId
 	}
@@ -97853,16 +100294,137 @@ func (v *WorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId
 }
 
 // IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *WorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
 	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-type WorkflowExecutionFilter struct {
-	WorkflowId *string `json:"workflowId,omitempty"`
-	RunId      *string `json:"runId,omitempty"`
+// GetBackoffStartIntervalInSeconds returns the value of BackoffStartIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetBackoffStartIntervalInSeconds() (o int32) {
+	if v != nil && v.BackoffStartIntervalInSeconds != nil {
+		return *v.BackoffStartIntervalInSeconds
+	}
+
+	return
 }
 
-// ToWire translates a WorkflowExecutionFilter struct into a Thrift-level intermediate
+// IsSetBackoffStartIntervalInSeconds returns true if BackoffStartIntervalInSeconds is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetBackoffStartIntervalInSeconds() bool {
+	return v != nil && v.BackoffStartIntervalInSeconds != nil
+}
+
+// GetInitiator returns the value of Initiator if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
+	if v != nil && v.Initiator != nil {
+		return *v.Initiator
+	}
+
+	return
+}
+
+// IsSetInitiator returns true if Initiator is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetInitiator() bool {
+	return v != nil && v.Initiator != nil
+}
+
+// GetFailureReason returns the value of FailureReason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetFailureReason() (o string) {
+	if v != nil && v.FailureReason != nil {
+		return *v.FailureReason
+	}
+
+	return
+}
+
+// IsSetFailureReason returns true if FailureReason is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetFailureReason() bool {
+	return v != nil && v.FailureReason != nil
+}
+
+// GetFailureDetails returns the value of FailureDetails if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetFailureDetails() (o []byte) {
+	if v != nil && v.FailureDetails != nil {
+		return v.FailureDetails
+	}
+
+	return
+}
+
+// IsSetFailureDetails returns true if FailureDetails is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetFailureDetails() bool {
+	return v != nil && v.FailureDetails != nil
+}
+
+// GetLastCompletionResult returns the value of LastCompletionResult if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetLastCompletionResult() (o []byte) {
+	if v != nil && v.LastCompletionResult != nil {
+		return v.LastCompletionResult
+	}
+
+	return
+}
+
+// IsSetLastCompletionResult returns true if LastCompletionResult is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetLastCompletionResult() bool {
+	return v != nil && v.LastCompletionResult != nil
+}
+
+// GetHeader returns the value of Header if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+
+	return
+}
+
+// IsSetHeader returns true if Header is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetHeader() bool {
+	return v != nil && v.Header != nil
+}
+
+// GetMemo returns the value of Memo if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+
+	return
+}
+
+// IsSetMemo returns true if Memo is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetMemo() bool {
+	return v != nil && v.Memo != nil
+}
+
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+
+	return
+}
+
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
+}
+
+type WorkflowExecutionFailedEventAttributes struct {
+	Reason                       *string `json:"reason,omitempty"`
+	Details                      []byte  `json:"details,omitempty"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionFailedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -97877,39 +100439,47 @@ type WorkflowExecutionFilter struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionFilter) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionFilter struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionFailedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionFilter struct
+// An error is returned if we were unable to build a WorkflowExecutionFailedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -97917,12 +100487,12 @@ func (v *WorkflowExecutionFilter) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionFilter
+//   var v WorkflowExecutionFailedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionFilter) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -97931,7 +100501,7 @@ func (v *WorkflowExecutionFilter) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+				v.Reason = &x
 				if err != nil {
 					return err
 				}
@@ -97939,9 +100509,17 @@ func (v *WorkflowExecutionFilter) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -97953,20 +100531,20 @@ func (v *WorkflowExecutionFilter) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionFilter struct directly into bytes, without going
+// Encode serializes a WorkflowExecutionFailedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionFilter struct could not be encoded.
-func (v *WorkflowExecutionFilter) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecutionFailedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.WorkflowId != nil {
+	if v.Reason != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+		if err := sw.WriteString(*(v.Reason)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -97974,11 +100552,23 @@ func (v *WorkflowExecutionFilter) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RunId != nil {
+	if v.Details != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunId)); err != nil {
+		if err := sw.WriteBinary(v.Details); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -97989,12 +100579,12 @@ func (v *WorkflowExecutionFilter) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionFilter struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecutionFailedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionFilter struct could not be generated from the wire
+// An error is returned if a WorkflowExecutionFailedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionFilter) Decode(sr stream.Reader) error {
+func (v *WorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -98010,15 +100600,21 @@ func (v *WorkflowExecutionFilter) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.WorkflowId = &x
+			v.Reason = &x
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RunId = &x
+			v.Details, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
 			if err != nil {
 				return err
 			}
@@ -98045,41 +100641,48 @@ func (v *WorkflowExecutionFilter) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionFilter
+// String returns a readable string representation of a WorkflowExecutionFailedEventAttributes
 // struct.
-func (v *WorkflowExecutionFilter) String() string {
+func (v *WorkflowExecutionFailedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
 		i++
 	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowExecutionFilter{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionFilter match the
-// provided WorkflowExecutionFilter.
+// Equals returns true if all the fields of this WorkflowExecutionFailedEventAttributes match the
+// provided WorkflowExecutionFailedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionFilter) Equals(rhs *WorkflowExecutionFilter) bool {
+func (v *WorkflowExecutionFailedEventAttributes) Equals(rhs *WorkflowExecutionFailedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
 
@@ -98087,69 +100690,74 @@ func (v *WorkflowExecutionFilter) Equals(rhs *WorkflowExecutionFilter) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionFilter.
-func (v *WorkflowExecutionFilter) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecutionFailedEventAttributes.
+func (v *WorkflowExecutionFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
 	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
 	return err
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
+// GetReason returns the value of Reason if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionFilter) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
+func (v *WorkflowExecutionFailedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
 	}
 
 	return
 }
 
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *WorkflowExecutionFilter) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
+// IsSetReason returns true if Reason is not nil.
+func (v *WorkflowExecutionFailedEventAttributes) IsSetReason() bool {
+	return v != nil && v.Reason != nil
 }
 
-// GetRunId returns the value of RunId if it is set or its
+// GetDetails returns the value of Details if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionFilter) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+func (v *WorkflowExecutionFailedEventAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 
 	return
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *WorkflowExecutionFilter) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+// IsSetDetails returns true if Details is not nil.
+func (v *WorkflowExecutionFailedEventAttributes) IsSetDetails() bool {
+	return v != nil && v.Details != nil
 }
 
-type WorkflowExecutionInfo struct {
-	Execution        *WorkflowExecution            `json:"execution,omitempty"`
-	Type             *WorkflowType                 `json:"type,omitempty"`
-	StartTime        *int64                        `json:"startTime,omitempty"`
-	CloseTime        *int64                        `json:"closeTime,omitempty"`
-	CloseStatus      *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-	HistoryLength    *int64                        `json:"historyLength,omitempty"`
-	ParentDomainId   *string                       `json:"parentDomainId,omitempty"`
-	ParentExecution  *WorkflowExecution            `json:"parentExecution,omitempty"`
-	ExecutionTime    *int64                        `json:"executionTime,omitempty"`
-	Memo             *Memo                         `json:"memo,omitempty"`
-	SearchAttributes *SearchAttributes             `json:"searchAttributes,omitempty"`
-	AutoResetPoints  *ResetPoints                  `json:"autoResetPoints,omitempty"`
-	TaskList         *string                       `json:"taskList,omitempty"`
-	IsCron           *bool                         `json:"isCron,omitempty"`
-	UpdateTime       *int64                        `json:"updateTime,omitempty"`
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
 }
 
-// ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *WorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
+}
+
+type WorkflowExecutionFilter struct {
+	WorkflowId *string `json:"workflowId,omitempty"`
+	RunId      *string `json:"runId,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionFilter struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -98164,64 +100772,352 @@ type WorkflowExecutionInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionFilter) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Type != nil {
-		w, err = v.Type.ToWire()
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.StartTime != nil {
-		w, err = wire.NewValueI64(*(v.StartTime)), error(nil)
-		if err != nil {
-			return w, err
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionFilter struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionFilter struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionFilter
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionFilter) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
 	}
-	if v.CloseTime != nil {
-		w, err = wire.NewValueI64(*(v.CloseTime)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionFilter struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionFilter struct could not be encoded.
+func (v *WorkflowExecutionFilter) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
-	if v.CloseStatus != nil {
-		w, err = v.CloseStatus.ToWire()
-		if err != nil {
-			return w, err
+
+	if v.WorkflowId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.HistoryLength != nil {
-		w, err = wire.NewValueI64(*(v.HistoryLength)), error(nil)
-		if err != nil {
-			return w, err
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
 	}
-	if v.ParentDomainId != nil {
-		w, err = wire.NewValueString(*(v.ParentDomainId)), error(nil)
+
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RunId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionFilter struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionFilter struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionFilter) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunId = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionFilter
+// struct.
+func (v *WorkflowExecutionFilter) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionFilter{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionFilter match the
+// provided WorkflowExecutionFilter.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionFilter) Equals(rhs *WorkflowExecutionFilter) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionFilter.
+func (v *WorkflowExecutionFilter) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	return err
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionFilter) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *WorkflowExecutionFilter) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionFilter) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *WorkflowExecutionFilter) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+type WorkflowExecutionInfo struct {
+	Execution        *WorkflowExecution            `json:"execution,omitempty"`
+	Type             *WorkflowType                 `json:"type,omitempty"`
+	StartTime        *int64                        `json:"startTime,omitempty"`
+	CloseTime        *int64                        `json:"closeTime,omitempty"`
+	CloseStatus      *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	HistoryLength    *int64                        `json:"historyLength,omitempty"`
+	ParentDomainId   *string                       `json:"parentDomainId,omitempty"`
+	ParentExecution  *WorkflowExecution            `json:"parentExecution,omitempty"`
+	ExecutionTime    *int64                        `json:"executionTime,omitempty"`
+	Memo             *Memo                         `json:"memo,omitempty"`
+	SearchAttributes *SearchAttributes             `json:"searchAttributes,omitempty"`
+	AutoResetPoints  *ResetPoints                  `json:"autoResetPoints,omitempty"`
+	TaskList         *string                       `json:"taskList,omitempty"`
+	IsCron           *bool                         `json:"isCron,omitempty"`
+	UpdateTime       *int64                        `json:"updateTime,omitempty"`
+	Paused           *bool                         `json:"paused,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [16]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Type != nil {
+		w, err = v.Type.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartTime != nil {
+		w, err = wire.NewValueI64(*(v.StartTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.CloseTime != nil {
+		w, err = wire.NewValueI64(*(v.CloseTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.CloseStatus != nil {
+		w, err = v.CloseStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.HistoryLength != nil {
+		w, err = wire.NewValueI64(*(v.HistoryLength)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ParentDomainId != nil {
+		w, err = wire.NewValueString(*(v.ParentDomainId)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -98292,6 +101188,14 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 140, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -98461,6 +101365,16 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 150:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -98657,6 +101571,18 @@ func (v *WorkflowExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Paused != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Paused)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -98792,6 +101718,14 @@ func (v *WorkflowExecutionInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 150 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Paused = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -98821,7 +101755,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [15]string
+	var fields [16]string
 	i := 0
 	if v.Execution != nil {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
@@ -98883,6 +101817,10 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("UpdateTime: %v", *(v.UpdateTime))
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -98942,6 +101880,9 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_I64_EqualsPtr(v.UpdateTime, rhs.UpdateTime) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
 
 	return true
 }
@@ -98997,6 +101938,9 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.UpdateTime != nil {
 		enc.AddInt64("updateTime", *v.UpdateTime)
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
 	return err
 }
 
@@ -99225,6 +102169,295 @@ func (v *WorkflowExecutionInfo) IsSetUpdateTime() bool {
 	return v != nil && v.UpdateTime != nil
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *WorkflowExecutionInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason   *string `json:"reason,omitempty"`
+	Identity *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionPausedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionPausedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionPausedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionPausedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionPausedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionPausedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionPausedEventAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionPausedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionPausedEventAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionPausedEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionPausedEventAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionPausedEventAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionPausedEventAttributes
+// struct.
+func (v *WorkflowExecutionPausedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionPausedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionPausedEventAttributes match the
+// provided WorkflowExecutionPausedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionPausedEventAttributes) Equals(rhs *WorkflowExecutionPausedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionPausedEventAttributes.
+func (v *WorkflowExecutionPausedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *WorkflowExecutionPausedEventAttributes) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionPausedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName *string `json:"signalName,omitempty"`
 	Input      []byte  `json:"input,omitempty"`
@@ -99586,6 +102819,8 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
+	IsolationGroup                      *string                 `json:"isolationGroup,omitempty"`
+	Priority                            *int32                  `json:"priority,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionStartedEventAttributes struct into a Thrift-level intermediate
@@ -99605,7 +102840,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [26]wire.Field
+		fields [28]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -99819,6 +103054,22 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 140, Value: w}
 		i++
 	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -100082,6 +103333,26 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 150:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -100410,6 +103681,30 @@ func (v *WorkflowExecutionStartedEventAttributes) Encode(sw stream.Writer) error
 		}
 	}
 
+	if v.IsolationGroup != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.IsolationGroup)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 160, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -100617,6 +103912,22 @@ func (v *WorkflowExecutionStartedEventAttributes) Decode(sr stream.Reader) error
 				return err
 			}
 
+		case fh.ID == 150 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.IsolationGroup = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 160 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -100646,7 +103957,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [26]string
+	var fields [28]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -100752,6 +104063,14 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -100844,6 +104163,12 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -100932,6 +104257,12 @@ func (v *WorkflowExecutionStartedEventAttributes) MarshalLogObject(enc zapcore.O
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -101250,88 +104581,1400 @@ func (v *WorkflowExecutionStartedEventAttributes) IsSetCronSchedule() bool {
 	return v != nil && v.CronSchedule != nil
 }
 
-// GetFirstDecisionTaskBackoffSeconds returns the value of FirstDecisionTaskBackoffSeconds if it is set or its
+// GetFirstDecisionTaskBackoffSeconds returns the value of FirstDecisionTaskBackoffSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetFirstDecisionTaskBackoffSeconds() (o int32) {
+	if v != nil && v.FirstDecisionTaskBackoffSeconds != nil {
+		return *v.FirstDecisionTaskBackoffSeconds
+	}
+
+	return
+}
+
+// IsSetFirstDecisionTaskBackoffSeconds returns true if FirstDecisionTaskBackoffSeconds is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetFirstDecisionTaskBackoffSeconds() bool {
+	return v != nil && v.FirstDecisionTaskBackoffSeconds != nil
+}
+
+// GetMemo returns the value of Memo if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+
+	return
+}
+
+// IsSetMemo returns true if Memo is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetMemo() bool {
+	return v != nil && v.Memo != nil
+}
+
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+
+	return
+}
+
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
+}
+
+// GetPrevAutoResetPoints returns the value of PrevAutoResetPoints if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetPrevAutoResetPoints() (o *ResetPoints) {
+	if v != nil && v.PrevAutoResetPoints != nil {
+		return v.PrevAutoResetPoints
+	}
+
+	return
+}
+
+// IsSetPrevAutoResetPoints returns true if PrevAutoResetPoints is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetPrevAutoResetPoints() bool {
+	return v != nil && v.PrevAutoResetPoints != nil
+}
+
+// GetHeader returns the value of Header if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+
+	return
+}
+
+// IsSetHeader returns true if Header is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetHeader() bool {
+	return v != nil && v.Header != nil
+}
+
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+type WorkflowExecutionTerminatedEventAttributes struct {
+	Reason   *string `json:"reason,omitempty"`
+	Details  []byte  `json:"details,omitempty"`
+	Identity *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionTerminatedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionTerminatedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionTerminatedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionTerminatedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionTerminatedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionTerminatedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionTerminatedEventAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionTerminatedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionTerminatedEventAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Details != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Details); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionTerminatedEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionTerminatedEventAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionTerminatedEventAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			v.Details, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionTerminatedEventAttributes
+// struct.
+func (v *WorkflowExecutionTerminatedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionTerminatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionTerminatedEventAttributes match the
+// provided WorkflowExecutionTerminatedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionTerminatedEventAttributes) Equals(rhs *WorkflowExecutionTerminatedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionTerminatedEventAttributes.
+func (v *WorkflowExecutionTerminatedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionTerminatedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *WorkflowExecutionTerminatedEventAttributes) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionTerminatedEventAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *WorkflowExecutionTerminatedEventAttributes) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionTerminatedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionTerminatedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type WorkflowExecutionTimedOutEventAttributes struct {
+	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionTimedOutEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionTimedOutEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TimeoutType != nil {
+		w, err = v.TimeoutType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionTimedOutEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionTimedOutEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionTimedOutEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionTimedOutEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x TimeoutType
+				x, err = _TimeoutType_Read(field.Value)
+				v.TimeoutType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionTimedOutEventAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionTimedOutEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionTimedOutEventAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TimeoutType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.TimeoutType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionTimedOutEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionTimedOutEventAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionTimedOutEventAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x TimeoutType
+			x, err = _TimeoutType_Decode(sr)
+			v.TimeoutType = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionTimedOutEventAttributes
+// struct.
+func (v *WorkflowExecutionTimedOutEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.TimeoutType != nil {
+		fields[i] = fmt.Sprintf("TimeoutType: %v", *(v.TimeoutType))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionTimedOutEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionTimedOutEventAttributes match the
+// provided WorkflowExecutionTimedOutEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionTimedOutEventAttributes) Equals(rhs *WorkflowExecutionTimedOutEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_TimeoutType_EqualsPtr(v.TimeoutType, rhs.TimeoutType) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionTimedOutEventAttributes.
+func (v *WorkflowExecutionTimedOutEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TimeoutType != nil {
+		err = multierr.Append(err, enc.AddObject("timeoutType", *v.TimeoutType))
+	}
+	return err
+}
+
+// GetTimeoutType returns the value of TimeoutType if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() (o TimeoutType) {
+	if v != nil && v.TimeoutType != nil {
+		return *v.TimeoutType
+	}
+
+	return
+}
+
+// IsSetTimeoutType returns true if TimeoutType is not nil.
+func (v *WorkflowExecutionTimedOutEventAttributes) IsSetTimeoutType() bool {
+	return v != nil && v.TimeoutType != nil
+}
+
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason   *string `json:"reason,omitempty"`
+	Identity *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionUnpausedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionUnpausedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionUnpausedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionUnpausedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionUnpausedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionUnpausedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionUnpausedEventAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionUnpausedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionUnpausedEventAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionUnpausedEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionUnpausedEventAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionUnpausedEventAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionUnpausedEventAttributes
+// struct.
+func (v *WorkflowExecutionUnpausedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionUnpausedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionUnpausedEventAttributes match the
+// provided WorkflowExecutionUnpausedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionUnpausedEventAttributes) Equals(rhs *WorkflowExecutionUnpausedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionUnpausedEventAttributes.
+func (v *WorkflowExecutionUnpausedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *WorkflowExecutionUnpausedEventAttributes) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionUnpausedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type WorkflowExecutionUpdateCompletedEventAttributes struct {
+	UpdateID                     *string `json:"updateID,omitempty"`
+	RequestedEventId             *int64  `json:"requestedEventId,omitempty"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	Result                       []byte  `json:"result,omitempty"`
+	FailureMessage               *string `json:"failureMessage,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionUpdateCompletedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.UpdateID != nil {
+		w, err = wire.NewValueString(*(v.UpdateID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RequestedEventId != nil {
+		w, err = wire.NewValueI64(*(v.RequestedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.FailureMessage != nil {
+		w, err = wire.NewValueString(*(v.FailureMessage)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionUpdateCompletedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionUpdateCompletedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionUpdateCompletedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RequestedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Result, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailureMessage = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionUpdateCompletedEventAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionUpdateCompletedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.UpdateID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.UpdateID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RequestedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.RequestedEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Result != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Result); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FailureMessage != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.FailureMessage)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionUpdateCompletedEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionUpdateCompletedEventAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.UpdateID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.RequestedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			v.Result, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FailureMessage = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionUpdateCompletedEventAttributes
+// struct.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.UpdateID != nil {
+		fields[i] = fmt.Sprintf("UpdateID: %v", *(v.UpdateID))
+		i++
+	}
+	if v.RequestedEventId != nil {
+		fields[i] = fmt.Sprintf("RequestedEventId: %v", *(v.RequestedEventId))
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
+	}
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
+		i++
+	}
+	if v.FailureMessage != nil {
+		fields[i] = fmt.Sprintf("FailureMessage: %v", *(v.FailureMessage))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionUpdateCompletedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionUpdateCompletedEventAttributes match the
+// provided WorkflowExecutionUpdateCompletedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) Equals(rhs *WorkflowExecutionUpdateCompletedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateID, rhs.UpdateID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RequestedEventId, rhs.RequestedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailureMessage, rhs.FailureMessage) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionUpdateCompletedEventAttributes.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.UpdateID != nil {
+		enc.AddString("updateID", *v.UpdateID)
+	}
+	if v.RequestedEventId != nil {
+		enc.AddInt64("requestedEventId", *v.RequestedEventId)
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	}
+	if v.Result != nil {
+		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
+	}
+	if v.FailureMessage != nil {
+		enc.AddString("failureMessage", *v.FailureMessage)
+	}
+	return err
+}
+
+// GetUpdateID returns the value of UpdateID if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionStartedEventAttributes) GetFirstDecisionTaskBackoffSeconds() (o int32) {
-	if v != nil && v.FirstDecisionTaskBackoffSeconds != nil {
-		return *v.FirstDecisionTaskBackoffSeconds
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetUpdateID() (o string) {
+	if v != nil && v.UpdateID != nil {
+		return *v.UpdateID
 	}
 
 	return
 }
 
-// IsSetFirstDecisionTaskBackoffSeconds returns true if FirstDecisionTaskBackoffSeconds is not nil.
-func (v *WorkflowExecutionStartedEventAttributes) IsSetFirstDecisionTaskBackoffSeconds() bool {
-	return v != nil && v.FirstDecisionTaskBackoffSeconds != nil
+// IsSetUpdateID returns true if UpdateID is not nil.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) IsSetUpdateID() bool {
+	return v != nil && v.UpdateID != nil
 }
 
-// GetMemo returns the value of Memo if it is set or its
+// GetRequestedEventId returns the value of RequestedEventId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionStartedEventAttributes) GetMemo() (o *Memo) {
-	if v != nil && v.Memo != nil {
-		return v.Memo
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetRequestedEventId() (o int64) {
+	if v != nil && v.RequestedEventId != nil {
+		return *v.RequestedEventId
 	}
 
 	return
 }
 
-// IsSetMemo returns true if Memo is not nil.
-func (v *WorkflowExecutionStartedEventAttributes) IsSetMemo() bool {
-	return v != nil && v.Memo != nil
+// IsSetRequestedEventId returns true if RequestedEventId is not nil.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) IsSetRequestedEventId() bool {
+	return v != nil && v.RequestedEventId != nil
 }
 
-// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionStartedEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
 }
 
-// IsSetSearchAttributes returns true if SearchAttributes is not nil.
-func (v *WorkflowExecutionStartedEventAttributes) IsSetSearchAttributes() bool {
-	return v != nil && v.SearchAttributes != nil
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-// GetPrevAutoResetPoints returns the value of PrevAutoResetPoints if it is set or its
+// GetResult returns the value of Result if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionStartedEventAttributes) GetPrevAutoResetPoints() (o *ResetPoints) {
-	if v != nil && v.PrevAutoResetPoints != nil {
-		return v.PrevAutoResetPoints
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
 	}
 
 	return
 }
 
-// IsSetPrevAutoResetPoints returns true if PrevAutoResetPoints is not nil.
-func (v *WorkflowExecutionStartedEventAttributes) IsSetPrevAutoResetPoints() bool {
-	return v != nil && v.PrevAutoResetPoints != nil
+// IsSetResult returns true if Result is not nil.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) IsSetResult() bool {
+	return v != nil && v.Result != nil
 }
 
-// GetHeader returns the value of Header if it is set or its
+// GetFailureMessage returns the value of FailureMessage if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionStartedEventAttributes) GetHeader() (o *Header) {
-	if v != nil && v.Header != nil {
-		return v.Header
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetFailureMessage() (o string) {
+	if v != nil && v.FailureMessage != nil {
+		return *v.FailureMessage
 	}
 
 	return
 }
 
-// IsSetHeader returns true if Header is not nil.
-func (v *WorkflowExecutionStartedEventAttributes) IsSetHeader() bool {
-	return v != nil && v.Header != nil
+// IsSetFailureMessage returns true if FailureMessage is not nil.
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) IsSetFailureMessage() bool {
+	return v != nil && v.FailureMessage != nil
 }
 
-type WorkflowExecutionTerminatedEventAttributes struct {
-	Reason   *string `json:"reason,omitempty"`
-	Details  []byte  `json:"details,omitempty"`
-	Identity *string `json:"identity,omitempty"`
+type WorkflowExecutionUpdateRequestedEventAttributes struct {
+	UpdateID   *string `json:"updateID,omitempty"`
+	UpdateName *string `json:"updateName,omitempty"`
+	Input      []byte  `json:"input,omitempty"`
+	Identity   *string `json:"identity,omitempty"`
+	RequestId  *string `json:"requestId,omitempty"`
 }
 
-// ToWire translates a WorkflowExecutionTerminatedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a WorkflowExecutionUpdateRequestedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -101346,47 +105989,63 @@ type WorkflowExecutionTerminatedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *WorkflowExecutionTerminatedEventAttributes) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+	if v.UpdateID != nil {
+		w, err = wire.NewValueString(*(v.UpdateID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.UpdateName != nil {
+		w, err = wire.NewValueString(*(v.UpdateName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 	if v.Identity != nil {
 		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a WorkflowExecutionTerminatedEventAttributes struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionUpdateRequestedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowExecutionTerminatedEventAttributes struct
+// An error is returned if we were unable to build a WorkflowExecutionUpdateRequestedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -101394,12 +106053,12 @@ func (v *WorkflowExecutionTerminatedEventAttributes) ToWire() (wire.Value, error
 //     return nil, err
 //   }
 //
-//   var v WorkflowExecutionTerminatedEventAttributes
+//   var v WorkflowExecutionUpdateRequestedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *WorkflowExecutionTerminatedEventAttributes) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -101408,7 +106067,7 @@ func (v *WorkflowExecutionTerminatedEventAttributes) FromWire(w wire.Value) erro
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
+				v.UpdateID = &x
 				if err != nil {
 					return err
 				}
@@ -101416,13 +106075,23 @@ func (v *WorkflowExecutionTerminatedEventAttributes) FromWire(w wire.Value) erro
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -101431,6 +106100,16 @@ func (v *WorkflowExecutionTerminatedEventAttributes) FromWire(w wire.Value) erro
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -101438,20 +106117,20 @@ func (v *WorkflowExecutionTerminatedEventAttributes) FromWire(w wire.Value) erro
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionTerminatedEventAttributes struct directly into bytes, without going
+// Encode serializes a WorkflowExecutionUpdateRequestedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowExecutionTerminatedEventAttributes struct could not be encoded.
-func (v *WorkflowExecutionTerminatedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowExecutionUpdateRequestedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Reason != nil {
+	if v.UpdateID != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Reason)); err != nil {
+		if err := sw.WriteString(*(v.UpdateID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -101459,11 +106138,11 @@ func (v *WorkflowExecutionTerminatedEventAttributes) Encode(sw stream.Writer) er
 		}
 	}
 
-	if v.Details != nil {
+	if v.UpdateName != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.Details); err != nil {
+		if err := sw.WriteString(*(v.UpdateName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -101471,10 +106150,22 @@ func (v *WorkflowExecutionTerminatedEventAttributes) Encode(sw stream.Writer) er
 		}
 	}
 
-	if v.Identity != nil {
+	if v.Input != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
+		if err := sw.WriteBinary(v.Input); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
 		if err := sw.WriteString(*(v.Identity)); err != nil {
 			return err
 		}
@@ -101483,15 +106174,27 @@ func (v *WorkflowExecutionTerminatedEventAttributes) Encode(sw stream.Writer) er
 		}
 	}
 
+	if v.RequestId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RequestId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowExecutionTerminatedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a WorkflowExecutionUpdateRequestedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowExecutionTerminatedEventAttributes struct could not be generated from the wire
+// An error is returned if a WorkflowExecutionUpdateRequestedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *WorkflowExecutionTerminatedEventAttributes) Decode(sr stream.Reader) error {
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -101507,18 +106210,26 @@ func (v *WorkflowExecutionTerminatedEventAttributes) Decode(sr stream.Reader) er
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Reason = &x
+			v.UpdateID = &x
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 20 && fh.Type == wire.TBinary:
-			v.Details, err = sr.ReadBinary()
+			var x string
+			x, err = sr.ReadString()
+			v.UpdateName = &x
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 30 && fh.Type == wire.TBinary:
+			v.Input, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
 			v.Identity = &x
@@ -101526,6 +106237,14 @@ func (v *WorkflowExecutionTerminatedEventAttributes) Decode(sr stream.Reader) er
 				return err
 			}
 
+		case fh.ID == 50 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RequestId = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -101548,105 +106267,140 @@ func (v *WorkflowExecutionTerminatedEventAttributes) Decode(sr stream.Reader) er
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowExecutionTerminatedEventAttributes
+// String returns a readable string representation of a WorkflowExecutionUpdateRequestedEventAttributes
 // struct.
-func (v *WorkflowExecutionTerminatedEventAttributes) String() string {
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+	if v.UpdateID != nil {
+		fields[i] = fmt.Sprintf("UpdateID: %v", *(v.UpdateID))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.UpdateName != nil {
+		fields[i] = fmt.Sprintf("UpdateName: %v", *(v.UpdateName))
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
 		i++
 	}
 	if v.Identity != nil {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
 
-	return fmt.Sprintf("WorkflowExecutionTerminatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionUpdateRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowExecutionTerminatedEventAttributes match the
-// provided WorkflowExecutionTerminatedEventAttributes.
+// Equals returns true if all the fields of this WorkflowExecutionUpdateRequestedEventAttributes match the
+// provided WorkflowExecutionUpdateRequestedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *WorkflowExecutionTerminatedEventAttributes) Equals(rhs *WorkflowExecutionTerminatedEventAttributes) bool {
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) Equals(rhs *WorkflowExecutionUpdateRequestedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+	if !_String_EqualsPtr(v.UpdateID, rhs.UpdateID) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_String_EqualsPtr(v.UpdateName, rhs.UpdateName) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionTerminatedEventAttributes.
-func (v *WorkflowExecutionTerminatedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowExecutionUpdateRequestedEventAttributes.
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Reason != nil {
-		enc.AddString("reason", *v.Reason)
+	if v.UpdateID != nil {
+		enc.AddString("updateID", *v.UpdateID)
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.UpdateName != nil {
+		enc.AddString("updateName", *v.UpdateName)
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
 	}
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
 	return err
 }
 
-// GetReason returns the value of Reason if it is set or its
+// GetUpdateID returns the value of UpdateID if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionTerminatedEventAttributes) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetUpdateID() (o string) {
+	if v != nil && v.UpdateID != nil {
+		return *v.UpdateID
 	}
 
 	return
 }
 
-// IsSetReason returns true if Reason is not nil.
-func (v *WorkflowExecutionTerminatedEventAttributes) IsSetReason() bool {
-	return v != nil && v.Reason != nil
+// IsSetUpdateID returns true if UpdateID is not nil.
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) IsSetUpdateID() bool {
+	return v != nil && v.UpdateID != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetUpdateName returns the value of UpdateName if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionTerminatedEventAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetUpdateName() (o string) {
+	if v != nil && v.UpdateName != nil {
+		return *v.UpdateName
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *WorkflowExecutionTerminatedEventAttributes) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetUpdateName returns true if UpdateName is not nil.
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) IsSetUpdateName() bool {
+	return v != nil && v.UpdateName != nil
+}
+
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
+}
+
+// IsSetInput returns true if Input is not nil.
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) IsSetInput() bool {
+	return v != nil && v.Input != nil
 }
 
 // GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *WorkflowExecutionTerminatedEventAttributes) GetIdentity() (o string) {
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetIdentity() (o string) {
 	if v != nil && v.Identity != nil {
 		return *v.Identity
 	}
@@ -101655,218 +106409,235 @@ func (v *WorkflowExecutionTerminatedEventAttributes) GetIdentity() (o string) {
 }
 
 // IsSetIdentity returns true if Identity is not nil.
-func (v *WorkflowExecutionTerminatedEventAttributes) IsSetIdentity() bool {
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
-type WorkflowExecutionTimedOutEventAttributes struct {
-	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
 }
 
-// ToWire translates a WorkflowExecutionTimedOutEventAttributes struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
+type WorkflowIdConflictPolicy int32
+
+const (
+	WorkflowIdConflictPolicyFail              WorkflowIdConflictPolicy = 0
+	WorkflowIdConflictPolicyUseExisting       WorkflowIdConflictPolicy = 1
+	WorkflowIdConflictPolicyTerminateExisting WorkflowIdConflictPolicy = 2
+)
+
+// WorkflowIdConflictPolicy_Values returns all recognized values of WorkflowIdConflictPolicy.
+func WorkflowIdConflictPolicy_Values() []WorkflowIdConflictPolicy {
+	return []WorkflowIdConflictPolicy{
+		WorkflowIdConflictPolicyFail,
+		WorkflowIdConflictPolicyUseExisting,
+		WorkflowIdConflictPolicyTerminateExisting,
+	}
+}
+
+// UnmarshalText tries to decode WorkflowIdConflictPolicy from a byte slice
+// containing its name.
 //
-// An error is returned if the struct or any of its fields failed to
-// validate.
+//   var v WorkflowIdConflictPolicy
+//   err := v.UnmarshalText([]byte("Fail"))
+func (v *WorkflowIdConflictPolicy) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Fail":
+		*v = WorkflowIdConflictPolicyFail
+		return nil
+	case "UseExisting":
+		*v = WorkflowIdConflictPolicyUseExisting
+		return nil
+	case "TerminateExisting":
+		*v = WorkflowIdConflictPolicyTerminateExisting
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowIdConflictPolicy", err)
+		}
+		*v = WorkflowIdConflictPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes WorkflowIdConflictPolicy to text.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *WorkflowExecutionTimedOutEventAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+// This implements the TextMarshaler interface.
+func (v WorkflowIdConflictPolicy) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Fail"), nil
+	case 1:
+		return []byte("UseExisting"), nil
+	case 2:
+		return []byte("TerminateExisting"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
 
-	if v.TimeoutType != nil {
-		w, err = v.TimeoutType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowIdConflictPolicy.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v WorkflowIdConflictPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Fail")
+	case 1:
+		enc.AddString("name", "UseExisting")
+	case 2:
+		enc.AddString("name", "TerminateExisting")
 	}
+	return nil
+}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+// Ptr returns a pointer to this enum value.
+func (v WorkflowIdConflictPolicy) Ptr() *WorkflowIdConflictPolicy {
+	return &v
 }
 
-// FromWire deserializes a WorkflowExecutionTimedOutEventAttributes struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
+// Encode encodes WorkflowIdConflictPolicy directly to bytes.
 //
-// An error is returned if we were unable to build a WorkflowExecutionTimedOutEventAttributes struct
-// from the provided intermediate representation.
+//   sWriter := BinaryStreamer.Writer(writer)
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   var v WorkflowIdConflictPolicy
+//   return v.Encode(sWriter)
+func (v WorkflowIdConflictPolicy) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates WorkflowIdConflictPolicy into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v WorkflowIdConflictPolicy) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes WorkflowIdConflictPolicy from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return nil, err
+//     return WorkflowIdConflictPolicy(0), err
 //   }
 //
-//   var v WorkflowExecutionTimedOutEventAttributes
+//   var v WorkflowIdConflictPolicy
 //   if err := v.FromWire(x); err != nil {
-//     return nil, err
+//     return WorkflowIdConflictPolicy(0), err
 //   }
-//   return &v, nil
-func (v *WorkflowExecutionTimedOutEventAttributes) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x TimeoutType
-				x, err = _TimeoutType_Read(field.Value)
-				v.TimeoutType = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
+//   return v, nil
+func (v *WorkflowIdConflictPolicy) FromWire(w wire.Value) error {
+	*v = (WorkflowIdConflictPolicy)(w.GetI32())
 	return nil
 }
 
-// Encode serializes a WorkflowExecutionTimedOutEventAttributes struct directly into bytes, without going
-// through an intermediary type.
+// Decode reads off the encoded WorkflowIdConflictPolicy directly off of the wire.
 //
-// An error is returned if a WorkflowExecutionTimedOutEventAttributes struct could not be encoded.
-func (v *WorkflowExecutionTimedOutEventAttributes) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v WorkflowIdConflictPolicy
+//   if err := v.Decode(sReader); err != nil {
+//     return WorkflowIdConflictPolicy(0), err
+//   }
+//   return v, nil
+func (v *WorkflowIdConflictPolicy) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
 		return err
 	}
+	*v = (WorkflowIdConflictPolicy)(i)
+	return nil
+}
 
-	if v.TimeoutType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := v.TimeoutType.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// String returns a readable string representation of WorkflowIdConflictPolicy.
+func (v WorkflowIdConflictPolicy) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Fail"
+	case 1:
+		return "UseExisting"
+	case 2:
+		return "TerminateExisting"
 	}
+	return fmt.Sprintf("WorkflowIdConflictPolicy(%d)", w)
+}
 
-	return sw.WriteStructEnd()
+// Equals returns true if this WorkflowIdConflictPolicy value matches the provided
+// value.
+func (v WorkflowIdConflictPolicy) Equals(rhs WorkflowIdConflictPolicy) bool {
+	return v == rhs
 }
 
-// Decode deserializes a WorkflowExecutionTimedOutEventAttributes struct directly from its Thrift-level
-// representation, without going through an intemediary type.
+// MarshalJSON serializes WorkflowIdConflictPolicy into JSON.
 //
-// An error is returned if a WorkflowExecutionTimedOutEventAttributes struct could not be generated from the wire
-// representation.
-func (v *WorkflowExecutionTimedOutEventAttributes) Decode(sr stream.Reader) error {
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v WorkflowIdConflictPolicy) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Fail\""), nil
+	case 1:
+		return ([]byte)("\"UseExisting\""), nil
+	case 2:
+		return ([]byte)("\"TerminateExisting\""), nil
 	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
 
-	fh, ok, err := sr.ReadFieldBegin()
+// UnmarshalJSON attempts to decode WorkflowIdConflictPolicy from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *WorkflowIdConflictPolicy) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
 	if err != nil {
 		return err
 	}
 
-	for ok {
-		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x TimeoutType
-			x, err = _TimeoutType_Decode(sr)
-			v.TimeoutType = &x
-			if err != nil {
-				return err
-			}
-
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
 			return err
 		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "WorkflowIdConflictPolicy")
 		}
-	}
-
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a WorkflowExecutionTimedOutEventAttributes
-// struct.
-func (v *WorkflowExecutionTimedOutEventAttributes) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [1]string
-	i := 0
-	if v.TimeoutType != nil {
-		fields[i] = fmt.Sprintf("TimeoutType: %v", *(v.TimeoutType))
-		i++
-	}
-
-	return fmt.Sprintf("WorkflowExecutionTimedOutEventAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this WorkflowExecutionTimedOutEventAttributes match the
-// provided WorkflowExecutionTimedOutEventAttributes.
-//
-// This function performs a deep comparison.
-func (v *WorkflowExecutionTimedOutEventAttributes) Equals(rhs *WorkflowExecutionTimedOutEventAttributes) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_TimeoutType_EqualsPtr(v.TimeoutType, rhs.TimeoutType) {
-		return false
-	}
-
-	return true
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowExecutionTimedOutEventAttributes.
-func (v *WorkflowExecutionTimedOutEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "WorkflowIdConflictPolicy")
+		}
+		*v = (WorkflowIdConflictPolicy)(x)
 		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "WorkflowIdConflictPolicy")
 	}
-	if v.TimeoutType != nil {
-		err = multierr.Append(err, enc.AddObject("timeoutType", *v.TimeoutType))
-	}
-	return err
-}
-
-// GetTimeoutType returns the value of TimeoutType if it is set or its
-// zero value if it is unset.
-func (v *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() (o TimeoutType) {
-	if v != nil && v.TimeoutType != nil {
-		return *v.TimeoutType
-	}
-
-	return
-}
-
-// IsSetTimeoutType returns true if TimeoutType is not nil.
-func (v *WorkflowExecutionTimedOutEventAttributes) IsSetTimeoutType() bool {
-	return v != nil && v.TimeoutType != nil
 }
 
 type WorkflowIdReusePolicy int32
@@ -103145,8 +107916,8 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "shared",
 	Package:  "github.com/uber/cadence/.gen/go/shared",
 	FilePath: "shared.thrift",
-	SHA1:     "24d0e9d04b63c31532c91f3529abecd12c9c6ab6",
+	SHA1:     "0675252bb954c649c5b1fff286af04a0246d2233",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception InternalDataInconsistencyError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n}\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n}\n\nexception ServiceBusyError {\n  1: required string message\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nexception RemoteSyncMatchedError {\n  10: required string message\n}\n\nexception StickyWorkerUnavailableError {\n  1: required string message\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  130: optional bool isCron\n  140: optional i64 (js.type = \"Long\") updateTime\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional i32 jitterStartSeconds\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  62: optional i64 (js.type = \"Long\") firstScheduledTimeNano\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n  180: optional i32 jitterStartSeconds\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n}\n\nstruct FailoverInfo {\n    10: optional i64 (js.type = \"Long\") failoverVersion\n    20: optional i64 (js.type = \"Long\") failoverStartTimestamp\n    30: optional i64 (js.type = \"Long\") failoverExpireTimestamp\n    40: optional i32 completedShardCount\n    50: optional list<i32> pendingShards\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional FailoverInfo failoverInfo\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n  170: optional i32 jitterStartSeconds\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct RestartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n  50: optional string cause\n  60: optional string firstExecutionRunID\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n  190: optional i32 jitterStartSeconds\n}\nstruct RestartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional string identity\n}\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n  60: optional string firstExecutionRunID\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n}\n\nstruct PendingChildExecutionInfo {\n  1: optional string domain\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional map<string,DescribeTaskListResponse> decisionTaskListMap\n  20: optional map<string,DescribeTaskListResponse> activityTaskListMap\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n  50: optional string                   clusterName\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct FeatureFlags {\n\t10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n  RecordChildWorkflowExecutionComplete\n  ApplyParentClosePolicy\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task\n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID\n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes {\n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional i64 (js.type = \"Long\") initiatedEventID\n  50: optional HistoryEvent completionEvent\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes {\n}\n\nstruct ApplyParentClosePolicyAttributes {\n  10: optional string childDomainID\n  20: optional string childWorkflowID\n  30: optional string childRunID\n  40: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct ApplyParentClosePolicyStatus {\n  10: optional bool completed\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct ApplyParentClosePolicyRequest {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional ApplyParentClosePolicyStatus status\n}\n\nstruct CrossClusterApplyParentClosePolicyRequestAttributes {\n  10: optional list<ApplyParentClosePolicyRequest> children\n}\n\nstruct ApplyParentClosePolicyResult {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct CrossClusterApplyParentClosePolicyResponseAttributes {\n  10: optional list<ApplyParentClosePolicyResult> childrenStatus\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n  50: optional CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes recordChildWorkflowExecutionCompleteAttributes\n  60: optional CrossClusterApplyParentClosePolicyRequestAttributes applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional i16 taskState\n  40: optional CrossClusterTaskFailedCause failedCause\n  50: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  60: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  70: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n  80: optional CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes recordChildWorkflowExecutionCompleteAttributes\n  90: optional CrossClusterApplyParentClosePolicyResponseAttributes applyParentClosePolicyAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception InternalDataInconsistencyError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n}\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n}\n\nexception ServiceBusyError {\n  1: required string message\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nexception RemoteSyncMatchedError {\n  10: required string message\n}\n\nexception StickyWorkerUnavailableError {\n  1: required string message\n}\n\nenum WorkflowIdConflictPolicy {\n  /*\n   * fail the start request when a workflow with the same ID is running.\n   */\n  Fail,\n  /*\n   * return the run ID of the running workflow instead of starting a new one.\n   */\n  UseExisting,\n  /*\n   * terminate the running workflow and start a new one in the same transaction.\n   */\n  TerminateExisting,\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n  WorkflowExecutionUpdateRequested,\n  WorkflowExecutionUpdateCompleted,\n  WorkflowExecutionPaused,\n  WorkflowExecutionUnpaused,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  130: optional bool isCron\n  140: optional i64 (js.type = \"Long\") updateTime\n  150: optional bool paused\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n  100: optional i32 priority\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional i32 jitterStartSeconds\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  62: optional i64 (js.type = \"Long\") firstScheduledTimeNano\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n  150: optional string isolationGroup\n  160: optional i32 priority\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n  130: optional i32 priority\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct WorkflowExecutionUpdateRequestedEventAttributes {\n  10: optional string updateID\n  20: optional string updateName\n  30: optional binary input\n  40: optional string identity\n  50: optional string requestId\n}\n\nstruct WorkflowExecutionUpdateCompletedEventAttributes {\n  10: optional string updateID\n  20: optional i64 (js.type = \"Long\") requestedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional binary result\n  50: optional string failureMessage\n}\n\nstruct WorkflowExecutionPausedEventAttributes {\n  10: optional string reason\n  20: optional string identity\n}\n\nstruct WorkflowExecutionUnpausedEventAttributes {\n  10: optional string reason\n  20: optional string identity\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n  180: optional i32 jitterStartSeconds\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n  460: optional WorkflowExecutionUpdateRequestedEventAttributes workflowExecutionUpdateRequestedEventAttributes\n  470: optional WorkflowExecutionUpdateCompletedEventAttributes workflowExecutionUpdateCompletedEventAttributes\n  480: optional WorkflowExecutionPausedEventAttributes workflowExecutionPausedEventAttributes\n  490: optional WorkflowExecutionUnpausedEventAttributes workflowExecutionUnpausedEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n}\n\nstruct FailoverInfo {\n    10: optional i64 (js.type = \"Long\") failoverVersion\n    20: optional i64 (js.type = \"Long\") failoverStartTimestamp\n    30: optional i64 (js.type = \"Long\") failoverExpireTimestamp\n    40: optional i32 completedShardCount\n    50: optional list<i32> pendingShards\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional FailoverInfo failoverInfo\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n  170: optional i32 jitterStartSeconds\n  180: optional WorkflowIdConflictPolicy workflowIdConflictPolicy\n  190: optional i32 priority\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct StartWorkflowExecutionAsyncRequest {\n  10: optional StartWorkflowExecutionRequest request\n}\n\nstruct StartWorkflowExecutionAsyncResponse {\n}\n\nstruct RestartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n  50: optional string cause\n  60: optional string firstExecutionRunID\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct UpdateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string updateID\n  40: optional string updateName\n  50: optional binary input\n  60: optional string identity\n  70: optional string requestId\n}\n\nstruct UpdateWorkflowExecutionResponse {\n  10: optional binary result\n  20: optional string failureMessage\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n  190: optional i32 jitterStartSeconds\n  200: optional WorkflowIdConflictPolicy workflowIdConflictPolicy\n}\n\nstruct SignalWithStartWorkflowExecutionAsyncRequest {\n  10: optional SignalWithStartWorkflowExecutionRequest request\n}\n\nstruct SignalWithStartWorkflowExecutionAsyncResponse {\n}\nstruct RestartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional string identity\n}\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n  60: optional string firstExecutionRunID\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n}\n\nstruct PendingChildExecutionInfo {\n  1: optional string domain\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n  30: optional TaskListPartitionConfig partitionConfig\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional map<string,DescribeTaskListResponse> decisionTaskListMap\n  20: optional map<string,DescribeTaskListResponse> activityTaskListMap\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionConfig {\n  10: optional i64 (js.type = \"Long\") version\n  20: optional i32 numReadPartitions\n  30: optional i32 numWritePartitions\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n  50: optional map<i32, i64> backlogCountHintByPriority\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n  50: optional string                   clusterName\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct FeatureFlags {\n\t10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n  RecordChildWorkflowExecutionComplete\n  ApplyParentClosePolicy\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task\n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID\n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes {\n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional i64 (js.type = \"Long\") initiatedEventID\n  50: optional HistoryEvent completionEvent\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes {\n}\n\nstruct ApplyParentClosePolicyAttributes {\n  10: optional string childDomainID\n  20: optional string childWorkflowID\n  30: optional string childRunID\n  40: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct ApplyParentClosePolicyStatus {\n  10: optional bool completed\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct ApplyParentClosePolicyRequest {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional ApplyParentClosePolicyStatus status\n}\n\nstruct CrossClusterApplyParentClosePolicyRequestAttributes {\n  10: optional list<ApplyParentClosePolicyRequest> children\n}\n\nstruct ApplyParentClosePolicyResult {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct CrossClusterApplyParentClosePolicyResponseAttributes {\n  10: optional list<ApplyParentClosePolicyResult> childrenStatus\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n  50: optional CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes recordChildWorkflowExecutionCompleteAttributes\n  60: optional CrossClusterApplyParentClosePolicyRequestAttributes applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional i16 taskState\n  40: optional CrossClusterTaskFailedCause failedCause\n  50: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  60: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  70: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n  80: optional CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes recordChildWorkflowExecutionCompleteAttributes\n  90: optional CrossClusterApplyParentClosePolicyResponseAttributes applyParentClosePolicyAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n"
@@ -0,0 +1,830 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/service.proto
+
+package frontendv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StartWorkflowExecutionAsyncRequest struct {
+	Request              *v1.StartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *StartWorkflowExecutionAsyncRequest) Reset()         { *m = StartWorkflowExecutionAsyncRequest{} }
+func (m *StartWorkflowExecutionAsyncRequest) String() string { return proto.CompactTextString(m) }
+func (*StartWorkflowExecutionAsyncRequest) ProtoMessage()    {}
+func (*StartWorkflowExecutionAsyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdfe4f76b1684dd2, []int{0}
+}
+func (m *StartWorkflowExecutionAsyncRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartWorkflowExecutionAsyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartWorkflowExecutionAsyncRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartWorkflowExecutionAsyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartWorkflowExecutionAsyncRequest.Merge(m, src)
+}
+func (m *StartWorkflowExecutionAsyncRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartWorkflowExecutionAsyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartWorkflowExecutionAsyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartWorkflowExecutionAsyncRequest proto.InternalMessageInfo
+
+func (m *StartWorkflowExecutionAsyncRequest) GetRequest() *v1.StartWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type StartWorkflowExecutionAsyncResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartWorkflowExecutionAsyncResponse) Reset()         { *m = StartWorkflowExecutionAsyncResponse{} }
+func (m *StartWorkflowExecutionAsyncResponse) String() string { return proto.CompactTextString(m) }
+func (*StartWorkflowExecutionAsyncResponse) ProtoMessage()    {}
+func (*StartWorkflowExecutionAsyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdfe4f76b1684dd2, []int{1}
+}
+func (m *StartWorkflowExecutionAsyncResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartWorkflowExecutionAsyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartWorkflowExecutionAsyncResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartWorkflowExecutionAsyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartWorkflowExecutionAsyncResponse.Merge(m, src)
+}
+func (m *StartWorkflowExecutionAsyncResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartWorkflowExecutionAsyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartWorkflowExecutionAsyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartWorkflowExecutionAsyncResponse proto.InternalMessageInfo
+
+type SignalWithStartWorkflowExecutionAsyncRequest struct {
+	Request              *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) Reset() {
+	*m = SignalWithStartWorkflowExecutionAsyncRequest{}
+}
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SignalWithStartWorkflowExecutionAsyncRequest) ProtoMessage() {}
+func (*SignalWithStartWorkflowExecutionAsyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdfe4f76b1684dd2, []int{2}
+}
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalWithStartWorkflowExecutionAsyncRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWithStartWorkflowExecutionAsyncRequest.Merge(m, src)
+}
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWithStartWorkflowExecutionAsyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWithStartWorkflowExecutionAsyncRequest proto.InternalMessageInfo
+
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) GetRequest() *v1.SignalWithStartWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type SignalWithStartWorkflowExecutionAsyncResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) Reset() {
+	*m = SignalWithStartWorkflowExecutionAsyncResponse{}
+}
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SignalWithStartWorkflowExecutionAsyncResponse) ProtoMessage() {}
+func (*SignalWithStartWorkflowExecutionAsyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdfe4f76b1684dd2, []int{3}
+}
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalWithStartWorkflowExecutionAsyncResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWithStartWorkflowExecutionAsyncResponse.Merge(m, src)
+}
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWithStartWorkflowExecutionAsyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWithStartWorkflowExecutionAsyncResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionAsyncRequest)(nil), "uber.cadence.frontend.v1.StartWorkflowExecutionAsyncRequest")
+	proto.RegisterType((*StartWorkflowExecutionAsyncResponse)(nil), "uber.cadence.frontend.v1.StartWorkflowExecutionAsyncResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionAsyncRequest)(nil), "uber.cadence.frontend.v1.SignalWithStartWorkflowExecutionAsyncRequest")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionAsyncResponse)(nil), "uber.cadence.frontend.v1.SignalWithStartWorkflowExecutionAsyncResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/frontend/v1/service.proto", fileDescriptor_fdfe4f76b1684dd2)
+}
+
+var fileDescriptor_fdfe4f76b1684dd2 = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xd1, 0x4a, 0xf3, 0x30,
+	0x14, 0xc7, 0xc9, 0xbe, 0x0f, 0x95, 0xec, 0x46, 0x72, 0x35, 0x26, 0x14, 0xa9, 0x4c, 0x44, 0x34,
+	0xa1, 0xf5, 0x4a, 0x9c, 0x17, 0x13, 0x74, 0x28, 0x5e, 0xc8, 0x06, 0x0e, 0xbc, 0x91, 0x36, 0xcb,
+	0xba, 0xe0, 0x4c, 0x6a, 0x9a, 0x76, 0xf3, 0x1d, 0x7c, 0x02, 0x9f, 0xc4, 0x47, 0xf0, 0xd2, 0x47,
+	0x90, 0x82, 0xef, 0x21, 0xae, 0x2d, 0xac, 0xb2, 0x75, 0x55, 0xef, 0x42, 0xfb, 0x3f, 0xbf, 0xf3,
+	0x0b, 0x27, 0x07, 0x6e, 0x87, 0x2e, 0x53, 0x84, 0x3a, 0x7d, 0x26, 0x28, 0x23, 0x03, 0x25, 0x85,
+	0x66, 0xa2, 0x4f, 0x22, 0x8b, 0x04, 0x4c, 0x45, 0x9c, 0x32, 0xec, 0x2b, 0xa9, 0x25, 0xaa, 0x7d,
+	0xe5, 0x70, 0x9a, 0xc3, 0x59, 0x0e, 0x47, 0x56, 0x7d, 0x37, 0x47, 0x70, 0x7c, 0x3e, 0x53, 0x7c,
+	0x3b, 0x96, 0xea, 0x6e, 0x30, 0x92, 0xe3, 0x84, 0x62, 0x4e, 0xa0, 0xd9, 0xd5, 0x8e, 0xd2, 0xbd,
+	0xf4, 0xf3, 0xe9, 0x84, 0xd1, 0x50, 0x73, 0x29, 0x5a, 0xc1, 0xa3, 0xa0, 0x1d, 0xf6, 0x10, 0xb2,
+	0x40, 0xa3, 0x4b, 0xb8, 0xaa, 0x92, 0x63, 0x0d, 0x6c, 0x82, 0x9d, 0xaa, 0x6d, 0xe3, 0x5c, 0x77,
+	0xc7, 0xe7, 0x38, 0xb2, 0xf0, 0x7c, 0x52, 0x0a, 0xe9, 0x64, 0x88, 0x8b, 0xff, 0x6b, 0x95, 0xf5,
+	0x7f, 0x66, 0x03, 0x6e, 0x15, 0x76, 0x0e, 0x7c, 0x29, 0x02, 0x66, 0x3e, 0x01, 0xb8, 0xd7, 0xe5,
+	0x9e, 0x70, 0x46, 0x3d, 0xae, 0x87, 0x25, 0x5c, 0xaf, 0xbf, 0xbb, 0x36, 0xe7, 0xbb, 0x2e, 0x61,
+	0x2e, 0xb0, 0x26, 0x70, 0xbf, 0xa4, 0x4d, 0xe2, 0x6f, 0x7f, 0x54, 0x60, 0x35, 0x8b, 0xb4, 0xae,
+	0xce, 0xd1, 0x33, 0x80, 0x1b, 0x05, 0x75, 0xa8, 0x89, 0x17, 0xcd, 0x15, 0x2f, 0xbf, 0x7c, 0xfd,
+	0xf8, 0x97, 0xd5, 0x89, 0x2c, 0x7a, 0x01, 0xb0, 0x51, 0xea, 0x7a, 0xe8, 0xac, 0xa0, 0xd1, 0x0f,
+	0xa6, 0x55, 0x6f, 0xff, 0x99, 0x93, 0xa8, 0x9f, 0xb4, 0x5f, 0x63, 0x03, 0xbc, 0xc5, 0x06, 0x78,
+	0x8f, 0x0d, 0x70, 0x73, 0xe8, 0x71, 0x3d, 0x0c, 0x5d, 0x4c, 0xe5, 0x3d, 0xc9, 0x6d, 0x03, 0xf6,
+	0x98, 0x20, 0xd3, 0xa7, 0x3f, 0xbb, 0x5a, 0x47, 0xd9, 0x39, 0xb2, 0xdc, 0x95, 0xe9, 0xdf, 0x83,
+	0xcf, 0x01, 0x00, 0x29, 0xa3, 0x86, 0xe5, 0x88, 0x03, 0x00, 0x00,
+}
+
+func (m *StartWorkflowExecutionAsyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowExecutionAsyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowExecutionAsyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartWorkflowExecutionAsyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowExecutionAsyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowExecutionAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionAsyncRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartWorkflowExecutionAsyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StartWorkflowExecutionAsyncRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionAsyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionAsyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.StartWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowExecutionAsyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionAsyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionAsyncRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionAsyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionAsyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionAsyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionAsyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipService(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthService
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupService
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthService
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthService        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowService          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupService = fmt.Errorf("proto: unexpected end of group")
+)
@@ -87,6 +87,11 @@ type HistoryAPIYARPCClient interface {
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest, ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest, ...yarpc.CallOption) (*GetFailoverInfoResponse, error)
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest, ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	ListWorkflowTasks(context.Context, *ListWorkflowTasksRequest, ...yarpc.CallOption) (*ListWorkflowTasksResponse, error)
+	RetryTask(context.Context, *RetryTaskRequest, ...yarpc.CallOption) (*RetryTaskResponse, error)
 }
 
 func newHistoryAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) HistoryAPIYARPCClient {
@@ -150,6 +155,11 @@ type HistoryAPIYARPCServer interface {
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest) (*GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest) (*GetFailoverInfoResponse, error)
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	ListWorkflowTasks(context.Context, *ListWorkflowTasksRequest) (*ListWorkflowTasksResponse, error)
+	RetryTask(context.Context, *RetryTaskRequest) (*RetryTaskResponse, error)
 }
 
 type buildHistoryAPIYARPCProceduresParams struct {
@@ -593,6 +603,56 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "UpdateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateWorkflowExecution,
+							NewRequest:  newHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PauseWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PauseWorkflowExecution,
+							NewRequest:  newHistoryAPIServicePauseWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UnpauseWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UnpauseWorkflowExecution,
+							NewRequest:  newHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListWorkflowTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListWorkflowTasks,
+							NewRequest:  newHistoryAPIServiceListWorkflowTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RetryTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RetryTask,
+							NewRequest:  newHistoryAPIServiceRetryTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -633,10 +693,10 @@ type FxHistoryAPIYARPCClientResult struct {
 // NewFxHistoryAPIYARPCClient provides a HistoryAPIYARPCClient
 // to an Fx application using the given name for routing.
 //
-//  fx.Provide(
-//    historyv1.NewFxHistoryAPIYARPCClient("service-name"),
-//    ...
-//  )
+//	fx.Provide(
+//	  historyv1.NewFxHistoryAPIYARPCClient("service-name"),
+//	  ...
+//	)
 func NewFxHistoryAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
 	return func(params FxHistoryAPIYARPCClientParams) FxHistoryAPIYARPCClientResult {
 		cc := params.Provider.ClientConfig(name)
@@ -682,10 +742,10 @@ type FxHistoryAPIYARPCProceduresResult struct {
 // NewFxHistoryAPIYARPCProcedures provides HistoryAPIYARPCServer procedures to an Fx application.
 // It expects a HistoryAPIYARPCServer to be present in the container.
 //
-//  fx.Provide(
-//    historyv1.NewFxHistoryAPIYARPCProcedures(),
-//    ...
-//  )
+//	fx.Provide(
+//	  historyv1.NewFxHistoryAPIYARPCProcedures(),
+//	  ...
+//	)
 func NewFxHistoryAPIYARPCProcedures() interface{} {
 	return func(params FxHistoryAPIYARPCProceduresParams) FxHistoryAPIYARPCProceduresResult {
 		return FxHistoryAPIYARPCProceduresResult{
@@ -1221,6 +1281,66 @@ func (c *_HistoryAPIYARPCCaller) GetFailoverInfo(ctx context.Context, request *G
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest, options ...yarpc.CallOption) (*UpdateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateWorkflowExecution", request, newHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest, options ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PauseWorkflowExecution", request, newHistoryAPIServicePauseWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServicePauseWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) UnpauseWorkflowExecution(ctx context.Context, request *UnpauseWorkflowExecutionRequest, options ...yarpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UnpauseWorkflowExecution", request, newHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) ListWorkflowTasks(ctx context.Context, request *ListWorkflowTasksRequest, options ...yarpc.CallOption) (*ListWorkflowTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListWorkflowTasks", request, newHistoryAPIServiceListWorkflowTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkflowTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceListWorkflowTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) RetryTask(ctx context.Context, request *RetryTaskRequest, options ...yarpc.CallOption) (*RetryTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RetryTask", request, newHistoryAPIServiceRetryTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RetryTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceRetryTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _HistoryAPIYARPCHandler struct {
 	server HistoryAPIYARPCServer
 }
@@ -1913,6 +2033,86 @@ func (h *_HistoryAPIYARPCHandler) GetFailoverInfo(ctx context.Context, requestMe
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) UpdateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) PauseWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PauseWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PauseWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServicePauseWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PauseWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) UnpauseWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UnpauseWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UnpauseWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UnpauseWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) ListWorkflowTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkflowTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkflowTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceListWorkflowTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListWorkflowTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) RetryTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RetryTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RetryTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceRetryTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RetryTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newHistoryAPIServiceStartWorkflowExecutionYARPCRequest() proto.Message {
 	return &StartWorkflowExecutionRequest{}
 }
@@ -2257,6 +2457,46 @@ func newHistoryAPIServiceGetFailoverInfoYARPCResponse() proto.Message {
 	return &GetFailoverInfoResponse{}
 }
 
+func newHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest() proto.Message {
+	return &UpdateWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse() proto.Message {
+	return &UpdateWorkflowExecutionResponse{}
+}
+
+func newHistoryAPIServicePauseWorkflowExecutionYARPCRequest() proto.Message {
+	return &PauseWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServicePauseWorkflowExecutionYARPCResponse() proto.Message {
+	return &PauseWorkflowExecutionResponse{}
+}
+
+func newHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest() proto.Message {
+	return &UnpauseWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse() proto.Message {
+	return &UnpauseWorkflowExecutionResponse{}
+}
+
+func newHistoryAPIServiceListWorkflowTasksYARPCRequest() proto.Message {
+	return &ListWorkflowTasksRequest{}
+}
+
+func newHistoryAPIServiceListWorkflowTasksYARPCResponse() proto.Message {
+	return &ListWorkflowTasksResponse{}
+}
+
+func newHistoryAPIServiceRetryTaskYARPCRequest() proto.Message {
+	return &RetryTaskRequest{}
+}
+
+func newHistoryAPIServiceRetryTaskYARPCResponse() proto.Message {
+	return &RetryTaskResponse{}
+}
+
 var (
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCRequest             = &StartWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCResponse            = &StartWorkflowExecutionResponse{}
@@ -2344,311 +2584,341 @@ var (
 	emptyHistoryAPIServiceRespondCrossClusterTasksCompletedYARPCResponse = &RespondCrossClusterTasksCompletedResponse{}
 	emptyHistoryAPIServiceGetFailoverInfoYARPCRequest                    = &GetFailoverInfoRequest{}
 	emptyHistoryAPIServiceGetFailoverInfoYARPCResponse                   = &GetFailoverInfoResponse{}
+	emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest            = &UpdateWorkflowExecutionRequest{}
+	emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse           = &UpdateWorkflowExecutionResponse{}
+	emptyHistoryAPIServicePauseWorkflowExecutionYARPCRequest             = &PauseWorkflowExecutionRequest{}
+	emptyHistoryAPIServicePauseWorkflowExecutionYARPCResponse            = &PauseWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest           = &UnpauseWorkflowExecutionRequest{}
+	emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse          = &UnpauseWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceListWorkflowTasksYARPCRequest                  = &ListWorkflowTasksRequest{}
+	emptyHistoryAPIServiceListWorkflowTasksYARPCResponse                 = &ListWorkflowTasksResponse{}
+	emptyHistoryAPIServiceRetryTaskYARPCRequest                          = &RetryTaskRequest{}
+	emptyHistoryAPIServiceRetryTaskYARPCResponse                         = &RetryTaskResponse{}
 )
 
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
-		0x76, 0x68, 0x8e, 0xf8, 0xf5, 0x48, 0x0e, 0xc9, 0x12, 0x3f, 0x86, 0x43, 0x89, 0x22, 0xdb, 0x96,
-		0x4d, 0xcb, 0xeb, 0xa1, 0x45, 0xdb, 0xfa, 0xb2, 0xbc, 0x5a, 0x89, 0x94, 0xe4, 0x31, 0xf4, 0xd9,
-		0x64, 0xe4, 0x24, 0x48, 0xdc, 0xdb, 0xec, 0xae, 0x21, 0x3b, 0xea, 0xe9, 0x1e, 0x75, 0xf7, 0x90,
-		0x1a, 0x1f, 0x02, 0x07, 0x1b, 0x04, 0xd8, 0x45, 0x90, 0xdd, 0x2c, 0x36, 0x41, 0x80, 0x00, 0x0b,
-		0x04, 0x1b, 0x60, 0xb3, 0x46, 0x6e, 0xc9, 0x2d, 0xc8, 0x29, 0x40, 0x90, 0xbf, 0x90, 0x53, 0x2e,
-		0x9b, 0x43, 0x02, 0xe4, 0x94, 0x3d, 0x07, 0x41, 0x7d, 0x74, 0x4f, 0x7f, 0x54, 0x57, 0x0f, 0xc9,
-		0x00, 0xf2, 0x3a, 0xbe, 0x4d, 0x57, 0xd5, 0x7b, 0xf5, 0xea, 0xd5, 0xab, 0x57, 0xef, 0xab, 0x06,
-		0x2e, 0x76, 0xf7, 0xb0, 0xbf, 0x61, 0x1a, 0x16, 0x76, 0x4d, 0xbc, 0x71, 0x60, 0x07, 0xa1, 0xe7,
-		0xf7, 0x36, 0x0e, 0x2f, 0x6f, 0x04, 0xd8, 0x3f, 0xb4, 0x4d, 0xdc, 0xe8, 0xf8, 0x5e, 0xe8, 0xa1,
-		0x45, 0x32, 0xac, 0xc1, 0x87, 0x35, 0xf8, 0xb0, 0xc6, 0xe1, 0xe5, 0xfa, 0xca, 0xbe, 0xe7, 0xed,
-		0x3b, 0x78, 0x83, 0x0e, 0xdb, 0xeb, 0xb6, 0x36, 0xac, 0xae, 0x6f, 0x84, 0xb6, 0xe7, 0x32, 0xc0,
-		0xfa, 0x85, 0x6c, 0x7f, 0x68, 0xb7, 0x71, 0x10, 0x1a, 0xed, 0x0e, 0x1f, 0x90, 0x43, 0x70, 0xe4,
-		0x1b, 0x9d, 0x0e, 0xf6, 0x03, 0xde, 0xbf, 0x9a, 0x22, 0xd0, 0xe8, 0xd8, 0x84, 0x38, 0xd3, 0x6b,
-		0xb7, 0xe3, 0x29, 0xd6, 0x44, 0x23, 0x22, 0x12, 0x39, 0x15, 0xa2, 0x21, 0x2f, 0xba, 0x38, 0x1e,
-		0xa0, 0x8a, 0x06, 0x84, 0x46, 0xf0, 0xdc, 0xb1, 0x83, 0x50, 0x36, 0xe6, 0xc8, 0xf3, 0x9f, 0xb7,
-		0x1c, 0xef, 0x88, 0x8f, 0xb9, 0x24, 0x1a, 0xc3, 0x59, 0xa9, 0x67, 0xc6, 0xae, 0x97, 0x8d, 0xc5,
-		0x3e, 0x1f, 0xf9, 0x5a, 0x7a, 0xa4, 0xd5, 0xb6, 0x5d, 0xca, 0x05, 0xa7, 0x1b, 0x84, 0x65, 0x83,
-		0xd2, 0x8c, 0x58, 0x13, 0x0f, 0x7a, 0xd1, 0xc5, 0x5d, 0xbe, 0xd5, 0xf5, 0x37, 0xc5, 0x43, 0x7c,
-		0xdc, 0x71, 0x6c, 0x33, 0xb9, 0xb5, 0xaf, 0xa7, 0x06, 0x06, 0x07, 0x86, 0x8f, 0xad, 0xfc, 0x8c,
-		0x17, 0x0b, 0x46, 0xa5, 0x99, 0xa1, 0xfe, 0xcd, 0x30, 0x9c, 0xdf, 0x09, 0x0d, 0x3f, 0xfc, 0x94,
-		0xb7, 0xdf, 0x7d, 0x89, 0xcd, 0x2e, 0x99, 0x4d, 0xc3, 0x2f, 0xba, 0x38, 0x08, 0xd1, 0x03, 0x18,
-		0xf5, 0xd9, 0xcf, 0x9a, 0xb2, 0xaa, 0xac, 0x4f, 0x6c, 0x6e, 0x36, 0x52, 0x42, 0x69, 0x74, 0xec,
-		0xc6, 0xe1, 0xe5, 0x86, 0x14, 0x89, 0x16, 0xa1, 0x40, 0xcb, 0x30, 0x6e, 0x79, 0x6d, 0xc3, 0x76,
-		0x75, 0xdb, 0xaa, 0x0d, 0xad, 0x2a, 0xeb, 0xe3, 0xda, 0x18, 0x6b, 0x68, 0x5a, 0xe8, 0x77, 0x60,
-		0xbe, 0x63, 0xf8, 0xd8, 0x0d, 0x75, 0x1c, 0x21, 0xd0, 0x6d, 0xb7, 0xe5, 0xd5, 0x2a, 0x74, 0xe2,
-		0x75, 0xe1, 0xc4, 0x4f, 0x28, 0x44, 0x3c, 0x63, 0xd3, 0x6d, 0x79, 0xda, 0xd9, 0x4e, 0xbe, 0x11,
-		0xd5, 0x60, 0xd4, 0x08, 0x43, 0xdc, 0xee, 0x84, 0xb5, 0x33, 0xab, 0xca, 0xfa, 0xb0, 0x16, 0x7d,
-		0xa2, 0x2d, 0x98, 0xc6, 0x2f, 0x3b, 0x36, 0x3b, 0x40, 0x3a, 0x39, 0x29, 0xb5, 0x61, 0x3a, 0x63,
-		0xbd, 0xc1, 0x4e, 0x49, 0x23, 0x3a, 0x25, 0x8d, 0xdd, 0xe8, 0x18, 0x69, 0xd5, 0x3e, 0x08, 0x69,
-		0x44, 0x2d, 0x58, 0x32, 0x3d, 0x37, 0xb4, 0xdd, 0x2e, 0xd6, 0x8d, 0x40, 0x77, 0xf1, 0x91, 0x6e,
-		0xbb, 0x76, 0x68, 0x1b, 0xa1, 0xe7, 0xd7, 0x46, 0x56, 0x95, 0xf5, 0xea, 0xe6, 0xdb, 0xc2, 0x05,
-		0x6c, 0x71, 0xa8, 0xdb, 0xc1, 0x23, 0x7c, 0xd4, 0x8c, 0x40, 0xb4, 0x05, 0x53, 0xd8, 0x8e, 0x9a,
-		0x30, 0x1b, 0xf5, 0x58, 0x7a, 0xcb, 0xb0, 0x9d, 0xae, 0x8f, 0x6b, 0xa3, 0x94, 0xdc, 0x73, 0x42,
-		0xfc, 0xf7, 0xd8, 0x18, 0x6d, 0x26, 0x06, 0xe3, 0x2d, 0x48, 0x83, 0x05, 0xc7, 0x08, 0x42, 0xdd,
-		0xf4, 0xda, 0x1d, 0x07, 0xd3, 0xc5, 0xfb, 0x38, 0xe8, 0x3a, 0x61, 0x6d, 0x4c, 0x82, 0xef, 0x89,
-		0xd1, 0x73, 0x3c, 0xc3, 0xd2, 0xe6, 0x08, 0xec, 0x56, 0x0c, 0xaa, 0x51, 0x48, 0xf4, 0x9b, 0xb0,
-		0xdc, 0xb2, 0xfd, 0x20, 0xd4, 0x2d, 0x6c, 0xda, 0x01, 0xe5, 0xa7, 0x11, 0x3c, 0xd7, 0xf7, 0x0c,
-		0xf3, 0xb9, 0xd7, 0x6a, 0xd5, 0xc6, 0x29, 0xe2, 0xa5, 0x1c, 0x5f, 0xb7, 0xb9, 0xfa, 0xd2, 0x6a,
-		0x14, 0x7a, 0x9b, 0x03, 0xef, 0x1a, 0xc1, 0xf3, 0x3b, 0x0c, 0x54, 0xbd, 0x0a, 0x2b, 0x45, 0x42,
-		0x16, 0x74, 0x3c, 0x37, 0xc0, 0x68, 0x1e, 0x46, 0xfc, 0x2e, 0x95, 0x2c, 0x85, 0x4a, 0xd6, 0xb0,
-		0xdf, 0x75, 0x9b, 0x96, 0xfa, 0xd7, 0x43, 0xb0, 0xb2, 0x63, 0xef, 0xbb, 0x86, 0x53, 0x28, 0xe4,
-		0x0f, 0xb3, 0x42, 0xfe, 0x9e, 0x58, 0xc8, 0xa5, 0x58, 0x06, 0x94, 0xf2, 0x16, 0x2c, 0xe3, 0x97,
-		0x21, 0xf6, 0x5d, 0xc3, 0x89, 0x55, 0x53, 0x5f, 0xe0, 0xb9, 0xac, 0xbf, 0x21, 0x9c, 0x3f, 0x3f,
-		0xf3, 0x52, 0x84, 0x2a, 0xd7, 0x85, 0x1a, 0x70, 0xd6, 0x3c, 0xb0, 0x1d, 0xab, 0x3f, 0x89, 0xe7,
-		0x3a, 0x3d, 0x2a, 0xfb, 0x63, 0xda, 0x2c, 0xed, 0x8a, 0x80, 0x1e, 0xbb, 0x4e, 0x4f, 0x5d, 0x83,
-		0x0b, 0x85, 0xeb, 0x63, 0x0c, 0x56, 0x7f, 0xaa, 0xc0, 0x9b, 0x7c, 0x8c, 0x1d, 0x1e, 0xc8, 0xf5,
-		0xc6, 0xb3, 0x2c, 0x4b, 0x6f, 0xca, 0x58, 0x5a, 0x86, 0x6e, 0x30, 0xde, 0xaa, 0xb7, 0x61, 0xbd,
-		0x1c, 0xa1, 0x5c, 0x5a, 0x7e, 0xa0, 0xc0, 0x79, 0x0d, 0x07, 0xf8, 0xd4, 0x1a, 0x51, 0x8a, 0x64,
-		0xc0, 0xf5, 0x5c, 0x85, 0x95, 0x22, 0x34, 0xf2, 0x55, 0x7c, 0x39, 0x04, 0x6b, 0xbb, 0xd8, 0x6f,
-		0xdb, 0xae, 0x11, 0xe2, 0xc2, 0x95, 0x3c, 0xc9, 0xae, 0xe4, 0x8a, 0x70, 0x25, 0xa5, 0x88, 0x7e,
-		0xcd, 0x25, 0xff, 0x75, 0x50, 0x65, 0x4b, 0xe4, 0xc2, 0xff, 0x23, 0x05, 0x56, 0xb7, 0x71, 0x60,
-		0xfa, 0xf6, 0x5e, 0x31, 0x47, 0x1f, 0x67, 0x39, 0xfa, 0x81, 0x70, 0x39, 0x65, 0x78, 0x06, 0x14,
-		0x8f, 0xff, 0xa9, 0xc0, 0x9a, 0x04, 0x15, 0x17, 0x11, 0x07, 0x16, 0xfb, 0xf7, 0xa9, 0xe9, 0xb9,
-		0x2d, 0x7b, 0x9f, 0x6b, 0x5b, 0xa9, 0xb2, 0xcb, 0x21, 0xdc, 0x4a, 0x82, 0x6a, 0x0b, 0x58, 0xd8,
-		0x8e, 0xf6, 0x60, 0x31, 0xbf, 0xb7, 0xec, 0x1a, 0x1f, 0xa2, 0xb3, 0x5d, 0x1a, 0x6c, 0x36, 0x7a,
-		0x91, 0xcf, 0x1f, 0x89, 0x9a, 0xd1, 0xa7, 0x80, 0x3a, 0xd8, 0xb5, 0x6c, 0x77, 0x5f, 0x37, 0xcc,
-		0xd0, 0x3e, 0xb4, 0x43, 0x1b, 0x07, 0xb5, 0xca, 0x6a, 0xa5, 0xd8, 0x4a, 0x60, 0xc3, 0x6f, 0xb3,
-		0xd1, 0x3d, 0x8a, 0x7c, 0xb6, 0x93, 0x6a, 0xb4, 0x71, 0x80, 0x7e, 0x0b, 0x66, 0x22, 0xc4, 0x54,
-		0x4c, 0x7c, 0xec, 0xd6, 0xce, 0x50, 0xb4, 0x0d, 0x19, 0xda, 0x2d, 0x32, 0x36, 0x4d, 0xf9, 0x74,
-		0x27, 0xd1, 0xe5, 0x63, 0x17, 0xed, 0xf4, 0x51, 0x47, 0x57, 0x23, 0xb7, 0x32, 0xa4, 0x14, 0x47,
-		0x37, 0x61, 0x0a, 0x69, 0xd4, 0xa8, 0xbe, 0x84, 0xb9, 0xa7, 0xc4, 0x9c, 0x8e, 0xb8, 0x17, 0x89,
-		0xe1, 0x56, 0x56, 0x0c, 0xdf, 0x12, 0xce, 0x21, 0x82, 0x1d, 0x50, 0xf4, 0x7e, 0xa6, 0xc0, 0x7c,
-		0x06, 0x9c, 0x8b, 0xdb, 0x2d, 0x98, 0xa4, 0x26, 0x7e, 0x64, 0x4b, 0x28, 0x03, 0xd8, 0x12, 0x13,
-		0x14, 0x82, 0x9b, 0x10, 0x4d, 0xa8, 0x46, 0x08, 0x7e, 0x0f, 0x9b, 0x21, 0xb6, 0xb8, 0xe0, 0xa8,
-		0xc5, 0x6b, 0xd0, 0xf8, 0x48, 0x6d, 0xea, 0x45, 0xf2, 0x53, 0xfd, 0x43, 0x05, 0xea, 0x54, 0x81,
-		0xee, 0x84, 0xb6, 0xf9, 0xbc, 0x47, 0xcc, 0x89, 0x07, 0x76, 0x10, 0x46, 0x6c, 0x6a, 0x66, 0xd9,
-		0xb4, 0x51, 0xac, 0xc9, 0x85, 0x18, 0x06, 0x64, 0xd6, 0x79, 0x58, 0x16, 0xe2, 0xe0, 0x9a, 0xe5,
-		0xbf, 0x15, 0x58, 0xb8, 0x8f, 0xc3, 0x87, 0xdd, 0xd0, 0xd8, 0x73, 0xf0, 0x4e, 0x68, 0x84, 0x58,
-		0x13, 0xa1, 0x55, 0x32, 0xfa, 0xf4, 0x37, 0x00, 0x09, 0xd4, 0xe8, 0xd0, 0xb1, 0xd4, 0xe8, 0x6c,
-		0xee, 0x84, 0xa1, 0xf7, 0x60, 0x01, 0xbf, 0xec, 0x50, 0x06, 0xea, 0x2e, 0x7e, 0x19, 0xea, 0xf8,
-		0x90, 0xd8, 0xe4, 0xb6, 0x45, 0x35, 0x74, 0x45, 0x3b, 0x1b, 0xf5, 0x3e, 0xc2, 0x2f, 0xc3, 0xbb,
-		0xa4, 0xaf, 0x69, 0xa1, 0x77, 0x61, 0xce, 0xec, 0xfa, 0xd4, 0x78, 0xdf, 0xf3, 0x0d, 0xd7, 0x3c,
-		0xd0, 0x43, 0xef, 0x39, 0x3d, 0x3d, 0xca, 0xfa, 0xa4, 0x86, 0x78, 0xdf, 0x1d, 0xda, 0xb5, 0x4b,
-		0x7a, 0xd4, 0x9f, 0x8c, 0xc3, 0x62, 0x6e, 0xd5, 0x5c, 0x86, 0xc4, 0x2b, 0x53, 0x4e, 0xbb, 0xb2,
-		0x7b, 0x30, 0x15, 0xa3, 0x0d, 0x7b, 0x1d, 0xcc, 0x79, 0xb5, 0x26, 0xc5, 0xb8, 0xdb, 0xeb, 0x60,
-		0x6d, 0xf2, 0x28, 0xf1, 0x85, 0x54, 0x98, 0x12, 0x31, 0x66, 0xc2, 0x4d, 0x30, 0xe4, 0x19, 0x2c,
-		0x75, 0x7c, 0x7c, 0x68, 0x7b, 0xdd, 0x40, 0x0f, 0x88, 0x25, 0x82, 0xad, 0xfe, 0xf8, 0x33, 0x74,
-		0xde, 0xe5, 0x9c, 0x19, 0xdc, 0x74, 0xc3, 0x2b, 0xef, 0x3f, 0x33, 0x9c, 0x2e, 0xd6, 0x16, 0x22,
-		0xe8, 0x1d, 0x06, 0x1c, 0xe1, 0x7d, 0x07, 0xce, 0x52, 0xa3, 0x9d, 0x59, 0xd9, 0x31, 0xc6, 0x61,
-		0x4a, 0xc1, 0x0c, 0xe9, 0xba, 0x47, 0x7a, 0xa2, 0xe1, 0x37, 0x60, 0x9c, 0x1a, 0xe0, 0xc4, 0xa1,
-		0xa6, 0x6e, 0xc8, 0xc4, 0xe6, 0x79, 0xf1, 0x25, 0x1f, 0x49, 0xe5, 0x58, 0xc8, 0x7f, 0xa1, 0xfb,
-		0x30, 0x13, 0x50, 0x89, 0xd5, 0xfb, 0x28, 0x46, 0x07, 0x41, 0x51, 0x0d, 0x52, 0x82, 0x8e, 0xde,
-		0x87, 0x05, 0xd3, 0xb1, 0x09, 0xa5, 0x8e, 0xbd, 0xe7, 0x1b, 0x7e, 0x4f, 0x3f, 0xc4, 0x3e, 0xd5,
-		0x80, 0x63, 0x54, 0xa4, 0xe7, 0x58, 0xef, 0x03, 0xd6, 0xf9, 0x8c, 0xf5, 0x25, 0xa0, 0x5a, 0xd8,
-		0x08, 0xbb, 0x3e, 0x8e, 0xa1, 0xc6, 0x93, 0x50, 0xf7, 0x58, 0x67, 0x04, 0x75, 0x01, 0x26, 0x38,
-		0x94, 0xdd, 0xee, 0x38, 0x35, 0xa0, 0x43, 0x81, 0x35, 0x35, 0xdb, 0x1d, 0x07, 0x05, 0x70, 0x29,
-		0xbb, 0x2a, 0x3d, 0x30, 0x0f, 0xb0, 0xd5, 0x75, 0xb0, 0x1e, 0x7a, 0x6c, 0xb3, 0xa8, 0x17, 0xe8,
-		0x75, 0xc3, 0xda, 0x44, 0x99, 0xc3, 0xf2, 0x7a, 0x7a, 0xad, 0x3b, 0x1c, 0xd3, 0xae, 0x47, 0xf7,
-		0x6d, 0x97, 0xa1, 0x21, 0x26, 0x09, 0xdb, 0x2a, 0xe2, 0xa3, 0xf7, 0x17, 0x32, 0x49, 0x1d, 0xd1,
-		0x59, 0xda, 0xb5, 0x43, 0x7a, 0xa2, 0x55, 0x14, 0x1d, 0xa7, 0xa9, 0xa2, 0xe3, 0x84, 0x1e, 0x40,
-		0x35, 0x96, 0xed, 0x80, 0x1c, 0xa6, 0x5a, 0x95, 0x3a, 0x9d, 0x17, 0xd3, 0x5b, 0xc5, 0x22, 0x01,
-		0x49, 0xf9, 0x66, 0x27, 0x2f, 0x3e, 0x18, 0xf4, 0x13, 0x99, 0x30, 0x17, 0x63, 0x33, 0x1d, 0x2f,
-		0xc0, 0x1c, 0xe7, 0x34, 0xc5, 0x79, 0x79, 0x40, 0x83, 0x81, 0x00, 0x12, 0x7c, 0xdd, 0x40, 0x8b,
-		0xcf, 0x73, 0xdc, 0x48, 0x4e, 0xf9, 0x2c, 0x67, 0x84, 0xce, 0x82, 0x17, 0xe4, 0x16, 0x9f, 0x11,
-		0xdd, 0x89, 0x7d, 0xaa, 0x39, 0x83, 0x3e, 0x8e, 0xc6, 0x6b, 0x33, 0x87, 0x99, 0x16, 0x74, 0x13,
-		0x96, 0x6d, 0x72, 0xe6, 0x32, 0x7b, 0x8c, 0x5d, 0xa2, 0x67, 0xac, 0xda, 0x2c, 0x35, 0x03, 0x17,
-		0xed, 0x20, 0xad, 0x8d, 0xef, 0xb2, 0x6e, 0xf5, 0x57, 0x0a, 0x2c, 0x3e, 0xf1, 0x1c, 0xe7, 0xff,
-		0x99, 0x36, 0xfe, 0xf9, 0x18, 0xd4, 0xf2, 0xcb, 0xfe, 0x46, 0x1d, 0x7f, 0xa3, 0x8e, 0xbf, 0x8e,
-		0xea, 0xb8, 0xe8, 0x7c, 0x4c, 0x16, 0xaa, 0x57, 0xa1, 0xae, 0x9a, 0x3a, 0xb5, 0xae, 0xfa, 0xf5,
-		0xd3, 0xda, 0xea, 0x3f, 0x0d, 0xc1, 0xaa, 0x86, 0x4d, 0xcf, 0xb7, 0x92, 0x51, 0x3a, 0x7e, 0x2c,
-		0x5e, 0xa5, 0xa6, 0xbc, 0x00, 0x13, 0xb1, 0xe0, 0xc4, 0x4a, 0x00, 0xa2, 0xa6, 0xa6, 0x85, 0x16,
-		0x61, 0x94, 0xca, 0x18, 0x3f, 0xf1, 0x15, 0x6d, 0x84, 0x7c, 0x36, 0x2d, 0x74, 0x1e, 0x80, 0xdb,
-		0xf1, 0xd1, 0xd9, 0x1d, 0xd7, 0xc6, 0x79, 0x4b, 0xd3, 0x42, 0x1a, 0x4c, 0x76, 0x3c, 0xc7, 0xd1,
-		0x23, 0x5f, 0x61, 0x44, 0xe2, 0x2b, 0x10, 0x1d, 0x7a, 0xcf, 0xf3, 0x93, 0xac, 0x89, 0x7c, 0x85,
-		0x09, 0x82, 0x84, 0x7f, 0xa8, 0xff, 0x36, 0x0a, 0x6b, 0x12, 0x2e, 0x72, 0xc5, 0x9b, 0xd3, 0x90,
-		0xca, 0xc9, 0x34, 0xa4, 0x54, 0xfb, 0x0d, 0x9d, 0x5c, 0xfb, 0x7d, 0x0b, 0x50, 0xc4, 0x5f, 0x2b,
-		0xab, 0x7e, 0x67, 0xe2, 0x9e, 0x68, 0xf4, 0x3a, 0x51, 0x60, 0x02, 0xd5, 0x5b, 0x21, 0x1a, 0x2a,
-		0x85, 0x37, 0xa7, 0xd1, 0x87, 0xf3, 0x1a, 0x3d, 0x11, 0xcf, 0x1f, 0x49, 0xc7, 0xf3, 0xaf, 0x41,
-		0x8d, 0xab, 0x94, 0x7e, 0x00, 0x22, 0xba, 0xfd, 0x47, 0xe9, 0xed, 0xbf, 0xc0, 0xfa, 0x63, 0xd9,
-		0xe1, 0x97, 0x3f, 0xd2, 0x60, 0x2a, 0x8e, 0x5b, 0xd3, 0x90, 0x05, 0x0b, 0x84, 0xbf, 0x53, 0x74,
-		0x1a, 0x77, 0x7d, 0xc3, 0x0d, 0x88, 0x2a, 0x4b, 0xb9, 0xe9, 0x93, 0x56, 0xe2, 0x0b, 0x7d, 0x06,
-		0xe7, 0x04, 0x01, 0x91, 0xbe, 0x0a, 0x1f, 0x1f, 0x44, 0x85, 0x2f, 0xe5, 0xc4, 0x3d, 0xd6, 0xe6,
-		0x05, 0xa6, 0x25, 0x14, 0x99, 0x96, 0x6b, 0x30, 0x99, 0xd2, 0x79, 0x13, 0x54, 0xe7, 0x4d, 0xec,
-		0x25, 0x94, 0xdd, 0x6d, 0xa8, 0xf6, 0xb7, 0x95, 0xe6, 0x43, 0x26, 0x4b, 0xf3, 0x21, 0x53, 0x31,
-		0x04, 0x4d, 0x87, 0x7c, 0x04, 0x93, 0xd1, 0x5e, 0x53, 0x04, 0x53, 0xa5, 0x08, 0x26, 0xf8, 0x78,
-		0x0a, 0x6e, 0xc0, 0x28, 0xf1, 0xe4, 0x89, 0x92, 0xad, 0xd2, 0xf8, 0xcb, 0xfd, 0x46, 0x41, 0x2a,
-		0xb4, 0x51, 0x7a, 0x8a, 0x68, 0x88, 0xc0, 0xc6, 0xc1, 0x5d, 0x37, 0xf4, 0x7b, 0x5a, 0x84, 0xb7,
-		0xfe, 0x19, 0x4c, 0x26, 0x3b, 0xd0, 0x0c, 0x54, 0x9e, 0xe3, 0x1e, 0x57, 0x56, 0xe4, 0x27, 0xba,
-		0x06, 0xc3, 0x87, 0x44, 0xfc, 0xa5, 0xf1, 0x87, 0xe8, 0xd4, 0xb1, 0x38, 0x04, 0x03, 0xb8, 0x31,
-		0x74, 0x4d, 0x49, 0xe8, 0xc9, 0x28, 0xea, 0xf4, 0x8d, 0x9e, 0xcc, 0xe9, 0xc9, 0x24, 0x6b, 0x84,
-		0x7a, 0xf2, 0x97, 0x95, 0x48, 0x4f, 0x0a, 0xb9, 0xc8, 0xf5, 0xe4, 0x27, 0x30, 0x9d, 0xd1, 0x43,
-		0x52, 0x4d, 0xc9, 0xee, 0xdf, 0x1e, 0xd5, 0x24, 0x5a, 0x35, 0xad, 0xa7, 0x72, 0x92, 0x3b, 0x74,
-		0x3c, 0xc9, 0x4d, 0xa8, 0xa5, 0x4a, 0x5a, 0x2d, 0x7d, 0x06, 0x2b, 0xe9, 0x53, 0xa5, 0x7b, 0x2d,
-		0x3d, 0x3c, 0xb0, 0x03, 0x3d, 0x99, 0x97, 0x94, 0x4f, 0x55, 0x4f, 0x9d, 0xb2, 0xc7, 0xad, 0xdd,
-		0x03, 0x3b, 0xb8, 0xcd, 0xf1, 0x37, 0x61, 0xf6, 0x00, 0x1b, 0x7e, 0xb8, 0x87, 0x8d, 0x50, 0xb7,
-		0x70, 0x68, 0xd8, 0x4e, 0xc0, 0x43, 0x8c, 0xf2, 0xe8, 0xdb, 0x4c, 0x0c, 0xb6, 0xcd, 0xa0, 0xf2,
-		0xf7, 0xce, 0xc8, 0xc9, 0xee, 0x9d, 0x37, 0x61, 0x3a, 0xc6, 0xc3, 0xc4, 0x9a, 0x2a, 0xe0, 0x71,
-		0x2d, 0xb6, 0x7a, 0xb6, 0x69, 0xab, 0xfa, 0xe7, 0x0a, 0xbc, 0xc6, 0x76, 0x33, 0x75, 0x92, 0x79,
-		0x7a, 0xb1, 0x7f, 0x5e, 0xb4, 0x6c, 0xc4, 0xee, 0x5a, 0x51, 0xc4, 0xae, 0x0c, 0xd5, 0x80, 0xa1,
-		0xbb, 0xbf, 0xab, 0xc0, 0xeb, 0x72, 0x6c, 0x5c, 0x04, 0x71, 0xff, 0x72, 0xf3, 0x79, 0x1b, 0x27,
-		0xf1, 0xc6, 0xc9, 0x55, 0x97, 0x36, 0x1d, 0x64, 0x24, 0xfd, 0x67, 0x0a, 0xac, 0xf4, 0x63, 0xde,
-		0xc4, 0x40, 0xb6, 0xec, 0xa0, 0x63, 0x84, 0xe6, 0x81, 0xee, 0x78, 0xa6, 0xe1, 0x38, 0xbd, 0xda,
-		0x10, 0x55, 0x98, 0x9f, 0x49, 0x66, 0x2d, 0x5f, 0x4e, 0xa3, 0x1f, 0x14, 0xdf, 0xf5, 0xb6, 0xf9,
-		0x0c, 0x0f, 0xd8, 0x04, 0x4c, 0x8f, 0x2e, 0x1b, 0xc5, 0x23, 0xea, 0xbf, 0x0f, 0xab, 0x65, 0x08,
-		0x04, 0xfa, 0x76, 0x3b, 0xad, 0x6f, 0xc5, 0x21, 0xf7, 0x48, 0x0d, 0x50, 0x5c, 0x11, 0x62, 0x7a,
-		0xed, 0x26, 0x74, 0xef, 0x8f, 0x14, 0xa2, 0x7b, 0x73, 0xcb, 0xbc, 0x67, 0xd8, 0x4e, 0x5f, 0x96,
-		0x06, 0xcc, 0xd5, 0x94, 0xe1, 0x19, 0x50, 0x90, 0x5e, 0x23, 0x7a, 0xac, 0x10, 0x13, 0x8f, 0x04,
-		0xff, 0x44, 0x01, 0x35, 0xaf, 0xed, 0x3e, 0x8e, 0x8e, 0x67, 0x44, 0xf9, 0xd3, 0x2c, 0xe5, 0x57,
-		0x0b, 0x28, 0x2f, 0xc3, 0x34, 0x20, 0xed, 0x4f, 0xc8, 0xe1, 0x94, 0xe0, 0xe2, 0xb2, 0xf9, 0x16,
-		0xcc, 0x98, 0x86, 0x6b, 0xe2, 0xf8, 0x06, 0xc0, 0xec, 0x4e, 0x1b, 0xd3, 0xa6, 0x59, 0xbb, 0x16,
-		0x35, 0x27, 0xcf, 0x7b, 0x12, 0xe7, 0x29, 0xcf, 0xbb, 0x0c, 0xd5, 0x80, 0x4b, 0x7d, 0x23, 0x3e,
-		0xee, 0x05, 0xc8, 0x12, 0xd9, 0x40, 0xc1, 0xc0, 0xd3, 0x48, 0x58, 0x21, 0x9e, 0x63, 0x4b, 0x98,
-		0x08, 0x53, 0x4a, 0xc2, 0xf2, 0x0b, 0xa4, 0xfb, 0xd3, 0xa7, 0x7c, 0x60, 0x09, 0x2b, 0xc3, 0x34,
-		0x20, 0xed, 0x17, 0xc5, 0xe2, 0x10, 0xe3, 0xe2, 0xd4, 0xff, 0xbd, 0x02, 0x17, 0x34, 0xdc, 0xf6,
-		0x0e, 0x31, 0x4b, 0xf3, 0x7f, 0x55, 0x82, 0x74, 0x69, 0xc3, 0xa8, 0x92, 0x31, 0x8c, 0x54, 0x95,
-		0xc8, 0x4a, 0x11, 0xd5, 0x7c, 0x69, 0xff, 0x30, 0x04, 0x17, 0xf9, 0x12, 0xd8, 0xb2, 0x0b, 0x73,
-		0xcc, 0xd2, 0x05, 0x1a, 0x50, 0x4d, 0x9f, 0x41, 0xbe, 0xb8, 0x1b, 0x05, 0xfb, 0x37, 0xc0, 0x84,
-		0xda, 0x54, 0xea, 0xf4, 0xa2, 0x3d, 0x58, 0x8c, 0xd3, 0xf8, 0xc2, 0x42, 0x2d, 0x71, 0x86, 0xf7,
-		0x2e, 0x87, 0xc9, 0x64, 0x78, 0xb1, 0xa8, 0xf9, 0xd8, 0x29, 0xfc, 0x75, 0x78, 0xa3, 0x6c, 0x2d,
-		0x9c, 0xcf, 0xff, 0xa8, 0xc0, 0x72, 0x14, 0x15, 0x12, 0x78, 0xe9, 0xaf, 0x44, 0x7c, 0x2e, 0xc1,
-		0xac, 0x1d, 0xe8, 0xe9, 0xba, 0x29, 0xca, 0xcb, 0x31, 0x6d, 0xda, 0x0e, 0xee, 0x25, 0x2b, 0xa2,
-		0xd4, 0x15, 0x38, 0x27, 0x26, 0x9f, 0xaf, 0xef, 0x97, 0x43, 0x44, 0x83, 0x11, 0x65, 0x9d, 0xce,
-		0x4a, 0xe7, 0x54, 0xeb, 0xab, 0x58, 0xe8, 0x1a, 0x4c, 0xf2, 0xa2, 0x38, 0x6c, 0x25, 0x02, 0xb5,
-		0x71, 0x5b, 0xd3, 0x42, 0x9f, 0xc2, 0x59, 0x33, 0x22, 0x35, 0x31, 0xf5, 0x99, 0x63, 0x4d, 0x8d,
-		0x62, 0x14, 0xfd, 0xb9, 0x1f, 0xc0, 0x4c, 0xa2, 0xd0, 0x8d, 0x39, 0x09, 0xc3, 0x83, 0x3a, 0x09,
-		0xd3, 0x7d, 0x50, 0xda, 0xa0, 0xbe, 0x49, 0x4e, 0xab, 0x94, 0xcb, 0x7c, 0x3f, 0xfe, 0x63, 0x08,
-		0x6a, 0x1a, 0x2f, 0xe2, 0xc4, 0x14, 0x36, 0x78, 0xb6, 0xf9, 0x2a, 0xf7, 0xe0, 0x77, 0x61, 0x3e,
-		0x1d, 0xc9, 0xec, 0xe9, 0x76, 0x88, 0xdb, 0x51, 0xfd, 0x44, 0xb6, 0x52, 0xc0, 0x6a, 0xdb, 0x6e,
-		0x2e, 0x98, 0xd9, 0x6b, 0x86, 0xb8, 0xad, 0x9d, 0x3d, 0xcc, 0xb5, 0x05, 0xe8, 0x03, 0x18, 0xa1,
-		0xbc, 0x0d, 0xf8, 0x96, 0x89, 0x03, 0x1b, 0xdb, 0x46, 0x68, 0xdc, 0x71, 0xbc, 0x3d, 0x8d, 0x0f,
-		0x46, 0x5b, 0x50, 0x75, 0xf1, 0x91, 0xee, 0x77, 0xf9, 0xd6, 0x44, 0x9e, 0x4b, 0x09, 0xf8, 0xa4,
-		0x8b, 0x8f, 0xb4, 0x2e, 0xdb, 0x93, 0x40, 0x5d, 0x86, 0x25, 0x01, 0xab, 0xf9, 0x46, 0xfc, 0x40,
-		0x81, 0x85, 0x9d, 0x9e, 0x6b, 0xee, 0x1c, 0x18, 0xbe, 0xc5, 0xe3, 0x9b, 0x7c, 0x1b, 0x2e, 0x42,
-		0x35, 0xf0, 0xba, 0xbe, 0x89, 0x75, 0x5e, 0xdb, 0xcb, 0xf7, 0x62, 0x8a, 0xb5, 0x6e, 0xb1, 0x46,
-		0xb4, 0x04, 0x63, 0x01, 0x01, 0x8e, 0x2e, 0xb0, 0x61, 0x6d, 0x94, 0x7e, 0x37, 0x2d, 0xd4, 0x80,
-		0x33, 0xd4, 0x59, 0xac, 0x94, 0x7a, 0x70, 0x74, 0x9c, 0xba, 0x04, 0x8b, 0x39, 0x5a, 0x38, 0x9d,
-		0xff, 0x32, 0x0c, 0x67, 0x49, 0x5f, 0x74, 0x11, 0xbe, 0x4a, 0x59, 0xa9, 0xc1, 0x68, 0x14, 0x4f,
-		0x62, 0x47, 0x35, 0xfa, 0x24, 0x27, 0xb9, 0xef, 0xcc, 0xc6, 0x81, 0x82, 0x38, 0xb0, 0x40, 0x78,
-		0x92, 0x8f, 0x22, 0x0d, 0x1f, 0x37, 0x8a, 0x74, 0x1e, 0x20, 0x72, 0xaa, 0x6c, 0x8b, 0x3a, 0xa1,
-		0x15, 0x6d, 0x9c, 0xb7, 0x34, 0xad, 0x9c, 0xab, 0x3e, 0x7a, 0x3c, 0x57, 0xfd, 0x13, 0x9e, 0xbb,
-		0xe9, 0x7b, 0xcd, 0x14, 0xcb, 0x58, 0x29, 0x96, 0x59, 0x02, 0x16, 0xdb, 0xbf, 0x14, 0xd7, 0x15,
-		0x18, 0x8d, 0x5c, 0xee, 0xf1, 0x01, 0x5c, 0xee, 0x68, 0x70, 0x32, 0x5c, 0x00, 0xe9, 0x70, 0xc1,
-		0x2d, 0x98, 0x64, 0x99, 0x25, 0x5e, 0xe3, 0x3b, 0x31, 0x40, 0x8d, 0xef, 0x04, 0x4d, 0x38, 0xf1,
-		0xf2, 0xde, 0x77, 0x81, 0x96, 0xe8, 0xf2, 0x9a, 0x76, 0xdd, 0xb6, 0xb0, 0x1b, 0xda, 0x61, 0x8f,
-		0xc6, 0xf2, 0xc6, 0x35, 0x44, 0xfa, 0x3e, 0xa5, 0x5d, 0x4d, 0xde, 0x83, 0x1e, 0xc1, 0x74, 0x46,
-		0x35, 0xf0, 0xb8, 0xdd, 0xc5, 0x81, 0x94, 0x82, 0x56, 0x4d, 0x2b, 0x04, 0x75, 0x01, 0xe6, 0xd2,
-		0x92, 0xcc, 0x45, 0xfc, 0x4f, 0x15, 0x58, 0x8e, 0xea, 0xd6, 0xbe, 0x22, 0x26, 0x9c, 0xfa, 0x27,
-		0x0a, 0x9c, 0x13, 0xd3, 0xc4, 0xbd, 0x9b, 0xf7, 0x60, 0xa1, 0xcd, 0xda, 0x59, 0x56, 0x45, 0xb7,
-		0x5d, 0xdd, 0x34, 0xcc, 0x03, 0xcc, 0x29, 0x3c, 0xdb, 0x4e, 0x40, 0x35, 0xdd, 0x2d, 0xd2, 0x85,
-		0xae, 0xc3, 0x52, 0x0e, 0xc8, 0x32, 0x42, 0x63, 0xcf, 0x08, 0x30, 0x37, 0x82, 0x17, 0xd2, 0x70,
-		0xdb, 0xbc, 0x57, 0x3d, 0x07, 0xf5, 0x88, 0x1e, 0xce, 0xcf, 0x8f, 0xbd, 0xb8, 0xf0, 0x48, 0xfd,
-		0x83, 0xa1, 0x3e, 0x0b, 0x53, 0xdd, 0x9c, 0xda, 0x75, 0x98, 0x71, 0xbb, 0xed, 0x3d, 0xec, 0xeb,
-		0x5e, 0x4b, 0xa7, 0x5a, 0x2a, 0xa0, 0x74, 0x0e, 0x6b, 0x55, 0xd6, 0xfe, 0xb8, 0x45, 0x95, 0x4f,
-		0x40, 0x98, 0x1d, 0x69, 0xb5, 0x80, 0xc6, 0x0e, 0x86, 0xb5, 0x31, 0xae, 0xd6, 0x02, 0xd4, 0x84,
-		0x49, 0xbe, 0x13, 0x6c, 0xa9, 0xe2, 0x1a, 0xcd, 0x48, 0x1c, 0x58, 0x30, 0x87, 0xae, 0x9c, 0x1a,
-		0x77, 0x13, 0x56, 0xbf, 0x01, 0x5d, 0x81, 0x45, 0x36, 0x8f, 0xe9, 0xb9, 0xa1, 0xef, 0x39, 0x0e,
-		0xf6, 0x29, 0x4f, 0xba, 0xec, 0xa6, 0x18, 0xd7, 0xe6, 0x69, 0xf7, 0x56, 0xdc, 0xcb, 0xf4, 0x22,
-		0x3d, 0x21, 0x96, 0xe5, 0xe3, 0x20, 0xe0, 0x11, 0xc7, 0xe8, 0x53, 0x6d, 0xc0, 0x2c, 0xcb, 0x4b,
-		0x11, 0xb8, 0x48, 0x76, 0x92, 0x4a, 0x5a, 0x49, 0x29, 0x69, 0x75, 0x0e, 0x50, 0x72, 0x3c, 0x17,
-		0xc6, 0xff, 0x52, 0x60, 0x96, 0x59, 0xe7, 0x49, 0x33, 0xb0, 0x18, 0x0d, 0xba, 0xc9, 0x73, 0xb8,
-		0x71, 0xca, 0xba, 0xba, 0x79, 0xa1, 0x80, 0x21, 0x04, 0x23, 0x0d, 0x8b, 0xd1, 0x2c, 0x2e, 0x0d,
-		0x89, 0x25, 0x82, 0xab, 0x95, 0x54, 0x70, 0x75, 0x0b, 0xa6, 0x0f, 0xed, 0xc0, 0xde, 0xb3, 0x1d,
-		0x3b, 0xec, 0x31, 0x4d, 0x54, 0x1e, 0x0f, 0xac, 0xf6, 0x41, 0xa8, 0x1a, 0x5a, 0x83, 0x49, 0x7e,
-		0x85, 0xe9, 0xae, 0xc1, 0x35, 0xee, 0xb8, 0x36, 0xc1, 0xdb, 0x1e, 0x19, 0x6d, 0x4c, 0xb8, 0x90,
-		0x5c, 0x2e, 0xe7, 0xc2, 0x0f, 0x29, 0x17, 0x02, 0x1c, 0x3e, 0xed, 0xe2, 0x2e, 0x1e, 0x80, 0x0b,
-		0xd9, 0x99, 0x86, 0x72, 0x33, 0xa5, 0x19, 0x55, 0x39, 0x26, 0xa3, 0x18, 0x9d, 0x7d, 0x82, 0x38,
-		0x9d, 0x3f, 0x56, 0x60, 0x2e, 0x92, 0xfb, 0xaf, 0x0c, 0xa9, 0x8f, 0x61, 0x3e, 0x43, 0x13, 0x3f,
-		0x85, 0x57, 0x60, 0xb1, 0xe3, 0x7b, 0x26, 0x0e, 0x02, 0xdb, 0xdd, 0xd7, 0xe9, 0x73, 0x1f, 0xa6,
-		0x07, 0xc8, 0x61, 0xac, 0x10, 0x99, 0xef, 0x77, 0x53, 0x48, 0xaa, 0x04, 0x02, 0xf5, 0x7b, 0x0a,
-		0x9c, 0xbf, 0x8f, 0x43, 0xad, 0xff, 0xf8, 0xe7, 0x21, 0x0e, 0x02, 0x63, 0x1f, 0xc7, 0x26, 0xcb,
-		0x2d, 0x18, 0xa1, 0xe9, 0x1b, 0x86, 0x68, 0x62, 0xf3, 0xcd, 0x02, 0x6a, 0x13, 0x28, 0x68, 0x6e,
-		0x47, 0xe3, 0x60, 0x03, 0x30, 0x85, 0xe8, 0x98, 0x95, 0x22, 0x2a, 0xf8, 0x02, 0x5f, 0x40, 0x95,
-		0x71, 0xbd, 0xcd, 0x7b, 0x38, 0x39, 0x9f, 0x14, 0x46, 0x1f, 0xe5, 0x08, 0x1b, 0xf4, 0x6c, 0x46,
-		0xad, 0x2c, 0xd2, 0x38, 0x15, 0x24, 0xdb, 0xea, 0x0e, 0xa0, 0xfc, 0xa0, 0x64, 0x34, 0x71, 0x98,
-		0x45, 0x13, 0xbf, 0x93, 0x8e, 0x26, 0x5e, 0x2a, 0x67, 0x50, 0x4c, 0x4c, 0x22, 0x92, 0xd8, 0x86,
-		0xd5, 0xfb, 0x38, 0xdc, 0x7e, 0xf0, 0x54, 0xb2, 0x17, 0x4d, 0x00, 0x76, 0xa4, 0xdd, 0x96, 0x17,
-		0x31, 0x60, 0x80, 0xe9, 0x88, 0x20, 0x51, 0x35, 0x49, 0x45, 0x8f, 0xfc, 0x0a, 0xd4, 0x97, 0xb0,
-		0x26, 0x99, 0x8e, 0x33, 0x7d, 0x07, 0x66, 0x13, 0xcf, 0xc2, 0x68, 0x2a, 0x31, 0x9a, 0xf6, 0x8d,
-		0xc1, 0xa6, 0xd5, 0x66, 0xfc, 0x74, 0x43, 0xa0, 0xfe, 0xab, 0x02, 0x73, 0x1a, 0x36, 0x3a, 0x1d,
-		0x87, 0xb9, 0x3c, 0xf1, 0xea, 0x16, 0x60, 0x84, 0x87, 0xee, 0xd9, 0x3d, 0xc7, 0xbf, 0xe4, 0xa5,
-		0xfe, 0xe2, 0x4b, 0xba, 0x72, 0x5a, 0x7b, 0xf4, 0x64, 0xce, 0x85, 0xba, 0x08, 0xf3, 0x99, 0xa5,
-		0x71, 0x6d, 0xf2, 0x0b, 0x05, 0x96, 0x35, 0xdc, 0xf2, 0x71, 0x70, 0x10, 0x67, 0x31, 0x08, 0x37,
-		0xbe, 0x82, 0x6b, 0x27, 0x8e, 0xbf, 0x98, 0x54, 0xbe, 0x96, 0xeb, 0xb0, 0xb8, 0xe5, 0x75, 0x5d,
-		0x22, 0x3c, 0x59, 0x01, 0x5d, 0x01, 0x68, 0x79, 0xbe, 0x89, 0xef, 0xe1, 0xd0, 0x3c, 0xe0, 0x21,
-		0xd9, 0x44, 0x8b, 0x6a, 0x40, 0x2d, 0x0f, 0xca, 0x85, 0xed, 0x2e, 0x8c, 0x62, 0x37, 0xa4, 0x99,
-		0x58, 0x26, 0x62, 0x6f, 0x17, 0x88, 0x18, 0xb7, 0x42, 0xb6, 0x1f, 0x3c, 0xa5, 0xb8, 0x78, 0xb6,
-		0x95, 0xc3, 0xaa, 0xbf, 0x18, 0x82, 0x05, 0x0d, 0x1b, 0x96, 0x80, 0xba, 0x4d, 0x38, 0x13, 0xd7,
-		0x36, 0x54, 0x37, 0x57, 0x8a, 0x6c, 0x8b, 0x07, 0x4f, 0xa9, 0xd6, 0xa5, 0x63, 0x65, 0xae, 0x58,
-		0xde, 0x99, 0xab, 0x88, 0x9c, 0xb9, 0x5d, 0xa8, 0xd9, 0x2e, 0x19, 0x61, 0x1f, 0x62, 0x1d, 0xbb,
-		0xb1, 0x06, 0x1b, 0xb0, 0x1e, 0x6c, 0x3e, 0x06, 0xbe, 0xeb, 0x46, 0xaa, 0xa8, 0x69, 0x11, 0xc1,
-		0xe8, 0x10, 0x24, 0x81, 0xfd, 0x39, 0xbb, 0x7c, 0x87, 0xb5, 0x31, 0xd2, 0xb0, 0x63, 0x7f, 0x8e,
-		0xd1, 0x1b, 0x30, 0x4d, 0xab, 0x1a, 0xe8, 0x08, 0x96, 0x7c, 0x1f, 0xa1, 0xc9, 0x77, 0x5a, 0xec,
-		0xf0, 0xc4, 0xd8, 0xc7, 0xac, 0x16, 0xef, 0x6f, 0x87, 0x60, 0x31, 0xc7, 0x2b, 0xbe, 0x1d, 0x27,
-		0x61, 0x96, 0x50, 0x5f, 0x0c, 0x9d, 0x4e, 0x5f, 0xa0, 0xef, 0xc2, 0x42, 0x0e, 0x69, 0x14, 0x04,
-		0x3c, 0xae, 0x02, 0x9c, 0xcb, 0x62, 0xa7, 0x31, 0x40, 0x01, 0xbb, 0xce, 0x88, 0xd8, 0xf5, 0xef,
-		0x0a, 0x2c, 0x3e, 0xe9, 0xfa, 0xfb, 0xf8, 0xeb, 0x2d, 0x5b, 0x6a, 0x1d, 0x6a, 0xf9, 0x65, 0xf2,
-		0xc3, 0xff, 0xe5, 0x10, 0x2c, 0x3e, 0xc4, 0x5f, 0x7b, 0x1e, 0xfc, 0xdf, 0x9c, 0xaf, 0x3b, 0x50,
-		0xcb, 0xf3, 0x8a, 0x9f, 0x2f, 0x01, 0x0e, 0x45, 0x84, 0xe3, 0x0b, 0x05, 0xce, 0x3d, 0xf2, 0x42,
-		0xbb, 0xd5, 0x23, 0xee, 0xb6, 0x77, 0x88, 0xfd, 0x87, 0x06, 0xf1, 0xa5, 0x63, 0xae, 0x7f, 0x17,
-		0x16, 0x5a, 0xbc, 0x47, 0x6f, 0xd3, 0x2e, 0x3d, 0x65, 0xb0, 0x15, 0x9d, 0x8f, 0x34, 0x3a, 0x66,
-		0xb3, 0xcd, 0xb5, 0xf2, 0x8d, 0x81, 0x7a, 0x01, 0xce, 0x17, 0x50, 0xc0, 0x85, 0xc2, 0x80, 0xe5,
-		0xfb, 0x38, 0xdc, 0xf2, 0xbd, 0x20, 0xe0, 0xbb, 0x92, 0xba, 0xdc, 0x52, 0x8e, 0x9f, 0x92, 0x71,
-		0xfc, 0x2e, 0x42, 0x35, 0x34, 0xfc, 0x7d, 0x1c, 0xc6, 0xbb, 0xcc, 0xae, 0xb9, 0x29, 0xd6, 0xca,
-		0xf1, 0xa9, 0xbf, 0xaa, 0xc0, 0x39, 0xf1, 0x1c, 0x9c, 0x9f, 0x6d, 0x82, 0x87, 0xa8, 0x86, 0xbd,
-		0x1e, 0x73, 0x43, 0xf9, 0xf2, 0xef, 0xcb, 0x0c, 0xc4, 0x42, 0x74, 0xd4, 0xf8, 0x0e, 0xee, 0xf4,
-		0xa8, 0x01, 0xc8, 0x6e, 0x98, 0xc9, 0x30, 0xd1, 0x84, 0xbe, 0x50, 0x60, 0xbe, 0x45, 0x33, 0x5e,
-		0xba, 0x69, 0x74, 0x03, 0xdc, 0x9f, 0x96, 0xe9, 0xbb, 0x87, 0x27, 0x9b, 0x96, 0x25, 0xd1, 0xb6,
-		0x08, 0xc6, 0xd4, 0xe4, 0xa8, 0x95, 0xeb, 0xa8, 0x77, 0x60, 0x36, 0x47, 0xa5, 0xc0, 0x3c, 0xbd,
-		0x9b, 0x36, 0x4f, 0x37, 0x0a, 0xc4, 0x21, 0x4b, 0x13, 0xdf, 0xbc, 0xa4, 0x8d, 0x5a, 0xef, 0xc0,
-		0x62, 0x01, 0x81, 0x82, 0x79, 0x6f, 0x25, 0xe7, 0xad, 0x16, 0x86, 0x7b, 0xef, 0xe3, 0xb0, 0x9f,
-		0x3d, 0xa4, 0x78, 0x93, 0x56, 0xf1, 0x7f, 0x2a, 0xb0, 0xce, 0xf3, 0x75, 0x39, 0xa6, 0xe5, 0x12,
-		0x0d, 0x12, 0xcf, 0x6c, 0x30, 0x29, 0x43, 0xcf, 0x98, 0x10, 0xc5, 0x85, 0x15, 0x51, 0xac, 0x7a,
-		0x70, 0xa6, 0xf1, 0x72, 0x8a, 0xa9, 0x30, 0xf1, 0x15, 0xa0, 0xd7, 0x61, 0xaa, 0x45, 0x0c, 0xa0,
-		0x47, 0x98, 0xd9, 0x52, 0x3c, 0xbf, 0x94, 0x6e, 0x54, 0x7d, 0x78, 0x6b, 0x80, 0xb5, 0xc6, 0xe6,
-		0xd2, 0x70, 0x64, 0x8f, 0x9f, 0x6c, 0x5b, 0x29, 0xb4, 0xfa, 0x01, 0x7d, 0x11, 0x16, 0x1d, 0x6c,
-		0x7a, 0x49, 0x0e, 0x10, 0x1b, 0x53, 0x43, 0xfa, 0xa4, 0x2a, 0x0d, 0x16, 0x1b, 0x0e, 0xf3, 0xfd,
-		0xbc, 0x4a, 0x14, 0x88, 0xe9, 0xf2, 0x42, 0xa9, 0x61, 0xad, 0x9f, 0x74, 0xd9, 0x61, 0x51, 0x98,
-		0xae, 0x4b, 0xe3, 0xe2, 0xd1, 0x9b, 0x45, 0x1e, 0x42, 0x62, 0xf1, 0xa1, 0x29, 0xde, 0xca, 0x22,
-		0x48, 0x9b, 0xff, 0xfc, 0x0e, 0x00, 0xb7, 0xfe, 0x6e, 0x3f, 0x69, 0xa2, 0xef, 0x2b, 0xb0, 0x20,
-		0x7e, 0x7b, 0x8d, 0xae, 0x14, 0x1e, 0x3f, 0xe9, 0xeb, 0xef, 0xfa, 0xd5, 0x63, 0xc3, 0xf1, 0x55,
-		0xff, 0xb1, 0x02, 0x8b, 0x05, 0xaf, 0xda, 0x91, 0x04, 0xa9, 0xf4, 0x9d, 0x7f, 0xfd, 0xda, 0xf1,
-		0x01, 0x39, 0x39, 0x3f, 0x57, 0x60, 0xb5, 0xec, 0x81, 0x3a, 0xfa, 0x4e, 0x19, 0xfa, 0xb2, 0xc7,
-		0xf2, 0xf5, 0xdb, 0xa7, 0xc0, 0xc0, 0x29, 0x25, 0x9b, 0x28, 0x7e, 0x7a, 0x2e, 0xd9, 0x44, 0xe9,
-		0x93, 0x77, 0xc9, 0x26, 0x96, 0xbc, 0x71, 0xff, 0x33, 0x05, 0xea, 0xc5, 0x0f, 0xb4, 0x51, 0x71,
-		0x7d, 0x55, 0xe9, 0xc3, 0xf5, 0xfa, 0x87, 0x27, 0x82, 0xe5, 0x74, 0xfd, 0x58, 0x81, 0xa5, 0xc2,
-		0xe7, 0xd7, 0xe8, 0x7a, 0x21, 0xea, 0xb2, 0xd7, 0xdf, 0xf5, 0x1b, 0x27, 0x01, 0xe5, 0x44, 0xb9,
-		0x30, 0x95, 0x7a, 0x97, 0x8b, 0xde, 0x29, 0x44, 0x26, 0x7a, 0xfe, 0x5b, 0x6f, 0x0c, 0x3a, 0x9c,
-		0xcf, 0xf7, 0x85, 0x02, 0x67, 0x05, 0x8f, 0x5b, 0xd1, 0x7b, 0xf2, 0xdd, 0x16, 0x3e, 0xa7, 0xad,
-		0xbf, 0x7f, 0x3c, 0x20, 0x4e, 0x42, 0x08, 0xd3, 0x99, 0x87, 0xa4, 0x68, 0x43, 0x76, 0xcf, 0x0b,
-		0x52, 0x0e, 0xf5, 0x77, 0x07, 0x07, 0xe0, 0xb3, 0x1e, 0xc1, 0x4c, 0xf6, 0xc1, 0x14, 0x2a, 0xc6,
-		0x52, 0xf0, 0xa4, 0xac, 0x7e, 0xf9, 0x18, 0x10, 0x09, 0xb1, 0x2b, 0xac, 0x1c, 0x94, 0x88, 0x5d,
-		0xd9, 0xa3, 0x8d, 0xfa, 0x29, 0x0a, 0x15, 0xd1, 0x5f, 0x2a, 0x70, 0x4e, 0x56, 0x58, 0x88, 0x6e,
-		0x9e, 0xb0, 0x1e, 0x91, 0x91, 0xf6, 0xd1, 0xa9, 0xaa, 0x19, 0x39, 0xcb, 0x0a, 0xaa, 0xef, 0xa4,
-		0x2c, 0x93, 0xd7, 0xfe, 0x49, 0x59, 0x56, 0x52, 0xec, 0x97, 0xd8, 0x47, 0x41, 0x69, 0x73, 0xe9,
-		0x3e, 0x16, 0x17, 0x95, 0x97, 0xee, 0xa3, 0xac, 0x92, 0x3a, 0xb1, 0x8f, 0xc2, 0x02, 0xb8, 0xf2,
-		0x7d, 0x94, 0x15, 0xe1, 0x95, 0xef, 0xa3, 0xb4, 0xea, 0x2e, 0xb9, 0x8f, 0xf9, 0x1a, 0xb7, 0xf2,
-		0x7d, 0x2c, 0xac, 0xb0, 0x2b, 0xdf, 0xc7, 0xe2, 0x92, 0x3a, 0xf4, 0x17, 0x34, 0x88, 0x58, 0x58,
-		0xbc, 0x86, 0x3e, 0x3c, 0xd6, 0x9a, 0xd3, 0xe5, 0x73, 0xf5, 0x9b, 0x27, 0x03, 0x4e, 0x91, 0x56,
-		0x58, 0xb9, 0x29, 0x25, 0xad, 0xac, 0x76, 0x54, 0x4a, 0x5a, 0x79, 0xb1, 0xe8, 0x5f, 0x29, 0xb0,
-		0x22, 0x2f, 0xd9, 0x42, 0xdf, 0x96, 0x4c, 0x30, 0x40, 0xdd, 0x5a, 0xfd, 0xd6, 0x89, 0xe1, 0x39,
-		0x8d, 0x3f, 0x54, 0xa0, 0x56, 0x54, 0xb8, 0x87, 0xae, 0x49, 0xb0, 0x4b, 0x2b, 0x14, 0xeb, 0xd7,
-		0x4f, 0x00, 0xc9, 0x29, 0xfa, 0x9e, 0x02, 0x73, 0xa2, 0xf2, 0x2f, 0x54, 0x7c, 0x73, 0x4a, 0x8a,
-		0xdd, 0xea, 0x1f, 0x1c, 0x13, 0x8a, 0x53, 0xf1, 0x53, 0xfa, 0x1f, 0x49, 0x92, 0xea, 0x27, 0xf4,
-		0x51, 0x89, 0x6c, 0xc8, 0x6b, 0xd3, 0xea, 0xdf, 0x3e, 0x29, 0x38, 0x27, 0xf0, 0x73, 0x98, 0xcd,
-		0x15, 0x02, 0xa1, 0xcb, 0x12, 0xa4, 0xe2, 0xfa, 0xac, 0xfa, 0xe6, 0x71, 0x40, 0xfa, 0xd6, 0x48,
-		0xa6, 0xb4, 0x47, 0x62, 0x8d, 0x88, 0x0b, 0x92, 0x24, 0xd6, 0x48, 0x41, 0xd5, 0x10, 0x7a, 0x0e,
-		0x93, 0xc9, 0x52, 0x0b, 0xf4, 0x2d, 0x29, 0x86, 0x4c, 0x6d, 0x51, 0xfd, 0x9d, 0x01, 0x47, 0x27,
-		0xa4, 0x50, 0x54, 0x2b, 0x21, 0x91, 0x42, 0x49, 0xb9, 0x87, 0x44, 0x0a, 0xa5, 0x05, 0x19, 0xc4,
-		0xf2, 0x14, 0x94, 0x40, 0x48, 0x2c, 0xcf, 0xe2, 0x7a, 0x8a, 0xfa, 0xfb, 0xc7, 0x03, 0x8a, 0x1f,
-		0x7d, 0x40, 0xbf, 0xa2, 0x00, 0x5d, 0x2a, 0xc4, 0x91, 0x2b, 0x53, 0xa8, 0xbf, 0x3d, 0xd0, 0xd8,
-		0xfe, 0x34, 0xfd, 0x94, 0xbd, 0x64, 0x9a, 0x5c, 0x19, 0x83, 0x64, 0x9a, 0x7c, 0x0d, 0x00, 0x9b,
-		0x26, 0xca, 0xb8, 0x4b, 0xa7, 0xc9, 0xd4, 0x09, 0x48, 0xa7, 0xc9, 0xa6, 0xf0, 0x89, 0x87, 0x92,
-		0xca, 0x96, 0x4b, 0x3c, 0x14, 0x51, 0xa6, 0x5f, 0xe2, 0xa1, 0x88, 0x93, 0xf0, 0xdf, 0x67, 0x7f,
-		0xaf, 0x23, 0xc8, 0xa8, 0x4a, 0x5c, 0x59, 0x69, 0xf6, 0x5d, 0xe2, 0xca, 0x96, 0xe4, 0xcb, 0x89,
-		0x01, 0x53, 0x98, 0xe0, 0x95, 0x18, 0x30, 0x65, 0x39, 0x68, 0x89, 0x01, 0x53, 0x9e, 0x4f, 0x76,
-		0x61, 0x2a, 0x95, 0x1e, 0x95, 0x6c, 0x88, 0x28, 0x43, 0x2c, 0xd9, 0x10, 0x61, 0xd6, 0x95, 0xaa,
-		0x0f, 0x51, 0x2a, 0x13, 0xc9, 0xdc, 0xbf, 0xc2, 0x24, 0xad, 0x44, 0x7d, 0xc8, 0xf2, 0xa5, 0xc4,
-		0x7f, 0xcb, 0x26, 0x3d, 0x25, 0xfe, 0x5b, 0x41, 0x6a, 0x55, 0xe2, 0xbf, 0x15, 0x66, 0x54, 0x43,
-		0x98, 0xce, 0x64, 0xf7, 0x24, 0x17, 0x84, 0x38, 0x67, 0x2a, 0xb9, 0x20, 0x8a, 0x12, 0x87, 0xc4,
-		0x5d, 0xcd, 0x64, 0x8f, 0x64, 0xee, 0xaa, 0x38, 0x9f, 0x26, 0x73, 0x57, 0x0b, 0x52, 0x53, 0x64,
-		0xe2, 0x6c, 0xb6, 0x45, 0x32, 0x71, 0x41, 0x12, 0x4b, 0x32, 0x71, 0x61, 0x2a, 0xe7, 0x8f, 0x14,
-		0x98, 0x17, 0x26, 0x48, 0x50, 0xb1, 0xc4, 0xc8, 0x52, 0x3a, 0xf5, 0x2b, 0xc7, 0x05, 0x4b, 0xc8,
-		0xbb, 0x28, 0xbd, 0x20, 0x91, 0x77, 0x49, 0xde, 0x46, 0x22, 0xef, 0xd2, 0x4c, 0xcc, 0x97, 0x4a,
-		0xfc, 0x3e, 0xa8, 0x38, 0x8e, 0x8d, 0x6e, 0x97, 0xf9, 0x1b, 0xa5, 0xf1, 0xfe, 0xfa, 0x9d, 0xd3,
-		0xa0, 0x48, 0x85, 0x74, 0x92, 0x81, 0x6c, 0x79, 0x48, 0x47, 0x10, 0x29, 0x97, 0x87, 0x74, 0x44,
-		0x31, 0xf2, 0x3b, 0xd7, 0x7f, 0xfb, 0xea, 0xbe, 0x1d, 0x1e, 0x74, 0xf7, 0x1a, 0xa6, 0xd7, 0xde,
-		0x48, 0xfd, 0x83, 0x72, 0x63, 0x1f, 0xbb, 0xec, 0xcf, 0xb2, 0x13, 0xff, 0xd6, 0xfd, 0x21, 0xff,
-		0x79, 0x78, 0x79, 0x6f, 0x84, 0xf6, 0xbd, 0xf7, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0xd0,
-		0xca, 0x61, 0xd9, 0x5b, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x4d, 0x6c, 0x1c, 0x47,
+		0x76, 0x30, 0x7a, 0x86, 0xc3, 0x9f, 0x47, 0x72, 0x48, 0x96, 0xf8, 0x33, 0x6c, 0x4a, 0x14, 0xd9,
+		0x96, 0x2c, 0x5a, 0x5e, 0x8f, 0x2c, 0xda, 0xfa, 0x5d, 0x79, 0xbd, 0x12, 0x29, 0xc9, 0x63, 0x48,
+		0xb2, 0xd4, 0xe4, 0xca, 0xdf, 0x17, 0x24, 0x9e, 0x6d, 0x4e, 0xd7, 0x90, 0x1d, 0xcd, 0x74, 0x8f,
+		0xbb, 0x7b, 0x28, 0x8d, 0x0f, 0x81, 0x83, 0x0d, 0x02, 0x64, 0xb1, 0x88, 0x93, 0xc5, 0x26, 0x08,
+		0x90, 0x20, 0x40, 0xb0, 0x41, 0x16, 0x6b, 0xe4, 0x96, 0xdc, 0x82, 0x9c, 0x72, 0xc9, 0x25, 0xa7,
+		0x9c, 0xf6, 0x10, 0x2c, 0x02, 0x6c, 0x0e, 0x09, 0x92, 0x53, 0xf6, 0x1c, 0x04, 0xf5, 0xd3, 0x7f,
+		0xd3, 0xd5, 0x35, 0x3d, 0xc3, 0x00, 0x96, 0xbd, 0xbe, 0x4d, 0x57, 0xbd, 0xf7, 0xea, 0xd5, 0xab,
+		0x57, 0xaf, 0x5e, 0xbd, 0xf7, 0xba, 0x07, 0xce, 0x77, 0x0f, 0xb0, 0x7b, 0xa9, 0x61, 0x98, 0xd8,
+		0x6e, 0xe0, 0x4b, 0x47, 0x96, 0xe7, 0x3b, 0x6e, 0xef, 0xd2, 0xf1, 0xe5, 0x4b, 0x1e, 0x76, 0x8f,
+		0xad, 0x06, 0xae, 0x76, 0x5c, 0xc7, 0x77, 0xd0, 0x0a, 0x01, 0xab, 0x72, 0xb0, 0x2a, 0x07, 0xab,
+		0x1e, 0x5f, 0x56, 0xd7, 0x0f, 0x1d, 0xe7, 0xb0, 0x85, 0x2f, 0x51, 0xb0, 0x83, 0x6e, 0xf3, 0x92,
+		0xd9, 0x75, 0x0d, 0xdf, 0x72, 0x6c, 0x86, 0xa8, 0x9e, 0xed, 0xef, 0xf7, 0xad, 0x36, 0xf6, 0x7c,
+		0xa3, 0xdd, 0xe1, 0x00, 0x29, 0x02, 0xcf, 0x5d, 0xa3, 0xd3, 0xc1, 0xae, 0xc7, 0xfb, 0x37, 0x12,
+		0x0c, 0x1a, 0x1d, 0x8b, 0x30, 0xd7, 0x70, 0xda, 0xed, 0x70, 0x88, 0x4d, 0x11, 0x44, 0xc0, 0x22,
+		0xe7, 0x42, 0x04, 0xf2, 0x71, 0x17, 0x87, 0x00, 0x9a, 0x08, 0xc0, 0x37, 0xbc, 0x67, 0x2d, 0xcb,
+		0xf3, 0x65, 0x30, 0xcf, 0x1d, 0xf7, 0x59, 0xb3, 0xe5, 0x3c, 0xe7, 0x30, 0x17, 0x45, 0x30, 0x5c,
+		0x94, 0xf5, 0x3e, 0xd8, 0xad, 0x41, 0xb0, 0xd8, 0xe5, 0x90, 0xaf, 0x24, 0x21, 0xcd, 0xb6, 0x65,
+		0x53, 0x29, 0xb4, 0xba, 0x9e, 0x3f, 0x08, 0x28, 0x29, 0x88, 0x4d, 0x31, 0xd0, 0xc7, 0x5d, 0xdc,
+		0xe5, 0x4b, 0xad, 0x5e, 0x10, 0x83, 0xb8, 0xb8, 0xd3, 0xb2, 0x1a, 0xf1, 0xa5, 0x3d, 0x97, 0x00,
+		0xf4, 0x8e, 0x0c, 0x17, 0x9b, 0xe9, 0x11, 0xcf, 0x67, 0x40, 0x25, 0x85, 0xa1, 0xfd, 0x4b, 0x09,
+		0xce, 0xec, 0xf9, 0x86, 0xeb, 0x7f, 0xc8, 0xdb, 0xef, 0xbe, 0xc0, 0x8d, 0x2e, 0x19, 0x4d, 0xc7,
+		0x1f, 0x77, 0xb1, 0xe7, 0xa3, 0x07, 0x30, 0xe1, 0xb2, 0x9f, 0x15, 0x65, 0x43, 0xd9, 0x9a, 0xde,
+		0xde, 0xae, 0x26, 0x94, 0xd2, 0xe8, 0x58, 0xd5, 0xe3, 0xcb, 0x55, 0x29, 0x11, 0x3d, 0x20, 0x81,
+		0xd6, 0x60, 0xca, 0x74, 0xda, 0x86, 0x65, 0xd7, 0x2d, 0xb3, 0x52, 0xd8, 0x50, 0xb6, 0xa6, 0xf4,
+		0x49, 0xd6, 0x50, 0x33, 0xd1, 0xaf, 0xc3, 0x52, 0xc7, 0x70, 0xb1, 0xed, 0xd7, 0x71, 0x40, 0xa0,
+		0x6e, 0xd9, 0x4d, 0xa7, 0x52, 0xa4, 0x03, 0x6f, 0x09, 0x07, 0x7e, 0x4c, 0x31, 0xc2, 0x11, 0x6b,
+		0x76, 0xd3, 0xd1, 0x4f, 0x75, 0xd2, 0x8d, 0xa8, 0x02, 0x13, 0x86, 0xef, 0xe3, 0x76, 0xc7, 0xaf,
+		0x8c, 0x6d, 0x28, 0x5b, 0x25, 0x3d, 0x78, 0x44, 0x3b, 0x30, 0x87, 0x5f, 0x74, 0x2c, 0xb6, 0x81,
+		0xea, 0x64, 0xa7, 0x54, 0x4a, 0x74, 0x44, 0xb5, 0xca, 0x76, 0x49, 0x35, 0xd8, 0x25, 0xd5, 0xfd,
+		0x60, 0x1b, 0xe9, 0xe5, 0x08, 0x85, 0x34, 0xa2, 0x26, 0xac, 0x36, 0x1c, 0xdb, 0xb7, 0xec, 0x2e,
+		0xae, 0x1b, 0x5e, 0xdd, 0xc6, 0xcf, 0xeb, 0x96, 0x6d, 0xf9, 0x96, 0xe1, 0x3b, 0x6e, 0x65, 0x7c,
+		0x43, 0xd9, 0x2a, 0x6f, 0xbf, 0x2e, 0x9c, 0xc0, 0x0e, 0xc7, 0xba, 0xed, 0x3d, 0xc2, 0xcf, 0x6b,
+		0x01, 0x8a, 0xbe, 0xdc, 0x10, 0xb6, 0xa3, 0x1a, 0x2c, 0x04, 0x3d, 0x66, 0xbd, 0x69, 0x58, 0xad,
+		0xae, 0x8b, 0x2b, 0x13, 0x94, 0xdd, 0xd3, 0x42, 0xfa, 0xf7, 0x18, 0x8c, 0x3e, 0x1f, 0xa2, 0xf1,
+		0x16, 0xa4, 0xc3, 0x72, 0xcb, 0xf0, 0xfc, 0x7a, 0xc3, 0x69, 0x77, 0x5a, 0x98, 0x4e, 0xde, 0xc5,
+		0x5e, 0xb7, 0xe5, 0x57, 0x26, 0x25, 0xf4, 0x1e, 0x1b, 0xbd, 0x96, 0x63, 0x98, 0xfa, 0x22, 0xc1,
+		0xdd, 0x09, 0x51, 0x75, 0x8a, 0x89, 0xfe, 0x1f, 0xac, 0x35, 0x2d, 0xd7, 0xf3, 0xeb, 0x26, 0x6e,
+		0x58, 0x1e, 0x95, 0xa7, 0xe1, 0x3d, 0xab, 0x1f, 0x18, 0x8d, 0x67, 0x4e, 0xb3, 0x59, 0x99, 0xa2,
+		0x84, 0x57, 0x53, 0x72, 0xdd, 0xe5, 0xe6, 0x4b, 0xaf, 0x50, 0xec, 0x5d, 0x8e, 0xbc, 0x6f, 0x78,
+		0xcf, 0xee, 0x30, 0x54, 0x74, 0x01, 0xe6, 0x2c, 0xcf, 0x69, 0xb1, 0x45, 0x3a, 0x74, 0x9d, 0x6e,
+		0xa7, 0x32, 0x4d, 0x15, 0xa8, 0x1c, 0x36, 0xdf, 0x27, 0xad, 0xef, 0x8f, 0x4d, 0xc2, 0xfc, 0xf4,
+		0xfb, 0x63, 0x93, 0x33, 0xf3, 0xb3, 0xda, 0x35, 0x58, 0xcf, 0xd2, 0x4c, 0xaf, 0xe3, 0xd8, 0x1e,
+		0x46, 0x4b, 0x30, 0xee, 0x76, 0xa9, 0x3a, 0x2a, 0x94, 0x5a, 0xc9, 0xed, 0xda, 0x35, 0x53, 0xfb,
+		0xcb, 0x02, 0xac, 0xef, 0x59, 0x87, 0xb6, 0xd1, 0xca, 0xdc, 0x19, 0x0f, 0xfb, 0x77, 0xc6, 0x5b,
+		0xe2, 0x9d, 0x21, 0xa5, 0x92, 0x73, 0x6b, 0x34, 0x61, 0x0d, 0xbf, 0xf0, 0xb1, 0x6b, 0x1b, 0xad,
+		0xd0, 0x9e, 0x45, 0xbb, 0x84, 0x6f, 0x90, 0x57, 0x85, 0xe3, 0xa7, 0x47, 0x5e, 0x0d, 0x48, 0xa5,
+		0xba, 0x50, 0x15, 0x4e, 0x35, 0x8e, 0xac, 0x96, 0x19, 0x0d, 0xe2, 0xd8, 0xad, 0x1e, 0xdd, 0x30,
+		0x93, 0xfa, 0x02, 0xed, 0x0a, 0x90, 0x3e, 0xb0, 0x5b, 0x3d, 0x6d, 0x13, 0xce, 0x66, 0xce, 0x8f,
+		0x09, 0x58, 0xfb, 0x27, 0x05, 0x2e, 0x70, 0x18, 0xcb, 0x3f, 0x92, 0x1b, 0x9b, 0xa7, 0xfd, 0x22,
+		0xbd, 0x25, 0x13, 0xe9, 0x20, 0x72, 0x39, 0x65, 0x2b, 0x50, 0xac, 0xb1, 0x0c, 0xc5, 0x2a, 0xce,
+		0x8f, 0x69, 0xb7, 0x61, 0x6b, 0xf0, 0xf8, 0x72, 0xe5, 0xfa, 0xbe, 0x02, 0x67, 0x74, 0xec, 0xe1,
+		0x13, 0x5b, 0x5d, 0x29, 0x91, 0x7c, 0xd3, 0x27, 0x5b, 0x24, 0x8b, 0x8c, 0x7c, 0x16, 0x9f, 0x17,
+		0x60, 0x73, 0x1f, 0xbb, 0x6d, 0xcb, 0x36, 0x7c, 0x9c, 0x39, 0x93, 0xc7, 0xfd, 0x33, 0xb9, 0x2a,
+		0x9c, 0xc9, 0x40, 0x42, 0x5f, 0xf2, 0x8d, 0x72, 0x0e, 0x34, 0xd9, 0x14, 0xf9, 0x5e, 0xf9, 0x03,
+		0x05, 0x36, 0x76, 0xb1, 0xd7, 0x70, 0xad, 0x83, 0x6c, 0x89, 0x7e, 0xd0, 0x2f, 0xd1, 0x2b, 0xc2,
+		0xe9, 0x0c, 0xa2, 0x93, 0x53, 0x3d, 0xfe, 0xa7, 0x08, 0x9b, 0x12, 0x52, 0x5c, 0x45, 0x5a, 0xb0,
+		0x12, 0x9d, 0xd9, 0x0d, 0xc7, 0x6e, 0x5a, 0x87, 0xdc, 0xa2, 0x4b, 0x6d, 0x63, 0x8a, 0xe0, 0x4e,
+		0x1c, 0x55, 0x5f, 0xc6, 0xc2, 0x76, 0x74, 0x00, 0x2b, 0xe9, 0xb5, 0x65, 0xae, 0x42, 0x81, 0x8e,
+		0x76, 0x31, 0xdf, 0x68, 0xd4, 0x59, 0x58, 0x7a, 0x2e, 0x6a, 0x46, 0x1f, 0x02, 0xea, 0x60, 0xdb,
+		0xb4, 0xec, 0xc3, 0xba, 0xd1, 0xf0, 0xad, 0x63, 0xcb, 0xb7, 0xb0, 0x57, 0x29, 0x6e, 0x14, 0xb3,
+		0x3d, 0x11, 0x06, 0x7e, 0x9b, 0x41, 0xf7, 0x28, 0xf1, 0x85, 0x4e, 0xa2, 0xd1, 0xc2, 0x1e, 0xfa,
+		0xff, 0x30, 0x1f, 0x10, 0xa6, 0x6a, 0xe2, 0x62, 0xbb, 0x32, 0x46, 0xc9, 0x56, 0x65, 0x64, 0x77,
+		0x08, 0x6c, 0x92, 0xf3, 0xb9, 0x4e, 0xac, 0xcb, 0xc5, 0x36, 0xda, 0x8b, 0x48, 0x07, 0xc7, 0x2f,
+		0xf7, 0x64, 0xa4, 0x1c, 0x07, 0xa7, 0x6d, 0x82, 0x68, 0xd0, 0xa8, 0xbd, 0x80, 0xc5, 0x27, 0xc4,
+		0x65, 0x0f, 0xa4, 0x17, 0xa8, 0xe1, 0x4e, 0xbf, 0x1a, 0xbe, 0x26, 0x1c, 0x43, 0x84, 0x9b, 0x53,
+		0xf5, 0x7e, 0xac, 0xc0, 0x52, 0x1f, 0x3a, 0x57, 0xb7, 0x77, 0x61, 0x86, 0x5e, 0x23, 0x02, 0x7f,
+		0x45, 0xc9, 0xe1, 0xaf, 0x4c, 0x53, 0x0c, 0xee, 0xa6, 0xd4, 0xa0, 0x1c, 0x10, 0xf8, 0x4d, 0xdc,
+		0xf0, 0xb1, 0xc9, 0x15, 0x47, 0xcb, 0x9e, 0x83, 0xce, 0x21, 0xf5, 0xd9, 0x8f, 0xe3, 0x8f, 0xda,
+		0xef, 0x28, 0xa0, 0x52, 0x03, 0xba, 0xe7, 0x5b, 0x8d, 0x67, 0x3d, 0xe2, 0xb2, 0x3c, 0xb0, 0x3c,
+		0x3f, 0x10, 0x53, 0xad, 0x5f, 0x4c, 0x97, 0xb2, 0x2d, 0xb9, 0x90, 0x42, 0x4e, 0x61, 0x9d, 0x81,
+		0x35, 0x21, 0x0d, 0x6e, 0x59, 0xfe, 0x5b, 0x81, 0xe5, 0xfb, 0xd8, 0x7f, 0xd8, 0xf5, 0x8d, 0x83,
+		0x16, 0xde, 0xf3, 0x0d, 0x1f, 0xeb, 0x22, 0xb2, 0x4a, 0x9f, 0x3d, 0xfd, 0x0e, 0x20, 0x81, 0x19,
+		0x2d, 0x0c, 0x65, 0x46, 0x17, 0x52, 0x3b, 0x0c, 0xbd, 0x05, 0xcb, 0xf8, 0x45, 0x87, 0x0a, 0xb0,
+		0x6e, 0xe3, 0x17, 0x7e, 0x1d, 0x1f, 0x13, 0xbf, 0xdf, 0x32, 0xa9, 0x85, 0x2e, 0xea, 0xa7, 0x82,
+		0xde, 0x47, 0xf8, 0x85, 0x7f, 0x97, 0xf4, 0xd5, 0x4c, 0xf4, 0x26, 0x2c, 0x36, 0xba, 0x2e, 0xbd,
+		0x20, 0x1c, 0xb8, 0x86, 0xdd, 0x38, 0xaa, 0xfb, 0xce, 0x33, 0xba, 0x7b, 0x94, 0xad, 0x19, 0x1d,
+		0xf1, 0xbe, 0x3b, 0xb4, 0x6b, 0x9f, 0xf4, 0x68, 0x3f, 0x9a, 0x82, 0x95, 0xd4, 0xac, 0xb9, 0x0e,
+		0x89, 0x67, 0xa6, 0x9c, 0x74, 0x66, 0xf7, 0x60, 0x36, 0x24, 0xeb, 0xf7, 0x3a, 0x98, 0xcb, 0x6a,
+		0x53, 0x4a, 0x71, 0xbf, 0xd7, 0xc1, 0xfa, 0xcc, 0xf3, 0xd8, 0x13, 0xd2, 0x60, 0x56, 0x24, 0x98,
+		0x69, 0x3b, 0x26, 0x90, 0xa7, 0xb0, 0xda, 0x71, 0xf1, 0xb1, 0xe5, 0x74, 0xbd, 0xba, 0x47, 0x3c,
+		0x11, 0x6c, 0x46, 0xf0, 0x63, 0x74, 0xdc, 0xb5, 0x94, 0xab, 0x5d, 0xb3, 0xfd, 0xab, 0x6f, 0x3f,
+		0x35, 0x5a, 0x5d, 0xac, 0x2f, 0x07, 0xd8, 0x7b, 0x0c, 0x39, 0xa0, 0xfb, 0x06, 0x9c, 0xa2, 0x17,
+		0x03, 0xe6, 0xc9, 0x87, 0x14, 0x4b, 0x94, 0x83, 0x79, 0xd2, 0x75, 0x8f, 0xf4, 0x04, 0xe0, 0x37,
+		0x61, 0x8a, 0x3a, 0xf9, 0xe4, 0xd2, 0x4e, 0xaf, 0x3a, 0xd3, 0xdb, 0x67, 0xc4, 0x87, 0x7c, 0xa0,
+		0x95, 0x93, 0x3e, 0xff, 0x85, 0xee, 0xc3, 0xbc, 0x47, 0x35, 0xb6, 0x1e, 0x91, 0x98, 0xc8, 0x43,
+		0xa2, 0xec, 0x25, 0x14, 0x1d, 0xbd, 0x0d, 0xcb, 0x8d, 0x96, 0x45, 0x38, 0x6d, 0x59, 0x07, 0xae,
+		0xe1, 0xf6, 0xea, 0xc7, 0xd8, 0xa5, 0x16, 0x70, 0x92, 0xaa, 0xf4, 0x22, 0xeb, 0x7d, 0xc0, 0x3a,
+		0x9f, 0xb2, 0xbe, 0x18, 0x56, 0x13, 0x1b, 0x7e, 0xd7, 0xc5, 0x21, 0xd6, 0x54, 0x1c, 0xeb, 0x1e,
+		0xeb, 0x0c, 0xb0, 0xce, 0xc2, 0x34, 0xc7, 0xb2, 0xda, 0x9d, 0x56, 0x05, 0x28, 0x28, 0xb0, 0xa6,
+		0x5a, 0xbb, 0xd3, 0x42, 0x1e, 0x5c, 0xec, 0x9f, 0x55, 0xdd, 0x6b, 0x1c, 0x61, 0xb3, 0xdb, 0xc2,
+		0x75, 0xdf, 0x61, 0x8b, 0x45, 0x6f, 0x9a, 0x4e, 0xd7, 0xaf, 0x4c, 0x0f, 0xba, 0x14, 0x9d, 0x4b,
+		0xce, 0x75, 0x8f, 0x53, 0xda, 0x77, 0xe8, 0xba, 0xed, 0x33, 0x32, 0xc4, 0x25, 0x61, 0x4b, 0xe5,
+		0xf9, 0x4e, 0x6c, 0x22, 0x33, 0xf4, 0xb2, 0xbb, 0x40, 0xbb, 0xf6, 0x7c, 0x27, 0x9a, 0x45, 0xd6,
+		0x76, 0x9a, 0xcd, 0xda, 0x4e, 0xe8, 0x01, 0x94, 0x43, 0xdd, 0xf6, 0xc8, 0x66, 0xaa, 0x94, 0xe9,
+		0xc5, 0xf6, 0x7c, 0x72, 0xa9, 0x58, 0xb4, 0x21, 0xae, 0xdf, 0x6c, 0xe7, 0xcd, 0x3e, 0x8f, 0x3f,
+		0xa2, 0x06, 0x2c, 0x86, 0xd4, 0x1a, 0x2d, 0xc7, 0xc3, 0x9c, 0xe6, 0x1c, 0xa5, 0x79, 0x39, 0xa7,
+		0xc3, 0x40, 0x10, 0x09, 0xbd, 0xae, 0xa7, 0x87, 0xfb, 0x39, 0x6c, 0x24, 0xbb, 0x7c, 0x81, 0x0b,
+		0xa2, 0xce, 0x02, 0x24, 0xe4, 0x14, 0x9f, 0x17, 0x9d, 0x89, 0x11, 0xd7, 0x5c, 0x40, 0xef, 0x05,
+		0xf0, 0xfa, 0xfc, 0x71, 0x5f, 0x0b, 0xba, 0x05, 0x6b, 0x96, 0x57, 0x67, 0xcb, 0x12, 0x5b, 0x63,
+		0x6c, 0x13, 0x3b, 0x63, 0x56, 0x16, 0xa8, 0x1b, 0xb8, 0x62, 0x79, 0x49, 0x6b, 0x7c, 0x97, 0x75,
+		0x6b, 0xbf, 0x54, 0x60, 0xe5, 0xb1, 0xd3, 0x6a, 0xfd, 0x8a, 0x59, 0xe3, 0x9f, 0x4c, 0x42, 0x25,
+		0x3d, 0xed, 0xaf, 0xcd, 0xf1, 0xd7, 0xe6, 0xf8, 0xab, 0x68, 0x8e, 0xb3, 0xf6, 0xc7, 0x4c, 0xa6,
+		0x79, 0x15, 0xda, 0xaa, 0xd9, 0x13, 0xdb, 0xaa, 0x2f, 0x9f, 0xd5, 0xd6, 0xfe, 0xa1, 0x00, 0x1b,
+		0x3a, 0x6e, 0x38, 0xae, 0x19, 0x8f, 0x04, 0xf2, 0x6d, 0xf1, 0x45, 0x5a, 0xca, 0xb3, 0x30, 0x1d,
+		0x2a, 0x4e, 0x68, 0x04, 0x20, 0x68, 0xaa, 0x99, 0x68, 0x05, 0x26, 0xa8, 0x8e, 0xf1, 0x1d, 0x5f,
+		0xd4, 0xc7, 0xc9, 0x63, 0xcd, 0x44, 0x67, 0x00, 0xb8, 0x1f, 0x1f, 0xec, 0xdd, 0x29, 0x7d, 0x8a,
+		0xb7, 0xd4, 0x4c, 0xa4, 0xc3, 0x4c, 0xc7, 0x69, 0xb5, 0xea, 0xbc, 0xa5, 0x32, 0x2e, 0xb9, 0x2b,
+		0x10, 0x1b, 0x7a, 0xcf, 0x71, 0xe3, 0xa2, 0x09, 0xee, 0x0a, 0xd3, 0x84, 0x08, 0x7f, 0xd0, 0x7e,
+		0x3e, 0x01, 0x9b, 0x12, 0x29, 0x72, 0xc3, 0x9b, 0xb2, 0x90, 0xca, 0x68, 0x16, 0x52, 0x6a, 0xfd,
+		0x0a, 0xa3, 0x5b, 0xbf, 0x6f, 0x00, 0x0a, 0xe4, 0x6b, 0xf6, 0x9b, 0xdf, 0xf9, 0xb0, 0x27, 0x80,
+		0xde, 0x22, 0x06, 0x4c, 0x60, 0x7a, 0x8b, 0x7a, 0x99, 0xb7, 0x07, 0x90, 0x29, 0x8b, 0x5e, 0x4a,
+		0x5b, 0xf4, 0x58, 0xce, 0x60, 0x3c, 0x99, 0x33, 0xb8, 0x0e, 0x15, 0x6e, 0x52, 0xa2, 0x00, 0x44,
+		0x70, 0xfa, 0x4f, 0xd0, 0xd3, 0x7f, 0x99, 0xf5, 0x87, 0xba, 0xc3, 0x0f, 0x7f, 0xa4, 0xc3, 0x6c,
+		0x18, 0x1b, 0xa7, 0x21, 0x0b, 0x16, 0x6c, 0x7f, 0x23, 0x6b, 0x37, 0xee, 0xbb, 0x86, 0xed, 0x11,
+		0x53, 0x96, 0xb8, 0xa6, 0xcf, 0x98, 0xb1, 0x27, 0xf4, 0x11, 0x9c, 0x16, 0x04, 0x44, 0x22, 0x13,
+		0x3e, 0x95, 0xc7, 0x84, 0xaf, 0xa6, 0xd4, 0x3d, 0xe8, 0xca, 0x72, 0x2d, 0x21, 0xcb, 0xb5, 0xdc,
+		0x84, 0x99, 0x84, 0xcd, 0x9b, 0xa6, 0x36, 0x6f, 0xfa, 0x20, 0x66, 0xec, 0x6e, 0x43, 0x39, 0x5a,
+		0x56, 0x9a, 0x73, 0x99, 0x19, 0x98, 0x73, 0x99, 0x0d, 0x31, 0x48, 0x1b, 0x7a, 0x07, 0x66, 0x82,
+		0xb5, 0xa6, 0x04, 0x66, 0x07, 0x12, 0x98, 0xe6, 0xf0, 0x14, 0xdd, 0x80, 0x09, 0x72, 0x93, 0x27,
+		0x46, 0xb6, 0x4c, 0xe3, 0x2f, 0xf7, 0xab, 0x19, 0xe9, 0xd6, 0xea, 0xc0, 0x5d, 0x44, 0x43, 0x04,
+		0x16, 0xf6, 0xee, 0xda, 0xbe, 0xdb, 0xd3, 0x03, 0xba, 0xea, 0x47, 0x30, 0x13, 0xef, 0x40, 0xf3,
+		0x50, 0x7c, 0x86, 0x7b, 0xdc, 0x58, 0x91, 0x9f, 0xe8, 0x3a, 0x94, 0x8e, 0x89, 0xfa, 0x4b, 0xe3,
+		0x0f, 0xc1, 0xae, 0x63, 0x71, 0x08, 0x86, 0x70, 0xb3, 0x70, 0x5d, 0x89, 0xd9, 0xc9, 0x20, 0xea,
+		0xf4, 0xb5, 0x9d, 0x4c, 0xd9, 0xc9, 0xb8, 0x68, 0x84, 0x76, 0xf2, 0x17, 0xc5, 0xc0, 0x4e, 0x0a,
+		0xa5, 0xc8, 0xed, 0xe4, 0xfb, 0x30, 0xd7, 0x67, 0x87, 0xa4, 0x96, 0x92, 0x9d, 0xbf, 0x3d, 0x6a,
+		0x49, 0xf4, 0x72, 0xd2, 0x4e, 0xa5, 0x34, 0xb7, 0x30, 0x9c, 0xe6, 0xc6, 0xcc, 0x52, 0x31, 0x69,
+		0x96, 0x3e, 0x82, 0xf5, 0xe4, 0xae, 0xaa, 0x3b, 0xcd, 0xba, 0x7f, 0x64, 0x79, 0xf5, 0x78, 0xee,
+		0x53, 0x3e, 0x94, 0x9a, 0xd8, 0x65, 0x1f, 0x34, 0xf7, 0x8f, 0x2c, 0xef, 0x36, 0xa7, 0x5f, 0x83,
+		0x85, 0x23, 0x6c, 0xb8, 0xfe, 0x01, 0x36, 0xfc, 0xba, 0x89, 0x7d, 0xc3, 0x6a, 0x79, 0x95, 0x52,
+		0x8e, 0xe8, 0xdb, 0x7c, 0x88, 0xb6, 0xcb, 0xb0, 0xd2, 0xe7, 0xce, 0xf8, 0x68, 0xe7, 0xce, 0x05,
+		0x98, 0x0b, 0xe9, 0x30, 0xb5, 0xa6, 0x06, 0x78, 0x4a, 0x0f, 0xbd, 0x9e, 0x5d, 0xda, 0xaa, 0xfd,
+		0x99, 0x02, 0xaf, 0xb0, 0xd5, 0x4c, 0xec, 0x64, 0x9e, 0xc2, 0x8c, 0xf6, 0x8b, 0xde, 0x1f, 0xb1,
+		0xbb, 0x9e, 0x15, 0xb1, 0x1b, 0x44, 0x2a, 0x5f, 0xe8, 0x8e, 0xe7, 0x95, 0xfe, 0xa6, 0x08, 0xe7,
+		0xe4, 0x34, 0xb9, 0x22, 0xe2, 0xe8, 0x88, 0x73, 0x79, 0x1b, 0x67, 0xf4, 0xe6, 0xe8, 0x06, 0x4c,
+		0x9f, 0xf3, 0xfa, 0xf4, 0xfd, 0xc7, 0x0a, 0xac, 0x47, 0x91, 0x6f, 0xe2, 0x26, 0x9b, 0x96, 0xd7,
+		0x31, 0xfc, 0xc6, 0x51, 0xbd, 0xe5, 0x34, 0x8c, 0x56, 0xab, 0x57, 0x29, 0x50, 0xb3, 0xf9, 0x91,
+		0x64, 0xd4, 0xc1, 0xd3, 0xa9, 0x46, 0xa1, 0xf1, 0x7d, 0x67, 0x97, 0x8f, 0xf0, 0x80, 0x0d, 0xc0,
+		0xac, 0xe9, 0x9a, 0x91, 0x0d, 0xa1, 0xfe, 0x16, 0x6c, 0x0c, 0x22, 0x20, 0xb0, 0xba, 0xbb, 0x49,
+		0xab, 0x2b, 0x0e, 0xbc, 0x07, 0xc6, 0x80, 0xd2, 0x0a, 0x08, 0xd3, 0xc3, 0x37, 0x66, 0x81, 0x49,
+		0xc6, 0x46, 0x30, 0x4d, 0x92, 0x62, 0xc7, 0xe6, 0x90, 0x19, 0x9b, 0x41, 0x74, 0x72, 0x46, 0x82,
+		0x5f, 0x81, 0x4d, 0x09, 0x25, 0x1e, 0x0f, 0xfe, 0x91, 0x02, 0x5a, 0xda, 0xe6, 0xbd, 0x17, 0x6c,
+		0xd2, 0x80, 0xf3, 0x27, 0xfd, 0x9c, 0x5f, 0xcb, 0xe0, 0x7c, 0x10, 0xa5, 0x9c, 0xbc, 0x3f, 0x86,
+		0x57, 0xa4, 0xb4, 0xb8, 0x6e, 0xbe, 0x06, 0xf3, 0x0d, 0xc3, 0x6e, 0xe0, 0xf0, 0x1c, 0xc0, 0xec,
+		0x64, 0x9b, 0xd4, 0xe7, 0x58, 0xbb, 0x1e, 0x34, 0x6b, 0x7f, 0x1c, 0xed, 0xfa, 0x38, 0xcd, 0x13,
+		0xee, 0x7a, 0x19, 0xa9, 0x9c, 0x53, 0x7d, 0x15, 0xce, 0xc9, 0x89, 0xc5, 0x72, 0x82, 0x02, 0xc0,
+		0x93, 0x68, 0x58, 0x26, 0x9d, 0xa1, 0x35, 0x4c, 0x44, 0x29, 0xa1, 0x61, 0xe9, 0x09, 0xd2, 0xf5,
+		0xc1, 0xe6, 0xd0, 0x1a, 0x36, 0x88, 0x52, 0x4e, 0xde, 0xcf, 0xc3, 0x2b, 0x52, 0x5a, 0x9c, 0xfb,
+		0xbf, 0x55, 0xe0, 0xac, 0x8e, 0xdb, 0xce, 0x31, 0x66, 0xc9, 0xfe, 0x97, 0x25, 0x54, 0x97, 0x74,
+		0x8f, 0x8a, 0x7d, 0xee, 0x91, 0xa6, 0xc1, 0x46, 0x36, 0xd7, 0x7c, 0x6a, 0x7f, 0x57, 0x80, 0xf3,
+		0x7c, 0x0a, 0x6c, 0xda, 0x99, 0x99, 0x66, 0xe9, 0x04, 0x0d, 0x28, 0x27, 0xf7, 0x60, 0xa5, 0x20,
+		0x3a, 0x84, 0xc2, 0xf5, 0xcb, 0x31, 0xa0, 0x3e, 0x9b, 0xd8, 0xbd, 0x24, 0xcf, 0x1b, 0x26, 0xf3,
+		0x85, 0x25, 0x61, 0xe2, 0x3c, 0xef, 0x5d, 0x8e, 0xd3, 0x97, 0xe7, 0xc5, 0xa2, 0xe6, 0xa1, 0x13,
+		0xf9, 0x5b, 0xf0, 0xea, 0xa0, 0xb9, 0x70, 0x39, 0xff, 0xbd, 0x02, 0x6b, 0x41, 0x6c, 0x48, 0x70,
+		0x57, 0xff, 0x42, 0xd4, 0xe7, 0x22, 0x2c, 0x58, 0x5e, 0x3d, 0x59, 0xa1, 0x45, 0x65, 0x39, 0xa9,
+		0xcf, 0x59, 0xde, 0xbd, 0x78, 0xed, 0x95, 0xb6, 0x0e, 0xa7, 0xc5, 0xec, 0xf3, 0xf9, 0xfd, 0xa2,
+		0x00, 0xe7, 0x98, 0xb1, 0x4e, 0xe6, 0xa6, 0x53, 0xa6, 0xf5, 0x8b, 0x98, 0xe8, 0x26, 0xcc, 0xf0,
+		0xf2, 0x3b, 0x6c, 0xc6, 0xc2, 0xb5, 0x61, 0x5b, 0xcd, 0x44, 0x1f, 0xc2, 0xa9, 0x46, 0xc0, 0x6a,
+		0x6c, 0xe8, 0xb1, 0xa1, 0x86, 0x46, 0x21, 0x89, 0x68, 0xec, 0x07, 0x30, 0x1f, 0x2b, 0xa9, 0x63,
+		0x57, 0x85, 0x52, 0xde, 0xab, 0xc2, 0x5c, 0x84, 0x4a, 0x1b, 0xb4, 0x0b, 0x70, 0x7e, 0x80, 0x94,
+		0xf9, 0x7a, 0xfc, 0x7b, 0x01, 0x2a, 0x3a, 0x2f, 0x17, 0xc5, 0x14, 0xd7, 0x7b, 0xba, 0xfd, 0x45,
+		0xae, 0xc1, 0x6f, 0xc0, 0x52, 0x32, 0x9e, 0xd9, 0xab, 0x5b, 0x3e, 0x6e, 0x07, 0x55, 0x14, 0xfd,
+		0xf5, 0x02, 0xa4, 0xe4, 0x35, 0x15, 0xd2, 0xec, 0xd5, 0x7c, 0xdc, 0xd6, 0x4f, 0x1d, 0xa7, 0xda,
+		0x3c, 0x74, 0x05, 0xc6, 0xa9, 0x6c, 0xbd, 0xca, 0x98, 0x24, 0xbc, 0xb1, 0x6b, 0xf8, 0xc6, 0x9d,
+		0x96, 0x73, 0xa0, 0x73, 0x60, 0xb4, 0x03, 0x65, 0x52, 0x9c, 0x49, 0x2a, 0x9a, 0x38, 0x7a, 0x29,
+		0x0f, 0xfa, 0x8c, 0x8d, 0x9f, 0xeb, 0x5d, 0xb6, 0x26, 0x9e, 0xb6, 0x06, 0xab, 0x02, 0x51, 0xf3,
+		0x85, 0xf8, 0xbe, 0x02, 0xcb, 0x7b, 0x3d, 0xbb, 0xb1, 0x77, 0x64, 0xb8, 0x26, 0x8f, 0x72, 0xf2,
+		0x65, 0x38, 0x0f, 0x65, 0xcf, 0xe9, 0xba, 0x0d, 0x5c, 0xe7, 0x55, 0xc4, 0x7c, 0x2d, 0x66, 0x59,
+		0xeb, 0x0e, 0x6b, 0x44, 0xab, 0x30, 0x49, 0x02, 0x40, 0x66, 0x70, 0x80, 0x95, 0xf4, 0x09, 0xfa,
+		0x5c, 0x33, 0x51, 0x15, 0xc6, 0xe8, 0x95, 0xb1, 0x38, 0xf0, 0x1e, 0x47, 0xe1, 0xb4, 0x55, 0x58,
+		0x49, 0xf1, 0xc2, 0xf9, 0xfc, 0xc7, 0x12, 0x9c, 0x22, 0x7d, 0xc1, 0x41, 0xf8, 0x45, 0xea, 0x4a,
+		0x05, 0x26, 0x82, 0xa8, 0x12, 0xdb, 0xaa, 0xc1, 0x23, 0xd9, 0xc9, 0xd1, 0x95, 0x36, 0x0c, 0x17,
+		0x84, 0xe1, 0x05, 0x22, 0x93, 0x74, 0x2c, 0xa9, 0x34, 0x6c, 0x2c, 0xe9, 0x0c, 0x40, 0x70, 0xa9,
+		0xb2, 0x4c, 0x7a, 0x15, 0x2d, 0xea, 0x53, 0xbc, 0xa5, 0x66, 0xa6, 0x2e, 0xec, 0x13, 0xc3, 0x5d,
+		0xd8, 0xdf, 0xe7, 0x19, 0x9c, 0xe8, 0xee, 0x4c, 0xa9, 0x4c, 0x0e, 0xa4, 0xb2, 0x40, 0xd0, 0x42,
+		0xff, 0x97, 0xd2, 0xba, 0x0a, 0x13, 0xc1, 0xc5, 0x7b, 0x2a, 0xc7, 0xc5, 0x3b, 0x00, 0x8e, 0x07,
+		0x0d, 0x20, 0x19, 0x34, 0x78, 0x17, 0x66, 0x58, 0x7e, 0x89, 0x57, 0x13, 0x4f, 0xe7, 0xa8, 0x26,
+		0x9e, 0xa6, 0x69, 0x27, 0xf6, 0x40, 0x52, 0x1d, 0x94, 0x00, 0xab, 0x9e, 0xaf, 0x5b, 0x26, 0xb6,
+		0x7d, 0xcb, 0xef, 0xd1, 0x88, 0xde, 0x94, 0x8e, 0x48, 0xdf, 0x87, 0xb4, 0xab, 0xc6, 0x7b, 0xd0,
+		0x23, 0x98, 0xeb, 0x33, 0x0d, 0x3c, 0x7a, 0x77, 0x3e, 0x97, 0x51, 0xd0, 0xcb, 0x49, 0x83, 0xa0,
+		0x2d, 0xc3, 0x62, 0x52, 0x93, 0xb9, 0x8a, 0xff, 0xa1, 0x02, 0x6b, 0x41, 0xf5, 0xda, 0x4b, 0xe2,
+		0xc2, 0x69, 0xbf, 0xaf, 0xc0, 0x69, 0x31, 0x4f, 0xfc, 0x76, 0xf3, 0x16, 0x2c, 0xb7, 0x59, 0x3b,
+		0xcb, 0xad, 0xd4, 0x2d, 0xbb, 0xde, 0x30, 0x1a, 0x47, 0x98, 0x73, 0x78, 0xaa, 0x1d, 0xc3, 0xaa,
+		0xd9, 0x3b, 0xa4, 0x0b, 0xdd, 0x80, 0xd5, 0x14, 0x92, 0x69, 0xf8, 0xc6, 0x81, 0xe1, 0x61, 0xee,
+		0x04, 0x2f, 0x27, 0xf1, 0x76, 0x79, 0xaf, 0x76, 0x1a, 0xd4, 0x80, 0x1f, 0x2e, 0xcf, 0xf7, 0x9c,
+		0xb0, 0xfc, 0x48, 0xfb, 0xed, 0x02, 0xac, 0x09, 0xbb, 0x39, 0xb7, 0x5b, 0x30, 0x6f, 0x77, 0xdb,
+		0x07, 0xd8, 0x25, 0xa1, 0x26, 0x6a, 0xa5, 0x3c, 0xca, 0x67, 0x49, 0x2f, 0xb3, 0xf6, 0x0f, 0x9a,
+		0xd4, 0xf8, 0x78, 0x44, 0xd8, 0x81, 0x55, 0xf3, 0x68, 0xec, 0xa0, 0xa4, 0x4f, 0x72, 0xb3, 0xe6,
+		0xa1, 0x1a, 0xcc, 0xf0, 0x95, 0x60, 0x53, 0x15, 0x57, 0x6a, 0x06, 0xea, 0xc0, 0x42, 0x3a, 0x74,
+		0xe6, 0xd4, 0xb9, 0x9b, 0x36, 0xa3, 0x06, 0x74, 0x15, 0x56, 0xd8, 0x38, 0x0d, 0xc7, 0xf6, 0x5d,
+		0xa7, 0xd5, 0xc2, 0x2e, 0x95, 0x49, 0xd7, 0xe3, 0x85, 0xbd, 0x4b, 0xb4, 0x7b, 0x27, 0xec, 0x65,
+		0x76, 0x91, 0xee, 0x10, 0xd3, 0x74, 0xb1, 0xe7, 0xf1, 0xb8, 0x63, 0xf0, 0xa8, 0x55, 0x61, 0x81,
+		0x65, 0xa7, 0x08, 0x5e, 0xa0, 0x3b, 0x71, 0x23, 0xad, 0x24, 0x8c, 0xb4, 0xb6, 0x08, 0x28, 0x0e,
+		0xcf, 0x95, 0xf1, 0xbf, 0x14, 0x58, 0x60, 0xde, 0x79, 0xdc, 0x0d, 0xcc, 0x26, 0x83, 0x6e, 0xf1,
+		0x4c, 0x6e, 0x98, 0xb8, 0x2e, 0x6f, 0x9f, 0xcd, 0x10, 0x08, 0xa1, 0x48, 0x83, 0x63, 0x93, 0x3e,
+		0xff, 0x15, 0x0f, 0xb1, 0x16, 0x13, 0x21, 0xd6, 0x1d, 0x98, 0x3b, 0xb6, 0x3c, 0xeb, 0xc0, 0x6a,
+		0x59, 0x7e, 0x8f, 0x59, 0xa2, 0xc1, 0x51, 0xc1, 0x72, 0x84, 0x42, 0x1a, 0x89, 0x59, 0xe6, 0x47,
+		0x58, 0xdd, 0x36, 0xb8, 0xc5, 0x9d, 0xd2, 0xa7, 0x79, 0xdb, 0x23, 0xa3, 0x8d, 0x89, 0x14, 0xe2,
+		0xd3, 0xe5, 0x52, 0xf8, 0x8c, 0x4a, 0xc1, 0xc3, 0xfe, 0x93, 0x2e, 0xee, 0xe2, 0x1c, 0x52, 0xe8,
+		0x1f, 0xa9, 0x90, 0x1a, 0x29, 0x29, 0xa8, 0xe2, 0x90, 0x82, 0x62, 0x7c, 0x46, 0x0c, 0x71, 0x3e,
+		0x7f, 0xa8, 0xc0, 0x62, 0xa0, 0xf7, 0x2f, 0x0d, 0xab, 0x1f, 0xc0, 0x52, 0x1f, 0x4f, 0x7c, 0x17,
+		0x5e, 0x85, 0x95, 0x8e, 0xeb, 0x34, 0xb0, 0xe7, 0x91, 0xea, 0x4f, 0xfa, 0x62, 0x11, 0xb3, 0x03,
+		0x64, 0x33, 0x16, 0x89, 0xce, 0x47, 0xdd, 0x14, 0x93, 0x1a, 0x01, 0x4f, 0xfb, 0x9e, 0x02, 0x67,
+		0xee, 0x63, 0x5f, 0x8f, 0x5e, 0x33, 0x7a, 0x88, 0x3d, 0xcf, 0x38, 0xc4, 0xa1, 0xcb, 0xf2, 0x2e,
+		0x8c, 0xd3, 0x24, 0x0e, 0x23, 0x34, 0xbd, 0x7d, 0x21, 0x83, 0xdb, 0x18, 0x09, 0x9a, 0xe1, 0xd1,
+		0x39, 0x5a, 0x0e, 0xa1, 0x10, 0x1b, 0xb3, 0x9e, 0xc5, 0x05, 0x9f, 0xe0, 0xc7, 0x50, 0x66, 0x52,
+		0x6f, 0xf3, 0x1e, 0xce, 0xce, 0xfb, 0x99, 0xd1, 0x47, 0x39, 0xc1, 0x2a, 0xdd, 0x9b, 0x41, 0x2b,
+		0x8b, 0x34, 0xce, 0x7a, 0xf1, 0x36, 0xb5, 0x05, 0x28, 0x0d, 0x14, 0x8f, 0x26, 0x96, 0x58, 0x34,
+		0xf1, 0xdb, 0xc9, 0x68, 0xe2, 0xc5, 0xc1, 0x02, 0x0a, 0x99, 0x89, 0x45, 0x12, 0xdb, 0xb0, 0x71,
+		0x1f, 0xfb, 0xbb, 0x0f, 0x9e, 0x48, 0xd6, 0xa2, 0x06, 0xc0, 0xb6, 0xb4, 0xdd, 0x74, 0x02, 0x01,
+		0xe4, 0x18, 0x8e, 0x28, 0x12, 0x35, 0x93, 0x53, 0x3e, 0xff, 0xe5, 0x69, 0x2f, 0x60, 0x53, 0x32,
+		0x1c, 0x17, 0xfa, 0x1e, 0x2c, 0xc4, 0x5e, 0x40, 0xa3, 0x09, 0xc5, 0x60, 0xd8, 0x57, 0xf3, 0x0d,
+		0xab, 0xcf, 0xbb, 0xc9, 0x06, 0x4f, 0xfb, 0x99, 0x02, 0x8b, 0x3a, 0x36, 0x3a, 0x9d, 0x16, 0xbb,
+		0xf2, 0x84, 0xb3, 0x5b, 0x86, 0x71, 0x1e, 0xc0, 0x67, 0xe7, 0x1c, 0x7f, 0x92, 0x17, 0xfc, 0x8b,
+		0x0f, 0xe9, 0xe2, 0x49, 0xfd, 0xd1, 0xd1, 0x2e, 0x17, 0xda, 0x0a, 0x2c, 0xf5, 0x4d, 0x8d, 0x5b,
+		0x93, 0x9f, 0x2a, 0xa4, 0x3e, 0xb7, 0xe9, 0x62, 0xef, 0x28, 0xcc, 0x65, 0x10, 0x69, 0xbc, 0x84,
+		0x73, 0x27, 0x17, 0x7f, 0x31, 0xab, 0x7c, 0x2e, 0x37, 0x60, 0x65, 0xc7, 0xe9, 0xda, 0x44, 0x79,
+		0xfa, 0x15, 0x74, 0x1d, 0xa0, 0xe9, 0xb8, 0x0d, 0x7c, 0x0f, 0xfb, 0x8d, 0x23, 0x1e, 0x92, 0x8d,
+		0xb5, 0x68, 0x06, 0x54, 0xd2, 0xa8, 0x5c, 0xd9, 0xee, 0xc2, 0x04, 0xb6, 0x7d, 0x9a, 0x8f, 0x65,
+		0x2a, 0xf6, 0x7a, 0x86, 0x8a, 0x71, 0x2f, 0x64, 0xf7, 0xc1, 0x13, 0x4a, 0x8b, 0xe7, 0x5c, 0x39,
+		0xae, 0xf6, 0xd3, 0x02, 0x2c, 0xeb, 0xd8, 0x30, 0x05, 0xdc, 0x6d, 0xc3, 0x58, 0x58, 0xe1, 0x50,
+		0xde, 0x5e, 0xcf, 0xf2, 0x2d, 0x1e, 0x3c, 0xa1, 0x56, 0x97, 0xc2, 0xca, 0xae, 0x62, 0xe9, 0xcb,
+		0x5c, 0x51, 0x74, 0x99, 0xdb, 0x87, 0x8a, 0x65, 0x13, 0x08, 0xeb, 0x18, 0xd7, 0xb1, 0x1d, 0x5a,
+		0xb0, 0x9c, 0x55, 0x61, 0x4b, 0x21, 0xf2, 0x5d, 0x3b, 0x30, 0x45, 0x35, 0x93, 0x28, 0x46, 0x87,
+		0x10, 0xf1, 0xac, 0x4f, 0xd8, 0xe1, 0x5b, 0xd2, 0x27, 0x49, 0xc3, 0x9e, 0xf5, 0x09, 0x46, 0xaf,
+		0xc2, 0x1c, 0xad, 0x6d, 0xa0, 0x10, 0x2c, 0x05, 0x3f, 0x4e, 0x53, 0xf0, 0xb4, 0xe4, 0xe1, 0xb1,
+		0x71, 0x88, 0x59, 0x45, 0xde, 0x5f, 0x17, 0x60, 0x25, 0x25, 0x2b, 0xbe, 0x1c, 0xa3, 0x08, 0x4b,
+		0x68, 0x2f, 0x0a, 0x27, 0xb3, 0x17, 0xe8, 0xbb, 0xb0, 0x9c, 0x22, 0x1a, 0x04, 0x01, 0x87, 0x35,
+		0x80, 0x8b, 0xfd, 0xd4, 0x49, 0xab, 0x48, 0x5c, 0x63, 0x22, 0x71, 0xfd, 0x1b, 0xa9, 0xdb, 0xec,
+		0xba, 0x87, 0xf8, 0xab, 0xad, 0x5b, 0x9a, 0x0a, 0x95, 0xf4, 0x34, 0xf9, 0xe6, 0xff, 0xbc, 0x00,
+		0x2b, 0x0f, 0xf1, 0x57, 0x5e, 0x06, 0xff, 0x37, 0xfb, 0xeb, 0x0e, 0x54, 0x1e, 0x62, 0xb1, 0x20,
+		0x45, 0x34, 0x14, 0x11, 0x8d, 0x4f, 0x15, 0x38, 0xfd, 0xc8, 0xf1, 0xad, 0x66, 0x8f, 0x5c, 0xb7,
+		0x9d, 0x63, 0xec, 0x3e, 0x34, 0xc8, 0x5d, 0x3a, 0x94, 0xfa, 0x77, 0x61, 0xb9, 0xc9, 0x7b, 0xea,
+		0x6d, 0xda, 0x55, 0x4f, 0x38, 0x6c, 0x59, 0xfb, 0x23, 0x49, 0x8e, 0x0e, 0xa6, 0x2f, 0x36, 0xd3,
+		0x8d, 0x9e, 0x76, 0x16, 0xce, 0x64, 0x70, 0xc0, 0x95, 0xc2, 0x80, 0xb5, 0xfb, 0xd8, 0xdf, 0x71,
+		0x1d, 0xcf, 0xe3, 0xab, 0x92, 0x38, 0xdc, 0x12, 0x17, 0x3f, 0xa5, 0xef, 0xe2, 0x77, 0x1e, 0xca,
+		0xbe, 0xe1, 0x1e, 0x62, 0x3f, 0x5c, 0x65, 0x76, 0xcc, 0xcd, 0xb2, 0x56, 0x4e, 0x4f, 0xfb, 0x65,
+		0x11, 0x4e, 0x8b, 0xc7, 0xe0, 0xf2, 0x6c, 0x43, 0x99, 0x99, 0x86, 0x83, 0x1e, 0xbb, 0x86, 0x56,
+		0x94, 0x01, 0x55, 0x3d, 0x32, 0x72, 0xd4, 0xf9, 0xf6, 0xee, 0xf4, 0xa8, 0x03, 0xc8, 0x4e, 0x98,
+		0x19, 0x3f, 0xd6, 0x84, 0x3e, 0x55, 0x60, 0xa9, 0x49, 0x33, 0x5e, 0xf5, 0x86, 0xd1, 0xf5, 0x70,
+		0x34, 0x2c, 0xb3, 0x77, 0x0f, 0x47, 0x1b, 0x96, 0x25, 0xd1, 0x76, 0x08, 0xc5, 0xc4, 0xe0, 0xa8,
+		0x99, 0xea, 0x50, 0x3b, 0xb0, 0x90, 0xe2, 0x52, 0xe0, 0x9e, 0xde, 0x4d, 0xba, 0xa7, 0x97, 0x32,
+		0xd4, 0xa1, 0x9f, 0x27, 0xbe, 0x78, 0x71, 0x1f, 0x55, 0xed, 0xc0, 0x4a, 0x06, 0x83, 0x82, 0x71,
+		0xdf, 0x8d, 0x8f, 0x5b, 0xce, 0x0c, 0xf7, 0xde, 0xc7, 0x7e, 0x94, 0x3d, 0xa4, 0x74, 0xe3, 0x5e,
+		0xf1, 0x7f, 0x28, 0xb0, 0xc5, 0xf3, 0x75, 0x29, 0xa1, 0xa5, 0x12, 0x0d, 0x92, 0x9b, 0x59, 0x3e,
+		0x2d, 0x43, 0x4f, 0x99, 0x12, 0x85, 0x85, 0x15, 0x41, 0xac, 0x3a, 0xbf, 0xd0, 0x18, 0x1e, 0xa1,
+		0x1b, 0x3d, 0x79, 0xe8, 0x1c, 0xcc, 0x36, 0x89, 0x03, 0xf4, 0x08, 0x33, 0x5f, 0x8a, 0xe7, 0x97,
+		0x92, 0x8d, 0x9a, 0x0b, 0xaf, 0xe5, 0x98, 0x6b, 0xe8, 0x2e, 0x95, 0x02, 0x7f, 0x7c, 0xb4, 0x65,
+		0xa5, 0xd8, 0xda, 0x15, 0xfa, 0x5e, 0x58, 0xb0, 0xb1, 0xe9, 0x21, 0x99, 0x23, 0x36, 0xa6, 0xf9,
+		0xb0, 0x92, 0x42, 0x0b, 0x1d, 0x87, 0xa5, 0x28, 0xaf, 0x12, 0x04, 0x62, 0xba, 0xbc, 0x5c, 0xaa,
+		0xa4, 0x47, 0x49, 0x97, 0x3d, 0x16, 0x85, 0xe9, 0xda, 0x34, 0x2e, 0x1e, 0xbc, 0xb9, 0xc8, 0x43,
+		0x48, 0x2c, 0x3e, 0x34, 0xcb, 0x5b, 0x29, 0xa8, 0xa7, 0xfd, 0xac, 0x00, 0xeb, 0xdf, 0xe9, 0x98,
+		0xb2, 0xf7, 0x8d, 0x5f, 0xa6, 0x4b, 0xc4, 0x1a, 0x4c, 0x75, 0x29, 0xb7, 0xc1, 0x51, 0x34, 0xa5,
+		0x4f, 0xb2, 0x86, 0x9a, 0x49, 0x4a, 0xe4, 0x78, 0x67, 0x2c, 0x7e, 0x02, 0xac, 0x89, 0x46, 0x0a,
+		0xb6, 0xa1, 0x64, 0xd9, 0x9d, 0x6e, 0x50, 0xe3, 0x26, 0x0f, 0xf3, 0x32, 0x50, 0xa4, 0xc2, 0x64,
+		0x18, 0x7d, 0x65, 0x55, 0x50, 0xe1, 0x73, 0x5f, 0xea, 0x78, 0xb2, 0x3f, 0x75, 0xfc, 0x99, 0x02,
+		0x67, 0x33, 0x65, 0xcb, 0x97, 0xf6, 0x6d, 0x18, 0x1f, 0xe2, 0x8d, 0x4b, 0x0e, 0x4b, 0x22, 0xd6,
+		0x41, 0x68, 0xb9, 0x90, 0x23, 0xb4, 0x1c, 0x00, 0x6b, 0x3f, 0x57, 0xe0, 0xcc, 0x63, 0x62, 0x10,
+		0xbe, 0x14, 0x8b, 0xbd, 0x4c, 0x64, 0x63, 0x78, 0x3c, 0x83, 0x38, 0xa5, 0xf3, 0xa7, 0xc4, 0x92,
+		0x94, 0x92, 0x4b, 0xa2, 0x6d, 0xc0, 0x7a, 0xd6, 0x04, 0xf9, 0xc9, 0xfa, 0xaf, 0x64, 0x55, 0xec,
+		0xce, 0x57, 0x5a, 0x0a, 0x1a, 0x6c, 0x64, 0x4f, 0x91, 0xcb, 0xe1, 0xaf, 0x14, 0xa8, 0x90, 0x52,
+		0xe4, 0x97, 0xfe, 0xf2, 0xfc, 0x03, 0x05, 0x56, 0x05, 0x8c, 0xf2, 0x0d, 0x24, 0x39, 0xa1, 0xae,
+		0x06, 0xf6, 0x9c, 0xf9, 0x0f, 0x1b, 0x19, 0xf6, 0x9c, 0x06, 0xef, 0xa8, 0x21, 0x67, 0xe0, 0xe8,
+		0x34, 0x4c, 0xf9, 0x6e, 0xd7, 0x6e, 0x18, 0x3e, 0x36, 0x79, 0x2a, 0x3f, 0x6a, 0xd0, 0xfe, 0x53,
+		0x81, 0x79, 0x1d, 0xfb, 0x6e, 0xef, 0x57, 0x23, 0xe4, 0x7c, 0x0a, 0x16, 0x62, 0xb3, 0x65, 0x42,
+		0xdf, 0xfe, 0xe7, 0x6d, 0x00, 0x1e, 0x33, 0xb8, 0xfd, 0xb8, 0x86, 0x7e, 0x8f, 0xa4, 0x67, 0x85,
+		0xdf, 0xed, 0x40, 0x57, 0x33, 0x9d, 0x36, 0xe9, 0x87, 0x46, 0xd4, 0x6b, 0x43, 0xe3, 0x71, 0x7d,
+		0xf8, 0x81, 0x02, 0x2b, 0x19, 0x1f, 0x50, 0x41, 0x12, 0xa2, 0xd2, 0x4f, 0xca, 0xa8, 0xd7, 0x87,
+		0x47, 0xe4, 0xec, 0xfc, 0x44, 0x81, 0x8d, 0x41, 0x1f, 0x37, 0x41, 0xdf, 0x1e, 0x44, 0x7e, 0xd0,
+		0x77, 0x59, 0xd4, 0xdb, 0x27, 0xa0, 0xc0, 0x39, 0x25, 0x8b, 0x28, 0xfe, 0x6c, 0x89, 0x64, 0x11,
+		0xa5, 0x9f, 0x4b, 0x51, 0xaf, 0x0d, 0x8d, 0xc7, 0x79, 0xf9, 0x23, 0x05, 0xd4, 0xec, 0x8f, 0x7b,
+		0xa0, 0xec, 0xaa, 0xdc, 0x81, 0x1f, 0x3d, 0x51, 0xbf, 0x39, 0x12, 0x2e, 0xe7, 0xeb, 0x87, 0x0a,
+		0xac, 0x66, 0x7e, 0xba, 0x03, 0xdd, 0xc8, 0x24, 0x3d, 0xe8, 0xcb, 0x21, 0xea, 0xcd, 0x51, 0x50,
+		0x39, 0x53, 0x36, 0xcc, 0x26, 0xbe, 0xe9, 0x80, 0xde, 0xc8, 0x24, 0x26, 0xfa, 0x74, 0x84, 0x5a,
+		0xcd, 0x0b, 0xce, 0xc7, 0xfb, 0x54, 0x81, 0x53, 0x82, 0x0f, 0x23, 0xa0, 0xb7, 0xe4, 0xab, 0x2d,
+		0xfc, 0x14, 0x83, 0xfa, 0xf6, 0x70, 0x48, 0x9c, 0x05, 0x1f, 0xe6, 0xfa, 0x3e, 0x42, 0x80, 0x2e,
+		0xc9, 0x6e, 0x87, 0x82, 0x44, 0xb5, 0xfa, 0x66, 0x7e, 0x04, 0x3e, 0xea, 0x73, 0x98, 0xef, 0x7f,
+		0xd9, 0x16, 0x65, 0x53, 0xc9, 0x78, 0x1d, 0x59, 0xbd, 0x3c, 0x04, 0x46, 0x4c, 0xed, 0x32, 0xeb,
+		0xcd, 0x25, 0x6a, 0x37, 0xe8, 0x85, 0x3f, 0xf5, 0x04, 0xe5, 0xed, 0xe8, 0x4f, 0x15, 0x38, 0xcd,
+		0x1e, 0xc4, 0xe5, 0xe8, 0xe8, 0xd6, 0x88, 0x55, 0xec, 0x8c, 0xb5, 0x77, 0x4e, 0x54, 0x03, 0xcf,
+		0x45, 0x96, 0x51, 0xb3, 0x2d, 0x15, 0x99, 0xbc, 0x62, 0x5c, 0xbd, 0x39, 0x0a, 0x6a, 0x6a, 0x1d,
+		0x05, 0xaf, 0xc5, 0x0c, 0x5c, 0xc7, 0xec, 0x17, 0x92, 0xd4, 0x9b, 0xa3, 0xa0, 0xa6, 0xd7, 0x51,
+		0x58, 0x36, 0x3d, 0x78, 0x1d, 0x65, 0xa5, 0xdb, 0xea, 0x3b, 0x23, 0x62, 0xa7, 0xd7, 0x31, 0x5d,
+		0x19, 0x3d, 0x78, 0x1d, 0x33, 0xeb, 0xb2, 0xd5, 0x9b, 0xa3, 0xa0, 0x72, 0xa6, 0xfe, 0x84, 0xa6,
+		0x9e, 0x32, 0x4b, 0x9e, 0xd1, 0x37, 0x87, 0x9a, 0x73, 0xb2, 0xe8, 0x5a, 0xbd, 0x35, 0x1a, 0x72,
+		0x82, 0xb5, 0xcc, 0x7a, 0x7f, 0x29, 0x6b, 0x83, 0xde, 0x38, 0x50, 0x6f, 0x8d, 0x86, 0xcc, 0x59,
+		0xfb, 0x0b, 0x05, 0xd6, 0x39, 0xa5, 0x8c, 0x42, 0x5f, 0xf4, 0x2d, 0xc9, 0x00, 0x39, 0xaa, 0x9d,
+		0xd5, 0x77, 0x47, 0xc6, 0xe7, 0x3c, 0x7e, 0xa6, 0x40, 0x85, 0x55, 0x58, 0xa4, 0xcb, 0xbd, 0xd1,
+		0x75, 0x09, 0x75, 0x69, 0x5d, 0xbb, 0x7a, 0x63, 0x04, 0x4c, 0xce, 0xd1, 0xf7, 0x14, 0x58, 0x14,
+		0x15, 0x0d, 0xa3, 0xec, 0x93, 0x53, 0x52, 0x22, 0xad, 0x5e, 0x19, 0x12, 0x8b, 0x73, 0xf1, 0xe7,
+		0xf4, 0xfb, 0x7a, 0x92, 0x9a, 0x59, 0xf4, 0xce, 0x00, 0xdd, 0x90, 0x57, 0x34, 0xab, 0xdf, 0x1a,
+		0x15, 0x9d, 0x33, 0xf8, 0x09, 0xb9, 0xa6, 0xf4, 0x95, 0x8f, 0xa2, 0xcb, 0x12, 0xa2, 0xe2, 0xaa,
+		0x5e, 0x75, 0x7b, 0x18, 0x94, 0xc8, 0x1b, 0xe9, 0x2b, 0x08, 0x95, 0x78, 0x23, 0xe2, 0x32, 0x56,
+		0xf5, 0xcd, 0xfc, 0x08, 0x7c, 0xd4, 0x67, 0x30, 0x13, 0x2f, 0xd0, 0x43, 0xdf, 0x90, 0x52, 0xe8,
+		0xab, 0x48, 0x55, 0xdf, 0xc8, 0x09, 0x1d, 0xd3, 0x42, 0x51, 0x85, 0x9d, 0x44, 0x0b, 0x25, 0x45,
+		0x82, 0xea, 0x95, 0x21, 0xb1, 0x62, 0x9e, 0xa7, 0xa0, 0x70, 0x4e, 0xe2, 0x79, 0x66, 0x57, 0xe1,
+		0xa9, 0x6f, 0x0f, 0x87, 0x14, 0xbe, 0x2a, 0x08, 0x51, 0x1d, 0x1a, 0xba, 0x98, 0x49, 0x23, 0x55,
+		0xdc, 0xa6, 0xbe, 0x9e, 0x0b, 0x36, 0x1a, 0x26, 0x2a, 0xf4, 0x92, 0x0c, 0x93, 0x2a, 0x7e, 0x53,
+		0x5f, 0xcf, 0x05, 0x1b, 0x1f, 0x26, 0xa8, 0xd3, 0x92, 0x0e, 0xd3, 0x57, 0x5d, 0xa6, 0xbe, 0x9e,
+		0x0b, 0x36, 0xba, 0xa1, 0x24, 0x6a, 0xac, 0x24, 0x37, 0x14, 0x51, 0x7d, 0x98, 0x5a, 0xcd, 0x0b,
+		0x1e, 0xbb, 0xca, 0x8a, 0x6b, 0x95, 0x24, 0x57, 0x59, 0x69, 0xcd, 0x96, 0x7a, 0x6d, 0x68, 0xbc,
+		0x98, 0x03, 0x93, 0x59, 0x16, 0x24, 0x71, 0x60, 0x06, 0x55, 0x2e, 0xa9, 0x37, 0x47, 0x41, 0x8d,
+		0x16, 0x24, 0x51, 0x54, 0x23, 0x59, 0x10, 0x51, 0x5d, 0x91, 0x5a, 0xcd, 0x0b, 0x1e, 0x33, 0x1f,
+		0xa2, 0x02, 0x18, 0x24, 0xbb, 0xfe, 0x65, 0x96, 0xf6, 0xa8, 0x57, 0x86, 0xc4, 0x8a, 0xee, 0x6f,
+		0xfd, 0xa5, 0x32, 0x92, 0xfb, 0x5b, 0x46, 0x41, 0x8e, 0x7a, 0x79, 0x08, 0x8c, 0xe8, 0x80, 0xe8,
+		0xab, 0x09, 0x91, 0x1c, 0x10, 0xe2, 0x4a, 0x1b, 0xf5, 0xcd, 0xfc, 0x08, 0xb1, 0xeb, 0x6a, 0x5f,
+		0xcd, 0x81, 0xec, 0xba, 0x2a, 0xae, 0xc2, 0x50, 0x2f, 0x0f, 0x81, 0x11, 0x0d, 0xfc, 0x10, 0xe7,
+		0x1e, 0xf8, 0x21, 0x1e, 0x76, 0xe0, 0xcc, 0x02, 0x80, 0xdf, 0x55, 0x60, 0x49, 0x98, 0x56, 0x47,
+		0xd9, 0x1a, 0x23, 0x2b, 0x04, 0x50, 0xaf, 0x0e, 0x8b, 0x16, 0xd3, 0x77, 0x51, 0x52, 0x5a, 0xa2,
+		0xef, 0x92, 0x6c, 0xbf, 0x7a, 0x65, 0x48, 0x2c, 0xce, 0xc5, 0xe7, 0x4a, 0xf8, 0x56, 0x69, 0x76,
+		0xf6, 0x13, 0xdd, 0x1e, 0x74, 0xdf, 0x18, 0x98, 0x25, 0x56, 0xef, 0x9c, 0x84, 0x44, 0x22, 0xa4,
+		0x13, 0x4f, 0x7f, 0xca, 0x43, 0x3a, 0x82, 0xfc, 0xaa, 0xfa, 0x66, 0x7e, 0x84, 0x58, 0xb4, 0x38,
+		0x23, 0x45, 0x27, 0x89, 0x16, 0xcb, 0x13, 0xa6, 0xea, 0xf5, 0xe1, 0x11, 0x63, 0x07, 0x97, 0x38,
+		0x7d, 0x25, 0x39, 0xb8, 0xa4, 0x09, 0x3d, 0xf5, 0xda, 0xd0, 0x78, 0xb1, 0xab, 0x50, 0x56, 0x12,
+		0x49, 0x72, 0x15, 0x1a, 0x90, 0x5a, 0x53, 0x6f, 0x8c, 0x80, 0x19, 0xf9, 0xf8, 0xa9, 0x3c, 0x90,
+		0xc4, 0xc7, 0xcf, 0x4a, 0x6e, 0xa9, 0xdb, 0xc3, 0xa0, 0xf0, 0xb1, 0x0f, 0x60, 0x2a, 0x4c, 0x83,
+		0xa0, 0xd7, 0x24, 0xfa, 0x9e, 0x4c, 0x0c, 0xa9, 0x17, 0xf3, 0x80, 0xb2, 0x31, 0xee, 0xdc, 0xf8,
+		0xb5, 0x6b, 0x87, 0x96, 0x7f, 0xd4, 0x3d, 0xa8, 0x36, 0x9c, 0xf6, 0xa5, 0xc4, 0xdf, 0x4d, 0x54,
+		0x0f, 0xb1, 0xcd, 0xfe, 0x59, 0x24, 0xf6, 0xd7, 0x26, 0xdf, 0xe4, 0x3f, 0x8f, 0x2f, 0x1f, 0x8c,
+		0xd3, 0xbe, 0xb7, 0xfe, 0x77, 0x00, 0x24, 0xf8, 0x4b, 0xf0, 0x06, 0x65, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -2663,7 +2933,7 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -2678,7 +2948,7 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -2697,8 +2967,7 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
@@ -2752,437 +3021,457 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x7f, 0x81, 0xc7, 0xa1, 0x4c, 0x36, 0x0d, 0xf9, 0x69, 0xfd, 0xac, 0xf8, 0x6d, 0x86, 0xa6, 0x81,
 		0xa1, 0xf3, 0xf3, 0xf1, 0x94, 0xeb, 0xb7, 0xf9, 0xc4, 0x0d, 0x65, 0xd2, 0x5d, 0xff, 0x9b, 0xbe,
 		0xe1, 0x2c, 0xee, 0x4e, 0x65, 0xf9, 0xe3, 0xd8, 0x8f, 0xea, 0x39, 0x4d, 0xf9, 0xfc, 0x78, 0x52,
-		0x2b, 0x62, 0x4f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x13, 0xdb, 0xef, 0xb7, 0xcc, 0x06, 0x00,
-		0x00,
+		0x2b, 0x62, 0x4f, 0xff, 0x1e, 0x00, 0x13, 0xdb, 0xef, 0xb7, 0xcc, 0x06, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0x57,
-		0x15, 0xee, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0x5f, 0x67, 0x92,
-		0x26, 0xae, 0xe3, 0xac, 0x13, 0x27, 0x4d, 0x48, 0xd3, 0x1f, 0x12, 0xc7, 0x56, 0x57, 0x32, 0x49,
-		0x34, 0x71, 0x52, 0x40, 0x95, 0x96, 0xf1, 0xcc, 0x75, 0x3c, 0x78, 0x77, 0x67, 0x3b, 0x33, 0xeb,
-		0x8d, 0x91, 0x78, 0xe2, 0x01, 0x09, 0xb5, 0x82, 0xaa, 0x42, 0xa2, 0x82, 0x0a, 0x84, 0x04, 0x6a,
-		0x11, 0xa2, 0x08, 0x84, 0x00, 0xf1, 0x02, 0x48, 0x08, 0x24, 0x50, 0xe1, 0x89, 0x17, 0x5e, 0x79,
-		0xa0, 0x6f, 0x3c, 0x50, 0xde, 0x90, 0xd0, 0xdc, 0xb9, 0xb3, 0x3b, 0x3f, 0xf7, 0xce, 0xdc, 0x59,
-		0x6f, 0x5a, 0x50, 0xf3, 0xe6, 0xb9, 0x73, 0xce, 0x99, 0xef, 0xde, 0x7b, 0xce, 0xb9, 0xe7, 0x9e,
-		0x73, 0xd6, 0x70, 0xa2, 0xbd, 0x8e, 0xad, 0x05, 0x4d, 0xd5, 0x71, 0x53, 0xc3, 0x0b, 0x6a, 0xcb,
-		0x58, 0xd8, 0xbe, 0xb0, 0xb0, 0x69, 0xd8, 0x8e, 0x69, 0xed, 0x54, 0x5a, 0x96, 0xe9, 0x98, 0x68,
-		0x9f, 0x4b, 0x52, 0xa1, 0x24, 0x15, 0xb5, 0x65, 0x54, 0xb6, 0x2f, 0x1c, 0x3a, 0xf6, 0xc0, 0x34,
-		0x1f, 0xd4, 0xf1, 0x02, 0x21, 0x59, 0x6f, 0x6f, 0x2c, 0xe8, 0x6d, 0x4b, 0x75, 0x0c, 0xb3, 0xe9,
-		0x31, 0x1d, 0x3a, 0x1e, 0x7d, 0xef, 0x18, 0x0d, 0x6c, 0x3b, 0x6a, 0xa3, 0x45, 0x09, 0x66, 0x58,
-		0x1f, 0xd6, 0xcc, 0x46, 0xa3, 0x2b, 0x42, 0x66, 0x51, 0x38, 0xaa, 0xbd, 0x55, 0x37, 0x6c, 0x27,
-		0x89, 0xa6, 0x63, 0x5a, 0x5b, 0x1b, 0x75, 0xb3, 0xe3, 0xd1, 0xc8, 0x37, 0xa1, 0xf8, 0xa2, 0x37,
-		0x21, 0x74, 0x15, 0x0a, 0x78, 0x1b, 0x37, 0x1d, 0x7b, 0x4a, 0x9a, 0xc9, 0xcf, 0x96, 0x17, 0x4f,
-		0x54, 0x18, 0x73, 0xab, 0x50, 0xea, 0x65, 0x97, 0x52, 0xa1, 0x0c, 0xf2, 0xfb, 0x57, 0x60, 0x34,
-		0xf8, 0x02, 0x4d, 0xc3, 0x08, 0x79, 0x55, 0x33, 0xf4, 0x29, 0x69, 0x46, 0x9a, 0xcd, 0x2b, 0x45,
-		0xf2, 0x5c, 0xd5, 0xd1, 0x55, 0x00, 0xef, 0x95, 0x3b, 0xe9, 0xa9, 0xdc, 0x8c, 0x34, 0x5b, 0x5e,
-		0x3c, 0x54, 0xf1, 0x56, 0xa4, 0xe2, 0xaf, 0x48, 0x65, 0xcd, 0x5f, 0x11, 0xa5, 0x44, 0xa8, 0xdd,
-		0x67, 0x34, 0x05, 0xc5, 0x6d, 0x6c, 0xd9, 0x86, 0xd9, 0x9c, 0xca, 0x7b, 0x42, 0xe9, 0x23, 0x3a,
-		0x08, 0x45, 0x77, 0xf2, 0xee, 0xe7, 0x86, 0xc8, 0x9b, 0x82, 0xfb, 0x58, 0xd5, 0xd1, 0xb7, 0x24,
-		0x38, 0xeb, 0x4f, 0xb9, 0x86, 0x1f, 0x62, 0xad, 0xed, 0xee, 0x43, 0xcd, 0x76, 0x54, 0xcb, 0xc1,
-		0x7a, 0xcd, 0x43, 0xa2, 0x3a, 0x8e, 0x65, 0xac, 0xb7, 0x1d, 0x6c, 0x4f, 0x0d, 0x13, 0x3c, 0xcf,
-		0x32, 0xa7, 0xfe, 0x12, 0x95, 0xb3, 0xec, 0x8b, 0xb9, 0xeb, 0x49, 0x21, 0x53, 0xbe, 0xde, 0x95,
-		0xf1, 0xe2, 0x13, 0xca, 0x99, 0x8e, 0x18, 0x29, 0xfa, 0xae, 0x04, 0xe7, 0x18, 0xf0, 0x34, 0xb3,
-		0xd1, 0xaa, 0x63, 0x26, 0xc0, 0x02, 0x01, 0xf8, 0xbc, 0x18, 0xc0, 0x25, 0x5f, 0x4e, 0x1c, 0xe2,
-		0x53, 0x1d, 0x51, 0x62, 0xf4, 0xa6, 0x04, 0x73, 0x0c, 0x90, 0x1b, 0xaa, 0x51, 0x67, 0x21, 0x2c,
-		0x12, 0x84, 0xd7, 0xc4, 0x10, 0xae, 0x10, 0x21, 0x71, 0x78, 0xa7, 0x3b, 0x42, 0x94, 0xe8, 0x3b,
-		0xec, 0x05, 0x74, 0x75, 0x4b, 0xaf, 0x99, 0x6d, 0x27, 0x0e, 0x6f, 0x84, 0xc0, 0x7b, 0x4e, 0x0c,
-		0x9e, 0xab, 0x76, 0xfa, 0xed, 0xb6, 0x13, 0x07, 0x38, 0xdb, 0x11, 0xa4, 0x45, 0x6f, 0x48, 0x30,
-		0xab, 0x63, 0xcd, 0xb0, 0x09, 0x30, 0x57, 0x4b, 0x6d, 0x6d, 0x13, 0xeb, 0x6d, 0xe6, 0xe2, 0x95,
-		0x08, 0xba, 0xab, 0x4c, 0x74, 0x37, 0xa9, 0x90, 0x35, 0xd5, 0xde, 0xba, 0xeb, 0x8b, 0x88, 0x23,
-		0x3b, 0xa5, 0x0b, 0xd0, 0xa1, 0xd7, 0x24, 0x38, 0x1d, 0x41, 0xc5, 0xb3, 0x09, 0x20, 0x98, 0xae,
-		0xa4, 0x63, 0xe2, 0x99, 0x83, 0xac, 0xa7, 0x52, 0x31, 0x56, 0x29, 0xc1, 0x08, 0xca, 0x82, 0xab,
-		0x94, 0xa0, 0xff, 0xa1, 0x55, 0xe2, 0xaa, 0xfe, 0xeb, 0x31, 0x54, 0x09, 0x9a, 0x35, 0x4a, 0x50,
-		0x7d, 0x22, 0x15, 0x15, 0x5f, 0xa9, 0x4e, 0xea, 0xe9, 0x64, 0xe8, 0x2b, 0x12, 0x3c, 0x19, 0xc6,
-		0xc4, 0xb3, 0xc4, 0x3d, 0x04, 0xd0, 0xe5, 0x54, 0x40, 0x3c, 0x23, 0x3c, 0xa1, 0xa7, 0x11, 0x91,
-		0x6d, 0x53, 0x35, 0xc7, 0xd8, 0x36, 0x9c, 0x9d, 0x54, 0xe5, 0x1e, 0x4b, 0xd8, 0xb6, 0xeb, 0x54,
-		0x48, 0x9a, 0x72, 0xab, 0x02, 0x74, 0x44, 0xb9, 0x23, 0xa8, 0x78, 0xca, 0xbd, 0x37, 0x41, 0xb9,
-		0x43, 0x98, 0xb8, 0xca, 0xad, 0xa6, 0x52, 0x31, 0x56, 0x29, 0x41, 0xb9, 0xc7, 0x05, 0x57, 0x29,
-		0x49, 0xb9, 0x55, 0x01, 0x3a, 0xa2, 0x48, 0x61, 0x54, 0x3c, 0x45, 0x9a, 0x48, 0x50, 0xa4, 0x20,
-		0x24, 0xae, 0x22, 0xa9, 0x69, 0x44, 0xc4, 0xd2, 0xc2, 0x60, 0x12, 0x2c, 0x0d, 0x25, 0x58, 0x5a,
-		0x10, 0x4f, 0x82, 0xa5, 0xa9, 0xe9, 0x64, 0xa8, 0x03, 0xc7, 0x5c, 0x10, 0x16, 0x5f, 0x7b, 0xf6,
-		0x11, 0x20, 0xe7, 0x99, 0x40, 0x5c, 0xa9, 0x16, 0x57, 0x6d, 0x0e, 0x3b, 0xfc, 0xd7, 0xe8, 0x15,
-		0x38, 0xe2, 0x7d, 0x78, 0xc3, 0xb0, 0x58, 0x9f, 0xdd, 0x4f, 0x3e, 0x5b, 0xe1, 0x7f, 0x76, 0xc5,
-		0xe5, 0x8b, 0x7f, 0x74, 0xda, 0xe1, 0xbd, 0x44, 0xdf, 0x97, 0x60, 0x21, 0xa2, 0xa2, 0x6a, 0x53,
-		0xc3, 0xf5, 0x9a, 0x85, 0x5f, 0x69, 0x63, 0x9b, 0x39, 0xfb, 0x03, 0x04, 0xc6, 0x0b, 0xe9, 0x9a,
-		0x4a, 0x24, 0x29, 0xbe, 0xa0, 0x38, 0xae, 0x39, 0x55, 0x98, 0x1a, 0xfd, 0x54, 0x82, 0x4b, 0x14,
-		0x93, 0x0f, 0x51, 0x4c, 0x89, 0x27, 0x09, 0xda, 0x25, 0x26, 0x5a, 0xfa, 0x35, 0xef, 0xd3, 0x22,
-		0x1a, 0x5d, 0xb1, 0x32, 0x71, 0xa0, 0xaf, 0x49, 0x70, 0x86, 0xb5, 0xbc, 0x2c, 0xa0, 0x07, 0x05,
-		0xb5, 0x7b, 0x89, 0x4a, 0x48, 0xd1, 0x6e, 0x0e, 0x19, 0xfa, 0x02, 0x1c, 0xf7, 0x94, 0x8c, 0x8f,
-		0x64, 0x8a, 0x20, 0xb9, 0xc0, 0xd7, 0x33, 0x3e, 0x04, 0x4f, 0x81, 0x79, 0xdf, 0xfe, 0xb2, 0x04,
-		0xa7, 0xe8, 0xe6, 0x51, 0x45, 0xe7, 0x6c, 0xda, 0x34, 0x41, 0xf0, 0x34, 0x13, 0x81, 0x27, 0xdc,
-		0xd3, 0x77, 0xce, 0x36, 0xcd, 0x68, 0x29, 0x34, 0xe8, 0x8b, 0x30, 0xd3, 0x50, 0xad, 0x2d, 0x6c,
-		0xd5, 0x2c, 0xac, 0x99, 0x96, 0xce, 0x02, 0x71, 0x88, 0x80, 0x58, 0x64, 0x82, 0xf8, 0x14, 0x61,
-		0x56, 0x28, 0x6f, 0x1c, 0xc1, 0xd1, 0x46, 0x12, 0x01, 0xfa, 0xb6, 0x04, 0xf3, 0xac, 0xfb, 0x89,
-		0xf1, 0xa0, 0xa9, 0x32, 0x17, 0xe4, 0x70, 0x96, 0xf0, 0xf5, 0x2e, 0x15, 0x23, 0x12, 0xbe, 0x72,
-		0x68, 0xd1, 0xf7, 0x24, 0xa8, 0xb0, 0x22, 0x6c, 0x6c, 0x35, 0x8c, 0xa6, 0xca, 0xf4, 0x0b, 0x47,
-		0x12, 0xfc, 0x42, 0x3c, 0xc4, 0xee, 0x0a, 0x62, 0xf8, 0x85, 0x8e, 0x30, 0x35, 0xfa, 0x99, 0x04,
-		0x97, 0x58, 0x57, 0xa9, 0x54, 0x2f, 0x76, 0x94, 0xa0, 0xbd, 0x29, 0x78, 0xa3, 0x4a, 0x73, 0x65,
-		0x0b, 0x9d, 0x6c, 0x2c, 0x3c, 0x0d, 0xe0, 0x1b, 0xe5, 0xb1, 0x2c, 0x1a, 0xc0, 0x37, 0xd0, 0xd9,
-		0x8e, 0x20, 0x2d, 0xfa, 0xbb, 0x04, 0xcb, 0x11, 0x8f, 0x8b, 0x1f, 0x3a, 0xd8, 0x6a, 0xaa, 0xf5,
-		0x1a, 0x03, 0xb9, 0xd1, 0x34, 0x1c, 0x83, 0xad, 0x18, 0xc7, 0x09, 0xf4, 0xbb, 0xe9, 0x2e, 0x78,
-		0x99, 0xca, 0x8f, 0xcd, 0xa7, 0xea, 0x0b, 0x8f, 0x4f, 0xe8, 0x79, 0x6b, 0x57, 0x12, 0xd0, 0xdf,
-		0x24, 0xb8, 0x91, 0x61, 0x9a, 0x3c, 0x8f, 0x35, 0x43, 0xe6, 0x78, 0x67, 0x17, 0x73, 0xe4, 0x39,
-		0xb3, 0x6b, 0x56, 0xff, 0xec, 0xe8, 0x3d, 0x09, 0x9e, 0x4b, 0x9a, 0x4e, 0xba, 0x9d, 0x9c, 0x20,
-		0x13, 0x5b, 0x65, 0x4e, 0x8c, 0x0b, 0x26, 0xd5, 0x5e, 0xae, 0xe0, 0xfe, 0x58, 0x49, 0x1c, 0xc0,
-		0x4c, 0x9d, 0x34, 0x1d, 0xa3, 0xd9, 0xc6, 0x7a, 0x4d, 0xb5, 0x6b, 0x4d, 0xdc, 0x89, 0xcf, 0x43,
-		0x4e, 0x88, 0x03, 0x18, 0x19, 0x14, 0x2a, 0xee, 0xba, 0x7d, 0x0b, 0x77, 0x18, 0x71, 0x40, 0x27,
-		0x13, 0x07, 0xfa, 0xad, 0x04, 0x57, 0x49, 0x34, 0x59, 0xd3, 0x36, 0x8d, 0xba, 0x9e, 0xd1, 0x7e,
-		0x4e, 0x12, 0xe8, 0x2f, 0x32, 0xa1, 0x93, 0x50, 0x72, 0xc9, 0x15, 0x9a, 0xc5, 0x68, 0x2e, 0xda,
-		0xd9, 0xd9, 0xd0, 0x2f, 0x25, 0xb8, 0x9c, 0x32, 0x09, 0x9e, 0x75, 0x9c, 0x22, 0x33, 0x58, 0xce,
-		0x3a, 0x03, 0x9e, 0x49, 0x9c, 0xb7, 0x33, 0xf2, 0xa0, 0x1f, 0x4a, 0x70, 0x81, 0x8b, 0x9a, 0x1b,
-		0xe7, 0x3f, 0x49, 0x60, 0x5f, 0x67, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03, 0xff, 0x79, 0x2d, 0x03,
-		0x3d, 0xfa, 0x89, 0x04, 0x17, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0xa7, 0x13, 0x94, 0x9c, 0x0d, 0x38,
-		0xe1, 0x3a, 0x59, 0xd1, 0x32, 0x71, 0xa0, 0x77, 0x24, 0x38, 0x9f, 0x59, 0x33, 0xce, 0x10, 0xc4,
-		0x9f, 0xcc, 0x80, 0x98, 0xa7, 0x14, 0x67, 0xb5, 0x0c, 0xfa, 0xf0, 0xae, 0x04, 0x8b, 0xfc, 0x05,
-		0xe6, 0x1e, 0xc2, 0xb3, 0x04, 0xed, 0x8d, 0x2c, 0xeb, 0xcb, 0x3d, 0x89, 0xcf, 0x69, 0x59, 0x18,
-		0xd0, 0x8f, 0x93, 0x54, 0x22, 0xe1, 0xd2, 0xfc, 0x54, 0x66, 0xc8, 0xfc, 0xeb, 0x33, 0x07, 0x32,
-		0xef, 0x22, 0xed, 0xc6, 0x66, 0x7c, 0xc8, 0x09, 0x91, 0xe4, 0x5c, 0x42, 0x6c, 0xc6, 0xc1, 0x9c,
-		0x10, 0x4e, 0x2e, 0x68, 0xd9, 0x58, 0xc8, 0xa1, 0xe9, 0x85, 0xe2, 0xfd, 0x46, 0x3c, 0x67, 0x13,
-		0x0e, 0x4d, 0x2f, 0xe2, 0xee, 0x27, 0xd4, 0xb9, 0x62, 0xf7, 0xc7, 0x8a, 0x7e, 0x27, 0xc1, 0x33,
-		0x02, 0x13, 0xe2, 0xd9, 0xe8, 0x3c, 0x99, 0x4d, 0xb5, 0x9f, 0xd9, 0xf0, 0x8c, 0xf5, 0x92, 0xdd,
-		0x07, 0x1f, 0xfa, 0x85, 0x04, 0x4f, 0x27, 0x4d, 0x80, 0x7f, 0x7f, 0x3a, 0x97, 0x70, 0x00, 0x71,
-		0x41, 0xf0, 0xef, 0x51, 0xe7, 0x71, 0x46, 0x1e, 0xe2, 0x70, 0xda, 0x2d, 0x1b, 0x5b, 0x4e, 0x0f,
-		0xb8, 0x8d, 0x55, 0x4b, 0xdb, 0x0c, 0xc0, 0x8c, 0xe3, 0xae, 0x24, 0x58, 0xef, 0x3d, 0x22, 0xce,
-		0x47, 0x70, 0x97, 0x08, 0xeb, 0x7d, 0x91, 0x61, 0xbd, 0xed, 0x2c, 0x0c, 0x37, 0x46, 0x01, 0x7a,
-		0x40, 0xe4, 0x1f, 0x8d, 0xc2, 0x19, 0xd1, 0xd3, 0x6b, 0x05, 0xf6, 0x74, 0xe7, 0xe8, 0xec, 0xb4,
-		0x30, 0xa9, 0x05, 0xf2, 0x2a, 0x8b, 0xbe, 0xd0, 0xb5, 0x9d, 0x16, 0x56, 0x46, 0x3b, 0x81, 0x27,
-		0xf4, 0x32, 0x1c, 0x68, 0xa9, 0x96, 0xbb, 0x22, 0x41, 0xa3, 0xdb, 0x30, 0x69, 0xf9, 0x70, 0x96,
-		0x29, 0xef, 0x0e, 0xe1, 0x08, 0xd8, 0xc4, 0x86, 0xa9, 0xec, 0x6b, 0xc5, 0x07, 0xd1, 0x33, 0x50,
-		0x22, 0x19, 0x99, 0xba, 0x61, 0x3b, 0xa4, 0xb0, 0x58, 0x5e, 0x3c, 0xca, 0x4e, 0x79, 0xa8, 0xf6,
-		0xd6, 0xaa, 0x61, 0x3b, 0xca, 0x88, 0x43, 0xff, 0x42, 0x8b, 0x30, 0x6c, 0x34, 0x5b, 0x6d, 0x87,
-		0x94, 0x1d, 0xcb, 0x8b, 0x47, 0x38, 0x48, 0x76, 0xea, 0xa6, 0xaa, 0x2b, 0x1e, 0x29, 0x52, 0x61,
-		0x26, 0x12, 0x72, 0xd4, 0x1c, 0xb3, 0xa6, 0xd5, 0x4d, 0x1b, 0x13, 0xff, 0x6d, 0xb6, 0x1d, 0x5a,
-		0x87, 0x9c, 0x8e, 0xd5, 0x45, 0x6f, 0xd2, 0x4a, 0xb2, 0x72, 0x04, 0x87, 0xd6, 0x7e, 0xcd, 0x5c,
-		0x72, 0xf9, 0xd7, 0x3c, 0x76, 0xf4, 0x12, 0x1c, 0xee, 0xa5, 0xbd, 0xe3, 0xd2, 0x0b, 0x69, 0xd2,
-		0x0f, 0x3a, 0x7e, 0x32, 0x3b, 0x22, 0xf8, 0x1a, 0x1c, 0xea, 0x45, 0xd8, 0xbd, 0x59, 0x58, 0xed,
-		0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xec, 0x52, 0x74, 0xd7, 0x59, 0x69, 0x37, 0xab,
-		0x3a, 0xaa, 0x42, 0x89, 0xba, 0x4a, 0xd3, 0x22, 0x75, 0xb8, 0xb1, 0xc5, 0xb3, 0x6c, 0xd7, 0x4e,
-		0x05, 0x90, 0x10, 0xba, 0xea, 0xb3, 0x28, 0x3d, 0x6e, 0x54, 0x85, 0x89, 0x1e, 0x0e, 0xd7, 0x5d,
-		0xb5, 0x2d, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf1, 0x68, 0x94, 0xf1, 0x2e, 0x1b, 0x1d, 0x41,
-		0x0a, 0x4c, 0xd6, 0x55, 0xf7, 0xce, 0xe7, 0x85, 0x33, 0x64, 0x3a, 0xd8, 0x6e, 0xd7, 0x1d, 0x5a,
-		0xf8, 0x4a, 0xde, 0xd3, 0xfd, 0x2e, 0xef, 0x52, 0x97, 0x55, 0x21, 0x9c, 0xe8, 0x2a, 0x4c, 0x9b,
-		0x96, 0xf1, 0xc0, 0xf0, 0x1c, 0x6d, 0x64, 0x95, 0xca, 0x64, 0x95, 0x26, 0x7d, 0x82, 0xc8, 0x22,
-		0x1d, 0x82, 0x11, 0x43, 0xc7, 0x4d, 0xc7, 0x70, 0x76, 0x48, 0x45, 0xa9, 0xa4, 0x74, 0x9f, 0xd1,
-		0x45, 0x98, 0xdc, 0x30, 0x2c, 0xdb, 0x89, 0xcb, 0xdc, 0x43, 0x28, 0xf7, 0x91, 0xb7, 0x11, 0x81,
-		0x4b, 0x30, 0x6a, 0x61, 0xc7, 0xda, 0xa9, 0xb5, 0xcc, 0xba, 0xa1, 0xed, 0xd0, 0x2a, 0xcc, 0x0c,
-		0xe7, 0x82, 0xea, 0x58, 0x3b, 0x77, 0x08, 0x9d, 0x52, 0xb6, 0x7a, 0x0f, 0x68, 0x0a, 0x8a, 0xaa,
-		0xe3, 0xe0, 0x46, 0xcb, 0x21, 0x15, 0x93, 0x61, 0xc5, 0x7f, 0x44, 0x4b, 0xb0, 0x17, 0x3f, 0x6c,
-		0x19, 0x9e, 0xe2, 0x78, 0x45, 0xfd, 0xf1, 0xd4, 0xa2, 0xfe, 0x58, 0x8f, 0x85, 0x54, 0xf6, 0x4f,
-		0xc2, 0x1e, 0xcd, 0x72, 0xad, 0x81, 0x56, 0x74, 0x48, 0xc5, 0xa1, 0xa4, 0x8c, 0xba, 0x83, 0x7e,
-		0x95, 0x07, 0x7d, 0x1a, 0x0e, 0x7b, 0xb3, 0x0f, 0x57, 0xbf, 0xd6, 0x55, 0x6d, 0xcb, 0xdc, 0xd8,
-		0xa0, 0x45, 0x81, 0x04, 0xa5, 0x9e, 0x22, 0xdc, 0xc1, 0xc2, 0xd7, 0x0d, 0x8f, 0x15, 0x9d, 0x83,
-		0xa1, 0x06, 0x6e, 0x98, 0x34, 0x9d, 0x3f, 0xcd, 0x4e, 0xf4, 0xe1, 0x86, 0xa9, 0x10, 0x32, 0xa4,
-		0xc0, 0x44, 0xcc, 0x63, 0xd3, 0x9c, 0xfc, 0x93, 0xec, 0xb3, 0x31, 0xe2, 0x61, 0x95, 0x71, 0x3b,
-		0x32, 0x82, 0xee, 0xc1, 0x64, 0xcb, 0xc2, 0xdb, 0x35, 0xb5, 0xed, 0x98, 0xae, 0xfe, 0x61, 0xa7,
-		0xd6, 0x32, 0x8d, 0xa6, 0xe3, 0x67, 0xd9, 0x79, 0xfb, 0x65, 0x63, 0xe7, 0x0e, 0xa1, 0x53, 0xf6,
-		0xb9, 0xfc, 0xd7, 0xdb, 0x8e, 0x19, 0x18, 0x44, 0x17, 0xa1, 0xb0, 0x89, 0x55, 0x1d, 0x5b, 0x34,
-		0xfd, 0x7d, 0x98, 0xdd, 0xd4, 0x41, 0x48, 0x14, 0x4a, 0x8a, 0x56, 0x61, 0xbf, 0xb7, 0xd0, 0xbd,
-		0x5a, 0x1e, 0xd9, 0xd7, 0x83, 0xa9, 0xfb, 0x8a, 0x08, 0x5f, 0xb7, 0x2e, 0xe7, 0xbe, 0x90, 0xdf,
-		0x91, 0xe0, 0x29, 0xf1, 0xbb, 0xc3, 0x25, 0x28, 0x50, 0xeb, 0x93, 0x04, 0xac, 0x8f, 0xd2, 0xa2,
-		0x15, 0x98, 0x49, 0x2e, 0x1e, 0x1b, 0x3a, 0x39, 0x2b, 0xf2, 0xca, 0x11, 0x7e, 0xdd, 0xb7, 0xaa,
-		0xcb, 0x6f, 0x4b, 0x70, 0x5a, 0x30, 0x04, 0xb9, 0x0c, 0x45, 0xdf, 0xef, 0x48, 0x02, 0x7e, 0xc7,
-		0x27, 0x1e, 0x18, 0x54, 0x13, 0x66, 0x85, 0xe3, 0xef, 0x25, 0x18, 0xa5, 0xae, 0xbf, 0x77, 0x0c,
-		0x8f, 0x71, 0x54, 0x8a, 0x7a, 0x7a, 0x72, 0x0a, 0x97, 0x9d, 0xde, 0x83, 0xfc, 0x27, 0x09, 0x4e,
-		0x89, 0xb4, 0x20, 0x84, 0xcf, 0x53, 0x29, 0xdb, 0x79, 0x7a, 0x0b, 0x26, 0x39, 0x67, 0x56, 0x2e,
-		0xcd, 0xbc, 0xf7, 0xd9, 0x8c, 0xf3, 0x2a, 0xe0, 0xb7, 0xf2, 0x21, 0xbf, 0x25, 0xbf, 0x26, 0x81,
-		0x9c, 0xde, 0xbd, 0x80, 0xe6, 0x01, 0x45, 0x2b, 0xda, 0xdd, 0x9e, 0xa6, 0x71, 0x3b, 0xb4, 0x04,
-		0x11, 0xe7, 0x9d, 0x8b, 0x38, 0xef, 0xa3, 0x00, 0x7e, 0x7a, 0xd1, 0xd0, 0x09, 0x9a, 0x92, 0x52,
-		0xa2, 0x23, 0x55, 0x5d, 0xfe, 0x67, 0x64, 0x79, 0xb9, 0x16, 0x92, 0x0d, 0xd1, 0x2c, 0x8c, 0x87,
-		0xb3, 0x1a, 0x5d, 0xf5, 0x1a, 0xb3, 0x03, 0x33, 0x8e, 0x60, 0xcf, 0x47, 0xb0, 0x9f, 0x81, 0xbd,
-		0xeb, 0x46, 0x53, 0xb5, 0x76, 0x6a, 0xda, 0x26, 0xd6, 0xb6, 0xec, 0x76, 0x83, 0x04, 0x3c, 0x25,
-		0x65, 0xcc, 0x1b, 0x5e, 0xa2, 0xa3, 0xe8, 0x2c, 0x4c, 0x84, 0x73, 0x71, 0xf8, 0xa1, 0x17, 0xcc,
-		0x8c, 0x2a, 0xe3, 0x38, 0x98, 0x22, 0xc3, 0x0f, 0x1d, 0xf9, 0xd5, 0x3c, 0x9c, 0x14, 0x68, 0x8c,
-		0x78, 0x64, 0x33, 0x8e, 0x9a, 0x45, 0xbe, 0x0f, 0xb3, 0x40, 0xc7, 0xa0, 0xbc, 0xae, 0xda, 0xd8,
-		0x3f, 0x88, 0xbd, 0x65, 0x29, 0xb9, 0x43, 0xde, 0xf1, 0x7b, 0x04, 0xa0, 0x89, 0x3b, 0xfe, 0xeb,
-		0x61, 0x6f, 0x61, 0x9b, 0xb8, 0xe3, 0xbd, 0x9d, 0x07, 0xb4, 0x61, 0x5a, 0x5b, 0x14, 0xa9, 0xdf,
-		0xdd, 0x56, 0xf0, 0xa6, 0xe6, 0xbe, 0x21, 0x58, 0xef, 0xd3, 0x36, 0xb7, 0x49, 0xd7, 0x39, 0xaa,
-		0xb6, 0xd9, 0xa4, 0x91, 0x16, 0x7d, 0x42, 0x37, 0x61, 0x58, 0x53, 0xdb, 0x36, 0xa6, 0x41, 0x55,
-		0x45, 0xb8, 0x05, 0x65, 0xc9, 0xe5, 0x52, 0x3c, 0x66, 0xf9, 0xed, 0x3c, 0x9c, 0x48, 0x6d, 0x0b,
-		0x79, 0x64, 0x9b, 0x71, 0xc3, 0x9f, 0x83, 0xb7, 0x0b, 0xf3, 0x82, 0x5d, 0x2b, 0xc1, 0x19, 0x04,
-		0x7d, 0xf2, 0x50, 0x16, 0x9f, 0x1c, 0x54, 0xfd, 0xe1, 0x88, 0xea, 0x47, 0xf6, 0xb7, 0x90, 0xbc,
-		0xbf, 0x45, 0xa1, 0xfd, 0x1d, 0xe1, 0xec, 0x2f, 0xc3, 0xcc, 0x4a, 0x2c, 0x33, 0x93, 0xdf, 0x2a,
-		0xc0, 0x29, 0x91, 0x8e, 0x19, 0x74, 0x1c, 0xca, 0xdd, 0xb2, 0x33, 0xdd, 0xa6, 0x92, 0x02, 0xfe,
-		0x50, 0x55, 0x77, 0xaf, 0x68, 0xbd, 0xba, 0xb4, 0x6b, 0x04, 0xb9, 0x84, 0x2b, 0x5a, 0xf7, 0x93,
-		0xe4, 0x8a, 0xa6, 0x06, 0x9e, 0x5c, 0xd5, 0xd4, 0xcd, 0x86, 0x6a, 0x34, 0xa9, 0xef, 0xa0, 0x4f,
-		0xe1, 0xc3, 0x60, 0xa8, 0xcf, 0xcb, 0x55, 0x41, 0xfc, 0x72, 0xb5, 0x06, 0xd3, 0xbe, 0x12, 0xc6,
-		0xcf, 0x90, 0x62, 0xda, 0x19, 0x32, 0xe9, 0xf3, 0x46, 0x8e, 0x91, 0x88, 0x54, 0x7a, 0x44, 0x51,
-		0xa9, 0x23, 0x19, 0xa4, 0x7a, 0x77, 0x2a, 0x2a, 0x95, 0x7f, 0xd8, 0x95, 0xfa, 0x3a, 0xec, 0x56,
-		0x60, 0x62, 0x13, 0xab, 0x96, 0xb3, 0x8e, 0xd5, 0x1e, 0x3a, 0x48, 0x13, 0x35, 0xde, 0xe5, 0xe9,
-		0xc9, 0x49, 0x0f, 0x51, 0xca, 0xe9, 0x21, 0x4a, 0xec, 0xe6, 0x31, 0xda, 0xcf, 0xcd, 0xa3, 0x17,
-		0xc1, 0xee, 0x11, 0x8e, 0x60, 0xe5, 0x7f, 0x48, 0x20, 0xa7, 0x77, 0x6f, 0x7d, 0x68, 0x87, 0x7b,
-		0x30, 0x0c, 0x19, 0x0a, 0x5f, 0x9f, 0x5e, 0x80, 0x51, 0x72, 0xfb, 0xf4, 0xfd, 0xd6, 0xb0, 0x80,
-		0xdf, 0x2a, 0xbb, 0x1c, 0xf4, 0x41, 0xfe, 0x8b, 0x14, 0x76, 0x05, 0x03, 0x8e, 0xac, 0xd9, 0x4b,
-		0x94, 0xcb, 0xe0, 0xee, 0xf3, 0xa9, 0xd1, 0xc6, 0x50, 0x78, 0x31, 0xe5, 0x3f, 0x4b, 0x70, 0x22,
-		0xbd, 0xa5, 0xa6, 0xdf, 0x00, 0xfc, 0xa3, 0x98, 0xd1, 0xaf, 0x72, 0x70, 0x52, 0xa0, 0x31, 0xcd,
-		0x9d, 0x93, 0x8e, 0x1d, 0xd5, 0xa8, 0xdb, 0x42, 0x9b, 0xe4, 0x13, 0x3f, 0xb2, 0x39, 0x45, 0x23,
-		0xa4, 0xa1, 0x7e, 0x22, 0xa4, 0x5d, 0xab, 0xf8, 0xd7, 0x25, 0x98, 0x13, 0xef, 0x27, 0x13, 0x39,
-		0xf3, 0x06, 0x73, 0x05, 0x7b, 0x57, 0x82, 0x8c, 0x9d, 0x63, 0xe9, 0xd8, 0xf6, 0xfb, 0x61, 0x90,
-		0xe7, 0x61, 0x68, 0x60, 0x23, 0x82, 0x38, 0x2f, 0x80, 0xf8, 0xcd, 0x88, 0x1e, 0xf2, 0x6a, 0x4c,
-		0xfd, 0xea, 0xe1, 0x0a, 0xcc, 0xd4, 0x55, 0x27, 0xd0, 0x41, 0x11, 0xed, 0x27, 0xe8, 0xad, 0xac,
-		0x47, 0xc7, 0xda, 0x4a, 0x2f, 0x6c, 0x62, 0xe8, 0x73, 0x3e, 0x83, 0x3e, 0x0f, 0xa5, 0xda, 0x68,
-		0x24, 0xd0, 0x93, 0xdf, 0x93, 0xe0, 0x70, 0x42, 0xcf, 0x26, 0x9a, 0x86, 0x11, 0xaf, 0x57, 0xad,
-		0xbb, 0x6f, 0x45, 0xf2, 0x5c, 0xd5, 0xd1, 0x2a, 0x1c, 0xe8, 0x1e, 0xe4, 0x1b, 0x86, 0x95, 0xe1,
-		0xd2, 0x8a, 0xe8, 0x39, 0xbe, 0x62, 0x58, 0x38, 0xcb, 0xf1, 0x2b, 0xb2, 0xd9, 0x9f, 0x83, 0x69,
-		0x6e, 0x33, 0x68, 0xd2, 0x6c, 0x84, 0x63, 0x76, 0xf9, 0xf7, 0x12, 0x1c, 0x49, 0xea, 0x03, 0x1c,
-		0xc8, 0x57, 0x06, 0xb5, 0x1e, 0x89, 0x0e, 0xfa, 0xe7, 0x12, 0xcc, 0xa4, 0xf5, 0x13, 0x26, 0xcd,
-		0xe6, 0x91, 0x9a, 0x6d, 0x22, 0xf2, 0xff, 0x14, 0x21, 0x63, 0xdb, 0x0a, 0x5a, 0x80, 0xfd, 0xa4,
-		0x33, 0x26, 0x9a, 0x44, 0xf6, 0xe6, 0x34, 0xd1, 0xc4, 0x9d, 0x48, 0x0a, 0x39, 0x56, 0xc7, 0xc9,
-		0xf5, 0x57, 0xc7, 0x79, 0x5c, 0x69, 0x11, 0xaf, 0xb4, 0x88, 0xe8, 0x4e, 0x51, 0x40, 0x77, 0x6e,
-		0xc3, 0x24, 0xcd, 0x90, 0x53, 0x8c, 0x46, 0xd3, 0xc1, 0xd6, 0xb6, 0x5a, 0x4f, 0xbf, 0xb7, 0xec,
-		0xa7, 0x8c, 0x04, 0x5e, 0x95, 0xb2, 0x85, 0xab, 0x38, 0xa5, 0x5d, 0x55, 0x71, 0x02, 0x21, 0x1c,
-		0x64, 0x09, 0xe1, 0xf8, 0x25, 0x9b, 0x72, 0xdf, 0x25, 0x9b, 0xde, 0x3d, 0x63, 0x54, 0x3c, 0x53,
-		0xee, 0x17, 0x0e, 0xf6, 0xec, 0xa2, 0x70, 0x30, 0xb6, 0xab, 0xc2, 0x81, 0xeb, 0x83, 0x17, 0xb2,
-		0xf6, 0xce, 0x75, 0xbd, 0x95, 0x14, 0xf4, 0x56, 0x49, 0xf7, 0x9b, 0x75, 0x38, 0xd8, 0xad, 0xb7,
-		0x47, 0x6a, 0xb0, 0x9e, 0x1d, 0xcf, 0x25, 0x56, 0xd4, 0xc3, 0x55, 0xd8, 0x03, 0x98, 0x35, 0x2c,
-		0xff, 0x40, 0x62, 0xa4, 0xb4, 0x79, 0x27, 0x8b, 0x88, 0x79, 0x48, 0x02, 0xe6, 0x11, 0x88, 0x74,
-		0x72, 0x19, 0x22, 0x1d, 0xf9, 0x03, 0x09, 0x8e, 0x26, 0xf6, 0x7e, 0xbb, 0xa1, 0x1e, 0xed, 0x2c,
-		0x6f, 0xaa, 0x0d, 0x7f, 0xa9, 0xc1, 0x1b, 0xba, 0xa5, 0x36, 0x70, 0xbf, 0x9f, 0x1e, 0xd8, 0xa9,
-		0xd2, 0xd3, 0xf8, 0x21, 0xf1, 0x9b, 0xf5, 0x37, 0x59, 0x9b, 0xc4, 0xeb, 0x75, 0x38, 0x0e, 0x65,
-		0xda, 0x6d, 0x12, 0x5c, 0x02, 0x6f, 0x88, 0x2c, 0x41, 0xd7, 0xa9, 0xe7, 0xc4, 0x9d, 0x7a, 0x42,
-		0x9e, 0x5a, 0xfe, 0x86, 0x04, 0x73, 0x19, 0xfa, 0x7b, 0x7a, 0xf9, 0x54, 0x29, 0x94, 0x4f, 0xed,
-		0x77, 0x67, 0x92, 0xa0, 0xfd, 0x26, 0x07, 0xcf, 0xef, 0xae, 0xc7, 0x79, 0x60, 0x3a, 0xdf, 0xcb,
-		0xd5, 0xe5, 0x42, 0xb9, 0xba, 0x7b, 0x80, 0xe2, 0xbd, 0x34, 0xd4, 0xbe, 0x4f, 0x8b, 0xf5, 0xcb,
-		0x2a, 0x13, 0xb1, 0x86, 0x58, 0x34, 0x05, 0x45, 0xcd, 0x6c, 0x3a, 0x96, 0x59, 0x27, 0x8a, 0x36,
-		0xaa, 0xf8, 0x8f, 0xa8, 0x02, 0xfb, 0x22, 0x6d, 0x61, 0x66, 0xb3, 0xee, 0x45, 0xe6, 0x23, 0xca,
-		0x44, 0xa8, 0x5b, 0xeb, 0x76, 0xb3, 0xbe, 0x23, 0xbf, 0x91, 0x87, 0x6b, 0xbb, 0xe8, 0xa1, 0x46,
-		0xf7, 0x82, 0x7e, 0x6f, 0x8c, 0xf3, 0x0b, 0x05, 0x21, 0xc9, 0xa1, 0xb4, 0xf3, 0x80, 0xee, 0x93,
-		0xdc, 0x1c, 0x2a, 0x7b, 0x5f, 0x86, 0x76, 0xbb, 0x2f, 0xf3, 0x80, 0xa2, 0x9d, 0x6b, 0xb4, 0x42,
-		0x91, 0x57, 0xc6, 0x8d, 0x90, 0x12, 0x7a, 0x29, 0x2c, 0x7f, 0x17, 0x0b, 0xa1, 0x5d, 0x94, 0xff,
-		0x2a, 0xc1, 0x95, 0x3e, 0x1b, 0xc0, 0x39, 0x18, 0x24, 0x0e, 0x86, 0x0f, 0x57, 0x71, 0xe5, 0xaf,
-		0xe6, 0xe1, 0x4a, 0x9f, 0x4d, 0x7a, 0xff, 0xaf, 0xb6, 0x1a, 0xf1, 0xd8, 0x43, 0x7c, 0x8f, 0x3d,
-		0x2c, 0xee, 0xb1, 0xb9, 0xaa, 0xc3, 0x73, 0x00, 0x45, 0x9e, 0x03, 0x78, 0x35, 0x0f, 0x97, 0xfa,
-		0x69, 0x34, 0x14, 0xb3, 0x7c, 0x21, 0xc9, 0x8f, 0x2d, 0xbf, 0x67, 0xf9, 0xef, 0x4b, 0x70, 0x3e,
-		0x6b, 0xd3, 0xe4, 0xff, 0xb4, 0xc9, 0xf3, 0xcf, 0x2a, 0xf9, 0x8f, 0x12, 0x9c, 0xcb, 0xd4, 0x68,
-		0x39, 0x30, 0x17, 0xc0, 0xbc, 0x35, 0xe4, 0x76, 0x77, 0x6b, 0x78, 0xab, 0x04, 0x17, 0xfb, 0xf8,
-		0xc5, 0x48, 0x60, 0x3b, 0xa4, 0xd0, 0x76, 0x1c, 0x87, 0x72, 0x77, 0x3b, 0xa8, 0xce, 0x97, 0x14,
-		0xf0, 0x87, 0x58, 0x29, 0x84, 0xfc, 0x00, 0x52, 0x08, 0xfd, 0xd6, 0x13, 0x87, 0x07, 0x9b, 0x42,
-		0x28, 0x3c, 0xd2, 0x14, 0x42, 0xb1, 0xef, 0x14, 0xc2, 0x7d, 0xa0, 0xfd, 0xae, 0x54, 0x22, 0x2d,
-		0xc3, 0x79, 0x4d, 0x02, 0xa7, 0x13, 0x9a, 0x66, 0x89, 0x14, 0x5a, 0x8c, 0x9b, 0x68, 0x45, 0x87,
-		0x82, 0x46, 0x52, 0x0a, 0xfb, 0x73, 0x11, 0x95, 0x07, 0x01, 0x95, 0xd7, 0x60, 0x2a, 0xa0, 0x4e,
-		0x35, 0x0b, 0xb7, 0x7b, 0xf0, 0xcb, 0x04, 0xfe, 0x5c, 0xa2, 0xe2, 0x54, 0x75, 0xc5, 0x65, 0xa1,
-		0x53, 0x38, 0xd0, 0x61, 0x0d, 0xc7, 0xca, 0x93, 0x7b, 0xfa, 0x29, 0x4f, 0xc6, 0x3a, 0x17, 0xc7,
-		0x18, 0x9d, 0x8b, 0xbd, 0x9b, 0xd6, 0xde, 0xec, 0xb9, 0x85, 0xf1, 0x5d, 0xe4, 0x16, 0x26, 0x76,
-		0xd7, 0x94, 0xf8, 0x0c, 0x94, 0x75, 0x5c, 0x57, 0x77, 0x3c, 0xd5, 0x4c, 0xef, 0xb0, 0x04, 0x42,
-		0x4d, 0x54, 0x11, 0x3d, 0x0b, 0xa3, 0x9f, 0x37, 0x1c, 0xc7, 0xff, 0xef, 0x09, 0xdd, 0xde, 0x4a,
-		0x2e, 0x73, 0xd9, 0x23, 0x27, 0xdc, 0xf2, 0xeb, 0x79, 0x38, 0x9f, 0xf5, 0xf7, 0x60, 0x1f, 0xbd,
-		0x73, 0x5a, 0xf5, 0xa3, 0x0c, 0xaf, 0x4e, 0x76, 0x39, 0xf3, 0x8f, 0x99, 0x42, 0xc1, 0x45, 0xc0,
-		0xcc, 0x86, 0xc3, 0x66, 0xc6, 0x3e, 0x42, 0x0b, 0x9c, 0x23, 0x74, 0x40, 0x99, 0x44, 0xf9, 0x0f,
-		0x39, 0x98, 0xcf, 0xf2, 0x63, 0x37, 0xee, 0x7e, 0xb0, 0xcf, 0xee, 0xdc, 0x6e, 0xcf, 0xee, 0x41,
-		0xed, 0x22, 0x7b, 0x75, 0x87, 0x38, 0xab, 0xdb, 0xb3, 0xed, 0x61, 0xf1, 0x2c, 0xca, 0x07, 0x39,
-		0xc8, 0xf8, 0x33, 0xbc, 0x8f, 0xc7, 0x62, 0xb2, 0x8a, 0x42, 0xc3, 0xcc, 0xa2, 0x50, 0xaf, 0x9b,
-		0xa1, 0x20, 0xde, 0xcd, 0x20, 0xff, 0x2b, 0x07, 0x67, 0x07, 0xe1, 0x51, 0x3e, 0xa6, 0x8b, 0x1e,
-		0xc8, 0xd7, 0x17, 0x32, 0xe4, 0xeb, 0xe5, 0x7f, 0xe7, 0xe0, 0x5c, 0xa6, 0x5f, 0x45, 0x3e, 0x5e,
-		0xf8, 0xd8, 0xc2, 0xfb, 0x09, 0xc9, 0x42, 0x96, 0x2c, 0xf5, 0x97, 0xf2, 0xbc, 0x85, 0xe7, 0x75,
-		0xa0, 0x3c, 0x5e, 0xf8, 0xc4, 0x06, 0x98, 0x42, 0x3f, 0x9d, 0xf3, 0xbf, 0xce, 0xc1, 0x42, 0xc6,
-		0x5f, 0xab, 0x3e, 0xde, 0x87, 0xd0, 0x3e, 0xcc, 0x39, 0xb0, 0x97, 0xfc, 0xb9, 0x62, 0xd4, 0x1d,
-		0x6c, 0x91, 0x4f, 0x1d, 0x85, 0xe9, 0xe5, 0xfb, 0xcb, 0xb7, 0xd6, 0x6a, 0x2b, 0xd5, 0xd5, 0xb5,
-		0x65, 0xa5, 0xb6, 0xf6, 0x99, 0x3b, 0xcb, 0xb5, 0xea, 0xad, 0xfb, 0xd7, 0x57, 0xab, 0x37, 0xc7,
-		0x9f, 0x40, 0xc7, 0xe1, 0x70, 0xfc, 0xf5, 0xf5, 0xd5, 0xd5, 0x1a, 0x19, 0x1d, 0x97, 0xd0, 0x09,
-		0x38, 0x1a, 0x27, 0x58, 0x5a, 0xbd, 0x7d, 0x77, 0x99, 0x92, 0xe4, 0x6e, 0xbc, 0x0c, 0x07, 0x35,
-		0xb3, 0xc1, 0x5a, 0x83, 0x1b, 0xfe, 0xff, 0x3b, 0xbd, 0xe3, 0xc6, 0xb1, 0x77, 0xa4, 0xcf, 0x5e,
-		0x78, 0x60, 0x38, 0x9b, 0xed, 0xf5, 0x8a, 0x66, 0x36, 0x16, 0x82, 0xff, 0x77, 0xf5, 0x9c, 0xa1,
-		0xd7, 0x17, 0x1e, 0x98, 0xde, 0xff, 0x7a, 0xa5, 0xff, 0x84, 0xf5, 0x9a, 0xda, 0x32, 0xb6, 0x2f,
-		0xac, 0x17, 0xc8, 0xd8, 0xc5, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x51, 0x24, 0x07, 0xc6, 0x67,
-		0x56, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x1c, 0x49,
+		0xf5, 0xdf, 0x9e, 0xb1, 0xc7, 0x9e, 0x37, 0x8e, 0x63, 0x97, 0x13, 0xc7, 0x8e, 0x9d, 0xc4, 0xe9,
+		0x64, 0x13, 0xaf, 0x63, 0xcf, 0x24, 0x4e, 0x36, 0xf9, 0x67, 0xb3, 0x1f, 0xff, 0xc4, 0xb1, 0x95,
+		0x91, 0x4c, 0x62, 0x75, 0x9c, 0x2c, 0xa0, 0x15, 0x43, 0x7b, 0xba, 0x1c, 0x37, 0x9e, 0x99, 0x9e,
+		0xed, 0xae, 0xf1, 0xc4, 0x48, 0x9c, 0x38, 0x20, 0xa1, 0x5d, 0xc1, 0x6a, 0x85, 0x60, 0x05, 0x08,
+		0x84, 0x04, 0xda, 0x45, 0x48, 0x8b, 0x16, 0x21, 0x58, 0x71, 0x01, 0x24, 0x04, 0x02, 0xb4, 0x70,
+		0xe2, 0x82, 0xc4, 0x89, 0x03, 0xdc, 0x38, 0xb0, 0xdc, 0x90, 0x50, 0x57, 0x57, 0xcf, 0x47, 0x77,
+		0x55, 0x77, 0xf5, 0xd8, 0xd9, 0x05, 0x6d, 0x6e, 0xee, 0xea, 0xf7, 0x5e, 0xff, 0xaa, 0xea, 0xbd,
+		0x57, 0xef, 0xd5, 0x7b, 0x63, 0x38, 0xd9, 0xd8, 0xc0, 0x76, 0xa1, 0xac, 0x1b, 0xb8, 0x56, 0xc6,
+		0x05, 0xbd, 0x6e, 0x16, 0x76, 0x2e, 0x14, 0xb6, 0x4c, 0x87, 0x58, 0xf6, 0x6e, 0xbe, 0x6e, 0x5b,
+		0xc4, 0x42, 0x63, 0x2e, 0x49, 0x9e, 0x91, 0xe4, 0xf5, 0xba, 0x99, 0xdf, 0xb9, 0x70, 0xf4, 0xf8,
+		0x03, 0xcb, 0x7a, 0x50, 0xc1, 0x05, 0x4a, 0xb2, 0xd1, 0xd8, 0x2c, 0x18, 0x0d, 0x5b, 0x27, 0xa6,
+		0x55, 0xf3, 0x98, 0x8e, 0x9e, 0x08, 0xbe, 0x27, 0x66, 0x15, 0x3b, 0x44, 0xaf, 0xd6, 0x19, 0xc1,
+		0x0c, 0xef, 0xc3, 0x65, 0xab, 0x5a, 0x6d, 0x89, 0x50, 0x79, 0x14, 0x44, 0x77, 0xb6, 0x2b, 0xa6,
+		0x43, 0xa2, 0x68, 0x9a, 0x96, 0xbd, 0xbd, 0x59, 0xb1, 0x9a, 0x1e, 0x8d, 0x7a, 0x13, 0x06, 0x6e,
+		0x79, 0x13, 0x42, 0x57, 0x21, 0x83, 0x77, 0x70, 0x8d, 0x38, 0x13, 0xca, 0x4c, 0x7a, 0x36, 0xb7,
+		0x78, 0x32, 0xcf, 0x99, 0x5b, 0x9e, 0x51, 0x2f, 0xbb, 0x94, 0x1a, 0x63, 0x50, 0xbf, 0xfa, 0x1c,
+		0x0c, 0x75, 0xbe, 0x40, 0x93, 0x30, 0x48, 0x5f, 0x95, 0x4c, 0x63, 0x42, 0x99, 0x51, 0x66, 0xd3,
+		0xda, 0x00, 0x7d, 0x2e, 0x1a, 0xe8, 0x2a, 0x80, 0xf7, 0xca, 0x9d, 0xf4, 0x44, 0x6a, 0x46, 0x99,
+		0xcd, 0x2d, 0x1e, 0xcd, 0x7b, 0x2b, 0x92, 0xf7, 0x57, 0x24, 0xbf, 0xee, 0xaf, 0x88, 0x96, 0xa5,
+		0xd4, 0xee, 0x33, 0x9a, 0x80, 0x81, 0x1d, 0x6c, 0x3b, 0xa6, 0x55, 0x9b, 0x48, 0x7b, 0x42, 0xd9,
+		0x23, 0x3a, 0x02, 0x03, 0xee, 0xe4, 0xdd, 0xcf, 0xf5, 0xd1, 0x37, 0x19, 0xf7, 0xb1, 0x68, 0xa0,
+		0x6f, 0x28, 0x70, 0xce, 0x9f, 0x72, 0x09, 0x3f, 0xc4, 0xe5, 0x86, 0xbb, 0x0f, 0x25, 0x87, 0xe8,
+		0x36, 0xc1, 0x46, 0xc9, 0x43, 0xa2, 0x13, 0x62, 0x9b, 0x1b, 0x0d, 0x82, 0x9d, 0x89, 0x7e, 0x8a,
+		0xe7, 0x59, 0xee, 0xd4, 0x5f, 0x64, 0x72, 0x96, 0x7d, 0x31, 0x77, 0x3d, 0x29, 0x74, 0xca, 0xd7,
+		0x5b, 0x32, 0x6e, 0x3d, 0xa1, 0x9d, 0x6d, 0xca, 0x91, 0xa2, 0xef, 0x28, 0xb0, 0xc0, 0x81, 0x57,
+		0xb6, 0xaa, 0xf5, 0x0a, 0xe6, 0x02, 0xcc, 0x50, 0x80, 0xcf, 0xcb, 0x01, 0x5c, 0xf2, 0xe5, 0x84,
+		0x21, 0x3e, 0xd5, 0x94, 0x25, 0x46, 0x6f, 0x28, 0x30, 0xc7, 0x01, 0xb9, 0xa9, 0x9b, 0x15, 0x1e,
+		0xc2, 0x01, 0x8a, 0xf0, 0x9a, 0x1c, 0xc2, 0x15, 0x2a, 0x24, 0x0c, 0xef, 0x4c, 0x53, 0x8a, 0x12,
+		0x7d, 0x9b, 0xbf, 0x80, 0xae, 0x6e, 0x19, 0x25, 0xab, 0x41, 0xc2, 0xf0, 0x06, 0x29, 0xbc, 0xe7,
+		0xe4, 0xe0, 0xb9, 0x6a, 0x67, 0xdc, 0x69, 0x90, 0x30, 0xc0, 0xd9, 0xa6, 0x24, 0x2d, 0x7a, 0x5d,
+		0x81, 0x59, 0x03, 0x97, 0x4d, 0x87, 0x02, 0x73, 0xb5, 0xd4, 0x29, 0x6f, 0x61, 0xa3, 0xc1, 0x5d,
+		0xbc, 0x2c, 0x45, 0x77, 0x95, 0x8b, 0xee, 0x26, 0x13, 0xb2, 0xae, 0x3b, 0xdb, 0x77, 0x7d, 0x11,
+		0x61, 0x64, 0xa7, 0x0d, 0x09, 0x3a, 0xf4, 0xaa, 0x02, 0x67, 0x02, 0xa8, 0x44, 0x36, 0x01, 0x14,
+		0xd3, 0x95, 0x78, 0x4c, 0x22, 0x73, 0x50, 0x8d, 0x58, 0x2a, 0xce, 0x2a, 0x45, 0x18, 0x41, 0x4e,
+		0x72, 0x95, 0x22, 0xf4, 0xff, 0xb4, 0x21, 0x41, 0x87, 0x5e, 0x0b, 0xa1, 0x8a, 0xd0, 0xac, 0x21,
+		0x8a, 0xea, 0xff, 0x62, 0x51, 0x89, 0x95, 0xea, 0x94, 0x11, 0x4f, 0x86, 0xbe, 0xa8, 0xc0, 0x93,
+		0xdd, 0x98, 0x44, 0x96, 0x78, 0x80, 0x02, 0xba, 0x1c, 0x0b, 0x48, 0x64, 0x84, 0x27, 0x8d, 0x38,
+		0x22, 0xba, 0x6d, 0x7a, 0x99, 0x98, 0x3b, 0x26, 0xd9, 0x8d, 0x55, 0xee, 0xe1, 0x88, 0x6d, 0xbb,
+		0xce, 0x84, 0xc4, 0x29, 0xb7, 0x2e, 0x41, 0x47, 0x95, 0x3b, 0x80, 0x4a, 0xa4, 0xdc, 0x07, 0x23,
+		0x94, 0xbb, 0x0b, 0x93, 0x50, 0xb9, 0xf5, 0x58, 0x2a, 0xce, 0x2a, 0x45, 0x28, 0xf7, 0x88, 0xe4,
+		0x2a, 0x45, 0x29, 0xb7, 0x2e, 0x41, 0x47, 0x15, 0xa9, 0x1b, 0x95, 0x48, 0x91, 0x46, 0x23, 0x14,
+		0xa9, 0x13, 0x92, 0x50, 0x91, 0xf4, 0x38, 0x22, 0x6a, 0x69, 0xdd, 0x60, 0x22, 0x2c, 0x0d, 0x45,
+		0x58, 0x5a, 0x27, 0x9e, 0x08, 0x4b, 0xd3, 0xe3, 0xc9, 0x50, 0x13, 0x8e, 0xbb, 0x20, 0x6c, 0xb1,
+		0xf6, 0x8c, 0x51, 0x20, 0xe7, 0xb9, 0x40, 0x5c, 0xa9, 0xb6, 0x50, 0x6d, 0xa6, 0x88, 0xf8, 0x35,
+		0x7a, 0x19, 0xa6, 0xbd, 0x0f, 0x6f, 0x9a, 0x36, 0xef, 0xb3, 0x87, 0xe8, 0x67, 0xf3, 0xe2, 0xcf,
+		0xae, 0x98, 0x76, 0x48, 0xea, 0xad, 0x27, 0xb4, 0x49, 0x22, 0x7a, 0x89, 0xbe, 0xa7, 0x40, 0x21,
+		0xa0, 0xa2, 0x7a, 0xad, 0x8c, 0x2b, 0x25, 0x1b, 0xbf, 0xdc, 0xc0, 0x0e, 0x77, 0xf6, 0x87, 0x29,
+		0x8c, 0x17, 0xe2, 0x35, 0x95, 0x4a, 0xd2, 0x7c, 0x41, 0x61, 0x5c, 0x73, 0xba, 0x34, 0x35, 0xfa,
+		0x91, 0x02, 0x97, 0x18, 0x26, 0x1f, 0xa2, 0x9c, 0x12, 0x8f, 0x53, 0xb4, 0x4b, 0x5c, 0xb4, 0xec,
+		0x6b, 0xde, 0xa7, 0x65, 0x34, 0x3a, 0x6f, 0x27, 0xe2, 0x40, 0x5f, 0x56, 0xe0, 0x2c, 0x6f, 0x79,
+		0x79, 0x40, 0x8f, 0x48, 0x6a, 0xf7, 0x12, 0x93, 0x10, 0xa3, 0xdd, 0x02, 0x32, 0xf4, 0x59, 0x38,
+		0xe1, 0x29, 0x99, 0x18, 0xc9, 0x04, 0x45, 0x72, 0x41, 0xac, 0x67, 0x62, 0x08, 0xd3, 0x24, 0xe2,
+		0x3d, 0xfa, 0x82, 0x02, 0xa7, 0xd9, 0xe6, 0x31, 0x45, 0x17, 0x6c, 0xda, 0x24, 0x45, 0xf0, 0x34,
+		0x17, 0x81, 0x27, 0xdc, 0xd3, 0x77, 0xc1, 0x36, 0xcd, 0x94, 0x63, 0x68, 0xd0, 0xe7, 0x60, 0xa6,
+		0xaa, 0xdb, 0xdb, 0xd8, 0x2e, 0xd9, 0xb8, 0x6c, 0xd9, 0x06, 0x0f, 0xc4, 0x51, 0x0a, 0x62, 0x91,
+		0x0b, 0xe2, 0x63, 0x94, 0x59, 0x63, 0xbc, 0x61, 0x04, 0xc7, 0xaa, 0x51, 0x04, 0xe8, 0x5b, 0x0a,
+		0xcc, 0xf3, 0xf2, 0x13, 0xf3, 0x41, 0x4d, 0xe7, 0x2e, 0xc8, 0x54, 0x92, 0xf0, 0xf5, 0x2e, 0x13,
+		0x23, 0x13, 0xbe, 0x0a, 0x68, 0xd1, 0x77, 0x15, 0xc8, 0x73, 0x10, 0x12, 0x6c, 0x57, 0xcd, 0x9a,
+		0xce, 0xf5, 0x0b, 0xd3, 0x11, 0x7e, 0x21, 0x1c, 0x62, 0xb7, 0x04, 0x71, 0xfc, 0x42, 0x53, 0x9a,
+		0x1a, 0xfd, 0x58, 0x81, 0x4b, 0xbc, 0x54, 0x2a, 0xd6, 0x8b, 0x1d, 0xa3, 0x68, 0x6f, 0x4a, 0x66,
+		0x54, 0x71, 0xae, 0xac, 0xd0, 0x4c, 0xc6, 0x22, 0xd2, 0x00, 0xb1, 0x51, 0x1e, 0x4f, 0xa2, 0x01,
+		0x62, 0x03, 0x9d, 0x6d, 0x4a, 0xd2, 0xa2, 0xbf, 0x2a, 0xb0, 0x1c, 0xf0, 0xb8, 0xf8, 0x21, 0xc1,
+		0x76, 0x4d, 0xaf, 0x94, 0x38, 0xc8, 0xcd, 0x9a, 0x49, 0x4c, 0xbe, 0x62, 0x9c, 0xa0, 0xd0, 0xef,
+		0xc6, 0xbb, 0xe0, 0x65, 0x26, 0x3f, 0x34, 0x9f, 0xa2, 0x2f, 0x3c, 0x3c, 0xa1, 0xe7, 0xed, 0x3d,
+		0x49, 0x40, 0x7f, 0x56, 0xe0, 0x46, 0x82, 0x69, 0x8a, 0x3c, 0xd6, 0x0c, 0x9d, 0xe3, 0xda, 0x1e,
+		0xe6, 0x28, 0x72, 0x66, 0xd7, 0xec, 0xde, 0xd9, 0xd1, 0x7b, 0x0a, 0x3c, 0x17, 0x35, 0x9d, 0x78,
+		0x3b, 0x39, 0x49, 0x27, 0xb6, 0xca, 0x9d, 0x98, 0x10, 0x4c, 0xac, 0xbd, 0x5c, 0xc1, 0xbd, 0xb1,
+		0xd2, 0x38, 0x80, 0x37, 0x0f, 0xab, 0x46, 0xcc, 0x5a, 0x03, 0x1b, 0x25, 0xdd, 0x29, 0xd5, 0x70,
+		0x33, 0x3c, 0x0f, 0x35, 0x22, 0x0e, 0x08, 0x83, 0xf0, 0xc5, 0x5d, 0x77, 0x6e, 0xe3, 0x66, 0x18,
+		0x7e, 0xbe, 0x99, 0x88, 0x03, 0xfd, 0x52, 0x81, 0xab, 0x34, 0x9a, 0x2c, 0x95, 0xb7, 0xcc, 0x8a,
+		0x91, 0xd0, 0x7e, 0x4e, 0x51, 0xe8, 0xb7, 0xb8, 0xd0, 0x69, 0x28, 0xb9, 0xe4, 0x0a, 0x4d, 0x62,
+		0x34, 0x17, 0x9d, 0xe4, 0x6c, 0xe8, 0x5d, 0x05, 0x2e, 0xc7, 0x4c, 0x42, 0x64, 0x1d, 0xa7, 0xe9,
+		0x0c, 0x96, 0x93, 0xce, 0x40, 0x64, 0x12, 0xe7, 0x9d, 0x84, 0x3c, 0xe8, 0x07, 0x0a, 0x5c, 0x10,
+		0xa2, 0x16, 0xc6, 0xf9, 0x4f, 0x52, 0xd8, 0xd7, 0xf9, 0x61, 0x08, 0xf7, 0xeb, 0xc2, 0xc0, 0x7f,
+		0xbe, 0x9c, 0x80, 0x1e, 0xbd, 0xa3, 0xc0, 0x45, 0x21, 0xdc, 0x88, 0x24, 0xf2, 0x4c, 0x84, 0x92,
+		0xf3, 0x01, 0x47, 0xa4, 0x93, 0xf9, 0x72, 0x22, 0x0e, 0xf4, 0x96, 0x02, 0xe7, 0x13, 0x6b, 0xc6,
+		0x59, 0x8a, 0xf8, 0xff, 0x13, 0x20, 0x16, 0x29, 0xc5, 0xb9, 0x72, 0x02, 0x7d, 0x78, 0x5b, 0x81,
+		0x45, 0xf1, 0x02, 0x0b, 0x0f, 0xe1, 0x59, 0x8a, 0xf6, 0x46, 0x92, 0xf5, 0x15, 0x9e, 0xc4, 0x0b,
+		0xe5, 0x24, 0x0c, 0xe8, 0x87, 0x51, 0x2a, 0x11, 0x91, 0x34, 0x3f, 0x95, 0x18, 0xb2, 0x38, 0x7d,
+		0x5e, 0x28, 0x27, 0x61, 0xa0, 0xb1, 0x99, 0x18, 0x72, 0x44, 0x24, 0x39, 0x17, 0x11, 0x9b, 0x09,
+		0x30, 0x47, 0x84, 0x93, 0x85, 0x72, 0x32, 0x16, 0x7a, 0x68, 0x7a, 0xa1, 0x78, 0xaf, 0x11, 0xcf,
+		0xb9, 0x88, 0x43, 0xd3, 0x8b, 0xb8, 0x7b, 0x09, 0x75, 0xae, 0x38, 0xbd, 0xb1, 0xa2, 0x5f, 0x29,
+		0xf0, 0x8c, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x4f, 0x67, 0x53, 0xec, 0x65, 0x36, 0x22, 0x63, 0xbd,
+		0xe4, 0xf4, 0xc0, 0x87, 0x7e, 0xaa, 0xc0, 0xd3, 0x51, 0x13, 0x10, 0xe7, 0x4f, 0x0b, 0x11, 0x07,
+		0x90, 0x10, 0x84, 0x38, 0x8f, 0x3a, 0x8f, 0x13, 0xf2, 0x50, 0x87, 0xd3, 0xa8, 0x3b, 0xd8, 0x26,
+		0x6d, 0xe0, 0x0e, 0xd6, 0xed, 0xf2, 0x56, 0x07, 0xcc, 0x30, 0xee, 0x7c, 0x84, 0xf5, 0xde, 0xa3,
+		0xe2, 0x7c, 0x04, 0x77, 0xa9, 0xb0, 0xf6, 0x17, 0x39, 0xd6, 0xdb, 0x48, 0xc2, 0x20, 0xca, 0xac,
+		0x1a, 0x75, 0x43, 0x27, 0x38, 0x2a, 0x62, 0x2c, 0x24, 0xc9, 0xac, 0xee, 0x51, 0x71, 0x89, 0x32,
+		0xab, 0x68, 0x96, 0x18, 0xdc, 0x11, 0x87, 0xe7, 0xf9, 0xe4, 0xb8, 0x23, 0x4e, 0xcf, 0x42, 0x33,
+		0x19, 0x8b, 0xa8, 0xde, 0x56, 0xd7, 0x1b, 0x0e, 0x0f, 0xed, 0x85, 0x24, 0xf5, 0xb6, 0x35, 0x2a,
+		0x44, 0xa6, 0xde, 0xc6, 0xa5, 0x14, 0x65, 0xab, 0x8d, 0x9a, 0x08, 0xdd, 0x62, 0x92, 0x6c, 0xf5,
+		0x5e, 0xad, 0xce, 0xfb, 0x2a, 0x37, 0x5b, 0x15, 0xd0, 0xde, 0x18, 0x02, 0x68, 0x7f, 0x5e, 0xfd,
+		0xed, 0x10, 0x9c, 0x95, 0x8d, 0xb5, 0x56, 0xe0, 0x40, 0x6b, 0x6a, 0x64, 0xb7, 0x8e, 0x69, 0xe5,
+		0x5a, 0x54, 0x07, 0xf7, 0x85, 0xae, 0xef, 0xd6, 0xb1, 0x36, 0xd4, 0xec, 0x78, 0x42, 0x2f, 0xc1,
+		0xe1, 0xba, 0x6e, 0xbb, 0xeb, 0xd0, 0x79, 0x44, 0x6c, 0x5a, 0xac, 0xd8, 0x3d, 0xcb, 0x95, 0xb7,
+		0x46, 0x39, 0x3a, 0x3c, 0xf8, 0xa6, 0xa5, 0x8d, 0xd5, 0xc3, 0x83, 0xe8, 0x19, 0xc8, 0xd2, 0xfb,
+		0xc3, 0x8a, 0xe9, 0x10, 0x5a, 0x06, 0xcf, 0x2d, 0x1e, 0xe3, 0x5f, 0xd0, 0xe9, 0xce, 0xf6, 0xaa,
+		0xe9, 0x10, 0x6d, 0x90, 0xb0, 0xbf, 0xd0, 0x22, 0xf4, 0x9b, 0xb5, 0x7a, 0x83, 0xd0, 0x22, 0x79,
+		0x6e, 0x71, 0x5a, 0x80, 0x64, 0xb7, 0x62, 0xe9, 0x86, 0xe6, 0x91, 0x22, 0x1d, 0x66, 0x02, 0x01,
+		0x72, 0x89, 0x58, 0xa5, 0x72, 0xc5, 0x72, 0x30, 0x8d, 0x36, 0xac, 0x06, 0x61, 0x55, 0xf3, 0xc9,
+		0x50, 0x15, 0xff, 0x26, 0xeb, 0x7b, 0xd0, 0xa6, 0x71, 0xd7, 0xda, 0xaf, 0x5b, 0x4b, 0x2e, 0xff,
+		0xba, 0xc7, 0x8e, 0x5e, 0x84, 0xa9, 0x76, 0x91, 0x26, 0x2c, 0x3d, 0x13, 0x27, 0xfd, 0x08, 0xf1,
+		0x4b, 0x2f, 0x01, 0xc1, 0xd7, 0xe0, 0x68, 0x3b, 0x1f, 0x6c, 0xcf, 0xc2, 0x6e, 0xd4, 0xdc, 0x4e,
+		0x01, 0xb7, 0x50, 0x9d, 0xd5, 0x8e, 0xb4, 0x28, 0x5a, 0xeb, 0xac, 0x35, 0x6a, 0x45, 0x03, 0x15,
+		0x21, 0xcb, 0x0e, 0x76, 0xcb, 0xa6, 0x55, 0xe3, 0xe1, 0xc5, 0x73, 0xfc, 0x40, 0x84, 0x09, 0xa0,
+		0x09, 0x5f, 0xd1, 0x67, 0xd1, 0xda, 0xdc, 0xa8, 0x08, 0xa3, 0x6d, 0x1c, 0xee, 0xe1, 0xda, 0xb0,
+		0xf1, 0x44, 0x36, 0x62, 0x0f, 0x56, 0x3c, 0x1a, 0x6d, 0xa4, 0xc5, 0xc6, 0x46, 0x90, 0x06, 0xe3,
+		0x15, 0xdd, 0xbd, 0xa1, 0xf0, 0xbc, 0x07, 0x9d, 0x0e, 0x76, 0x1a, 0x15, 0x32, 0x01, 0x11, 0xf2,
+		0xfc, 0x3d, 0x3d, 0xe4, 0xf2, 0x2e, 0xb5, 0x58, 0x35, 0xca, 0x89, 0xae, 0xc2, 0xa4, 0x65, 0x9b,
+		0x0f, 0x4c, 0x2f, 0x2c, 0x08, 0xac, 0x52, 0x8e, 0xae, 0xd2, 0xb8, 0x4f, 0x10, 0x58, 0xa4, 0xa3,
+		0x30, 0x68, 0x1a, 0xb8, 0x46, 0x4c, 0xb2, 0x4b, 0xeb, 0x9f, 0x59, 0xad, 0xf5, 0x8c, 0x2e, 0xc2,
+		0xf8, 0xa6, 0x69, 0x3b, 0x24, 0x2c, 0xf3, 0x00, 0xa5, 0x1c, 0xa3, 0x6f, 0x03, 0x02, 0x97, 0x60,
+		0xc8, 0xc6, 0xc4, 0xde, 0x2d, 0xd5, 0xad, 0x8a, 0x59, 0xde, 0x65, 0x35, 0xc3, 0x19, 0xc1, 0x75,
+		0x0a, 0xb1, 0x77, 0xd7, 0x28, 0x9d, 0x96, 0xb3, 0xdb, 0x0f, 0x6e, 0xa3, 0x88, 0x4e, 0x08, 0xae,
+		0xd6, 0x09, 0xad, 0xef, 0xf5, 0x6b, 0xfe, 0x23, 0x5a, 0x82, 0x83, 0xf8, 0x61, 0xdd, 0xf4, 0x14,
+		0xc7, 0x6b, 0x41, 0x19, 0x89, 0x6d, 0x41, 0x19, 0x6e, 0xb3, 0xb8, 0x83, 0xe8, 0x14, 0x1c, 0x28,
+		0xdb, 0xae, 0x35, 0xb0, 0xfa, 0x23, 0xad, 0x8f, 0x65, 0xb5, 0x21, 0x77, 0xd0, 0xaf, 0x49, 0xa2,
+		0x8f, 0xc3, 0x94, 0x37, 0xfb, 0xee, 0x5a, 0xed, 0x86, 0x5e, 0xde, 0xb6, 0x36, 0x37, 0x27, 0x50,
+		0x9c, 0x52, 0x4f, 0x50, 0xee, 0xce, 0x32, 0xed, 0x0d, 0x8f, 0x15, 0x2d, 0x40, 0x5f, 0x15, 0x57,
+		0x2d, 0x56, 0x7c, 0x9a, 0xe4, 0x5f, 0x4b, 0xe3, 0xaa, 0xa5, 0x51, 0x32, 0xa4, 0xc1, 0x68, 0x28,
+		0xbe, 0x60, 0x15, 0xa4, 0x27, 0xf9, 0x91, 0x5c, 0x20, 0x1e, 0xd0, 0x46, 0x9c, 0xc0, 0x08, 0xba,
+		0x07, 0xe3, 0x75, 0x1b, 0xef, 0x94, 0xf4, 0x06, 0xb1, 0x5c, 0xfd, 0xc3, 0xa4, 0x54, 0xb7, 0xcc,
+		0x1a, 0xf1, 0x6b, 0x42, 0xa2, 0xfd, 0x72, 0x30, 0x59, 0xa3, 0x74, 0xda, 0x98, 0xcb, 0x7f, 0xbd,
+		0x41, 0xac, 0x8e, 0x41, 0x74, 0x11, 0x32, 0x5b, 0x58, 0x37, 0xb0, 0xcd, 0x8a, 0x35, 0x53, 0xfc,
+		0x16, 0x24, 0x4a, 0xa2, 0x31, 0x52, 0xb4, 0x0a, 0x87, 0xbc, 0x85, 0x6e, 0x57, 0x9e, 0xe9, 0xbe,
+		0x1e, 0x89, 0xdd, 0x57, 0x44, 0xf9, 0x5a, 0x55, 0x64, 0xba, 0xb7, 0x47, 0x61, 0xb0, 0x6e, 0x9b,
+		0x96, 0xed, 0x2a, 0xf4, 0x04, 0xd5, 0x9d, 0xd6, 0xb3, 0xfa, 0x96, 0x02, 0x4f, 0xc9, 0x67, 0xc1,
+		0x97, 0x20, 0xc3, 0x2c, 0x53, 0x91, 0xb0, 0x4c, 0x46, 0x8b, 0x56, 0x60, 0x26, 0xba, 0x0d, 0xc2,
+		0x34, 0xe8, 0x39, 0x92, 0xd6, 0xa6, 0xc5, 0x1d, 0x0c, 0x45, 0x43, 0x7d, 0x53, 0x81, 0x33, 0x92,
+		0xc1, 0xf4, 0x65, 0x18, 0xf0, 0x7d, 0x92, 0x22, 0xe1, 0x93, 0x7c, 0xe2, 0x7d, 0x83, 0x6a, 0xc1,
+		0xac, 0x74, 0x26, 0xb9, 0x04, 0x43, 0xec, 0x58, 0x68, 0x1f, 0xd1, 0xc3, 0x02, 0x75, 0x63, 0xa7,
+		0x00, 0x3d, 0xa1, 0x73, 0xa4, 0xfd, 0xa0, 0xfe, 0x5e, 0x81, 0xd3, 0x32, 0xcd, 0x34, 0xdd, 0x67,
+		0xad, 0x92, 0xec, 0xac, 0xbd, 0x0d, 0xe3, 0x82, 0xf3, 0x2c, 0x15, 0x67, 0xfa, 0x63, 0x0e, 0xe7,
+		0x2c, 0xeb, 0xf0, 0x69, 0xe9, 0x2e, 0x9f, 0xa6, 0xbe, 0xaa, 0x80, 0x1a, 0xdf, 0x87, 0x83, 0xe6,
+		0x01, 0x05, 0x7b, 0x33, 0x5a, 0xdd, 0x79, 0x23, 0x4e, 0xd7, 0x12, 0x04, 0x1c, 0x7b, 0x2a, 0xe0,
+		0xd8, 0x8f, 0x01, 0xf8, 0x17, 0xe5, 0xa6, 0x41, 0xd1, 0x64, 0xb5, 0x2c, 0x1b, 0x29, 0x1a, 0xea,
+		0x3f, 0x02, 0xcb, 0x2b, 0xb4, 0x90, 0x64, 0x88, 0x66, 0x61, 0xa4, 0xfb, 0x7e, 0xae, 0xa5, 0x5e,
+		0xc3, 0x4e, 0xc7, 0x8c, 0x03, 0xd8, 0xd3, 0x01, 0xec, 0x67, 0xe1, 0xe0, 0x86, 0x59, 0xd3, 0xed,
+		0xdd, 0x52, 0x79, 0x0b, 0x97, 0xb7, 0x9d, 0x46, 0x95, 0x06, 0x43, 0x59, 0x6d, 0xd8, 0x1b, 0x5e,
+		0x62, 0xa3, 0xe8, 0x1c, 0x8c, 0x76, 0xdf, 0x2a, 0xe3, 0x87, 0x5e, 0xa0, 0x33, 0xa4, 0x8d, 0xe0,
+		0xce, 0xcb, 0x5e, 0xfc, 0x90, 0xa8, 0xaf, 0xa4, 0xe1, 0x94, 0x44, 0x8b, 0xcf, 0x23, 0x9b, 0x71,
+		0xd0, 0x2c, 0xd2, 0x3d, 0x98, 0x05, 0x3a, 0x0e, 0xb9, 0x0d, 0xdd, 0xc1, 0xfe, 0x21, 0xed, 0x2d,
+		0x4b, 0xd6, 0x1d, 0xf2, 0x8e, 0xe6, 0x69, 0x00, 0xf7, 0x42, 0x9d, 0xbd, 0xee, 0xf7, 0x16, 0xb6,
+		0x86, 0x9b, 0xde, 0xdb, 0x79, 0x40, 0x9b, 0x96, 0xbd, 0xcd, 0x90, 0xfa, 0x7d, 0x9a, 0x19, 0x6f,
+		0x6a, 0xee, 0x1b, 0x8a, 0xf5, 0xbe, 0x37, 0x8e, 0xc6, 0x5d, 0xe7, 0xa8, 0x3b, 0x56, 0x8d, 0x45,
+		0x61, 0xec, 0x09, 0xdd, 0x84, 0xfe, 0xb2, 0x1b, 0xd6, 0xb3, 0x80, 0x2b, 0x2f, 0xdd, 0x4c, 0xb5,
+		0xe4, 0x72, 0x69, 0x1e, 0xb3, 0xfa, 0x66, 0x1a, 0x4e, 0xc6, 0x36, 0x38, 0x3d, 0xb2, 0xcd, 0xb8,
+		0xe1, 0xcf, 0xc1, 0xdb, 0x85, 0x79, 0xc9, 0xfe, 0xab, 0xce, 0x19, 0x74, 0xfa, 0xe4, 0xbe, 0x24,
+		0x3e, 0xb9, 0x53, 0xf5, 0xfb, 0x03, 0xaa, 0x1f, 0xd8, 0xdf, 0x4c, 0xf4, 0xfe, 0x0e, 0x48, 0xed,
+		0xef, 0xa0, 0x60, 0x7f, 0x39, 0x66, 0x96, 0xe5, 0x99, 0x99, 0xfa, 0x6e, 0x06, 0x4e, 0xcb, 0xf4,
+		0x7e, 0xa1, 0x13, 0x90, 0x6b, 0x35, 0x50, 0xb0, 0x6d, 0xca, 0x6a, 0xe0, 0x0f, 0x15, 0x0d, 0x37,
+		0x7d, 0x6b, 0x11, 0x50, 0x23, 0x48, 0x45, 0xa4, 0x6f, 0xad, 0x4f, 0xd2, 0xf4, 0x4d, 0xef, 0x78,
+		0x72, 0x55, 0xd3, 0xb0, 0xaa, 0xba, 0x59, 0x63, 0xbe, 0x83, 0x3d, 0x75, 0x1f, 0x06, 0x7d, 0x3d,
+		0x26, 0x5e, 0x19, 0xf9, 0xc4, 0x6b, 0x1d, 0x26, 0x7d, 0x25, 0x0c, 0x9f, 0x21, 0x03, 0x71, 0x67,
+		0xc8, 0xb8, 0xcf, 0x1b, 0x38, 0x46, 0x02, 0x52, 0xd9, 0x11, 0xc5, 0xa4, 0x0e, 0x26, 0x90, 0xea,
+		0xe5, 0x5b, 0x4c, 0xaa, 0xf8, 0xb0, 0xcb, 0xf6, 0x74, 0xd8, 0xad, 0xc0, 0xe8, 0x16, 0xd6, 0x6d,
+		0xb2, 0x81, 0xf5, 0x36, 0x3a, 0x88, 0x13, 0x35, 0xd2, 0xe2, 0x69, 0xcb, 0x89, 0x0f, 0x51, 0x72,
+		0xf1, 0x21, 0x4a, 0x28, 0x2b, 0x19, 0xea, 0x25, 0x2b, 0x69, 0x47, 0xb7, 0x07, 0xe4, 0xa3, 0xdb,
+		0xce, 0x78, 0x74, 0x38, 0x10, 0x8f, 0xfe, 0x4d, 0x01, 0x35, 0xbe, 0x47, 0xf1, 0x03, 0x3b, 0xf8,
+		0x3b, 0x43, 0x94, 0xbe, 0xee, 0xb4, 0xeb, 0x05, 0x18, 0xa2, 0x59, 0xab, 0xef, 0xd3, 0xfa, 0x25,
+		0x7c, 0x5a, 0xce, 0xe5, 0x60, 0x0f, 0xea, 0x1f, 0x95, 0x6e, 0x37, 0xb1, 0xcf, 0x51, 0x37, 0x7f,
+		0x89, 0x52, 0x09, 0x8e, 0x82, 0x74, 0x6c, 0x24, 0xd2, 0xd7, 0xbd, 0x98, 0xea, 0x1f, 0x14, 0x38,
+		0x19, 0xdf, 0x38, 0xd6, 0x6b, 0x70, 0xfe, 0x61, 0xcc, 0xe8, 0x67, 0x29, 0x38, 0x25, 0xd1, 0x7e,
+		0xe9, 0xce, 0xc9, 0xc0, 0x44, 0x37, 0x2b, 0x8e, 0xd4, 0x26, 0xf9, 0xc4, 0x8f, 0x6c, 0x4e, 0xc1,
+		0xe8, 0xa9, 0xaf, 0x97, 0xe8, 0x69, 0xcf, 0x2a, 0xfe, 0x15, 0x05, 0xe6, 0xe4, 0xbb, 0x26, 0x65,
+		0xce, 0xc3, 0xfd, 0x49, 0xcf, 0xde, 0x56, 0x20, 0x61, 0x7f, 0x64, 0x3c, 0xb6, 0x43, 0x7e, 0x88,
+		0xe4, 0x79, 0x18, 0xef, 0x41, 0x0a, 0x71, 0x5a, 0x02, 0xf1, 0x1b, 0x01, 0x3d, 0x14, 0x55, 0x52,
+		0x7b, 0xd5, 0xc3, 0x15, 0x98, 0xa9, 0xe8, 0xa4, 0xa3, 0x4f, 0x28, 0x58, 0x03, 0x69, 0xaf, 0xac,
+		0x47, 0xc7, 0xdb, 0x4a, 0x2f, 0xa4, 0xe2, 0xe8, 0x73, 0x3a, 0x81, 0x3e, 0xf7, 0xc5, 0xda, 0x68,
+		0x20, 0x08, 0x54, 0xdf, 0x53, 0x60, 0x2a, 0xa2, 0x33, 0xd9, 0xfd, 0xe5, 0x96, 0xd7, 0x91, 0xd9,
+		0xda, 0xb7, 0x01, 0xfa, 0x5c, 0x34, 0xd0, 0x2a, 0x1c, 0x6e, 0x1d, 0xf2, 0x9b, 0xa6, 0x9d, 0x20,
+		0xa1, 0x45, 0xec, 0x8c, 0x77, 0x3b, 0x8f, 0x93, 0x1c, 0xcd, 0x32, 0x9b, 0xfd, 0x69, 0x98, 0x14,
+		0xb6, 0x3c, 0x47, 0xcd, 0x46, 0x3a, 0x9e, 0x57, 0x7f, 0xad, 0xc0, 0x74, 0x54, 0xb7, 0xeb, 0xbe,
+		0x7c, 0x65, 0xbf, 0xd6, 0x23, 0xd2, 0x41, 0xff, 0x44, 0x81, 0x99, 0xb8, 0xae, 0xd9, 0xa8, 0xd9,
+		0x3c, 0x52, 0xb3, 0x8d, 0x44, 0xfe, 0xef, 0x01, 0x48, 0xd8, 0x9c, 0x85, 0x0a, 0x70, 0x88, 0xf6,
+		0x7f, 0x05, 0x2f, 0x9f, 0xbd, 0x39, 0x8d, 0xd6, 0x70, 0x33, 0x70, 0xf5, 0x1c, 0xaa, 0xff, 0xa4,
+		0x7a, 0xab, 0xff, 0x3c, 0xae, 0xd0, 0xc8, 0x57, 0x68, 0x64, 0x74, 0x67, 0x40, 0x42, 0x77, 0xee,
+		0xc0, 0x38, 0xbb, 0x59, 0x67, 0x18, 0xcd, 0x1a, 0xc1, 0xf6, 0x8e, 0x5e, 0x89, 0xcf, 0x69, 0x0e,
+		0x31, 0x46, 0x0a, 0xaf, 0xc8, 0xd8, 0xba, 0xab, 0x3f, 0xd9, 0x3d, 0x55, 0x7f, 0x3a, 0x42, 0x38,
+		0x48, 0x12, 0xc2, 0x89, 0x4b, 0x3d, 0xb9, 0x9e, 0x4b, 0x3d, 0xed, 0x1c, 0x64, 0x48, 0x3e, 0x07,
+		0xf1, 0x0b, 0x0e, 0x07, 0xf6, 0x50, 0x70, 0x18, 0xde, 0x53, 0xc1, 0xc1, 0xf5, 0xc1, 0x85, 0xa4,
+		0x1d, 0xa2, 0x2d, 0x6f, 0xa5, 0x74, 0x7a, 0xab, 0xa8, 0xfc, 0x66, 0x03, 0x8e, 0xb4, 0xba, 0x4a,
+		0x02, 0xb5, 0x5b, 0xcf, 0x8e, 0xe7, 0x22, 0xfb, 0x46, 0xba, 0xab, 0xb7, 0x87, 0x31, 0x6f, 0x58,
+		0xfd, 0xbe, 0x02, 0xb3, 0x82, 0x99, 0xf0, 0x4a, 0xd2, 0xf1, 0xe6, 0xa1, 0x48, 0x98, 0x47, 0x47,
+		0xa4, 0x93, 0x4a, 0x10, 0xe9, 0xa8, 0xef, 0x2b, 0x70, 0x2c, 0xf2, 0x17, 0x0e, 0x6e, 0xa8, 0xc7,
+		0x7e, 0x3f, 0x51, 0xd3, 0xab, 0xfe, 0x52, 0x83, 0x37, 0x74, 0x5b, 0xaf, 0xe2, 0x5e, 0x3f, 0xbd,
+		0x6f, 0xa7, 0x4a, 0x5b, 0xe3, 0xfb, 0xa4, 0x35, 0x5e, 0xfd, 0x3a, 0x6f, 0x93, 0x44, 0x1d, 0x3d,
+		0x27, 0x20, 0xc7, 0x7a, 0xaa, 0x3a, 0x97, 0xc0, 0x1b, 0xa2, 0x4b, 0xd0, 0x72, 0xea, 0x29, 0x79,
+		0xa7, 0x1e, 0x71, 0x87, 0xad, 0x7e, 0x4d, 0x81, 0xb9, 0x04, 0x5d, 0x6c, 0xed, 0xbb, 0x56, 0xa5,
+		0xeb, 0xae, 0xb5, 0xd7, 0x9d, 0x89, 0x82, 0xf6, 0x8b, 0x14, 0x3c, 0xbf, 0xb7, 0x4e, 0xfe, 0x7d,
+		0xd3, 0xf9, 0xf6, 0x3d, 0x5e, 0xaa, 0xeb, 0x1e, 0xef, 0x1e, 0xa0, 0x70, 0x07, 0x0b, 0xb3, 0xef,
+		0x33, 0x72, 0x7d, 0x2a, 0xda, 0x68, 0xa8, 0x1d, 0xc5, 0xbd, 0xfc, 0x28, 0x5b, 0x35, 0x62, 0x5b,
+		0x15, 0xaa, 0x68, 0x43, 0x9a, 0xff, 0x88, 0xf2, 0x30, 0x16, 0x68, 0x7e, 0xb4, 0x6a, 0x15, 0x2f,
+		0x32, 0x1f, 0xd4, 0x46, 0xbb, 0x7a, 0x12, 0xef, 0xd4, 0x2a, 0xbb, 0xea, 0xeb, 0x69, 0xb8, 0xb6,
+		0x87, 0x5f, 0x0a, 0xa0, 0x7b, 0x9d, 0x7e, 0x6f, 0x58, 0xf0, 0x3b, 0x1c, 0x29, 0xc9, 0x5d, 0x57,
+		0xd2, 0xfb, 0x94, 0x4f, 0x0a, 0xef, 0x57, 0xf9, 0xfb, 0xd2, 0xb7, 0xd7, 0x7d, 0x99, 0x07, 0x14,
+		0xec, 0xcf, 0x64, 0xd5, 0x8b, 0xb4, 0x36, 0x62, 0x76, 0x29, 0xa1, 0x77, 0x85, 0xe5, 0xef, 0x62,
+		0xa6, 0x6b, 0x17, 0xd5, 0x3f, 0x29, 0x70, 0xa5, 0xc7, 0x9f, 0x39, 0x08, 0x30, 0x28, 0x02, 0x0c,
+		0x1f, 0xac, 0xe2, 0xaa, 0x5f, 0x4a, 0xc3, 0x95, 0x1e, 0x5b, 0x51, 0xff, 0x57, 0x6d, 0x35, 0xe0,
+		0xb1, 0xfb, 0xc4, 0x1e, 0xbb, 0x5f, 0xde, 0x63, 0x0b, 0x55, 0x47, 0xe4, 0x00, 0x06, 0x44, 0x0e,
+		0xe0, 0x95, 0x34, 0x5c, 0xea, 0xa5, 0x9d, 0x56, 0xce, 0xf2, 0xa5, 0x24, 0x3f, 0xb6, 0xfc, 0xb6,
+		0xe5, 0xff, 0x5d, 0x81, 0xf3, 0x49, 0x5b, 0x83, 0xff, 0xab, 0x4d, 0x5e, 0x7c, 0x56, 0xa9, 0xbf,
+		0x53, 0x60, 0x21, 0x51, 0x3b, 0xf1, 0xbe, 0xb9, 0x00, 0x6e, 0xd6, 0x90, 0xda, 0x5b, 0xd6, 0xf0,
+		0x17, 0x5e, 0xd6, 0x10, 0xd3, 0x35, 0x3c, 0x05, 0x59, 0xd6, 0x21, 0xdc, 0xba, 0x2b, 0x18, 0xf4,
+		0x06, 0x8a, 0x86, 0xeb, 0x38, 0xd8, 0x4b, 0xea, 0x38, 0xbc, 0xcd, 0x02, 0x6f, 0xa8, 0xdb, 0x71,
+		0xa4, 0x7b, 0x0b, 0xf5, 0xfa, 0x22, 0x2b, 0x2e, 0xfd, 0xc1, 0x56, 0x8b, 0x77, 0x52, 0xc2, 0x09,
+		0x0a, 0x2b, 0x24, 0x91, 0x13, 0x9c, 0x07, 0x24, 0xbc, 0xcc, 0x1c, 0xb1, 0x83, 0x17, 0x98, 0xfb,
+		0x15, 0xa3, 0xb7, 0x8b, 0x36, 0x7d, 0x09, 0x8a, 0x36, 0x1d, 0x79, 0x75, 0x7f, 0x82, 0xbc, 0x5a,
+		0x7d, 0x89, 0xd3, 0x19, 0xc5, 0xef, 0x76, 0x16, 0x45, 0xce, 0x11, 0x39, 0xa4, 0xfa, 0x29, 0x4e,
+		0xe6, 0x20, 0xe8, 0x55, 0xee, 0x49, 0xfe, 0x37, 0xb3, 0x70, 0xb1, 0x87, 0xdf, 0xfa, 0x75, 0xb8,
+		0x18, 0xa5, 0xcb, 0xc5, 0x9c, 0x80, 0x5c, 0xcb, 0xc5, 0xb0, 0xad, 0xce, 0x6a, 0xe0, 0x0f, 0xf1,
+		0xae, 0xc5, 0xd2, 0xfb, 0x70, 0x2d, 0xd6, 0x6b, 0xfd, 0xbc, 0x7f, 0x7f, 0xaf, 0xc5, 0x32, 0x8f,
+		0xf4, 0x5a, 0x6c, 0xa0, 0xe7, 0x6b, 0xb1, 0xfb, 0xc0, 0x7a, 0xbf, 0x99, 0x44, 0x56, 0x76, 0xf6,
+		0x9a, 0x62, 0xce, 0x44, 0x34, 0x90, 0x53, 0x29, 0xac, 0xf8, 0x3c, 0x5a, 0x0f, 0x0e, 0x75, 0x3a,
+		0xfe, 0x6c, 0x77, 0x8c, 0x22, 0x63, 0xca, 0x20, 0x61, 0xca, 0x65, 0x98, 0xe8, 0x50, 0xa7, 0x92,
+		0x8d, 0x1b, 0x6d, 0xf8, 0x39, 0x0a, 0x7f, 0x2e, 0x52, 0x71, 0x8a, 0x86, 0x86, 0x1b, 0x3e, 0x5e,
+		0xed, 0x70, 0x93, 0x37, 0x1c, 0x2a, 0xc7, 0x1f, 0xe8, 0xa5, 0x1c, 0x1f, 0xea, 0xe2, 0x1d, 0xe6,
+		0x74, 0xf1, 0xb6, 0x6f, 0x0f, 0x0e, 0x26, 0xbf, 0x2f, 0x1b, 0xd9, 0xc3, 0x7d, 0xd9, 0xe8, 0xde,
+		0x1a, 0x74, 0x9f, 0x81, 0x9c, 0x81, 0x2b, 0xfa, 0xae, 0xa7, 0x9a, 0xf1, 0xdd, 0xc6, 0x40, 0xa9,
+		0xa9, 0x2a, 0xa2, 0x67, 0x61, 0xe8, 0x33, 0x26, 0x21, 0xfe, 0xff, 0xbd, 0x99, 0x18, 0x8b, 0x63,
+		0xce, 0x79, 0xe4, 0x94, 0x5b, 0x7d, 0x2d, 0x0d, 0xe7, 0x93, 0xfe, 0x92, 0xf7, 0xc3, 0x77, 0x4e,
+		0xab, 0x7e, 0xe4, 0xec, 0xd5, 0x7e, 0x2f, 0x27, 0xfe, 0x19, 0x6a, 0x57, 0xc0, 0xdc, 0x61, 0x66,
+		0xfd, 0xdd, 0x66, 0xc6, 0x0f, 0x0b, 0x33, 0x82, 0xb0, 0x70, 0x9f, 0x6e, 0xc7, 0xd5, 0xdf, 0xa4,
+		0x60, 0x3e, 0xc9, 0xcf, 0x94, 0x85, 0xfb, 0xc1, 0x8f, 0x47, 0x53, 0x7b, 0x8d, 0x47, 0xf7, 0x6b,
+		0x17, 0xf9, 0xab, 0xdb, 0x27, 0x58, 0xdd, 0xb6, 0x6d, 0xf7, 0xcb, 0xdf, 0x0c, 0xbe, 0x9f, 0x82,
+		0x84, 0x3f, 0xa0, 0xfe, 0x68, 0x2c, 0x26, 0xaf, 0xd0, 0xd9, 0xcf, 0x2d, 0x74, 0xb6, 0x83, 0xbd,
+		0x8c, 0x7c, 0xb0, 0xa7, 0xfe, 0x33, 0x05, 0xe7, 0xf6, 0xc3, 0xa3, 0x7c, 0x44, 0x17, 0xbd, 0x23,
+		0x56, 0xce, 0x24, 0x89, 0x95, 0xff, 0x95, 0x82, 0x85, 0x44, 0xbf, 0x67, 0x7f, 0xbc, 0xf0, 0xa1,
+		0x85, 0xf7, 0x2f, 0xd9, 0x33, 0x49, 0x2a, 0x2f, 0x9f, 0x4f, 0x8b, 0x16, 0x5e, 0xd4, 0x55, 0xf5,
+		0x78, 0xe1, 0x23, 0x9b, 0xba, 0x32, 0xbd, 0xfc, 0x52, 0xe4, 0xe7, 0x29, 0x28, 0x24, 0xfc, 0x3f,
+		0x03, 0x8f, 0xf7, 0xa1, 0x6b, 0x1f, 0xe6, 0x08, 0x1c, 0xa4, 0x7f, 0xae, 0x98, 0x15, 0x82, 0x6d,
+		0xfa, 0xa9, 0x63, 0x30, 0xb9, 0x7c, 0x7f, 0xf9, 0xf6, 0x7a, 0x69, 0xa5, 0xb8, 0xba, 0xbe, 0xac,
+		0x95, 0xd6, 0x3f, 0xb1, 0xb6, 0x5c, 0x2a, 0xde, 0xbe, 0x7f, 0x7d, 0xb5, 0x78, 0x73, 0xe4, 0x09,
+		0x74, 0x02, 0xa6, 0xc2, 0xaf, 0xaf, 0xaf, 0xae, 0x96, 0xe8, 0xe8, 0x88, 0x82, 0x4e, 0xc2, 0xb1,
+		0x30, 0xc1, 0xd2, 0xea, 0x9d, 0xbb, 0xcb, 0x8c, 0x24, 0x75, 0xe3, 0x25, 0x38, 0x52, 0xb6, 0xaa,
+		0xbc, 0x35, 0xb8, 0xe1, 0xff, 0xa7, 0xea, 0x35, 0xdb, 0x22, 0xd6, 0x9a, 0xf2, 0xc9, 0x0b, 0x0f,
+		0x4c, 0xb2, 0xd5, 0xd8, 0xc8, 0x97, 0xad, 0x6a, 0xa1, 0xf3, 0x3f, 0x66, 0x2f, 0x98, 0x46, 0xa5,
+		0xf0, 0xc0, 0xf2, 0xfe, 0x4b, 0x37, 0xfb, 0xf7, 0xd9, 0xd7, 0xf4, 0xba, 0xb9, 0x73, 0x61, 0x23,
+		0x43, 0xc7, 0x2e, 0xfe, 0x67, 0x00, 0x78, 0x26, 0xc1, 0x73, 0x21, 0x5c, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0xe8, 0xd9, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xf3, 0x74, 0x51,
-		0x04, 0xc5, 0x26, 0xc1, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0xb1, 0x83, 0x55, 0xb0, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x9b, 0xd0, 0x0f, 0x05, 0x92, 0x72, 0xe2, 0x17, 0xd9,
-		0xc3, 0xec, 0x89, 0xf6, 0x18, 0x03, 0x29, 0xd9, 0xf3, 0x12, 0x6f, 0x77, 0xe4, 0xf9, 0xce, 0x77,
-		0x7e, 0x3e, 0x9e, 0x43, 0xe0, 0x54, 0x31, 0x15, 0x5e, 0x82, 0x09, 0x2d, 0x12, 0xea, 0xe1, 0x92,
-		0x79, 0xeb, 0xa1, 0xa7, 0xb0, 0x4c, 0x33, 0x26, 0x95, 0x5b, 0x0a, 0xae, 0x38, 0xfc, 0x42, 0xfb,
-		0xb8, 0x8d, 0x8f, 0x8b, 0x4b, 0xe6, 0xae, 0x87, 0xfd, 0xaf, 0x97, 0x9c, 0x2f, 0x33, 0xea, 0x19,
-		0x97, 0xb8, 0xfa, 0xe8, 0x91, 0x4a, 0x60, 0xc5, 0x78, 0x51, 0x93, 0xfa, 0xdf, 0x3c, 0xc4, 0x15,
-		0xcb, 0xa9, 0x54, 0x38, 0x2f, 0x1b, 0x87, 0x47, 0x01, 0xee, 0x04, 0x2e, 0x4b, 0x2a, 0x64, 0x8d,
-		0x3b, 0x1f, 0xc0, 0x49, 0x84, 0x65, 0x3a, 0x63, 0x52, 0x41, 0x08, 0x8e, 0x0b, 0x9c, 0xd3, 0x33,
-		0x6b, 0x60, 0x9d, 0x9f, 0x06, 0xe6, 0x0c, 0x7f, 0x04, 0xc7, 0x29, 0x2b, 0xc8, 0xd9, 0xd1, 0xc0,
-		0x3a, 0xef, 0x5e, 0x7c, 0xeb, 0x1e, 0x28, 0xd2, 0xdd, 0x06, 0x98, 0xb2, 0x82, 0x04, 0xc6, 0xdd,
-		0xc1, 0xc0, 0xde, 0x5a, 0xaf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xaf, 0xc1, 0x97, 0x39, 0xbe, 0x47,
-		0xba, 0x6d, 0x89, 0x4a, 0x2a, 0x90, 0xa4, 0x09, 0x2f, 0x88, 0x49, 0xd7, 0xbe, 0xf8, 0xca, 0xad,
-		0x2b, 0x75, 0xb7, 0x95, 0xba, 0x13, 0x5e, 0xc5, 0x19, 0xbd, 0xc1, 0x59, 0x45, 0x83, 0xcf, 0x73,
-		0x7c, 0xaf, 0x03, 0xca, 0x05, 0x15, 0xa1, 0xa1, 0x39, 0x1f, 0x40, 0x6f, 0x9b, 0x62, 0x81, 0x85,
-		0x62, 0x5a, 0x95, 0x5d, 0x2e, 0x1b, 0xb4, 0x52, 0xba, 0x69, 0x3a, 0xd1, 0x47, 0xf8, 0x06, 0x3c,
-		0xe3, 0x77, 0x05, 0x15, 0x68, 0xc5, 0xa5, 0x42, 0xa6, 0xcf, 0x23, 0x83, 0x76, 0x8c, 0xf9, 0x1d,
-		0x97, 0x6a, 0x8e, 0x73, 0xea, 0xfc, 0x65, 0x81, 0xee, 0x36, 0x6e, 0xa8, 0xb0, 0xaa, 0x24, 0xfc,
-		0x0e, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x97, 0x28, 0xe1, 0x55, 0xa1, 0xd0, 0x8a, 0x15, 0xca, 0xc4,
-		0x6e, 0x05, 0x76, 0x83, 0x8c, 0x35, 0xf0, 0x8e, 0x15, 0x0a, 0xbe, 0x06, 0x40, 0x50, 0x4c, 0x50,
-		0x46, 0xd7, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xa9, 0xb6, 0xcc, 0xb4, 0x01, 0xbe, 0x02, 0xa7, 0x38,
-		0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x13, 0x9c, 0xa4, 0x35, 0xf8, 0x06, 0x3c, 0x13, 0x58, 0xd1, 0x7d,
-		0x75, 0x8e, 0x07, 0xd6, 0xb9, 0x15, 0x74, 0xb4, 0x79, 0xd7, 0x3b, 0x9c, 0x80, 0x8e, 0x96, 0x11,
-		0x31, 0x82, 0xe2, 0x8c, 0x27, 0xe9, 0xd9, 0x13, 0xa3, 0xe1, 0xe0, 0x3f, 0x9f, 0xc7, 0x9f, 0x5c,
-		0x6a, 0xbf, 0xa0, 0xad, 0x69, 0x3e, 0x31, 0x17, 0xe7, 0x67, 0xd0, 0xde, 0xc3, 0x60, 0x0f, 0x9c,
-		0x48, 0x85, 0x85, 0x42, 0x8c, 0x34, 0xcd, 0x7d, 0x6a, 0xee, 0x3e, 0x81, 0xcf, 0xc1, 0x53, 0x5a,
-		0x10, 0x0d, 0xd4, 0xfd, 0x3c, 0xa1, 0x05, 0xf1, 0x89, 0xf3, 0x87, 0x05, 0xc0, 0x82, 0x67, 0x19,
-		0x15, 0x7e, 0xf1, 0x91, 0xc3, 0x09, 0xb0, 0x33, 0x2c, 0x15, 0xc2, 0x49, 0x42, 0xa5, 0x44, 0x7a,
-		0x14, 0x9b, 0xc7, 0xed, 0x3f, 0x7a, 0xdc, 0x68, 0x3b, 0xa7, 0x41, 0x57, 0x73, 0x46, 0x86, 0xa2,
-		0x8d, 0xb0, 0x0f, 0x4e, 0x18, 0xa1, 0x85, 0x62, 0x6a, 0xd3, 0xbc, 0xd0, 0xee, 0x7e, 0x48, 0x9f,
-		0xd6, 0x01, 0x7d, 0x9c, 0x3f, 0x2d, 0xd0, 0x0b, 0x15, 0x4b, 0xd2, 0xcd, 0xd5, 0x3d, 0x4d, 0x2a,
-		0x3d, 0x1a, 0x23, 0xa5, 0x04, 0x8b, 0x2b, 0x45, 0x25, 0xfc, 0x05, 0xd8, 0x77, 0x5c, 0xa4, 0x54,
-		0x98, 0x59, 0x44, 0x7a, 0x07, 0x9b, 0x3a, 0x5f, 0xff, 0xef, 0x7c, 0x07, 0xdd, 0x9a, 0xb6, 0x5b,
-		0x98, 0x08, 0xf4, 0x64, 0xb2, 0xa2, 0xa4, 0xca, 0x28, 0x52, 0x1c, 0xd5, 0xea, 0xe9, 0xb6, 0x79,
-		0xa5, 0x4c, 0xed, 0xed, 0x8b, 0xde, 0xe3, 0xb1, 0x6e, 0x36, 0x38, 0x78, 0xb1, 0xe5, 0x46, 0x3c,
-		0xd4, 0xcc, 0xa8, 0x26, 0xbe, 0xfd, 0x1d, 0x7c, 0xb6, 0xbf, 0x51, 0xb0, 0x0f, 0x5e, 0x44, 0xa3,
-		0x70, 0x8a, 0x66, 0x7e, 0x18, 0xa1, 0xa9, 0x3f, 0x9f, 0x20, 0x7f, 0x7e, 0x33, 0x9a, 0xf9, 0x13,
-		0xfb, 0x13, 0xd8, 0x03, 0xcf, 0x1f, 0x60, 0xf3, 0xf7, 0xc1, 0xf5, 0x68, 0x66, 0x5b, 0x07, 0xa0,
-		0x30, 0xf2, 0xc7, 0xd3, 0x5b, 0xfb, 0xe8, 0x2d, 0xf9, 0x27, 0x43, 0xb4, 0x29, 0xe9, 0xbf, 0x33,
-		0x44, 0xb7, 0x8b, 0xab, 0xbd, 0x0c, 0xaf, 0xc0, 0xcb, 0x07, 0xd8, 0xe4, 0x6a, 0xec, 0x87, 0xfe,
-		0xfb, 0xb9, 0x6d, 0x1d, 0x00, 0x47, 0xe3, 0xc8, 0xbf, 0xf1, 0xa3, 0x5b, 0xfb, 0xe8, 0xf2, 0x37,
-		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
-		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
-		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
-		0x07, 0x00, 0x00, 0xff, 0xff, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x6d, 0x6f, 0xdb, 0x36,
+		0x10, 0x9e, 0xec, 0x24, 0x4b, 0xce, 0x4b, 0xaa, 0x71, 0x7d, 0x89, 0xdd, 0xb5, 0xf5, 0xfc, 0xa1,
+		0x08, 0x8a, 0x4d, 0x46, 0x32, 0x0c, 0x18, 0xb6, 0x0f, 0x83, 0x5f, 0x82, 0x55, 0x88, 0xe3, 0x1a,
+		0xb2, 0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x87, 0x90, 0x2c, 0x0a, 0x24, 0xe5, 0xc4, 0xbf,
+		0x60, 0xff, 0x60, 0x3f, 0x66, 0xdf, 0xf6, 0xcf, 0x06, 0x52, 0x72, 0xe6, 0x25, 0x6e, 0xfa, 0x4d,
+		0xbc, 0xe7, 0x9e, 0x3b, 0xde, 0x73, 0xc7, 0x13, 0x74, 0x8a, 0x88, 0xc9, 0x6e, 0x4c, 0x28, 0xcb,
+		0x62, 0xd6, 0x25, 0x39, 0xef, 0x2e, 0x8e, 0xbb, 0x9a, 0xa8, 0x24, 0xe5, 0x4a, 0x7b, 0xb9, 0x14,
+		0x5a, 0xa0, 0xaf, 0x8c, 0x8f, 0x57, 0xf9, 0x78, 0x24, 0xe7, 0xde, 0xe2, 0xb8, 0xf5, 0x72, 0x26,
+		0xc4, 0x2c, 0x65, 0x5d, 0xeb, 0x12, 0x15, 0x1f, 0xba, 0xb4, 0x90, 0x44, 0x73, 0x91, 0x95, 0xa4,
+		0xd6, 0xab, 0xbb, 0xb8, 0xe6, 0x73, 0xa6, 0x34, 0x99, 0xe7, 0x95, 0xc3, 0xbd, 0x00, 0xd7, 0x92,
+		0xe4, 0x39, 0x93, 0xaa, 0xc4, 0x3b, 0xef, 0x61, 0x37, 0x24, 0x2a, 0x19, 0x71, 0xa5, 0x11, 0x82,
+		0xad, 0x8c, 0xcc, 0xd9, 0xa1, 0xd3, 0x76, 0x8e, 0xf6, 0x02, 0xfb, 0x8d, 0x7e, 0x80, 0xad, 0x84,
+		0x67, 0xf4, 0xb0, 0xd6, 0x76, 0x8e, 0x0e, 0x4e, 0xbe, 0xf1, 0x36, 0x5c, 0xd2, 0x5b, 0x05, 0x38,
+		0xe3, 0x19, 0x0d, 0xac, 0x7b, 0x87, 0x80, 0xbb, 0xb2, 0x9e, 0x33, 0x4d, 0x28, 0xd1, 0x04, 0x9d,
+		0xc3, 0xe3, 0x39, 0xb9, 0xc1, 0xa6, 0x6c, 0x85, 0x73, 0x26, 0xb1, 0x62, 0xb1, 0xc8, 0xa8, 0x4d,
+		0xd7, 0x38, 0xf9, 0xda, 0x2b, 0x6f, 0xea, 0xad, 0x6e, 0xea, 0x0d, 0x45, 0x11, 0xa5, 0xec, 0x82,
+		0xa4, 0x05, 0x0b, 0xbe, 0x9c, 0x93, 0x1b, 0x13, 0x50, 0x4d, 0x98, 0x9c, 0x5a, 0x5a, 0xe7, 0x3d,
+		0x34, 0x57, 0x29, 0x26, 0x44, 0x6a, 0x6e, 0x54, 0xb9, 0xcd, 0xe5, 0x42, 0x3d, 0x61, 0xcb, 0xaa,
+		0x12, 0xf3, 0x89, 0x5e, 0xc3, 0x23, 0x71, 0x9d, 0x31, 0x89, 0xaf, 0x84, 0xd2, 0xd8, 0xd6, 0x59,
+		0xb3, 0xe8, 0xbe, 0x35, 0xbf, 0x15, 0x4a, 0x8f, 0xc9, 0x9c, 0x75, 0xfe, 0xa9, 0xc3, 0xc1, 0x2a,
+		0xee, 0x54, 0x13, 0x5d, 0x28, 0xf4, 0x2d, 0xa0, 0x88, 0xc4, 0x49, 0x2a, 0x66, 0x38, 0x16, 0x45,
+		0xa6, 0xf1, 0x15, 0xcf, 0xb4, 0x8d, 0x5d, 0x0f, 0xdc, 0x0a, 0x19, 0x18, 0xe0, 0x2d, 0xcf, 0x34,
+		0x7a, 0x01, 0x20, 0x19, 0xa1, 0x38, 0x65, 0x0b, 0x96, 0xda, 0x1c, 0xf5, 0x60, 0xcf, 0x58, 0x46,
+		0xc6, 0x80, 0x9e, 0xc3, 0x1e, 0x89, 0x93, 0x0a, 0xad, 0x5b, 0x74, 0x97, 0xc4, 0x49, 0x09, 0xbe,
+		0x86, 0x47, 0x92, 0x68, 0xb6, 0xae, 0xce, 0x56, 0xdb, 0x39, 0x72, 0x82, 0x7d, 0x63, 0xbe, 0xad,
+		0x1d, 0x0d, 0x61, 0xdf, 0xc8, 0x88, 0x39, 0xc5, 0x51, 0x2a, 0xe2, 0xe4, 0x70, 0xdb, 0x6a, 0xd8,
+		0xfe, 0x68, 0x7b, 0xfc, 0x61, 0xdf, 0xf8, 0x05, 0x0d, 0x43, 0xf3, 0xa9, 0x3d, 0xa0, 0x3f, 0x1d,
+		0x78, 0x79, 0xbf, 0x30, 0x1c, 0x2d, 0x71, 0x2e, 0xb9, 0x90, 0x5c, 0x2f, 0x0f, 0x77, 0xda, 0xf5,
+		0xa3, 0xc6, 0xc9, 0xe0, 0xc1, 0xb6, 0x97, 0x2a, 0x79, 0xfd, 0x3b, 0x42, 0xf4, 0x97, 0x93, 0x2a,
+		0xca, 0x69, 0xa6, 0xe5, 0x32, 0x68, 0x45, 0x1f, 0x75, 0x68, 0x9d, 0xc3, 0xab, 0x4f, 0xd0, 0xd7,
+		0x3b, 0xba, 0x5d, 0x76, 0xf4, 0x31, 0x6c, 0x2f, 0xcc, 0x70, 0x54, 0x1a, 0x97, 0x87, 0x9f, 0x6a,
+		0x3f, 0x3a, 0x9d, 0x5f, 0xa0, 0xb1, 0x56, 0x34, 0x6a, 0xc2, 0xae, 0xd2, 0x44, 0x6a, 0xcc, 0x69,
+		0xd5, 0xb5, 0xcf, 0xed, 0xd9, 0xa7, 0xe8, 0x09, 0xec, 0xb0, 0x8c, 0x1a, 0xa0, 0x0a, 0xc2, 0x32,
+		0xea, 0xd3, 0xce, 0x5f, 0x0e, 0xc0, 0x44, 0xa4, 0x29, 0x93, 0x7e, 0xf6, 0x41, 0xa0, 0x21, 0xb8,
+		0x29, 0x51, 0x1a, 0x93, 0x38, 0x66, 0x4a, 0x61, 0xf3, 0xc6, 0xaa, 0xa9, 0x6d, 0xdd, 0x9b, 0xda,
+		0x70, 0xf5, 0x00, 0x83, 0x03, 0xc3, 0xe9, 0x59, 0x8a, 0x31, 0xa2, 0x16, 0xec, 0x72, 0xca, 0x32,
+		0x6d, 0x74, 0x2d, 0x47, 0xef, 0xf6, 0xbc, 0xa9, 0xf1, 0xf5, 0x0d, 0x8d, 0xef, 0xfc, 0xed, 0x40,
+		0x73, 0xaa, 0x79, 0x9c, 0x2c, 0x4f, 0x6f, 0x58, 0x5c, 0x98, 0x99, 0xef, 0x69, 0x2d, 0x79, 0x54,
+		0x68, 0xa6, 0xd0, 0xaf, 0xe0, 0x5e, 0x0b, 0x99, 0x30, 0x69, 0x1f, 0x19, 0x36, 0xcb, 0xa5, 0xba,
+		0xe7, 0x8b, 0x07, 0x3b, 0x18, 0x1c, 0x94, 0xb4, 0xd5, 0x19, 0x85, 0xd0, 0x54, 0xf1, 0x15, 0xa3,
+		0x45, 0xca, 0xb0, 0x16, 0xb8, 0x54, 0xcf, 0x94, 0x2d, 0x0a, 0x6d, 0xef, 0xde, 0x38, 0x69, 0xde,
+		0x7f, 0xaf, 0xd5, 0x6a, 0x0a, 0x9e, 0xae, 0xb8, 0xa1, 0x98, 0x1a, 0x66, 0x58, 0x12, 0xdf, 0xfc,
+		0x01, 0x5f, 0xac, 0xaf, 0x0a, 0xd4, 0x82, 0xa7, 0x61, 0x6f, 0x7a, 0x86, 0x47, 0xfe, 0x34, 0xc4,
+		0x67, 0xfe, 0x78, 0x88, 0xfd, 0xf1, 0x45, 0x6f, 0xe4, 0x0f, 0xdd, 0xcf, 0x50, 0x13, 0x9e, 0xdc,
+		0xc1, 0xc6, 0xef, 0x82, 0xf3, 0xde, 0xc8, 0x75, 0x36, 0x40, 0xd3, 0xd0, 0x1f, 0x9c, 0x5d, 0xba,
+		0xb5, 0x37, 0xf4, 0xbf, 0x0c, 0xe1, 0x32, 0x67, 0xff, 0xcf, 0x10, 0x5e, 0x4e, 0x4e, 0xd7, 0x32,
+		0x3c, 0x87, 0x67, 0x77, 0xb0, 0xe1, 0xe9, 0xc0, 0x9f, 0xfa, 0xef, 0xc6, 0xae, 0xb3, 0x01, 0xec,
+		0x0d, 0x42, 0xff, 0xc2, 0x0f, 0x2f, 0xdd, 0x5a, 0xff, 0x77, 0x78, 0x16, 0x8b, 0xf9, 0x26, 0x45,
+		0xfb, 0xfb, 0xb7, 0x2b, 0xc9, 0xa8, 0x32, 0x71, 0x7e, 0x3b, 0x9e, 0x71, 0x7d, 0x55, 0x44, 0x5e,
+		0x2c, 0xe6, 0xdd, 0xf5, 0x9f, 0xc0, 0x77, 0x9c, 0xa6, 0xdd, 0x99, 0x28, 0xf7, 0x72, 0xf5, 0x47,
+		0xf8, 0x99, 0xe4, 0x7c, 0x71, 0x1c, 0xed, 0x58, 0xdb, 0xf7, 0xff, 0x0e, 0x00, 0x09, 0x2b, 0x99,
+		0xfc, 0x35, 0x06, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-		0xf5, 0xff, 0x52, 0xb2, 0x1d, 0xfb, 0xc9, 0x3f, 0xe8, 0x71, 0x1c, 0x2b, 0xc9, 0x26, 0x71, 0xb4,
-		0x9b, 0xc4, 0xd1, 0x77, 0x6d, 0xaf, 0x93, 0xcd, 0xa6, 0x59, 0x37, 0x4d, 0x69, 0x92, 0x8e, 0x99,
-		0xc8, 0x94, 0x3a, 0xa2, 0xe2, 0x78, 0xd1, 0x96, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72,
-		0x94, 0xc4, 0xf7, 0x02, 0x3d, 0xf7, 0x56, 0xf4, 0xd4, 0x3f, 0xa0, 0x40, 0x51, 0xf4, 0x5c, 0x14,
-		0xe8, 0xa1, 0xb7, 0x5e, 0x7b, 0xec, 0xbd, 0x7f, 0x44, 0x81, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x7e,
-		0x50, 0x69, 0x81, 0xed, 0xcd, 0x7c, 0xf3, 0xf9, 0x3c, 0xbe, 0xf7, 0xe6, 0xbd, 0xcf, 0x0c, 0x2d,
-		0x28, 0x74, 0x4e, 0x48, 0xb0, 0x5d, 0x77, 0x1a, 0xc4, 0xab, 0x93, 0x6d, 0xa7, 0xed, 0x6e, 0xbf,
-		0xdf, 0xd9, 0xfe, 0xe0, 0x07, 0xef, 0x4e, 0x9b, 0xfe, 0x87, 0xad, 0x76, 0xe0, 0x53, 0x1f, 0xad,
-		0x30, 0xcc, 0x96, 0xc0, 0x6c, 0x39, 0x6d, 0x77, 0xeb, 0xfd, 0xce, 0x8d, 0xdb, 0x67, 0xbe, 0x7f,
-		0xd6, 0x24, 0xdb, 0x1c, 0x72, 0xd2, 0x39, 0xdd, 0x6e, 0x74, 0x02, 0x87, 0xba, 0xbe, 0x17, 0x91,
-		0x6e, 0xdc, 0xb9, 0xbc, 0x4e, 0xdd, 0x16, 0x09, 0xa9, 0xd3, 0x6a, 0x0b, 0xc0, 0xfa, 0xb0, 0x37,
-		0xd7, 0xfd, 0x56, 0xab, 0xeb, 0x62, 0x68, 0x6c, 0xd4, 0x09, 0xdf, 0x35, 0xdd, 0x90, 0x46, 0x98,
-		0xc2, 0xbf, 0x66, 0x60, 0xf5, 0x48, 0x84, 0xab, 0x7f, 0x24, 0xf5, 0x0e, 0x0b, 0xc1, 0xf0, 0x4e,
-		0x7d, 0x54, 0x03, 0x14, 0xe7, 0x61, 0x93, 0x78, 0x25, 0x2f, 0xad, 0x4b, 0x1b, 0xb9, 0x47, 0xf7,
-		0xb7, 0x86, 0xa4, 0xb4, 0x35, 0xe0, 0x07, 0x2f, 0x7f, 0xb8, 0x6c, 0x42, 0x4f, 0x60, 0x8a, 0x5e,
-		0xb4, 0x49, 0x3e, 0xc3, 0x1d, 0xdd, 0x1d, 0xeb, 0xc8, 0xba, 0x68, 0x13, 0xcc, 0xe1, 0xe8, 0x19,
-		0x40, 0x48, 0x9d, 0x80, 0xda, 0xac, 0x0c, 0xf9, 0x2c, 0x27, 0xdf, 0xd8, 0x8a, 0x6a, 0xb4, 0x15,
-		0xd7, 0x68, 0xcb, 0x8a, 0x6b, 0x84, 0xe7, 0x38, 0x9a, 0x3d, 0x33, 0x6a, 0xbd, 0xe9, 0x87, 0x24,
-		0xa2, 0x4e, 0xa5, 0x53, 0x39, 0x9a, 0x53, 0x2d, 0x98, 0x8f, 0xa8, 0x21, 0x75, 0x68, 0x27, 0xcc,
-		0x4f, 0xaf, 0x4b, 0x1b, 0x8b, 0x8f, 0x76, 0x26, 0xcb, 0x5e, 0x65, 0xcc, 0x2a, 0x27, 0xe2, 0x5c,
-		0xbd, 0xf7, 0x80, 0xee, 0xc1, 0xe2, 0xb9, 0x1b, 0x52, 0x3f, 0xb8, 0xb0, 0x9b, 0xc4, 0x3b, 0xa3,
-		0xe7, 0xf9, 0x99, 0x75, 0x69, 0x23, 0x8b, 0x17, 0x84, 0xb5, 0xc4, 0x8d, 0xe8, 0xa7, 0xb0, 0xda,
-		0x76, 0x02, 0xe2, 0xd1, 0x5e, 0xf9, 0x6d, 0xd7, 0x3b, 0xf5, 0xf3, 0x57, 0x78, 0x0a, 0x1b, 0x43,
-		0xa3, 0xa8, 0x70, 0x46, 0xdf, 0x4e, 0xe2, 0x95, 0xf6, 0xa0, 0x11, 0x29, 0xb0, 0xd8, 0x73, 0xcb,
-		0x2b, 0x33, 0x9b, 0x5a, 0x99, 0x85, 0x2e, 0x83, 0x57, 0x67, 0x13, 0xa6, 0x5a, 0xa4, 0xe5, 0xe7,
-		0xe7, 0x38, 0xf1, 0xfa, 0xd0, 0x78, 0x0e, 0x49, 0xcb, 0xc7, 0x1c, 0x86, 0x30, 0x2c, 0x87, 0xc4,
-		0x09, 0xea, 0xe7, 0xb6, 0x43, 0x69, 0xe0, 0x9e, 0x74, 0x28, 0x09, 0xf3, 0xc0, 0xb9, 0xf7, 0x86,
-		0x72, 0xab, 0x1c, 0xad, 0x74, 0xc1, 0x58, 0x0e, 0x2f, 0x59, 0x50, 0x09, 0x96, 0x9d, 0x0e, 0xf5,
-		0xed, 0x80, 0x84, 0x84, 0xda, 0x6d, 0xdf, 0xf5, 0x68, 0x98, 0xcf, 0x71, 0x9f, 0xeb, 0x43, 0x7d,
-		0x62, 0x06, 0xac, 0x70, 0x1c, 0x5e, 0x62, 0xd4, 0x84, 0x01, 0xdd, 0x84, 0x39, 0x36, 0x1e, 0x36,
-		0x9b, 0x8f, 0xfc, 0xfc, 0xba, 0xb4, 0x31, 0x87, 0x67, 0x99, 0xa1, 0xe4, 0x86, 0x14, 0xad, 0xc1,
-		0x15, 0x37, 0xb4, 0xeb, 0x81, 0xef, 0xe5, 0x17, 0xd6, 0xa5, 0x8d, 0x59, 0x3c, 0xe3, 0x86, 0x6a,
-		0xe0, 0x7b, 0x68, 0x17, 0x72, 0x9d, 0x76, 0xc3, 0xa1, 0xa2, 0xc1, 0x16, 0x53, 0xcb, 0x08, 0x11,
-		0x9c, 0x19, 0x0a, 0xbf, 0xce, 0xc0, 0xed, 0xc1, 0xce, 0xf1, 0xbd, 0x53, 0xf7, 0x4c, 0xe8, 0x01,
-		0xfa, 0x36, 0x19, 0x55, 0x34, 0x7f, 0xb7, 0x86, 0xe6, 0x66, 0x89, 0x50, 0x13, 0x41, 0x3b, 0xb0,
-		0xde, 0xdb, 0x65, 0x31, 0x40, 0xbe, 0xdd, 0x1b, 0x07, 0xbf, 0x43, 0xc5, 0x24, 0x5e, 0x1f, 0x08,
-		0x58, 0x13, 0x01, 0xe0, 0xcf, 0xba, 0x2e, 0xaa, 0x7c, 0xa8, 0x7c, 0x35, 0x1e, 0x10, 0xbf, 0x43,
-		0xd1, 0x11, 0xdc, 0xe4, 0xe1, 0x8d, 0xf0, 0x9e, 0x4d, 0xf3, 0xbe, 0xc6, 0xd8, 0x43, 0x1c, 0x17,
-		0xfe, 0x26, 0xc1, 0xca, 0x90, 0x76, 0x66, 0xbb, 0xd4, 0xf0, 0x5b, 0x8e, 0xeb, 0xd9, 0x6e, 0x83,
-		0xd7, 0x63, 0x0e, 0xcf, 0x46, 0x06, 0xa3, 0x81, 0xee, 0x40, 0x4e, 0x2c, 0x7a, 0x4e, 0x2b, 0x52,
-		0x99, 0x39, 0x0c, 0x91, 0xc9, 0x74, 0x5a, 0x64, 0x84, 0xac, 0x65, 0xff, 0x5b, 0x59, 0xbb, 0x0b,
-		0xf3, 0xae, 0xe7, 0x52, 0xd7, 0xa1, 0xa4, 0xc1, 0xe2, 0x9a, 0xe2, 0x13, 0x9d, 0xeb, 0xda, 0x8c,
-		0x46, 0xe1, 0x57, 0x12, 0xac, 0xea, 0x1f, 0x29, 0x09, 0x3c, 0xa7, 0xf9, 0xbd, 0x48, 0xed, 0xe5,
-		0x98, 0x32, 0x83, 0x31, 0xfd, 0x63, 0x1a, 0x56, 0x2a, 0xc4, 0x6b, 0xb8, 0xde, 0x99, 0x52, 0xa7,
-		0xee, 0x7b, 0x97, 0x5e, 0xf0, 0x88, 0xee, 0x40, 0xce, 0x11, 0xcf, 0xbd, 0x2a, 0x43, 0x6c, 0x32,
-		0x1a, 0x68, 0x1f, 0x16, 0xba, 0x80, 0x54, 0x3d, 0x8f, 0x5d, 0x73, 0x3d, 0x9f, 0x77, 0x12, 0x4f,
-		0xe8, 0x05, 0x4c, 0x33, 0x6d, 0x8d, 0x24, 0x7d, 0xf1, 0xd1, 0xc3, 0xe1, 0xa2, 0xd6, 0x1f, 0x21,
-		0x93, 0x51, 0x82, 0x23, 0x1e, 0x32, 0x60, 0xf9, 0x9c, 0x38, 0x01, 0x3d, 0x21, 0x0e, 0xb5, 0x1b,
-		0x84, 0x3a, 0x6e, 0x33, 0x14, 0x22, 0xff, 0xd9, 0x08, 0x85, 0xbc, 0x68, 0xfa, 0x4e, 0x03, 0xcb,
-		0x5d, 0x9a, 0x16, 0xb1, 0xd0, 0x2b, 0x58, 0x69, 0x3a, 0x21, 0xb5, 0x7b, 0xfe, 0xf8, 0x40, 0x4f,
-		0xa7, 0x0e, 0xf4, 0x32, 0xa3, 0x1d, 0xc4, 0x2c, 0xae, 0x8d, 0xfb, 0xc0, 0x8d, 0xd1, 0x54, 0x90,
-		0x46, 0xe4, 0x69, 0x26, 0xd5, 0xd3, 0x12, 0x23, 0x55, 0x23, 0x0e, 0xf7, 0x93, 0x87, 0x2b, 0x0e,
-		0xa5, 0xa4, 0xd5, 0xa6, 0x5c, 0xf6, 0xa7, 0x71, 0xfc, 0x88, 0x1e, 0x82, 0xdc, 0x72, 0x3e, 0xba,
-		0xad, 0x4e, 0xcb, 0x16, 0xa6, 0x90, 0x4b, 0xf8, 0x34, 0x5e, 0x12, 0x76, 0x45, 0x98, 0x99, 0xd6,
-		0x87, 0xf5, 0x73, 0xd2, 0xe8, 0x34, 0xe3, 0x48, 0xe6, 0xd2, 0xb5, 0xbe, 0xcb, 0xe0, 0x71, 0xa8,
-		0xb0, 0x44, 0x3e, 0xb6, 0xdd, 0x68, 0x66, 0x23, 0x1f, 0x90, 0xea, 0x63, 0xb1, 0x47, 0xe1, 0x4e,
-		0x5e, 0xc0, 0x3c, 0x2f, 0xca, 0xa9, 0xe3, 0x36, 0x3b, 0x01, 0x11, 0x42, 0x3d, 0x7c, 0x9b, 0xf6,
-		0x23, 0x0c, 0xce, 0x31, 0x86, 0x78, 0x40, 0x5f, 0xc1, 0x55, 0xee, 0x80, 0xf5, 0x3a, 0x09, 0x6c,
-		0xb7, 0x41, 0x3c, 0xea, 0xd2, 0x0b, 0xa1, 0xd5, 0x88, 0xad, 0x1d, 0xf1, 0x25, 0x43, 0xac, 0x14,
-		0xfe, 0x98, 0x81, 0xeb, 0xa2, 0x7d, 0xd4, 0x73, 0xb7, 0xd9, 0xf8, 0x5e, 0x06, 0xef, 0xcb, 0x84,
-		0x5b, 0x36, 0x1c, 0x49, 0x2d, 0x92, 0x3f, 0x24, 0x2e, 0x37, 0x5c, 0x91, 0x2e, 0x8f, 0x69, 0x76,
-		0x60, 0x4c, 0xd1, 0x1b, 0x10, 0x67, 0xb8, 0x10, 0xd7, 0xb6, 0xdf, 0x74, 0xeb, 0x17, 0xbc, 0xcd,
-		0x17, 0x47, 0x04, 0x1a, 0x29, 0x27, 0x17, 0xd4, 0x0a, 0x47, 0xe3, 0xe5, 0xf6, 0x65, 0x13, 0xba,
-		0x06, 0x33, 0x91, 0x34, 0xf2, 0x26, 0x9f, 0xc3, 0xe2, 0xa9, 0xf0, 0xd7, 0x4c, 0x57, 0x16, 0x34,
-		0x52, 0x77, 0xc3, 0xb8, 0x5e, 0xdd, 0x69, 0x95, 0xd2, 0xa7, 0x35, 0x26, 0xf6, 0x4d, 0xeb, 0x60,
-		0x27, 0x66, 0x3e, 0xb5, 0x13, 0x9f, 0xc3, 0x7c, 0xdf, 0x50, 0xa5, 0xdf, 0x05, 0x73, 0xe1, 0xf0,
-		0x81, 0x9a, 0xea, 0x1f, 0x28, 0x0c, 0x6b, 0x7e, 0xe0, 0x9e, 0xb9, 0x9e, 0xd3, 0xb4, 0x2f, 0x05,
-		0x99, 0x2e, 0x01, 0xab, 0x31, 0xb5, 0x9a, 0x0c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8f, 0x65, 0xab,
-		0xe4, 0xd7, 0x9d, 0xa6, 0xe6, 0x86, 0x6d, 0x87, 0xd6, 0xcf, 0x27, 0x53, 0xd9, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0xfb, 0x23, 0xb0, 0xfd, 0x53, 0x9b, 0x9e, 0xbb, 0xa1, 0x9d, 0xac, 0xe2, 0x78,
-		0x87, 0x37, 0xfa, 0x22, 0x2a, 0x9f, 0x5a, 0xe7, 0x6e, 0x28, 0xb4, 0x09, 0xdd, 0x02, 0xe0, 0xb7,
-		0x07, 0xea, 0xbf, 0x23, 0x51, 0x17, 0xce, 0x63, 0x7e, 0xdd, 0xb1, 0x98, 0xa1, 0xf0, 0x0a, 0x72,
-		0xc9, 0x0b, 0xda, 0x2e, 0xcc, 0x88, 0x3b, 0x9e, 0xb4, 0x9e, 0xdd, 0xc8, 0x3d, 0xfa, 0x3c, 0xe5,
-		0x8e, 0xc7, 0xaf, 0xbf, 0x82, 0x52, 0xf8, 0x7d, 0x06, 0x16, 0xfb, 0x97, 0xd0, 0x03, 0x58, 0x3a,
-		0x71, 0x3d, 0x27, 0xb8, 0xb0, 0xeb, 0xe7, 0xa4, 0xfe, 0x2e, 0xec, 0xb4, 0xc4, 0x26, 0x2c, 0x46,
-		0x66, 0x55, 0x58, 0xd1, 0x2a, 0xcc, 0x04, 0x1d, 0x2f, 0x3e, 0x44, 0xe7, 0xf0, 0x74, 0xd0, 0x61,
-		0xb7, 0x8d, 0xe7, 0x70, 0xf3, 0xd4, 0x0d, 0x42, 0x76, 0xf0, 0x44, 0xcd, 0x6e, 0xd7, 0xfd, 0x56,
-		0xbb, 0x49, 0xfa, 0x26, 0x39, 0xcf, 0x21, 0xf1, 0x38, 0xa8, 0x31, 0x80, 0xd3, 0xe7, 0xeb, 0x01,
-		0x71, 0xba, 0x7b, 0x93, 0x5e, 0xca, 0x9c, 0xc0, 0x0b, 0x39, 0x5d, 0xe0, 0x02, 0xeb, 0x7a, 0x67,
-		0x93, 0xb6, 0xe9, 0x7c, 0x4c, 0xe0, 0x0e, 0x6e, 0x03, 0xf0, 0x8b, 0x33, 0x75, 0x4e, 0x9a, 0xd1,
-		0xe9, 0x34, 0x8b, 0x13, 0x96, 0xe2, 0x1f, 0x24, 0xb8, 0x3a, 0xec, 0xec, 0x45, 0x05, 0xb8, 0x5d,
-		0xd1, 0x4d, 0xcd, 0x30, 0x5f, 0xda, 0x8a, 0x6a, 0x19, 0x6f, 0x0c, 0xeb, 0xd8, 0xae, 0x5a, 0x8a,
-		0xa5, 0xdb, 0x86, 0xf9, 0x46, 0x29, 0x19, 0x9a, 0xfc, 0x7f, 0xe8, 0x0b, 0x58, 0x1f, 0x81, 0xa9,
-		0xaa, 0x07, 0xba, 0x56, 0x2b, 0xe9, 0x9a, 0x2c, 0x8d, 0xf1, 0x54, 0xb5, 0x14, 0x6c, 0xe9, 0x9a,
-		0x9c, 0x41, 0xff, 0x0f, 0x0f, 0x46, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0x92, 0x8d, 0xf5, 0x9f, 0xd4,
-		0xf4, 0x2a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xc5, 0xdc, 0xa7, 0x40, 0xc9, 0x37, 0x69, 0xba, 0x6a,
-		0x54, 0x8d, 0xb2, 0x39, 0x2e, 0xe6, 0x4b, 0x98, 0x11, 0x31, 0x5f, 0x46, 0xc5, 0x31, 0x17, 0x7f,
-		0x99, 0xe9, 0x7d, 0x57, 0x1b, 0x0d, 0x4c, 0x3a, 0x5d, 0xcd, 0xfd, 0x02, 0xd6, 0x8f, 0xca, 0xf8,
-		0xf5, 0x7e, 0xa9, 0x7c, 0x64, 0x1b, 0x9a, 0x8d, 0xf5, 0x5a, 0x55, 0xb7, 0x2b, 0xe5, 0x92, 0xa1,
-		0x1e, 0x27, 0x22, 0xf9, 0x01, 0x7c, 0x3d, 0x12, 0xa5, 0x94, 0x98, 0x55, 0xab, 0x55, 0x4a, 0x86,
-		0xca, 0xde, 0xba, 0xaf, 0x18, 0x25, 0x5d, 0xb3, 0xcb, 0x66, 0xe9, 0x58, 0x96, 0xd0, 0x97, 0xb0,
-		0x31, 0x29, 0x53, 0xce, 0xa0, 0x4d, 0x78, 0x38, 0x12, 0x8d, 0xf5, 0x57, 0xba, 0x6a, 0x25, 0xe0,
-		0x59, 0xb4, 0x03, 0x9b, 0x23, 0xe1, 0x96, 0x8e, 0x0f, 0x0d, 0x93, 0x17, 0x74, 0xdf, 0xc6, 0x35,
-		0xd3, 0x34, 0xcc, 0x97, 0xf2, 0x54, 0xf1, 0xb7, 0x12, 0x2c, 0x0f, 0x1c, 0x46, 0xe8, 0x0e, 0xdc,
-		0xac, 0x28, 0x58, 0x37, 0x2d, 0x5b, 0x2d, 0x95, 0x87, 0x15, 0x60, 0x04, 0x40, 0xd9, 0x53, 0x4c,
-		0xad, 0x6c, 0xca, 0x12, 0xba, 0x0f, 0x85, 0x61, 0x00, 0xd1, 0x0b, 0xa2, 0x35, 0xe4, 0x0c, 0xba,
-		0x0b, 0xb7, 0x86, 0xe1, 0xba, 0xd1, 0xca, 0xd9, 0xe2, 0x3f, 0x33, 0xf0, 0xd9, 0xb8, 0xcf, 0x77,
-		0xd6, 0x81, 0xdd, 0xb4, 0xf5, 0xb7, 0xba, 0x5a, 0xb3, 0xd8, 0x9e, 0x47, 0xfe, 0xd8, 0xce, 0xd7,
-		0xaa, 0x89, 0xc8, 0x93, 0x25, 0x1d, 0x01, 0x56, 0xcb, 0x87, 0x95, 0x92, 0x6e, 0xf1, 0x6e, 0x2a,
-		0xc2, 0xfd, 0x34, 0x78, 0xb4, 0xc1, 0x72, 0xa6, 0x6f, 0x6f, 0x47, 0xb9, 0xe6, 0x79, 0xb3, 0x51,
-		0x40, 0x5b, 0x50, 0x4c, 0x43, 0x77, 0xab, 0xa0, 0xc9, 0x53, 0xe8, 0x6b, 0xf8, 0x2a, 0x3d, 0x70,
-		0xd3, 0x32, 0xcc, 0x9a, 0xae, 0xd9, 0x4a, 0xd5, 0x36, 0xf5, 0x23, 0x79, 0x7a, 0x92, 0x74, 0x2d,
-		0xe3, 0x90, 0xf5, 0x67, 0xcd, 0x92, 0x67, 0x8a, 0x7f, 0x96, 0xe0, 0x9a, 0xea, 0x7b, 0xd4, 0xf5,
-		0x3a, 0x44, 0x09, 0x4d, 0xf2, 0xc1, 0x88, 0xee, 0x39, 0x7e, 0x80, 0xee, 0xc1, 0xdd, 0xd8, 0xbf,
-		0x70, 0x6f, 0x1b, 0xa6, 0x61, 0x19, 0x8a, 0x55, 0xc6, 0x89, 0xfa, 0x8e, 0x85, 0xb1, 0x81, 0xd4,
-		0x74, 0x1c, 0xd5, 0x75, 0x34, 0x0c, 0xeb, 0x16, 0x3e, 0x16, 0xad, 0x10, 0x29, 0xcc, 0x68, 0xac,
-		0x8a, 0xd9, 0x7c, 0x8b, 0xf9, 0x97, 0xb3, 0xc5, 0xdf, 0x49, 0x90, 0x13, 0xdf, 0xa8, 0xfc, 0x13,
-		0x26, 0x0f, 0x57, 0x59, 0x82, 0xe5, 0x9a, 0x65, 0x5b, 0xc7, 0x15, 0xbd, 0xbf, 0x87, 0xfb, 0x56,
-		0xb8, 0x3c, 0xd8, 0x56, 0x39, 0xaa, 0x4e, 0xa4, 0x24, 0xfd, 0x00, 0xf1, 0x16, 0x86, 0xe1, 0x60,
-		0x39, 0x33, 0x16, 0x13, 0xf9, 0xc9, 0xa2, 0x1b, 0x70, 0xad, 0x0f, 0x73, 0xa0, 0x2b, 0xd8, 0xda,
-		0xd3, 0x15, 0x4b, 0x9e, 0x2a, 0xfe, 0x46, 0x82, 0xeb, 0xb1, 0x12, 0x5a, 0xec, 0x60, 0x75, 0x5b,
-		0xa4, 0x51, 0xee, 0x50, 0xd5, 0xe9, 0x84, 0x04, 0x3d, 0x84, 0x7b, 0x5d, 0x0d, 0xb3, 0x94, 0xea,
-		0xeb, 0xde, 0x5e, 0xd9, 0xaa, 0xc2, 0x86, 0xbb, 0x97, 0x4d, 0x2a, 0x54, 0x84, 0x20, 0x4b, 0xe8,
-		0x01, 0x7c, 0x3e, 0x1e, 0x8a, 0xf5, 0xaa, 0x6e, 0xc9, 0x99, 0xe2, 0xdf, 0x73, 0xb0, 0x96, 0x0c,
-		0x8e, 0x5d, 0xf4, 0x49, 0x23, 0x0a, 0xed, 0x3e, 0x14, 0xfa, 0x9d, 0x08, 0x9d, 0xbb, 0x1c, 0xd7,
-		0x0e, 0x6c, 0x8e, 0xc1, 0xd5, 0xcc, 0x03, 0xc5, 0xd4, 0xd8, 0x73, 0x0c, 0x92, 0x25, 0xf4, 0x02,
-		0x76, 0xc7, 0x50, 0xf6, 0x14, 0xad, 0x57, 0xe5, 0xee, 0x89, 0xa3, 0x58, 0x16, 0x36, 0xf6, 0x6a,
-		0x96, 0x5e, 0x95, 0x33, 0x48, 0x07, 0x25, 0xc5, 0x41, 0xbf, 0x0e, 0x0d, 0x75, 0x93, 0x45, 0xcf,
-		0xe0, 0x49, 0x5a, 0x1c, 0x51, 0xcb, 0x18, 0x87, 0x3a, 0x4e, 0x52, 0xa7, 0xd0, 0xb7, 0xf0, 0x4d,
-		0x0a, 0x55, 0xbc, 0x79, 0x80, 0x3b, 0x8d, 0x76, 0xe1, 0x69, 0x6a, 0xf4, 0x6a, 0x19, 0x6b, 0xf6,
-		0xa1, 0x82, 0x5f, 0xf7, 0x93, 0x67, 0x90, 0x01, 0x7a, 0xda, 0x8b, 0x85, 0xba, 0xd9, 0x43, 0x74,
-		0x21, 0xe1, 0xea, 0xca, 0x04, 0x55, 0x64, 0x86, 0x14, 0x37, 0xb3, 0xe8, 0x25, 0xa8, 0x93, 0x95,
-		0x62, 0xbc, 0xa3, 0x39, 0xf4, 0x16, 0xac, 0x4f, 0xdb, 0x55, 0xfd, 0xad, 0xa5, 0x63, 0x53, 0x49,
-		0xf3, 0x0c, 0xe8, 0x39, 0x3c, 0x4b, 0x2d, 0x5a, 0xbf, 0xfe, 0x24, 0xe8, 0x39, 0xf4, 0x14, 0x1e,
-		0x8f, 0xa1, 0x27, 0x7b, 0xa4, 0x77, 0x2b, 0x30, 0x34, 0x79, 0x1e, 0x3d, 0x81, 0x9d, 0x31, 0x44,
-		0x3e, 0x85, 0x76, 0xd5, 0x32, 0xd4, 0xd7, 0xc7, 0xd1, 0x72, 0xc9, 0xa8, 0x5a, 0xf2, 0x02, 0xfa,
-		0x31, 0xfc, 0x70, 0x0c, 0xad, 0x9b, 0x2c, 0xfb, 0x43, 0xc7, 0x89, 0x11, 0x63, 0xb0, 0x1a, 0xd6,
-		0xe5, 0xc5, 0x09, 0xf6, 0xa4, 0x6a, 0xbc, 0x4c, 0xaf, 0xdc, 0x12, 0x52, 0xe1, 0xc5, 0x44, 0x23,
-		0xa2, 0x1e, 0x18, 0x25, 0x6d, 0xb8, 0x13, 0x19, 0x3d, 0x86, 0xed, 0x31, 0x4e, 0xf6, 0xcb, 0x58,
-		0xd5, 0xc5, 0x89, 0xd5, 0x15, 0x89, 0x65, 0xf4, 0x0d, 0x3c, 0x1a, 0x47, 0x52, 0x8c, 0x52, 0xf9,
-		0x8d, 0x8e, 0x2f, 0xf3, 0x10, 0x3b, 0x46, 0x27, 0x4b, 0xdd, 0x30, 0x2b, 0x35, 0xcb, 0xae, 0x1a,
-		0xdf, 0xe9, 0xf2, 0x0a, 0x3b, 0x46, 0x53, 0x77, 0x2a, 0xae, 0x95, 0x7c, 0x75, 0x50, 0x8c, 0x07,
-		0x5e, 0xb2, 0x67, 0x98, 0x0a, 0x3e, 0x96, 0x57, 0x53, 0x7a, 0x6f, 0x50, 0xe8, 0xfa, 0x5a, 0xe8,
-		0xda, 0x24, 0xe9, 0xe8, 0x0a, 0x56, 0x0f, 0x92, 0x15, 0x5f, 0x63, 0xa7, 0xce, 0x5d, 0xfe, 0x0f,
-		0x97, 0x81, 0x7b, 0x55, 0x52, 0xe2, 0x77, 0x60, 0x33, 0xda, 0xb7, 0x21, 0x5d, 0x30, 0x42, 0xed,
-		0xf7, 0xe0, 0x47, 0x93, 0x51, 0xba, 0xeb, 0x4a, 0x09, 0xeb, 0x8a, 0x76, 0xdc, 0xbd, 0x92, 0x4a,
-		0xc5, 0xbf, 0x48, 0x50, 0x54, 0x1d, 0xaf, 0x4e, 0x9a, 0xf1, 0xff, 0x63, 0xc7, 0x46, 0xb9, 0x0b,
-		0x4f, 0x27, 0x98, 0xf7, 0x11, 0xf1, 0x1e, 0x41, 0xf5, 0x53, 0xc9, 0x35, 0xf3, 0xb5, 0x59, 0x3e,
-		0x32, 0xc7, 0x11, 0x44, 0x12, 0x55, 0xf7, 0x8c, 0xff, 0x33, 0x79, 0xb2, 0x24, 0x44, 0xdb, 0xfd,
-		0x67, 0x49, 0x7c, 0x2a, 0x79, 0xa2, 0x24, 0xf6, 0x7e, 0x06, 0x6b, 0x75, 0xbf, 0x35, 0xec, 0x2b,
-		0x7e, 0x6f, 0x21, 0x4e, 0xa7, 0xc2, 0x3e, 0x63, 0x2b, 0xd2, 0x77, 0x3b, 0x67, 0x2e, 0x3d, 0xef,
-		0x9c, 0x6c, 0xd5, 0xfd, 0xd6, 0x76, 0xf2, 0x97, 0xcd, 0x4d, 0xb7, 0xd1, 0xdc, 0x3e, 0xf3, 0xa3,
-		0x5f, 0x4a, 0xc5, 0xcf, 0x9c, 0xbb, 0x4e, 0xdb, 0x7d, 0xbf, 0x73, 0x32, 0xc3, 0x6d, 0x8f, 0xff,
-		0x1d, 0x00, 0x00, 0xff, 0xff, 0x06, 0x2a, 0xbb, 0x7f, 0xa6, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+		0x15, 0x2f, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x41, 0x8f, 0xe3, 0x58, 0xf9, 0x76, 0xb4, 0x9b, 0xc4,
+		0x51, 0xd7, 0xf2, 0xda, 0xd9, 0x6c, 0x9a, 0x75, 0xd3, 0x94, 0x26, 0xe9, 0x98, 0x89, 0x4c, 0xa9,
+		0x43, 0x2a, 0x8e, 0x17, 0x6d, 0x09, 0x5a, 0xa2, 0x2d, 0x22, 0x12, 0x29, 0x90, 0xa3, 0x24, 0xbe,
+		0x17, 0xe8, 0xb9, 0x87, 0x02, 0x45, 0x4f, 0xfd, 0x03, 0x0a, 0x14, 0x45, 0xcf, 0x45, 0x81, 0x1e,
+		0x7a, 0x29, 0x7a, 0xed, 0xb1, 0xf7, 0xfe, 0x17, 0xc5, 0x0c, 0x3f, 0x44, 0x7d, 0x52, 0x69, 0x81,
+		0xed, 0xcd, 0x7c, 0xf3, 0xfb, 0x3d, 0xbe, 0xf7, 0xe6, 0xbd, 0xdf, 0x8c, 0x68, 0x28, 0x74, 0xcf,
+		0x2c, 0x6f, 0xa7, 0x6e, 0x36, 0x2c, 0xa7, 0x6e, 0xed, 0x98, 0x1d, 0x7b, 0xe7, 0xfd, 0xee, 0xce,
+		0x07, 0xd7, 0x7b, 0x77, 0xde, 0x72, 0x3f, 0x94, 0x3a, 0x9e, 0x4b, 0x5c, 0xb4, 0x46, 0x31, 0xa5,
+		0x10, 0x53, 0x32, 0x3b, 0x76, 0xe9, 0xfd, 0xee, 0x8d, 0x3b, 0x17, 0xae, 0x7b, 0xd1, 0xb2, 0x76,
+		0x18, 0xe4, 0xac, 0x7b, 0xbe, 0xd3, 0xe8, 0x7a, 0x26, 0xb1, 0x5d, 0x27, 0x20, 0xdd, 0xb8, 0x3b,
+		0xb8, 0x4e, 0xec, 0xb6, 0xe5, 0x13, 0xb3, 0xdd, 0x09, 0x01, 0x9b, 0xa3, 0xde, 0x5c, 0x77, 0xdb,
+		0xed, 0xd8, 0xc5, 0xc8, 0xd8, 0x88, 0xe9, 0xbf, 0x6b, 0xd9, 0x3e, 0x09, 0x30, 0x85, 0x5f, 0x5f,
+		0x81, 0xf5, 0x93, 0x30, 0x5c, 0xf9, 0xa3, 0x55, 0xef, 0xd2, 0x10, 0x14, 0xe7, 0xdc, 0x45, 0x35,
+		0x40, 0x51, 0x1e, 0x86, 0x15, 0xad, 0xe4, 0xb9, 0x4d, 0x6e, 0x2b, 0xb7, 0xf7, 0xa0, 0x34, 0x22,
+		0xa5, 0xd2, 0x90, 0x1f, 0xbc, 0xfa, 0x61, 0xd0, 0x84, 0x9e, 0xc0, 0x0c, 0xb9, 0xec, 0x58, 0xf9,
+		0x0c, 0x73, 0x74, 0x6f, 0xa2, 0x23, 0xfd, 0xb2, 0x63, 0x61, 0x06, 0x47, 0xcf, 0x00, 0x7c, 0x62,
+		0x7a, 0xc4, 0xa0, 0x65, 0xc8, 0x67, 0x19, 0xf9, 0x46, 0x29, 0xa8, 0x51, 0x29, 0xaa, 0x51, 0x49,
+		0x8f, 0x6a, 0x84, 0x17, 0x18, 0x9a, 0x3e, 0x53, 0x6a, 0xbd, 0xe5, 0xfa, 0x56, 0x40, 0x9d, 0x49,
+		0xa7, 0x32, 0x34, 0xa3, 0xea, 0xb0, 0x18, 0x50, 0x7d, 0x62, 0x92, 0xae, 0x9f, 0x9f, 0xdd, 0xe4,
+		0xb6, 0x96, 0xf7, 0x76, 0xa7, 0xcb, 0x5e, 0xa4, 0x4c, 0x8d, 0x11, 0x71, 0xae, 0xde, 0x7b, 0x40,
+		0xf7, 0x61, 0xb9, 0x69, 0xfb, 0xc4, 0xf5, 0x2e, 0x8d, 0x96, 0xe5, 0x5c, 0x90, 0x66, 0x7e, 0x6e,
+		0x93, 0xdb, 0xca, 0xe2, 0xa5, 0xd0, 0x5a, 0x66, 0x46, 0xf4, 0x53, 0x58, 0xef, 0x98, 0x9e, 0xe5,
+		0x90, 0x5e, 0xf9, 0x0d, 0xdb, 0x39, 0x77, 0xf3, 0x57, 0x58, 0x0a, 0x5b, 0x23, 0xa3, 0xa8, 0x32,
+		0x46, 0xdf, 0x4e, 0xe2, 0xb5, 0xce, 0xb0, 0x11, 0x09, 0xb0, 0xdc, 0x73, 0xcb, 0x2a, 0x33, 0x9f,
+		0x5a, 0x99, 0xa5, 0x98, 0xc1, 0xaa, 0xb3, 0x0d, 0x33, 0x6d, 0xab, 0xed, 0xe6, 0x17, 0x18, 0xf1,
+		0xfa, 0xc8, 0x78, 0x8e, 0xad, 0xb6, 0x8b, 0x19, 0x0c, 0x61, 0x58, 0xf5, 0x2d, 0xd3, 0xab, 0x37,
+		0x0d, 0x93, 0x10, 0xcf, 0x3e, 0xeb, 0x12, 0xcb, 0xcf, 0x03, 0xe3, 0xde, 0x1f, 0xc9, 0xd5, 0x18,
+		0x5a, 0x88, 0xc1, 0x98, 0xf7, 0x07, 0x2c, 0xa8, 0x0c, 0xab, 0x66, 0x97, 0xb8, 0x86, 0x67, 0xf9,
+		0x16, 0x31, 0x3a, 0xae, 0xed, 0x10, 0x3f, 0x9f, 0x63, 0x3e, 0x37, 0x47, 0xfa, 0xc4, 0x14, 0x58,
+		0x65, 0x38, 0xbc, 0x42, 0xa9, 0x09, 0x03, 0xba, 0x09, 0x0b, 0x74, 0x3c, 0x0c, 0x3a, 0x1f, 0xf9,
+		0xc5, 0x4d, 0x6e, 0x6b, 0x01, 0xcf, 0x53, 0x43, 0xd9, 0xf6, 0x09, 0xda, 0x80, 0x2b, 0xb6, 0x6f,
+		0xd4, 0x3d, 0xd7, 0xc9, 0x2f, 0x6d, 0x72, 0x5b, 0xf3, 0x78, 0xce, 0xf6, 0x45, 0xcf, 0x75, 0xd0,
+		0x3e, 0xe4, 0xba, 0x9d, 0x86, 0x49, 0xc2, 0x06, 0x5b, 0x4e, 0x2d, 0x23, 0x04, 0x70, 0x56, 0xc3,
+		0x6b, 0x30, 0xd7, 0x31, 0xbb, 0xbe, 0xd5, 0xc8, 0xaf, 0x04, 0x4e, 0x83, 0xa7, 0xc2, 0x6f, 0x32,
+		0x70, 0x67, 0xb8, 0xa3, 0x5c, 0xe7, 0xdc, 0xbe, 0x08, 0x75, 0x02, 0x7d, 0x93, 0x8c, 0x36, 0x98,
+		0xcb, 0xdb, 0x23, 0x73, 0xd6, 0xc3, 0x14, 0x12, 0xc9, 0x98, 0xb0, 0xd9, 0xdb, 0xfd, 0x70, 0xb0,
+		0x5c, 0xa3, 0x37, 0x26, 0x6e, 0x97, 0x84, 0x13, 0x7a, 0x7d, 0x28, 0x11, 0x29, 0x0c, 0x00, 0xdf,
+		0x8a, 0x5d, 0x68, 0x6c, 0xd8, 0x5c, 0x31, 0x1a, 0x1c, 0xb7, 0x4b, 0xd0, 0x09, 0xdc, 0x64, 0xe1,
+		0x8d, 0xf1, 0x9e, 0x4d, 0xf3, 0xbe, 0x41, 0xd9, 0x23, 0x1c, 0x17, 0xfe, 0xc1, 0xc1, 0xda, 0x88,
+		0x36, 0xa7, 0xbb, 0xd7, 0x70, 0xdb, 0xa6, 0xed, 0x18, 0x76, 0x83, 0xd5, 0x63, 0x01, 0xcf, 0x07,
+		0x06, 0xa5, 0x81, 0xee, 0x42, 0x2e, 0x5c, 0x74, 0xcc, 0x76, 0xa0, 0x3e, 0x0b, 0x18, 0x02, 0x93,
+		0x6a, 0xb6, 0xad, 0x31, 0x72, 0x97, 0xfd, 0x5f, 0xe5, 0xee, 0x1e, 0x2c, 0xda, 0x8e, 0x4d, 0x6c,
+		0x93, 0x58, 0x0d, 0x1a, 0xd7, 0x0c, 0x9b, 0xf4, 0x5c, 0x6c, 0x53, 0x1a, 0x85, 0x5f, 0x71, 0xb0,
+		0x2e, 0x7f, 0x24, 0x96, 0xe7, 0x98, 0xad, 0xef, 0x44, 0x82, 0x07, 0x63, 0xca, 0x0c, 0xc7, 0xf4,
+		0xaf, 0x59, 0x58, 0xab, 0x5a, 0x4e, 0xc3, 0x76, 0x2e, 0x84, 0x3a, 0xb1, 0xdf, 0xdb, 0xe4, 0x92,
+		0x45, 0x74, 0x17, 0x72, 0x66, 0xf8, 0xdc, 0xab, 0x32, 0x44, 0x26, 0xa5, 0x81, 0x0e, 0x61, 0x29,
+		0x06, 0xa4, 0xea, 0x7c, 0xe4, 0x9a, 0xe9, 0xfc, 0xa2, 0x99, 0x78, 0x42, 0x2f, 0x60, 0x96, 0x6a,
+		0x6e, 0x20, 0xf5, 0xcb, 0x7b, 0x8f, 0x46, 0x8b, 0x5d, 0x7f, 0x84, 0x54, 0x5e, 0x2d, 0x1c, 0xf0,
+		0x90, 0x02, 0xab, 0x4d, 0xcb, 0xf4, 0xc8, 0x99, 0x65, 0x12, 0xa3, 0x61, 0x11, 0xd3, 0x6e, 0xf9,
+		0xa1, 0xf8, 0xdf, 0x1a, 0xa3, 0x9c, 0x97, 0x2d, 0xd7, 0x6c, 0x60, 0x3e, 0xa6, 0x49, 0x01, 0x0b,
+		0xbd, 0x82, 0xb5, 0x96, 0xe9, 0x13, 0xa3, 0xe7, 0x8f, 0x0d, 0xfa, 0x6c, 0xea, 0xa0, 0xaf, 0x52,
+		0xda, 0x51, 0xc4, 0x62, 0xf3, 0x7e, 0x08, 0xcc, 0x18, 0x4c, 0x85, 0xd5, 0x08, 0x3c, 0xcd, 0xa5,
+		0x7a, 0x5a, 0xa1, 0x24, 0x2d, 0xe0, 0x30, 0x3f, 0x79, 0xb8, 0x62, 0x12, 0x62, 0xb5, 0x3b, 0x84,
+		0x1d, 0x07, 0xb3, 0x38, 0x7a, 0x44, 0x8f, 0x80, 0x6f, 0x9b, 0x1f, 0xed, 0x76, 0xb7, 0x6d, 0x84,
+		0x26, 0x9f, 0x49, 0xfb, 0x2c, 0x5e, 0x09, 0xed, 0x42, 0x68, 0xa6, 0x67, 0x80, 0x5f, 0x6f, 0x5a,
+		0x8d, 0x6e, 0x2b, 0x8a, 0x64, 0x21, 0xfd, 0x0c, 0x88, 0x19, 0x2c, 0x0e, 0x11, 0x56, 0xac, 0x8f,
+		0x1d, 0x3b, 0x98, 0xd9, 0xc0, 0x07, 0xa4, 0xfa, 0x58, 0xee, 0x51, 0x98, 0x93, 0x17, 0xb0, 0xc8,
+		0x8a, 0x72, 0x6e, 0xda, 0xad, 0xae, 0x67, 0xe5, 0x73, 0x13, 0xb6, 0xe9, 0x30, 0xc0, 0xe0, 0x1c,
+		0x65, 0x84, 0x0f, 0xe8, 0x4b, 0xb8, 0xca, 0x1c, 0xd0, 0x5e, 0xb7, 0x3c, 0xc3, 0x6e, 0x58, 0x0e,
+		0xb1, 0xc9, 0x65, 0xa8, 0xe1, 0x88, 0xae, 0x9d, 0xb0, 0x25, 0x25, 0x5c, 0x29, 0xfc, 0x29, 0x03,
+		0xd7, 0xc3, 0xf6, 0x11, 0x9b, 0x76, 0xab, 0xf1, 0x9d, 0x0c, 0xde, 0x17, 0x09, 0xb7, 0x74, 0x38,
+		0x92, 0x5a, 0xc4, 0x7f, 0x48, 0x5c, 0x7a, 0x98, 0x22, 0x0d, 0x8e, 0x69, 0x76, 0x68, 0x4c, 0xd1,
+		0x1b, 0x08, 0xcf, 0xf6, 0x50, 0x5c, 0x3b, 0x6e, 0xcb, 0xae, 0x5f, 0xb2, 0x36, 0x5f, 0x1e, 0x13,
+		0x68, 0xa0, 0x9c, 0x4c, 0x50, 0xab, 0x0c, 0x8d, 0x57, 0x3b, 0x83, 0x26, 0x7a, 0x2a, 0x05, 0xd2,
+		0xc8, 0x9a, 0x7c, 0x01, 0x87, 0x4f, 0x85, 0xbf, 0x65, 0x62, 0x59, 0x90, 0xac, 0xba, 0xed, 0x47,
+		0xf5, 0x8a, 0xa7, 0x95, 0x4b, 0x9f, 0xd6, 0x88, 0xd8, 0x37, 0xad, 0xc3, 0x9d, 0x98, 0xf9, 0xd4,
+		0x4e, 0x7c, 0x0e, 0x8b, 0x7d, 0x43, 0x95, 0x7e, 0x47, 0xcc, 0xf9, 0xa3, 0x07, 0x6a, 0xa6, 0x7f,
+		0xa0, 0x30, 0x6c, 0xb8, 0x9e, 0x7d, 0x61, 0x3b, 0x66, 0xcb, 0x18, 0x08, 0x32, 0x5d, 0x02, 0xd6,
+		0x23, 0xaa, 0x96, 0x0c, 0xb6, 0xf0, 0xe7, 0x0c, 0x5c, 0x8f, 0x64, 0xab, 0xec, 0xd6, 0xcd, 0x96,
+		0x64, 0xfb, 0x1d, 0x93, 0xd4, 0x9b, 0xd3, 0xa9, 0xec, 0xff, 0xbf, 0x5c, 0x3f, 0x87, 0x3b, 0xfd,
+		0x11, 0x18, 0xee, 0xb9, 0x41, 0x9a, 0xb6, 0x6f, 0x24, 0xab, 0x38, 0xd9, 0xe1, 0x8d, 0xbe, 0x88,
+		0x2a, 0xe7, 0x7a, 0xd3, 0xf6, 0x43, 0x6d, 0x42, 0xb7, 0x01, 0xd8, 0xed, 0x81, 0xb8, 0xef, 0xac,
+		0xa0, 0x0b, 0x17, 0x31, 0xbb, 0xee, 0xe8, 0xd4, 0x50, 0x78, 0x05, 0xb9, 0xe4, 0xc5, 0x6d, 0x1f,
+		0xe6, 0xc2, 0xbb, 0x1f, 0xb7, 0x99, 0xdd, 0xca, 0xed, 0x7d, 0x96, 0x72, 0xf7, 0x63, 0xd7, 0xe2,
+		0x90, 0x52, 0xf8, 0x43, 0x06, 0x96, 0xfb, 0x97, 0xd0, 0x43, 0x58, 0x39, 0xb3, 0x1d, 0xd3, 0xbb,
+		0x34, 0xea, 0x4d, 0xab, 0xfe, 0xce, 0xef, 0xb6, 0xc3, 0x4d, 0x58, 0x0e, 0xcc, 0x62, 0x68, 0x45,
+		0xeb, 0x30, 0xe7, 0x75, 0x9d, 0xe8, 0x10, 0x5d, 0xc0, 0xb3, 0x5e, 0x97, 0xde, 0x36, 0x9e, 0xc3,
+		0xcd, 0x73, 0xdb, 0xf3, 0xe9, 0xc1, 0x13, 0x34, 0xbb, 0x51, 0x77, 0xdb, 0x9d, 0x96, 0xd5, 0x37,
+		0xc9, 0x79, 0x06, 0x89, 0xc6, 0x41, 0x8c, 0x00, 0x8c, 0xbe, 0x58, 0xf7, 0x2c, 0x33, 0xde, 0x9b,
+		0xf4, 0x52, 0xe6, 0x42, 0x7c, 0x28, 0xa7, 0x4b, 0x4c, 0x60, 0x6d, 0xe7, 0x62, 0xda, 0x36, 0x5d,
+		0x8c, 0x08, 0xcc, 0xc1, 0x1d, 0x00, 0x76, 0xa1, 0x26, 0xe6, 0x59, 0x2b, 0x38, 0x9d, 0xe6, 0x71,
+		0xc2, 0x52, 0xfc, 0x23, 0x07, 0x57, 0x47, 0x9d, 0xbd, 0xa8, 0x00, 0x77, 0xaa, 0xb2, 0x2a, 0x29,
+		0xea, 0x4b, 0x43, 0x10, 0x75, 0xe5, 0x8d, 0xa2, 0x9f, 0x1a, 0x9a, 0x2e, 0xe8, 0xb2, 0xa1, 0xa8,
+		0x6f, 0x84, 0xb2, 0x22, 0xf1, 0xdf, 0x43, 0x9f, 0xc3, 0xe6, 0x18, 0x8c, 0x26, 0x1e, 0xc9, 0x52,
+		0xad, 0x2c, 0x4b, 0x3c, 0x37, 0xc1, 0x93, 0xa6, 0x0b, 0x58, 0x97, 0x25, 0x3e, 0x83, 0xbe, 0x0f,
+		0x0f, 0xc7, 0x60, 0x44, 0x41, 0x15, 0xe5, 0xb2, 0x81, 0xe5, 0x9f, 0xd4, 0x64, 0x8d, 0x82, 0xb3,
+		0xc5, 0x5f, 0xf4, 0x62, 0xee, 0x53, 0xa0, 0xe4, 0x9b, 0x24, 0x59, 0x54, 0x34, 0xa5, 0xa2, 0x4e,
+		0x8a, 0x79, 0x00, 0x33, 0x26, 0xe6, 0x41, 0x54, 0x14, 0x73, 0xf1, 0x97, 0x99, 0xde, 0xef, 0x6d,
+		0xa5, 0x81, 0xad, 0x6e, 0xac, 0xb9, 0x9f, 0xc3, 0xe6, 0x49, 0x05, 0xbf, 0x3e, 0x2c, 0x57, 0x4e,
+		0x0c, 0x45, 0x32, 0xb0, 0x5c, 0xd3, 0x64, 0xa3, 0x5a, 0x29, 0x2b, 0xe2, 0x69, 0x22, 0x92, 0x1f,
+		0xc0, 0x57, 0x63, 0x51, 0x42, 0x99, 0x5a, 0xa5, 0x5a, 0xb5, 0xac, 0x88, 0xf4, 0xad, 0x87, 0x82,
+		0x52, 0x96, 0x25, 0xa3, 0xa2, 0x96, 0x4f, 0x79, 0x0e, 0x7d, 0x01, 0x5b, 0xd3, 0x32, 0xf9, 0x0c,
+		0xda, 0x86, 0x47, 0x63, 0xd1, 0x58, 0x7e, 0x25, 0x8b, 0x7a, 0x02, 0x9e, 0x45, 0xbb, 0xb0, 0x3d,
+		0x16, 0xae, 0xcb, 0xf8, 0x58, 0x51, 0x59, 0x41, 0x0f, 0x0d, 0x5c, 0x53, 0x55, 0x45, 0x7d, 0xc9,
+		0xcf, 0x14, 0xff, 0xce, 0x41, 0xbe, 0x57, 0x09, 0xfa, 0xd3, 0xa6, 0x65, 0xd7, 0x49, 0x58, 0x8c,
+		0x87, 0xf0, 0x59, 0xd2, 0x9f, 0x58, 0x51, 0x0f, 0xcb, 0x8a, 0xa8, 0x0f, 0xd7, 0x63, 0xa0, 0x6a,
+		0x83, 0x40, 0x5a, 0x82, 0xe1, 0xdc, 0x07, 0x51, 0x34, 0x58, 0xf9, 0xad, 0xa2, 0xe9, 0x34, 0xb2,
+		0x0c, 0xda, 0x83, 0xd2, 0x24, 0x74, 0x2f, 0x9f, 0x98, 0x93, 0x2d, 0xfe, 0x8e, 0x83, 0xd5, 0xa1,
+		0xa3, 0x15, 0xdd, 0x85, 0x9b, 0x55, 0x01, 0xcb, 0xaa, 0x6e, 0x88, 0xe5, 0xca, 0xa8, 0xed, 0x1c,
+		0x03, 0x10, 0x0e, 0x04, 0x55, 0xaa, 0xa8, 0x3c, 0x87, 0x1e, 0x40, 0x61, 0x14, 0x20, 0xec, 0xec,
+		0xb0, 0xd1, 0xf9, 0x0c, 0xba, 0x07, 0xb7, 0x47, 0xe1, 0xe2, 0x58, 0xf9, 0x6c, 0xf1, 0xdf, 0x19,
+		0xb8, 0x35, 0xe9, 0x23, 0x05, 0x9d, 0xa7, 0x38, 0x6f, 0xf9, 0xad, 0x2c, 0xd6, 0x74, 0xda, 0xc1,
+		0x81, 0x3f, 0xda, 0xc7, 0x35, 0x2d, 0x11, 0x79, 0xb2, 0x41, 0xc6, 0x80, 0xc5, 0xca, 0x71, 0xb5,
+		0x2c, 0xeb, 0x6c, 0x36, 0x8a, 0xf0, 0x20, 0x0d, 0x1e, 0xb4, 0x2b, 0x9f, 0xe9, 0xdb, 0xad, 0x71,
+		0xae, 0x59, 0xde, 0x74, 0xb0, 0x51, 0x09, 0x8a, 0x69, 0xe8, 0xb8, 0x0a, 0x12, 0x3f, 0x83, 0xbe,
+		0x82, 0x2f, 0xd3, 0x03, 0x57, 0x75, 0x45, 0xad, 0xc9, 0x92, 0x21, 0x68, 0x86, 0x2a, 0x9f, 0xf0,
+		0xb3, 0xd3, 0xa4, 0xab, 0x2b, 0xc7, 0x74, 0xda, 0x6a, 0x3a, 0x3f, 0x57, 0xfc, 0x0b, 0x07, 0xd7,
+		0x44, 0xd7, 0x21, 0xb6, 0xd3, 0xb5, 0x04, 0x5f, 0xb5, 0x3e, 0x28, 0xc1, 0xad, 0xcd, 0xf5, 0xd0,
+		0x7d, 0xb8, 0x17, 0xf9, 0x0f, 0xdd, 0x1b, 0x8a, 0xaa, 0xe8, 0x8a, 0xa0, 0x57, 0x70, 0xa2, 0xbe,
+		0x13, 0x61, 0x54, 0x5e, 0x24, 0x19, 0x07, 0x75, 0x1d, 0x0f, 0xc3, 0xb2, 0x8e, 0x4f, 0xc3, 0x56,
+		0x08, 0xf4, 0x72, 0x3c, 0x56, 0xc4, 0x15, 0x35, 0x56, 0x33, 0x3e, 0x5b, 0xfc, 0x3d, 0x07, 0xb9,
+		0xf0, 0x17, 0x37, 0xfb, 0x41, 0x96, 0x87, 0xab, 0x34, 0xc1, 0x4a, 0x4d, 0x37, 0xf4, 0xd3, 0xaa,
+		0xdc, 0xdf, 0xc3, 0x7d, 0x2b, 0x4c, 0xec, 0x0c, 0xbd, 0x12, 0x54, 0x27, 0xd0, 0xc5, 0x7e, 0x40,
+		0xf8, 0x16, 0x8a, 0x61, 0x60, 0x3e, 0x33, 0x11, 0x13, 0xf8, 0xc9, 0xa2, 0x1b, 0x70, 0xad, 0x0f,
+		0x73, 0x24, 0x0b, 0x58, 0x3f, 0x90, 0x05, 0x9d, 0x9f, 0x29, 0xfe, 0x96, 0x83, 0xeb, 0x91, 0xae,
+		0xd3, 0xef, 0x1d, 0x34, 0xf4, 0x46, 0xa5, 0x4b, 0x44, 0xfa, 0x39, 0x05, 0x3d, 0x82, 0xfb, 0xb1,
+		0x22, 0xeb, 0x82, 0xf6, 0xba, 0xb7, 0x57, 0x86, 0x28, 0xd4, 0xb4, 0x64, 0x36, 0xa9, 0xd0, 0x30,
+		0x04, 0x9e, 0xa3, 0x22, 0x35, 0x19, 0x8a, 0x65, 0x4d, 0xd6, 0xf9, 0x4c, 0xf1, 0x9f, 0x39, 0xd8,
+		0x48, 0x06, 0x47, 0x7f, 0xb6, 0x58, 0x8d, 0x20, 0xb4, 0x07, 0x50, 0xe8, 0x77, 0x12, 0xaa, 0xf6,
+		0x60, 0x5c, 0xbb, 0xb0, 0x3d, 0x01, 0x57, 0x53, 0x8f, 0x04, 0x55, 0xa2, 0xcf, 0x11, 0x88, 0xe7,
+		0xd0, 0x0b, 0xd8, 0x9f, 0x40, 0x39, 0x10, 0xa4, 0x5e, 0x95, 0xe3, 0xf3, 0x53, 0xd0, 0x75, 0xac,
+		0x1c, 0xd4, 0x74, 0x59, 0xe3, 0x33, 0x48, 0x06, 0x21, 0xc5, 0x41, 0xbf, 0x0e, 0x8d, 0x74, 0x93,
+		0x45, 0xcf, 0xe0, 0x49, 0x5a, 0x1c, 0x41, 0xcb, 0x28, 0xc7, 0x32, 0x4e, 0x52, 0x67, 0xd0, 0x37,
+		0xf0, 0x75, 0x0a, 0x35, 0x7c, 0xf3, 0x10, 0x77, 0x16, 0xed, 0xc3, 0xd3, 0xd4, 0xe8, 0xc5, 0x0a,
+		0x96, 0x8c, 0x63, 0x01, 0xbf, 0xee, 0x27, 0xcf, 0x21, 0x05, 0xe4, 0xb4, 0x17, 0x87, 0xea, 0x66,
+		0x8c, 0xd0, 0x85, 0x84, 0xab, 0x2b, 0x53, 0x54, 0x91, 0x1a, 0x52, 0xdc, 0xcc, 0xa3, 0x97, 0x20,
+		0x4e, 0x57, 0x8a, 0xc9, 0x8e, 0x16, 0xd0, 0x5b, 0xd0, 0x3f, 0x6d, 0x57, 0xe5, 0xb7, 0xba, 0x8c,
+		0x55, 0x21, 0xcd, 0x33, 0xa0, 0xe7, 0xf0, 0x2c, 0xb5, 0x68, 0xfd, 0xfa, 0x93, 0xa0, 0xe7, 0xd0,
+		0x53, 0x78, 0x3c, 0x81, 0x9e, 0xec, 0x91, 0xde, 0x1d, 0x47, 0x91, 0xf8, 0x45, 0xf4, 0x04, 0x76,
+		0x27, 0x10, 0xd9, 0x14, 0x1a, 0x9a, 0xae, 0x88, 0xaf, 0x4f, 0x83, 0xe5, 0xb2, 0xa2, 0xe9, 0xfc,
+		0x12, 0xfa, 0x31, 0xfc, 0x70, 0x02, 0x2d, 0x4e, 0x96, 0xfe, 0x21, 0xe3, 0xc4, 0x88, 0x51, 0x58,
+		0x0d, 0xcb, 0xfc, 0xf2, 0x14, 0x7b, 0xa2, 0x29, 0x2f, 0xd3, 0x2b, 0xb7, 0x82, 0x44, 0x78, 0x31,
+		0xd5, 0x88, 0x88, 0x47, 0x4a, 0x59, 0x1a, 0xed, 0x84, 0x47, 0x8f, 0x61, 0x67, 0x82, 0x93, 0xc3,
+		0x0a, 0x16, 0xe5, 0xf0, 0xc4, 0x8a, 0x45, 0x62, 0x15, 0x7d, 0x0d, 0x7b, 0x93, 0x48, 0x82, 0x52,
+		0xae, 0xbc, 0x91, 0xf1, 0x20, 0x0f, 0xd1, 0x63, 0x74, 0xba, 0xd4, 0x15, 0xb5, 0x5a, 0xd3, 0x0d,
+		0x4d, 0xf9, 0x56, 0xe6, 0xd7, 0xe8, 0x31, 0x9a, 0xba, 0x53, 0x51, 0xad, 0xf8, 0xab, 0xc3, 0x62,
+		0x3c, 0xf4, 0x92, 0x03, 0x45, 0x15, 0xf0, 0x29, 0xbf, 0x9e, 0xd2, 0x7b, 0xc3, 0x42, 0xd7, 0xd7,
+		0x42, 0xd7, 0xa6, 0x49, 0x47, 0x16, 0xb0, 0x78, 0x94, 0xac, 0xf8, 0x06, 0x3d, 0x75, 0xee, 0xb1,
+		0xcf, 0x47, 0x43, 0xf7, 0xaa, 0xa4, 0xc4, 0xef, 0xc2, 0x76, 0xb0, 0x6f, 0x23, 0xba, 0x60, 0x8c,
+		0xda, 0x1f, 0xc0, 0x8f, 0xa6, 0xa3, 0xc4, 0xeb, 0x42, 0x19, 0xcb, 0x82, 0x74, 0x1a, 0x5f, 0xb0,
+		0xb9, 0xe2, 0x5f, 0x39, 0x28, 0x8a, 0xa6, 0x53, 0xb7, 0x5a, 0xd1, 0xd7, 0xe5, 0x89, 0x51, 0xee,
+		0xc3, 0xd3, 0x29, 0xe6, 0x7d, 0x4c, 0xbc, 0x27, 0xa0, 0x7d, 0x2a, 0xb9, 0xa6, 0xbe, 0x56, 0x2b,
+		0x27, 0xea, 0x24, 0x42, 0x98, 0x84, 0x66, 0x5f, 0x38, 0xe6, 0xd4, 0x49, 0x84, 0x6d, 0xf7, 0xdf,
+		0x25, 0xf1, 0xa9, 0xe4, 0xa9, 0x92, 0x38, 0xf8, 0x19, 0x6c, 0xd4, 0xdd, 0xf6, 0xa8, 0x6f, 0x12,
+		0x07, 0x4b, 0x51, 0x3a, 0x55, 0xfa, 0xa3, 0xbc, 0xca, 0x7d, 0xbb, 0x7b, 0x61, 0x93, 0x66, 0xf7,
+		0xac, 0x54, 0x77, 0xdb, 0x3b, 0xc9, 0xff, 0xdf, 0x6e, 0xdb, 0x8d, 0xd6, 0xce, 0x85, 0x1b, 0xfc,
+		0x3f, 0x38, 0xfc, 0x67, 0xee, 0xbe, 0xd9, 0xb1, 0xdf, 0xef, 0x9e, 0xcd, 0x31, 0xdb, 0xe3, 0xff,
+		0x0c, 0x00, 0xcd, 0x3f, 0x1d, 0x32, 0x8c, 0x1e, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/query.proto
 	[]byte{
@@ -3218,382 +3507,390 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x5c, 0xdb, 0x34, 0x3e, 0x88, 0xb5, 0xab, 0x3b, 0x78, 0x11, 0xd0, 0x69, 0xd5, 0x8b, 0x5e, 0x41,
 		0x11, 0xd0, 0xca, 0x2f, 0xc8, 0x12, 0xee, 0xba, 0x93, 0x30, 0xfd, 0x9c, 0x8d, 0xa4, 0x80, 0x4e,
 		0xe5, 0xcd, 0x93, 0x7b, 0x1d, 0x8e, 0x23, 0x79, 0x42, 0xe5, 0xe2, 0xd2, 0xca, 0xfb, 0xbb, 0xf4,
-		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x69,
-		0x28, 0x5b, 0xfb, 0x03, 0x00, 0x00,
+		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x03, 0x00, 0xbd, 0x69, 0x28, 0x5b, 0xfb,
+		0x03, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6e, 0x1c, 0xc7,
-		0xf1, 0xc7, 0xf0, 0x4b, 0x64, 0xed, 0x92, 0xa2, 0x5a, 0x22, 0xb9, 0x5a, 0x89, 0x1f, 0x1a, 0xd9,
-		0xfe, 0xf3, 0x2f, 0x5b, 0xcb, 0x88, 0xb2, 0x25, 0x59, 0x76, 0x62, 0x50, 0x2b, 0x51, 0x66, 0x60,
-		0x19, 0xcc, 0x90, 0x89, 0x90, 0x5c, 0x06, 0xcd, 0x99, 0xda, 0xdd, 0x36, 0x67, 0x67, 0x86, 0xdd,
-		0x3d, 0xa4, 0xd7, 0x39, 0x04, 0x09, 0x8c, 0x24, 0xc8, 0x17, 0x92, 0x63, 0x80, 0x00, 0x39, 0x24,
-		0xc7, 0x20, 0x97, 0xbc, 0x43, 0x1e, 0x21, 0xf7, 0x3c, 0x41, 0xde, 0xc0, 0x08, 0xa6, 0xa7, 0x67,
-		0xbf, 0x38, 0x33, 0x4b, 0x32, 0x30, 0xa4, 0xe4, 0xc6, 0xe9, 0xae, 0x5f, 0x55, 0x75, 0x55, 0x75,
-		0x75, 0x55, 0x71, 0xe1, 0x4e, 0x74, 0x80, 0x7c, 0xc3, 0xa1, 0x2e, 0xfa, 0x0e, 0x6e, 0xd0, 0x90,
-		0x6d, 0x1c, 0xdf, 0xdb, 0x10, 0xc8, 0x8f, 0x99, 0x83, 0xf6, 0x49, 0xc0, 0x0f, 0x1b, 0x5e, 0x70,
-		0x52, 0x0b, 0x79, 0x20, 0x03, 0x72, 0x35, 0xa6, 0xad, 0x69, 0xda, 0x1a, 0x0d, 0x59, 0xed, 0xf8,
-		0x5e, 0x75, 0xa5, 0x19, 0x04, 0x4d, 0x0f, 0x37, 0x14, 0xc9, 0x41, 0xd4, 0xd8, 0x70, 0x23, 0x4e,
-		0x25, 0x0b, 0xfc, 0x04, 0x54, 0x5d, 0xcb, 0x12, 0xe0, 0x04, 0xed, 0x76, 0x97, 0xe2, 0x56, 0x16,
-		0x45, 0x8b, 0x09, 0x19, 0xf0, 0x8e, 0x26, 0x59, 0xcd, 0x22, 0x39, 0x8a, 0xb0, 0x4b, 0x60, 0x66,
-		0x11, 0x48, 0x2a, 0x0e, 0x3d, 0x26, 0x64, 0x11, 0xcd, 0xe0, 0x11, 0xcd, 0xbf, 0x18, 0xb0, 0x6a,
-		0xa1, 0x90, 0x94, 0xcb, 0x97, 0x7a, 0xe7, 0xd9, 0xe7, 0xe8, 0x44, 0xf1, 0x81, 0x2c, 0x3c, 0x8a,
-		0x50, 0x48, 0xb2, 0x08, 0x53, 0x6e, 0xd0, 0xa6, 0xcc, 0xaf, 0x18, 0x6b, 0xc6, 0xfa, 0x8c, 0xa5,
-		0xbf, 0xc8, 0x77, 0x81, 0xa4, 0xdc, 0x6c, 0x4c, 0x41, 0x95, 0xb1, 0x35, 0x63, 0xbd, 0xb4, 0xf9,
-		0x56, 0x2d, 0xc3, 0x76, 0xb5, 0xd3, 0x22, 0xae, 0x9c, 0x0c, 0x2f, 0x91, 0x2a, 0x4c, 0x33, 0x17,
-		0x7d, 0xc9, 0x64, 0xa7, 0x32, 0xae, 0x04, 0x76, 0xbf, 0xcd, 0x9f, 0x4f, 0xc3, 0xf2, 0xde, 0x85,
-		0x94, 0x5d, 0x85, 0x52, 0x57, 0x59, 0xe6, 0x2a, 0x2d, 0x67, 0x2c, 0x48, 0x97, 0x76, 0x5c, 0xb2,
-		0x0d, 0xb3, 0x5d, 0x02, 0xd9, 0x09, 0x51, 0xc9, 0x2e, 0x6d, 0xde, 0x2a, 0x3c, 0xc8, 0x7e, 0x27,
-		0x44, 0xab, 0x7c, 0xd2, 0xf7, 0x45, 0x1e, 0xc3, 0x4c, 0xec, 0x07, 0x3b, 0x76, 0x44, 0x65, 0x42,
-		0xf1, 0x58, 0xce, 0xe4, 0xb1, 0x4f, 0xc5, 0xe1, 0x27, 0x4c, 0x48, 0x6b, 0x5a, 0xea, 0xbf, 0xc8,
-		0x26, 0x4c, 0x32, 0x3f, 0x8c, 0x64, 0x65, 0x52, 0xe1, 0x6e, 0x66, 0xe2, 0x76, 0x69, 0xc7, 0x0b,
-		0xa8, 0x6b, 0x25, 0xa4, 0x84, 0xc2, 0x5a, 0xd7, 0xf8, 0xb6, 0x72, 0xa4, 0x2d, 0x03, 0xdb, 0xf1,
-		0x02, 0x81, 0xb6, 0x64, 0x6d, 0x0c, 0x22, 0x59, 0x99, 0x52, 0xec, 0xae, 0xd7, 0x92, 0xd0, 0xad,
-		0xa5, 0xa1, 0x5b, 0x7b, 0xaa, 0x43, 0xd7, 0xba, 0xd9, 0x65, 0xa1, 0xac, 0xbb, 0x1f, 0xd4, 0x63,
-		0xfc, 0x7e, 0x02, 0x27, 0x2f, 0xe1, 0x86, 0x3a, 0x52, 0x0e, 0xf7, 0x4b, 0xa3, 0xb8, 0x2f, 0xc5,
-		0xe8, 0x2c, 0xc6, 0xfd, 0xae, 0x9e, 0x1e, 0x74, 0x35, 0x59, 0x06, 0xe0, 0x89, 0x4f, 0x63, 0x7f,
-		0xcd, 0xa8, 0xdd, 0x19, 0xbd, 0xb2, 0xe3, 0x12, 0x07, 0x2a, 0x7d, 0xfe, 0xb4, 0x39, 0x46, 0x02,
-		0xed, 0x30, 0xf0, 0x98, 0xd3, 0xa9, 0xc0, 0x9a, 0xb1, 0x3e, 0xb7, 0x79, 0xa7, 0xd0, 0x73, 0x3b,
-		0xae, 0x15, 0x43, 0x76, 0x15, 0xc2, 0x5a, 0x38, 0xc9, 0x5a, 0x26, 0x75, 0x28, 0x73, 0x94, 0xbc,
-		0x93, 0x32, 0x2e, 0xa9, 0x93, 0xae, 0x65, 0x32, 0xb6, 0x62, 0x42, 0xcd, 0xae, 0xc4, 0x7b, 0x1f,
-		0xe4, 0x36, 0xcc, 0x3a, 0x3c, 0xf6, 0x8d, 0xd3, 0x42, 0x37, 0xf2, 0xb0, 0x52, 0x56, 0x67, 0x29,
-		0xc7, 0x8b, 0x7b, 0x7a, 0x8d, 0xdc, 0x85, 0x89, 0x36, 0xb6, 0x83, 0xca, 0xac, 0xb6, 0x65, 0x96,
-		0x84, 0x17, 0xd8, 0x0e, 0x2c, 0x45, 0x46, 0x2c, 0xb8, 0x22, 0x90, 0x72, 0xa7, 0x65, 0x53, 0x29,
-		0x39, 0x3b, 0x88, 0x24, 0x8a, 0xca, 0x9c, 0xc2, 0xbe, 0x99, 0x89, 0xdd, 0x53, 0xd4, 0x5b, 0x5d,
-		0x62, 0x6b, 0x5e, 0x0c, 0xad, 0x90, 0xfb, 0x30, 0xd5, 0x42, 0xea, 0x22, 0xaf, 0x5c, 0x56, 0x8c,
-		0x6e, 0x64, 0x32, 0xfa, 0x58, 0x91, 0x58, 0x9a, 0x94, 0x3c, 0x86, 0x92, 0x8b, 0x1e, 0xed, 0x24,
-		0xb1, 0x51, 0x99, 0x1f, 0x15, 0x0a, 0xa0, 0xa8, 0x55, 0x2c, 0x90, 0x0f, 0xa1, 0xfc, 0x19, 0x93,
-		0x12, 0xb9, 0x06, 0x5f, 0x19, 0x05, 0x2e, 0x25, 0xe4, 0x0a, 0x6d, 0x3e, 0x84, 0x95, 0xbc, 0x4c,
-		0x20, 0xc2, 0xc0, 0x17, 0x48, 0x16, 0x60, 0x8a, 0x47, 0x7e, 0x1c, 0x3d, 0x49, 0x2a, 0x98, 0xe4,
-		0x91, 0xbf, 0xe3, 0x9a, 0xef, 0xc3, 0x5a, 0x7e, 0xc6, 0x2b, 0x86, 0xfe, 0x7d, 0x0c, 0x56, 0xf6,
-		0x58, 0xd3, 0xa7, 0xde, 0x7f, 0x41, 0xb2, 0x1c, 0xba, 0x41, 0x13, 0xc3, 0x37, 0x68, 0x15, 0x4a,
-		0x42, 0x9d, 0xc5, 0xf6, 0x69, 0x1b, 0x55, 0xca, 0x99, 0xb1, 0x20, 0x59, 0xfa, 0x94, 0xb6, 0x91,
-		0x7c, 0x04, 0x65, 0x4d, 0x90, 0x24, 0xa5, 0xa9, 0x33, 0x24, 0x25, 0xcd, 0x72, 0x47, 0xa5, 0xa6,
-		0x0a, 0x5c, 0x72, 0x02, 0x5f, 0xf2, 0xc0, 0x53, 0x39, 0xa2, 0x6c, 0xa5, 0x9f, 0xe6, 0x2d, 0x58,
-		0xcd, 0xb5, 0x63, 0xe2, 0x02, 0xf3, 0x2b, 0x03, 0xfe, 0x4f, 0xd3, 0x30, 0xd9, 0x2a, 0x4e, 0xfa,
-		0x2f, 0x61, 0x36, 0xc9, 0x4d, 0xfa, 0x74, 0xca, 0xf6, 0xa5, 0xcd, 0xcd, 0xec, 0xab, 0x50, 0xc4,
-		0xca, 0x2a, 0x2b, 0x46, 0x29, 0xe3, 0x21, 0x1b, 0x8d, 0x8d, 0xb4, 0xd1, 0xf8, 0x7f, 0x60, 0xa3,
-		0x89, 0x41, 0x1b, 0x6d, 0xc1, 0xfa, 0xe8, 0xf3, 0x17, 0xc7, 0xeb, 0x5f, 0xc7, 0x60, 0xd9, 0x42,
-		0x81, 0xaf, 0xcd, 0xdb, 0xbe, 0x08, 0x53, 0x1c, 0xa9, 0x08, 0x7c, 0x1d, 0xac, 0xfa, 0x8b, 0x3c,
-		0x84, 0x8a, 0x8b, 0x0e, 0x13, 0xf1, 0x1b, 0xd6, 0x60, 0x3e, 0x13, 0x2d, 0x1b, 0x8f, 0xd1, 0xef,
-		0x06, 0xee, 0xb8, 0xb5, 0x90, 0xee, 0x6f, 0xab, 0xed, 0x67, 0xf1, 0xee, 0x8e, 0x3b, 0x14, 0xe3,
-		0x93, 0xc3, 0x31, 0x5e, 0x83, 0xab, 0xe2, 0x90, 0x85, 0xb6, 0xf6, 0x11, 0x47, 0x1a, 0x86, 0x5e,
-		0x47, 0x45, 0xf2, 0xb4, 0x75, 0x25, 0xde, 0x4a, 0x4c, 0x6c, 0x25, 0x1b, 0x71, 0x52, 0xc9, 0xb3,
-		0x57, 0xb1, 0xa5, 0xff, 0x30, 0x06, 0x6f, 0x6a, 0x9b, 0xd6, 0xa9, 0xef, 0xe0, 0xff, 0x42, 0x82,
-		0xb8, 0x06, 0x93, 0x0e, 0x8d, 0x44, 0x9a, 0x1a, 0x92, 0x0f, 0x72, 0x1f, 0x16, 0x1b, 0x8c, 0x0b,
-		0xd9, 0x53, 0xd2, 0xd6, 0x06, 0x99, 0x52, 0x64, 0x57, 0xd5, 0x6e, 0x4f, 0x27, 0x65, 0x9e, 0x75,
-		0x78, 0x6b, 0x94, 0x75, 0xf4, 0xb5, 0xff, 0xdb, 0x18, 0xdc, 0xda, 0x47, 0xde, 0x66, 0x3e, 0x95,
-		0xf8, 0xba, 0x87, 0xed, 0x03, 0xb8, 0xe4, 0xa2, 0xa4, 0xcc, 0x13, 0xba, 0xd2, 0x2b, 0xbe, 0xf8,
-		0x29, 0xf1, 0x80, 0x53, 0x26, 0x87, 0x9c, 0x72, 0x21, 0xfb, 0xbe, 0x01, 0x66, 0x91, 0xd1, 0xb4,
-		0x6d, 0x7f, 0x67, 0xc0, 0xda, 0x53, 0x14, 0x0e, 0x67, 0x07, 0xaf, 0x8b, 0x69, 0xcd, 0xaf, 0xc6,
-		0xe1, 0x56, 0x81, 0x4e, 0xfa, 0xd6, 0x79, 0xb0, 0xd4, 0x33, 0x87, 0x13, 0xf8, 0x0d, 0xd6, 0xd4,
-		0x45, 0x81, 0x4e, 0xf5, 0xf7, 0xcf, 0xa6, 0x41, 0xbd, 0x1f, 0x6a, 0x2d, 0x62, 0xe6, 0x3a, 0x39,
-		0x80, 0xa5, 0xd3, 0x47, 0xb5, 0x99, 0xdf, 0x08, 0xf4, 0x79, 0xef, 0x9c, 0x4d, 0xda, 0x8e, 0xdf,
-		0x08, 0x7a, 0xa5, 0xe5, 0xc0, 0x32, 0x79, 0x09, 0x24, 0x44, 0xdf, 0x65, 0x7e, 0xd3, 0xa6, 0x8e,
-		0x64, 0xc7, 0x4c, 0x32, 0x14, 0x95, 0xf1, 0xb5, 0xf1, 0xf5, 0xd2, 0xe6, 0x7a, 0x76, 0x14, 0x25,
-		0xe4, 0x5b, 0x09, 0x75, 0x47, 0x31, 0xbf, 0x12, 0x0e, 0x2c, 0x32, 0x14, 0xe4, 0xfb, 0x30, 0x9f,
-		0x32, 0x76, 0x5a, 0xcc, 0x73, 0x39, 0xfa, 0x95, 0x09, 0xc5, 0xb6, 0x56, 0xc4, 0xb6, 0x1e, 0xd3,
-		0x0e, 0x6a, 0x7e, 0x39, 0xec, 0xdb, 0xe2, 0xe8, 0x93, 0xbd, 0x1e, 0xeb, 0x34, 0x1b, 0xeb, 0x4e,
-		0xa5, 0x50, 0xe3, 0xa7, 0x9a, 0x76, 0x80, 0x69, 0xba, 0x68, 0x7e, 0x39, 0x0e, 0xd7, 0xbe, 0x13,
-		0x77, 0xb6, 0xa9, 0xf9, 0x5e, 0xd1, 0x1d, 0x7f, 0x04, 0x93, 0xaa, 0xc1, 0xd6, 0x4f, 0xb8, 0x59,
-		0xc8, 0x49, 0x29, 0x6c, 0x25, 0x00, 0x62, 0xc3, 0xa2, 0xfa, 0xc3, 0xe6, 0xf8, 0x19, 0x3a, 0x32,
-		0x8e, 0x4f, 0x97, 0x29, 0xa5, 0x26, 0x54, 0x23, 0xf2, 0xff, 0x99, 0xac, 0x12, 0x16, 0x0a, 0x51,
-		0x4f, 0x01, 0xd6, 0xb5, 0xa3, 0x8c, 0xd5, 0x38, 0x1e, 0x13, 0x01, 0x4e, 0xe0, 0x0b, 0x26, 0x24,
-		0xfa, 0x4e, 0xc7, 0xf6, 0xf0, 0x18, 0x3d, 0x65, 0xfe, 0xbc, 0x56, 0x47, 0x49, 0xa8, 0xf7, 0x20,
-		0x9f, 0xc4, 0x08, 0x6b, 0xe1, 0x28, 0x6b, 0xd9, 0xfc, 0x93, 0x01, 0x0b, 0x43, 0x6e, 0xd0, 0x77,
-		0xef, 0x23, 0x28, 0xa7, 0xc7, 0x13, 0x91, 0x97, 0xd6, 0x56, 0x23, 0x4a, 0x1c, 0x7d, 0x8e, 0x18,
-		0x40, 0x76, 0x60, 0xae, 0xdf, 0x3e, 0xe8, 0x6a, 0x67, 0x99, 0xa3, 0xec, 0x82, 0xae, 0x35, 0x7b,
-		0xd4, 0xff, 0x69, 0xfe, 0xcb, 0x80, 0xa5, 0x34, 0x5b, 0x74, 0xfb, 0xe7, 0x11, 0xf1, 0x32, 0xd0,
-		0x90, 0x8f, 0x9d, 0xaf, 0x21, 0x7f, 0x0e, 0x73, 0x5d, 0x6c, 0x6f, 0x2a, 0x30, 0x97, 0x33, 0x15,
-		0x48, 0x19, 0x24, 0x53, 0x01, 0xd9, 0xf7, 0x15, 0x17, 0x38, 0xcc, 0x77, 0xbc, 0xc8, 0x45, 0xbb,
-		0xc7, 0x50, 0x48, 0x2a, 0xa3, 0xe4, 0xe9, 0x98, 0xb6, 0x16, 0xf4, 0x7e, 0xca, 0x64, 0x4f, 0x6d,
-		0x9a, 0x7f, 0x36, 0xa0, 0x72, 0xfa, 0xc4, 0xda, 0x35, 0xef, 0xc3, 0xa5, 0x30, 0xf0, 0x3c, 0xe4,
-		0xa2, 0x62, 0xa8, 0x2b, 0xbe, 0x9a, 0xed, 0x15, 0x45, 0xa3, 0xae, 0x5f, 0x4a, 0x4f, 0x5e, 0xc0,
-		0xfc, 0x29, 0x45, 0x12, 0xe3, 0xdc, 0x2e, 0x3c, 0x5b, 0xa2, 0x96, 0x35, 0x27, 0x07, 0xd5, 0x7c,
-		0x0f, 0x6e, 0x3c, 0x47, 0x99, 0x12, 0x89, 0x27, 0x9d, 0xa7, 0xca, 0xf8, 0x23, 0x7c, 0x63, 0xfe,
-		0x66, 0x02, 0x6e, 0x66, 0xe3, 0xf4, 0x09, 0x7f, 0x04, 0x8b, 0xdd, 0xc2, 0xb0, 0xa7, 0x6f, 0x9b,
-		0x86, 0xfa, 0xc0, 0xdf, 0xce, 0x54, 0xb6, 0x88, 0x65, 0x2d, 0xcd, 0x3c, 0x29, 0xc5, 0x0b, 0x1a,
-		0x3e, 0xf3, 0x25, 0xef, 0x58, 0x57, 0xdd, 0xd3, 0x3b, 0xb1, 0x02, 0x3a, 0x3f, 0x77, 0x86, 0x14,
-		0x18, 0xbb, 0xa8, 0x02, 0x69, 0x06, 0x3f, 0xad, 0x00, 0x3d, 0xbd, 0x53, 0x8d, 0x62, 0xff, 0x67,
-		0x6b, 0x4c, 0xe6, 0x61, 0xfc, 0x10, 0x3b, 0xda, 0xa6, 0xf1, 0x9f, 0xa4, 0x0e, 0x93, 0xc7, 0xd4,
-		0x8b, 0x50, 0xfb, 0xf2, 0x6e, 0xa6, 0x76, 0x79, 0xf1, 0x64, 0x25, 0xd8, 0xc7, 0x63, 0x8f, 0x8c,
-		0x58, 0x6c, 0x9e, 0x9e, 0x5f, 0xa3, 0x58, 0x53, 0xc0, 0xb2, 0xba, 0x33, 0x9a, 0x64, 0x97, 0x72,
-		0xa9, 0x72, 0xa0, 0xf8, 0x1a, 0x6f, 0xb9, 0xf9, 0xd3, 0x31, 0x58, 0xc9, 0x93, 0xaa, 0xe3, 0xf0,
-		0x08, 0x96, 0x33, 0xc2, 0x20, 0xec, 0x12, 0xea, 0x70, 0xac, 0x15, 0x8a, 0xec, 0xf2, 0x7d, 0x81,
-		0x92, 0xba, 0x54, 0x52, 0xab, 0x3a, 0xec, 0xf1, 0x9e, 0xe8, 0x58, 0x64, 0x46, 0xe8, 0xf7, 0x89,
-		0x1c, 0xbb, 0x98, 0xc8, 0xe1, 0x28, 0xef, 0x89, 0x34, 0x97, 0x60, 0xe1, 0x39, 0xca, 0xba, 0x17,
-		0x09, 0xa9, 0xf3, 0x45, 0x62, 0x75, 0xf3, 0x27, 0x06, 0x2c, 0x0e, 0xef, 0x68, 0xcb, 0xb4, 0xe0,
-		0xba, 0x88, 0xc2, 0x30, 0xe0, 0x12, 0x5d, 0xdb, 0xf1, 0x58, 0xdc, 0xb5, 0x1d, 0x23, 0x17, 0xda,
-		0x2a, 0xb1, 0x23, 0xde, 0xc9, 0xee, 0xc3, 0x53, 0x54, 0x5d, 0x81, 0xbe, 0xa7, 0x31, 0xd6, 0x92,
-		0xc8, 0xde, 0x30, 0x7f, 0x39, 0x0e, 0xe6, 0xf3, 0x8c, 0xde, 0xec, 0xe3, 0x64, 0x74, 0xfe, 0x8a,
-		0xea, 0x86, 0x1b, 0x30, 0x13, 0xd2, 0x26, 0xda, 0x82, 0x7d, 0x91, 0xbc, 0x0e, 0x93, 0xd6, 0x74,
-		0xbc, 0xb0, 0xc7, 0xbe, 0x40, 0xf2, 0x16, 0x5c, 0xf6, 0xf1, 0xf3, 0xd8, 0x6b, 0x4d, 0xb4, 0x65,
-		0x70, 0x88, 0xbe, 0xee, 0xf2, 0x67, 0xe3, 0xe5, 0x5d, 0xda, 0xc4, 0xfd, 0x78, 0x91, 0xbc, 0x0d,
-		0xe4, 0x84, 0x32, 0x69, 0x37, 0x02, 0x6e, 0xfb, 0x78, 0x92, 0x34, 0xbf, 0xea, 0x71, 0x9f, 0xb6,
-		0x2e, 0xc7, 0x3b, 0xdb, 0x01, 0xff, 0x14, 0x4f, 0x54, 0xd7, 0x4b, 0x6c, 0xb8, 0xae, 0xff, 0x5b,
-		0xa0, 0x9b, 0xe4, 0x06, 0xf3, 0x24, 0xf2, 0xe4, 0x7d, 0x9a, 0x52, 0xef, 0xd3, 0x1b, 0x99, 0xe7,
-		0x51, 0xf0, 0x6d, 0x45, 0xac, 0x9e, 0xa8, 0x45, 0xcd, 0x66, 0x68, 0x9d, 0xdc, 0x86, 0x59, 0xd5,
-		0x35, 0x53, 0xee, 0xb4, 0xd8, 0x31, 0x4d, 0xa6, 0x37, 0xd3, 0x56, 0x39, 0x5e, 0xdc, 0xd2, 0x6b,
-		0xe6, 0x3f, 0x0d, 0xb8, 0x5d, 0xe8, 0x0d, 0x1d, 0x1f, 0x0f, 0xe0, 0x92, 0x16, 0x53, 0x58, 0x39,
-		0xa4, 0xb0, 0x94, 0x98, 0x7c, 0x0b, 0x4a, 0x9c, 0x9e, 0xd8, 0x29, 0x36, 0x09, 0xf6, 0xec, 0x2b,
-		0xfd, 0x94, 0x4a, 0xfa, 0xc4, 0x0b, 0x0e, 0x2c, 0xe0, 0xf4, 0x44, 0x33, 0xca, 0x32, 0xfd, 0x78,
-		0x96, 0xe9, 0xab, 0x30, 0x9d, 0x9c, 0x13, 0x5d, 0xfd, 0x12, 0x77, 0xbf, 0xcd, 0x0e, 0x94, 0xb7,
-		0x91, 0xca, 0x88, 0xe3, 0xb6, 0x47, 0x9b, 0x82, 0x30, 0xd8, 0xcc, 0x68, 0x0c, 0xa8, 0xc7, 0x91,
-		0xba, 0x71, 0x75, 0xd6, 0x0e, 0x3d, 0x8c, 0xaf, 0x01, 0x72, 0x1e, 0x70, 0x1b, 0x7d, 0x7a, 0xe0,
-		0x61, 0x32, 0x28, 0x98, 0xb6, 0xee, 0x9e, 0x0a, 0x9d, 0xad, 0x04, 0x57, 0x4f, 0x61, 0xcf, 0x62,
-		0xd4, 0xb3, 0x04, 0x64, 0xfe, 0xca, 0x80, 0x1b, 0x16, 0x36, 0x38, 0x8a, 0x56, 0xf7, 0x9f, 0x0d,
-		0x54, 0x1c, 0x8a, 0x57, 0xd4, 0xa6, 0xad, 0xc0, 0xcd, 0x6c, 0x6d, 0x12, 0x2f, 0x6f, 0xfe, 0xe3,
-		0x32, 0x94, 0xd2, 0x9d, 0xad, 0xdd, 0x1d, 0xf2, 0x33, 0x03, 0x2a, 0x79, 0x53, 0x56, 0xf2, 0x6e,
-		0xce, 0x00, 0xbd, 0xf0, 0xdf, 0x50, 0xd5, 0xf7, 0xce, 0x89, 0xd2, 0xf1, 0xf7, 0x63, 0x03, 0x16,
-		0xb3, 0xa7, 0x67, 0xe4, 0x02, 0xf3, 0xc1, 0xea, 0xfd, 0x73, 0x61, 0xb4, 0x0e, 0x5f, 0x1a, 0xb0,
-		0x94, 0x33, 0xef, 0x24, 0x39, 0x0c, 0x0b, 0xa7, 0xcc, 0xd5, 0x77, 0xcf, 0x07, 0xd2, 0x6a, 0xfc,
-		0xd1, 0x80, 0xb5, 0x51, 0x23, 0x45, 0xf2, 0x61, 0x11, 0xeb, 0x51, 0x93, 0xd8, 0xea, 0x37, 0x2f,
-		0x88, 0xee, 0x73, 0x56, 0xf6, 0x00, 0x2e, 0xc7, 0x59, 0x85, 0xd3, 0xcd, 0x1c, 0x67, 0x8d, 0x98,
-		0xf0, 0xfd, 0xde, 0x80, 0x95, 0xe2, 0x61, 0x15, 0x79, 0x9c, 0xc3, 0xf7, 0x0c, 0xf3, 0xbf, 0xea,
-		0x07, 0x17, 0xc2, 0x6a, 0xdd, 0x7e, 0x6d, 0x40, 0x35, 0x7f, 0xd0, 0x43, 0x1e, 0x64, 0xd7, 0x02,
-		0xa3, 0xc6, 0x69, 0xd5, 0x87, 0xe7, 0xc6, 0x69, 0x7d, 0x7e, 0x61, 0xc0, 0xf5, 0xdc, 0xe9, 0x0d,
-		0x79, 0xaf, 0xb0, 0x0c, 0xcc, 0xd5, 0xe6, 0xc1, 0x79, 0x61, 0x5a, 0x99, 0x06, 0xcc, 0x0e, 0x74,
-		0xb0, 0xa4, 0xa0, 0xf1, 0x1e, 0x1a, 0x36, 0x54, 0xef, 0x9c, 0x85, 0x54, 0xcb, 0x09, 0x60, 0x7e,
-		0xb8, 0x94, 0x25, 0xef, 0x9c, 0xb1, 0xe2, 0x4d, 0xa4, 0x9d, 0xaf, 0x3e, 0x26, 0x3f, 0x84, 0x6b,
-		0x59, 0x0d, 0x05, 0xf9, 0xc6, 0x39, 0x7a, 0x8f, 0x44, 0xf0, 0xbd, 0x73, 0x77, 0x2b, 0xea, 0x4a,
-		0x66, 0x17, 0xc7, 0x39, 0x57, 0xb2, 0xb0, 0x7e, 0xcf, 0xb9, 0x92, 0x23, 0xaa, 0x6f, 0x06, 0x73,
-		0x83, 0xd5, 0x27, 0xb9, 0x93, 0x77, 0x90, 0xd3, 0xc5, 0x6b, 0xf5, 0xed, 0x33, 0xd1, 0x6a, 0x51,
-		0xbf, 0x35, 0x54, 0x27, 0x9b, 0x57, 0xd6, 0x90, 0x87, 0x79, 0xcc, 0x46, 0x94, 0xa5, 0xd5, 0x47,
-		0xe7, 0x07, 0xf6, 0xdc, 0x9f, 0xf5, 0xf6, 0xe6, 0xb8, 0xbf, 0xa0, 0x68, 0xc8, 0x71, 0x7f, 0xd1,
-		0xc3, 0xfe, 0xc4, 0x85, 0x25, 0x27, 0x68, 0x67, 0xe1, 0x9e, 0x5c, 0x4b, 0x11, 0x7b, 0xc9, 0xcf,
-		0x67, 0x76, 0x79, 0x20, 0x83, 0x5d, 0xe3, 0x07, 0xf7, 0x9a, 0x4c, 0xb6, 0xa2, 0x83, 0x9a, 0x13,
-		0xb4, 0x37, 0xfa, 0x7f, 0x82, 0x72, 0x97, 0xb9, 0xde, 0x46, 0x33, 0x48, 0x7e, 0x39, 0xa3, 0x7f,
-		0x8f, 0xf2, 0x01, 0x0d, 0xd9, 0xf1, 0xbd, 0x83, 0x29, 0xb5, 0x76, 0xff, 0xdf, 0x01, 0x00, 0x00,
-		0xff, 0xff, 0xd4, 0xe2, 0x1d, 0x57, 0x9e, 0x23, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x6f, 0xdc, 0xc6,
+		0x15, 0x06, 0x57, 0xf7, 0xb3, 0x92, 0x2c, 0x8f, 0x2d, 0x89, 0x5e, 0x5b, 0x37, 0xe6, 0x52, 0xd5,
+		0x89, 0x57, 0x95, 0xe4, 0xd8, 0x89, 0x93, 0x36, 0x90, 0xd7, 0x96, 0xa3, 0x22, 0x0e, 0x54, 0x4a,
+		0xa9, 0xd1, 0xbe, 0x10, 0x23, 0x72, 0x76, 0x35, 0x11, 0x97, 0xa4, 0x86, 0x43, 0x29, 0x9b, 0x3e,
+		0x14, 0x2d, 0x82, 0x16, 0xe8, 0xbd, 0x7d, 0x2b, 0x50, 0xa0, 0x0f, 0xed, 0x63, 0xd1, 0x97, 0xbe,
+		0xf6, 0xb9, 0xff, 0xa2, 0x0f, 0xfd, 0x05, 0xfd, 0x07, 0x41, 0x31, 0x17, 0xee, 0x4d, 0x24, 0x57,
+		0xab, 0x22, 0x88, 0xdb, 0xb7, 0x9d, 0x99, 0xf3, 0x9d, 0x39, 0x73, 0x6e, 0x73, 0xe6, 0x70, 0xe1,
+		0x6e, 0x72, 0x44, 0xd8, 0x86, 0x8b, 0x3d, 0x12, 0xb8, 0x64, 0x03, 0x47, 0x74, 0xe3, 0x6c, 0x73,
+		0x23, 0x26, 0xec, 0x8c, 0xba, 0xc4, 0x39, 0x0f, 0xd9, 0x49, 0xdd, 0x0f, 0xcf, 0xab, 0x11, 0x0b,
+		0x79, 0x88, 0x6e, 0x08, 0xda, 0xaa, 0xa6, 0xad, 0xe2, 0x88, 0x56, 0xcf, 0x36, 0x2b, 0xcb, 0x8d,
+		0x30, 0x6c, 0xf8, 0x64, 0x43, 0x92, 0x1c, 0x25, 0xf5, 0x0d, 0x2f, 0x61, 0x98, 0xd3, 0x30, 0x50,
+		0xa0, 0xca, 0x6a, 0xd6, 0x06, 0x6e, 0xd8, 0x6c, 0xb6, 0x29, 0xd6, 0xb2, 0x28, 0x8e, 0x69, 0xcc,
+		0x43, 0xd6, 0xd2, 0x24, 0x2b, 0x59, 0x24, 0xa7, 0x09, 0x69, 0x13, 0x58, 0x59, 0x04, 0x1c, 0xc7,
+		0x27, 0x3e, 0x8d, 0x79, 0x11, 0x4d, 0xef, 0x11, 0xad, 0xbf, 0x18, 0xb0, 0x62, 0x93, 0x98, 0x63,
+		0xc6, 0x5f, 0xe8, 0x95, 0xa7, 0x9f, 0x12, 0x37, 0x11, 0x07, 0xb2, 0xc9, 0x69, 0x42, 0x62, 0x8e,
+		0x16, 0x60, 0xdc, 0x0b, 0x9b, 0x98, 0x06, 0xa6, 0xb1, 0x6a, 0xac, 0x4f, 0xd9, 0x7a, 0x84, 0x3e,
+		0x06, 0x94, 0x72, 0x73, 0x48, 0x0a, 0x32, 0x4b, 0xab, 0xc6, 0x7a, 0x79, 0xeb, 0xf5, 0x6a, 0x86,
+		0xee, 0xaa, 0x17, 0xb7, 0xb8, 0x7e, 0xde, 0x3f, 0x85, 0x2a, 0x30, 0x49, 0x3d, 0x12, 0x70, 0xca,
+		0x5b, 0xe6, 0x88, 0xdc, 0xb0, 0x3d, 0xb6, 0x7e, 0x37, 0x05, 0x4b, 0x07, 0x57, 0x12, 0x76, 0x05,
+		0xca, 0x6d, 0x61, 0xa9, 0x27, 0xa5, 0x9c, 0xb2, 0x21, 0x9d, 0xda, 0xf3, 0xd0, 0x2e, 0xcc, 0xb4,
+		0x09, 0x78, 0x2b, 0x22, 0x72, 0xef, 0xf2, 0xd6, 0x5a, 0xe1, 0x41, 0x0e, 0x5b, 0x11, 0xb1, 0xa7,
+		0xcf, 0xbb, 0x46, 0xe8, 0x11, 0x4c, 0x09, 0x3b, 0x38, 0xc2, 0x10, 0xe6, 0xa8, 0xe4, 0xb1, 0x94,
+		0xc9, 0xe3, 0x10, 0xc7, 0x27, 0x1f, 0xd2, 0x98, 0xdb, 0x93, 0x5c, 0xff, 0x42, 0x5b, 0x30, 0x46,
+		0x83, 0x28, 0xe1, 0xe6, 0x98, 0xc4, 0xdd, 0xc9, 0xc4, 0xed, 0xe3, 0x96, 0x1f, 0x62, 0xcf, 0x56,
+		0xa4, 0x08, 0xc3, 0x6a, 0x5b, 0xf9, 0x8e, 0x34, 0xa4, 0xc3, 0x43, 0xc7, 0xf5, 0xc3, 0x98, 0x38,
+		0x9c, 0x36, 0x49, 0x98, 0x70, 0x73, 0x5c, 0xb2, 0xbb, 0x55, 0x55, 0xae, 0x5b, 0x4d, 0x5d, 0xb7,
+		0xfa, 0x44, 0xbb, 0xae, 0x7d, 0xa7, 0xcd, 0x42, 0x6a, 0xf7, 0x30, 0xac, 0x09, 0xfc, 0xa1, 0x82,
+		0xa3, 0x17, 0x70, 0x5b, 0x1e, 0x29, 0x87, 0xfb, 0xc4, 0x20, 0xee, 0x8b, 0x02, 0x9d, 0xc5, 0xb8,
+		0xdb, 0xd4, 0x93, 0xbd, 0xa6, 0x46, 0x4b, 0x00, 0x4c, 0xd9, 0x54, 0xd8, 0x6b, 0x4a, 0xae, 0x4e,
+		0xe9, 0x99, 0x3d, 0x0f, 0xb9, 0x60, 0x76, 0xd9, 0xd3, 0x61, 0x24, 0x89, 0x89, 0x13, 0x85, 0x3e,
+		0x75, 0x5b, 0x26, 0xac, 0x1a, 0xeb, 0xb3, 0x5b, 0x77, 0x0b, 0x2d, 0xb7, 0xe7, 0xd9, 0x02, 0xb2,
+		0x2f, 0x11, 0xf6, 0xfc, 0x79, 0xd6, 0x34, 0xaa, 0xc1, 0x34, 0x23, 0x9c, 0xb5, 0x52, 0xc6, 0x65,
+		0x79, 0xd2, 0xd5, 0x4c, 0xc6, 0xb6, 0x20, 0xd4, 0xec, 0xca, 0xac, 0x33, 0x40, 0xaf, 0xc0, 0x8c,
+		0xcb, 0x84, 0x6d, 0xdc, 0x63, 0xe2, 0x25, 0x3e, 0x31, 0xa7, 0xe5, 0x59, 0xa6, 0xc5, 0xe4, 0x81,
+		0x9e, 0x43, 0xf7, 0x60, 0xb4, 0x49, 0x9a, 0xa1, 0x39, 0xa3, 0x75, 0x99, 0xb5, 0xc3, 0x73, 0xd2,
+		0x0c, 0x6d, 0x49, 0x86, 0x6c, 0xb8, 0x1e, 0x13, 0xcc, 0xdc, 0x63, 0x07, 0x73, 0xce, 0xe8, 0x51,
+		0xc2, 0x49, 0x6c, 0xce, 0x4a, 0xec, 0x6b, 0x99, 0xd8, 0x03, 0x49, 0xbd, 0xd3, 0x26, 0xb6, 0xe7,
+		0xe2, 0xbe, 0x19, 0xb4, 0x0d, 0xe3, 0xc7, 0x04, 0x7b, 0x84, 0x99, 0xd7, 0x24, 0xa3, 0xdb, 0x99,
+		0x8c, 0x3e, 0x90, 0x24, 0xb6, 0x26, 0x45, 0x8f, 0xa0, 0xec, 0x11, 0x1f, 0xb7, 0x94, 0x6f, 0x98,
+		0x73, 0x83, 0x5c, 0x01, 0x24, 0xb5, 0xf4, 0x05, 0xf4, 0x1e, 0x4c, 0x7f, 0x42, 0x39, 0x27, 0x4c,
+		0x83, 0xaf, 0x0f, 0x02, 0x97, 0x15, 0xb9, 0x42, 0xfb, 0x70, 0xbb, 0xdb, 0x01, 0xdc, 0x30, 0xa8,
+		0xfb, 0xd4, 0xe5, 0xa9, 0xa9, 0x90, 0xf4, 0x81, 0x7b, 0x03, 0x7c, 0xa0, 0xa6, 0x51, 0xda, 0x6e,
+		0xe6, 0x79, 0xce, 0x8a, 0xf0, 0xd4, 0x88, 0xd1, 0x90, 0x09, 0x4f, 0xbd, 0xb1, 0x6a, 0xac, 0x8f,
+		0xd9, 0xed, 0xb1, 0xf5, 0x10, 0x96, 0xf3, 0x72, 0x52, 0x1c, 0x85, 0x41, 0x4c, 0xd0, 0x3c, 0x8c,
+		0xb3, 0x24, 0x10, 0x7e, 0xac, 0x92, 0xd2, 0x18, 0x4b, 0x82, 0x3d, 0xcf, 0x7a, 0x07, 0x56, 0xf3,
+		0x73, 0x6f, 0x31, 0xf4, 0x1f, 0x25, 0x58, 0x3e, 0xa0, 0x8d, 0x00, 0xfb, 0xff, 0x03, 0x69, 0xbb,
+		0x2f, 0x96, 0x47, 0xfb, 0x63, 0x79, 0x05, 0xca, 0xb1, 0x3c, 0x8b, 0x13, 0xe0, 0x26, 0x91, 0xc9,
+		0x6f, 0xca, 0x06, 0x35, 0xf5, 0x11, 0x6e, 0x12, 0xf4, 0x3e, 0x4c, 0x6b, 0x02, 0x95, 0x1e, 0xc7,
+		0x2f, 0x91, 0x1e, 0x35, 0xcb, 0x3d, 0x99, 0x24, 0x4d, 0x98, 0x70, 0xc3, 0x80, 0xb3, 0xd0, 0x97,
+		0xd9, 0x6a, 0xda, 0x4e, 0x87, 0xd6, 0x1a, 0xac, 0xe4, 0xea, 0x51, 0x99, 0xc0, 0xfa, 0xc2, 0x80,
+		0xaf, 0x69, 0x1a, 0xca, 0x8f, 0x8b, 0xaf, 0x9f, 0x17, 0x30, 0xa3, 0xb2, 0xa4, 0x3e, 0x9d, 0xd4,
+		0x7d, 0x79, 0x6b, 0x2b, 0x3b, 0x28, 0x8b, 0x58, 0xd9, 0xd3, 0x92, 0x51, 0xca, 0xb8, 0x4f, 0x47,
+		0xa5, 0x81, 0x3a, 0x1a, 0xf9, 0x2f, 0x74, 0x34, 0xda, 0xab, 0xa3, 0x1d, 0x58, 0x1f, 0x7c, 0xfe,
+		0x62, 0x7f, 0xfd, 0x7b, 0x09, 0x96, 0x3f, 0x8e, 0x3c, 0xcc, 0xc9, 0xcb, 0xe2, 0xaf, 0xb7, 0x61,
+		0x2a, 0x91, 0x02, 0x09, 0x59, 0xb5, 0xc3, 0xaa, 0x09, 0xe5, 0x91, 0x7a, 0x51, 0x6a, 0x5b, 0x79,
+		0x2c, 0xa8, 0x29, 0xa9, 0xed, 0xab, 0xdc, 0xd4, 0xdd, 0x11, 0x32, 0x5e, 0x18, 0x21, 0x13, 0x7d,
+		0x11, 0x62, 0xfd, 0xda, 0x80, 0x95, 0x5c, 0xf5, 0x69, 0xcd, 0xdf, 0x87, 0x71, 0x46, 0xe2, 0xc4,
+		0x4f, 0x7d, 0xae, 0x58, 0x26, 0x4d, 0x8b, 0x1e, 0xc0, 0x44, 0x1d, 0x53, 0x3f, 0x61, 0xc4, 0x2c,
+		0x15, 0xc0, 0x76, 0x15, 0x8d, 0x9d, 0x12, 0x5b, 0x7f, 0x2d, 0xc1, 0x92, 0x4d, 0x62, 0xf2, 0xd2,
+		0x94, 0x8d, 0x0b, 0xe2, 0xf8, 0x38, 0x0e, 0x03, 0x6d, 0x4c, 0x3d, 0x42, 0x0f, 0xc1, 0xf4, 0x88,
+		0x4b, 0x63, 0x51, 0x1e, 0xd5, 0x69, 0x40, 0xe3, 0x63, 0x87, 0x9c, 0x91, 0xa0, 0x9d, 0x89, 0x46,
+		0xec, 0xf9, 0x74, 0x7d, 0x57, 0x2e, 0x3f, 0x15, 0xab, 0x7b, 0x5e, 0x9f, 0x49, 0xc6, 0xfa, 0x93,
+		0x56, 0x15, 0x6e, 0xc4, 0x27, 0x34, 0x72, 0x74, 0xd0, 0x31, 0x82, 0xa3, 0xc8, 0x57, 0x86, 0x9d,
+		0xb4, 0xaf, 0x8b, 0x25, 0x15, 0x33, 0xb6, 0x5a, 0x10, 0xb7, 0x44, 0x9e, 0xbe, 0x8a, 0x43, 0xe7,
+		0x0f, 0x25, 0x78, 0x4d, 0xeb, 0xb4, 0x86, 0x03, 0x97, 0xfc, 0x3f, 0x64, 0xfc, 0x9b, 0x30, 0xe6,
+		0xe2, 0x24, 0x4e, 0x73, 0xbd, 0x1a, 0xa0, 0x6d, 0x58, 0xa8, 0x53, 0x16, 0xf3, 0x8e, 0x90, 0x8e,
+		0x56, 0x88, 0x0a, 0x97, 0x1b, 0x72, 0xb5, 0x23, 0x93, 0x54, 0xcf, 0x3a, 0xbc, 0x3e, 0x48, 0x3b,
+		0x3a, 0x8f, 0xff, 0xad, 0x04, 0x6b, 0x87, 0x84, 0x35, 0x69, 0xf0, 0x12, 0xa5, 0xa1, 0x3c, 0xb7,
+		0x7d, 0x00, 0x13, 0x1e, 0xe1, 0x98, 0xfa, 0xb1, 0x39, 0x5a, 0x10, 0x97, 0x69, 0x38, 0xa7, 0xc4,
+		0x3d, 0x46, 0x19, 0xeb, 0x33, 0xca, 0x95, 0xf4, 0xfb, 0x2a, 0x58, 0x45, 0x4a, 0xd3, 0xba, 0xfd,
+		0xad, 0x01, 0xab, 0x4f, 0x48, 0xec, 0x32, 0x7a, 0xf4, 0xb2, 0xa8, 0xd6, 0xfa, 0x62, 0x04, 0xd6,
+		0x0a, 0x64, 0xd2, 0x51, 0xe7, 0xc3, 0x62, 0x47, 0x1d, 0xa2, 0x8a, 0xa4, 0x0d, 0x5d, 0x6f, 0xea,
+		0x3c, 0xba, 0x7d, 0x39, 0x09, 0x6a, 0xdd, 0x50, 0x7b, 0x81, 0x64, 0xce, 0xa3, 0x23, 0x58, 0xbc,
+		0x78, 0x54, 0x87, 0x06, 0xf5, 0x50, 0x9f, 0xf7, 0xee, 0xe5, 0x76, 0xdb, 0x0b, 0xea, 0x61, 0xe7,
+		0xd5, 0xd2, 0x33, 0x8d, 0x5e, 0x00, 0x8a, 0x48, 0xe0, 0xd1, 0xa0, 0xe1, 0x60, 0x97, 0xd3, 0x33,
+		0xca, 0x29, 0x89, 0xcd, 0x91, 0xd5, 0x91, 0xf5, 0xf2, 0xd6, 0x7a, 0xb6, 0x17, 0x29, 0xf2, 0x1d,
+		0x45, 0xdd, 0x92, 0xcc, 0xaf, 0x47, 0x3d, 0x93, 0x94, 0xc4, 0xe8, 0x7b, 0x30, 0x97, 0x32, 0x76,
+		0x8f, 0xa9, 0xef, 0x31, 0x12, 0x98, 0xa3, 0x92, 0x6d, 0xb5, 0x88, 0x6d, 0x4d, 0xd0, 0xf6, 0x4a,
+		0x7e, 0x2d, 0xea, 0x5a, 0x62, 0x24, 0x40, 0x07, 0x1d, 0xd6, 0x69, 0x36, 0xd6, 0x57, 0x6b, 0xa1,
+		0xc4, 0x4f, 0x34, 0x6d, 0x0f, 0xd3, 0x74, 0xd2, 0xfa, 0x7c, 0x04, 0x6e, 0x7e, 0x47, 0x34, 0x4d,
+		0x52, 0xf5, 0x7d, 0x45, 0x31, 0xfe, 0x36, 0x8c, 0xc9, 0xde, 0x8d, 0xae, 0xc9, 0xac, 0x42, 0x4e,
+		0x52, 0x60, 0x5b, 0x01, 0x90, 0x03, 0x0b, 0xf2, 0x87, 0xc3, 0xc8, 0x27, 0xc4, 0xe5, 0xc2, 0x3f,
+		0x3d, 0x2a, 0x85, 0x1a, 0x95, 0xef, 0x9b, 0xaf, 0x67, 0xb2, 0x52, 0x2c, 0x24, 0xa2, 0x96, 0x02,
+		0xec, 0x9b, 0xa7, 0x19, 0xb3, 0xc2, 0x1f, 0xd5, 0x06, 0x6e, 0x18, 0xc4, 0x34, 0xe6, 0x24, 0x70,
+		0x5b, 0x8e, 0x4f, 0xce, 0x88, 0x6f, 0x8e, 0x15, 0xbc, 0xa2, 0xe5, 0x0e, 0xb5, 0x0e, 0xe4, 0x43,
+		0x81, 0xb0, 0xe7, 0x4f, 0xb3, 0xa6, 0xad, 0x3f, 0x19, 0x30, 0xdf, 0x67, 0x06, 0x1d, 0x7b, 0xef,
+		0xc3, 0x74, 0x7a, 0xbc, 0x4b, 0x17, 0x2e, 0x65, 0x7d, 0x0e, 0x01, 0x40, 0x7b, 0x30, 0xdb, 0xad,
+		0x1f, 0xe2, 0x99, 0xa5, 0x02, 0x15, 0x77, 0xe9, 0x85, 0x78, 0xf6, 0xcc, 0x69, 0xf7, 0xd0, 0xfa,
+		0xb7, 0x01, 0x8b, 0x69, 0xb6, 0x68, 0xb7, 0x66, 0x06, 0xf8, 0x4b, 0x4f, 0xaf, 0xa7, 0x34, 0x5c,
+		0xaf, 0xe7, 0x19, 0xcc, 0xb6, 0xb1, 0x9d, 0x86, 0xd3, 0xec, 0xd6, 0x5a, 0x21, 0x03, 0xd5, 0x70,
+		0xe2, 0x5d, 0x23, 0x51, 0xe0, 0xd0, 0xc0, 0xf5, 0x13, 0x8f, 0x38, 0x1d, 0x86, 0x31, 0xc7, 0x3c,
+		0x51, 0x57, 0xc7, 0xa4, 0x3d, 0xaf, 0xd7, 0x53, 0x26, 0x07, 0x72, 0xd1, 0xfa, 0xb3, 0x01, 0xe6,
+		0xc5, 0x13, 0x6b, 0xd3, 0xbc, 0x03, 0x13, 0x51, 0xe8, 0xfb, 0x84, 0xc5, 0xa6, 0x21, 0x43, 0x7c,
+		0x25, 0xdb, 0x2a, 0x92, 0x46, 0x86, 0x5f, 0x4a, 0x8f, 0x9e, 0xc3, 0xdc, 0x05, 0x41, 0x94, 0x72,
+		0x5e, 0x29, 0x3c, 0x9b, 0x12, 0xcb, 0x9e, 0xe5, 0xbd, 0x62, 0xbe, 0x05, 0xb7, 0x9f, 0x11, 0x9e,
+		0x12, 0xc5, 0x8f, 0x5b, 0x4f, 0xa4, 0xf2, 0x07, 0xd8, 0xc6, 0xfa, 0xd5, 0x28, 0xdc, 0xc9, 0xc6,
+		0xe9, 0x13, 0xfe, 0x10, 0x16, 0xda, 0x85, 0x61, 0x47, 0xde, 0x26, 0x8e, 0xf4, 0x81, 0xbf, 0x9d,
+		0x29, 0x6c, 0x11, 0xcb, 0x6a, 0x9a, 0x79, 0x52, 0x8a, 0xe7, 0x38, 0x7a, 0x1a, 0x70, 0xd6, 0xb2,
+		0x6f, 0x78, 0x17, 0x57, 0x84, 0x00, 0x3a, 0x3f, 0xb7, 0xfa, 0x04, 0x28, 0x5d, 0x55, 0x80, 0x34,
+		0x83, 0x5f, 0x14, 0x00, 0x5f, 0x5c, 0xa9, 0x24, 0xc2, 0xfe, 0xd9, 0x12, 0xa3, 0x39, 0x18, 0x39,
+		0x21, 0x2d, 0xad, 0x53, 0xf1, 0x13, 0xd5, 0x60, 0xec, 0x0c, 0xfb, 0x49, 0xfa, 0x4e, 0xc8, 0x6e,
+		0xad, 0xe4, 0xf9, 0x93, 0xad, 0xb0, 0x8f, 0x4a, 0x6f, 0x1b, 0x62, 0xdb, 0x3c, 0x39, 0xbf, 0xc4,
+		0x6d, 0xad, 0x18, 0x96, 0x64, 0xcc, 0x68, 0x92, 0x7d, 0xcc, 0xb8, 0xcc, 0x81, 0xf1, 0x97, 0x18,
+		0xe5, 0xd6, 0x4f, 0x4a, 0xb0, 0x9c, 0xb7, 0xab, 0xf6, 0xc3, 0x53, 0x58, 0xca, 0x70, 0x83, 0xa8,
+		0x4d, 0x68, 0x1a, 0x05, 0x57, 0xec, 0x05, 0xbe, 0xcf, 0x09, 0xc7, 0x1e, 0xe6, 0xd8, 0xae, 0xf4,
+		0x5b, 0xbc, 0xb3, 0xb5, 0xd8, 0x32, 0xc3, 0xf5, 0xbb, 0xb6, 0x2c, 0x5d, 0x6d, 0xcb, 0x7e, 0x2f,
+		0xef, 0x6c, 0x69, 0x2d, 0xc2, 0xfc, 0x33, 0xc2, 0x6b, 0x7e, 0x12, 0x73, 0x9d, 0x2f, 0x94, 0xd6,
+		0xad, 0x1f, 0x1b, 0xb0, 0xd0, 0xbf, 0xa2, 0x35, 0x73, 0x0c, 0xb7, 0xe2, 0x24, 0x8a, 0x42, 0xc6,
+		0x89, 0xe7, 0xb8, 0x3e, 0x15, 0xaf, 0xb6, 0x33, 0xc2, 0x62, 0xad, 0x15, 0x61, 0x88, 0x37, 0xb3,
+		0x1b, 0x2b, 0x29, 0xaa, 0x26, 0x41, 0xdf, 0xd5, 0x18, 0x7b, 0x31, 0xce, 0x5e, 0xb0, 0x7e, 0x3e,
+		0x02, 0xd6, 0xb3, 0x8c, 0xb7, 0xd9, 0x07, 0xea, 0xab, 0xcc, 0x57, 0xd7, 0xa2, 0x88, 0x70, 0x83,
+		0x38, 0x31, 0xfd, 0x4c, 0xdd, 0x0e, 0xa2, 0xeb, 0x88, 0x1b, 0xe4, 0x80, 0x7e, 0x46, 0xd0, 0xeb,
+		0x70, 0x2d, 0x20, 0x9f, 0x0a, 0xab, 0x35, 0x88, 0xc3, 0xc3, 0x13, 0x12, 0xe8, 0xb6, 0xcd, 0x8c,
+		0x98, 0xde, 0xc7, 0x0d, 0x72, 0x28, 0x26, 0xd1, 0x1b, 0x80, 0xce, 0x31, 0xe5, 0x4e, 0x3d, 0x64,
+		0x4e, 0x40, 0xce, 0xd5, 0xe3, 0x57, 0x5e, 0xee, 0x93, 0xf6, 0x35, 0xb1, 0xb2, 0x1b, 0xb2, 0x8f,
+		0xc8, 0xb9, 0x7c, 0xf5, 0x22, 0x07, 0x6e, 0xe9, 0x0f, 0x51, 0x8a, 0xce, 0xa9, 0x53, 0x5f, 0x34,
+		0x68, 0xe5, 0xfd, 0x34, 0x2e, 0xef, 0xa7, 0x57, 0x33, 0xcf, 0x23, 0xe1, 0xbb, 0x92, 0x58, 0x5e,
+		0x51, 0x0b, 0x9a, 0x4d, 0xdf, 0xbc, 0x68, 0x86, 0xcb, 0x57, 0xb3, 0xe8, 0x3d, 0xd3, 0x33, 0xac,
+		0xda, 0x71, 0x93, 0xf6, 0xb4, 0x98, 0xdc, 0xd1, 0x73, 0xd6, 0xbf, 0x0c, 0x78, 0xa5, 0xd0, 0x1a,
+		0xda, 0x3f, 0x1e, 0xc0, 0x84, 0xde, 0xa6, 0xb0, 0x72, 0x48, 0x61, 0x29, 0x31, 0xfa, 0x16, 0x94,
+		0x19, 0x3e, 0x77, 0x52, 0xac, 0x72, 0xf6, 0xec, 0x90, 0x7e, 0x82, 0x39, 0x7e, 0xec, 0x87, 0x47,
+		0x36, 0x30, 0x7c, 0xae, 0x19, 0x65, 0xa9, 0x7e, 0x24, 0x4b, 0xf5, 0x15, 0x98, 0x54, 0xe7, 0x24,
+		0x9e, 0xbe, 0x89, 0xdb, 0x63, 0xab, 0x05, 0xd3, 0xbb, 0x04, 0xf3, 0x84, 0x91, 0x5d, 0x1f, 0x37,
+		0x62, 0x44, 0x61, 0x2b, 0xe3, 0x61, 0x80, 0x7d, 0x46, 0xb0, 0x27, 0xaa, 0xb3, 0x66, 0xe4, 0x13,
+		0x11, 0x06, 0x84, 0xb1, 0x90, 0x39, 0x24, 0xc0, 0x47, 0x3e, 0x51, 0x8d, 0x82, 0x49, 0xfb, 0xde,
+		0x05, 0xd7, 0xd9, 0x51, 0xb8, 0x5a, 0x0a, 0x7b, 0x2a, 0x50, 0x4f, 0x15, 0xc8, 0xfa, 0x85, 0x01,
+		0xb7, 0x6d, 0x52, 0x67, 0x24, 0x3e, 0x6e, 0x7f, 0xc7, 0xc2, 0xf1, 0x49, 0xfc, 0x15, 0x3d, 0xd3,
+		0x96, 0xe1, 0x4e, 0xb6, 0x34, 0xca, 0xca, 0x5b, 0xff, 0x9c, 0x83, 0x72, 0xba, 0xb2, 0xb3, 0xbf,
+		0x87, 0x7e, 0x6a, 0x80, 0x99, 0xd7, 0x36, 0x47, 0xf7, 0x73, 0xbe, 0xcd, 0x14, 0x7e, 0xe1, 0xac,
+		0xbc, 0x35, 0x24, 0x4a, 0xfb, 0xdf, 0x8f, 0x0c, 0x58, 0xc8, 0x6e, 0x87, 0xa2, 0x2b, 0x34, 0x7c,
+		0x2b, 0xdb, 0x43, 0x61, 0xb4, 0x0c, 0x9f, 0x1b, 0xb0, 0x98, 0xd3, 0xc0, 0x46, 0x39, 0x0c, 0x0b,
+		0x3f, 0x1b, 0x54, 0xee, 0x0f, 0x07, 0xd2, 0x62, 0xfc, 0xd1, 0x80, 0xd5, 0x41, 0x3d, 0x62, 0xf4,
+		0x5e, 0x11, 0xeb, 0x41, 0xad, 0xf5, 0xca, 0x37, 0xaf, 0x88, 0xee, 0x52, 0x54, 0x4e, 0x0b, 0x35,
+		0x47, 0x51, 0xc5, 0xfd, 0xea, 0xca, 0xfd, 0xe1, 0x40, 0x5d, 0x3e, 0x93, 0xdd, 0x07, 0xcc, 0xf1,
+		0x99, 0xc2, 0x26, 0x6b, 0x65, 0x7b, 0x28, 0x8c, 0x96, 0xe1, 0xf7, 0x06, 0x2c, 0x6b, 0x06, 0x39,
+		0x3d, 0x33, 0xf4, 0x28, 0x87, 0xef, 0x25, 0xda, 0x90, 0x95, 0x77, 0xaf, 0x84, 0xd5, 0xb2, 0xfd,
+		0xd2, 0x80, 0x4a, 0x7e, 0xbf, 0x09, 0x3d, 0xc8, 0x2e, 0x49, 0x06, 0x75, 0xf5, 0x2a, 0x0f, 0x87,
+		0xc6, 0x69, 0x79, 0x7e, 0x66, 0xc0, 0xad, 0xdc, 0x26, 0x12, 0x7a, 0xab, 0xb0, 0x1a, 0xcd, 0x95,
+		0xe6, 0xc1, 0xb0, 0x30, 0x2d, 0x4c, 0x1d, 0x66, 0x7a, 0x1e, 0xd2, 0xa8, 0xe0, 0xfd, 0xdf, 0xd7,
+		0xf3, 0xa8, 0xdc, 0xbd, 0x0c, 0xa9, 0xde, 0x27, 0x84, 0xb9, 0xfe, 0x8a, 0x1a, 0xbd, 0x79, 0xc9,
+		0xc2, 0x5b, 0xed, 0x36, 0x5c, 0x99, 0x8e, 0x7e, 0x00, 0x37, 0xb3, 0xde, 0x35, 0xe8, 0x1b, 0x43,
+		0x3c, 0x81, 0xd4, 0xc6, 0x9b, 0x43, 0x3f, 0x9a, 0x64, 0x48, 0x66, 0xd7, 0xe8, 0x39, 0x21, 0x59,
+		0xf8, 0x8c, 0xc8, 0x09, 0xc9, 0x01, 0x8f, 0x00, 0x0a, 0xb3, 0xbd, 0x45, 0x30, 0xba, 0x9b, 0x77,
+		0x90, 0x8b, 0x35, 0x74, 0xe5, 0x8d, 0x4b, 0xd1, 0xea, 0xad, 0x7e, 0x63, 0xc8, 0x07, 0x75, 0x5e,
+		0x75, 0x85, 0x1e, 0xe6, 0x31, 0x1b, 0x50, 0x1d, 0x57, 0xde, 0x1e, 0x1e, 0xd8, 0x31, 0x7f, 0x56,
+		0x09, 0x90, 0x63, 0xfe, 0x82, 0xda, 0xa5, 0xb2, 0x39, 0x04, 0x42, 0x6d, 0xfe, 0xd8, 0x83, 0x45,
+		0x37, 0x6c, 0x66, 0xe1, 0x1e, 0xdf, 0x4c, 0x11, 0x07, 0xea, 0x0f, 0x62, 0xfb, 0x2c, 0xe4, 0xe1,
+		0xbe, 0xf1, 0xfd, 0xcd, 0x06, 0xe5, 0xc7, 0xc9, 0x51, 0xd5, 0x0d, 0x9b, 0x1b, 0xdd, 0x7f, 0xb2,
+		0xba, 0x47, 0x3d, 0x7f, 0xa3, 0x11, 0xaa, 0xff, 0x86, 0xe9, 0x7f, 0x5c, 0xbd, 0x8b, 0x23, 0x7a,
+		0xb6, 0x79, 0x34, 0x2e, 0xe7, 0xb6, 0xff, 0x33, 0x00, 0x63, 0x5d, 0xdb, 0xc6, 0x80, 0x26, 0x00,
+		0x00,
 	},
 	// uber/cadence/api/v1/service_worker.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdc, 0xc6,
-		0x15, 0x06, 0x75, 0xd7, 0x59, 0x5d, 0x56, 0x63, 0xd4, 0x5d, 0xad, 0x6f, 0xf2, 0xa6, 0xb6, 0x95,
-		0x36, 0xdd, 0xad, 0x95, 0xd4, 0x71, 0xec, 0xb8, 0xa8, 0x2e, 0x16, 0xac, 0x22, 0x4d, 0x15, 0x7a,
-		0xe3, 0x00, 0x29, 0x60, 0x62, 0x96, 0x1c, 0x69, 0x07, 0xe2, 0x72, 0x68, 0x72, 0xb8, 0xf2, 0xbe,
-		0xf4, 0xa1, 0x8f, 0x49, 0x1f, 0x0a, 0x14, 0x4d, 0x5f, 0x0a, 0xf8, 0xb9, 0x3f, 0xa0, 0xbf, 0xa6,
-		0x4f, 0x7d, 0xee, 0x7f, 0x28, 0x0a, 0xce, 0x0c, 0xb9, 0xdc, 0xdd, 0x21, 0xf7, 0xd2, 0x07, 0x05,
-		0xc8, 0x9b, 0x38, 0xfc, 0xce, 0xe1, 0x99, 0xf3, 0x9d, 0x39, 0xf3, 0xcd, 0x68, 0x61, 0x37, 0x6a,
-		0x91, 0xa0, 0x61, 0x63, 0x87, 0x78, 0x36, 0x69, 0x60, 0x9f, 0x36, 0xba, 0x0f, 0x1b, 0x21, 0x09,
-		0xba, 0xd4, 0x26, 0xd6, 0x25, 0x0b, 0x2e, 0x48, 0x50, 0xf7, 0x03, 0xc6, 0x19, 0xba, 0x16, 0x23,
-		0xeb, 0x0a, 0x59, 0xc7, 0x3e, 0xad, 0x77, 0x1f, 0x56, 0x6f, 0x9f, 0x33, 0x76, 0xee, 0x92, 0x86,
-		0x80, 0xb4, 0xa2, 0xb3, 0x86, 0x13, 0x05, 0x98, 0x53, 0xe6, 0x49, 0xa3, 0xea, 0x9d, 0xe1, 0xf7,
-		0x9c, 0x76, 0x48, 0xc8, 0x71, 0xc7, 0x57, 0x80, 0x11, 0x07, 0x97, 0x01, 0xf6, 0x7d, 0x12, 0x84,
-		0xea, 0xfd, 0x8e, 0x2e, 0x3e, 0x9b, 0x75, 0x3a, 0xe9, 0x27, 0x6a, 0x3a, 0x84, 0x43, 0x6c, 0x1a,
-		0xf6, 0xc3, 0xb8, 0xab, 0xc3, 0xb4, 0x69, 0xc8, 0x59, 0xd0, 0x4b, 0x22, 0xd5, 0x41, 0xde, 0x44,
-		0x24, 0x05, 0x68, 0xbf, 0xc3, 0x71, 0x78, 0xe1, 0xd2, 0x90, 0x17, 0x61, 0xe2, 0x2c, 0x9e, 0xb9,
-		0xec, 0x52, 0x62, 0x6a, 0xff, 0x34, 0xa0, 0x7a, 0xca, 0x5c, 0xf7, 0x98, 0x05, 0x47, 0x2a, 0xca,
-		0x26, 0x0e, 0x2f, 0x4c, 0xf2, 0x26, 0x22, 0x21, 0x47, 0xd7, 0x61, 0xc9, 0x61, 0x1d, 0x4c, 0xbd,
-		0x8a, 0xb1, 0x63, 0xec, 0xae, 0x9a, 0xea, 0x09, 0x3d, 0x81, 0xd5, 0xf8, 0x63, 0x56, 0xfc, 0xb5,
-		0xca, 0xdc, 0x8e, 0xb1, 0x5b, 0xda, 0xbb, 0x55, 0xd7, 0x50, 0x52, 0x8f, 0x9d, 0x7d, 0x46, 0x43,
-		0x6e, 0xae, 0x70, 0xf5, 0x17, 0xaa, 0xc2, 0x0a, 0x75, 0x88, 0xc7, 0x29, 0xef, 0x55, 0xe6, 0x85,
-		0xd7, 0xf4, 0x19, 0x3d, 0x80, 0xcd, 0x16, 0xf5, 0x70, 0xd0, 0xb3, 0xec, 0x36, 0xb1, 0x2f, 0xc2,
-		0xa8, 0x53, 0x59, 0x10, 0x90, 0x0d, 0x39, 0x7c, 0xa8, 0x46, 0x6b, 0xff, 0x5d, 0x86, 0x1b, 0xda,
-		0xb8, 0x43, 0x9f, 0x79, 0x21, 0x41, 0xb7, 0x00, 0x44, 0x80, 0x9c, 0x5d, 0x10, 0x19, 0xfc, 0x9a,
-		0x29, 0x42, 0x6e, 0xc6, 0x03, 0xe8, 0x4b, 0x40, 0x49, 0x22, 0x2c, 0xf2, 0x96, 0xd8, 0x51, 0x5c,
-		0x25, 0x6a, 0x22, 0xf7, 0xb5, 0x13, 0xf9, 0x4a, 0xc1, 0x9f, 0x27, 0x68, 0x73, 0xeb, 0x72, 0x78,
-		0x08, 0x1d, 0xc3, 0x7a, 0xea, 0x96, 0xf7, 0x7c, 0x22, 0xe6, 0x57, 0xda, 0xbb, 0x5b, 0xe8, 0xb1,
-		0xd9, 0xf3, 0x89, 0xb9, 0x76, 0x99, 0x79, 0x42, 0xaf, 0x60, 0xdb, 0x0f, 0x48, 0x97, 0xb2, 0x28,
-		0xb4, 0x42, 0x8e, 0x03, 0x4e, 0x1c, 0x8b, 0x74, 0x89, 0xc7, 0x2d, 0xea, 0x88, 0x84, 0x94, 0xf6,
-		0x6e, 0xd4, 0x65, 0xad, 0xd6, 0x93, 0x5a, 0xad, 0x9f, 0x78, 0xfc, 0xd1, 0x47, 0xaf, 0xb0, 0x1b,
-		0x11, 0xf3, 0x7a, 0x62, 0xfd, 0x52, 0x1a, 0x3f, 0x8f, 0x6d, 0x4f, 0x1c, 0xb4, 0x0b, 0xe5, 0x11,
-		0x77, 0x8b, 0x3b, 0xc6, 0xee, 0xbc, 0xb9, 0x11, 0x0e, 0x22, 0x2b, 0xb0, 0x8c, 0x39, 0x27, 0x1d,
-		0x9f, 0x57, 0x96, 0x04, 0x20, 0x79, 0x44, 0x1f, 0x00, 0x6a, 0x61, 0xfb, 0xc2, 0x65, 0xe7, 0x96,
-		0xcd, 0x22, 0x8f, 0x5b, 0x6d, 0xea, 0xf1, 0xca, 0xb2, 0x00, 0x95, 0xd5, 0x9b, 0xc3, 0xf8, 0xc5,
-		0x0b, 0xea, 0x71, 0xf4, 0x08, 0x96, 0x55, 0x65, 0x57, 0x56, 0x44, 0xdc, 0x37, 0xb5, 0xb9, 0x78,
-		0x21, 0x31, 0x66, 0x02, 0x46, 0xf7, 0x61, 0xd3, 0x23, 0x6f, 0xb9, 0xe5, 0xe3, 0x73, 0xa2, 0x48,
-		0x5c, 0x15, 0x24, 0xae, 0xc7, 0xc3, 0xa7, 0xf8, 0x9c, 0x48, 0x22, 0x1f, 0xc3, 0xa2, 0x58, 0x16,
-		0x15, 0x10, 0xde, 0x6b, 0x85, 0x99, 0xfe, 0x22, 0x46, 0x9a, 0xd2, 0x00, 0xbd, 0x86, 0x9b, 0xa3,
-		0x25, 0x60, 0xf5, 0xab, 0xba, 0x34, 0x49, 0x55, 0x6f, 0x8f, 0xd4, 0x40, 0xf2, 0x0a, 0xed, 0xc3,
-		0x46, 0x68, 0xb7, 0x89, 0x13, 0xb9, 0xc4, 0xb1, 0xe2, 0x46, 0x53, 0x59, 0x13, 0x1e, 0xab, 0x23,
-		0xc4, 0x35, 0x93, 0x2e, 0x64, 0xae, 0xa7, 0x16, 0xf1, 0x18, 0x7a, 0x06, 0x6b, 0x09, 0x5d, 0xc2,
-		0xc1, 0xfa, 0x58, 0x07, 0x25, 0x85, 0x17, 0xe6, 0x5f, 0xc1, 0x72, 0x3c, 0x55, 0x4a, 0xc2, 0xca,
-		0xc6, 0xce, 0xfc, 0x6e, 0x69, 0xef, 0x99, 0x76, 0x32, 0x05, 0xcb, 0xa8, 0xfe, 0x85, 0xb4, 0x7f,
-		0xee, 0xf1, 0x98, 0x1c, 0xe5, 0x0d, 0xd5, 0x40, 0xb0, 0xd0, 0xaf, 0xa1, 0x4d, 0xc1, 0x7e, 0x29,
-		0x1e, 0x54, 0x05, 0x54, 0x7d, 0x0d, 0x6b, 0x59, 0x63, 0x54, 0x86, 0xf9, 0x0b, 0xd2, 0x53, 0x6d,
-		0x24, 0xfe, 0x33, 0xa6, 0xae, 0x1b, 0x57, 0xab, 0x5a, 0x76, 0x13, 0x51, 0x27, 0x0c, 0x9e, 0xcc,
-		0x3d, 0x36, 0x6a, 0xff, 0x58, 0x84, 0xf7, 0x64, 0x98, 0x4e, 0x36, 0xf2, 0x43, 0xd6, 0xf1, 0x5d,
-		0xc2, 0x89, 0x93, 0x74, 0xb0, 0x31, 0x8d, 0xe0, 0x29, 0xac, 0x26, 0xdd, 0x39, 0xac, 0xcc, 0x89,
-		0x2c, 0xe9, 0x29, 0x4f, 0x3e, 0x62, 0xf6, 0xf1, 0xe8, 0x67, 0xb0, 0xd5, 0xaf, 0x1c, 0x9b, 0x79,
-		0x9c, 0xbc, 0xe5, 0x62, 0xc9, 0xaf, 0x99, 0xe5, 0xf4, 0xc5, 0xa1, 0x1c, 0x1f, 0x68, 0x7b, 0x0b,
-		0x43, 0x6d, 0xef, 0xf7, 0xb0, 0x15, 0x72, 0x6a, 0x5f, 0xf4, 0x2c, 0xcc, 0x79, 0x40, 0x5b, 0x11,
-		0x27, 0xa1, 0x58, 0x98, 0xa5, 0xbd, 0xba, 0x36, 0x9a, 0x97, 0x02, 0x9d, 0x16, 0xdd, 0x7e, 0x6a,
-		0x65, 0x96, 0xa5, 0xa3, 0xfe, 0x08, 0xfa, 0x18, 0x2a, 0x01, 0xe1, 0x51, 0xe0, 0x59, 0x1e, 0xb9,
-		0xb4, 0x92, 0xe8, 0x45, 0xa5, 0x8b, 0xb5, 0xbd, 0x62, 0xfe, 0x48, 0xbe, 0xff, 0x9c, 0x5c, 0x66,
-		0x53, 0x89, 0x0e, 0xe0, 0xf6, 0x19, 0x0b, 0x6c, 0x62, 0xd9, 0x01, 0xc1, 0x9c, 0x68, 0xcc, 0x97,
-		0x85, 0x79, 0x55, 0xa0, 0x0e, 0x05, 0x68, 0xd8, 0x87, 0xa6, 0xa1, 0xaf, 0xe8, 0x1a, 0x3a, 0x62,
-		0xb0, 0x2e, 0xd6, 0xa5, 0x15, 0x90, 0x30, 0x72, 0x79, 0x58, 0x59, 0x15, 0x64, 0xfc, 0x46, 0x3b,
-		0xfd, 0x09, 0x88, 0xaf, 0xcb, 0x8a, 0x91, 0xce, 0x64, 0xfd, 0xae, 0xbd, 0xc9, 0x0c, 0x55, 0x29,
-		0x6c, 0x8d, 0x40, 0x34, 0x55, 0xfa, 0xab, 0xc1, 0x2a, 0xdd, 0x9d, 0xa0, 0x4a, 0x85, 0xc3, 0x6c,
-		0xad, 0xbe, 0x9b, 0x87, 0x9f, 0x14, 0x87, 0xac, 0x76, 0xad, 0x2f, 0x61, 0x7d, 0x30, 0xc1, 0x86,
-		0xf8, 0xe8, 0x2f, 0xa6, 0x5d, 0xb7, 0xe6, 0x9a, 0x93, 0x25, 0xe1, 0x9d, 0x01, 0xb7, 0xb1, 0xcd,
-		0x69, 0x97, 0x72, 0x4a, 0x42, 0x8b, 0x33, 0xcb, 0xa1, 0xa1, 0x8f, 0xb9, 0xdd, 0xb6, 0x5c, 0x66,
-		0x63, 0xd7, 0xed, 0xa9, 0xd2, 0xff, 0x7a, 0x86, 0x6c, 0xab, 0x4e, 0xb1, 0x9f, 0xfa, 0x6f, 0xb2,
-		0x23, 0xe5, 0xfd, 0x33, 0xe9, 0x5c, 0x66, 0xff, 0x06, 0xce, 0x47, 0x54, 0xff, 0x00, 0x3b, 0xe3,
-		0x1c, 0x68, 0xb8, 0x39, 0x1a, 0xe4, 0x46, 0xbf, 0x54, 0x94, 0xdf, 0x9e, 0xf0, 0x95, 0x38, 0x3e,
-		0xf1, 0xce, 0x58, 0x96, 0xa1, 0x3f, 0xce, 0xc1, 0x8e, 0x66, 0x9a, 0xc7, 0x98, 0xba, 0x13, 0xb7,
-		0x92, 0x03, 0x58, 0xb4, 0x71, 0x14, 0xca, 0x68, 0x36, 0xf6, 0x3e, 0x28, 0x6c, 0x23, 0x7d, 0xef,
-		0x87, 0xb1, 0x8d, 0x29, 0x4d, 0xe3, 0xed, 0xd2, 0x21, 0x1c, 0x53, 0x37, 0x54, 0xd2, 0x41, 0xbf,
-		0x5d, 0x9e, 0xe2, 0x9e, 0xcb, 0xb0, 0x63, 0x26, 0xe0, 0xc2, 0xe6, 0xa2, 0x59, 0x82, 0x8b, 0x5a,
-		0x4d, 0xf5, 0x1e, 0xdc, 0x2d, 0xc8, 0x81, 0xe4, 0xb9, 0xf6, 0xef, 0xbe, 0x60, 0x4c, 0x32, 0x7b,
-		0x95, 0x82, 0xf1, 0x25, 0xa0, 0xd4, 0xaf, 0xd5, 0x21, 0x1c, 0x3b, 0x98, 0x63, 0x25, 0x91, 0xee,
-		0x15, 0x7e, 0xe0, 0xb7, 0x0a, 0x6c, 0x96, 0xf9, 0xd0, 0x48, 0xed, 0xdb, 0x95, 0x54, 0x5c, 0x0e,
-		0xce, 0xf1, 0x4a, 0xc5, 0xe5, 0x1d, 0x28, 0xa9, 0x25, 0xd4, 0x8b, 0xf7, 0x5c, 0x99, 0x09, 0x48,
-		0x86, 0x4e, 0x9c, 0x58, 0x7d, 0xa6, 0x00, 0xa1, 0x3e, 0x17, 0x0a, 0xd4, 0x67, 0x3a, 0x31, 0xa1,
-		0x3e, 0x71, 0xe6, 0x09, 0xed, 0xc1, 0x22, 0xf5, 0xfc, 0x88, 0xab, 0x1d, 0xa8, 0xb8, 0x04, 0x25,
-		0x54, 0xa3, 0x76, 0x96, 0xfe, 0x5f, 0xb5, 0xb3, 0x3c, 0x9d, 0xda, 0x69, 0xc2, 0x76, 0xe2, 0x2f,
-		0xee, 0x70, 0xb6, 0xcb, 0x42, 0x22, 0x1c, 0xb1, 0x88, 0x2b, 0xed, 0xb9, 0x3d, 0xe2, 0xeb, 0x48,
-		0x1d, 0x10, 0xcd, 0xeb, 0x89, 0x6d, 0x93, 0x1d, 0xc6, 0x96, 0x4d, 0x69, 0x88, 0x3e, 0x87, 0xeb,
-		0xe2, 0x23, 0xa3, 0x2e, 0x57, 0xc7, 0xb9, 0xbc, 0x26, 0x0c, 0x87, 0xfc, 0x1d, 0xc3, 0x56, 0x9b,
-		0xe0, 0x80, 0xb7, 0x08, 0xe6, 0xa9, 0x2b, 0x18, 0xe7, 0xaa, 0x9c, 0xda, 0x24, 0x7e, 0x32, 0xfa,
-		0x3c, 0x16, 0xaa, 0x8b, 0x7d, 0x7d, 0xfe, 0x1a, 0x6e, 0x0f, 0x32, 0x61, 0xb1, 0x33, 0x8b, 0xb7,
-		0x69, 0x68, 0x25, 0x06, 0xe3, 0x75, 0x68, 0x75, 0x80, 0x99, 0xdf, 0x9d, 0x35, 0xdb, 0x34, 0xdc,
-		0x57, 0xfe, 0x4f, 0xb2, 0x33, 0x48, 0x9a, 0xd5, 0xfa, 0x04, 0x95, 0xd2, 0x9f, 0xc4, 0x91, 0xea,
-		0x5a, 0x23, 0xc7, 0xa5, 0x8d, 0xd9, 0x8e, 0x4b, 0x0f, 0x60, 0x33, 0xf5, 0xa3, 0xba, 0xcf, 0xa6,
-		0xec, 0x70, 0xc9, 0xf0, 0x91, 0xec, 0x42, 0x1f, 0xc2, 0x52, 0x9b, 0x60, 0x87, 0x04, 0x95, 0xb2,
-		0x3a, 0x44, 0x69, 0x0f, 0x23, 0x02, 0x62, 0x2a, 0x68, 0xed, 0x3b, 0x23, 0x55, 0x9a, 0xd9, 0x6e,
-		0x30, 0xad, 0xd2, 0xfc, 0x08, 0x96, 0xa4, 0xb4, 0x51, 0x9d, 0xa0, 0x38, 0x59, 0x0a, 0x5b, 0xd4,
-		0xfb, 0x6a, 0xf7, 0x53, 0x55, 0x91, 0x13, 0x97, 0x6a, 0xd9, 0xdf, 0xce, 0xc1, 0x83, 0x22, 0xe0,
-		0x41, 0xef, 0xe4, 0x68, 0x5c, 0xff, 0xbe, 0xaa, 0x9e, 0xd6, 0xcf, 0xda, 0xc2, 0x8c, 0x59, 0x5b,
-		0x1c, 0xca, 0xda, 0x4f, 0x61, 0x77, 0x7c, 0x32, 0x54, 0xe6, 0xfe, 0x6a, 0xa4, 0xb2, 0x20, 0x0b,
-		0x9e, 0x4a, 0x16, 0x3c, 0x82, 0xe5, 0x33, 0x4c, 0xdd, 0x28, 0x20, 0x85, 0xc4, 0x1f, 0x4b, 0x8c,
-		0x99, 0x80, 0x0b, 0x99, 0xef, 0xef, 0xd4, 0xba, 0xb0, 0x54, 0xf0, 0xdf, 0xcc, 0x69, 0xeb, 0x43,
-		0xa2, 0xbe, 0xcf, 0x9c, 0x67, 0x32, 0xb6, 0x30, 0x6b, 0xc6, 0x86, 0x59, 0x7f, 0x00, 0xf7, 0xc6,
-		0xe4, 0x42, 0x65, 0xed, 0x6f, 0x06, 0xd4, 0x74, 0xf5, 0x81, 0x3d, 0x9b, 0x4c, 0x45, 0x7a, 0xd2,
-		0x1a, 0xe7, 0x66, 0xd5, 0x71, 0xc3, 0xa4, 0xdf, 0xd3, 0xb7, 0xa1, 0x34, 0x30, 0x35, 0x81, 0x3f,
-		0xcd, 0xc1, 0xfd, 0x02, 0xdc, 0xf7, 0x9c, 0xf8, 0x24, 0x6b, 0x0b, 0xb3, 0x66, 0x6d, 0x98, 0xf8,
-		0xf7, 0xf5, 0xbd, 0x6f, 0x20, 0x1b, 0x03, 0xd4, 0xdb, 0x2c, 0x18, 0x80, 0xbe, 0x48, 0x76, 0xad,
-		0x2b, 0xa4, 0xfe, 0x34, 0xa6, 0xbe, 0x20, 0x30, 0xa5, 0x4b, 0xdf, 0x87, 0xb2, 0x2d, 0x26, 0x66,
-		0x05, 0x32, 0x56, 0xe2, 0x88, 0xf8, 0x56, 0xcc, 0x4d, 0x39, 0x6e, 0x26, 0xc3, 0xaa, 0x4a, 0x72,
-		0x5d, 0xfe, 0xd0, 0xaa, 0xa4, 0x19, 0x57, 0xc9, 0x98, 0x6c, 0x4c, 0x9f, 0xe4, 0x7f, 0xf5, 0xb7,
-		0x0f, 0x71, 0x33, 0x30, 0x8b, 0x6c, 0xf8, 0xf5, 0x90, 0x6c, 0x98, 0xfc, 0x02, 0x22, 0xd9, 0x0c,
-		0x5f, 0xc1, 0x35, 0xf9, 0xaf, 0x13, 0xab, 0x4b, 0x02, 0x71, 0xb5, 0x40, 0xbd, 0x33, 0xa6, 0xce,
-		0x97, 0xf9, 0x44, 0x91, 0xe0, 0x95, 0x84, 0x8b, 0xb3, 0xf2, 0xd6, 0xe5, 0xf0, 0x50, 0x66, 0x13,
-		0xd2, 0x4d, 0x2e, 0xd1, 0x1e, 0x06, 0x54, 0x4d, 0x12, 0x12, 0x2e, 0x6f, 0xac, 0xd2, 0xd3, 0xdd,
-		0x95, 0xd4, 0x56, 0xed, 0x16, 0xdc, 0xd0, 0x06, 0x23, 0x83, 0xdd, 0xfb, 0xcf, 0x26, 0xac, 0xca,
-		0xa9, 0xef, 0x9f, 0x9e, 0xa0, 0xb7, 0x70, 0x4d, 0x73, 0xc5, 0x82, 0x1a, 0x93, 0x5f, 0xc6, 0x88,
-		0x39, 0x56, 0xa7, 0xbe, 0xbd, 0x41, 0x7f, 0x31, 0xe0, 0x66, 0xd1, 0xa5, 0x0b, 0x7a, 0x3c, 0xeb,
-		0xad, 0x58, 0xf5, 0x93, 0x99, 0x6f, 0x78, 0xd0, 0x37, 0x06, 0x6c, 0xe7, 0xde, 0x0f, 0xa0, 0x5f,
-		0x4e, 0xea, 0x78, 0x40, 0x3c, 0x55, 0x1f, 0x4d, 0x6b, 0xa6, 0x82, 0xe9, 0x93, 0x93, 0x5d, 0xb1,
-		0xc5, 0xe4, 0x68, 0xee, 0x2b, 0x8a, 0xc9, 0xd1, 0x1e, 0xfe, 0x33, 0xe4, 0x68, 0x05, 0x64, 0x31,
-		0x39, 0x45, 0x27, 0x88, 0x62, 0x72, 0x0a, 0x35, 0x3e, 0x7a, 0xa7, 0x57, 0xaa, 0x03, 0xb2, 0x16,
-		0x7d, 0x3a, 0xb5, 0xff, 0xcc, 0x3e, 0x50, 0x7d, 0x36, 0xa3, 0xf5, 0x68, 0xf9, 0x8c, 0x4a, 0xb0,
-		0xe2, 0xf2, 0xc9, 0xd5, 0xde, 0xc5, 0xe5, 0x93, 0xaf, 0x8d, 0xd1, 0x77, 0x06, 0xdc, 0x2a, 0xd4,
-		0x83, 0xe8, 0x93, 0xe9, 0x3c, 0x67, 0x13, 0xf5, 0x64, 0x16, 0x53, 0x15, 0xd8, 0x9f, 0x0d, 0xd1,
-		0xa2, 0xf2, 0xf4, 0x0a, 0xfa, 0x78, 0x62, 0x12, 0x06, 0x05, 0x6b, 0xf5, 0xf1, 0xf4, 0x86, 0x2a,
-		0xa4, 0xbf, 0x1b, 0x70, 0x67, 0x8c, 0x84, 0x42, 0x4f, 0xa7, 0xf5, 0x9e, 0xcd, 0xd7, 0xa7, 0xb3,
-		0x19, 0x0f, 0x64, 0x2c, 0x77, 0xef, 0xce, 0xcd, 0xd8, 0x38, 0x9d, 0x97, 0x9b, 0xb1, 0xf1, 0x3a,
-		0x4c, 0x66, 0xac, 0x50, 0x4e, 0xe4, 0x66, 0x6c, 0x12, 0x49, 0x96, 0x9b, 0xb1, 0xc9, 0x14, 0x4c,
-		0x66, 0x25, 0x8e, 0xee, 0xdc, 0xc5, 0x2b, 0x31, 0x57, 0xc6, 0x14, 0xaf, 0xc4, 0x7c, 0x81, 0x10,
-		0x37, 0x72, 0xcd, 0x96, 0x9c, 0xd3, 0xc8, 0xf3, 0x95, 0x44, 0x4e, 0x23, 0x2f, 0xd8, 0xed, 0x0f,
-		0x5a, 0xf0, 0x63, 0x9b, 0x75, 0x74, 0x66, 0x07, 0x48, 0xaa, 0x80, 0x97, 0xf2, 0x97, 0x27, 0xa7,
-		0x01, 0xe3, 0xec, 0xd4, 0xf8, 0xfa, 0xe1, 0x39, 0xe5, 0xed, 0xa8, 0x55, 0xb7, 0x59, 0xa7, 0x91,
-		0xfd, 0x69, 0xc5, 0xcf, 0xa9, 0xe3, 0x36, 0xce, 0x99, 0xfc, 0xd5, 0x88, 0xfa, 0x9d, 0xc5, 0x53,
-		0xec, 0xd3, 0xee, 0xc3, 0xd6, 0x92, 0x18, 0xfb, 0xf0, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b,
-		0xa4, 0xf1, 0x91, 0xd9, 0x22, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdb, 0xc8,
+		0x15, 0x06, 0x65, 0xcb, 0x97, 0x23, 0x5f, 0xe4, 0x09, 0x9a, 0x32, 0x74, 0x2e, 0x8a, 0x72, 0xb1,
+		0xb7, 0xdd, 0x4a, 0x89, 0x77, 0xeb, 0x75, 0x6e, 0x45, 0x7d, 0x89, 0x11, 0x17, 0xdb, 0xad, 0x97,
+		0xd1, 0x66, 0x81, 0x2d, 0x10, 0x62, 0x44, 0x8e, 0x2d, 0xc2, 0x14, 0x47, 0x21, 0x87, 0x72, 0xf4,
+		0xd2, 0x87, 0x3e, 0xee, 0xf6, 0xa1, 0x40, 0xd1, 0xed, 0x4b, 0x81, 0x3c, 0xf7, 0x07, 0xf4, 0xd7,
+		0xf4, 0xa9, 0xcf, 0xfd, 0x0f, 0x45, 0xc1, 0x99, 0xa1, 0x44, 0x49, 0x23, 0xea, 0xd2, 0x07, 0x2f,
+		0xb0, 0x6f, 0xe6, 0xf0, 0x3b, 0xdf, 0x9c, 0x39, 0xe7, 0xcc, 0x99, 0x8f, 0x63, 0xc1, 0x76, 0x54,
+		0x27, 0x41, 0xd5, 0xc6, 0x0e, 0xf1, 0x6d, 0x52, 0xc5, 0x2d, 0xb7, 0xda, 0x7e, 0x5c, 0x0d, 0x49,
+		0xd0, 0x76, 0x6d, 0x62, 0x5d, 0xd2, 0xe0, 0x82, 0x04, 0x95, 0x56, 0x40, 0x19, 0x45, 0xd7, 0x62,
+		0x64, 0x45, 0x22, 0x2b, 0xb8, 0xe5, 0x56, 0xda, 0x8f, 0x8d, 0xdb, 0xe7, 0x94, 0x9e, 0x7b, 0xa4,
+		0xca, 0x21, 0xf5, 0xe8, 0xac, 0xea, 0x44, 0x01, 0x66, 0x2e, 0xf5, 0x85, 0x91, 0x71, 0x67, 0xf0,
+		0x3d, 0x73, 0x9b, 0x24, 0x64, 0xb8, 0xd9, 0x92, 0x80, 0x21, 0x82, 0xcb, 0x00, 0xb7, 0x5a, 0x24,
+		0x08, 0xe5, 0xfb, 0x92, 0xca, 0x3f, 0x9b, 0x36, 0x9b, 0xdd, 0x29, 0xca, 0x2a, 0x84, 0x43, 0x6c,
+		0x37, 0xec, 0xb9, 0x71, 0x57, 0x85, 0x69, 0xb8, 0x21, 0xa3, 0x41, 0x27, 0xf1, 0x54, 0x05, 0x79,
+		0x17, 0x91, 0xa0, 0x93, 0x35, 0x0f, 0xc3, 0xe1, 0x85, 0xe7, 0x86, 0x2c, 0x0b, 0x13, 0x47, 0xf1,
+		0xcc, 0xa3, 0x97, 0x02, 0x53, 0xfe, 0xa7, 0x06, 0xc6, 0x29, 0xf5, 0xbc, 0x63, 0x1a, 0x1c, 0x49,
+		0x2f, 0x6b, 0x38, 0xbc, 0x30, 0xc9, 0xbb, 0x88, 0x84, 0x0c, 0x5d, 0x87, 0x05, 0x87, 0x36, 0xb1,
+		0xeb, 0xeb, 0x5a, 0x49, 0xdb, 0x5e, 0x36, 0xe5, 0x13, 0x7a, 0x0a, 0xcb, 0xf1, 0x64, 0x56, 0x3c,
+		0x9b, 0x9e, 0x2b, 0x69, 0xdb, 0x85, 0x9d, 0x5b, 0x15, 0x45, 0x4a, 0x2a, 0x31, 0xd9, 0xe7, 0x6e,
+		0xc8, 0xcc, 0x25, 0x26, 0xff, 0x42, 0x06, 0x2c, 0xb9, 0x0e, 0xf1, 0x99, 0xcb, 0x3a, 0xfa, 0x1c,
+		0x67, 0xed, 0x3e, 0xa3, 0x2d, 0x58, 0xaf, 0xbb, 0x3e, 0x0e, 0x3a, 0x96, 0xdd, 0x20, 0xf6, 0x45,
+		0x18, 0x35, 0xf5, 0x79, 0x0e, 0x59, 0x13, 0xc3, 0x87, 0x72, 0xb4, 0xfc, 0xdf, 0x45, 0xd8, 0x54,
+		0xfa, 0x1d, 0xb6, 0xa8, 0x1f, 0x12, 0x74, 0x0b, 0x80, 0x3b, 0xc8, 0xe8, 0x05, 0x11, 0xce, 0xaf,
+		0x98, 0xdc, 0xe5, 0x5a, 0x3c, 0x80, 0xbe, 0x02, 0x94, 0x04, 0xc2, 0x22, 0xef, 0x89, 0x1d, 0xc5,
+		0x55, 0x22, 0x17, 0xf2, 0x50, 0xb9, 0x90, 0xaf, 0x25, 0xfc, 0x65, 0x82, 0x36, 0x37, 0x2e, 0x07,
+		0x87, 0xd0, 0x31, 0xac, 0x76, 0x69, 0x59, 0xa7, 0x45, 0xf8, 0xfa, 0x0a, 0x3b, 0x77, 0x33, 0x19,
+		0x6b, 0x9d, 0x16, 0x31, 0x57, 0x2e, 0x53, 0x4f, 0xe8, 0x0d, 0xdc, 0x68, 0x05, 0xa4, 0xed, 0xd2,
+		0x28, 0xb4, 0x42, 0x86, 0x03, 0x46, 0x1c, 0x8b, 0xb4, 0x89, 0xcf, 0x2c, 0xd7, 0xe1, 0x01, 0x29,
+		0xec, 0x6c, 0x56, 0x44, 0xad, 0x56, 0x92, 0x5a, 0xad, 0x9c, 0xf8, 0x6c, 0xf7, 0xd3, 0x37, 0xd8,
+		0x8b, 0x88, 0x79, 0x3d, 0xb1, 0x7e, 0x2d, 0x8c, 0x5f, 0xc6, 0xb6, 0x27, 0x0e, 0xda, 0x86, 0xe2,
+		0x10, 0x5d, 0xbe, 0xa4, 0x6d, 0xcf, 0x99, 0x6b, 0x61, 0x3f, 0x52, 0x87, 0x45, 0xcc, 0x18, 0x69,
+		0xb6, 0x98, 0xbe, 0xc0, 0x01, 0xc9, 0x23, 0xfa, 0x18, 0x50, 0x1d, 0xdb, 0x17, 0x1e, 0x3d, 0xb7,
+		0x6c, 0x1a, 0xf9, 0xcc, 0x6a, 0xb8, 0x3e, 0xd3, 0x17, 0x39, 0xa8, 0x28, 0xdf, 0x1c, 0xc6, 0x2f,
+		0x5e, 0xb9, 0x3e, 0x43, 0xbb, 0xb0, 0x28, 0x2b, 0x5b, 0x5f, 0xe2, 0x7e, 0xdf, 0x54, 0xc6, 0xe2,
+		0x95, 0xc0, 0x98, 0x09, 0x18, 0x3d, 0x84, 0x75, 0x9f, 0xbc, 0x67, 0x56, 0x0b, 0x9f, 0x13, 0x99,
+		0xc4, 0x65, 0x9e, 0xc4, 0xd5, 0x78, 0xf8, 0x14, 0x9f, 0x13, 0x91, 0xc8, 0x3d, 0xc8, 0xf3, 0x6d,
+		0xa1, 0x03, 0x67, 0x2f, 0x67, 0x46, 0xfa, 0xcb, 0x18, 0x69, 0x0a, 0x03, 0xf4, 0x16, 0x6e, 0x0e,
+		0x97, 0x80, 0xd5, 0xab, 0xea, 0xc2, 0x24, 0x55, 0x7d, 0x63, 0xa8, 0x06, 0x92, 0x57, 0x68, 0x1f,
+		0xd6, 0x42, 0xbb, 0x41, 0x9c, 0xc8, 0x23, 0x8e, 0x15, 0x37, 0x1a, 0x7d, 0x85, 0x33, 0x1a, 0x43,
+		0x89, 0xab, 0x25, 0x5d, 0xc8, 0x5c, 0xed, 0x5a, 0xc4, 0x63, 0xe8, 0x05, 0xac, 0x24, 0xe9, 0xe2,
+		0x04, 0xab, 0x63, 0x09, 0x0a, 0x12, 0xcf, 0xcd, 0xbf, 0x86, 0xc5, 0x78, 0xa9, 0x2e, 0x09, 0xf5,
+		0xb5, 0xd2, 0xdc, 0x76, 0x61, 0xe7, 0x85, 0x72, 0x31, 0x19, 0xdb, 0xa8, 0xf2, 0xa5, 0xb0, 0x7f,
+		0xe9, 0xb3, 0x38, 0x39, 0x92, 0x0d, 0x95, 0x81, 0x67, 0xa1, 0x57, 0x43, 0xeb, 0x3c, 0xfb, 0x85,
+		0x78, 0x50, 0x16, 0x90, 0xf1, 0x16, 0x56, 0xd2, 0xc6, 0xa8, 0x08, 0x73, 0x17, 0xa4, 0x23, 0xdb,
+		0x48, 0xfc, 0x67, 0x9c, 0xba, 0x76, 0x5c, 0xad, 0x7a, 0x6e, 0xf2, 0xd4, 0x71, 0x83, 0xa7, 0xb9,
+		0x3d, 0xad, 0xfc, 0x8f, 0x3c, 0xdc, 0x13, 0x6e, 0x3a, 0x69, 0xcf, 0x0f, 0x69, 0xb3, 0xe5, 0x11,
+		0x46, 0x9c, 0xa4, 0x83, 0x8d, 0x69, 0x04, 0xcf, 0x60, 0x39, 0xe9, 0xce, 0xa1, 0x9e, 0x2b, 0xcd,
+		0x8d, 0x4c, 0x79, 0x32, 0x89, 0xd9, 0xc3, 0xa3, 0x9f, 0xc3, 0x46, 0xaf, 0x72, 0x6c, 0xea, 0x33,
+		0xf2, 0x9e, 0xf1, 0x2d, 0xbf, 0x62, 0x16, 0xbb, 0x2f, 0x0e, 0xc5, 0x78, 0x5f, 0xdb, 0x9b, 0x1f,
+		0x68, 0x7b, 0xbf, 0x87, 0x8d, 0x90, 0xb9, 0xf6, 0x45, 0xc7, 0xc2, 0x8c, 0x05, 0x6e, 0x3d, 0x62,
+		0x24, 0xe4, 0x1b, 0xb3, 0xb0, 0x53, 0x51, 0x7a, 0xf3, 0x9a, 0xa3, 0xbb, 0x45, 0xb7, 0xdf, 0xb5,
+		0x32, 0x8b, 0x82, 0xa8, 0x37, 0x82, 0x3e, 0x03, 0x3d, 0x20, 0x2c, 0x0a, 0x7c, 0xcb, 0x27, 0x97,
+		0x56, 0xe2, 0x3d, 0xaf, 0x74, 0xbe, 0xb7, 0x97, 0xcc, 0x9f, 0x88, 0xf7, 0x5f, 0x90, 0xcb, 0x74,
+		0x28, 0xd1, 0x01, 0xdc, 0x3e, 0xa3, 0x81, 0x4d, 0x2c, 0x3b, 0x20, 0x98, 0x11, 0x85, 0xf9, 0x22,
+		0x37, 0x37, 0x38, 0xea, 0x90, 0x83, 0x06, 0x39, 0x14, 0x0d, 0x7d, 0x49, 0xd5, 0xd0, 0x11, 0x85,
+		0x55, 0xbe, 0x2f, 0xad, 0x80, 0x84, 0x91, 0xc7, 0x42, 0x7d, 0x99, 0x27, 0xe3, 0x37, 0xca, 0xe5,
+		0x4f, 0x90, 0xf8, 0x8a, 0xa8, 0x18, 0x41, 0x26, 0xea, 0x77, 0xe5, 0x5d, 0x6a, 0xc8, 0x70, 0x61,
+		0x63, 0x08, 0xa2, 0xa8, 0xd2, 0x5f, 0xf5, 0x57, 0xe9, 0xf6, 0x04, 0x55, 0xca, 0x09, 0xd3, 0xb5,
+		0xfa, 0x61, 0x0e, 0xee, 0x67, 0xbb, 0x2c, 0x4f, 0xad, 0xaf, 0x60, 0xb5, 0x3f, 0xc0, 0x1a, 0x9f,
+		0xf4, 0xd1, 0xb4, 0xfb, 0xd6, 0x5c, 0x71, 0xd2, 0x49, 0xf8, 0xa0, 0xc1, 0x6d, 0x6c, 0x33, 0xb7,
+		0xed, 0x32, 0x97, 0x84, 0x16, 0xa3, 0x96, 0xe3, 0x86, 0x2d, 0xcc, 0xec, 0x86, 0xe5, 0x51, 0x1b,
+		0x7b, 0x5e, 0x47, 0x96, 0xfe, 0x37, 0x33, 0x44, 0x5b, 0x76, 0x8a, 0xfd, 0x2e, 0x7f, 0x8d, 0x1e,
+		0x49, 0xf6, 0xcf, 0x05, 0xb9, 0x88, 0xfe, 0x26, 0x1e, 0x8d, 0x30, 0xfe, 0x00, 0xa5, 0x71, 0x04,
+		0x8a, 0xdc, 0x1c, 0xf5, 0xe7, 0x46, 0xbd, 0x55, 0x24, 0x6f, 0x87, 0x73, 0x25, 0xc4, 0x27, 0xfe,
+		0x19, 0x4d, 0x67, 0xe8, 0x8f, 0x39, 0x28, 0x29, 0x96, 0x79, 0x8c, 0x5d, 0x6f, 0xe2, 0x56, 0x72,
+		0x00, 0x79, 0x1b, 0x47, 0xa1, 0xf0, 0x66, 0x6d, 0xe7, 0xe3, 0xcc, 0x36, 0xd2, 0x63, 0x3f, 0x8c,
+		0x6d, 0x4c, 0x61, 0x1a, 0x1f, 0x97, 0x0e, 0x61, 0xd8, 0xf5, 0x42, 0x7d, 0x2e, 0xe3, 0xb8, 0x3c,
+		0xc5, 0x1d, 0x8f, 0x62, 0xc7, 0x4c, 0xc0, 0x99, 0xcd, 0x45, 0xb1, 0x05, 0xf3, 0x4a, 0x4d, 0x75,
+		0x0f, 0xee, 0x66, 0xc4, 0x40, 0xe4, 0xb9, 0xfc, 0xef, 0x9e, 0x60, 0x4c, 0x22, 0x7b, 0x95, 0x82,
+		0xf1, 0x35, 0xa0, 0x2e, 0xaf, 0xd5, 0x24, 0x0c, 0x3b, 0x98, 0x61, 0x29, 0x91, 0x1e, 0x64, 0x4e,
+		0xf0, 0x5b, 0x09, 0x36, 0x8b, 0x6c, 0x60, 0xa4, 0xfc, 0xdd, 0x12, 0x6c, 0x2a, 0xd7, 0x78, 0xa5,
+		0xe2, 0xf2, 0x0e, 0x14, 0xe4, 0x16, 0xea, 0xc4, 0x67, 0xae, 0x88, 0x04, 0x24, 0x43, 0x27, 0x4e,
+		0xac, 0x3e, 0xbb, 0x00, 0xae, 0x3e, 0xe7, 0x33, 0xd4, 0x67, 0x77, 0x61, 0x5c, 0x7d, 0xe2, 0xd4,
+		0x13, 0xda, 0x81, 0xbc, 0xeb, 0xb7, 0x22, 0xa6, 0xe7, 0x27, 0x28, 0x41, 0x01, 0x55, 0xa8, 0x9d,
+		0x85, 0xff, 0x57, 0xed, 0x2c, 0x4e, 0xa7, 0x76, 0x6a, 0x70, 0x23, 0xe1, 0x8b, 0x3b, 0x9c, 0xed,
+		0xd1, 0x90, 0x70, 0x22, 0x1a, 0x31, 0xa9, 0x3d, 0x6f, 0x0c, 0x71, 0x1d, 0xc9, 0x0f, 0x44, 0xf3,
+		0x7a, 0x62, 0x5b, 0xa3, 0x87, 0xb1, 0x65, 0x4d, 0x18, 0xa2, 0x2f, 0xe0, 0x3a, 0x9f, 0x64, 0x98,
+		0x72, 0x79, 0x1c, 0xe5, 0x35, 0x6e, 0x38, 0xc0, 0x77, 0x0c, 0x1b, 0x0d, 0x82, 0x03, 0x56, 0x27,
+		0x98, 0x75, 0xa9, 0x60, 0x1c, 0x55, 0xb1, 0x6b, 0x93, 0xf0, 0xa4, 0xf4, 0x79, 0x2c, 0x54, 0xf3,
+		0x3d, 0x7d, 0xfe, 0x16, 0x6e, 0xf7, 0x67, 0xc2, 0xa2, 0x67, 0x16, 0x6b, 0xb8, 0xa1, 0x95, 0x18,
+		0x8c, 0xd7, 0xa1, 0x46, 0x5f, 0x66, 0x7e, 0x77, 0x56, 0x6b, 0xb8, 0xe1, 0xbe, 0xe4, 0x3f, 0x49,
+		0xaf, 0x20, 0x69, 0x56, 0xab, 0x13, 0x54, 0x4a, 0x6f, 0x11, 0x47, 0xb2, 0x6b, 0x0d, 0x7d, 0x2e,
+		0xad, 0xcd, 0xf6, 0xb9, 0xb4, 0x05, 0xeb, 0x5d, 0x1e, 0xd9, 0x7d, 0xd6, 0x45, 0x87, 0x4b, 0x86,
+		0x8f, 0xf8, 0x28, 0xfa, 0x04, 0x16, 0x1a, 0x04, 0x3b, 0x24, 0xd0, 0x8b, 0xf2, 0x23, 0x4a, 0xf9,
+		0x31, 0xc2, 0x21, 0xa6, 0x84, 0x96, 0xbf, 0xd7, 0xba, 0x4a, 0x33, 0xdd, 0x0d, 0xa6, 0x55, 0x9a,
+		0x9f, 0xc2, 0x82, 0x90, 0x36, 0x7a, 0x6e, 0x82, 0x60, 0x49, 0x6c, 0x56, 0xef, 0x2b, 0x3f, 0x84,
+		0xfb, 0xd9, 0x7e, 0xc9, 0x96, 0xfd, 0x5d, 0x0e, 0xb6, 0xb2, 0x80, 0x07, 0x9d, 0x93, 0xa3, 0x71,
+		0xfd, 0xfb, 0xaa, 0x7a, 0x5a, 0x2f, 0x6a, 0xf3, 0x33, 0x46, 0x2d, 0x3f, 0x10, 0xb5, 0x9f, 0xc1,
+		0xf6, 0xf8, 0x60, 0xc8, 0xc8, 0xfd, 0x55, 0x83, 0x92, 0x02, 0x3c, 0x95, 0x2c, 0xd8, 0x85, 0xc5,
+		0x33, 0xec, 0x7a, 0x51, 0x40, 0x32, 0x13, 0x7f, 0x2c, 0x30, 0x66, 0x02, 0xce, 0xcc, 0x7c, 0xef,
+		0xa4, 0x56, 0xb9, 0x25, 0x9d, 0xff, 0x36, 0x07, 0xf7, 0x47, 0xa2, 0x7e, 0xc8, 0x39, 0x4f, 0x45,
+		0x6c, 0x7e, 0xd6, 0x88, 0x0d, 0x66, 0x7d, 0x0b, 0x1e, 0x8c, 0x89, 0x85, 0x8c, 0xda, 0xdf, 0x34,
+		0x28, 0xab, 0xea, 0x03, 0xfb, 0x36, 0x99, 0x2a, 0xe9, 0x49, 0x6b, 0xcc, 0xcd, 0xaa, 0xe3, 0x06,
+		0x93, 0xfe, 0x00, 0xee, 0x65, 0x3a, 0x26, 0x17, 0xf0, 0xa7, 0x1c, 0x3c, 0xcc, 0xc0, 0xfd, 0xc0,
+		0x13, 0x9f, 0x44, 0x6d, 0x7e, 0xd6, 0xa8, 0x0d, 0x26, 0xfe, 0x23, 0xd8, 0x1a, 0x1b, 0x8d, 0xbe,
+		0xd4, 0xdb, 0x34, 0xe8, 0x83, 0xbe, 0x4a, 0x4e, 0xad, 0x2b, 0x4c, 0xfd, 0x29, 0xdc, 0xcb, 0x74,
+		0x4c, 0xea, 0xd2, 0x8f, 0xa0, 0x68, 0xf3, 0x85, 0x59, 0x81, 0xf0, 0x95, 0x38, 0xdc, 0xbf, 0x25,
+		0x73, 0x5d, 0x8c, 0x9b, 0xc9, 0xb0, 0xac, 0x92, 0x91, 0x94, 0x3f, 0xb6, 0x2a, 0xa9, 0xc1, 0xd6,
+		0xd8, 0x68, 0x4c, 0x1f, 0xe4, 0x7f, 0xf5, 0x8e, 0x0f, 0x7e, 0x33, 0x30, 0x8b, 0x6c, 0xf8, 0xf5,
+		0x80, 0x6c, 0x98, 0xfc, 0x02, 0x22, 0x39, 0x0c, 0xdf, 0xc0, 0x35, 0xf1, 0xaf, 0x13, 0xab, 0x4d,
+		0x02, 0x7e, 0xb5, 0xe0, 0xfa, 0x67, 0x54, 0x9f, 0x1b, 0x93, 0x28, 0x12, 0xbc, 0x11, 0x70, 0xfe,
+		0xad, 0xbc, 0x71, 0x39, 0x38, 0x94, 0x3a, 0x84, 0x54, 0x8b, 0x4b, 0xb4, 0x87, 0x06, 0x86, 0x49,
+		0x42, 0xc2, 0xc4, 0x8d, 0x55, 0xf7, 0xeb, 0xee, 0x4a, 0x6a, 0xab, 0x7c, 0x0b, 0x36, 0x95, 0xce,
+		0x08, 0x67, 0x77, 0xfe, 0xb3, 0x0e, 0xcb, 0x62, 0xe9, 0xfb, 0xa7, 0x27, 0xe8, 0x3d, 0x5c, 0x53,
+		0x5c, 0xb1, 0xa0, 0xea, 0xe4, 0x97, 0x31, 0x7c, 0x8d, 0xc6, 0xd4, 0xb7, 0x37, 0xe8, 0x2f, 0x1a,
+		0xdc, 0xcc, 0xba, 0x74, 0x41, 0x7b, 0xb3, 0xde, 0x8a, 0x19, 0x4f, 0x66, 0xbe, 0xe1, 0x41, 0xdf,
+		0x6a, 0x70, 0x63, 0xe4, 0xfd, 0x00, 0xfa, 0xe5, 0xa4, 0xc4, 0x7d, 0xe2, 0xc9, 0xd8, 0x9d, 0xd6,
+		0x4c, 0x3a, 0xd3, 0x4b, 0x4e, 0x7a, 0xc7, 0x66, 0x27, 0x47, 0x71, 0x5f, 0x61, 0x3c, 0x9a, 0xdc,
+		0x60, 0x38, 0x39, 0x4a, 0x01, 0x99, 0x9d, 0x9c, 0xac, 0x2f, 0x08, 0xe3, 0xc9, 0x0c, 0x96, 0xd2,
+		0xab, 0x0f, 0x6a, 0xa5, 0xda, 0x27, 0x6b, 0xd1, 0xf3, 0xa9, 0xf9, 0x53, 0xe7, 0x80, 0xf1, 0x62,
+		0x46, 0xeb, 0xe1, 0xf2, 0x19, 0x96, 0x60, 0xd9, 0xe5, 0x33, 0x52, 0x7b, 0x1b, 0xbb, 0xd3, 0x9a,
+		0x49, 0x67, 0xbe, 0xd7, 0xe0, 0x56, 0xa6, 0x1e, 0x44, 0x4f, 0xa6, 0x63, 0x4e, 0x07, 0xea, 0xe9,
+		0x2c, 0xa6, 0xd2, 0xb1, 0x3f, 0x6b, 0xb0, 0xa9, 0x40, 0x26, 0x7a, 0x05, 0x7d, 0x36, 0x71, 0x12,
+		0xfa, 0x05, 0xab, 0xb1, 0x37, 0xbd, 0xa1, 0x74, 0xe9, 0xef, 0x1a, 0xdc, 0x19, 0x23, 0xa1, 0xd0,
+		0xb3, 0x69, 0xd9, 0xd3, 0xf1, 0x7a, 0x3e, 0x9b, 0x71, 0x5f, 0xc4, 0x46, 0x9e, 0xdd, 0x23, 0x23,
+		0x36, 0x4e, 0xe7, 0x19, 0x7b, 0xd3, 0x1b, 0xf6, 0x45, 0x2c, 0x53, 0x4e, 0x8c, 0x8c, 0xd8, 0x24,
+		0x92, 0xcc, 0x78, 0x3e, 0x9b, 0xf1, 0xf0, 0x4e, 0x1c, 0x3e, 0xb9, 0xb3, 0x77, 0xe2, 0x48, 0x19,
+		0x63, 0xec, 0x4e, 0x6b, 0xd6, 0x6b, 0xe4, 0x8a, 0x23, 0x79, 0x44, 0x23, 0x1f, 0xad, 0x24, 0x8c,
+		0x47, 0x93, 0x1b, 0x88, 0x99, 0x0f, 0xea, 0xf0, 0x53, 0x9b, 0x36, 0x55, 0x66, 0x07, 0x48, 0xa8,
+		0x80, 0xd7, 0xe2, 0x97, 0x27, 0xa7, 0x01, 0x65, 0xf4, 0x54, 0xfb, 0xe6, 0xf1, 0xb9, 0xcb, 0x1a,
+		0x51, 0xbd, 0x62, 0xd3, 0x66, 0x35, 0xfd, 0xd3, 0x8a, 0x5f, 0xb8, 0x8e, 0x57, 0x3d, 0xa7, 0xe2,
+		0x57, 0x23, 0xf2, 0x77, 0x16, 0xcf, 0x70, 0xcb, 0x6d, 0x3f, 0xae, 0x2f, 0xf0, 0xb1, 0x4f, 0xfe,
+		0x37, 0x00, 0x2b, 0xa4, 0xf1, 0x91, 0xd9, 0x22, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6e, 0x14, 0xc7,
-		0x16, 0xbe, 0xed, 0x9f, 0xf9, 0x39, 0x1e, 0x03, 0x2e, 0x83, 0xb1, 0xc1, 0x60, 0x33, 0x57, 0xd7,
-		0x5c, 0xb0, 0x3c, 0x63, 0x1b, 0x2e, 0x42, 0x80, 0xd0, 0xc5, 0x06, 0x0b, 0x4b, 0x60, 0xac, 0xc6,
-		0x04, 0x29, 0x52, 0xd4, 0x2a, 0x57, 0x97, 0xed, 0x8a, 0x7b, 0xba, 0x27, 0xd5, 0xd5, 0x1e, 0x26,
-		0x52, 0xa4, 0xac, 0x92, 0x6c, 0xf2, 0x00, 0x91, 0xb2, 0xca, 0x2a, 0xd9, 0x90, 0x6d, 0xa2, 0xac,
-		0xf2, 0x08, 0x59, 0xe4, 0x49, 0xf2, 0x02, 0x51, 0x55, 0x57, 0xf7, 0x8c, 0xc7, 0x3d, 0x3d, 0xdd,
-		0x86, 0xb0, 0x73, 0xd7, 0x9c, 0xf3, 0xd5, 0x57, 0x75, 0x4e, 0x9f, 0xef, 0x1b, 0x0f, 0x54, 0x83,
-		0x5d, 0xca, 0xeb, 0x04, 0xdb, 0xd4, 0x25, 0xb4, 0x8e, 0x9b, 0xac, 0x7e, 0xb4, 0x52, 0xb7, 0x29,
-		0x61, 0x3e, 0xf3, 0xdc, 0x5a, 0x93, 0x7b, 0xc2, 0x43, 0x93, 0x32, 0xa6, 0xa6, 0x63, 0x6a, 0xb8,
-		0xc9, 0x6a, 0x47, 0x2b, 0x97, 0xae, 0xee, 0x7b, 0xde, 0xbe, 0x43, 0xeb, 0x2a, 0x64, 0x37, 0xd8,
-		0xab, 0xdb, 0x01, 0xc7, 0x22, 0x4e, 0xba, 0x34, 0x9f, 0x04, 0x4c, 0xbc, 0x46, 0x23, 0x8e, 0x48,
-		0xdc, 0x5a, 0x60, 0xff, 0xd0, 0x61, 0xbe, 0x48, 0x8b, 0x69, 0x79, 0xfc, 0x70, 0xcf, 0xf1, 0x5a,
-		0x61, 0x4c, 0xf5, 0x9b, 0x09, 0x28, 0x3d, 0xd6, 0x8c, 0xd1, 0x77, 0x06, 0xdc, 0xf4, 0xc9, 0x01,
-		0xb5, 0x03, 0x87, 0x5a, 0x98, 0x08, 0x76, 0xc4, 0x44, 0xdb, 0x92, 0xa8, 0x56, 0x74, 0x2a, 0x0b,
-		0x0b, 0xc1, 0xd9, 0x6e, 0x20, 0xa8, 0x3f, 0x6d, 0xcc, 0x1b, 0xff, 0x1d, 0x5b, 0xbd, 0x5f, 0x4b,
-		0x38, 0x61, 0xed, 0xa5, 0x86, 0x79, 0xa4, 0x51, 0x76, 0xb0, 0x7f, 0x18, 0xed, 0xf3, 0x28, 0x86,
-		0x78, 0xfa, 0x2f, 0x73, 0xc1, 0xcf, 0x14, 0x89, 0x3e, 0x87, 0x39, 0x5f, 0x60, 0x2e, 0x2c, 0xc1,
-		0x1a, 0x94, 0x27, 0xf2, 0x19, 0x52, 0x7c, 0x56, 0x92, 0xf9, 0xc8, 0xdc, 0x1d, 0x99, 0x9a, 0xc8,
-		0x62, 0xd6, 0x4f, 0xf9, 0x1c, 0xfd, 0x68, 0x80, 0xbc, 0xfd, 0xa6, 0x43, 0x05, 0xb5, 0xa2, 0x0b,
-		0xb4, 0xe8, 0x1b, 0x4a, 0x02, 0x59, 0xb4, 0x44, 0x32, 0xc3, 0x8a, 0xcc, 0xff, 0x13, 0xc9, 0xac,
-		0x6b, 0xac, 0xd7, 0x1a, 0xea, 0x49, 0x84, 0x94, 0xc8, 0x6d, 0x91, 0x64, 0x0f, 0x47, 0xdf, 0x1b,
-		0xb0, 0xb8, 0x87, 0x99, 0x93, 0x95, 0xe6, 0x88, 0xa2, 0xf9, 0x20, 0x91, 0xe6, 0x06, 0x66, 0x4e,
-		0x36, 0x8a, 0xd7, 0xf7, 0xb2, 0x85, 0xa2, 0x9f, 0x0c, 0x58, 0xe6, 0xf4, 0xb3, 0x80, 0xfa, 0xc2,
-		0x22, 0xd8, 0x25, 0xd4, 0xc9, 0xd0, 0x67, 0xa3, 0x29, 0x57, 0x69, 0x86, 0x60, 0xeb, 0x0a, 0x6b,
-		0x60, 0xb3, 0x2d, 0xf2, 0xec, 0xe1, 0xe8, 0x0b, 0x98, 0xd7, 0x14, 0xfb, 0xb7, 0x5c, 0x41, 0x51,
-		0x5b, 0x4d, 0xae, 0xb2, 0x4a, 0xee, 0xdf, 0x73, 0x57, 0x48, 0x5a, 0x00, 0xfa, 0xc1, 0x80, 0x25,
-		0xbd, 0x7f, 0xc6, 0x5a, 0x16, 0x15, 0x99, 0x87, 0x29, 0x64, 0xb2, 0x55, 0xf3, 0x06, 0xc9, 0x1a,
-		0x8c, 0xfe, 0x30, 0xe0, 0x61, 0x4f, 0x3d, 0xe9, 0x1b, 0x41, 0xb9, 0x8b, 0x33, 0xb3, 0x2e, 0x29,
-		0xd6, 0xcf, 0x07, 0x57, 0xf7, 0x89, 0x06, 0xce, 0x76, 0x88, 0xbb, 0xfc, 0x94, 0xb9, 0xe8, 0x4b,
-		0x03, 0xae, 0x71, 0x4a, 0x3c, 0x6e, 0x5b, 0x0d, 0xcc, 0x0f, 0xfb, 0x54, 0xbe, 0xac, 0x68, 0xdf,
-		0xea, 0x43, 0x5b, 0x66, 0x3f, 0x57, 0xc9, 0x89, 0xe4, 0xae, 0xf2, 0xd4, 0x08, 0xf4, 0xab, 0x01,
-		0x77, 0x88, 0xe7, 0x0a, 0xe6, 0x06, 0xd4, 0xc2, 0xbe, 0xe5, 0xd2, 0x56, 0xd6, 0xeb, 0x04, 0xc5,
-		0xeb, 0x49, 0x9f, 0xb9, 0x13, 0x42, 0x3e, 0xf2, 0xb7, 0x68, 0x2b, 0xdb, 0x35, 0x2e, 0x93, 0x9c,
-		0x39, 0xe8, 0x67, 0x03, 0x56, 0xc3, 0x49, 0x4d, 0x0e, 0x98, 0x63, 0x67, 0xe5, 0x3d, 0xa6, 0x78,
-		0xaf, 0xf5, 0x1f, 0xde, 0xeb, 0x12, 0x2d, 0x1b, 0xe9, 0x25, 0x3f, 0x4f, 0x02, 0xfa, 0xcd, 0x80,
-		0x3b, 0x3e, 0xdb, 0x97, 0x3d, 0x9b, 0xb7, 0x79, 0x2b, 0x8a, 0xf5, 0x46, 0x32, 0x6b, 0x05, 0x99,
-		0xaf, 0x6b, 0x57, 0xfc, 0xbc, 0x49, 0xe8, 0x17, 0x03, 0xfe, 0x17, 0x34, 0x7d, 0xca, 0x45, 0x87,
-		0xb4, 0x4f, 0x31, 0x27, 0x07, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0xb4, 0xca, 0x2b, 0x85, 0x18,
-		0xed, 0xff, 0x52, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x95, 0x20, 0x67, 0xce, 0x5a, 0x05, 0xa0, 0x43,
-		0xa7, 0xfa, 0x6d, 0x01, 0x16, 0xb2, 0xd9, 0x06, 0x34, 0x07, 0x63, 0xb1, 0x6c, 0x30, 0x5b, 0x19,
-		0x91, 0xb2, 0x09, 0xd1, 0xd2, 0xa6, 0x8d, 0x36, 0x60, 0xbc, 0xa3, 0x2b, 0xed, 0x26, 0xd5, 0xde,
-		0xe0, 0x5a, 0xe2, 0x59, 0xe3, 0xcd, 0xda, 0x4d, 0x6a, 0x56, 0x70, 0xd7, 0x13, 0x9a, 0x82, 0x82,
-		0xed, 0x35, 0x30, 0x73, 0x95, 0x9e, 0x97, 0x4d, 0xfd, 0x84, 0xee, 0x41, 0x59, 0xc9, 0x95, 0x74,
-		0x5b, 0x5a, 0x43, 0xaf, 0x24, 0x62, 0xcb, 0x03, 0x3c, 0x63, 0xbe, 0x30, 0x4b, 0x42, 0xff, 0x85,
-		0x56, 0x61, 0x94, 0xb9, 0xcd, 0x40, 0x68, 0x5d, 0x9b, 0x4d, 0xcc, 0xdb, 0xc6, 0x6d, 0xc7, 0xc3,
-		0xb6, 0x19, 0x86, 0xa2, 0x1d, 0x98, 0x89, 0x8d, 0x99, 0xf0, 0x2c, 0xe2, 0x78, 0x3e, 0x55, 0xb2,
-		0xe4, 0x05, 0x42, 0x8b, 0xd0, 0x4c, 0x2d, 0x34, 0x95, 0xb5, 0xc8, 0x54, 0xd6, 0x1e, 0x6b, 0x53,
-		0x69, 0x4e, 0x45, 0xb9, 0x3b, 0xde, 0xba, 0xcc, 0xdc, 0x09, 0x13, 0x7b, 0x51, 0x3b, 0xfe, 0x4a,
-		0xa2, 0x16, 0x73, 0xa0, 0xc6, 0xee, 0x4a, 0xa2, 0x6e, 0xc1, 0x94, 0x46, 0xea, 0x25, 0x5a, 0x1a,
-		0x04, 0x39, 0x19, 0xda, 0xb0, 0xe3, 0x2c, 0x37, 0x60, 0xe2, 0x80, 0x62, 0x2e, 0x76, 0x29, 0xee,
-		0xb0, 0x2b, 0x0f, 0x82, 0x3a, 0x17, 0xe7, 0x44, 0x38, 0xeb, 0x50, 0xe1, 0x54, 0xf0, 0xb6, 0xd5,
-		0xf4, 0x1c, 0x46, 0xda, 0x7a, 0xe2, 0xcc, 0xf7, 0x99, 0xe0, 0x82, 0xb7, 0xb7, 0x55, 0x9c, 0x39,
-		0xc6, 0x3b, 0x0f, 0xe8, 0x16, 0x14, 0x0e, 0x28, 0xb6, 0x29, 0xd7, 0xaf, 0xfe, 0xe5, 0xc4, 0xf4,
-		0xa7, 0x2a, 0xc4, 0xd4, 0xa1, 0xe8, 0x36, 0x4c, 0x45, 0x22, 0xe9, 0x78, 0x04, 0x3b, 0x96, 0xcd,
-		0xfc, 0x26, 0x16, 0xe4, 0x40, 0xbd, 0x82, 0x25, 0xf3, 0xbc, 0xfe, 0xf4, 0x99, 0xfc, 0xf0, 0xb1,
-		0xfe, 0xac, 0xfa, 0xb5, 0x01, 0xb3, 0x69, 0xb6, 0x15, 0xcd, 0x40, 0x29, 0x74, 0x26, 0xf1, 0x2b,
-		0x50, 0x54, 0xcf, 0x9b, 0x36, 0x7a, 0x06, 0x17, 0xe2, 0x1a, 0xec, 0x31, 0xde, 0x29, 0xc1, 0xd0,
-		0xa0, 0x7b, 0x43, 0xba, 0x04, 0x1b, 0x8c, 0x47, 0x15, 0xa8, 0x12, 0x58, 0xcc, 0x61, 0x59, 0xd1,
-		0x6d, 0x28, 0x70, 0xea, 0x07, 0x8e, 0xd0, 0xdf, 0x10, 0xd2, 0x3b, 0x5c, 0xc7, 0x56, 0x31, 0x5c,
-		0xcf, 0x68, 0x38, 0xd1, 0x1d, 0x28, 0x4a, 0xc3, 0x19, 0x70, 0x9a, 0xba, 0xc3, 0x46, 0x18, 0x63,
-		0x46, 0xc1, 0xd5, 0x2d, 0x58, 0xcc, 0xe1, 0x17, 0x07, 0x4e, 0x99, 0xea, 0x3d, 0xb8, 0x92, 0x6a,
-		0xf2, 0x52, 0x2a, 0x54, 0x25, 0x70, 0x23, 0xb3, 0x27, 0x93, 0x07, 0xb6, 0xa9, 0xc0, 0xcc, 0xf1,
-		0x33, 0x5d, 0x69, 0x14, 0x5c, 0xfd, 0xcb, 0x80, 0xbb, 0xa7, 0xf5, 0x50, 0x5d, 0xb3, 0xcf, 0x38,
-		0x36, 0xfb, 0x5e, 0x01, 0x3a, 0xa9, 0x8e, 0xba, 0xb1, 0x16, 0x12, 0x79, 0x9d, 0xd8, 0xcd, 0x9c,
-		0x68, 0xf5, 0x2e, 0xa1, 0x69, 0x28, 0x4a, 0xaf, 0xc1, 0x3d, 0x47, 0xcd, 0xda, 0x8a, 0x19, 0x3d,
-		0xa2, 0x1a, 0x4c, 0xf6, 0x58, 0x09, 0xcf, 0x75, 0xda, 0x6a, 0xec, 0x96, 0xcc, 0x09, 0xd2, 0x2d,
-		0xf3, 0x2f, 0x5c, 0xa7, 0x5d, 0x7d, 0x6b, 0xc0, 0xd5, 0x74, 0x0b, 0x26, 0x4b, 0xab, 0xbd, 0x9d,
-		0x8b, 0x1b, 0x34, 0x2a, 0x6d, 0xb8, 0xb4, 0x85, 0x1b, 0xb4, 0xfb, 0xc6, 0x87, 0x72, 0xdc, 0x78,
-		0xd7, 0x7c, 0x18, 0xce, 0x3c, 0x1f, 0xaa, 0x6f, 0x4b, 0xb0, 0x9c, 0xd7, 0x9b, 0x49, 0x89, 0x8b,
-		0xef, 0x43, 0x49, 0x9c, 0x91, 0x22, 0x71, 0x11, 0x60, 0x28, 0x71, 0xad, 0xae, 0xa7, 0xe3, 0x52,
-		0x36, 0x74, 0x4a, 0x29, 0x1b, 0xce, 0x2e, 0x65, 0x18, 0xe6, 0x3b, 0x9e, 0xaa, 0x8f, 0x50, 0x8c,
-		0x0c, 0x9a, 0x52, 0xb3, 0x31, 0xc4, 0xcb, 0x04, 0xc5, 0x78, 0x0d, 0x97, 0xd5, 0x91, 0xfa, 0xa0,
-		0x8f, 0x0e, 0x42, 0xbf, 0x28, 0xb3, 0x93, 0x80, 0x5f, 0xc0, 0xd4, 0x2e, 0x26, 0x87, 0xde, 0xde,
-		0x9e, 0xc6, 0x66, 0xae, 0xa0, 0xfc, 0x08, 0x3b, 0x83, 0x35, 0xf8, 0xbc, 0x4e, 0x54, 0xb0, 0x9b,
-		0x3a, 0xed, 0x84, 0x26, 0x15, 0x4f, 0xa3, 0x49, 0x9b, 0x50, 0x66, 0x2e, 0x13, 0x0c, 0x0b, 0x8f,
-		0x2b, 0x8d, 0x3d, 0xb3, 0xba, 0x38, 0xd8, 0xff, 0x6f, 0x46, 0x29, 0x66, 0x27, 0xbb, 0x7b, 0xb2,
-		0x96, 0x73, 0x4c, 0x56, 0x64, 0xc2, 0x94, 0x83, 0xe5, 0x77, 0xc0, 0x50, 0x26, 0x64, 0x69, 0xb5,
-		0x04, 0x40, 0x86, 0xce, 0x38, 0x2f, 0x73, 0xd7, 0xe3, 0x54, 0x53, 0x65, 0xa2, 0x7f, 0xc3, 0x38,
-		0xe1, 0xb2, 0x47, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x36, 0x2b, 0x72, 0x31, 0xf2, 0x89, 0xa7, 0xd3,
-		0xe3, 0x25, 0x18, 0x69, 0xd0, 0x86, 0xa7, 0x0d, 0xf0, 0x4c, 0x62, 0xca, 0x73, 0xda, 0xf0, 0x4c,
-		0x15, 0x86, 0x4c, 0x98, 0x38, 0x61, 0xa8, 0xa7, 0xcf, 0xa8, 0xdc, 0xff, 0x24, 0x3b, 0xff, 0x1e,
-		0xeb, 0x6b, 0x9e, 0xf3, 0x7b, 0x56, 0xd0, 0x03, 0xa8, 0x7c, 0xca, 0x84, 0xa0, 0x3c, 0x6c, 0xa4,
-		0xe9, 0xb3, 0x83, 0xfa, 0x67, 0x2c, 0x0c, 0x57, 0xed, 0x53, 0xfd, 0xb3, 0x08, 0x4b, 0xb9, 0xbe,
-		0x14, 0xf5, 0x1d, 0xe6, 0x73, 0x30, 0x16, 0x4f, 0x11, 0x66, 0xab, 0xf7, 0xbf, 0x6c, 0x42, 0xb4,
-		0x14, 0x3a, 0xe9, 0xe3, 0x63, 0x66, 0xf8, 0x3d, 0x8c, 0x99, 0x0f, 0xe0, 0x98, 0xb3, 0x8c, 0x99,
-		0xc2, 0x3f, 0x3a, 0x66, 0x8a, 0xa7, 0x1e, 0x33, 0x1f, 0xc1, 0x64, 0x13, 0x73, 0xea, 0x0a, 0x8d,
-		0xa8, 0x87, 0x43, 0xf8, 0x6a, 0x2f, 0xf4, 0x39, 0xbd, 0x8c, 0x57, 0x28, 0x7a, 0x44, 0x4c, 0x34,
-		0x7b, 0x97, 0xba, 0x25, 0xb6, 0x7c, 0x5c, 0x62, 0x09, 0x4c, 0x77, 0xb5, 0x81, 0xc5, 0x69, 0xd0,
-		0xd9, 0x16, 0xd4, 0xb6, 0x37, 0x53, 0x0b, 0xbe, 0x69, 0x9b, 0x32, 0x45, 0x6f, 0x7d, 0xa1, 0x95,
-		0xb4, 0xfc, 0x7e, 0x0c, 0xf8, 0x89, 0xa9, 0x50, 0x49, 0x9d, 0x0a, 0xe3, 0xf9, 0xa7, 0xc2, 0x99,
-		0x77, 0x98, 0x0a, 0x67, 0xdf, 0x69, 0x2a, 0x54, 0x7f, 0x1f, 0x82, 0x95, 0xdc, 0xff, 0x36, 0xf8,
-		0xd0, 0x46, 0x6d, 0x0e, 0xc6, 0xf4, 0x7f, 0x4b, 0x94, 0x77, 0x0a, 0xbf, 0x18, 0x43, 0xb8, 0xa4,
-		0xbc, 0x53, 0xfc, 0xba, 0x8e, 0x64, 0x7f, 0x5d, 0xbb, 0x5a, 0x73, 0x34, 0x93, 0xfb, 0x2b, 0xf4,
-		0x73, 0x7f, 0x5f, 0x19, 0xb0, 0x9c, 0xf7, 0xbf, 0x17, 0xc9, 0xc5, 0x34, 0xde, 0xa9, 0x98, 0x6b,
-		0x9f, 0xc0, 0x45, 0xe2, 0x35, 0x92, 0xb2, 0xd7, 0xc6, 0x23, 0x0a, 0xdb, 0x72, 0x28, 0x6c, 0x1b,
-		0x1f, 0xaf, 0xec, 0x33, 0x71, 0x10, 0xec, 0xd6, 0x88, 0xd7, 0xa8, 0x77, 0xff, 0x6a, 0xb3, 0xc4,
-		0x6c, 0xa7, 0xbe, 0xef, 0x85, 0x3f, 0x14, 0xe9, 0x9f, 0x70, 0xee, 0xe3, 0x26, 0x3b, 0x5a, 0xd9,
-		0x2d, 0xa8, 0xb5, 0x5b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xc1, 0xe0, 0x1d, 0x85, 0x1a,
-		0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5d, 0x6f, 0xd4, 0xc6,
+		0x1a, 0x3e, 0xce, 0xc7, 0x7e, 0xbc, 0xbb, 0x01, 0x32, 0x81, 0x90, 0x40, 0x20, 0x61, 0x8f, 0x4e,
+		0xf8, 0x88, 0xb2, 0x9b, 0x04, 0x0e, 0x42, 0x80, 0xd0, 0x21, 0x81, 0x88, 0x48, 0x10, 0x22, 0x13,
+		0x0e, 0xd2, 0x91, 0x8e, 0xac, 0xc9, 0x78, 0x92, 0x4c, 0xe3, 0xf5, 0x6c, 0xc7, 0xe3, 0x84, 0xad,
+		0x54, 0xa9, 0x57, 0x6d, 0xff, 0x42, 0xa5, 0x5e, 0xf5, 0xaa, 0xdc, 0xd0, 0xdb, 0x56, 0xbd, 0xea,
+		0x4f, 0xe8, 0x45, 0x7f, 0x49, 0xff, 0x40, 0xe5, 0xf1, 0xd8, 0xbb, 0xd9, 0x78, 0xbd, 0x76, 0xa0,
+		0xdc, 0xc5, 0xe3, 0xf7, 0x7d, 0xe6, 0xf1, 0xbc, 0xaf, 0xdf, 0xe7, 0x71, 0x16, 0x6a, 0xfe, 0x0e,
+		0x15, 0x0d, 0x82, 0x6d, 0xea, 0x12, 0xda, 0xc0, 0x2d, 0xd6, 0x38, 0x5c, 0x6e, 0xd8, 0x94, 0x30,
+		0x8f, 0x71, 0xb7, 0xde, 0x12, 0x5c, 0x72, 0x34, 0x11, 0xc4, 0xd4, 0x75, 0x4c, 0x1d, 0xb7, 0x58,
+		0xfd, 0x70, 0xf9, 0xd2, 0xd5, 0x3d, 0xce, 0xf7, 0x1c, 0xda, 0x50, 0x21, 0x3b, 0xfe, 0x6e, 0xc3,
+		0xf6, 0x05, 0x96, 0x71, 0xd2, 0xa5, 0xb9, 0x24, 0x60, 0xc2, 0x9b, 0xcd, 0x38, 0x22, 0x71, 0x6b,
+		0x89, 0xbd, 0x03, 0x87, 0x79, 0x32, 0x2d, 0xe6, 0x88, 0x8b, 0x83, 0x5d, 0x87, 0x1f, 0x85, 0x31,
+		0xb5, 0x6f, 0xc7, 0xa1, 0xf4, 0x44, 0x33, 0x46, 0xdf, 0x19, 0x70, 0xcb, 0x23, 0xfb, 0xd4, 0xf6,
+		0x1d, 0x6a, 0x61, 0x22, 0xd9, 0x21, 0x93, 0x6d, 0x2b, 0x40, 0xb5, 0xa2, 0xa7, 0xb2, 0xb0, 0x94,
+		0x82, 0xed, 0xf8, 0x92, 0x7a, 0x53, 0xc6, 0x9c, 0x71, 0xa3, 0xb2, 0xf2, 0xa0, 0x9e, 0xf0, 0x84,
+		0xf5, 0x57, 0x1a, 0xe6, 0xb1, 0x46, 0xd9, 0xc6, 0xde, 0x41, 0xb4, 0xcf, 0xe3, 0x18, 0xe2, 0xd9,
+		0x3f, 0xcc, 0x79, 0x2f, 0x53, 0x24, 0xfa, 0x02, 0x66, 0x3d, 0x89, 0x85, 0xb4, 0x24, 0x6b, 0x52,
+		0x91, 0xc8, 0x67, 0x48, 0xf1, 0x59, 0x4e, 0xe6, 0x13, 0xe4, 0x6e, 0x07, 0xa9, 0x89, 0x2c, 0x66,
+		0xbc, 0x94, 0xfb, 0xe8, 0x47, 0x03, 0x82, 0xd3, 0x6f, 0x39, 0x54, 0x52, 0x2b, 0x3a, 0x40, 0x8b,
+		0xbe, 0xa5, 0xc4, 0x0f, 0x8a, 0x96, 0x48, 0x66, 0x58, 0x91, 0xf9, 0x4f, 0x22, 0x99, 0x35, 0x8d,
+		0xf5, 0x46, 0x43, 0x3d, 0x8d, 0x90, 0x12, 0xb9, 0x2d, 0x90, 0xec, 0xe1, 0xe8, 0x7b, 0x03, 0x16,
+		0x76, 0x31, 0x73, 0xb2, 0xd2, 0x1c, 0x51, 0x34, 0x1f, 0x26, 0xd2, 0x5c, 0xc7, 0xcc, 0xc9, 0x46,
+		0xf1, 0xfa, 0x6e, 0xb6, 0x50, 0xf4, 0xce, 0x80, 0x25, 0x41, 0x3f, 0xf7, 0xa9, 0x27, 0x2d, 0x82,
+		0x5d, 0x42, 0x9d, 0x0c, 0x7d, 0x36, 0x9a, 0x72, 0x94, 0x66, 0x08, 0xb6, 0xa6, 0xb0, 0x06, 0x36,
+		0xdb, 0x82, 0xc8, 0x1e, 0x8e, 0xbe, 0x84, 0x39, 0x4d, 0xb1, 0x7f, 0xcb, 0x15, 0x14, 0xb5, 0x95,
+		0xe4, 0x2a, 0xab, 0xe4, 0xfe, 0x3d, 0x77, 0x85, 0xa4, 0x05, 0xa0, 0x1f, 0x0c, 0x58, 0xd4, 0xfb,
+		0x67, 0xac, 0x65, 0x51, 0x91, 0x79, 0x94, 0x42, 0x26, 0x5b, 0x35, 0x6f, 0x92, 0xac, 0xc1, 0xe8,
+		0x77, 0x03, 0x1e, 0xf5, 0xd4, 0x93, 0xbe, 0x95, 0x54, 0xb8, 0x38, 0x33, 0xeb, 0x92, 0x62, 0xfd,
+		0x62, 0x70, 0x75, 0x9f, 0x6a, 0xe0, 0x6c, 0x0f, 0x71, 0x4f, 0x9c, 0x32, 0x17, 0x7d, 0x65, 0xc0,
+		0x35, 0x41, 0x09, 0x17, 0xb6, 0xd5, 0xc4, 0xe2, 0xa0, 0x4f, 0xe5, 0xcb, 0x8a, 0xf6, 0xed, 0x3e,
+		0xb4, 0x83, 0xec, 0x17, 0x2a, 0x39, 0x91, 0xdc, 0x55, 0x91, 0x1a, 0x81, 0x7e, 0x31, 0xe0, 0x2e,
+		0xe1, 0xae, 0x64, 0xae, 0x4f, 0x2d, 0xec, 0x59, 0x2e, 0x3d, 0xca, 0x7a, 0x9c, 0xa0, 0x78, 0x3d,
+		0xed, 0x33, 0x77, 0x42, 0xc8, 0xc7, 0xde, 0x26, 0x3d, 0xca, 0x76, 0x8c, 0x4b, 0x24, 0x67, 0x0e,
+		0xfa, 0xc9, 0x80, 0x95, 0x70, 0x52, 0x93, 0x7d, 0xe6, 0xd8, 0x59, 0x79, 0x57, 0x14, 0xef, 0xd5,
+		0xfe, 0xc3, 0x7b, 0x2d, 0x40, 0xcb, 0x46, 0x7a, 0xd1, 0xcb, 0x93, 0x80, 0x7e, 0x35, 0xe0, 0xae,
+		0xc7, 0xf6, 0x5c, 0x9c, 0xbf, 0x79, 0xab, 0x8a, 0xf5, 0x7a, 0x32, 0x6b, 0x05, 0x99, 0xaf, 0x6b,
+		0x97, 0xbd, 0xbc, 0x49, 0xe8, 0x67, 0x03, 0xfe, 0xed, 0xb7, 0x3c, 0x2a, 0x64, 0x87, 0xb4, 0x47,
+		0xb1, 0x20, 0xfb, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa5, 0xb4, 0xca, 0x6b, 0x85, 0x18, 0xed, 0xff,
+		0x4a, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x15, 0x3f, 0x67, 0xce, 0x6a, 0x15, 0xa0, 0x43, 0xa7, 0xf6,
+		0xae, 0x00, 0xf3, 0xd9, 0x6c, 0x03, 0x9a, 0x85, 0x4a, 0x2c, 0x1b, 0xcc, 0x56, 0x46, 0xa4, 0x6c,
+		0x42, 0xb4, 0xb4, 0x61, 0xa3, 0x75, 0x18, 0x8b, 0x03, 0x64, 0xbb, 0x45, 0xb5, 0x37, 0xb8, 0x96,
+		0xf8, 0xac, 0xf1, 0x66, 0xed, 0x16, 0x35, 0xab, 0xb8, 0xeb, 0x0a, 0x4d, 0x42, 0xc1, 0xe6, 0x4d,
+		0xcc, 0x5c, 0xa5, 0xe7, 0x65, 0x53, 0x5f, 0xa1, 0xfb, 0x50, 0x56, 0x72, 0x15, 0xb8, 0x2d, 0xad,
+		0xa1, 0x57, 0x12, 0xb1, 0x83, 0x07, 0x78, 0xce, 0x3c, 0x69, 0x96, 0xa4, 0xfe, 0x0b, 0xad, 0xc0,
+		0x28, 0x73, 0x5b, 0xbe, 0xd4, 0xba, 0x36, 0x93, 0x98, 0xb7, 0x85, 0xdb, 0x0e, 0xc7, 0xb6, 0x19,
+		0x86, 0xa2, 0x6d, 0x98, 0x8e, 0x8d, 0x99, 0xe4, 0x16, 0x71, 0xb8, 0x47, 0x95, 0x2c, 0x71, 0x5f,
+		0x6a, 0x11, 0x9a, 0xae, 0x87, 0xa6, 0xb2, 0x1e, 0x99, 0xca, 0xfa, 0x13, 0x6d, 0x2a, 0xcd, 0xc9,
+		0x28, 0x77, 0x9b, 0xaf, 0x05, 0x99, 0xdb, 0x61, 0x62, 0x2f, 0x6a, 0xc7, 0x5f, 0x05, 0xa8, 0xc5,
+		0x1c, 0xa8, 0xb1, 0xbb, 0x0a, 0x50, 0x37, 0x61, 0x52, 0x23, 0xf5, 0x12, 0x2d, 0x0d, 0x82, 0x9c,
+		0x08, 0x6d, 0xd8, 0x71, 0x96, 0xeb, 0x30, 0xbe, 0x4f, 0xb1, 0x90, 0x3b, 0x14, 0x77, 0xd8, 0x95,
+		0x07, 0x41, 0x9d, 0x8b, 0x73, 0x22, 0x9c, 0x35, 0xa8, 0x0a, 0x2a, 0x45, 0xdb, 0x6a, 0x71, 0x87,
+		0x91, 0xb6, 0x9e, 0x38, 0x73, 0x7d, 0x26, 0xb8, 0x14, 0xed, 0x2d, 0x15, 0x67, 0x56, 0x44, 0xe7,
+		0x02, 0xdd, 0x86, 0xc2, 0x3e, 0xc5, 0x36, 0x15, 0xfa, 0xd5, 0xbf, 0x9c, 0x98, 0xfe, 0x4c, 0x85,
+		0x98, 0x3a, 0x14, 0xdd, 0x81, 0xc9, 0x48, 0x24, 0x1d, 0x4e, 0xb0, 0x63, 0xd9, 0xcc, 0x6b, 0x61,
+		0x49, 0xf6, 0xd5, 0x2b, 0x58, 0x32, 0xcf, 0xeb, 0xbb, 0xcf, 0x83, 0x9b, 0x4f, 0xf4, 0x3d, 0x74,
+		0x09, 0x4a, 0x2d, 0xc1, 0xb8, 0x60, 0xb2, 0x3d, 0x75, 0x66, 0xce, 0xb8, 0x31, 0x6a, 0xc6, 0xd7,
+		0xb5, 0x6f, 0x0c, 0x98, 0x49, 0xb3, 0xb4, 0x68, 0x1a, 0x4a, 0xa1, 0x6b, 0x89, 0x5f, 0x8f, 0xa2,
+		0xba, 0xde, 0xb0, 0xd1, 0x73, 0xb8, 0x10, 0xd7, 0x67, 0x97, 0x89, 0x4e, 0x79, 0x86, 0x06, 0x9d,
+		0x29, 0xd2, 0xe5, 0x59, 0x67, 0x22, 0xaa, 0x4e, 0x8d, 0xc0, 0x42, 0x0e, 0x3b, 0x8b, 0xee, 0x40,
+		0x41, 0x50, 0xcf, 0x77, 0xe4, 0x94, 0x91, 0xa1, 0xfb, 0x75, 0x6c, 0x0d, 0xc3, 0xf5, 0x8c, 0x66,
+		0x14, 0xdd, 0x85, 0x62, 0x60, 0x46, 0x7d, 0x41, 0x53, 0x77, 0x58, 0x0f, 0x63, 0xcc, 0x28, 0xb8,
+		0xb6, 0x09, 0x0b, 0x39, 0xbc, 0xe4, 0xc0, 0x09, 0x54, 0xbb, 0x0f, 0x57, 0x52, 0x0d, 0x60, 0x4a,
+		0x85, 0x6a, 0x04, 0x6e, 0x66, 0xf6, 0x6b, 0xc1, 0x03, 0xdb, 0x54, 0x62, 0xe6, 0x78, 0x99, 0x8e,
+		0x34, 0x0a, 0xae, 0xfd, 0x69, 0xc0, 0xbd, 0xd3, 0xfa, 0xab, 0xae, 0xb9, 0x68, 0x1c, 0x9b, 0x8b,
+		0xaf, 0x01, 0x9d, 0x54, 0x4e, 0xdd, 0x58, 0xf3, 0x89, 0xbc, 0x4e, 0xec, 0x66, 0x8e, 0x1f, 0xf5,
+		0x2e, 0xa1, 0x29, 0x28, 0x12, 0xee, 0x4a, 0xc1, 0x1d, 0x35, 0x87, 0xab, 0x66, 0x74, 0x89, 0xea,
+		0x30, 0xd1, 0x63, 0x33, 0xb8, 0xeb, 0xb4, 0xd5, 0x48, 0x2e, 0x99, 0xe3, 0xa4, 0xdb, 0x02, 0xbc,
+		0x74, 0x9d, 0x76, 0xed, 0xbd, 0x01, 0x57, 0xd3, 0xed, 0x59, 0x50, 0x5a, 0xed, 0xfb, 0x5c, 0xdc,
+		0xa4, 0x51, 0x69, 0xc3, 0xa5, 0x4d, 0xdc, 0xa4, 0xdd, 0x27, 0x3e, 0x94, 0xe3, 0xc4, 0xbb, 0x66,
+		0xc7, 0x70, 0xe6, 0xd9, 0x51, 0x7b, 0x5f, 0x82, 0xa5, 0xbc, 0xbe, 0x2d, 0x90, 0xbf, 0xf8, 0x3c,
+		0x94, 0xfc, 0x19, 0x29, 0xf2, 0x17, 0x01, 0x86, 0xf2, 0x77, 0xd4, 0x75, 0x75, 0x5c, 0xe6, 0x86,
+		0x4e, 0x29, 0x73, 0xc3, 0xd9, 0x65, 0x0e, 0xc3, 0x5c, 0xc7, 0x6f, 0xf5, 0x11, 0x91, 0x91, 0x41,
+		0x53, 0x6a, 0x26, 0x86, 0x78, 0x95, 0xa0, 0x26, 0x6f, 0xe0, 0xb2, 0x7a, 0xa4, 0x3e, 0xe8, 0xa3,
+		0x83, 0xd0, 0x2f, 0x06, 0xd9, 0x49, 0xc0, 0x2f, 0x61, 0x72, 0x07, 0x93, 0x03, 0xbe, 0xbb, 0xab,
+		0xb1, 0x99, 0x2b, 0xa9, 0x38, 0xc4, 0xce, 0x60, 0x7d, 0x3e, 0xaf, 0x13, 0x15, 0xec, 0x86, 0x4e,
+		0x3b, 0xa1, 0x57, 0xc5, 0xd3, 0xe8, 0xd5, 0x06, 0x94, 0x99, 0xcb, 0x24, 0xc3, 0x92, 0x0b, 0xa5,
+		0xbf, 0x67, 0x56, 0x16, 0x06, 0x7f, 0x1b, 0x6c, 0x44, 0x29, 0x66, 0x27, 0xbb, 0x7b, 0xb2, 0x96,
+		0x73, 0x4c, 0x56, 0x64, 0xc2, 0xa4, 0x83, 0x83, 0xef, 0xc3, 0x50, 0x26, 0x82, 0xd2, 0x6a, 0x09,
+		0x80, 0x0c, 0x9d, 0x71, 0x3e, 0xc8, 0x5d, 0x8b, 0x53, 0x4d, 0x95, 0x89, 0xfe, 0x09, 0x63, 0x44,
+		0x04, 0x3d, 0xa2, 0x2d, 0x88, 0x12, 0xf3, 0xb2, 0x59, 0x0d, 0x16, 0x23, 0x0f, 0x79, 0x3a, 0xad,
+		0x5e, 0x84, 0x91, 0x26, 0x6d, 0x72, 0x6d, 0x8e, 0xa7, 0x13, 0x53, 0x5e, 0xd0, 0x26, 0x37, 0x55,
+		0x18, 0x32, 0x61, 0xfc, 0x84, 0xd9, 0x56, 0x6a, 0x5d, 0x59, 0xf9, 0x57, 0xf2, 0x57, 0x41, 0x8f,
+		0x2d, 0x36, 0xcf, 0x79, 0x3d, 0x2b, 0xe8, 0x21, 0x54, 0x3f, 0x63, 0x52, 0x52, 0x11, 0x36, 0xd2,
+		0xd4, 0xd9, 0x41, 0xfd, 0x53, 0x09, 0xc3, 0x55, 0xfb, 0xd4, 0xfe, 0x28, 0xc2, 0x62, 0xae, 0x0f,
+		0xa6, 0xbe, 0xc3, 0x7c, 0x16, 0x2a, 0xf1, 0x14, 0x61, 0xb6, 0x7a, 0xff, 0xcb, 0x26, 0x44, 0x4b,
+		0xa1, 0xcb, 0x3e, 0x3e, 0x66, 0x86, 0x3f, 0xc2, 0x98, 0xf9, 0x04, 0x6e, 0x3a, 0xcb, 0x98, 0x29,
+		0xfc, 0xad, 0x63, 0xa6, 0x78, 0xea, 0x31, 0xf3, 0x5f, 0x98, 0x68, 0x61, 0x41, 0x5d, 0xa9, 0x11,
+		0xf5, 0x70, 0x08, 0x5f, 0xed, 0xf9, 0x3e, 0x4f, 0x1f, 0xc4, 0x2b, 0x14, 0x3d, 0x22, 0xc6, 0x5b,
+		0xbd, 0x4b, 0xdd, 0x12, 0x5b, 0x3e, 0x2e, 0xb1, 0x04, 0xa6, 0xba, 0xda, 0xc0, 0x12, 0xd4, 0xef,
+		0x6c, 0x0b, 0x6a, 0xdb, 0x5b, 0xa9, 0x05, 0xdf, 0xb0, 0x4d, 0xea, 0x47, 0xfb, 0x98, 0x17, 0x8e,
+		0x92, 0x96, 0x3f, 0x8e, 0x39, 0x3f, 0x31, 0x15, 0xaa, 0xa9, 0x53, 0x61, 0x2c, 0xff, 0x54, 0x38,
+		0xf3, 0x01, 0x53, 0xe1, 0xec, 0x07, 0x4d, 0x85, 0xda, 0x6f, 0x43, 0xb0, 0x9c, 0xfb, 0x5f, 0x0a,
+		0x9f, 0xda, 0xa8, 0xcd, 0x42, 0x45, 0xff, 0x27, 0x45, 0x79, 0xa7, 0xf0, 0xa3, 0x19, 0xc2, 0x25,
+		0xe5, 0x9d, 0xe2, 0xd7, 0x75, 0x24, 0xfb, 0xeb, 0xda, 0xd5, 0x9a, 0xa3, 0x99, 0xdc, 0x5f, 0xa1,
+		0x9f, 0xfb, 0xfb, 0xda, 0x80, 0xa5, 0xbc, 0xff, 0xd9, 0x48, 0x2e, 0xa6, 0xf1, 0x41, 0xc5, 0x5c,
+		0xfd, 0x3f, 0x5c, 0x24, 0xbc, 0x99, 0x94, 0xbd, 0x3a, 0x16, 0x51, 0xd8, 0x12, 0x5c, 0xf2, 0x2d,
+		0xe3, 0x7f, 0xcb, 0x7b, 0x4c, 0xee, 0xfb, 0x3b, 0x75, 0xc2, 0x9b, 0x8d, 0xee, 0x5f, 0x74, 0x16,
+		0x99, 0xed, 0x34, 0xf6, 0x78, 0xf8, 0x23, 0x92, 0xfe, 0x79, 0xe7, 0x01, 0x6e, 0xb1, 0xc3, 0xe5,
+		0x9d, 0x82, 0x5a, 0xbb, 0xfd, 0xd7, 0x00, 0xc8, 0xdd, 0x9c, 0x12, 0xa1, 0x1a, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/cluster.proto
 	[]byte{
@@ -3626,8 +3923,7 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x6f, 0x53, 0xb4, 0xa3, 0xd6, 0x32, 0x4b, 0xef, 0xb5, 0x5d, 0xdf, 0x15, 0x64, 0x9a, 0xee, 0x68,
 		0x47, 0x9d, 0x3f, 0xff, 0x7e, 0xb2, 0x12, 0x76, 0x5d, 0x65, 0x31, 0xd7, 0x32, 0xb9, 0x78, 0x75,
 		0x4f, 0x45, 0x5e, 0x24, 0x2b, 0x9d, 0xb8, 0x5b, 0xeb, 0x4e, 0xf0, 0x95, 0x0b, 0x36, 0xb3, 0xec,
-		0xba, 0xab, 0x3f, 0xfb, 0x13, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x49, 0xfd, 0x6d, 0xaa, 0x03, 0x00,
-		0x00,
+		0xba, 0xab, 0x3f, 0xfb, 0x33, 0x00, 0xaf, 0x49, 0xfd, 0x6d, 0xaa, 0x03, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/history.proto
 	[]byte{
@@ -3644,284 +3940,295 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
 		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
 		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
-		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x14, 0xd7, 0xd4, 0x3e,
-		0x01, 0x00, 0x00,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x18, 0x00, 0x44, 0x14, 0xd7, 0xd4, 0x3e, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/queue.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-		0x15, 0x36, 0x25, 0xff, 0x90, 0x9f, 0x1d, 0x87, 0x9e, 0x6c, 0x1a, 0xe5, 0x87, 0x11, 0x45, 0x0b,
-		0x24, 0x82, 0x37, 0x91, 0x6a, 0x7b, 0x83, 0x14, 0xdd, 0xa2, 0x2d, 0x43, 0x31, 0x16, 0xd7, 0xb2,
-		0x24, 0x0c, 0xa9, 0x24, 0x5e, 0x14, 0x18, 0xd0, 0xe2, 0xd8, 0x21, 0x42, 0x91, 0x5a, 0x92, 0x72,
-		0xaa, 0x3f, 0xa0, 0xe9, 0xbd, 0xdb, 0x6b, 0x2f, 0xbd, 0x16, 0xe8, 0xa9, 0x3d, 0x14, 0xbd, 0x16,
-		0xe8, 0xa9, 0xd7, 0xed, 0x21, 0xff, 0x44, 0xff, 0x84, 0x82, 0x43, 0x4a, 0xa2, 0x28, 0x4a, 0xa2,
-		0x13, 0x17, 0xe8, 0x61, 0x6f, 0xd6, 0xe3, 0x37, 0x8f, 0xdf, 0xfb, 0xde, 0xcc, 0xa7, 0x37, 0x16,
-		0x3c, 0xe8, 0x9f, 0x52, 0xa7, 0xd2, 0xd1, 0x74, 0x6a, 0x75, 0x68, 0x45, 0xd3, 0xbb, 0x86, 0x55,
-		0xb9, 0xd8, 0xab, 0x7c, 0xdb, 0xa7, 0x7d, 0x5a, 0xee, 0x39, 0xb6, 0x67, 0xa3, 0x9b, 0x3e, 0xa4,
-		0x1c, 0x42, 0xca, 0x0c, 0x52, 0xbe, 0xd8, 0xbb, 0x73, 0xff, 0xdc, 0xb6, 0xcf, 0x4d, 0x5a, 0x61,
-		0xa0, 0xd3, 0xfe, 0x59, 0xc5, 0x33, 0xba, 0xd4, 0xf5, 0xb4, 0x6e, 0x2f, 0x58, 0x77, 0xa7, 0x30,
-		0x99, 0xba, 0x67, 0xf8, 0x89, 0x3b, 0x76, 0xb7, 0x6b, 0x5b, 0x21, 0xe2, 0x41, 0x12, 0xe2, 0x8d,
-		0xe1, 0x7a, 0xb6, 0x33, 0x08, 0x21, 0xc5, 0x24, 0xc8, 0x3b, 0xdb, 0x79, 0x7b, 0x66, 0xda, 0xef,
-		0x02, 0x4c, 0xf1, 0x43, 0x06, 0x3e, 0x13, 0x1d, 0xdb, 0x75, 0x45, 0xb3, 0xef, 0x7a, 0xd4, 0x51,
-		0x35, 0xf7, 0xad, 0x6c, 0x9d, 0xd9, 0xe8, 0x2e, 0xac, 0xeb, 0x76, 0x57, 0x33, 0x2c, 0x62, 0xe8,
-		0x79, 0xae, 0xc0, 0x95, 0xd6, 0x71, 0x2e, 0x08, 0xc8, 0x3a, 0x6a, 0x03, 0x1a, 0xe6, 0x21, 0xf4,
-		0xd7, 0xb4, 0xd3, 0xf7, 0x0c, 0xdb, 0xca, 0x67, 0x0a, 0x5c, 0x69, 0x63, 0xff, 0x61, 0x79, 0xb2,
-		0xe6, 0x9e, 0x51, 0xbe, 0xd8, 0x2b, 0xbf, 0x0a, 0xe1, 0xd2, 0x10, 0x8d, 0xb7, 0xdf, 0xc5, 0x43,
-		0xa8, 0x06, 0xeb, 0x9e, 0xe6, 0xbe, 0x25, 0xde, 0xa0, 0x47, 0xf3, 0xd9, 0x02, 0x57, 0xda, 0xda,
-		0xff, 0xa2, 0x9c, 0xa8, 0x60, 0x39, 0xce, 0x59, 0x1d, 0xf4, 0x28, 0xce, 0x79, 0xe1, 0x5f, 0x68,
-		0x07, 0x80, 0x65, 0x72, 0x3d, 0xcd, 0xa3, 0xf9, 0xe5, 0x02, 0x57, 0x5a, 0xc1, 0x2c, 0xb7, 0xe2,
-		0x07, 0xd0, 0x2d, 0x58, 0x63, 0x8f, 0x0d, 0x3d, 0xbf, 0x52, 0xe0, 0x4a, 0x59, 0xbc, 0xea, 0x7f,
-		0x94, 0x75, 0x74, 0x0c, 0x9f, 0x5d, 0x18, 0xae, 0x71, 0x6a, 0x98, 0x86, 0x37, 0x20, 0xa3, 0xae,
-		0xe4, 0x57, 0x59, 0x69, 0x77, 0xca, 0x41, 0xdf, 0xca, 0xc3, 0xbe, 0x95, 0xd5, 0x21, 0x02, 0xdf,
-		0x18, 0xaf, 0x1b, 0x05, 0x8b, 0xdf, 0x67, 0xe0, 0xc7, 0x51, 0xa6, 0x8a, 0xa7, 0x39, 0x9e, 0xf8,
-		0xc6, 0x30, 0xf5, 0xb1, 0x0e, 0xf4, 0xdb, 0x3e, 0x75, 0x3d, 0xc1, 0xf3, 0x1c, 0xe3, 0xb4, 0xef,
-		0x51, 0x17, 0x95, 0x80, 0xf7, 0x34, 0xe7, 0x9c, 0x7a, 0x24, 0xde, 0x80, 0xad, 0x20, 0x5e, 0x1d,
-		0xb6, 0x61, 0x07, 0xc0, 0x09, 0x96, 0xfb, 0x98, 0x0c, 0xc3, 0xac, 0x87, 0x11, 0x59, 0x47, 0x8f,
-		0x01, 0x19, 0x96, 0xe1, 0x19, 0x9a, 0x47, 0x75, 0x42, 0x2f, 0xa8, 0xc5, 0x60, 0x59, 0x56, 0x30,
-		0x3f, 0x7a, 0x22, 0xf9, 0x0f, 0x64, 0x1d, 0xbd, 0xe7, 0xe0, 0x4e, 0x1c, 0xae, 0x8d, 0x58, 0x31,
-		0x0d, 0x37, 0xf6, 0x6b, 0x89, 0xcd, 0x1d, 0x97, 0x35, 0xd5, 0x66, 0x79, 0xe2, 0x35, 0xe3, 0x2a,
-		0x71, 0xde, 0x98, 0xf1, 0x04, 0x15, 0xe1, 0x5a, 0x58, 0xbf, 0xd3, 0xb7, 0x86, 0x2d, 0x5a, 0xc7,
-		0x1b, 0x41, 0x10, 0xf7, 0x2d, 0x59, 0x2f, 0x7e, 0x0d, 0x7b, 0x0b, 0x75, 0x75, 0x7b, 0xb6, 0xe5,
-		0xd2, 0x48, 0xe2, 0x9b, 0xb0, 0x1a, 0x66, 0x0c, 0xe4, 0x5c, 0x71, 0x58, 0xae, 0xbf, 0x65, 0xe0,
-		0x71, 0x34, 0x99, 0xa8, 0x59, 0x1d, 0x6a, 0x5e, 0x49, 0x83, 0x4e, 0xe1, 0x76, 0x88, 0xfc, 0xe4,
-		0xe3, 0x72, 0x2b, 0x48, 0x34, 0xf5, 0x20, 0xb6, 0x09, 0xb2, 0xe9, 0x36, 0xc1, 0xf2, 0x8c, 0x4d,
-		0x50, 0x86, 0x1b, 0x1d, 0x5f, 0xc6, 0x31, 0x5f, 0xdb, 0x32, 0x07, 0xac, 0x03, 0x39, 0xbc, 0xdd,
-		0x89, 0xb6, 0xb8, 0x69, 0x99, 0x83, 0x62, 0x05, 0x9e, 0xcc, 0x95, 0x2e, 0xde, 0x83, 0xe2, 0x5f,
-		0xb3, 0x93, 0x62, 0x2b, 0xc6, 0xb9, 0xa5, 0xfd, 0x20, 0x76, 0x1a, 0xb1, 0xd1, 0x7d, 0xd8, 0x70,
-		0x99, 0x5c, 0xc4, 0xd2, 0xba, 0x94, 0x79, 0xd2, 0x3a, 0x86, 0x20, 0xd4, 0xd0, 0xba, 0x14, 0xfd,
-		0x02, 0x36, 0x43, 0x80, 0x61, 0xf5, 0xfa, 0x5e, 0x7e, 0x8d, 0x15, 0x7d, 0x2f, 0xb1, 0xe8, 0x96,
-		0x36, 0x30, 0x6d, 0x4d, 0xc7, 0x61, 0x4a, 0xd9, 0x5f, 0x80, 0xf2, 0xb0, 0xd6, 0xb1, 0x2d, 0xcf,
-		0xb1, 0xcd, 0x7c, 0xae, 0xc0, 0x95, 0x36, 0xf1, 0xf0, 0x63, 0xbc, 0xd1, 0x53, 0x6d, 0x9b, 0x6a,
-		0xf4, 0xbf, 0x32, 0x20, 0x44, 0x57, 0x60, 0xda, 0xb1, 0x1d, 0x3d, 0xd9, 0x24, 0x44, 0xbb, 0xdb,
-		0x33, 0xa9, 0x47, 0xff, 0xdf, 0xbb, 0x7f, 0x39, 0x43, 0xad, 0x03, 0xdf, 0x09, 0x0a, 0x33, 0x6c,
-		0x2b, 0x80, 0x87, 0x2e, 0xfa, 0x20, 0x91, 0x48, 0x2d, 0xf8, 0xf2, 0x66, 0xcb, 0xf1, 0xf5, 0xf1,
-		0x52, 0x16, 0x28, 0x56, 0xe1, 0xf9, 0xe5, 0xe5, 0x9c, 0xea, 0xca, 0x7f, 0x38, 0x28, 0x08, 0xbd,
-		0x9e, 0x39, 0x68, 0x69, 0x0e, 0xb5, 0x3c, 0xd1, 0xb4, 0x5d, 0xda, 0xb2, 0x4d, 0xa3, 0x33, 0x88,
-		0x88, 0xfe, 0x10, 0xae, 0x07, 0xfb, 0x32, 0xae, 0xf9, 0x35, 0x16, 0x1e, 0x49, 0xbe, 0x0b, 0xdb,
-		0xb1, 0xfd, 0x3b, 0xfa, 0x16, 0xba, 0x3e, 0xb1, 0x7b, 0x65, 0x1d, 0x15, 0x60, 0x33, 0xc0, 0x86,
-		0x0e, 0x1c, 0x1c, 0x1d, 0x60, 0x31, 0x66, 0xe9, 0xe8, 0x25, 0xdc, 0xe8, 0x31, 0x52, 0xa4, 0xe3,
-		0xb3, 0x22, 0x3d, 0x46, 0x8b, 0x29, 0xb6, 0x35, 0xa3, 0x75, 0x53, 0x45, 0xe0, 0xed, 0x5e, 0x3c,
-		0x54, 0xfc, 0x8e, 0x83, 0x7b, 0xc9, 0x25, 0xfb, 0xb3, 0x40, 0xdf, 0x45, 0xf7, 0x60, 0x3d, 0x14,
-		0x9b, 0x06, 0x85, 0xe6, 0xf0, 0x38, 0x80, 0xda, 0xb0, 0x79, 0xa6, 0x19, 0x26, 0xd5, 0x49, 0x47,
-		0xeb, 0xbb, 0x94, 0xd5, 0xb7, 0xb5, 0xbf, 0x9f, 0x72, 0x2c, 0x79, 0xc1, 0x96, 0x8a, 0xfe, 0x4a,
-		0xbc, 0x71, 0x36, 0xfe, 0x50, 0xfc, 0x3b, 0x07, 0x3b, 0xc9, 0xac, 0xc2, 0x43, 0x80, 0x8e, 0x61,
-		0x85, 0xa9, 0xc3, 0x28, 0x6d, 0xec, 0x3f, 0x9b, 0xf1, 0xc6, 0x45, 0xdd, 0xc4, 0x41, 0x16, 0x74,
-		0x04, 0xab, 0x2e, 0xab, 0x37, 0x3c, 0x0c, 0x07, 0x97, 0xca, 0x17, 0x48, 0x85, 0xc3, 0x14, 0xc5,
-		0xdf, 0x72, 0x70, 0x10, 0x2d, 0x75, 0x6e, 0x25, 0x91, 0x9d, 0xd5, 0x82, 0x1c, 0x63, 0xe3, 0x50,
-		0x2b, 0xcf, 0x15, 0xb2, 0xa5, 0x8d, 0xfd, 0x2f, 0x2f, 0x45, 0x23, 0xcc, 0x88, 0x47, 0x59, 0x8a,
-		0xff, 0x98, 0xd9, 0x5d, 0x4c, 0xdd, 0xbe, 0x79, 0xe5, 0x32, 0xfe, 0x8f, 0xb6, 0xc3, 0xef, 0x39,
-		0xf8, 0x32, 0x8d, 0xa0, 0x53, 0x33, 0xcd, 0xaf, 0xc2, 0xb3, 0xea, 0x50, 0x8b, 0x84, 0xfd, 0x0d,
-		0x84, 0x3d, 0xb8, 0xa4, 0xb0, 0xbe, 0x58, 0x78, 0x6b, 0x98, 0x2b, 0xe8, 0x77, 0xf1, 0x0f, 0x6b,
-		0x70, 0x2b, 0x5e, 0xc3, 0x70, 0x7f, 0x0e, 0x87, 0x75, 0xc3, 0x3a, 0xb3, 0x43, 0x71, 0xd3, 0x0e,
-		0xeb, 0xfe, 0x05, 0x23, 0x18, 0xd6, 0xd9, 0x55, 0xe3, 0x77, 0x1c, 0x14, 0x5c, 0x7f, 0x82, 0x23,
-		0x81, 0x45, 0x8c, 0x7c, 0x3b, 0x3a, 0x7f, 0x06, 0xbb, 0xf6, 0x30, 0xc5, 0x1b, 0xd2, 0x0c, 0xd9,
-		0xb5, 0x25, 0xbc, 0xe3, 0x4e, 0xe3, 0x22, 0xc2, 0xfe, 0x86, 0x83, 0xbb, 0x1d, 0x36, 0xce, 0x24,
-		0xf3, 0xc9, 0x32, 0x3e, 0x62, 0x0a, 0x3e, 0x8b, 0xe6, 0xc9, 0xda, 0x12, 0xbe, 0xdd, 0x99, 0xc4,
-		0xc4, 0x78, 0x84, 0x5f, 0xea, 0x89, 0x3c, 0x96, 0x53, 0xf3, 0x58, 0x34, 0x6a, 0xf9, 0x3c, 0xdc,
-		0x49, 0x4c, 0x84, 0xc7, 0xf7, 0x1c, 0x7c, 0xe5, 0xb0, 0x2f, 0x1d, 0x12, 0x33, 0xfd, 0x31, 0xad,
-		0xa1, 0x6b, 0x92, 0xe1, 0x8c, 0x14, 0xe1, 0xb9, 0xc2, 0x78, 0xbe, 0x4e, 0xc1, 0xf3, 0xa3, 0x26,
-		0x85, 0xda, 0x12, 0x7e, 0xea, 0x7c, 0xd4, 0x88, 0xf1, 0x27, 0x0e, 0x1e, 0x6b, 0xfe, 0xa1, 0x20,
-		0x09, 0x5f, 0x3f, 0x49, 0x95, 0x04, 0x77, 0xc1, 0xaf, 0x53, 0x54, 0x92, 0xd2, 0x16, 0x6b, 0x4b,
-		0xf8, 0x91, 0x96, 0x0e, 0xfa, 0x7c, 0x13, 0x60, 0x4c, 0xa5, 0xf8, 0x97, 0x1c, 0xe4, 0xa7, 0xcf,
-		0x67, 0x60, 0x12, 0xd1, 0x4b, 0x2e, 0x37, 0x71, 0xc9, 0x9d, 0xb8, 0x66, 0x67, 0xae, 0xee, 0x9a,
-		0x9d, 0x8d, 0x5f, 0xb3, 0xe3, 0x66, 0xb9, 0x7c, 0x25, 0x66, 0x89, 0xbe, 0x4b, 0xe3, 0x17, 0x2b,
-		0x89, 0xf7, 0xd5, 0xd4, 0x7e, 0x11, 0x37, 0xda, 0xc5, 0x86, 0xf1, 0x7e, 0x81, 0x61, 0x04, 0xdb,
-		0xa6, 0xfa, 0x31, 0x86, 0x91, 0x40, 0x66, 0x8e, 0x63, 0xbc, 0x5f, 0xe0, 0x18, 0x6b, 0xa9, 0x89,
-		0x2c, 0x9c, 0xf2, 0xe7, 0x5b, 0xc6, 0xbf, 0x3f, 0xd1, 0x32, 0x72, 0x8c, 0xe8, 0xc9, 0x95, 0x59,
-		0x46, 0x02, 0xfb, 0x8f, 0xf4, 0x8c, 0x3f, 0x73, 0xf0, 0x64, 0x9e, 0x67, 0x04, 0x6f, 0x8a, 0xd6,
-		0xb2, 0xce, 0x6a, 0x39, 0xfa, 0x04, 0xd3, 0x48, 0x60, 0x5f, 0xd2, 0x52, 0x62, 0x63, 0xb6, 0x61,
-		0x27, 0xb9, 0x06, 0xab, 0xd1, 0x45, 0x0a, 0x5c, 0x63, 0x47, 0x3a, 0xec, 0xc7, 0x70, 0x9c, 0x28,
-		0xa7, 0x3c, 0xb4, 0xc3, 0x09, 0x6d, 0xd3, 0x8b, 0x24, 0xdd, 0x7d, 0xcf, 0x41, 0x6e, 0x68, 0x1f,
-		0xe8, 0x26, 0x6c, 0xab, 0x82, 0x72, 0x44, 0xd4, 0x93, 0x96, 0x44, 0xe4, 0xc6, 0x4b, 0xa1, 0x2e,
-		0x57, 0xf9, 0x25, 0xf4, 0x23, 0x40, 0xe3, 0xb0, 0x8a, 0x85, 0x86, 0xf2, 0x42, 0xc2, 0x3c, 0x87,
-		0x6e, 0xc0, 0xf5, 0x48, 0x5c, 0x3e, 0x96, 0x30, 0x9f, 0x41, 0xb7, 0xe1, 0xe6, 0x38, 0x88, 0xa5,
-		0x56, 0x5d, 0x16, 0x05, 0x55, 0x6e, 0x36, 0xf8, 0x2c, 0xba, 0x0b, 0xb7, 0xc6, 0x8f, 0x44, 0xdc,
-		0x54, 0x14, 0x22, 0xd6, 0xdb, 0x8a, 0x2a, 0x61, 0x7e, 0x79, 0xf7, 0x9f, 0x09, 0xff, 0xee, 0x64,
-		0xa4, 0x3e, 0x87, 0xfb, 0x13, 0x58, 0x92, 0x44, 0x71, 0x0f, 0x9e, 0xcc, 0x02, 0x29, 0xaa, 0x80,
-		0x55, 0x22, 0xd6, 0xe4, 0x7a, 0x95, 0x48, 0xaf, 0x25, 0xb1, 0xcd, 0xd8, 0x70, 0xe8, 0x31, 0x94,
-		0x66, 0x2d, 0x11, 0x85, 0x86, 0x28, 0xd5, 0x23, 0xe8, 0xcc, 0x3c, 0xb4, 0x22, 0x1f, 0x36, 0x84,
-		0x28, 0x3a, 0x8b, 0xaa, 0xf0, 0xcb, 0x59, 0x68, 0x2c, 0x89, 0x4d, 0x5c, 0x0d, 0xf9, 0xbc, 0x6a,
-		0xe2, 0xa3, 0xa3, 0x7a, 0xf3, 0xd5, 0x78, 0x31, 0x11, 0x9b, 0xc7, 0xad, 0xba, 0xa4, 0x4a, 0xfc,
-		0x32, 0x7a, 0x0a, 0x7b, 0xb3, 0xb2, 0x08, 0xad, 0x56, 0xfd, 0x84, 0xb4, 0x04, 0x2c, 0x35, 0x54,
-		0x22, 0xd6, 0x9b, 0x8a, 0x44, 0x5a, 0xcd, 0xba, 0x2c, 0x9e, 0xf0, 0x2b, 0xbb, 0x7f, 0xcc, 0xc2,
-		0xdd, 0x39, 0x8e, 0x8d, 0xbe, 0x80, 0x47, 0x09, 0x69, 0x5f, 0x08, 0x72, 0x5d, 0xaa, 0x12, 0x51,
-		0x68, 0x2b, 0x51, 0x61, 0x93, 0x39, 0x4c, 0x80, 0xab, 0xcd, 0x63, 0x41, 0x6e, 0x90, 0x46, 0x53,
-		0x25, 0x82, 0xa8, 0xca, 0x2f, 0x25, 0x9e, 0xbb, 0xe4, 0x32, 0xe9, 0xb5, 0xac, 0xa8, 0x0a, 0x9f,
-		0x41, 0x3f, 0x83, 0x9f, 0x2c, 0x5a, 0xe6, 0x4b, 0xf6, 0xc2, 0x97, 0x4c, 0xa8, 0x63, 0x49, 0xa8,
-		0x9e, 0x10, 0xdc, 0x6e, 0x34, 0xe4, 0xc6, 0x21, 0x9f, 0x45, 0xcf, 0xe0, 0x20, 0xf5, 0xea, 0xc8,
-		0x6b, 0x97, 0xd1, 0xcf, 0xe1, 0xa7, 0x97, 0x7e, 0xed, 0xb0, 0x4f, 0x55, 0x7e, 0x65, 0xc6, 0xee,
-		0x9b, 0x58, 0xdf, 0x6e, 0x88, 0x82, 0x2a, 0x1d, 0x36, 0xb1, 0xfc, 0x8d, 0x54, 0xe5, 0x57, 0x77,
-		0x3f, 0x70, 0x80, 0x0e, 0xa9, 0x17, 0xef, 0xcd, 0x03, 0xd8, 0x39, 0x94, 0xd4, 0xb9, 0x1d, 0x79,
-		0x08, 0xc5, 0x64, 0x88, 0x22, 0xe1, 0x97, 0xb2, 0x28, 0x91, 0xe7, 0x6d, 0xe5, 0x84, 0xe7, 0x66,
-		0xa7, 0xf2, 0x4f, 0x6a, 0xb3, 0xad, 0xf2, 0x19, 0x54, 0x86, 0xdd, 0x19, 0xa9, 0x6a, 0x02, 0xae,
-		0x92, 0xe6, 0xab, 0x86, 0x84, 0x95, 0x9a, 0xdc, 0x22, 0xf5, 0xa6, 0xa2, 0xf2, 0x59, 0xf4, 0x08,
-		0x3e, 0x4f, 0xc6, 0x4f, 0x56, 0xb7, 0xfc, 0xfc, 0xd9, 0x37, 0x4f, 0xcf, 0x0d, 0xef, 0x4d, 0xff,
-		0xb4, 0xdc, 0xb1, 0xbb, 0x95, 0xe8, 0x8f, 0x1d, 0x4f, 0x0c, 0xdd, 0xac, 0x9c, 0xdb, 0xc1, 0xef,
-		0x2b, 0xa3, 0x5f, 0x66, 0xbe, 0x62, 0x7f, 0x5c, 0xec, 0x9d, 0xae, 0xb2, 0xf8, 0xc1, 0x7f, 0x03,
-		0x00, 0x00, 0xff, 0xff, 0xeb, 0x31, 0x53, 0x3b, 0xc1, 0x19, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6e, 0xdb, 0xd8,
+		0xf5, 0x36, 0xf5, 0xc7, 0x96, 0x8e, 0x3d, 0x36, 0x7d, 0x33, 0x49, 0x14, 0x27, 0x46, 0x64, 0x0d,
+		0x7e, 0x89, 0xe0, 0x49, 0xe4, 0x9f, 0xed, 0x09, 0x52, 0x34, 0x83, 0xb6, 0x34, 0x45, 0x5b, 0x1c,
+		0xcb, 0x92, 0xe6, 0x92, 0x4a, 0xe2, 0x41, 0x01, 0x82, 0x16, 0xaf, 0x1d, 0x22, 0x12, 0xa9, 0x21,
+		0x29, 0xa7, 0x7a, 0x80, 0xa6, 0xfb, 0x99, 0x6e, 0xbb, 0xe9, 0xb6, 0x40, 0x57, 0xed, 0xa2, 0xe8,
+		0xb6, 0x40, 0x57, 0xdd, 0x4e, 0x17, 0xf3, 0x12, 0x7d, 0x84, 0x82, 0x97, 0xa4, 0x44, 0x51, 0x94,
+		0x45, 0x27, 0x2e, 0xd0, 0x45, 0x77, 0xe2, 0xe1, 0x39, 0x87, 0xdf, 0xf9, 0xce, 0xbd, 0x1f, 0xcf,
+		0xa5, 0x60, 0x6b, 0x70, 0x46, 0xac, 0x9d, 0x8e, 0xaa, 0x11, 0xa3, 0x43, 0x76, 0x54, 0xad, 0xa7,
+		0x1b, 0x3b, 0x97, 0xbb, 0x3b, 0xdf, 0x0e, 0xc8, 0x80, 0x54, 0xfa, 0x96, 0xe9, 0x98, 0xe8, 0xb6,
+		0xeb, 0x52, 0xf1, 0x5d, 0x2a, 0xd4, 0xa5, 0x72, 0xb9, 0xbb, 0xf1, 0xf0, 0xc2, 0x34, 0x2f, 0xba,
+		0x64, 0x87, 0x3a, 0x9d, 0x0d, 0xce, 0x77, 0x1c, 0xbd, 0x47, 0x6c, 0x47, 0xed, 0xf5, 0xbd, 0xb8,
+		0x8d, 0xe2, 0x64, 0xea, 0xbe, 0xee, 0x26, 0xee, 0x98, 0xbd, 0x9e, 0x69, 0xf8, 0x1e, 0x5b, 0x71,
+		0x1e, 0x6f, 0x74, 0xdb, 0x31, 0xad, 0xa1, 0xef, 0x52, 0x8a, 0x73, 0x79, 0x67, 0x5a, 0x6f, 0xcf,
+		0xbb, 0xe6, 0x3b, 0xcf, 0xa7, 0xf4, 0x63, 0x0a, 0x3e, 0xe5, 0x2d, 0xd3, 0xb6, 0xf9, 0xee, 0xc0,
+		0x76, 0x88, 0x25, 0xab, 0xf6, 0x5b, 0xd1, 0x38, 0x37, 0xd1, 0x7d, 0xc8, 0x6b, 0x66, 0x4f, 0xd5,
+		0x0d, 0x45, 0xd7, 0x0a, 0x4c, 0x91, 0x29, 0xe7, 0x71, 0xce, 0x33, 0x88, 0x1a, 0x6a, 0x03, 0x0a,
+		0xf2, 0x28, 0xe4, 0x57, 0xa4, 0x33, 0x70, 0x74, 0xd3, 0x28, 0xa4, 0x8a, 0x4c, 0x79, 0x79, 0xef,
+		0x51, 0x65, 0xb2, 0xe6, 0xbe, 0x5e, 0xb9, 0xdc, 0xad, 0xbc, 0xf2, 0xdd, 0x85, 0xc0, 0x1b, 0xaf,
+		0xbf, 0x8b, 0x9a, 0x50, 0x0d, 0xf2, 0x8e, 0x6a, 0xbf, 0x55, 0x9c, 0x61, 0x9f, 0x14, 0xd2, 0x45,
+		0xa6, 0xbc, 0xba, 0xf7, 0x79, 0x25, 0x96, 0xc1, 0x4a, 0x14, 0xb3, 0x3c, 0xec, 0x13, 0x9c, 0x73,
+		0xfc, 0x5f, 0x68, 0x13, 0x80, 0x66, 0xb2, 0x1d, 0xd5, 0x21, 0x85, 0x4c, 0x91, 0x29, 0x67, 0x31,
+		0xcd, 0x2d, 0xb9, 0x06, 0x74, 0x17, 0x96, 0xe8, 0x6d, 0x5d, 0x2b, 0x64, 0x8b, 0x4c, 0x39, 0x8d,
+		0x17, 0xdd, 0x4b, 0x51, 0x43, 0x27, 0xf0, 0xe9, 0xa5, 0x6e, 0xeb, 0x67, 0x7a, 0x57, 0x77, 0x86,
+		0xca, 0xa8, 0x2b, 0x85, 0x45, 0x5a, 0xda, 0x46, 0xc5, 0xeb, 0x5b, 0x25, 0xe8, 0x5b, 0x45, 0x0e,
+		0x3c, 0xf0, 0xad, 0x71, 0xdc, 0xc8, 0x58, 0xfa, 0x21, 0x05, 0xff, 0x1f, 0x46, 0x2a, 0x39, 0xaa,
+		0xe5, 0xf0, 0x6f, 0xf4, 0xae, 0x36, 0xe6, 0x81, 0x7c, 0x3b, 0x20, 0xb6, 0xc3, 0x39, 0x8e, 0xa5,
+		0x9f, 0x0d, 0x1c, 0x62, 0xa3, 0x32, 0xb0, 0x8e, 0x6a, 0x5d, 0x10, 0x47, 0x89, 0x36, 0x60, 0xd5,
+		0xb3, 0x57, 0x83, 0x36, 0x6c, 0x02, 0x58, 0x5e, 0xb8, 0xeb, 0x93, 0xa2, 0x3e, 0x79, 0xdf, 0x22,
+		0x6a, 0xe8, 0x09, 0x20, 0xdd, 0xd0, 0x1d, 0x5d, 0x75, 0x88, 0xa6, 0x90, 0x4b, 0x62, 0x50, 0xb7,
+		0x34, 0x2d, 0x98, 0x1d, 0xdd, 0x11, 0xdc, 0x1b, 0xa2, 0x86, 0xde, 0x33, 0xb0, 0x11, 0x75, 0x57,
+		0x47, 0xa8, 0x28, 0x87, 0xcb, 0x7b, 0xb5, 0xd8, 0xe6, 0x8e, 0xcb, 0x9a, 0x6a, 0xb3, 0x38, 0xf1,
+		0x98, 0x71, 0x95, 0xb8, 0xa0, 0xcf, 0xb8, 0x83, 0x4a, 0xf0, 0x89, 0x5f, 0xbf, 0x35, 0x30, 0x82,
+		0x16, 0xe5, 0xf1, 0xb2, 0x67, 0xc4, 0x03, 0x43, 0xd4, 0x4a, 0x5f, 0xc1, 0xee, 0x5c, 0x5e, 0xed,
+		0xbe, 0x69, 0xd8, 0x24, 0x94, 0xf8, 0x36, 0x2c, 0x5a, 0x83, 0x10, 0x9d, 0x59, 0x8b, 0xe6, 0xfa,
+		0x4b, 0x0a, 0x9e, 0x84, 0x93, 0xf1, 0xaa, 0xd1, 0x21, 0xdd, 0x1b, 0x69, 0xd0, 0x19, 0xdc, 0xf3,
+		0x3d, 0x3f, 0x7a, 0xbb, 0xdc, 0xf5, 0x12, 0x4d, 0xdd, 0x88, 0x2c, 0x82, 0x74, 0xb2, 0x45, 0x90,
+		0x99, 0xb1, 0x08, 0x2a, 0x70, 0xab, 0xe3, 0xd2, 0x38, 0xc6, 0x6b, 0x1a, 0xdd, 0x21, 0xed, 0x40,
+		0x0e, 0xaf, 0x77, 0xc2, 0x2d, 0x6e, 0x1a, 0xdd, 0x61, 0x69, 0x07, 0x9e, 0x5e, 0x49, 0x5d, 0xb4,
+		0x07, 0xa5, 0x3f, 0xa7, 0x27, 0xc9, 0x96, 0xf4, 0x0b, 0x43, 0xfd, 0x1f, 0xd9, 0x49, 0xc8, 0x46,
+		0x0f, 0x61, 0xd9, 0xa6, 0x74, 0x29, 0x86, 0xda, 0x23, 0x54, 0x93, 0xf2, 0x18, 0x3c, 0x53, 0x43,
+		0xed, 0x11, 0xf4, 0x73, 0x58, 0xf1, 0x1d, 0x74, 0xa3, 0x3f, 0x70, 0x0a, 0x4b, 0xb4, 0xe8, 0x07,
+		0xb1, 0x45, 0xb7, 0xd4, 0x61, 0xd7, 0x54, 0x35, 0xec, 0xa7, 0x14, 0xdd, 0x00, 0x54, 0x80, 0xa5,
+		0x8e, 0x69, 0x38, 0x96, 0xd9, 0x2d, 0xe4, 0x8a, 0x4c, 0x79, 0x05, 0x07, 0x97, 0xd1, 0x46, 0x4f,
+		0xb5, 0x6d, 0xaa, 0xd1, 0xff, 0x48, 0x01, 0x17, 0x8e, 0xc0, 0xa4, 0x63, 0x5a, 0x5a, 0xbc, 0x48,
+		0xf0, 0x66, 0xaf, 0xdf, 0x25, 0x0e, 0xf9, 0x6f, 0xef, 0xfe, 0xf5, 0x04, 0xb5, 0x0e, 0x6c, 0xc7,
+		0x2b, 0x4c, 0x37, 0x0d, 0xcf, 0xdd, 0x57, 0xd1, 0xad, 0x58, 0x20, 0x35, 0xef, 0xe5, 0x4d, 0xc3,
+		0xf1, 0xda, 0x38, 0x94, 0x1a, 0x4a, 0x55, 0x38, 0xb8, 0x3e, 0x9d, 0x53, 0x5d, 0xf9, 0x17, 0x03,
+		0x45, 0xae, 0xdf, 0xef, 0x0e, 0x5b, 0xaa, 0x45, 0x0c, 0x87, 0xef, 0x9a, 0x36, 0x69, 0x99, 0x5d,
+		0xbd, 0x33, 0x0c, 0x91, 0xfe, 0x08, 0xd6, 0xbc, 0x75, 0x19, 0xe5, 0xfc, 0x13, 0x6a, 0x1e, 0x51,
+		0xbe, 0x0d, 0xeb, 0x91, 0xf5, 0x3b, 0x7a, 0x0b, 0xad, 0x4d, 0xac, 0x5e, 0x51, 0x43, 0x45, 0x58,
+		0xf1, 0x7c, 0x7d, 0x05, 0xf6, 0xb6, 0x0e, 0x50, 0x1b, 0x95, 0x74, 0xf4, 0x12, 0x6e, 0xf5, 0x29,
+		0x28, 0xa5, 0xe3, 0xa2, 0x52, 0xfa, 0x14, 0x16, 0x65, 0x6c, 0x75, 0x46, 0xeb, 0xa6, 0x8a, 0xc0,
+		0xeb, 0xfd, 0xa8, 0xa9, 0xf4, 0x3d, 0x03, 0x0f, 0xe2, 0x4b, 0x76, 0x67, 0x81, 0x81, 0x8d, 0x1e,
+		0x40, 0xde, 0x27, 0x9b, 0x78, 0x85, 0xe6, 0xf0, 0xd8, 0x80, 0xda, 0xb0, 0x72, 0xae, 0xea, 0x5d,
+		0xa2, 0x29, 0x1d, 0x75, 0x60, 0x13, 0x5a, 0xdf, 0xea, 0xde, 0x5e, 0xc2, 0xb1, 0xe4, 0x90, 0x86,
+		0xf2, 0x6e, 0x24, 0x5e, 0x3e, 0x1f, 0x5f, 0x94, 0xfe, 0xca, 0xc0, 0x66, 0x3c, 0x2a, 0x7f, 0x13,
+		0xa0, 0x13, 0xc8, 0x52, 0x76, 0x28, 0xa4, 0xe5, 0xbd, 0xe7, 0x33, 0x9e, 0x38, 0xaf, 0x9b, 0xd8,
+		0xcb, 0x82, 0x8e, 0x61, 0xd1, 0xa6, 0xf5, 0xfa, 0x9b, 0x61, 0xff, 0x5a, 0xf9, 0x3c, 0xaa, 0xb0,
+		0x9f, 0xa2, 0xf4, 0x1b, 0x06, 0xf6, 0xc3, 0xa5, 0x5e, 0x59, 0x49, 0x68, 0x65, 0xb5, 0x20, 0x47,
+		0xd1, 0x58, 0xc4, 0x28, 0x30, 0xc5, 0x74, 0x79, 0x79, 0xef, 0x8b, 0x6b, 0xc1, 0xf0, 0x33, 0xe2,
+		0x51, 0x96, 0xd2, 0xdf, 0x66, 0x76, 0x17, 0x13, 0x7b, 0xd0, 0xbd, 0x71, 0x1a, 0xff, 0x43, 0xcb,
+		0xe1, 0xb7, 0x0c, 0x7c, 0x91, 0x84, 0xd0, 0xa9, 0x99, 0xe6, 0x97, 0xfe, 0x5e, 0xb5, 0x88, 0xa1,
+		0xf8, 0xfd, 0xf5, 0x88, 0xdd, 0xbf, 0x26, 0xb1, 0x2e, 0x59, 0x78, 0x35, 0xc8, 0xe5, 0xf5, 0xbb,
+		0xf4, 0xbb, 0x25, 0xb8, 0x1b, 0xad, 0x21, 0x58, 0x9f, 0xc1, 0xb0, 0xae, 0x1b, 0xe7, 0xa6, 0x4f,
+		0x6e, 0xd2, 0x61, 0xdd, 0x3d, 0x60, 0x78, 0xc3, 0xba, 0xfb, 0x0b, 0x7d, 0xc7, 0x40, 0xd1, 0x76,
+		0x27, 0x38, 0xc5, 0x93, 0x88, 0x91, 0x6e, 0x87, 0xe7, 0x4f, 0x6f, 0xd5, 0x1e, 0x25, 0x78, 0x42,
+		0x92, 0x21, 0xbb, 0xb6, 0x80, 0x37, 0xed, 0x69, 0xbf, 0x10, 0xb1, 0xbf, 0x66, 0xe0, 0x7e, 0x87,
+		0x8e, 0x33, 0xf1, 0x78, 0xd2, 0x14, 0x0f, 0x9f, 0x00, 0xcf, 0xbc, 0x79, 0xb2, 0xb6, 0x80, 0xef,
+		0x75, 0x26, 0x7d, 0x22, 0x38, 0xfc, 0x97, 0x7a, 0x2c, 0x8e, 0x4c, 0x62, 0x1c, 0xf3, 0x46, 0x2d,
+		0x17, 0x87, 0x3d, 0xe9, 0x13, 0xc2, 0xf1, 0x03, 0x03, 0x2f, 0x2c, 0xfa, 0xd2, 0x51, 0x22, 0xa2,
+		0x3f, 0x86, 0x15, 0xa8, 0xa6, 0x12, 0xcc, 0x48, 0x21, 0x9c, 0x59, 0x8a, 0xf3, 0x75, 0x02, 0x9c,
+		0x1f, 0x34, 0x29, 0xd4, 0x16, 0xf0, 0x33, 0xeb, 0x43, 0x02, 0xd1, 0x1f, 0x18, 0x78, 0xa2, 0xba,
+		0x9b, 0x42, 0x89, 0x79, 0xfd, 0xc4, 0x55, 0xe2, 0x9d, 0x05, 0xbf, 0x4a, 0x50, 0x49, 0x42, 0x59,
+		0xac, 0x2d, 0xe0, 0xc7, 0x6a, 0x32, 0xd7, 0x83, 0x15, 0x80, 0x31, 0x94, 0xd2, 0x9f, 0x72, 0x50,
+		0x98, 0xde, 0x9f, 0x9e, 0x48, 0x84, 0x0f, 0xb9, 0xcc, 0xc4, 0x21, 0x77, 0xe2, 0x98, 0x9d, 0xba,
+		0xb9, 0x63, 0x76, 0x3a, 0x7a, 0xcc, 0x8e, 0x8a, 0x65, 0xe6, 0x46, 0xc4, 0x12, 0x7d, 0x9f, 0x44,
+		0x2f, 0xb2, 0xb1, 0xe7, 0xd5, 0xc4, 0x7a, 0x11, 0x15, 0xda, 0xf9, 0x82, 0xf1, 0x7e, 0x8e, 0x60,
+		0x78, 0xcb, 0xa6, 0xfa, 0x21, 0x82, 0x11, 0x03, 0xe6, 0x0a, 0xc5, 0x78, 0x3f, 0x47, 0x31, 0x96,
+		0x12, 0x03, 0x99, 0x3b, 0xe5, 0x5f, 0x2d, 0x19, 0xff, 0xfc, 0x48, 0xc9, 0xc8, 0x51, 0xa0, 0xa7,
+		0x37, 0x26, 0x19, 0x31, 0xe8, 0x3f, 0x50, 0x33, 0xfe, 0xc8, 0xc0, 0xd3, 0xab, 0x34, 0xc3, 0x7b,
+		0x52, 0xb8, 0x96, 0x3c, 0xad, 0xe5, 0xf8, 0x23, 0x44, 0x23, 0x06, 0x7d, 0x59, 0x4d, 0xe8, 0x1b,
+		0x91, 0x0d, 0x33, 0x4e, 0x35, 0x68, 0x8d, 0x36, 0x92, 0xdc, 0xaf, 0x2f, 0xf6, 0xdb, 0xa0, 0x1f,
+		0xc1, 0x38, 0x51, 0x49, 0xb8, 0x69, 0x83, 0x09, 0x6d, 0xc5, 0x19, 0x5f, 0xd8, 0xa5, 0xef, 0xd2,
+		0x90, 0xff, 0x7a, 0x40, 0x06, 0xc4, 0x75, 0x41, 0x5f, 0x86, 0xf5, 0x87, 0xa1, 0x9a, 0xf0, 0x70,
+		0x46, 0xfa, 0x18, 0xcd, 0x41, 0x90, 0x19, 0x09, 0x57, 0x16, 0xd3, 0xdf, 0x61, 0xa9, 0x4b, 0x4f,
+		0x48, 0x1d, 0x0f, 0x6b, 0x91, 0xef, 0x79, 0x85, 0xcc, 0xdc, 0x4f, 0x79, 0xab, 0x93, 0x9f, 0xf2,
+		0xd0, 0x16, 0xac, 0x74, 0xbc, 0x0a, 0xbd, 0x83, 0xb7, 0xff, 0x3d, 0xca, 0xb7, 0xd1, 0x93, 0xf7,
+		0x0b, 0xc8, 0x7a, 0x1a, 0xb8, 0x48, 0xcb, 0xf9, 0xbf, 0x19, 0xe5, 0x8c, 0x38, 0xa0, 0xfa, 0x88,
+		0xbd, 0x18, 0x74, 0x07, 0x16, 0xdd, 0xa3, 0x38, 0xd1, 0xe8, 0xd6, 0xcc, 0x61, 0xff, 0xca, 0x3d,
+		0x8d, 0xab, 0x8e, 0x43, 0x7a, 0x7d, 0x87, 0x6e, 0x85, 0x2c, 0x0e, 0x2e, 0xdd, 0xb2, 0x2c, 0xa2,
+		0xe9, 0x76, 0x5f, 0x75, 0x3a, 0x6f, 0xbc, 0xb2, 0xf2, 0xf3, 0xcb, 0x1a, 0x87, 0xb8, 0xc6, 0xed,
+		0xf7, 0x0c, 0xe4, 0x02, 0x7e, 0xd1, 0x6d, 0x58, 0x97, 0x39, 0xe9, 0x58, 0x91, 0x4f, 0x5b, 0x82,
+		0x22, 0x36, 0x5e, 0x72, 0x75, 0xb1, 0xca, 0x2e, 0xa0, 0x3b, 0x80, 0xc6, 0x66, 0x19, 0x73, 0x0d,
+		0xe9, 0x50, 0xc0, 0x2c, 0x83, 0x6e, 0xc1, 0x5a, 0xc8, 0x2e, 0x9e, 0x08, 0x98, 0x4d, 0xa1, 0x7b,
+		0x70, 0x7b, 0x6c, 0xc4, 0x42, 0xab, 0x2e, 0xf2, 0x9c, 0x2c, 0x36, 0x1b, 0x6c, 0x1a, 0xdd, 0x87,
+		0xbb, 0xe3, 0x5b, 0x3c, 0x6e, 0x4a, 0x92, 0xc2, 0xd7, 0xdb, 0x92, 0x2c, 0x60, 0x36, 0xe3, 0x02,
+		0x59, 0x9d, 0x64, 0x06, 0x3d, 0x80, 0xc2, 0xd7, 0x6d, 0xa1, 0x2d, 0x28, 0x34, 0x4a, 0x92, 0x39,
+		0x39, 0x8c, 0x2a, 0xee, 0x6e, 0x4b, 0x68, 0x54, 0xc5, 0xc6, 0x11, 0xcb, 0xa0, 0x0d, 0xb8, 0x33,
+		0x75, 0x97, 0xe3, 0x8f, 0x85, 0x2a, 0x9b, 0x8a, 0x8d, 0x3c, 0xe0, 0xf8, 0xe3, 0xe6, 0xe1, 0x21,
+		0x9b, 0xde, 0xfe, 0x7b, 0xcc, 0xc7, 0x70, 0xca, 0xce, 0x67, 0xf0, 0x70, 0x02, 0xb4, 0x12, 0xc7,
+		0xd5, 0x2e, 0x3c, 0x9d, 0xe5, 0x24, 0xc9, 0x1c, 0x96, 0x15, 0xbe, 0x26, 0xd6, 0xab, 0x8a, 0xf0,
+		0x5a, 0xe0, 0xdb, 0x94, 0x16, 0x06, 0x3d, 0x81, 0xf2, 0xac, 0x10, 0x9e, 0x6b, 0xf0, 0x42, 0x3d,
+		0xe4, 0x9d, 0xba, 0xca, 0x5b, 0x12, 0x8f, 0x1a, 0x5c, 0xd8, 0x3b, 0x8d, 0xaa, 0xf0, 0x8b, 0x59,
+		0xde, 0x58, 0xe0, 0x9b, 0xb8, 0xea, 0xe3, 0x79, 0xd5, 0xc4, 0xc7, 0xc7, 0xf5, 0xe6, 0xab, 0x71,
+		0xb0, 0xc2, 0x37, 0x4f, 0x5a, 0x75, 0x41, 0x16, 0xd8, 0x0c, 0x7a, 0x06, 0xbb, 0xb3, 0xb2, 0x70,
+		0xad, 0x56, 0xfd, 0x54, 0x69, 0x71, 0x58, 0x68, 0xc8, 0x0a, 0x5f, 0x6f, 0x4a, 0x82, 0xd2, 0x6a,
+		0xd6, 0x45, 0xfe, 0x94, 0xcd, 0x6e, 0xff, 0x3e, 0x0d, 0xf7, 0xaf, 0x78, 0x9f, 0xa3, 0xcf, 0xe1,
+		0x71, 0x4c, 0xda, 0x43, 0x4e, 0xac, 0x0b, 0x55, 0x85, 0xe7, 0xda, 0x52, 0x98, 0xd8, 0x78, 0x0c,
+		0x13, 0xce, 0xd5, 0xe6, 0x09, 0x27, 0x36, 0x94, 0x46, 0x53, 0x56, 0x38, 0x5e, 0x16, 0x5f, 0x0a,
+		0x2c, 0x73, 0xcd, 0x30, 0xe1, 0xb5, 0x28, 0xc9, 0x12, 0x9b, 0x42, 0x5f, 0xc2, 0x4f, 0xe6, 0x85,
+		0xb9, 0x94, 0x1d, 0xba, 0x94, 0x71, 0x75, 0x2c, 0x70, 0xd5, 0x53, 0x05, 0xb7, 0x1b, 0x0d, 0x77,
+		0xf1, 0xa5, 0xd1, 0x73, 0xd8, 0x4f, 0x1c, 0x1d, 0x7a, 0x6c, 0x06, 0xfd, 0x0c, 0x7e, 0x7a, 0xed,
+		0xc7, 0x06, 0x7d, 0xaa, 0xb2, 0xd9, 0x19, 0xab, 0x6f, 0x22, 0xbe, 0xdd, 0xe0, 0x39, 0x59, 0x38,
+		0x6a, 0x62, 0xf1, 0x1b, 0xa1, 0xca, 0x2e, 0x6e, 0xff, 0xc8, 0x00, 0x3a, 0x22, 0x4e, 0xb4, 0x37,
+		0x5b, 0xb0, 0x79, 0x24, 0xc8, 0x57, 0x76, 0xe4, 0x11, 0x94, 0xe2, 0x5d, 0x24, 0x01, 0xbf, 0x14,
+		0x79, 0x41, 0x39, 0x68, 0x4b, 0xa7, 0x2c, 0x33, 0x3b, 0x95, 0x2b, 0x19, 0xcd, 0xb6, 0xcc, 0xa6,
+		0x50, 0x05, 0xb6, 0x67, 0xa4, 0xaa, 0x71, 0xb8, 0xaa, 0x34, 0x5f, 0x35, 0x04, 0x2c, 0xd5, 0xc4,
+		0x96, 0x52, 0x6f, 0x4a, 0x32, 0x9b, 0x46, 0x8f, 0xe1, 0xb3, 0x78, 0xff, 0xc9, 0xea, 0x32, 0x07,
+		0xcf, 0xbf, 0x79, 0x76, 0xa1, 0x3b, 0x6f, 0x06, 0x67, 0x95, 0x8e, 0xd9, 0xdb, 0x09, 0xff, 0x15,
+		0xf6, 0x54, 0xd7, 0xba, 0x3b, 0x17, 0xa6, 0xf7, 0xef, 0xdb, 0xe8, 0x7f, 0xbb, 0x17, 0xf4, 0xc7,
+		0xe5, 0xee, 0xd9, 0x22, 0xb5, 0xef, 0xff, 0x7b, 0x00, 0x4f, 0xbd, 0x23, 0xbd, 0xdf, 0x1b, 0x00,
+		0x00,
 	},
 	// uber/cadence/admin/v1/replication.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x73, 0x1a, 0xc7,
-		0x16, 0xf6, 0x80, 0x78, 0xe8, 0x08, 0x01, 0x6a, 0xc9, 0x16, 0x96, 0xac, 0xba, 0x18, 0xbf, 0x64,
-		0xb9, 0x2e, 0x5c, 0xc9, 0xd7, 0xf7, 0x59, 0x29, 0xd7, 0x58, 0xa0, 0x68, 0x62, 0x3d, 0x1b, 0x2c,
-		0x47, 0xa9, 0x4a, 0x4d, 0x8d, 0x66, 0x5a, 0x62, 0x4a, 0x30, 0x43, 0x4d, 0x37, 0xc8, 0xec, 0x92,
-		0x7d, 0xaa, 0xb2, 0xc9, 0x2e, 0xcb, 0xfc, 0x8d, 0xac, 0xb3, 0x4d, 0x7e, 0x52, 0x6a, 0xba, 0x7b,
-		0x80, 0x81, 0x01, 0xcb, 0xe5, 0x45, 0x76, 0xf4, 0x39, 0xdf, 0x79, 0xf4, 0xe9, 0xd3, 0xdf, 0x69,
-		0x06, 0x9e, 0x75, 0x2f, 0x88, 0x57, 0x31, 0x0d, 0x8b, 0x38, 0x26, 0xa9, 0x18, 0x56, 0xdb, 0x76,
-		0x2a, 0xbd, 0xed, 0x8a, 0x47, 0x3a, 0x2d, 0xdb, 0x34, 0x98, 0xed, 0x3a, 0xe5, 0x8e, 0xe7, 0x32,
-		0x17, 0xdd, 0xf5, 0x81, 0x65, 0x09, 0x2c, 0x73, 0x60, 0xb9, 0xb7, 0xbd, 0xf6, 0xb7, 0x2b, 0xd7,
-		0xbd, 0x6a, 0x91, 0x0a, 0x07, 0x5d, 0x74, 0x2f, 0x2b, 0xcc, 0x6e, 0x13, 0xca, 0x8c, 0x76, 0x47,
-		0xd8, 0xad, 0x15, 0xc3, 0x01, 0x3a, 0xb6, 0xef, 0xde, 0x74, 0xdb, 0xed, 0xc0, 0x73, 0x34, 0xc2,
-		0x72, 0xdb, 0x86, 0x1d, 0x20, 0x1e, 0x45, 0x27, 0xd9, 0xb4, 0x29, 0x73, 0xbd, 0xbe, 0x00, 0x95,
-		0x7e, 0x8a, 0xc1, 0x32, 0x1e, 0xa6, 0x7d, 0x48, 0x28, 0x35, 0xae, 0x08, 0x45, 0x75, 0x58, 0x1a,
-		0xd9, 0x8d, 0xce, 0x0c, 0x7a, 0x4d, 0x0b, 0x4a, 0x31, 0xbe, 0xb9, 0xb0, 0xf3, 0xb4, 0x1c, 0xb9,
-		0xa9, 0xf2, 0x88, 0x9b, 0x86, 0x41, 0xaf, 0x71, 0xde, 0x0b, 0x0b, 0x28, 0xfa, 0x2f, 0xdc, 0x6f,
-		0x19, 0x94, 0xe9, 0x1e, 0x61, 0x9e, 0x4d, 0x7a, 0xc4, 0xd2, 0xdb, 0x22, 0x9e, 0x6e, 0x5b, 0x85,
-		0x58, 0x51, 0xd9, 0x8c, 0xe3, 0x7b, 0x3e, 0x00, 0x07, 0x7a, 0x99, 0x8e, 0x66, 0xa1, 0xfb, 0x90,
-		0x6e, 0x1a, 0x54, 0x6f, 0xbb, 0x1e, 0x29, 0xc4, 0x8b, 0xca, 0x66, 0x1a, 0xa7, 0x9a, 0x06, 0x3d,
-		0x74, 0x3d, 0x82, 0x30, 0x2c, 0xd1, 0xbe, 0x63, 0xea, 0xb4, 0x69, 0x78, 0x96, 0x4e, 0x99, 0xc1,
-		0xba, 0xb4, 0x30, 0x57, 0x54, 0x66, 0xa4, 0x5a, 0xef, 0x3b, 0x66, 0xdd, 0x87, 0xd7, 0x39, 0x1a,
-		0xe7, 0x68, 0x58, 0x50, 0xfa, 0x31, 0x09, 0xb9, 0xb1, 0xfd, 0xa0, 0x2f, 0x61, 0xde, 0x2f, 0x83,
-		0xce, 0xfa, 0x1d, 0x52, 0x50, 0x8a, 0xca, 0x66, 0x76, 0x67, 0xeb, 0x76, 0xa5, 0x68, 0xf4, 0x3b,
-		0x04, 0xa7, 0x99, 0xfc, 0x85, 0x1e, 0x43, 0x96, 0xba, 0x5d, 0xcf, 0x24, 0xbc, 0xac, 0xc3, 0xbd,
-		0x67, 0x84, 0xd4, 0xb7, 0xd0, 0x2c, 0xf4, 0x1a, 0x16, 0x4d, 0x8f, 0xc8, 0xf2, 0xdb, 0x6d, 0xb1,
-		0xed, 0x85, 0x9d, 0xb5, 0xb2, 0xe8, 0x9d, 0x72, 0xd0, 0x3b, 0xe5, 0x46, 0xd0, 0x3b, 0x38, 0x13,
-		0x18, 0xf8, 0x22, 0x64, 0xc2, 0x3d, 0xd1, 0x0f, 0x22, 0x8c, 0xc1, 0x98, 0x67, 0x5f, 0x74, 0x19,
-		0x09, 0x8a, 0xf3, 0x62, 0x4a, 0xf2, 0x55, 0x6e, 0xe4, 0x67, 0xa1, 0x0e, 0x4c, 0xf6, 0xef, 0xe0,
-		0x15, 0x2b, 0x42, 0x8e, 0xbe, 0x53, 0xe0, 0xe1, 0x44, 0xf5, 0x27, 0x02, 0x26, 0x78, 0xc0, 0x7f,
-		0xde, 0xee, 0x34, 0x26, 0x22, 0x6f, 0xd0, 0x59, 0x00, 0xd4, 0x03, 0x0e, 0xd0, 0x0d, 0x93, 0xd9,
-		0x3d, 0x9b, 0xf5, 0x27, 0xa2, 0x27, 0x79, 0xf4, 0xed, 0x19, 0xd1, 0x55, 0x69, 0x3a, 0x11, 0x7a,
-		0x8d, 0x4e, 0xd5, 0xa2, 0x36, 0xac, 0xc9, 0xbb, 0x24, 0x22, 0xf6, 0x76, 0x46, 0x83, 0xa6, 0x78,
-		0xd0, 0xf2, 0x94, 0xa0, 0xfb, 0xc2, 0xd0, 0xf7, 0x78, 0xb6, 0x13, 0x8a, 0xb8, 0xda, 0x8c, 0x56,
-		0x21, 0x17, 0xd6, 0x2e, 0x0d, 0xbb, 0xe5, 0xf6, 0x88, 0xa7, 0xb7, 0x0d, 0xef, 0x9a, 0x78, 0xa3,
-		0xe1, 0xd2, 0x3c, 0x5c, 0x65, 0x4a, 0xb8, 0x3d, 0x69, 0x78, 0xc8, 0xed, 0x42, 0xf1, 0x0a, 0x97,
-		0x53, 0x74, 0x6f, 0x32, 0x00, 0xc3, 0x00, 0xa5, 0x5f, 0x63, 0xb0, 0x12, 0xd5, 0x19, 0xe8, 0x14,
-		0xf2, 0xb2, 0xcd, 0xdc, 0x0e, 0xf1, 0x78, 0xfb, 0xc9, 0xdb, 0xf1, 0x74, 0x66, 0x83, 0x1d, 0x07,
-		0x68, 0x9c, 0xb3, 0xc2, 0x02, 0x94, 0x85, 0x98, 0xbc, 0x14, 0xf3, 0x38, 0x66, 0x5b, 0xe8, 0x25,
-		0x24, 0x05, 0x44, 0xde, 0x81, 0xf5, 0x31, 0xc7, 0x1d, 0x7b, 0xe8, 0x16, 0x4b, 0x28, 0x7a, 0x02,
-		0x59, 0xd3, 0x75, 0x2e, 0xed, 0x2b, 0xbd, 0x47, 0x3c, 0xea, 0x67, 0x35, 0xc7, 0x6f, 0xd9, 0xa2,
-		0x90, 0x9e, 0x09, 0x21, 0x7a, 0x0e, 0xf9, 0x41, 0x59, 0x03, 0x60, 0x82, 0x03, 0x73, 0x81, 0x3c,
-		0x80, 0xfe, 0x0f, 0xee, 0x77, 0x3c, 0xd2, 0xb3, 0xdd, 0x2e, 0xd5, 0x27, 0x6c, 0x92, 0xdc, 0x66,
-		0x35, 0x00, 0xec, 0x85, 0x6d, 0x4b, 0x3f, 0x2b, 0xb0, 0x31, 0xb3, 0xcf, 0xfd, 0x7c, 0x25, 0x2b,
-		0x98, 0xad, 0x2e, 0x65, 0xc4, 0xe3, 0x55, 0x9c, 0xc7, 0x8b, 0x42, 0xba, 0x2b, 0x84, 0x3e, 0x11,
-		0x8a, 0xab, 0x26, 0x2b, 0x94, 0xc0, 0x29, 0xbe, 0xd6, 0x2c, 0xf4, 0x1f, 0x98, 0x1f, 0xcc, 0x91,
-		0x5b, 0xb0, 0xc5, 0x10, 0x5c, 0xfa, 0x3d, 0x01, 0x6b, 0xd3, 0xef, 0x01, 0x5a, 0x87, 0x79, 0x79,
-		0xc4, 0xb6, 0x25, 0xb3, 0x4a, 0x0b, 0x81, 0x66, 0xa1, 0x77, 0x80, 0x6e, 0x5c, 0xef, 0xfa, 0xb2,
-		0xe5, 0xde, 0xe8, 0xe4, 0x03, 0x31, 0xbb, 0xbc, 0x03, 0x62, 0x91, 0xfc, 0x2b, 0x0e, 0xea, 0xbd,
-		0x84, 0xd7, 0x02, 0x34, 0x5e, 0xba, 0x19, 0x17, 0xa1, 0x02, 0xa4, 0x82, 0xd2, 0xc6, 0x79, 0x69,
-		0x83, 0x25, 0x7a, 0x08, 0x19, 0x6a, 0x36, 0x89, 0xd5, 0x6d, 0x11, 0x5e, 0x05, 0x71, 0xac, 0x0b,
-		0x03, 0x99, 0x66, 0x21, 0x15, 0xb2, 0x43, 0x08, 0x27, 0xcf, 0xc4, 0x47, 0xcb, 0xb1, 0x38, 0xb0,
-		0xe0, 0xec, 0xb9, 0x01, 0x40, 0x99, 0xe1, 0x31, 0x11, 0x43, 0x9c, 0xee, 0xbc, 0x94, 0x68, 0x16,
-		0xfa, 0x02, 0x32, 0x81, 0x9a, 0xfb, 0x4f, 0x7d, 0xd4, 0xff, 0x82, 0xc4, 0x73, 0xef, 0x5f, 0xc1,
-		0x32, 0x9f, 0x84, 0x4d, 0x62, 0x78, 0xec, 0x82, 0x18, 0x4c, 0x78, 0x49, 0x7f, 0xd4, 0xcb, 0x92,
-		0x6f, 0xb6, 0x1f, 0x58, 0x71, 0x5f, 0xff, 0x82, 0x94, 0x45, 0x98, 0x61, 0xb7, 0x68, 0x61, 0x9e,
-		0xdb, 0x3f, 0x88, 0xac, 0xfa, 0x89, 0xd1, 0x6f, 0xb9, 0x86, 0x85, 0x03, 0xb0, 0x5f, 0x61, 0x83,
-		0x31, 0xd2, 0xee, 0xb0, 0x02, 0x88, 0x46, 0x92, 0x4b, 0xf4, 0x1a, 0x32, 0x3c, 0x3b, 0xbf, 0xc9,
-		0xbb, 0x1e, 0x29, 0x2c, 0xcc, 0x70, 0xbb, 0x27, 0x30, 0x78, 0xc1, 0xb7, 0x90, 0x0b, 0xf4, 0x0f,
-		0x58, 0xe1, 0x0e, 0xfc, 0x63, 0x25, 0x9e, 0x6e, 0x5b, 0xc4, 0x61, 0x36, 0xeb, 0x17, 0x32, 0xbc,
-		0x77, 0x90, 0xaf, 0x7b, 0xcf, 0x55, 0x9a, 0xd4, 0xa0, 0x23, 0xc8, 0xc9, 0xf3, 0xd5, 0x25, 0x01,
-		0x16, 0x16, 0x79, 0xd4, 0x27, 0x53, 0x48, 0x44, 0x5e, 0x2c, 0x49, 0xa4, 0x38, 0xdb, 0x0b, 0xad,
-		0x4b, 0xdf, 0xc7, 0x61, 0x75, 0x0a, 0xc9, 0xa2, 0x55, 0x48, 0x05, 0x83, 0x57, 0xe1, 0xe7, 0x9a,
-		0x64, 0x62, 0xe4, 0x86, 0xfa, 0x3c, 0x76, 0xab, 0x3e, 0x8f, 0x7f, 0x6e, 0x9f, 0x7f, 0x0b, 0x77,
-		0xc7, 0x36, 0xae, 0xdb, 0x8c, 0xb4, 0xfd, 0x21, 0xed, 0x3f, 0xb6, 0x9e, 0xdf, 0x6a, 0xfb, 0x1a,
-		0x23, 0x6d, 0xbc, 0xdc, 0x9b, 0x90, 0x51, 0xf4, 0x0a, 0x92, 0xa4, 0x47, 0x1c, 0x16, 0xcc, 0xe0,
-		0x8d, 0x68, 0xea, 0x34, 0x98, 0xf1, 0xa6, 0xe5, 0x5e, 0x60, 0x09, 0x46, 0xbb, 0x90, 0x75, 0xc8,
-		0x8d, 0xee, 0x75, 0x1d, 0x5d, 0x9a, 0x27, 0x6f, 0x63, 0x9e, 0x71, 0xc8, 0x0d, 0xee, 0x3a, 0x35,
-		0x6e, 0x52, 0xfa, 0x45, 0x81, 0xc2, 0xb4, 0xc9, 0x33, 0x9b, 0x53, 0xa2, 0x48, 0x39, 0x16, 0x4d,
-		0xca, 0x9f, 0xfb, 0x4c, 0x2a, 0xfd, 0xa0, 0xc0, 0x72, 0x38, 0xcb, 0x86, 0x7b, 0x4d, 0x1c, 0x3f,
-		0xc1, 0x80, 0x68, 0xc5, 0xcb, 0x37, 0x81, 0xd3, 0x92, 0x69, 0x29, 0xfa, 0x1a, 0x72, 0x63, 0xc3,
-		0x58, 0x32, 0xde, 0xa7, 0x4e, 0x60, 0x9c, 0x0d, 0xcf, 0xdf, 0xd2, 0x6f, 0xe1, 0x07, 0x39, 0x7f,
-		0x0c, 0x3a, 0x97, 0xee, 0x5f, 0xc2, 0xc1, 0xeb, 0xa3, 0x2f, 0xde, 0x38, 0xe7, 0x88, 0xe1, 0x2b,
-		0x76, 0xe4, 0x16, 0xcd, 0x85, 0x6e, 0xd1, 0x08, 0x73, 0x27, 0xc2, 0xcc, 0xfd, 0x18, 0xb2, 0x97,
-		0xb6, 0x47, 0x99, 0xe8, 0xa9, 0x21, 0xaf, 0x66, 0xb8, 0x94, 0x77, 0x8d, 0x66, 0xa1, 0x12, 0x2c,
-		0x3a, 0xe4, 0xc3, 0x08, 0x28, 0x25, 0x08, 0xde, 0x17, 0x06, 0x98, 0xf1, 0x19, 0x90, 0x9e, 0x98,
-		0x01, 0x7e, 0xf7, 0xe5, 0x47, 0x0b, 0xc9, 0x0f, 0x75, 0x74, 0x7a, 0x2a, 0xe1, 0xe9, 0xf9, 0x19,
-		0x7f, 0x4e, 0x02, 0xd3, 0x8e, 0xe7, 0x9a, 0x84, 0xd2, 0xb0, 0x69, 0x7c, 0x68, 0x7a, 0x12, 0xe8,
-		0x07, 0xa6, 0xa5, 0xb7, 0x90, 0x1b, 0x7b, 0x16, 0x84, 0xc7, 0xb8, 0xf2, 0x29, 0x63, 0xdc, 0x81,
-		0x15, 0x79, 0xf9, 0xab, 0x07, 0xa7, 0xbb, 0x6e, 0xd7, 0x61, 0x35, 0x87, 0x79, 0x7d, 0xb4, 0x02,
-		0x09, 0xd3, 0x5f, 0x49, 0xba, 0x13, 0x8b, 0x59, 0x2f, 0x89, 0xc9, 0xb7, 0x48, 0x3c, 0xe2, 0x2d,
-		0xb2, 0xf5, 0xc7, 0x64, 0xaf, 0xf2, 0xd6, 0x78, 0x08, 0x1b, 0xb8, 0x76, 0x72, 0xa0, 0xed, 0xaa,
-		0x0d, 0xed, 0xf8, 0x48, 0x6f, 0xa8, 0xf5, 0xb7, 0x7a, 0xe3, 0xfc, 0xa4, 0xa6, 0x6b, 0x47, 0x67,
-		0xea, 0x81, 0x56, 0xcd, 0xdf, 0x41, 0x45, 0x78, 0x10, 0x0d, 0xa9, 0x1e, 0x1f, 0xaa, 0xda, 0x51,
-		0x5e, 0x99, 0xee, 0x64, 0x5f, 0xab, 0x37, 0x8e, 0xf1, 0x79, 0x3e, 0x86, 0x5e, 0xc0, 0xb3, 0x68,
-		0x48, 0xfd, 0xfc, 0x68, 0x57, 0xaf, 0xef, 0xab, 0xb8, 0xaa, 0xd7, 0x1b, 0x6a, 0xe3, 0x5d, 0x3d,
-		0x1f, 0x47, 0xcf, 0xe0, 0xd1, 0x0c, 0xb0, 0xba, 0xdb, 0xd0, 0xce, 0xb4, 0xc6, 0x79, 0x7e, 0x0e,
-		0x6d, 0xc1, 0xd3, 0x99, 0x81, 0xf5, 0xc3, 0x5a, 0x43, 0xad, 0xaa, 0x0d, 0x35, 0x9f, 0x40, 0x8f,
-		0xa1, 0x38, 0x1b, 0x7b, 0xb6, 0x93, 0x4f, 0xa2, 0xe7, 0xf0, 0x24, 0x1a, 0xb5, 0xa7, 0x6a, 0x07,
-		0xc7, 0x67, 0x35, 0xac, 0x1f, 0xaa, 0xf8, 0x6d, 0x0d, 0xe7, 0x53, 0x5b, 0x36, 0xe4, 0xc6, 0x9e,
-		0xc7, 0xe8, 0x01, 0x14, 0x44, 0x51, 0xf4, 0xe3, 0x93, 0x1a, 0x16, 0x2e, 0x86, 0x85, 0x5c, 0x87,
-		0xd5, 0x09, 0xed, 0x2e, 0xae, 0xa9, 0x8d, 0x5a, 0x5e, 0x89, 0x54, 0xbe, 0x3b, 0xa9, 0xfa, 0xca,
-		0xd8, 0xd6, 0x11, 0xa4, 0xaa, 0x07, 0xa7, 0xfc, 0xc0, 0x56, 0x20, 0x5f, 0x3d, 0x38, 0x1d, 0x3f,
-		0xa3, 0x02, 0xac, 0x0c, 0xa4, 0x23, 0xf9, 0xe7, 0x15, 0xb4, 0x0c, 0xb9, 0x81, 0x46, 0x1e, 0x58,
-		0xec, 0xcd, 0xbf, 0xbf, 0x79, 0x75, 0x65, 0xb3, 0x66, 0xf7, 0xa2, 0x6c, 0xba, 0xed, 0xca, 0xe8,
-		0xc7, 0x87, 0xbf, 0xdb, 0x56, 0xab, 0x72, 0xe5, 0x8a, 0xcf, 0x1d, 0x83, 0x2f, 0x11, 0xff, 0xe7,
-		0x3f, 0x7a, 0xdb, 0x17, 0x49, 0x2e, 0x7f, 0xf9, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0x11,
-		0xc6, 0x58, 0x56, 0x11, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0xdb, 0x46,
+		0x13, 0x0f, 0x25, 0xeb, 0xe1, 0xb1, 0x2c, 0xc9, 0x6b, 0x27, 0x66, 0xec, 0x18, 0x9f, 0xa2, 0xbc,
+		0x1c, 0x07, 0x9f, 0xf4, 0xd9, 0xf9, 0xf2, 0x3d, 0x51, 0x04, 0x8c, 0x25, 0xd7, 0x6c, 0xfc, 0x5c,
+		0x29, 0x4e, 0x5d, 0xa0, 0x20, 0x68, 0x72, 0x6d, 0x11, 0x96, 0x48, 0x81, 0xbb, 0x92, 0xa3, 0x5b,
+		0x7b, 0x2f, 0xd0, 0x4b, 0x6f, 0x3d, 0xf6, 0xdf, 0xe8, 0xb9, 0xd7, 0xf6, 0x4f, 0x2a, 0xb8, 0xbb,
+		0x94, 0x44, 0xbd, 0xe2, 0x36, 0x87, 0xde, 0xb4, 0x33, 0xbf, 0xdf, 0xcc, 0xec, 0xec, 0xec, 0xcc,
+		0x8a, 0xf0, 0xac, 0x73, 0x41, 0xfc, 0xb2, 0x65, 0xda, 0xc4, 0xb5, 0x48, 0xd9, 0xb4, 0x5b, 0x8e,
+		0x5b, 0xee, 0x6e, 0x97, 0x7d, 0xd2, 0x6e, 0x3a, 0x96, 0xc9, 0x1c, 0xcf, 0x2d, 0xb5, 0x7d, 0x8f,
+		0x79, 0xe8, 0x6e, 0x00, 0x2c, 0x49, 0x60, 0x89, 0x03, 0x4b, 0xdd, 0xed, 0xb5, 0xbf, 0x5d, 0x79,
+		0xde, 0x55, 0x93, 0x94, 0x39, 0xe8, 0xa2, 0x73, 0x59, 0x66, 0x4e, 0x8b, 0x50, 0x66, 0xb6, 0xda,
+		0x82, 0xb7, 0x56, 0x88, 0x3a, 0x68, 0x3b, 0x81, 0x79, 0xcb, 0x6b, 0xb5, 0x3c, 0x77, 0x16, 0xc2,
+		0xf6, 0x5a, 0xa6, 0x13, 0x22, 0x1e, 0x4d, 0x0e, 0xb2, 0xe1, 0x50, 0xe6, 0xf9, 0x3d, 0x01, 0x2a,
+		0xfe, 0x10, 0x83, 0x65, 0x3c, 0x08, 0xfb, 0x90, 0x50, 0x6a, 0x5e, 0x11, 0x8a, 0x6a, 0xb0, 0x34,
+		0xb4, 0x1b, 0x83, 0x99, 0xf4, 0x9a, 0xaa, 0x4a, 0x21, 0xbe, 0xb9, 0xb0, 0xf3, 0xb4, 0x34, 0x71,
+		0x53, 0xa5, 0x21, 0x33, 0x75, 0x93, 0x5e, 0xe3, 0xbc, 0x1f, 0x15, 0x50, 0xf4, 0x5f, 0xb8, 0xdf,
+		0x34, 0x29, 0x33, 0x7c, 0xc2, 0x7c, 0x87, 0x74, 0x89, 0x6d, 0xb4, 0x84, 0x3f, 0xc3, 0xb1, 0xd5,
+		0x58, 0x41, 0xd9, 0x8c, 0xe3, 0x7b, 0x01, 0x00, 0x87, 0x7a, 0x19, 0x8e, 0x6e, 0xa3, 0xfb, 0x90,
+		0x6e, 0x98, 0xd4, 0x68, 0x79, 0x3e, 0x51, 0xe3, 0x05, 0x65, 0x33, 0x8d, 0x53, 0x0d, 0x93, 0x1e,
+		0x7a, 0x3e, 0x41, 0x18, 0x96, 0x68, 0xcf, 0xb5, 0x0c, 0xda, 0x30, 0x7d, 0xdb, 0xa0, 0xcc, 0x64,
+		0x1d, 0xaa, 0xce, 0x15, 0x94, 0x19, 0xa1, 0xd6, 0x7a, 0xae, 0x55, 0x0b, 0xe0, 0x35, 0x8e, 0xc6,
+		0x39, 0x1a, 0x15, 0x14, 0xbf, 0x4f, 0x42, 0x6e, 0x64, 0x3f, 0xe8, 0x73, 0x98, 0x0f, 0xd2, 0x60,
+		0xb0, 0x5e, 0x9b, 0xa8, 0x4a, 0x41, 0xd9, 0xcc, 0xee, 0x6c, 0xdd, 0x2e, 0x15, 0xf5, 0x5e, 0x9b,
+		0xe0, 0x34, 0x93, 0xbf, 0xd0, 0x63, 0xc8, 0x52, 0xaf, 0xe3, 0x5b, 0x84, 0xa7, 0x75, 0xb0, 0xf7,
+		0x8c, 0x90, 0x06, 0x0c, 0xdd, 0x46, 0xaf, 0x61, 0xd1, 0xf2, 0x89, 0x4c, 0xbf, 0xd3, 0x12, 0xdb,
+		0x5e, 0xd8, 0x59, 0x2b, 0x89, 0xda, 0x29, 0x85, 0xb5, 0x53, 0xaa, 0x87, 0xb5, 0x83, 0x33, 0x21,
+		0x21, 0x10, 0x21, 0x0b, 0xee, 0x89, 0x7a, 0x10, 0x6e, 0x4c, 0xc6, 0x7c, 0xe7, 0xa2, 0xc3, 0x48,
+		0x98, 0x9c, 0x17, 0x53, 0x82, 0xaf, 0x70, 0x52, 0x10, 0x85, 0xd6, 0xa7, 0xec, 0xdf, 0xc1, 0x2b,
+		0xf6, 0x04, 0x39, 0xfa, 0x46, 0x81, 0x87, 0x63, 0xd9, 0x1f, 0x73, 0x98, 0xe0, 0x0e, 0xff, 0x79,
+		0xbb, 0xd3, 0x18, 0xf3, 0xbc, 0x41, 0x67, 0x01, 0x50, 0x17, 0x38, 0xc0, 0x30, 0x2d, 0xe6, 0x74,
+		0x1d, 0xd6, 0x1b, 0xf3, 0x9e, 0xe4, 0xde, 0xb7, 0x67, 0x78, 0xd7, 0x24, 0x75, 0xcc, 0xf5, 0x1a,
+		0x9d, 0xaa, 0x45, 0x2d, 0x58, 0x93, 0x77, 0x49, 0x78, 0xec, 0xee, 0x0c, 0x3b, 0x4d, 0x71, 0xa7,
+		0xa5, 0x29, 0x4e, 0xf7, 0x05, 0x31, 0xb0, 0x78, 0xb6, 0x13, 0xf1, 0xb8, 0xda, 0x98, 0xac, 0x42,
+		0x1e, 0xac, 0x5d, 0x9a, 0x4e, 0xd3, 0xeb, 0x12, 0xdf, 0x68, 0x99, 0xfe, 0x35, 0xf1, 0x87, 0xdd,
+		0xa5, 0xb9, 0xbb, 0xf2, 0x14, 0x77, 0x7b, 0x92, 0x78, 0xc8, 0x79, 0x11, 0x7f, 0xea, 0xe5, 0x14,
+		0xdd, 0x9b, 0x0c, 0xc0, 0xc0, 0x41, 0xf1, 0xe7, 0x18, 0xac, 0x4c, 0xaa, 0x0c, 0x74, 0x0a, 0x79,
+		0x59, 0x66, 0x5e, 0x9b, 0xf8, 0xbc, 0xfc, 0xe4, 0xed, 0x78, 0x3a, 0xb3, 0xc0, 0x8e, 0x43, 0x34,
+		0xce, 0xd9, 0x51, 0x01, 0xca, 0x42, 0x4c, 0x5e, 0x8a, 0x79, 0x1c, 0x73, 0x6c, 0xf4, 0x12, 0x92,
+		0x02, 0x22, 0xef, 0xc0, 0xfa, 0x88, 0xe1, 0xb6, 0x33, 0x30, 0x8b, 0x25, 0x14, 0x3d, 0x81, 0xac,
+		0xe5, 0xb9, 0x97, 0xce, 0x95, 0xd1, 0x25, 0x3e, 0x0d, 0xa2, 0x9a, 0xe3, 0xb7, 0x6c, 0x51, 0x48,
+		0xcf, 0x84, 0x10, 0x3d, 0x87, 0x7c, 0x3f, 0xad, 0x21, 0x30, 0xc1, 0x81, 0xb9, 0x50, 0x1e, 0x42,
+		0xff, 0x07, 0xf7, 0xdb, 0x3e, 0xe9, 0x3a, 0x5e, 0x87, 0x1a, 0x63, 0x9c, 0x24, 0xe7, 0xac, 0x86,
+		0x80, 0xbd, 0x28, 0xb7, 0xf8, 0xa3, 0x02, 0x1b, 0x33, 0xeb, 0x3c, 0x88, 0x57, 0x76, 0x05, 0xab,
+		0xd9, 0xa1, 0x8c, 0xf8, 0x3c, 0x8b, 0xf3, 0x78, 0x51, 0x48, 0x77, 0x85, 0x30, 0x68, 0x84, 0xe2,
+		0xaa, 0xc9, 0x0c, 0x25, 0x70, 0x8a, 0xaf, 0x75, 0x1b, 0xfd, 0x07, 0xe6, 0xfb, 0x73, 0xe4, 0x16,
+		0xdd, 0x62, 0x00, 0x2e, 0xfe, 0x9a, 0x80, 0xb5, 0xe9, 0xf7, 0x00, 0xad, 0xc3, 0xbc, 0x3c, 0x62,
+		0xc7, 0x96, 0x51, 0xa5, 0x85, 0x40, 0xb7, 0xd1, 0x3b, 0x40, 0x37, 0x9e, 0x7f, 0x7d, 0xd9, 0xf4,
+		0x6e, 0x0c, 0xf2, 0x81, 0x58, 0x1d, 0x5e, 0x01, 0xb1, 0x89, 0xfd, 0x57, 0x1c, 0xd4, 0x7b, 0x09,
+		0xaf, 0x86, 0x68, 0xbc, 0x74, 0x33, 0x2a, 0x42, 0x2a, 0xa4, 0xc2, 0xd4, 0xc6, 0x79, 0x6a, 0xc3,
+		0x25, 0x7a, 0x08, 0x19, 0x6a, 0x35, 0x88, 0xdd, 0x69, 0x12, 0x9e, 0x05, 0x71, 0xac, 0x0b, 0x7d,
+		0x99, 0x6e, 0x23, 0x0d, 0xb2, 0x03, 0x08, 0x6f, 0x9e, 0x89, 0x8f, 0xa6, 0x63, 0xb1, 0xcf, 0x08,
+		0x64, 0x68, 0x03, 0x80, 0x32, 0xd3, 0x67, 0xc2, 0x87, 0x38, 0xdd, 0x79, 0x29, 0xd1, 0x6d, 0xf4,
+		0x19, 0x64, 0x42, 0x35, 0xb7, 0x9f, 0xfa, 0xa8, 0xfd, 0x05, 0x89, 0xe7, 0xd6, 0xbf, 0x80, 0x65,
+		0x3e, 0x09, 0x1b, 0xc4, 0xf4, 0xd9, 0x05, 0x31, 0x99, 0xb0, 0x92, 0xfe, 0xa8, 0x95, 0xa5, 0x80,
+		0xb6, 0x1f, 0xb2, 0xb8, 0xad, 0x7f, 0x41, 0xca, 0x26, 0xcc, 0x74, 0x9a, 0x54, 0x9d, 0xe7, 0xfc,
+		0x07, 0x13, 0xb3, 0x7e, 0x62, 0xf6, 0x9a, 0x9e, 0x69, 0xe3, 0x10, 0x1c, 0x64, 0xd8, 0x64, 0x8c,
+		0xb4, 0xda, 0x4c, 0x05, 0x51, 0x48, 0x72, 0x89, 0x5e, 0x43, 0x86, 0x47, 0x17, 0x14, 0x79, 0xc7,
+		0x27, 0xea, 0xc2, 0x0c, 0xb3, 0x7b, 0x02, 0x83, 0x17, 0x02, 0x86, 0x5c, 0xa0, 0x7f, 0xc0, 0x0a,
+		0x37, 0x10, 0x1c, 0x2b, 0xf1, 0x0d, 0xc7, 0x26, 0x2e, 0x73, 0x58, 0x4f, 0xcd, 0xf0, 0xda, 0x41,
+		0x81, 0xee, 0x3d, 0x57, 0xe9, 0x52, 0x83, 0x8e, 0x20, 0x27, 0xcf, 0xd7, 0x90, 0x0d, 0x50, 0x5d,
+		0xe4, 0x5e, 0x9f, 0x4c, 0x69, 0x22, 0xf2, 0x62, 0xc9, 0x46, 0x8a, 0xb3, 0xdd, 0xc8, 0xba, 0xf8,
+		0x6d, 0x1c, 0x56, 0xa7, 0x34, 0x59, 0xb4, 0x0a, 0xa9, 0x70, 0xf0, 0x2a, 0xfc, 0x5c, 0x93, 0x4c,
+		0x8c, 0xdc, 0x48, 0x9d, 0xc7, 0x6e, 0x55, 0xe7, 0xf1, 0x4f, 0xad, 0xf3, 0xaf, 0xe1, 0xee, 0xc8,
+		0xc6, 0x0d, 0x87, 0x91, 0x56, 0x30, 0xa4, 0x83, 0xc7, 0xd6, 0xf3, 0x5b, 0x6d, 0x5f, 0x67, 0xa4,
+		0x85, 0x97, 0xbb, 0x63, 0x32, 0x8a, 0x5e, 0x41, 0x92, 0x74, 0x89, 0xcb, 0xc2, 0x19, 0xbc, 0x31,
+		0xb9, 0x75, 0x9a, 0xcc, 0x7c, 0xd3, 0xf4, 0x2e, 0xb0, 0x04, 0xa3, 0x5d, 0xc8, 0xba, 0xe4, 0xc6,
+		0xf0, 0x3b, 0xae, 0x21, 0xe9, 0xc9, 0xdb, 0xd0, 0x33, 0x2e, 0xb9, 0xc1, 0x1d, 0xb7, 0xca, 0x29,
+		0xc5, 0x9f, 0x14, 0x50, 0xa7, 0x4d, 0x9e, 0xd9, 0x3d, 0x65, 0x52, 0x53, 0x8e, 0x4d, 0x6e, 0xca,
+		0x9f, 0xfa, 0x4c, 0x2a, 0x7e, 0xa7, 0xc0, 0x72, 0x34, 0xca, 0xba, 0x77, 0x4d, 0xdc, 0x20, 0xc0,
+		0xb0, 0xd1, 0x8a, 0x97, 0x6f, 0x02, 0xa7, 0x65, 0xa7, 0xa5, 0xe8, 0x4b, 0xc8, 0x8d, 0x0c, 0x63,
+		0x35, 0xf6, 0xa7, 0x26, 0x30, 0xce, 0x46, 0xe7, 0x6f, 0xf1, 0x97, 0xe8, 0x83, 0x9c, 0x3f, 0x06,
+		0xdd, 0x4b, 0xef, 0x2f, 0xe9, 0xc1, 0xeb, 0xc3, 0x2f, 0xde, 0x38, 0xef, 0x11, 0x83, 0x57, 0xec,
+		0xd0, 0x2d, 0x9a, 0x8b, 0xdc, 0xa2, 0xa1, 0xce, 0x9d, 0x88, 0x76, 0xee, 0xc7, 0x90, 0xbd, 0x74,
+		0x7c, 0xca, 0x44, 0x4d, 0x0d, 0xfa, 0x6a, 0x86, 0x4b, 0x79, 0xd5, 0xe8, 0x36, 0x2a, 0xc2, 0xa2,
+		0x4b, 0x3e, 0x0c, 0x81, 0x52, 0xa2, 0xc1, 0x07, 0xc2, 0x10, 0x33, 0x3a, 0x03, 0xd2, 0x63, 0x33,
+		0x20, 0xa8, 0xbe, 0xfc, 0x70, 0x22, 0xf9, 0xa1, 0x0e, 0x4f, 0x4f, 0x25, 0x3a, 0x3d, 0x3f, 0xe1,
+		0xcf, 0x49, 0x48, 0x6d, 0xfb, 0x9e, 0x45, 0x28, 0x8d, 0x52, 0xe3, 0x03, 0xea, 0x49, 0xa8, 0xef,
+		0x53, 0x8b, 0x6f, 0x21, 0x37, 0xf2, 0x2c, 0x88, 0x8e, 0x71, 0xe5, 0x8f, 0x8c, 0x71, 0x17, 0x56,
+		0xe4, 0xe5, 0xaf, 0x1c, 0x9c, 0xee, 0x7a, 0x1d, 0x97, 0x55, 0x5d, 0xe6, 0xf7, 0xd0, 0x0a, 0x24,
+		0xac, 0x60, 0x25, 0xdb, 0x9d, 0x58, 0xcc, 0x7a, 0x49, 0x8c, 0xbf, 0x45, 0xe2, 0x13, 0xde, 0x22,
+		0x5b, 0xbf, 0x8d, 0xd7, 0x2a, 0x2f, 0x8d, 0x87, 0xb0, 0x81, 0xab, 0x27, 0x07, 0xfa, 0xae, 0x56,
+		0xd7, 0x8f, 0x8f, 0x8c, 0xba, 0x56, 0x7b, 0x6b, 0xd4, 0xcf, 0x4f, 0xaa, 0x86, 0x7e, 0x74, 0xa6,
+		0x1d, 0xe8, 0x95, 0xfc, 0x1d, 0x54, 0x80, 0x07, 0x93, 0x21, 0x95, 0xe3, 0x43, 0x4d, 0x3f, 0xca,
+		0x2b, 0xd3, 0x8d, 0xec, 0xeb, 0xb5, 0xfa, 0x31, 0x3e, 0xcf, 0xc7, 0xd0, 0x0b, 0x78, 0x36, 0x19,
+		0x52, 0x3b, 0x3f, 0xda, 0x35, 0x6a, 0xfb, 0x1a, 0xae, 0x18, 0xb5, 0xba, 0x56, 0x7f, 0x57, 0xcb,
+		0xc7, 0xd1, 0x33, 0x78, 0x34, 0x03, 0xac, 0xed, 0xd6, 0xf5, 0x33, 0xbd, 0x7e, 0x9e, 0x9f, 0x43,
+		0x5b, 0xf0, 0x74, 0xa6, 0x63, 0xe3, 0xb0, 0x5a, 0xd7, 0x2a, 0x5a, 0x5d, 0xcb, 0x27, 0xd0, 0x63,
+		0x28, 0xcc, 0xc6, 0x9e, 0xed, 0xe4, 0x93, 0xe8, 0x39, 0x3c, 0x99, 0x8c, 0xda, 0xd3, 0xf4, 0x83,
+		0xe3, 0xb3, 0x2a, 0x36, 0x0e, 0x35, 0xfc, 0xb6, 0x8a, 0xf3, 0xa9, 0x2d, 0x07, 0x72, 0x23, 0xcf,
+		0x63, 0xf4, 0x00, 0x54, 0x91, 0x14, 0xe3, 0xf8, 0xa4, 0x8a, 0x85, 0x89, 0x41, 0x22, 0xd7, 0x61,
+		0x75, 0x4c, 0xbb, 0x8b, 0xab, 0x5a, 0xbd, 0x9a, 0x57, 0x26, 0x2a, 0xdf, 0x9d, 0x54, 0x02, 0x65,
+		0x6c, 0xeb, 0x08, 0x52, 0x95, 0x83, 0x53, 0x7e, 0x60, 0x2b, 0x90, 0xaf, 0x1c, 0x9c, 0x8e, 0x9e,
+		0x91, 0x0a, 0x2b, 0x7d, 0xe9, 0x50, 0xfc, 0x79, 0x05, 0x2d, 0x43, 0xae, 0xaf, 0x91, 0x07, 0x16,
+		0x7b, 0xf3, 0xef, 0xaf, 0x5e, 0x5d, 0x39, 0xac, 0xd1, 0xb9, 0x28, 0x59, 0x5e, 0xab, 0x3c, 0xfc,
+		0xf1, 0xe1, 0xef, 0x8e, 0xdd, 0x2c, 0x5f, 0x79, 0xe2, 0x73, 0x47, 0xff, 0x4b, 0xc4, 0xff, 0xf9,
+		0x8f, 0xee, 0xf6, 0x45, 0x92, 0xcb, 0x5f, 0xfe, 0x3e, 0x00, 0x40, 0x11, 0xc6, 0x58, 0x56, 0x11,
+		0x00, 0x00,
 	},
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xdb, 0x36,
-		0x14, 0x9d, 0xec, 0x24, 0x73, 0xae, 0x1c, 0xd7, 0x65, 0x9a, 0x46, 0xf1, 0x86, 0x45, 0x4d, 0x51,
-		0xc0, 0x2b, 0x30, 0x79, 0xf1, 0x86, 0xad, 0xdd, 0xb0, 0x07, 0xc7, 0x52, 0x3b, 0x0f, 0x59, 0x16,
-		0xc8, 0x6e, 0x1e, 0xd6, 0x07, 0x81, 0x96, 0x68, 0x9b, 0xa8, 0x2c, 0x0a, 0x14, 0xed, 0x34, 0x6f,
-		0xc3, 0x3e, 0x62, 0x1f, 0xb3, 0xc7, 0x7d, 0xd9, 0x20, 0x8a, 0x52, 0x1c, 0x5b, 0x48, 0xfa, 0x46,
-		0xde, 0x7b, 0xcf, 0xe1, 0xd1, 0xd1, 0xbd, 0x94, 0xc0, 0x5c, 0x8c, 0x09, 0xef, 0xf8, 0x38, 0x20,
-		0x91, 0x4f, 0x3a, 0x38, 0xa6, 0x9d, 0xe5, 0x69, 0x27, 0x60, 0x73, 0x4c, 0x23, 0x2b, 0xe6, 0x4c,
-		0x30, 0xb4, 0x9f, 0x56, 0x58, 0xaa, 0xc2, 0xc2, 0x31, 0xb5, 0x96, 0xa7, 0xad, 0xaf, 0xa6, 0x8c,
-		0x4d, 0x43, 0xd2, 0x91, 0x25, 0xe3, 0xc5, 0xa4, 0x13, 0x2c, 0x38, 0x16, 0x94, 0x29, 0x50, 0xeb,
-		0x78, 0x3d, 0x2f, 0xe8, 0x9c, 0x24, 0x02, 0xcf, 0xe3, 0xac, 0xe0, 0xe4, 0x9f, 0x1a, 0xec, 0xd8,
-		0xf2, 0x18, 0xd4, 0x80, 0x0a, 0x0d, 0x0c, 0xcd, 0xd4, 0xda, 0xbb, 0x6e, 0x85, 0x06, 0x08, 0xc1,
-		0x56, 0x84, 0xe7, 0xc4, 0xa8, 0xc8, 0x88, 0x5c, 0xa3, 0xd7, 0xb0, 0x93, 0x08, 0x2c, 0x16, 0x89,
-		0x51, 0x35, 0xb5, 0x76, 0xa3, 0xfb, 0xcc, 0x2a, 0x51, 0x65, 0x65, 0x84, 0x43, 0x59, 0xe8, 0x2a,
-		0x00, 0x32, 0x41, 0x0f, 0x48, 0xe2, 0x73, 0x1a, 0xa7, 0xfa, 0x8c, 0x2d, 0xc9, 0xba, 0x1a, 0x42,
-		0xc7, 0xa0, 0xb3, 0xeb, 0x88, 0x70, 0x8f, 0xcc, 0x31, 0x0d, 0x8d, 0x6d, 0x59, 0x01, 0x32, 0xe4,
-		0xa4, 0x11, 0xf4, 0x1a, 0xb6, 0x02, 0x2c, 0xb0, 0xb1, 0x63, 0x56, 0xdb, 0x7a, 0xf7, 0xc5, 0x3d,
-		0x67, 0x5b, 0x36, 0x16, 0xd8, 0x89, 0x04, 0xbf, 0x71, 0x25, 0x04, 0xcd, 0xe0, 0xf9, 0x35, 0xe3,
-		0x1f, 0x26, 0x21, 0xbb, 0xf6, 0xc8, 0x47, 0xe2, 0x2f, 0xd2, 0x13, 0x3d, 0x4e, 0x04, 0x89, 0xe4,
-		0x2a, 0x26, 0x9c, 0xb2, 0xc0, 0xf8, 0xdc, 0xd4, 0xda, 0x7a, 0xf7, 0xc8, 0xca, 0x6c, 0xb3, 0x72,
-		0xdb, 0x2c, 0x5b, 0xd9, 0xea, 0x9a, 0x39, 0x8b, 0x93, 0x93, 0xb8, 0x39, 0xc7, 0xa5, 0xa4, 0x40,
-		0x7d, 0xa8, 0x8f, 0x71, 0xe0, 0x8d, 0x69, 0x84, 0x39, 0x25, 0x89, 0x51, 0x93, 0x94, 0x66, 0xa9,
-		0xd8, 0x33, 0x1c, 0x9c, 0xa9, 0x3a, 0x57, 0x1f, 0xdf, 0x6e, 0xd0, 0x7b, 0x38, 0x9c, 0xd1, 0x44,
-		0x30, 0x7e, 0xe3, 0x61, 0xee, 0xcf, 0xe8, 0x12, 0x87, 0x9e, 0x32, 0x7e, 0x57, 0x1a, 0xff, 0xbc,
-		0x94, 0xaf, 0xa7, 0x6a, 0x95, 0xf5, 0x07, 0x8a, 0xe3, 0x6e, 0x18, 0x7d, 0x0b, 0x4f, 0x36, 0xc8,
-		0x17, 0x9c, 0x1a, 0x20, 0x0d, 0x47, 0x6b, 0xa0, 0x77, 0x9c, 0x22, 0x0c, 0xad, 0x25, 0x4d, 0xe8,
-		0x98, 0x86, 0x54, 0x6c, 0x2a, 0xd2, 0x3f, 0x5d, 0x91, 0x71, 0x4b, 0xb3, 0x26, 0xea, 0x07, 0x38,
-		0x2c, 0x3b, 0x22, 0xd5, 0x55, 0x97, 0xba, 0x0e, 0x36, 0xa1, 0xa9, 0x34, 0x0b, 0xf6, 0xb1, 0x2f,
-		0xe8, 0x92, 0x78, 0x7e, 0xb8, 0x48, 0x04, 0xe1, 0x9e, 0x6c, 0xda, 0x3d, 0x89, 0x79, 0x9c, 0xa5,
-		0xfa, 0x59, 0xe6, 0x22, 0xed, 0xe0, 0x4b, 0xa8, 0xa9, 0xc2, 0xc4, 0x68, 0xc8, 0x3e, 0xfa, 0xbe,
-		0x54, 0xb8, 0xc2, 0xb8, 0x24, 0x0e, 0xa9, 0x2f, 0xdf, 0x7d, 0x9f, 0x45, 0x13, 0x3a, 0xcd, 0x1b,
-		0xa1, 0x60, 0x41, 0x5f, 0x43, 0x73, 0x82, 0x69, 0xc8, 0x96, 0x84, 0x7b, 0x4b, 0xc2, 0x93, 0xb4,
-		0xbb, 0x1f, 0x99, 0x5a, 0xbb, 0xea, 0x3e, 0xca, 0xe3, 0x57, 0x59, 0x18, 0xb5, 0xa1, 0x49, 0x13,
-		0x6f, 0x1a, 0xb2, 0x31, 0x0e, 0xbd, 0x6c, 0xba, 0x8d, 0xa6, 0xa9, 0xb5, 0x6b, 0x6e, 0x83, 0x26,
-		0x6f, 0x65, 0x58, 0x0d, 0xe3, 0x1b, 0xd8, 0x2b, 0x48, 0x69, 0x34, 0x61, 0xc6, 0x63, 0xd9, 0x46,
-		0xe5, 0xf3, 0xf6, 0x46, 0x55, 0x0e, 0xa2, 0x09, 0x73, 0xeb, 0x93, 0x95, 0x5d, 0xeb, 0x47, 0xd8,
-		0x2d, 0x46, 0x01, 0x35, 0xa1, 0xfa, 0x81, 0xdc, 0xa8, 0x11, 0x4f, 0x97, 0xe8, 0x09, 0x6c, 0x2f,
-		0x71, 0xb8, 0xc8, 0x87, 0x3c, 0xdb, 0xfc, 0x54, 0x79, 0xa5, 0x9d, 0xd8, 0x70, 0xfc, 0x80, 0x05,
-		0xe8, 0x19, 0xd4, 0xef, 0x78, 0x9e, 0xf1, 0xea, 0xfe, 0xad, 0xdb, 0x27, 0xff, 0x6a, 0xa0, 0xaf,
-		0x34, 0x39, 0xfa, 0x0d, 0x6a, 0xc5, 0x60, 0x68, 0xd2, 0x7d, 0xeb, 0xa1, 0xc1, 0xb0, 0xf2, 0x45,
-		0x36, 0xce, 0x05, 0xbe, 0xe5, 0xc1, 0xde, 0x9d, 0x54, 0xc9, 0xe3, 0xbd, 0x5a, 0x7d, 0x3c, 0xbd,
-		0x7b, 0x72, 0xef, 0x59, 0x37, 0xd2, 0xbe, 0x15, 0x0b, 0xfe, 0xd6, 0x60, 0xef, 0x4e, 0x12, 0x3d,
-		0x85, 0x1d, 0x4e, 0x70, 0xc2, 0x22, 0x75, 0x88, 0xda, 0xa1, 0x16, 0xd4, 0x58, 0x4c, 0x38, 0x16,
-		0x8c, 0x2b, 0x27, 0x8b, 0x3d, 0xfa, 0x05, 0xea, 0x3e, 0x27, 0x58, 0x90, 0xc0, 0x4b, 0x2f, 0x5f,
-		0x79, 0x71, 0xea, 0xdd, 0xd6, 0xc6, 0x15, 0x33, 0xca, 0x6f, 0x66, 0x57, 0x57, 0xf5, 0x69, 0xe4,
-		0xe4, 0xbf, 0x0a, 0xd4, 0x57, 0xdf, 0x6f, 0x69, 0xbb, 0x69, 0xe5, 0xed, 0x36, 0x02, 0xa3, 0x28,
-		0x4d, 0x04, 0xe6, 0xc2, 0x2b, 0xae, 0x7f, 0xe5, 0xc8, 0x7d, 0x32, 0x9e, 0xe6, 0xd8, 0x61, 0x0a,
-		0x2d, 0xe2, 0xe8, 0x0a, 0x8e, 0x0a, 0x56, 0xf2, 0x31, 0xa6, 0x9c, 0xac, 0xd0, 0x3e, 0xfc, 0x74,
-		0x87, 0x39, 0xd8, 0x91, 0xd8, 0x5b, 0xde, 0x2e, 0x1c, 0xf8, 0x6c, 0x1e, 0x87, 0x24, 0xb5, 0x2a,
-		0x99, 0x61, 0x1e, 0x78, 0x3e, 0x5b, 0x44, 0x42, 0x7e, 0x2a, 0xb6, 0xdd, 0xfd, 0x22, 0x39, 0x4c,
-		0x73, 0xfd, 0x34, 0x85, 0x5e, 0x40, 0x23, 0x26, 0x51, 0x40, 0xa3, 0x69, 0x86, 0x48, 0x8c, 0x6d,
-		0xb3, 0xda, 0xde, 0x76, 0xf7, 0x54, 0x54, 0x96, 0x26, 0x2f, 0xff, 0xd2, 0xa0, 0xbe, 0xfa, 0x51,
-		0x42, 0x47, 0x70, 0x60, 0xff, 0xf1, 0x7b, 0x6f, 0x70, 0xe1, 0x0d, 0x47, 0xbd, 0xd1, 0xbb, 0xa1,
-		0x37, 0xb8, 0xb8, 0xea, 0x9d, 0x0f, 0xec, 0xe6, 0x67, 0xe8, 0x4b, 0x30, 0xee, 0xa6, 0x5c, 0xe7,
-		0xed, 0x60, 0x38, 0x72, 0x5c, 0xc7, 0x6e, 0x6a, 0x9b, 0x59, 0xdb, 0xb9, 0x74, 0x9d, 0x7e, 0x6f,
-		0xe4, 0xd8, 0xcd, 0xca, 0x26, 0xad, 0xed, 0x9c, 0x3b, 0x69, 0xaa, 0xfa, 0x72, 0x06, 0x8d, 0xb5,
-		0x1b, 0xef, 0x0b, 0x38, 0xec, 0xb9, 0xfd, 0x5f, 0x07, 0x57, 0xbd, 0xf3, 0x52, 0x15, 0xeb, 0x49,
-		0x7b, 0x30, 0xec, 0x9d, 0x9d, 0x4b, 0x15, 0x25, 0x50, 0xe7, 0x22, 0x4b, 0x56, 0xce, 0xde, 0xc3,
-		0xa1, 0xcf, 0xe6, 0x65, 0xad, 0x7e, 0xa6, 0x67, 0x26, 0x5c, 0xa6, 0x6f, 0xe5, 0x52, 0xfb, 0xf3,
-		0x74, 0x4a, 0xc5, 0x6c, 0x31, 0xb6, 0x7c, 0x36, 0xef, 0xac, 0xfe, 0x7f, 0x7c, 0x43, 0x83, 0xb0,
-		0x33, 0x65, 0xd9, 0x5f, 0x83, 0xfa, 0x19, 0xf9, 0x19, 0xc7, 0x74, 0x79, 0x3a, 0xde, 0x91, 0xb1,
-		0xef, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x01, 0x9f, 0x23, 0xb0, 0x08, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+		0x17, 0xfd, 0x64, 0x27, 0xf9, 0x9c, 0x2b, 0xc7, 0x75, 0x99, 0xa6, 0x51, 0xbc, 0x61, 0x51, 0x53,
+		0x14, 0xf0, 0x0a, 0x4c, 0x5e, 0xbc, 0x61, 0x6b, 0x37, 0xec, 0xc1, 0xb1, 0xd4, 0xce, 0x43, 0x96,
+		0x05, 0xb2, 0x9b, 0x87, 0xf5, 0x41, 0xa0, 0x25, 0xda, 0x26, 0x2a, 0x8b, 0x02, 0x45, 0x39, 0xcd,
+		0xdb, 0xb0, 0x1f, 0xb1, 0x1f, 0xb3, 0xc7, 0xfd, 0xb2, 0x41, 0x14, 0xa5, 0x38, 0xb1, 0xd0, 0xec,
+		0x8d, 0xbc, 0xf7, 0x9e, 0xc3, 0xa3, 0xa3, 0x7b, 0x29, 0x81, 0x99, 0x4e, 0x09, 0xef, 0xf9, 0x38,
+		0x20, 0x91, 0x4f, 0x7a, 0x38, 0xa6, 0xbd, 0xd5, 0x69, 0x2f, 0x60, 0x4b, 0x4c, 0x23, 0x2b, 0xe6,
+		0x4c, 0x30, 0xb4, 0x9f, 0x55, 0x58, 0xaa, 0xc2, 0xc2, 0x31, 0xb5, 0x56, 0xa7, 0x9d, 0x2f, 0xe6,
+		0x8c, 0xcd, 0x43, 0xd2, 0x93, 0x25, 0xd3, 0x74, 0xd6, 0x0b, 0x52, 0x8e, 0x05, 0x65, 0x0a, 0xd4,
+		0x39, 0xbe, 0x9f, 0x17, 0x74, 0x49, 0x12, 0x81, 0x97, 0x71, 0x5e, 0x70, 0xf2, 0x57, 0x03, 0x76,
+		0x6c, 0x79, 0x0c, 0x6a, 0x41, 0x8d, 0x06, 0x86, 0x66, 0x6a, 0xdd, 0x5d, 0xb7, 0x46, 0x03, 0x84,
+		0x60, 0x2b, 0xc2, 0x4b, 0x62, 0xd4, 0x64, 0x44, 0xae, 0xd1, 0x6b, 0xd8, 0x49, 0x04, 0x16, 0x69,
+		0x62, 0xd4, 0x4d, 0xad, 0xdb, 0xea, 0x3f, 0xb3, 0x2a, 0x54, 0x59, 0x39, 0xe1, 0x58, 0x16, 0xba,
+		0x0a, 0x80, 0x4c, 0xd0, 0x03, 0x92, 0xf8, 0x9c, 0xc6, 0x99, 0x3e, 0x63, 0x4b, 0xb2, 0xae, 0x87,
+		0xd0, 0x31, 0xe8, 0xec, 0x3a, 0x22, 0xdc, 0x23, 0x4b, 0x4c, 0x43, 0x63, 0x5b, 0x56, 0x80, 0x0c,
+		0x39, 0x59, 0x04, 0xbd, 0x86, 0xad, 0x00, 0x0b, 0x6c, 0xec, 0x98, 0xf5, 0xae, 0xde, 0x7f, 0xf1,
+		0x89, 0xb3, 0x2d, 0x1b, 0x0b, 0xec, 0x44, 0x82, 0xdf, 0xb8, 0x12, 0x82, 0x16, 0xf0, 0xfc, 0x9a,
+		0xf1, 0x0f, 0xb3, 0x90, 0x5d, 0x7b, 0xe4, 0x23, 0xf1, 0xd3, 0xec, 0x44, 0x8f, 0x13, 0x41, 0x22,
+		0xb9, 0x8a, 0x09, 0xa7, 0x2c, 0x30, 0xfe, 0x6f, 0x6a, 0x5d, 0xbd, 0x7f, 0x64, 0xe5, 0xb6, 0x59,
+		0x85, 0x6d, 0x96, 0xad, 0x6c, 0x75, 0xcd, 0x82, 0xc5, 0x29, 0x48, 0xdc, 0x82, 0xe3, 0x52, 0x52,
+		0xa0, 0x21, 0x34, 0xa7, 0x38, 0xf0, 0xa6, 0x34, 0xc2, 0x9c, 0x92, 0xc4, 0x68, 0x48, 0x4a, 0xb3,
+		0x52, 0xec, 0x19, 0x0e, 0xce, 0x54, 0x9d, 0xab, 0x4f, 0x6f, 0x37, 0xe8, 0x3d, 0x1c, 0x2e, 0x68,
+		0x22, 0x18, 0xbf, 0xf1, 0x30, 0xf7, 0x17, 0x74, 0x85, 0x43, 0x4f, 0x19, 0xbf, 0x2b, 0x8d, 0x7f,
+		0x5e, 0xc9, 0x37, 0x50, 0xb5, 0xca, 0xfa, 0x03, 0xc5, 0x71, 0x37, 0x8c, 0xbe, 0x86, 0x27, 0x1b,
+		0xe4, 0x29, 0xa7, 0x06, 0x48, 0xc3, 0xd1, 0x3d, 0xd0, 0x3b, 0x4e, 0x11, 0x86, 0xce, 0x8a, 0x26,
+		0x74, 0x4a, 0x43, 0x2a, 0x36, 0x15, 0xe9, 0xff, 0x5d, 0x91, 0x71, 0x4b, 0x73, 0x4f, 0xd4, 0x77,
+		0x70, 0x58, 0x75, 0x44, 0xa6, 0xab, 0x29, 0x75, 0x1d, 0x6c, 0x42, 0x33, 0x69, 0x16, 0xec, 0x63,
+		0x5f, 0xd0, 0x15, 0xf1, 0xfc, 0x30, 0x4d, 0x04, 0xe1, 0x9e, 0x6c, 0xda, 0x3d, 0x89, 0x79, 0x9c,
+		0xa7, 0x86, 0x79, 0xe6, 0x22, 0xeb, 0xe0, 0x4b, 0x68, 0xa8, 0xc2, 0xc4, 0x68, 0xc9, 0x3e, 0xfa,
+		0xb6, 0x52, 0xb8, 0xc2, 0xb8, 0x24, 0x0e, 0xa9, 0x2f, 0xdf, 0xfd, 0x90, 0x45, 0x33, 0x3a, 0x2f,
+		0x1a, 0xa1, 0x64, 0x41, 0x5f, 0x42, 0x7b, 0x86, 0x69, 0xc8, 0x56, 0x84, 0x7b, 0x2b, 0xc2, 0x93,
+		0xac, 0xbb, 0x1f, 0x99, 0x5a, 0xb7, 0xee, 0x3e, 0x2a, 0xe2, 0x57, 0x79, 0x18, 0x75, 0xa1, 0x4d,
+		0x13, 0x6f, 0x1e, 0xb2, 0x29, 0x0e, 0xbd, 0x7c, 0xba, 0x8d, 0xb6, 0xa9, 0x75, 0x1b, 0x6e, 0x8b,
+		0x26, 0x6f, 0x65, 0x58, 0x0d, 0xe3, 0x1b, 0xd8, 0x2b, 0x49, 0x69, 0x34, 0x63, 0xc6, 0x63, 0xd9,
+		0x46, 0xd5, 0xf3, 0xf6, 0x46, 0x55, 0x8e, 0xa2, 0x19, 0x73, 0x9b, 0xb3, 0xb5, 0x5d, 0xe7, 0x7b,
+		0xd8, 0x2d, 0x47, 0x01, 0xb5, 0xa1, 0xfe, 0x81, 0xdc, 0xa8, 0x11, 0xcf, 0x96, 0xe8, 0x09, 0x6c,
+		0xaf, 0x70, 0x98, 0x16, 0x43, 0x9e, 0x6f, 0x7e, 0xa8, 0xbd, 0xd2, 0x4e, 0x6c, 0x38, 0x7e, 0xc0,
+		0x02, 0xf4, 0x0c, 0x9a, 0x77, 0x3c, 0xcf, 0x79, 0x75, 0xff, 0xd6, 0xed, 0x93, 0xbf, 0x35, 0xd0,
+		0xd7, 0x9a, 0x1c, 0xfd, 0x02, 0x8d, 0x72, 0x30, 0x34, 0xe9, 0xbe, 0xf5, 0xd0, 0x60, 0x58, 0xc5,
+		0x22, 0x1f, 0xe7, 0x12, 0xdf, 0xf1, 0x60, 0xef, 0x4e, 0xaa, 0xe2, 0xf1, 0x5e, 0xad, 0x3f, 0x9e,
+		0xde, 0x3f, 0xf9, 0xe4, 0x59, 0x37, 0xd2, 0xbe, 0x35, 0x0b, 0xfe, 0xd4, 0x60, 0xef, 0x4e, 0x12,
+		0x3d, 0x85, 0x1d, 0x4e, 0x70, 0xc2, 0x22, 0x75, 0x88, 0xda, 0xa1, 0x0e, 0x34, 0x58, 0x4c, 0x38,
+		0x16, 0x8c, 0x2b, 0x27, 0xcb, 0x3d, 0xfa, 0x09, 0x9a, 0x3e, 0x27, 0x58, 0x90, 0xc0, 0xcb, 0x2e,
+		0x5f, 0x79, 0x71, 0xea, 0xfd, 0xce, 0xc6, 0x15, 0x33, 0x29, 0x6e, 0x66, 0x57, 0x57, 0xf5, 0x59,
+		0xe4, 0xe4, 0x9f, 0x1a, 0x34, 0xd7, 0xdf, 0x6f, 0x65, 0xbb, 0x69, 0xd5, 0xed, 0x36, 0x01, 0xa3,
+		0x2c, 0x4d, 0x04, 0xe6, 0xc2, 0x2b, 0xaf, 0x7f, 0xa3, 0xf6, 0xa0, 0x8c, 0xa7, 0x05, 0x76, 0x9c,
+		0x41, 0xcb, 0x38, 0xba, 0x82, 0xa3, 0x92, 0x95, 0x7c, 0x8c, 0x29, 0x27, 0x6b, 0xb4, 0x0f, 0x3f,
+		0xdd, 0x61, 0x01, 0x76, 0x24, 0xf6, 0x96, 0xb7, 0x0f, 0x07, 0x3e, 0x5b, 0xc6, 0x21, 0xc9, 0xac,
+		0x4a, 0x16, 0x98, 0x07, 0x9e, 0xcf, 0xd2, 0x48, 0xc8, 0x4f, 0xc5, 0xb6, 0xbb, 0x5f, 0x26, 0xc7,
+		0x59, 0x6e, 0x98, 0xa5, 0xd0, 0x0b, 0x68, 0xc5, 0x24, 0x0a, 0x68, 0x34, 0xcf, 0x11, 0x89, 0xb1,
+		0x6d, 0xd6, 0xbb, 0xdb, 0xee, 0x9e, 0x8a, 0xca, 0xd2, 0xe4, 0xe5, 0x1f, 0x1a, 0x34, 0xd7, 0x3f,
+		0x4a, 0xe8, 0x08, 0x0e, 0xec, 0xdf, 0x7e, 0x1d, 0x8c, 0x2e, 0xbc, 0xf1, 0x64, 0x30, 0x79, 0x37,
+		0xf6, 0x46, 0x17, 0x57, 0x83, 0xf3, 0x91, 0xdd, 0xfe, 0x1f, 0xfa, 0x1c, 0x8c, 0xbb, 0x29, 0xd7,
+		0x79, 0x3b, 0x1a, 0x4f, 0x1c, 0xd7, 0xb1, 0xdb, 0xda, 0x66, 0xd6, 0x76, 0x2e, 0x5d, 0x67, 0x38,
+		0x98, 0x38, 0x76, 0xbb, 0xb6, 0x49, 0x6b, 0x3b, 0xe7, 0x4e, 0x96, 0xaa, 0xbf, 0x5c, 0x40, 0xeb,
+		0xde, 0x8d, 0xf7, 0x19, 0x1c, 0x0e, 0xdc, 0xe1, 0xcf, 0xa3, 0xab, 0xc1, 0x79, 0xa5, 0x8a, 0xfb,
+		0x49, 0x7b, 0x34, 0x1e, 0x9c, 0x9d, 0x4b, 0x15, 0x15, 0x50, 0xe7, 0x22, 0x4f, 0xd6, 0xce, 0xde,
+		0xc3, 0xa1, 0xcf, 0x96, 0x55, 0xad, 0x7e, 0xa6, 0xe7, 0x26, 0x5c, 0x66, 0x6f, 0xe5, 0x52, 0xfb,
+		0xfd, 0x74, 0x4e, 0xc5, 0x22, 0x9d, 0x5a, 0x3e, 0x5b, 0xf6, 0xd6, 0xff, 0x3f, 0xbe, 0xa2, 0x41,
+		0xd8, 0x9b, 0xb3, 0xfc, 0xaf, 0x41, 0xfd, 0x8c, 0xfc, 0x88, 0x63, 0xba, 0x3a, 0x9d, 0xee, 0xc8,
+		0xd8, 0x37, 0xff, 0x0e, 0x00, 0xb2, 0x01, 0x9f, 0x23, 0xb0, 0x08, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -3942,8 +4249,8 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0xb3, 0xa4, 0x83, 0x02, 0x7f, 0x39, 0xf3, 0x44, 0x7c, 0x8b, 0xb6, 0xcb, 0x9f, 0xbc, 0x41, 0x6f,
 		0xf8, 0x6f, 0xd2, 0x9f, 0x9e, 0xfd, 0xea, 0xe6, 0xcf, 0xe7, 0xdb, 0x85, 0x7a, 0xb6, 0xf6, 0x6e,
 		0x2e, 0x5f, 0x2f, 0xe6, 0xc2, 0x96, 0x75, 0x81, 0x99, 0x5a, 0x90, 0xe0, 0xf8, 0x78, 0x0e, 0x92,
-		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x07, 0x00,
-		0x00, 0xff, 0xff, 0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
+		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x0f, 0x00,
+		0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/workflow.proto
 	[]byte{
@@ -3960,8 +4267,7 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x30, 0x7f, 0x4f, 0x17, 0x01, 0x56, 0xac, 0x26, 0x06, 0x05, 0x85, 0x06, 0x80, 0x4c, 0x64, 0x73,
 		0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x09,
 		0x21, 0xbd, 0xf4, 0xd4, 0x3c, 0x7d, 0x70, 0x98, 0x20, 0x02, 0xcb, 0x1a, 0xc2, 0x2a, 0x33, 0x4c,
-		0x62, 0x03, 0xcb, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff, 0xc3, 0x37, 0x89, 0x56, 0x01,
-		0x00, 0x00,
+		0x62, 0x03, 0xcb, 0x18, 0x03, 0x06, 0x00, 0xff, 0xc3, 0x37, 0x89, 0x56, 0x01, 0x00, 0x00,
 	},
 }
 
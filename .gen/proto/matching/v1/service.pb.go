@@ -54,6 +54,7 @@ type PollForDecisionTaskRequest struct {
 	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	PollerId             string                         `protobuf:"bytes,3,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
 	ForwardedFrom        string                         `protobuf:"bytes,4,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	IsolationGroup       string                         `protobuf:"bytes,5,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
 	XXX_sizecache        int32                          `json:"-"`
@@ -120,6 +121,13 @@ func (m *PollForDecisionTaskRequest) GetForwardedFrom() string {
 	return ""
 }
 
+func (m *PollForDecisionTaskRequest) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
 type PollForDecisionTaskResponse struct {
 	TaskToken                 []byte                       `protobuf:"bytes,1,opt,name=task_token,json=taskToken,proto3" json:"task_token,omitempty"`
 	WorkflowExecution         *v1.WorkflowExecution        `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -300,6 +308,7 @@ type PollForActivityTaskRequest struct {
 	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	PollerId             string                         `protobuf:"bytes,3,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
 	ForwardedFrom        string                         `protobuf:"bytes,4,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	IsolationGroup       string                         `protobuf:"bytes,5,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
 	XXX_sizecache        int32                          `json:"-"`
@@ -366,6 +375,13 @@ func (m *PollForActivityTaskRequest) GetForwardedFrom() string {
 	return ""
 }
 
+func (m *PollForActivityTaskRequest) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
 type PollForActivityTaskResponse struct {
 	TaskToken                  []byte                `protobuf:"bytes,1,opt,name=task_token,json=taskToken,proto3" json:"task_token,omitempty"`
 	WorkflowExecution          *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -541,6 +557,9 @@ type AddDecisionTaskRequest struct {
 	ScheduleToStartTimeout *types.Duration       `protobuf:"bytes,5,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
 	Source                 v11.TaskSource        `protobuf:"varint,6,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom          string                `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	IsolationGroup         string                `protobuf:"bytes,8,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
+	Priority               int32                 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey            string                `protobuf:"bytes,10,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
 	XXX_unrecognized       []byte                `json:"-"`
 	XXX_sizecache          int32                 `json:"-"`
@@ -628,6 +647,27 @@ func (m *AddDecisionTaskRequest) GetForwardedFrom() string {
 	return ""
 }
 
+func (m *AddDecisionTaskRequest) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
+func (m *AddDecisionTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddDecisionTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type AddDecisionTaskResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -677,6 +717,9 @@ type AddActivityTaskRequest struct {
 	Source                   v11.TaskSource            `protobuf:"varint,7,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom            string                    `protobuf:"bytes,8,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	ActivityTaskDispatchInfo *ActivityTaskDispatchInfo `protobuf:"bytes,9,opt,name=activityTaskDispatchInfo,proto3" json:"activityTaskDispatchInfo,omitempty"`
+	IsolationGroup           string                    `protobuf:"bytes,10,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
+	Priority                 int32                     `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey              string                    `protobuf:"bytes,12,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{}                  `json:"-"`
 	XXX_unrecognized         []byte                    `json:"-"`
 	XXX_sizecache            int32                     `json:"-"`
@@ -778,6 +821,27 @@ func (m *AddActivityTaskRequest) GetActivityTaskDispatchInfo() *ActivityTaskDisp
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
 	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
@@ -1314,11 +1378,12 @@ func (m *DescribeTaskListRequest) GetDomainId() string {
 }
 
 type DescribeTaskListResponse struct {
-	Pollers              []*v1.PollerInfo   `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
-	TaskListStatus       *v1.TaskListStatus `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Pollers              []*v1.PollerInfo         `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus       *v1.TaskListStatus       `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	PartitionConfig      *TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
@@ -1368,6 +1433,13 @@ func (m *DescribeTaskListResponse) GetTaskListStatus() *v1.TaskListStatus {
 	return nil
 }
 
+func (m *DescribeTaskListResponse) GetPartitionConfig() *TaskListPartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
 type ListTaskListPartitionsRequest struct {
 	Domain               string       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	TaskList             *v1.TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
@@ -1580,6 +1652,69 @@ func (m *GetTaskListsByDomainResponse) GetActivityTaskListMap() map[string]*Desc
 	return nil
 }
 
+type TaskListPartitionConfig struct {
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	NumReadPartitions    int32    `protobuf:"varint,2,opt,name=num_read_partitions,json=numReadPartitions,proto3" json:"num_read_partitions,omitempty"`
+	NumWritePartitions   int32    `protobuf:"varint,3,opt,name=num_write_partitions,json=numWritePartitions,proto3" json:"num_write_partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskListPartitionConfig) Reset()         { *m = TaskListPartitionConfig{} }
+func (m *TaskListPartitionConfig) String() string { return proto.CompactTextString(m) }
+func (*TaskListPartitionConfig) ProtoMessage()    {}
+func (*TaskListPartitionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{21}
+}
+func (m *TaskListPartitionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListPartitionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListPartitionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskListPartitionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListPartitionConfig.Merge(m, src)
+}
+func (m *TaskListPartitionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListPartitionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListPartitionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListPartitionConfig proto.InternalMessageInfo
+
+func (m *TaskListPartitionConfig) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TaskListPartitionConfig) GetNumReadPartitions() int32 {
+	if m != nil {
+		return m.NumReadPartitions
+	}
+	return 0
+}
+
+func (m *TaskListPartitionConfig) GetNumWritePartitions() int32 {
+	if m != nil {
+		return m.NumWritePartitions
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PollForDecisionTaskRequest)(nil), "uber.cadence.matching.v1.PollForDecisionTaskRequest")
 	proto.RegisterType((*PollForDecisionTaskResponse)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse")
@@ -1605,6 +1740,7 @@ func init() {
 	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse")
 	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.ActivityTaskListMapEntry")
 	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.DecisionTaskListMapEntry")
+	proto.RegisterType((*TaskListPartitionConfig)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig")
 }
 
 func init() {
@@ -1612,133 +1748,143 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2004 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0xc6, 0xea, 0x46, 0xf1, 0x50, 0xa2, 0xe5, 0x71, 0x22, 0xaf, 0x28, 0xeb, 0x62, 0xa6, 0x49,
-	0xd5, 0x22, 0x5d, 0x55, 0x4a, 0xec, 0x3a, 0x0e, 0x8a, 0x42, 0x96, 0x2c, 0x9b, 0x45, 0x5d, 0x3b,
-	0x6b, 0xd5, 0x05, 0x8a, 0xc2, 0x8b, 0xd1, 0xee, 0x48, 0xdc, 0x8a, 0xdc, 0x5d, 0xef, 0x0c, 0xa9,
-	0xb0, 0x0f, 0x7d, 0x28, 0xd2, 0xa2, 0x40, 0x5e, 0xfb, 0x0b, 0xda, 0x3c, 0xf6, 0x27, 0xf4, 0x07,
-	0xe4, 0xb1, 0xef, 0x41, 0x81, 0xc2, 0x40, 0xff, 0x47, 0x30, 0x97, 0x5d, 0xee, 0x92, 0xb3, 0x14,
-	0x29, 0x39, 0xf1, 0x1b, 0x77, 0xe6, 0x9c, 0xef, 0xdc, 0xe6, 0x5c, 0x66, 0x08, 0x1f, 0x74, 0x8e,
-	0x49, 0xbc, 0xed, 0x62, 0x8f, 0x04, 0x2e, 0xd9, 0x6e, 0x63, 0xe6, 0x36, 0xfd, 0xe0, 0x74, 0xbb,
-	0xbb, 0xb3, 0x4d, 0x49, 0xdc, 0xf5, 0x5d, 0x62, 0x45, 0x71, 0xc8, 0x42, 0x64, 0x72, 0x3a, 0x4b,
-	0xd1, 0x59, 0x09, 0x9d, 0xd5, 0xdd, 0xa9, 0xad, 0x9f, 0x86, 0xe1, 0x69, 0x8b, 0x6c, 0x0b, 0xba,
-	0xe3, 0xce, 0xc9, 0xb6, 0xd7, 0x89, 0x31, 0xf3, 0xc3, 0x40, 0x72, 0xd6, 0x36, 0x06, 0xf7, 0x99,
-	0xdf, 0x26, 0x94, 0xe1, 0x76, 0xa4, 0x08, 0x86, 0x00, 0xce, 0x63, 0x1c, 0x45, 0x24, 0xa6, 0x6a,
-	0x7f, 0x33, 0xa7, 0x22, 0x8e, 0x7c, 0xae, 0x9d, 0x1b, 0xb6, 0xdb, 0x7d, 0x11, 0x3a, 0x8a, 0x57,
-	0x1d, 0x12, 0xf7, 0x14, 0x41, 0x5d, 0x47, 0xc0, 0x30, 0x3d, 0x6b, 0xf9, 0x94, 0x29, 0x9a, 0x2d,
-	0x1d, 0x8d, 0x72, 0x82, 0x73, 0x1e, 0xc6, 0x67, 0x24, 0x56, 0x94, 0x3f, 0xbe, 0x88, 0xf2, 0xa4,
-	0x15, 0x9e, 0x2b, 0xda, 0xdb, 0x3a, 0xda, 0xa6, 0x4f, 0x59, 0x98, 0x2a, 0xf7, 0x83, 0x1c, 0x09,
-	0x6d, 0xe2, 0x98, 0x78, 0xc3, 0x54, 0xef, 0x17, 0x50, 0xe5, 0xad, 0xa8, 0x7f, 0x6d, 0x40, 0xed,
-	0x59, 0xd8, 0x6a, 0x1d, 0x86, 0xf1, 0x01, 0x71, 0x7d, 0xea, 0x87, 0xc1, 0x11, 0xa6, 0x67, 0x36,
-	0x79, 0xd5, 0x21, 0x94, 0xa1, 0x06, 0x94, 0x62, 0xf9, 0xd3, 0x34, 0x36, 0x8d, 0xad, 0xca, 0xee,
-	0xb6, 0x95, 0x0b, 0x2c, 0x8e, 0x7c, 0xab, 0xbb, 0x63, 0x15, 0x23, 0xd8, 0x09, 0x3f, 0x5a, 0x85,
-	0xb2, 0x17, 0xb6, 0xb1, 0x1f, 0x38, 0xbe, 0x67, 0x4e, 0x6d, 0x1a, 0x5b, 0x65, 0x7b, 0x5e, 0x2e,
-	0x34, 0x3c, 0xbe, 0x19, 0x85, 0xad, 0x16, 0x89, 0xf9, 0xe6, 0xb4, 0xdc, 0x94, 0x0b, 0x0d, 0x0f,
-	0xbd, 0x0f, 0xd5, 0x93, 0x30, 0x3e, 0xc7, 0xb1, 0x47, 0x3c, 0xe7, 0x24, 0x0e, 0xdb, 0xe6, 0x8c,
-	0xa0, 0x58, 0x4c, 0x57, 0x0f, 0xe3, 0xb0, 0x5d, 0xff, 0xa2, 0x0c, 0xab, 0x5a, 0x45, 0x68, 0x14,
-	0x06, 0x94, 0xa0, 0x35, 0x00, 0x6e, 0xbc, 0xc3, 0xc2, 0x33, 0x12, 0x08, 0x73, 0x16, 0xec, 0x32,
-	0x5f, 0x39, 0xe2, 0x0b, 0xe8, 0x37, 0x80, 0x92, 0x58, 0x38, 0xe4, 0x73, 0xe2, 0x76, 0xf8, 0x99,
-	0x14, 0x8a, 0x56, 0x76, 0x3f, 0xd0, 0x5a, 0xfd, 0x5b, 0x45, 0xfe, 0x30, 0xa1, 0xb6, 0xaf, 0x9f,
-	0x0f, 0x2e, 0xa1, 0x43, 0x58, 0x4c, 0x61, 0x59, 0x2f, 0x22, 0xc2, 0xba, 0xca, 0xee, 0xed, 0x91,
-	0x88, 0x47, 0xbd, 0x88, 0xd8, 0x0b, 0xe7, 0x99, 0x2f, 0xf4, 0x02, 0x56, 0xa2, 0x98, 0x74, 0xfd,
-	0xb0, 0x43, 0x1d, 0xca, 0x70, 0xcc, 0x88, 0xe7, 0x90, 0x2e, 0x09, 0x18, 0xf7, 0xd8, 0x8c, 0xc0,
-	0x5c, 0xb5, 0x64, 0x66, 0x58, 0x49, 0x66, 0x58, 0x8d, 0x80, 0xdd, 0xfd, 0xf8, 0x05, 0x6e, 0x75,
-	0x88, 0xbd, 0x9c, 0x70, 0x3f, 0x97, 0xcc, 0x0f, 0x39, 0x6f, 0xc3, 0x43, 0x5b, 0xb0, 0x34, 0x04,
-	0x37, 0xbb, 0x69, 0x6c, 0x4d, 0xdb, 0x55, 0x9a, 0xa7, 0x34, 0xa1, 0x84, 0x19, 0x23, 0xed, 0x88,
-	0x99, 0x73, 0x9b, 0xc6, 0xd6, 0xac, 0x9d, 0x7c, 0xa2, 0x3a, 0x2c, 0x06, 0xe4, 0x73, 0xd6, 0x07,
-	0x28, 0x09, 0x80, 0x0a, 0x5f, 0x4c, 0xb8, 0x3f, 0x04, 0x74, 0x8c, 0xdd, 0xb3, 0x56, 0x78, 0xea,
-	0xb8, 0x61, 0x27, 0x60, 0x4e, 0xd3, 0x0f, 0x98, 0x39, 0x2f, 0x08, 0x97, 0xd4, 0xce, 0x3e, 0xdf,
-	0x78, 0xec, 0x07, 0x0c, 0xdd, 0x03, 0x93, 0x32, 0xdf, 0x3d, 0xeb, 0xf5, 0x43, 0xe1, 0x90, 0x00,
-	0x1f, 0xb7, 0x88, 0x67, 0x96, 0x37, 0x8d, 0xad, 0x79, 0x7b, 0x59, 0xee, 0xa7, 0x8e, 0x7e, 0x28,
-	0x77, 0xd1, 0x3d, 0x98, 0x15, 0x99, 0x6c, 0x82, 0xf0, 0x49, 0x7d, 0xa4, 0x9f, 0x3f, 0xe3, 0x94,
-	0xb6, 0x64, 0x40, 0x36, 0x2c, 0x7a, 0xea, 0xdc, 0x38, 0x7e, 0x70, 0x12, 0x9a, 0x15, 0x81, 0xf0,
-	0x93, 0x3c, 0x82, 0xcc, 0x24, 0x0e, 0x72, 0x14, 0xe3, 0x80, 0xfa, 0x24, 0x60, 0xc9, 0x69, 0x6b,
-	0x04, 0x27, 0xa1, 0xbd, 0xe0, 0x65, 0xbe, 0xd0, 0x4b, 0xb8, 0x35, 0x7c, 0xa8, 0x1c, 0x71, 0x0c,
-	0x79, 0x12, 0x9a, 0x0b, 0x42, 0xc4, 0x9a, 0x56, 0x49, 0x7e, 0x78, 0x7f, 0xe5, 0x53, 0x66, 0xaf,
-	0x0c, 0x9d, 0xaa, 0x64, 0x0b, 0x59, 0x70, 0x43, 0x3a, 0x9d, 0xa7, 0x3e, 0x71, 0xba, 0x24, 0xe6,
-	0xa2, 0xcd, 0x45, 0x11, 0x9f, 0xeb, 0x62, 0xeb, 0x39, 0xdf, 0x79, 0x21, 0x37, 0xd0, 0x6d, 0x58,
-	0x38, 0x8e, 0x71, 0xe0, 0x36, 0x55, 0x16, 0x54, 0x45, 0x16, 0x54, 0xe4, 0x9a, 0xcc, 0x83, 0x3d,
-	0xa8, 0x52, 0xb7, 0x49, 0xbc, 0x4e, 0x8b, 0x78, 0x0e, 0xaf, 0xbd, 0xe6, 0x35, 0xa1, 0x64, 0x6d,
-	0xe8, 0x74, 0x1d, 0x25, 0x85, 0xd9, 0x5e, 0x4c, 0x39, 0xf8, 0x1a, 0xfa, 0x39, 0x2c, 0x24, 0x67,
-	0x4a, 0x00, 0x2c, 0x5d, 0x08, 0x50, 0x51, 0xf4, 0x82, 0xfd, 0xf7, 0x50, 0xe2, 0x11, 0xf1, 0x09,
-	0x35, 0xaf, 0x6f, 0x4e, 0x6f, 0x55, 0x76, 0x1f, 0x58, 0x45, 0xdd, 0xc4, 0x1a, 0x91, 0xf0, 0xd6,
-	0x67, 0x12, 0xe4, 0x61, 0xc0, 0xe2, 0x9e, 0x9d, 0x40, 0xd6, 0x5e, 0xc2, 0x42, 0x76, 0x03, 0x2d,
-	0xc1, 0xf4, 0x19, 0xe9, 0x89, 0x7a, 0x50, 0xb6, 0xf9, 0x4f, 0x7e, 0x84, 0xba, 0x3c, 0x67, 0x54,
-	0xf2, 0x8f, 0x75, 0x84, 0x04, 0xc3, 0xfd, 0xa9, 0x7b, 0x46, 0xb6, 0xa2, 0xee, 0xb9, 0xcc, 0xef,
-	0xfa, 0xac, 0x77, 0xf9, 0x8a, 0xaa, 0x41, 0xf8, 0x1e, 0x2b, 0xea, 0x97, 0xf3, 0x69, 0x45, 0xcd,
-	0x2b, 0xf2, 0x56, 0x2b, 0xea, 0x06, 0x54, 0xb0, 0xd2, 0xa6, 0x6f, 0x1b, 0x24, 0x4b, 0x0d, 0x8f,
-	0x97, 0xdc, 0x94, 0x40, 0x94, 0xdc, 0x99, 0x11, 0x25, 0x37, 0x35, 0x4c, 0x94, 0x5c, 0x9c, 0xf9,
-	0x42, 0xbb, 0x30, 0xeb, 0x07, 0x51, 0x87, 0x89, 0x7a, 0x58, 0xd9, 0xbd, 0xa5, 0x0f, 0x14, 0xee,
-	0xb5, 0x42, 0xec, 0xd9, 0x92, 0x54, 0x93, 0x3d, 0x73, 0x57, 0xcd, 0x9e, 0xd2, 0x64, 0xd9, 0x73,
-	0x04, 0x2b, 0x09, 0x9e, 0xc3, 0x42, 0xc7, 0x6d, 0x85, 0x94, 0x08, 0xa0, 0xb0, 0x23, 0xeb, 0x6d,
-	0x65, 0x77, 0x65, 0x08, 0xeb, 0x40, 0xcd, 0x60, 0xf6, 0x72, 0xc2, 0x7b, 0x14, 0xee, 0x73, 0xce,
-	0x23, 0xc9, 0x88, 0x7e, 0x0d, 0xcb, 0x42, 0xc8, 0x30, 0x64, 0xf9, 0x22, 0xc8, 0x1b, 0x82, 0x71,
-	0x00, 0xef, 0x10, 0xae, 0x37, 0x09, 0x8e, 0xd9, 0x31, 0xc1, 0x2c, 0x85, 0x82, 0x8b, 0xa0, 0x96,
-	0x52, 0x9e, 0x04, 0x27, 0xd3, 0x94, 0x2a, 0xf9, 0xa6, 0xf4, 0x12, 0xd6, 0xf3, 0x91, 0x70, 0xc2,
-	0x13, 0x87, 0x35, 0x7d, 0xea, 0x24, 0x0c, 0x0b, 0x17, 0x3a, 0xb6, 0x96, 0x8b, 0xcc, 0xd3, 0x93,
-	0xa3, 0xa6, 0x4f, 0xf7, 0x14, 0x7e, 0x23, 0x6b, 0x81, 0x47, 0x18, 0xf6, 0x5b, 0x54, 0x14, 0xde,
-	0x8b, 0x4e, 0x4a, 0xdf, 0x88, 0x03, 0xc9, 0x35, 0x3c, 0x23, 0x54, 0x2f, 0x37, 0x23, 0xfc, 0x10,
-	0xae, 0xa5, 0x38, 0xb2, 0x10, 0x88, 0xda, 0x5d, 0xb6, 0xab, 0xc9, 0xf2, 0x81, 0x58, 0x45, 0x1f,
-	0xc1, 0x5c, 0x93, 0x60, 0x8f, 0xc4, 0xaa, 0x34, 0xaf, 0x6a, 0x25, 0x3d, 0x16, 0x24, 0xb6, 0x22,
-	0xad, 0xff, 0x63, 0x1a, 0x96, 0xf7, 0x3c, 0x4f, 0x37, 0x26, 0xe6, 0x2a, 0x91, 0x31, 0x50, 0x89,
-	0xbe, 0xa3, 0x32, 0x70, 0x1f, 0xca, 0xfd, 0x3e, 0x3a, 0x3d, 0x4e, 0x1f, 0x9d, 0x67, 0x49, 0xdb,
-	0xdc, 0x80, 0x4a, 0x9a, 0x23, 0x6a, 0x7c, 0x9a, 0xb6, 0x21, 0x59, 0x6a, 0x78, 0x83, 0x49, 0xa4,
-	0x8e, 0xbe, 0x3a, 0xa6, 0xb3, 0x13, 0x24, 0x91, 0x98, 0xb6, 0x92, 0xc3, 0x7a, 0x1f, 0xe6, 0x68,
-	0xd8, 0x89, 0x5d, 0x59, 0x14, 0xaa, 0x83, 0x9d, 0x25, 0x33, 0x5a, 0x60, 0x7a, 0xf6, 0x5c, 0x50,
-	0xda, 0x8a, 0x43, 0x53, 0xb2, 0x4b, 0xba, 0x92, 0xbd, 0x02, 0x37, 0x87, 0x62, 0x24, 0xab, 0x75,
-	0xfd, 0xdf, 0x33, 0x22, 0x7e, 0xba, 0xa6, 0xf4, 0x36, 0xe2, 0xc7, 0x07, 0x4f, 0x61, 0x9a, 0xd3,
-	0x17, 0x2d, 0x6b, 0x79, 0x55, 0xae, 0x1f, 0x24, 0x0a, 0xe4, 0x22, 0x3d, 0x73, 0xa5, 0x48, 0xcf,
-	0x4e, 0x16, 0xe9, 0xb9, 0xab, 0x47, 0xba, 0xf4, 0x06, 0x22, 0x3d, 0xaf, 0x89, 0x34, 0x0a, 0xc0,
-	0xc4, 0x99, 0x50, 0x1e, 0xf8, 0x34, 0xe2, 0x73, 0x11, 0x1f, 0x3b, 0x55, 0x4d, 0xde, 0x2d, 0x1e,
-	0x9b, 0xf6, 0x0a, 0x38, 0xed, 0x42, 0xcc, 0xfa, 0x37, 0xd3, 0x60, 0x16, 0xb1, 0xa1, 0x5f, 0xc2,
-	0xb5, 0x7e, 0xb1, 0x15, 0x63, 0xa7, 0x9a, 0x6e, 0xf4, 0x35, 0xec, 0xb1, 0xbc, 0xaa, 0x8a, 0xbb,
-	0x81, 0xdd, 0x6f, 0x98, 0xe2, 0x7b, 0xa8, 0xff, 0x4d, 0x4d, 0xd6, 0xff, 0x32, 0x1d, 0x61, 0x7a,
-	0xd2, 0x8e, 0x30, 0xf3, 0xe6, 0x3b, 0xc2, 0xec, 0x9b, 0xe9, 0x08, 0x73, 0x6f, 0xac, 0x23, 0x94,
-	0x74, 0x1d, 0x41, 0xd5, 0x0d, 0xdd, 0x94, 0x57, 0xff, 0xc6, 0x80, 0x77, 0xc4, 0x94, 0x9b, 0xc8,
-	0x49, 0xaa, 0xc6, 0xfe, 0xe0, 0x28, 0xfb, 0x23, 0xad, 0x7a, 0x3a, 0xde, 0x31, 0x87, 0xd8, 0xab,
-	0xd4, 0xf8, 0x31, 0x67, 0xdc, 0x7f, 0x1a, 0xf0, 0xee, 0x80, 0x86, 0x6a, 0xba, 0xfd, 0x05, 0x2c,
-	0x88, 0x8b, 0xa1, 0x13, 0x13, 0xda, 0x69, 0x25, 0x36, 0x8e, 0x8e, 0x64, 0x45, 0x70, 0xd8, 0x82,
-	0x01, 0x35, 0xa0, 0x9a, 0x00, 0xfc, 0x81, 0xb8, 0x8c, 0x78, 0x23, 0x2f, 0x14, 0xf2, 0x22, 0xa1,
-	0x28, 0xed, 0xc5, 0x57, 0xd9, 0xcf, 0xfa, 0xff, 0x0d, 0xd8, 0x94, 0x8a, 0x79, 0x82, 0x8e, 0xdb,
-	0xbb, 0x1f, 0xb6, 0xa3, 0x16, 0xe1, 0xc4, 0xca, 0x95, 0x4f, 0x07, 0xe3, 0x71, 0x47, 0x2b, 0xe8,
-	0x22, 0x9c, 0xef, 0x21, 0x36, 0x37, 0xa1, 0x24, 0x78, 0x55, 0xef, 0x2d, 0xdb, 0x73, 0xfc, 0xb3,
-	0xe1, 0xd5, 0xdf, 0x83, 0xdb, 0x23, 0xd4, 0x53, 0x07, 0xf2, 0xbf, 0x06, 0xdc, 0xda, 0xc7, 0x81,
-	0x4b, 0x5a, 0x4f, 0x3b, 0x8c, 0x32, 0x1c, 0x78, 0x7e, 0x70, 0xca, 0xef, 0x29, 0x63, 0xb5, 0xb3,
-	0xdc, 0xc5, 0x68, 0x6a, 0xe0, 0x62, 0xf4, 0x08, 0xaa, 0xa9, 0x51, 0xfd, 0xe7, 0x9a, 0x6a, 0x41,
-	0xe2, 0x25, 0x96, 0xc9, 0xc4, 0x63, 0x99, 0xaf, 0xab, 0xf4, 0xac, 0xfa, 0x06, 0xac, 0x15, 0x98,
-	0xa7, 0x1c, 0xf0, 0x27, 0xb8, 0x79, 0x40, 0xa8, 0x1b, 0xfb, 0xc7, 0x24, 0x65, 0x57, 0xa6, 0x1f,
-	0x0e, 0x9e, 0x81, 0x0f, 0xb5, 0x52, 0x0b, 0xd8, 0xc7, 0x0b, 0x7d, 0xfd, 0x2b, 0x03, 0xcc, 0x61,
-	0x04, 0x95, 0x36, 0x9f, 0x40, 0x49, 0xba, 0x93, 0x9a, 0x86, 0xb8, 0xbd, 0x6f, 0x14, 0x5e, 0x70,
-	0x49, 0x2c, 0x7a, 0x4e, 0x42, 0x8f, 0x9e, 0xc0, 0x52, 0xdf, 0xfb, 0x94, 0x61, 0xd6, 0xa1, 0x2a,
-	0x65, 0xde, 0x1b, 0xe9, 0xbb, 0xe7, 0x82, 0xd4, 0xae, 0xb2, 0xdc, 0x77, 0x9d, 0xc2, 0x9a, 0x88,
-	0x87, 0x5a, 0x7d, 0x86, 0x63, 0xe6, 0xf3, 0xae, 0x4d, 0x13, 0x67, 0x2d, 0xc3, 0x9c, 0x2a, 0x8a,
-	0xf2, 0x90, 0xa8, 0xaf, 0x7c, 0xf0, 0xa6, 0x26, 0x0b, 0xde, 0x5f, 0xa7, 0x60, 0xbd, 0x48, 0xaa,
-	0xf2, 0xd0, 0x2b, 0x58, 0xeb, 0xdf, 0x4f, 0x53, 0x7b, 0xa3, 0x94, 0x50, 0xf9, 0xcd, 0x1a, 0x29,
-	0x32, 0xc5, 0x7d, 0x42, 0x18, 0xf6, 0x30, 0xc3, 0x76, 0x2d, 0xdb, 0xba, 0xf3, 0xa2, 0xb9, 0xc8,
-	0xf4, 0x6d, 0x4b, 0x2b, 0x72, 0xea, 0x72, 0x22, 0xbd, 0xcc, 0xa0, 0x99, 0x17, 0x59, 0xbf, 0x03,
-	0xab, 0x8f, 0x48, 0xea, 0x06, 0xfa, 0xa0, 0x27, 0x3b, 0xcd, 0x05, 0xbe, 0xaf, 0x7f, 0x35, 0x03,
-	0xb7, 0xf4, 0x7c, 0xca, 0x7b, 0x5f, 0x18, 0xb0, 0xac, 0xb1, 0xa5, 0x8d, 0x23, 0xe5, 0xb7, 0xa7,
-	0xc5, 0x63, 0xcf, 0x28, 0x60, 0xeb, 0x60, 0xc0, 0x96, 0x27, 0x38, 0x92, 0x4f, 0x47, 0x37, 0xbc,
-	0xe1, 0x1d, 0xa1, 0x86, 0x26, 0x8a, 0x5c, 0x8d, 0xa9, 0x2b, 0xa9, 0xb1, 0x37, 0x10, 0xc5, 0xbe,
-	0x1a, 0x78, 0x78, 0xa7, 0xf6, 0x47, 0x9e, 0x89, 0x7a, 0xbd, 0x35, 0x2f, 0x5b, 0x8f, 0xf3, 0x2f,
-	0x5b, 0x23, 0x06, 0xc4, 0xa2, 0xf4, 0xce, 0xbc, 0x74, 0x71, 0xd9, 0x45, 0xca, 0x7e, 0xd7, 0xb2,
-	0x77, 0xff, 0x05, 0x50, 0x79, 0xa2, 0x78, 0xf6, 0x9e, 0x35, 0xd0, 0x9f, 0x0d, 0xb8, 0xa1, 0x79,
-	0x0b, 0x44, 0x1f, 0x4f, 0xf8, 0x74, 0x28, 0x0e, 0x67, 0xed, 0xce, 0xa5, 0x1e, 0x1c, 0xb3, 0x4a,
-	0x64, 0x1d, 0x33, 0x86, 0x12, 0x9a, 0x4b, 0xd9, 0x18, 0x4a, 0x68, 0x1f, 0xe5, 0xba, 0x70, 0x6d,
-	0xe0, 0x06, 0x88, 0x7e, 0x3a, 0xe2, 0x22, 0xa0, 0xbd, 0xd0, 0xd7, 0x76, 0x26, 0xe0, 0xc8, 0xc9,
-	0xcd, 0xd9, 0x3d, 0x5a, 0xae, 0xce, 0xe6, 0x9d, 0x09, 0x38, 0x94, 0xdc, 0x08, 0x16, 0x73, 0xf3,
-	0x1b, 0xb2, 0x8a, 0x31, 0x74, 0xa3, 0x68, 0x6d, 0x7b, 0x6c, 0x7a, 0x25, 0xf1, 0xef, 0x06, 0xac,
-	0x14, 0x4e, 0x29, 0xe8, 0x7e, 0x31, 0xdc, 0x45, 0x93, 0x57, 0xed, 0xd3, 0x4b, 0xf1, 0x2a, 0xb5,
-	0xfe, 0x66, 0xc0, 0xbb, 0xda, 0xb9, 0x01, 0xdd, 0x2d, 0x86, 0x1d, 0x35, 0x47, 0xd5, 0x7e, 0x36,
-	0x31, 0x9f, 0x52, 0xa5, 0x07, 0x4b, 0x83, 0x49, 0x8c, 0x76, 0x26, 0x49, 0x78, 0x29, 0xff, 0x12,
-	0x35, 0x02, 0x7d, 0x69, 0xc0, 0xb2, 0xbe, 0xff, 0xa2, 0x11, 0xe6, 0x8c, 0x9c, 0x13, 0x6a, 0xf7,
-	0x26, 0x67, 0x54, 0xda, 0xfc, 0xc5, 0x80, 0x77, 0x74, 0xd5, 0x1e, 0xdd, 0x99, 0xb4, 0x3b, 0x48,
-	0x4d, 0xee, 0x5e, 0xae, 0xa9, 0x3c, 0x78, 0xf4, 0xf5, 0xeb, 0x75, 0xe3, 0x3f, 0xaf, 0xd7, 0x8d,
-	0xff, 0xbd, 0x5e, 0x37, 0x7e, 0xf7, 0xc9, 0xa9, 0xcf, 0x9a, 0x9d, 0x63, 0xcb, 0x0d, 0xdb, 0xdb,
-	0xb9, 0xbf, 0x89, 0xad, 0x53, 0x12, 0xc8, 0xff, 0xd5, 0xb3, 0x7f, 0xed, 0x7f, 0x9a, 0xfc, 0xee,
-	0xee, 0x1c, 0xcf, 0x89, 0xdd, 0x8f, 0xbe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x69, 0x09, 0x08, 0xb6,
-	0x08, 0x20, 0x00, 0x00,
+	// 2164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xc7, 0x52, 0xa2, 0x28, 0x3e, 0x52, 0x94, 0x3c, 0x4e, 0xe4, 0x15, 0x65, 0xcb, 0x32, 0xd3,
+	0x24, 0x6a, 0x91, 0x52, 0x11, 0x13, 0xbb, 0x8e, 0x83, 0xa2, 0x90, 0x25, 0xcb, 0x66, 0x5a, 0xd7,
+	0xce, 0x5a, 0x75, 0x80, 0x22, 0xf0, 0x62, 0xb4, 0x3b, 0x14, 0xb7, 0x22, 0x77, 0xd7, 0x3b, 0xb3,
+	0x54, 0xd8, 0x43, 0x0f, 0x45, 0x5a, 0x04, 0xcd, 0xb5, 0xfd, 0x04, 0xcd, 0xb1, 0x1f, 0xa4, 0xc7,
+	0xde, 0x83, 0x02, 0x85, 0x81, 0x7e, 0x80, 0x7e, 0x83, 0x62, 0x66, 0x67, 0x97, 0x5c, 0x72, 0x96,
+	0x7f, 0x24, 0xa7, 0xe9, 0x8d, 0x3b, 0xf3, 0xde, 0xef, 0xbd, 0x79, 0xff, 0x67, 0x40, 0x78, 0x27,
+	0x3c, 0x21, 0xc1, 0xae, 0x85, 0x6d, 0xe2, 0x5a, 0x64, 0xb7, 0x8b, 0x99, 0xd5, 0x76, 0xdc, 0xd3,
+	0xdd, 0xde, 0xde, 0x2e, 0x25, 0x41, 0xcf, 0xb1, 0x48, 0xdd, 0x0f, 0x3c, 0xe6, 0x21, 0x9d, 0xd3,
+	0xd5, 0x25, 0x5d, 0x3d, 0xa6, 0xab, 0xf7, 0xf6, 0xaa, 0x5b, 0xa7, 0x9e, 0x77, 0xda, 0x21, 0xbb,
+	0x82, 0xee, 0x24, 0x6c, 0xed, 0xda, 0x61, 0x80, 0x99, 0xe3, 0xb9, 0x11, 0x67, 0xf5, 0xe6, 0xe8,
+	0x3e, 0x73, 0xba, 0x84, 0x32, 0xdc, 0xf5, 0x25, 0xc1, 0x18, 0xc0, 0x79, 0x80, 0x7d, 0x9f, 0x04,
+	0x54, 0xee, 0x6f, 0xa7, 0x54, 0xc4, 0xbe, 0xc3, 0xb5, 0xb3, 0xbc, 0x6e, 0x77, 0x20, 0x42, 0x45,
+	0xf1, 0x32, 0x24, 0x41, 0x5f, 0x12, 0xd4, 0x54, 0x04, 0x0c, 0xd3, 0xb3, 0x8e, 0x43, 0x99, 0xa4,
+	0xd9, 0x51, 0xd1, 0x48, 0x23, 0x98, 0xe7, 0x5e, 0x70, 0x46, 0x02, 0x49, 0xf9, 0xa3, 0x69, 0x94,
+	0xad, 0x8e, 0x77, 0x2e, 0x69, 0x6f, 0xa9, 0x68, 0xdb, 0x0e, 0x65, 0x5e, 0xa2, 0xdc, 0x0f, 0x52,
+	0x24, 0xb4, 0x8d, 0x03, 0x62, 0x8f, 0x53, 0xbd, 0x9d, 0x41, 0x95, 0x3e, 0x45, 0xed, 0x3f, 0x1a,
+	0x54, 0x9f, 0x7a, 0x9d, 0xce, 0x91, 0x17, 0x1c, 0x12, 0xcb, 0xa1, 0x8e, 0xe7, 0x1e, 0x63, 0x7a,
+	0x66, 0x90, 0x97, 0x21, 0xa1, 0x0c, 0x35, 0xa1, 0x10, 0x44, 0x3f, 0x75, 0x6d, 0x5b, 0xdb, 0x29,
+	0x35, 0x76, 0xeb, 0x29, 0xc7, 0x62, 0xdf, 0xa9, 0xf7, 0xf6, 0xea, 0xd9, 0x08, 0x46, 0xcc, 0x8f,
+	0x36, 0xa1, 0x68, 0x7b, 0x5d, 0xec, 0xb8, 0xa6, 0x63, 0xeb, 0xb9, 0x6d, 0x6d, 0xa7, 0x68, 0x2c,
+	0x47, 0x0b, 0x4d, 0x9b, 0x6f, 0xfa, 0x5e, 0xa7, 0x43, 0x02, 0xbe, 0xb9, 0x10, 0x6d, 0x46, 0x0b,
+	0x4d, 0x1b, 0xbd, 0x0d, 0x95, 0x96, 0x17, 0x9c, 0xe3, 0xc0, 0x26, 0xb6, 0xd9, 0x0a, 0xbc, 0xae,
+	0xbe, 0x28, 0x28, 0x56, 0x92, 0xd5, 0xa3, 0xc0, 0xeb, 0xa2, 0x77, 0x61, 0xd5, 0xa1, 0x5e, 0x47,
+	0xc4, 0x92, 0x79, 0x1a, 0x78, 0xa1, 0xaf, 0xe7, 0x05, 0x5d, 0x25, 0x59, 0x7e, 0xc8, 0x57, 0x6b,
+	0x5f, 0x16, 0x61, 0x53, 0xa9, 0x31, 0xf5, 0x3d, 0x97, 0x12, 0x74, 0x03, 0x80, 0x5b, 0xc9, 0x64,
+	0xde, 0x19, 0x71, 0xc5, 0xb9, 0xcb, 0x46, 0x91, 0xaf, 0x1c, 0xf3, 0x05, 0xf4, 0x2b, 0x40, 0xb1,
+	0xd3, 0x4c, 0xf2, 0x05, 0xb1, 0x42, 0x8e, 0x2c, 0x4e, 0x54, 0x6a, 0xbc, 0xa3, 0x34, 0xcf, 0x67,
+	0x92, 0xfc, 0x41, 0x4c, 0x6d, 0x5c, 0x39, 0x1f, 0x5d, 0x42, 0x47, 0xb0, 0x92, 0xc0, 0xb2, 0xbe,
+	0x4f, 0x84, 0x19, 0x4a, 0x8d, 0x5b, 0x13, 0x11, 0x8f, 0xfb, 0x3e, 0x31, 0xca, 0xe7, 0x43, 0x5f,
+	0xe8, 0x39, 0x6c, 0xf8, 0x01, 0xe9, 0x39, 0x5e, 0x48, 0x4d, 0xca, 0x70, 0xc0, 0x88, 0x6d, 0x92,
+	0x1e, 0x71, 0x19, 0x37, 0xed, 0xa2, 0xc0, 0xdc, 0xac, 0x47, 0x29, 0x54, 0x8f, 0x53, 0xa8, 0xde,
+	0x74, 0xd9, 0x9d, 0x0f, 0x9f, 0xe3, 0x4e, 0x48, 0x8c, 0xf5, 0x98, 0xfb, 0x59, 0xc4, 0xfc, 0x80,
+	0xf3, 0x36, 0x6d, 0xb4, 0x03, 0x6b, 0x63, 0x70, 0xdc, 0xbe, 0x0b, 0x46, 0x85, 0xa6, 0x29, 0x75,
+	0x28, 0x60, 0xc6, 0x48, 0xd7, 0x67, 0xfa, 0xd2, 0xb6, 0xb6, 0x93, 0x37, 0xe2, 0x4f, 0x54, 0x83,
+	0x15, 0x97, 0x7c, 0xc1, 0x06, 0x00, 0x05, 0x01, 0x50, 0xe2, 0x8b, 0x31, 0xf7, 0x7b, 0x80, 0x4e,
+	0xb0, 0x75, 0xd6, 0xf1, 0x4e, 0x4d, 0xcb, 0x0b, 0x5d, 0x66, 0xb6, 0x1d, 0x97, 0xe9, 0xcb, 0x82,
+	0x70, 0x4d, 0xee, 0x1c, 0xf0, 0x8d, 0x47, 0x8e, 0xcb, 0xd0, 0x5d, 0xd0, 0x29, 0x73, 0xac, 0xb3,
+	0xfe, 0xc0, 0x15, 0x26, 0x71, 0xf1, 0x49, 0x87, 0xd8, 0x7a, 0x71, 0x5b, 0xdb, 0x59, 0x36, 0xd6,
+	0xa3, 0xfd, 0xc4, 0xd0, 0x0f, 0xa2, 0x5d, 0x74, 0x17, 0xf2, 0x22, 0xe5, 0x75, 0x10, 0x36, 0xa9,
+	0x4d, 0xb4, 0xf3, 0xa7, 0x9c, 0xd2, 0x88, 0x18, 0x90, 0x01, 0x2b, 0xb6, 0x8c, 0x1b, 0xd3, 0x71,
+	0x5b, 0x9e, 0x5e, 0x12, 0x08, 0x3f, 0x4e, 0x23, 0x44, 0x29, 0xc7, 0x41, 0x8e, 0x03, 0xec, 0x52,
+	0x87, 0xb8, 0x2c, 0x8e, 0xb6, 0xa6, 0xdb, 0xf2, 0x8c, 0xb2, 0x3d, 0xf4, 0x85, 0x5e, 0xc0, 0xf5,
+	0xf1, 0xa0, 0x32, 0x45, 0x18, 0xf2, 0x6c, 0xd5, 0xcb, 0x42, 0xc4, 0x0d, 0xa5, 0x92, 0x3c, 0x78,
+	0x7f, 0xe1, 0x50, 0x66, 0x6c, 0x8c, 0x45, 0x55, 0xbc, 0x85, 0xea, 0x70, 0x35, 0x32, 0x3a, 0xaf,
+	0x11, 0xc4, 0xec, 0x91, 0x80, 0x8b, 0xd6, 0x57, 0x84, 0x7f, 0xae, 0x88, 0xad, 0x67, 0x7c, 0xe7,
+	0x79, 0xb4, 0x81, 0x6e, 0x41, 0xf9, 0x24, 0xc0, 0xae, 0xd5, 0x96, 0x59, 0x50, 0x11, 0x59, 0x50,
+	0x8a, 0xd6, 0xa2, 0x3c, 0xd8, 0x87, 0x0a, 0xb5, 0xda, 0xc4, 0x0e, 0x3b, 0xc4, 0x36, 0x79, 0x91,
+	0xd6, 0x57, 0x85, 0x92, 0xd5, 0xb1, 0xe8, 0x3a, 0x8e, 0x2b, 0xb8, 0xb1, 0x92, 0x70, 0xf0, 0x35,
+	0xf4, 0x53, 0x28, 0xc7, 0x31, 0x25, 0x00, 0xd6, 0xa6, 0x02, 0x94, 0x24, 0xbd, 0x60, 0xff, 0x1c,
+	0x0a, 0xdc, 0x23, 0x0e, 0xa1, 0xfa, 0x95, 0xed, 0x85, 0x9d, 0x52, 0xe3, 0x7e, 0x3d, 0xab, 0xed,
+	0xd4, 0x27, 0x24, 0x7c, 0xfd, 0xd3, 0x08, 0xe4, 0x81, 0xcb, 0x82, 0xbe, 0x11, 0x43, 0x56, 0x5f,
+	0x40, 0x79, 0x78, 0x03, 0xad, 0xc1, 0xc2, 0x19, 0xe9, 0x8b, 0x7a, 0x50, 0x34, 0xf8, 0x4f, 0x1e,
+	0x42, 0x3d, 0x9e, 0x33, 0x7a, 0x6e, 0xf6, 0x10, 0x12, 0x0c, 0xf7, 0x72, 0x77, 0xb5, 0xe1, 0xd2,
+	0xbb, 0x6f, 0x31, 0xa7, 0xe7, 0xb0, 0xfe, 0xc5, 0x4b, 0xaf, 0x02, 0xe1, 0xff, 0xb1, 0xf4, 0x7e,
+	0xbd, 0x0c, 0x9b, 0x4a, 0x8d, 0xbf, 0xd7, 0xd2, 0x7b, 0x13, 0x4a, 0x58, 0x6a, 0x33, 0x30, 0x02,
+	0xc4, 0x4b, 0x4d, 0x9b, 0xd7, 0xe6, 0x84, 0x40, 0xd4, 0xe6, 0xc5, 0x09, 0xb5, 0x39, 0x39, 0x98,
+	0xa8, 0xcd, 0x78, 0xe8, 0x0b, 0x35, 0x20, 0xef, 0xb8, 0x7e, 0xc8, 0x84, 0x75, 0x4a, 0x8d, 0xeb,
+	0x6a, 0x8f, 0xe2, 0x7e, 0xc7, 0xc3, 0xb6, 0x11, 0x91, 0x2a, 0xd2, 0x6c, 0xe9, 0xb2, 0x69, 0x56,
+	0x98, 0x2f, 0xcd, 0x8e, 0x61, 0x23, 0xc6, 0x33, 0x99, 0x67, 0x5a, 0x1d, 0x8f, 0x12, 0x01, 0xe4,
+	0x85, 0x51, 0x61, 0x2e, 0x35, 0x36, 0xc6, 0xb0, 0x0e, 0xe5, 0x54, 0x67, 0xac, 0xc7, 0xbc, 0xc7,
+	0xde, 0x01, 0xe7, 0x3c, 0x8e, 0x18, 0xd1, 0x2f, 0x61, 0x5d, 0x08, 0x19, 0x87, 0x2c, 0x4e, 0x83,
+	0xbc, 0x2a, 0x18, 0x47, 0xf0, 0x8e, 0xe0, 0x4a, 0x9b, 0xe0, 0x80, 0x9d, 0x10, 0xcc, 0x12, 0x28,
+	0x98, 0x06, 0xb5, 0x96, 0xf0, 0xc4, 0x38, 0x43, 0xdd, 0xab, 0x94, 0xee, 0x5e, 0x2f, 0x60, 0x2b,
+	0xed, 0x09, 0xd3, 0x6b, 0x99, 0xac, 0xed, 0x50, 0x33, 0x66, 0x28, 0x4f, 0x35, 0x6c, 0x35, 0xe5,
+	0x99, 0x27, 0xad, 0xe3, 0xb6, 0x43, 0xf7, 0x25, 0x7e, 0x73, 0xf8, 0x04, 0x36, 0x61, 0xd8, 0xe9,
+	0x50, 0x7d, 0x65, 0x86, 0x48, 0x19, 0x1c, 0xe2, 0x30, 0xe2, 0x1a, 0x1f, 0x26, 0x2a, 0x17, 0x1b,
+	0x26, 0xde, 0x85, 0xd5, 0x04, 0x27, 0xaa, 0x18, 0xa2, 0xc8, 0x17, 0x8d, 0x4a, 0xbc, 0x7c, 0x28,
+	0x56, 0xd1, 0x07, 0xb0, 0xd4, 0x26, 0xd8, 0x26, 0x81, 0xac, 0xe1, 0x9b, 0x4a, 0x49, 0x8f, 0x04,
+	0x89, 0x21, 0x49, 0x6b, 0x7f, 0x5a, 0x84, 0xf5, 0x7d, 0xdb, 0x56, 0x0d, 0x9e, 0xa9, 0x92, 0xa5,
+	0x8d, 0x94, 0xac, 0xef, 0xa8, 0x0c, 0xdc, 0x83, 0xe2, 0xa0, 0xe1, 0x2e, 0xcc, 0xd2, 0x70, 0x97,
+	0x99, 0xfc, 0xc5, 0x4b, 0x48, 0x92, 0x23, 0x72, 0xce, 0x5a, 0x30, 0x20, 0x5e, 0x6a, 0xda, 0xa3,
+	0x49, 0x24, 0x43, 0x5f, 0x86, 0x69, 0x7e, 0x8e, 0x24, 0x12, 0x63, 0x59, 0x1c, 0xac, 0xf7, 0x60,
+	0x89, 0x7a, 0x61, 0x60, 0x45, 0x45, 0xa1, 0xd2, 0xa8, 0x65, 0xce, 0x20, 0x98, 0x9e, 0x3d, 0x13,
+	0x94, 0x86, 0xe4, 0x50, 0xd4, 0xf6, 0xc2, 0x8c, 0xb5, 0x7d, 0x59, 0x55, 0xdb, 0x51, 0x15, 0x96,
+	0xfd, 0xc0, 0xf1, 0x02, 0x87, 0xf5, 0x45, 0x0a, 0xe7, 0x8d, 0xe4, 0x9b, 0x8f, 0x13, 0x2d, 0xec,
+	0x04, 0x2e, 0xa1, 0xd4, 0xe4, 0x4d, 0x14, 0x04, 0x42, 0x29, 0x5e, 0xfb, 0x39, 0xe9, 0xd7, 0x36,
+	0xe0, 0xda, 0x58, 0x2c, 0x44, 0x5d, 0xa1, 0xf6, 0x4d, 0x5e, 0xc4, 0x89, 0xaa, 0x4b, 0x7e, 0x1f,
+	0x71, 0xc2, 0x27, 0x61, 0x61, 0x42, 0x73, 0x20, 0x3a, 0xea, 0x19, 0x95, 0x68, 0xfd, 0x30, 0x56,
+	0x20, 0x15, 0x51, 0x8b, 0x97, 0x8a, 0xa8, 0xfc, 0x7c, 0x11, 0xb5, 0x74, 0xf9, 0x88, 0x2a, 0xbc,
+	0x86, 0x88, 0x5a, 0x56, 0x45, 0x94, 0x0b, 0x3a, 0x1e, 0x72, 0xe5, 0xa1, 0x43, 0x7d, 0x3e, 0xa8,
+	0xf1, 0x39, 0x58, 0xd6, 0xfe, 0x46, 0xf6, 0x1c, 0xb7, 0x9f, 0xc1, 0x69, 0x64, 0x62, 0xaa, 0x22,
+	0x18, 0xa6, 0x46, 0x70, 0x69, 0x4a, 0x04, 0x97, 0xc7, 0x23, 0xf8, 0xdb, 0x05, 0xd0, 0xb3, 0xd4,
+	0x43, 0x9f, 0xc0, 0xea, 0xa0, 0x79, 0x88, 0x79, 0x5b, 0xd7, 0x26, 0xd4, 0xe4, 0x47, 0xd1, 0x65,
+	0x5e, 0x5c, 0x8a, 0x8c, 0xc1, 0x00, 0x20, 0xbe, 0xc7, 0xfa, 0x79, 0x6e, 0xbe, 0x7e, 0x3e, 0xd4,
+	0xe1, 0x16, 0xe6, 0xed, 0x70, 0x8b, 0xaf, 0xbf, 0xc3, 0xe5, 0x5f, 0x4f, 0x87, 0x5b, 0x7a, 0x6d,
+	0x1d, 0xae, 0xa0, 0xea, 0x70, 0xb2, 0x3e, 0xa9, 0xa6, 0xd6, 0xda, 0xb7, 0x1a, 0xbc, 0x21, 0xc6,
+	0xfb, 0x58, 0x4e, 0x5c, 0x9d, 0x0e, 0x46, 0x67, 0xf8, 0x1f, 0x2a, 0xd5, 0x53, 0xf1, 0xce, 0x38,
+	0xbd, 0x5f, 0xa6, 0x67, 0xcd, 0x36, 0xdc, 0xd7, 0xfe, 0xaa, 0xc1, 0x9b, 0x23, 0x1a, 0xca, 0x69,
+	0xfd, 0x67, 0x50, 0x16, 0x37, 0x62, 0x33, 0x20, 0x34, 0xec, 0xc4, 0x67, 0x9c, 0xec, 0xc9, 0x92,
+	0xe0, 0x30, 0x04, 0x03, 0x6a, 0x42, 0x25, 0x06, 0xf8, 0x0d, 0xb1, 0x18, 0xb1, 0x27, 0xde, 0xa4,
+	0xa2, 0x1b, 0x94, 0xa4, 0x34, 0x56, 0x5e, 0x0e, 0x7f, 0xd6, 0xfe, 0xad, 0xc1, 0x76, 0xa4, 0x98,
+	0x2d, 0xe8, 0xf8, 0x79, 0x0f, 0xbc, 0xae, 0xdf, 0x21, 0x9c, 0x58, 0x9a, 0xf2, 0xc9, 0xa8, 0x3f,
+	0x6e, 0x2b, 0x05, 0x4d, 0xc3, 0xf9, 0x1f, 0xf8, 0xe6, 0x1a, 0x14, 0x04, 0xaf, 0x9c, 0x25, 0x8a,
+	0xc6, 0x12, 0xff, 0x6c, 0xda, 0xb5, 0xb7, 0xe0, 0xd6, 0x04, 0xf5, 0x64, 0x40, 0xfe, 0x53, 0x83,
+	0xeb, 0x07, 0xd8, 0xb5, 0x48, 0xe7, 0x49, 0xc8, 0x28, 0xc3, 0xae, 0xed, 0xb8, 0xa7, 0xfc, 0xde,
+	0x35, 0x53, 0xdb, 0x4c, 0xdd, 0x08, 0x73, 0x23, 0x37, 0xc2, 0x87, 0x50, 0x49, 0x0e, 0x35, 0x78,
+	0xa7, 0xaa, 0x64, 0x24, 0x5e, 0x7c, 0xb2, 0x28, 0xf1, 0xd8, 0xd0, 0xd7, 0x65, 0x7a, 0x63, 0xed,
+	0x26, 0xdc, 0xc8, 0x38, 0x9e, 0x34, 0xc0, 0xef, 0xe0, 0xda, 0x21, 0xa1, 0x56, 0xe0, 0x9c, 0x90,
+	0x84, 0x5d, 0x1e, 0xfd, 0x68, 0x34, 0x06, 0xde, 0x53, 0x4a, 0xcd, 0x60, 0x9f, 0xcd, 0xf5, 0xb5,
+	0xaf, 0x72, 0xa0, 0x8f, 0x23, 0xc8, 0xb4, 0xf9, 0x08, 0x0a, 0x91, 0x39, 0xa9, 0xae, 0x89, 0x67,
+	0x8b, 0x9b, 0x99, 0x37, 0x7b, 0x12, 0x88, 0xde, 0x16, 0xd3, 0xa3, 0xc7, 0xb0, 0x36, 0xb0, 0x3e,
+	0x65, 0x98, 0x85, 0x54, 0xa6, 0xcc, 0x5b, 0x13, 0x6d, 0xf7, 0x4c, 0x90, 0x1a, 0x15, 0x96, 0xfa,
+	0x46, 0x9f, 0xc3, 0x9a, 0x8f, 0x03, 0xe6, 0x88, 0xce, 0x68, 0x79, 0x6e, 0xcb, 0x39, 0x95, 0x81,
+	0xba, 0x97, 0xdd, 0x81, 0x63, 0xcc, 0xa7, 0x31, 0xe7, 0x81, 0x60, 0x34, 0x56, 0xfd, 0xf4, 0xc2,
+	0x27, 0x8b, 0xcb, 0x8b, 0x6b, 0xf9, 0x1a, 0x85, 0x1b, 0xc2, 0xe7, 0xa3, 0x5c, 0x34, 0x76, 0xc8,
+	0x3a, 0x2c, 0xc9, 0xc2, 0x1b, 0x05, 0xa2, 0xfc, 0x4a, 0x07, 0x48, 0x6e, 0xbe, 0x00, 0xf9, 0x63,
+	0x0e, 0xb6, 0xb2, 0xa4, 0x4a, 0x2f, 0xbc, 0x84, 0x1b, 0x83, 0x3b, 0x7d, 0x62, 0xd3, 0xe4, 0x0c,
+	0xb1, 0x6f, 0xea, 0x13, 0x45, 0x26, 0xb8, 0x8f, 0x09, 0xc3, 0x36, 0x66, 0xd8, 0xa8, 0x0e, 0x8f,
+	0x21, 0x69, 0xd1, 0x5c, 0x64, 0xf2, 0x70, 0xa8, 0x14, 0x99, 0xbb, 0x98, 0x48, 0x7b, 0x68, 0x68,
+	0x4e, 0x8b, 0xac, 0xdd, 0x86, 0xcd, 0x87, 0x24, 0x31, 0x03, 0xbd, 0xdf, 0x8f, 0xba, 0xd9, 0x14,
+	0xdb, 0xd7, 0xbe, 0x59, 0x84, 0xeb, 0x6a, 0x3e, 0x69, 0xbd, 0x2f, 0x35, 0x58, 0x57, 0x9c, 0xa5,
+	0x8b, 0x7d, 0x69, 0xb7, 0x27, 0xd9, 0x01, 0x34, 0x09, 0xb8, 0x7e, 0x38, 0x72, 0x96, 0xc7, 0xd8,
+	0x8f, 0xde, 0xe5, 0xae, 0xda, 0xe3, 0x3b, 0x42, 0x0d, 0x85, 0x17, 0xb9, 0x1a, 0xb9, 0x4b, 0xa9,
+	0xb1, 0x3f, 0xe2, 0xc5, 0x81, 0x1a, 0x78, 0x7c, 0xa7, 0xfa, 0x5b, 0x9e, 0xed, 0x6a, 0xbd, 0x15,
+	0xcf, 0x86, 0x8f, 0xd2, 0xcf, 0x86, 0x13, 0x86, 0xdd, 0xac, 0x12, 0x32, 0xf4, 0x8c, 0xc8, 0x65,
+	0x67, 0x29, 0xfb, 0x5d, 0xcb, 0xae, 0xfd, 0x45, 0x83, 0x6b, 0x19, 0xe5, 0x80, 0x4f, 0x99, 0xf1,
+	0x2b, 0xb3, 0x26, 0xee, 0x2e, 0xf1, 0x27, 0x7f, 0x8b, 0x76, 0xc3, 0xae, 0x19, 0x10, 0x6c, 0xa7,
+	0x83, 0x5f, 0xbc, 0x45, 0xbb, 0x61, 0xd7, 0x20, 0xd8, 0x1e, 0x4a, 0x9b, 0xf7, 0xe1, 0x0d, 0x4e,
+	0x7f, 0x1e, 0x38, 0x8c, 0x0c, 0x33, 0x44, 0xc3, 0x2b, 0x72, 0xc3, 0xee, 0x67, 0x7c, 0x6b, 0xc0,
+	0xd1, 0xf8, 0x1b, 0x40, 0xe9, 0xb1, 0x3c, 0xcb, 0xfe, 0xd3, 0x26, 0xfa, 0xbd, 0x06, 0x57, 0x15,
+	0x0f, 0xc0, 0xe8, 0xc3, 0x39, 0xdf, 0x8b, 0x45, 0xd2, 0x54, 0x6f, 0x5f, 0xe8, 0x95, 0x79, 0x58,
+	0x89, 0x61, 0x87, 0xcd, 0xa0, 0x84, 0xe2, 0xe2, 0x5b, 0xbd, 0x3d, 0x27, 0x97, 0x54, 0xa2, 0x07,
+	0xab, 0x23, 0xb7, 0x6c, 0xf4, 0xfe, 0x84, 0xcb, 0x96, 0xf2, 0x71, 0xa6, 0xba, 0x37, 0x07, 0x47,
+	0x4a, 0x6e, 0xea, 0xdc, 0x93, 0xe5, 0xaa, 0xce, 0xbc, 0x37, 0x07, 0x87, 0x94, 0xeb, 0xc3, 0x4a,
+	0x6a, 0x76, 0x45, 0xf5, 0x6c, 0x0c, 0xd5, 0x18, 0x5e, 0xdd, 0x9d, 0x99, 0x5e, 0x4a, 0xfc, 0xb3,
+	0x06, 0x1b, 0x99, 0x13, 0x1a, 0xba, 0x97, 0x0d, 0x37, 0x6d, 0xea, 0xac, 0x7e, 0x7c, 0x21, 0x5e,
+	0xa9, 0xd6, 0x57, 0x1a, 0xbc, 0xa9, 0x9c, 0x99, 0xd0, 0x9d, 0x6c, 0xd8, 0x49, 0x33, 0x64, 0xf5,
+	0x27, 0x73, 0xf3, 0x49, 0x55, 0xfa, 0xb0, 0x36, 0x5a, 0x5c, 0xd0, 0xde, 0x3c, 0x85, 0x28, 0x92,
+	0x7f, 0x81, 0xda, 0x85, 0xbe, 0xd6, 0x60, 0x5d, 0x3d, 0x17, 0xa0, 0x09, 0xc7, 0x99, 0x38, 0xbf,
+	0x54, 0xef, 0xce, 0xcf, 0x28, 0xb5, 0xf9, 0x83, 0x06, 0x6f, 0xa8, 0xba, 0x10, 0xba, 0x3d, 0x6f,
+	0xd7, 0x8a, 0x34, 0xb9, 0x73, 0xb1, 0x66, 0x77, 0xff, 0xe1, 0xdf, 0x5f, 0x6d, 0x69, 0xff, 0x78,
+	0xb5, 0xa5, 0xfd, 0xeb, 0xd5, 0x96, 0xf6, 0xeb, 0x8f, 0x4e, 0x1d, 0xd6, 0x0e, 0x4f, 0xea, 0x96,
+	0xd7, 0xdd, 0x4d, 0xfd, 0x89, 0xa0, 0x7e, 0x4a, 0xdc, 0xe8, 0x5f, 0x17, 0xc3, 0x7f, 0xfc, 0xf8,
+	0x38, 0xfe, 0xdd, 0xdb, 0x3b, 0x59, 0x12, 0xbb, 0x1f, 0xfc, 0x77, 0x00, 0xa8, 0x38, 0x5f, 0x8c,
+	0x26, 0x22, 0x00, 0x00,
 }
 
 func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
@@ -1765,6 +1911,13 @@ func (m *PollForDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ForwardedFrom) > 0 {
 		i -= len(m.ForwardedFrom)
 		copy(dAtA[i:], m.ForwardedFrom)
@@ -2027,6 +2180,13 @@ func (m *PollForActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ForwardedFrom) > 0 {
 		i -= len(m.ForwardedFrom)
 		copy(dAtA[i:], m.ForwardedFrom)
@@ -2286,6 +2446,25 @@ func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.ForwardedFrom) > 0 {
 		i -= len(m.ForwardedFrom)
 		copy(dAtA[i:], m.ForwardedFrom)
@@ -2400,6 +2579,25 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.ActivityTaskDispatchInfo != nil {
 		{
 			size, err := m.ActivityTaskDispatchInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -2971,6 +3169,18 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskListStatus != nil {
 		{
 			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -3214,6 +3424,48 @@ func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *TaskListPartitionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListPartitionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListPartitionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NumWritePartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumWritePartitions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumReadPartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumReadPartitions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -3247,6 +3499,10 @@ func (m *PollForDecisionTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3358,6 +3614,10 @@ func (m *PollForActivityTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3471,6 +3731,17 @@ func (m *AddDecisionTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3529,6 +3800,17 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 		l = m.ActivityTaskDispatchInfo.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3749,6 +4031,10 @@ func (m *DescribeTaskListResponse) Size() (n int) {
 		l = m.TaskListStatus.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3853,6 +4139,27 @@ func (m *GetTaskListsByDomainResponse) Size() (n int) {
 	return n
 }
 
+func (m *TaskListPartitionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovService(uint64(m.Version))
+	}
+	if m.NumReadPartitions != 0 {
+		n += 1 + sovService(uint64(m.NumReadPartitions))
+	}
+	if m.NumWritePartitions != 0 {
+		n += 1 + sovService(uint64(m.NumWritePartitions))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4020,6 +4327,38 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -4854,6 +5193,38 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -5715,13 +6086,96 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
 			if (iNdEx + skippy) > l {
@@ -6095,6 +6549,89 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -7437,6 +7974,42 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskListPartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8089,6 +8662,114 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListPartitionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListPartitionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
+			}
+			m.NumReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
+			}
+			m.NumWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -353,6 +353,26 @@ func (c *clientImpl) ListDynamicConfig(
 	return c.client.ListDynamicConfig(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkflowTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListWorkflowTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) RetryTask(
+	ctx context.Context,
+	request *types.RetryTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RetryTask(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -68,6 +68,16 @@ func (g grpcClient) DescribeQueue(ctx context.Context, request *types.DescribeQu
 	return proto.ToAdminDescribeQueueResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) ListWorkflowTasks(ctx context.Context, request *types.ListWorkflowTasksRequest, opts ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	response, err := g.c.ListWorkflowTasks(ctx, proto.FromAdminListWorkflowTasksRequest(request), opts...)
+	return proto.ToAdminListWorkflowTasksResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) RetryTask(ctx context.Context, request *types.RetryTaskRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RetryTask(ctx, proto.FromAdminRetryTaskRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) DescribeWorkflowExecution(ctx context.Context, request *types.AdminDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, proto.FromAdminDescribeWorkflowExecutionRequest(request), opts...)
 	return proto.ToAdminDescribeWorkflowExecutionResponse(response), proto.ToError(err)
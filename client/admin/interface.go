@@ -60,4 +60,6 @@ type Client interface {
 	ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest, ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest, ...yarpc.CallOption) (*types.AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest, ...yarpc.CallOption) (*types.AdminMaintainWorkflowResponse, error)
+	ListWorkflowTasks(context.Context, *types.ListWorkflowTasksRequest, ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error)
+	RetryTask(context.Context, *types.RetryTaskRequest, ...yarpc.CallOption) error
 }
@@ -443,3 +443,29 @@ func (c *retryableClient) ListDynamicConfig(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkflowTasksResponse, error) {
+	var resp *types.ListWorkflowTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListWorkflowTasks(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) RetryTask(
+	ctx context.Context,
+	request *types.RetryTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	op := func() error {
+		return c.client.RetryTask(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
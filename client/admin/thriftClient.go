@@ -177,3 +177,13 @@ func (t thriftClient) ListDynamicConfig(ctx context.Context, request *types.List
 	response, err := t.c.ListDynamicConfig(ctx, thrift.FromListDynamicConfigRequest(request), opts...)
 	return thrift.ToListDynamicConfigResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) ListWorkflowTasks(ctx context.Context, request *types.ListWorkflowTasksRequest, opts ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	response, err := t.c.ListWorkflowTasks(ctx, thrift.FromListWorkflowTasksRequest(request), opts...)
+	return thrift.ToListWorkflowTasksResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) RetryTask(ctx context.Context, request *types.RetryTaskRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RetryTask(ctx, thrift.FromRetryTaskRequest(request), opts...)
+	return thrift.ToError(err)
+}
@@ -1003,6 +1003,32 @@ func (c *errorInjectionClient) SignalWithStartWorkflowExecution(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) SignalWithStartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.SignalWithStartWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.SignalWithStartWorkflowExecutionAsyncResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.SignalWithStartWorkflowExecutionAsync(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.FrontendClientOperationSignalWithStartWorkflowExecutionAsync,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
 func (c *errorInjectionClient) SignalWorkflowExecution(
 	ctx context.Context,
 	request *types.SignalWorkflowExecutionRequest,
@@ -1054,6 +1080,32 @@ func (c *errorInjectionClient) StartWorkflowExecution(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) StartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.StartWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (*types.StartWorkflowExecutionAsyncResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.StartWorkflowExecutionAsyncResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.StartWorkflowExecutionAsync(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.FrontendClientOperationStartWorkflowExecutionAsync,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
 func (c *errorInjectionClient) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *types.TerminateWorkflowExecutionRequest,
@@ -1104,3 +1156,29 @@ func (c *errorInjectionClient) UpdateDomain(
 	}
 	return resp, clientErr
 }
+
+func (c *errorInjectionClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.UpdateWorkflowExecutionResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.FrontendClientOperationUpdateWorkflowExecution,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/yarpc"
 
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
@@ -35,6 +36,7 @@ type grpcClient struct {
 	workflow   apiv1.WorkflowAPIYARPCClient
 	worker     apiv1.WorkerAPIYARPCClient
 	visibility apiv1.VisibilityAPIYARPCClient
+	frontend   frontendv1.WorkflowAPIYARPCClient
 }
 
 func NewGRPCClient(
@@ -42,8 +44,9 @@ func NewGRPCClient(
 	workflow apiv1.WorkflowAPIYARPCClient,
 	worker apiv1.WorkerAPIYARPCClient,
 	visibility apiv1.VisibilityAPIYARPCClient,
+	frontend frontendv1.WorkflowAPIYARPCClient,
 ) Client {
-	return grpcClient{domain, workflow, worker, visibility}
+	return grpcClient{domain, workflow, worker, visibility, frontend}
 }
 
 func (g grpcClient) CountWorkflowExecutions(ctx context.Context, request *types.CountWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*types.CountWorkflowExecutionsResponse, error) {
@@ -231,6 +234,11 @@ func (g grpcClient) SignalWithStartWorkflowExecution(ctx context.Context, reques
 	return proto.ToSignalWithStartWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) SignalWithStartWorkflowExecutionAsync(ctx context.Context, request *types.SignalWithStartWorkflowExecutionAsyncRequest, opts ...yarpc.CallOption) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+	response, err := g.frontend.SignalWithStartWorkflowExecutionAsync(ctx, proto.FromSignalWithStartWorkflowExecutionAsyncRequest(request), opts...)
+	return proto.ToSignalWithStartWorkflowExecutionAsyncResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) SignalWorkflowExecution(ctx context.Context, request *types.SignalWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
 	_, err := g.workflow.SignalWorkflowExecution(ctx, proto.FromSignalWorkflowExecutionRequest(request), opts...)
 	return proto.ToError(err)
@@ -241,6 +249,11 @@ func (g grpcClient) StartWorkflowExecution(ctx context.Context, request *types.S
 	return proto.ToStartWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) StartWorkflowExecutionAsync(ctx context.Context, request *types.StartWorkflowExecutionAsyncRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionAsyncResponse, error) {
+	response, err := g.frontend.StartWorkflowExecutionAsync(ctx, proto.FromStartWorkflowExecutionAsyncRequest(request), opts...)
+	return proto.ToStartWorkflowExecutionAsyncResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) TerminateWorkflowExecution(ctx context.Context, request *types.TerminateWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
 	_, err := g.workflow.TerminateWorkflowExecution(ctx, proto.FromTerminateWorkflowExecutionRequest(request), opts...)
 	return proto.ToError(err)
@@ -250,3 +263,8 @@ func (g grpcClient) UpdateDomain(ctx context.Context, request *types.UpdateDomai
 	response, err := g.domain.UpdateDomain(ctx, proto.FromUpdateDomainRequest(request), opts...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) UpdateWorkflowExecution(ctx context.Context, request *types.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.workflow.UpdateWorkflowExecution(ctx, proto.FromUpdateWorkflowExecutionRequest(request), opts...)
+	return proto.ToUpdateWorkflowExecutionResponse(response), proto.ToError(err)
+}
@@ -708,6 +708,24 @@ func (c *metricClient) SignalWithStartWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) SignalWithStartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.SignalWithStartWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientSignalWithStartWorkflowExecutionAsyncScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientSignalWithStartWorkflowExecutionAsyncScope, metrics.CadenceClientLatency)
+	resp, err := c.client.SignalWithStartWorkflowExecutionAsync(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientSignalWithStartWorkflowExecutionAsyncScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) SignalWorkflowExecution(
 	ctx context.Context,
 	request *types.SignalWorkflowExecutionRequest,
@@ -744,6 +762,24 @@ func (c *metricClient) StartWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) StartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.StartWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (*types.StartWorkflowExecutionAsyncResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientStartWorkflowExecutionAsyncScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientStartWorkflowExecutionAsyncScope, metrics.CadenceClientLatency)
+	resp, err := c.client.StartWorkflowExecutionAsync(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientStartWorkflowExecutionAsyncScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *types.TerminateWorkflowExecutionRequest,
@@ -779,3 +815,21 @@ func (c *metricClient) UpdateDomain(
 	}
 	return resp, err
 }
+
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
@@ -454,6 +454,18 @@ func (c *retryableClient) SignalWithStartWorkflowExecution(ctx context.Context,
 	return resp, err
 }
 
+func (c *retryableClient) SignalWithStartWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWithStartWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (sp2 *types.SignalWithStartWorkflowExecutionAsyncResponse, err error) {
+
+	var resp *types.SignalWithStartWorkflowExecutionAsyncResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.SignalWithStartWorkflowExecutionAsync(ctx, sp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) SignalWorkflowExecution(ctx context.Context, sp1 *types.SignalWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
 
 	op := func() error {
@@ -474,6 +486,18 @@ func (c *retryableClient) StartWorkflowExecution(ctx context.Context, sp1 *types
 	return resp, err
 }
 
+func (c *retryableClient) StartWorkflowExecutionAsync(ctx context.Context, sp1 *types.StartWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionAsyncResponse, err error) {
+
+	var resp *types.StartWorkflowExecutionAsyncResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartWorkflowExecutionAsync(ctx, sp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) TerminateWorkflowExecution(ctx context.Context, tp1 *types.TerminateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
 
 	op := func() error {
@@ -493,3 +517,15 @@ func (c *retryableClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDom
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+
+	var resp *types.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
@@ -222,6 +222,11 @@ func (t thriftClient) SignalWithStartWorkflowExecution(ctx context.Context, requ
 	return thrift.ToStartWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) SignalWithStartWorkflowExecutionAsync(ctx context.Context, request *types.SignalWithStartWorkflowExecutionAsyncRequest, opts ...yarpc.CallOption) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+	response, err := t.c.SignalWithStartWorkflowExecutionAsync(ctx, thrift.FromSignalWithStartWorkflowExecutionAsyncRequest(request), opts...)
+	return thrift.ToSignalWithStartWorkflowExecutionAsyncResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) SignalWorkflowExecution(ctx context.Context, request *types.SignalWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
 	err := t.c.SignalWorkflowExecution(ctx, thrift.FromSignalWorkflowExecutionRequest(request), opts...)
 	return thrift.ToError(err)
@@ -232,6 +237,11 @@ func (t thriftClient) StartWorkflowExecution(ctx context.Context, request *types
 	return thrift.ToStartWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) StartWorkflowExecutionAsync(ctx context.Context, request *types.StartWorkflowExecutionAsyncRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionAsyncResponse, error) {
+	response, err := t.c.StartWorkflowExecutionAsync(ctx, thrift.FromStartWorkflowExecutionAsyncRequest(request), opts...)
+	return thrift.ToStartWorkflowExecutionAsyncResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) TerminateWorkflowExecution(ctx context.Context, request *types.TerminateWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
 	err := t.c.TerminateWorkflowExecution(ctx, thrift.FromTerminateWorkflowExecutionRequest(request), opts...)
 	return thrift.ToError(err)
@@ -241,3 +251,8 @@ func (t thriftClient) UpdateDomain(ctx context.Context, request *types.UpdateDom
 	response, err := t.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(request), opts...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) UpdateWorkflowExecution(ctx context.Context, request *types.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	response, err := t.c.UpdateWorkflowExecution(ctx, thrift.FromUpdateWorkflowExecutionRequest(request), opts...)
+	return thrift.ToUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
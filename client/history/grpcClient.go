@@ -248,7 +248,32 @@ func (g grpcClient) TerminateWorkflowExecution(ctx context.Context, request *typ
 	return proto.ToError(err)
 }
 
+func (g grpcClient) UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, proto.FromHistoryUpdateWorkflowExecutionRequest(request), opts...)
+	return proto.ToHistoryUpdateWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PauseWorkflowExecution(ctx, proto.FromHistoryPauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UnpauseWorkflowExecution(ctx, proto.FromHistoryUnpauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) GetFailoverInfo(ctx context.Context, request *types.GetFailoverInfoRequest, opts ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error) {
 	response, err := g.c.GetFailoverInfo(ctx, proto.FromHistoryGetFailoverInfoRequest(request), opts...)
 	return proto.ToHistoryGetFailoverInfoResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) ListWorkflowTasks(ctx context.Context, request *types.HistoryListWorkflowTasksRequest, opts ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	response, err := g.c.ListWorkflowTasks(ctx, proto.FromHistoryListWorkflowTasksRequest(request), opts...)
+	return proto.ToHistoryListWorkflowTasksResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) RetryTask(ctx context.Context, request *types.RetryTaskRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RetryTask(ctx, proto.FromHistoryRetryTaskRequest(request), opts...)
+	return proto.ToError(err)
+}
@@ -248,7 +248,27 @@ func (t thriftClient) TerminateWorkflowExecution(ctx context.Context, request *t
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (t thriftClient) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	return thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (t thriftClient) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	return thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (t thriftClient) GetFailoverInfo(ctx context.Context, request *types.GetFailoverInfoRequest, opts ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error) {
 	response, err := t.c.GetFailoverInfo(ctx, thrift.FromGetFailoverInfoRequest(request), opts...)
 	return thrift.ToGetFailoverInfoResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) ListWorkflowTasks(ctx context.Context, request *types.HistoryListWorkflowTasksRequest, opts ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (t thriftClient) RetryTask(ctx context.Context, request *types.RetryTaskRequest, opts ...yarpc.CallOption) error {
+	return thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	partitionConfigCacheMaxCount  = 10000
+	partitionConfigRefreshTimeout = 5 * time.Second
+)
+
+type (
+	// PartitionConfigProvider is the interface for implementers of
+	// component that provides the number of read and write partitions
+	// of a task list. When adaptive scaling is enabled for a task list,
+	// the partition config persisted by its root partition is used,
+	// otherwise the numbers come from dynamic config
+	PartitionConfigProvider interface {
+		// GetNumberOfReadPartitions returns the number of read partitions
+		GetNumberOfReadPartitions(domainID string, taskList types.TaskList, taskListType int) int
+		// GetNumberOfWritePartitions returns the number of write partitions
+		GetNumberOfWritePartitions(domainID string, taskList types.TaskList, taskListType int) int
+	}
+
+	partitionConfigProviderImpl struct {
+		client               Client
+		peerResolver         PeerResolver
+		domainIDToName       func(string) (string, error)
+		configs              cache.Cache
+		timeSource           clock.TimeSource
+		logger               log.Logger
+		enableAdaptiveScaler dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		nReadPartitions      dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		nWritePartitions     dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		refreshInterval      dynamicconfig.DurationPropertyFn
+	}
+
+	partitionConfigKey struct {
+		domainID     string
+		taskListName string
+		taskListType int
+	}
+
+	partitionConfigEntry struct {
+		config      atomic.Value // *types.TaskListPartitionConfig
+		lastRefresh int64        // unix nano
+		refreshing  int32
+	}
+)
+
+// NewPartitionConfigProvider returns an instance of PartitionConfigProvider
+// that caches the partition config of task lists and refreshes it from the
+// root partitions in the background
+func NewPartitionConfigProvider(
+	client Client,
+	peerResolver PeerResolver,
+	domainIDToName func(string) (string, error),
+	dc *dynamicconfig.Collection,
+	logger log.Logger,
+) PartitionConfigProvider {
+	return &partitionConfigProviderImpl{
+		client:         client,
+		peerResolver:   peerResolver,
+		domainIDToName: domainIDToName,
+		configs: cache.New(&cache.Options{
+			InitialCapacity: 32,
+			MaxCount:        partitionConfigCacheMaxCount,
+		}),
+		timeSource:           clock.NewRealTimeSource(),
+		logger:               logger,
+		enableAdaptiveScaler: dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableAdaptiveScaler),
+		nReadPartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
+		nWritePartitions:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
+		refreshInterval:      dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval),
+	}
+}
+
+func (p *partitionConfigProviderImpl) GetNumberOfReadPartitions(
+	domainID string,
+	taskList types.TaskList,
+	taskListType int,
+) int {
+	config, domainName := p.getPartitionConfig(domainID, taskList, taskListType)
+	if config != nil {
+		return int(config.NumReadPartitions)
+	}
+	return p.nReadPartitions(domainName, taskList.GetName(), taskListType)
+}
+
+func (p *partitionConfigProviderImpl) GetNumberOfWritePartitions(
+	domainID string,
+	taskList types.TaskList,
+	taskListType int,
+) int {
+	config, domainName := p.getPartitionConfig(domainID, taskList, taskListType)
+	if config != nil {
+		// never write to a partition that is not polled
+		return int(common.MinInt32(config.NumReadPartitions, config.NumWritePartitions))
+	}
+	return p.nWritePartitions(domainName, taskList.GetName(), taskListType)
+}
+
+// getPartitionConfig returns the cached partition config of the task list, or nil
+// when the numbers from dynamic config should be used instead
+func (p *partitionConfigProviderImpl) getPartitionConfig(
+	domainID string,
+	taskList types.TaskList,
+	taskListType int,
+) (*types.TaskListPartitionConfig, string) {
+	domainName, err := p.domainIDToName(domainID)
+	if err != nil {
+		return nil, ""
+	}
+	if taskList.GetKind() == types.TaskListKindSticky ||
+		!p.enableAdaptiveScaler(domainName, taskList.GetName(), taskListType) {
+		return nil, domainName
+	}
+
+	key := partitionConfigKey{
+		domainID:     domainID,
+		taskListName: taskList.GetName(),
+		taskListType: taskListType,
+	}
+	var entry *partitionConfigEntry
+	if value := p.configs.Get(key); value != nil {
+		entry = value.(*partitionConfigEntry)
+	} else {
+		value, err := p.configs.PutIfNotExist(key, &partitionConfigEntry{})
+		if err != nil {
+			return nil, domainName
+		}
+		entry = value.(*partitionConfigEntry)
+	}
+
+	lastRefresh := time.Unix(0, atomic.LoadInt64(&entry.lastRefresh))
+	if p.timeSource.Now().Sub(lastRefresh) >= p.refreshInterval() &&
+		atomic.CompareAndSwapInt32(&entry.refreshing, 0, 1) {
+		go p.refresh(key, entry)
+	}
+
+	config, _ := entry.config.Load().(*types.TaskListPartitionConfig)
+	if config == nil || config.NumReadPartitions <= 0 || config.NumWritePartitions <= 0 {
+		return nil, domainName
+	}
+	return config, domainName
+}
+
+// refresh loads the partition config of the task list from its root partition.
+// On failure the previously loaded config is kept until the next refresh
+func (p *partitionConfigProviderImpl) refresh(key partitionConfigKey, entry *partitionConfigEntry) {
+	defer func() {
+		atomic.StoreInt64(&entry.lastRefresh, p.timeSource.Now().UnixNano())
+		atomic.StoreInt32(&entry.refreshing, 0)
+	}()
+
+	peer, err := p.peerResolver.FromTaskList(key.taskListName)
+	if err != nil {
+		p.logger.Warn("Failed to resolve root partition of task list", tag.WorkflowTaskListName(key.taskListName), tag.Error(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), partitionConfigRefreshTimeout)
+	defer cancel()
+	taskListType := types.TaskListType(key.taskListType)
+	resp, err := p.client.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: key.domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:     &types.TaskList{Name: key.taskListName, Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType: &taskListType,
+		},
+	}, yarpc.WithShardKey(peer))
+	if err != nil {
+		p.logger.Warn("Failed to refresh task list partition config", tag.WorkflowTaskListName(key.taskListName), tag.Error(err))
+		return
+	}
+
+	entry.config.Store(resp.GetPartitionConfig())
+}
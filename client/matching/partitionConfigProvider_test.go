@@ -0,0 +1,113 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain"
+	testTaskList   = "test-tasklist"
+)
+
+func setupPartitionConfigProvider(t *testing.T, enableAdaptiveScaler bool) (*partitionConfigProviderImpl, *MockClient) {
+	controller := gomock.NewController(t)
+	client := NewMockClient(controller)
+	resolver := membership.NewMockResolver(controller)
+	resolver.EXPECT().Lookup(service.Matching, testTaskList).Return(
+		membership.NewDetailedHostInfo("tasklistHost:1234", "tasklistHost_1234", membership.PortMap{membership.PortTchannel: 1234}),
+		nil,
+	).AnyTimes()
+
+	dcClient := dynamicconfig.NewInMemoryClient()
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingEnableAdaptiveScaler, enableAdaptiveScaler))
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingNumTasklistReadPartitions, 3))
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingNumTasklistWritePartitions, 2))
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingPartitionConfigRefreshInterval, time.Hour))
+
+	provider := NewPartitionConfigProvider(
+		client,
+		NewPeerResolver(resolver, membership.PortTchannel),
+		func(string) (string, error) { return testDomainName, nil },
+		dynamicconfig.NewCollection(dcClient, log.NewNoop()),
+		log.NewNoop(),
+	)
+	return provider.(*partitionConfigProviderImpl), client
+}
+
+func TestPartitionConfigProvider_AdaptiveScalerDisabled(t *testing.T) {
+	provider, _ := setupPartitionConfigProvider(t, false)
+	taskList := types.TaskList{Name: testTaskList, Kind: types.TaskListKindNormal.Ptr()}
+
+	assert.Equal(t, 3, provider.GetNumberOfReadPartitions(testDomainID, taskList, persistence.TaskListTypeDecision))
+	assert.Equal(t, 2, provider.GetNumberOfWritePartitions(testDomainID, taskList, persistence.TaskListTypeDecision))
+	assert.Equal(t, 0, provider.configs.Size())
+}
+
+func TestPartitionConfigProvider_AdaptiveScalerEnabled(t *testing.T) {
+	provider, client := setupPartitionConfigProvider(t, true)
+	taskList := types.TaskList{Name: testTaskList, Kind: types.TaskListKindNormal.Ptr()}
+
+	client.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *types.MatchingDescribeTaskListRequest, _ ...interface{}) (*types.DescribeTaskListResponse, error) {
+			assert.Equal(t, testDomainID, request.GetDomainUUID())
+			assert.Equal(t, testTaskList, request.GetDescRequest().GetTaskList().GetName())
+			assert.Equal(t, types.TaskListTypeActivity, request.GetDescRequest().GetTaskListType())
+			return &types.DescribeTaskListResponse{
+				PartitionConfig: &types.TaskListPartitionConfig{
+					Version:            3,
+					NumReadPartitions:  5,
+					NumWritePartitions: 4,
+				},
+			}, nil
+		},
+	).Times(1)
+
+	// dynamic config is used until the partition config is loaded from the root partition
+	assert.Equal(t, 3, provider.GetNumberOfReadPartitions(testDomainID, taskList, persistence.TaskListTypeActivity))
+	assert.Eventually(t, func() bool {
+		return provider.GetNumberOfReadPartitions(testDomainID, taskList, persistence.TaskListTypeActivity) == 5
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 4, provider.GetNumberOfWritePartitions(testDomainID, taskList, persistence.TaskListTypeActivity))
+}
+
+func TestPartitionConfigProvider_StickyTaskList(t *testing.T) {
+	provider, _ := setupPartitionConfigProvider(t, true)
+	taskList := types.TaskList{Name: testTaskList, Kind: types.TaskListKindSticky.Ptr()}
+
+	assert.Equal(t, 3, provider.GetNumberOfReadPartitions(testDomainID, taskList, persistence.TaskListTypeDecision))
+	assert.Equal(t, 2, provider.GetNumberOfWritePartitions(testDomainID, taskList, persistence.TaskListTypeDecision))
+	assert.Equal(t, 0, provider.configs.Size())
+}
@@ -41,7 +41,9 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/messaging/kafka"
+	"github.com/uber/cadence/common/messaging/memory"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/peerprovider/ringpopprovider"
 	"github.com/uber/cadence/common/resource"
@@ -53,6 +55,12 @@ import (
 	"github.com/uber/cadence/service/worker"
 )
 
+var (
+	// inMemoryAsyncWorkflowQueue is shared by all services of this process, so that
+	// requests queued by frontend are visible to the worker service
+	inMemoryAsyncWorkflowQueue = memory.NewClient()
+)
+
 type (
 	server struct {
 		name   string
@@ -209,6 +217,9 @@ func (s *server) startService() common.Daemon {
 		params.MessagingClient = nil
 	}
 
+	params.AsyncWorkflowQueueClient = s.newAsyncWorkflowQueueClient(&params)
+	params.AsyncWorkflowQueueConfig = s.cfg.AsyncWorkflowQueue
+
 	if isAdvancedVisEnabled {
 		// verify config of advanced visibility store
 		advancedVisStoreKey := s.cfg.Persistence.AdvancedVisibilityStore
@@ -286,6 +297,17 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+func (s *server) newAsyncWorkflowQueueClient(params *resource.Params) messaging.Client {
+	switch s.cfg.AsyncWorkflowQueue.Type {
+	case config.AsyncWorkflowQueueTypeKafka:
+		return kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, false)
+	case config.AsyncWorkflowQueueTypeMemory:
+		return inMemoryAsyncWorkflowQueue
+	default:
+		return nil
+	}
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
@@ -25,6 +25,7 @@ import (
 
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"    // needed to load mysql plugin
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres" // needed to load postgres plugin
+	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"   // needed to load sqlite plugin
 	"github.com/uber/cadence/tools/sql"
 )
 
@@ -0,0 +1,181 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azureblob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"go.uber.org/multierr"
+
+	"github.com/uber/cadence/common/config"
+)
+
+var (
+	errEmptyAccountName   = errors.New("empty azure storage account name")
+	errContainerNotExists = errors.New("requested container does not exist")
+	errBlobNotExists      = errors.New("requested blob does not exist")
+)
+
+type (
+	// blobClient is a thin wrapper around a single Azure storage account,
+	// blob names are relative to the given container.
+	blobClient interface {
+		Upload(ctx context.Context, container, blob string, data []byte) error
+		Download(ctx context.Context, container, blob string) ([]byte, error)
+		Exists(ctx context.Context, container, blob string) (bool, error)
+		ContainerExists(ctx context.Context, container string) (bool, error)
+		// List returns blob names with the given prefix in lexicographic order starting from marker,
+		// an empty next marker means there are no more blobs to list.
+		List(ctx context.Context, container, prefix, marker string, maxResults int) ([]string, string, error)
+	}
+
+	azureBlobClient struct {
+		serviceURL azblob.ServiceURL
+	}
+)
+
+func newBlobClient(config *config.AzureBlobArchiver) (blobClient, error) {
+	if len(config.AccountName) == 0 {
+		return nil, errEmptyAccountName
+	}
+
+	endpoint := config.Endpoint
+	if len(endpoint) == 0 {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", config.AccountName)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := azblob.NewAnonymousCredential()
+	if len(config.AccountKey) != 0 {
+		credential, err = azblob.NewSharedKeyCredential(config.AccountName, config.AccountKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pipeline := azblob.NewPipeline(credential, azblob.PipelineOptions{})
+	return &azureBlobClient{serviceURL: azblob.NewServiceURL(*u, pipeline)}, nil
+}
+
+func (c *azureBlobClient) Upload(ctx context.Context, container, blob string, data []byte) error {
+	blobURL := c.serviceURL.NewContainerURL(container).NewBlockBlobURL(blob)
+	_, err := azblob.UploadBufferToBlockBlob(ctx, data, blobURL, azblob.UploadToBlockBlobOptions{})
+	return convertError(err)
+}
+
+func (c *azureBlobClient) Download(ctx context.Context, container, blob string) (data []byte, err error) {
+	blobURL := c.serviceURL.NewContainerURL(container).NewBlobURL(blob)
+	response, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	body := response.Body(azblob.RetryReaderOptions{})
+	defer func() {
+		if ierr := body.Close(); ierr != nil {
+			err = multierr.Append(err, ierr)
+		}
+	}()
+	return ioutil.ReadAll(body)
+}
+
+func (c *azureBlobClient) Exists(ctx context.Context, container, blob string) (bool, error) {
+	blobURL := c.serviceURL.NewContainerURL(container).NewBlobURL(blob)
+	_, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if err = convertError(err); err == errBlobNotExists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *azureBlobClient) ContainerExists(ctx context.Context, container string) (bool, error) {
+	_, err := c.serviceURL.NewContainerURL(container).GetProperties(ctx, azblob.LeaseAccessConditions{})
+	if err != nil {
+		if err = convertError(err); err == errContainerNotExists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *azureBlobClient) List(ctx context.Context, container, prefix, marker string, maxResults int) ([]string, string, error) {
+	response, err := c.serviceURL.NewContainerURL(container).ListBlobsFlatSegment(ctx, azblob.Marker{Val: &marker}, azblob.ListBlobsSegmentOptions{
+		Prefix:     prefix,
+		MaxResults: int32(maxResults),
+	})
+	if err != nil {
+		return nil, "", convertError(err)
+	}
+
+	names := make([]string, 0, len(response.Segment.BlobItems))
+	for _, item := range response.Segment.BlobItems {
+		names = append(names, item.Name)
+	}
+	var nextMarker string
+	if response.NextMarker.Val != nil {
+		nextMarker = *response.NextMarker.Val
+	}
+	return names, nextMarker, nil
+}
+
+// convertError maps not found storage errors to errContainerNotExists and errBlobNotExists,
+// all other errors are returned as is.
+func convertError(err error) error {
+	var storageErr azblob.StorageError
+	if !errors.As(err, &storageErr) {
+		return err
+	}
+	switch storageErr.ServiceCode() {
+	case azblob.ServiceCodeContainerNotFound:
+		return errContainerNotExists
+	case azblob.ServiceCodeBlobNotFound:
+		return errBlobNotExists
+	}
+	return err
+}
+
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var responseErr azblob.ResponseError
+	if errors.As(err, &responseErr) {
+		if response := responseErr.Response(); response != nil {
+			return response.StatusCode == http.StatusTooManyRequests ||
+				(response.StatusCode >= http.StatusInternalServerError && response.StatusCode != http.StatusNotImplemented)
+		}
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
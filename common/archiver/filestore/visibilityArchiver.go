@@ -46,7 +46,7 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		fileMode    os.FileMode
 		dirMode     os.FileMode
-		queryParser QueryParser
+		queryParser archiver.VisibilityQueryParser
 	}
 
 	queryVisibilityToken struct {
@@ -60,7 +60,7 @@ type (
 		domainID      string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		parsedQuery   *archiver.VisibilityQuery
 	}
 )
 
@@ -81,7 +81,7 @@ func NewVisibilityArchiver(
 		container:   container,
 		fileMode:    os.FileMode(fileMode),
 		dirMode:     os.FileMode(dirMode),
-		queryParser: NewQueryParser(),
+		queryParser: archiver.NewVisibilityQueryParser(),
 	}, nil
 }
 
@@ -151,7 +151,7 @@ func (v *visibilityArchiver) Query(
 		return nil, &types.BadRequestError{Message: err.Error()}
 	}
 
-	if parsedQuery.emptyResult {
+	if parsedQuery.EmptyResult {
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
@@ -211,11 +211,11 @@ func (v *visibilityArchiver) query(
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
 
-		if record.CloseTimestamp < request.parsedQuery.earliestCloseTime {
+		if record.CloseTimestamp < request.parsedQuery.EarliestCloseTime {
 			break
 		}
 
-		if matchQuery(record, request.parsedQuery) {
+		if request.parsedQuery.Match((*archiver.ArchiveVisibilityRequest)(record)) {
 			response.Executions = append(response.Executions, convertToExecutionInfo(record))
 			if len(response.Executions) == request.pageSize {
 				if idx != len(files) {
@@ -303,25 +303,6 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	return filteredFilenames, nil
 }
 
-func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
-	if record.CloseTimestamp < query.earliestCloseTime || record.CloseTimestamp > query.latestCloseTime {
-		return false
-	}
-	if query.workflowID != nil && record.WorkflowID != *query.workflowID {
-		return false
-	}
-	if query.runID != nil && record.RunID != *query.runID {
-		return false
-	}
-	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
-		return false
-	}
-	if query.closeStatus != nil && record.CloseStatus != *query.closeStatus {
-		return false
-	}
-	return true
-}
-
 func convertToExecutionInfo(record *visibilityRecord) *types.WorkflowExecutionInfo {
 	return &types.WorkflowExecutionInfo{
 		Execution: &types.WorkflowExecution{
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"os"
 	"path"
 	"testing"
@@ -191,90 +192,6 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	s.Equal(request, archivedRecord)
 }
 
-func (s *visibilityArchiverSuite) TestMatchQuery() {
-	testCases := []struct {
-		query       *parsedQuery
-		record      *visibilityRecord
-		shouldMatch bool
-	}{
-		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-			},
-			record: &visibilityRecord{
-				CloseTimestamp: int64(1999),
-			},
-			shouldMatch: true,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-			},
-			record: &visibilityRecord{
-				CloseTimestamp: int64(999),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-				workflowID:        common.StringPtr("random workflowID"),
-			},
-			record: &visibilityRecord{
-				CloseTimestamp: int64(2000),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-				workflowID:        common.StringPtr("random workflowID"),
-				runID:             common.StringPtr("random runID"),
-			},
-			record: &visibilityRecord{
-				CloseTimestamp:   int64(12345),
-				WorkflowID:       "random workflowID",
-				RunID:            "random runID",
-				WorkflowTypeName: "random type name",
-			},
-			shouldMatch: true,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-				workflowTypeName:  common.StringPtr("some random type name"),
-			},
-			record: &visibilityRecord{
-				CloseTimestamp: int64(12345),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-				workflowTypeName:  common.StringPtr("some random type name"),
-				closeStatus:       types.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
-			},
-			record: &visibilityRecord{
-				CloseTimestamp:   int64(12345),
-				CloseStatus:      types.WorkflowExecutionCloseStatusContinuedAsNew,
-				WorkflowTypeName: "some random type name",
-			},
-			shouldMatch: true,
-		},
-	}
-
-	for _, tc := range testCases {
-		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query))
-	}
-}
-
 func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
 	testCases := []struct {
 		filenames      []string
@@ -337,7 +254,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
+	mockParser := archiver.NewMockVisibilityQueryParser(s.controller)
 	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
@@ -351,10 +268,11 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(1),
-		latestCloseTime:   int64(101),
+	mockParser := archiver.NewMockVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.VisibilityQuery{
+		LatestStartTime:   math.MaxInt64,
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(101),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -371,10 +289,11 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(1),
-		latestCloseTime:   int64(101),
+	mockParser := archiver.NewMockVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.VisibilityQuery{
+		LatestStartTime:   math.MaxInt64,
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(101),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -390,11 +309,12 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(1),
-		latestCloseTime:   int64(10001),
-		workflowID:        common.StringPtr(testWorkflowID),
+	mockParser := archiver.NewMockVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.VisibilityQuery{
+		LatestStartTime:   math.MaxInt64,
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(10001),
+		WorkflowID:        common.StringPtr(testWorkflowID),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -414,11 +334,12 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(1),
-		latestCloseTime:   int64(10001),
-		closeStatus:       types.WorkflowExecutionCloseStatusFailed.Ptr(),
+	mockParser := archiver.NewMockVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.VisibilityQuery{
+		LatestStartTime:   math.MaxInt64,
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(10001),
+		CloseStatus:       types.WorkflowExecutionCloseStatusFailed.Ptr(),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -449,11 +370,12 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	dir := s.T().TempDir()
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(10),
-		latestCloseTime:   int64(10001),
-		closeStatus:       types.WorkflowExecutionCloseStatusFailed.Ptr(),
+	mockParser := archiver.NewMockVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.VisibilityQuery{
+		LatestStartTime:   math.MaxInt64,
+		EarliestCloseTime: int64(10),
+		LatestCloseTime:   int64(10001),
+		CloseStatus:       types.WorkflowExecutionCloseStatusFailed.Ptr(),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	URI, err := archiver.NewURI("file://" + dir)
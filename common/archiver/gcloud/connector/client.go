@@ -145,6 +145,9 @@ func (s *storageWrapper) Query(ctx context.Context, URI archiver.URI, fileNamePr
 		if err == iterator.Done {
 			return fileNames, nil
 		}
+		if err != nil {
+			return nil, err
+		}
 		fileNames = append(fileNames, attrs.Name)
 	}
 
@@ -27,7 +27,6 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/dgryski/go-farm"
 
@@ -59,30 +58,6 @@ func constructHistoryFilenamePrefix(domainID, workflowID, runID string) string {
 	return strings.Join([]string{hash(domainID), hash(workflowID), hash(runID)}, "")
 }
 
-func constructVisibilityFilenamePrefix(domainID, tag string) string {
-	return fmt.Sprintf("%s/%s", domainID, tag)
-}
-
-func constructTimeBasedSearchKey(domainID, tag string, timestamp int64, precision string) string {
-	t := time.Unix(0, timestamp).In(time.UTC)
-	var timeFormat = ""
-	switch precision {
-	case PrecisionSecond:
-		timeFormat = ":05"
-		fallthrough
-	case PrecisionMinute:
-		timeFormat = ":04" + timeFormat
-		fallthrough
-	case PrecisionHour:
-		timeFormat = "15" + timeFormat
-		fallthrough
-	case PrecisionDay:
-		timeFormat = "2006-01-02T" + timeFormat
-	}
-
-	return fmt.Sprintf("%s_%s", constructVisibilityFilenamePrefix(domainID, tag), t.Format(timeFormat))
-}
-
 func hash(s string) (result string) {
 	if s != "" {
 		return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
@@ -138,18 +113,40 @@ func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
 	return record, nil
 }
 
-func constructVisibilityFilename(domain, workflowTypeName, workflowID, runID, tag string, timestamp int64) string {
-	t := time.Unix(0, timestamp).In(time.UTC)
-	prefix := constructVisibilityFilenamePrefix(domain, tag)
-	return fmt.Sprintf("%s_%s_%s_%s_%s.visibility", prefix, t.Format(time.RFC3339), hash(workflowTypeName), hash(workflowID), hash(runID))
-}
-
 func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
 	token := &queryVisibilityToken{}
 	err := json.Unmarshal(bytes, token)
 	return token, err
 }
 
+// constructLegacyVisibilityFilenamePrefix returns the prefix of the visibility records archived before the
+// shared visibility index. Each record was stored twice, only the copy keyed by close time is read:
+// <domain-id>/closeTimeout_<close-time>_<hash(workflow-type-name)>_<hash(workflow-id)>_<hash(run-id)>.visibility
+func constructLegacyVisibilityFilenamePrefix(domainID string) string {
+	return fmt.Sprintf("%s/%s_", domainID, legacyIndexKeyCloseTimeout)
+}
+
+// matchLegacyVisibilityFilename checks the hashed workflow ID and workflow type name in a legacy file name
+// against the query, so that records which can't match are not read
+func matchLegacyVisibilityFilename(key string, query *archiver.VisibilityQuery) bool {
+	parts := strings.Split(strings.TrimSuffix(key, ".visibility"), "_")
+	if len(parts) != 5 {
+		return true
+	}
+	if query.WorkflowTypeName != nil && parts[2] != hash(*query.WorkflowTypeName) {
+		return false
+	}
+	if query.WorkflowID != nil && parts[3] != hash(*query.WorkflowID) {
+		return false
+	}
+	return true
+}
+
+// relativeVisibilityKey strips the URI path from an object name returned by a storage query
+func relativeVisibilityKey(URI archiver.URI, name string) string {
+	return strings.TrimPrefix(name, strings.TrimPrefix(URI.Path(), "/")+"/")
+}
+
 func convertToExecutionInfo(record *visibilityRecord) *types.WorkflowExecutionInfo {
 	return &types.WorkflowExecutionInfo{
 		Execution: &types.WorkflowExecution{
@@ -171,78 +168,6 @@ func convertToExecutionInfo(record *visibilityRecord) *types.WorkflowExecutionIn
 	}
 }
 
-func newRunIDPrecondition(runID string) connector.Precondition {
-	return func(subject interface{}) bool {
-
-		if runID == "" {
-			return true
-		}
-
-		fileName, ok := subject.(string)
-		if !ok {
-			return false
-		}
-
-		if strings.Contains(fileName, runID) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
-			}
-			return strings.Contains(fileName, fileNameParts[4])
-		}
-
-		return false
-	}
-}
-
-func newWorkflowIDPrecondition(workflowID string) connector.Precondition {
-	return func(subject interface{}) bool {
-
-		if workflowID == "" {
-			return true
-		}
-
-		fileName, ok := subject.(string)
-		if !ok {
-			return false
-		}
-
-		if strings.Contains(fileName, workflowID) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
-			}
-			return strings.Contains(fileName, fileNameParts[3])
-		}
-
-		return false
-	}
-}
-
-func newWorkflowTypeNamePrecondition(workflowTypeName string) connector.Precondition {
-	return func(subject interface{}) bool {
-
-		if workflowTypeName == "" {
-			return true
-		}
-
-		fileName, ok := subject.(string)
-		if !ok {
-			return false
-		}
-
-		if strings.Contains(fileName, workflowTypeName) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
-			}
-			return strings.Contains(fileName, fileNameParts[2])
-		}
-
-		return false
-	}
-}
-
 func isRetryableError(err error) (retryable bool) {
 	switch err.Error() {
 	case connector.ErrBucketNotFound.Error(),
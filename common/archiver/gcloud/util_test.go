@@ -123,114 +123,3 @@ func (s *utilSuite) TestConstructHistoryFilenamePrefix() {
 func (s *utilSuite) TestConstructHistoryFilenameMultipart() {
 	s.Equal("28646288347718592068344541402884576509131521284625246243_-24_0.history", constructHistoryFilenameMultipart("domainID", "workflowID", "runID", -24, 0))
 }
-
-func (s *utilSuite) TestConstructVisibilityFilenamePrefix() {
-	s.Equal("domainID/startTimeout", constructVisibilityFilenamePrefix("domainID", indexKeyStartTimeout))
-}
-
-func (s *utilSuite) TestConstructTimeBasedSearchKey() {
-	s.Equal("domainID/startTimeout_1970-01-01T", constructTimeBasedSearchKey("domainID", indexKeyStartTimeout, 1580819141, "Day"))
-}
-
-func (s *utilSuite) TestConstructVisibilityFilename() {
-	s.Equal("domainID/startTimeout_1970-01-01T00:24:32Z_4346151385925082125_8344541402884576509_131521284625246243.visibility", constructVisibilityFilename("domainID", "workflowTypeName", "workflowID", "runID", indexKeyStartTimeout, 1472313624305))
-}
-
-func (s *utilSuite) TestWorkflowIdPrecondition() {
-	testCases := []struct {
-		workflowID     string
-		fileName       string
-		expectedResult bool
-	}{
-		{
-			workflowID:     "4418294404690464320",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: true,
-		},
-		{
-			workflowID:     "testWorkflowID",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: false,
-		},
-		{
-			workflowID:     "",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: true,
-		},
-	}
-
-	for _, testCase := range testCases {
-		s.Equal(newWorkflowIDPrecondition(testCase.workflowID)(testCase.fileName), testCase.expectedResult)
-	}
-
-}
-
-func (s *utilSuite) TestRunIdPrecondition() {
-	testCases := []struct {
-		workflowID     string
-		runID          string
-		fileName       string
-		expectedResult bool
-	}{
-		{
-			workflowID:     "4418294404690464320",
-			runID:          "15619178330501475177",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: true,
-		},
-		{
-			workflowID:     "4418294404690464320",
-			runID:          "15619178330501475177",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_unkonwnRunID.visibility",
-			expectedResult: false,
-		},
-		{
-			workflowID:     "4418294404690464320",
-			runID:          "",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_unkonwnRunID.visibility",
-			expectedResult: true,
-		},
-	}
-
-	for _, testCase := range testCases {
-		s.Equal(newRunIDPrecondition(testCase.runID)(testCase.fileName), testCase.expectedResult)
-	}
-
-}
-
-func (s *utilSuite) TestWorkflowTypeNamePrecondition() {
-	testCases := []struct {
-		workflowID       string
-		runID            string
-		workflowTypeName string
-		fileName         string
-		expectedResult   bool
-	}{
-		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "12851121011173788097",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   true,
-		},
-		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "12851121011173788097",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_12851121011173788098_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   false,
-		},
-		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_unkownWorkflowTypeName_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   true,
-		},
-	}
-
-	for _, testCase := range testCases {
-		s.Equal(newWorkflowTypeNamePrecondition(testCase.workflowTypeName)(testCase.fileName), testCase.expectedResult)
-	}
-
-}
@@ -23,8 +23,7 @@ package gcloud
 import (
 	"context"
 	"errors"
-	"fmt"
-	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/uber/cadence/common/archiver"
@@ -36,10 +35,9 @@ import (
 )
 
 const (
-	errEncodeVisibilityRecord = "failed to encode visibility record"
-	indexKeyStartTimeout      = "startTimeout"
-	indexKeyCloseTimeout      = "closeTimeout"
-	timeoutInSeconds          = 5
+	errEncodeVisibilityRecord  = "failed to encode visibility record"
+	legacyIndexKeyCloseTimeout = "closeTimeout"
+	timeoutInSeconds           = 5
 )
 
 var (
@@ -50,11 +48,13 @@ type (
 	visibilityArchiver struct {
 		container     *archiver.VisibilityBootstrapContainer
 		gcloudStorage connector.Client
-		queryParser   QueryParser
+		queryParser   archiver.VisibilityQueryParser
 	}
 
 	queryVisibilityToken struct {
-		Offset int
+		LastKey string
+		// Legacy is true once all indexed records are read and the legacy file names are being read
+		Legacy bool `json:",omitempty"`
 	}
 
 	visibilityRecord archiver.ArchiveVisibilityRequest
@@ -63,7 +63,7 @@ type (
 		domainID      string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		parsedQuery   *archiver.VisibilityQuery
 	}
 )
 
@@ -71,7 +71,7 @@ func newVisibilityArchiver(container *archiver.VisibilityBootstrapContainer, sto
 	return &visibilityArchiver{
 		container:     container,
 		gcloudStorage: storage,
-		queryParser:   NewQueryParser(),
+		queryParser:   archiver.NewVisibilityQueryParser(),
 	}
 }
 
@@ -126,18 +126,11 @@ func (v *visibilityArchiver) Archive(ctx context.Context, URI archiver.URI, requ
 		return err
 	}
 
-	// The filename has the format: closeTimestamp_hash(runID).visibility
-	// This format allows the archiver to sort all records without reading the file contents
-	filename := constructVisibilityFilename(request.DomainID, request.WorkflowTypeName, request.WorkflowID, request.RunID, indexKeyCloseTimeout, request.CloseTimestamp)
-	if err := v.gcloudStorage.Upload(ctx, URI, filename, encodedVisibilityRecord); err != nil {
-		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
-		return errRetriable
-	}
-
-	filename = constructVisibilityFilename(request.DomainID, request.WorkflowTypeName, request.WorkflowID, request.RunID, indexKeyStartTimeout, request.StartTimestamp)
-	if err := v.gcloudStorage.Upload(ctx, URI, filename, encodedVisibilityRecord); err != nil {
-		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
-		return errRetriable
+	for _, key := range archiver.VisibilityIndexKeys(request) {
+		if err := v.gcloudStorage.Upload(ctx, URI, key, encodedVisibilityRecord); err != nil {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+			return errRetriable
+		}
 	}
 
 	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
@@ -163,7 +156,7 @@ func (v *visibilityArchiver) Query(ctx context.Context, URI archiver.URI, reques
 		return nil, &types.BadRequestError{Message: err.Error()}
 	}
 
-	if parsedQuery.emptyResult {
+	if parsedQuery.EmptyResult {
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
@@ -176,7 +169,7 @@ func (v *visibilityArchiver) Query(ctx context.Context, URI archiver.URI, reques
 }
 
 func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, request *queryVisibilityRequest) (*archiver.QueryVisibilityResponse, error) {
-	token := new(queryVisibilityToken)
+	token := &queryVisibilityToken{}
 	if request.nextPageToken != nil {
 		var err error
 		token, err = deserializeQueryVisibilityToken(request.nextPageToken)
@@ -185,59 +178,95 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 		}
 	}
 
-	var prefix = constructVisibilityFilenamePrefix(request.domainID, indexKeyCloseTimeout)
-	if request.parsedQuery.closeTime != 0 {
-		prefix = constructTimeBasedSearchKey(request.domainID, indexKeyCloseTimeout, request.parsedQuery.closeTime, *request.parsedQuery.searchPrecision)
-	}
-	if request.parsedQuery.startTime != 0 {
-		prefix = constructTimeBasedSearchKey(request.domainID, indexKeyStartTimeout, request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
+	response := &archiver.QueryVisibilityResponse{}
+	if !token.Legacy {
+		search := archiver.NewVisibilityIndexSearch(request.domainID, request.parsedQuery)
+		search.ResumeAfter(token.LastKey)
+		keys, err := v.listVisibilityKeys(ctx, URI, search.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		var searchKeys []string
+		for _, key := range keys {
+			if key <= search.StartAfter {
+				continue
+			}
+			if search.Exhausted(key) {
+				break
+			}
+			searchKeys = append(searchKeys, key)
+		}
+		if err := v.readVisibilityRecords(ctx, URI, searchKeys, false, request, response); err != nil || response.NextPageToken != nil {
+			return response, err
+		}
+		token = &queryVisibilityToken{Legacy: true}
 	}
 
-	filters := make([]connector.Precondition, 0)
-	if request.parsedQuery.workflowID != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowID)))
+	// records archived with the legacy file names are returned after the indexed records
+	keys, err := v.listVisibilityKeys(ctx, URI, constructLegacyVisibilityFilenamePrefix(request.domainID))
+	if err != nil {
+		return nil, err
 	}
-
-	if request.parsedQuery.runID != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.runID)))
+	var legacyKeys []string
+	for _, key := range keys {
+		if key > token.LastKey && matchLegacyVisibilityFilename(key, request.parsedQuery) {
+			legacyKeys = append(legacyKeys, key)
+		}
 	}
-
-	if request.parsedQuery.workflowType != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowType)))
+	if err := v.readVisibilityRecords(ctx, URI, legacyKeys, true, request, response); err != nil {
+		return nil, err
 	}
+	return response, nil
+}
 
-	filenames, completed, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, request.pageSize, token.Offset, filters)
+// listVisibilityKeys returns the sorted keys, relative to the URI path, of the objects with the given prefix
+func (v *visibilityArchiver) listVisibilityKeys(ctx context.Context, URI archiver.URI, prefix string) ([]string, error) {
+	names, err := v.gcloudStorage.Query(ctx, URI, prefix)
 	if err != nil {
 		return nil, &types.InternalServiceError{Message: err.Error()}
 	}
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, relativeVisibilityKey(URI, name))
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
 
-	response := &archiver.QueryVisibilityResponse{}
-	for _, file := range filenames {
-		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", request.domainID, filepath.Base(file)))
+// readVisibilityRecords appends the records matching the query to the response until the page is full
+func (v *visibilityArchiver) readVisibilityRecords(
+	ctx context.Context,
+	URI archiver.URI,
+	keys []string,
+	legacy bool,
+	request *queryVisibilityRequest,
+	response *archiver.QueryVisibilityResponse,
+) error {
+	for _, key := range keys {
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, key)
 		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+			return &types.InternalServiceError{Message: err.Error()}
 		}
 
 		record, err := decodeVisibilityRecord(encodedRecord)
 		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+			return &types.InternalServiceError{Message: err.Error()}
 		}
 
-		response.Executions = append(response.Executions, convertToExecutionInfo(record))
-	}
-
-	if !completed {
-		newToken := &queryVisibilityToken{
-			Offset: currentCursorPos,
+		if !request.parsedQuery.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+			continue
 		}
-		encodedToken, err := serializeToken(newToken)
-		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		if len(response.Executions) == request.pageSize {
+			encodedToken, err := serializeToken(&queryVisibilityToken{LastKey: key, Legacy: legacy})
+			if err != nil {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+			response.NextPageToken = encodedToken
+			return nil
 		}
-		response.NextPageToken = encodedToken
 	}
-
-	return response, nil
+	return nil
 }
 
 // ValidateURI is used to define what a valid URI for an implementation is.
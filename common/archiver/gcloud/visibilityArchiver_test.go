@@ -23,6 +23,8 @@ package gcloud
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,7 +36,6 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/gcloud/connector/mocks"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -205,7 +206,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	mockCtrl := gomock.NewController(s.T())
 	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
+	mockParser := archiver.NewMockVisibilityQueryParser(mockCtrl)
 	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
@@ -227,15 +228,9 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	mockCtrl := gomock.NewController(s.T())
 	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime: int64(101),
-		startTime: int64(1),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID:      testDomainID,
-		Query:         "parsed by mockParser",
+		Query:         "CloseTime = 101 AND StartTime = 1",
 		PageSize:      1,
 		NextPageToken: []byte{1, 2, 3},
 	}
@@ -248,32 +243,16 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	ctx := context.Background()
 	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
 	s.NoError(err)
-	storageWrapper := &mocks.Client{}
-	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, 10, 0, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 1, nil).Times(1)
-	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
-
-	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
-	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
+	visibilityArchiver := newVisibilityArchiver(s.container, newTestStorage(URI))
+	for _, record := range s.expectedVisibilityRecords {
+		s.NoError(visibilityArchiver.Archive(ctx, URI, (*archiver.ArchiveVisibilityRequest)(record)))
+	}
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	dayPrecision := string("Day")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       int64(101),
-		searchPrecision: &dayPrecision,
-		workflowType:    common.StringPtr("MobileOnlyWorkflow::processMobileOnly"),
-		workflowID:      common.StringPtr(testWorkflowID),
-		runID:           common.StringPtr(testRunID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 10,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowType = '%s' AND CloseTime = '2020-02-05T00:00:00Z' AND SearchPrecision = 'Day'", testWorkflowTypeName),
 	}
-
 	response, err := visibilityArchiver.Query(ctx, URI, request)
 	s.NoError(err)
 	s.NotNil(response)
@@ -283,53 +262,144 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
-
-	pageSize := 2
 	ctx := context.Background()
 	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
 	s.NoError(err)
-	storageWrapper := &mocks.Client{}
-	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, pageSize, 0, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility", "closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, false, 1, nil).Times(2)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, pageSize, 1, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:16Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 2, nil).Times(2)
-	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
-	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
-	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:16Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
+	visibilityArchiver := newVisibilityArchiver(s.container, newTestStorage(URI))
+	records := s.newTestVisibilityRecords()
+	for _, record := range records {
+		s.NoError(visibilityArchiver.Archive(ctx, URI, (*archiver.ArchiveVisibilityRequest)(record)))
+	}
 
-	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	for _, query := range []string{
+		fmt.Sprintf("WorkflowID = '%s'", testWorkflowID),
+		fmt.Sprintf("WorkflowTypeName = '%s'", testWorkflowTypeName),
+		"CloseStatus = 'Completed'",
+		"CloseTime >= '2020-02-05T00:00:00Z' AND CloseTime < '2020-02-06T00:00:00Z'",
+	} {
+		request := &archiver.QueryVisibilityRequest{
+			DomainID: testDomainID,
+			PageSize: 2,
+			Query:    query,
+		}
+		response, err := visibilityArchiver.Query(ctx, URI, request)
+		s.NoError(err)
+		s.NotNil(response)
+		s.NotNil(response.NextPageToken)
+		s.Len(response.Executions, 2, query)
+		s.Equal(convertToExecutionInfo(records[2]), response.Executions[0])
+		s.Equal(convertToExecutionInfo(records[1]), response.Executions[1])
+
+		request.NextPageToken = response.NextPageToken
+		response, err = visibilityArchiver.Query(ctx, URI, request)
+		s.NoError(err)
+		s.NotNil(response)
+		s.Nil(response.NextPageToken)
+		s.Len(response.Executions, 1, query)
+		s.Equal(convertToExecutionInfo(records[0]), response.Executions[0])
+	}
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_SearchAttributes() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
 	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
+	visibilityArchiver := newVisibilityArchiver(s.container, newTestStorage(URI))
+	records := s.newTestVisibilityRecords()
+	for _, record := range records {
+		s.NoError(visibilityArchiver.Archive(ctx, URI, (*archiver.ArchiveVisibilityRequest)(record)))
+	}
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	dayPrecision := string("Day")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       int64(101),
-		searchPrecision: &dayPrecision,
-		workflowType:    common.StringPtr("MobileOnlyWorkflow::processMobileOnly"),
-		workflowID:      common.StringPtr(testWorkflowID),
-		runID:           common.StringPtr(testRunID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
-		PageSize: pageSize,
-		Query:    "parsed by mockParser",
+		PageSize: 10,
+		Query:    "CustomKeywordField = 'keyword-1' AND CustomIntField > 0",
+	}
+	response, err := visibilityArchiver.Query(ctx, URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(records[1]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_LegacyFilenames() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	storage := newTestStorage(URI)
+	visibilityArchiver := newVisibilityArchiver(s.container, storage)
+	records := s.newTestVisibilityRecords()
+	s.NoError(visibilityArchiver.Archive(ctx, URI, (*archiver.ArchiveVisibilityRequest)(records[2])))
+
+	otherWorkflow := *records[0]
+	otherWorkflow.WorkflowID = "other-workflow-id"
+	otherWorkflow.RunID = "other-run-id"
+	for _, record := range []*visibilityRecord{records[0], records[1], &otherWorkflow} {
+		encodedRecord, err := encode(record)
+		s.NoError(err)
+		for _, tag := range []string{"closeTimeout", "startTimeout"} {
+			filename := fmt.Sprintf("%s/%s_%s_%s_%s_%s.visibility", record.DomainID, tag,
+				time.Unix(0, record.CloseTimestamp).UTC().Format(time.RFC3339),
+				hash(record.WorkflowTypeName), hash(record.WorkflowID), hash(record.RunID))
+			s.NoError(storage.Upload(ctx, URI, filename, encodedRecord))
+		}
 	}
 
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 2,
+		Query:    fmt.Sprintf("WorkflowID = '%s'", testWorkflowID),
+	}
 	response, err := visibilityArchiver.Query(ctx, URI, request)
 	s.NoError(err)
-	s.NotNil(response)
 	s.NotNil(response.NextPageToken)
-	s.Len(response.Executions, 2)
-	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
-	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[1])
+	s.Equal([]*types.WorkflowExecutionInfo{convertToExecutionInfo(records[2]), convertToExecutionInfo(records[0])}, response.Executions)
 
 	request.NextPageToken = response.NextPageToken
 	response, err = visibilityArchiver.Query(ctx, URI, request)
 	s.NoError(err)
-	s.NotNil(response)
 	s.Nil(response.NextPageToken)
-	s.Len(response.Executions, 1)
-	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
+	s.Equal([]*types.WorkflowExecutionInfo{convertToExecutionInfo(records[1])}, response.Executions)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityRecords() []*visibilityRecord {
+	var records []*visibilityRecord
+	for i := 0; i != 3; i++ {
+		record := *s.expectedVisibilityRecords[0]
+		record.RunID = fmt.Sprintf("%s-%d", testRunID, i)
+		record.CloseTimestamp += int64(i) * int64(time.Second)
+		record.SearchAttributes = map[string]string{
+			"CustomKeywordField": fmt.Sprintf(`"keyword-%d"`, i),
+			"CustomIntField":     fmt.Sprintf("%d", i),
+		}
+		records = append(records, &record)
+	}
+	return records
+}
+
+// newTestStorage emulates a bucket in memory, query results contain the URI path just like the real client
+func newTestStorage(URI archiver.URI) *mocks.Client {
+	objects := make(map[string][]byte)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
+	storageWrapper.On("Upload", mock.Anything, URI, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ archiver.URI, fileName string, file []byte) error {
+			objects[fileName] = file
+			return nil
+		})
+	storageWrapper.On("Query", mock.Anything, URI, mock.Anything).Return(
+		func(_ context.Context, _ archiver.URI, fileNamePrefix string) []string {
+			var names []string
+			for key := range objects {
+				if strings.HasPrefix(key, fileNamePrefix) {
+					names = append(names, strings.TrimPrefix(URI.Path(), "/")+"/"+key)
+				}
+			}
+			return names
+		}, nil)
+	storageWrapper.On("Get", mock.Anything, URI, mock.Anything).Return(
+		func(_ context.Context, _ archiver.URI, fileName string) []byte {
+			return objects[fileName]
+		}, nil)
+	return storageWrapper
 }
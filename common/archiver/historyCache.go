@@ -0,0 +1,172 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	historyCacheTTL = time.Hour
+)
+
+type (
+	cachedHistoryArchiver struct {
+		HistoryArchiver
+		historyCache cache.Cache
+	}
+
+	historyCacheKey struct {
+		URI        string
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	historyCacheEntry struct {
+		historyBatches []*types.History
+		size           uint64
+	}
+
+	// cachedHistoryToken is the page token of history served from the cache, tokens of the wrapped
+	// archiver don't have the field set
+	cachedHistoryToken struct {
+		NextBatchIdx *int `json:"cachedHistoryNextBatchIdx,omitempty"`
+	}
+)
+
+// NewHistoryCache creates a LRU cache for archived histories, bounded by the total encoded size of the histories
+func NewHistoryCache(maxSizeInBytes int) cache.Cache {
+	return cache.New(&cache.Options{
+		TTL:     historyCacheTTL,
+		MaxSize: uint64(maxSizeInBytes),
+		GetCacheItemSizeFunc: func(value interface{}) uint64 {
+			return value.(*historyCacheEntry).size
+		},
+	})
+}
+
+// NewCachedHistoryArchiver wraps a HistoryArchiver so that the decoded history of a run is kept in historyCache,
+// keyed by URI and run. The whole history of the run is read on a cache miss, pages are then served from the
+// cached history without downloading and decoding blobs again.
+// Requests for a specific close failover version and page tokens of the wrapped archiver bypass the cache.
+// Responses served from the cache share the history batches and must not be modified.
+func NewCachedHistoryArchiver(historyArchiver HistoryArchiver, historyCache cache.Cache) HistoryArchiver {
+	return &cachedHistoryArchiver{
+		HistoryArchiver: historyArchiver,
+		historyCache:    historyCache,
+	}
+}
+
+func (a *cachedHistoryArchiver) Get(
+	ctx context.Context,
+	URI URI,
+	request *GetHistoryRequest,
+) (*GetHistoryResponse, error) {
+	if request.CloseFailoverVersion != nil {
+		return a.HistoryArchiver.Get(ctx, URI, request)
+	}
+	nextBatchIdx := 0
+	if request.NextPageToken != nil {
+		token := &cachedHistoryToken{}
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil || token.NextBatchIdx == nil {
+			return a.HistoryArchiver.Get(ctx, URI, request)
+		}
+		nextBatchIdx = *token.NextBatchIdx
+	}
+
+	historyBatches, err := a.getHistoryBatches(ctx, URI, request)
+	if err != nil {
+		return nil, err
+	}
+	if nextBatchIdx < 0 || nextBatchIdx > len(historyBatches) {
+		return nil, &types.BadRequestError{Message: ErrNextPageTokenCorrupted.Error()}
+	}
+
+	response := &GetHistoryResponse{}
+	numOfEvents := 0
+	for _, batch := range historyBatches[nextBatchIdx:] {
+		response.HistoryBatches = append(response.HistoryBatches, batch)
+		nextBatchIdx++
+		numOfEvents += len(batch.Events)
+		if numOfEvents >= request.PageSize {
+			break
+		}
+	}
+	if nextBatchIdx < len(historyBatches) {
+		response.NextPageToken, err = json.Marshal(&cachedHistoryToken{NextBatchIdx: &nextBatchIdx})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// getHistoryBatches returns the whole history of the run, reading it from the wrapped archiver on a cache miss
+func (a *cachedHistoryArchiver) getHistoryBatches(
+	ctx context.Context,
+	URI URI,
+	request *GetHistoryRequest,
+) ([]*types.History, error) {
+	key := historyCacheKey{
+		URI:        URI.String(),
+		DomainID:   request.DomainID,
+		WorkflowID: request.WorkflowID,
+		RunID:      request.RunID,
+	}
+	if entry, ok := a.historyCache.Get(key).(*historyCacheEntry); ok {
+		return entry.historyBatches, nil
+	}
+
+	getRequest := &GetHistoryRequest{
+		DomainID:   request.DomainID,
+		WorkflowID: request.WorkflowID,
+		RunID:      request.RunID,
+		PageSize:   common.GetHistoryMaxPageSize,
+	}
+	var historyBatches []*types.History
+	for {
+		response, err := a.HistoryArchiver.Get(ctx, URI, getRequest)
+		if err != nil {
+			return nil, err
+		}
+		historyBatches = append(historyBatches, response.HistoryBatches...)
+		if response.NextPageToken == nil {
+			break
+		}
+		getRequest.NextPageToken = response.NextPageToken
+	}
+	// the archivers encode history blobs as json, so the encoded size approximates the downloaded size
+	encoded, err := json.Marshal(historyBatches)
+	if err == nil {
+		a.historyCache.Put(key, &historyCacheEntry{
+			historyBatches: historyBatches,
+			size:           uint64(len(encoded)),
+		})
+	}
+	return historyBatches, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestCachedHistoryArchiver_Get(t *testing.T) {
+	URI, err := NewURI("test://history/URI")
+	require.NoError(t, err)
+	request := &GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   2,
+	}
+	firstBatch := &types.History{Events: []*types.HistoryEvent{{ID: 1}, {ID: 2}}}
+	secondBatch := &types.History{Events: []*types.HistoryEvent{{ID: 3}}}
+
+	// the whole history of the run is read once, independent of the requested page size
+	historyArchiver := &HistoryArchiverMock{}
+	historyArchiver.On("Get", mock.Anything, URI, mock.MatchedBy(func(r *GetHistoryRequest) bool {
+		return r.NextPageToken == nil && r.PageSize == common.GetHistoryMaxPageSize
+	})).Return(&GetHistoryResponse{
+		HistoryBatches: []*types.History{firstBatch},
+		NextPageToken:  []byte("next"),
+	}, nil).Once()
+	historyArchiver.On("Get", mock.Anything, URI, mock.MatchedBy(func(r *GetHistoryRequest) bool {
+		return string(r.NextPageToken) == "next"
+	})).Return(&GetHistoryResponse{
+		HistoryBatches: []*types.History{secondBatch},
+	}, nil).Once()
+	defer historyArchiver.AssertExpectations(t)
+
+	cachedArchiver := NewCachedHistoryArchiver(historyArchiver, NewHistoryCache(1024*1024))
+	for i := 0; i < 3; i++ {
+		response, err := cachedArchiver.Get(context.Background(), URI, request)
+		require.NoError(t, err)
+		assert.Equal(t, []*types.History{firstBatch}, response.HistoryBatches)
+		require.NotNil(t, response.NextPageToken)
+
+		nextRequest := *request
+		nextRequest.NextPageToken = response.NextPageToken
+		response, err = cachedArchiver.Get(context.Background(), URI, &nextRequest)
+		require.NoError(t, err)
+		assert.Equal(t, []*types.History{secondBatch}, response.HistoryBatches)
+		assert.Nil(t, response.NextPageToken)
+	}
+}
+
+func TestCachedHistoryArchiver_BypassCache(t *testing.T) {
+	URI, err := NewURI("test://history/URI")
+	require.NoError(t, err)
+	oldVersionPage := &GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{{ID: 1, Version: 1}}}},
+	}
+	archiverTokenPage := &GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{{ID: 2, Version: testCloseFailoverVersion}}}},
+	}
+
+	historyArchiver := &HistoryArchiverMock{}
+	historyArchiver.On("Get", mock.Anything, URI, mock.MatchedBy(func(r *GetHistoryRequest) bool {
+		return r.CloseFailoverVersion != nil && *r.CloseFailoverVersion == 1
+	})).Return(oldVersionPage, nil).Twice()
+	historyArchiver.On("Get", mock.Anything, URI, mock.MatchedBy(func(r *GetHistoryRequest) bool {
+		return string(r.NextPageToken) == `{"NextBatchIdx":1}`
+	})).Return(archiverTokenPage, nil).Twice()
+	defer historyArchiver.AssertExpectations(t)
+
+	cachedArchiver := NewCachedHistoryArchiver(historyArchiver, NewHistoryCache(1024*1024))
+	for i := 0; i < 2; i++ {
+		response, err := cachedArchiver.Get(context.Background(), URI, &GetHistoryRequest{
+			DomainID:             testDomainID,
+			WorkflowID:           testWorkflowID,
+			RunID:                testRunID,
+			PageSize:             10,
+			CloseFailoverVersion: common.Int64Ptr(1),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, oldVersionPage, response)
+
+		// page tokens of the wrapped archiver are passed through
+		response, err = cachedArchiver.Get(context.Background(), URI, &GetHistoryRequest{
+			DomainID:      testDomainID,
+			WorkflowID:    testWorkflowID,
+			RunID:         testRunID,
+			PageSize:      10,
+			NextPageToken: []byte(`{"NextBatchIdx":1}`),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, archiverTokenPage, response)
+	}
+}
+
+func TestCachedHistoryArchiver_ErrorNotCached(t *testing.T) {
+	URI, err := NewURI("test://history/URI")
+	require.NoError(t, err)
+	request := &GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   10,
+	}
+
+	historyArchiver := &HistoryArchiverMock{}
+	historyArchiver.On("Get", mock.Anything, URI, mock.Anything).Return(nil, errors.New("some random error")).Twice()
+	defer historyArchiver.AssertExpectations(t)
+
+	cachedArchiver := NewCachedHistoryArchiver(historyArchiver, NewHistoryCache(1024*1024))
+	for i := 0; i < 2; i++ {
+		response, err := cachedArchiver.Get(context.Background(), URI, request)
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/archiver/gcloud"
 
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/azureblob"
 	"github.com/uber/cadence/common/archiver/filestore"
 	"github.com/uber/cadence/common/archiver/s3store"
 	"github.com/uber/cadence/common/config"
@@ -151,6 +152,18 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 			return nil, ErrArchiverConfigNotFound
 		}
 		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
+
+	case s3store.CompatibleURIScheme:
+		if p.historyArchiverConfigs.S3Compatible == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		historyArchiver, err = s3store.NewS3CompatibleHistoryArchiver(container, p.historyArchiverConfigs.S3Compatible)
+
+	case azureblob.URIScheme:
+		if p.historyArchiverConfigs.AzureBlob == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		historyArchiver, err = azureblob.NewHistoryArchiver(container, p.historyArchiverConfigs.AzureBlob)
 	default:
 		return nil, ErrUnknownScheme
 	}
@@ -201,6 +214,16 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
+	case s3store.CompatibleURIScheme:
+		if p.visibilityArchiverConfigs.S3Compatible == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = s3store.NewS3CompatibleVisibilityArchiver(container, p.visibilityArchiverConfigs.S3Compatible)
+	case azureblob.URIScheme:
+		if p.visibilityArchiverConfigs.AzureBlob == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = azureblob.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.AzureBlob)
 
 	default:
 		return nil, ErrUnknownScheme
@@ -0,0 +1,92 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	// CompatibleURIScheme is the scheme for archiving to an S3 compatible object store such as MinIO
+	CompatibleURIScheme = "s3compat"
+
+	defaultCompatibleRegion = "us-east-1"
+)
+
+var (
+	errEmptyCompatibleEndpoint    = errors.New("empty s3 compatible endpoint")
+	errIncompleteStaticCredential = errors.New("accessKeyID and secretAccessKey must be set together")
+)
+
+// NewS3CompatibleHistoryArchiver creates a new archiver.HistoryArchiver for an S3 compatible object store
+func NewS3CompatibleHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.S3CompatibleArchiver,
+) (archiver.HistoryArchiver, error) {
+	s3Config, err := newCompatibleS3Config(config)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiverWithS3Config(container, CompatibleURIScheme, s3Config, nil)
+}
+
+// NewS3CompatibleVisibilityArchiver creates a new archiver.VisibilityArchiver for an S3 compatible object store
+func NewS3CompatibleVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.S3CompatibleArchiver,
+) (archiver.VisibilityArchiver, error) {
+	s3Config, err := newCompatibleS3Config(config)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiverWithS3Config(container, CompatibleURIScheme, s3Config)
+}
+
+func newCompatibleS3Config(config *config.S3CompatibleArchiver) (*aws.Config, error) {
+	if len(config.Endpoint) == 0 {
+		return nil, errEmptyCompatibleEndpoint
+	}
+	if (len(config.AccessKeyID) == 0) != (len(config.SecretAccessKey) == 0) {
+		return nil, errIncompleteStaticCredential
+	}
+
+	region := config.Region
+	if len(region) == 0 {
+		region = defaultCompatibleRegion
+	}
+	s3Config := &aws.Config{
+		Endpoint:         aws.String(config.Endpoint),
+		Region:           aws.String(region),
+		S3ForcePathStyle: aws.Bool(true),
+		DisableSSL:       aws.Bool(config.DisableSSL),
+	}
+	// without static credentials the default aws credential chain is used
+	if len(config.AccessKeyID) != 0 {
+		s3Config.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, "")
+	}
+	return s3Config, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+)
+
+func TestNewCompatibleS3Config(t *testing.T) {
+	testCases := []struct {
+		config      *config.S3CompatibleArchiver
+		expectedErr error
+	}{
+		{
+			config:      &config.S3CompatibleArchiver{},
+			expectedErr: errEmptyCompatibleEndpoint,
+		},
+		{
+			config: &config.S3CompatibleArchiver{
+				Endpoint:    "http://127.0.0.1:9000",
+				AccessKeyID: "minioadmin",
+			},
+			expectedErr: errIncompleteStaticCredential,
+		},
+		{
+			config: &config.S3CompatibleArchiver{
+				Endpoint: "http://127.0.0.1:9000",
+			},
+		},
+		{
+			config: &config.S3CompatibleArchiver{
+				Endpoint:        "http://127.0.0.1:9000",
+				Region:          "eu-west-1",
+				AccessKeyID:     "minioadmin",
+				SecretAccessKey: "minioadmin",
+				DisableSSL:      true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s3Config, err := newCompatibleS3Config(tc.config)
+		if tc.expectedErr != nil {
+			assert.Equal(t, tc.expectedErr, err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tc.config.Endpoint, aws.StringValue(s3Config.Endpoint))
+		assert.True(t, aws.BoolValue(s3Config.S3ForcePathStyle))
+		assert.Equal(t, tc.config.DisableSSL, aws.BoolValue(s3Config.DisableSSL))
+		if len(tc.config.Region) == 0 {
+			assert.Equal(t, defaultCompatibleRegion, aws.StringValue(s3Config.Region))
+		} else {
+			assert.Equal(t, tc.config.Region, aws.StringValue(s3Config.Region))
+		}
+		if len(tc.config.AccessKeyID) == 0 {
+			assert.Nil(t, s3Config.Credentials)
+			continue
+		}
+		value, err := s3Config.Credentials.Get()
+		require.NoError(t, err)
+		assert.Equal(t, tc.config.AccessKeyID, value.AccessKeyID)
+		assert.Equal(t, tc.config.SecretAccessKey, value.SecretAccessKey)
+	}
+}
+
+func TestSoftValidateURI_CompatibleScheme(t *testing.T) {
+	compatibleURI, err := archiver.NewURI("s3compat://bucket/a/b")
+	require.NoError(t, err)
+	awsURI, err := archiver.NewURI("s3://bucket/a/b")
+	require.NoError(t, err)
+
+	assert.NoError(t, softValidateURI(compatibleURI, CompatibleURIScheme))
+	assert.Equal(t, archiver.ErrURISchemeMismatch, softValidateURI(awsURI, CompatibleURIScheme))
+	assert.Equal(t, archiver.ErrURISchemeMismatch, softValidateURI(compatibleURI, URIScheme))
+}
@@ -66,6 +66,8 @@ type (
 	historyArchiver struct {
 		container *archiver.HistoryBootstrapContainer
 		s3cli     s3iface.S3API
+		// scheme is URIScheme or CompatibleURIScheme
+		scheme string
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 	}
@@ -104,6 +106,15 @@ func newHistoryArchiver(
 		Region:           aws.String(config.Region),
 		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
 	}
+	return newHistoryArchiverWithS3Config(container, URIScheme, s3Config, historyIterator)
+}
+
+func newHistoryArchiverWithS3Config(
+	container *archiver.HistoryBootstrapContainer,
+	scheme string,
+	s3Config *aws.Config,
+	historyIterator archiver.HistoryIterator,
+) (*historyArchiver, error) {
 	sess, err := session.NewSession(s3Config)
 	if err != nil {
 		return nil, err
@@ -112,9 +123,11 @@ func newHistoryArchiver(
 	return &historyArchiver{
 		container:       container,
 		s3cli:           s3.New(sess),
+		scheme:          scheme,
 		historyIterator: historyIterator,
 	}, nil
 }
+
 func (h *historyArchiver) Archive(
 	ctx context.Context,
 	URI archiver.URI,
@@ -140,7 +153,7 @@ func (h *historyArchiver) Archive(
 
 	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
 
-	if err := softValidateURI(URI); err != nil {
+	if err := softValidateURI(URI, h.scheme); err != nil {
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
 		return err
 	}
@@ -267,7 +280,7 @@ func (h *historyArchiver) Get(
 	URI archiver.URI,
 	request *archiver.GetHistoryRequest,
 ) (*archiver.GetHistoryResponse, error) {
-	if err := softValidateURI(URI); err != nil {
+	if err := softValidateURI(URI, h.scheme); err != nil {
 		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
 	}
 
@@ -347,7 +360,7 @@ func (h *historyArchiver) Get(
 }
 
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
-	err := softValidateURI(URI)
+	err := softValidateURI(URI, h.scheme)
 	if err != nil {
 		return err
 	}
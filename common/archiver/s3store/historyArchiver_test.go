@@ -156,11 +156,9 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 			}
 
 			if input.StartAfter != nil {
-				for k, v := range objects {
-					if *input.StartAfter == *v.Key {
-						start = k + 1
-					}
-				}
+				start = sort.Search(len(objects), func(i int) bool {
+					return *objects[i].Key > *input.StartAfter
+				})
 			}
 
 			isTruncated := false
@@ -670,6 +668,7 @@ func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.H
 	archiver := &historyArchiver{
 		container:       s.container,
 		s3cli:           s.s3cli,
+		scheme:          URIScheme,
 		historyIterator: historyIterator,
 	}
 	return archiver
@@ -27,7 +27,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -77,17 +76,24 @@ func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
 	return token, err
 }
 
-func deserializeQueryVisibilityToken(bytes []byte) *string {
-	var ret = string(bytes)
-	return &ret
+// deserializeQueryVisibilityToken returns the last key read and whether it is a key of the legacy layout
+func deserializeQueryVisibilityToken(bytes []byte) (string, bool) {
+	token := string(bytes)
+	if strings.HasPrefix(token, legacyQueryVisibilityTokenPrefix) {
+		return strings.TrimPrefix(token, legacyQueryVisibilityTokenPrefix), true
+	}
+	return token, false
 }
-func serializeQueryVisibilityToken(token string) []byte {
+func serializeQueryVisibilityToken(token string, legacy bool) []byte {
+	if legacy {
+		token = legacyQueryVisibilityTokenPrefix + token
+	}
 	return []byte(token)
 }
 
 // Only validates the scheme and buckets are passed
-func softValidateURI(URI archiver.URI) error {
-	if URI.Scheme() != URIScheme {
+func softValidateURI(URI archiver.URI, scheme string) error {
+	if URI.Scheme() != scheme {
 		return archiver.ErrURISchemeMismatch
 	}
 	if len(URI.Hostname()) == 0 {
@@ -147,33 +153,23 @@ func constructHistoryKeyPrefix(path, domainID, workflowID, runID string) string
 	return strings.TrimLeft(strings.Join([]string{path, domainID, "history", workflowID, runID}, "/"), "/")
 }
 
-func constructTimeBasedSearchKey(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, precision string) string {
-	t := time.Unix(0, timestamp).In(time.UTC)
-	var timeFormat = ""
-	switch precision {
-	case PrecisionSecond:
-		timeFormat = ":05"
-		fallthrough
-	case PrecisionMinute:
-		timeFormat = ":04" + timeFormat
-		fallthrough
-	case PrecisionHour:
-		timeFormat = "15" + timeFormat
-		fallthrough
-	case PrecisionDay:
-		timeFormat = "2006-01-02T" + timeFormat
+// constructLegacyVisibilitySearchPrefix returns the prefix of the visibility keys written before the shared visibility index:
+// <domain-id>/visibility/{workflowID|workflowTypeName}/<value>/{closeTimeout|startTimeout}/<timestamp>/<run-id>.
+// Every record was stored under both its workflow ID and its workflow type name, a query on neither lists the keys of all
+// workflow type names and only reads the closeTimeout ones.
+func constructLegacyVisibilitySearchPrefix(domainID string, query *archiver.VisibilityQuery) string {
+	switch {
+	case query.WorkflowID != nil:
+		return strings.Join([]string{domainID, "visibility", legacyPrimaryIndexKeyWorkflowID, *query.WorkflowID, legacySecondaryIndexKeyCloseTimeout, ""}, "/")
+	case query.WorkflowTypeName != nil:
+		return strings.Join([]string{domainID, "visibility", legacyPrimaryIndexKeyWorkflowTypeName, *query.WorkflowTypeName, legacySecondaryIndexKeyCloseTimeout, ""}, "/")
+	default:
+		return strings.Join([]string{domainID, "visibility", legacyPrimaryIndexKeyWorkflowTypeName, ""}, "/")
 	}
-
-	return fmt.Sprintf("%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(timeFormat))
-}
-
-func constructTimestampIndex(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, runID string) string {
-	t := time.Unix(0, timestamp).In(time.UTC)
-	return fmt.Sprintf("%s/%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(time.RFC3339), runID)
 }
 
-func constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexType string) string {
-	return strings.TrimLeft(strings.Join([]string{path, domainID, "visibility", primaryIndexKey, primaryIndexValue, secondaryIndexType}, "/"), "/")
+func constructVisibilityKey(path, indexKey string) string {
+	return strings.TrimLeft(strings.Join([]string{path, indexKey}, "/"), "/")
 }
 
 func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
@@ -22,6 +22,7 @@ package s3store
 
 import (
 	"context"
+	"strings"
 
 	"github.com/uber/cadence/common/metrics"
 
@@ -40,7 +41,8 @@ type (
 	visibilityArchiver struct {
 		container   *archiver.VisibilityBootstrapContainer
 		s3cli       s3iface.S3API
-		queryParser QueryParser
+		scheme      string
+		queryParser archiver.VisibilityQueryParser
 	}
 
 	visibilityRecord archiver.ArchiveVisibilityRequest
@@ -49,23 +51,18 @@ type (
 		domainID      string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
-	}
-
-	indexToArchive struct {
-		primaryIndex            string
-		primaryIndexValue       string
-		secondaryIndex          string
-		secondaryIndexTimestamp int64
+		parsedQuery   *archiver.VisibilityQuery
 	}
 )
 
 const (
-	errEncodeVisibilityRecord       = "failed to encode visibility record"
-	secondaryIndexKeyStartTimeout   = "startTimeout"
-	secondaryIndexKeyCloseTimeout   = "closeTimeout"
-	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
-	primaryIndexKeyWorkflowID       = "workflowID"
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+
+	// keys of the visibility layout used before the shared visibility index, they are only read
+	legacySecondaryIndexKeyCloseTimeout   = "closeTimeout"
+	legacyPrimaryIndexKeyWorkflowTypeName = "workflowTypeName"
+	legacyPrimaryIndexKeyWorkflowID       = "workflowID"
+	legacyQueryVisibilityTokenPrefix      = "legacy:"
 )
 
 // NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on s3
@@ -84,6 +81,13 @@ func newVisibilityArchiver(
 		Region:           aws.String(config.Region),
 		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
 	}
+	return newVisibilityArchiverWithS3Config(container, URIScheme, s3Config)
+}
+
+func newVisibilityArchiverWithS3Config(
+	container *archiver.VisibilityBootstrapContainer,
+	scheme string,
+	s3Config *aws.Config) (*visibilityArchiver, error) {
 	sess, err := session.NewSession(s3Config)
 	if err != nil {
 		return nil, err
@@ -91,7 +95,8 @@ func newVisibilityArchiver(
 	return &visibilityArchiver{
 		container:   container,
 		s3cli:       s3.New(sess),
-		queryParser: NewQueryParser(),
+		scheme:      scheme,
+		queryParser: archiver.NewVisibilityQueryParser(),
 	}, nil
 }
 
@@ -122,7 +127,7 @@ func (v *visibilityArchiver) Archive(
 		}
 	}()
 
-	if err := softValidateURI(URI); err != nil {
+	if err := softValidateURI(URI, v.scheme); err != nil {
 		archiveFailReason = archiver.ErrReasonInvalidURI
 		return err
 	}
@@ -137,10 +142,9 @@ func (v *visibilityArchiver) Archive(
 		archiveFailReason = errEncodeVisibilityRecord
 		return err
 	}
-	indexes := createIndexesToArchive(request)
 	// Upload archive to all indexes
-	for _, element := range indexes {
-		key := constructTimestampIndex(URI.Path(), request.DomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.RunID)
+	for _, indexKey := range archiver.VisibilityIndexKeys(request) {
+		key := constructVisibilityKey(URI.Path(), indexKey)
 		if err := upload(ctx, v.s3cli, URI, key, encodedVisibilityRecord); err != nil {
 			archiveFailReason = errWriteKey
 			return err
@@ -149,21 +153,12 @@ func (v *visibilityArchiver) Archive(
 	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
 	return nil
 }
-func createIndexesToArchive(request *archiver.ArchiveVisibilityRequest) []indexToArchive {
-	return []indexToArchive{
-		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
-		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyStartTimeout, request.StartTimestamp},
-		{primaryIndexKeyWorkflowID, request.WorkflowID, secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
-		{primaryIndexKeyWorkflowID, request.WorkflowID, secondaryIndexKeyStartTimeout, request.StartTimestamp},
-	}
-}
-
 func (v *visibilityArchiver) Query(
 	ctx context.Context,
 	URI archiver.URI,
 	request *archiver.QueryVisibilityRequest,
 ) (*archiver.QueryVisibilityResponse, error) {
-	if err := softValidateURI(URI); err != nil {
+	if err := softValidateURI(URI, v.scheme); err != nil {
 		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
 	}
 
@@ -176,6 +171,10 @@ func (v *visibilityArchiver) Query(
 		return nil, &types.BadRequestError{Message: err.Error()}
 	}
 
+	if parsedQuery.EmptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
 	return v.query(ctx, URI, &queryVisibilityRequest{
 		domainID:      request.DomainID,
 		pageSize:      request.PageSize,
@@ -191,61 +190,104 @@ func (v *visibilityArchiver) query(
 ) (*archiver.QueryVisibilityResponse, error) {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
-	var token *string
-	if request.nextPageToken != nil {
-		token = deserializeQueryVisibilityToken(request.nextPageToken)
-	}
-	primaryIndex := primaryIndexKeyWorkflowTypeName
-	primaryIndexValue := request.parsedQuery.workflowTypeName
-	if request.parsedQuery.workflowID != nil {
-		primaryIndex = primaryIndexKeyWorkflowID
-		primaryIndexValue = request.parsedQuery.workflowID
+
+	lastKey, legacy := deserializeQueryVisibilityToken(request.nextPageToken)
+	response := &archiver.QueryVisibilityResponse{}
+	if !legacy {
+		search := archiver.NewVisibilityIndexSearch(request.domainID, request.parsedQuery)
+		search.ResumeAfter(lastKey)
+		filter := func(indexKey string) (bool, bool) {
+			return true, search.Exhausted(indexKey)
+		}
+		if err := v.listVisibilityRecords(ctx, URI, search.Prefix, search.StartAfter, filter, false, request, response); err != nil || response.NextPageToken != nil {
+			return response, err
+		}
+		lastKey = ""
 	}
-	var prefix = constructVisibilitySearchPrefix(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout) + "/"
-	if request.parsedQuery.closeTime != nil {
-		prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout, *request.parsedQuery.closeTime, *request.parsedQuery.searchPrecision)
+
+	// records archived with the legacy layout are returned after the indexed records
+	prefix := constructLegacyVisibilitySearchPrefix(request.domainID, request.parsedQuery)
+	closeTimeoutOnly := request.parsedQuery.WorkflowID == nil && request.parsedQuery.WorkflowTypeName == nil
+	filter := func(indexKey string) (bool, bool) {
+		return !closeTimeoutOnly || strings.Contains(indexKey, "/"+legacySecondaryIndexKeyCloseTimeout+"/"), false
 	}
-	if request.parsedQuery.startTime != nil {
-		prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
+	if err := v.listVisibilityRecords(ctx, URI, prefix, lastKey, filter, true, request, response); err != nil {
+		return nil, err
 	}
+	return response, nil
+}
 
-	results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
-		Bucket:            aws.String(URI.Hostname()),
-		Prefix:            aws.String(prefix),
-		MaxKeys:           aws.Int64(int64(request.pageSize)),
-		ContinuationToken: token,
-	})
-	if err != nil {
-		if isRetryableError(err) {
-			return nil, &types.InternalServiceError{Message: err.Error()}
-		}
-		return nil, &types.BadRequestError{Message: err.Error()}
+// listVisibilityRecords lists the keys with the given prefix, relative to the URI path, and appends the records
+// matching the query to the response until the page is full or the filter tells the listing is done
+func (v *visibilityArchiver) listVisibilityRecords(
+	ctx context.Context,
+	URI archiver.URI,
+	prefix string,
+	startAfter string,
+	filter func(indexKey string) (read bool, done bool),
+	legacy bool,
+	request *queryVisibilityRequest,
+	response *archiver.QueryVisibilityResponse,
+) error {
+	pathPrefix := constructVisibilityKey(URI.Path(), "")
+	input := &s3.ListObjectsV2Input{
+		Bucket:  aws.String(URI.Hostname()),
+		Prefix:  aws.String(constructVisibilityKey(URI.Path(), prefix)),
+		MaxKeys: aws.Int64(int64(request.pageSize)),
 	}
-	if len(results.Contents) == 0 {
-		return &archiver.QueryVisibilityResponse{}, nil
+	if startAfter != "" {
+		input.StartAfter = aws.String(constructVisibilityKey(URI.Path(), startAfter))
 	}
 
-	response := &archiver.QueryVisibilityResponse{}
-	if *results.IsTruncated {
-		response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
-	}
-	for _, item := range results.Contents {
-		encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+	for {
+		results, err := v.s3cli.ListObjectsV2WithContext(ctx, input)
 		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+			if isRetryableError(err) {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+			return &types.BadRequestError{Message: err.Error()}
 		}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
-		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+		for _, item := range results.Contents {
+			indexKey := strings.TrimPrefix(*item.Key, pathPrefix)
+			read, done := filter(indexKey)
+			if done {
+				return nil
+			}
+			if !read {
+				continue
+			}
+
+			encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+			if err != nil {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+
+			if !request.parsedQuery.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+				continue
+			}
+			response.Executions = append(response.Executions, convertToExecutionInfo(record))
+			if len(response.Executions) == request.pageSize {
+				response.NextPageToken = serializeQueryVisibilityToken(indexKey, legacy)
+				return nil
+			}
 		}
-		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+
+		if results.IsTruncated == nil || !*results.IsTruncated {
+			return nil
+		}
+		input.StartAfter = nil
+		input.ContinuationToken = results.NextContinuationToken
 	}
-	return response, nil
 }
 
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
-	err := softValidateURI(URI)
+	err := softValidateURI(URI, v.scheme)
 	if err != nil {
 		return err
 	}
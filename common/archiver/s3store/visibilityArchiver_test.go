@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,7 +38,6 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/s3store/mocks"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -105,7 +105,8 @@ func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchive
 	archiver := &visibilityArchiver{
 		container:   s.container,
 		s3cli:       s.s3cli,
-		queryParser: NewQueryParser(),
+		scheme:      URIScheme,
+		queryParser: archiver.NewVisibilityQueryParser(),
 	}
 	return archiver
 }
@@ -211,14 +212,16 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	err = visibilityArchiver.Archive(context.Background(), URI, request)
 	s.NoError(err)
 
-	expectedKey := constructTimestampIndex(URI.Path(), testDomainID, primaryIndexKeyWorkflowID, testWorkflowID, secondaryIndexKeyCloseTimeout, closeTimestamp.UnixNano(), testRunID)
-	data, err := download(context.Background(), visibilityArchiver.s3cli, URI, expectedKey)
-	s.NoError(err, expectedKey)
+	for _, indexKey := range archiver.VisibilityIndexKeys(request) {
+		expectedKey := constructVisibilityKey(URI.Path(), indexKey)
+		data, err := download(context.Background(), visibilityArchiver.s3cli, URI, expectedKey)
+		s.NoError(err, expectedKey)
 
-	archivedRecord := &archiver.ArchiveVisibilityRequest{}
-	err = json.Unmarshal(data, archivedRecord)
-	s.NoError(err)
-	s.Equal(request, archivedRecord)
+		archivedRecord := &archiver.ArchiveVisibilityRequest{}
+		err = json.Unmarshal(data, archivedRecord)
+		s.NoError(err)
+		s.Equal(request, archivedRecord)
+	}
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidURI() {
@@ -243,7 +246,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
+	mockParser := archiver.NewMockVisibilityQueryParser(s.controller)
 	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
@@ -256,16 +259,9 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 }
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID:      common.StringPtr(testWorkflowID),
-		closeTime:       common.Int64Ptr(0),
-		searchPrecision: common.StringPtr(PrecisionSecond),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowID = '%s' AND CloseTime = 0 AND SearchPrecision = 'Second'", testWorkflowID),
 		PageSize: 1,
 	}
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
@@ -277,17 +273,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       common.Int64Ptr(int64(1 * time.Hour)),
-		searchPrecision: common.StringPtr(PrecisionHour),
-		workflowID:      common.StringPtr(testWorkflowID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 10,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowID = '%s' AND CloseTime = %d AND SearchPrecision = 'Hour'", testWorkflowID, int64(time.Hour)),
 	}
 	URI, err := archiver.NewURI(testBucketURI)
 	s.NoError(err)
@@ -296,22 +285,16 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 2)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[1])
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       common.Int64Ptr(0),
-		searchPrecision: common.StringPtr(PrecisionDay),
-		workflowID:      common.StringPtr(testWorkflowID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 2,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowID = '%s' AND CloseTime = 0 AND SearchPrecision = 'Day'", testWorkflowID),
 	}
 	URI, err := archiver.NewURI(testBucketURI)
 	s.NoError(err)
@@ -320,7 +303,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.NotNil(response.NextPageToken)
 	s.Len(response.Executions, 2)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), response.Executions[0])
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
 
 	request.NextPageToken = response.NextPageToken
@@ -329,7 +312,66 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 1)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_LegacyLayout() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI(testBucketURI)
+	s.NoError(err)
+	visibilityArchiver := s.newTestVisibilityArchiver()
+
+	domainID := "legacy-domain-id"
+	var records []*visibilityRecord
+	for i := 0; i != 3; i++ {
+		record := *s.visibilityRecords[0]
+		record.DomainID = domainID
+		record.RunID = fmt.Sprintf("%s-%d", testRunID, i)
+		record.CloseTimestamp += int64(i) * int64(time.Minute)
+		records = append(records, &record)
+	}
+	s.writeVisibilityRecordForQueryTest(visibilityArchiver, records[2])
+	otherWorkflow := *records[0]
+	otherWorkflow.WorkflowID = "other-workflow-id"
+	otherWorkflow.RunID = "other-run-id"
+	otherWorkflow.WorkflowTypeName = "other-workflow-type"
+	otherWorkflow.CloseStatus = types.WorkflowExecutionCloseStatusCompleted
+	for _, record := range []*visibilityRecord{records[0], records[1], &otherWorkflow} {
+		encodedRecord, err := encode(record)
+		s.NoError(err)
+		for _, index := range []struct{ primary, value string }{
+			{legacyPrimaryIndexKeyWorkflowID, record.WorkflowID},
+			{legacyPrimaryIndexKeyWorkflowTypeName, record.WorkflowTypeName},
+		} {
+			for _, secondary := range []string{legacySecondaryIndexKeyCloseTimeout, "startTimeout"} {
+				key := strings.Join([]string{URI.Path(), domainID, "visibility", index.primary, index.value, secondary,
+					time.Unix(0, record.CloseTimestamp).UTC().Format(time.RFC3339), record.RunID}, "/")
+				s.NoError(upload(ctx, s.s3cli, URI, strings.TrimLeft(key, "/"), encodedRecord))
+			}
+		}
+	}
+
+	for _, query := range []string{
+		fmt.Sprintf("WorkflowID = '%s'", testWorkflowID),
+		fmt.Sprintf("WorkflowTypeName = '%s'", testWorkflowTypeName),
+		"CloseStatus = 'Failed'",
+	} {
+		request := &archiver.QueryVisibilityRequest{
+			DomainID: domainID,
+			PageSize: 2,
+			Query:    query,
+		}
+		response, err := visibilityArchiver.Query(ctx, URI, request)
+		s.NoError(err, query)
+		s.NotNil(response.NextPageToken, query)
+		s.Equal([]*types.WorkflowExecutionInfo{convertToExecutionInfo(records[2]), convertToExecutionInfo(records[0])}, response.Executions, query)
+
+		request.NextPageToken = response.NextPageToken
+		response, err = visibilityArchiver.Query(ctx, URI, request)
+		s.NoError(err, query)
+		s.Nil(response.NextPageToken, query)
+		s.Equal([]*types.WorkflowExecutionInfo{convertToExecutionInfo(records[1])}, response.Executions, query)
+	}
 }
 
 type precisionTest struct {
@@ -347,70 +389,70 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 			hour:      0,
 			minute:    0,
 			second:    0,
-			precision: PrecisionDay,
+			precision: archiver.PrecisionDay,
 		},
 		{
 			day:       1,
 			hour:      1,
 			minute:    0,
 			second:    0,
-			precision: PrecisionDay,
+			precision: archiver.PrecisionDay,
 		},
 		{
 			day:       2,
 			hour:      1,
 			minute:    0,
 			second:    0,
-			precision: PrecisionHour,
+			precision: archiver.PrecisionHour,
 		},
 		{
 			day:       2,
 			hour:      1,
 			minute:    30,
 			second:    0,
-			precision: PrecisionHour,
+			precision: archiver.PrecisionHour,
 		},
 		{
 			day:       3,
 			hour:      2,
 			minute:    1,
 			second:    0,
-			precision: PrecisionMinute,
+			precision: archiver.PrecisionMinute,
 		},
 		{
 			day:       3,
 			hour:      2,
 			minute:    1,
 			second:    30,
-			precision: PrecisionMinute,
+			precision: archiver.PrecisionMinute,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    1,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    1,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    2,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    2,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 	}
 	visibilityArchiver := s.newTestVisibilityArchiver()
@@ -433,66 +475,28 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.NoError(err)
 	}
 
-	request := &archiver.QueryVisibilityRequest{
-		DomainID: testDomainID,
-		PageSize: 100,
-		Query:    "parsed by mockParser",
-	}
-
 	for i, testData := range precisionTests {
-		mockParser := NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:       common.Int64Ptr((testData.day+30)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision: common.StringPtr(testData.precision),
-			workflowID:      common.StringPtr(testWorkflowID),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err := visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:       common.Int64Ptr((testData.day)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision: common.StringPtr(testData.precision),
-			workflowID:      common.StringPtr(testWorkflowID),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:        common.Int64Ptr((testData.day+30)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision:  common.StringPtr(testData.precision),
-			workflowTypeName: common.StringPtr(testWorkflowTypeName),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:        common.Int64Ptr((testData.day)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision:  common.StringPtr(testData.precision),
-			workflowTypeName: common.StringPtr(testWorkflowTypeName),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
+		startTime := testData.day*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)
+		closeTime := startTime + 30*int64(time.Hour)*24
+		for _, query := range []string{
+			fmt.Sprintf("WorkflowID = '%s' AND CloseTime = %d AND SearchPrecision = '%s'", testWorkflowID, closeTime, testData.precision),
+			fmt.Sprintf("WorkflowID = '%s' AND StartTime = %d AND SearchPrecision = '%s'", testWorkflowID, startTime, testData.precision),
+			fmt.Sprintf("WorkflowTypeName = '%s' AND CloseTime = %d AND SearchPrecision = '%s'", testWorkflowTypeName, closeTime, testData.precision),
+			fmt.Sprintf("WorkflowType = '%s' AND StartTime = %d AND SearchPrecision = '%s'", testWorkflowTypeName, startTime, testData.precision),
+		} {
+			request := &archiver.QueryVisibilityRequest{
+				DomainID: testDomainID,
+				PageSize: 100,
+				Query:    query,
+			}
+			response, err := visibilityArchiver.Query(context.Background(), URI, request)
+			s.NoError(err)
+			s.NotNil(response)
+			s.Len(response.Executions, 2, "Iteration ", i, query)
+		}
 	}
 }
+
 func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	URI, err := archiver.NewURI(testBucketURI + "/archive-and-query")
@@ -502,55 +506,44 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 		s.NoError(err)
 	}
 
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID: common.StringPtr(testWorkflowID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
-	request := &archiver.QueryVisibilityRequest{
-		DomainID: testDomainID,
-		PageSize: 1,
-		Query:    "parsed by mockParser",
-	}
-	executions := []*types.WorkflowExecutionInfo{}
-	var first = true
-	for first || request.NextPageToken != nil {
-		response, err := visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		executions = append(executions, response.Executions...)
-		request.NextPageToken = response.NextPageToken
-		first = false
+	for _, query := range []string{
+		fmt.Sprintf("WorkflowID = '%s'", testWorkflowID),
+		fmt.Sprintf("WorkflowTypeName = '%s'", testWorkflowTypeName),
+		"CloseStatus = 'Failed'",
+		fmt.Sprintf("CloseTime > %d AND CloseTime <= %d", int64(30*time.Minute), int64(3*time.Hour)),
+	} {
+		request := &archiver.QueryVisibilityRequest{
+			DomainID: testDomainID,
+			PageSize: 1,
+			Query:    query,
+		}
+		executions := []*types.WorkflowExecutionInfo{}
+		var first = true
+		for first || request.NextPageToken != nil {
+			response, err := visibilityArchiver.Query(context.Background(), URI, request)
+			s.NoError(err)
+			s.NotNil(response)
+			executions = append(executions, response.Executions...)
+			request.NextPageToken = response.NextPageToken
+			first = false
+		}
+		s.Len(executions, 3, query)
+		s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[0])
+		s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
+		s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), executions[2])
 	}
-	s.Len(executions, 3)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), executions[0])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
-
-	mockParser = NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowTypeName: common.StringPtr(testWorkflowTypeName),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
-	request = &archiver.QueryVisibilityRequest{
+
+	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
-		PageSize: 1,
-		Query:    "parsed by mockParser",
-	}
-	executions = []*types.WorkflowExecutionInfo{}
-	first = true
-	for first || request.NextPageToken != nil {
-		response, err := visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		executions = append(executions, response.Executions...)
-		request.NextPageToken = response.NextPageToken
-		first = false
+		PageSize: 10,
+		Query:    fmt.Sprintf("WorkflowID = '%s' AND CloseTime >= %d AND CloseTime < %d", testWorkflowID, int64(1*time.Hour), int64(3*time.Hour)),
 	}
-	s.Len(executions, 3)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), executions[0])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
+	response, err := visibilityArchiver.Query(context.Background(), URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[1])
 }
 
 func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
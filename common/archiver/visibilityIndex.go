@@ -0,0 +1,140 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dgryski/go-farm"
+)
+
+// The visibility index layout shared by the object store based visibility archivers.
+// Every archived record is stored under the following keys, relative to the archival URI:
+//
+//	<domain-id>/visibility/all/{startTime|closeTime}/<inverted-timestamp>_<run-id>
+//	<domain-id>/visibility/workflowID/<hash(workflow-id)>/{startTime|closeTime}/<inverted-timestamp>_<run-id>
+//	<domain-id>/visibility/workflowTypeName/<hash(workflow-type-name)>/{startTime|closeTime}/<inverted-timestamp>_<run-id>
+//
+// The inverted timestamp is math.MaxInt64 minus the start or close time in unix nanoseconds, zero padded
+// to 19 digits, so that a lexicographic listing of an index returns the newest records first.
+// An index only narrows down the keys that need to be read, the records are always checked with VisibilityQuery.Match.
+const (
+	visibilityIndexDirectory        = "visibility"
+	visibilityIndexAll              = "all"
+	visibilityIndexWorkflowID       = "workflowID"
+	visibilityIndexWorkflowTypeName = "workflowTypeName"
+	visibilityIndexStartTime        = "startTime"
+	visibilityIndexCloseTime        = "closeTime"
+
+	visibilityIndexTimestampFormat = "%019d"
+	// visibilityIndexKeySeparator separates the inverted timestamp and the run ID in the last key segment,
+	// visibilityIndexKeySeparatorNext is the character right after it
+	visibilityIndexKeySeparator     = "_"
+	visibilityIndexKeySeparatorNext = "`"
+)
+
+type (
+	// VisibilityIndexSearch is the range of keys in the visibility index which may contain records matching a query.
+	// Keys should be listed in lexicographic order starting after StartAfter, listing can stop at the first key past EndKey.
+	VisibilityIndexSearch struct {
+		Prefix     string
+		StartAfter string
+		EndKey     string
+	}
+)
+
+// VisibilityIndexKeys returns all keys the visibility record should be stored under
+func VisibilityIndexKeys(request *ArchiveVisibilityRequest) []string {
+	var keys []string
+	for _, prefix := range []string{
+		constructVisibilityIndexPrefix(request.DomainID, visibilityIndexAll, ""),
+		constructVisibilityIndexPrefix(request.DomainID, visibilityIndexWorkflowID, request.WorkflowID),
+		constructVisibilityIndexPrefix(request.DomainID, visibilityIndexWorkflowTypeName, request.WorkflowTypeName),
+	} {
+		keys = append(keys,
+			constructVisibilityIndexKey(prefix, visibilityIndexStartTime, request.StartTimestamp, request.RunID),
+			constructVisibilityIndexKey(prefix, visibilityIndexCloseTime, request.CloseTimestamp, request.RunID),
+		)
+	}
+	return keys
+}
+
+// NewVisibilityIndexSearch picks the most selective visibility index for the query.
+// Records are returned ordered by start time if the query only restricts the start time, and by close time otherwise.
+func NewVisibilityIndexSearch(domainID string, query *VisibilityQuery) *VisibilityIndexSearch {
+	prefix := constructVisibilityIndexPrefix(domainID, visibilityIndexAll, "")
+	if query.WorkflowID != nil {
+		prefix = constructVisibilityIndexPrefix(domainID, visibilityIndexWorkflowID, *query.WorkflowID)
+	} else if query.WorkflowTypeName != nil {
+		prefix = constructVisibilityIndexPrefix(domainID, visibilityIndexWorkflowTypeName, *query.WorkflowTypeName)
+	}
+
+	timeIndex, earliest, latest := visibilityIndexCloseTime, query.EarliestCloseTime, query.LatestCloseTime
+	if query.HasStartTimeRange() && !query.HasCloseTimeRange() {
+		timeIndex, earliest, latest = visibilityIndexStartTime, query.EarliestStartTime, query.LatestStartTime
+	}
+	prefix = fmt.Sprintf("%s/%s/", prefix, timeIndex)
+
+	search := &VisibilityIndexSearch{
+		Prefix: prefix,
+		EndKey: prefix + invertTimestamp(earliest) + visibilityIndexKeySeparatorNext,
+	}
+	if latest < math.MaxInt64 {
+		search.StartAfter = prefix + invertTimestamp(latest)
+	}
+	return search
+}
+
+// ResumeAfter moves the start of the search past the given key, it is used to continue a paginated search
+func (s *VisibilityIndexSearch) ResumeAfter(key string) {
+	if key > s.StartAfter {
+		s.StartAfter = key
+	}
+}
+
+// Exhausted returns true if the key, and every key listed after it, is out of the searched range
+func (s *VisibilityIndexSearch) Exhausted(key string) bool {
+	return !strings.HasPrefix(key, s.Prefix) || key > s.EndKey
+}
+
+func constructVisibilityIndexPrefix(domainID, index, value string) string {
+	if index == visibilityIndexAll {
+		return strings.Join([]string{domainID, visibilityIndexDirectory, index}, "/")
+	}
+	return strings.Join([]string{domainID, visibilityIndexDirectory, index, hashVisibilityIndexValue(value)}, "/")
+}
+
+func constructVisibilityIndexKey(prefix, timeIndex string, timestamp int64, runID string) string {
+	return fmt.Sprintf("%s/%s/%s%s%s", prefix, timeIndex, invertTimestamp(timestamp), visibilityIndexKeySeparator, runID)
+}
+
+func invertTimestamp(timestamp int64) string {
+	if timestamp < 0 {
+		timestamp = 0
+	}
+	return fmt.Sprintf(visibilityIndexTimestampFormat, math.MaxInt64-timestamp)
+}
+
+func hashVisibilityIndexValue(value string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(value)))
+}
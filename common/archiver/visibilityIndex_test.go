@@ -0,0 +1,78 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVisibilityIndexSearch(t *testing.T) {
+	parser := NewVisibilityQueryParser()
+	var keys []string
+	for i, timestamp := range []int64{1000, 2000, 3000, 4000} {
+		request := &ArchiveVisibilityRequest{
+			DomainID:         "domainID",
+			WorkflowID:       "workflowID",
+			RunID:            string(rune('a' + i)),
+			WorkflowTypeName: "workflowType",
+			StartTimestamp:   timestamp - 500,
+			CloseTimestamp:   timestamp,
+		}
+		keys = append(keys, VisibilityIndexKeys(request)...)
+	}
+	sort.Strings(keys)
+
+	search := func(query string, lastKey string) []string {
+		parsedQuery, err := parser.Parse(query)
+		require.NoError(t, err)
+		search := NewVisibilityIndexSearch("domainID", parsedQuery)
+		search.ResumeAfter(lastKey)
+		var runIDs []string
+		for _, key := range keys {
+			if key <= search.StartAfter || key < search.Prefix {
+				continue
+			}
+			if search.Exhausted(key) {
+				break
+			}
+			runIDs = append(runIDs, key[len(key)-1:])
+		}
+		return runIDs
+	}
+
+	require.Equal(t, []string{"d", "c", "b", "a"}, search("WorkflowID = 'workflowID'", ""))
+	require.Equal(t, []string{"d", "c", "b", "a"}, search("WorkflowType = 'workflowType'", ""))
+	require.Equal(t, []string{"d", "c", "b", "a"}, search("CloseStatus = 'Completed'", ""))
+	require.Empty(t, search("WorkflowID = 'another workflowID'", ""))
+	require.Equal(t, []string{"c", "b"}, search("CloseTime >= 2000 and CloseTime <= 3000", ""))
+	require.Equal(t, []string{"c", "b"}, search("StartTime > 1000 and StartTime < 3000", ""))
+	require.Equal(t, []string{"b"}, search("WorkflowID = 'workflowID' and CloseTime = 2000", ""))
+
+	parsedQuery, err := parser.Parse("WorkflowID = 'workflowID' and CloseTime <= 3000")
+	require.NoError(t, err)
+	first := search("WorkflowID = 'workflowID' and CloseTime <= 3000", "")
+	require.Equal(t, []string{"c", "b", "a"}, first)
+	lastKey := NewVisibilityIndexSearch("domainID", parsedQuery).Prefix + invertTimestamp(3000) + visibilityIndexKeySeparator + "c"
+	require.Equal(t, []string{"b", "a"}, search("WorkflowID = 'workflowID' and CloseTime <= 3000", lastKey))
+}
@@ -0,0 +1,545 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source visibilityQuery.go -destination visibilityQuery_mock.go
+
+package archiver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// VisibilityQueryParser parses the archived visibility query syntax shared by all visibility archivers
+	VisibilityQueryParser interface {
+		Parse(query string) (*VisibilityQuery, error)
+	}
+
+	// VisibilityQuery is the parsed form of an archived visibility query.
+	// All time bounds are inclusive and in unix nanoseconds.
+	VisibilityQuery struct {
+		WorkflowID        *string
+		RunID             *string
+		WorkflowTypeName  *string
+		CloseStatus       *types.WorkflowExecutionCloseStatus
+		EarliestStartTime int64
+		LatestStartTime   int64
+		EarliestCloseTime int64
+		LatestCloseTime   int64
+		SearchAttributes  []*SearchAttributeCondition
+		// EmptyResult is set when the query contains contradicting conditions
+		EmptyResult bool
+	}
+
+	// SearchAttributeCondition is a single comparison against a search attribute
+	SearchAttributeCondition struct {
+		Key      string
+		Operator string
+		Value    interface{}
+	}
+
+	visibilityQueryParser struct{}
+
+	visibilityQueryParseState struct {
+		query           *VisibilityQuery
+		startTime       *int64
+		closeTime       *int64
+		searchPrecision *string
+	}
+)
+
+// All system fields allowed for filtering, any other name is treated as a search attribute
+const (
+	WorkflowID       = "WorkflowID"
+	RunID            = "RunID"
+	WorkflowType     = "WorkflowType"
+	WorkflowTypeName = "WorkflowTypeName"
+	CloseStatus      = "CloseStatus"
+	StartTime        = "StartTime"
+	CloseTime        = "CloseTime"
+	SearchPrecision  = "SearchPrecision"
+)
+
+// Precision specific values
+const (
+	PrecisionDay    = "Day"
+	PrecisionHour   = "Hour"
+	PrecisionMinute = "Minute"
+	PrecisionSecond = "Second"
+)
+
+const (
+	queryTemplate = "select * from dummy where %s"
+
+	defaultDateTimeFormat = time.RFC3339
+)
+
+// visibilityQueryStringEscaper escapes the characters which have a special meaning in a single quoted string
+var visibilityQueryStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+var systemFields = []string{WorkflowID, RunID, WorkflowType, WorkflowTypeName, CloseStatus, StartTime, CloseTime, SearchPrecision}
+
+var searchPrecisions = map[string]time.Duration{
+	PrecisionDay:    24 * time.Hour,
+	PrecisionHour:   time.Hour,
+	PrecisionMinute: time.Minute,
+	PrecisionSecond: time.Second,
+}
+
+// NewVisibilityQueryParser creates a new query parser for archived visibility records
+func NewVisibilityQueryParser() VisibilityQueryParser {
+	return &visibilityQueryParser{}
+}
+
+func (p *visibilityQueryParser) Parse(query string) (*VisibilityQuery, error) {
+	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	if err != nil {
+		return nil, err
+	}
+	whereExpr := stmt.(*sqlparser.Select).Where.Expr
+	state := &visibilityQueryParseState{
+		query: &VisibilityQuery{
+			LatestStartTime: math.MaxInt64,
+			LatestCloseTime: math.MaxInt64,
+		},
+	}
+	if err := p.convertWhereExpr(whereExpr, state); err != nil {
+		return nil, err
+	}
+	if err := state.applyTimeEquality(); err != nil {
+		return nil, err
+	}
+	return state.query, nil
+}
+
+func (p *visibilityQueryParser) convertWhereExpr(expr sqlparser.Expr, state *visibilityQueryParseState) error {
+	if expr == nil {
+		return errors.New("where expression is nil")
+	}
+
+	switch expr := expr.(type) {
+	case *sqlparser.ComparisonExpr:
+		return p.convertComparisonExpr(expr, state)
+	case *sqlparser.AndExpr:
+		return p.convertAndExpr(expr, state)
+	case *sqlparser.ParenExpr:
+		return p.convertParenExpr(expr, state)
+	default:
+		return errors.New("only comparison and \"and\" expression is supported")
+	}
+}
+
+func (p *visibilityQueryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, state *visibilityQueryParseState) error {
+	return p.convertWhereExpr(parenExpr.Expr, state)
+}
+
+func (p *visibilityQueryParser) convertAndExpr(andExpr *sqlparser.AndExpr, state *visibilityQueryParseState) error {
+	if err := p.convertWhereExpr(andExpr.Left, state); err != nil {
+		return err
+	}
+	return p.convertWhereExpr(andExpr.Right, state)
+}
+
+func (p *visibilityQueryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, state *visibilityQueryParseState) error {
+	colName, ok := compExpr.Left.(*sqlparser.ColName)
+	if !ok {
+		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
+	}
+	colNameStr := sqlparser.String(colName)
+	if isMisspelledSystemField(colNameStr) {
+		return fmt.Errorf("unknown filter name: %s", colNameStr)
+	}
+	op := compExpr.Operator
+
+	if boolVal, ok := compExpr.Right.(sqlparser.BoolVal); ok && !isSystemField(colNameStr) {
+		return p.convertSearchAttribute(colNameStr, op, bool(boolVal), state)
+	}
+	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
+	if !ok {
+		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+	}
+	valStr := sqlparser.String(valExpr)
+	query := state.query
+
+	switch colNameStr {
+	case WorkflowID:
+		return convertStringEquality(colNameStr, op, valExpr, &query.WorkflowID, query)
+	case RunID:
+		return convertStringEquality(colNameStr, op, valExpr, &query.RunID, query)
+	case WorkflowType, WorkflowTypeName:
+		return convertStringEquality(colNameStr, op, valExpr, &query.WorkflowTypeName, query)
+	case CloseStatus:
+		val, err := extractStringValue(valStr)
+		if err != nil {
+			// if failed to extract string value, it means user input close status as a number
+			val = valStr
+		}
+		if op != "=" {
+			return fmt.Errorf("only operator = is supported for %s", CloseStatus)
+		}
+		status, err := convertStatusStr(val)
+		if err != nil {
+			return err
+		}
+		if query.CloseStatus != nil && *query.CloseStatus != status {
+			query.EmptyResult = true
+			return nil
+		}
+		query.CloseStatus = status.Ptr()
+	case StartTime:
+		timestamp, err := convertToTimestamp(valStr)
+		if err != nil {
+			return err
+		}
+		return convertTimeRange(colNameStr, op, timestamp, &state.startTime, &query.EarliestStartTime, &query.LatestStartTime, query)
+	case CloseTime:
+		timestamp, err := convertToTimestamp(valStr)
+		if err != nil {
+			return err
+		}
+		return convertTimeRange(colNameStr, op, timestamp, &state.closeTime, &query.EarliestCloseTime, &query.LatestCloseTime, query)
+	case SearchPrecision:
+		val, err := extractStringValue(valStr)
+		if err != nil {
+			return err
+		}
+		if op != "=" {
+			return fmt.Errorf("only operator = is supported for %s", SearchPrecision)
+		}
+		if state.searchPrecision != nil && *state.searchPrecision != val {
+			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
+		}
+		if _, ok := searchPrecisions[val]; !ok {
+			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
+		}
+		state.searchPrecision = common.StringPtr(val)
+	default:
+		val, err := convertSearchAttributeValue(valExpr)
+		if err != nil {
+			return err
+		}
+		return p.convertSearchAttribute(colNameStr, op, val, state)
+	}
+
+	return nil
+}
+
+func (p *visibilityQueryParser) convertSearchAttribute(key string, op string, val interface{}, state *visibilityQueryParseState) error {
+	switch op {
+	case "=", "!=", "<", "<=", ">", ">=":
+	default:
+		return fmt.Errorf("operator %s is not supported for search attribute %s", op, key)
+	}
+	if _, ok := val.(bool); ok && op != "=" && op != "!=" {
+		return fmt.Errorf("operator %s is not supported for bool value of search attribute %s", op, key)
+	}
+	state.query.SearchAttributes = append(state.query.SearchAttributes, &SearchAttributeCondition{
+		Key:      key,
+		Operator: op,
+		Value:    val,
+	})
+	return nil
+}
+
+func isSystemField(name string) bool {
+	for _, field := range systemFields {
+		if name == field {
+			return true
+		}
+	}
+	return false
+}
+
+// isMisspelledSystemField catches system fields written in the wrong case,
+// which would otherwise silently be treated as search attributes
+func isMisspelledSystemField(name string) bool {
+	for _, field := range systemFields {
+		if name != field && strings.EqualFold(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyTimeEquality turns the StartTime and CloseTime equality conditions into ranges.
+// When SearchPrecision is specified the range covers the whole day, hour, minute or second
+// the timestamp falls into, otherwise only the exact timestamp is matched.
+func (s *visibilityQueryParseState) applyTimeEquality() error {
+	if s.searchPrecision != nil && s.startTime == nil && s.closeTime == nil {
+		return fmt.Errorf("%s requires a %s or %s", SearchPrecision, StartTime, CloseTime)
+	}
+	query := s.query
+	for _, equality := range []struct {
+		timestamp *int64
+		earliest  *int64
+		latest    *int64
+	}{
+		{s.startTime, &query.EarliestStartTime, &query.LatestStartTime},
+		{s.closeTime, &query.EarliestCloseTime, &query.LatestCloseTime},
+	} {
+		if equality.timestamp == nil {
+			continue
+		}
+		lower, upper := *equality.timestamp, *equality.timestamp
+		if s.searchPrecision != nil {
+			precision := searchPrecisions[*s.searchPrecision]
+			lower = time.Unix(0, lower).UTC().Truncate(precision).UnixNano()
+			upper = lower + precision.Nanoseconds() - 1
+		}
+		*equality.earliest = common.MaxInt64(*equality.earliest, lower)
+		*equality.latest = common.MinInt64(*equality.latest, upper)
+	}
+	if query.EarliestStartTime > query.LatestStartTime || query.EarliestCloseTime > query.LatestCloseTime {
+		query.EmptyResult = true
+	}
+	return nil
+}
+
+// HasStartTimeRange returns true if the query restricts the start time of workflows
+func (q *VisibilityQuery) HasStartTimeRange() bool {
+	return q.EarliestStartTime > 0 || q.LatestStartTime < math.MaxInt64
+}
+
+// HasCloseTimeRange returns true if the query restricts the close time of workflows
+func (q *VisibilityQuery) HasCloseTimeRange() bool {
+	return q.EarliestCloseTime > 0 || q.LatestCloseTime < math.MaxInt64
+}
+
+// Match returns true if the archived visibility record satisfies every condition of the query
+func (q *VisibilityQuery) Match(record *ArchiveVisibilityRequest) bool {
+	if q.EmptyResult {
+		return false
+	}
+	if record.CloseTimestamp < q.EarliestCloseTime || record.CloseTimestamp > q.LatestCloseTime {
+		return false
+	}
+	if record.StartTimestamp < q.EarliestStartTime || record.StartTimestamp > q.LatestStartTime {
+		return false
+	}
+	if q.WorkflowID != nil && *q.WorkflowID != record.WorkflowID {
+		return false
+	}
+	if q.RunID != nil && *q.RunID != record.RunID {
+		return false
+	}
+	if q.WorkflowTypeName != nil && *q.WorkflowTypeName != record.WorkflowTypeName {
+		return false
+	}
+	if q.CloseStatus != nil && *q.CloseStatus != record.CloseStatus {
+		return false
+	}
+	for _, condition := range q.SearchAttributes {
+		if !condition.match(record.SearchAttributes) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *SearchAttributeCondition) match(searchAttributes map[string]string) bool {
+	encoded, ok := searchAttributes[c.Key]
+	if !ok {
+		return false
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(encoded), &value); err != nil {
+		value = encoded
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	// for array values, "!=" holds only if none of the elements equals the condition value,
+	// every other operator holds if any of the elements satisfies it
+	if c.Operator == "!=" {
+		for _, v := range values {
+			if cmp, ok := compareSearchAttributeValues(v, c.Value); ok && cmp == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		cmp, ok := compareSearchAttributeValues(v, c.Value)
+		if !ok {
+			continue
+		}
+		switch c.Operator {
+		case "=":
+			ok = cmp == 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// compareSearchAttributeValues compares a decoded search attribute value with a query value,
+// the second return value is false if the two values are not comparable
+func compareSearchAttributeValues(value interface{}, target interface{}) (int, bool) {
+	switch target := target.(type) {
+	case string:
+		v, ok := value.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(v, target), true
+	case float64:
+		v, ok := value.(float64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case v < target:
+			return -1, true
+		case v > target:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case bool:
+		v, ok := value.(bool)
+		if !ok || v != target {
+			return 1, ok
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+func convertStringEquality(name string, op string, valExpr *sqlparser.SQLVal, field **string, query *VisibilityQuery) error {
+	// the value is taken unescaped from the parsed literal, so quotes and backslashes in it round trip
+	if valExpr.Type != sqlparser.StrVal {
+		return fmt.Errorf("value %s is not a string value", sqlparser.String(valExpr))
+	}
+	val := string(valExpr.Val)
+	if op != "=" {
+		return fmt.Errorf("only operator = is supported for %s", name)
+	}
+	if *field != nil && **field != val {
+		query.EmptyResult = true
+		return nil
+	}
+	*field = common.StringPtr(val)
+	return nil
+}
+
+func convertTimeRange(name string, op string, timestamp int64, equality **int64, earliest *int64, latest *int64, query *VisibilityQuery) error {
+	switch op {
+	case "=":
+		if *equality != nil && **equality != timestamp {
+			query.EmptyResult = true
+			return nil
+		}
+		*equality = common.Int64Ptr(timestamp)
+	case "<":
+		*latest = common.MinInt64(*latest, timestamp-1)
+	case "<=":
+		*latest = common.MinInt64(*latest, timestamp)
+	case ">":
+		*earliest = common.MaxInt64(*earliest, timestamp+1)
+	case ">=":
+		*earliest = common.MaxInt64(*earliest, timestamp)
+	default:
+		return fmt.Errorf("operator %s is not supported for %s", op, name)
+	}
+	return nil
+}
+
+func convertSearchAttributeValue(valExpr *sqlparser.SQLVal) (interface{}, error) {
+	switch valExpr.Type {
+	case sqlparser.StrVal:
+		return string(valExpr.Val), nil
+	case sqlparser.IntVal, sqlparser.FloatVal:
+		return strconv.ParseFloat(string(valExpr.Val), 64)
+	default:
+		return nil, fmt.Errorf("invalid value: %s", sqlparser.String(valExpr))
+	}
+}
+
+func convertToTimestamp(timeStr string) (int64, error) {
+	timestamp, err := strconv.ParseInt(timeStr, 10, 64)
+	if err == nil {
+		return timestamp, nil
+	}
+	timestampStr, err := extractStringValue(timeStr)
+	if err != nil {
+		return 0, err
+	}
+	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
+	if err != nil {
+		return 0, err
+	}
+	return parsedTime.UnixNano(), nil
+}
+
+func convertStatusStr(statusStr string) (types.WorkflowExecutionCloseStatus, error) {
+	statusStr = strings.ToLower(strings.TrimSpace(statusStr))
+	switch statusStr {
+	case "completed", strconv.Itoa(int(types.WorkflowExecutionCloseStatusCompleted)):
+		return types.WorkflowExecutionCloseStatusCompleted, nil
+	case "failed", strconv.Itoa(int(types.WorkflowExecutionCloseStatusFailed)):
+		return types.WorkflowExecutionCloseStatusFailed, nil
+	case "canceled", strconv.Itoa(int(types.WorkflowExecutionCloseStatusCanceled)):
+		return types.WorkflowExecutionCloseStatusCanceled, nil
+	case "terminated", strconv.Itoa(int(types.WorkflowExecutionCloseStatusTerminated)):
+		return types.WorkflowExecutionCloseStatusTerminated, nil
+	case "continuedasnew", "continued_as_new", strconv.Itoa(int(types.WorkflowExecutionCloseStatusContinuedAsNew)):
+		return types.WorkflowExecutionCloseStatusContinuedAsNew, nil
+	case "timedout", "timed_out", strconv.Itoa(int(types.WorkflowExecutionCloseStatusTimedOut)):
+		return types.WorkflowExecutionCloseStatusTimedOut, nil
+	default:
+		return 0, fmt.Errorf("unknown workflow close status: %s", statusStr)
+	}
+}
+
+// EscapeVisibilityQueryString escapes a value to be used in a single quoted string of an archived visibility query
+func EscapeVisibilityQueryString(s string) string {
+	return visibilityQueryStringEscaper.Replace(s)
+}
+
+func extractStringValue(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return s[1 : len(s)-1], nil
+	}
+	return "", fmt.Errorf("value %s is not a string value", s)
+}
@@ -21,10 +21,10 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: queryParser.go
+// Source: visibilityQuery.go
 
-// Package gcloud is a generated GoMock package.
-package gcloud
+// Package archiver is a generated GoMock package.
+package archiver
 
 import (
 	reflect "reflect"
@@ -32,40 +32,40 @@ import (
 	gomock "github.com/golang/mock/gomock"
 )
 
-// MockQueryParser is a mock of QueryParser interface.
-type MockQueryParser struct {
+// MockVisibilityQueryParser is a mock of VisibilityQueryParser interface.
+type MockVisibilityQueryParser struct {
 	ctrl     *gomock.Controller
-	recorder *MockQueryParserMockRecorder
+	recorder *MockVisibilityQueryParserMockRecorder
 }
 
-// MockQueryParserMockRecorder is the mock recorder for MockQueryParser.
-type MockQueryParserMockRecorder struct {
-	mock *MockQueryParser
+// MockVisibilityQueryParserMockRecorder is the mock recorder for MockVisibilityQueryParser.
+type MockVisibilityQueryParserMockRecorder struct {
+	mock *MockVisibilityQueryParser
 }
 
-// NewMockQueryParser creates a new mock instance.
-func NewMockQueryParser(ctrl *gomock.Controller) *MockQueryParser {
-	mock := &MockQueryParser{ctrl: ctrl}
-	mock.recorder = &MockQueryParserMockRecorder{mock}
+// NewMockVisibilityQueryParser creates a new mock instance.
+func NewMockVisibilityQueryParser(ctrl *gomock.Controller) *MockVisibilityQueryParser {
+	mock := &MockVisibilityQueryParser{ctrl: ctrl}
+	mock.recorder = &MockVisibilityQueryParserMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockQueryParser) EXPECT() *MockQueryParserMockRecorder {
+func (m *MockVisibilityQueryParser) EXPECT() *MockVisibilityQueryParserMockRecorder {
 	return m.recorder
 }
 
 // Parse mocks base method.
-func (m *MockQueryParser) Parse(query string) (*parsedQuery, error) {
+func (m *MockVisibilityQueryParser) Parse(query string) (*VisibilityQuery, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Parse", query)
-	ret0, _ := ret[0].(*parsedQuery)
+	ret0, _ := ret[0].(*VisibilityQuery)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Parse indicates an expected call of Parse.
-func (mr *MockQueryParserMockRecorder) Parse(query interface{}) *gomock.Call {
+func (mr *MockVisibilityQueryParserMockRecorder) Parse(query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQueryParser)(nil).Parse), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockVisibilityQueryParser)(nil).Parse), query)
 }
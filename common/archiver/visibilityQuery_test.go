@@ -0,0 +1,414 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type visibilityQuerySuite struct {
+	*require.Assertions
+	suite.Suite
+
+	parser VisibilityQueryParser
+}
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.parser = NewVisibilityQueryParser()
+}
+
+func (s *visibilityQuerySuite) TestParseWorkflowID_RunID_WorkflowType() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *VisibilityQuery
+	}{
+		{
+			query: "WorkflowID = \"random workflowID\"",
+			parsedQuery: &VisibilityQuery{
+				WorkflowID: common.StringPtr("random workflowID"),
+			},
+		},
+		{
+			query: "WorkflowID = \"random workflowID\" and WorkflowID = \"random workflowID\"",
+			parsedQuery: &VisibilityQuery{
+				WorkflowID: common.StringPtr("random workflowID"),
+			},
+		},
+		{
+			query: "RunID = \"random runID\"",
+			parsedQuery: &VisibilityQuery{
+				RunID: common.StringPtr("random runID"),
+			},
+		},
+		{
+			query: "WorkflowType = \"random typeName\"",
+			parsedQuery: &VisibilityQuery{
+				WorkflowTypeName: common.StringPtr("random typeName"),
+			},
+		},
+		{
+			query: "WorkflowTypeName = 'random typeName'",
+			parsedQuery: &VisibilityQuery{
+				WorkflowTypeName: common.StringPtr("random typeName"),
+			},
+		},
+		{
+			query: "WorkflowType = 'random typeName' and WorkflowTypeName = \"another typeName\"",
+			parsedQuery: &VisibilityQuery{
+				EmptyResult: true,
+			},
+		},
+		{
+			query: "WorkflowType = 'random typeName' and (WorkflowID = \"random workflowID\" and RunID='random runID')",
+			parsedQuery: &VisibilityQuery{
+				WorkflowID:       common.StringPtr("random workflowID"),
+				RunID:            common.StringPtr("random runID"),
+				WorkflowTypeName: common.StringPtr("random typeName"),
+			},
+		},
+		{
+			query:     "runID = random workflowID",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
+			expectErr: true,
+		},
+		{
+			query:     "workflowid = \"random workflowID\"",
+			expectErr: true,
+		},
+		{
+			query:     "RunID > \"random workflowID\"",
+			expectErr: true,
+		},
+		{
+			query: "WorkflowID = 'it\\'s a \\\\ workflowID'",
+			parsedQuery: &VisibilityQuery{
+				WorkflowID: common.StringPtr(`it's a \ workflowID`),
+			},
+		},
+		{
+			query: fmt.Sprintf("WorkflowID = '%s'", EscapeVisibilityQueryString(`' or WorkflowID = '\`)),
+			parsedQuery: &VisibilityQuery{
+				WorkflowID: common.StringPtr(`' or WorkflowID = '\`),
+			},
+		},
+		{
+			query:     "WorkflowID = 123",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult, tc.query)
+		if !tc.parsedQuery.EmptyResult {
+			s.Equal(tc.parsedQuery.WorkflowID, parsedQuery.WorkflowID)
+			s.Equal(tc.parsedQuery.RunID, parsedQuery.RunID)
+			s.Equal(tc.parsedQuery.WorkflowTypeName, parsedQuery.WorkflowTypeName)
+		}
+	}
+}
+
+func (s *visibilityQuerySuite) TestParseCloseStatus() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *VisibilityQuery
+	}{
+		{
+			query: "CloseStatus = \"Completed\"",
+			parsedQuery: &VisibilityQuery{
+				CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+			},
+		},
+		{
+			query: "CloseStatus = 'continuedasnew'",
+			parsedQuery: &VisibilityQuery{
+				CloseStatus: types.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
+			},
+		},
+		{
+			query: "CloseStatus = 'TIMED_OUT'",
+			parsedQuery: &VisibilityQuery{
+				CloseStatus: types.WorkflowExecutionCloseStatusTimedOut.Ptr(),
+			},
+		},
+		{
+			query: "(CloseStatus = 'Timedout' and CloseStatus = \"canceled\")",
+			parsedQuery: &VisibilityQuery{
+				EmptyResult: true,
+			},
+		},
+		{
+			query: "CloseStatus = 1",
+			parsedQuery: &VisibilityQuery{
+				CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr(),
+			},
+		},
+		{
+			query:     "closeStatus = \"Failed\"",
+			expectErr: true,
+		},
+		{
+			query:     "CloseStatus = \"unknown\"",
+			expectErr: true,
+		},
+		{
+			query:     "CloseStatus > \"Failed\"",
+			expectErr: true,
+		},
+		{
+			query:     "CloseStatus = 10",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult, tc.query)
+		if !tc.parsedQuery.EmptyResult {
+			s.Equal(tc.parsedQuery.CloseStatus, parsedQuery.CloseStatus)
+		}
+	}
+}
+
+func (s *visibilityQuerySuite) TestParseTimeRange() {
+	testCases := []struct {
+		query             string
+		expectErr         bool
+		emptyResult       bool
+		earliestStartTime int64
+		latestStartTime   int64
+		earliestCloseTime int64
+		latestCloseTime   int64
+	}{
+		{
+			query:             "CloseTime <= 1000",
+			latestStartTime:   math.MaxInt64,
+			earliestCloseTime: 0,
+			latestCloseTime:   1000,
+		},
+		{
+			query:             "CloseTime < 2000 and CloseTime <= 1000 and CloseTime > 300",
+			latestStartTime:   math.MaxInt64,
+			earliestCloseTime: 301,
+			latestCloseTime:   1000,
+		},
+		{
+			query:             "CloseTime = 2000 and (CloseTime > 1000 and CloseTime <= 9999)",
+			latestStartTime:   math.MaxInt64,
+			earliestCloseTime: 2000,
+			latestCloseTime:   2000,
+		},
+		{
+			query:             "CloseTime <= \"2019-01-01T11:11:11Z\" and CloseTime >= 1000000",
+			latestStartTime:   math.MaxInt64,
+			earliestCloseTime: 1000000,
+			latestCloseTime:   1546341071000000000,
+		},
+		{
+			query:             "StartTime >= 1000 and StartTime < 2000",
+			earliestStartTime: 1000,
+			latestStartTime:   1999,
+			latestCloseTime:   math.MaxInt64,
+		},
+		{
+			query:             "StartTime = '2020-01-21T16:16:11Z' and SearchPrecision = 'Day'",
+			earliestStartTime: time.Date(2020, 1, 21, 0, 0, 0, 0, time.UTC).UnixNano(),
+			latestStartTime:   time.Date(2020, 1, 22, 0, 0, 0, 0, time.UTC).UnixNano() - 1,
+			latestCloseTime:   math.MaxInt64,
+		},
+		{
+			query:             "CloseTime = '2020-01-21T16:16:11Z' and SearchPrecision = 'Minute'",
+			latestStartTime:   math.MaxInt64,
+			earliestCloseTime: time.Date(2020, 1, 21, 16, 16, 0, 0, time.UTC).UnixNano(),
+			latestCloseTime:   time.Date(2020, 1, 21, 16, 17, 0, 0, time.UTC).UnixNano() - 1,
+		},
+		{
+			query:       "CloseTime = 2000 and CloseTime = 3000",
+			emptyResult: true,
+		},
+		{
+			query:       "CloseTime > 2000 and CloseTime < 1000",
+			emptyResult: true,
+		},
+		{
+			query:     "closeTime = 2000",
+			expectErr: true,
+		},
+		{
+			query:     "CloseTime > \"2019-01-01 00:00:00\"",
+			expectErr: true,
+		},
+		{
+			query:     "CloseTime != 2000",
+			expectErr: true,
+		},
+		{
+			query:     "SearchPrecision = 'Day'",
+			expectErr: true,
+		},
+		{
+			query:     "StartTime = 2000 and SearchPrecision = 'Week'",
+			expectErr: true,
+		},
+		{
+			query:     "StartTime = 2000 and SearchPrecision = 'Day' and SearchPrecision = 'Hour'",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.emptyResult, parsedQuery.EmptyResult, tc.query)
+		if !tc.emptyResult {
+			s.Equal(tc.earliestStartTime, parsedQuery.EarliestStartTime, tc.query)
+			s.Equal(tc.latestStartTime, parsedQuery.LatestStartTime, tc.query)
+			s.Equal(tc.earliestCloseTime, parsedQuery.EarliestCloseTime, tc.query)
+			s.Equal(tc.latestCloseTime, parsedQuery.LatestCloseTime, tc.query)
+		}
+	}
+}
+
+func (s *visibilityQuerySuite) TestParseSearchAttributes() {
+	testCases := []struct {
+		query      string
+		expectErr  bool
+		conditions []*SearchAttributeCondition
+	}{
+		{
+			query: "CustomKeywordField = 'keyword' and CustomIntField >= 10 and CustomBoolField = true",
+			conditions: []*SearchAttributeCondition{
+				{Key: "CustomKeywordField", Operator: "=", Value: "keyword"},
+				{Key: "CustomIntField", Operator: ">=", Value: float64(10)},
+				{Key: "CustomBoolField", Operator: "=", Value: true},
+			},
+		},
+		{
+			query: "CustomDoubleField != 1.5",
+			conditions: []*SearchAttributeCondition{
+				{Key: "CustomDoubleField", Operator: "!=", Value: 1.5},
+			},
+		},
+		{
+			query:     "CustomBoolField > false",
+			expectErr: true,
+		},
+		{
+			query:     "CustomKeywordField like 'keyword%'",
+			expectErr: true,
+		},
+		{
+			query:     "CustomKeywordField = CustomStringField",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.conditions, parsedQuery.SearchAttributes)
+	}
+}
+
+func (s *visibilityQuerySuite) TestMatch() {
+	record := &ArchiveVisibilityRequest{
+		WorkflowID:       "random workflowID",
+		RunID:            "random runID",
+		WorkflowTypeName: "random type name",
+		StartTimestamp:   int64(500),
+		CloseTimestamp:   int64(12345),
+		CloseStatus:      types.WorkflowExecutionCloseStatusContinuedAsNew,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": `["keyword-1","keyword-2"]`,
+			"CustomIntField":     "10",
+			"CustomBoolField":    "true",
+			"CustomStringField":  "not json",
+		},
+	}
+	testCases := []struct {
+		query       string
+		shouldMatch bool
+	}{
+		{"CloseTime >= 1000 and CloseTime <= 12345", true},
+		{"CloseTime > 12345", false},
+		{"StartTime >= 1000", false},
+		{"StartTime < 1000 and CloseTime >= 1000", true},
+		{"WorkflowID = 'random workflowID' and RunID = 'random runID'", true},
+		{"WorkflowID = 'another workflowID'", false},
+		{"WorkflowType = 'random type name' and CloseStatus = 'ContinuedAsNew'", true},
+		{"CloseStatus = 'Failed'", false},
+		{"CustomKeywordField = 'keyword-2'", true},
+		{"CustomKeywordField = 'keyword-3'", false},
+		{"CustomKeywordField != 'keyword-1'", false},
+		{"CustomKeywordField != 'keyword-3'", true},
+		{"CustomIntField > 5 and CustomIntField <= 10", true},
+		{"CustomIntField < 10", false},
+		{"CustomIntField = 'ten'", false},
+		{"CustomBoolField = true", true},
+		{"CustomBoolField = false", false},
+		{"CustomStringField = 'not json'", true},
+		{"MissingField = 'value'", false},
+		{"WorkflowID = 'random workflowID' and WorkflowID = 'another workflowID'", false},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		s.NoError(err, tc.query)
+		s.Equal(tc.shouldMatch, parsedQuery.Match(record), tc.query)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package asyncworkflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+type (
+	// RequestType is the type of the request carried by a Message
+	RequestType string
+
+	// Message is the envelope written to an async workflow queue
+	Message struct {
+		Type RequestType `json:"type"`
+		// PartitionKey is used by the queue to keep requests of the same workflow in order
+		PartitionKey string              `json:"partitionKey"`
+		Encoding     common.EncodingType `json:"encoding"`
+		Payload      []byte              `json:"payload"`
+		// RequestTime is the time in unix nanoseconds at which the frontend accepted the request,
+		// zero for messages written before the field was added
+		RequestTime int64 `json:"requestTime,omitempty"`
+	}
+)
+
+const (
+	// RequestTypeStartWorkflowExecution is the type of an async StartWorkflowExecution request
+	RequestTypeStartWorkflowExecution RequestType = "StartWorkflowExecution"
+	// RequestTypeSignalWithStartWorkflowExecution is the type of an async SignalWithStartWorkflowExecution request
+	RequestTypeSignalWithStartWorkflowExecution RequestType = "SignalWithStartWorkflowExecution"
+)
+
+var encoder = codec.NewThriftRWEncoder()
+
+// NewStartWorkflowExecutionMessage creates a queue message for a StartWorkflowExecution request
+func NewStartWorkflowExecutionMessage(request *types.StartWorkflowExecutionRequest, requestTime time.Time) (*Message, error) {
+	payload, err := encoder.Encode(thrift.FromStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		Type:         RequestTypeStartWorkflowExecution,
+		PartitionKey: request.GetWorkflowID(),
+		Encoding:     common.EncodingTypeThriftRW,
+		Payload:      payload,
+		RequestTime:  requestTime.UnixNano(),
+	}, nil
+}
+
+// NewSignalWithStartWorkflowExecutionMessage creates a queue message for a SignalWithStartWorkflowExecution request
+func NewSignalWithStartWorkflowExecutionMessage(request *types.SignalWithStartWorkflowExecutionRequest, requestTime time.Time) (*Message, error) {
+	payload, err := encoder.Encode(thrift.FromSignalWithStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		Type:         RequestTypeSignalWithStartWorkflowExecution,
+		PartitionKey: request.GetWorkflowID(),
+		Encoding:     common.EncodingTypeThriftRW,
+		Payload:      payload,
+		RequestTime:  requestTime.UnixNano(),
+	}, nil
+}
+
+// GetStartWorkflowExecutionRequest decodes the StartWorkflowExecution request carried by the message
+func (m *Message) GetStartWorkflowExecutionRequest() (*types.StartWorkflowExecutionRequest, error) {
+	if err := m.validate(RequestTypeStartWorkflowExecution); err != nil {
+		return nil, err
+	}
+	var request shared.StartWorkflowExecutionRequest
+	if err := encoder.Decode(m.Payload, &request); err != nil {
+		return nil, err
+	}
+	return thrift.ToStartWorkflowExecutionRequest(&request), nil
+}
+
+// GetSignalWithStartWorkflowExecutionRequest decodes the SignalWithStartWorkflowExecution request carried by the message
+func (m *Message) GetSignalWithStartWorkflowExecutionRequest() (*types.SignalWithStartWorkflowExecutionRequest, error) {
+	if err := m.validate(RequestTypeSignalWithStartWorkflowExecution); err != nil {
+		return nil, err
+	}
+	var request shared.SignalWithStartWorkflowExecutionRequest
+	if err := encoder.Decode(m.Payload, &request); err != nil {
+		return nil, err
+	}
+	return thrift.ToSignalWithStartWorkflowExecutionRequest(&request), nil
+}
+
+// GetRequestTime returns the time at which the request was accepted, or fallback for messages that don't carry it
+func (m *Message) GetRequestTime(fallback time.Time) time.Time {
+	if m.RequestTime == 0 {
+		return fallback
+	}
+	return time.Unix(0, m.RequestTime)
+}
+
+func (m *Message) validate(requestType RequestType) error {
+	if m.Type != requestType {
+		return fmt.Errorf("unexpected async request type %v, expected %v", m.Type, requestType)
+	}
+	if m.Encoding != common.EncodingTypeThriftRW {
+		return fmt.Errorf("unsupported async request encoding %v", m.Encoding)
+	}
+	return nil
+}
+
+// EncodeMessage serializes a message before it is written to the queue
+func EncodeMessage(message *Message) ([]byte, error) {
+	return json.Marshal(message)
+}
+
+// DecodeMessage deserializes a message read from the queue
+func DecodeMessage(payload []byte) (*Message, error) {
+	var message Message
+	if err := json.Unmarshal(payload, &message); err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
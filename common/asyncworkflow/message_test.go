@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package asyncworkflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestStartWorkflowExecutionMessage(t *testing.T) {
+	request := testdata.StartWorkflowExecutionRequest
+	requestTime := time.Unix(0, 1234567890)
+	message, err := NewStartWorkflowExecutionMessage(&request, requestTime)
+	require.NoError(t, err)
+	assert.Equal(t, RequestTypeStartWorkflowExecution, message.Type)
+	assert.Equal(t, request.WorkflowID, message.PartitionKey)
+
+	payload, err := EncodeMessage(message)
+	require.NoError(t, err)
+	decoded, err := DecodeMessage(payload)
+	require.NoError(t, err)
+	assert.Equal(t, message, decoded)
+	assert.True(t, requestTime.Equal(decoded.GetRequestTime(time.Now())))
+
+	result, err := decoded.GetStartWorkflowExecutionRequest()
+	require.NoError(t, err)
+	assert.Equal(t, &request, result)
+
+	_, err = decoded.GetSignalWithStartWorkflowExecutionRequest()
+	assert.Error(t, err)
+}
+
+func TestSignalWithStartWorkflowExecutionMessage(t *testing.T) {
+	request := testdata.SignalWithStartWorkflowExecutionRequest
+	message, err := NewSignalWithStartWorkflowExecutionMessage(&request, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, RequestTypeSignalWithStartWorkflowExecution, message.Type)
+	assert.Equal(t, request.WorkflowID, message.PartitionKey)
+
+	payload, err := EncodeMessage(message)
+	require.NoError(t, err)
+	decoded, err := DecodeMessage(payload)
+	require.NoError(t, err)
+
+	result, err := decoded.GetSignalWithStartWorkflowExecutionRequest()
+	require.NoError(t, err)
+	assert.Equal(t, &request, result)
+
+	_, err = decoded.GetStartWorkflowExecutionRequest()
+	assert.Error(t, err)
+}
+
+func TestMessage_GetRequestTime_Fallback(t *testing.T) {
+	// messages written before the request time was added don't carry it
+	decoded, err := DecodeMessage([]byte(`{"type":"StartWorkflowExecution","partitionKey":"wid","encoding":"thriftrw"}`))
+	require.NoError(t, err)
+	fallback := time.Unix(100, 0)
+	assert.Equal(t, fallback, decoded.GetRequestTime(fallback))
+}
+
+func TestDecodeMessage_Invalid(t *testing.T) {
+	_, err := DecodeMessage([]byte("not a message"))
+	assert.Error(t, err)
+
+	message := &Message{Type: RequestTypeStartWorkflowExecution, Encoding: "unknown"}
+	_, err = message.GetStartWorkflowExecutionRequest()
+	assert.Error(t, err)
+}
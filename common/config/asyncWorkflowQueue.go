@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+)
+
+const (
+	// AsyncWorkflowQueueTypeKafka writes async workflow requests to kafka
+	AsyncWorkflowQueueTypeKafka = "kafka"
+	// AsyncWorkflowQueueTypeMemory keeps async workflow requests in memory, all services must run in the same process
+	AsyncWorkflowQueueTypeMemory = "memory"
+)
+
+// Enabled returns true if async workflow APIs are backed by a queue
+func (a *AsyncWorkflowQueue) Enabled() bool {
+	return a.Type != ""
+}
+
+// Validate validates the async workflow queue config
+func (a *AsyncWorkflowQueue) Validate(kafka *KafkaConfig) error {
+	switch a.Type {
+	case "", AsyncWorkflowQueueTypeMemory:
+		return nil
+	case AsyncWorkflowQueueTypeKafka:
+		for _, queue := range a.Queues {
+			if _, ok := kafka.Applications[queue]; !ok {
+				return fmt.Errorf("[AsyncWorkflowQueueConfig] Missing kafka application for queue %v", queue)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("[AsyncWorkflowQueueConfig] Unknown queue type %v", a.Type)
+	}
+}
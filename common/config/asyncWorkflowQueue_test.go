@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsyncWorkflowQueueValidate(t *testing.T) {
+	kafka := &KafkaConfig{
+		Applications: map[string]TopicList{
+			"async-workflow": {Topic: "async-workflow", DLQTopic: "async-workflow-dlq"},
+		},
+	}
+
+	assert.NoError(t, (&AsyncWorkflowQueue{}).Validate(kafka))
+	assert.NoError(t, (&AsyncWorkflowQueue{Type: AsyncWorkflowQueueTypeMemory}).Validate(kafka))
+	assert.NoError(t, (&AsyncWorkflowQueue{
+		Type:   AsyncWorkflowQueueTypeKafka,
+		Queues: []string{"async-workflow"},
+	}).Validate(kafka))
+
+	err := (&AsyncWorkflowQueue{
+		Type:   AsyncWorkflowQueueTypeKafka,
+		Queues: []string{"async-workflow", "missing"},
+	}).Validate(kafka)
+	assert.EqualError(t, err, "[AsyncWorkflowQueueConfig] Missing kafka application for queue missing")
+
+	err = (&AsyncWorkflowQueue{Type: "sqs"}).Validate(kafka)
+	assert.EqualError(t, err, "[AsyncWorkflowQueueConfig] Unknown queue type sqs")
+}
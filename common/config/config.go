@@ -72,6 +72,18 @@ type (
 		Blobstore Blobstore `yaml:"blobstore"`
 		// Authorization is the config for setting up authorization
 		Authorization Authorization `yaml:"authorization"`
+		// AsyncWorkflowQueue is the config for the queues behind the async workflow APIs
+		AsyncWorkflowQueue AsyncWorkflowQueue `yaml:"asyncWorkflowQueue"`
+	}
+
+	// AsyncWorkflowQueue describes the queues async workflow requests are written to
+	AsyncWorkflowQueue struct {
+		// Type is the queue implementation, either "kafka" or "memory".
+		// Async workflow APIs are disabled when it's empty.
+		Type string `yaml:"type"`
+		// Queues are the queue names drained by the worker service. With kafka, every
+		// queue name must be an application in the kafka config.
+		Queues []string `yaml:"queues"`
 	}
 
 	Authorization struct {
@@ -200,7 +212,8 @@ type (
 
 	// NoSQL contains configuration to connect to NoSQL Database cluster
 	NoSQL struct {
-		// PluginName is the name of NoSQL plugin, default is "cassandra". Supported values: cassandra
+		// PluginName is the name of NoSQL plugin, default is "cassandra". Supported values: cassandra, mongodb, dynamodb, memory
+		// memory is a process local in-memory store for testing only, the data is lost when the process exits
 		PluginName string `yaml:"pluginName"`
 		// Hosts is a csv of cassandra endpoints
 		Hosts string `yaml:"hosts" validate:"nonzero"`
@@ -228,6 +241,7 @@ type (
 		// Use it ONLY when a configure is too specific to a particular NoSQL database that should not be in the common struct
 		// Otherwise please add new fields to the struct for better documentation
 		// If being used in any database, update this comment here to make it clear
+		// dynamodb: "endpoint" overrides the service endpoint (e.g. http://localhost:8000 for DynamoDB Local)
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 	}
 
@@ -239,9 +253,10 @@ type (
 		// Password is the password corresponding to the user name
 		// If useMultipleDatabases, must be empty and provide it via multipleDatabasesConfig instead
 		Password string `yaml:"password"`
-		// PluginName is the name of SQL plugin
+		// PluginName is the name of SQL plugin. Supported values: mysql, postgres, sqlite
 		PluginName string `yaml:"pluginName" validate:"nonzero"`
 		// DatabaseName is the name of SQL database to connect to
+		// For sqlite, it is the path to the database file, or the name of the database in memory mode
 		// If useMultipleDatabases, must be empty and provide it via multipleDatabasesConfig instead
 		// Required if not useMultipleDatabases
 		DatabaseName string `yaml:"databaseName"`
@@ -252,6 +267,7 @@ type (
 		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix
 		ConnectProtocol string `yaml:"connectProtocol" validate:"nonzero"`
 		// ConnectAttributes is a set of key-value attributes to be sent as part of connect data_source_name url
+		// For sqlite, "mode: memory" keeps the database in memory for the lifetime of the process
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 		// MaxConns the max number of connections to this datastore
 		MaxConns int `yaml:"maxConns"`
@@ -262,7 +278,8 @@ type (
 		// NumShards is the number of DB shards in a sharded sql database. Default is 1 for single SQL database setup.
 		// It's for computing a shardID value of [0,NumShards) to decide which shard of DB to query.
 		// Relationship with NumHistoryShards, both values cannot be changed once set in the same cluster,
-		// and the historyShardID value calculated from NumHistoryShards will be calculated using this NumShards to get a dbShardID
+		// and the historyShardID value calculated from NumHistoryShards will be calculated using this NumShards to get a dbShardID,
+		// unless the history shard is assigned to another DB shard by the DB shard map
 		NumShards int `yaml:"nShards"`
 		// TLS is the configuration for TLS connections
 		TLS *TLS `yaml:"tls"`
@@ -276,7 +293,8 @@ type (
 		// of  User, Password, DatabaseName, ConnectAddr.
 		UseMultipleDatabases bool `yaml:"useMultipleDatabases"`
 		// Required when UseMultipleDatabases is true
-		// the length of the list should be exactly the same as NumShards
+		// the length of the list should be at least NumShards, the databases after the first NumShards
+		// are added for resharding and only store the history shards moved to them
 		MultipleDatabasesConfig []MultipleDatabasesConfigEntry `yaml:"multipleDatabasesConfig"`
 	}
 
@@ -424,9 +442,11 @@ type (
 
 	// HistoryArchiverProvider contains the config for all history archivers
 	HistoryArchiverProvider struct {
-		Filestore *FilestoreArchiver `yaml:"filestore"`
-		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
-		S3store   *S3Archiver        `yaml:"s3store"`
+		Filestore    *FilestoreArchiver    `yaml:"filestore"`
+		Gstorage     *GstorageArchiver     `yaml:"gstorage"`
+		S3store      *S3Archiver           `yaml:"s3store"`
+		S3Compatible *S3CompatibleArchiver `yaml:"s3compatible"`
+		AzureBlob    *AzureBlobArchiver    `yaml:"azureblob"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -441,9 +461,11 @@ type (
 
 	// VisibilityArchiverProvider contains the config for all visibility archivers
 	VisibilityArchiverProvider struct {
-		Filestore *FilestoreArchiver `yaml:"filestore"`
-		S3store   *S3Archiver        `yaml:"s3store"`
-		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Filestore    *FilestoreArchiver    `yaml:"filestore"`
+		S3store      *S3Archiver           `yaml:"s3store"`
+		S3Compatible *S3CompatibleArchiver `yaml:"s3compatible"`
+		Gstorage     *GstorageArchiver     `yaml:"gstorage"`
+		AzureBlob    *AzureBlobArchiver    `yaml:"azureblob"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -464,6 +486,30 @@ type (
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
 	}
 
+	// S3CompatibleArchiver contains the config for archiving to an S3 compatible object store such as MinIO.
+	// Requests are always sent to Endpoint using path-style addressing.
+	S3CompatibleArchiver struct {
+		// Endpoint is the URL of the object store, e.g. http://127.0.0.1:9000
+		Endpoint string `yaml:"endpoint"`
+		// Region is optional and defaults to us-east-1, as most S3 compatible stores ignore it
+		Region string `yaml:"region"`
+		// AccessKeyID and SecretAccessKey are the static credentials used to sign requests,
+		// when both are empty the default AWS credential chain is used
+		AccessKeyID     string `yaml:"accessKeyID"`
+		SecretAccessKey string `yaml:"secretAccessKey"`
+		// DisableSSL allows plain http connections to the endpoint
+		DisableSSL bool `yaml:"disableSSL"`
+	}
+
+	// AzureBlobArchiver contains the config for Azure Blob Storage archiver
+	AzureBlobArchiver struct {
+		AccountName string `yaml:"accountName"`
+		AccountKey  string `yaml:"accountKey"`
+		// Endpoint is optional and defaults to https://<accountName>.blob.core.windows.net,
+		// set it to use a storage emulator such as Azurite
+		Endpoint string `yaml:"endpoint"`
+	}
+
 	// PublicClient is config for connecting to cadence frontend
 	PublicClient struct {
 		// HostPort is the host port to connect on. Host can be DNS name
@@ -525,6 +571,9 @@ func (c *Config) validate() error {
 	if err := c.Archival.Validate(&c.DomainDefaults.Archival); err != nil {
 		return err
 	}
+	if err := c.AsyncWorkflowQueue.Validate(&c.Kafka); err != nil {
+		return err
+	}
 
 	return c.Authorization.Validate()
 }
@@ -120,7 +120,19 @@ func TestInvalidMultipleDatabaseConfig_wrongNumDBShards(t *testing.T) {
 	sqlds.SQL.NumShards = 3
 	cfg.Persistence.DataStores["default"] = sqlds
 	err := cfg.ValidateAndFillDefaults()
-	require.EqualError(t, err, "sql persistence config: nShards must be greater than one and equal to the length of multipleDatabasesConfig")
+	require.EqualError(t, err, "sql persistence config: nShards must be greater than one and not greater than the length of multipleDatabasesConfig")
+}
+
+func TestValidMultipleDatabaseConfig_reshardDatabases(t *testing.T) {
+	cfg := getValidMultipleDatabasseConfig()
+	sqlds := cfg.Persistence.DataStores["default"]
+	sqlds.SQL.MultipleDatabasesConfig = append(sqlds.SQL.MultipleDatabasesConfig, MultipleDatabasesConfigEntry{
+		DatabaseName: "db3",
+		ConnectAddr:  "192.168.0.3:3306",
+	})
+	cfg.Persistence.DataStores["default"] = sqlds
+	err := cfg.ValidateAndFillDefaults()
+	require.NoError(t, err)
 }
 
 func TestInvalidMultipleDatabaseConfig_nonEmptySQLUser(t *testing.T) {
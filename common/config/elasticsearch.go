@@ -34,12 +34,15 @@ type (
 	ElasticSearchConfig struct {
 		URL     url.URL           `yaml:"url"`     //nolint:govet
 		Indices map[string]string `yaml:"indices"` //nolint:govet
-		// supporting v6 and v7. Default to v6 if empty.
+		// supporting v6, v7, v8 and os2 (OpenSearch 2). Default to v6 if empty.
 		Version string `yaml:"version"` //nolint:govet
 		// optional username to communicate with ElasticSearch
 		Username string `yaml:"username"` //nolint:govet
 		// optional password to communicate with ElasticSearch
 		Password string `yaml:"password"` //nolint:govet
+		// optional base64 encoded API key to communicate with ElasticSearch, only supported by v8.
+		// It takes precedence over username and password.
+		APIKey string `yaml:"apiKey"` //nolint:govet
 		// optional to disable sniff, according to issues on Github,
 		// Sniff could cause issue like "no Elasticsearch node available"
 		DisableSniff bool `yaml:"disableSniff"`
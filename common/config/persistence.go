@@ -116,8 +116,8 @@ func (c *Persistence) Validate() error {
 				if ds.SQL.Password != "" {
 					return fmt.Errorf("sql persistence config: password can only be configured in multipleDatabasesConfig when UseMultipleDatabases is true")
 				}
-				if ds.SQL.NumShards <= 1 || len(ds.SQL.MultipleDatabasesConfig) != ds.SQL.NumShards {
-					return fmt.Errorf("sql persistence config: nShards must be greater than one and equal to the length of multipleDatabasesConfig")
+				if ds.SQL.NumShards <= 1 || len(ds.SQL.MultipleDatabasesConfig) < ds.SQL.NumShards {
+					return fmt.Errorf("sql persistence config: nShards must be greater than one and not greater than the length of multipleDatabasesConfig")
 				}
 				for _, entry := range ds.SQL.MultipleDatabasesConfig {
 					if entry.DatabaseName == "" {
@@ -105,6 +105,10 @@ const (
 	// BatcherLocalDomainName is domain name for batcher workflows running in local cluster
 	// Batcher cannot use SystemLocalDomain because auth
 	BatcherLocalDomainName = "cadence-batcher"
+	// SchedulerDomainID is domain id for scheduler local domain
+	SchedulerDomainID = "0bc1b6c4-5b8b-4a8c-9d8f-3f6f1d6c2a71"
+	// SchedulerLocalDomainName is domain name for schedule workflows running in local cluster
+	SchedulerLocalDomainName = "cadence-scheduler"
 	// ShadowerDomainID is domain id for workflow shadower local domain
 	ShadowerDomainID = "59c51119-1b41-4a28-986d-d6e377716f82"
 	// ShadowerLocalDomainName
@@ -120,6 +124,12 @@ const (
 	CriticalLongPollTimeout = time.Second * 20
 )
 
+const (
+	// TaskFairnessKeyHeaderName is the workflow start header whose value is attached to the tasks
+	// of the workflow and used as their fairness key when matching dispatches backlog by header
+	TaskFairnessKeyHeaderName = "cadence-task-fairness-key"
+)
+
 const (
 	// DefaultTransactionSizeLimit is the largest allowed transaction size to persistence
 	DefaultTransactionSizeLimit = 14 * 1024 * 1024
@@ -533,6 +533,12 @@ const (
 	// Default value: 10000
 	// Allowed filters: N/A
 	VisibilityArchivalQueryMaxPageSize
+	// FrontendArchivedHistoryCacheMaxSize is the max total size in bytes of archived histories cached by each frontend host, 0 disables the cache
+	// KeyName: frontend.archivedHistoryCacheMaxSize
+	// Value type: Int
+	// Default value: 64MB (64*1024*1024)
+	// Allowed filters: N/A
+	FrontendArchivedHistoryCacheMaxSize
 
 	// key for matching
 
@@ -620,6 +626,18 @@ const (
 	// Default value: 1
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingNumTasklistReadPartitions
+	// MatchingPartitionUpscaleRPS is the add task rate per write partition above which the adaptive scaler adds partitions
+	// KeyName: matching.partitionUpscaleRPS
+	// Value type: Int
+	// Default value: 200
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionUpscaleRPS
+	// MatchingAdaptiveScalerMaxPartitions is the max number of partitions the adaptive scaler can assign to a task list
+	// KeyName: matching.adaptiveScalerMaxPartitions
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingAdaptiveScalerMaxPartitions
 	// MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder
 	// KeyName: matching.forwarderMaxOutstandingPolls
 	// Value type: Int
@@ -644,6 +662,12 @@ const (
 	// Default value: 20
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingTaskFairnessBacklogs is the number of backlogs each priority level of a task list is split into when fair dispatch is enabled, tasks are persisted to the backlog picked by the hash of their fairness key so that a noisy key only delays the keys sharing its backlog
+	// KeyName: matching.taskFairnessBacklogs
+	// Value type: Int
+	// Default value: 4
+	// Allowed filters: DomainName
+	MatchingTaskFairnessBacklogs
 
 	// key for history
 
@@ -733,7 +757,7 @@ const (
 	TaskProcessRPS
 	// TaskSchedulerType is the task scheduler type for priority task processor
 	// KeyName: history.taskSchedulerType
-	// Value type: Int enum(1 for SchedulerTypeFIFO, 2 for SchedulerTypeWRR(weighted round robin scheduler implementation))
+	// Value type: Int enum(1 for SchedulerTypeFIFO, 2 for SchedulerTypeWRR(weighted round robin scheduler implementation), 3 for SchedulerTypeDRR(deficit round robin across domains within each priority))
 	// Default value: 2 (task.SchedulerTypeWRR)
 	// Allowed filters: N/A
 	TaskSchedulerType
@@ -957,6 +981,12 @@ const (
 	// Default value: 10000
 	// Allowed filters: N/A
 	ReplicatorCacheCapacity
+	// ListWorkflowTasksMaxScanSize is the max number of persisted tasks scanned per history queue when listing the tasks of a workflow
+	// KeyName: history.listWorkflowTasksMaxScanSize
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: N/A
+	ListWorkflowTasksMaxScanSize
 
 	// ExecutionMgrNumConns is persistence connections number for ExecutionManager
 	// KeyName: history.executionMgrNumConns
@@ -982,6 +1012,13 @@ const (
 	// Default value: 10000
 	// Allowed filters: DomainName
 	MaximumSignalsPerExecution
+	// MaximumCompletedUpdatesPerExecution is max number of completed update IDs kept in mutable state for deduplication,
+	// the IDs of the oldest updates are dropped when the limit is exceeded
+	// KeyName: history.maximumCompletedUpdatesPerExecution
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName
+	MaximumCompletedUpdatesPerExecution
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	// KeyName: history.numArchiveSystemWorkflows
 	// Value type: Int
@@ -1301,6 +1338,24 @@ const (
 	// Value type: Int
 	// Default value: 1 (no jittering)
 	WorkflowDeletionJitterRange
+	// AsyncWorkflowConsumerConcurrency is the number of concurrent workers draining each async workflow queue
+	// KeyName: worker.asyncWorkflowConsumerConcurrency
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	AsyncWorkflowConsumerConcurrency
+	// MutableStateChecksumVersion is the payload version used when generating mutable state checksums
+	// KeyName: history.mutableStateChecksumVersion
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: DomainName
+	MutableStateChecksumVersion
+	// TaskSchedulerDomainQuantum is the number of consecutive tasks of a domain dispatched by the deficit round robin task scheduler before moving to the next domain of the same priority
+	// KeyName: history.taskSchedulerDomainQuantum
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: DomainName
+	TaskSchedulerDomainQuantum
 
 	// LastIntKey must be the last one in this const group
 	LastIntKey
@@ -1411,6 +1466,13 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	SendRawWorkflowHistory
+	// FrontendEnableArchivedRunIDLookup is whether to look up the latest archived run in the visibility archive
+	// when a closed workflow is requested without a run ID and it no longer exists in persistence
+	// KeyName: frontend.enableArchivedRunIDLookup
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	FrontendEnableArchivedRunIDLookup
 	// FrontendEmitSignalNameMetricsTag enables emitting signal name tag in metrics in frontend client
 	// KeyName: frontend.emitSignalNameMetricsTag
 	// Value type: Bool
@@ -1432,6 +1494,14 @@ const (
 	// Default value: false
 	// Allowed filters: DomainID
 	MatchingEnableTaskInfoLogByDomainID
+	// MatchingEnableAdaptiveScaler is to enable the adaptive scaler, which scales task list partitions based on traffic
+	// and persists the partition config in the task list record. When disabled, partition counts come from
+	// MatchingNumTasklistWritePartitions and MatchingNumTasklistReadPartitions
+	// KeyName: matching.enableAdaptiveScaler
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableAdaptiveScaler
 
 	// key for history
 
@@ -1752,6 +1822,60 @@ const (
 	EnablePendingActivityValidation
 
 	EnableCassandraAllConsistencyLevelDelete
+	// EnableAsyncWorkflowStart decides whether the async start and signal-with-start APIs are allowed for a domain
+	// KeyName: frontend.enableAsyncWorkflowStart
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableAsyncWorkflowStart
+	// EnableAsyncWorkflowConsumer decides whether the worker drains async workflow queues into history
+	// KeyName: worker.enableAsyncWorkflowConsumer
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableAsyncWorkflowConsumer
+	// MatchingEnableTaskIsolation enables dispatching tasks to pollers in the same isolation group as the workflow
+	// KeyName: matching.enableTaskIsolation
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	MatchingEnableTaskIsolation
+	// EnableTaskIsolationByDomain is whether the isolation group of a workflow is attached to the decision and activity tasks pushed to matching
+	// KeyName: history.enableTaskIsolationByDomain
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableTaskIsolationByDomain
+	// EnableScheduler decides whether or not to start the scheduler that runs schedule workflows in worker
+	// KeyName: worker.enableScheduler
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableScheduler
+	// EnableUpdateWorkflowExecution decides whether the UpdateWorkflowExecution API is allowed for a domain
+	// KeyName: frontend.enableUpdateWorkflowExecution
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableUpdateWorkflowExecution
+	// EnableVisibilityMigration decides whether or not to start the visibility migrator that copies visibility records between stores in worker
+	// KeyName: worker.enableVisibilityMigration
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableVisibilityMigration
+	// EnableDBReshard decides whether or not to start the DB resharder that moves history shards between SQL databases in worker
+	// KeyName: worker.enableDBReshard
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableDBReshard
+	// EnableMutableStateChecksumRepair indicates whether a workflow failing mutable state checksum verification should be overwritten with the state rebuilt from its history
+	// KeyName: history.enableMutableStateChecksumRepair
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableMutableStateChecksumRepair
 
 	// LastBoolKey must be the last one in this const group
 	LastBoolKey
@@ -1801,6 +1925,12 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	MatchingErrorInjectionRate
+	// MatchingPartitionDownscaleFactor is the fraction of MatchingPartitionUpscaleRPS below which the adaptive scaler removes partitions
+	// KeyName: matching.partitionDownscaleFactor
+	// Value type: Float64
+	// Default value: 0.75
+	// Allowed filters: N/A
+	MatchingPartitionDownscaleFactor
 
 	// key for history
 
@@ -1942,10 +2072,17 @@ const (
 	// TODO: https://github.com/uber/cadence/issues/3861
 	WorkerDeterministicConstructionCheckProbability
 	// WorkerBlobIntegrityCheckProbability controls the probability of running an integrity check for any given archival
-	// KeyName: N/A
-	// Default value: N/A
-	// TODO: https://github.com/uber/cadence/issues/3861
+	// KeyName: worker.BlobIntegrityCheckProbability
+	// Value type: Float64
+	// Default value: 0.002
+	// Allowed filters: DomainName
 	WorkerBlobIntegrityCheckProbability
+	// TaskSchedulerDomainQueueRatio is the max ratio of a priority queue of the deficit round robin task scheduler the tasks of a single domain can take
+	// KeyName: history.taskSchedulerDomainQueueRatio
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: N/A
+	TaskSchedulerDomainQueueRatio
 
 	// LastFloatKey must be the last one in this const group
 	LastFloatKey
@@ -2009,6 +2146,36 @@ const (
 	// Value type: string ["test-domain","test-domain2"]
 	// Default value: ""
 	ESAnalyzerWorkflowVersionMetricDomains
+	// AsyncWorkflowQueueName is the queue async workflow requests of a domain are written to
+	// KeyName: frontend.asyncWorkflowQueueName
+	// Value type: String
+	// Default value: async-workflow
+	// Allowed filters: DomainName
+	AsyncWorkflowQueueName
+	// MatchingAllIsolationGroups is a comma separated list of all valid isolation groups. Tasks and pollers of other isolation groups are not isolated
+	// KeyName: matching.allIsolationGroups
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: DomainName
+	MatchingAllIsolationGroups
+	// MatchingDrainedIsolationGroups is a comma separated list of isolation groups that are drained. Tasks of drained groups are dispatched to any poller and pollers of drained groups receive no tasks
+	// KeyName: matching.drainedIsolationGroups
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: DomainName
+	MatchingDrainedIsolationGroups
+	// MatchingTaskFairnessKey is the key the backlog of a task list is fairly dispatched across, one of domain, workflowIDPrefix or header (the cadence-task-fairness-key start header). Fair dispatch is disabled when empty. The backlog is split by the hash of the key, see MatchingTaskFairnessBacklogs, and the tasks read from it are dispatched with weighted round robin across keys. Tasks that are sync matched to a waiting poller skip it. Keep the key and the number of backlogs of a task list until its backlog is drained
+	// KeyName: matching.taskFairnessKey
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: DomainName
+	MatchingTaskFairnessKey
+	// MatchingTaskFairnessWorkflowIDDelimiter is the delimiter that ends the workflow ID prefix used as fairness key
+	// KeyName: matching.taskFairnessWorkflowIDDelimiter
+	// Value type: String
+	// Default value: ":"
+	// Allowed filters: DomainName
+	MatchingTaskFairnessWorkflowIDDelimiter
 
 	// LastStringKey must be the last one in this const group
 	LastStringKey
@@ -2077,6 +2244,30 @@ const (
 	// Default value: 100ms
 	// Allowed filters: DomainName
 	MatchingActivityTaskSyncMatchWaitTime
+	// MatchingAdaptiveScalerUpdateInterval is the interval at which the adaptive scaler samples task list traffic
+	// KeyName: matching.adaptiveScalerUpdateInterval
+	// Value type: Duration
+	// Default value: 15s
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingAdaptiveScalerUpdateInterval
+	// MatchingPartitionUpscaleSustainedDuration is how long the traffic must stay above the upscale threshold before partitions are added
+	// KeyName: matching.partitionUpscaleSustainedDuration
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionUpscaleSustainedDuration
+	// MatchingPartitionDownscaleSustainedDuration is how long the traffic must stay below the downscale threshold before partitions are removed
+	// KeyName: matching.partitionDownscaleSustainedDuration
+	// Value type: Duration
+	// Default value: 2m
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionDownscaleSustainedDuration
+	// MatchingPartitionConfigRefreshInterval is how long matching clients cache the partition config of a task list
+	// KeyName: matching.partitionConfigRefreshInterval
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: N/A
+	MatchingPartitionConfigRefreshInterval
 
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	// KeyName: history.longPollExpirationInterval
@@ -2412,12 +2603,24 @@ const (
 	// Default value: 1s (1*time.Second)
 	// Allowed filters: N/A
 	WorkerESProcessorFlushInterval
+	// WorkerESProcessorBulkTimeout is the timeout of a bulk request of esProcessor, only used by ElasticSearch 8 and OpenSearch 2
+	// KeyName: worker.ESProcessorBulkTimeout
+	// Value type: Duration
+	// Default value: 30s (30*time.Second)
+	// Allowed filters: N/A
+	WorkerESProcessorBulkTimeout
 	// WorkerTimeLimitPerArchivalIteration is controls the time limit of each iteration of archival workflow
 	// KeyName: worker.TimeLimitPerArchivalIteration
 	// Value type: Duration
 	// Default value: archiver.MaxArchivalIterationTimeout()
 	// Allowed filters: N/A
 	WorkerTimeLimitPerArchivalIteration
+	// WorkerArchivalVerificationInterval is the interval at which the archival verification workflow checks the archivals sampled for integrity checks
+	// KeyName: worker.archivalVerificationInterval
+	// Value type: Duration
+	// Default value: 5m (5*time.Minute)
+	// Allowed filters: N/A
+	WorkerArchivalVerificationInterval
 	// WorkerReplicationTaskMaxRetryDuration is the max retry duration for any task
 	// KeyName: worker.replicationTaskMaxRetryDuration
 	// Value type: Duration
@@ -2434,6 +2637,18 @@ const (
 	// Value type: Duration
 	// Default value: 30 minutes
 	ESAnalyzerBufferWaitTime
+	// AsyncWorkflowConsumerMaxRetryDuration is the max duration an async workflow request is retried before it is sent to the DLQ
+	// KeyName: worker.asyncWorkflowConsumerMaxRetryDuration
+	// Value type: Duration
+	// Default value: 5 minutes
+	// Allowed filters: N/A
+	AsyncWorkflowConsumerMaxRetryDuration
+	// MatchingTaskIsolationDuration is the amount of time a backlog task waits for a poller in its own isolation group before it can be dispatched to any poller
+	// KeyName: matching.taskIsolationDuration
+	// Value type: Duration
+	// Default value: 5s
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskIsolationDuration
 
 	// LastDurationKey must be the last one in this const group
 	LastDurationKey
@@ -2483,6 +2698,18 @@ const (
 	// Default value: see common.ConvertIntMapToDynamicConfigMapProperty(DefaultStuckTaskSplitThreshold) in code base
 	// Allowed filters: N/A
 	QueueProcessorStuckTaskSplitThreshold
+	// MatchingTaskPriorityWeights is the weighted round robin weight of each task priority level of a task list, higher levels are served first. Every level above the lowest persists its backlog in its own task list so it is read regardless of the lower levels, tasks that are sync matched to a waiting poller skip it. Keep a level configured until its backlog, reported per priority by DescribeTaskList, is drained
+	// KeyName: matching.taskPriorityWeights
+	// Value type: Map
+	// Default value: map[0:1], a single level which dispatches the backlog in task ID order
+	// Allowed filters: N/A
+	MatchingTaskPriorityWeights
+	// MatchingTaskFairnessWeights is the weighted round robin weight of each fairness key when dispatching the tasks read from the backlogs of a task list, keys that are not listed have a weight of 1
+	// KeyName: matching.taskFairnessWeights
+	// Value type: Map
+	// Default value: empty map, all keys have a weight of 1
+	// Allowed filters: N/A
+	MatchingTaskFairnessWeights
 
 	// LastMapKey must be the last one in this const group
 	LastMapKey
@@ -2746,6 +2973,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "VisibilityArchivalQueryMaxPageSize is the maximum page size for a visibility archival query",
 		DefaultValue: 10000,
 	},
+	FrontendArchivedHistoryCacheMaxSize: DynamicInt{
+		KeyName:      "frontend.archivedHistoryCacheMaxSize",
+		Description:  "FrontendArchivedHistoryCacheMaxSize is the max total size in bytes of archived histories cached by each frontend host, 0 disables the cache",
+		DefaultValue: 64 * 1024 * 1024,
+	},
 	MatchingUserRPS: DynamicInt{
 		KeyName:      "matching.rps",
 		Description:  "MatchingUserRPS is request rate per second for each matching host",
@@ -2816,6 +3048,16 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingNumTasklistReadPartitions is the number of read partitions for a task list",
 		DefaultValue: 1,
 	},
+	MatchingPartitionUpscaleRPS: DynamicInt{
+		KeyName:      "matching.partitionUpscaleRPS",
+		Description:  "MatchingPartitionUpscaleRPS is the add task rate per write partition above which the adaptive scaler adds partitions",
+		DefaultValue: 200,
+	},
+	MatchingAdaptiveScalerMaxPartitions: DynamicInt{
+		KeyName:      "matching.adaptiveScalerMaxPartitions",
+		Description:  "MatchingAdaptiveScalerMaxPartitions is the max number of partitions the adaptive scaler can assign to a task list",
+		DefaultValue: 10,
+	},
 	MatchingForwarderMaxOutstandingPolls: DynamicInt{
 		KeyName:      "matching.forwarderMaxOutstandingPolls",
 		Description:  "MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder",
@@ -2836,6 +3078,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task list partition tree",
 		DefaultValue: 20,
 	},
+	MatchingTaskFairnessBacklogs: DynamicInt{
+		KeyName:      "matching.taskFairnessBacklogs",
+		Description:  "MatchingTaskFairnessBacklogs is the number of backlogs each priority level of a task list is split into when fair dispatch is enabled, tasks are persisted to the backlog picked by the hash of their fairness key so that a noisy key only delays the keys sharing its backlog",
+		DefaultValue: 4,
+	},
 	HistoryRPS: DynamicInt{
 		KeyName:      "history.rps",
 		Description:  "HistoryRPS is request rate per second for each history host",
@@ -3086,6 +3333,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "ReplicatorCacheCapacity is the capacity of replication cache in number of tasks",
 		DefaultValue: 10000,
 	},
+	ListWorkflowTasksMaxScanSize: DynamicInt{
+		KeyName:      "history.listWorkflowTasksMaxScanSize",
+		Description:  "ListWorkflowTasksMaxScanSize is the max number of persisted tasks scanned per history queue when listing the tasks of a workflow",
+		DefaultValue: 10000,
+	},
 	ExecutionMgrNumConns: DynamicInt{
 		KeyName:      "history.executionMgrNumConns",
 		Description:  "ExecutionMgrNumConns is persistence connections number for ExecutionManager",
@@ -3106,6 +3358,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MaximumSignalsPerExecution is max number of signals supported by single execution",
 		DefaultValue: 10000, // 10K signals should big enough given workflow execution has 200K history lengh limit. It needs to be non-zero to protect continueAsNew from infinit loop
 	},
+	MaximumCompletedUpdatesPerExecution: DynamicInt{
+		KeyName:      "history.maximumCompletedUpdatesPerExecution",
+		Description:  "MaximumCompletedUpdatesPerExecution is max number of completed update IDs kept in mutable state for deduplication",
+		DefaultValue: 1000,
+	},
 	NumArchiveSystemWorkflows: DynamicInt{
 		KeyName:      "history.numArchiveSystemWorkflows",
 		Description:  "NumArchiveSystemWorkflows is key for number of archive system workflows running in total",
@@ -3371,6 +3628,21 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "WorkflowDeletionJitterRange defines the duration in minutes for workflow close tasks jittering",
 		DefaultValue: 60,
 	},
+	AsyncWorkflowConsumerConcurrency: DynamicInt{
+		KeyName:      "worker.asyncWorkflowConsumerConcurrency",
+		Description:  "AsyncWorkflowConsumerConcurrency is the number of concurrent workers draining each async workflow queue",
+		DefaultValue: 10,
+	},
+	MutableStateChecksumVersion: DynamicInt{
+		KeyName:      "history.mutableStateChecksumVersion",
+		Description:  "MutableStateChecksumVersion is the payload version used when generating mutable state checksums",
+		DefaultValue: 1,
+	},
+	TaskSchedulerDomainQuantum: DynamicInt{
+		KeyName:      "history.taskSchedulerDomainQuantum",
+		Description:  "TaskSchedulerDomainQuantum is the number of consecutive tasks of a domain dispatched by the deficit round robin task scheduler before moving to the next domain of the same priority",
+		DefaultValue: 1,
+	},
 }
 
 var BoolKeys = map[BoolKey]DynamicBool{
@@ -3469,6 +3741,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "SendRawWorkflowHistory is whether to enable raw history retrieving",
 		DefaultValue: false,
 	},
+	FrontendEnableArchivedRunIDLookup: DynamicBool{
+		KeyName:      "frontend.enableArchivedRunIDLookup",
+		Description:  "FrontendEnableArchivedRunIDLookup is whether to look up the latest archived run in the visibility archive when a closed workflow is requested without a run ID and it no longer exists in persistence",
+		DefaultValue: false,
+	},
 	FrontendEmitSignalNameMetricsTag: DynamicBool{
 		KeyName:      "frontend.emitSignalNameMetricsTag",
 		Description:  "FrontendEmitSignalNameMetricsTag enables emitting signal name tag in metrics in frontend client",
@@ -3484,6 +3761,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableTaskInfoLogByDomainID is enables info level logs for decision/activity task based on the request domainID",
 		DefaultValue: false,
 	},
+	MatchingEnableAdaptiveScaler: DynamicBool{
+		KeyName:      "matching.enableAdaptiveScaler",
+		Description:  "MatchingEnableAdaptiveScaler is to enable the adaptive scaler, which scales task list partitions based on traffic and persists the partition config in the task list record",
+		DefaultValue: false,
+	},
 	EventsCacheGlobalEnable: DynamicBool{
 		KeyName:      "history.eventsCacheGlobalEnable",
 		Description:  "EventsCacheGlobalEnable is enables global cache over all history shards",
@@ -3749,6 +4031,51 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "Uses all consistency level for Cassandra delete operations",
 		DefaultValue: false,
 	},
+	EnableAsyncWorkflowStart: DynamicBool{
+		KeyName:      "frontend.enableAsyncWorkflowStart",
+		Description:  "EnableAsyncWorkflowStart decides whether the async start and signal-with-start APIs are allowed for a domain",
+		DefaultValue: false,
+	},
+	EnableAsyncWorkflowConsumer: DynamicBool{
+		KeyName:      "worker.enableAsyncWorkflowConsumer",
+		Description:  "EnableAsyncWorkflowConsumer decides whether the worker drains async workflow queues into history",
+		DefaultValue: false,
+	},
+	MatchingEnableTaskIsolation: DynamicBool{
+		KeyName:      "matching.enableTaskIsolation",
+		Description:  "MatchingEnableTaskIsolation enables dispatching tasks to pollers in the same isolation group as the workflow",
+		DefaultValue: false,
+	},
+	EnableTaskIsolationByDomain: DynamicBool{
+		KeyName:      "history.enableTaskIsolationByDomain",
+		Description:  "EnableTaskIsolationByDomain is whether the isolation group of a workflow is attached to the decision and activity tasks pushed to matching",
+		DefaultValue: false,
+	},
+	EnableScheduler: DynamicBool{
+		KeyName:      "worker.enableScheduler",
+		Description:  "EnableScheduler decides whether or not to start the scheduler that runs schedule workflows in worker",
+		DefaultValue: false,
+	},
+	EnableUpdateWorkflowExecution: DynamicBool{
+		KeyName:      "frontend.enableUpdateWorkflowExecution",
+		Description:  "EnableUpdateWorkflowExecution decides whether the UpdateWorkflowExecution API is allowed for a domain",
+		DefaultValue: false,
+	},
+	EnableVisibilityMigration: DynamicBool{
+		KeyName:      "worker.enableVisibilityMigration",
+		Description:  "EnableVisibilityMigration decides whether or not to start the visibility migrator that copies visibility records between stores in worker",
+		DefaultValue: false,
+	},
+	EnableDBReshard: DynamicBool{
+		KeyName:      "worker.enableDBReshard",
+		Description:  "EnableDBReshard decides whether or not to start the DB resharder that moves history shards between SQL databases in worker",
+		DefaultValue: false,
+	},
+	EnableMutableStateChecksumRepair: DynamicBool{
+		KeyName:      "history.enableMutableStateChecksumRepair",
+		Description:  "EnableMutableStateChecksumRepair indicates whether a workflow failing mutable state checksum verification should be overwritten with the state rebuilt from its history",
+		DefaultValue: false,
+	},
 }
 
 var FloatKeys = map[FloatKey]DynamicFloat{
@@ -3782,6 +4109,11 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Description:  "MatchingErrorInjectionRate is rate for injecting random error in matching client",
 		DefaultValue: 0,
 	},
+	MatchingPartitionDownscaleFactor: DynamicFloat{
+		KeyName:      "matching.partitionDownscaleFactor",
+		Description:  "MatchingPartitionDownscaleFactor is the fraction of MatchingPartitionUpscaleRPS below which the adaptive scaler removes partitions",
+		DefaultValue: 0.75,
+	},
 	TaskRedispatchIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.taskRedispatchIntervalJitterCoefficient",
 		Description:  "TaskRedispatchIntervalJitterCoefficient is the task redispatch interval jitter coefficient",
@@ -3902,6 +4234,11 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Description:  "WorkerBlobIntegrityCheckProbability controls the probability of running an integrity check for any given archival",
 		DefaultValue: 0.002,
 	},
+	TaskSchedulerDomainQueueRatio: DynamicFloat{
+		KeyName:      "history.taskSchedulerDomainQueueRatio",
+		Description:  "TaskSchedulerDomainQueueRatio is the max ratio of a priority queue of the deficit round robin task scheduler the tasks of a single domain can take",
+		DefaultValue: 0.5,
+	},
 }
 
 var StringKeys = map[StringKey]DynamicString{
@@ -3955,6 +4292,31 @@ var StringKeys = map[StringKey]DynamicString{
 		Description:  "ESAnalyzerWorkflowDurationWarnThresholds defines the domains we want to emit wf version metrics on",
 		DefaultValue: "",
 	},
+	AsyncWorkflowQueueName: DynamicString{
+		KeyName:      "frontend.asyncWorkflowQueueName",
+		Description:  "AsyncWorkflowQueueName is the queue async workflow requests of a domain are written to",
+		DefaultValue: "async-workflow",
+	},
+	MatchingAllIsolationGroups: DynamicString{
+		KeyName:      "matching.allIsolationGroups",
+		Description:  "MatchingAllIsolationGroups is a comma separated list of all valid isolation groups. Tasks and pollers of other isolation groups are not isolated",
+		DefaultValue: "",
+	},
+	MatchingDrainedIsolationGroups: DynamicString{
+		KeyName:      "matching.drainedIsolationGroups",
+		Description:  "MatchingDrainedIsolationGroups is a comma separated list of isolation groups that are drained. Tasks of drained groups are dispatched to any poller and pollers of drained groups receive no tasks",
+		DefaultValue: "",
+	},
+	MatchingTaskFairnessKey: DynamicString{
+		KeyName:      "matching.taskFairnessKey",
+		Description:  "MatchingTaskFairnessKey is the key the backlog of a task list is fairly dispatched across, one of domain, workflowIDPrefix or header (the cadence-task-fairness-key start header). Fair dispatch is disabled when empty. The backlog is split by the hash of the key, see MatchingTaskFairnessBacklogs, and the tasks read from it are dispatched with weighted round robin across keys. Tasks that are sync matched to a waiting poller skip it. Keep the key and the number of backlogs of a task list until its backlog is drained",
+		DefaultValue: "",
+	},
+	MatchingTaskFairnessWorkflowIDDelimiter: DynamicString{
+		KeyName:      "matching.taskFairnessWorkflowIDDelimiter",
+		Description:  "MatchingTaskFairnessWorkflowIDDelimiter is the delimiter that ends the workflow ID prefix used as fairness key",
+		DefaultValue: ":",
+	},
 }
 
 var DurationKeys = map[DurationKey]DynamicDuration{
@@ -4018,6 +4380,26 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "MatchingActivityTaskSyncMatchWaitTime is the amount of time activity task will wait to be sync matched",
 		DefaultValue: time.Millisecond * 50,
 	},
+	MatchingAdaptiveScalerUpdateInterval: DynamicDuration{
+		KeyName:      "matching.adaptiveScalerUpdateInterval",
+		Description:  "MatchingAdaptiveScalerUpdateInterval is the interval at which the adaptive scaler samples task list traffic",
+		DefaultValue: time.Second * 15,
+	},
+	MatchingPartitionUpscaleSustainedDuration: DynamicDuration{
+		KeyName:      "matching.partitionUpscaleSustainedDuration",
+		Description:  "MatchingPartitionUpscaleSustainedDuration is how long the traffic must stay above the upscale threshold before partitions are added",
+		DefaultValue: time.Minute,
+	},
+	MatchingPartitionDownscaleSustainedDuration: DynamicDuration{
+		KeyName:      "matching.partitionDownscaleSustainedDuration",
+		Description:  "MatchingPartitionDownscaleSustainedDuration is how long the traffic must stay below the downscale threshold before partitions are removed",
+		DefaultValue: time.Minute * 2,
+	},
+	MatchingPartitionConfigRefreshInterval: DynamicDuration{
+		KeyName:      "matching.partitionConfigRefreshInterval",
+		Description:  "MatchingPartitionConfigRefreshInterval is how long matching clients cache the partition config of a task list",
+		DefaultValue: time.Second * 10,
+	},
 	HistoryLongPollExpirationInterval: DynamicDuration{
 		KeyName:      "history.longPollExpirationInterval",
 		Description:  "HistoryLongPollExpirationInterval is the long poll expiration interval in the history service",
@@ -4288,11 +4670,21 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "WorkerESProcessorFlushInterval is flush interval for esProcessor",
 		DefaultValue: time.Second,
 	},
+	WorkerESProcessorBulkTimeout: DynamicDuration{
+		KeyName:      "worker.ESProcessorBulkTimeout",
+		Description:  "WorkerESProcessorBulkTimeout is the timeout of a bulk request of esProcessor, only used by ElasticSearch 8 and OpenSearch 2",
+		DefaultValue: time.Second * 30,
+	},
 	WorkerTimeLimitPerArchivalIteration: DynamicDuration{
 		KeyName:      "worker.TimeLimitPerArchivalIteration",
 		Description:  "WorkerTimeLimitPerArchivalIteration is controls the time limit of each iteration of archival workflow",
 		DefaultValue: time.Hour * 24 * 15,
 	},
+	WorkerArchivalVerificationInterval: DynamicDuration{
+		KeyName:      "worker.archivalVerificationInterval",
+		Description:  "WorkerArchivalVerificationInterval is the interval at which the archival verification workflow checks the archivals sampled for integrity checks",
+		DefaultValue: time.Minute * 5,
+	},
 	WorkerReplicationTaskMaxRetryDuration: DynamicDuration{
 		KeyName:      "worker.replicationTaskMaxRetryDuration",
 		Description:  "WorkerReplicationTaskMaxRetryDuration is the max retry duration for any task",
@@ -4308,6 +4700,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ESAnalyzerBufferWaitTime controls min time required to consider a worklow stuck",
 		DefaultValue: time.Minute * 30,
 	},
+	AsyncWorkflowConsumerMaxRetryDuration: DynamicDuration{
+		KeyName:      "worker.asyncWorkflowConsumerMaxRetryDuration",
+		Description:  "AsyncWorkflowConsumerMaxRetryDuration is the max duration an async workflow request is retried before it is sent to the DLQ",
+		DefaultValue: time.Minute * 5,
+	},
+	MatchingTaskIsolationDuration: DynamicDuration{
+		KeyName:      "matching.taskIsolationDuration",
+		Description:  "MatchingTaskIsolationDuration is the amount of time a backlog task waits for a poller in its own isolation group before it can be dispatched to any poller",
+		DefaultValue: time.Second * 5,
+	},
 }
 
 var MapKeys = map[MapKey]DynamicMap{
@@ -4345,6 +4747,16 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "QueueProcessorStuckTaskSplitThreshold is the threshold for the number of attempts of a task",
 		DefaultValue: common.ConvertIntMapToDynamicConfigMapProperty(map[int]int{0: 100, 1: 10000}),
 	},
+	MatchingTaskPriorityWeights: DynamicMap{
+		KeyName:      "matching.taskPriorityWeights",
+		Description:  "MatchingTaskPriorityWeights is the weighted round robin weight of each task priority level of a task list, higher levels are served first. Every level above the lowest persists its backlog in its own task list so it is read regardless of the lower levels, tasks that are sync matched to a waiting poller skip it. Keep a level configured until its backlog, reported per priority by DescribeTaskList, is drained",
+		DefaultValue: common.ConvertIntMapToDynamicConfigMapProperty(map[int]int{0: 1}),
+	},
+	MatchingTaskFairnessWeights: DynamicMap{
+		KeyName:      "matching.taskFairnessWeights",
+		Description:  "MatchingTaskFairnessWeights is the weighted round robin weight of each fairness key when dispatching the tasks read from the backlogs of a task list, keys that are not listed have a weight of 1",
+		DefaultValue: map[string]interface{}{},
+	},
 }
 
 var _keyNames map[string]Key
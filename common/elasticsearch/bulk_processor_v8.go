@@ -0,0 +1,422 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+var _ GenericBulkProcessor = (*v8BulkProcessor)(nil)
+var _ GenericBulkableRequest = (*v8BulkableRequest)(nil)
+
+var errBulkProcessorClosed = errors.New("bulk processor is closed")
+
+type (
+	// v8BulkProcessor batches the requests added to it and commits them with the bulk API,
+	// it follows the behavior of olivere/elastic BulkProcessor: each worker commits its own batch
+	// when BulkActions or BulkSize is reached, and all workers are flushed every FlushInterval.
+	v8BulkProcessor struct {
+		client      *elasticV8
+		params      *BulkProcessorParameters
+		requestsC   chan *v8BulkableRequest
+		workers     []*v8BulkWorker
+		executionID int64
+
+		startMu sync.Mutex
+		status  int32
+		stopC   chan struct{}
+		wg      sync.WaitGroup
+	}
+
+	v8BulkWorker struct {
+		processor *v8BulkProcessor
+		requests  []GenericBulkableRequest
+		size      int
+		flushC    chan chan struct{}
+	}
+
+	// v8BulkableRequest is a request of the bulk API
+	v8BulkableRequest struct {
+		request *GenericBulkableAddRequest
+		source  []string
+		err     error
+	}
+)
+
+func newV8BulkProcessor(client *elasticV8, params *BulkProcessorParameters) *v8BulkProcessor {
+	return &v8BulkProcessor{
+		client:    client,
+		params:    params,
+		requestsC: make(chan *v8BulkableRequest),
+		stopC:     make(chan struct{}),
+		status:    common.DaemonStatusInitialized,
+	}
+}
+
+func (v *v8BulkProcessor) Start(ctx context.Context) error {
+	v.startMu.Lock()
+	defer v.startMu.Unlock()
+	switch atomic.LoadInt32(&v.status) {
+	case common.DaemonStatusStarted:
+		return nil
+	case common.DaemonStatusStopped:
+		return errBulkProcessorClosed
+	}
+
+	numOfWorkers := v.params.NumOfWorkers
+	if numOfWorkers < 1 {
+		numOfWorkers = 1
+	}
+	v.workers = make([]*v8BulkWorker, numOfWorkers)
+	for i := range v.workers {
+		v.workers[i] = &v8BulkWorker{
+			processor: v,
+			flushC:    make(chan chan struct{}),
+		}
+		v.wg.Add(1)
+		go v.workers[i].work()
+	}
+	if v.params.FlushInterval > 0 {
+		v.wg.Add(1)
+		go v.flusher()
+	}
+	atomic.StoreInt32(&v.status, common.DaemonStatusStarted)
+	return nil
+}
+
+// Stop is an alias for Close
+func (v *v8BulkProcessor) Stop() error {
+	return v.Close()
+}
+
+// Close commits the pending requests and stops the workers, the processor can't be started again
+func (v *v8BulkProcessor) Close() error {
+	v.startMu.Lock()
+	defer v.startMu.Unlock()
+	if atomic.LoadInt32(&v.status) == common.DaemonStatusStopped {
+		return nil
+	}
+	atomic.StoreInt32(&v.status, common.DaemonStatusStopped)
+	close(v.stopC)
+	v.wg.Wait()
+	return nil
+}
+
+// Add queues the request to a worker, the request is dropped if the processor is not started or is closed
+func (v *v8BulkProcessor) Add(request *GenericBulkableAddRequest) {
+	if atomic.LoadInt32(&v.status) == common.DaemonStatusInitialized {
+		v.client.logger.Warn("Request is dropped as bulk processor is not started", tag.ESDocID(request.ID))
+		return
+	}
+	req := newV8BulkableRequest(request)
+	select {
+	case v.requestsC <- req:
+	case <-v.stopC:
+		v.client.logger.Warn("Request is dropped as bulk processor is closed", tag.ESDocID(request.ID))
+	}
+}
+
+// Flush commits the pending requests of all workers and waits for them to complete
+func (v *v8BulkProcessor) Flush() error {
+	for _, w := range v.workers {
+		ack := make(chan struct{})
+		select {
+		case w.flushC <- ack:
+			<-ack
+		case <-v.stopC:
+			return errBulkProcessorClosed
+		}
+	}
+	return nil
+}
+
+func (v *v8BulkProcessor) RetrieveKafkaKey(request GenericBulkableRequest, logger log.Logger, metricsClient metrics.Client) string {
+	req, err := request.Source()
+	if err != nil {
+		logger.Error("Get request source err.", tag.Error(err), tag.ESRequest(request.String()))
+		metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+		return ""
+	}
+
+	var key string
+	if len(req) == 2 { // index or create requests
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(req[1]), &body); err != nil {
+			logger.Error("Unmarshal index request body err.", tag.Error(err))
+			metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+			return ""
+		}
+
+		k, ok := body[KafkaKey]
+		if !ok {
+			// must be bug in code and bad deployment, check processor that add es requests
+			panic("KafkaKey not found")
+		}
+		key, ok = k.(string)
+		if !ok {
+			// must be bug in code and bad deployment, check processor that add es requests
+			panic("KafkaKey is not string")
+		}
+	} else { // delete requests
+		var body map[string]map[string]interface{}
+		if err := json.Unmarshal([]byte(req[0]), &body); err != nil {
+			logger.Error("Unmarshal delete request body err.", tag.Error(err))
+			metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+			return ""
+		}
+
+		opMap, ok := body["delete"]
+		if !ok {
+			// must be bug, check if dependency changed
+			panic("delete key not found in request")
+		}
+		k, ok := opMap["_id"]
+		if !ok {
+			// must be bug in code and bad deployment, check processor that add es requests
+			panic("_id not found in request opMap")
+		}
+		key, _ = k.(string)
+	}
+	return key
+}
+
+func (v *v8BulkProcessor) flusher() {
+	defer v.wg.Done()
+
+	ticker := time.NewTicker(v.params.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := v.Flush(); err != nil {
+				return
+			}
+		case <-v.stopC:
+			return
+		}
+	}
+}
+
+func (w *v8BulkWorker) work() {
+	defer w.processor.wg.Done()
+
+	for {
+		select {
+		case req := <-w.processor.requestsC:
+			w.add(req)
+			if w.shouldCommit() {
+				w.commit()
+			}
+		case ack := <-w.flushC:
+			w.commit()
+			close(ack)
+		case <-w.processor.stopC:
+			w.commit()
+			return
+		}
+	}
+}
+
+func (w *v8BulkWorker) add(req *v8BulkableRequest) {
+	w.requests = append(w.requests, req)
+	source, err := req.Source()
+	if err != nil {
+		return
+	}
+	for _, line := range source {
+		w.size += len(line) + 1
+	}
+}
+
+func (w *v8BulkWorker) shouldCommit() bool {
+	params := w.processor.params
+	if params.BulkActions > 0 && len(w.requests) >= params.BulkActions {
+		return true
+	}
+	return params.BulkSize > 0 && w.size >= params.BulkSize
+}
+
+// commit sends the pending requests with the bulk API, retrying with the backoff if the whole request failed
+func (w *v8BulkWorker) commit() {
+	if len(w.requests) == 0 {
+		return
+	}
+	requests := w.requests
+	w.requests = nil
+	w.size = 0
+
+	params := w.processor.params
+	executionID := atomic.AddInt64(&w.processor.executionID, 1)
+	if params.BeforeFunc != nil {
+		params.BeforeFunc(executionID, requests)
+	}
+
+	var response *GenericBulkResponse
+	var err error
+	for retry := 1; ; retry++ {
+		response, err = w.processor.client.bulk(requests, params.Timeout)
+		if err == nil || !isRetriableBulkError(err) || params.Backoff == nil {
+			break
+		}
+		wait, ok := params.Backoff.Next(retry)
+		if !ok {
+			break
+		}
+		time.Sleep(wait)
+	}
+
+	if params.AfterFunc != nil {
+		if response == nil {
+			response = &GenericBulkResponse{}
+		}
+		params.AfterFunc(executionID, requests, response, convertV8ErrorToGenericError(err))
+	}
+}
+
+func isRetriableBulkError(err error) bool {
+	if e, ok := err.(*errorV8); ok {
+		return e.Status == http.StatusTooManyRequests || e.Status >= http.StatusInternalServerError
+	}
+	// transport errors
+	return true
+}
+
+func convertV8ErrorToGenericError(err error) *GenericError {
+	if err == nil {
+		return nil
+	}
+	status := unknownStatusCode
+	if e, ok := err.(*errorV8); ok {
+		status = e.Status
+	}
+	return &GenericError{
+		Status:  status,
+		Details: err,
+	}
+}
+
+// bulk sends the requests with the bulk API, the request is canceled after the timeout unless it's 0
+func (c *elasticV8) bulk(requests []GenericBulkableRequest, timeout time.Duration) (*GenericBulkResponse, error) {
+	var body strings.Builder
+	for _, req := range requests {
+		source, err := req.Source()
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range source {
+			body.WriteString(line)
+			body.WriteByte('\n')
+		}
+	}
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var response GenericBulkResponse
+	err := c.perform(ctx, http.MethodPost, "/_bulk", nil, []byte(body.String()), contentTypeNDJSON, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func newV8BulkableRequest(request *GenericBulkableAddRequest) *v8BulkableRequest {
+	req := &v8BulkableRequest{request: request}
+	req.source, req.err = req.buildSource()
+	return req
+}
+
+// Source returns the lines of the request in the body of the bulk API,
+// there is no _type in the action line as mapping types are removed in ElasticSearch 8 and OpenSearch 2
+func (r *v8BulkableRequest) Source() ([]string, error) {
+	return r.source, r.err
+}
+
+func (r *v8BulkableRequest) String() string {
+	lines, err := r.Source()
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *v8BulkableRequest) buildSource() ([]string, error) {
+	request := r.request
+	meta := map[string]interface{}{
+		"_index": request.Index,
+		"_id":    request.ID,
+	}
+	var op string
+	switch request.RequestType {
+	case BulkableDeleteRequest:
+		op = "delete"
+	case BulkableIndexRequest:
+		op = "index"
+	case BulkableCreateRequest:
+		// create request doesn't support external version
+		op = "create"
+	default:
+		return nil, errors.New("unknown bulkable request type")
+	}
+	if request.RequestType != BulkableCreateRequest && request.VersionType != "" {
+		meta["version"] = request.Version
+		meta["version_type"] = request.VersionType
+	}
+
+	action, err := json.Marshal(map[string]interface{}{op: meta})
+	if err != nil {
+		return nil, err
+	}
+	if request.RequestType == BulkableDeleteRequest {
+		return []string{string(action)}, nil
+	}
+
+	var doc string
+	switch d := request.Doc.(type) {
+	case string:
+		doc = d
+	case json.RawMessage:
+		doc = string(d)
+	default:
+		body, err := json.Marshal(request.Doc)
+		if err != nil {
+			return nil, err
+		}
+		doc = string(body)
+	}
+	return []string{string(action), doc}, nil
+}
@@ -0,0 +1,770 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	esaws "github.com/olivere/elastic/v7/aws/v4"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+var _ GenericClient = (*elasticV8)(nil)
+
+const (
+	// pointInTimeKeepAliveV8 is how long the point in time is kept alive between two pages of ScanByQuery
+	pointInTimeKeepAliveV8 = "1m"
+
+	versionOpenSearch2 = "os2"
+
+	contentTypeJSON   = "application/json"
+	contentTypeNDJSON = "application/x-ndjson"
+)
+
+type (
+	// elasticV8 implements Client for ElasticSearch 8 and OpenSearch 2 with their REST API,
+	// as olivere/elastic doesn't support them.
+	elasticV8 struct {
+		url        *url.URL
+		httpClient *http.Client
+		username   string
+		password   string
+		apiKey     string
+		logger     log.Logger
+		serializer p.PayloadSerializer
+		// openSearch is set for OpenSearch 2, which has its own point in time API
+		openSearch bool
+	}
+
+	// errorV8 is the error returned by ElasticSearch 8 and OpenSearch 2 for a failed request
+	errorV8 struct {
+		Status  int           `json:"status"`
+		Details *errorDetails `json:"error,omitempty"`
+	}
+
+	errorDetails struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	}
+
+	searchResultV8 struct {
+		TookInMillis int64                      `json:"took"`
+		TimedOut     bool                       `json:"timed_out"`
+		PitID        string                     `json:"pit_id,omitempty"`
+		Hits         *searchHitsV8              `json:"hits,omitempty"`
+		Aggregations map[string]json.RawMessage `json:"aggregations,omitempty"`
+	}
+
+	searchHitsV8 struct {
+		TotalHits *totalHitsV8   `json:"total,omitempty"`
+		Hits      []*searchHitV8 `json:"hits,omitempty"`
+	}
+
+	totalHitsV8 struct {
+		Value    int64  `json:"value"`
+		Relation string `json:"relation"`
+	}
+
+	searchHitV8 struct {
+		ID     string          `json:"_id"`
+		Source json.RawMessage `json:"_source,omitempty"`
+		Sort   []interface{}   `json:"sort,omitempty"`
+	}
+
+	countResultV8 struct {
+		Count int64 `json:"count"`
+	}
+
+	// pointInTimeV8 is the response of opening a point in time, ElasticSearch 8 returns
+	// the id in ID while OpenSearch 2 returns it in PitID
+	pointInTimeV8 struct {
+		ID    string `json:"id,omitempty"`
+		PitID string `json:"pit_id,omitempty"`
+	}
+)
+
+// NewV8Client returns a new implementation of GenericClient for ElasticSearch 8 and OpenSearch 2
+func NewV8Client(
+	connectConfig *config.ElasticSearchConfig,
+	logger log.Logger,
+) (GenericClient, error) {
+	httpClient := &http.Client{}
+	if connectConfig.TLS.Enabled {
+		var err error
+		httpClient, err = buildTLSHTTPClient(connectConfig.TLS)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if connectConfig.AWSSigning.Enable {
+		if err := config.CheckAWSSigningConfig(connectConfig.AWSSigning); err != nil {
+			return nil, err
+		}
+		var err error
+		// requests are signed before they are sent over the TLS transport
+		httpClient, err = buildSigningHTTPClientV8(connectConfig.AWSSigning, httpClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	esURL := connectConfig.URL
+	username, password := connectConfig.Username, connectConfig.Password
+	if esURL.User != nil {
+		// credentials may also be set in the URL, see config.SetUsernamePassword
+		username = esURL.User.Username()
+		password, _ = esURL.User.Password()
+		esURL.User = nil
+	}
+
+	return &elasticV8{
+		url:        &esURL,
+		httpClient: httpClient,
+		username:   username,
+		password:   password,
+		apiKey:     connectConfig.APIKey,
+		logger:     logger,
+		serializer: p.NewPayloadSerializer(),
+		openSearch: connectConfig.Version == versionOpenSearch2,
+	}, nil
+}
+
+// buildSigningHTTPClientV8 returns a client signing the requests with AWS credentials and sending them with httpClient
+func buildSigningHTTPClientV8(signingConfig config.AWSSigning, httpClient *http.Client) (*http.Client, error) {
+	if signingConfig.EnvironmentCredential != nil {
+		region := signingConfig.EnvironmentCredential.Region
+		sess, err := session.NewSession(&aws.Config{Region: aws.String(region)})
+		if err != nil {
+			return nil, err
+		}
+		return esaws.NewV4SigningClientWithHTTPClient(sess.Config.Credentials, region, httpClient), nil
+	}
+	staticCredential := signingConfig.StaticCredential
+	awsCredentials := credentials.NewStaticCredentials(
+		staticCredential.AccessKey,
+		staticCredential.SecretKey,
+		staticCredential.SessionToken,
+	)
+	return esaws.NewV4SigningClientWithHTTPClient(awsCredentials, staticCredential.Region, httpClient), nil
+}
+
+func (e *errorV8) Error() string {
+	if e.Details != nil {
+		return fmt.Sprintf("elastic: Error %d (%s): %s [type=%s]", e.Status, http.StatusText(e.Status), e.Details.Reason, e.Details.Type)
+	}
+	return fmt.Sprintf("elastic: Error %d (%s)", e.Status, http.StatusText(e.Status))
+}
+
+func (c *elasticV8) IsNotFoundError(err error) bool {
+	if e, ok := err.(*errorV8); ok {
+		return e.Status == http.StatusNotFound
+	}
+	return false
+}
+
+// root is for nested object like Attr property for search attributes.
+func (c *elasticV8) PutMapping(ctx context.Context, index, root, key, valueType string) error {
+	body, err := json.Marshal(buildPutMappingBodyV7(root, key, valueType))
+	if err != nil {
+		return err
+	}
+	return c.perform(ctx, http.MethodPut, "/"+index+"/_mapping", nil, body, contentTypeJSON, nil)
+}
+
+func (c *elasticV8) CreateIndex(ctx context.Context, index string) error {
+	return c.perform(ctx, http.MethodPut, "/"+index, nil, nil, "", nil)
+}
+
+func (c *elasticV8) CountByQuery(ctx context.Context, index, query string) (int64, error) {
+	var result countResultV8
+	if err := c.perform(ctx, http.MethodPost, "/"+index+"/_count", nil, []byte(query), contentTypeJSON, &result); err != nil {
+		return 0, err
+	}
+	return result.Count, nil
+}
+
+func (c *elasticV8) Search(ctx context.Context, request *SearchRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	token, err := GetNextPageToken(request.ListRequest.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	searchResult, err := c.getSearchResult(
+		ctx,
+		request.Index,
+		request.ListRequest,
+		request.MatchQuery,
+		request.IsOpen,
+		token,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getListWorkflowExecutionsResponse(searchResult.Hits, token, request.ListRequest.PageSize, request.MaxResultWindow, request.Filter)
+}
+
+func (c *elasticV8) SearchByQuery(ctx context.Context, request *SearchByQueryRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	searchResult, err := c.searchWithDSL(ctx, request.Index, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := GetNextPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getListWorkflowExecutionsResponse(searchResult.Hits, token, request.PageSize, request.MaxResultWindow, request.Filter)
+}
+
+// ScanByQuery pages through a point in time with search_after, as the scroll API is
+// discouraged for deep pagination by ElasticSearch 8 and OpenSearch 2
+func (c *elasticV8) ScanByQuery(ctx context.Context, request *ScanByQueryRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	token, err := GetNextPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pitID := token.PointInTimeID
+	if len(pitID) == 0 { // first call
+		pitID, err = c.openPointInTime(ctx, request.Index)
+		if err != nil {
+			return nil, &types.InternalServiceError{
+				Message: fmt.Sprintf("ScanByQuery failed. Error: %v", err),
+			}
+		}
+	}
+
+	searchResult, err := c.searchPointInTime(ctx, pitID, request.Query, request.PageSize, token.SortValue)
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("ScanByQuery failed. Error: %v", err),
+		}
+	}
+	if len(searchResult.PitID) != 0 { // the id of a point in time may change between searches
+		pitID = searchResult.PitID
+	}
+
+	isLastPage := searchResult.Hits == nil || len(searchResult.Hits.Hits) < request.PageSize
+	if isLastPage { // no more result
+		if err := c.closePointInTime(ctx, pitID); err != nil {
+			c.logger.Warn("close point in time fail", tag.Error(err))
+		}
+	}
+
+	return c.getScanWorkflowExecutionsResponse(searchResult.Hits, request.PageSize, pitID, isLastPage)
+}
+
+func (c *elasticV8) SearchForOneClosedExecution(
+	ctx context.Context,
+	index string,
+	request *p.InternalGetClosedWorkflowExecutionRequest,
+) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
+
+	must := []interface{}{
+		newMatchQueryV8(DomainID, request.DomainUUID),
+		newExistsQueryV8(CloseStatus),
+		newMatchQueryV8(WorkflowID, request.Execution.GetWorkflowID()),
+	}
+	if rid := request.Execution.GetRunID(); rid != "" {
+		must = append(must, newMatchQueryV8(RunID, rid))
+	}
+	body := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": must,
+			},
+		},
+	}
+
+	searchResult, err := c.search(ctx, index, body)
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("SearchForOneClosedExecution failed. Error: %v", err),
+		}
+	}
+
+	response := &p.InternalGetClosedWorkflowExecutionResponse{}
+	if searchResult.Hits == nil || len(searchResult.Hits.Hits) == 0 {
+		return response, nil
+	}
+	response.Execution = c.convertSearchResultToVisibilityRecord(searchResult.Hits.Hits[0])
+
+	return response, nil
+}
+
+func (c *elasticV8) SearchRaw(ctx context.Context, index string, query string) (*RawResponse, error) {
+	esResult, err := c.searchWithDSL(ctx, index, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if esResult.TimedOut {
+		return nil, types.InternalServiceError{
+			Message: fmt.Sprintf("ElasticSearch Error: Request timed out: %v ms", esResult.TookInMillis),
+		}
+	}
+
+	result := RawResponse{
+		TookInMillis: esResult.TookInMillis,
+		Aggregations: esResult.Aggregations,
+	}
+	if esResult.Hits == nil {
+		return &result, nil
+	}
+
+	if esResult.Hits.TotalHits != nil {
+		result.Hits.TotalHits = esResult.Hits.TotalHits.Value
+	}
+	if len(esResult.Hits.Hits) > 0 {
+		result.Hits.Hits = make([]*p.InternalVisibilityWorkflowExecutionInfo, 0, len(esResult.Hits.Hits))
+		for _, hit := range esResult.Hits.Hits {
+			workflowExecutionInfo := c.convertSearchResultToVisibilityRecord(hit)
+			result.Hits.Hits = append(result.Hits.Hits, workflowExecutionInfo)
+		}
+	}
+
+	return &result, nil
+}
+
+func (c *elasticV8) RunBulkProcessor(ctx context.Context, parameters *BulkProcessorParameters) (GenericBulkProcessor, error) {
+	processor := newV8BulkProcessor(c, parameters)
+	if err := processor.Start(ctx); err != nil {
+		return nil, err
+	}
+	return processor, nil
+}
+
+func (c *elasticV8) search(ctx context.Context, index string, body map[string]interface{}) (*searchResultV8, error) {
+	query, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return c.searchWithDSL(ctx, index, string(query))
+}
+
+func (c *elasticV8) searchWithDSL(ctx context.Context, index, query string) (*searchResultV8, error) {
+	var result searchResultV8
+	if err := c.perform(ctx, http.MethodPost, "/"+index+"/_search", nil, []byte(query), contentTypeJSON, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *elasticV8) openPointInTime(ctx context.Context, index string) (string, error) {
+	params := url.Values{}
+	params.Set("keep_alive", pointInTimeKeepAliveV8)
+	path := "/" + index + "/_pit"
+	if c.openSearch {
+		path = "/" + index + "/_search/point_in_time"
+	}
+	var result pointInTimeV8
+	if err := c.perform(ctx, http.MethodPost, path, params, nil, contentTypeJSON, &result); err != nil {
+		return "", err
+	}
+	if c.openSearch {
+		return result.PitID, nil
+	}
+	return result.ID, nil
+}
+
+// searchPointInTime returns the page of the query after searchAfter in the point in time. The hits are sorted
+// by RunID, which is unique in the visibility index, so that they are sorted the same way by ElasticSearch 8
+// and OpenSearch 2.
+func (c *elasticV8) searchPointInTime(
+	ctx context.Context,
+	pitID string,
+	query string,
+	pageSize int,
+	searchAfter interface{},
+) (*searchResultV8, error) {
+	body := map[string]interface{}{}
+	if len(query) != 0 {
+		dec := json.NewDecoder(strings.NewReader(query))
+		dec.UseNumber()
+		if err := dec.Decode(&body); err != nil {
+			return nil, err
+		}
+	}
+	// from is not allowed with search_after
+	delete(body, "from")
+	body["pit"] = map[string]interface{}{
+		"id":         pitID,
+		"keep_alive": pointInTimeKeepAliveV8,
+	}
+	body["sort"] = []interface{}{
+		map[string]interface{}{RunID: map[string]interface{}{"order": "asc"}},
+	}
+	body["size"] = pageSize
+	if searchAfter != nil {
+		body["search_after"] = []interface{}{searchAfter}
+	}
+	request, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	// the index comes from the point in time, so it must not be in the path
+	var result searchResultV8
+	if err := c.perform(ctx, http.MethodPost, "/_search", nil, request, contentTypeJSON, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *elasticV8) closePointInTime(ctx context.Context, pitID string) error {
+	if pitID == "" {
+		return nil
+	}
+	path := "/_pit"
+	var request interface{} = map[string]interface{}{"id": pitID}
+	if c.openSearch {
+		path = "/_search/point_in_time"
+		request = map[string]interface{}{"pit_id": []string{pitID}}
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	return c.perform(ctx, http.MethodDelete, path, nil, body, contentTypeJSON, nil)
+}
+
+// perform sends the request to ElasticSearch and decodes the response into result if it's not nil
+func (c *elasticV8) perform(
+	ctx context.Context,
+	method string,
+	path string,
+	params url.Values,
+	body []byte,
+	contentType string,
+	result interface{},
+) error {
+	resp, err := c.performRaw(ctx, method, path, params, body, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newErrorV8(resp)
+	}
+	if result == nil {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return err
+	}
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber() // critical to ensure decode of int64 won't lose precise
+	return dec.Decode(result)
+}
+
+func (c *elasticV8) performRaw(
+	ctx context.Context,
+	method string,
+	path string,
+	params url.Values,
+	body []byte,
+	contentType string,
+) (*http.Response, error) {
+	reqURL := *c.url
+	reqURL.Path = strings.TrimSuffix(reqURL.Path, "/") + path
+	if len(params) > 0 {
+		reqURL.RawQuery = params.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", contentTypeJSON)
+	switch {
+	case c.apiKey != "":
+		req.Header.Set("Authorization", "ApiKey "+c.apiKey)
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return c.httpClient.Do(req)
+}
+
+func newErrorV8(resp *http.Response) error {
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return &errorV8{Status: resp.StatusCode}
+	}
+	var e struct {
+		Status int             `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(data, &e); err != nil || len(e.Error) == 0 {
+		return &errorV8{Status: resp.StatusCode, Details: &errorDetails{Reason: string(data)}}
+	}
+	result := &errorV8{Status: resp.StatusCode}
+	var details errorDetails
+	if err := json.Unmarshal(e.Error, &details); err == nil {
+		result.Details = &details
+	} else {
+		// some errors like the ones of the scroll API only have a string reason
+		result.Details = &errorDetails{Reason: string(e.Error)}
+	}
+	return result
+}
+
+func (c *elasticV8) getListWorkflowExecutionsResponse(
+	searchHits *searchHitsV8,
+	token *ElasticVisibilityPageToken,
+	pageSize int,
+	maxResultWindow int,
+	isRecordValid func(rec *p.InternalVisibilityWorkflowExecutionInfo) bool,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	response.Executions = make([]*p.InternalVisibilityWorkflowExecutionInfo, 0)
+	if searchHits == nil {
+		return response, nil
+	}
+	actualHits := searchHits.Hits
+	numOfActualHits := len(actualHits)
+
+	for i := 0; i < numOfActualHits; i++ {
+		workflowExecutionInfo := c.convertSearchResultToVisibilityRecord(actualHits[i])
+		if isRecordValid == nil || isRecordValid(workflowExecutionInfo) {
+			// for old APIs like ListOpenWorkflowExecutions, we added 1 ms to range query to overcome ES limitation
+			// (see getSearchResult function), but manually dropped records beyond request range here.
+			response.Executions = append(response.Executions, workflowExecutionInfo)
+		}
+	}
+
+	if numOfActualHits == pageSize { // this means the response is not the last page
+		var nextPageToken []byte
+		var err error
+
+		var totalHits int64
+		if searchHits.TotalHits != nil {
+			totalHits = searchHits.TotalHits.Value
+		}
+		// ES Search API support pagination using From and PageSize, but has limit that From+PageSize cannot exceed a threshold
+		// to retrieve deeper pages, use ES SearchAfter
+		if totalHits <= int64(maxResultWindow-pageSize) { // use ES Search From+Size
+			nextPageToken, err = SerializePageToken(&ElasticVisibilityPageToken{From: token.From + numOfActualHits})
+		} else { // use ES Search After
+			sortVals := actualHits[numOfActualHits-1].Sort
+			if len(sortVals) < 2 {
+				return nil, &types.InternalServiceError{
+					Message: "ElasticSearch Error: sort values are required to search after",
+				}
+			}
+			tieBreaker, _ := sortVals[1].(string)
+			nextPageToken, err = SerializePageToken(&ElasticVisibilityPageToken{SortValue: sortVals[0], TieBreaker: tieBreaker})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		response.NextPageToken = make([]byte, len(nextPageToken))
+		copy(response.NextPageToken, nextPageToken)
+	}
+
+	return response, nil
+}
+
+func (c *elasticV8) getScanWorkflowExecutionsResponse(
+	searchHits *searchHitsV8,
+	pageSize int, pitID string,
+	isLastPage bool,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	response.Executions = make([]*p.InternalVisibilityWorkflowExecutionInfo, 0)
+	if searchHits == nil {
+		return response, nil
+	}
+	actualHits := searchHits.Hits
+	numOfActualHits := len(actualHits)
+
+	for i := 0; i < numOfActualHits; i++ {
+		workflowExecutionInfo := c.convertSearchResultToVisibilityRecord(actualHits[i])
+		response.Executions = append(response.Executions, workflowExecutionInfo)
+	}
+
+	if numOfActualHits == pageSize && !isLastPage {
+		sortVals := actualHits[numOfActualHits-1].Sort
+		if len(sortVals) < 1 {
+			return nil, &types.InternalServiceError{
+				Message: "ElasticSearch Error: sort values are required to search after",
+			}
+		}
+		nextPageToken, err := SerializePageToken(&ElasticVisibilityPageToken{PointInTimeID: pitID, SortValue: sortVals[0]})
+		if err != nil {
+			return nil, err
+		}
+		response.NextPageToken = make([]byte, len(nextPageToken))
+		copy(response.NextPageToken, nextPageToken)
+	}
+
+	return response, nil
+}
+
+func (c *elasticV8) convertSearchResultToVisibilityRecord(hit *searchHitV8) *p.InternalVisibilityWorkflowExecutionInfo {
+	var source *VisibilityRecord
+	err := json.Unmarshal(hit.Source, &source)
+	if err != nil { // log and skip error
+		c.logger.Error("unable to unmarshal search hit source",
+			tag.Error(err), tag.ESDocID(hit.ID))
+		return nil
+	}
+
+	record := &p.InternalVisibilityWorkflowExecutionInfo{
+		DomainID:         source.DomainID,
+		WorkflowType:     source.WorkflowType,
+		WorkflowID:       source.WorkflowID,
+		RunID:            source.RunID,
+		TypeName:         source.WorkflowType,
+		StartTime:        time.Unix(0, source.StartTime),
+		ExecutionTime:    time.Unix(0, source.ExecutionTime),
+		Memo:             p.NewDataBlob(source.Memo, common.EncodingType(source.Encoding)),
+		TaskList:         source.TaskList,
+		IsCron:           source.IsCron,
+		NumClusters:      source.NumClusters,
+		SearchAttributes: source.Attr,
+	}
+	if source.UpdateTime != 0 {
+		record.UpdateTime = time.Unix(0, source.UpdateTime)
+	}
+	if source.CloseTime != 0 {
+		record.CloseTime = time.Unix(0, source.CloseTime)
+		record.Status = thrift.ToWorkflowExecutionCloseStatus(&source.CloseStatus)
+		record.HistoryLength = source.HistoryLength
+	}
+
+	return record
+}
+
+func (c *elasticV8) getSearchResult(
+	ctx context.Context,
+	index string,
+	request *p.InternalListWorkflowExecutionsRequest,
+	matchQuery *GenericMatch,
+	isOpen bool,
+	token *ElasticVisibilityPageToken,
+) (*searchResultV8, error) {
+
+	timeField := CloseTime
+	if isOpen {
+		timeField = StartTime
+	}
+	// ElasticSearch is unable to precisely compare time, have to manually add resolution 1ms to time range.
+	// Also has to use string instead of int64 to avoid data conversion issue,
+	// 9223372036854775807 to 9223372036854776000 (long overflow)
+	if request.LatestTime.UnixNano() > math.MaxInt64-oneMicroSecondInNano { // prevent latestTime overflow
+		request.LatestTime = time.Unix(0, math.MaxInt64-oneMicroSecondInNano)
+	}
+	if request.EarliestTime.UnixNano() < math.MinInt64+oneMicroSecondInNano { // prevent earliestTime overflow
+		request.EarliestTime = time.Unix(0, math.MinInt64+oneMicroSecondInNano)
+	}
+	earliestTimeStr := strconv.FormatInt(request.EarliestTime.UnixNano()-oneMicroSecondInNano, 10)
+	latestTimeStr := strconv.FormatInt(request.LatestTime.UnixNano()+oneMicroSecondInNano, 10)
+	rangeQuery := map[string]interface{}{
+		"range": map[string]interface{}{
+			timeField: map[string]interface{}{
+				"gte": earliestTimeStr,
+				"lte": latestTimeStr,
+			},
+		},
+	}
+
+	must := []interface{}{newMatchQueryV8(DomainID, request.DomainUUID)}
+	if matchQuery != nil {
+		must = append(must, newMatchQueryV8(matchQuery.Name, matchQuery.Text))
+	}
+	boolQuery := map[string]interface{}{
+		"filter": []interface{}{rangeQuery},
+	}
+	if isOpen {
+		boolQuery["must_not"] = []interface{}{newExistsQueryV8(CloseStatus)}
+	} else {
+		must = append(must, newExistsQueryV8(CloseStatus))
+	}
+	boolQuery["must"] = must
+
+	body := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": boolQuery,
+		},
+		"from": token.From,
+		"sort": []interface{}{
+			map[string]interface{}{timeField: map[string]interface{}{"order": "desc"}},
+			map[string]interface{}{RunID: map[string]interface{}{"order": "desc"}},
+		},
+	}
+	if request.PageSize != 0 {
+		body["size"] = request.PageSize
+	}
+	if ShouldSearchAfter(token) {
+		body["search_after"] = []interface{}{token.SortValue, token.TieBreaker}
+	}
+
+	return c.search(ctx, index, body)
+}
+
+func newMatchQueryV8(name string, text interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"match": map[string]interface{}{
+			name: map[string]interface{}{
+				"query": text,
+			},
+		},
+	}
+}
+
+func newExistsQueryV8(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"exists": map[string]interface{}{
+			"field": name,
+		},
+	}
+}
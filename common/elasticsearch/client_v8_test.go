@@ -0,0 +1,416 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type recordedRequestV8 struct {
+	method string
+	path   string
+	query  url.Values
+	header http.Header
+	body   string
+}
+
+func newTestV8Client(t *testing.T, handler func(req *recordedRequestV8) (int, string)) (*elasticV8, *[]*recordedRequestV8) {
+	var mu sync.Mutex
+	var requests []*recordedRequestV8
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := &recordedRequestV8{
+			method: r.Method,
+			path:   r.URL.Path,
+			query:  r.URL.Query(),
+			header: r.Header,
+			body:   string(body),
+		}
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+		status, resp := handler(req)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(resp))
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	serverURL.User = url.UserPassword("user", "pass")
+	client, err := NewGenericClient(&config.ElasticSearchConfig{URL: *serverURL, Version: "v8"}, log.NewNoop())
+	require.NoError(t, err)
+	return client.(*elasticV8), &requests
+}
+
+func TestNewGenericClient_V8(t *testing.T) {
+	for _, version := range []string{"v8", "os2"} {
+		client, err := NewGenericClient(&config.ElasticSearchConfig{Version: version}, log.NewNoop())
+		require.NoError(t, err)
+		assert.IsType(t, &elasticV8{}, client)
+	}
+}
+
+func TestNewV8Client_TLSAndAWSSigning(t *testing.T) {
+	var authorization string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"count":1}`))
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client, err := NewV8Client(&config.ElasticSearchConfig{
+		URL: *serverURL,
+		AWSSigning: config.AWSSigning{
+			Enable: true,
+			StaticCredential: &config.AWSStaticCredential{
+				AccessKey: "access-key",
+				SecretKey: "secret-key",
+				Region:    "us-east-1",
+			},
+		},
+		TLS: config.TLS{Enabled: true},
+	}, log.NewNoop())
+	require.NoError(t, err)
+
+	count, err := client.CountByQuery(context.Background(), "test-index", `{}`)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.True(t, strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=access-key/"), authorization)
+}
+
+func TestV8Client_Search(t *testing.T) {
+	client, requests := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		return http.StatusOK, `{"took":1,"hits":{"total":{"value":20000,"relation":"eq"},"hits":[
+			{"_id":"wid~rid","_source":{"WorkflowID":"wid","RunID":"rid","DomainID":"domain-id","WorkflowType":"type","StartTime":1000,"CloseTime":2000,"CloseStatus":1,"HistoryLength":5},"sort":[2000,"rid"]}]}}`
+	})
+
+	resp, err := client.Search(context.Background(), &SearchRequest{
+		Index: "test-index",
+		ListRequest: &p.InternalListWorkflowExecutionsRequest{
+			DomainUUID:   "domain-id",
+			EarliestTime: time.Unix(0, 100),
+			LatestTime:   time.Unix(0, 3000),
+			PageSize:     1,
+		},
+		MatchQuery:      &GenericMatch{Name: WorkflowID, Text: "wid"},
+		MaxResultWindow: 10000,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Executions, 1)
+	assert.Equal(t, "wid", resp.Executions[0].WorkflowID)
+	assert.Equal(t, time.Unix(0, 2000), resp.Executions[0].CloseTime)
+	assert.Equal(t, types.WorkflowExecutionCloseStatusFailed, *resp.Executions[0].Status)
+	assert.Equal(t, int64(5), resp.Executions[0].HistoryLength)
+
+	// total hits exceed the max result window, so the next page is read with search after
+	token, err := GetNextPageToken(resp.NextPageToken)
+	require.NoError(t, err)
+	assert.Equal(t, "rid", token.TieBreaker)
+	assert.Equal(t, json.Number("2000"), token.SortValue)
+
+	require.Len(t, *requests, 1)
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPost, req.method)
+	assert.Equal(t, "/test-index/_search", req.path)
+	username, password, ok := (&http.Request{Header: req.header}).BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+	assert.JSONEq(t, `{
+		"from": 0,
+		"size": 1,
+		"query": {"bool": {
+			"must": [
+				{"match": {"DomainID": {"query": "domain-id"}}},
+				{"match": {"WorkflowID": {"query": "wid"}}},
+				{"exists": {"field": "CloseStatus"}}
+			],
+			"filter": [{"range": {"CloseTime": {"gte": "-900", "lte": "4000"}}}]
+		}},
+		"sort": [{"CloseTime": {"order": "desc"}}, {"RunID": {"order": "desc"}}]
+	}`, req.body)
+}
+
+func TestV8Client_CountByQuery(t *testing.T) {
+	client, requests := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		return http.StatusOK, `{"count":42}`
+	})
+	client.apiKey = "api-key"
+
+	count, err := client.CountByQuery(context.Background(), "test-index", `{"query":{"match_all":{}}}`)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), count)
+	req := (*requests)[0]
+	assert.Equal(t, "/test-index/_count", req.path)
+	assert.Equal(t, "ApiKey api-key", req.header.Get("Authorization"))
+}
+
+func TestV8Client_ScanByQuery(t *testing.T) {
+	client, requests := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		switch {
+		case req.path == "/test-index/_pit":
+			return http.StatusOK, `{"id":"pit-id"}`
+		case req.path == "/_search" && !strings.Contains(req.body, "search_after"):
+			return http.StatusOK, `{"pit_id":"pit-id-2","hits":{"hits":[{"_id":"1","_source":{"WorkflowID":"wid1"},"sort":["rid1"]}]}}`
+		case req.path == "/_search":
+			return http.StatusOK, `{"pit_id":"pit-id-2","hits":{"hits":[]}}`
+		default:
+			return http.StatusOK, `{"succeeded":true}`
+		}
+	})
+
+	resp, err := client.ScanByQuery(context.Background(), &ScanByQueryRequest{
+		Index:    "test-index",
+		Query:    `{"query":{"match_all":{}},"from":0,"size":10}`,
+		PageSize: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Executions, 1)
+	assert.Equal(t, "wid1", resp.Executions[0].WorkflowID)
+	assert.Equal(t, pointInTimeKeepAliveV8, (*requests)[0].query.Get("keep_alive"))
+	assert.JSONEq(t, `{"query":{"match_all":{}},"size":1,"pit":{"id":"pit-id","keep_alive":"1m"},"sort":[{"RunID":{"order":"asc"}}]}`, (*requests)[1].body)
+
+	resp, err = client.ScanByQuery(context.Background(), &ScanByQueryRequest{
+		Index:         "test-index",
+		Query:         `{"query":{"match_all":{}}}`,
+		NextPageToken: resp.NextPageToken,
+		PageSize:      1,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Executions)
+	assert.Nil(t, resp.NextPageToken)
+
+	require.Len(t, *requests, 4)
+	assert.JSONEq(t, `{"query":{"match_all":{}},"size":1,"pit":{"id":"pit-id-2","keep_alive":"1m"},"sort":[{"RunID":{"order":"asc"}}],"search_after":["rid1"]}`, (*requests)[2].body)
+	assert.Equal(t, http.MethodDelete, (*requests)[3].method)
+	assert.Equal(t, "/_pit", (*requests)[3].path)
+	assert.JSONEq(t, `{"id":"pit-id-2"}`, (*requests)[3].body)
+}
+
+func TestV8Client_ScanByQuery_OpenSearch(t *testing.T) {
+	client, requests := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		switch req.path {
+		case "/test-index/_search/point_in_time":
+			return http.StatusOK, `{"pit_id":"pit-id"}`
+		case "/_search":
+			return http.StatusOK, `{"hits":{"hits":[]}}`
+		default:
+			return http.StatusOK, `{"pits":[{"pit_id":"pit-id","successful":true}]}`
+		}
+	})
+	client.openSearch = true
+
+	resp, err := client.ScanByQuery(context.Background(), &ScanByQueryRequest{
+		Index:    "test-index",
+		PageSize: 1,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Executions)
+	assert.Nil(t, resp.NextPageToken)
+
+	require.Len(t, *requests, 3)
+	assert.JSONEq(t, `{"size":1,"pit":{"id":"pit-id","keep_alive":"1m"},"sort":[{"RunID":{"order":"asc"}}]}`, (*requests)[1].body)
+	assert.Equal(t, http.MethodDelete, (*requests)[2].method)
+	assert.Equal(t, "/_search/point_in_time", (*requests)[2].path)
+	assert.JSONEq(t, `{"pit_id":["pit-id"]}`, (*requests)[2].body)
+}
+
+func TestV8Client_Error(t *testing.T) {
+	client, _ := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		return http.StatusNotFound, `{"error":{"type":"index_not_found_exception","reason":"no such index [test-index]"},"status":404}`
+	})
+
+	err := client.PutMapping(context.Background(), "test-index", "Attr", "key", "keyword")
+	require.Error(t, err)
+	assert.True(t, client.IsNotFoundError(err))
+	assert.Contains(t, err.Error(), "no such index [test-index]")
+}
+
+func TestV8BulkProcessor(t *testing.T) {
+	client, requests := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		return http.StatusOK, `{"took":1,"errors":true,"items":[
+			{"index":{"_index":"test-index","_id":"wid~rid","status":201}},
+			{"delete":{"_index":"test-index","_id":"wid~rid2","status":409}}]}`
+	})
+
+	var mu sync.Mutex
+	var afterRequests []GenericBulkableRequest
+	var afterResponse *GenericBulkResponse
+	processor, err := client.RunBulkProcessor(context.Background(), &BulkProcessorParameters{
+		Name:         "test-processor",
+		NumOfWorkers: 1,
+		BulkActions:  10,
+		BeforeFunc:   func(int64, []GenericBulkableRequest) {},
+		AfterFunc: func(executionID int64, requests []GenericBulkableRequest, response *GenericBulkResponse, err *GenericError) {
+			assert.Nil(t, err)
+			mu.Lock()
+			defer mu.Unlock()
+			afterRequests = requests
+			afterResponse = response
+		},
+	})
+	require.NoError(t, err)
+
+	processor.Add(&GenericBulkableAddRequest{
+		Index:       "test-index",
+		Type:        GetESDocType(),
+		ID:          "wid~rid",
+		VersionType: "external",
+		Version:     3,
+		RequestType: BulkableIndexRequest,
+		Doc:         map[string]interface{}{KafkaKey: "kafka-key"},
+	})
+	processor.Add(&GenericBulkableAddRequest{
+		Index:       "test-index",
+		Type:        GetESDocType(),
+		ID:          "wid~rid2",
+		VersionType: "external",
+		Version:     4,
+		RequestType: BulkableDeleteRequest,
+	})
+	require.NoError(t, processor.Flush())
+	require.NoError(t, processor.Stop())
+
+	require.Len(t, *requests, 1)
+	req := (*requests)[0]
+	assert.Equal(t, "/_bulk", req.path)
+	assert.Equal(t, contentTypeNDJSON, req.header.Get("Content-Type"))
+	lines := strings.Split(strings.TrimSuffix(req.body, "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"index":{"_index":"test-index","_id":"wid~rid","version":3,"version_type":"external"}}`, lines[0])
+	assert.JSONEq(t, `{"KafkaKey":"kafka-key"}`, lines[1])
+	assert.JSONEq(t, `{"delete":{"_index":"test-index","_id":"wid~rid2","version":4,"version_type":"external"}}`, lines[2])
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, afterRequests, 2)
+	assert.True(t, afterResponse.Errors)
+	assert.Equal(t, 409, afterResponse.Items[1]["delete"].Status)
+	assert.Equal(t, "kafka-key", processor.RetrieveKafkaKey(afterRequests[0], log.NewNoop(), metrics.NewNoopMetricsClient()))
+	assert.Equal(t, "wid~rid2", processor.RetrieveKafkaKey(afterRequests[1], log.NewNoop(), metrics.NewNoopMetricsClient()))
+}
+
+func TestV8BulkProcessor_Retry(t *testing.T) {
+	var mu sync.Mutex
+	attempts := 0
+	client, _ := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			return http.StatusTooManyRequests, `{"error":{"type":"es_rejected_execution_exception","reason":"rejected"},"status":429}`
+		}
+		return http.StatusOK, `{"took":1,"errors":false,"items":[{"create":{"_index":"test-index","_id":"id","status":201}}]}`
+	})
+
+	var afterErr *GenericError
+	processor, err := client.RunBulkProcessor(context.Background(), &BulkProcessorParameters{
+		NumOfWorkers: 1,
+		BulkActions:  1,
+		Backoff:      NewExponentialBackoff(time.Millisecond, 10*time.Millisecond),
+		AfterFunc: func(executionID int64, requests []GenericBulkableRequest, response *GenericBulkResponse, err *GenericError) {
+			afterErr = err
+		},
+	})
+	require.NoError(t, err)
+	processor.Add(&GenericBulkableAddRequest{
+		Index:       "test-index",
+		ID:          "id",
+		RequestType: BulkableCreateRequest,
+		Doc:         map[string]interface{}{KafkaKey: "kafka-key"},
+	})
+	require.NoError(t, processor.Close())
+
+	assert.Nil(t, afterErr)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestV8BulkProcessor_Timeout(t *testing.T) {
+	client, _ := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		time.Sleep(200 * time.Millisecond)
+		return http.StatusOK, `{"took":1,"errors":false,"items":[{"create":{"_index":"test-index","_id":"id","status":201}}]}`
+	})
+
+	var afterErr *GenericError
+	processor, err := client.RunBulkProcessor(context.Background(), &BulkProcessorParameters{
+		NumOfWorkers: 1,
+		BulkActions:  1,
+		Timeout:      10 * time.Millisecond,
+		AfterFunc: func(executionID int64, requests []GenericBulkableRequest, response *GenericBulkResponse, err *GenericError) {
+			afterErr = err
+		},
+	})
+	require.NoError(t, err)
+	processor.Add(&GenericBulkableAddRequest{
+		Index:       "test-index",
+		ID:          "id",
+		RequestType: BulkableCreateRequest,
+		Doc:         map[string]interface{}{KafkaKey: "kafka-key"},
+	})
+	require.NoError(t, processor.Close())
+
+	require.NotNil(t, afterErr)
+	assert.True(t, errors.Is(afterErr.Details, context.DeadlineExceeded))
+}
+
+func TestV8BulkProcessor_NotStarted(t *testing.T) {
+	client, requests := newTestV8Client(t, func(req *recordedRequestV8) (int, string) {
+		return http.StatusOK, `{"took":1,"errors":false,"items":[]}`
+	})
+
+	processor := newV8BulkProcessor(client, &BulkProcessorParameters{NumOfWorkers: 1, BulkActions: 1})
+	request := &GenericBulkableAddRequest{
+		Index:       "test-index",
+		ID:          "id",
+		RequestType: BulkableDeleteRequest,
+	}
+	// neither blocks before the processor is started
+	processor.Add(request)
+	require.NoError(t, processor.Flush())
+
+	require.NoError(t, processor.Close())
+	processor.Add(request)
+	assert.Equal(t, errBulkProcessorClosed, processor.Start(context.Background()))
+	assert.Empty(t, *requests)
+}
@@ -46,6 +46,8 @@ func NewGenericClient(
 		return NewV6Client(connectConfig, logger)
 	case "v7":
 		return NewV7Client(connectConfig, logger)
+	case "v8", "os2":
+		return NewV8Client(connectConfig, logger)
 	default:
 		return nil, fmt.Errorf("not supported ElasticSearch version: %v", connectConfig.Version)
 	}
@@ -151,6 +153,9 @@ type (
 		Backoff       GenericBackoff
 		BeforeFunc    GenericBulkBeforeFunc
 		AfterFunc     GenericBulkAfterFunc
+		// Timeout is the timeout of a single bulk request, no timeout if it's 0.
+		// It's only used by ElasticSearch 8 and OpenSearch 2.
+		Timeout time.Duration
 	}
 
 	// GenericBackoff allows callers to implement their own Backoff strategy.
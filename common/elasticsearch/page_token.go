@@ -38,6 +38,8 @@ type (
 		TieBreaker string // runID
 		// for ES scroll API
 		ScrollID string
+		// for ES point in time API, SortValue is the value to search after
+		PointInTimeID string
 	}
 )
 
@@ -747,6 +747,11 @@ func ResponseMaxSize(size int) Tag {
 	return newInt("response-max-size", size)
 }
 
+// TaskListPartitionConfig returns tag for TaskListPartitionConfig
+func TaskListPartitionConfig(partitionConfig interface{}) Tag {
+	return newObjectTag("task-list-partition-config", partitionConfig)
+}
+
 ///////////////////  Archival tags defined here: archival- ///////////////////
 // archival request tags
 
@@ -36,6 +36,10 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowUpdateRequested        = workflowAction("add-workflow-update-requested-event")
+	WorkflowActionWorkflowUpdateCompleted        = workflowAction("add-workflow-update-completed-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused               = workflowAction("add-workflow-unpaused-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -100,6 +104,7 @@ var (
 	ComponentTaskList                   = component("tasklist")
 	ComponentHistoryEngine              = component("history-engine")
 	ComponentHistoryCache               = component("history-cache")
+	ComponentChecksumRepairer           = component("checksum-repairer")
 	ComponentDecisionHandler            = component("decision-handler")
 	ComponentEventsCache                = component("events-cache")
 	ComponentTransferQueue              = component("transfer-queue-processor")
@@ -132,6 +137,10 @@ var (
 	ComponentCrossClusterTaskFetcher    = component("cross-cluster-task-fetcher")
 	ComponentShardScanner               = component("shardscanner-scanner")
 	ComponentShardFixer                 = component("shardscanner-fixer")
+	ComponentAsyncWorkflowConsumer      = component("async-workflow-consumer")
+	ComponentScheduler                  = component("scheduler")
+	ComponentVisibilityMigrator         = component("visibility-migrator")
+	ComponentDBResharder                = component("db-resharder")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -301,50 +310,55 @@ var (
 	AdminClientOperationUpdateDynamicConfig               = clientOperation("admin-update-dynamic-config")
 	AdminClientOperationRestoreDynamicConfig              = clientOperation("admin-restore-dynamic-config")
 	AdminClientOperationListDynamicConfig                 = clientOperation("admin-list-dynamic-config")
+	AdminClientOperationListWorkflowTasks                 = clientOperation("admin-list-wf-tasks")
+	AdminClientOperationRetryTask                         = clientOperation("admin-retry-task")
 	AdminDeleteWorkflow                                   = clientOperation("admin-delete-workflow")
 	MaintainCorruptWorkflow                               = clientOperation("maintain-corrupt-workflow")
 
-	FrontendClientOperationDeprecateDomain                  = clientOperation("frontend-deprecate-domain")
-	FrontendClientOperationDescribeDomain                   = clientOperation("frontend-describe-domain")
-	FrontendClientOperationDescribeTaskList                 = clientOperation("frontend-describe-task-list")
-	FrontendClientOperationDescribeWorkflowExecution        = clientOperation("frontend-describe-wf-execution")
-	FrontendClientOperationGetWorkflowExecutionHistory      = clientOperation("frontend-get-wf-execution-history")
-	FrontendClientOperationListArchivedWorkflowExecutions   = clientOperation("frontend-list-archived-wf-executions")
-	FrontendClientOperationListClosedWorkflowExecutions     = clientOperation("frontend-list-closed-wf-executions")
-	FrontendClientOperationListDomains                      = clientOperation("frontend-list-domains")
-	FrontendClientOperationListOpenWorkflowExecutions       = clientOperation("frontend-list-open-wf-executions")
-	FrontendClientOperationListWorkflowExecutions           = clientOperation("frontend-list-wf-executions")
-	FrontendClientOperationScanWorkflowExecutions           = clientOperation("frontend-scan-wf-executions")
-	FrontendClientOperationCountWorkflowExecutions          = clientOperation("frontend-count-wf-executions")
-	FrontendClientOperationGetSearchAttributes              = clientOperation("frontend-get-search-attributes")
-	FrontendClientOperationPollForActivityTask              = clientOperation("frontend-poll-for-activity-task")
-	FrontendClientOperationPollForDecisionTask              = clientOperation("frontend-poll-for-decision-task")
-	FrontendClientOperationQueryWorkflow                    = clientOperation("frontend-query-workflow")
-	FrontendClientOperationRecordActivityTaskHeartbeat      = clientOperation("frontend-record-activity-heartbeat")
-	FrontendClientOperationRecordActivityTaskHeartbeatByID  = clientOperation("frontend-record-activity-heartbeat-by-id")
-	FrontendClientOperationRegisterDomain                   = clientOperation("frontend-register-domain")
-	FrontendClientOperationRequestCancelWorkflowExecution   = clientOperation("frontend-request-cancel-wf-execution")
-	FrontendClientOperationResetStickyTaskList              = clientOperation("frontend-reset-sticky-task-list")
-	FrontendClientOperationResetWorkflowExecution           = clientOperation("frontend-reset-wf-execution")
-	FrontendClientOperationRefreshWorkflowTasks             = clientOperation("frontend-refresh-wf-tasks")
-	FrontendClientOperationRespondActivityTaskCanceled      = clientOperation("frontend-respond-activity-task-canceled")
-	FrontendClientOperationRespondActivityTaskCanceledByID  = clientOperation("frontend-respond-activity-task-canceled-by-id")
-	FrontendClientOperationRespondActivityTaskCompleted     = clientOperation("frontend-respond-activity-task-completed")
-	FrontendClientOperationRespondActivityTaskCompletedByID = clientOperation("frontend-respond-activity-task-completed-by-id")
-	FrontendClientOperationRespondActivityTaskFailed        = clientOperation("frontend-respond-activity-task-failed")
-	FrontendClientOperationRespondActivityTaskFailedByID    = clientOperation("frontend-respond-activity-task-failed-by-id")
-	FrontendClientOperationRespondDecisionTaskCompleted     = clientOperation("frontend-respond-decision-task-completed")
-	FrontendClientOperationRespondDecisionTaskFailed        = clientOperation("frontend-respond-decision-task-failed")
-	FrontendClientOperationRespondQueryTaskCompleted        = clientOperation("frontend-respond-query-task-completed")
-	FrontendClientOperationRestartWorkflowExecution         = clientOperation("frontend-restart-wf-execution")
-	FrontendClientOperationSignalWithStartWorkflowExecution = clientOperation("frontend-signal-with-start-wf-execution")
-	FrontendClientOperationSignalWorkflowExecution          = clientOperation("frontend-signal-wf-execution")
-	FrontendClientOperationStartWorkflowExecution           = clientOperation("frontend-start-wf-execution")
-	FrontendClientOperationTerminateWorkflowExecution       = clientOperation("frontend-terminate-wf-execution")
-	FrontendClientOperationUpdateDomain                     = clientOperation("frontend-update-domain")
-	FrontendClientOperationGetClusterInfo                   = clientOperation("frontend-get-cluster-info")
-	FrontendClientOperationListTaskListPartitions           = clientOperation("frontend-list-task-list-partitions")
-	FrontendClientOperationGetTaskListsByDomain             = clientOperation("frontend-get-task-list-for-domain")
+	FrontendClientOperationDeprecateDomain                       = clientOperation("frontend-deprecate-domain")
+	FrontendClientOperationDescribeDomain                        = clientOperation("frontend-describe-domain")
+	FrontendClientOperationDescribeTaskList                      = clientOperation("frontend-describe-task-list")
+	FrontendClientOperationDescribeWorkflowExecution             = clientOperation("frontend-describe-wf-execution")
+	FrontendClientOperationGetWorkflowExecutionHistory           = clientOperation("frontend-get-wf-execution-history")
+	FrontendClientOperationListArchivedWorkflowExecutions        = clientOperation("frontend-list-archived-wf-executions")
+	FrontendClientOperationListClosedWorkflowExecutions          = clientOperation("frontend-list-closed-wf-executions")
+	FrontendClientOperationListDomains                           = clientOperation("frontend-list-domains")
+	FrontendClientOperationListOpenWorkflowExecutions            = clientOperation("frontend-list-open-wf-executions")
+	FrontendClientOperationListWorkflowExecutions                = clientOperation("frontend-list-wf-executions")
+	FrontendClientOperationScanWorkflowExecutions                = clientOperation("frontend-scan-wf-executions")
+	FrontendClientOperationCountWorkflowExecutions               = clientOperation("frontend-count-wf-executions")
+	FrontendClientOperationGetSearchAttributes                   = clientOperation("frontend-get-search-attributes")
+	FrontendClientOperationPollForActivityTask                   = clientOperation("frontend-poll-for-activity-task")
+	FrontendClientOperationPollForDecisionTask                   = clientOperation("frontend-poll-for-decision-task")
+	FrontendClientOperationQueryWorkflow                         = clientOperation("frontend-query-workflow")
+	FrontendClientOperationRecordActivityTaskHeartbeat           = clientOperation("frontend-record-activity-heartbeat")
+	FrontendClientOperationRecordActivityTaskHeartbeatByID       = clientOperation("frontend-record-activity-heartbeat-by-id")
+	FrontendClientOperationRegisterDomain                        = clientOperation("frontend-register-domain")
+	FrontendClientOperationRequestCancelWorkflowExecution        = clientOperation("frontend-request-cancel-wf-execution")
+	FrontendClientOperationResetStickyTaskList                   = clientOperation("frontend-reset-sticky-task-list")
+	FrontendClientOperationResetWorkflowExecution                = clientOperation("frontend-reset-wf-execution")
+	FrontendClientOperationRefreshWorkflowTasks                  = clientOperation("frontend-refresh-wf-tasks")
+	FrontendClientOperationRespondActivityTaskCanceled           = clientOperation("frontend-respond-activity-task-canceled")
+	FrontendClientOperationRespondActivityTaskCanceledByID       = clientOperation("frontend-respond-activity-task-canceled-by-id")
+	FrontendClientOperationRespondActivityTaskCompleted          = clientOperation("frontend-respond-activity-task-completed")
+	FrontendClientOperationRespondActivityTaskCompletedByID      = clientOperation("frontend-respond-activity-task-completed-by-id")
+	FrontendClientOperationRespondActivityTaskFailed             = clientOperation("frontend-respond-activity-task-failed")
+	FrontendClientOperationRespondActivityTaskFailedByID         = clientOperation("frontend-respond-activity-task-failed-by-id")
+	FrontendClientOperationRespondDecisionTaskCompleted          = clientOperation("frontend-respond-decision-task-completed")
+	FrontendClientOperationRespondDecisionTaskFailed             = clientOperation("frontend-respond-decision-task-failed")
+	FrontendClientOperationRespondQueryTaskCompleted             = clientOperation("frontend-respond-query-task-completed")
+	FrontendClientOperationRestartWorkflowExecution              = clientOperation("frontend-restart-wf-execution")
+	FrontendClientOperationSignalWithStartWorkflowExecution      = clientOperation("frontend-signal-with-start-wf-execution")
+	FrontendClientOperationSignalWithStartWorkflowExecutionAsync = clientOperation("frontend-signal-with-start-wf-execution-async")
+	FrontendClientOperationSignalWorkflowExecution               = clientOperation("frontend-signal-wf-execution")
+	FrontendClientOperationStartWorkflowExecution                = clientOperation("frontend-start-wf-execution")
+	FrontendClientOperationStartWorkflowExecutionAsync           = clientOperation("frontend-start-wf-execution-async")
+	FrontendClientOperationTerminateWorkflowExecution            = clientOperation("frontend-terminate-wf-execution")
+	FrontendClientOperationUpdateDomain                          = clientOperation("frontend-update-domain")
+	FrontendClientOperationUpdateWorkflowExecution               = clientOperation("frontend-update-wf-execution")
+	FrontendClientOperationGetClusterInfo                        = clientOperation("frontend-get-cluster-info")
+	FrontendClientOperationListTaskListPartitions                = clientOperation("frontend-list-task-list-partitions")
+	FrontendClientOperationGetTaskListsByDomain                  = clientOperation("frontend-get-task-list-for-domain")
 
 	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
 	HistoryClientOperationDescribeHistoryHost               = clientOperation("history-describe-history-host")
@@ -389,6 +403,11 @@ var (
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
 	HistoryClientOperationGetFailoverInfo                   = clientOperation("history-get-failover-info")
+	HistoryClientOperationUpdateWorkflowExecution           = clientOperation("history-update-wf-execution")
+	HistoryClientOperationPauseWorkflowExecution            = clientOperation("history-pause-wf-execution")
+	HistoryClientOperationUnpauseWorkflowExecution          = clientOperation("history-unpause-wf-execution")
+	HistoryClientOperationListWorkflowTasks                 = clientOperation("history-list-wf-tasks")
+	HistoryClientOperationRetryTask                         = clientOperation("history-retry-task")
 
 	MatchingClientOperationAddActivityTask        = clientOperation("matching-add-activity-task")
 	MatchingClientOperationAddDecisionTask        = clientOperation("matching-add-decision-task")
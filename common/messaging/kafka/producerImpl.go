@@ -27,6 +27,7 @@ import (
 	"github.com/Shopify/sarama"
 
 	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/asyncworkflow"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -104,6 +105,17 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case *asyncworkflow.Message:
+		payload, err := asyncworkflow.EncodeMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.StringEncoder(message.PartitionKey),
+			Value: sarama.ByteEncoder(payload),
+		}
+		return msg, nil
 	case *sarama.ConsumerMessage:
 		msg := &sarama.ProducerMessage{
 			Topic: p.topic,
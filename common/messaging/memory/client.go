@@ -0,0 +1,236 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/asyncworkflow"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/messaging"
+)
+
+const (
+	defaultQueueSize = 10000
+	dlqSuffix        = "-dlq"
+)
+
+var (
+	errUnknownMessageType = errors.New("unknown producer message type")
+	errDLQFull            = errors.New("dlq is full")
+)
+
+type (
+	// clientImpl is an in-memory implementation of the messaging client. Every application
+	// gets its own queue, created on first use, plus a DLQ queue for nacked messages.
+	// Producers and consumers only see each other when they are created by the same client.
+	clientImpl struct {
+		sync.Mutex
+		queueSize int
+		queues    map[string]*queue
+	}
+
+	queue struct {
+		name       string
+		messages   chan *messageImpl
+		nextOffset int64
+	}
+
+	producerImpl struct {
+		queue      *queue
+		msgEncoder codec.BinaryEncoder
+	}
+
+	consumerImpl struct {
+		queue      *queue
+		dlq        *queue
+		msgChan    chan messaging.Message
+		shutdownCh chan struct{}
+		startOnce  sync.Once
+		stopOnce   sync.Once
+	}
+
+	messageImpl struct {
+		value  []byte
+		offset int64
+		dlq    *queue
+	}
+)
+
+var _ messaging.Client = (*clientImpl)(nil)
+var _ messaging.Producer = (*producerImpl)(nil)
+var _ messaging.Consumer = (*consumerImpl)(nil)
+var _ messaging.Message = (*messageImpl)(nil)
+
+// NewClient creates an in-memory messaging client, which is meant for single process
+// deployments and tests. Messages are lost when the process exits.
+func NewClient() messaging.Client {
+	return NewClientWithQueueSize(defaultQueueSize)
+}
+
+// NewClientWithQueueSize creates an in-memory messaging client whose queues hold up to queueSize messages
+func NewClientWithQueueSize(queueSize int) messaging.Client {
+	return &clientImpl{
+		queueSize: queueSize,
+		queues:    make(map[string]*queue),
+	}
+}
+
+// NewConsumer creates a consumer reading the queue of the given application
+func (c *clientImpl) NewConsumer(appName, consumerName string) (messaging.Consumer, error) {
+	return &consumerImpl{
+		queue:      c.getQueue(appName),
+		dlq:        c.getQueue(appName + dlqSuffix),
+		msgChan:    make(chan messaging.Message),
+		shutdownCh: make(chan struct{}),
+	}, nil
+}
+
+// NewProducer creates a producer writing to the queue of the given application
+func (c *clientImpl) NewProducer(appName string) (messaging.Producer, error) {
+	return &producerImpl{
+		queue:      c.getQueue(appName),
+		msgEncoder: codec.NewThriftRWEncoder(),
+	}, nil
+}
+
+func (c *clientImpl) getQueue(name string) *queue {
+	c.Lock()
+	defer c.Unlock()
+
+	q, ok := c.queues[name]
+	if !ok {
+		q = &queue{
+			name:     name,
+			messages: make(chan *messageImpl, c.queueSize),
+		}
+		c.queues[name] = q
+	}
+	return q
+}
+
+func (q *queue) newMessage(value []byte, dlq *queue) *messageImpl {
+	return &messageImpl{
+		value:  value,
+		offset: atomic.AddInt64(&q.nextOffset, 1) - 1,
+		dlq:    dlq,
+	}
+}
+
+// Publish blocks until the message is queued or the context is done
+func (p *producerImpl) Publish(ctx context.Context, msg interface{}) error {
+	value, err := p.serialize(msg)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case p.queue.messages <- p.queue.newMessage(value, nil):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *producerImpl) serialize(msg interface{}) ([]byte, error) {
+	switch msg := msg.(type) {
+	case *asyncworkflow.Message:
+		return asyncworkflow.EncodeMessage(msg)
+	case *indexer.Message:
+		return p.msgEncoder.Encode(msg)
+	default:
+		return nil, errUnknownMessageType
+	}
+}
+
+// Start starts the consumer
+func (c *consumerImpl) Start() error {
+	c.startOnce.Do(func() {
+		go c.pump()
+	})
+	return nil
+}
+
+// Stop stops the consumer, the message channel is closed once the consumer stopped
+func (c *consumerImpl) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.shutdownCh)
+	})
+}
+
+// Messages return the message channel for this consumer
+func (c *consumerImpl) Messages() <-chan messaging.Message {
+	return c.msgChan
+}
+
+func (c *consumerImpl) pump() {
+	defer close(c.msgChan)
+
+	for {
+		select {
+		case msg := <-c.queue.messages:
+			msg.dlq = c.dlq
+			select {
+			case c.msgChan <- msg:
+			case <-c.shutdownCh:
+				// hand the message back so that it is not lost for other consumers,
+				// without blocking the shutdown when the queue is full
+				go func() { c.queue.messages <- msg }()
+				return
+			}
+		case <-c.shutdownCh:
+			return
+		}
+	}
+}
+
+func (m *messageImpl) Value() []byte {
+	return m.value
+}
+
+func (m *messageImpl) Partition() int32 {
+	return 0
+}
+
+func (m *messageImpl) Offset() int64 {
+	return m.offset
+}
+
+func (m *messageImpl) Ack() error {
+	return nil
+}
+
+// Nack moves the message to the DLQ of the queue it was read from
+func (m *messageImpl) Nack() error {
+	if m.dlq == nil {
+		return nil
+	}
+	select {
+	case m.dlq.messages <- m.dlq.newMessage(m.value, nil):
+		return nil
+	default:
+		return errDLQFull
+	}
+}
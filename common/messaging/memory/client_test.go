@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/asyncworkflow"
+	"github.com/uber/cadence/common/messaging"
+)
+
+func TestPublishConsume(t *testing.T) {
+	client := NewClient()
+	producer, err := client.NewProducer("app")
+	require.NoError(t, err)
+	consumer, err := client.NewConsumer("app", "consumer")
+	require.NoError(t, err)
+	require.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	message := &asyncworkflow.Message{Type: asyncworkflow.RequestTypeStartWorkflowExecution, PartitionKey: "wid"}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, producer.Publish(context.Background(), message))
+	}
+
+	for i := 0; i < 3; i++ {
+		msg := receive(t, consumer.Messages())
+		assert.Equal(t, int64(i), msg.Offset())
+		decoded, err := asyncworkflow.DecodeMessage(msg.Value())
+		require.NoError(t, err)
+		assert.Equal(t, message.PartitionKey, decoded.PartitionKey)
+		assert.NoError(t, msg.Ack())
+	}
+}
+
+func TestPublish_UnknownMessageType(t *testing.T) {
+	producer, err := NewClient().NewProducer("app")
+	require.NoError(t, err)
+	assert.Equal(t, errUnknownMessageType, producer.Publish(context.Background(), "message"))
+}
+
+func TestPublish_QueueFull(t *testing.T) {
+	producer, err := NewClientWithQueueSize(1).NewProducer("app")
+	require.NoError(t, err)
+	message := &asyncworkflow.Message{Type: asyncworkflow.RequestTypeStartWorkflowExecution}
+	require.NoError(t, producer.Publish(context.Background(), message))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, producer.Publish(ctx, message))
+}
+
+func TestNack_MovesMessageToDLQ(t *testing.T) {
+	client := NewClient()
+	producer, err := client.NewProducer("app")
+	require.NoError(t, err)
+	consumer, err := client.NewConsumer("app", "consumer")
+	require.NoError(t, err)
+	dlqConsumer, err := client.NewConsumer("app"+dlqSuffix, "dlq-consumer")
+	require.NoError(t, err)
+	require.NoError(t, consumer.Start())
+	require.NoError(t, dlqConsumer.Start())
+	defer consumer.Stop()
+	defer dlqConsumer.Stop()
+
+	require.NoError(t, producer.Publish(context.Background(), &asyncworkflow.Message{PartitionKey: "wid"}))
+	msg := receive(t, consumer.Messages())
+	require.NoError(t, msg.Nack())
+
+	dlqMsg := receive(t, dlqConsumer.Messages())
+	assert.Equal(t, msg.Value(), dlqMsg.Value())
+}
+
+func TestStop_ClosesMessageChannel(t *testing.T) {
+	consumer, err := NewClient().NewConsumer("app", "consumer")
+	require.NoError(t, err)
+	require.NoError(t, consumer.Start())
+	consumer.Stop()
+
+	select {
+	case _, ok := <-consumer.Messages():
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("message channel is not closed")
+	}
+}
+
+func receive(t *testing.T, messages <-chan messaging.Message) messaging.Message {
+	select {
+	case msg := <-messages:
+		require.NotNil(t, msg)
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+	return nil
+}
@@ -335,6 +335,9 @@ type (
 		DecisionOriginalScheduledTimestamp int64
 		CancelRequested                    bool
 		CancelRequestID                    string
+		Paused                             bool
+		PendingUpdateIDs                   map[string]int64 // update ID -> WorkflowExecutionUpdateRequested event ID
+		CompletedUpdateIDs                 map[string]int64 // update ID -> first event ID of the batch of the WorkflowExecutionUpdateCompleted event
 		StickyTaskList                     string
 		StickyScheduleToStartTimeout       int32
 		ClientLibraryVersion               string
@@ -441,14 +444,25 @@ type (
 
 	// TaskListInfo describes a state of a task list implementation.
 	TaskListInfo struct {
-		DomainID    string
-		Name        string
-		TaskType    int
-		RangeID     int64
-		AckLevel    int64
-		Kind        int
-		Expiry      time.Time
-		LastUpdated time.Time
+		DomainID        string
+		Name            string
+		TaskType        int
+		RangeID         int64
+		AckLevel        int64
+		Kind            int
+		Expiry          time.Time
+		LastUpdated     time.Time
+		PartitionConfig *TaskListPartitionConfig
+	}
+
+	// TaskListPartitionConfig is the partition layout of a task list, as
+	// decided by the adaptive partition scaler in matching. It is only
+	// persisted in the record of the root partition. A nil config means
+	// partition counts come from dynamic config.
+	TaskListPartitionConfig struct {
+		Version            int64
+		NumReadPartitions  int
+		NumWritePartitions int
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -461,6 +475,9 @@ type (
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		IsolationGroup         string
+		Priority               int32
+		FairnessKey            string
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -306,6 +306,9 @@ type (
 		DecisionOriginalScheduledTimestamp time.Time
 		CancelRequested                    bool
 		CancelRequestID                    string
+		Paused                             bool
+		PendingUpdateIDs                   map[string]int64
+		CompletedUpdateIDs                 map[string]int64
 		StickyTaskList                     string
 		StickyScheduleToStartTimeout       time.Duration
 		ClientLibraryVersion               string
@@ -879,6 +882,9 @@ type (
 		ScheduleToStartTimeout time.Duration
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		IsolationGroup         string
+		Priority               int32
+		FairnessKey            string
 	}
 
 	// InternalCreateTasksInfo describes a task to be created in InternalCreateTasksRequest
@@ -442,7 +442,8 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 	}
 
 	var queryDSL string
-	if len(token.ScrollID) == 0 { // first call
+	// the scroll API keeps the query of the first call, while the point in time API needs it for every page
+	if len(token.ScrollID) == 0 {
 		queryDSL, err = getESQueryDSLForScan(request)
 		if err != nil {
 			return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
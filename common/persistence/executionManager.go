@@ -168,6 +168,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		DecisionOriginalScheduledTimestamp: info.DecisionOriginalScheduledTimestamp.UnixNano(),
 		CancelRequested:                    info.CancelRequested,
 		CancelRequestID:                    info.CancelRequestID,
+		Paused:                             info.Paused,
+		PendingUpdateIDs:                   info.PendingUpdateIDs,
+		CompletedUpdateIDs:                 info.CompletedUpdateIDs,
 		StickyTaskList:                     info.StickyTaskList,
 		StickyScheduleToStartTimeout:       int32(info.StickyScheduleToStartTimeout.Seconds()),
 		ClientLibraryVersion:               info.ClientLibraryVersion,
@@ -493,6 +496,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		DecisionOriginalScheduledTimestamp: time.Unix(0, info.DecisionOriginalScheduledTimestamp),
 		CancelRequested:                    info.CancelRequested,
 		CancelRequestID:                    info.CancelRequestID,
+		Paused:                             info.Paused,
+		PendingUpdateIDs:                   info.PendingUpdateIDs,
+		CompletedUpdateIDs:                 info.CompletedUpdateIDs,
 		StickyTaskList:                     info.StickyTaskList,
 		StickyScheduleToStartTimeout:       common.SecondsToDuration(int64(info.StickyScheduleToStartTimeout)),
 		ClientLibraryVersion:               info.ClientLibraryVersion,
@@ -69,10 +69,21 @@ func newNoSQLTaskStore(
 }
 
 func (t *nosqlTaskStore) GetOrphanTasks(ctx context.Context, request *p.GetOrphanTasksRequest) (*p.GetOrphanTasksResponse, error) {
-	// TODO: It's unclear if this's necessary or possible for NoSQL
-	return nil, &types.InternalServiceError{
-		Message: "Unimplemented call to GetOrphanTasks for NoSQL",
+	rows, err := t.db.SelectOrphanTasks(ctx, request.Limit)
+	if err != nil {
+		return nil, convertCommonErrors(t.db, "GetOrphanTasks", err)
+	}
+
+	tasks := make([]*p.TaskKey, len(rows))
+	for i, row := range rows {
+		tasks[i] = &p.TaskKey{
+			DomainID:     row.DomainID,
+			TaskListName: row.TaskListName,
+			TaskType:     row.TaskListType,
+			TaskID:       row.TaskID,
+		}
 	}
+	return &p.GetOrphanTasksResponse{Tasks: tasks}, nil
 }
 
 func (t *nosqlTaskStore) LeaseTaskList(
@@ -130,6 +141,7 @@ func (t *nosqlTaskStore) LeaseTaskList(
 			TaskListKind:    currTL.TaskListKind,
 			AckLevel:        currTL.AckLevel,
 			LastUpdatedTime: now,
+			PartitionConfig: currTL.PartitionConfig,
 		}, currTL.RangeID-1)
 	}
 	if err != nil {
@@ -143,13 +155,14 @@ func (t *nosqlTaskStore) LeaseTaskList(
 		return nil, convertCommonErrors(t.db, "LeaseTaskList", err)
 	}
 	tli := &p.TaskListInfo{
-		DomainID:    request.DomainID,
-		Name:        request.TaskList,
-		TaskType:    request.TaskType,
-		RangeID:     currTL.RangeID,
-		AckLevel:    currTL.AckLevel,
-		Kind:        request.TaskListKind,
-		LastUpdated: now,
+		DomainID:        request.DomainID,
+		Name:            request.TaskList,
+		TaskType:        request.TaskType,
+		RangeID:         currTL.RangeID,
+		AckLevel:        currTL.AckLevel,
+		Kind:            request.TaskListKind,
+		LastUpdated:     now,
+		PartitionConfig: currTL.PartitionConfig,
 	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -168,6 +181,7 @@ func (t *nosqlTaskStore) UpdateTaskList(
 		TaskListKind:    tli.Kind,
 		AckLevel:        tli.AckLevel,
 		LastUpdatedTime: time.Now(),
+		PartitionConfig: tli.PartitionConfig,
 	}
 
 	if tli.Kind == p.TaskListKindSticky { // if task_list is sticky, then update with TTL
@@ -191,12 +205,31 @@ func (t *nosqlTaskStore) UpdateTaskList(
 }
 
 func (t *nosqlTaskStore) ListTaskList(
-	_ context.Context,
-	_ *p.ListTaskListRequest,
+	ctx context.Context,
+	request *p.ListTaskListRequest,
 ) (*p.ListTaskListResponse, error) {
-	return nil, &types.InternalServiceError{
-		Message: "unsupported operation",
+	resp, err := t.db.ListTaskList(ctx, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, convertCommonErrors(t.db, "ListTaskList", err)
 	}
+
+	items := make([]p.TaskListInfo, 0, len(resp.TaskLists))
+	for _, row := range resp.TaskLists {
+		items = append(items, p.TaskListInfo{
+			DomainID:        row.DomainID,
+			Name:            row.TaskListName,
+			TaskType:        row.TaskListType,
+			RangeID:         row.RangeID,
+			AckLevel:        row.AckLevel,
+			Kind:            row.TaskListKind,
+			LastUpdated:     row.LastUpdatedTime,
+			PartitionConfig: row.PartitionConfig,
+		})
+	}
+	return &p.ListTaskListResponse{
+		Items:         items,
+		NextPageToken: resp.NextPageToken,
+	}, nil
 }
 
 func (t *nosqlTaskStore) DeleteTaskList(
@@ -231,16 +264,22 @@ func (t *nosqlTaskStore) CreateTasks(
 	var tasks []*nosqlplugin.TaskRowForInsert
 	for _, t := range request.Tasks {
 		task := &nosqlplugin.TaskRow{
-			DomainID:     request.TaskListInfo.DomainID,
-			TaskListName: request.TaskListInfo.Name,
-			TaskListType: request.TaskListInfo.TaskType,
-			TaskID:       t.TaskID,
-			WorkflowID:   t.Execution.GetWorkflowID(),
-			RunID:        t.Execution.GetRunID(),
-			ScheduledID:  t.Data.ScheduleID,
-			CreatedTime:  now,
+			DomainID:       request.TaskListInfo.DomainID,
+			TaskListName:   request.TaskListInfo.Name,
+			TaskListType:   request.TaskListInfo.TaskType,
+			TaskID:         t.TaskID,
+			WorkflowID:     t.Execution.GetWorkflowID(),
+			RunID:          t.Execution.GetRunID(),
+			ScheduledID:    t.Data.ScheduleID,
+			CreatedTime:    now,
+			IsolationGroup: t.Data.IsolationGroup,
+			Priority:       t.Data.Priority,
+			FairnessKey:    t.Data.FairnessKey,
 		}
 		ttl := int(t.Data.ScheduleToStartTimeout.Seconds())
+		if ttl > 0 {
+			task.Expiry = now.Add(time.Duration(ttl) * time.Second)
+		}
 		tasks = append(tasks, &nosqlplugin.TaskRowForInsert{
 			TaskRow:    *task,
 			TTLSeconds: ttl,
@@ -272,6 +311,7 @@ func toTaskListRow(info *p.TaskListInfo) *nosqlplugin.TaskListRow {
 		RangeID:         info.RangeID,
 		AckLevel:        info.AckLevel,
 		LastUpdatedTime: info.LastUpdated,
+		PartitionConfig: info.PartitionConfig,
 	}
 }
 
@@ -313,12 +353,16 @@ func (t *nosqlTaskStore) GetTasks(
 
 func toTaskInfo(t *nosqlplugin.TaskRow) *p.InternalTaskInfo {
 	return &p.InternalTaskInfo{
-		DomainID:    t.DomainID,
-		WorkflowID:  t.WorkflowID,
-		RunID:       t.RunID,
-		TaskID:      t.TaskID,
-		ScheduleID:  t.ScheduledID,
-		CreatedTime: t.CreatedTime,
+		DomainID:       t.DomainID,
+		WorkflowID:     t.WorkflowID,
+		RunID:          t.RunID,
+		TaskID:         t.TaskID,
+		ScheduleID:     t.ScheduledID,
+		CreatedTime:    t.CreatedTime,
+		IsolationGroup: t.IsolationGroup,
+		Priority:       t.Priority,
+		FairnessKey:    t.FairnessKey,
+		Expiry:         t.Expiry,
 	}
 }
 
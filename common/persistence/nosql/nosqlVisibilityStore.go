@@ -22,6 +22,7 @@ package nosql
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -36,6 +37,11 @@ import (
 const (
 	defaultCloseTTLSeconds = 86400
 	openExecutionTTLBuffer = int64(86400) // setting it to a day to account for shard going down
+
+	// countPageSize is the page size used to count the records matching a query
+	countPageSize = 1000
+	// countLimit is the max number of records read to count the records matching a query
+	countLimit = 10 * countPageSize
 )
 
 type (
@@ -43,6 +49,13 @@ type (
 		sortByCloseTime bool
 		nosqlStore
 	}
+
+	// visibilityQueryPageToken is the page token of ListWorkflowExecutions, open records are
+	// read before closed records if the query matches both
+	visibilityQueryPageToken struct {
+		Closed bool   `json:",omitempty"`
+		Token  []byte `json:",omitempty"`
+	}
 )
 
 // newNoSQLVisibilityStore is used to create an instance of VisibilityStore implementation
@@ -363,21 +376,121 @@ func (v *nosqlVisibilityStore) DeleteWorkflowExecution(
 }
 
 func (v *nosqlVisibilityStore) ListWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	query, err := parseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	return v.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", query, request)
 }
 
 func (v *nosqlVisibilityStore) ScanWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := parseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	return v.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", query, request)
 }
 
+// CountWorkflowExecutions reads all the records matching the query, as nosql
+// visibility tables can't be counted without reading them. The query must be
+// narrowed by a filter and at most countLimit records are counted.
 func (v *nosqlVisibilityStore) CountWorkflowExecutions(
-	_ context.Context,
-	_ *p.CountWorkflowExecutionsRequest,
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	query, err := parseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	if !query.isNarrowed() {
+		return nil, &types.BadRequestError{
+			Message: "CountWorkflowExecutions requires a WorkflowType, WorkflowID, CloseStatus or time range filter.",
+		}
+	}
+
+	listRequest := &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: request.DomainUUID,
+		Domain:     request.Domain,
+		PageSize:   countPageSize,
+		Query:      request.Query,
+	}
+	var count int64
+	for {
+		resp, err := v.listWorkflowExecutionsByQuery(ctx, "CountWorkflowExecutions", query, listRequest)
+		if err != nil {
+			return nil, err
+		}
+		count += int64(len(resp.Executions))
+		if count > countLimit {
+			return nil, &types.LimitExceededError{
+				Message: fmt.Sprintf("CountWorkflowExecutions: more than %v workflows match the query, narrow the query to count them.", countLimit),
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+		}
+		listRequest.NextPageToken = resp.NextPageToken
+	}
+}
+
+func (v *nosqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	query *visibilityQuery,
+	request *p.ListWorkflowExecutionsByQueryRequest,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	token := &visibilityQueryPageToken{}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &types.BadRequestError{
+				Message: fmt.Sprintf("%v: invalid next page token: %v", opName, err),
+			}
+		}
+	}
+
+	filters := query.filters(v.sortByCloseTime)
+	filter := filters[0]
+	if token.Closed && len(filters) > 1 {
+		filter = filters[1]
+	}
+	filter.ListRequest = p.InternalListWorkflowExecutionsRequest{
+		DomainUUID:    request.DomainUUID,
+		Domain:        request.Domain,
+		EarliestTime:  query.earliestTime,
+		LatestTime:    query.latestTime,
+		PageSize:      request.PageSize,
+		NextPageToken: token.Token,
+	}
+	resp, err := v.db.SelectVisibility(ctx, filter)
+	if err != nil {
+		return nil, convertCommonErrors(v.db, opName, err)
+	}
+
+	var nextToken *visibilityQueryPageToken
+	switch {
+	case len(resp.NextPageToken) > 0:
+		nextToken = &visibilityQueryPageToken{
+			Closed: filter == filters[len(filters)-1] && query.closed,
+			Token:  resp.NextPageToken,
+		}
+	case filter != filters[len(filters)-1]:
+		// open records are all read, continue with closed records
+		nextToken = &visibilityQueryPageToken{Closed: true}
+	}
+	var nextPageToken []byte
+	if nextToken != nil {
+		nextPageToken, err = json.Marshal(nextToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    resp.Executions,
+		NextPageToken: nextPageToken,
+	}, nil
 }
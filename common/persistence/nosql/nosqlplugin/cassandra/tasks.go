@@ -52,7 +52,10 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`partition_config_version: ?, ` +
+		`num_read_partitions: ?, ` +
+		`num_write_partitions: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -60,7 +63,10 @@ const (
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`isolation_group: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ? ` +
 		`}`
 
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
@@ -174,12 +180,14 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 		LastUpdatedTime: lastUpdatedTime,
 		AckLevel:        ackLevel,
 		RangeID:         rangeID,
+		PartitionConfig: parseTaskListPartitionConfig(tlDB),
 	}, nil
 }
 
 // InsertTaskList insert a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *cdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
+	partitionConfigVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigValues(row.PartitionConfig)
 	query := db.session.Query(templateInsertTaskListQuery,
 		row.DomainID,
 		row.TaskListName,
@@ -193,6 +201,9 @@ func (db *cdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow)
 		0,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		partitionConfigVersion,
+		numReadPartitions,
+		numWritePartitions,
 	).WithContext(ctx)
 
 	previous := make(map[string]interface{})
@@ -211,6 +222,7 @@ func (db *cdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
+	partitionConfigVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigValues(row.PartitionConfig)
 	query := db.session.Query(templateUpdateTaskListQuery,
 		row.RangeID,
 		row.DomainID,
@@ -219,6 +231,9 @@ func (db *cdb) UpdateTaskList(
 		row.AckLevel,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		partitionConfigVersion,
+		numReadPartitions,
+		numWritePartitions,
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -236,6 +251,28 @@ func (db *cdb) UpdateTaskList(
 	return handleTaskListAppliedError(applied, previous)
 }
 
+func taskListPartitionConfigValues(config *p.TaskListPartitionConfig) (int64, int, int) {
+	if config == nil {
+		return 0, 0, 0
+	}
+	return config.Version, config.NumReadPartitions, config.NumWritePartitions
+}
+
+func parseTaskListPartitionConfig(tlDB map[string]interface{}) *p.TaskListPartitionConfig {
+	// rows written before schema v0.37 don't have the partition config columns
+	version, _ := tlDB["partition_config_version"].(int64)
+	if version == 0 {
+		return nil
+	}
+	numReadPartitions, _ := tlDB["num_read_partitions"].(int)
+	numWritePartitions, _ := tlDB["num_write_partitions"].(int)
+	return &p.TaskListPartitionConfig{
+		Version:            version,
+		NumReadPartitions:  numReadPartitions,
+		NumWritePartitions: numWritePartitions,
+	}
+}
+
 func handleTaskListAppliedError(applied bool, previous map[string]interface{}) error {
 	if !applied {
 		// NOTE: Cassandra only returns the conflicted columns in this results
@@ -262,6 +299,7 @@ func (db *cdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
+	partitionConfigVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigValues(row.PartitionConfig)
 	batch := db.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	// part 1 is used to set TTL on primary key as UPDATE can't set TTL for primary key
 	batch.Query(templateUpdateTaskListQueryWithTTLPart1,
@@ -282,6 +320,9 @@ func (db *cdb) UpdateTaskListWithTTL(
 		row.AckLevel,
 		row.TaskListKind,
 		time.Now(),
+		partitionConfigVersion,
+		numReadPartitions,
+		numWritePartitions,
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -344,6 +385,7 @@ func (db *cdb) InsertTasks(
 	taskListType := tasklistCondition.TaskListType
 	taskListKind := tasklistCondition.TaskListKind
 	ackLevel := tasklistCondition.AckLevel
+	partitionConfigVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigValues(tasklistCondition.PartitionConfig)
 
 	for _, task := range tasksToInsert {
 		scheduleID := task.ScheduledID
@@ -359,7 +401,10 @@ func (db *cdb) InsertTasks(
 				task.WorkflowID,
 				task.RunID,
 				scheduleID,
-				task.CreatedTime)
+				task.CreatedTime,
+				task.IsolationGroup,
+				task.Priority,
+				task.FairnessKey)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -375,6 +420,9 @@ func (db *cdb) InsertTasks(
 				task.RunID,
 				scheduleID,
 				task.CreatedTime,
+				task.IsolationGroup,
+				task.Priority,
+				task.FairnessKey,
 				ttl)
 		}
 	}
@@ -388,6 +436,9 @@ func (db *cdb) InsertTasks(
 		ackLevel,
 		taskListKind,
 		time.Now(),
+		partitionConfigVersion,
+		numReadPartitions,
+		numWritePartitions,
 		domainID,
 		taskListName,
 		taskListType,
@@ -462,6 +513,12 @@ func createTaskInfo(
 			info.ScheduledID = v.(int64)
 		case "created_time":
 			info.CreatedTime = v.(time.Time)
+		case "isolation_group":
+			info.IsolationGroup = v.(string)
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
 		}
 	}
 
@@ -483,3 +540,11 @@ func (db *cdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFi
 	).WithContext(ctx)
 	return p.UnknownNumRowsAffected, db.executeWithConsistencyAll(query)
 }
+
+// SelectOrphanTasks returns tasks that belong to a tasklist no longer present, up to the limit
+// Not supported by Cassandra, because tasks and tasklists share a partition and tasks are removed by TTL
+func (db *cdb) SelectOrphanTasks(ctx context.Context, limit int) ([]*nosqlplugin.TaskRow, error) {
+	return nil, &types.InternalServiceError{
+		Message: "unsupported operation",
+	}
+}
@@ -81,7 +81,10 @@ const (
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
-		`memo: ? ` +
+		`memo: ?, ` +
+		`paused: ?, ` +
+		`pending_update_ids: ?, ` +
+		`completed_update_ids: ? ` +
 		`}`
 
 	templateTransferTaskType = `{` +
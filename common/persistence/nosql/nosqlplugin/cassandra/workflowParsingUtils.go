@@ -176,6 +176,12 @@ func parseWorkflowExecutionInfo(
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
 			info.Memo = v.(map[string][]byte)
+		case "paused":
+			info.Paused = v.(bool)
+		case "pending_update_ids":
+			info.PendingUpdateIDs = v.(map[string]int64)
+		case "completed_update_ids":
+			info.CompletedUpdateIDs = v.(map[string]int64)
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
@@ -1266,6 +1266,9 @@ func (db *cdb) updateWorkflowExecution(
 		int32(execution.ExpirationInterval.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		execution.Paused,
+		execution.PendingUpdateIDs,
+		execution.CompletedUpdateIDs,
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1361,6 +1364,9 @@ func (db *cdb) createWorkflowExecution(
 		int32(execution.ExpirationInterval.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		execution.Paused,
+		execution.PendingUpdateIDs,
+		execution.CompletedUpdateIDs,
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
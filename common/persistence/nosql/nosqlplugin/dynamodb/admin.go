@@ -1,5 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
-// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,15 +21,78 @@
 package dynamodb
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 var _ nosqlplugin.AdminDB = (*ddb)(nil)
 
+const (
+	testSchemaDir = "schema/dynamodb/"
+)
+
 func (db *ddb) SetupTestDatabase(schemaBaseDir string) error {
-	panic("TODO")
+	if schemaBaseDir == "" {
+		var err error
+		schemaBaseDir, err = nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	schemaFile := schemaBaseDir + "cadence/schema.json"
+	byteValues, err := ioutil.ReadFile(schemaFile)
+	if err != nil {
+		return err
+	}
+	var tables []cadence.TableSchema
+	if err := json.Unmarshal(byteValues, &tables); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	for _, table := range tables {
+		input := *table.CreateTable
+		input.TableName = db.tableName(*table.CreateTable.TableName)
+		if _, err := db.client.CreateTableWithContext(ctx, &input); err != nil {
+			return err
+		}
+		if err := db.client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{
+			TableName: input.TableName,
+		}); err != nil {
+			return err
+		}
+		if table.TimeToLive != nil {
+			if _, err := db.client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+				TableName:               input.TableName,
+				TimeToLiveSpecification: table.TimeToLive,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func (db *ddb) TeardownTestDatabase() error {
-	panic("TODO")
+	ctx := context.Background()
+	for _, name := range cadence.AllTableNames {
+		_, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{
+			TableName: db.tableName(name),
+		})
+		if err != nil {
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeResourceNotFoundException {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
 }
@@ -22,15 +22,67 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	item, err := marshalItem(cadence.ClusterConfigItem{
+		RowType:              row.RowType,
+		Version:              row.Version,
+		UnixTimestampSeconds: row.Timestamp.Unix(),
+		Data:                 row.Values.Data,
+		DataEncoding:         row.Values.GetEncodingString(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           db.tableName(cadence.ClusterConfigTableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(pk)"),
+	})
+	if isConditionalCheckFailedError(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	values, err := attributeValues(":row_type", rowType)
+	if err != nil {
+		return nil, err
+	}
+	output, err := db.client.QueryWithContext(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.ClusterConfigTableName),
+		KeyConditionExpression:    aws.String("pk = :row_type"),
+		ExpressionAttributeValues: values,
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int64(1),
+		ConsistentRead:            aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Items) == 0 {
+		return nil, errItemNotFound
+	}
+
+	var item cadence.ClusterConfigItem
+	if err := unmarshalItem(output.Items[0], &item); err != nil {
+		return nil, err
+	}
+	return &persistence.InternalConfigStoreEntry{
+		RowType:   rowType,
+		Version:   item.Version,
+		Timestamp: time.Unix(item.UnixTimestampSeconds, 0),
+		Values:    persistence.NewDataBlob(item.Data, common.EncodingType(item.DataEncoding)),
+	}, nil
 }
@@ -21,36 +21,69 @@
 package dynamodb
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 const (
-	// PluginName is the name of the plugin
-	PluginName = "dynamodb"
+	// maxTransactionItems is the max number of items that a TransactWriteItems request can contain
+	maxTransactionItems = 100
+	// maxTransactionBytes is the max aggregate size of the items in a TransactWriteItems request, with a margin
+	// for the request overhead that is not counted by writeSize
+	maxTransactionBytes = 4000000
+	// maxItemBytes is the max size of an item
+	maxItemBytes = 400 * 1024
+	// maxBatchWriteItems is the max number of items that a BatchWriteItem request can contain
+	maxBatchWriteItems = 25
+
+	conditionalCheckFailedReason = "ConditionalCheckFailed"
 )
 
 var (
-	errConditionFailed = errors.New("internal condition fail error")
+	errItemNotFound = errors.New("item not found")
 )
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	client dynamodbiface.DynamoDBAPI
+	cfg    *config.NoSQL
+	logger log.Logger
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
+type (
+	// transactionItem is a write of TransactWriteItems request.
+	// onConditionFailure is called with the existing item when the condition expression of the write is not met,
+	// and it returns the error for the caller, e.g. WorkflowOperationConditionFailure
+	transactionItem struct {
+		write              *dynamodb.TransactWriteItem
+		onConditionFailure func(existing map[string]*dynamodb.AttributeValue) error
+	}
+)
+
 // NewDynamoDB return a new DB
 func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+	return newDynamoDB(&cfg, logger)
 }
 
 func (db *ddb) Close() {
-	panic("TODO")
+	// the DynamoDB client is stateless HTTP client, nothing to close
 }
 
 func (db *ddb) PluginName() string {
@@ -58,21 +91,401 @@ func (db *ddb) PluginName() string {
 }
 
 func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+	return err == errItemNotFound
 }
 
 func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == request.ErrCodeResponseTimeout || aerr.Code() == request.CanceledErrorCode
+	}
+	return false
 }
 
 func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case dynamodb.ErrCodeProvisionedThroughputExceededException,
+			dynamodb.ErrCodeRequestLimitExceeded,
+			"ThrottlingException":
+			return true
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsDBUnavailableError(err error) bool {
-	panic("TODO")
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case dynamodb.ErrCodeInternalServerError, "ServiceUnavailable":
+			return true
+		}
+	}
+	return false
+}
+
+// tableName returns the full table name, which is prefixed by the keyspace in the config
+func (db *ddb) tableName(name string) *string {
+	return aws.String(db.cfg.Keyspace + "_" + name)
+}
+
+// getItem reads an item with strongly consistent read, return errItemNotFound if it doesn't exist
+func (db *ddb) getItem(
+	ctx context.Context,
+	table string,
+	key map[string]*dynamodb.AttributeValue,
+	out interface{},
+) error {
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      db.tableName(table),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return err
+	}
+	if len(output.Item) == 0 {
+		return errItemNotFound
+	}
+	return unmarshalItem(output.Item, out)
+}
+
+// putItem writes an item, the item is replaced if it already exists
+func (db *ddb) putItem(ctx context.Context, table string, item interface{}) error {
+	attributes, err := marshalItem(item)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(table),
+		Item:      attributes,
+	})
+	return err
+}
+
+// deleteItem deletes an item, it's a noop if the item doesn't exist
+func (db *ddb) deleteItem(ctx context.Context, table string, key map[string]*dynamodb.AttributeValue) error {
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: db.tableName(table),
+		Key:       key,
+	})
+	return err
+}
+
+// batchDeleteItems deletes the items in batches of maxBatchWriteItems, unprocessed items are retried
+func (db *ddb) batchDeleteItems(ctx context.Context, table string, keys []map[string]*dynamodb.AttributeValue) error {
+	for start := 0; start < len(keys); start += maxBatchWriteItems {
+		end := start + maxBatchWriteItems
+		if end > len(keys) {
+			end = len(keys)
+		}
+		requests := make([]*dynamodb.WriteRequest, 0, end-start)
+		for _, key := range keys[start:end] {
+			requests = append(requests, &dynamodb.WriteRequest{
+				DeleteRequest: &dynamodb.DeleteRequest{Key: key},
+			})
+		}
+		unprocessed := map[string][]*dynamodb.WriteRequest{
+			*db.tableName(table): requests,
+		}
+		for len(unprocessed) > 0 {
+			output, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: unprocessed,
+			})
+			if err != nil {
+				return err
+			}
+			unprocessed = output.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+// executeTransaction writes all the items in a single TransactWriteItems request.
+// If the transaction is cancelled because of condition failures, the error returned by onConditionFailure
+// of the first failed item is returned. So the items that need higher priority of reporting(e.g. shard condition)
+// should be put in front of the others.
+func (db *ddb) executeTransaction(ctx context.Context, items []*transactionItem) error {
+	if len(items) > maxTransactionItems {
+		return fmt.Errorf("too many items in a transaction: %v, max allowed: %v", len(items), maxTransactionItems)
+	}
+	writes := make([]*dynamodb.TransactWriteItem, 0, len(items))
+	for _, item := range items {
+		writes = append(writes, item.write)
+	}
+
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: writes,
+	})
+	if err == nil {
+		return nil
+	}
+	cancelled, ok := err.(*dynamodb.TransactionCanceledException)
+	if !ok {
+		return err
+	}
+	for i, reason := range cancelled.CancellationReasons {
+		if i >= len(items) || reason == nil || aws.StringValue(reason.Code) != conditionalCheckFailedReason {
+			continue
+		}
+		if items[i].onConditionFailure == nil {
+			return nosqlplugin.NewConditionFailure(fmt.Sprintf("transaction condition failed: %v", aws.StringValue(reason.Message)))
+		}
+		return items[i].onConditionFailure(reason.Item)
+	}
+	// cancelled for other reasons, e.g. TransactionConflict
+	return err
+}
+
+// writeSize estimates the size of a write in a transaction, i.e. the size of the written item or key,
+// counted as DynamoDB does: the lengths of the attribute names plus the sizes of the values
+func writeSize(write *dynamodb.TransactWriteItem) int {
+	switch {
+	case write.Put != nil:
+		return attributesSize(write.Put.Item)
+	case write.Update != nil:
+		return attributesSize(write.Update.Key) + attributesSize(write.Update.ExpressionAttributeValues)
+	case write.Delete != nil:
+		return attributesSize(write.Delete.Key)
+	case write.ConditionCheck != nil:
+		return attributesSize(write.ConditionCheck.Key)
+	default:
+		return 0
+	}
+}
+
+func attributesSize(attributes map[string]*dynamodb.AttributeValue) int {
+	size := 0
+	for name, value := range attributes {
+		size += len(name) + attributeValueSize(value)
+	}
+	return size
+}
+
+func attributeValueSize(value *dynamodb.AttributeValue) int {
+	if value == nil {
+		return 0
+	}
+	size := len(aws.StringValue(value.S)) + len(aws.StringValue(value.N)) + len(value.B) + 1
+	for _, s := range value.SS {
+		size += len(aws.StringValue(s))
+	}
+	for _, n := range value.NS {
+		size += len(aws.StringValue(n))
+	}
+	for _, b := range value.BS {
+		size += len(b)
+	}
+	for _, element := range value.L {
+		size += attributeValueSize(element) + 1
+	}
+	size += attributesSize(value.M)
+	return size
+}
+
+// queryPage runs the query until pageSize items are returned, or there are no more items.
+// Because of the filter expression, a single query may return fewer items than the limit even if there are more.
+// The returned page token is the key of the last returned item, so that the next page starts right after it.
+// keyAttributes are the attributes of the table key, plus the sort key of the index if querying an index.
+func (db *ddb) queryPage(
+	ctx context.Context,
+	input *dynamodb.QueryInput,
+	pageSize int,
+	pageToken []byte,
+	keyAttributes []string,
+) ([]map[string]*dynamodb.AttributeValue, []byte, error) {
+	return paginate(pageSize, pageToken, keyAttributes, func(
+		startKey map[string]*dynamodb.AttributeValue,
+		limit *int64,
+	) ([]map[string]*dynamodb.AttributeValue, map[string]*dynamodb.AttributeValue, error) {
+		input.ExclusiveStartKey = startKey
+		input.Limit = limit
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		return output.Items, output.LastEvaluatedKey, nil
+	})
+}
+
+// scanPage is the same as queryPage, but for scanning the whole table
+func (db *ddb) scanPage(
+	ctx context.Context,
+	input *dynamodb.ScanInput,
+	pageSize int,
+	pageToken []byte,
+	keyAttributes []string,
+) ([]map[string]*dynamodb.AttributeValue, []byte, error) {
+	return paginate(pageSize, pageToken, keyAttributes, func(
+		startKey map[string]*dynamodb.AttributeValue,
+		limit *int64,
+	) ([]map[string]*dynamodb.AttributeValue, map[string]*dynamodb.AttributeValue, error) {
+		input.ExclusiveStartKey = startKey
+		input.Limit = limit
+		output, err := db.client.ScanWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		return output.Items, output.LastEvaluatedKey, nil
+	})
+}
+
+// queryAll runs the query until there are no more items
+func (db *ddb) queryAll(
+	ctx context.Context,
+	input *dynamodb.QueryInput,
+) ([]map[string]*dynamodb.AttributeValue, error) {
+	items, _, err := db.queryPage(ctx, input, 0, nil, nil)
+	return items, err
+}
+
+// queryCount returns the number of items of the query
+func (db *ddb) queryCount(ctx context.Context, input *dynamodb.QueryInput) (int64, error) {
+	input.Select = aws.String(dynamodb.SelectCount)
+	input.ConsistentRead = aws.Bool(true)
+	var count int64
+	for {
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return 0, err
+		}
+		count += aws.Int64Value(output.Count)
+		if len(output.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+func paginate(
+	pageSize int,
+	pageToken []byte,
+	keyAttributes []string,
+	fetch func(startKey map[string]*dynamodb.AttributeValue, limit *int64) ([]map[string]*dynamodb.AttributeValue, map[string]*dynamodb.AttributeValue, error),
+) ([]map[string]*dynamodb.AttributeValue, []byte, error) {
+	var startKey map[string]*dynamodb.AttributeValue
+	if len(pageToken) > 0 {
+		if err := json.Unmarshal(pageToken, &startKey); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var items []map[string]*dynamodb.AttributeValue
+	for {
+		var limit *int64
+		if pageSize > 0 {
+			limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		result, lastEvaluatedKey, err := fetch(startKey, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, result...)
+		if len(lastEvaluatedKey) == 0 {
+			// no more items
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			break
+		}
+		startKey = lastEvaluatedKey
+	}
+
+	lastItem := items[len(items)-1]
+	lastKey := make(map[string]*dynamodb.AttributeValue, len(keyAttributes))
+	for _, attribute := range keyAttributes {
+		lastKey[attribute] = lastItem[attribute]
+	}
+	nextPageToken, err := json.Marshal(lastKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, nextPageToken, nil
+}
+
+func marshalItem(item interface{}) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(item)
+}
+
+func unmarshalItem(attributes map[string]*dynamodb.AttributeValue, out interface{}) error {
+	return dynamodbattribute.UnmarshalMap(attributes, out)
+}
+
+func unmarshalItems(attributes []map[string]*dynamodb.AttributeValue, out interface{}) error {
+	return dynamodbattribute.UnmarshalListOfMaps(attributes, out)
+}
+
+// key returns the primary key of a table that has only the partition key
+func key(pk interface{}) (map[string]*dynamodb.AttributeValue, error) {
+	pkValue, err := attributeValue(pk)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]*dynamodb.AttributeValue{
+		cadence.PartitionKey: pkValue,
+	}, nil
+}
+
+// compositeKey returns the primary key of a table that has both partition key and sort key
+func compositeKey(pk interface{}, sk interface{}) (map[string]*dynamodb.AttributeValue, error) {
+	pkValue, err := attributeValue(pk)
+	if err != nil {
+		return nil, err
+	}
+	skValue, err := attributeValue(sk)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]*dynamodb.AttributeValue{
+		cadence.PartitionKey: pkValue,
+		cadence.SortKey:      skValue,
+	}, nil
+}
+
+// attributeValue converts a string or an integer to AttributeValue
+func attributeValue(v interface{}) (*dynamodb.AttributeValue, error) {
+	switch value := v.(type) {
+	case string:
+		return &dynamodb.AttributeValue{S: aws.String(value)}, nil
+	case bool:
+		return &dynamodb.AttributeValue{BOOL: aws.Bool(value)}, nil
+	case []byte:
+		return &dynamodb.AttributeValue{B: value}, nil
+	case int:
+		return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(int64(value), 10))}, nil
+	case int32:
+		return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(int64(value), 10))}, nil
+	case int64:
+		return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(value, 10))}, nil
+	default:
+		return nil, fmt.Errorf("unsupported attribute value type: %T", v)
+	}
+}
+
+// attributeValues builds the ExpressionAttributeValues from pairs of placeholders and values
+func attributeValues(pairs ...interface{}) (map[string]*dynamodb.AttributeValue, error) {
+	if len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("odd number of placeholders and values: %v", len(pairs))
+	}
+	values := make(map[string]*dynamodb.AttributeValue, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		placeholder, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("unsupported placeholder type: %T", pairs[i])
+		}
+		value, err := attributeValue(pairs[i+1])
+		if err != nil {
+			return nil, err
+		}
+		values[placeholder] = value
+	}
+	return values, nil
 }
 
-func (db *ddb) IsConditionFailedError(err error) bool {
-	return err == errConditionFailed
+// isConditionalCheckFailedError checks if the error is returned by a single item write with condition expression
+func isConditionalCheckFailedError(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
 }
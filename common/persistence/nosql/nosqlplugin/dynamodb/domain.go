@@ -22,8 +22,17 @@ package dynamodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 // Insert a new record to domain, return error if failed or already exists
@@ -32,7 +41,63 @@ func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	// same as Cassandra, new domain is inserted with the current notification version
+	// and the initial failover versions
+	insertRow := *row
+	insertRow.NotificationVersion = metadataNotificationVersion
+	insertRow.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	insertRow.PreviousFailoverVersion = common.InitialPreviousFailoverVersion
+	domainItem, err := newDomainItem(&insertRow)
+	if err != nil {
+		return err
+	}
+	domainIDItem, err := marshalItem(cadence.DomainItem{
+		PK:   cadence.DomainIDKeyPrefix + row.Info.ID,
+		ID:   row.Info.ID,
+		Name: row.Info.Name,
+	})
+	if err != nil {
+		return err
+	}
+	metadataItem, err := db.updateDomainMetadata(metadataNotificationVersion)
+	if err != nil {
+		return err
+	}
+
+	return db.executeTransaction(ctx, []*transactionItem{
+		{
+			write: &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName:           db.tableName(cadence.DomainTableName),
+					Item:                domainItem,
+					ConditionExpression: aws.String("attribute_not_exists(pk)"),
+				},
+			},
+			onConditionFailure: func(map[string]*dynamodb.AttributeValue) error {
+				return &types.DomainAlreadyExistsError{
+					Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+				}
+			},
+		},
+		{
+			write: &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName:           db.tableName(cadence.DomainTableName),
+					Item:                domainIDItem,
+					ConditionExpression: aws.String("attribute_not_exists(pk)"),
+				},
+			},
+			onConditionFailure: func(map[string]*dynamodb.AttributeValue) error {
+				return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+			},
+		},
+		metadataItem,
+	})
 }
 
 // Update domain
@@ -40,7 +105,73 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	domainItem, err := newDomainItem(row)
+	if err != nil {
+		return err
+	}
+	metadataItem, err := db.updateDomainMetadata(row.NotificationVersion)
+	if err != nil {
+		return err
+	}
+	return db.executeTransaction(ctx, []*transactionItem{
+		metadataItem,
+		{
+			write: &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName: db.tableName(cadence.DomainTableName),
+					Item:      domainItem,
+				},
+			},
+		},
+	})
+}
+
+// updateDomainMetadata returns the transaction item that increases the notification version by one,
+// if the current notification version is matched
+func (db *ddb) updateDomainMetadata(notificationVersion int64) (*transactionItem, error) {
+	onConditionFailure := func(map[string]*dynamodb.AttributeValue) error {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	if notificationVersion == 0 {
+		// the metadata item may not exist yet when the first domain is created
+		item, err := marshalItem(cadence.DomainItem{
+			PK:                  cadence.DomainMetadataKey,
+			NotificationVersion: 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &transactionItem{
+			write: &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName:           db.tableName(cadence.DomainTableName),
+					Item:                item,
+					ConditionExpression: aws.String("attribute_not_exists(pk)"),
+				},
+			},
+			onConditionFailure: onConditionFailure,
+		}, nil
+	}
+	metadataKey, err := key(cadence.DomainMetadataKey)
+	if err != nil {
+		return nil, err
+	}
+	values, err := attributeValues(":version", notificationVersion, ":next_version", notificationVersion+1)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionItem{
+		write: &dynamodb.TransactWriteItem{
+			Update: &dynamodb.Update{
+				TableName:                 db.tableName(cadence.DomainTableName),
+				Key:                       metadataKey,
+				UpdateExpression:          aws.String("SET notification_version = :next_version"),
+				ConditionExpression:       aws.String("notification_version = :version"),
+				ExpressionAttributeValues: values,
+			},
+		},
+		onConditionFailure: onConditionFailure,
+	}, nil
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +180,25 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	name, err := db.resolveDomainName(ctx, domainID, domainName)
+	if err != nil {
+		return nil, err
+	}
+	nameKey, err := key(cadence.DomainNameKeyPrefix + name)
+	if err != nil {
+		return nil, err
+	}
+	var item cadence.DomainItem
+	if err := db.getItem(ctx, cadence.DomainTableName, nameKey, &item); err != nil {
+		return nil, err
+	}
+	return toDomainRow(&item)
 }
 
 // Get all domain data
@@ -58,7 +207,33 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	values, err := attributeValues(":prefix", cadence.DomainNameKeyPrefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName:                 db.tableName(cadence.DomainTableName),
+		FilterExpression:          aws.String("begins_with(pk, :prefix)"),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, pageSize, pageToken, []string{cadence.PartitionKey})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var domainItems []cadence.DomainItem
+	if err := unmarshalItems(items, &domainItems); err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.DomainRow, 0, len(domainItems))
+	for i := range domainItems {
+		row, err := toDomainRow(&domainItems[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +242,110 @@ func (db *ddb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainID == nil && domainName == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	name, err := db.resolveDomainName(ctx, domainID, domainName)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	nameKey, err := key(cadence.DomainNameKeyPrefix + name)
+	if err != nil {
+		return err
+	}
+	var item cadence.DomainItem
+	if err := db.getItem(ctx, cadence.DomainTableName, nameKey, &item); err != nil {
+		if db.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	idKey, err := key(cadence.DomainIDKeyPrefix + item.ID)
+	if err != nil {
+		return err
+	}
+
+	return db.executeTransaction(ctx, []*transactionItem{
+		{
+			write: &dynamodb.TransactWriteItem{
+				Delete: &dynamodb.Delete{
+					TableName: db.tableName(cadence.DomainTableName),
+					Key:       nameKey,
+				},
+			},
+		},
+		{
+			write: &dynamodb.TransactWriteItem{
+				Delete: &dynamodb.Delete{
+					TableName: db.tableName(cadence.DomainTableName),
+					Key:       idKey,
+				},
+			},
+		},
+	})
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	metadataKey, err := key(cadence.DomainMetadataKey)
+	if err != nil {
+		return -1, err
+	}
+	var item cadence.DomainItem
+	if err := db.getItem(ctx, cadence.DomainTableName, metadataKey, &item); err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata item doesn't exist until the first domain is created
+			return 0, nil
+		}
+		return -1, err
+	}
+	return item.NotificationVersion, nil
+}
+
+// resolveDomainName returns the domain name, looking it up by domainID if the name is not provided
+func (db *ddb) resolveDomainName(
+	ctx context.Context,
+	domainID *string,
+	domainName *string,
+) (string, error) {
+	if domainName != nil {
+		return *domainName, nil
+	}
+	idKey, err := key(cadence.DomainIDKeyPrefix + *domainID)
+	if err != nil {
+		return "", err
+	}
+	var item cadence.DomainItem
+	if err := db.getItem(ctx, cadence.DomainTableName, idKey, &item); err != nil {
+		return "", err
+	}
+	return item.Name, nil
+}
+
+func newDomainItem(row *nosqlplugin.DomainRow) (map[string]*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	return marshalItem(cadence.DomainItem{
+		PK:                  cadence.DomainNameKeyPrefix + row.Info.Name,
+		ID:                  row.Info.ID,
+		Name:                row.Info.Name,
+		NotificationVersion: row.NotificationVersion,
+		Data:                data,
+	})
+}
+
+func toDomainRow(item *cadence.DomainItem) (*nosqlplugin.DomainRow, error) {
+	var row nosqlplugin.DomainRow
+	if err := json.Unmarshal(item.Data, &row); err != nil {
+		return nil, err
+	}
+	row.NotificationVersion = item.NotificationVersion
+	return &row, nil
 }
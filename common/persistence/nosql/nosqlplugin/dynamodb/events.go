@@ -22,31 +22,252 @@ package dynamodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var items []*transactionItem
+	if treeRow != nil {
+		ancestors, err := json.Marshal(treeRow.Ancestors)
+		if err != nil {
+			return err
+		}
+		item, err := marshalItem(cadence.HistoryTreeItem{
+			TreeID:          treeRow.TreeID,
+			BranchID:        treeRow.BranchID,
+			ShardID:         treeRow.ShardID,
+			Ancestors:       ancestors,
+			CreateTimestamp: treeRow.CreateTimestamp.UnixNano(),
+			Info:            treeRow.Info,
+		})
+		if err != nil {
+			return err
+		}
+		items = append(items, &transactionItem{
+			write: &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName: db.tableName(cadence.HistoryTreeTableName),
+					Item:      item,
+				},
+			},
+		})
+	}
+	if nodeRow != nil {
+		var txnID int64
+		if nodeRow.TxnID != nil {
+			txnID = *nodeRow.TxnID
+		}
+		item, err := marshalItem(cadence.HistoryNodeItem{
+			PK:           historyNodePartitionKey(nodeRow.TreeID, nodeRow.BranchID),
+			SK:           historyNodeSortKey(nodeRow.NodeID, txnID),
+			ShardID:      nodeRow.ShardID,
+			NodeID:       nodeRow.NodeID,
+			TxnID:        txnID,
+			Data:         nodeRow.Data,
+			DataEncoding: nodeRow.DataEncoding,
+		})
+		if err != nil {
+			return err
+		}
+		items = append(items, &transactionItem{
+			write: &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName: db.tableName(cadence.HistoryNodeTableName),
+					Item:      item,
+				},
+			},
+		})
+	}
+
+	if len(items) == 1 {
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+			TableName: items[0].write.Put.TableName,
+			Item:      items[0].write.Put.Item,
+		})
+		return err
+	}
+	return db.executeTransaction(ctx, items)
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	// the upper bound is exclusive because any sort key of MaxNodeID is greater than the prefix
+	values, err := attributeValues(
+		":pk", historyNodePartitionKey(filter.TreeID, filter.BranchID),
+		":min_sk", historyNodeSortKeyPrefix(filter.MinNodeID),
+		":max_sk", historyNodeSortKeyPrefix(filter.MaxNodeID),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.HistoryNodeTableName),
+		KeyConditionExpression:    aws.String("pk = :pk AND sk BETWEEN :min_sk AND :max_sk"),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, filter.PageSize, filter.NextPageToken, []string{cadence.PartitionKey, cadence.SortKey})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nodeItems []cadence.HistoryNodeItem
+	if err := unmarshalItems(items, &nodeItems); err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(nodeItems))
+	for _, item := range nodeItems {
+		txnID := item.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			ShardID:      item.ShardID,
+			TreeID:       filter.TreeID,
+			BranchID:     filter.BranchID,
+			NodeID:       item.NodeID,
+			TxnID:        &txnID,
+			Data:         item.Data,
+			DataEncoding: item.DataEncoding,
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// nodes are deleted first, so that the deletion can be retried if it fails in the middle
+	for _, nodeFilter := range nodeFilters {
+		values, err := attributeValues(
+			":pk", historyNodePartitionKey(nodeFilter.TreeID, nodeFilter.BranchID),
+			":min_sk", historyNodeSortKeyPrefix(nodeFilter.MinNodeID),
+		)
+		if err != nil {
+			return err
+		}
+		keys, err := db.queryKeys(ctx, &dynamodb.QueryInput{
+			TableName:                 db.tableName(cadence.HistoryNodeTableName),
+			KeyConditionExpression:    aws.String("pk = :pk AND sk >= :min_sk"),
+			ExpressionAttributeValues: values,
+		})
+		if err != nil {
+			return err
+		}
+		if err := db.batchDeleteItems(ctx, cadence.HistoryNodeTableName, keys); err != nil {
+			return err
+		}
+	}
+
+	if treeFilter.BranchID != nil {
+		branchKey, err := compositeKey(treeFilter.TreeID, *treeFilter.BranchID)
+		if err != nil {
+			return err
+		}
+		return db.deleteItem(ctx, cadence.HistoryTreeTableName, branchKey)
+	}
+	values, err := attributeValues(":pk", treeFilter.TreeID)
+	if err != nil {
+		return err
+	}
+	keys, err := db.queryKeys(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.HistoryTreeTableName),
+		KeyConditionExpression:    aws.String("pk = :pk"),
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		return err
+	}
+	return db.batchDeleteItems(ctx, cadence.HistoryTreeTableName, keys)
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	items, newPageToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName:      db.tableName(cadence.HistoryTreeTableName),
+		ConsistentRead: aws.Bool(true),
+	}, pageSize, nextPageToken, []string{cadence.PartitionKey, cadence.SortKey})
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := toHistoryTreeRows(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, newPageToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	keyCondition := "pk = :pk"
+	values, err := attributeValues(":pk", filter.TreeID)
+	if filter.BranchID != nil {
+		keyCondition = "pk = :pk AND sk = :sk"
+		values, err = attributeValues(":pk", filter.TreeID, ":sk", *filter.BranchID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	input := &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.HistoryTreeTableName),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}
+	items, err := db.queryAll(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return toHistoryTreeRows(items)
+}
+
+// queryKeys returns the primary keys of all the items of the query
+func (db *ddb) queryKeys(ctx context.Context, input *dynamodb.QueryInput) ([]map[string]*dynamodb.AttributeValue, error) {
+	input.ProjectionExpression = aws.String("pk, sk")
+	input.ConsistentRead = aws.Bool(true)
+	return db.queryAll(ctx, input)
+}
+
+func toHistoryTreeRows(items []map[string]*dynamodb.AttributeValue) ([]*nosqlplugin.HistoryTreeRow, error) {
+	var treeItems []cadence.HistoryTreeItem
+	if err := unmarshalItems(items, &treeItems); err != nil {
+		return nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(treeItems))
+	for _, item := range treeItems {
+		row := &nosqlplugin.HistoryTreeRow{
+			ShardID:         item.ShardID,
+			TreeID:          item.TreeID,
+			BranchID:        item.BranchID,
+			CreateTimestamp: time.Unix(0, item.CreateTimestamp),
+			Info:            item.Info,
+		}
+		if err := json.Unmarshal(item.Ancestors, &row.Ancestors); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func historyNodePartitionKey(treeID, branchID string) string {
+	return treeID + "#" + branchID
+}
+
+// historyNodeSortKey orders the nodes by nodeID ASC, txnID DESC
+func historyNodeSortKey(nodeID, txnID int64) string {
+	return fmt.Sprintf("%020d#%020d", nodeID, math.MaxInt64-txnID)
+}
+
+func historyNodeSortKeyPrefix(nodeID int64) string {
+	return fmt.Sprintf("%020d#", nodeID)
 }
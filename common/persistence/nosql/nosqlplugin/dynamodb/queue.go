@@ -23,8 +23,12 @@ package dynamodb
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 // Insert message into queue, return error if failed or already exists
@@ -33,7 +37,23 @@ func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	item, err := marshalItem(cadence.QueueMessageItem{
+		QueueType: int(row.QueueType),
+		MessageID: row.ID,
+		Payload:   row.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           db.tableName(cadence.QueueMessageTableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(pk)"),
+	})
+	if isConditionalCheckFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +61,29 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	values, err := attributeValues(":queue_type", int(queueType))
+	if err != nil {
+		return 0, err
+	}
+	output, err := db.client.QueryWithContext(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.QueueMessageTableName),
+		KeyConditionExpression:    aws.String("pk = :queue_type"),
+		ExpressionAttributeValues: values,
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int64(1),
+		ConsistentRead:            aws.Bool(true),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(output.Items) == 0 {
+		return 0, errItemNotFound
+	}
+	var item cadence.QueueMessageItem
+	if err := unmarshalItem(output.Items[0], &item); err != nil {
+		return 0, err
+	}
+	return item.MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +93,33 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	values, err := attributeValues(":queue_type", int(queueType), ":begin", exclusiveBeginMessageID)
+	if err != nil {
+		return nil, err
+	}
+	items, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.QueueMessageTableName),
+		KeyConditionExpression:    aws.String("pk = :queue_type AND sk > :begin"),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, maxRows, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var messageItems []cadence.QueueMessageItem
+	if err := unmarshalItems(items, &messageItems); err != nil {
+		return nil, err
+	}
+	var result []*nosqlplugin.QueueMessageRow
+	for _, item := range messageItems {
+		result = append(result, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        item.MessageID,
+			Payload:   item.Payload,
+		})
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +127,43 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	if request.InclusiveEndMessageID <= request.ExclusiveBeginMessageID {
+		return &nosqlplugin.SelectMessagesBetweenResponse{}, nil
+	}
+	values, err := attributeValues(
+		":queue_type", int(request.QueueType),
+		":begin", request.ExclusiveBeginMessageID+1,
+		":end", request.InclusiveEndMessageID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.QueueMessageTableName),
+		KeyConditionExpression:    aws.String("pk = :queue_type AND sk BETWEEN :begin AND :end"),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, request.PageSize, request.NextPageToken, []string{cadence.PartitionKey, cadence.SortKey})
+	if err != nil {
+		return nil, err
+	}
+
+	var messageItems []cadence.QueueMessageItem
+	if err := unmarshalItems(items, &messageItems); err != nil {
+		return nil, err
+	}
+	var rows []nosqlplugin.QueueMessageRow
+	for _, item := range messageItems {
+		rows = append(rows, nosqlplugin.QueueMessageRow{
+			QueueType: request.QueueType,
+			ID:        item.MessageID,
+			Payload:   item.Payload,
+		})
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +172,19 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	values, err := attributeValues(":queue_type", int(queueType), ":begin", exclusiveBeginMessageID)
+	if err != nil {
+		return err
+	}
+	keys, err := db.queryKeys(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.QueueMessageTableName),
+		KeyConditionExpression:    aws.String("pk = :queue_type AND sk < :begin"),
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		return err
+	}
+	return db.batchDeleteItems(ctx, cadence.QueueMessageTableName, keys)
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +194,26 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	if inclusiveEndMessageID <= exclusiveBeginMessageID {
+		return nil
+	}
+	values, err := attributeValues(
+		":queue_type", int(queueType),
+		":begin", exclusiveBeginMessageID+1,
+		":end", inclusiveEndMessageID,
+	)
+	if err != nil {
+		return err
+	}
+	keys, err := db.queryKeys(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.QueueMessageTableName),
+		KeyConditionExpression:    aws.String("pk = :queue_type AND sk BETWEEN :begin AND :end"),
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		return err
+	}
+	return db.batchDeleteItems(ctx, cadence.QueueMessageTableName, keys)
 }
 
 // Delete one message
@@ -87,7 +222,11 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	messageKey, err := compositeKey(int(queueType), messageID)
+	if err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, cadence.QueueMessageTableName, messageKey)
 }
 
 // Insert an empty metadata row, starting from a version
@@ -96,17 +235,56 @@ func (db *ddb) InsertQueueMetadata(
 	queueType persistence.QueueType,
 	version int64,
 ) error {
-	panic("TODO")
+	item, err := marshalItem(cadence.QueueMetadataItem{
+		QueueType:        int(queueType),
+		ClusterAckLevels: map[string]int64{},
+		Version:          version,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           db.tableName(cadence.QueueMetadataTableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(pk)"),
+	})
+	if isConditionalCheckFailedError(err) {
+		// it's ok if the metadata exists already
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
 // then the current version will increase by one when updating the metadata row
-// Return ConditionFailure if the condition doesn't meet
+// it should return ConditionFailure if the condition is not met
 func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	item, err := marshalItem(cadence.QueueMetadataItem{
+		QueueType:        int(row.QueueType),
+		ClusterAckLevels: row.ClusterAckLevels,
+		Version:          row.Version,
+	})
+	if err != nil {
+		return err
+	}
+	values, err := attributeValues(":previous_version", row.Version-1)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 db.tableName(cadence.QueueMetadataTableName),
+		Item:                      item,
+		ConditionExpression:       aws.String("#version = :previous_version"),
+		ExpressionAttributeNames:  map[string]*string{"#version": aws.String("version")},
+		ExpressionAttributeValues: values,
+	})
+	if isConditionalCheckFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -114,12 +292,38 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	metadataKey, err := key(int(queueType))
+	if err != nil {
+		return nil, err
+	}
+	var item cadence.QueueMetadataItem
+	if err := db.getItem(ctx, cadence.QueueMetadataTableName, metadataKey, &item); err != nil {
+		return nil, err
+	}
+
+	// if record exist but ackLevels is empty, we initialize the map
+	ackLevels := item.ClusterAckLevels
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          item.Version,
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	values, err := attributeValues(":queue_type", int(queueType))
+	if err != nil {
+		return 0, err
+	}
+	return db.queryCount(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.QueueMessageTableName),
+		KeyConditionExpression:    aws.String("pk = :queue_type"),
+		ExpressionAttributeValues: values,
+	})
 }
@@ -22,29 +22,174 @@ package dynamodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	item, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           db.tableName(cadence.ShardTableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(pk)"),
+	})
+	if isConditionalCheckFailedError(err) {
+		return db.getConflictedShardRow(ctx, row.ShardID, "InsertShard failed because shard already exists")
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	shardKey, err := key(shardID)
+	if err != nil {
+		return 0, nil, err
+	}
+	var item cadence.ShardItem
+	if err := db.getItem(ctx, cadence.ShardTableName, shardKey, &item); err != nil {
+		return 0, nil, err
+	}
+
+	var row nosqlplugin.ShardRow
+	if err := json.Unmarshal(item.Data, &row); err != nil {
+		return 0, nil, err
+	}
+	if row.ClusterTransferAckLevel == nil {
+		row.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: row.TransferAckLevel,
+		}
+	}
+	if row.ClusterTimerAckLevel == nil {
+		row.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: row.TimerAckLevel,
+		}
+	}
+	if row.ClusterReplicationLevel == nil {
+		row.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if row.ReplicationDLQAckLevel == nil {
+		row.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return item.RangeID, &row, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	shardKey, err := key(shardID)
+	if err != nil {
+		return err
+	}
+	values, err := attributeValues(":range_id", rangeID, ":previous_range_id", previousRangeID)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 db.tableName(cadence.ShardTableName),
+		Key:                       shardKey,
+		UpdateExpression:          aws.String("SET range_id = :range_id"),
+		ConditionExpression:       aws.String("range_id = :previous_range_id"),
+		ExpressionAttributeValues: values,
+	})
+	if isConditionalCheckFailedError(err) {
+		return db.getConflictedShardRow(ctx, shardID, fmt.Sprintf("UpdateRangeID failed, previous rangeID: %v", previousRangeID))
+	}
+	return err
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	shardRow := *row
+	shardRow.UpdatedAt = time.Now()
+	item, err := newShardItem(&shardRow)
+	if err != nil {
+		return err
+	}
+	values, err := attributeValues(":previous_range_id", previousRangeID)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 db.tableName(cadence.ShardTableName),
+		Item:                      item,
+		ConditionExpression:       aws.String("range_id = :previous_range_id"),
+		ExpressionAttributeValues: values,
+	})
+	if isConditionalCheckFailedError(err) {
+		return db.getConflictedShardRow(ctx, row.ShardID, fmt.Sprintf("UpdateShard failed, previous rangeID: %v", previousRangeID))
+	}
+	return err
+}
+
+// assertShardRangeID returns the transaction item that checks the rangeID of the shard.
+// It should be the first item of the transaction so that the shard condition failure is reported first.
+func (db *ddb) assertShardRangeID(shardID int, rangeID int64) (*transactionItem, error) {
+	shardKey, err := key(shardID)
+	if err != nil {
+		return nil, err
+	}
+	values, err := attributeValues(":range_id", rangeID)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionItem{
+		write: &dynamodb.TransactWriteItem{
+			ConditionCheck: &dynamodb.ConditionCheck{
+				TableName:                           db.tableName(cadence.ShardTableName),
+				Key:                                 shardKey,
+				ConditionExpression:                 aws.String("range_id = :range_id"),
+				ExpressionAttributeValues:           values,
+				ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+			},
+		},
+		onConditionFailure: func(existing map[string]*dynamodb.AttributeValue) error {
+			var item cadence.ShardItem
+			if err := unmarshalItem(existing, &item); err != nil {
+				return err
+			}
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				ShardRangeIDNotMatch: &item.RangeID,
+			}
+		},
+	}, nil
+}
+
+func (db *ddb) getConflictedShardRow(ctx context.Context, shardID int, details string) error {
+	shardKey, err := key(shardID)
+	if err != nil {
+		return err
+	}
+	var item cadence.ShardItem
+	if err := db.getItem(ctx, cadence.ShardTableName, shardKey, &item); err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: item.RangeID,
+		Details: details,
+	}
+}
+
+func newShardItem(row *nosqlplugin.ShardRow) (map[string]*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	return marshalItem(cadence.ShardItem{
+		ShardID: row.ShardID,
+		RangeID: row.RangeID,
+		Data:    data,
+	})
 }
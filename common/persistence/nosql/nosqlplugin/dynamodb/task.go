@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,20 +22,62 @@ package dynamodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
+)
+
+const (
+	// DynamoDB removes expired items periodically(typically within 48 hours), so they are filtered out on read
+	notExpiredFilter = "(attribute_not_exists(expiry) OR expiry > :now)"
+	// orphanTasksPageSize is the number of tasks scanned at a time when looking for orphan tasks
+	orphanTasksPageSize = 100
 )
 
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	taskListKey, err := key(taskListPartitionKey(filter))
+	if err != nil {
+		return nil, err
+	}
+	var item cadence.TaskListItem
+	if err := db.getItem(ctx, cadence.TaskListTableName, taskListKey, &item); err != nil {
+		return nil, err
+	}
+	if item.Expiry > 0 && item.Expiry <= time.Now().Unix() {
+		return nil, errItemNotFound
+	}
+	return toTaskListRow(&item), nil
 }
 
 // InsertTaskList insert a single tasklist row
-// Return IsConditionFailedError if the row already exists, and also the existing row
+// Return TaskOperationConditionFailure if the row already exists
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	item, err := newTaskListItem(row, 0)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           db.tableName(cadence.TaskListTableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(pk)"),
+	})
+	if isConditionalCheckFailedError(err) {
+		return db.getConflictedTaskListRow(ctx, &nosqlplugin.TaskListFilter{
+			DomainID:     row.DomainID,
+			TaskListName: row.TaskListName,
+			TaskListType: row.TaskListType,
+		}, "InsertTaskList failed because tasklist already exists")
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +87,7 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, row, previousRangeID, 0)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +100,91 @@ func (db *ddb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	expiry := time.Now().Unix() + ttlSeconds
+	return db.updateTaskList(ctx, row, previousRangeID, expiry)
+}
+
+func (db *ddb) updateTaskList(
+	ctx context.Context,
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+	expiry int64,
+) error {
+	item, err := newTaskListItem(row, expiry)
+	if err != nil {
+		return err
+	}
+	values, err := attributeValues(":previous_range_id", previousRangeID)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 db.tableName(cadence.TaskListTableName),
+		Item:                      item,
+		ConditionExpression:       aws.String("range_id = :previous_range_id"),
+		ExpressionAttributeValues: values,
+	})
+	if isConditionalCheckFailedError(err) {
+		return db.getConflictedTaskListRow(ctx, &nosqlplugin.TaskListFilter{
+			DomainID:     row.DomainID,
+			TaskListName: row.TaskListName,
+			TaskListType: row.TaskListType,
+		}, fmt.Sprintf("UpdateTaskList failed, previous rangeID: %v", previousRangeID))
+	}
+	return err
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	values, err := attributeValues(":now", time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	items, newPageToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName:                 db.tableName(cadence.TaskListTableName),
+		FilterExpression:          aws.String(notExpiredFilter),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, pageSize, nextPageToken, []string{cadence.PartitionKey})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskListItems []cadence.TaskListItem
+	if err := unmarshalItems(items, &taskListItems); err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{
+		NextPageToken: newPageToken,
+	}
+	for i := range taskListItems {
+		result.TaskLists = append(result.TaskLists, toTaskListRow(&taskListItems[i]))
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	taskListKey, err := key(taskListPartitionKey(filter))
+	if err != nil {
+		return err
+	}
+	values, err := attributeValues(":previous_range_id", previousRangeID)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 db.tableName(cadence.TaskListTableName),
+		Key:                       taskListKey,
+		ConditionExpression:       aws.String("range_id = :previous_range_id"),
+		ExpressionAttributeValues: values,
+	})
+	if isConditionalCheckFailedError(err) {
+		return db.getConflictedTaskListRow(ctx, filter, fmt.Sprintf("DeleteTaskList failed, previous rangeID: %v", previousRangeID))
+	}
+	return err
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,18 +194,269 @@ func (db *ddb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     tasklistCondition.DomainID,
+		TaskListName: tasklistCondition.TaskListName,
+		TaskListType: tasklistCondition.TaskListType,
+	}
+	pk := taskListPartitionKey(filter)
+	taskListKey, err := key(pk)
+	if err != nil {
+		return err
+	}
+	values, err := attributeValues(":range_id", tasklistCondition.RangeID)
+	if err != nil {
+		return err
+	}
+	conditionItem := &transactionItem{
+		write: &dynamodb.TransactWriteItem{
+			ConditionCheck: &dynamodb.ConditionCheck{
+				TableName:                 db.tableName(cadence.TaskListTableName),
+				Key:                       taskListKey,
+				ConditionExpression:       aws.String("range_id = :range_id"),
+				ExpressionAttributeValues: values,
+			},
+		},
+		onConditionFailure: func(map[string]*dynamodb.AttributeValue) error {
+			return db.getConflictedTaskListRow(ctx, filter, fmt.Sprintf("InsertTasks failed, rangeID: %v", tasklistCondition.RangeID))
+		},
+	}
+
+	now := time.Now()
+	items := []*transactionItem{conditionItem}
+	for _, task := range tasksToInsert {
+		data, err := json.Marshal(&task.TaskRow)
+		if err != nil {
+			return err
+		}
+		taskItem := cadence.TaskItem{
+			PK:     pk,
+			TaskID: task.TaskID,
+			Data:   data,
+		}
+		if task.TTLSeconds > 0 {
+			taskItem.Expiry = now.Unix() + int64(task.TTLSeconds)
+		}
+		attributes, err := marshalItem(taskItem)
+		if err != nil {
+			return err
+		}
+		items = append(items, &transactionItem{
+			write: &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName: db.tableName(cadence.TaskTableName),
+					Item:      attributes,
+				},
+			},
+		})
+
+		// a batch larger than the transaction limit is split into multiple transactions,
+		// each of them is conditioned on the rangeID of the tasklist
+		if len(items) == maxTransactionItems {
+			if err := db.executeTransaction(ctx, items); err != nil {
+				return err
+			}
+			items = []*transactionItem{conditionItem}
+		}
+	}
+	if len(items) == 1 && len(tasksToInsert) > 0 {
+		return nil
+	}
+	return db.executeTransaction(ctx, items)
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MaxTaskID <= filter.MinTaskID {
+		return nil, nil
+	}
+	values, err := attributeValues(
+		":pk", taskListPartitionKey(&filter.TaskListFilter),
+		":min_task_id", filter.MinTaskID+1,
+		":max_task_id", filter.MaxTaskID,
+		":now", time.Now().Unix(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	items, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.TaskTableName),
+		KeyConditionExpression:    aws.String("pk = :pk AND sk BETWEEN :min_task_id AND :max_task_id"),
+		FilterExpression:          aws.String(notExpiredFilter),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, filter.BatchSize, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var taskItems []cadence.TaskItem
+	if err := unmarshalItems(items, &taskItems); err != nil {
+		return nil, err
+	}
+	var response []*nosqlplugin.TaskRow
+	for _, item := range taskItems {
+		task := &nosqlplugin.TaskRow{}
+		if err := json.Unmarshal(item.Data, task); err != nil {
+			return nil, err
+		}
+		task.TaskID = item.TaskID
+		response = append(response, task)
+	}
+	return response, nil
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	if filter.MaxTaskID <= filter.MinTaskID {
+		return 0, nil
+	}
+	values, err := attributeValues(
+		":pk", taskListPartitionKey(&filter.TaskListFilter),
+		":min_task_id", filter.MinTaskID+1,
+		":max_task_id", filter.MaxTaskID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	keys, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.TaskTableName),
+		KeyConditionExpression:    aws.String("pk = :pk AND sk BETWEEN :min_task_id AND :max_task_id"),
+		ExpressionAttributeValues: values,
+		ProjectionExpression:      aws.String("pk, sk"),
+		ConsistentRead:            aws.Bool(true),
+	}, filter.BatchSize, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	if err := db.batchDeleteItems(ctx, cadence.TaskTableName, keys); err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// SelectOrphanTasks returns tasks that belong to a tasklist no longer present, up to the limit
+func (db *ddb) SelectOrphanTasks(ctx context.Context, limit int) ([]*nosqlplugin.TaskRow, error) {
+	values, err := attributeValues(":now", time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	// the existence of each tasklist is only checked once
+	orphanTaskLists := make(map[string]bool)
+	var response []*nosqlplugin.TaskRow
+	var pageToken []byte
+	for {
+		items, nextPageToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+			TableName:                 db.tableName(cadence.TaskTableName),
+			FilterExpression:          aws.String(notExpiredFilter),
+			ExpressionAttributeValues: values,
+			ConsistentRead:            aws.Bool(true),
+		}, orphanTasksPageSize, pageToken, []string{cadence.PartitionKey, cadence.SortKey})
+		if err != nil {
+			return nil, err
+		}
+
+		var taskItems []cadence.TaskItem
+		if err := unmarshalItems(items, &taskItems); err != nil {
+			return nil, err
+		}
+		for _, item := range taskItems {
+			task := &nosqlplugin.TaskRow{}
+			if err := json.Unmarshal(item.Data, task); err != nil {
+				return nil, err
+			}
+			task.TaskID = item.TaskID
+
+			orphan, ok := orphanTaskLists[item.PK]
+			if !ok {
+				_, err := db.SelectTaskList(ctx, &nosqlplugin.TaskListFilter{
+					DomainID:     task.DomainID,
+					TaskListName: task.TaskListName,
+					TaskListType: task.TaskListType,
+				})
+				if err != nil && !db.IsNotFoundError(err) {
+					return nil, err
+				}
+				orphan = err != nil
+				orphanTaskLists[item.PK] = orphan
+			}
+			if !orphan {
+				continue
+			}
+			response = append(response, task)
+			if limit > 0 && len(response) >= limit {
+				return response, nil
+			}
+		}
+
+		if len(nextPageToken) == 0 {
+			return response, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func taskListPartitionKey(filter *nosqlplugin.TaskListFilter) string {
+	return fmt.Sprintf("%v#%v#%v", filter.DomainID, filter.TaskListName, filter.TaskListType)
+}
+
+func newTaskListItem(row *nosqlplugin.TaskListRow, expiry int64) (map[string]*dynamodb.AttributeValue, error) {
+	item := cadence.TaskListItem{
+		PK: taskListPartitionKey(&nosqlplugin.TaskListFilter{
+			DomainID:     row.DomainID,
+			TaskListName: row.TaskListName,
+			TaskListType: row.TaskListType,
+		}),
+		DomainID:        row.DomainID,
+		TaskListName:    row.TaskListName,
+		TaskListType:    row.TaskListType,
+		RangeID:         row.RangeID,
+		TaskListKind:    row.TaskListKind,
+		AckLevel:        row.AckLevel,
+		LastUpdatedTime: row.LastUpdatedTime.UnixNano(),
+		Expiry:          expiry,
+	}
+	if row.PartitionConfig != nil {
+		item.PartitionConfigVersion = row.PartitionConfig.Version
+		item.NumReadPartitions = row.PartitionConfig.NumReadPartitions
+		item.NumWritePartitions = row.PartitionConfig.NumWritePartitions
+	}
+	return marshalItem(item)
+}
+
+func toTaskListRow(item *cadence.TaskListItem) *nosqlplugin.TaskListRow {
+	row := &nosqlplugin.TaskListRow{
+		DomainID:        item.DomainID,
+		TaskListName:    item.TaskListName,
+		TaskListType:    item.TaskListType,
+		RangeID:         item.RangeID,
+		TaskListKind:    item.TaskListKind,
+		AckLevel:        item.AckLevel,
+		LastUpdatedTime: time.Unix(0, item.LastUpdatedTime),
+	}
+	if item.PartitionConfigVersion != 0 {
+		row.PartitionConfig = &persistence.TaskListPartitionConfig{
+			Version:            item.PartitionConfigVersion,
+			NumReadPartitions:  item.NumReadPartitions,
+			NumWritePartitions: item.NumWritePartitions,
+		}
+	}
+	return row
+}
+
+func (db *ddb) getConflictedTaskListRow(ctx context.Context, filter *nosqlplugin.TaskListFilter, details string) error {
+	var rangeID int64
+	row, err := db.SelectTaskList(ctx, filter)
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return err
+		}
+	} else {
+		rangeID = row.RangeID
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: details,
+	}
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,8 +22,15 @@ package dynamodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 func (db *ddb) InsertVisibility(
@@ -31,7 +38,11 @@ func (db *ddb) InsertVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	item, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.putItem(ctx, cadence.VisibilityTableName, item)
 }
 
 func (db *ddb) UpdateVisibility(
@@ -39,26 +50,180 @@ func (db *ddb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	// a single table is used for both open and closed records, so moving a record
+	// between open and closed is simply flipping the closed flag
+	closed := !row.UpdateCloseToOpen
+	item, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, closed, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.putItem(ctx, cadence.VisibilityTableName, item)
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := &filter.ListRequest
+	conditions := "#closed = :closed AND " + notExpiredFilter
+	closed := false
+	var pairs []interface{}
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen:
+	case nosqlplugin.AllClosed:
+		closed = true
+	case nosqlplugin.OpenByWorkflowType:
+		conditions += " AND workflow_type = :workflow_type"
+		pairs = append(pairs, ":workflow_type", filter.WorkflowType)
+	case nosqlplugin.ClosedByWorkflowType:
+		conditions += " AND workflow_type = :workflow_type"
+		closed = true
+		pairs = append(pairs, ":workflow_type", filter.WorkflowType)
+	case nosqlplugin.OpenByWorkflowID:
+		conditions += " AND workflow_id = :workflow_id"
+		pairs = append(pairs, ":workflow_id", filter.WorkflowID)
+	case nosqlplugin.ClosedByWorkflowID:
+		conditions += " AND workflow_id = :workflow_id"
+		closed = true
+		pairs = append(pairs, ":workflow_id", filter.WorkflowID)
+	case nosqlplugin.ClosedByClosedStatus:
+		conditions += " AND close_status = :close_status"
+		closed = true
+		pairs = append(pairs, ":close_status", filter.CloseStatus)
+	default:
+		return nil, fmt.Errorf("unknown visibility filter type: %v", filter.FilterType)
+	}
+
+	var indexName, timeAttribute string
+	switch filter.SortType {
+	case nosqlplugin.SortByStartTime:
+		indexName, timeAttribute = cadence.VisibilityStartTimeIndexName, "start_time"
+	case nosqlplugin.SortByClosedTime:
+		indexName, timeAttribute = cadence.VisibilityCloseTimeIndexName, "close_time"
+	default:
+		return nil, fmt.Errorf("unknown visibility sort type: %v", filter.SortType)
+	}
+	pairs = append(pairs,
+		":closed", closed,
+		":now", time.Now().Unix(),
+		":domain_id", request.DomainUUID,
+		":earliest_time", request.EarliestTime.UnixNano(),
+		":latest_time", request.LatestTime.UnixNano(),
+	)
+	values, err := attributeValues(pairs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// the index is queried in descending order of the time, which is the same as Cassandra
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.VisibilityTableName),
+		IndexName:                 aws.String(indexName),
+		KeyConditionExpression:    aws.String(fmt.Sprintf("pk = :domain_id AND %v BETWEEN :earliest_time AND :latest_time", timeAttribute)),
+		FilterExpression:          aws.String(conditions),
+		ExpressionAttributeNames:  map[string]*string{"#closed": aws.String("closed")},
+		ExpressionAttributeValues: values,
+		ScanIndexForward:          aws.Bool(false),
+	}, request.PageSize, request.NextPageToken, []string{cadence.PartitionKey, cadence.SortKey, timeAttribute})
+	if err != nil {
+		return nil, err
+	}
+
+	var visibilityItems []cadence.VisibilityItem
+	if err := unmarshalItems(items, &visibilityItems); err != nil {
+		return nil, err
+	}
+	response := &nosqlplugin.SelectVisibilityResponse{
+		NextPageToken: nextPageToken,
+	}
+	for i := range visibilityItems {
+		row, err := toVisibilityRow(&visibilityItems[i])
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	visibilityKey, err := compositeKey(domainID, visibilitySortKey(workflowID, runID))
+	if err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, cadence.VisibilityTableName, visibilityKey)
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	visibilityKey, err := compositeKey(domainID, visibilitySortKey(workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	var item cadence.VisibilityItem
+	if err := db.getItem(ctx, cadence.VisibilityTableName, visibilityKey, &item); err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !item.Closed || (item.Expiry > 0 && item.Expiry <= time.Now().Unix()) {
+		return nil, nil
+	}
+	return toVisibilityRow(&item)
+}
+
+func visibilitySortKey(workflowID, runID string) string {
+	return workflowID + "#" + runID
+}
+
+func newVisibilityItem(
+	domainID string,
+	row *nosqlplugin.VisibilityRow,
+	closed bool,
+	ttlSeconds int64,
+) (*cadence.VisibilityItem, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	item := &cadence.VisibilityItem{
+		DomainID:     domainID,
+		SK:           visibilitySortKey(row.WorkflowID, row.RunID),
+		WorkflowID:   row.WorkflowID,
+		RunID:        row.RunID,
+		WorkflowType: row.TypeName,
+		StartTime:    row.StartTime.UnixNano(),
+		Closed:       closed,
+		Data:         data,
+	}
+	if closed {
+		// open records don't have close time, so that they are not in the close time index
+		item.CloseTime = row.CloseTime.UnixNano()
+		if row.Status != nil {
+			item.CloseStatus = int32(*row.Status)
+		}
+	}
+	if ttlSeconds > 0 {
+		item.Expiry = time.Now().Unix() + ttlSeconds
+	}
+	return item, nil
+}
+
+func toVisibilityRow(item *cadence.VisibilityItem) (*nosqlplugin.VisibilityRow, error) {
+	var row nosqlplugin.VisibilityRow
+	if err := json.Unmarshal(item.Data, &row); err != nil {
+		return nil, err
+	}
+	row.DomainID = item.DomainID
+	if !item.Closed {
+		// open records don't have close status even if the data was written by an update
+		row.Status = nil
+	}
+	return &row, nil
 }
@@ -1,5 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
-// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -23,10 +22,17 @@ package dynamodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*ddb)(nil)
@@ -41,7 +47,33 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	// shard condition is the first item, as ShardRangeIDNotMatch takes priority over other condition failures
+	shardConditionItem, err := db.assertShardRangeID(shardID, shardCondition.RangeID)
+	if err != nil {
+		return err
+	}
+	items := []*transactionItem{shardConditionItem}
+	currentWorkflowItem, err := db.createOrUpdateCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest)
+	if err != nil {
+		return err
+	}
+	if currentWorkflowItem != nil {
+		items = append(items, currentWorkflowItem)
+	}
+	executionItem, mapItems, err := db.createWorkflowExecution(ctx, shardID, execution)
+	if err != nil {
+		return err
+	}
+	items = append(items, executionItem)
+	taskItems, err := db.createTasks(shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	if err != nil {
+		return err
+	}
+	return db.executeTransactionWithTasks(ctx, items, mapItems, taskItems)
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -56,105 +88,459 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	shardConditionItem, err := db.assertShardRangeID(shardID, shardCondition.RangeID)
+	if err != nil {
+		return err
+	}
+	items := []*transactionItem{shardConditionItem}
+	currentWorkflowItem, err := db.createOrUpdateCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest)
+	if err != nil {
+		return err
+	}
+	if currentWorkflowItem != nil {
+		items = append(items, currentWorkflowItem)
+	}
+	var mapItems []*transactionItem
+	if mutatedExecution != nil {
+		executionItem, executionMapItems, err := db.updateWorkflowExecution(ctx, shardID, mutatedExecution)
+		if err != nil {
+			return err
+		}
+		items = append(items, executionItem)
+		mapItems = append(mapItems, executionMapItems...)
+	}
+	if insertedExecution != nil {
+		executionItem, executionMapItems, err := db.createWorkflowExecution(ctx, shardID, insertedExecution)
+		if err != nil {
+			return err
+		}
+		items = append(items, executionItem)
+		mapItems = append(mapItems, executionMapItems...)
+	}
+	if resetExecution != nil {
+		executionItem, executionMapItems, err := db.resetWorkflowExecution(ctx, shardID, resetExecution)
+		if err != nil {
+			return err
+		}
+		items = append(items, executionItem)
+		mapItems = append(mapItems, executionMapItems...)
+	}
+	taskItems, err := db.createTasks(shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	if err != nil {
+		return err
+	}
+	return db.executeTransactionWithTasks(ctx, items, mapItems, taskItems)
 }
 
 func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	currentWorkflowKey, err := compositeKey(shardID, currentWorkflowSortKey(domainID, workflowID))
+	if err != nil {
+		return nil, err
+	}
+	var item cadence.CurrentWorkflowItem
+	if err := db.getItem(ctx, cadence.CurrentWorkflowTableName, currentWorkflowKey, &item); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          item.ShardID,
+		DomainID:         item.DomainID,
+		WorkflowID:       item.WorkflowID,
+		RunID:            item.RunID,
+		State:            item.State,
+		CloseStatus:      item.CloseStatus,
+		CreateRequestID:  item.CreateRequestID,
+		LastWriteVersion: item.LastWriteVersion,
+	}, nil
 }
 
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	data, err := db.selectWorkflowExecutionData(ctx, shardID, domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       data.ExecutionInfo,
+		VersionHistories:    data.VersionHistories,
+		ActivityInfos:       data.ActivityInfos,
+		TimerInfos:          data.TimerInfos,
+		ChildExecutionInfos: data.ChildExecutionInfos,
+		RequestCancelInfos:  data.RequestCancelInfos,
+		SignalInfos:         data.SignalInfos,
+		SignalRequestedIDs:  data.SignalRequestedIDs,
+		BufferedEvents:      data.BufferedEvents,
+		Checksum:            data.Checksum,
+	}, nil
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	currentWorkflowKey, err := compositeKey(shardID, currentWorkflowSortKey(domainID, workflowID))
+	if err != nil {
+		return err
+	}
+	values, err := attributeValues(":run_id", currentRunIDCondition)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 db.tableName(cadence.CurrentWorkflowTableName),
+		Key:                       currentWorkflowKey,
+		ConditionExpression:       aws.String("run_id = :run_id"),
+		ExpressionAttributeValues: values,
+	})
+	if isConditionalCheckFailedError(err) {
+		// same as Cassandra, it's a noop if the current run has changed
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	// map items are deleted first, so that the deletion can be retried if it fails in the middle
+	mapKeys, err := db.selectWorkflowExecutionMapKeys(ctx, shardID, domainID, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	if err := db.batchDeleteItems(ctx, cadence.WorkflowExecutionMapTableName, mapKeys); err != nil {
+		return err
+	}
+	executionKey, err := compositeKey(shardID, workflowExecutionSortKey(domainID, workflowID, runID))
+	if err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, cadence.WorkflowExecutionTableName, executionKey)
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	values, err := attributeValues(":shard_id", shardID)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.CurrentWorkflowTableName),
+		KeyConditionExpression:    aws.String("pk = :shard_id"),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, pageSize, pageToken, []string{cadence.PartitionKey, cadence.SortKey})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var currentWorkflowItems []cadence.CurrentWorkflowItem
+	if err := unmarshalItems(items, &currentWorkflowItems); err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(currentWorkflowItems))
+	for _, item := range currentWorkflowItems {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     item.DomainID,
+			WorkflowID:   item.WorkflowID,
+			RunID:        item.RunID,
+			State:        item.State,
+			CurrentRunID: item.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	values, err := attributeValues(":shard_id", shardID)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.WorkflowExecutionTableName),
+		KeyConditionExpression:    aws.String("pk = :shard_id"),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, pageSize, pageToken, []string{cadence.PartitionKey, cadence.SortKey})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var executionItems []cadence.WorkflowExecutionItem
+	if err := unmarshalItems(items, &executionItems); err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(executionItems))
+	for i := range executionItems {
+		data, err := decodeWorkflowExecutionData(&executionItems[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	executionKey, err := compositeKey(shardID, workflowExecutionSortKey(domainID, workflowID, runID))
+	if err != nil {
+		return false, err
+	}
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:            db.tableName(cadence.WorkflowExecutionTableName),
+		Key:                  executionKey,
+		ProjectionExpression: aws.String("pk"),
+		ConsistentRead:       aws.Bool(true),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(output.Item) > 0, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectTasksOrderByTaskID(
+		ctx, cadence.TransferTaskTableName, shardID,
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var taskItems []cadence.ShardTaskItem
+	if err := unmarshalItems(items, &taskItems); err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TransferTask, 0, len(taskItems))
+	for _, item := range taskItems {
+		task := &nosqlplugin.TransferTask{}
+		if err := json.Unmarshal(item.Data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	taskKey, err := compositeKey(shardID, taskID)
+	if err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, cadence.TransferTaskTableName, taskKey)
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, cadence.TransferTaskTableName, shardID, exclusiveBeginTaskID, inclusiveEndTaskID)
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
-	panic("TODO")
+	if !inclusiveMinTime.Before(exclusiveMaxTime) {
+		return nil, nil, nil
+	}
+	// the upper bound is exclusive because any sort key of exclusiveMaxTime is greater than the prefix
+	values, err := attributeValues(
+		":shard_id", shardID,
+		":min_sk", timerTaskSortKeyPrefix(inclusiveMinTime.UnixNano()),
+		":max_sk", timerTaskSortKeyPrefix(exclusiveMaxTime.UnixNano()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.TimerTaskTableName),
+		KeyConditionExpression:    aws.String("pk = :shard_id AND sk BETWEEN :min_sk AND :max_sk"),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, pageSize, pageToken, []string{cadence.PartitionKey, cadence.SortKey})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var taskItems []cadence.TimerTaskItem
+	if err := unmarshalItems(items, &taskItems); err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TimerTask, 0, len(taskItems))
+	for _, item := range taskItems {
+		task := &nosqlplugin.TimerTask{}
+		if err := json.Unmarshal(item.Data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	taskKey, err := compositeKey(shardID, timerTaskSortKey(visibilityTimestamp.UnixNano(), taskID))
+	if err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, cadence.TimerTaskTableName, taskKey)
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	if !inclusiveMinTime.Before(exclusiveMaxTime) {
+		return nil
+	}
+	values, err := attributeValues(
+		":shard_id", shardID,
+		":min_sk", timerTaskSortKeyPrefix(inclusiveMinTime.UnixNano()),
+		":max_sk", timerTaskSortKeyPrefix(exclusiveMaxTime.UnixNano()),
+	)
+	if err != nil {
+		return err
+	}
+	keys, err := db.queryKeys(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.TimerTaskTableName),
+		KeyConditionExpression:    aws.String("pk = :shard_id AND sk BETWEEN :min_sk AND :max_sk"),
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		return err
+	}
+	return db.batchDeleteItems(ctx, cadence.TimerTaskTableName, keys)
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectTasksOrderByTaskID(
+		ctx, cadence.ReplicationTaskTableName, shardID,
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := toReplicationTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	taskKey, err := compositeKey(shardID, taskID)
+	if err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, cadence.ReplicationTaskTableName, taskKey)
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, cadence.ReplicationTaskTableName, shardID, math.MinInt64, inclusiveEndTaskID)
 }
 
 func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	if len(tasks) == 0 {
+		return nil
+	}
+	taskItems, err := db.createTasks(condition.ShardID, nil, nil, tasks, nil)
+	if err != nil {
+		return err
+	}
+	shardConditionItem, err := db.assertShardRangeID(condition.ShardID, condition.RangeID)
+	if err != nil {
+		return err
+	}
+	return db.executeTransactionWithTasks(ctx, []*transactionItem{shardConditionItem}, nil, taskItems)
 }
 
 func (db *ddb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectTasksOrderByTaskID(
+		ctx, cadence.CrossClusterTaskTableName, clusterTaskPartitionKey(shardID, targetCluster),
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var taskItems []cadence.ClusterTaskItem
+	if err := unmarshalItems(items, &taskItems); err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.CrossClusterTask, 0, len(taskItems))
+	for _, item := range taskItems {
+		task := &nosqlplugin.CrossClusterTask{}
+		if err := json.Unmarshal(item.Data, &task.TransferTask); err != nil {
+			return nil, nil, err
+		}
+		task.TargetCluster = item.Cluster
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	taskKey, err := compositeKey(clusterTaskPartitionKey(shardID, targetCluster), taskID)
+	if err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, cadence.CrossClusterTaskTableName, taskKey)
 }
 
 func (db *ddb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(
+		ctx, cadence.CrossClusterTaskTableName, clusterTaskPartitionKey(shardID, targetCluster),
+		exclusiveBeginTaskID, inclusiveEndTaskID,
+	)
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
-	panic("TODO")
+	data, err := json.Marshal(&task)
+	if err != nil {
+		return err
+	}
+	return db.putItem(ctx, cadence.ReplicationDLQTaskTableName, cadence.ClusterTaskItem{
+		PK:      clusterTaskPartitionKey(shardID, sourceCluster),
+		TaskID:  task.TaskID,
+		ShardID: shardID,
+		Cluster: sourceCluster,
+		Data:    data,
+	})
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectTasksOrderByTaskID(
+		ctx, cadence.ReplicationDLQTaskTableName, clusterTaskPartitionKey(shardID, sourceCluster),
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := toReplicationTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	values, err := attributeValues(":pk", clusterTaskPartitionKey(shardID, sourceCluster))
+	if err != nil {
+		return 0, err
+	}
+	return db.queryCount(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.ReplicationDLQTaskTableName),
+		KeyConditionExpression:    aws.String("pk = :pk"),
+		ExpressionAttributeValues: values,
+	})
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	taskKey, err := compositeKey(clusterTaskPartitionKey(shardID, sourceCluster), taskID)
+	if err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, cadence.ReplicationDLQTaskTableName, taskKey)
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(
+		ctx, cadence.ReplicationDLQTaskTableName, clusterTaskPartitionKey(shardID, sourceCluster),
+		exclusiveBeginTaskID, inclusiveEndTaskID,
+	)
 }
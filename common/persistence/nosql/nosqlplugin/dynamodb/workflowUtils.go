@@ -0,0 +1,1128 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
+)
+
+type (
+	// workflowExecutionData is the mutable state of a workflow execution. The maps and the buffered events are stored
+	// in workflow_execution_map table, one item per map entry and per batch of buffered events, so that a large
+	// workflow doesn't exceed the item size limit. The rest is the JSON encoded data attribute of workflow_execution table.
+	workflowExecutionData struct {
+		ExecutionInfo    *persistence.InternalWorkflowExecutionInfo
+		VersionHistories *persistence.DataBlob
+		Checksum         checksum.Checksum
+		LastWriteVersion int64
+		// the buffered events are the batches from FirstBufferedEventBatch(inclusive) to NextBufferedEventBatch(exclusive)
+		FirstBufferedEventBatch int64
+		NextBufferedEventBatch  int64
+
+		ActivityInfos       map[int64]*persistence.InternalActivityInfo       `json:"-"`
+		TimerInfos          map[string]*persistence.TimerInfo                 `json:"-"`
+		ChildExecutionInfos map[int64]*persistence.InternalChildExecutionInfo `json:"-"`
+		RequestCancelInfos  map[int64]*persistence.RequestCancelInfo          `json:"-"`
+		SignalInfos         map[int64]*persistence.SignalInfo                 `json:"-"`
+		SignalRequestedIDs  map[string]struct{}                               `json:"-"`
+		BufferedEvents      []*persistence.DataBlob                           `json:"-"`
+
+		// version is the version attribute of the execution item
+		version int64
+		// obsoleteEntries are the sort keys of the map items that are not visible in the version and never will be,
+		// e.g. the items replaced by a newer version of the entry, they are deleted by the next write of the execution
+		obsoleteEntries []string
+	}
+
+	// workflowExecutionEntries are the JSON encoded map entries written by a write of a workflow execution,
+	// by map name and entry key. A nil entry deletes the key
+	workflowExecutionEntries map[string]map[string][]byte
+)
+
+const (
+	// maxSelectWorkflowExecutionAttempts is the max number of reads of a workflow execution that is written
+	// while its map items are read
+	maxSelectWorkflowExecutionAttempts = 3
+)
+
+// the names of the maps in workflow_execution_map table
+const (
+	activityInfosMapName       = "activity_infos"
+	timerInfosMapName          = "timer_infos"
+	childExecutionInfosMapName = "child_execution_infos"
+	requestCancelInfosMapName  = "request_cancel_infos"
+	signalInfosMapName         = "signal_infos"
+	signalRequestedIDsMapName  = "signal_requested_ids"
+	bufferedEventsMapName      = "buffered_events"
+)
+
+func currentWorkflowSortKey(domainID, workflowID string) string {
+	return domainID + "#" + workflowID
+}
+
+func workflowExecutionSortKey(domainID, workflowID, runID string) string {
+	return domainID + "#" + workflowID + "#" + runID
+}
+
+func workflowExecutionMapPartitionKey(shardID int, domainID, workflowID, runID string) string {
+	return fmt.Sprintf("%v#%v", shardID, workflowExecutionSortKey(domainID, workflowID, runID))
+}
+
+// workflowExecutionMapSortKey is the sort key of a map item, the version is zero-padded
+// so that the items of an entry are ordered by version
+func workflowExecutionMapSortKey(name, key string, version int64) string {
+	return fmt.Sprintf("%v#%v#%020d", name, key, version)
+}
+
+// bufferedEventBatchKey is the entry key of a batch of buffered events
+func bufferedEventBatchKey(batch int64) string {
+	return fmt.Sprintf("%020d", batch)
+}
+
+func clusterTaskPartitionKey(shardID int, cluster string) string {
+	return fmt.Sprintf("%v#%v", shardID, cluster)
+}
+
+// timerTaskSortKeyPrefix encodes the visibility timestamp so that the string order is the same as the time order.
+// The sign bit is flipped so that negative timestamps are ordered before the positive ones.
+func timerTaskSortKeyPrefix(visibilityTimestampNano int64) string {
+	return fmt.Sprintf("%020d#", uint64(visibilityTimestampNano)^(1<<63))
+}
+
+func timerTaskSortKey(visibilityTimestampNano, taskID int64) string {
+	return fmt.Sprintf("%v%020d", timerTaskSortKeyPrefix(visibilityTimestampNano), taskID)
+}
+
+// createOrUpdateCurrentWorkflow returns the transaction item that writes the current workflow,
+// or nil if the write mode is noop
+func (db *ddb) createOrUpdateCurrentWorkflow(
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) (*transactionItem, error) {
+	item, err := marshalItem(cadence.CurrentWorkflowItem{
+		ShardID:          shardID,
+		SK:               currentWorkflowSortKey(domainID, workflowID),
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            request.Row.RunID,
+		State:            request.Row.State,
+		CloseStatus:      request.Row.CloseStatus,
+		CreateRequestID:  request.Row.CreateRequestID,
+		LastWriteVersion: request.Row.LastWriteVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	put := &dynamodb.Put{
+		TableName:                           db.tableName(cadence.CurrentWorkflowTableName),
+		Item:                                item,
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	}
+
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil, nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		put.ConditionExpression = aws.String("attribute_not_exists(pk)")
+		return &transactionItem{
+			write: &dynamodb.TransactWriteItem{Put: put},
+			onConditionFailure: func(existingAttributes map[string]*dynamodb.AttributeValue) error {
+				var existing cadence.CurrentWorkflowItem
+				if err := unmarshalItem(existingAttributes, &existing); err != nil {
+					return err
+				}
+				msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+					existing.WorkflowID, existing.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+						OtherInfo:        msg,
+						CreateRequestID:  existing.CreateRequestID,
+						RunID:            existing.RunID,
+						State:            existing.State,
+						CloseStatus:      existing.CloseStatus,
+						LastWriteVersion: existing.LastWriteVersion,
+					},
+				}
+			},
+		}, nil
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return nil, fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		put.ConditionExpression = aws.String("run_id = :run_id")
+		pairs := []interface{}{":run_id", *request.Condition.CurrentRunID}
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			put.ConditionExpression = aws.String("run_id = :run_id AND last_write_version = :last_write_version AND #state = :state")
+			put.ExpressionAttributeNames = map[string]*string{"#state": aws.String("state")}
+			pairs = append(pairs, ":last_write_version", *request.Condition.LastWriteVersion, ":state", *request.Condition.State)
+		}
+		if put.ExpressionAttributeValues, err = attributeValues(pairs...); err != nil {
+			return nil, err
+		}
+		return &transactionItem{
+			write: &dynamodb.TransactWriteItem{Put: put},
+			onConditionFailure: func(existingAttributes map[string]*dynamodb.AttributeValue) error {
+				var existing cadence.CurrentWorkflowItem
+				if len(existingAttributes) > 0 {
+					if err := unmarshalItem(existingAttributes, &existing); err != nil {
+						return err
+					}
+				}
+				msg := fmt.Sprintf("Workflow execution condition failed by mismatch current workflow. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+					workflowID, request.Condition.GetCurrentRunID(), existing.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+}
+
+// createWorkflowExecution returns the conditional item that inserts a new workflow execution and the writes of
+// its map items. The map items left by a failed insert of the same execution are deleted, as the writes are
+// conditioned on the execution not existing.
+func (db *ddb) createWorkflowExecution(
+	ctx context.Context,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*transactionItem, []*transactionItem, error) {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return nil, nil, fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return nil, nil, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	data := newWorkflowExecutionData(execution)
+	data.version = 1
+	leftoverKeys, err := db.selectWorkflowExecutionMapKeys(ctx, shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, leftoverKey := range leftoverKeys {
+		data.obsoleteEntries = append(data.obsoleteEntries, aws.StringValue(leftoverKey[cadence.SortKey].S))
+	}
+	entries, err := newWorkflowExecutionEntries(execution)
+	if err != nil {
+		return nil, nil, err
+	}
+	item, err := newWorkflowExecutionItem(shardID, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapItems, err := db.writeWorkflowExecutionMaps(shardID, data, entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	executionItem := &transactionItem{
+		write: &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName:                           db.tableName(cadence.WorkflowExecutionTableName),
+				Item:                                item,
+				ConditionExpression:                 aws.String("attribute_not_exists(pk)"),
+				ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+			},
+		},
+		onConditionFailure: func(existingAttributes map[string]*dynamodb.AttributeValue) error {
+			var existing cadence.WorkflowExecutionItem
+			if err := unmarshalItem(existingAttributes, &existing); err != nil {
+				return err
+			}
+			existingData, err := decodeWorkflowExecutionData(&existing)
+			if err != nil {
+				return err
+			}
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+				execution.WorkflowID, execution.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  execution.CreateRequestID,
+					RunID:            execution.RunID,
+					State:            execution.State,
+					CloseStatus:      execution.CloseStatus,
+					LastWriteVersion: existingData.LastWriteVersion,
+				},
+			}
+		},
+	}
+	return executionItem, mapItems, nil
+}
+
+// updateWorkflowExecution returns the conditional item that updates an existing workflow execution and the writes
+// of its map items. Only the map entries and the batch of buffered events changed by the request are written.
+// The write is conditioned on next_event_id and version, so the transaction fails if the item is changed after the read.
+func (db *ddb) updateWorkflowExecution(
+	ctx context.Context,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*transactionItem, []*transactionItem, error) {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return nil, nil, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	previous, err := db.selectWorkflowExecutionForUpdate(ctx, shardID, execution)
+	if err != nil {
+		return nil, nil, err
+	}
+	data := newWorkflowExecutionDataFromPrevious(execution, previous)
+	entries, err := newWorkflowExecutionEntries(execution)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeNone:
+	case nosqlplugin.EventBufferWriteModeAppend:
+		if err := entries.put(bufferedEventsMapName, bufferedEventBatchKey(data.NextBufferedEventBatch), execution.NewBufferedEventBatch); err != nil {
+			return nil, nil, err
+		}
+		data.NextBufferedEventBatch++
+	case nosqlplugin.EventBufferWriteModeClear:
+		data.FirstBufferedEventBatch = data.NextBufferedEventBatch
+	default:
+		return nil, nil, fmt.Errorf("unknown event buffer write mode %v", execution.EventBufferWriteMode)
+	}
+
+	return db.replaceWorkflowExecution(shardID, data, *execution.PreviousNextEventIDCondition, entries)
+}
+
+// resetWorkflowExecution returns the conditional item that overrides an existing workflow execution and the writes
+// of its map items, the entries that are not in the maps of the request are deleted
+func (db *ddb) resetWorkflowExecution(
+	ctx context.Context,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*transactionItem, []*transactionItem, error) {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return nil, nil, fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return nil, nil, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+
+	previous, err := db.selectWorkflowExecutionForUpdate(ctx, shardID, execution)
+	if err != nil {
+		return nil, nil, err
+	}
+	data := newWorkflowExecutionDataFromPrevious(execution, previous)
+	data.FirstBufferedEventBatch = data.NextBufferedEventBatch
+	entries, err := newWorkflowExecutionEntries(execution)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries.deleteMissing(previous)
+	return db.replaceWorkflowExecution(shardID, data, *execution.PreviousNextEventIDCondition, entries)
+}
+
+// selectWorkflowExecutionForUpdate reads the current execution data and checks the next event ID condition
+func (db *ddb) selectWorkflowExecutionForUpdate(
+	ctx context.Context,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*workflowExecutionData, error) {
+	if execution.PreviousNextEventIDCondition == nil {
+		return nil, fmt.Errorf("PreviousNextEventIDCondition is required for updating workflow execution")
+	}
+	requestCondition := *execution.PreviousNextEventIDCondition
+
+	data, err := db.selectWorkflowExecutionData(ctx, shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			msg := fmt.Sprintf("Failed to update mutable state. Workflow execution not found. WorkflowId: %v, RunId: %v, Request Condition: %v",
+				execution.WorkflowID, execution.RunID, requestCondition)
+			return nil, &nosqlplugin.WorkflowOperationConditionFailure{
+				UnknownConditionFailureDetails: &msg,
+			}
+		}
+		return nil, err
+	}
+	if data.ExecutionInfo.NextEventID != requestCondition {
+		msg := fmt.Sprintf("Failed to update mutable state.  Request Condition: %v, Actual Value: %v",
+			requestCondition, data.ExecutionInfo.NextEventID)
+		return nil, &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	return data, nil
+}
+
+// replaceWorkflowExecution returns the conditional item that writes the execution item with the version of the data,
+// and the writes of the map items. The map items are not visible until the execution item is written, so they
+// don't need to be in the same transaction as the execution item.
+func (db *ddb) replaceWorkflowExecution(
+	shardID int,
+	data *workflowExecutionData,
+	previousNextEventID int64,
+	entries workflowExecutionEntries,
+) (*transactionItem, []*transactionItem, error) {
+	item, err := newWorkflowExecutionItem(shardID, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err := attributeValues(
+		":previous_next_event_id", previousNextEventID,
+		":previous_version", data.version-1,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapItems, err := db.writeWorkflowExecutionMaps(shardID, data, entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	executionItem := &transactionItem{
+		write: &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName:                           db.tableName(cadence.WorkflowExecutionTableName),
+				Item:                                item,
+				ConditionExpression:                 aws.String("next_event_id = :previous_next_event_id AND #version = :previous_version"),
+				ExpressionAttributeNames:            map[string]*string{"#version": aws.String("version")},
+				ExpressionAttributeValues:           values,
+				ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+			},
+		},
+		onConditionFailure: func(existingAttributes map[string]*dynamodb.AttributeValue) error {
+			var existing cadence.WorkflowExecutionItem
+			if len(existingAttributes) > 0 {
+				if err := unmarshalItem(existingAttributes, &existing); err != nil {
+					return err
+				}
+			}
+			msg := fmt.Sprintf("Failed to update mutable state.  Request Condition: %v, Actual Value: %v",
+				previousNextEventID, existing.NextEventID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				UnknownConditionFailureDetails: &msg,
+			}
+		},
+	}
+	return executionItem, mapItems, nil
+}
+
+// writeWorkflowExecutionMaps returns the writes of the map items of a write of the execution: the entries are put
+// with the version of the data, and the obsolete map items are deleted
+func (db *ddb) writeWorkflowExecutionMaps(
+	shardID int,
+	data *workflowExecutionData,
+	entries workflowExecutionEntries,
+) ([]*transactionItem, error) {
+	info := data.ExecutionInfo
+	pk := workflowExecutionMapPartitionKey(shardID, info.DomainID, info.WorkflowID, info.RunID)
+	var items []*transactionItem
+	written := make(map[string]bool)
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		keys := make([]string, 0, len(entries[name]))
+		for key := range entries[name] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			encoded := entries[name][key]
+			sk := workflowExecutionMapSortKey(name, key, data.version)
+			attributes, err := marshalItem(cadence.WorkflowExecutionMapItem{
+				PK:      pk,
+				SK:      sk,
+				Name:    name,
+				Key:     key,
+				Version: data.version,
+				Deleted: encoded == nil,
+				Data:    encoded,
+			})
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, &transactionItem{
+				write: &dynamodb.TransactWriteItem{
+					Put: &dynamodb.Put{
+						TableName: db.tableName(cadence.WorkflowExecutionMapTableName),
+						Item:      attributes,
+					},
+				},
+			})
+			written[sk] = true
+		}
+	}
+	for _, sk := range data.obsoleteEntries {
+		if written[sk] {
+			// the item is left by a failed write of the same version, it's replaced
+			continue
+		}
+		mapKey, err := compositeKey(pk, sk)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &transactionItem{
+			write: &dynamodb.TransactWriteItem{
+				Delete: &dynamodb.Delete{
+					TableName: db.tableName(cadence.WorkflowExecutionMapTableName),
+					Key:       mapKey,
+				},
+			},
+		})
+	}
+	return items, nil
+}
+
+// selectWorkflowExecutionData reads the execution item and then its map items. The read is retried if the execution
+// is written in between, so that the map items are consistent with the execution item.
+// Return errItemNotFound if the execution doesn't exist
+func (db *ddb) selectWorkflowExecutionData(
+	ctx context.Context,
+	shardID int,
+	domainID, workflowID, runID string,
+) (*workflowExecutionData, error) {
+	executionKey, err := compositeKey(shardID, workflowExecutionSortKey(domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	values, err := attributeValues(":pk", workflowExecutionMapPartitionKey(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 0; attempt < maxSelectWorkflowExecutionAttempts; attempt++ {
+		var item cadence.WorkflowExecutionItem
+		if err := db.getItem(ctx, cadence.WorkflowExecutionTableName, executionKey, &item); err != nil {
+			return nil, err
+		}
+		mapItems, err := db.queryAll(ctx, &dynamodb.QueryInput{
+			TableName:                 db.tableName(cadence.WorkflowExecutionMapTableName),
+			KeyConditionExpression:    aws.String("pk = :pk"),
+			ExpressionAttributeValues: values,
+			ConsistentRead:            aws.Bool(true),
+		})
+		if err != nil {
+			return nil, err
+		}
+		version, err := db.selectWorkflowExecutionVersion(ctx, executionKey)
+		if err != nil {
+			return nil, err
+		}
+		if version != item.Version {
+			continue
+		}
+
+		data, err := decodeWorkflowExecutionData(&item)
+		if err != nil {
+			return nil, err
+		}
+		if err := resolveWorkflowExecutionMaps(data, mapItems); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+	return nil, fmt.Errorf("workflow execution is written while being read. WorkflowId: %v, RunId: %v", workflowID, runID)
+}
+
+// selectWorkflowExecutionVersion returns the version attribute of the execution item
+func (db *ddb) selectWorkflowExecutionVersion(ctx context.Context, executionKey map[string]*dynamodb.AttributeValue) (int64, error) {
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:                db.tableName(cadence.WorkflowExecutionTableName),
+		Key:                      executionKey,
+		ProjectionExpression:     aws.String("#version"),
+		ExpressionAttributeNames: map[string]*string{"#version": aws.String("version")},
+		ConsistentRead:           aws.Bool(true),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(output.Item) == 0 {
+		return 0, errItemNotFound
+	}
+	var item cadence.WorkflowExecutionItem
+	if err := unmarshalItem(output.Item, &item); err != nil {
+		return 0, err
+	}
+	return item.Version, nil
+}
+
+// selectWorkflowExecutionMapKeys returns the primary keys of all the map items of a workflow execution
+func (db *ddb) selectWorkflowExecutionMapKeys(
+	ctx context.Context,
+	shardID int,
+	domainID, workflowID, runID string,
+) ([]map[string]*dynamodb.AttributeValue, error) {
+	values, err := attributeValues(":pk", workflowExecutionMapPartitionKey(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	return db.queryKeys(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(cadence.WorkflowExecutionMapTableName),
+		KeyConditionExpression:    aws.String("pk = :pk"),
+		ExpressionAttributeValues: values,
+	})
+}
+
+// resolveWorkflowExecutionMaps decodes the map items that are visible in the version of the data into its maps
+// and buffered events. The visible item of an entry is the one with the highest version up to the version of the data.
+// The other items are collected as obsolete: the items left by failed writes after the version, the items replaced
+// by a newer version of the entry, the deletions of entries without older items and the cleared buffered events.
+func resolveWorkflowExecutionMaps(data *workflowExecutionData, items []map[string]*dynamodb.AttributeValue) error {
+	var mapItems []cadence.WorkflowExecutionMapItem
+	if err := unmarshalItems(items, &mapItems); err != nil {
+		return err
+	}
+	visible := make(map[string]*cadence.WorkflowExecutionMapItem)
+	counts := make(map[string]int)
+	for i := range mapItems {
+		item := &mapItems[i]
+		if item.Version > data.version {
+			data.obsoleteEntries = append(data.obsoleteEntries, item.SK)
+			continue
+		}
+		entry := item.Name + "#" + item.Key
+		counts[entry]++
+		if current, ok := visible[entry]; ok {
+			if current.Version > item.Version {
+				data.obsoleteEntries = append(data.obsoleteEntries, item.SK)
+				continue
+			}
+			data.obsoleteEntries = append(data.obsoleteEntries, current.SK)
+		}
+		visible[entry] = item
+	}
+
+	type bufferedEventBatch struct {
+		batch  int64
+		events *persistence.DataBlob
+	}
+	var batches []bufferedEventBatch
+	for entry, item := range visible {
+		if item.Deleted {
+			// the deletion is only removed after the older items of the entry,
+			// otherwise the entry would become visible again
+			if counts[entry] == 1 {
+				data.obsoleteEntries = append(data.obsoleteEntries, item.SK)
+			}
+			continue
+		}
+		if item.Name != bufferedEventsMapName {
+			if err := decodeWorkflowExecutionMapItem(data, item); err != nil {
+				return err
+			}
+			continue
+		}
+		batch, err := strconv.ParseInt(item.Key, 10, 64)
+		if err != nil {
+			return err
+		}
+		if batch < data.FirstBufferedEventBatch || batch >= data.NextBufferedEventBatch {
+			data.obsoleteEntries = append(data.obsoleteEntries, item.SK)
+			continue
+		}
+		events := &persistence.DataBlob{}
+		if err := json.Unmarshal(item.Data, events); err != nil {
+			return err
+		}
+		batches = append(batches, bufferedEventBatch{batch: batch, events: events})
+	}
+	sort.Slice(batches, func(i, j int) bool {
+		return batches[i].batch < batches[j].batch
+	})
+	for _, batch := range batches {
+		data.BufferedEvents = append(data.BufferedEvents, batch.events)
+	}
+	sort.Strings(data.obsoleteEntries)
+	return nil
+}
+
+// decodeWorkflowExecutionMapItem decodes the entry of a map item into the maps of the data
+func decodeWorkflowExecutionMapItem(data *workflowExecutionData, item *cadence.WorkflowExecutionMapItem) error {
+	switch item.Name {
+	case timerInfosMapName:
+		info := &persistence.TimerInfo{}
+		if err := json.Unmarshal(item.Data, info); err != nil {
+			return err
+		}
+		data.TimerInfos[item.Key] = info
+		return nil
+	case signalRequestedIDsMapName:
+		data.SignalRequestedIDs[item.Key] = struct{}{}
+		return nil
+	}
+
+	id, err := strconv.ParseInt(item.Key, 10, 64)
+	if err != nil {
+		return err
+	}
+	switch item.Name {
+	case activityInfosMapName:
+		info := &persistence.InternalActivityInfo{}
+		data.ActivityInfos[id] = info
+		return json.Unmarshal(item.Data, info)
+	case childExecutionInfosMapName:
+		info := &persistence.InternalChildExecutionInfo{}
+		data.ChildExecutionInfos[id] = info
+		return json.Unmarshal(item.Data, info)
+	case requestCancelInfosMapName:
+		info := &persistence.RequestCancelInfo{}
+		data.RequestCancelInfos[id] = info
+		return json.Unmarshal(item.Data, info)
+	case signalInfosMapName:
+		info := &persistence.SignalInfo{}
+		data.SignalInfos[id] = info
+		return json.Unmarshal(item.Data, info)
+	default:
+		return fmt.Errorf("unknown workflow execution map: %v", item.Name)
+	}
+}
+
+// createTasks returns the transaction items that insert the tasks
+func (db *ddb) createTasks(
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) ([]*transactionItem, error) {
+	var items []*transactionItem
+	appendItem := func(table string, item interface{}) error {
+		attributes, err := marshalItem(item)
+		if err != nil {
+			return err
+		}
+		items = append(items, &transactionItem{
+			write: &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName: db.tableName(table),
+					Item:      attributes,
+				},
+			},
+		})
+		return nil
+	}
+
+	for _, task := range transferTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return nil, err
+		}
+		if err := appendItem(cadence.TransferTaskTableName, cadence.ShardTaskItem{
+			ShardID: shardID,
+			TaskID:  task.TaskID,
+			Data:    data,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	for _, task := range crossClusterTasks {
+		data, err := json.Marshal(&task.TransferTask)
+		if err != nil {
+			return nil, err
+		}
+		if err := appendItem(cadence.CrossClusterTaskTableName, cadence.ClusterTaskItem{
+			PK:      clusterTaskPartitionKey(shardID, task.TargetCluster),
+			TaskID:  task.TaskID,
+			ShardID: shardID,
+			Cluster: task.TargetCluster,
+			Data:    data,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	for _, task := range replicationTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return nil, err
+		}
+		if err := appendItem(cadence.ReplicationTaskTableName, cadence.ShardTaskItem{
+			ShardID: shardID,
+			TaskID:  task.TaskID,
+			Data:    data,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	for _, task := range timerTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return nil, err
+		}
+		visibilityTimestamp := task.VisibilityTimestamp.UnixNano()
+		if err := appendItem(cadence.TimerTaskTableName, cadence.TimerTaskItem{
+			ShardID:             shardID,
+			SK:                  timerTaskSortKey(visibilityTimestamp, task.TaskID),
+			VisibilityTimestamp: visibilityTimestamp,
+			TaskID:              task.TaskID,
+			Data:                data,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// executeTransactionWithTasks writes the conditional items together with the writes of the map items and the tasks.
+// The transaction of the conditional items is limited to maxTransactionItems items and maxTransactionBytes,
+// so the writes that don't fit in it are written ahead, in separate transactions which check the conditions of all
+// the conditional items. The map items written ahead are not visible until the execution item is written, and the
+// tasks fill the transaction of the conditional items first, so tasks are only written ahead when there are too many
+// of them to fit, and only if all the conditions are met at that time.
+// All the transactions are built before any of them is executed, so a write that can't be split doesn't write anything.
+func (db *ddb) executeTransactionWithTasks(
+	ctx context.Context,
+	conditionItems []*transactionItem,
+	mapItems []*transactionItem,
+	taskItems []*transactionItem,
+) error {
+	transactions, err := splitTransactions(conditionItems, append(append([]*transactionItem{}, taskItems...), mapItems...))
+	if err != nil {
+		return err
+	}
+	for _, transaction := range transactions {
+		if err := db.executeTransaction(ctx, transaction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitTransactions returns the transactions of the conditional items and the other items in the order to execute:
+// the transactions of the items written ahead, each starting with the checks of the conditions, and
+// then the transaction of the conditional items, which is filled with the other items in order
+func splitTransactions(conditionItems []*transactionItem, items []*transactionItem) ([][]*transactionItem, error) {
+	checks := make([]*transactionItem, 0, len(conditionItems))
+	checksSize, conditionsSize := 0, 0
+	for _, item := range conditionItems {
+		check := conditionCheck(item)
+		checks = append(checks, check)
+		checksSize += writeSize(check.write)
+		conditionsSize += writeSize(item.write)
+	}
+	if len(conditionItems) >= maxTransactionItems || conditionsSize >= maxTransactionBytes {
+		return nil, fmt.Errorf("too many conditional items in a transaction: %v items of %v bytes", len(conditionItems), conditionsSize)
+	}
+	for _, item := range items {
+		if size := writeSize(item.write); size > maxItemBytes {
+			return nil, fmt.Errorf("item of %v bytes exceeds the max item size of %v bytes", size, maxItemBytes)
+		}
+	}
+
+	last := append([]*transactionItem{}, conditionItems...)
+	next := fillTransaction(&last, conditionsSize, items)
+	var transactions [][]*transactionItem
+	for next < len(items) {
+		transaction := append([]*transactionItem{}, checks...)
+		filled := fillTransaction(&transaction, checksSize, items[next:])
+		if filled == 0 {
+			return nil, fmt.Errorf("item of %v bytes doesn't fit in a transaction with the conditions", writeSize(items[next].write))
+		}
+		transactions = append(transactions, transaction)
+		next += filled
+	}
+	return append(transactions, last), nil
+}
+
+// fillTransaction appends the items to the transaction until it's full, and returns the number of appended items
+func fillTransaction(transaction *[]*transactionItem, size int, items []*transactionItem) int {
+	for i, item := range items {
+		itemSize := writeSize(item.write)
+		if len(*transaction) >= maxTransactionItems || size+itemSize > maxTransactionBytes {
+			return i
+		}
+		*transaction = append(*transaction, item)
+		size += itemSize
+	}
+	return len(items)
+}
+
+// conditionCheck returns the condition of a conditional item as a ConditionCheck, which checks the same condition
+// without writing the item
+func conditionCheck(item *transactionItem) *transactionItem {
+	put := item.write.Put
+	if put == nil {
+		return item
+	}
+	itemKey := map[string]*dynamodb.AttributeValue{
+		cadence.PartitionKey: put.Item[cadence.PartitionKey],
+	}
+	if sk, ok := put.Item[cadence.SortKey]; ok {
+		itemKey[cadence.SortKey] = sk
+	}
+	return &transactionItem{
+		write: &dynamodb.TransactWriteItem{
+			ConditionCheck: &dynamodb.ConditionCheck{
+				TableName:                           put.TableName,
+				Key:                                 itemKey,
+				ConditionExpression:                 put.ConditionExpression,
+				ExpressionAttributeNames:            put.ExpressionAttributeNames,
+				ExpressionAttributeValues:           put.ExpressionAttributeValues,
+				ReturnValuesOnConditionCheckFailure: put.ReturnValuesOnConditionCheckFailure,
+			},
+		},
+		onConditionFailure: item.onConditionFailure,
+	}
+}
+
+// selectTasksOrderByTaskID queries the tasks of a partition with taskID in (exclusiveMinTaskID, inclusiveMaxTaskID]
+func (db *ddb) selectTasksOrderByTaskID(
+	ctx context.Context,
+	table string,
+	pk interface{},
+	pageSize int,
+	pageToken []byte,
+	exclusiveMinTaskID, inclusiveMaxTaskID int64,
+) ([]map[string]*dynamodb.AttributeValue, []byte, error) {
+	if inclusiveMaxTaskID <= exclusiveMinTaskID {
+		return nil, nil, nil
+	}
+	values, err := attributeValues(
+		":pk", pk,
+		":min_task_id", exclusiveMinTaskID+1,
+		":max_task_id", inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(table),
+		KeyConditionExpression:    aws.String("pk = :pk AND sk BETWEEN :min_task_id AND :max_task_id"),
+		ExpressionAttributeValues: values,
+		ConsistentRead:            aws.Bool(true),
+	}, pageSize, pageToken, []string{cadence.PartitionKey, cadence.SortKey})
+}
+
+// rangeDeleteTasks deletes the tasks of a partition with taskID in (exclusiveMinTaskID, inclusiveMaxTaskID]
+func (db *ddb) rangeDeleteTasks(
+	ctx context.Context,
+	table string,
+	pk interface{},
+	exclusiveMinTaskID, inclusiveMaxTaskID int64,
+) error {
+	if inclusiveMaxTaskID <= exclusiveMinTaskID {
+		return nil
+	}
+	values, err := attributeValues(
+		":pk", pk,
+		":min_task_id", exclusiveMinTaskID+1,
+		":max_task_id", inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return err
+	}
+	keys, err := db.queryKeys(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(table),
+		KeyConditionExpression:    aws.String("pk = :pk AND sk BETWEEN :min_task_id AND :max_task_id"),
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		return err
+	}
+	return db.batchDeleteItems(ctx, table, keys)
+}
+
+func toReplicationTasks(items []map[string]*dynamodb.AttributeValue) ([]*nosqlplugin.ReplicationTask, error) {
+	var taskItems []cadence.ShardTaskItem
+	if err := unmarshalItems(items, &taskItems); err != nil {
+		return nil, err
+	}
+	tasks := make([]*nosqlplugin.ReplicationTask, 0, len(taskItems))
+	for _, item := range taskItems {
+		task := &nosqlplugin.ReplicationTask{}
+		if err := json.Unmarshal(item.Data, task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func newWorkflowExecutionData(execution *nosqlplugin.WorkflowExecutionRequest) *workflowExecutionData {
+	executionInfo := execution.InternalWorkflowExecutionInfo
+	data := &workflowExecutionData{
+		ExecutionInfo:    &executionInfo,
+		VersionHistories: execution.VersionHistories,
+		LastWriteVersion: execution.LastWriteVersion,
+	}
+	if execution.Checksums != nil {
+		data.Checksum = *execution.Checksums
+	}
+	return data
+}
+
+// newWorkflowExecutionDataFromPrevious returns the data of a write of an existing execution, the version is the
+// next version of the previous data and the buffered events are not changed
+func newWorkflowExecutionDataFromPrevious(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	previous *workflowExecutionData,
+) *workflowExecutionData {
+	data := newWorkflowExecutionData(execution)
+	data.version = previous.version + 1
+	data.obsoleteEntries = previous.obsoleteEntries
+	data.FirstBufferedEventBatch = previous.FirstBufferedEventBatch
+	data.NextBufferedEventBatch = previous.NextBufferedEventBatch
+	return data
+}
+
+// newWorkflowExecutionEntries returns the map entries upserted and deleted by the request
+func newWorkflowExecutionEntries(execution *nosqlplugin.WorkflowExecutionRequest) (workflowExecutionEntries, error) {
+	entries := make(workflowExecutionEntries)
+	for id, info := range execution.ActivityInfos {
+		if err := entries.put(activityInfosMapName, strconv.FormatInt(id, 10), info); err != nil {
+			return nil, err
+		}
+	}
+	for id, info := range execution.TimerInfos {
+		if err := entries.put(timerInfosMapName, id, info); err != nil {
+			return nil, err
+		}
+	}
+	for id, info := range execution.ChildWorkflowInfos {
+		if err := entries.put(childExecutionInfosMapName, strconv.FormatInt(id, 10), info); err != nil {
+			return nil, err
+		}
+	}
+	for id, info := range execution.RequestCancelInfos {
+		if err := entries.put(requestCancelInfosMapName, strconv.FormatInt(id, 10), info); err != nil {
+			return nil, err
+		}
+	}
+	for id, info := range execution.SignalInfos {
+		if err := entries.put(signalInfosMapName, strconv.FormatInt(id, 10), info); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		if err := entries.put(signalRequestedIDsMapName, id, struct{}{}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range execution.ActivityInfoKeysToDelete {
+		entries.delete(activityInfosMapName, strconv.FormatInt(id, 10))
+	}
+	for _, id := range execution.TimerInfoKeysToDelete {
+		entries.delete(timerInfosMapName, id)
+	}
+	for _, id := range execution.ChildWorkflowInfoKeysToDelete {
+		entries.delete(childExecutionInfosMapName, strconv.FormatInt(id, 10))
+	}
+	for _, id := range execution.RequestCancelInfoKeysToDelete {
+		entries.delete(requestCancelInfosMapName, strconv.FormatInt(id, 10))
+	}
+	for _, id := range execution.SignalInfoKeysToDelete {
+		entries.delete(signalInfosMapName, strconv.FormatInt(id, 10))
+	}
+	for _, id := range execution.SignalRequestedIDsKeysToDelete {
+		entries.delete(signalRequestedIDsMapName, id)
+	}
+	return entries, nil
+}
+
+func (e workflowExecutionEntries) put(name, key string, value interface{}) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if e[name] == nil {
+		e[name] = make(map[string][]byte)
+	}
+	e[name][key] = encoded
+	return nil
+}
+
+func (e workflowExecutionEntries) delete(name, key string) {
+	if e[name] == nil {
+		e[name] = make(map[string][]byte)
+	}
+	e[name][key] = nil
+}
+
+// deleteMissing deletes the entries of the previous maps that are not written
+func (e workflowExecutionEntries) deleteMissing(previous *workflowExecutionData) {
+	deleteMissing := func(name, key string) {
+		if _, ok := e[name][key]; !ok {
+			e.delete(name, key)
+		}
+	}
+	for id := range previous.ActivityInfos {
+		deleteMissing(activityInfosMapName, strconv.FormatInt(id, 10))
+	}
+	for id := range previous.TimerInfos {
+		deleteMissing(timerInfosMapName, id)
+	}
+	for id := range previous.ChildExecutionInfos {
+		deleteMissing(childExecutionInfosMapName, strconv.FormatInt(id, 10))
+	}
+	for id := range previous.RequestCancelInfos {
+		deleteMissing(requestCancelInfosMapName, strconv.FormatInt(id, 10))
+	}
+	for id := range previous.SignalInfos {
+		deleteMissing(signalInfosMapName, strconv.FormatInt(id, 10))
+	}
+	for id := range previous.SignalRequestedIDs {
+		deleteMissing(signalRequestedIDsMapName, id)
+	}
+}
+
+func newWorkflowExecutionItem(shardID int, data *workflowExecutionData) (map[string]*dynamodb.AttributeValue, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	info := data.ExecutionInfo
+	return marshalItem(cadence.WorkflowExecutionItem{
+		ShardID:     shardID,
+		SK:          workflowExecutionSortKey(info.DomainID, info.WorkflowID, info.RunID),
+		DomainID:    info.DomainID,
+		WorkflowID:  info.WorkflowID,
+		RunID:       info.RunID,
+		NextEventID: info.NextEventID,
+		Version:     data.version,
+		Data:        encoded,
+	})
+}
+
+func decodeWorkflowExecutionData(item *cadence.WorkflowExecutionItem) (*workflowExecutionData, error) {
+	data := workflowExecutionData{
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+		SignalRequestedIDs:  make(map[string]struct{}),
+		version:             item.Version,
+	}
+	if err := json.Unmarshal(item.Data, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
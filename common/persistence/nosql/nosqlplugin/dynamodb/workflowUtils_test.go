@@ -0,0 +1,237 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
+)
+
+type fakeTransactionClient struct {
+	dynamodbiface.DynamoDBAPI
+
+	transactions [][]*dynamodb.TransactWriteItem
+}
+
+func (c *fakeTransactionClient) TransactWriteItemsWithContext(
+	_ aws.Context,
+	input *dynamodb.TransactWriteItemsInput,
+	_ ...request.Option,
+) (*dynamodb.TransactWriteItemsOutput, error) {
+	c.transactions = append(c.transactions, input.TransactItems)
+	return &dynamodb.TransactWriteItemsOutput{}, nil
+}
+
+func TestExecuteTransactionWithTasks(t *testing.T) {
+	client := &fakeTransactionClient{}
+	db := &ddb{client: client, cfg: &config.NoSQL{Keyspace: "cadence"}, logger: log.NewNoop()}
+
+	var transferTasks []*nosqlplugin.TransferTask
+	for i := 0; i < 150; i++ {
+		transferTasks = append(transferTasks, &nosqlplugin.TransferTask{TaskID: int64(i)})
+	}
+	var timerTasks []*nosqlplugin.TimerTask
+	for i := 0; i < 100; i++ {
+		timerTasks = append(timerTasks, &nosqlplugin.TimerTask{TaskID: int64(150 + i), VisibilityTimestamp: time.Now()})
+	}
+	taskItems, err := db.createTasks(1, transferTasks, nil, nil, timerTasks)
+	require.NoError(t, err)
+	shardConditionItem, err := db.assertShardRangeID(1, 10)
+	require.NoError(t, err)
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:   "domain",
+			WorkflowID: "workflow",
+			RunID:      "run",
+		},
+		ActivityInfos: map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1}, 2: {ScheduleID: 2}},
+	}
+	data := newWorkflowExecutionDataFromPrevious(execution, &workflowExecutionData{version: 1})
+	entries, err := newWorkflowExecutionEntries(execution)
+	require.NoError(t, err)
+	executionItem, mapItems, err := db.replaceWorkflowExecution(1, data, 5, entries)
+	require.NoError(t, err)
+	require.Len(t, mapItems, 2)
+
+	err = db.executeTransactionWithTasks(context.Background(), []*transactionItem{shardConditionItem, executionItem}, mapItems, taskItems)
+	require.NoError(t, err)
+
+	// 2 conditional items + 250 tasks + 2 map items: the tasks fill the last transaction with the conditional items,
+	// the rest is written ahead in transactions that check both conditions
+	require.Len(t, client.transactions, 3)
+	taskCount, mapCount := 0, 0
+	for i, transaction := range client.transactions {
+		assert.LessOrEqual(t, len(transaction), maxTransactionItems)
+		assert.NotNil(t, transaction[0].ConditionCheck, "transaction %v should be conditioned on the shard", i)
+		for _, write := range transaction[2:] {
+			switch aws.StringValue(write.Put.TableName) {
+			case aws.StringValue(db.tableName(cadence.WorkflowExecutionMapTableName)):
+				mapCount++
+			default:
+				taskCount++
+			}
+		}
+	}
+	assert.Equal(t, len(taskItems), taskCount)
+	assert.Equal(t, len(mapItems), mapCount)
+	for _, transaction := range client.transactions[:2] {
+		check := transaction[1].ConditionCheck
+		require.NotNil(t, check, "a transaction written ahead should check the execution condition")
+		assert.Equal(t, executionItem.write.Put.ConditionExpression, check.ConditionExpression)
+		assert.Equal(t, executionItem.write.Put.ExpressionAttributeValues, check.ExpressionAttributeValues)
+	}
+	last := client.transactions[len(client.transactions)-1]
+	assert.Equal(t, executionItem.write, last[1])
+	assert.Equal(t, taskItems[0].write, last[2])
+	assert.Len(t, last, maxTransactionItems)
+
+	// an item that can't be written fails the write before anything is written
+	client.transactions = nil
+	tooLarge := &transactionItem{write: &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+		TableName: db.tableName(cadence.WorkflowExecutionMapTableName),
+		Item:      map[string]*dynamodb.AttributeValue{"data": {B: make([]byte, maxItemBytes)}},
+	}}}
+	err = db.executeTransactionWithTasks(context.Background(), []*transactionItem{shardConditionItem, executionItem}, []*transactionItem{tooLarge}, taskItems)
+	assert.Error(t, err)
+	assert.Empty(t, client.transactions)
+}
+
+func TestAttributeValue_UnsupportedType(t *testing.T) {
+	_, err := attributeValue(1.5)
+	assert.Error(t, err)
+
+	_, err = compositeKey(1, struct{}{})
+	assert.Error(t, err)
+
+	_, err = attributeValues(":a", 1, ":b")
+	assert.Error(t, err)
+
+	values, err := attributeValues(":a", 1, ":b", "b")
+	require.NoError(t, err)
+	assert.Equal(t, "1", aws.StringValue(values[":a"].N))
+	assert.Equal(t, "b", aws.StringValue(values[":b"].S))
+}
+
+func TestWorkflowExecutionMaps(t *testing.T) {
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		MapsWriteMode: nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:   "domain",
+			WorkflowID: "workflow",
+			RunID:      "run",
+		},
+		ActivityInfos:         map[int64]*persistence.InternalActivityInfo{5: {ScheduleID: 5}},
+		SignalRequestedIDs:    []string{"signal"},
+		TimerInfoKeysToDelete: []string{"timer"},
+	}
+	previous := &workflowExecutionData{
+		version:                 1,
+		FirstBufferedEventBatch: 0,
+		NextBufferedEventBatch:  1,
+		obsoleteEntries:         []string{workflowExecutionMapSortKey(activityInfosMapName, "3", 1)},
+	}
+	data := newWorkflowExecutionDataFromPrevious(execution, previous)
+	entries, err := newWorkflowExecutionEntries(execution)
+	require.NoError(t, err)
+	require.NoError(t, entries.put(bufferedEventsMapName, bufferedEventBatchKey(data.NextBufferedEventBatch), &persistence.DataBlob{Data: []byte("events")}))
+	data.NextBufferedEventBatch++
+
+	db := &ddb{cfg: &config.NoSQL{Keyspace: "cadence"}}
+	items, err := db.writeWorkflowExecutionMaps(1, data, entries)
+	require.NoError(t, err)
+	require.Len(t, items, 5)
+	// the entries are written with the new version, the timer is deleted with a tombstone
+	// and the obsolete item of the previous version is deleted
+	var written []map[string]*dynamodb.AttributeValue
+	for _, item := range items[:4] {
+		require.NotNil(t, item.write.Put)
+		written = append(written, item.write.Put.Item)
+	}
+	var timer cadence.WorkflowExecutionMapItem
+	require.NoError(t, unmarshalItem(written[3], &timer))
+	assert.Equal(t, "1#domain#workflow#run", timer.PK)
+	assert.Equal(t, workflowExecutionMapSortKey(timerInfosMapName, "timer", 2), timer.SK)
+	assert.True(t, timer.Deleted)
+	require.NotNil(t, items[4].write.Delete)
+	assert.Equal(t, previous.obsoleteEntries[0], aws.StringValue(items[4].write.Delete.Key[cadence.SortKey].S))
+
+	// the items of the previous versions and the items of a failed write of a later version
+	older := func(name, key string, version int64, deleted bool, value interface{}) map[string]*dynamodb.AttributeValue {
+		encoded, err := json.Marshal(value)
+		require.NoError(t, err)
+		item, err := marshalItem(cadence.WorkflowExecutionMapItem{
+			PK:      "1#domain#workflow#run",
+			SK:      workflowExecutionMapSortKey(name, key, version),
+			Name:    name,
+			Key:     key,
+			Version: version,
+			Deleted: deleted,
+			Data:    encoded,
+		})
+		require.NoError(t, err)
+		return item
+	}
+	written = append(written,
+		older(activityInfosMapName, "5", 1, false, &persistence.InternalActivityInfo{ScheduleID: 4}),
+		older(timerInfosMapName, "timer", 1, false, &persistence.TimerInfo{TimerID: "timer"}),
+		older(childExecutionInfosMapName, "7", 1, true, nil),
+		older(bufferedEventsMapName, bufferedEventBatchKey(0), 1, false, &persistence.DataBlob{Data: []byte("first")}),
+		older(activityInfosMapName, "6", 3, false, &persistence.InternalActivityInfo{ScheduleID: 6}),
+	)
+
+	decoded, err := decodeWorkflowExecutionData(&cadence.WorkflowExecutionItem{Version: 2, Data: []byte(`{"FirstBufferedEventBatch":0,"NextBufferedEventBatch":2}`)})
+	require.NoError(t, err)
+	require.NoError(t, resolveWorkflowExecutionMaps(decoded, written))
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{5: {ScheduleID: 5}}, decoded.ActivityInfos)
+	assert.Equal(t, map[string]struct{}{"signal": {}}, decoded.SignalRequestedIDs)
+	assert.Empty(t, decoded.TimerInfos)
+	assert.Empty(t, decoded.ChildExecutionInfos)
+	assert.Equal(t, []*persistence.DataBlob{{Data: []byte("first")}, {Data: []byte("events")}}, decoded.BufferedEvents)
+	assert.Equal(t, []string{
+		workflowExecutionMapSortKey(activityInfosMapName, "5", 1),
+		workflowExecutionMapSortKey(activityInfosMapName, "6", 3),
+		workflowExecutionMapSortKey(childExecutionInfosMapName, "7", 1),
+		workflowExecutionMapSortKey(timerInfosMapName, "timer", 1),
+	}, decoded.obsoleteEntries)
+
+	// the cleared buffered events become obsolete
+	cleared, err := decodeWorkflowExecutionData(&cadence.WorkflowExecutionItem{Version: 2, Data: []byte(`{"FirstBufferedEventBatch":2,"NextBufferedEventBatch":2}`)})
+	require.NoError(t, err)
+	require.NoError(t, resolveWorkflowExecutionMaps(cleared, written))
+	assert.Empty(t, cleared.BufferedEvents)
+	assert.Contains(t, cleared.obsoleteEntries, workflowExecutionMapSortKey(bufferedEventsMapName, bufferedEventBatchKey(0), 1))
+	assert.Contains(t, cleared.obsoleteEntries, workflowExecutionMapSortKey(bufferedEventsMapName, bufferedEventBatchKey(1), 2))
+}
@@ -344,6 +344,9 @@ type (
 		// DeleteTask delete a batch of tasks
 		// Also return the number of rows deleted -- if it's not supported then ignore the batchSize, and return persistence.UnknownNumRowsAffected
 		RangeDeleteTasks(ctx context.Context, filter *TasksFilter) (rowsDeleted int, err error)
+		// SelectOrphanTasks returns tasks that belong to a tasklist no longer present, up to the limit
+		// Return an error if it's not supported -- tasks are expected to be removed by TTL in that case
+		SelectOrphanTasks(ctx context.Context, limit int) ([]*TaskRow, error)
 	}
 
 	/**
@@ -972,6 +972,21 @@ func (mr *MockDBMockRecorder) SelectOneClosedWorkflow(ctx, domainID, workflowID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOneClosedWorkflow", reflect.TypeOf((*MockDB)(nil).SelectOneClosedWorkflow), ctx, domainID, workflowID, runID)
 }
 
+// SelectOrphanTasks mocks base method.
+func (m *MockDB) SelectOrphanTasks(ctx context.Context, limit int) ([]*TaskRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectOrphanTasks", ctx, limit)
+	ret0, _ := ret[0].([]*TaskRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectOrphanTasks indicates an expected call of SelectOrphanTasks.
+func (mr *MockDBMockRecorder) SelectOrphanTasks(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOrphanTasks", reflect.TypeOf((*MockDB)(nil).SelectOrphanTasks), ctx, limit)
+}
+
 // SelectQueueMetadata mocks base method.
 func (m *MockDB) SelectQueueMetadata(ctx context.Context, queueType persistence.QueueType) (*QueueMetadataRow, error) {
 	m.ctrl.T.Helper()
@@ -2002,6 +2017,21 @@ func (mr *MocktableCRUDMockRecorder) SelectOneClosedWorkflow(ctx, domainID, work
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOneClosedWorkflow", reflect.TypeOf((*MocktableCRUD)(nil).SelectOneClosedWorkflow), ctx, domainID, workflowID, runID)
 }
 
+// SelectOrphanTasks mocks base method.
+func (m *MocktableCRUD) SelectOrphanTasks(ctx context.Context, limit int) ([]*TaskRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectOrphanTasks", ctx, limit)
+	ret0, _ := ret[0].([]*TaskRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectOrphanTasks indicates an expected call of SelectOrphanTasks.
+func (mr *MocktableCRUDMockRecorder) SelectOrphanTasks(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOrphanTasks", reflect.TypeOf((*MocktableCRUD)(nil).SelectOrphanTasks), ctx, limit)
+}
+
 // SelectQueueMetadata mocks base method.
 func (m *MocktableCRUD) SelectQueueMetadata(ctx context.Context, queueType persistence.QueueType) (*QueueMetadataRow, error) {
 	m.ctrl.T.Helper()
@@ -3025,6 +3055,21 @@ func (mr *MockTaskCRUDMockRecorder) RangeDeleteTasks(ctx, filter interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteTasks", reflect.TypeOf((*MockTaskCRUD)(nil).RangeDeleteTasks), ctx, filter)
 }
 
+// SelectOrphanTasks mocks base method.
+func (m *MockTaskCRUD) SelectOrphanTasks(ctx context.Context, limit int) ([]*TaskRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectOrphanTasks", ctx, limit)
+	ret0, _ := ret[0].([]*TaskRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectOrphanTasks indicates an expected call of SelectOrphanTasks.
+func (mr *MockTaskCRUDMockRecorder) SelectOrphanTasks(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOrphanTasks", reflect.TypeOf((*MockTaskCRUD)(nil).SelectOrphanTasks), ctx, limit)
+}
+
 // SelectTaskList mocks base method.
 func (m *MockTaskCRUD) SelectTaskList(ctx context.Context, filter *TaskListFilter) (*TaskListRow, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,66 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func (db *memdb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
+	defer db.lock()()
+
+	versions, ok := db.store.configs[row.RowType]
+	if !ok {
+		versions = make(map[int64]*persistence.InternalConfigStoreEntry)
+		db.store.configs[row.RowType] = versions
+	}
+	if _, ok := versions[row.Version]; ok {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	versions[row.Version] = copyConfigStoreEntry(row)
+	return nil
+}
+
+func (db *memdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
+	defer db.lock()()
+
+	var latest *persistence.InternalConfigStoreEntry
+	for _, entry := range db.store.configs[rowType] {
+		if latest == nil || entry.Version > latest.Version {
+			latest = entry
+		}
+	}
+	if latest == nil {
+		return nil, errNotFound
+	}
+	return copyConfigStoreEntry(latest), nil
+}
+
+func copyConfigStoreEntry(entry *persistence.InternalConfigStoreEntry) *persistence.InternalConfigStoreEntry {
+	result := *entry
+	if entry.Values != nil {
+		result.Values = persistence.NewDataBlob(copyBytes(entry.Values.Data), entry.Values.Encoding)
+	}
+	return &result
+}
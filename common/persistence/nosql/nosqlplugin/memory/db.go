@@ -0,0 +1,187 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// memdb represents a logical connection to an in-memory database
+type memdb struct {
+	store    *store
+	keyspace string
+	logger   log.Logger
+}
+
+var _ nosqlplugin.DB = (*memdb)(nil)
+
+type (
+	// store contains all the tables of an in-memory database.
+	// Every operation holds the lock of the whole store, so a conditional write is atomic across all the tables it touches.
+	// Conditions are always checked before any change is applied (see writeBatch), so a failed
+	// conditional write never leaves partial changes behind.
+	// Rows are stored as copies (JSON encoded like the data blob columns of other plugins, or deep copied
+	// for the workflow executions), so callers can never modify the stored data by mutating the requests or responses.
+	store struct {
+		sync.Mutex
+
+		// domain table, keyed by domain name
+		domains map[string]*domainEntry
+		// domain_metadata table, which is just the notification version
+		domainNotificationVersion int64
+
+		// shard table
+		shards map[int]*shardEntry
+
+		// workflow tables
+		currentWorkflows    map[currentWorkflowKey]nosqlplugin.CurrentWorkflowRow
+		workflowExecutions  map[workflowExecutionKey]*workflowExecutionEntry
+		transferTasks       map[int]map[int64][]byte
+		crossClusterTasks   map[clusterTaskKey]map[int64][]byte
+		replicationTasks    map[int]map[int64][]byte
+		replicationDLQTasks map[clusterTaskKey]map[int64][]byte
+		timerTasks          map[int]map[timerTaskKey][]byte
+
+		// history tables
+		historyTrees map[historyBranchKey]*historyTreeEntry
+		historyNodes map[historyBranchKey]map[historyNodeKey]*historyNodeEntry
+
+		// queue tables
+		queueMessages map[persistence.QueueType]map[int64][]byte
+		queueMetadata map[persistence.QueueType]*nosqlplugin.QueueMetadataRow
+
+		// tasklist tables
+		taskLists map[nosqlplugin.TaskListFilter]*taskListEntry
+		tasks     map[nosqlplugin.TaskListFilter]map[int64]*taskEntry
+
+		// visibility table
+		visibility map[visibilityKey]*visibilityEntry
+
+		// cluster_config table, keyed by row type and version
+		configs map[int]map[int64]*persistence.InternalConfigStoreEntry
+	}
+
+	// writeBatch collects the changes of a conditional write. All conditions are checked while the changes
+	// are being added, and the changes are only applied when all of them are met.
+	writeBatch []func()
+)
+
+func newStore() *store {
+	return &store{
+		domains:             make(map[string]*domainEntry),
+		shards:              make(map[int]*shardEntry),
+		currentWorkflows:    make(map[currentWorkflowKey]nosqlplugin.CurrentWorkflowRow),
+		workflowExecutions:  make(map[workflowExecutionKey]*workflowExecutionEntry),
+		transferTasks:       make(map[int]map[int64][]byte),
+		crossClusterTasks:   make(map[clusterTaskKey]map[int64][]byte),
+		replicationTasks:    make(map[int]map[int64][]byte),
+		replicationDLQTasks: make(map[clusterTaskKey]map[int64][]byte),
+		timerTasks:          make(map[int]map[timerTaskKey][]byte),
+		historyTrees:        make(map[historyBranchKey]*historyTreeEntry),
+		historyNodes:        make(map[historyBranchKey]map[historyNodeKey]*historyNodeEntry),
+		queueMessages:       make(map[persistence.QueueType]map[int64][]byte),
+		queueMetadata:       make(map[persistence.QueueType]*nosqlplugin.QueueMetadataRow),
+		taskLists:           make(map[nosqlplugin.TaskListFilter]*taskListEntry),
+		tasks:               make(map[nosqlplugin.TaskListFilter]map[int64]*taskEntry),
+		visibility:          make(map[visibilityKey]*visibilityEntry),
+		configs:             make(map[int]map[int64]*persistence.InternalConfigStoreEntry),
+	}
+}
+
+func (db *memdb) Close() {
+	// the data is owned by the plugin and must outlive a single DB object
+}
+
+func (db *memdb) PluginName() string {
+	return PluginName
+}
+
+// lock locks the whole store, the returned function must be called to unlock it
+func (db *memdb) lock() func() {
+	db.store.Lock()
+	return db.store.Unlock
+}
+
+func (b *writeBatch) add(change func()) {
+	*b = append(*b, change)
+}
+
+func (b writeBatch) apply() {
+	for _, change := range b {
+		change()
+	}
+}
+
+// encodePageToken serializes the last read keys of a page, so that the next page can be queried from it
+func encodePageToken(token interface{}) ([]byte, error) {
+	return json.Marshal(token)
+}
+
+// decodePageToken deserializes a page token, return false if the token is empty(the first page)
+func decodePageToken(pageToken []byte, token interface{}) (bool, error) {
+	if len(pageToken) == 0 {
+		return false, nil
+	}
+	if err := json.Unmarshal(pageToken, token); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// isExpired returns true if a row with TTL is already expired
+func isExpired(expireAt *time.Time, now time.Time) bool {
+	return expireAt != nil && !expireAt.After(now)
+}
+
+// newExpireAt returns the expiry time of a TTL, or nil if there is no TTL
+func newExpireAt(ttlSeconds int64, now time.Time) *time.Time {
+	if ttlSeconds <= 0 {
+		return nil
+	}
+	expireAt := now.Add(time.Duration(ttlSeconds) * time.Second)
+	return &expireAt
+}
+
+// sortedTaskIDs returns the keys of a task table within (exclusiveMinTaskID, inclusiveMaxTaskID] in ascending order
+func sortedTaskIDs(tasks map[int64][]byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) []int64 {
+	taskIDs := make([]int64, 0, len(tasks))
+	for taskID := range tasks {
+		if taskID > exclusiveMinTaskID && taskID <= inclusiveMaxTaskID {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+	return taskIDs
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
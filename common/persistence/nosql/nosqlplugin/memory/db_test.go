@@ -0,0 +1,117 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func newTestDB(t *testing.T, keyspace string) nosqlplugin.DB {
+	db, err := memoryPlugin.CreateDB(&config.NoSQL{Keyspace: keyspace}, loggerimpl.NewNopLogger(), &persistence.DynamicConfiguration{})
+	require.NoError(t, err)
+	t.Cleanup(func() { memoryPlugin.dropStore(keyspace) })
+	return db
+}
+
+func TestStoreIsSharedWithinKeyspace(t *testing.T) {
+	ctx := context.Background()
+	db1 := newTestDB(t, "TestStoreIsSharedWithinKeyspace")
+	db2 := newTestDB(t, "TestStoreIsSharedWithinKeyspace")
+	other := newTestDB(t, "TestStoreIsSharedWithinKeyspace_other")
+
+	require.NoError(t, db1.InsertShard(ctx, &nosqlplugin.ShardRow{ShardID: 1, RangeID: 1}))
+
+	rangeID, _, err := db2.SelectShard(ctx, 1, "active")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rangeID)
+
+	_, _, err = other.SelectShard(ctx, 1, "active")
+	assert.True(t, other.IsNotFoundError(err))
+
+	admin, err := memoryPlugin.CreateAdminDB(&config.NoSQL{Keyspace: "TestStoreIsSharedWithinKeyspace"}, loggerimpl.NewNopLogger(), &persistence.DynamicConfiguration{})
+	require.NoError(t, err)
+	require.NoError(t, admin.TeardownTestDatabase())
+	_, _, err = newTestDB(t, "TestStoreIsSharedWithinKeyspace").SelectShard(ctx, 1, "active")
+	assert.True(t, db1.IsNotFoundError(err))
+}
+
+func TestFailedConditionalWriteHasNoPartialChanges(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t, "TestFailedConditionalWriteHasNoPartialChanges")
+	require.NoError(t, db.InsertShard(ctx, &nosqlplugin.ShardRow{ShardID: 1, RangeID: 1}))
+
+	newExecution := func(runID string) *nosqlplugin.WorkflowExecutionRequest {
+		execution := &nosqlplugin.WorkflowExecutionRequest{}
+		execution.DomainID = "domain"
+		execution.WorkflowID = "workflow"
+		execution.RunID = runID
+		execution.NextEventID = 2
+		return execution
+	}
+	insert := func(runID string, writeMode nosqlplugin.CurrentWorkflowWriteMode, taskID int64) error {
+		return db.InsertWorkflowExecutionWithTasks(
+			ctx,
+			&nosqlplugin.CurrentWorkflowWriteRequest{
+				WriteMode: writeMode,
+				Row:       nosqlplugin.CurrentWorkflowRow{RunID: runID},
+			},
+			newExecution(runID),
+			[]*nosqlplugin.TransferTask{{TaskID: taskID}},
+			nil,
+			nil,
+			nil,
+			&nosqlplugin.ShardCondition{ShardID: 1, RangeID: 1},
+		)
+	}
+
+	require.NoError(t, insert("run1", nosqlplugin.CurrentWorkflowWriteModeInsert, 1))
+
+	// the current workflow condition fails, so neither the execution nor the task must be written
+	err := insert("run2", nosqlplugin.CurrentWorkflowWriteModeInsert, 2)
+	require.IsType(t, &nosqlplugin.WorkflowOperationConditionFailure{}, err)
+	assert.NotNil(t, err.(*nosqlplugin.WorkflowOperationConditionFailure).WorkflowExecutionAlreadyExists)
+
+	exists, err := db.IsWorkflowExecutionExists(ctx, 1, "domain", "workflow", "run2")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	tasks, _, err := db.SelectTransferTasksOrderByTaskID(ctx, 1, 10, nil, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, int64(1), tasks[0].TaskID)
+
+	// the shard condition fails
+	require.NoError(t, db.UpdateRangeID(ctx, 1, 2, 1))
+	err = insert("run3", nosqlplugin.CurrentWorkflowWriteModeNoop, 3)
+	require.IsType(t, &nosqlplugin.WorkflowOperationConditionFailure{}, err)
+	assert.Equal(t, int64(2), *err.(*nosqlplugin.WorkflowOperationConditionFailure).ShardRangeIDNotMatch)
+	exists, err = db.IsWorkflowExecutionExists(ctx, 1, "domain", "workflow", "run3")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+type domainEntry struct {
+	id   string
+	data []byte
+}
+
+// Insert a new record to domain, return error if failed or already exists
+// Return ConditionFailure if the condition doesn't meet
+func (db *memdb) InsertDomain(
+	ctx context.Context,
+	row *nosqlplugin.DomainRow,
+) error {
+	defer db.lock()()
+
+	if _, ok := db.store.domains[row.Info.Name]; ok {
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+		}
+	}
+	if _, _, ok := db.findDomainByID(row.Info.ID); ok {
+		return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+	}
+
+	// same as Cassandra, new domain is inserted with the current notification version
+	// and the initial failover versions
+	insertRow := *row
+	insertRow.NotificationVersion = db.store.domainNotificationVersion
+	insertRow.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	insertRow.PreviousFailoverVersion = common.InitialPreviousFailoverVersion
+	data, err := json.Marshal(&insertRow)
+	if err != nil {
+		return err
+	}
+
+	db.store.domains[row.Info.Name] = &domainEntry{
+		id:   row.Info.ID,
+		data: data,
+	}
+	db.store.domainNotificationVersion++
+	return nil
+}
+
+// Update domain
+func (db *memdb) UpdateDomain(
+	ctx context.Context,
+	row *nosqlplugin.DomainRow,
+) error {
+	defer db.lock()()
+
+	if db.store.domainNotificationVersion != row.NotificationVersion {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	entry, ok := db.store.domains[row.Info.Name]
+	if !ok {
+		return errNotFound
+	}
+	current, err := toDomainRow(entry)
+	if err != nil {
+		return err
+	}
+
+	// same as Cassandra, whether the domain is global can't be changed by an update
+	updateRow := *row
+	updateRow.IsGlobalDomain = current.IsGlobalDomain
+	data, err := json.Marshal(&updateRow)
+	if err != nil {
+		return err
+	}
+
+	entry.data = data
+	db.store.domainNotificationVersion++
+	return nil
+}
+
+// Get one domain data, either by domainID or domainName
+func (db *memdb) SelectDomain(
+	ctx context.Context,
+	domainID *string,
+	domainName *string,
+) (*nosqlplugin.DomainRow, error) {
+	defer db.lock()()
+
+	var entry *domainEntry
+	var ok bool
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID != nil {
+		_, entry, ok = db.findDomainByID(*domainID)
+	} else if domainName != nil {
+		entry, ok = db.store.domains[*domainName]
+	} else {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+	if !ok {
+		return nil, errNotFound
+	}
+	return toDomainRow(entry)
+}
+
+// Get all domain data
+func (db *memdb) SelectAllDomains(
+	ctx context.Context,
+	pageSize int,
+	pageToken []byte,
+) ([]*nosqlplugin.DomainRow, []byte, error) {
+	defer db.lock()()
+
+	var lastName string
+	hasToken, err := decodePageToken(pageToken, &lastName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(db.store.domains))
+	for name := range db.store.domains {
+		if !hasToken || name > lastName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if pageSize > 0 && len(names) > pageSize {
+		names = names[:pageSize]
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(names))
+	for _, name := range names {
+		row, err := toDomainRow(db.store.domains[name])
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(names) == pageSize {
+		nextPageToken, err = encodePageToken(names[len(names)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, nextPageToken, nil
+}
+
+// Delete a domain, either by domainID or domainName
+func (db *memdb) DeleteDomain(
+	ctx context.Context,
+	domainID *string,
+	domainName *string,
+) error {
+	defer db.lock()()
+
+	if domainID != nil {
+		if name, _, ok := db.findDomainByID(*domainID); ok {
+			delete(db.store.domains, name)
+		}
+	} else if domainName != nil {
+		delete(db.store.domains, *domainName)
+	} else {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+	return nil
+}
+
+func (db *memdb) SelectDomainMetadata(
+	ctx context.Context,
+) (int64, error) {
+	defer db.lock()()
+
+	return db.store.domainNotificationVersion, nil
+}
+
+// findDomainByID returns the name and the entry of the domain with the ID, it must be called with the store locked
+func (db *memdb) findDomainByID(domainID string) (string, *domainEntry, bool) {
+	for name, entry := range db.store.domains {
+		if entry.id == domainID {
+			return name, entry, true
+		}
+	}
+	return "", nil, false
+}
+
+func toDomainRow(entry *domainEntry) (*nosqlplugin.DomainRow, error) {
+	var row nosqlplugin.DomainRow
+	if err := json.Unmarshal(entry.data, &row); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type (
+	historyBranchKey struct {
+		TreeID   string
+		BranchID string
+	}
+
+	historyNodeKey struct {
+		NodeID int64
+		TxnID  int64
+	}
+
+	historyTreeEntry struct {
+		shardID         int
+		ancestors       []byte
+		createTimestamp time.Time
+		info            string
+	}
+
+	historyNodeEntry struct {
+		shardID      int
+		data         []byte
+		dataEncoding string
+	}
+
+	historyNodePageToken = historyNodeKey
+
+	historyTreePageToken = historyBranchKey
+)
+
+// InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
+func (db *memdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var ancestors []byte
+	if treeRow != nil {
+		var err error
+		ancestors, err = json.Marshal(treeRow.Ancestors)
+		if err != nil {
+			return err
+		}
+	}
+
+	defer db.lock()()
+
+	if treeRow != nil {
+		db.store.historyTrees[historyBranchKey{TreeID: treeRow.TreeID, BranchID: treeRow.BranchID}] = &historyTreeEntry{
+			shardID:         treeRow.ShardID,
+			ancestors:       ancestors,
+			createTimestamp: treeRow.CreateTimestamp,
+			info:            treeRow.Info,
+		}
+	}
+	if nodeRow != nil {
+		branchKey := historyBranchKey{TreeID: nodeRow.TreeID, BranchID: nodeRow.BranchID}
+		nodes, ok := db.store.historyNodes[branchKey]
+		if !ok {
+			nodes = make(map[historyNodeKey]*historyNodeEntry)
+			db.store.historyNodes[branchKey] = nodes
+		}
+		var txnID int64
+		if nodeRow.TxnID != nil {
+			txnID = *nodeRow.TxnID
+		}
+		nodes[historyNodeKey{NodeID: nodeRow.NodeID, TxnID: txnID}] = &historyNodeEntry{
+			shardID:      nodeRow.ShardID,
+			data:         copyBytes(nodeRow.Data),
+			dataEncoding: nodeRow.DataEncoding,
+		}
+	}
+	return nil
+}
+
+// SelectFromHistoryNode read nodes based on a filter
+func (db *memdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
+	var token historyNodePageToken
+	hasToken, err := decodePageToken(filter.NextPageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer db.lock()()
+
+	nodes := db.store.historyNodes[historyBranchKey{TreeID: filter.TreeID, BranchID: filter.BranchID}]
+	keys := make([]historyNodeKey, 0, len(nodes))
+	for key := range nodes {
+		if key.NodeID < filter.MinNodeID || key.NodeID >= filter.MaxNodeID {
+			continue
+		}
+		// nodes are sorted by nodeID ASC, txnID DESC
+		if hasToken && (key.NodeID < token.NodeID || (key.NodeID == token.NodeID && key.TxnID >= token.TxnID)) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].NodeID != keys[j].NodeID {
+			return keys[i].NodeID < keys[j].NodeID
+		}
+		return keys[i].TxnID > keys[j].TxnID
+	})
+	if filter.PageSize > 0 && len(keys) > filter.PageSize {
+		keys = keys[:filter.PageSize]
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(keys))
+	for _, key := range keys {
+		entry := nodes[key]
+		txnID := key.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			ShardID:      entry.shardID,
+			TreeID:       filter.TreeID,
+			BranchID:     filter.BranchID,
+			NodeID:       key.NodeID,
+			TxnID:        &txnID,
+			Data:         copyBytes(entry.data),
+			DataEncoding: entry.dataEncoding,
+		})
+	}
+
+	var nextPageToken []byte
+	if filter.PageSize > 0 && len(keys) == filter.PageSize {
+		nextPageToken, err = encodePageToken(keys[len(keys)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, nextPageToken, nil
+}
+
+// DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
+func (db *memdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
+	defer db.lock()()
+
+	for key := range db.store.historyTrees {
+		if key.TreeID == treeFilter.TreeID && (treeFilter.BranchID == nil || key.BranchID == *treeFilter.BranchID) {
+			delete(db.store.historyTrees, key)
+		}
+	}
+
+	for _, nodeFilter := range nodeFilters {
+		branchKey := historyBranchKey{TreeID: nodeFilter.TreeID, BranchID: nodeFilter.BranchID}
+		nodes := db.store.historyNodes[branchKey]
+		for key := range nodes {
+			if key.NodeID >= nodeFilter.MinNodeID {
+				delete(nodes, key)
+			}
+		}
+		if len(nodes) == 0 {
+			delete(db.store.historyNodes, branchKey)
+		}
+	}
+	return nil
+}
+
+// SelectAllHistoryTrees will return all tree branches with pagination
+func (db *memdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
+	var token historyTreePageToken
+	hasToken, err := decodePageToken(nextPageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer db.lock()()
+
+	rows, err := db.selectHistoryTrees(func(key historyBranchKey) bool {
+		return !hasToken || key.TreeID > token.TreeID || (key.TreeID == token.TreeID && key.BranchID > token.BranchID)
+	}, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newPageToken []byte
+	if pageSize > 0 && len(rows) == pageSize {
+		last := rows[len(rows)-1]
+		newPageToken, err = encodePageToken(historyTreePageToken{TreeID: last.TreeID, BranchID: last.BranchID})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, newPageToken, nil
+}
+
+// SelectFromHistoryTree read branch records for a tree
+func (db *memdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
+	defer db.lock()()
+
+	return db.selectHistoryTrees(func(key historyBranchKey) bool {
+		return key.TreeID == filter.TreeID && (filter.BranchID == nil || key.BranchID == *filter.BranchID)
+	}, 0)
+}
+
+// selectHistoryTrees returns the tree rows sorted by treeID and branchID, it must be called with the store locked
+func (db *memdb) selectHistoryTrees(match func(key historyBranchKey) bool, limit int) ([]*nosqlplugin.HistoryTreeRow, error) {
+	keys := make([]historyBranchKey, 0)
+	for key := range db.store.historyTrees {
+		if match(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].TreeID != keys[j].TreeID {
+			return keys[i].TreeID < keys[j].TreeID
+		}
+		return keys[i].BranchID < keys[j].BranchID
+	})
+	if limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(keys))
+	for _, key := range keys {
+		entry := db.store.historyTrees[key]
+		row := &nosqlplugin.HistoryTreeRow{
+			ShardID:         entry.shardID,
+			TreeID:          key.TreeID,
+			BranchID:        key.BranchID,
+			CreateTimestamp: entry.createTimestamp,
+			Info:            entry.info,
+		}
+		if err := json.Unmarshal(entry.ancestors, &row.Ancestors); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "memory"
+)
+
+type plugin struct {
+	sync.Mutex
+	// stores are keyed by the keyspace of the config, so that all the DB objects
+	// created for the same keyspace share the same data within the process
+	stores map[string]*store
+}
+
+var _ nosqlplugin.Plugin = (*plugin)(nil)
+
+var memoryPlugin = &plugin{
+	stores: make(map[string]*store),
+}
+
+func init() {
+	nosql.RegisterPlugin(PluginName, memoryPlugin)
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (nosqlplugin.DB, error) {
+	return p.doCreateDB(cfg, logger), nil
+}
+
+// CreateAdminDB initialize the AdminDB object
+func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (nosqlplugin.AdminDB, error) {
+	return p.doCreateDB(cfg, logger), nil
+}
+
+func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) *memdb {
+	return &memdb{
+		store:    p.getOrCreateStore(cfg.Keyspace),
+		keyspace: cfg.Keyspace,
+		logger:   logger,
+	}
+}
+
+func (p *plugin) getOrCreateStore(keyspace string) *store {
+	p.Lock()
+	defer p.Unlock()
+
+	s, ok := p.stores[keyspace]
+	if !ok {
+		s = newStore()
+		p.stores[keyspace] = s
+	}
+	return s
+}
+
+// dropStore removes the data of a keyspace. DB objects that are still holding
+// the dropped store keep working on it, but new DB objects will get an empty store.
+func (p *plugin) dropStore(keyspace string) {
+	p.Lock()
+	defer p.Unlock()
+
+	delete(p.stores, keyspace)
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"math"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// Insert message into queue, return error if failed or already exists
+// Return ConditionFailure if the condition doesn't meet
+func (db *memdb) InsertIntoQueue(
+	ctx context.Context,
+	row *nosqlplugin.QueueMessageRow,
+) error {
+	defer db.lock()()
+
+	messages, ok := db.store.queueMessages[row.QueueType]
+	if !ok {
+		messages = make(map[int64][]byte)
+		db.store.queueMessages[row.QueueType] = messages
+	}
+	if _, ok := messages[row.ID]; ok {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	messages[row.ID] = copyBytes(row.Payload)
+	return nil
+}
+
+// Get the ID of last message inserted into the queue
+func (db *memdb) SelectLastEnqueuedMessageID(
+	ctx context.Context,
+	queueType persistence.QueueType,
+) (int64, error) {
+	defer db.lock()()
+
+	messages := db.store.queueMessages[queueType]
+	if len(messages) == 0 {
+		return 0, errNotFound
+	}
+	var lastMessageID int64
+	first := true
+	for messageID := range messages {
+		if first || messageID > lastMessageID {
+			lastMessageID = messageID
+			first = false
+		}
+	}
+	return lastMessageID, nil
+}
+
+// Read queue messages starting from the exclusiveBeginMessageID
+func (db *memdb) SelectMessagesFrom(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	exclusiveBeginMessageID int64,
+	maxRows int,
+) ([]*nosqlplugin.QueueMessageRow, error) {
+	defer db.lock()()
+
+	var result []*nosqlplugin.QueueMessageRow
+	for _, messageID := range db.selectMessageIDs(queueType, exclusiveBeginMessageID, math.MaxInt64, maxRows) {
+		result = append(result, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        messageID,
+			Payload:   copyBytes(db.store.queueMessages[queueType][messageID]),
+		})
+	}
+	return result, nil
+}
+
+// Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
+func (db *memdb) SelectMessagesBetween(
+	ctx context.Context,
+	request nosqlplugin.SelectMessagesBetweenRequest,
+) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
+	defer db.lock()()
+
+	exclusiveBeginMessageID := request.ExclusiveBeginMessageID
+	var lastMessageID int64
+	hasToken, err := decodePageToken(request.NextPageToken, &lastMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if hasToken && lastMessageID > exclusiveBeginMessageID {
+		exclusiveBeginMessageID = lastMessageID
+	}
+
+	messageIDs := db.selectMessageIDs(request.QueueType, exclusiveBeginMessageID, request.InclusiveEndMessageID, request.PageSize)
+	var rows []nosqlplugin.QueueMessageRow
+	for _, messageID := range messageIDs {
+		rows = append(rows, nosqlplugin.QueueMessageRow{
+			QueueType: request.QueueType,
+			ID:        messageID,
+			Payload:   copyBytes(db.store.queueMessages[request.QueueType][messageID]),
+		})
+	}
+
+	var nextPageToken []byte
+	if request.PageSize > 0 && len(messageIDs) == request.PageSize {
+		nextPageToken, err = encodePageToken(messageIDs[len(messageIDs)-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// selectMessageIDs returns the sorted message IDs within (exclusiveBeginMessageID, inclusiveEndMessageID],
+// it must be called with the store locked
+func (db *memdb) selectMessageIDs(
+	queueType persistence.QueueType,
+	exclusiveBeginMessageID int64,
+	inclusiveEndMessageID int64,
+	limit int,
+) []int64 {
+	messageIDs := sortedTaskIDs(db.store.queueMessages[queueType], exclusiveBeginMessageID, inclusiveEndMessageID)
+	if limit > 0 && len(messageIDs) > limit {
+		messageIDs = messageIDs[:limit]
+	}
+	return messageIDs
+}
+
+// Delete all messages before exclusiveBeginMessageID
+func (db *memdb) DeleteMessagesBefore(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	exclusiveBeginMessageID int64,
+) error {
+	defer db.lock()()
+
+	messages := db.store.queueMessages[queueType]
+	for messageID := range messages {
+		if messageID < exclusiveBeginMessageID {
+			delete(messages, messageID)
+		}
+	}
+	return nil
+}
+
+// Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
+func (db *memdb) DeleteMessagesInRange(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	exclusiveBeginMessageID int64,
+	inclusiveEndMessageID int64,
+) error {
+	defer db.lock()()
+
+	messages := db.store.queueMessages[queueType]
+	for messageID := range messages {
+		if messageID > exclusiveBeginMessageID && messageID <= inclusiveEndMessageID {
+			delete(messages, messageID)
+		}
+	}
+	return nil
+}
+
+// Delete one message
+func (db *memdb) DeleteMessage(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	messageID int64,
+) error {
+	defer db.lock()()
+
+	delete(db.store.queueMessages[queueType], messageID)
+	return nil
+}
+
+// Insert an empty metadata row, starting from a version
+func (db *memdb) InsertQueueMetadata(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	version int64,
+) error {
+	defer db.lock()()
+
+	if _, ok := db.store.queueMetadata[queueType]; ok {
+		// it's ok if the metadata exists already
+		return nil
+	}
+	db.store.queueMetadata[queueType] = &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: map[string]int64{},
+		Version:          version,
+	}
+	return nil
+}
+
+// **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
+// then the current version will increase by one when updating the metadata row
+// it should return ConditionFailure if the condition is not met
+func (db *memdb) UpdateQueueMetadataCas(
+	ctx context.Context,
+	row nosqlplugin.QueueMetadataRow,
+) error {
+	defer db.lock()()
+
+	metadata, ok := db.store.queueMetadata[row.QueueType]
+	if !ok || metadata.Version != row.Version-1 {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	db.store.queueMetadata[row.QueueType] = &nosqlplugin.QueueMetadataRow{
+		QueueType:        row.QueueType,
+		ClusterAckLevels: copyAckLevels(row.ClusterAckLevels),
+		Version:          row.Version,
+	}
+	return nil
+}
+
+// Read a QueueMetadata
+func (db *memdb) SelectQueueMetadata(
+	ctx context.Context,
+	queueType persistence.QueueType,
+) (*nosqlplugin.QueueMetadataRow, error) {
+	defer db.lock()()
+
+	metadata, ok := db.store.queueMetadata[queueType]
+	if !ok {
+		return nil, errNotFound
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: copyAckLevels(metadata.ClusterAckLevels),
+		Version:          metadata.Version,
+	}, nil
+}
+
+func (db *memdb) GetQueueSize(
+	ctx context.Context,
+	queueType persistence.QueueType,
+) (int64, error) {
+	defer db.lock()()
+
+	return int64(len(db.store.queueMessages[queueType])), nil
+}
+
+func copyAckLevels(ackLevels map[string]int64) map[string]int64 {
+	result := make(map[string]int64, len(ackLevels))
+	for cluster, ackLevel := range ackLevels {
+		result[cluster] = ackLevel
+	}
+	return result
+}
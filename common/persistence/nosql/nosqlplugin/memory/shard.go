@@ -0,0 +1,152 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type shardEntry struct {
+	rangeID int64
+	data    []byte
+}
+
+// InsertShard creates a new shard, return error is there is any.
+// Return ShardOperationConditionFailure if the condition doesn't meet
+func (db *memdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
+	defer db.lock()()
+
+	if entry, ok := db.store.shards[row.ShardID]; ok {
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: entry.rangeID,
+			Details: "InsertShard failed because shard already exists",
+		}
+	}
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	db.store.shards[row.ShardID] = &shardEntry{
+		rangeID: row.RangeID,
+		data:    data,
+	}
+	return nil
+}
+
+// SelectShard gets a shard
+func (db *memdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
+	defer db.lock()()
+
+	entry, ok := db.store.shards[shardID]
+	if !ok {
+		return 0, nil, errNotFound
+	}
+	var row nosqlplugin.ShardRow
+	if err := json.Unmarshal(entry.data, &row); err != nil {
+		return 0, nil, err
+	}
+	if row.ClusterTransferAckLevel == nil {
+		row.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: row.TransferAckLevel,
+		}
+	}
+	if row.ClusterTimerAckLevel == nil {
+		row.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: row.TimerAckLevel,
+		}
+	}
+	if row.ClusterReplicationLevel == nil {
+		row.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if row.ReplicationDLQAckLevel == nil {
+		row.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return entry.rangeID, &row, nil
+}
+
+// UpdateRangeID updates the rangeID, return error is there is any
+// Return ShardOperationConditionFailure if the condition doesn't meet
+func (db *memdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
+	defer db.lock()()
+
+	entry, err := db.checkShardRangeID(shardID, previousRangeID, "UpdateRangeID")
+	if err != nil {
+		return err
+	}
+	entry.rangeID = rangeID
+	return nil
+}
+
+// UpdateShard updates a shard, return error is there is any.
+// Return ShardOperationConditionFailure if the condition doesn't meet
+func (db *memdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
+	defer db.lock()()
+
+	entry, err := db.checkShardRangeID(row.ShardID, previousRangeID, "UpdateShard")
+	if err != nil {
+		return err
+	}
+	shardRow := *row
+	shardRow.UpdatedAt = time.Now()
+	data, err := json.Marshal(&shardRow)
+	if err != nil {
+		return err
+	}
+	entry.rangeID = row.RangeID
+	entry.data = data
+	return nil
+}
+
+// checkShardRangeID returns the shard entry if its rangeID matches, it must be called with the store locked
+func (db *memdb) checkShardRangeID(shardID int, previousRangeID int64, operation string) (*shardEntry, error) {
+	entry, ok := db.store.shards[shardID]
+	if !ok || entry.rangeID != previousRangeID {
+		var rangeID int64
+		if ok {
+			rangeID = entry.rangeID
+		}
+		return nil, &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: rangeID,
+			Details: fmt.Sprintf("%v failed, previous rangeID: %v", operation, previousRangeID),
+		}
+	}
+	return entry, nil
+}
+
+// assertShardRangeID checks the rangeID of the shard for the workflow operations, it must be called with the store locked
+func (db *memdb) assertShardRangeID(shardID int, rangeID int64) error {
+	entry, ok := db.store.shards[shardID]
+	if !ok || entry.rangeID != rangeID {
+		var actualRangeID int64
+		if ok {
+			actualRangeID = entry.rangeID
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: &actualRangeID,
+		}
+	}
+	return nil
+}
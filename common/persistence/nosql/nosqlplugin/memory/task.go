@@ -0,0 +1,324 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type (
+	taskListEntry struct {
+		row      nosqlplugin.TaskListRow
+		expireAt *time.Time
+	}
+
+	taskEntry struct {
+		row      nosqlplugin.TaskRow
+		expireAt *time.Time
+	}
+
+	taskListPageToken = nosqlplugin.TaskListFilter
+)
+
+// SelectTaskList returns a single tasklist row.
+// Return IsNotFoundError if the row doesn't exist
+func (db *memdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
+	defer db.lock()()
+
+	entry, ok := db.getTaskList(*filter, time.Now())
+	if !ok {
+		return nil, errNotFound
+	}
+	row := entry.row
+	return &row, nil
+}
+
+// InsertTaskList insert a single tasklist row
+// Return TaskOperationConditionFailure if the row already exists
+func (db *memdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
+	defer db.lock()()
+
+	key := taskListKey(row)
+	if entry, ok := db.getTaskList(key, time.Now()); ok {
+		return &nosqlplugin.TaskOperationConditionFailure{
+			RangeID: entry.row.RangeID,
+			Details: "InsertTaskList failed because tasklist already exists",
+		}
+	}
+	db.store.taskLists[key] = &taskListEntry{row: *row}
+	return nil
+}
+
+// UpdateTaskList updates a single tasklist row
+// Return TaskOperationConditionFailure if the condition doesn't meet
+func (db *memdb) UpdateTaskList(
+	ctx context.Context,
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+) error {
+	return db.updateTaskList(row, previousRangeID, 0)
+}
+
+// UpdateTaskList updates a single tasklist row, and set an TTL on the record
+// Return TaskOperationConditionFailure if the condition doesn't meet
+// Ignore TTL if it's not supported, which becomes exactly the same as UpdateTaskList, but ListTaskList must be
+// implemented for TaskListScavenger
+func (db *memdb) UpdateTaskListWithTTL(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+) error {
+	return db.updateTaskList(row, previousRangeID, ttlSeconds)
+}
+
+func (db *memdb) updateTaskList(
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+	ttlSeconds int64,
+) error {
+	defer db.lock()()
+
+	now := time.Now()
+	key := taskListKey(row)
+	if err := db.checkTaskListRangeID(key, previousRangeID, now, "UpdateTaskList"); err != nil {
+		return err
+	}
+	db.store.taskLists[key] = &taskListEntry{
+		row:      *row,
+		expireAt: newExpireAt(ttlSeconds, now),
+	}
+	return nil
+}
+
+// ListTaskList returns all tasklists.
+// Noop if TTL is already implemented in other methods
+func (db *memdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
+	var token taskListPageToken
+	hasToken, err := decodePageToken(nextPageToken, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.lock()()
+
+	now := time.Now()
+	var keys []nosqlplugin.TaskListFilter
+	for key, entry := range db.store.taskLists {
+		if isExpired(entry.expireAt, now) {
+			continue
+		}
+		if hasToken && !lessTaskListKey(token, key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessTaskListKey(keys[i], keys[j]) })
+	if pageSize > 0 && len(keys) > pageSize {
+		keys = keys[:pageSize]
+	}
+
+	result := &nosqlplugin.ListTaskListResult{}
+	for _, key := range keys {
+		row := db.store.taskLists[key].row
+		result.TaskLists = append(result.TaskLists, &row)
+	}
+	if pageSize > 0 && len(keys) == pageSize {
+		result.NextPageToken, err = encodePageToken(keys[len(keys)-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// DeleteTaskList deletes a single tasklist row
+// Return TaskOperationConditionFailure if the condition doesn't meet
+func (db *memdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
+	defer db.lock()()
+
+	if err := db.checkTaskListRangeID(*filter, previousRangeID, time.Now(), "DeleteTaskList"); err != nil {
+		return err
+	}
+	delete(db.store.taskLists, *filter)
+	return nil
+}
+
+// InsertTasks inserts a batch of tasks
+// Return TaskOperationConditionFailure if the condition doesn't meet
+func (db *memdb) InsertTasks(
+	ctx context.Context,
+	tasksToInsert []*nosqlplugin.TaskRowForInsert,
+	tasklistCondition *nosqlplugin.TaskListRow,
+) error {
+	defer db.lock()()
+
+	now := time.Now()
+	key := taskListKey(tasklistCondition)
+	if err := db.checkTaskListRangeID(key, tasklistCondition.RangeID, now, "InsertTasks"); err != nil {
+		return err
+	}
+
+	tasks, ok := db.store.tasks[key]
+	if !ok {
+		tasks = make(map[int64]*taskEntry)
+		db.store.tasks[key] = tasks
+	}
+	for _, task := range tasksToInsert {
+		row := task.TaskRow
+		row.DomainID = key.DomainID
+		row.TaskListName = key.TaskListName
+		row.TaskListType = key.TaskListType
+		tasks[task.TaskID] = &taskEntry{
+			row:      row,
+			expireAt: newExpireAt(int64(task.TTLSeconds), now),
+		}
+	}
+	return nil
+}
+
+// SelectTasks return tasks that associated to a tasklist
+func (db *memdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
+	defer db.lock()()
+
+	tasks := db.store.tasks[filter.TaskListFilter]
+	taskIDs := db.selectTaskIDs(tasks, filter, time.Now())
+
+	var response []*nosqlplugin.TaskRow
+	for _, taskID := range taskIDs {
+		row := tasks[taskID].row
+		response = append(response, &row)
+	}
+	return response, nil
+}
+
+// DeleteTask delete a batch tasks that taskIDs less than the row
+// If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
+func (db *memdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
+	defer db.lock()()
+
+	tasks := db.store.tasks[filter.TaskListFilter]
+	taskIDs := db.selectTaskIDs(tasks, filter, time.Now())
+	for _, taskID := range taskIDs {
+		delete(tasks, taskID)
+	}
+	return len(taskIDs), nil
+}
+
+// SelectOrphanTasks returns tasks that belong to a tasklist no longer present, up to the limit
+func (db *memdb) SelectOrphanTasks(ctx context.Context, limit int) ([]*nosqlplugin.TaskRow, error) {
+	defer db.lock()()
+
+	now := time.Now()
+	var keys []nosqlplugin.TaskListFilter
+	for key := range db.store.tasks {
+		if _, ok := db.getTaskList(key, now); !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessTaskListKey(keys[i], keys[j]) })
+
+	var response []*nosqlplugin.TaskRow
+	for _, key := range keys {
+		tasks := db.store.tasks[key]
+		for _, taskID := range db.selectTaskIDs(tasks, &nosqlplugin.TasksFilter{MinTaskID: math.MinInt64, MaxTaskID: math.MaxInt64}, now) {
+			if limit > 0 && len(response) >= limit {
+				return response, nil
+			}
+			row := tasks[taskID].row
+			response = append(response, &row)
+		}
+	}
+	return response, nil
+}
+
+// selectTaskIDs returns the sorted IDs of the tasks that are not expired within the range of the filter.
+// Expired tasks are removed from the table. It must be called with the store locked.
+func (db *memdb) selectTaskIDs(tasks map[int64]*taskEntry, filter *nosqlplugin.TasksFilter, now time.Time) []int64 {
+	var taskIDs []int64
+	for taskID, entry := range tasks {
+		if isExpired(entry.expireAt, now) {
+			delete(tasks, taskID)
+			continue
+		}
+		if taskID > filter.MinTaskID && taskID <= filter.MaxTaskID {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+	if filter.BatchSize > 0 && len(taskIDs) > filter.BatchSize {
+		taskIDs = taskIDs[:filter.BatchSize]
+	}
+	return taskIDs
+}
+
+// getTaskList returns the tasklist if it exists and is not expired, it must be called with the store locked
+func (db *memdb) getTaskList(key nosqlplugin.TaskListFilter, now time.Time) (*taskListEntry, bool) {
+	entry, ok := db.store.taskLists[key]
+	if !ok {
+		return nil, false
+	}
+	if isExpired(entry.expireAt, now) {
+		delete(db.store.taskLists, key)
+		return nil, false
+	}
+	return entry, true
+}
+
+// checkTaskListRangeID checks the rangeID of the tasklist, it must be called with the store locked
+func (db *memdb) checkTaskListRangeID(key nosqlplugin.TaskListFilter, previousRangeID int64, now time.Time, operation string) error {
+	entry, ok := db.getTaskList(key, now)
+	if !ok || entry.row.RangeID != previousRangeID {
+		var rangeID int64
+		if ok {
+			rangeID = entry.row.RangeID
+		}
+		return &nosqlplugin.TaskOperationConditionFailure{
+			RangeID: rangeID,
+			Details: fmt.Sprintf("%v failed, previous rangeID: %v", operation, previousRangeID),
+		}
+	}
+	return nil
+}
+
+func taskListKey(row *nosqlplugin.TaskListRow) nosqlplugin.TaskListFilter {
+	return nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+}
+
+func lessTaskListKey(a, b nosqlplugin.TaskListFilter) bool {
+	if a.DomainID != b.DomainID {
+		return a.DomainID < b.DomainID
+	}
+	if a.TaskListName != b.TaskListName {
+		return a.TaskListName < b.TaskListName
+	}
+	return a.TaskListType < b.TaskListType
+}
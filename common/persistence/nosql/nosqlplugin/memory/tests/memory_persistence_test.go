@@ -0,0 +1,113 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/memory"
+	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
+)
+
+func TestMemoryConfigStorePersistence(t *testing.T) {
+	s := new(persistencetests.ConfigStorePersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+// historyV2PersistenceSuite skips the test that needs the writers to run concurrently
+type historyV2PersistenceSuite struct {
+	persistencetests.HistoryV2PersistenceSuite
+}
+
+// TestConcurrentlyForkAndAppendBranches is skipped because the in-memory plugin serializes the writers
+// behind a single lock, so the forks don't run concurrently with the branch deletion
+func (s *historyV2PersistenceSuite) TestConcurrentlyForkAndAppendBranches() {
+	s.T().Skip("the in-memory plugin serializes the writers, so the forks don't run concurrently with the branch deletion")
+}
+
+func TestMemoryHistoryPersistence(t *testing.T) {
+	s := new(historyV2PersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryMatchingPersistence(t *testing.T) {
+	s := new(persistencetests.MatchingPersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryDomainPersistence(t *testing.T) {
+	s := new(persistencetests.MetadataPersistenceSuiteV2)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryQueuePersistence(t *testing.T) {
+	s := new(persistencetests.QueuePersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryShardPersistence(t *testing.T) {
+	s := new(persistencetests.ShardPersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryVisibilityPersistence(t *testing.T) {
+	s := new(persistencetests.DBVisibilityPersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryExecutionManager(t *testing.T) {
+	s := new(persistencetests.ExecutionManagerSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryExecutionManagerWithEventsV2(t *testing.T) {
+	s := new(persistencetests.ExecutionManagerSuiteForEventsV2)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+// NewTestBaseWithMemory returns a persistence test base backed by the in-memory plugin,
+// which doesn't require any external database process
+func NewTestBaseWithMemory() persistencetests.TestBase {
+	options := &persistencetests.TestBaseOptions{
+		DBPluginName: memory.PluginName,
+	}
+	return persistencetests.NewTestBaseWithNoSQL(options)
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type (
+	visibilityKey struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+		// open and closed records are kept apart, mirroring the separate
+		// open and closed tables of the cassandra schema
+		Closed bool
+	}
+
+	visibilityEntry struct {
+		workflowType string
+		startTime    time.Time
+		closeTime    time.Time
+		closeStatus  int32
+		expireAt     *time.Time
+		data         []byte
+	}
+
+	visibilityPageToken struct {
+		Time  int64
+		RunID string
+	}
+)
+
+func (db *memdb) InsertVisibility(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.VisibilityRowForInsert,
+) error {
+	entry, err := newVisibilityEntry(&row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+
+	defer db.lock()()
+
+	db.store.visibility[newVisibilityKey(row.DomainID, &row.VisibilityRow, false)] = entry
+	return nil
+}
+
+func (db *memdb) UpdateVisibility(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.VisibilityRowForUpdate,
+) error {
+	closed := !row.UpdateCloseToOpen
+	entry, err := newVisibilityEntry(&row.VisibilityRow, closed, ttlSeconds)
+	if err != nil {
+		return err
+	}
+
+	defer db.lock()()
+
+	if row.UpdateOpenToClose || row.UpdateCloseToOpen {
+		delete(db.store.visibility, newVisibilityKey(row.DomainID, &row.VisibilityRow, !closed))
+	}
+	db.store.visibility[newVisibilityKey(row.DomainID, &row.VisibilityRow, closed)] = entry
+	return nil
+}
+
+func newVisibilityKey(domainID string, row *nosqlplugin.VisibilityRow, closed bool) visibilityKey {
+	return visibilityKey{DomainID: domainID, WorkflowID: row.WorkflowID, RunID: row.RunID, Closed: closed}
+}
+
+func newVisibilityEntry(
+	row *nosqlplugin.VisibilityRow,
+	closed bool,
+	ttlSeconds int64,
+) (*visibilityEntry, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	entry := &visibilityEntry{
+		workflowType: row.TypeName,
+		startTime:    row.StartTime,
+		expireAt:     newExpireAt(ttlSeconds, time.Now()),
+		data:         data,
+	}
+	if closed {
+		entry.closeTime = row.CloseTime
+		if row.Status != nil {
+			entry.closeStatus = int32(*row.Status)
+		}
+	}
+	return entry, nil
+}
+
+func (db *memdb) SelectVisibility(
+	ctx context.Context,
+	filter *nosqlplugin.VisibilityFilter,
+) (*nosqlplugin.SelectVisibilityResponse, error) {
+	request := &filter.ListRequest
+	var match func(key visibilityKey, entry *visibilityEntry) bool
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen:
+		match = func(key visibilityKey, entry *visibilityEntry) bool { return !key.Closed }
+	case nosqlplugin.AllClosed:
+		match = func(key visibilityKey, entry *visibilityEntry) bool { return key.Closed }
+	case nosqlplugin.OpenByWorkflowType:
+		match = func(key visibilityKey, entry *visibilityEntry) bool {
+			return !key.Closed && entry.workflowType == filter.WorkflowType
+		}
+	case nosqlplugin.ClosedByWorkflowType:
+		match = func(key visibilityKey, entry *visibilityEntry) bool {
+			return key.Closed && entry.workflowType == filter.WorkflowType
+		}
+	case nosqlplugin.OpenByWorkflowID:
+		match = func(key visibilityKey, entry *visibilityEntry) bool {
+			return !key.Closed && key.WorkflowID == filter.WorkflowID
+		}
+	case nosqlplugin.ClosedByWorkflowID:
+		match = func(key visibilityKey, entry *visibilityEntry) bool {
+			return key.Closed && key.WorkflowID == filter.WorkflowID
+		}
+	case nosqlplugin.ClosedByClosedStatus:
+		match = func(key visibilityKey, entry *visibilityEntry) bool {
+			return key.Closed && entry.closeStatus == filter.CloseStatus
+		}
+	default:
+		return nil, fmt.Errorf("unknown visibility filter type: %v", filter.FilterType)
+	}
+
+	sortTime := func(entry *visibilityEntry) int64 { return entry.startTime.UnixNano() }
+	switch filter.SortType {
+	case nosqlplugin.SortByStartTime:
+	case nosqlplugin.SortByClosedTime:
+		sortTime = func(entry *visibilityEntry) int64 { return entry.closeTime.UnixNano() }
+	default:
+		return nil, fmt.Errorf("unknown visibility sort type: %v", filter.SortType)
+	}
+
+	var token visibilityPageToken
+	hasToken, err := decodePageToken(request.NextPageToken, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.lock()()
+
+	now := time.Now()
+	earliestTime := request.EarliestTime.UnixNano()
+	latestTime := request.LatestTime.UnixNano()
+	var keys []visibilityKey
+	for key, entry := range db.store.visibility {
+		if isExpired(entry.expireAt, now) {
+			delete(db.store.visibility, key)
+			continue
+		}
+		if key.DomainID != request.DomainUUID || !match(key, entry) {
+			continue
+		}
+		t := sortTime(entry)
+		if t < earliestTime || t > latestTime {
+			continue
+		}
+		// records are sorted by time DESC, runID DESC
+		if hasToken && (t > token.Time || (t == token.Time && key.RunID >= token.RunID)) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ti, tj := sortTime(db.store.visibility[keys[i]]), sortTime(db.store.visibility[keys[j]])
+		if ti != tj {
+			return ti > tj
+		}
+		return keys[i].RunID > keys[j].RunID
+	})
+	if request.PageSize > 0 && len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+	}
+
+	response := &nosqlplugin.SelectVisibilityResponse{}
+	for _, key := range keys {
+		row, err := toVisibilityRow(key, db.store.visibility[key])
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	if request.PageSize > 0 && len(keys) == request.PageSize {
+		last := keys[len(keys)-1]
+		response.NextPageToken, err = encodePageToken(visibilityPageToken{
+			Time:  sortTime(db.store.visibility[last]),
+			RunID: last.RunID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (db *memdb) DeleteVisibility(
+	ctx context.Context,
+	domainID, workflowID, runID string,
+) error {
+	defer db.lock()()
+
+	// callers may not know the workflowID, so records are matched by runID only
+	for key := range db.store.visibility {
+		if key.DomainID == domainID && key.RunID == runID {
+			delete(db.store.visibility, key)
+		}
+	}
+	return nil
+}
+
+func (db *memdb) SelectOneClosedWorkflow(
+	ctx context.Context,
+	domainID, workflowID, runID string,
+) (*nosqlplugin.VisibilityRow, error) {
+	defer db.lock()()
+
+	key := visibilityKey{DomainID: domainID, WorkflowID: workflowID, RunID: runID, Closed: true}
+	entry, ok := db.store.visibility[key]
+	if !ok || isExpired(entry.expireAt, time.Now()) {
+		return nil, nil
+	}
+	return toVisibilityRow(key, entry)
+}
+
+func toVisibilityRow(key visibilityKey, entry *visibilityEntry) (*nosqlplugin.VisibilityRow, error) {
+	var row nosqlplugin.VisibilityRow
+	if err := json.Unmarshal(entry.data, &row); err != nil {
+		return nil, err
+	}
+	row.DomainID = key.DomainID
+	if !key.Closed {
+		// open records don't have close status even if the data was written by an update
+		row.Status = nil
+	}
+	return &row, nil
+}
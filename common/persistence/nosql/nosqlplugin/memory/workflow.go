@@ -0,0 +1,575 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+var _ nosqlplugin.WorkflowCRUD = (*memdb)(nil)
+
+type (
+	currentWorkflowKey struct {
+		ShardID    int
+		DomainID   string
+		WorkflowID string
+	}
+
+	workflowExecutionKey struct {
+		ShardID    int
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	workflowExecutionEntry struct {
+		nextEventID      int64
+		lastWriteVersion int64
+		data             *workflowExecutionData
+	}
+
+	// workflowExecutionData is the data of a workflow_execution row, it is always stored and returned as a deep copy
+	workflowExecutionData struct {
+		ExecutionInfo       *persistence.InternalWorkflowExecutionInfo
+		VersionHistories    *persistence.DataBlob
+		Checksum            checksum.Checksum
+		LastWriteVersion    int64
+		ActivityInfos       map[int64]*persistence.InternalActivityInfo
+		TimerInfos          map[string]*persistence.TimerInfo
+		ChildExecutionInfos map[int64]*persistence.InternalChildExecutionInfo
+		RequestCancelInfos  map[int64]*persistence.RequestCancelInfo
+		SignalInfos         map[int64]*persistence.SignalInfo
+		SignalRequestedIDs  map[string]struct{}
+		BufferedEvents      []*persistence.DataBlob
+	}
+
+	clusterTaskKey struct {
+		ShardID int
+		Cluster string
+	}
+
+	timerTaskKey struct {
+		VisibilityTimestampNano int64
+		TaskID                  int64
+	}
+
+	currentWorkflowPageToken = currentWorkflowKey
+
+	workflowExecutionPageToken = workflowExecutionKey
+
+	timerTaskPageToken = timerTaskKey
+)
+
+func (db *memdb) InsertWorkflowExecutionWithTasks(
+	ctx context.Context,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	defer db.lock()()
+
+	// shard condition is checked first, as ShardRangeIDNotMatch takes priority over other condition failures
+	if err := db.assertShardRangeID(shardID, shardCondition.RangeID); err != nil {
+		return err
+	}
+	var batch writeBatch
+	if err := db.createOrUpdateCurrentWorkflow(&batch, shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if err := db.createWorkflowExecution(&batch, shardID, execution); err != nil {
+		return err
+	}
+	if err := db.createTasks(&batch, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks); err != nil {
+		return err
+	}
+	batch.apply()
+	return nil
+}
+
+func (db *memdb) UpdateWorkflowExecutionWithTasks(
+	ctx context.Context,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	mutatedExecution *nosqlplugin.WorkflowExecutionRequest,
+	insertedExecution *nosqlplugin.WorkflowExecutionRequest,
+	resetExecution *nosqlplugin.WorkflowExecutionRequest,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	defer db.lock()()
+
+	if err := db.assertShardRangeID(shardID, shardCondition.RangeID); err != nil {
+		return err
+	}
+	var batch writeBatch
+	if err := db.createOrUpdateCurrentWorkflow(&batch, shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if mutatedExecution != nil {
+		if err := db.updateWorkflowExecution(&batch, shardID, mutatedExecution); err != nil {
+			return err
+		}
+	}
+	if insertedExecution != nil {
+		if err := db.createWorkflowExecution(&batch, shardID, insertedExecution); err != nil {
+			return err
+		}
+	}
+	if resetExecution != nil {
+		if err := db.resetWorkflowExecution(&batch, shardID, resetExecution); err != nil {
+			return err
+		}
+	}
+	if err := db.createTasks(&batch, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks); err != nil {
+		return err
+	}
+	batch.apply()
+	return nil
+}
+
+func (db *memdb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
+	defer db.lock()()
+
+	row, ok := db.store.currentWorkflows[currentWorkflowKey{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID}]
+	if !ok {
+		return nil, errNotFound
+	}
+	return &row, nil
+}
+
+func (db *memdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
+	defer db.lock()()
+
+	entry, ok := db.store.workflowExecutions[workflowExecutionKey{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID, RunID: runID}]
+	if !ok {
+		return nil, errNotFound
+	}
+	data := copyWorkflowExecutionData(entry.data)
+	return &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       data.ExecutionInfo,
+		VersionHistories:    data.VersionHistories,
+		ActivityInfos:       data.ActivityInfos,
+		TimerInfos:          data.TimerInfos,
+		ChildExecutionInfos: data.ChildExecutionInfos,
+		RequestCancelInfos:  data.RequestCancelInfos,
+		SignalInfos:         data.SignalInfos,
+		SignalRequestedIDs:  data.SignalRequestedIDs,
+		BufferedEvents:      data.BufferedEvents,
+		Checksum:            data.Checksum,
+	}, nil
+}
+
+func (db *memdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
+	defer db.lock()()
+
+	key := currentWorkflowKey{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID}
+	if row, ok := db.store.currentWorkflows[key]; ok && row.RunID == currentRunIDCondition {
+		delete(db.store.currentWorkflows, key)
+	}
+	return nil
+}
+
+func (db *memdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
+	defer db.lock()()
+
+	delete(db.store.workflowExecutions, workflowExecutionKey{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID, RunID: runID})
+	return nil
+}
+
+func (db *memdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
+	var token currentWorkflowPageToken
+	hasToken, err := decodePageToken(pageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer db.lock()()
+
+	var keys []currentWorkflowKey
+	for key := range db.store.currentWorkflows {
+		if key.ShardID != shardID {
+			continue
+		}
+		if hasToken && !lessCurrentWorkflowKey(token, key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessCurrentWorkflowKey(keys[i], keys[j]) })
+	if pageSize > 0 && len(keys) > pageSize {
+		keys = keys[:pageSize]
+	}
+
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(keys))
+	for _, key := range keys {
+		row := db.store.currentWorkflows[key]
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     row.DomainID,
+			WorkflowID:   row.WorkflowID,
+			RunID:        row.RunID,
+			State:        row.State,
+			CurrentRunID: row.RunID,
+		})
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(keys) == pageSize {
+		nextPageToken, err = encodePageToken(keys[len(keys)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return executions, nextPageToken, nil
+}
+
+func (db *memdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
+	var token workflowExecutionPageToken
+	hasToken, err := decodePageToken(pageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer db.lock()()
+
+	var keys []workflowExecutionKey
+	for key := range db.store.workflowExecutions {
+		if key.ShardID != shardID {
+			continue
+		}
+		if hasToken && !lessWorkflowExecutionKey(token, key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessWorkflowExecutionKey(keys[i], keys[j]) })
+	if pageSize > 0 && len(keys) > pageSize {
+		keys = keys[:pageSize]
+	}
+
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(keys))
+	for _, key := range keys {
+		data := copyWorkflowExecutionData(db.store.workflowExecutions[key].data)
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(keys) == pageSize {
+		nextPageToken, err = encodePageToken(keys[len(keys)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return executions, nextPageToken, nil
+}
+
+func (db *memdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
+	defer db.lock()()
+
+	_, ok := db.store.workflowExecutions[workflowExecutionKey{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID, RunID: runID}]
+	return ok, nil
+}
+
+func (db *memdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
+	defer db.lock()()
+
+	entries, nextPageToken, err := selectTasksOrderByTaskID(
+		db.store.transferTasks[shardID], pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TransferTask, 0, len(entries))
+	for _, data := range entries {
+		task := &nosqlplugin.TransferTask{}
+		if err := decodeTransferTask(data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *memdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
+	defer db.lock()()
+
+	delete(db.store.transferTasks[shardID], taskID)
+	return nil
+}
+
+func (db *memdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	defer db.lock()()
+
+	deleteTasksInRange(db.store.transferTasks[shardID], exclusiveBeginTaskID, inclusiveEndTaskID)
+	return nil
+}
+
+func (db *memdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
+	var token timerTaskPageToken
+	hasToken, err := decodePageToken(pageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer db.lock()()
+
+	minTime := inclusiveMinTime.UnixNano()
+	maxTime := exclusiveMaxTime.UnixNano()
+	timerTasks := db.store.timerTasks[shardID]
+	keys := sortedTimerTaskKeys(timerTasks, func(key timerTaskKey) bool {
+		if key.VisibilityTimestampNano < minTime || key.VisibilityTimestampNano >= maxTime {
+			return false
+		}
+		return !hasToken || lessTimerTaskKey(token, key)
+	})
+	if pageSize > 0 && len(keys) > pageSize {
+		keys = keys[:pageSize]
+	}
+
+	tasks := make([]*nosqlplugin.TimerTask, 0, len(keys))
+	for _, key := range keys {
+		task := &nosqlplugin.TimerTask{}
+		if err := json.Unmarshal(timerTasks[key], task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(keys) == pageSize {
+		nextPageToken, err = encodePageToken(keys[len(keys)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *memdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	defer db.lock()()
+
+	delete(db.store.timerTasks[shardID], timerTaskKey{VisibilityTimestampNano: visibilityTimestamp.UnixNano(), TaskID: taskID})
+	return nil
+}
+
+func (db *memdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	defer db.lock()()
+
+	minTime := inclusiveMinTime.UnixNano()
+	maxTime := exclusiveMaxTime.UnixNano()
+	timerTasks := db.store.timerTasks[shardID]
+	for key := range timerTasks {
+		if key.VisibilityTimestampNano >= minTime && key.VisibilityTimestampNano < maxTime {
+			delete(timerTasks, key)
+		}
+	}
+	return nil
+}
+
+func (db *memdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	defer db.lock()()
+
+	entries, nextPageToken, err := selectTasksOrderByTaskID(
+		db.store.replicationTasks[shardID], pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := toReplicationTasks(entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *memdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
+	defer db.lock()()
+
+	delete(db.store.replicationTasks[shardID], taskID)
+	return nil
+}
+
+func (db *memdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
+	defer db.lock()()
+
+	deleteTasksInRange(db.store.replicationTasks[shardID], math.MinInt64, inclusiveEndTaskID)
+	return nil
+}
+
+func (db *memdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, condition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	defer db.lock()()
+
+	if err := db.assertShardRangeID(condition.ShardID, condition.RangeID); err != nil {
+		return err
+	}
+	var batch writeBatch
+	if err := db.createTasks(&batch, condition.ShardID, nil, nil, tasks, nil); err != nil {
+		return err
+	}
+	batch.apply()
+	return nil
+}
+
+func (db *memdb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
+	defer db.lock()()
+
+	entries, nextPageToken, err := selectTasksOrderByTaskID(
+		db.store.crossClusterTasks[clusterTaskKey{ShardID: shardID, Cluster: targetCluster}],
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.CrossClusterTask, 0, len(entries))
+	for _, data := range entries {
+		task := &nosqlplugin.CrossClusterTask{}
+		if err := decodeTransferTask(data, &task.TransferTask); err != nil {
+			return nil, nil, err
+		}
+		task.TargetCluster = targetCluster
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *memdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
+	defer db.lock()()
+
+	delete(db.store.crossClusterTasks[clusterTaskKey{ShardID: shardID, Cluster: targetCluster}], taskID)
+	return nil
+}
+
+func (db *memdb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	defer db.lock()()
+
+	deleteTasksInRange(db.store.crossClusterTasks[clusterTaskKey{ShardID: shardID, Cluster: targetCluster}], exclusiveBeginTaskID, inclusiveEndTaskID)
+	return nil
+}
+
+func (db *memdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
+	data, err := json.Marshal(&task)
+	if err != nil {
+		return err
+	}
+
+	defer db.lock()()
+
+	putClusterTask(db.store.replicationDLQTasks, clusterTaskKey{ShardID: shardID, Cluster: sourceCluster}, task.TaskID, data)
+	return nil
+}
+
+func (db *memdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	defer db.lock()()
+
+	entries, nextPageToken, err := selectTasksOrderByTaskID(
+		db.store.replicationDLQTasks[clusterTaskKey{ShardID: shardID, Cluster: sourceCluster}],
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := toReplicationTasks(entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *memdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
+	defer db.lock()()
+
+	return int64(len(db.store.replicationDLQTasks[clusterTaskKey{ShardID: shardID, Cluster: sourceCluster}])), nil
+}
+
+func (db *memdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
+	defer db.lock()()
+
+	delete(db.store.replicationDLQTasks[clusterTaskKey{ShardID: shardID, Cluster: sourceCluster}], taskID)
+	return nil
+}
+
+func (db *memdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	defer db.lock()()
+
+	deleteTasksInRange(db.store.replicationDLQTasks[clusterTaskKey{ShardID: shardID, Cluster: sourceCluster}], exclusiveBeginTaskID, inclusiveEndTaskID)
+	return nil
+}
+
+func lessCurrentWorkflowKey(a, b currentWorkflowKey) bool {
+	if a.DomainID != b.DomainID {
+		return a.DomainID < b.DomainID
+	}
+	return a.WorkflowID < b.WorkflowID
+}
+
+func lessWorkflowExecutionKey(a, b workflowExecutionKey) bool {
+	if a.DomainID != b.DomainID {
+		return a.DomainID < b.DomainID
+	}
+	if a.WorkflowID != b.WorkflowID {
+		return a.WorkflowID < b.WorkflowID
+	}
+	return a.RunID < b.RunID
+}
+
+func lessTimerTaskKey(a, b timerTaskKey) bool {
+	if a.VisibilityTimestampNano != b.VisibilityTimestampNano {
+		return a.VisibilityTimestampNano < b.VisibilityTimestampNano
+	}
+	return a.TaskID < b.TaskID
+}
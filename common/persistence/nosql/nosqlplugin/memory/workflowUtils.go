@@ -0,0 +1,575 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// createOrUpdateCurrentWorkflow checks the condition of the current_workflow record and adds the change to the batch,
+// it must be called with the store locked
+func (db *memdb) createOrUpdateCurrentWorkflow(
+	batch *writeBatch,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	key := currentWorkflowKey{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID}
+	row := request.Row
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	existing, exists := db.store.currentWorkflows[key]
+
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		if exists {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+				existing.WorkflowID, existing.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  existing.CreateRequestID,
+					RunID:            existing.RunID,
+					State:            existing.State,
+					CloseStatus:      existing.CloseStatus,
+					LastWriteVersion: existing.LastWriteVersion,
+				},
+			}
+		}
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		conditionMet := exists && existing.RunID == *request.Condition.CurrentRunID
+		if conditionMet && request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			conditionMet = existing.LastWriteVersion == *request.Condition.LastWriteVersion &&
+				existing.State == *request.Condition.State
+		}
+		if !conditionMet {
+			msg := fmt.Sprintf("Workflow execution condition failed by mismatch current workflow. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+				workflowID, request.Condition.GetCurrentRunID(), existing.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+
+	batch.add(func() {
+		db.store.currentWorkflows[key] = row
+	})
+	return nil
+}
+
+// createWorkflowExecution checks that the workflow execution doesn't exist and adds it to the batch,
+// it must be called with the store locked
+func (db *memdb) createWorkflowExecution(
+	batch *writeBatch,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	key := newWorkflowExecutionKey(shardID, execution)
+	if existing, ok := db.store.workflowExecutions[key]; ok {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+			execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: existing.lastWriteVersion,
+			},
+		}
+	}
+
+	data := newWorkflowExecutionData(execution)
+	mergeWorkflowExecutionMaps(data, execution)
+	db.putWorkflowExecution(batch, key, data)
+	return nil
+}
+
+// updateWorkflowExecution checks the next event ID condition and adds the merged workflow execution to the batch,
+// it must be called with the store locked
+func (db *memdb) updateWorkflowExecution(
+	batch *writeBatch,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	key := newWorkflowExecutionKey(shardID, execution)
+	previous, err := db.selectWorkflowExecutionForUpdate(key, execution)
+	if err != nil {
+		return err
+	}
+
+	data := newWorkflowExecutionData(execution)
+	data.ActivityInfos = previous.ActivityInfos
+	data.TimerInfos = previous.TimerInfos
+	data.ChildExecutionInfos = previous.ChildExecutionInfos
+	data.RequestCancelInfos = previous.RequestCancelInfos
+	data.SignalInfos = previous.SignalInfos
+	data.SignalRequestedIDs = previous.SignalRequestedIDs
+	mergeWorkflowExecutionMaps(data, execution)
+	deleteFromWorkflowExecutionMaps(data, execution)
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeNone:
+		data.BufferedEvents = previous.BufferedEvents
+	case nosqlplugin.EventBufferWriteModeAppend:
+		data.BufferedEvents = append(previous.BufferedEvents, execution.NewBufferedEventBatch)
+	case nosqlplugin.EventBufferWriteModeClear:
+		data.BufferedEvents = nil
+	default:
+		return fmt.Errorf("unknown event buffer write mode %v", execution.EventBufferWriteMode)
+	}
+
+	db.putWorkflowExecution(batch, key, data)
+	return nil
+}
+
+// resetWorkflowExecution checks the next event ID condition and adds the overridden workflow execution to the batch,
+// it must be called with the store locked
+func (db *memdb) resetWorkflowExecution(
+	batch *writeBatch,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+
+	key := newWorkflowExecutionKey(shardID, execution)
+	if _, err := db.selectWorkflowExecutionForUpdate(key, execution); err != nil {
+		return err
+	}
+
+	data := newWorkflowExecutionData(execution)
+	mergeWorkflowExecutionMaps(data, execution)
+	db.putWorkflowExecution(batch, key, data)
+	return nil
+}
+
+// selectWorkflowExecutionForUpdate reads the current execution data and checks the next event ID condition
+func (db *memdb) selectWorkflowExecutionForUpdate(
+	key workflowExecutionKey,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*workflowExecutionData, error) {
+	if execution.PreviousNextEventIDCondition == nil {
+		return nil, fmt.Errorf("PreviousNextEventIDCondition is required for updating workflow execution")
+	}
+	requestCondition := *execution.PreviousNextEventIDCondition
+
+	entry, ok := db.store.workflowExecutions[key]
+	if !ok {
+		msg := fmt.Sprintf("Failed to update mutable state. Workflow execution not found. WorkflowId: %v, RunId: %v, Request Condition: %v",
+			execution.WorkflowID, execution.RunID, requestCondition)
+		return nil, &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	if entry.nextEventID != requestCondition {
+		msg := fmt.Sprintf("Failed to update mutable state.  Request Condition: %v, Actual Value: %v",
+			requestCondition, entry.nextEventID)
+		return nil, &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	return copyWorkflowExecutionData(entry.data), nil
+}
+
+// putWorkflowExecution copies the execution data and adds the write to the batch
+func (db *memdb) putWorkflowExecution(
+	batch *writeBatch,
+	key workflowExecutionKey,
+	data *workflowExecutionData,
+) {
+	entry := &workflowExecutionEntry{
+		nextEventID:      data.ExecutionInfo.NextEventID,
+		lastWriteVersion: data.LastWriteVersion,
+		data:             copyWorkflowExecutionData(data),
+	}
+	batch.add(func() {
+		db.store.workflowExecutions[key] = entry
+	})
+}
+
+// createTasks adds the tasks to the batch, it must be called with the store locked
+func (db *memdb) createTasks(
+	batch *writeBatch,
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) error {
+	for _, task := range transferTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		taskID := task.TaskID
+		batch.add(func() {
+			putTask(db.store.transferTasks, shardID, taskID, data)
+		})
+	}
+
+	for _, task := range crossClusterTasks {
+		data, err := json.Marshal(&task.TransferTask)
+		if err != nil {
+			return err
+		}
+		key := clusterTaskKey{ShardID: shardID, Cluster: task.TargetCluster}
+		taskID := task.TaskID
+		batch.add(func() {
+			putClusterTask(db.store.crossClusterTasks, key, taskID, data)
+		})
+	}
+
+	for _, task := range replicationTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		taskID := task.TaskID
+		batch.add(func() {
+			putTask(db.store.replicationTasks, shardID, taskID, data)
+		})
+	}
+
+	for _, task := range timerTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		key := timerTaskKey{VisibilityTimestampNano: task.VisibilityTimestamp.UnixNano(), TaskID: task.TaskID}
+		batch.add(func() {
+			tasks, ok := db.store.timerTasks[shardID]
+			if !ok {
+				tasks = make(map[timerTaskKey][]byte)
+				db.store.timerTasks[shardID] = tasks
+			}
+			tasks[key] = data
+		})
+	}
+	return nil
+}
+
+func putTask(table map[int]map[int64][]byte, shardID int, taskID int64, data []byte) {
+	tasks, ok := table[shardID]
+	if !ok {
+		tasks = make(map[int64][]byte)
+		table[shardID] = tasks
+	}
+	tasks[taskID] = data
+}
+
+func putClusterTask(table map[clusterTaskKey]map[int64][]byte, key clusterTaskKey, taskID int64, data []byte) {
+	tasks, ok := table[key]
+	if !ok {
+		tasks = make(map[int64][]byte)
+		table[key] = tasks
+	}
+	tasks[taskID] = data
+}
+
+// selectTasksOrderByTaskID pages through a task table by taskID, it must be called with the store locked
+func selectTasksOrderByTaskID(
+	tasks map[int64][]byte,
+	pageSize int,
+	pageToken []byte,
+	exclusiveMinTaskID, inclusiveMaxTaskID int64,
+) ([][]byte, []byte, error) {
+	var lastTaskID int64
+	hasToken, err := decodePageToken(pageToken, &lastTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasToken && lastTaskID > exclusiveMinTaskID {
+		exclusiveMinTaskID = lastTaskID
+	}
+
+	taskIDs := sortedTaskIDs(tasks, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if pageSize > 0 && len(taskIDs) > pageSize {
+		taskIDs = taskIDs[:pageSize]
+	}
+	result := make([][]byte, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		result = append(result, tasks[taskID])
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(taskIDs) == pageSize {
+		nextPageToken, err = encodePageToken(taskIDs[len(taskIDs)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return result, nextPageToken, nil
+}
+
+func deleteTasksInRange(tasks map[int64][]byte, exclusiveBeginTaskID, inclusiveEndTaskID int64) {
+	for taskID := range tasks {
+		if taskID > exclusiveBeginTaskID && taskID <= inclusiveEndTaskID {
+			delete(tasks, taskID)
+		}
+	}
+}
+
+// decodeTransferTask decodes a transfer task row, the dummy target run ID is read back as empty like the cassandra plugin does
+func decodeTransferTask(data []byte, task *nosqlplugin.TransferTask) error {
+	if err := json.Unmarshal(data, task); err != nil {
+		return err
+	}
+	if task.TargetRunID == persistence.TransferTaskTransferTargetRunID {
+		task.TargetRunID = ""
+	}
+	return nil
+}
+
+func toReplicationTasks(entries [][]byte) ([]*nosqlplugin.ReplicationTask, error) {
+	tasks := make([]*nosqlplugin.ReplicationTask, 0, len(entries))
+	for _, data := range entries {
+		task := &nosqlplugin.ReplicationTask{}
+		if err := json.Unmarshal(data, task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func sortedTimerTaskKeys(tasks map[timerTaskKey][]byte, match func(key timerTaskKey) bool) []timerTaskKey {
+	keys := make([]timerTaskKey, 0)
+	for key := range tasks {
+		if match(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessTimerTaskKey(keys[i], keys[j]) })
+	return keys
+}
+
+func newWorkflowExecutionKey(shardID int, execution *nosqlplugin.WorkflowExecutionRequest) workflowExecutionKey {
+	return workflowExecutionKey{
+		ShardID:    shardID,
+		DomainID:   execution.DomainID,
+		WorkflowID: execution.WorkflowID,
+		RunID:      execution.RunID,
+	}
+}
+
+func newWorkflowExecutionData(execution *nosqlplugin.WorkflowExecutionRequest) *workflowExecutionData {
+	executionInfo := execution.InternalWorkflowExecutionInfo
+	data := &workflowExecutionData{
+		ExecutionInfo:       &executionInfo,
+		VersionHistories:    execution.VersionHistories,
+		LastWriteVersion:    execution.LastWriteVersion,
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+		SignalRequestedIDs:  make(map[string]struct{}),
+	}
+	if execution.Checksums != nil {
+		data.Checksum = *execution.Checksums
+	}
+	return data
+}
+
+// mergeWorkflowExecutionMaps upserts the entries of the request into the maps of the data
+func mergeWorkflowExecutionMaps(data *workflowExecutionData, execution *nosqlplugin.WorkflowExecutionRequest) {
+	for k, v := range execution.ActivityInfos {
+		data.ActivityInfos[k] = v
+	}
+	for k, v := range execution.TimerInfos {
+		data.TimerInfos[k] = v
+	}
+	for k, v := range execution.ChildWorkflowInfos {
+		data.ChildExecutionInfos[k] = v
+	}
+	for k, v := range execution.RequestCancelInfos {
+		data.RequestCancelInfos[k] = v
+	}
+	for k, v := range execution.SignalInfos {
+		data.SignalInfos[k] = v
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		data.SignalRequestedIDs[id] = struct{}{}
+	}
+}
+
+// deleteFromWorkflowExecutionMaps deletes the keys of the request from the maps of the data
+func deleteFromWorkflowExecutionMaps(data *workflowExecutionData, execution *nosqlplugin.WorkflowExecutionRequest) {
+	for _, k := range execution.ActivityInfoKeysToDelete {
+		delete(data.ActivityInfos, k)
+	}
+	for _, k := range execution.TimerInfoKeysToDelete {
+		delete(data.TimerInfos, k)
+	}
+	for _, k := range execution.ChildWorkflowInfoKeysToDelete {
+		delete(data.ChildExecutionInfos, k)
+	}
+	for _, k := range execution.RequestCancelInfoKeysToDelete {
+		delete(data.RequestCancelInfos, k)
+	}
+	for _, k := range execution.SignalInfoKeysToDelete {
+		delete(data.SignalInfos, k)
+	}
+	for _, k := range execution.SignalRequestedIDsKeysToDelete {
+		delete(data.SignalRequestedIDs, k)
+	}
+}
+
+// copyWorkflowExecutionData returns a deep copy of the execution data, the maps of the copy are never nil
+func copyWorkflowExecutionData(data *workflowExecutionData) *workflowExecutionData {
+	executionInfo := copyExecutionInfo(data.ExecutionInfo)
+	result := &workflowExecutionData{
+		ExecutionInfo:       executionInfo,
+		VersionHistories:    copyDataBlob(data.VersionHistories),
+		Checksum:            data.Checksum,
+		LastWriteVersion:    data.LastWriteVersion,
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo, len(data.ActivityInfos)),
+		TimerInfos:          make(map[string]*persistence.TimerInfo, len(data.TimerInfos)),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo, len(data.ChildExecutionInfos)),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo, len(data.RequestCancelInfos)),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo, len(data.SignalInfos)),
+		SignalRequestedIDs:  make(map[string]struct{}, len(data.SignalRequestedIDs)),
+	}
+	result.Checksum.Value = copyBytes(data.Checksum.Value)
+	for k, v := range data.ActivityInfos {
+		info := *v
+		info.ScheduledEvent = copyDataBlob(v.ScheduledEvent)
+		info.StartedEvent = copyDataBlob(v.StartedEvent)
+		info.Details = copyBytes(v.Details)
+		info.NonRetriableErrors = copyStrings(v.NonRetriableErrors)
+		info.LastFailureDetails = copyBytes(v.LastFailureDetails)
+		result.ActivityInfos[k] = &info
+	}
+	for k, v := range data.TimerInfos {
+		info := *v
+		result.TimerInfos[k] = &info
+	}
+	for k, v := range data.ChildExecutionInfos {
+		info := *v
+		info.InitiatedEvent = copyDataBlob(v.InitiatedEvent)
+		info.StartedEvent = copyDataBlob(v.StartedEvent)
+		result.ChildExecutionInfos[k] = &info
+	}
+	for k, v := range data.RequestCancelInfos {
+		info := *v
+		result.RequestCancelInfos[k] = &info
+	}
+	for k, v := range data.SignalInfos {
+		info := *v
+		info.Input = copyBytes(v.Input)
+		info.Control = copyBytes(v.Control)
+		result.SignalInfos[k] = &info
+	}
+	for k := range data.SignalRequestedIDs {
+		result.SignalRequestedIDs[k] = struct{}{}
+	}
+	for _, batch := range data.BufferedEvents {
+		if blob := copyDataBlob(batch); blob != nil {
+			result.BufferedEvents = append(result.BufferedEvents, blob)
+		}
+	}
+	return result
+}
+
+func copyExecutionInfo(info *persistence.InternalWorkflowExecutionInfo) *persistence.InternalWorkflowExecutionInfo {
+	result := *info
+	result.CompletionEvent = copyDataBlob(info.CompletionEvent)
+	result.ExecutionContext = copyBytes(info.ExecutionContext)
+	result.PendingUpdateIDs = copyInt64Map(info.PendingUpdateIDs)
+	result.CompletedUpdateIDs = copyInt64Map(info.CompletedUpdateIDs)
+	result.AutoResetPoints = copyDataBlob(info.AutoResetPoints)
+	result.NonRetriableErrors = copyStrings(info.NonRetriableErrors)
+	result.BranchToken = copyBytes(info.BranchToken)
+	result.Memo = copyBytesMap(info.Memo)
+	result.SearchAttributes = copyBytesMap(info.SearchAttributes)
+	return &result
+}
+
+// copyDataBlob returns nil for an empty blob, like persistence.NewDataBlob does when other plugins read the blob columns
+func copyDataBlob(blob *persistence.DataBlob) *persistence.DataBlob {
+	if blob == nil || len(blob.Data) == 0 {
+		return nil
+	}
+	return &persistence.DataBlob{
+		Encoding: blob.Encoding,
+		Data:     copyBytes(blob.Data),
+	}
+}
+
+func copyStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append([]string{}, s...)
+}
+
+func copyInt64Map(m map[string]int64) map[string]int64 {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]int64, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+func copyBytesMap(m map[string][]byte) map[string][]byte {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string][]byte, len(m))
+	for k, v := range m {
+		result[k] = copyBytes(v)
+	}
+	return result
+}
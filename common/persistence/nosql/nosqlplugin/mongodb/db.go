@@ -22,6 +22,7 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
 
 	"go.mongodb.org/mongo-driver/mongo"
 
@@ -47,3 +48,44 @@ func (db *mdb) Close() {
 func (db *mdb) PluginName() string {
 	return PluginName
 }
+
+// executeTransaction runs fn within a MongoDB transaction. Conditional writes of all the CRUD interfaces
+// are implemented by reading and writing the condition documents within the same transaction.
+// Concurrent transactions that write the same documents fail with write conflicts and are retried by the driver,
+// so the conditions are always checked against the latest committed data.
+// NOTE: transactions require MongoDB to be deployed as a replica set (a single node replica set works).
+func (db *mdb) executeTransaction(
+	ctx context.Context,
+	fn func(sessCtx mongo.SessionContext) error,
+) error {
+	session, err := db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return err
+}
+
+func (db *mdb) collection(name string) *mongo.Collection {
+	return db.dbConn.Collection(name)
+}
+
+// encodePageToken serializes the last read keys of a page, so that the next page can be queried from it
+func encodePageToken(token interface{}) ([]byte, error) {
+	return json.Marshal(token)
+}
+
+// decodePageToken deserializes a page token, return false if the token is empty(the first page)
+func decodePageToken(pageToken []byte, token interface{}) (bool, error) {
+	if len(pageToken) == 0 {
+		return false, nil
+	}
+	if err := json.Unmarshal(pageToken, token); err != nil {
+		return false, err
+	}
+	return true, nil
+}
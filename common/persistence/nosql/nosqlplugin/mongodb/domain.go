@@ -22,8 +22,18 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // Insert a new record to domain, return error if failed or already exists
@@ -32,7 +42,47 @@ func (db *mdb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		domains := db.collection(cadence.DomainCollectionName)
+
+		count, err := domains.CountDocuments(sessCtx, bson.D{{"name", row.Info.Name}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+			}
+		}
+		count, err = domains.CountDocuments(sessCtx, bson.D{{"_id", row.Info.ID}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+		}
+
+		metadataNotificationVersion, err := db.SelectDomainMetadata(sessCtx)
+		if err != nil {
+			return err
+		}
+
+		// same as Cassandra, new domain is inserted with the current notification version
+		// and the initial failover versions
+		insertRow := *row
+		insertRow.NotificationVersion = metadataNotificationVersion
+		insertRow.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+		insertRow.PreviousFailoverVersion = common.InitialPreviousFailoverVersion
+		entry, err := newDomainCollectionEntry(&insertRow)
+		if err != nil {
+			return err
+		}
+		if _, err := domains.InsertOne(sessCtx, entry); err != nil {
+			return err
+		}
+
+		return db.updateDomainMetadata(sessCtx, metadataNotificationVersion)
+	})
 }
 
 // Update domain
@@ -40,7 +90,50 @@ func (db *mdb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		if err := db.updateDomainMetadata(sessCtx, row.NotificationVersion); err != nil {
+			return err
+		}
+
+		entry, err := newDomainCollectionEntry(row)
+		if err != nil {
+			return err
+		}
+		_, err = db.collection(cadence.DomainCollectionName).ReplaceOne(sessCtx, bson.D{{"name", row.Info.Name}}, entry)
+		return err
+	})
+}
+
+// updateDomainMetadata increases the notification version by one, if the current notification version is matched
+func (db *mdb) updateDomainMetadata(
+	sessCtx mongo.SessionContext,
+	notificationVersion int64,
+) error {
+	metadata := db.collection(cadence.DomainMetadataCollectionName)
+	if notificationVersion == 0 {
+		// the metadata document may not exist yet when the first domain is created
+		_, err := metadata.InsertOne(sessCtx, cadence.DomainMetadataCollectionEntry{
+			ID:                  cadence.DomainMetadataDocumentID,
+			NotificationVersion: 1,
+		})
+		if mongo.IsDuplicateKeyError(err) {
+			return nosqlplugin.NewConditionFailure("domain")
+		}
+		return err
+	}
+
+	result, err := metadata.UpdateOne(
+		sessCtx,
+		bson.D{{"_id", cadence.DomainMetadataDocumentID}, {"notificationversion", notificationVersion}},
+		bson.D{{"$set", bson.D{{"notificationversion", notificationVersion + 1}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +142,22 @@ func (db *mdb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	var filter bson.D
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID != nil {
+		filter = bson.D{{"_id", *domainID}}
+	} else if domainName != nil {
+		filter = bson.D{{"name", *domainName}}
+	} else {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	var entry cadence.DomainCollectionEntry
+	if err := db.collection(cadence.DomainCollectionName).FindOne(ctx, filter).Decode(&entry); err != nil {
+		return nil, err
+	}
+	return toDomainRow(&entry)
 }
 
 // Get all domain data
@@ -58,7 +166,43 @@ func (db *mdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	var lastName string
+	filter := bson.D{}
+	hasToken, err := decodePageToken(pageToken, &lastName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasToken {
+		filter = bson.D{{"name", bson.D{{"$gt", lastName}}}}
+	}
+
+	queryOptions := options.Find().SetSort(bson.D{{"name", 1}}).SetLimit(int64(pageSize))
+	cursor, err := db.collection(cadence.DomainCollectionName).Find(ctx, filter, queryOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []cadence.DomainCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(entries))
+	for i := range entries {
+		row, err := toDomainRow(&entries[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(entries) == pageSize {
+		nextPageToken, err = encodePageToken(entries[len(entries)-1].Name)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +211,53 @@ func (db *mdb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	var filter bson.D
+	if domainID != nil {
+		filter = bson.D{{"_id", *domainID}}
+	} else if domainName != nil {
+		filter = bson.D{{"name", *domainName}}
+	} else {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+	_, err := db.collection(cadence.DomainCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	var entry cadence.DomainMetadataCollectionEntry
+	err := db.collection(cadence.DomainMetadataCollectionName).
+		FindOne(ctx, bson.D{{"_id", cadence.DomainMetadataDocumentID}}).
+		Decode(&entry)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata document doesn't exist until the first domain is created
+			return 0, nil
+		}
+		return -1, err
+	}
+	return entry.NotificationVersion, nil
+}
+
+func newDomainCollectionEntry(row *nosqlplugin.DomainRow) (*cadence.DomainCollectionEntry, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.DomainCollectionEntry{
+		ID:                  row.Info.ID,
+		Name:                row.Info.Name,
+		NotificationVersion: row.NotificationVersion,
+		Data:                data,
+	}, nil
+}
+
+func toDomainRow(entry *cadence.DomainCollectionEntry) (*nosqlplugin.DomainRow, error) {
+	var row nosqlplugin.DomainRow
+	if err := json.Unmarshal(entry.Data, &row); err != nil {
+		return nil, err
+	}
+	row.NotificationVersion = entry.NotificationVersion
+	return &row, nil
 }
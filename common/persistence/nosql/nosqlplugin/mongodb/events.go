@@ -22,31 +22,251 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+type (
+	historyNodePageToken struct {
+		NodeID int64
+		TxnID  int64
+	}
+
+	historyTreePageToken struct {
+		TreeID   string
+		BranchID string
+	}
 )
 
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *mdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	insert := func(ctx context.Context) error {
+		if treeRow != nil {
+			if err := db.upsertHistoryTree(ctx, treeRow); err != nil {
+				return err
+			}
+		}
+		if nodeRow != nil {
+			if err := db.upsertHistoryNode(ctx, nodeRow); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if treeRow != nil && nodeRow != nil {
+		return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+			return insert(sessCtx)
+		})
+	}
+	return insert(ctx)
+}
+
+func (db *mdb) upsertHistoryTree(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow) error {
+	ancestors, err := json.Marshal(treeRow.Ancestors)
+	if err != nil {
+		return err
+	}
+	entry := cadence.HistoryTreeCollectionEntry{
+		ShardID:             treeRow.ShardID,
+		TreeID:              treeRow.TreeID,
+		BranchID:            treeRow.BranchID,
+		Ancestors:           ancestors,
+		CreateTimestampNano: treeRow.CreateTimestamp.UnixNano(),
+		Info:                treeRow.Info,
+	}
+	_, err = db.collection(cadence.HistoryTreeCollectionName).ReplaceOne(
+		ctx,
+		bson.D{{"treeid", treeRow.TreeID}, {"branchid", treeRow.BranchID}},
+		entry,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+func (db *mdb) upsertHistoryNode(ctx context.Context, nodeRow *nosqlplugin.HistoryNodeRow) error {
+	var txnID int64
+	if nodeRow.TxnID != nil {
+		txnID = *nodeRow.TxnID
+	}
+	entry := cadence.HistoryNodeCollectionEntry{
+		ShardID:      nodeRow.ShardID,
+		TreeID:       nodeRow.TreeID,
+		BranchID:     nodeRow.BranchID,
+		NodeID:       nodeRow.NodeID,
+		TxnID:        txnID,
+		Data:         nodeRow.Data,
+		DataEncoding: nodeRow.DataEncoding,
+	}
+	_, err := db.collection(cadence.HistoryNodeCollectionName).ReplaceOne(
+		ctx,
+		bson.D{{"treeid", nodeRow.TreeID}, {"branchid", nodeRow.BranchID}, {"nodeid", nodeRow.NodeID}, {"txnid", txnID}},
+		entry,
+		options.Replace().SetUpsert(true),
+	)
+	return err
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *mdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	query := bson.D{
+		{"treeid", filter.TreeID},
+		{"branchid", filter.BranchID},
+		{"nodeid", bson.D{{"$gte", filter.MinNodeID}, {"$lt", filter.MaxNodeID}}},
+	}
+	var token historyNodePageToken
+	hasToken, err := decodePageToken(filter.NextPageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasToken {
+		// nodes are sorted by nodeID ASC, txnID DESC
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{"nodeid", bson.D{{"$gt", token.NodeID}}}},
+			bson.D{{"nodeid", token.NodeID}, {"txnid", bson.D{{"$lt", token.TxnID}}}},
+		}})
+	}
+
+	queryOptions := options.Find().
+		SetSort(bson.D{{"nodeid", 1}, {"txnid", -1}}).
+		SetLimit(int64(filter.PageSize))
+	cursor, err := db.collection(cadence.HistoryNodeCollectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []cadence.HistoryNodeCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(entries))
+	for _, entry := range entries {
+		txnID := entry.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			ShardID:      entry.ShardID,
+			TreeID:       entry.TreeID,
+			BranchID:     entry.BranchID,
+			NodeID:       entry.NodeID,
+			TxnID:        &txnID,
+			Data:         entry.Data,
+			DataEncoding: entry.DataEncoding,
+		})
+	}
+
+	var nextPageToken []byte
+	if filter.PageSize > 0 && len(entries) == filter.PageSize {
+		last := entries[len(entries)-1]
+		nextPageToken, err = encodePageToken(historyNodePageToken{NodeID: last.NodeID, TxnID: last.TxnID})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *mdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		treeQuery := bson.D{{"treeid", treeFilter.TreeID}}
+		if treeFilter.BranchID != nil {
+			treeQuery = append(treeQuery, bson.E{Key: "branchid", Value: *treeFilter.BranchID})
+		}
+		if _, err := db.collection(cadence.HistoryTreeCollectionName).DeleteMany(sessCtx, treeQuery); err != nil {
+			return err
+		}
+
+		for _, nodeFilter := range nodeFilters {
+			_, err := db.collection(cadence.HistoryNodeCollectionName).DeleteMany(sessCtx, bson.D{
+				{"treeid", nodeFilter.TreeID},
+				{"branchid", nodeFilter.BranchID},
+				{"nodeid", bson.D{{"$gte", nodeFilter.MinNodeID}}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *mdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	query := bson.D{}
+	var token historyTreePageToken
+	hasToken, err := decodePageToken(nextPageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasToken {
+		query = bson.D{{"$or", bson.A{
+			bson.D{{"treeid", bson.D{{"$gt", token.TreeID}}}},
+			bson.D{{"treeid", token.TreeID}, {"branchid", bson.D{{"$gt", token.BranchID}}}},
+		}}}
+	}
+
+	queryOptions := options.Find().
+		SetSort(bson.D{{"treeid", 1}, {"branchid", 1}}).
+		SetLimit(int64(pageSize))
+	rows, err := db.selectHistoryTrees(ctx, query, queryOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newPageToken []byte
+	if pageSize > 0 && len(rows) == pageSize {
+		last := rows[len(rows)-1]
+		newPageToken, err = encodePageToken(historyTreePageToken{TreeID: last.TreeID, BranchID: last.BranchID})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, newPageToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *mdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	query := bson.D{{"treeid", filter.TreeID}}
+	if filter.BranchID != nil {
+		query = append(query, bson.E{Key: "branchid", Value: *filter.BranchID})
+	}
+	return db.selectHistoryTrees(ctx, query, options.Find().SetSort(bson.D{{"branchid", 1}}))
+}
+
+func (db *mdb) selectHistoryTrees(ctx context.Context, query bson.D, queryOptions *options.FindOptions) ([]*nosqlplugin.HistoryTreeRow, error) {
+	cursor, err := db.collection(cadence.HistoryTreeCollectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	var entries []cadence.HistoryTreeCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(entries))
+	for _, entry := range entries {
+		row := &nosqlplugin.HistoryTreeRow{
+			ShardID:         entry.ShardID,
+			TreeID:          entry.TreeID,
+			BranchID:        entry.BranchID,
+			CreateTimestamp: time.Unix(0, entry.CreateTimestampNano),
+			Info:            entry.Info,
+		}
+		if err := json.Unmarshal(entry.Ancestors, &row.Ancestors); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
 }
@@ -22,10 +22,14 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // Insert message into queue, return error if failed or already exists
@@ -34,7 +38,15 @@ func (db *mdb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).InsertOne(ctx, cadence.QueueMessageCollectionEntry{
+		QueueType: int(row.QueueType),
+		MessageID: row.ID,
+		Payload:   row.Payload,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -42,7 +54,16 @@ func (db *mdb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	var entry cadence.QueueMessageCollectionEntry
+	err := db.collection(cadence.QueueMessageCollectionName).FindOne(
+		ctx,
+		bson.D{{"queuetype", int(queueType)}},
+		options.FindOne().SetSort(bson.D{{"messageid", -1}}),
+	).Decode(&entry)
+	if err != nil {
+		return 0, err
+	}
+	return entry.MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -52,7 +73,24 @@ func (db *mdb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	entries, err := db.selectMessages(
+		ctx,
+		bson.D{{"queuetype", int(queueType)}, {"messageid", bson.D{{"$gt", exclusiveBeginMessageID}}}},
+		maxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*nosqlplugin.QueueMessageRow
+	for _, entry := range entries {
+		result = append(result, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        entry.MessageID,
+			Payload:   entry.Payload,
+		})
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -60,7 +98,68 @@ func (db *mdb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	exclusiveBeginMessageID := request.ExclusiveBeginMessageID
+	var lastMessageID int64
+	hasToken, err := decodePageToken(request.NextPageToken, &lastMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if hasToken && lastMessageID > exclusiveBeginMessageID {
+		exclusiveBeginMessageID = lastMessageID
+	}
+
+	entries, err := db.selectMessages(
+		ctx,
+		bson.D{
+			{"queuetype", int(request.QueueType)},
+			{"messageid", bson.D{{"$gt", exclusiveBeginMessageID}, {"$lte", request.InclusiveEndMessageID}}},
+		},
+		request.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []nosqlplugin.QueueMessageRow
+	for _, entry := range entries {
+		rows = append(rows, nosqlplugin.QueueMessageRow{
+			QueueType: request.QueueType,
+			ID:        entry.MessageID,
+			Payload:   entry.Payload,
+		})
+	}
+
+	var nextPageToken []byte
+	if request.PageSize > 0 && len(entries) == request.PageSize {
+		nextPageToken, err = encodePageToken(entries[len(entries)-1].MessageID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (db *mdb) selectMessages(
+	ctx context.Context,
+	filter bson.D,
+	limit int,
+) ([]cadence.QueueMessageCollectionEntry, error) {
+	queryOptions := options.Find().SetSort(bson.D{{"messageid", 1}})
+	if limit > 0 {
+		queryOptions.SetLimit(int64(limit))
+	}
+	cursor, err := db.collection(cadence.QueueMessageCollectionName).Find(ctx, filter, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	var entries []cadence.QueueMessageCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -69,7 +168,11 @@ func (db *mdb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteMany(
+		ctx,
+		bson.D{{"queuetype", int(queueType)}, {"messageid", bson.D{{"$lt", exclusiveBeginMessageID}}}},
+	)
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -79,7 +182,14 @@ func (db *mdb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteMany(
+		ctx,
+		bson.D{
+			{"queuetype", int(queueType)},
+			{"messageid", bson.D{{"$gt", exclusiveBeginMessageID}, {"$lte", inclusiveEndMessageID}}},
+		},
+	)
+	return err
 }
 
 // Delete one message
@@ -88,7 +198,11 @@ func (db *mdb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteOne(
+		ctx,
+		bson.D{{"queuetype", int(queueType)}, {"messageid", messageID}},
+	)
+	return err
 }
 
 // Insert an empty metadata row, starting from a version
@@ -97,18 +211,37 @@ func (db *mdb) InsertQueueMetadata(
 	queueType persistence.QueueType,
 	version int64,
 ) error {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil
+	_, err := db.collection(cadence.QueueMetadataCollectionName).InsertOne(ctx, cadence.QueueMetadataCollectionEntry{
+		QueueType:        int(queueType),
+		ClusterAckLevels: map[string]int64{},
+		Version:          version,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// it's ok if the metadata exists already
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
 // then the current version will increase by one when updating the metadata row
-// Return ConditionFailure if the condition doesn't meet
+// it should return ConditionFailure if the condition is not met
 func (db *mdb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	result, err := db.collection(cadence.QueueMetadataCollectionName).UpdateOne(
+		ctx,
+		bson.D{{"_id", int(row.QueueType)}, {"version", row.Version - 1}},
+		bson.D{{"$set", bson.D{{"clusteracklevels", row.ClusterAckLevels}, {"version", row.Version}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return nil
 }
 
 // Read a QueueMetadata
@@ -116,13 +249,27 @@ func (db *mdb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil, nil
+	var entry cadence.QueueMetadataCollectionEntry
+	err := db.collection(cadence.QueueMetadataCollectionName).FindOne(ctx, bson.D{{"_id", int(queueType)}}).Decode(&entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// if record exist but ackLevels is empty, we initialize the map
+	ackLevels := entry.ClusterAckLevels
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          entry.Version,
+	}, nil
 }
 
 func (db *mdb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.QueueMessageCollectionName).CountDocuments(ctx, bson.D{{"queuetype", int(queueType)}})
 }
@@ -22,32 +22,148 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
-	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	log.Warn("not implemented...ignore the error for testing...")
-	return nil
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.ShardCollectionName).InsertOne(ctx, cadence.ShardCollectionEntry{
+		ShardID: row.ShardID,
+		RangeID: row.RangeID,
+		Data:    data,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return db.getConflictedShardRow(ctx, row.ShardID, "InsertShard failed because shard already exists")
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *mdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	var entry cadence.ShardCollectionEntry
+	err := db.collection(cadence.ShardCollectionName).FindOne(ctx, bson.D{{"_id", shardID}}).Decode(&entry)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var row nosqlplugin.ShardRow
+	if err := json.Unmarshal(entry.Data, &row); err != nil {
+		return 0, nil, err
+	}
+	if row.ClusterTransferAckLevel == nil {
+		row.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: row.TransferAckLevel,
+		}
+	}
+	if row.ClusterTimerAckLevel == nil {
+		row.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: row.TimerAckLevel,
+		}
+	}
+	if row.ClusterReplicationLevel == nil {
+		row.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if row.ReplicationDLQAckLevel == nil {
+		row.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return entry.RangeID, &row, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(
+		ctx,
+		bson.D{{"_id", shardID}, {"rangeid", previousRangeID}},
+		bson.D{{"$set", bson.D{{"rangeid", rangeID}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.getConflictedShardRow(ctx, shardID, fmt.Sprintf("UpdateRangeID failed, previous rangeID: %v", previousRangeID))
+	}
+	return nil
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	shardRow := *row
+	shardRow.UpdatedAt = time.Now()
+	data, err := json.Marshal(&shardRow)
+	if err != nil {
+		return err
+	}
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(
+		ctx,
+		bson.D{{"_id", row.ShardID}, {"rangeid", previousRangeID}},
+		bson.D{{"$set", bson.D{{"rangeid", row.RangeID}, {"data", data}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.getConflictedShardRow(ctx, row.ShardID, fmt.Sprintf("UpdateShard failed, previous rangeID: %v", previousRangeID))
+	}
+	return nil
+}
+
+// assertShardRangeID checks the rangeID of the shard within a transaction.
+// The shard document is always written, so that the transaction conflicts with any concurrent change of rangeID.
+func (db *mdb) assertShardRangeID(sessCtx mongo.SessionContext, shardID int, rangeID int64) error {
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(
+		sessCtx,
+		bson.D{{"_id", shardID}, {"rangeid", rangeID}},
+		bson.D{{"$inc", bson.D{{"leasecounter", 1}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		actualRangeID, err := db.selectShardRangeID(sessCtx, shardID)
+		if err != nil {
+			return err
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: &actualRangeID,
+		}
+	}
+	return nil
+}
+
+func (db *mdb) selectShardRangeID(ctx context.Context, shardID int) (int64, error) {
+	var entry cadence.ShardCollectionEntry
+	err := db.collection(cadence.ShardCollectionName).FindOne(ctx, bson.D{{"_id", shardID}}).Decode(&entry)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return entry.RangeID, nil
+}
+
+func (db *mdb) getConflictedShardRow(ctx context.Context, shardID int, details string) error {
+	rangeID, err := db.selectShardRangeID(ctx, shardID)
+	if err != nil {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: details,
+	}
 }
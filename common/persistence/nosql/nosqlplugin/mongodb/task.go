@@ -22,20 +22,60 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+type taskListPageToken struct {
+	DomainID     string
+	TaskListName string
+	TaskListType int
+}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	var entry cadence.TaskListCollectionEntry
+	err := db.collection(cadence.TaskListCollectionName).FindOne(ctx, taskListQuery(filter)).Decode(&entry)
+	if err != nil {
+		return nil, err
+	}
+	return toTaskListRow(&entry), nil
 }
 
 // InsertTaskList insert a single tasklist row
-// Return IsConditionFailedError if the row already exists, and also the existing row
+// Return TaskOperationConditionFailure if the row already exists
 func (db *mdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	partitionConfigVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigValues(row.PartitionConfig)
+	_, err := db.collection(cadence.TaskListCollectionName).InsertOne(ctx, cadence.TaskListCollectionEntry{
+		DomainID:               row.DomainID,
+		TaskListName:           row.TaskListName,
+		TaskListType:           row.TaskListType,
+		RangeID:                row.RangeID,
+		TaskListKind:           row.TaskListKind,
+		AckLevel:               row.AckLevel,
+		LastUpdatedTime:        row.LastUpdatedTime,
+		PartitionConfigVersion: partitionConfigVersion,
+		NumReadPartitions:      numReadPartitions,
+		NumWritePartitions:     numWritePartitions,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return db.getConflictedTaskListRow(ctx, &nosqlplugin.TaskListFilter{
+			DomainID:     row.DomainID,
+			TaskListName: row.TaskListName,
+			TaskListType: row.TaskListType,
+		}, "InsertTaskList failed because tasklist already exists")
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +85,7 @@ func (db *mdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, row, previousRangeID, nil)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +98,117 @@ func (db *mdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	expireAt := time.Now().Add(time.Duration(ttlSeconds) * time.Second)
+	return db.updateTaskList(ctx, row, previousRangeID, &expireAt)
+}
+
+func (db *mdb) updateTaskList(
+	ctx context.Context,
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+	expireAt *time.Time,
+) error {
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+	partitionConfigVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigValues(row.PartitionConfig)
+	update := bson.D{
+		{"rangeid", row.RangeID},
+		{"tasklistkind", row.TaskListKind},
+		{"acklevel", row.AckLevel},
+		{"lastupdatedtime", row.LastUpdatedTime},
+		{"partitionconfigversion", partitionConfigVersion},
+		{"numreadpartitions", numReadPartitions},
+		{"numwritepartitions", numWritePartitions},
+	}
+	var unset bson.D
+	if expireAt != nil {
+		update = append(update, bson.E{Key: "expireat", Value: *expireAt})
+	} else {
+		unset = bson.D{{"expireat", ""}}
+	}
+	updateDoc := bson.D{{"$set", update}}
+	if unset != nil {
+		updateDoc = append(updateDoc, bson.E{Key: "$unset", Value: unset})
+	}
+
+	result, err := db.collection(cadence.TaskListCollectionName).UpdateOne(
+		ctx,
+		append(taskListQuery(filter), bson.E{Key: "rangeid", Value: previousRangeID}),
+		updateDoc,
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.getConflictedTaskListRow(ctx, filter, fmt.Sprintf("UpdateTaskList failed, previous rangeID: %v", previousRangeID))
+	}
+	return nil
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	query := bson.D{}
+	var token taskListPageToken
+	hasToken, err := decodePageToken(nextPageToken, &token)
+	if err != nil {
+		return nil, err
+	}
+	if hasToken {
+		query = bson.D{{"$or", bson.A{
+			bson.D{{"domainid", bson.D{{"$gt", token.DomainID}}}},
+			bson.D{{"domainid", token.DomainID}, {"tasklistname", bson.D{{"$gt", token.TaskListName}}}},
+			bson.D{{"domainid", token.DomainID}, {"tasklistname", token.TaskListName}, {"tasklisttype", bson.D{{"$gt", token.TaskListType}}}},
+		}}}
+	}
+
+	queryOptions := options.Find().
+		SetSort(bson.D{{"domainid", 1}, {"tasklistname", 1}, {"tasklisttype", 1}}).
+		SetLimit(int64(pageSize))
+	cursor, err := db.collection(cadence.TaskListCollectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	var entries []cadence.TaskListCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+
+	result := &nosqlplugin.ListTaskListResult{}
+	for i := range entries {
+		result.TaskLists = append(result.TaskLists, toTaskListRow(&entries[i]))
+	}
+	if pageSize > 0 && len(entries) == pageSize {
+		last := entries[len(entries)-1]
+		result.NextPageToken, err = encodePageToken(taskListPageToken{
+			DomainID:     last.DomainID,
+			TaskListName: last.TaskListName,
+			TaskListType: last.TaskListType,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *mdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.collection(cadence.TaskListCollectionName).DeleteOne(
+		ctx,
+		append(taskListQuery(filter), bson.E{Key: "rangeid", Value: previousRangeID}),
+	)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return db.getConflictedTaskListRow(ctx, filter, fmt.Sprintf("DeleteTaskList failed, previous rangeID: %v", previousRangeID))
+	}
+	return nil
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,18 +218,202 @@ func (db *mdb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     tasklistCondition.DomainID,
+		TaskListName: tasklistCondition.TaskListName,
+		TaskListType: tasklistCondition.TaskListType,
+	}
+
+	documents := make([]interface{}, 0, len(tasksToInsert))
+	now := time.Now()
+	for _, task := range tasksToInsert {
+		data, err := json.Marshal(&task.TaskRow)
+		if err != nil {
+			return err
+		}
+		entry := cadence.MatchingTaskCollectionEntry{
+			DomainID:     filter.DomainID,
+			TaskListName: filter.TaskListName,
+			TaskListType: filter.TaskListType,
+			TaskID:       task.TaskID,
+			Data:         data,
+		}
+		if task.TTLSeconds > 0 {
+			expireAt := now.Add(time.Duration(task.TTLSeconds) * time.Second)
+			entry.ExpireAt = &expireAt
+		}
+		documents = append(documents, entry)
+	}
+
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		// the tasklist document is always written to make sure the rangeID didn't change
+		result, err := db.collection(cadence.TaskListCollectionName).UpdateOne(
+			sessCtx,
+			append(taskListQuery(filter), bson.E{Key: "rangeid", Value: tasklistCondition.RangeID}),
+			bson.D{{"$set", bson.D{{"lastupdatedtime", now}}}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return db.getConflictedTaskListRow(sessCtx, filter, fmt.Sprintf("InsertTasks failed, rangeID: %v", tasklistCondition.RangeID))
+		}
+		if len(documents) == 0 {
+			return nil
+		}
+		_, err = db.collection(cadence.TaskCollectionName).InsertMany(sessCtx, documents)
+		return err
+	})
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	query := append(
+		taskListQuery(&filter.TaskListFilter),
+		bson.E{Key: "taskid", Value: bson.D{{"$gt", filter.MinTaskID}, {"$lte", filter.MaxTaskID}}},
+	)
+	queryOptions := options.Find().SetSort(bson.D{{"taskid", 1}})
+	if filter.BatchSize > 0 {
+		queryOptions.SetLimit(int64(filter.BatchSize))
+	}
+	cursor, err := db.collection(cadence.TaskCollectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	var entries []cadence.MatchingTaskCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+
+	var response []*nosqlplugin.TaskRow
+	now := time.Now()
+	for _, entry := range entries {
+		if entry.ExpireAt != nil && entry.ExpireAt.Before(now) {
+			// MongoDB removes expired documents periodically, so they may still be returned
+			continue
+		}
+		task := &nosqlplugin.TaskRow{}
+		if err := json.Unmarshal(entry.Data, task); err != nil {
+			return nil, err
+		}
+		task.TaskID = entry.TaskID
+		response = append(response, task)
+	}
+	return response, nil
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
 func (db *mdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	query := append(
+		taskListQuery(&filter.TaskListFilter),
+		bson.E{Key: "taskid", Value: bson.D{{"$gt", filter.MinTaskID}, {"$lte", filter.MaxTaskID}}},
+	)
+	result, err := db.collection(cadence.TaskCollectionName).DeleteMany(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	// MongoDB doesn't support limiting the number of documents to delete,
+	// so all the tasks in the range are deleted regardless of BatchSize
+	if filter.BatchSize > 0 && int(result.DeletedCount) > filter.BatchSize {
+		return persistence.UnknownNumRowsAffected, nil
+	}
+	return int(result.DeletedCount), nil
+}
+
+// SelectOrphanTasks returns tasks that belong to a tasklist no longer present, up to the limit
+func (db *mdb) SelectOrphanTasks(ctx context.Context, limit int) ([]*nosqlplugin.TaskRow, error) {
+	pipeline := mongo.Pipeline{
+		{{"$lookup", bson.D{
+			{"from", cadence.TaskListCollectionName},
+			{"let", bson.D{{"domainid", "$domainid"}, {"tasklistname", "$tasklistname"}, {"tasklisttype", "$tasklisttype"}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$and", bson.A{
+					bson.D{{"$eq", bson.A{"$domainid", "$$domainid"}}},
+					bson.D{{"$eq", bson.A{"$tasklistname", "$$tasklistname"}}},
+					bson.D{{"$eq", bson.A{"$tasklisttype", "$$tasklisttype"}}},
+				}}}}}}},
+				bson.D{{"$limit", 1}},
+			}},
+			{"as", "tasklists"},
+		}}},
+		{{"$match", bson.D{{"tasklists", bson.D{{"$size", 0}}}}}},
+		{{"$sort", bson.D{{"domainid", 1}, {"tasklistname", 1}, {"tasklisttype", 1}, {"taskid", 1}}}},
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.D{{"$limit", limit}})
+	}
+	cursor, err := db.collection(cadence.TaskCollectionName).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var entries []cadence.MatchingTaskCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+
+	var response []*nosqlplugin.TaskRow
+	for _, entry := range entries {
+		task := &nosqlplugin.TaskRow{}
+		if err := json.Unmarshal(entry.Data, task); err != nil {
+			return nil, err
+		}
+		task.DomainID = entry.DomainID
+		task.TaskListName = entry.TaskListName
+		task.TaskListType = entry.TaskListType
+		task.TaskID = entry.TaskID
+		response = append(response, task)
+	}
+	return response, nil
+}
+
+func taskListQuery(filter *nosqlplugin.TaskListFilter) bson.D {
+	return bson.D{
+		{"domainid", filter.DomainID},
+		{"tasklistname", filter.TaskListName},
+		{"tasklisttype", filter.TaskListType},
+	}
+}
+
+func toTaskListRow(entry *cadence.TaskListCollectionEntry) *nosqlplugin.TaskListRow {
+	row := &nosqlplugin.TaskListRow{
+		DomainID:        entry.DomainID,
+		TaskListName:    entry.TaskListName,
+		TaskListType:    entry.TaskListType,
+		RangeID:         entry.RangeID,
+		TaskListKind:    entry.TaskListKind,
+		AckLevel:        entry.AckLevel,
+		LastUpdatedTime: entry.LastUpdatedTime,
+	}
+	if entry.PartitionConfigVersion != 0 {
+		row.PartitionConfig = &persistence.TaskListPartitionConfig{
+			Version:            entry.PartitionConfigVersion,
+			NumReadPartitions:  entry.NumReadPartitions,
+			NumWritePartitions: entry.NumWritePartitions,
+		}
+	}
+	return row
+}
+
+func taskListPartitionConfigValues(config *persistence.TaskListPartitionConfig) (int64, int, int) {
+	if config == nil {
+		return 0, 0, 0
+	}
+	return config.Version, config.NumReadPartitions, config.NumWritePartitions
+}
+
+func (db *mdb) getConflictedTaskListRow(ctx context.Context, filter *nosqlplugin.TaskListFilter, details string) error {
+	var rangeID int64
+	row, err := db.SelectTaskList(ctx, filter)
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return err
+		}
+	} else {
+		rangeID = row.RangeID
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: details,
+	}
 }
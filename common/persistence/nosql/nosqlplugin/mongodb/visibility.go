@@ -22,16 +22,38 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+type visibilityPageToken struct {
+	Time  int64
+	RunID string
+}
+
 func (db *mdb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	entry, err := newVisibilityCollectionEntry(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.VisibilityCollectionName).ReplaceOne(
+		ctx,
+		visibilityQuery(row.DomainID, row.WorkflowID, row.RunID),
+		entry,
+		options.Replace().SetUpsert(true),
+	)
+	return err
 }
 
 func (db *mdb) UpdateVisibility(
@@ -39,26 +61,184 @@ func (db *mdb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	// a single collection is used for both open and closed records, so moving a record
+	// between open and closed is simply flipping the closed flag
+	closed := !row.UpdateCloseToOpen
+	entry, err := newVisibilityCollectionEntry(row.DomainID, &row.VisibilityRow, closed, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.VisibilityCollectionName).ReplaceOne(
+		ctx,
+		visibilityQuery(row.DomainID, row.WorkflowID, row.RunID),
+		entry,
+		options.Replace().SetUpsert(true),
+	)
+	return err
 }
 
 func (db *mdb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := &filter.ListRequest
+	query := bson.D{{"domainid", request.DomainUUID}}
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen:
+		query = append(query, bson.E{Key: "closed", Value: false})
+	case nosqlplugin.AllClosed:
+		query = append(query, bson.E{Key: "closed", Value: true})
+	case nosqlplugin.OpenByWorkflowType:
+		query = append(query, bson.E{Key: "closed", Value: false}, bson.E{Key: "workflowtype", Value: filter.WorkflowType})
+	case nosqlplugin.ClosedByWorkflowType:
+		query = append(query, bson.E{Key: "closed", Value: true}, bson.E{Key: "workflowtype", Value: filter.WorkflowType})
+	case nosqlplugin.OpenByWorkflowID:
+		query = append(query, bson.E{Key: "closed", Value: false}, bson.E{Key: "workflowid", Value: filter.WorkflowID})
+	case nosqlplugin.ClosedByWorkflowID:
+		query = append(query, bson.E{Key: "closed", Value: true}, bson.E{Key: "workflowid", Value: filter.WorkflowID})
+	case nosqlplugin.ClosedByClosedStatus:
+		query = append(query, bson.E{Key: "closed", Value: true}, bson.E{Key: "closestatus", Value: filter.CloseStatus})
+	default:
+		return nil, fmt.Errorf("unknown visibility filter type: %v", filter.FilterType)
+	}
+
+	timeField := "starttimenano"
+	switch filter.SortType {
+	case nosqlplugin.SortByStartTime:
+	case nosqlplugin.SortByClosedTime:
+		timeField = "closetimenano"
+	default:
+		return nil, fmt.Errorf("unknown visibility sort type: %v", filter.SortType)
+	}
+
+	timeRange := bson.D{
+		{"$gte", request.EarliestTime.UnixNano()},
+		{"$lte", request.LatestTime.UnixNano()},
+	}
+	var token visibilityPageToken
+	hasToken, err := decodePageToken(request.NextPageToken, &token)
+	if err != nil {
+		return nil, err
+	}
+	if hasToken {
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{timeField, bson.D{{"$gte", request.EarliestTime.UnixNano()}, {"$lt", token.Time}}}},
+			bson.D{{timeField, token.Time}, {"runid", bson.D{{"$lt", token.RunID}}}},
+		}})
+		timeRange = bson.D{{"$lte", request.LatestTime.UnixNano()}}
+	}
+	query = append(query, bson.E{Key: timeField, Value: timeRange})
+
+	queryOptions := options.Find().
+		SetSort(bson.D{{timeField, -1}, {"runid", -1}}).
+		SetLimit(int64(request.PageSize))
+	cursor, err := db.collection(cadence.VisibilityCollectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	var entries []cadence.VisibilityCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+
+	response := &nosqlplugin.SelectVisibilityResponse{}
+	for i := range entries {
+		row, err := toVisibilityRow(&entries[i])
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	if request.PageSize > 0 && len(entries) == request.PageSize {
+		last := entries[len(entries)-1]
+		nextToken := visibilityPageToken{
+			Time:  last.StartTimeNano,
+			RunID: last.RunID,
+		}
+		if timeField == "closetimenano" {
+			nextToken.Time = last.CloseTimeNano
+		}
+		response.NextPageToken, err = encodePageToken(nextToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
 func (db *mdb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.VisibilityCollectionName).DeleteOne(ctx, visibilityQuery(domainID, workflowID, runID))
+	return err
 }
 
 func (db *mdb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	var entry cadence.VisibilityCollectionEntry
+	query := append(visibilityQuery(domainID, workflowID, runID), bson.E{Key: "closed", Value: true})
+	err := db.collection(cadence.VisibilityCollectionName).FindOne(ctx, query).Decode(&entry)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toVisibilityRow(&entry)
+}
+
+func visibilityQuery(domainID, workflowID, runID string) bson.D {
+	return bson.D{
+		{"domainid", domainID},
+		{"workflowid", workflowID},
+		{"runid", runID},
+	}
+}
+
+func newVisibilityCollectionEntry(
+	domainID string,
+	row *nosqlplugin.VisibilityRow,
+	closed bool,
+	ttlSeconds int64,
+) (*cadence.VisibilityCollectionEntry, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	entry := &cadence.VisibilityCollectionEntry{
+		DomainID:      domainID,
+		WorkflowID:    row.WorkflowID,
+		RunID:         row.RunID,
+		WorkflowType:  row.TypeName,
+		StartTimeNano: row.StartTime.UnixNano(),
+		Closed:        closed,
+		Data:          data,
+	}
+	if closed {
+		entry.CloseTimeNano = row.CloseTime.UnixNano()
+		if row.Status != nil {
+			entry.CloseStatus = int32(*row.Status)
+		}
+	}
+	if ttlSeconds > 0 {
+		expireAt := time.Now().Add(time.Duration(ttlSeconds) * time.Second)
+		entry.ExpireAt = &expireAt
+	}
+	return entry, nil
+}
+
+func toVisibilityRow(entry *cadence.VisibilityCollectionEntry) (*nosqlplugin.VisibilityRow, error) {
+	var row nosqlplugin.VisibilityRow
+	if err := json.Unmarshal(entry.Data, &row); err != nil {
+		return nil, err
+	}
+	row.DomainID = entry.DomainID
+	if !entry.Closed {
+		// open records don't have close status even if the data was written by an update
+		row.Status = nil
+	}
+	return &row, nil
 }
@@ -23,14 +23,55 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*mdb)(nil)
 
+type (
+	// workflowExecutionData is the JSON encoded data field of workflow_execution collection
+	workflowExecutionData struct {
+		ExecutionInfo       *persistence.InternalWorkflowExecutionInfo
+		VersionHistories    *persistence.DataBlob
+		Checksum            checksum.Checksum
+		LastWriteVersion    int64
+		ActivityInfos       map[int64]*persistence.InternalActivityInfo
+		TimerInfos          map[string]*persistence.TimerInfo
+		ChildExecutionInfos map[int64]*persistence.InternalChildExecutionInfo
+		RequestCancelInfos  map[int64]*persistence.RequestCancelInfo
+		SignalInfos         map[int64]*persistence.SignalInfo
+		SignalRequestedIDs  map[string]struct{}
+		BufferedEvents      []*persistence.DataBlob
+	}
+
+	currentWorkflowPageToken struct {
+		DomainID   string
+		WorkflowID string
+	}
+
+	workflowExecutionPageToken struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	timerTaskPageToken struct {
+		VisibilityTimestampNano int64
+		TaskID                  int64
+	}
+)
+
 func (db *mdb) InsertWorkflowExecutionWithTasks(
 	ctx context.Context,
 	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
@@ -41,7 +82,23 @@ func (db *mdb) InsertWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		// shard condition is checked first, as ShardRangeIDNotMatch takes priority over other condition failures
+		if err := db.assertShardRangeID(sessCtx, shardID, shardCondition.RangeID); err != nil {
+			return err
+		}
+		if err := db.createOrUpdateCurrentWorkflow(sessCtx, shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+			return err
+		}
+		if err := db.createWorkflowExecution(sessCtx, shardID, execution); err != nil {
+			return err
+		}
+		return db.createTasks(sessCtx, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	})
 }
 
 func (db *mdb) UpdateWorkflowExecutionWithTasks(
@@ -56,105 +113,460 @@ func (db *mdb) UpdateWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		if err := db.assertShardRangeID(sessCtx, shardID, shardCondition.RangeID); err != nil {
+			return err
+		}
+		if err := db.createOrUpdateCurrentWorkflow(sessCtx, shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+			return err
+		}
+		if mutatedExecution != nil {
+			if err := db.updateWorkflowExecution(sessCtx, shardID, mutatedExecution); err != nil {
+				return err
+			}
+		}
+		if insertedExecution != nil {
+			if err := db.createWorkflowExecution(sessCtx, shardID, insertedExecution); err != nil {
+				return err
+			}
+		}
+		if resetExecution != nil {
+			if err := db.resetWorkflowExecution(sessCtx, shardID, resetExecution); err != nil {
+				return err
+			}
+		}
+		return db.createTasks(sessCtx, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	})
 }
 
 func (db *mdb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	var entry cadence.CurrentWorkflowCollectionEntry
+	err := db.collection(cadence.CurrentWorkflowCollectionName).
+		FindOne(ctx, currentWorkflowQuery(shardID, domainID, workflowID)).
+		Decode(&entry)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          entry.ShardID,
+		DomainID:         entry.DomainID,
+		WorkflowID:       entry.WorkflowID,
+		RunID:            entry.RunID,
+		State:            entry.State,
+		CloseStatus:      entry.CloseStatus,
+		CreateRequestID:  entry.CreateRequestID,
+		LastWriteVersion: entry.LastWriteVersion,
+	}, nil
 }
 
 func (db *mdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	data, err := db.selectWorkflowExecutionData(ctx, shardID, domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       data.ExecutionInfo,
+		VersionHistories:    data.VersionHistories,
+		ActivityInfos:       data.ActivityInfos,
+		TimerInfos:          data.TimerInfos,
+		ChildExecutionInfos: data.ChildExecutionInfos,
+		RequestCancelInfos:  data.RequestCancelInfos,
+		SignalInfos:         data.SignalInfos,
+		SignalRequestedIDs:  data.SignalRequestedIDs,
+		BufferedEvents:      data.BufferedEvents,
+		Checksum:            data.Checksum,
+	}, nil
 }
 
 func (db *mdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	_, err := db.collection(cadence.CurrentWorkflowCollectionName).DeleteOne(
+		ctx,
+		append(currentWorkflowQuery(shardID, domainID, workflowID), bson.E{Key: "runid", Value: currentRunIDCondition}),
+	)
+	return err
 }
 
 func (db *mdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	_, err := db.collection(cadence.WorkflowExecutionCollectionName).DeleteOne(
+		ctx,
+		workflowExecutionQuery(shardID, domainID, workflowID, runID),
+	)
+	return err
 }
 
 func (db *mdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	query := bson.D{{"shardid", shardID}}
+	var token currentWorkflowPageToken
+	hasToken, err := decodePageToken(pageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasToken {
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{"domainid", bson.D{{"$gt", token.DomainID}}}},
+			bson.D{{"domainid", token.DomainID}, {"workflowid", bson.D{{"$gt", token.WorkflowID}}}},
+		}})
+	}
+
+	queryOptions := options.Find().
+		SetSort(bson.D{{"domainid", 1}, {"workflowid", 1}}).
+		SetLimit(int64(pageSize))
+	cursor, err := db.collection(cadence.CurrentWorkflowCollectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []cadence.CurrentWorkflowCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(entries))
+	for _, entry := range entries {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     entry.DomainID,
+			WorkflowID:   entry.WorkflowID,
+			RunID:        entry.RunID,
+			State:        entry.State,
+			CurrentRunID: entry.RunID,
+		})
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(entries) == pageSize {
+		last := entries[len(entries)-1]
+		nextPageToken, err = encodePageToken(currentWorkflowPageToken{
+			DomainID:   last.DomainID,
+			WorkflowID: last.WorkflowID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	query := bson.D{{"shardid", shardID}}
+	var token workflowExecutionPageToken
+	hasToken, err := decodePageToken(pageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasToken {
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{"domainid", bson.D{{"$gt", token.DomainID}}}},
+			bson.D{{"domainid", token.DomainID}, {"workflowid", bson.D{{"$gt", token.WorkflowID}}}},
+			bson.D{{"domainid", token.DomainID}, {"workflowid", token.WorkflowID}, {"runid", bson.D{{"$gt", token.RunID}}}},
+		}})
+	}
+
+	queryOptions := options.Find().
+		SetSort(bson.D{{"domainid", 1}, {"workflowid", 1}, {"runid", 1}}).
+		SetLimit(int64(pageSize))
+	cursor, err := db.collection(cadence.WorkflowExecutionCollectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []cadence.WorkflowExecutionCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(entries))
+	for i := range entries {
+		data, err := decodeWorkflowExecutionData(&entries[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(entries) == pageSize {
+		last := entries[len(entries)-1]
+		nextPageToken, err = encodePageToken(workflowExecutionPageToken{
+			DomainID:   last.DomainID,
+			WorkflowID: last.WorkflowID,
+			RunID:      last.RunID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	count, err := db.collection(cadence.WorkflowExecutionCollectionName).CountDocuments(
+		ctx,
+		workflowExecutionQuery(shardID, domainID, workflowID, runID),
+	)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 func (db *mdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
-	panic("TODO")
+	entries, nextPageToken, err := db.selectTasksOrderByTaskID(
+		ctx, cadence.TransferTaskCollectionName, bson.D{{"shardid", shardID}},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TransferTask, 0, len(entries))
+	for _, entry := range entries {
+		task := &nosqlplugin.TransferTask{}
+		if err := json.Unmarshal(entry.Data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TransferTaskCollectionName).DeleteOne(
+		ctx,
+		bson.D{{"shardid", shardID}, {"taskid", taskID}},
+	)
+	return err
 }
 
 func (db *mdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TransferTaskCollectionName).DeleteMany(
+		ctx,
+		bson.D{{"shardid", shardID}, {"taskid", bson.D{{"$gt", exclusiveBeginTaskID}, {"$lte", inclusiveEndTaskID}}}},
+	)
+	return err
 }
 
 func (db *mdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
-	panic("TODO")
+	query := bson.D{
+		{"shardid", shardID},
+		{"visibilitytimestampnano", bson.D{{"$gte", inclusiveMinTime.UnixNano()}, {"$lt", exclusiveMaxTime.UnixNano()}}},
+	}
+	var token timerTaskPageToken
+	hasToken, err := decodePageToken(pageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasToken {
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{"visibilitytimestampnano", bson.D{{"$gt", token.VisibilityTimestampNano}}}},
+			bson.D{{"visibilitytimestampnano", token.VisibilityTimestampNano}, {"taskid", bson.D{{"$gt", token.TaskID}}}},
+		}})
+	}
+
+	queryOptions := options.Find().
+		SetSort(bson.D{{"visibilitytimestampnano", 1}, {"taskid", 1}}).
+		SetLimit(int64(pageSize))
+	cursor, err := db.collection(cadence.TimerTaskCollectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []cadence.TimerTaskCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	tasks := make([]*nosqlplugin.TimerTask, 0, len(entries))
+	for _, entry := range entries {
+		task := &nosqlplugin.TimerTask{}
+		if err := json.Unmarshal(entry.Data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(entries) == pageSize {
+		last := entries[len(entries)-1]
+		nextPageToken, err = encodePageToken(timerTaskPageToken{
+			VisibilityTimestampNano: last.VisibilityTimestampNano,
+			TaskID:                  last.TaskID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TimerTaskCollectionName).DeleteOne(
+		ctx,
+		bson.D{
+			{"shardid", shardID},
+			{"visibilitytimestampnano", visibilityTimestamp.UnixNano()},
+			{"taskid", taskID},
+		},
+	)
+	return err
 }
 
 func (db *mdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TimerTaskCollectionName).DeleteMany(
+		ctx,
+		bson.D{
+			{"shardid", shardID},
+			{"visibilitytimestampnano", bson.D{{"$gte", inclusiveMinTime.UnixNano()}, {"$lt", exclusiveMaxTime.UnixNano()}}},
+		},
+	)
+	return err
 }
 
 func (db *mdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	entries, nextPageToken, err := db.selectTasksOrderByTaskID(
+		ctx, cadence.ReplicationTaskCollectionName, bson.D{{"shardid", shardID}},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := toReplicationTasks(entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationTaskCollectionName).DeleteOne(
+		ctx,
+		bson.D{{"shardid", shardID}, {"taskid", taskID}},
+	)
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationTaskCollectionName).DeleteMany(
+		ctx,
+		bson.D{{"shardid", shardID}, {"taskid", bson.D{{"$lte", inclusiveEndTaskID}}}},
+	)
+	return err
 }
 
 func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	if len(tasks) == 0 {
+		return nil
+	}
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		if err := db.assertShardRangeID(sessCtx, condition.ShardID, condition.RangeID); err != nil {
+			return err
+		}
+		return db.createTasks(sessCtx, condition.ShardID, nil, nil, tasks, nil)
+	})
 }
 
 func (db *mdb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
-	panic("TODO")
+	entries, nextPageToken, err := db.selectTasksOrderByTaskID(
+		ctx, cadence.CrossClusterTaskCollectionName, bson.D{{"shardid", shardID}, {"cluster", targetCluster}},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.CrossClusterTask, 0, len(entries))
+	for _, entry := range entries {
+		task := &nosqlplugin.CrossClusterTask{}
+		if err := json.Unmarshal(entry.Data, task); err != nil {
+			return nil, nil, err
+		}
+		task.TargetCluster = entry.Cluster
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.CrossClusterTaskCollectionName).DeleteOne(
+		ctx,
+		bson.D{{"shardid", shardID}, {"cluster", targetCluster}, {"taskid", taskID}},
+	)
+	return err
 }
 
 func (db *mdb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.CrossClusterTaskCollectionName).DeleteMany(
+		ctx,
+		bson.D{
+			{"shardid", shardID},
+			{"cluster", targetCluster},
+			{"taskid", bson.D{{"$gt", exclusiveBeginTaskID}, {"$lte", inclusiveEndTaskID}}},
+		},
+	)
+	return err
 }
 
 func (db *mdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
-	panic("TODO")
+	data, err := json.Marshal(&task)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.ReplicationDLQTaskCollectionName).InsertOne(ctx, cadence.TaskCollectionEntry{
+		ShardID: shardID,
+		Cluster: sourceCluster,
+		TaskID:  task.TaskID,
+		Data:    data,
+	})
+	return err
 }
 
 func (db *mdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	entries, nextPageToken, err := db.selectTasksOrderByTaskID(
+		ctx, cadence.ReplicationDLQTaskCollectionName, bson.D{{"shardid", shardID}, {"cluster", sourceCluster}},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := toReplicationTasks(entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.ReplicationDLQTaskCollectionName).CountDocuments(
+		ctx,
+		bson.D{{"shardid", shardID}, {"cluster", sourceCluster}},
+	)
 }
 
 func (db *mdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationDLQTaskCollectionName).DeleteOne(
+		ctx,
+		bson.D{{"shardid", shardID}, {"cluster", sourceCluster}, {"taskid", taskID}},
+	)
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationDLQTaskCollectionName).DeleteMany(
+		ctx,
+		bson.D{
+			{"shardid", shardID},
+			{"cluster", sourceCluster},
+			{"taskid", bson.D{{"$gt", exclusiveBeginTaskID}, {"$lte", inclusiveEndTaskID}}},
+		},
+	)
+	return err
 }
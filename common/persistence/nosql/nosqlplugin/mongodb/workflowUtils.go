@@ -0,0 +1,542 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+func currentWorkflowQuery(shardID int, domainID, workflowID string) bson.D {
+	return bson.D{
+		{"shardid", shardID},
+		{"domainid", domainID},
+		{"workflowid", workflowID},
+	}
+}
+
+func workflowExecutionQuery(shardID int, domainID, workflowID, runID string) bson.D {
+	return bson.D{
+		{"shardid", shardID},
+		{"domainid", domainID},
+		{"workflowid", workflowID},
+		{"runid", runID},
+	}
+}
+
+func (db *mdb) createOrUpdateCurrentWorkflow(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	entry := &cadence.CurrentWorkflowCollectionEntry{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            request.Row.RunID,
+		State:            request.Row.State,
+		CloseStatus:      request.Row.CloseStatus,
+		CreateRequestID:  request.Row.CreateRequestID,
+		LastWriteVersion: request.Row.LastWriteVersion,
+	}
+	currentWorkflows := db.collection(cadence.CurrentWorkflowCollectionName)
+
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		// a failed write aborts the whole transaction, so the condition is checked by reading first
+		var existing cadence.CurrentWorkflowCollectionEntry
+		err := currentWorkflows.FindOne(sessCtx, currentWorkflowQuery(shardID, domainID, workflowID)).Decode(&existing)
+		if err == nil {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+				existing.WorkflowID, existing.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  existing.CreateRequestID,
+					RunID:            existing.RunID,
+					State:            existing.State,
+					CloseStatus:      existing.CloseStatus,
+					LastWriteVersion: existing.LastWriteVersion,
+				},
+			}
+		}
+		if !db.IsNotFoundError(err) {
+			return err
+		}
+		_, err = currentWorkflows.InsertOne(sessCtx, entry)
+		return err
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		filter := append(
+			currentWorkflowQuery(shardID, domainID, workflowID),
+			bson.E{Key: "runid", Value: *request.Condition.CurrentRunID},
+		)
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			filter = append(
+				filter,
+				bson.E{Key: "lastwriteversion", Value: *request.Condition.LastWriteVersion},
+				bson.E{Key: "state", Value: *request.Condition.State},
+			)
+		}
+		result, err := currentWorkflows.ReplaceOne(sessCtx, filter, entry)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			actualCurrRunID := ""
+			var existing cadence.CurrentWorkflowCollectionEntry
+			err := currentWorkflows.FindOne(sessCtx, currentWorkflowQuery(shardID, domainID, workflowID)).Decode(&existing)
+			if err == nil {
+				actualCurrRunID = existing.RunID
+			} else if !db.IsNotFoundError(err) {
+				return err
+			}
+			msg := fmt.Sprintf("Workflow execution condition failed by mismatch current workflow. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+				workflowID, request.Condition.GetCurrentRunID(), actualCurrRunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+}
+
+func (db *mdb) createWorkflowExecution(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	existing, err := db.selectWorkflowExecutionData(sessCtx, shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	if err == nil {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+			execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: existing.LastWriteVersion,
+			},
+		}
+	}
+	if !db.IsNotFoundError(err) {
+		return err
+	}
+
+	data := newWorkflowExecutionData(execution)
+	mergeWorkflowExecutionMaps(data, execution)
+	entry, err := newWorkflowExecutionCollectionEntry(shardID, data)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.WorkflowExecutionCollectionName).InsertOne(sessCtx, entry)
+	return err
+}
+
+func (db *mdb) updateWorkflowExecution(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	previous, err := db.selectWorkflowExecutionForUpdate(sessCtx, shardID, execution)
+	if err != nil {
+		return err
+	}
+
+	data := newWorkflowExecutionData(execution)
+	data.ActivityInfos = previous.ActivityInfos
+	data.TimerInfos = previous.TimerInfos
+	data.ChildExecutionInfos = previous.ChildExecutionInfos
+	data.RequestCancelInfos = previous.RequestCancelInfos
+	data.SignalInfos = previous.SignalInfos
+	data.SignalRequestedIDs = previous.SignalRequestedIDs
+	mergeWorkflowExecutionMaps(data, execution)
+	deleteFromWorkflowExecutionMaps(data, execution)
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeNone:
+		data.BufferedEvents = previous.BufferedEvents
+	case nosqlplugin.EventBufferWriteModeAppend:
+		data.BufferedEvents = append(previous.BufferedEvents, execution.NewBufferedEventBatch)
+	case nosqlplugin.EventBufferWriteModeClear:
+		data.BufferedEvents = nil
+	default:
+		return fmt.Errorf("unknown event buffer write mode %v", execution.EventBufferWriteMode)
+	}
+
+	return db.replaceWorkflowExecution(sessCtx, shardID, data, *execution.PreviousNextEventIDCondition)
+}
+
+func (db *mdb) resetWorkflowExecution(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+
+	if _, err := db.selectWorkflowExecutionForUpdate(sessCtx, shardID, execution); err != nil {
+		return err
+	}
+
+	data := newWorkflowExecutionData(execution)
+	mergeWorkflowExecutionMaps(data, execution)
+	return db.replaceWorkflowExecution(sessCtx, shardID, data, *execution.PreviousNextEventIDCondition)
+}
+
+// selectWorkflowExecutionForUpdate reads the current execution data and checks the next event ID condition
+func (db *mdb) selectWorkflowExecutionForUpdate(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*workflowExecutionData, error) {
+	if execution.PreviousNextEventIDCondition == nil {
+		return nil, fmt.Errorf("PreviousNextEventIDCondition is required for updating workflow execution")
+	}
+	requestCondition := *execution.PreviousNextEventIDCondition
+
+	var entry cadence.WorkflowExecutionCollectionEntry
+	err := db.collection(cadence.WorkflowExecutionCollectionName).
+		FindOne(sessCtx, workflowExecutionQuery(shardID, execution.DomainID, execution.WorkflowID, execution.RunID)).
+		Decode(&entry)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			msg := fmt.Sprintf("Failed to update mutable state. Workflow execution not found. WorkflowId: %v, RunId: %v, Request Condition: %v",
+				execution.WorkflowID, execution.RunID, requestCondition)
+			return nil, &nosqlplugin.WorkflowOperationConditionFailure{
+				UnknownConditionFailureDetails: &msg,
+			}
+		}
+		return nil, err
+	}
+	if entry.NextEventID != requestCondition {
+		msg := fmt.Sprintf("Failed to update mutable state.  Request Condition: %v, Actual Value: %v",
+			requestCondition, entry.NextEventID)
+		return nil, &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	return decodeWorkflowExecutionData(&entry)
+}
+
+func (db *mdb) replaceWorkflowExecution(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	data *workflowExecutionData,
+	previousNextEventID int64,
+) error {
+	entry, err := newWorkflowExecutionCollectionEntry(shardID, data)
+	if err != nil {
+		return err
+	}
+	filter := append(
+		workflowExecutionQuery(shardID, entry.DomainID, entry.WorkflowID, entry.RunID),
+		bson.E{Key: "nexteventid", Value: previousNextEventID},
+	)
+	result, err := db.collection(cadence.WorkflowExecutionCollectionName).ReplaceOne(sessCtx, filter, entry)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		msg := fmt.Sprintf("Failed to update mutable state.  Request Condition: %v", previousNextEventID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	return nil
+}
+
+func (db *mdb) selectWorkflowExecutionData(
+	ctx context.Context,
+	shardID int,
+	domainID, workflowID, runID string,
+) (*workflowExecutionData, error) {
+	var entry cadence.WorkflowExecutionCollectionEntry
+	err := db.collection(cadence.WorkflowExecutionCollectionName).
+		FindOne(ctx, workflowExecutionQuery(shardID, domainID, workflowID, runID)).
+		Decode(&entry)
+	if err != nil {
+		return nil, err
+	}
+	return decodeWorkflowExecutionData(&entry)
+}
+
+func (db *mdb) createTasks(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) error {
+	var documents []interface{}
+	for _, task := range transferTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, cadence.TaskCollectionEntry{ShardID: shardID, TaskID: task.TaskID, Data: data})
+	}
+	if err := db.insertTasks(sessCtx, cadence.TransferTaskCollectionName, documents); err != nil {
+		return err
+	}
+
+	documents = nil
+	for _, task := range crossClusterTasks {
+		data, err := json.Marshal(&task.TransferTask)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, cadence.TaskCollectionEntry{
+			ShardID: shardID,
+			Cluster: task.TargetCluster,
+			TaskID:  task.TaskID,
+			Data:    data,
+		})
+	}
+	if err := db.insertTasks(sessCtx, cadence.CrossClusterTaskCollectionName, documents); err != nil {
+		return err
+	}
+
+	documents = nil
+	for _, task := range replicationTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, cadence.TaskCollectionEntry{ShardID: shardID, TaskID: task.TaskID, Data: data})
+	}
+	if err := db.insertTasks(sessCtx, cadence.ReplicationTaskCollectionName, documents); err != nil {
+		return err
+	}
+
+	documents = nil
+	for _, task := range timerTasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, cadence.TimerTaskCollectionEntry{
+			ShardID:                 shardID,
+			VisibilityTimestampNano: task.VisibilityTimestamp.UnixNano(),
+			TaskID:                  task.TaskID,
+			Data:                    data,
+		})
+	}
+	return db.insertTasks(sessCtx, cadence.TimerTaskCollectionName, documents)
+}
+
+func (db *mdb) insertTasks(sessCtx mongo.SessionContext, collectionName string, documents []interface{}) error {
+	if len(documents) == 0 {
+		return nil
+	}
+	_, err := db.collection(collectionName).InsertMany(sessCtx, documents)
+	return err
+}
+
+func (db *mdb) selectTasksOrderByTaskID(
+	ctx context.Context,
+	collectionName string,
+	query bson.D,
+	pageSize int,
+	pageToken []byte,
+	exclusiveMinTaskID, inclusiveMaxTaskID int64,
+) ([]cadence.TaskCollectionEntry, []byte, error) {
+	var lastTaskID int64
+	hasToken, err := decodePageToken(pageToken, &lastTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasToken && lastTaskID > exclusiveMinTaskID {
+		exclusiveMinTaskID = lastTaskID
+	}
+	query = append(query, bson.E{Key: "taskid", Value: bson.D{{"$gt", exclusiveMinTaskID}, {"$lte", inclusiveMaxTaskID}}})
+
+	queryOptions := options.Find().SetSort(bson.D{{"taskid", 1}}).SetLimit(int64(pageSize))
+	cursor, err := db.collection(collectionName).Find(ctx, query, queryOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []cadence.TaskCollectionEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	var nextPageToken []byte
+	if pageSize > 0 && len(entries) == pageSize {
+		nextPageToken, err = encodePageToken(entries[len(entries)-1].TaskID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return entries, nextPageToken, nil
+}
+
+func toReplicationTasks(entries []cadence.TaskCollectionEntry) ([]*nosqlplugin.ReplicationTask, error) {
+	tasks := make([]*nosqlplugin.ReplicationTask, 0, len(entries))
+	for _, entry := range entries {
+		task := &nosqlplugin.ReplicationTask{}
+		if err := json.Unmarshal(entry.Data, task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func newWorkflowExecutionData(execution *nosqlplugin.WorkflowExecutionRequest) *workflowExecutionData {
+	executionInfo := execution.InternalWorkflowExecutionInfo
+	data := &workflowExecutionData{
+		ExecutionInfo:       &executionInfo,
+		VersionHistories:    execution.VersionHistories,
+		LastWriteVersion:    execution.LastWriteVersion,
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+		SignalRequestedIDs:  make(map[string]struct{}),
+	}
+	if execution.Checksums != nil {
+		data.Checksum = *execution.Checksums
+	}
+	return data
+}
+
+// mergeWorkflowExecutionMaps upserts the entries of the request into the maps of the data
+func mergeWorkflowExecutionMaps(data *workflowExecutionData, execution *nosqlplugin.WorkflowExecutionRequest) {
+	for k, v := range execution.ActivityInfos {
+		data.ActivityInfos[k] = v
+	}
+	for k, v := range execution.TimerInfos {
+		data.TimerInfos[k] = v
+	}
+	for k, v := range execution.ChildWorkflowInfos {
+		data.ChildExecutionInfos[k] = v
+	}
+	for k, v := range execution.RequestCancelInfos {
+		data.RequestCancelInfos[k] = v
+	}
+	for k, v := range execution.SignalInfos {
+		data.SignalInfos[k] = v
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		data.SignalRequestedIDs[id] = struct{}{}
+	}
+}
+
+// deleteFromWorkflowExecutionMaps deletes the keys of the request from the maps of the data
+func deleteFromWorkflowExecutionMaps(data *workflowExecutionData, execution *nosqlplugin.WorkflowExecutionRequest) {
+	for _, k := range execution.ActivityInfoKeysToDelete {
+		delete(data.ActivityInfos, k)
+	}
+	for _, k := range execution.TimerInfoKeysToDelete {
+		delete(data.TimerInfos, k)
+	}
+	for _, k := range execution.ChildWorkflowInfoKeysToDelete {
+		delete(data.ChildExecutionInfos, k)
+	}
+	for _, k := range execution.RequestCancelInfoKeysToDelete {
+		delete(data.RequestCancelInfos, k)
+	}
+	for _, k := range execution.SignalInfoKeysToDelete {
+		delete(data.SignalInfos, k)
+	}
+	for _, k := range execution.SignalRequestedIDsKeysToDelete {
+		delete(data.SignalRequestedIDs, k)
+	}
+}
+
+func newWorkflowExecutionCollectionEntry(shardID int, data *workflowExecutionData) (*cadence.WorkflowExecutionCollectionEntry, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.WorkflowExecutionCollectionEntry{
+		ShardID:     shardID,
+		DomainID:    data.ExecutionInfo.DomainID,
+		WorkflowID:  data.ExecutionInfo.WorkflowID,
+		RunID:       data.ExecutionInfo.RunID,
+		NextEventID: data.ExecutionInfo.NextEventID,
+		Data:        encoded,
+	}, nil
+}
+
+func decodeWorkflowExecutionData(entry *cadence.WorkflowExecutionCollectionEntry) (*workflowExecutionData, error) {
+	var data workflowExecutionData
+	if err := json.Unmarshal(entry.Data, &data); err != nil {
+		return nil, err
+	}
+	// make sure the maps are never nil, even they are empty when encoded
+	if data.ActivityInfos == nil {
+		data.ActivityInfos = make(map[int64]*persistence.InternalActivityInfo)
+	}
+	if data.TimerInfos == nil {
+		data.TimerInfos = make(map[string]*persistence.TimerInfo)
+	}
+	if data.ChildExecutionInfos == nil {
+		data.ChildExecutionInfos = make(map[int64]*persistence.InternalChildExecutionInfo)
+	}
+	if data.RequestCancelInfos == nil {
+		data.RequestCancelInfos = make(map[int64]*persistence.RequestCancelInfo)
+	}
+	if data.SignalInfos == nil {
+		data.SignalInfos = make(map[int64]*persistence.SignalInfo)
+	}
+	if data.SignalRequestedIDs == nil {
+		data.SignalRequestedIDs = make(map[string]struct{})
+	}
+	return &data, nil
+}
@@ -147,10 +147,15 @@ type (
 		TaskListType int
 		TaskID       int64
 
-		WorkflowID  string
-		RunID       string
-		ScheduledID int64
-		CreatedTime time.Time
+		WorkflowID     string
+		RunID          string
+		ScheduledID    int64
+		CreatedTime    time.Time
+		IsolationGroup string
+		Priority       int32
+		FairnessKey    string
+		// zero if the task doesn't expire
+		Expiry time.Time
 	}
 
 	// TaskListFilter is for filtering tasklist
@@ -170,6 +175,7 @@ type (
 		TaskListKind    int
 		AckLevel        int64
 		LastUpdatedTime time.Time
+		PartitionConfig *persistence.TaskListPartitionConfig
 	}
 
 	// ListTaskListResult is the result of list tasklists
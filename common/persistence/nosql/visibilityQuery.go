@@ -0,0 +1,328 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nosql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// visibilityQuery is the restricted form of an advanced visibility query which can be served by
+	// the visibility tables of nosql stores: equality on one of WorkflowType, WorkflowID or CloseStatus
+	// and a range on either StartTime or CloseTime.
+	visibilityQuery struct {
+		workflowType *string
+		workflowID   *string
+		closeStatus  *int32
+		// open and closed tell whether open and closed records can match the query
+		open   bool
+		closed bool
+
+		earliestTime time.Time
+		latestTime   time.Time
+		// timeRangeOnCloseTime is true if the time range is on CloseTime instead of StartTime
+		timeRangeOnCloseTime bool
+		hasTimeRange         bool
+	}
+)
+
+const (
+	// missing is the value used by the query to check whether a field is set, e.g. CloseTime = missing
+	visibilityQueryMissingValue = "missing"
+)
+
+var (
+	visibilityQueryMinTime = time.Unix(0, 0)
+	visibilityQueryMaxTime = time.Unix(0, math.MaxInt64)
+)
+
+// parseVisibilityQuery parses the where clause of an advanced visibility query, it returns
+// BadRequestError if the query can't be served by nosql visibility store
+func parseVisibilityQuery(query string) (*visibilityQuery, error) {
+	visibilityQuery := &visibilityQuery{
+		open:         true,
+		closed:       true,
+		earliestTime: visibilityQueryMinTime,
+		latestTime:   visibilityQueryMaxTime,
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return visibilityQuery, nil
+	}
+	if common.IsJustOrderByClause(query) {
+		return nil, newUnsupportedQueryError("order by")
+	}
+
+	stmt, err := sqlparser.Parse(fmt.Sprintf("SELECT * FROM dummy WHERE %s", query))
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid query: %v", err)}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, &types.BadRequestError{Message: "Invalid select query."}
+	}
+	if len(sel.OrderBy) > 0 {
+		return nil, newUnsupportedQueryError("order by")
+	}
+	if sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil, &types.BadRequestError{Message: "Invalid query: only where and order by clauses are supported."}
+	}
+	if err := visibilityQuery.parseExpr(sel.Where.Expr); err != nil {
+		return nil, err
+	}
+	return visibilityQuery, visibilityQuery.validate()
+}
+
+func (q *visibilityQuery) parseExpr(expr sqlparser.Expr) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		if err := q.parseExpr(expr.Left); err != nil {
+			return err
+		}
+		return q.parseExpr(expr.Right)
+	case *sqlparser.ParenExpr:
+		return q.parseExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return q.parseComparison(expr)
+	case *sqlparser.RangeCond:
+		if expr.Operator != sqlparser.BetweenStr {
+			return newUnsupportedQueryError(expr.Operator)
+		}
+		field, err := parseVisibilityQueryField(expr.Left)
+		if err != nil {
+			return err
+		}
+		if err := q.parseTimeRange(field, sqlparser.GreaterEqualStr, expr.From); err != nil {
+			return err
+		}
+		return q.parseTimeRange(field, sqlparser.LessEqualStr, expr.To)
+	case *sqlparser.OrExpr:
+		return newUnsupportedQueryError("or")
+	case *sqlparser.NotExpr:
+		return newUnsupportedQueryError("not")
+	default:
+		return newUnsupportedQueryError(sqlparser.String(expr))
+	}
+}
+
+func (q *visibilityQuery) parseComparison(expr *sqlparser.ComparisonExpr) error {
+	field, err := parseVisibilityQueryField(expr.Left)
+	if err != nil {
+		return err
+	}
+
+	switch field {
+	case definition.WorkflowType, definition.WorkflowID, definition.CloseStatus:
+		if expr.Operator != sqlparser.EqualStr {
+			return newUnsupportedQueryError(fmt.Sprintf("operator %v on %v", expr.Operator, field))
+		}
+		value, err := parseVisibilityQueryValue(expr.Right)
+		if err != nil {
+			return err
+		}
+		return q.setEquality(field, value)
+	case definition.CloseTime:
+		if colName, ok := expr.Right.(*sqlparser.ColName); ok && colName.Name.EqualString(visibilityQueryMissingValue) {
+			switch expr.Operator {
+			case sqlparser.EqualStr:
+				q.closed = false
+			case sqlparser.NotEqualStr:
+				q.open = false
+			default:
+				return newUnsupportedQueryError(fmt.Sprintf("operator %v on missing value", expr.Operator))
+			}
+			return nil
+		}
+		return q.parseTimeRange(field, expr.Operator, expr.Right)
+	case definition.StartTime:
+		return q.parseTimeRange(field, expr.Operator, expr.Right)
+	default:
+		return newUnsupportedQueryError("field " + field)
+	}
+}
+
+func (q *visibilityQuery) setEquality(field string, value string) error {
+	if q.workflowType != nil || q.workflowID != nil || q.closeStatus != nil {
+		return newUnsupportedQueryError("more than one of WorkflowType, WorkflowID and CloseStatus")
+	}
+	switch field {
+	case definition.WorkflowType:
+		q.workflowType = common.StringPtr(value)
+	case definition.WorkflowID:
+		q.workflowID = common.StringPtr(value)
+	case definition.CloseStatus:
+		var status types.WorkflowExecutionCloseStatus
+		if err := status.UnmarshalText([]byte(value)); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid query: invalid CloseStatus %q", value)}
+		}
+		q.closeStatus = common.Int32Ptr(int32(status))
+		q.open = false
+	}
+	return nil
+}
+
+func (q *visibilityQuery) parseTimeRange(field string, operator string, valueExpr sqlparser.Expr) error {
+	onCloseTime := field == definition.CloseTime
+	if field != definition.StartTime && !onCloseTime {
+		return newUnsupportedQueryError("range on field " + field)
+	}
+	if q.hasTimeRange && q.timeRangeOnCloseTime != onCloseTime {
+		return newUnsupportedQueryError("range on both StartTime and CloseTime")
+	}
+	value, err := parseVisibilityQueryValue(valueExpr)
+	if err != nil {
+		return err
+	}
+	t, err := parseVisibilityQueryTime(value)
+	if err != nil {
+		return err
+	}
+
+	switch operator {
+	case sqlparser.GreaterEqualStr:
+	case sqlparser.GreaterThanStr:
+		t = t.Add(time.Nanosecond)
+	case sqlparser.LessEqualStr:
+	case sqlparser.LessThanStr:
+		t = t.Add(-time.Nanosecond)
+	default:
+		return newUnsupportedQueryError(fmt.Sprintf("operator %v on %v", operator, field))
+	}
+	switch operator {
+	case sqlparser.GreaterEqualStr, sqlparser.GreaterThanStr:
+		if t.After(q.earliestTime) {
+			q.earliestTime = t
+		}
+	default:
+		if t.Before(q.latestTime) {
+			q.latestTime = t
+		}
+	}
+	q.hasTimeRange = true
+	q.timeRangeOnCloseTime = onCloseTime
+	if onCloseTime {
+		q.open = false
+	}
+	return nil
+}
+
+func (q *visibilityQuery) validate() error {
+	if !q.open && !q.closed {
+		return &types.BadRequestError{Message: "Invalid query: the query matches neither open nor closed workflows."}
+	}
+	return nil
+}
+
+// isNarrowed returns whether the query filters the records by more than the domain
+func (q *visibilityQuery) isNarrowed() bool {
+	return q.workflowType != nil || q.workflowID != nil || q.closeStatus != nil || q.hasTimeRange
+}
+
+// filters returns the filters to read open and closed records matching the query, in this order
+func (q *visibilityQuery) filters(sortByCloseTime bool) []*nosqlplugin.VisibilityFilter {
+	var filters []*nosqlplugin.VisibilityFilter
+	if q.open {
+		filter := &nosqlplugin.VisibilityFilter{SortType: nosqlplugin.SortByStartTime}
+		switch {
+		case q.workflowType != nil:
+			filter.FilterType = nosqlplugin.OpenByWorkflowType
+			filter.WorkflowType = *q.workflowType
+		case q.workflowID != nil:
+			filter.FilterType = nosqlplugin.OpenByWorkflowID
+			filter.WorkflowID = *q.workflowID
+		default:
+			filter.FilterType = nosqlplugin.AllOpen
+		}
+		filters = append(filters, filter)
+	}
+	if q.closed {
+		filter := &nosqlplugin.VisibilityFilter{SortType: nosqlplugin.SortByStartTime}
+		if q.timeRangeOnCloseTime || (!q.hasTimeRange && sortByCloseTime) {
+			filter.SortType = nosqlplugin.SortByClosedTime
+		}
+		switch {
+		case q.workflowType != nil:
+			filter.FilterType = nosqlplugin.ClosedByWorkflowType
+			filter.WorkflowType = *q.workflowType
+		case q.workflowID != nil:
+			filter.FilterType = nosqlplugin.ClosedByWorkflowID
+			filter.WorkflowID = *q.workflowID
+		case q.closeStatus != nil:
+			filter.FilterType = nosqlplugin.ClosedByClosedStatus
+			filter.CloseStatus = *q.closeStatus
+		default:
+			filter.FilterType = nosqlplugin.AllClosed
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+func parseVisibilityQueryField(expr sqlparser.Expr) (string, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", &types.BadRequestError{Message: fmt.Sprintf("Invalid query: invalid field %q", sqlparser.String(expr))}
+	}
+	name := colName.Name.String()
+	if !colName.Qualifier.IsEmpty() || strings.HasPrefix(name, definition.Attr+".") {
+		return "", newUnsupportedQueryError("custom search attribute " + sqlparser.String(expr))
+	}
+	return name, nil
+}
+
+func parseVisibilityQueryValue(expr sqlparser.Expr) (string, error) {
+	if v, ok := expr.(*sqlparser.SQLVal); ok {
+		switch v.Type {
+		case sqlparser.StrVal, sqlparser.IntVal:
+			return string(v.Val), nil
+		}
+	}
+	return "", &types.BadRequestError{Message: fmt.Sprintf("Invalid query: invalid value %q", sqlparser.String(expr))}
+}
+
+// parseVisibilityQueryTime parses a time value, which is either unix nano or RFC3339, same as ElasticSearch
+func parseVisibilityQueryTime(value string) (time.Time, error) {
+	if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, nanos), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, &types.BadRequestError{Message: fmt.Sprintf("Invalid query: invalid time %q", value)}
+}
+
+func newUnsupportedQueryError(what string) error {
+	return &types.BadRequestError{
+		Message: fmt.Sprintf("Invalid query: %v is not supported by the visibility store without advanced visibility. "+
+			"Only equality on one of WorkflowType, WorkflowID and CloseStatus, and a range on either StartTime or CloseTime are supported.", what),
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestParseVisibilityQuery(t *testing.T) {
+	startTime := time.Unix(0, 1600000000000000000)
+	tests := map[string]struct {
+		query    string
+		expected *visibilityQuery
+	}{
+		"empty query": {
+			query: "",
+			expected: &visibilityQuery{
+				open:         true,
+				closed:       true,
+				earliestTime: visibilityQueryMinTime,
+				latestTime:   visibilityQueryMaxTime,
+			},
+		},
+		"workflow type of open workflows": {
+			query: "WorkflowType = 'type' and CloseTime = missing",
+			expected: &visibilityQuery{
+				workflowType: common.StringPtr("type"),
+				open:         true,
+				earliestTime: visibilityQueryMinTime,
+				latestTime:   visibilityQueryMaxTime,
+			},
+		},
+		"workflow id and start time range": {
+			query: "(WorkflowID = 'wid' and StartTime >= 1600000000000000000) and StartTime < '2020-09-14T12:26:40Z'",
+			expected: &visibilityQuery{
+				workflowID:   common.StringPtr("wid"),
+				open:         true,
+				closed:       true,
+				earliestTime: startTime,
+				latestTime:   startTime.Add(time.Hour * 24).Add(-time.Nanosecond),
+				hasTimeRange: true,
+			},
+		},
+		"close status and close time range": {
+			query: "CloseStatus = 'FAILED' and CloseTime between 1600000000000000000 and 1600000000000000001",
+			expected: &visibilityQuery{
+				closeStatus:          common.Int32Ptr(int32(types.WorkflowExecutionCloseStatusFailed)),
+				closed:               true,
+				earliestTime:         startTime,
+				latestTime:           startTime.Add(time.Nanosecond),
+				hasTimeRange:         true,
+				timeRangeOnCloseTime: true,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := parseVisibilityQuery(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected.workflowType, query.workflowType)
+			assert.Equal(t, test.expected.workflowID, query.workflowID)
+			assert.Equal(t, test.expected.closeStatus, query.closeStatus)
+			assert.Equal(t, test.expected.open, query.open)
+			assert.Equal(t, test.expected.closed, query.closed)
+			assert.True(t, test.expected.earliestTime.Equal(query.earliestTime))
+			assert.True(t, test.expected.latestTime.Equal(query.latestTime))
+			assert.Equal(t, test.expected.hasTimeRange, query.hasTimeRange)
+			assert.Equal(t, test.expected.timeRangeOnCloseTime, query.timeRangeOnCloseTime)
+		})
+	}
+}
+
+func TestParseVisibilityQuery_Unsupported(t *testing.T) {
+	tests := map[string]string{
+		"invalid syntax":          "WorkflowID = ",
+		"or":                      "WorkflowID = 'a' or WorkflowID = 'b'",
+		"not":                     "not WorkflowID = 'a'",
+		"order by":                "WorkflowID = 'a' order by StartTime",
+		"custom search attribute": "`Attr.CustomKeywordField` = 'a'",
+		"unsupported field":       "RunID = 'a'",
+		"multiple equalities":     "WorkflowID = 'a' and WorkflowType = 'b'",
+		"not equal":               "WorkflowType != 'b'",
+		"both time ranges":        "StartTime > 0 and CloseTime > 0",
+		"invalid time":            "StartTime > 'yesterday'",
+		"contradiction":           "CloseStatus = 'FAILED' and CloseTime = missing",
+	}
+
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseVisibilityQuery(query)
+			assert.IsType(t, &types.BadRequestError{}, err)
+		})
+	}
+}
+
+func TestListWorkflowExecutionsByQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db := nosqlplugin.NewMockDB(ctrl)
+	store := &nosqlVisibilityStore{
+		sortByCloseTime: true,
+		nosqlStore: nosqlStore{
+			db:     db,
+			logger: log.NewNoop(),
+		},
+	}
+	ctx := context.Background()
+	openExecution := &nosqlplugin.VisibilityRow{WorkflowID: "wid", RunID: "open-run"}
+	closedExecution := &nosqlplugin.VisibilityRow{WorkflowID: "wid", RunID: "closed-run"}
+
+	gomock.InOrder(
+		db.EXPECT().SelectVisibility(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, filter *nosqlplugin.VisibilityFilter) (*nosqlplugin.SelectVisibilityResponse, error) {
+				assert.Equal(t, nosqlplugin.OpenByWorkflowID, filter.FilterType)
+				assert.Equal(t, "wid", filter.WorkflowID)
+				assert.Empty(t, filter.ListRequest.NextPageToken)
+				return &nosqlplugin.SelectVisibilityResponse{Executions: []*nosqlplugin.VisibilityRow{openExecution}}, nil
+			}),
+		db.EXPECT().SelectVisibility(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, filter *nosqlplugin.VisibilityFilter) (*nosqlplugin.SelectVisibilityResponse, error) {
+				assert.Equal(t, nosqlplugin.ClosedByWorkflowID, filter.FilterType)
+				assert.Equal(t, nosqlplugin.SortByClosedTime, filter.SortType)
+				assert.Empty(t, filter.ListRequest.NextPageToken)
+				return &nosqlplugin.SelectVisibilityResponse{
+					Executions:    []*nosqlplugin.VisibilityRow{closedExecution},
+					NextPageToken: []byte("closed-token"),
+				}, nil
+			}),
+		db.EXPECT().SelectVisibility(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, filter *nosqlplugin.VisibilityFilter) (*nosqlplugin.SelectVisibilityResponse, error) {
+				assert.Equal(t, nosqlplugin.ClosedByWorkflowID, filter.FilterType)
+				assert.Equal(t, []byte("closed-token"), filter.ListRequest.NextPageToken)
+				return &nosqlplugin.SelectVisibilityResponse{}, nil
+			}),
+	)
+
+	request := &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: "domain-id",
+		PageSize:   1,
+		Query:      "WorkflowID = 'wid'",
+	}
+	var runIDs []string
+	for {
+		resp, err := store.ListWorkflowExecutions(ctx, request)
+		require.NoError(t, err)
+		for _, execution := range resp.Executions {
+			runIDs = append(runIDs, execution.RunID)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	assert.Equal(t, []string{"open-run", "closed-run"}, runIDs)
+}
+
+func TestCountWorkflowExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db := nosqlplugin.NewMockDB(ctrl)
+	store := &nosqlVisibilityStore{
+		nosqlStore: nosqlStore{
+			db:     db,
+			logger: log.NewNoop(),
+		},
+	}
+	ctx := context.Background()
+
+	gomock.InOrder(
+		db.EXPECT().SelectVisibility(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, filter *nosqlplugin.VisibilityFilter) (*nosqlplugin.SelectVisibilityResponse, error) {
+				assert.Equal(t, nosqlplugin.ClosedByClosedStatus, filter.FilterType)
+				assert.Equal(t, int32(types.WorkflowExecutionCloseStatusCompleted), filter.CloseStatus)
+				assert.Equal(t, nosqlplugin.SortByStartTime, filter.SortType)
+				assert.Equal(t, countPageSize, filter.ListRequest.PageSize)
+				return &nosqlplugin.SelectVisibilityResponse{
+					Executions:    []*nosqlplugin.VisibilityRow{{}, {}},
+					NextPageToken: []byte("token"),
+				}, nil
+			}),
+		db.EXPECT().SelectVisibility(ctx, gomock.Any()).Return(&nosqlplugin.SelectVisibilityResponse{
+			Executions: []*nosqlplugin.VisibilityRow{{}},
+		}, nil),
+	)
+
+	resp, err := store.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: "domain-id",
+		Query:      "CloseStatus = 'COMPLETED'",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.Count)
+}
+
+func TestCountWorkflowExecutions_NotNarrowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := &nosqlVisibilityStore{
+		nosqlStore: nosqlStore{
+			db:     nosqlplugin.NewMockDB(ctrl),
+			logger: log.NewNoop(),
+		},
+	}
+
+	for _, query := range []string{"", "CloseTime = missing"} {
+		_, err := store.CountWorkflowExecutions(context.Background(), &p.CountWorkflowExecutionsRequest{
+			DomainUUID: "domain-id",
+			Query:      query,
+		})
+		assert.IsType(t, &types.BadRequestError{}, err, query)
+	}
+}
+
+func TestCountWorkflowExecutions_LimitExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db := nosqlplugin.NewMockDB(ctrl)
+	store := &nosqlVisibilityStore{
+		nosqlStore: nosqlStore{
+			db:     db,
+			logger: log.NewNoop(),
+		},
+	}
+	ctx := context.Background()
+
+	db.EXPECT().SelectVisibility(ctx, gomock.Any()).Return(&nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, countPageSize),
+		NextPageToken: []byte("token"),
+	}, nil).Times(countLimit/countPageSize + 1)
+
+	_, err := store.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: "domain-id",
+		Query:      "WorkflowType = 'wtype'",
+	})
+	assert.IsType(t, &types.LimitExceededError{}, err)
+}
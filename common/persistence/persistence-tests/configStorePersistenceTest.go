@@ -33,12 +33,14 @@ import (
 	"github.com/uber/cadence/common/config"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/dynamodb"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/mongodb"
 	"github.com/uber/cadence/common/types"
 )
 
 var supportedPlugins = map[string]bool{
 	cassandra.PluginName: true,
+	dynamodb.PluginName:  true,
 	mongodb.PluginName:   true,
 }
 
@@ -22,6 +22,7 @@ package persistencetests
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -848,12 +849,209 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 			expected: p.ErrVisibilityOperationNotSupported,
 		},
 	}
+	if s.isSQLVisibilityStore() {
+		// SQL visibility stores search attributes in the visibility record
+		tests[1].expected = nil
+	}
 
 	for _, test := range tests {
 		s.Equal(test.expected, s.VisibilityMgr.UpsertWorkflowExecution(ctx, test.request))
 	}
 }
 
+// TestListWorkflowExecutionsByQuery test
+func (s *DBVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	if !s.isSQLVisibilityStore() {
+		s.T().Skip("advanced visibility query is only supported by SQL visibility store")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	executions := make([]types.WorkflowExecution, 3)
+	for i := range executions {
+		executions[i] = types.WorkflowExecution{
+			WorkflowID: fmt.Sprintf("visibility-query-workflow-%v", i),
+			RunID:      uuid.New(),
+		}
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        executions[i],
+			WorkflowTypeName: "visibility-query-workflow",
+			StartTimestamp:   startTime + int64(i),
+			TaskList:         "visibility-query-tasklist",
+			SearchAttributes: map[string][]byte{
+				"CustomKeywordField": []byte(fmt.Sprintf(`"keyword-%v"`, i%2)),
+				"CustomIntField":     []byte(fmt.Sprintf("%v", i)),
+			},
+		})
+		s.Nil(err)
+	}
+
+	err := s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        executions[0],
+		WorkflowTypeName: "visibility-query-workflow",
+		StartTimestamp:   startTime,
+		TaskList:         "visibility-query-tasklist",
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`["keyword-0","keyword-2"]`),
+			"CustomIntField":     []byte("10"),
+		},
+	})
+	s.Nil(err)
+
+	err = s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        executions[1],
+		WorkflowTypeName: "visibility-query-workflow",
+		StartTimestamp:   startTime + 1,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           types.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:    3,
+		TaskList:         "visibility-query-tasklist",
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"keyword-1"`),
+			"CustomIntField":     []byte("1"),
+		},
+	})
+	s.Nil(err)
+
+	listByQuery := func(query string, pageSize int, token []byte) *p.ListWorkflowExecutionsResponse {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID:    testDomainUUID,
+			PageSize:      pageSize,
+			NextPageToken: token,
+			Query:         query,
+		})
+		s.Nil(err)
+		return resp
+	}
+
+	resp := listByQuery("`Attr.CustomKeywordField` = 'keyword-2'", 10, nil)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(executions[0].GetRunID(), resp.Executions[0].Execution.GetRunID())
+	s.Equal("visibility-query-tasklist", resp.Executions[0].TaskList)
+	s.NotNil(resp.Executions[0].SearchAttributes)
+
+	resp = listByQuery("`Attr.CustomIntField` >= 5 and CloseTime = missing", 10, nil)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(executions[0].GetRunID(), resp.Executions[0].Execution.GetRunID())
+
+	resp = listByQuery("CloseStatus = 'COMPLETED'", 10, nil)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(executions[1].GetRunID(), resp.Executions[0].Execution.GetRunID())
+
+	resp = listByQuery("WorkflowType = 'visibility-query-workflow' order by `Attr.CustomIntField` desc", 2, nil)
+	s.Equal(2, len(resp.Executions))
+	s.Equal(executions[0].GetRunID(), resp.Executions[0].Execution.GetRunID())
+	s.Equal(executions[2].GetRunID(), resp.Executions[1].Execution.GetRunID())
+	s.NotEmpty(resp.NextPageToken)
+	resp = listByQuery("WorkflowType = 'visibility-query-workflow' order by `Attr.CustomIntField` desc", 2, resp.NextPageToken)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(executions[1].GetRunID(), resp.Executions[0].Execution.GetRunID())
+
+	var runIDs []string
+	var token []byte
+	for {
+		resp = listByQuery("", 1, token)
+		for _, execution := range resp.Executions {
+			runIDs = append(runIDs, execution.Execution.GetRunID())
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		token = resp.NextPageToken
+	}
+	s.Equal([]string{executions[2].GetRunID(), executions[1].GetRunID(), executions[0].GetRunID()}, runIDs)
+
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      "`Attr.CustomKeywordField` in ('keyword-0', 'keyword-1')",
+	})
+	s.Nil(err)
+	s.Equal(int64(3), countResp.Count)
+
+	_, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "`Attr.CustomKeywordField` = 'keyword-0' group by WorkflowID",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	_, err = s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      "`Attr.UnknownField` = 'keyword-0'",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+// TestListWorkflowExecutionsByRestrictedQuery tests the queries supported by all visibility stores
+func (s *DBVisibilityPersistenceSuite) TestListWorkflowExecutionsByRestrictedQuery() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	openExecution := types.WorkflowExecution{WorkflowID: "visibility-restricted-query-workflow", RunID: uuid.New()}
+	closedExecution := types.WorkflowExecution{WorkflowID: "visibility-restricted-query-workflow", RunID: uuid.New()}
+	for _, execution := range []types.WorkflowExecution{openExecution, closedExecution} {
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        execution,
+			WorkflowTypeName: "visibility-restricted-query-workflow",
+			StartTimestamp:   startTime,
+		})
+		s.Nil(err)
+	}
+	err := s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-restricted-query-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           types.WorkflowExecutionCloseStatusTerminated,
+		HistoryLength:    3,
+	})
+	s.Nil(err)
+
+	listByQuery := func(query string) []string {
+		var runIDs []string
+		var token []byte
+		for {
+			resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+				DomainUUID:    testDomainUUID,
+				PageSize:      1,
+				NextPageToken: token,
+				Query:         query,
+			})
+			s.Nil(err)
+			for _, execution := range resp.Executions {
+				runIDs = append(runIDs, execution.Execution.GetRunID())
+			}
+			if len(resp.NextPageToken) == 0 {
+				return runIDs
+			}
+			token = resp.NextPageToken
+		}
+	}
+
+	s.ElementsMatch([]string{openExecution.RunID, closedExecution.RunID},
+		listByQuery("WorkflowID = 'visibility-restricted-query-workflow'"))
+	s.Equal([]string{openExecution.RunID},
+		listByQuery(fmt.Sprintf("WorkflowType = 'visibility-restricted-query-workflow' and CloseTime = missing and StartTime >= %v", startTime)))
+	s.Equal([]string{closedExecution.RunID}, listByQuery("CloseStatus = 'TERMINATED'"))
+	s.Empty(listByQuery(fmt.Sprintf("StartTime > %v", startTime)))
+
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      "WorkflowType = 'visibility-restricted-query-workflow'",
+	})
+	s.Nil(err)
+	s.Equal(int64(2), countResp.Count)
+}
+
 func (s *DBVisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *types.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunID, resp.Execution.RunID)
@@ -876,6 +1074,16 @@ func (s *DBVisibilityPersistenceSuite) assertOpenExecutionEquals(
 	s.Zero(resp.HistoryLength)
 }
 
+func (s *DBVisibilityPersistenceSuite) isSQLVisibilityStore() bool {
+	cfg := s.VisibilityTestCluster.Config()
+	storeName := cfg.VisibilityStore
+	if storeName == "" {
+		storeName = cfg.DefaultStore
+	}
+	store, ok := cfg.DataStores[storeName]
+	return ok && store.SQL != nil
+}
+
 func (s *DBVisibilityPersistenceSuite) nanosToMillis(nanos int64) int64 {
 	return nanos / int64(time.Millisecond)
 }
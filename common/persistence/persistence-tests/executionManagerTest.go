@@ -3337,6 +3337,9 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateInfo() {
 	updatedStats := copyExecutionStats(state0.ExecutionStats)
 	updatedInfo.NextEventID = int64(5)
 	updatedInfo.LastProcessedEvent = int64(2)
+	updatedInfo.Paused = true
+	updatedInfo.PendingUpdateIDs = map[string]int64{"update-1": 3}
+	updatedInfo.CompletedUpdateIDs = map[string]int64{"update-2": 4}
 	versionHistory := p.NewVersionHistory([]byte{}, []*p.VersionHistoryItem{
 		{
 			EventID: updatedInfo.LastProcessedEvent,
@@ -3353,6 +3356,9 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateInfo() {
 	s.NotNil(state.ExecutionInfo, "expected valid MS Info state.")
 	s.Equal(updatedInfo.NextEventID, state.ExecutionInfo.NextEventID)
 	s.Equal(updatedInfo.State, state.ExecutionInfo.State)
+	s.True(state.ExecutionInfo.Paused)
+	s.Equal(updatedInfo.PendingUpdateIDs, state.ExecutionInfo.PendingUpdateIDs)
+	s.Equal(updatedInfo.CompletedUpdateIDs, state.ExecutionInfo.CompletedUpdateIDs)
 }
 
 // TestContinueAsNew test
@@ -5377,6 +5383,9 @@ func copyWorkflowExecutionInfo(sourceInfo *p.WorkflowExecutionInfo) *p.WorkflowE
 		DecisionTimeout:             sourceInfo.DecisionTimeout,
 		BranchToken:                 sourceInfo.BranchToken,
 		AutoResetPoints:             sourceInfo.AutoResetPoints,
+		Paused:                      sourceInfo.Paused,
+		PendingUpdateIDs:            sourceInfo.PendingUpdateIDs,
+		CompletedUpdateIDs:          sourceInfo.CompletedUpdateIDs,
 	}
 }
 
@@ -136,6 +136,157 @@ func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	s.Equal(int64(5), tasks1Response.Tasks[0].ScheduleID)
 }
 
+// TestCreateTaskWithIsolationGroup test
+func (s *MatchingPersistenceSuite) TestCreateTaskWithIsolationGroup() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := "2a2f9e2a-5b0a-4c1c-9b3e-3c8a7e0e5d11"
+	workflowExecution := types.WorkflowExecution{WorkflowID: "isolation-group-task-test",
+		RunID: "5c8e6a8e-3f0b-4e36-a7f1-93a9f1a5d2c4"}
+	taskList := "93a9f1a5d2c4"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	leaseResponse.TaskListInfo.LastUpdated = time.Time{}
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:       domainID,
+					WorkflowID:     workflowExecution.WorkflowID,
+					RunID:          workflowExecution.RunID,
+					TaskID:         taskID,
+					ScheduleID:     7,
+					IsolationGroup: "zone-a",
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(ctx, domainID, taskList, p.TaskListTypeDecision, 1)
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal(int64(7), resp.Tasks[0].ScheduleID)
+	s.Equal("zone-a", resp.Tasks[0].IsolationGroup)
+}
+
+// TestCreateTaskWithFairnessKey test
+func (s *MatchingPersistenceSuite) TestCreateTaskWithFairnessKey() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := "8b4d1f3a-2c6e-4a9b-b7d5-1e3f5a7c9b2d"
+	workflowExecution := types.WorkflowExecution{WorkflowID: "fairness-key-task-test",
+		RunID: "3e5a7c9b-1d2f-4b6a-8c0e-2f4a6b8d0c1e"}
+	taskList := "2f4a6b8d0c1e"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	leaseResponse.TaskListInfo.LastUpdated = time.Time{}
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:    domainID,
+					WorkflowID:  workflowExecution.WorkflowID,
+					RunID:       workflowExecution.RunID,
+					TaskID:      taskID,
+					ScheduleID:  5,
+					FairnessKey: "tenant-a",
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(ctx, domainID, taskList, p.TaskListTypeActivity, 1)
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal("tenant-a", resp.Tasks[0].FairnessKey)
+
+	_, err = s.TaskMgr.CompleteTasksLessThan(ctx, &p.CompleteTasksLessThanRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskType:     p.TaskListTypeActivity,
+		TaskID:       taskID + 1,
+		Limit:        1,
+	})
+	s.NoError(err)
+}
+
+// TestCreateTaskWithPriority test
+func (s *MatchingPersistenceSuite) TestCreateTaskWithPriority() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := "6f1c2d4e-8a3b-4c5d-9e7f-0a1b2c3d4e5f"
+	workflowExecution := types.WorkflowExecution{WorkflowID: "priority-task-test",
+		RunID: "0d9c8b7a-6f5e-4d3c-2b1a-0f9e8d7c6b5a"}
+	taskList := "0f9e8d7c6b5a"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	leaseResponse.TaskListInfo.LastUpdated = time.Time{}
+
+	taskIDs := []int64{s.GetNextSequenceNumber(), s.GetNextSequenceNumber()}
+	tasks := make([]*p.CreateTaskInfo, 0, len(taskIDs))
+	for i, taskID := range taskIDs {
+		tasks = append(tasks, &p.CreateTaskInfo{
+			TaskID:    taskID,
+			Execution: workflowExecution,
+			Data: &p.TaskInfo{
+				DomainID:   domainID,
+				WorkflowID: workflowExecution.WorkflowID,
+				RunID:      workflowExecution.RunID,
+				TaskID:     taskID,
+				ScheduleID: int64(i + 1),
+				Priority:   int32(i * 3),
+			},
+		})
+	}
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks:        tasks,
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(ctx, domainID, taskList, p.TaskListTypeActivity, 2)
+	s.NoError(err)
+	s.Equal(2, len(resp.Tasks))
+	s.Equal(int32(0), resp.Tasks[0].Priority)
+	s.Equal(int32(3), resp.Tasks[1].Priority)
+
+	_, err = s.TaskMgr.CompleteTasksLessThan(ctx, &p.CompleteTasksLessThanRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskType:     p.TaskListTypeActivity,
+		TaskID:       taskIDs[1] + 1,
+		Limit:        len(taskIDs),
+	})
+	s.NoError(err)
+}
+
 // TestGetTasksWithNoMaxReadLevel test
 func (s *MatchingPersistenceSuite) TestGetTasksWithNoMaxReadLevel() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
@@ -361,6 +512,51 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.Error(err)
 }
 
+// TestUpdateTaskListPartitionConfig test
+func (s *MatchingPersistenceSuite) TestUpdateTaskListPartitionConfig() {
+	domainID := uuid.New()
+	taskList := "partitioned-tl"
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	response, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	s.Nil(response.TaskListInfo.PartitionConfig)
+
+	partitionConfig := &p.TaskListPartitionConfig{
+		Version:            2,
+		NumReadPartitions:  4,
+		NumWritePartitions: 3,
+	}
+	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
+		TaskListInfo: &p.TaskListInfo{
+			DomainID:        domainID,
+			Name:            taskList,
+			TaskType:        p.TaskListTypeDecision,
+			RangeID:         response.TaskListInfo.RangeID,
+			AckLevel:        0,
+			Kind:            p.TaskListKindNormal,
+			PartitionConfig: partitionConfig,
+		},
+	})
+	s.NoError(err)
+
+	// the partition config must survive a lease renewal
+	response, err = s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+		RangeID:  response.TaskListInfo.RangeID,
+	})
+	s.NoError(err)
+	s.Equal(partitionConfig, response.TaskListInfo.PartitionConfig)
+}
+
 // TestLeaseAndUpdateTaskListSticky test
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
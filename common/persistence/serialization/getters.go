@@ -876,6 +876,30 @@ func (w *WorkflowExecutionInfo) GetFirstExecutionRunID() (o []byte) {
 	return
 }
 
+// GetPaused internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if w != nil {
+		return w.Paused
+	}
+	return
+}
+
+// GetPendingUpdateIDs internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPendingUpdateIDs() (o map[string]int64) {
+	if w != nil {
+		return w.PendingUpdateIDs
+	}
+	return
+}
+
+// GetCompletedUpdateIDs internal sql blob getter
+func (w *WorkflowExecutionInfo) GetCompletedUpdateIDs() (o map[string]int64) {
+	if w != nil {
+		return w.CompletedUpdateIDs
+	}
+	return
+}
+
 // GetVersion internal sql blob getter
 func (a *ActivityInfo) GetVersion() (o int64) {
 	if a != nil {
@@ -1380,6 +1404,30 @@ func (t *TaskInfo) GetCreatedTimestamp() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetIsolationGroup internal sql blob getter
+func (t *TaskInfo) GetIsolationGroup() (o string) {
+	if t != nil {
+		return t.IsolationGroup
+	}
+	return
+}
+
+// GetPriority internal sql blob getter
+func (t *TaskInfo) GetPriority() (o int32) {
+	if t != nil {
+		return t.Priority
+	}
+	return
+}
+
+// GetFairnessKey internal sql blob getter
+func (t *TaskInfo) GetFairnessKey() (o string) {
+	if t != nil {
+		return t.FairnessKey
+	}
+	return
+}
+
 // GetKind internal sql blob getter
 func (t *TaskListInfo) GetKind() (o int16) {
 	if t != nil {
@@ -1412,6 +1460,30 @@ func (t *TaskListInfo) GetLastUpdated() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetPartitionConfigVersion internal sql blob getter
+func (t *TaskListInfo) GetPartitionConfigVersion() (o int64) {
+	if t != nil {
+		return t.PartitionConfigVersion
+	}
+	return
+}
+
+// GetNumReadPartitions internal sql blob getter
+func (t *TaskListInfo) GetNumReadPartitions() (o int32) {
+	if t != nil {
+		return t.NumReadPartitions
+	}
+	return
+}
+
+// GetNumWritePartitions internal sql blob getter
+func (t *TaskListInfo) GetNumWritePartitions() (o int32) {
+	if t != nil {
+		return t.NumWritePartitions
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *TransferTaskInfo) GetDomainID() (o []byte) {
 	if t != nil {
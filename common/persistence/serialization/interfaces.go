@@ -162,6 +162,9 @@ type (
 		VersionHistories                   []byte
 		VersionHistoriesEncoding           string
 		FirstExecutionRunID                UUID
+		Paused                             bool
+		PendingUpdateIDs                   map[string]int64
+		CompletedUpdateIDs                 map[string]int64
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -249,14 +252,20 @@ type (
 		ScheduleID       int64
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
+		IsolationGroup   string
+		Priority         int32
+		FairnessKey      string
 	}
 
 	// TaskListInfo blob in a serialization agnostic format
 	TaskListInfo struct {
-		Kind            int16
-		AckLevel        int64
-		ExpiryTimestamp time.Time
-		LastUpdated     time.Time
+		Kind                   int16
+		AckLevel               int64
+		ExpiryTimestamp        time.Time
+		LastUpdated            time.Time
+		PartitionConfigVersion int64
+		NumReadPartitions      int32
+		NumWritePartitions     int32
 	}
 
 	// TransferTaskInfo blob in a serialization agnostic format
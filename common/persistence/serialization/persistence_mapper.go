@@ -73,6 +73,9 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		SearchAttributes:                   info.GetSearchAttributes(),
 		HistorySize:                        info.GetHistorySize(),
 		FirstExecutionRunID:                info.FirstExecutionRunID.String(),
+		Paused:                             info.GetPaused(),
+		PendingUpdateIDs:                   info.GetPendingUpdateIDs(),
+		CompletedUpdateIDs:                 info.GetCompletedUpdateIDs(),
 	}
 	if info.ParentDomainID != nil {
 		result.ParentDomainID = info.ParentDomainID.String()
@@ -154,6 +157,9 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		VersionHistoriesEncoding:           string(common.EncodingTypeEmpty),
 		InitiatedID:                        common.EmptyEventID,
 		FirstExecutionRunID:                MustParseUUID(executionInfo.FirstExecutionRunID),
+		Paused:                             executionInfo.Paused,
+		PendingUpdateIDs:                   executionInfo.PendingUpdateIDs,
+		CompletedUpdateIDs:                 executionInfo.CompletedUpdateIDs,
 	}
 
 	if executionInfo.CompletionEvent != nil {
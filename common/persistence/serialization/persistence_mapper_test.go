@@ -88,6 +88,9 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 		Memo:                               map[string][]byte{"key_1": []byte("Memo")},
 		SearchAttributes:                   map[string][]byte{"key_1": []byte("SearchAttributes")},
 		HistorySize:                        int64(rand.Intn(1000)),
+		Paused:                             true,
+		PendingUpdateIDs:                   map[string]int64{"update_1": int64(rand.Intn(1000))},
+		CompletedUpdateIDs:                 map[string]int64{"update_2": int64(rand.Intn(1000))},
 	}
 	actual := ToInternalWorkflowExecutionInfo(FromInternalWorkflowExecutionInfo(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -138,6 +141,9 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.NonRetriableErrors, actual.NonRetriableErrors)
 	assert.Equal(t, expected.BranchToken, actual.BranchToken)
 	assert.Equal(t, expected.CronSchedule, actual.CronSchedule)
+	assert.Equal(t, expected.Paused, actual.Paused)
+	assert.Equal(t, expected.PendingUpdateIDs, actual.PendingUpdateIDs)
+	assert.Equal(t, expected.CompletedUpdateIDs, actual.CompletedUpdateIDs)
 	assert.True(t, (expected.ExpirationInterval-actual.ExpirationInterval) < time.Second)
 	assert.Equal(t, expected.Memo, actual.Memo)
 	assert.Equal(t, expected.SearchAttributes, actual.SearchAttributes)
@@ -247,6 +247,9 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		VersionHistories:                        info.VersionHistories,
 		VersionHistoriesEncoding:                &info.VersionHistoriesEncoding,
 		FirstExecutionRunID:                     info.FirstExecutionRunID,
+		Paused:                                  &info.Paused,
+		PendingUpdateIDs:                        info.PendingUpdateIDs,
+		CompletedUpdateIDs:                      info.CompletedUpdateIDs,
 	}
 }
 
@@ -314,6 +317,9 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		VersionHistories:                   info.VersionHistories,
 		VersionHistoriesEncoding:           info.GetVersionHistoriesEncoding(),
 		FirstExecutionRunID:                info.FirstExecutionRunID,
+		Paused:                             info.GetPaused(),
+		PendingUpdateIDs:                   info.PendingUpdateIDs,
+		CompletedUpdateIDs:                 info.CompletedUpdateIDs,
 	}
 }
 
@@ -523,6 +529,9 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ScheduleID:       &info.ScheduleID,
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
+		IsolationGroup:   &info.IsolationGroup,
+		Priority:         &info.Priority,
+		FairnessKey:      &info.FairnessKey,
 	}
 }
 
@@ -536,6 +545,9 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ScheduleID:       info.GetScheduleID(),
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
+		IsolationGroup:   info.GetIsolationGroup(),
+		Priority:         info.GetPriority(),
+		FairnessKey:      info.GetFairnessKey(),
 	}
 }
 
@@ -544,10 +556,13 @@ func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 		return nil
 	}
 	return &sqlblobs.TaskListInfo{
-		Kind:             &info.Kind,
-		AckLevel:         &info.AckLevel,
-		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
-		LastUpdatedNanos: timeToUnixNanoPtr(info.LastUpdated),
+		Kind:                   &info.Kind,
+		AckLevel:               &info.AckLevel,
+		ExpiryTimeNanos:        timeToUnixNanoPtr(info.ExpiryTimestamp),
+		LastUpdatedNanos:       timeToUnixNanoPtr(info.LastUpdated),
+		PartitionConfigVersion: &info.PartitionConfigVersion,
+		NumReadPartitions:      &info.NumReadPartitions,
+		NumWritePartitions:     &info.NumWritePartitions,
 	}
 }
 
@@ -556,10 +571,13 @@ func taskListInfoFromThrift(info *sqlblobs.TaskListInfo) *TaskListInfo {
 		return nil
 	}
 	return &TaskListInfo{
-		Kind:            info.GetKind(),
-		AckLevel:        info.GetAckLevel(),
-		ExpiryTimestamp: timeFromUnixNano(info.GetExpiryTimeNanos()),
-		LastUpdated:     timeFromUnixNano(info.GetLastUpdatedNanos()),
+		Kind:                   info.GetKind(),
+		AckLevel:               info.GetAckLevel(),
+		ExpiryTimestamp:        timeFromUnixNano(info.GetExpiryTimeNanos()),
+		LastUpdated:            timeFromUnixNano(info.GetLastUpdatedNanos()),
+		PartitionConfigVersion: info.GetPartitionConfigVersion(),
+		NumReadPartitions:      info.GetNumReadPartitions(),
+		NumWritePartitions:     info.GetNumWritePartitions(),
 	}
 }
 
@@ -214,6 +214,9 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		Memo:                               map[string][]byte{"key_1": []byte("Memo")},
 		VersionHistories:                   []byte("VersionHistories"),
 		VersionHistoriesEncoding:           "VersionHistoriesEncoding",
+		Paused:                             true,
+		PendingUpdateIDs:                   map[string]int64{"update_1": int64(rand.Intn(1000))},
+		CompletedUpdateIDs:                 map[string]int64{"update_2": int64(rand.Intn(1000))},
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -268,6 +271,9 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.Memo, actual.Memo)
 	assert.Equal(t, expected.VersionHistories, actual.VersionHistories)
 	assert.Equal(t, expected.VersionHistoriesEncoding, actual.VersionHistoriesEncoding)
+	assert.Equal(t, expected.Paused, actual.Paused)
+	assert.Equal(t, expected.PendingUpdateIDs, actual.PendingUpdateIDs)
+	assert.Equal(t, expected.CompletedUpdateIDs, actual.CompletedUpdateIDs)
 	assert.Equal(t, expected.RetryExpirationTimestamp.Sub(actual.RetryExpirationTimestamp), time.Duration(0))
 	assert.True(t, (expected.StickyScheduleToStartTimeout-actual.StickyScheduleToStartTimeout) < time.Second)
 	assert.True(t, (expected.RetryInitialInterval-actual.RetryInitialInterval) < time.Second)
@@ -408,9 +414,15 @@ func TestTaskInfo(t *testing.T) {
 		ScheduleID:       int64(rand.Intn(1000)),
 		ExpiryTimestamp:  time.Now(),
 		CreatedTimestamp: time.Now(),
+		IsolationGroup:   "zone-a",
+		Priority:         2,
+		FairnessKey:      "tenant-a",
 	}
 	actual := taskInfoFromThrift(taskInfoToThrift(expected))
 	assert.Equal(t, expected.WorkflowID, actual.WorkflowID)
+	assert.Equal(t, expected.IsolationGroup, actual.IsolationGroup)
+	assert.Equal(t, expected.Priority, actual.Priority)
+	assert.Equal(t, expected.FairnessKey, actual.FairnessKey)
 	assert.Equal(t, expected.RunID, actual.RunID)
 	assert.Equal(t, expected.ScheduleID, actual.ScheduleID)
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
@@ -419,14 +431,20 @@ func TestTaskInfo(t *testing.T) {
 
 func TestTaskListInfo(t *testing.T) {
 	expected := &TaskListInfo{
-		Kind:            int16(rand.Intn(1000)),
-		AckLevel:        int64(rand.Intn(1000)),
-		ExpiryTimestamp: time.Now(),
-		LastUpdated:     time.Now(),
+		Kind:                   int16(rand.Intn(1000)),
+		AckLevel:               int64(rand.Intn(1000)),
+		ExpiryTimestamp:        time.Now(),
+		LastUpdated:            time.Now(),
+		PartitionConfigVersion: int64(rand.Intn(1000)),
+		NumReadPartitions:      int32(rand.Intn(1000)),
+		NumWritePartitions:     int32(rand.Intn(1000)),
 	}
 	actual := taskListInfoFromThrift(taskListInfoToThrift(expected))
 	assert.Equal(t, expected.Kind, actual.Kind)
 	assert.Equal(t, expected.AckLevel, actual.AckLevel)
+	assert.Equal(t, expected.PartitionConfigVersion, actual.PartitionConfigVersion)
+	assert.Equal(t, expected.NumReadPartitions, actual.NumReadPartitions)
+	assert.Equal(t, expected.NumWritePartitions, actual.NumWritePartitions)
 	assert.Equal(t, expected.LastUpdated.Sub(actual.LastUpdated), time.Duration(0))
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 }
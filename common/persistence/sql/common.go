@@ -129,6 +129,7 @@ func convertCommonErrors(
 		*types.DomainAlreadyExistsError,
 		*types.EntityNotExistsError,
 		*types.ServiceBusyError,
+		*types.BadRequestError,
 		*types.InternalServiceError:
 		return err
 	}
@@ -61,12 +61,16 @@ func (m *sqlShardStore) CreateShard(
 	ctx context.Context,
 	request *persistence.InternalCreateShardRequest,
 ) error {
-	if _, err := m.GetShard(ctx, &persistence.InternalGetShardRequest{
+	_, err := m.GetShard(ctx, &persistence.InternalGetShardRequest{
 		ShardID: request.ShardInfo.ShardID,
-	}); err == nil {
+	})
+	switch err.(type) {
+	case nil:
 		return &persistence.ShardAlreadyExistError{
 			Msg: fmt.Sprintf("CreateShard operation failed. Shard with ID %v already exists.", request.ShardInfo.ShardID),
 		}
+	case *types.ServiceBusyError:
+		return err
 	}
 
 	row, err := shardInfoToShardsRow(*request.ShardInfo, m.parser)
@@ -87,6 +91,18 @@ func (m *sqlShardStore) GetShard(
 	ctx context.Context,
 	request *persistence.InternalGetShardRequest,
 ) (*persistence.InternalGetShardResponse, error) {
+	// the shard is read when history acquires it, so the DB shard map is reloaded to route
+	// the shard to the DB shard it was moved to, and to not acquire it while it is being moved
+	shardMap, err := m.db.RefreshDBShardMap(ctx)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetShard", "Failed to refresh DB shard map.", err)
+	}
+	if move, ok := shardMap.GetMove(request.ShardID); ok {
+		return nil, &types.ServiceBusyError{
+			Message: fmt.Sprintf("GetShard operation failed. Shard %v is being moved to DB shard %v.", request.ShardID, move.DBShardID),
+		}
+	}
+
 	row, err := m.db.SelectFromShards(ctx, &sqlplugin.ShardsFilter{ShardID: int64(request.ShardID)})
 	if err != nil {
 		return nil, convertCommonErrors(m.db, "GetShard", fmt.Sprintf("Failed to get shard, ShardId: %v.", request.ShardID), err)
@@ -177,7 +193,7 @@ func (m *sqlShardStore) UpdateShard(
 			Message: fmt.Sprintf("UpdateShard operation failed. Error: %v", err),
 		}
 	}
-	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(request.ShardInfo.ShardID, m.db.GetTotalNumDBShards())
+	dbShardID := m.db.GetDBShardIDFromHistoryShardID(request.ShardInfo.ShardID)
 	return m.txExecute(ctx, dbShardID, "UpdateShard", func(tx sqlplugin.Tx) error {
 		if err := lockShard(ctx, tx, request.ShardInfo.ShardID, request.PreviousRangeID); err != nil {
 			return err
@@ -174,13 +174,14 @@ func (m *sqlTaskStore) LeaseTaskList(
 			return fmt.Errorf("%v rows affected instead of 1", rowsAffected)
 		}
 		resp = &persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
-			DomainID:    request.DomainID,
-			Name:        request.TaskList,
-			TaskType:    request.TaskType,
-			RangeID:     rangeID + 1,
-			AckLevel:    ackLevel,
-			Kind:        request.TaskListKind,
-			LastUpdated: now,
+			DomainID:        request.DomainID,
+			Name:            request.TaskList,
+			TaskType:        request.TaskType,
+			RangeID:         rangeID + 1,
+			AckLevel:        ackLevel,
+			Kind:            request.TaskListKind,
+			LastUpdated:     now,
+			PartitionConfig: taskListPartitionConfigFromBlob(tlInfo),
 		}}
 		return nil
 	})
@@ -202,6 +203,11 @@ func (m *sqlTaskStore) UpdateTaskList(
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		tlInfo.ExpiryTimestamp = stickyTaskListExpiry()
 	}
+	if config := request.TaskListInfo.PartitionConfig; config != nil {
+		tlInfo.PartitionConfigVersion = config.Version
+		tlInfo.NumReadPartitions = int32(config.NumReadPartitions)
+		tlInfo.NumWritePartitions = int32(config.NumWritePartitions)
+	}
 
 	var resp *persistence.UpdateTaskListResponse
 	blob, err := m.parser.TaskListInfoToBlob(tlInfo)
@@ -248,6 +254,17 @@ func (m *sqlTaskStore) UpdateTaskList(
 	return resp, err
 }
 
+func taskListPartitionConfigFromBlob(info *serialization.TaskListInfo) *persistence.TaskListPartitionConfig {
+	if info.GetPartitionConfigVersion() == 0 {
+		return nil
+	}
+	return &persistence.TaskListPartitionConfig{
+		Version:            info.GetPartitionConfigVersion(),
+		NumReadPartitions:  int(info.GetNumReadPartitions()),
+		NumWritePartitions: int(info.GetNumWritePartitions()),
+	}
+}
+
 type taskListPageToken struct {
 	ShardID  int
 	DomainID serialization.UUID
@@ -325,6 +342,7 @@ func (m *sqlTaskStore) ListTaskList(
 		resp.Items[i].AckLevel = info.GetAckLevel()
 		resp.Items[i].Expiry = info.GetExpiryTimestamp()
 		resp.Items[i].LastUpdated = info.GetLastUpdated()
+		resp.Items[i].PartitionConfig = taskListPartitionConfigFromBlob(info)
 	}
 
 	return resp, nil
@@ -392,6 +410,9 @@ func (m *sqlTaskStore) CreateTasks(
 			ScheduleID:       v.Data.ScheduleID,
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: time.Now(),
+			IsolationGroup:   v.Data.IsolationGroup,
+			Priority:         v.Data.Priority,
+			FairnessKey:      v.Data.FairnessKey,
 		})
 		if err != nil {
 			return nil, err
@@ -471,13 +492,16 @@ func (m *sqlTaskStore) GetTasks(
 			return nil, err
 		}
 		tasks[i] = &persistence.InternalTaskInfo{
-			DomainID:    request.DomainID,
-			WorkflowID:  info.GetWorkflowID(),
-			RunID:       info.RunID.String(),
-			TaskID:      v.TaskID,
-			ScheduleID:  info.GetScheduleID(),
-			Expiry:      info.GetExpiryTimestamp(),
-			CreatedTime: info.GetCreatedTimestamp(),
+			DomainID:       request.DomainID,
+			WorkflowID:     info.GetWorkflowID(),
+			RunID:          info.RunID.String(),
+			TaskID:         v.TaskID,
+			ScheduleID:     info.GetScheduleID(),
+			Expiry:         info.GetExpiryTimestamp(),
+			CreatedTime:    info.GetCreatedTimestamp(),
+			IsolationGroup: info.GetIsolationGroup(),
+			Priority:       info.GetPriority(),
+			FairnessKey:    info.GetFairnessKey(),
 		}
 	}
 
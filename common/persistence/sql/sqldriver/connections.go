@@ -33,7 +33,8 @@ type CreateSingleDBConn func(cfg *config.SQL) (*sqlx.DB, error)
 // CreateDBConnections returns references to logical connections to the underlying SQL databases.
 // By default when UseMultipleDatabases == false, the returned object is to tied to a single
 // SQL database and the object can be used to perform CRUD operations on the tables in the database.
-// If UseMultipleDatabases == true then return connections to all the databases
+// If UseMultipleDatabases == true then return connections to all the databases, including the ones
+// beyond NumShards which only store the history shards assigned to them by the DB shard map
 func CreateDBConnections(cfg *config.SQL, createConnFunc CreateSingleDBConn) ([]*sqlx.DB, error) {
 	if !cfg.UseMultipleDatabases {
 		xdb, err := createConnFunc(cfg)
@@ -42,8 +43,8 @@ func CreateDBConnections(cfg *config.SQL, createConnFunc CreateSingleDBConn) ([]
 		}
 		return []*sqlx.DB{xdb}, nil
 	}
-	if cfg.NumShards <= 1 || len(cfg.MultipleDatabasesConfig) != cfg.NumShards {
-		return nil, fmt.Errorf("invalid SQL config. NumShards should be > 1 and equal to the length of MultipleDatabasesConfig")
+	if cfg.NumShards <= 1 || len(cfg.MultipleDatabasesConfig) < cfg.NumShards {
+		return nil, fmt.Errorf("invalid SQL config. NumShards should be > 1 and not greater than the length of MultipleDatabasesConfig")
 	}
 
 	// recover from the original at the end
@@ -54,7 +55,7 @@ func CreateDBConnections(cfg *config.SQL, createConnFunc CreateSingleDBConn) ([]
 		cfg.ConnectAddr = ""
 	}()
 
-	xdbs := make([]*sqlx.DB, cfg.NumShards)
+	xdbs := make([]*sqlx.DB, len(cfg.MultipleDatabasesConfig))
 	for idx, entry := range cfg.MultipleDatabasesConfig {
 		cfg.User = entry.User
 		cfg.Password = entry.Password
@@ -51,6 +51,8 @@ type (
 		SelectForSchemaQuery(dbShardID int, dest interface{}, query string, args ...interface{}) error
 		// GetForSchemaQuery executes a get query for schema(returning single row).
 		GetForSchemaQuery(dbShardID int, dest interface{}, query string, args ...interface{}) error
+		// Rebind transforms a query from QUESTION to the bindvar type of the database
+		Rebind(query string) string
 	}
 
 	// the methods can be executed from either a started or transaction(then need to call Commit/Rollback), or without a transaction
@@ -59,5 +61,6 @@ type (
 		NamedExecContext(ctx context.Context, dbShardID int, query string, arg interface{}) (sql.Result, error)
 		GetContext(ctx context.Context, dbShardID int, dest interface{}, query string, args ...interface{}) error
 		SelectContext(ctx context.Context, dbShardID int, dest interface{}, query string, args ...interface{}) error
+		QueryxContext(ctx context.Context, dbShardID int, query string, args ...interface{}) (*sqlx.Rows, error)
 	}
 )
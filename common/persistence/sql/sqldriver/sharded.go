@@ -111,6 +111,19 @@ func (s *sharded) SelectContext(ctx context.Context, dbShardID int, dest interfa
 
 }
 
+func (s *sharded) QueryxContext(ctx context.Context, dbShardID int, query string, args ...interface{}) (*sqlx.Rows, error) {
+	if dbShardID == sqlplugin.DbShardUndefined || dbShardID == sqlplugin.DbAllShards {
+		return nil, fmt.Errorf("invalid dbShardID %v shouldn't be used to QueryxContext, there must be a bug", dbShardID)
+	}
+	if s.useTx {
+		if s.currTxShardID != dbShardID {
+			return nil, getUnmatchedTxnError(dbShardID, s.currTxShardID)
+		}
+		return s.tx.QueryxContext(ctx, query, args...)
+	}
+	return s.dbs[dbShardID].QueryxContext(ctx, query, args...)
+}
+
 // below are non-transactional methods only
 
 func (s *sharded) ExecDDL(ctx context.Context, dbShardID int, query string, args ...interface{}) (sql.Result, error) {
@@ -131,6 +144,11 @@ func (s *sharded) GetForSchemaQuery(dbShardID int, dest interface{}, query strin
 	return fmt.Errorf("sharded SQL driver shouldn't be used to GetForSchemaQuery, there must be a bug")
 }
 
+func (s *sharded) Rebind(query string) string {
+	// all the databases of a sharded SQL database use the same plugin
+	return s.dbs[0].Rebind(query)
+}
+
 func (s *sharded) BeginTxx(ctx context.Context, dbShardID int, opts *sql.TxOptions) (*sqlx.Tx, error) {
 	if dbShardID == sqlplugin.DbShardUndefined || dbShardID == sqlplugin.DbAllShards {
 		return nil, fmt.Errorf("invalid dbShardID %v shouldn't be used to BeginTxx, there must be a bug", dbShardID)
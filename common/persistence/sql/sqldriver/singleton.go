@@ -80,6 +80,13 @@ func (s *singleton) SelectContext(ctx context.Context, _ int, dest interface{},
 	return s.db.SelectContext(ctx, dest, query, args...)
 }
 
+func (s *singleton) QueryxContext(ctx context.Context, _ int, query string, args ...interface{}) (*sqlx.Rows, error) {
+	if s.useTx {
+		return s.tx.QueryxContext(ctx, query, args...)
+	}
+	return s.db.QueryxContext(ctx, query, args...)
+}
+
 // below are non-transactional methods only
 
 func (s *singleton) ExecDDL(ctx context.Context, _ int, query string, args ...interface{}) (sql.Result, error) {
@@ -94,6 +101,10 @@ func (s *singleton) GetForSchemaQuery(_ int, dest interface{}, query string, arg
 	return s.db.Get(dest, query, args...)
 }
 
+func (s *singleton) Rebind(query string) string {
+	return s.db.Rebind(query)
+}
+
 func (s *singleton) BeginTxx(ctx context.Context, _ int, opts *sql.TxOptions) (*sqlx.Tx, error) {
 	return s.db.BeginTxx(ctx, opts)
 }
@@ -0,0 +1,213 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// DBShardMap is a versioned assignment of history shards to DB shards.
+	// History shards without an assignment are stored in the DB shard given by GetDBShardIDFromHistoryShardID.
+	// The map is stored in the default DB shard and every update inserts a new version,
+	// so the versions also work as a compare and swap for concurrent updates.
+	DBShardMap struct {
+		Version int64 `json:"-"`
+		// Assignments are sorted and non-overlapping ranges of history shards
+		Assignments []DBShardAssignment `json:"assignments,omitempty"`
+		// Moves are the history shards being moved to another DB shard,
+		// history must not acquire them until the move is completed or aborted
+		Moves []DBShardAssignment `json:"moves,omitempty"`
+	}
+
+	// DBShardAssignment assigns the history shards of [FirstHistoryShardID, LastHistoryShardID] to a DB shard
+	DBShardAssignment struct {
+		FirstHistoryShardID int `json:"firstHistoryShardID"`
+		LastHistoryShardID  int `json:"lastHistoryShardID"`
+		DBShardID           int `json:"dbShardID"`
+	}
+)
+
+// DBShardMapFromRow decodes the DB shard map stored in a db_shard_maps row
+func DBShardMapFromRow(row *DBShardMapsRow) (*DBShardMap, error) {
+	if row.DataEncoding != string(common.EncodingTypeJSON) {
+		return nil, fmt.Errorf("unsupported DB shard map encoding: %v", row.DataEncoding)
+	}
+	shardMap := &DBShardMap{}
+	if err := json.Unmarshal(row.Data, shardMap); err != nil {
+		return nil, fmt.Errorf("failed to decode DB shard map version %v: %v", row.Version, err)
+	}
+	shardMap.Version = row.Version
+	return shardMap, nil
+}
+
+// ToRow encodes the DB shard map into a db_shard_maps row
+func (m *DBShardMap) ToRow() (*DBShardMapsRow, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return &DBShardMapsRow{
+		Version:      m.Version,
+		Data:         data,
+		DataEncoding: string(common.EncodingTypeJSON),
+	}, nil
+}
+
+// GetDBShardID returns the DB shard which stores the history shard
+func (m *DBShardMap) GetDBShardID(historyShardID int, numDBShards int) int {
+	idx := sort.Search(len(m.Assignments), func(i int) bool {
+		return m.Assignments[i].LastHistoryShardID >= historyShardID
+	})
+	if idx < len(m.Assignments) && m.Assignments[idx].FirstHistoryShardID <= historyShardID {
+		return m.Assignments[idx].DBShardID
+	}
+	return GetDBShardIDFromHistoryShardID(historyShardID, numDBShards)
+}
+
+// GetMove returns the in progress move of the history shard, if any
+func (m *DBShardMap) GetMove(historyShardID int) (DBShardAssignment, bool) {
+	for _, move := range m.Moves {
+		if move.FirstHistoryShardID <= historyShardID && historyShardID <= move.LastHistoryShardID {
+			return move, true
+		}
+	}
+	return DBShardAssignment{}, false
+}
+
+// MaxDBShardID returns the largest DB shard referenced by the map, or -1 if the map is empty
+func (m *DBShardMap) MaxDBShardID() int {
+	maxDBShardID := -1
+	for _, assignments := range [][]DBShardAssignment{m.Assignments, m.Moves} {
+		for _, assignment := range assignments {
+			if assignment.DBShardID > maxDBShardID {
+				maxDBShardID = assignment.DBShardID
+			}
+		}
+	}
+	return maxDBShardID
+}
+
+// StartMove returns the next version of the map with the history shard being moved to the target DB shard
+func (m *DBShardMap) StartMove(historyShardID int, targetDBShardID int) (*DBShardMap, error) {
+	if move, ok := m.GetMove(historyShardID); ok {
+		if move.DBShardID == targetDBShardID {
+			return m, nil
+		}
+		return nil, fmt.Errorf("history shard %v is already being moved to DB shard %v", historyShardID, move.DBShardID)
+	}
+	next := m.next()
+	next.Moves = append(next.Moves, DBShardAssignment{
+		FirstHistoryShardID: historyShardID,
+		LastHistoryShardID:  historyShardID,
+		DBShardID:           targetDBShardID,
+	})
+	return next, nil
+}
+
+// CompleteMove returns the next version of the map with the history shard assigned to the target DB shard of its move
+func (m *DBShardMap) CompleteMove(historyShardID int) (*DBShardMap, error) {
+	move, ok := m.GetMove(historyShardID)
+	if !ok {
+		return nil, fmt.Errorf("history shard %v is not being moved", historyShardID)
+	}
+	next := m.removeMove(historyShardID)
+	next.assign(historyShardID, historyShardID, move.DBShardID)
+	return next, nil
+}
+
+// AbortMove returns the next version of the map without the move of the history shard
+func (m *DBShardMap) AbortMove(historyShardID int) *DBShardMap {
+	if _, ok := m.GetMove(historyShardID); !ok {
+		return m
+	}
+	return m.removeMove(historyShardID)
+}
+
+func (m *DBShardMap) next() *DBShardMap {
+	return &DBShardMap{
+		Version:     m.Version + 1,
+		Assignments: append([]DBShardAssignment(nil), m.Assignments...),
+		Moves:       append([]DBShardAssignment(nil), m.Moves...),
+	}
+}
+
+func (m *DBShardMap) removeMove(historyShardID int) *DBShardMap {
+	next := m.next()
+	next.Moves = nil
+	for _, move := range m.Moves {
+		if move.FirstHistoryShardID <= historyShardID && historyShardID <= move.LastHistoryShardID {
+			if move.FirstHistoryShardID < historyShardID {
+				next.Moves = append(next.Moves, DBShardAssignment{
+					FirstHistoryShardID: move.FirstHistoryShardID,
+					LastHistoryShardID:  historyShardID - 1,
+					DBShardID:           move.DBShardID,
+				})
+			}
+			if historyShardID < move.LastHistoryShardID {
+				next.Moves = append(next.Moves, DBShardAssignment{
+					FirstHistoryShardID: historyShardID + 1,
+					LastHistoryShardID:  move.LastHistoryShardID,
+					DBShardID:           move.DBShardID,
+				})
+			}
+			continue
+		}
+		next.Moves = append(next.Moves, move)
+	}
+	return next
+}
+
+// assign overrides the assignments of the history shards in [first, last] and
+// merges the adjacent ranges assigned to the same DB shard
+func (m *DBShardMap) assign(first, last, dbShardID int) {
+	var assignments []DBShardAssignment
+	for _, a := range m.Assignments {
+		if a.LastHistoryShardID < first || a.FirstHistoryShardID > last {
+			assignments = append(assignments, a)
+			continue
+		}
+		if a.FirstHistoryShardID < first {
+			assignments = append(assignments, DBShardAssignment{FirstHistoryShardID: a.FirstHistoryShardID, LastHistoryShardID: first - 1, DBShardID: a.DBShardID})
+		}
+		if a.LastHistoryShardID > last {
+			assignments = append(assignments, DBShardAssignment{FirstHistoryShardID: last + 1, LastHistoryShardID: a.LastHistoryShardID, DBShardID: a.DBShardID})
+		}
+	}
+	assignments = append(assignments, DBShardAssignment{FirstHistoryShardID: first, LastHistoryShardID: last, DBShardID: dbShardID})
+	sort.Slice(assignments, func(i, j int) bool {
+		return assignments[i].FirstHistoryShardID < assignments[j].FirstHistoryShardID
+	})
+
+	m.Assignments = nil
+	for _, a := range assignments {
+		n := len(m.Assignments)
+		if n > 0 && m.Assignments[n-1].DBShardID == a.DBShardID && m.Assignments[n-1].LastHistoryShardID+1 == a.FirstHistoryShardID {
+			m.Assignments[n-1].LastHistoryShardID = a.LastHistoryShardID
+			continue
+		}
+		m.Assignments = append(m.Assignments, a)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBShardMap_GetDBShardID(t *testing.T) {
+	shardMap := &DBShardMap{
+		Assignments: []DBShardAssignment{
+			{FirstHistoryShardID: 2, LastHistoryShardID: 3, DBShardID: 4},
+			{FirstHistoryShardID: 7, LastHistoryShardID: 7, DBShardID: 5},
+		},
+	}
+	for historyShardID, dbShardID := range map[int]int{0: 0, 1: 1, 2: 4, 3: 4, 4: 0, 5: 1, 6: 0, 7: 5, 8: 0} {
+		assert.Equal(t, dbShardID, shardMap.GetDBShardID(historyShardID, 2), "history shard %v", historyShardID)
+	}
+	assert.Equal(t, 5, shardMap.MaxDBShardID())
+	assert.Equal(t, -1, (&DBShardMap{}).MaxDBShardID())
+}
+
+func TestDBShardMap_Move(t *testing.T) {
+	shardMap := &DBShardMap{Version: 3}
+
+	moving, err := shardMap.StartMove(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), moving.Version)
+	move, ok := moving.GetMove(1)
+	assert.True(t, ok)
+	assert.Equal(t, 2, move.DBShardID)
+	// the shard stays in its DB shard until the move is completed
+	assert.Equal(t, 1, moving.GetDBShardID(1, 2))
+	_, ok = shardMap.GetMove(1)
+	assert.False(t, ok, "the previous version must not be changed")
+
+	same, err := moving.StartMove(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, moving, same)
+	_, err = moving.StartMove(1, 3)
+	assert.Error(t, err)
+
+	aborted := moving.AbortMove(1)
+	assert.Equal(t, int64(5), aborted.Version)
+	assert.Empty(t, aborted.Moves)
+	assert.Equal(t, 1, aborted.GetDBShardID(1, 2))
+
+	completed, err := moving.CompleteMove(1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), completed.Version)
+	assert.Empty(t, completed.Moves)
+	assert.Equal(t, 2, completed.GetDBShardID(1, 2))
+
+	_, err = completed.CompleteMove(1)
+	assert.Error(t, err)
+}
+
+func TestDBShardMap_AssignMergesRanges(t *testing.T) {
+	shardMap := &DBShardMap{}
+	for _, historyShardID := range []int{5, 3, 4, 7} {
+		moving, err := shardMap.StartMove(historyShardID, 2)
+		require.NoError(t, err)
+		shardMap, err = moving.CompleteMove(historyShardID)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []DBShardAssignment{
+		{FirstHistoryShardID: 3, LastHistoryShardID: 5, DBShardID: 2},
+		{FirstHistoryShardID: 7, LastHistoryShardID: 7, DBShardID: 2},
+	}, shardMap.Assignments)
+
+	// moving a shard out of the middle of a range splits it
+	moving, err := shardMap.StartMove(4, 3)
+	require.NoError(t, err)
+	shardMap, err = moving.CompleteMove(4)
+	require.NoError(t, err)
+	assert.Equal(t, []DBShardAssignment{
+		{FirstHistoryShardID: 3, LastHistoryShardID: 3, DBShardID: 2},
+		{FirstHistoryShardID: 4, LastHistoryShardID: 4, DBShardID: 3},
+		{FirstHistoryShardID: 5, LastHistoryShardID: 5, DBShardID: 2},
+		{FirstHistoryShardID: 7, LastHistoryShardID: 7, DBShardID: 2},
+	}, shardMap.Assignments)
+}
+
+func TestDBShardMap_RemoveMoveFromRange(t *testing.T) {
+	shardMap := &DBShardMap{
+		Moves: []DBShardAssignment{{FirstHistoryShardID: 1, LastHistoryShardID: 3, DBShardID: 2}},
+	}
+	shardMap = shardMap.AbortMove(2)
+	assert.Equal(t, []DBShardAssignment{
+		{FirstHistoryShardID: 1, LastHistoryShardID: 1, DBShardID: 2},
+		{FirstHistoryShardID: 3, LastHistoryShardID: 3, DBShardID: 2},
+	}, shardMap.Moves)
+}
+
+func TestDBShardMap_Row(t *testing.T) {
+	shardMap := &DBShardMap{
+		Version:     7,
+		Assignments: []DBShardAssignment{{FirstHistoryShardID: 2, LastHistoryShardID: 3, DBShardID: 4}},
+		Moves:       []DBShardAssignment{{FirstHistoryShardID: 5, LastHistoryShardID: 5, DBShardID: 4}},
+	}
+	row, err := shardMap.ToRow()
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), row.Version)
+
+	decoded, err := DBShardMapFromRow(row)
+	require.NoError(t, err)
+	assert.Equal(t, shardMap, decoded)
+
+	row.DataEncoding = "thriftrw"
+	_, err = DBShardMapFromRow(row)
+	assert.Error(t, err)
+}
+
+func TestDBShardMapper(t *testing.T) {
+	var row *DBShardMapsRow
+	loadErr := sql.ErrNoRows
+	loads := 0
+	mapper := NewDBShardMapper(2, 3, func(ctx context.Context) (*DBShardMapsRow, error) {
+		loads++
+		return row, loadErr
+	})
+	require.NoError(t, mapper.Start())
+	defer mapper.Stop()
+	assert.Equal(t, 1, loads)
+	assert.Equal(t, int64(0), mapper.GetDBShardMap().Version)
+	assert.Equal(t, 1, mapper.GetDBShardIDFromHistoryShardID(3))
+
+	var err error
+	row, err = (&DBShardMap{
+		Version:     1,
+		Assignments: []DBShardAssignment{{FirstHistoryShardID: 3, LastHistoryShardID: 3, DBShardID: 2}},
+	}).ToRow()
+	require.NoError(t, err)
+	loadErr = nil
+	shardMap, err := mapper.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), shardMap.Version)
+	assert.Equal(t, 2, mapper.GetDBShardIDFromHistoryShardID(3))
+
+	// a failed refresh keeps the current map
+	loadErr = errors.New("some random error")
+	_, err = mapper.Refresh(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 2, mapper.GetDBShardIDFromHistoryShardID(3))
+
+	// the map can't refer to databases which are not configured
+	row, err = (&DBShardMap{
+		Version:     2,
+		Assignments: []DBShardAssignment{{FirstHistoryShardID: 3, LastHistoryShardID: 3, DBShardID: 3}},
+	}).ToRow()
+	require.NoError(t, err)
+	loadErr = nil
+	_, err = mapper.Refresh(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int64(1), mapper.GetDBShardMap().Version)
+}
+
+func TestDBShardMapper_SingleDatabase(t *testing.T) {
+	mapper := NewDBShardMapper(1, 1, func(ctx context.Context) (*DBShardMapsRow, error) {
+		return nil, errors.New("the map must not be loaded with a single database")
+	})
+	require.NoError(t, mapper.Start())
+	defer mapper.Stop()
+
+	shardMap, err := mapper.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), shardMap.Version)
+	assert.Equal(t, 0, mapper.GetDBShardIDFromHistoryShardID(3))
+}
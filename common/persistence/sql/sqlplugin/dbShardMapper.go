@@ -0,0 +1,160 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// DBShardMapRefreshInterval is how often every process reloads the DB shard map
+	DBShardMapRefreshInterval = 30 * time.Second
+
+	dbShardMapLoadTimeout = 5 * time.Second
+)
+
+type (
+	// DBShardMapLoader loads the latest version of the DB shard map
+	DBShardMapLoader func(ctx context.Context) (*DBShardMapsRow, error)
+
+	// DBShardMapper routes history shards to DB shards with the latest known DB shard map.
+	// With a single database the map is never loaded and every history shard is routed to it.
+	DBShardMapper struct {
+		numDBShards  int
+		numDatabases int
+		loader       DBShardMapLoader
+		status       int32
+		shardMap     atomic.Value
+		refreshLock  sync.Mutex
+		shutdownCh   chan struct{}
+	}
+)
+
+// NewDBShardMapper returns a DB shard mapper for numDatabases databases, of which the first numDBShards
+// are used for hashing the data which is not assigned by the DB shard map
+func NewDBShardMapper(numDBShards int, numDatabases int, loader DBShardMapLoader) *DBShardMapper {
+	m := &DBShardMapper{
+		numDBShards:  numDBShards,
+		numDatabases: numDatabases,
+		loader:       loader,
+		status:       common.DaemonStatusInitialized,
+		shutdownCh:   make(chan struct{}),
+	}
+	m.shardMap.Store(&DBShardMap{})
+	return m
+}
+
+// Start loads the DB shard map and keeps refreshing it in background
+func (m *DBShardMapper) Start() error {
+	if m.numDatabases <= 1 {
+		return nil
+	}
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbShardMapLoadTimeout)
+	defer cancel()
+	if _, err := m.Refresh(ctx); err != nil {
+		return fmt.Errorf("failed to load DB shard map: %v", err)
+	}
+	go m.refreshLoop()
+	return nil
+}
+
+// Stop stops refreshing the DB shard map
+func (m *DBShardMapper) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(m.shutdownCh)
+}
+
+// GetTotalNumDatabases returns the number of databases the history shards can be assigned to
+func (m *DBShardMapper) GetTotalNumDatabases() int {
+	return m.numDatabases
+}
+
+// GetDBShardMap returns the latest known DB shard map
+func (m *DBShardMapper) GetDBShardMap() *DBShardMap {
+	return m.shardMap.Load().(*DBShardMap)
+}
+
+// GetDBShardIDFromHistoryShardID maps historyShardID to a DBShardID with the latest known DB shard map
+func (m *DBShardMapper) GetDBShardIDFromHistoryShardID(historyShardID int) int {
+	return m.GetDBShardMap().GetDBShardID(historyShardID, m.numDBShards)
+}
+
+// Refresh reloads the DB shard map and returns it
+func (m *DBShardMapper) Refresh(ctx context.Context) (*DBShardMap, error) {
+	if m.numDatabases <= 1 {
+		return m.GetDBShardMap(), nil
+	}
+
+	m.refreshLock.Lock()
+	defer m.refreshLock.Unlock()
+
+	shardMap := &DBShardMap{}
+	row, err := m.loader(ctx)
+	switch err {
+	case nil:
+		if shardMap, err = DBShardMapFromRow(row); err != nil {
+			return nil, err
+		}
+	case sql.ErrNoRows:
+	default:
+		return nil, err
+	}
+
+	if shardMap.MaxDBShardID() >= m.numDatabases {
+		return nil, fmt.Errorf("DB shard map version %v refers to DB shard %v while only %v databases are configured",
+			shardMap.Version, shardMap.MaxDBShardID(), m.numDatabases)
+	}
+	if current := m.GetDBShardMap(); shardMap.Version < current.Version {
+		return current, nil
+	}
+	m.shardMap.Store(shardMap)
+	return shardMap, nil
+}
+
+func (m *DBShardMapper) refreshLoop() {
+	ticker := time.NewTicker(DBShardMapRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), dbShardMapLoadTimeout)
+			// a failed refresh keeps the current map, it is retried on the next tick
+			_, _ = m.Refresh(ctx)
+			cancel()
+		}
+	}
+}
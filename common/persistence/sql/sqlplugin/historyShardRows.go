@@ -0,0 +1,280 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	// HistoryShardTable is a table whose rows are partitioned by history shard
+	HistoryShardTable struct {
+		Name string
+		// OrderBy is the primary key of the table, it gives a stable order for paging through the rows
+		OrderBy string
+		// AutoIncrementColumn is not copied, its values are generated by the target database
+		AutoIncrementColumn string
+	}
+
+	// HistoryShardRowsFilter contains the column names within a history shard table
+	// that can be used to filter results through a WHERE clause
+	HistoryShardRowsFilter struct {
+		Table          string
+		HistoryShardID int
+		DBShardID      int
+		// TargetDBShardID, AfterKey and PageSize are only used for syncing rows,
+		// AfterKey is the LastKey of the previous page and nil for the first page
+		TargetDBShardID int
+		AfterKey        []interface{}
+		PageSize        int
+	}
+
+	// HistoryShardRowsPage is the result of syncing a page of the rows of a history shard table
+	HistoryShardRowsPage struct {
+		// Rows is the number of rows of the page in the source DB shard
+		Rows int
+		// Written is the number of rows inserted, replaced or deleted in the target DB shard
+		Written int
+		// LastKey is the key of the last row of the page, it is nil after the last page
+		LastKey []interface{}
+	}
+
+	// historyShardRowsDriver is the part of sqldriver.Driver used to move the rows of a history shard
+	historyShardRowsDriver interface {
+		ExecContext(ctx context.Context, dbShardID int, query string, args ...interface{}) (sql.Result, error)
+		GetContext(ctx context.Context, dbShardID int, dest interface{}, query string, args ...interface{}) error
+		QueryxContext(ctx context.Context, dbShardID int, query string, args ...interface{}) (*sqlx.Rows, error)
+		Rebind(query string) string
+	}
+)
+
+// HistoryShardTables are the tables storing the data of history shards, in the order they are copied
+var HistoryShardTables = []HistoryShardTable{
+	{Name: "shards", OrderBy: "shard_id"},
+	{Name: "executions", OrderBy: "shard_id, domain_id, workflow_id, run_id"},
+	{Name: "current_executions", OrderBy: "shard_id, domain_id, workflow_id"},
+	{Name: "buffered_events", OrderBy: "id", AutoIncrementColumn: "id"},
+	{Name: "activity_info_maps", OrderBy: "shard_id, domain_id, workflow_id, run_id, schedule_id"},
+	{Name: "timer_info_maps", OrderBy: "shard_id, domain_id, workflow_id, run_id, timer_id"},
+	{Name: "child_execution_info_maps", OrderBy: "shard_id, domain_id, workflow_id, run_id, initiated_id"},
+	{Name: "request_cancel_info_maps", OrderBy: "shard_id, domain_id, workflow_id, run_id, initiated_id"},
+	{Name: "signal_info_maps", OrderBy: "shard_id, domain_id, workflow_id, run_id, initiated_id"},
+	{Name: "buffered_replication_task_maps", OrderBy: "shard_id, domain_id, workflow_id, run_id, first_event_id"},
+	{Name: "signals_requested_sets", OrderBy: "shard_id, domain_id, workflow_id, run_id, signal_id"},
+	{Name: "transfer_tasks", OrderBy: "shard_id, task_id"},
+	{Name: "cross_cluster_tasks", OrderBy: "target_cluster, shard_id, task_id"},
+	{Name: "timer_tasks", OrderBy: "shard_id, visibility_timestamp, task_id"},
+	{Name: "replication_tasks", OrderBy: "shard_id, task_id"},
+	{Name: "replication_tasks_dlq", OrderBy: "source_cluster_name, shard_id, task_id"},
+}
+
+func getHistoryShardTable(name string) (HistoryShardTable, error) {
+	for _, table := range HistoryShardTables {
+		if table.Name == name {
+			return table, nil
+		}
+	}
+	return HistoryShardTable{}, fmt.Errorf("table %v is not partitioned by history shard", name)
+}
+
+// SyncHistoryShardRows makes a page of the rows of a history shard table in the target DB shard equal to the rows
+// in the source DB shard: missing rows are inserted, changed rows are replaced and the rows no longer in the source
+// DB shard are deleted. The rows of tables with an auto increment column can't be matched by key,
+// so they are only inserted and must be deleted from the target DB shard before the first page.
+func SyncHistoryShardRows(ctx context.Context, driver historyShardRowsDriver, filter *HistoryShardRowsFilter) (*HistoryShardRowsPage, error) {
+	table, err := getHistoryShardTable(filter.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceRows, err := selectHistoryShardRows(ctx, driver, filter.DBShardID, table, filter.HistoryShardID, filter.AfterKey, nil, filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	page := &HistoryShardRowsPage{Rows: len(sourceRows)}
+	if len(sourceRows) == filter.PageSize {
+		page.LastKey = table.key(sourceRows[len(sourceRows)-1])
+	}
+	if table.AutoIncrementColumn != "" {
+		for _, row := range sourceRows {
+			delete(row, table.AutoIncrementColumn)
+			if err := insertHistoryShardRow(ctx, driver, filter.TargetDBShardID, table, row); err != nil {
+				return nil, err
+			}
+		}
+		page.Written = len(sourceRows)
+		return page, nil
+	}
+
+	// the target rows are read in the same key range as the page, so that the pages cover all the target rows
+	targetRows, err := selectHistoryShardRows(ctx, driver, filter.TargetDBShardID, table, filter.HistoryShardID, filter.AfterKey, page.LastKey, 0)
+	if err != nil {
+		return nil, err
+	}
+	targetRowsByKey := make(map[string]map[string]interface{}, len(targetRows))
+	for _, row := range targetRows {
+		targetRowsByKey[fmt.Sprint(table.key(row))] = row
+	}
+	for _, row := range sourceRows {
+		key := fmt.Sprint(table.key(row))
+		targetRow, ok := targetRowsByKey[key]
+		delete(targetRowsByKey, key)
+		if ok && reflect.DeepEqual(row, targetRow) {
+			continue
+		}
+		if ok {
+			if err := deleteHistoryShardRow(ctx, driver, filter.TargetDBShardID, table, targetRow); err != nil {
+				return nil, err
+			}
+		}
+		if err := insertHistoryShardRow(ctx, driver, filter.TargetDBShardID, table, row); err != nil {
+			return nil, err
+		}
+		page.Written++
+	}
+	for _, row := range targetRowsByKey {
+		if err := deleteHistoryShardRow(ctx, driver, filter.TargetDBShardID, table, row); err != nil {
+			return nil, err
+		}
+		page.Written++
+	}
+	return page, nil
+}
+
+// CountHistoryShardRows returns the number of rows of a history shard table in the DB shard
+func CountHistoryShardRows(ctx context.Context, driver historyShardRowsDriver, filter *HistoryShardRowsFilter) (int64, error) {
+	table, err := getHistoryShardTable(filter.Table)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	query := driver.Rebind(fmt.Sprintf("SELECT COUNT(1) FROM %v WHERE shard_id = ?", table.Name))
+	err = driver.GetContext(ctx, filter.DBShardID, &count, query, filter.HistoryShardID)
+	return count, err
+}
+
+// DeleteHistoryShardRows deletes the rows of a history shard table from the DB shard
+func DeleteHistoryShardRows(ctx context.Context, driver historyShardRowsDriver, filter *HistoryShardRowsFilter) (int64, error) {
+	table, err := getHistoryShardTable(filter.Table)
+	if err != nil {
+		return 0, err
+	}
+	query := driver.Rebind(fmt.Sprintf("DELETE FROM %v WHERE shard_id = ?", table.Name))
+	result, err := driver.ExecContext(ctx, filter.DBShardID, query, filter.HistoryShardID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (t HistoryShardTable) keyColumns() []string {
+	return strings.Split(t.OrderBy, ", ")
+}
+
+func (t HistoryShardTable) key(row map[string]interface{}) []interface{} {
+	columns := t.keyColumns()
+	key := make([]interface{}, len(columns))
+	for i, column := range columns {
+		key[i] = row[column]
+	}
+	return key
+}
+
+// selectHistoryShardRows returns the rows of a history shard table with a key in (afterKey, toKey],
+// a nil key leaves that end of the range open and a zero limit returns all the rows in the range
+func selectHistoryShardRows(
+	ctx context.Context,
+	driver historyShardRowsDriver,
+	dbShardID int,
+	table HistoryShardTable,
+	historyShardID int,
+	afterKey []interface{},
+	toKey []interface{},
+	limit int,
+) ([]map[string]interface{}, error) {
+	columns := "(" + table.OrderBy + ")"
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(table.keyColumns())), ", ") + ")"
+	query := fmt.Sprintf("SELECT * FROM %v WHERE shard_id = ?", table.Name)
+	args := []interface{}{historyShardID}
+	if afterKey != nil {
+		query += fmt.Sprintf(" AND %v > %v", columns, placeholders)
+		args = append(args, afterKey...)
+	}
+	if toKey != nil {
+		query += fmt.Sprintf(" AND %v <= %v", columns, placeholders)
+		args = append(args, toKey...)
+	}
+	query += " ORDER BY " + table.OrderBy
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := driver.QueryxContext(ctx, dbShardID, driver.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []map[string]interface{}
+	for rows.Next() {
+		row := make(map[string]interface{})
+		if err := rows.MapScan(row); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+func insertHistoryShardRow(ctx context.Context, driver historyShardRowsDriver, dbShardID int, table HistoryShardTable, row map[string]interface{}) error {
+	columns := make([]string, 0, len(row))
+	for column := range row {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = row[column]
+	}
+	insert := driver.Rebind(fmt.Sprintf("INSERT INTO %v (%v) VALUES (%v)",
+		table.Name, strings.Join(columns, ", "), strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")))
+	_, err := driver.ExecContext(ctx, dbShardID, insert, args...)
+	return err
+}
+
+func deleteHistoryShardRow(ctx context.Context, driver historyShardRowsDriver, dbShardID int, table HistoryShardTable, row map[string]interface{}) error {
+	columns := table.keyColumns()
+	conditions := make([]string, len(columns))
+	for i, column := range columns {
+		conditions[i] = column + " = ?"
+	}
+	query := driver.Rebind(fmt.Sprintf("DELETE FROM %v WHERE %v", table.Name, strings.Join(conditions, " AND ")))
+	_, err := driver.ExecContext(ctx, dbShardID, query, table.key(row)...)
+	return err
+}
@@ -539,6 +539,9 @@ type (
 		IsCron           bool
 		NumClusters      int16
 		UpdateTime       time.Time
+		TaskList         string
+		// SearchAttributes is a JSON object of the custom search attributes
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -555,6 +558,19 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the parameters of an advanced visibility query on executions_visibility table
+	VisibilityQueryFilter struct {
+		DomainID string
+		Query    *VisibilityQuery
+		// LastStartTime and LastRunID are the position of the last row of the previous page,
+		// they are used for pagination when the query doesn't specify an order by clause
+		LastStartTime *time.Time
+		LastRunID     *string
+		// Offset is used for pagination when the query specifies an order by clause
+		Offset   int
+		PageSize int
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      persistence.QueueType
@@ -568,6 +584,13 @@ type (
 		Data      []byte
 	}
 
+	// DBShardMapsRow represents a row in db_shard_maps table
+	DBShardMapsRow struct {
+		Version      int64
+		Data         []byte
+		DataEncoding string
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
 		InsertIntoDomain(ctx context.Context, rows *DomainRow) (sql.Result, error)
@@ -786,6 +809,15 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table, or updates the search attributes, memo
+		// and update time of the row if it already exists
+		UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns the rows of visibility table matching an advanced visibility query
+		// Required filter params - {domainID, query, pageSize}
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows of visibility table matching an advanced visibility query
+		// Required filter params - {domainID, query}
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
@@ -799,12 +831,29 @@ type (
 		GetAckLevels(ctx context.Context, queueType persistence.QueueType, forUpdate bool) (map[string]int64, error)
 		GetQueueSize(ctx context.Context, queueType persistence.QueueType) (int64, error)
 
+		// InsertIntoDBShardMaps inserts a new version of the DB shard map, it fails with a duplicate entry
+		// error if the version already exists
+		InsertIntoDBShardMaps(ctx context.Context, row *DBShardMapsRow) (sql.Result, error)
+		// SelectLatestFromDBShardMaps returns the latest version of the DB shard map
+		SelectLatestFromDBShardMaps(ctx context.Context) (*DBShardMapsRow, error)
+
 		// The follow provide information about the underlying sql crud implementation
 		SupportsTTL() bool
 		MaxAllowedTTL() (*time.Duration, error)
 		SupportsAsyncTransaction() bool
 	}
 
+	// historyShardDataCRUD moves the rows of history shards between DB shards for online resharding
+	historyShardDataCRUD interface {
+		// SyncHistoryShardRows makes a page of rows of a history shard table in the target DB shard equal to the source DB shard
+		// Required filter params - {table, historyShardID, dbShardID, targetDBShardID, afterKey, pageSize}
+		SyncHistoryShardRows(ctx context.Context, filter *HistoryShardRowsFilter) (*HistoryShardRowsPage, error)
+		// Required filter params - {table, historyShardID, dbShardID}
+		CountHistoryShardRows(ctx context.Context, filter *HistoryShardRowsFilter) (int64, error)
+		// Required filter params - {table, historyShardID, dbShardID}
+		DeleteHistoryShardRows(ctx context.Context, filter *HistoryShardRowsFilter) (int64, error)
+	}
+
 	// adminCRUD defines admin operations for CLI and test suites
 	adminCRUD interface {
 		CreateSchemaVersionTables() error
@@ -834,7 +883,17 @@ type (
 		tableCRUD
 		ErrorChecker
 
+		historyShardDataCRUD
+
 		GetTotalNumDBShards() int
+		// GetTotalNumDatabases returns the number of databases, including the ones only used by the DB shard map
+		GetTotalNumDatabases() int
+		// GetDBShardIDFromHistoryShardID maps historyShardID to a DBShardID with the latest known DB shard map
+		GetDBShardIDFromHistoryShardID(historyShardID int) int
+		// GetDBShardMap returns the latest known DB shard map
+		GetDBShardMap() *DBShardMap
+		// RefreshDBShardMap reloads the DB shard map from the database
+		RefreshDBShardMap(ctx context.Context) (*DBShardMap, error)
 		BeginTx(ctx context.Context, dbShardID int) (Tx, error)
 		PluginName() string
 		Close() error
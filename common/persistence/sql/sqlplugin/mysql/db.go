@@ -39,6 +39,8 @@ type (
 		driver      sqldriver.Driver
 		originalDBs []*sqlx.DB
 		numDBShards int
+		// dbShardMapper is shared by the transactions started from the db
+		dbShardMapper *sqlplugin.DBShardMapper
 	}
 )
 
@@ -96,7 +98,7 @@ func (mdb *db) IsThrottlingError(err error) bool {
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying mysql database
 // dbShardID is needed when tx is not nil
-func newDB(xdbs []*sqlx.DB, tx *sqlx.Tx, dbShardID int, numDBShards int) (*db, error) {
+func newDB(xdbs []*sqlx.DB, tx *sqlx.Tx, dbShardID int, numDBShards int, dbShardMapper *sqlplugin.DBShardMapper) (*db, error) {
 	driver, err := sqldriver.NewDriver(xdbs, tx, dbShardID)
 	if err != nil {
 		return nil, err
@@ -108,6 +110,10 @@ func newDB(xdbs []*sqlx.DB, tx *sqlx.Tx, dbShardID int, numDBShards int) (*db, e
 		driver:      driver,
 		numDBShards: numDBShards,
 	}
+	if dbShardMapper == nil {
+		dbShardMapper = sqlplugin.NewDBShardMapper(numDBShards, len(xdbs), db.SelectLatestFromDBShardMaps)
+	}
+	db.dbShardMapper = dbShardMapper
 
 	return db, nil
 }
@@ -118,7 +124,7 @@ func (mdb *db) BeginTx(ctx context.Context, dbShardID int) (sqlplugin.Tx, error)
 	if err != nil {
 		return nil, err
 	}
-	return newDB(mdb.originalDBs, xtx, dbShardID, mdb.numDBShards)
+	return newDB(mdb.originalDBs, xtx, dbShardID, mdb.numDBShards, mdb.dbShardMapper)
 }
 
 // Commit commits a previously started transaction
@@ -133,6 +139,7 @@ func (mdb *db) Rollback() error {
 
 // Close closes the connection to the mysql db
 func (mdb *db) Close() error {
+	mdb.dbShardMapper.Stop()
 	return mdb.driver.Close()
 }
 
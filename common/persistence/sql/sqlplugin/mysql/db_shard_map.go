@@ -0,0 +1,87 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertDBShardMapQry = `INSERT INTO
+ db_shard_maps (version, data, data_encoding) VALUES (?, ?, ?)`
+
+	getLatestDBShardMapQry = `SELECT
+ version, data, data_encoding
+ FROM db_shard_maps ORDER BY version DESC LIMIT 1`
+)
+
+// InsertIntoDBShardMaps inserts a new version of the DB shard map
+func (mdb *db) InsertIntoDBShardMaps(ctx context.Context, row *sqlplugin.DBShardMapsRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, insertDBShardMapQry, row.Version, row.Data, row.DataEncoding)
+}
+
+// SelectLatestFromDBShardMaps reads the latest version of the DB shard map
+func (mdb *db) SelectLatestFromDBShardMaps(ctx context.Context) (*sqlplugin.DBShardMapsRow, error) {
+	var row sqlplugin.DBShardMapsRow
+	err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, getLatestDBShardMapQry)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+// GetTotalNumDatabases returns the number of databases, including the ones only used by the DB shard map
+func (mdb *db) GetTotalNumDatabases() int {
+	return mdb.dbShardMapper.GetTotalNumDatabases()
+}
+
+// GetDBShardIDFromHistoryShardID maps historyShardID to a DBShardID with the latest known DB shard map
+func (mdb *db) GetDBShardIDFromHistoryShardID(historyShardID int) int {
+	return mdb.dbShardMapper.GetDBShardIDFromHistoryShardID(historyShardID)
+}
+
+// GetDBShardMap returns the latest known DB shard map
+func (mdb *db) GetDBShardMap() *sqlplugin.DBShardMap {
+	return mdb.dbShardMapper.GetDBShardMap()
+}
+
+// RefreshDBShardMap reloads the DB shard map from the database
+func (mdb *db) RefreshDBShardMap(ctx context.Context) (*sqlplugin.DBShardMap, error) {
+	return mdb.dbShardMapper.Refresh(ctx)
+}
+
+// SyncHistoryShardRows makes a page of rows of a history shard table in the target DB shard equal to the source DB shard
+func (mdb *db) SyncHistoryShardRows(ctx context.Context, filter *sqlplugin.HistoryShardRowsFilter) (*sqlplugin.HistoryShardRowsPage, error) {
+	return sqlplugin.SyncHistoryShardRows(ctx, mdb.driver, filter)
+}
+
+// CountHistoryShardRows returns the number of rows of a history shard table in the DB shard
+func (mdb *db) CountHistoryShardRows(ctx context.Context, filter *sqlplugin.HistoryShardRowsFilter) (int64, error) {
+	return sqlplugin.CountHistoryShardRows(ctx, mdb.driver, filter)
+}
+
+// DeleteHistoryShardRows deletes the rows of a history shard table from the DB shard
+func (mdb *db) DeleteHistoryShardRows(ctx context.Context, filter *sqlplugin.HistoryShardRowsFilter) (int64, error) {
+	return sqlplugin.DeleteHistoryShardRows(ctx, mdb.driver, filter)
+}
@@ -75,7 +75,15 @@ func (p *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
+	db, err := newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.dbShardMapper.Start(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
 }
 
 // CreateAdminDB initialize the adminDb object
@@ -86,7 +94,7 @@ func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
+	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards, nil)
 }
 
 func (p *plugin) createSingleDBConn(cfg *config.SQL) (*sqlx.DB, error) {
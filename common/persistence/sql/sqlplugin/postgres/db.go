@@ -38,6 +38,8 @@ type (
 		driver      sqldriver.Driver
 		originalDBs []*sqlx.DB
 		numDBShards int
+		// dbShardMapper is shared by the transactions started from the db
+		dbShardMapper *sqlplugin.DBShardMapper
 	}
 )
 
@@ -82,7 +84,7 @@ func (pdb *db) IsThrottlingError(err error) bool {
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying postgres database
 // dbShardID is needed when tx is not nil
-func newDB(xdbs []*sqlx.DB, tx *sqlx.Tx, dbShardID int, numDBShards int) (*db, error) {
+func newDB(xdbs []*sqlx.DB, tx *sqlx.Tx, dbShardID int, numDBShards int, dbShardMapper *sqlplugin.DBShardMapper) (*db, error) {
 	driver, err := sqldriver.NewDriver(xdbs, tx, dbShardID)
 	if err != nil {
 		return nil, err
@@ -94,6 +96,10 @@ func newDB(xdbs []*sqlx.DB, tx *sqlx.Tx, dbShardID int, numDBShards int) (*db, e
 		driver:      driver,
 		numDBShards: numDBShards,
 	}
+	if dbShardMapper == nil {
+		dbShardMapper = sqlplugin.NewDBShardMapper(numDBShards, len(xdbs), db.SelectLatestFromDBShardMaps)
+	}
+	db.dbShardMapper = dbShardMapper
 	return db, nil
 }
 
@@ -103,7 +109,7 @@ func (pdb *db) BeginTx(ctx context.Context, dbShardID int) (sqlplugin.Tx, error)
 	if err != nil {
 		return nil, err
 	}
-	return newDB(pdb.originalDBs, xtx, dbShardID, pdb.numDBShards)
+	return newDB(pdb.originalDBs, xtx, dbShardID, pdb.numDBShards, pdb.dbShardMapper)
 }
 
 // Commit commits a previously started transaction
@@ -118,6 +124,7 @@ func (pdb *db) Rollback() error {
 
 // Close closes the connection to the mysql db
 func (pdb *db) Close() error {
+	pdb.dbShardMapper.Stop()
 	return pdb.driver.Close()
 }
 
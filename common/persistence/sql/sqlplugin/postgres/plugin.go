@@ -60,7 +60,15 @@ func (d *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
+	db, err := newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.dbShardMapper.Start(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
 }
 
 // CreateAdminDB initialize the adminDB object
@@ -71,7 +79,7 @@ func (d *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
+	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards, nil)
 }
 
 // CreateDBConnection creates a returns a reference to a logical connection to the
@@ -26,19 +26,20 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
          ON CONFLICT (domain_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		ON CONFLICT (domain_id, run_id) DO UPDATE
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -51,7 +52,18 @@ const (
 			  encoding = excluded.encoding,
 				is_cron = excluded.is_cron,
 				num_clusters = excluded.num_clusters,
-				update_time = excluded.update_time`
+				update_time = excluded.update_time,
+				task_list = excluded.task_list,
+				search_attributes = excluded.search_attributes`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (domain_id, run_id) DO UPDATE
+		  SET memo = excluded.memo,
+			  encoding = excluded.encoding,
+			  update_time = excluded.update_time,
+			  search_attributes = excluded.search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND domain_id = $1
@@ -68,7 +80,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT $7`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, update_time`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, update_time, task_list, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
@@ -88,7 +100,7 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = $1` + templateConditions2
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, is_cron, update_time
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, is_cron, update_time, task_list, search_attributes
 		 FROM executions_visibility
 		 WHERE domain_id = $1 AND close_status IS NOT NULL
 		 AND run_id = $2`
@@ -114,7 +126,9 @@ func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.Encoding,
 		row.IsCron,
 		row.NumClusters,
-		row.UpdateTime)
+		row.UpdateTime,
+		row.TaskList,
+		searchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -138,7 +152,9 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			row.Encoding,
 			row.IsCron,
 			row.NumClusters,
-			row.UpdateTime)
+			row.UpdateTime,
+			row.TaskList,
+			searchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -150,6 +166,55 @@ func (pdb *db) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	return pdb.driver.ExecContext(ctx, dbShardID, templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
 }
 
+// UpsertIntoVisibility inserts a row into visibility table, or updates the search attributes of the existing row
+func (pdb *db) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	row.StartTime = pdb.converter.ToPostgresDateTime(row.StartTime)
+	return pdb.driver.ExecContext(ctx, dbShardID, templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.TaskList,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads the rows of visibility table matching an advanced visibility query
+func (pdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	query, args, err := sqlplugin.BuildSelectFromVisibilityByQuery(filter, &visibilityQueryDialect{converter: pdb.converter})
+	if err != nil {
+		return nil, err
+	}
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		pdb.convertVisibilityRow(&rows[i])
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows of visibility table matching an advanced visibility query
+func (pdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	query, args, err := sqlplugin.BuildCountFromVisibilityByQuery(filter, &visibilityQueryDialect{converter: pdb.converter})
+	if err != nil {
+		return 0, err
+	}
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	var count int64
+	err = pdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
 // SelectFromVisibility reads one or more rows from visibility table
 func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) ([]sqlplugin.VisibilityRow, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
@@ -228,14 +293,55 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 		return nil, err
 	}
 	for i := range rows {
-		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
-		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
-		if rows[i].CloseTime != nil {
-			closeTime := pdb.converter.FromPostgresDateTime(*rows[i].CloseTime)
-			rows[i].CloseTime = &closeTime
-		}
-		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
-		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
+		pdb.convertVisibilityRow(&rows[i])
 	}
 	return rows, err
 }
+
+func (pdb *db) convertVisibilityRow(row *sqlplugin.VisibilityRow) {
+	row.StartTime = pdb.converter.FromPostgresDateTime(row.StartTime)
+	row.ExecutionTime = pdb.converter.FromPostgresDateTime(row.ExecutionTime)
+	if row.CloseTime != nil {
+		closeTime := pdb.converter.FromPostgresDateTime(*row.CloseTime)
+		row.CloseTime = &closeTime
+	}
+	row.RunID = strings.TrimSpace(row.RunID)
+	row.WorkflowID = strings.TrimSpace(row.WorkflowID)
+}
+
+// searchAttributesArg returns the search attributes as string, so that
+// they are sent as text instead of bytea to the JSONB column
+func searchAttributesArg(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
+
+type visibilityQueryDialect struct {
+	converter DataConverter
+}
+
+func (d *visibilityQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (d *visibilityQueryDialect) SearchAttribute(key string) string {
+	return fmt.Sprintf("search_attributes->'%s'", key)
+}
+
+func (d *visibilityQueryDialect) SearchAttributeText(key string) string {
+	return fmt.Sprintf("search_attributes->>'%s'", key)
+}
+
+func (d *visibilityQueryDialect) SearchAttributeContains(key string, placeholder string) string {
+	return fmt.Sprintf("search_attributes->'%s' @> %s::jsonb", key, placeholder)
+}
+
+func (d *visibilityQueryDialect) JSONValue(placeholder string) string {
+	return fmt.Sprintf("%s::jsonb", placeholder)
+}
+
+func (d *visibilityQueryDialect) ConvertTime(t time.Time) time.Time {
+	return d.converter.ToPostgresDateTime(t)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	db struct {
+		converter   DataConverter
+		driver      sqldriver.Driver
+		originalDBs []*sqlx.DB
+		numDBShards int
+		// dbShardMapper is shared by the transactions started from the db
+		dbShardMapper *sqlplugin.DBShardMapper
+		// inMemory is true when the database is kept in memory instead of a file
+		inMemory bool
+		// sharedConn is true when the connections are shared within the process and must be kept open
+		sharedConn bool
+	}
+)
+
+func (sdb *db) GetTotalNumDBShards() int {
+	return sdb.numDBShards
+}
+
+var _ sqlplugin.AdminDB = (*db)(nil)
+var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.Tx = (*db)(nil)
+
+func (sdb *db) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(*sqlite.Error)
+	// a primary key or unique constraint violation indicates the row already exists,
+	// so we don't do the insert and return a ConditionalUpdate error.
+	return ok && (sqlErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY ||
+		sqlErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE)
+}
+
+func (sdb *db) IsNotFoundError(err error) bool {
+	return err == sql.ErrNoRows
+}
+
+func (sdb *db) IsTimeoutError(err error) bool {
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	sqlErr, ok := err.(*sqlite.Error)
+	// SQLITE_BUSY is returned once the busy timeout elapsed while waiting for the write lock,
+	// the code is an extended result code so the primary result code is in the low byte
+	return ok && (sqlErr.Code()&0xff == sqlite3.SQLITE_BUSY || sqlErr.Code()&0xff == sqlite3.SQLITE_LOCKED)
+}
+
+func (sdb *db) IsThrottlingError(err error) bool {
+	return false
+}
+
+// newDB returns an instance of DB, which is a logical
+// connection to the underlying sqlite database
+// dbShardID is needed when tx is not nil
+func newDB(xdbs []*sqlx.DB, tx *sqlx.Tx, dbShardID int, numDBShards int, inMemory bool, sharedConn bool, dbShardMapper *sqlplugin.DBShardMapper) (*db, error) {
+	driver, err := sqldriver.NewDriver(xdbs, tx, dbShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &db{
+		converter:   &converter{},
+		originalDBs: xdbs, // this is kept because newDB will be called again when starting a transaction
+		driver:      driver,
+		numDBShards: numDBShards,
+		inMemory:    inMemory,
+		sharedConn:  sharedConn,
+	}
+	if dbShardMapper == nil {
+		dbShardMapper = sqlplugin.NewDBShardMapper(numDBShards, len(xdbs), db.SelectLatestFromDBShardMaps)
+	}
+	db.dbShardMapper = dbShardMapper
+
+	return db, nil
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (sdb *db) BeginTx(ctx context.Context, dbShardID int) (sqlplugin.Tx, error) {
+	xtx, err := sdb.driver.BeginTxx(ctx, dbShardID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newDB(sdb.originalDBs, xtx, dbShardID, sdb.numDBShards, sdb.inMemory, sdb.sharedConn, sdb.dbShardMapper)
+}
+
+// Commit commits a previously started transaction
+func (sdb *db) Commit() error {
+	return sdb.driver.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (sdb *db) Rollback() error {
+	return sdb.driver.Rollback()
+}
+
+// Close closes the connection to the sqlite db
+func (sdb *db) Close() error {
+	sdb.dbShardMapper.Stop()
+	if sdb.sharedConn {
+		// closing the shared connection would drop the in-memory database
+		return nil
+	}
+	return sdb.driver.Close()
+}
+
+// PluginName returns the name of the sqlite plugin
+func (sdb *db) PluginName() string {
+	return PluginName
+}
+
+// SupportsTTL returns weather SQLite supports TTL
+func (sdb *db) SupportsTTL() bool {
+	return false
+}
+
+// MaxAllowedTTL returns the max allowed ttl SQLite supports
+func (sdb *db) MaxAllowedTTL() (*time.Duration, error) {
+	return nil, sqlplugin.ErrTTLNotSupported
+}
+
+// SupportsAsyncTransaction returns weather SQLite supports Asynchronous transaction
+func (sdb *db) SupportsAsyncTransaction() bool {
+	return false
+}
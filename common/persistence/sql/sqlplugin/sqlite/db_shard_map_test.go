@@ -0,0 +1,178 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"io/fs"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	sqliteschema "github.com/uber/cadence/schema/sqlite"
+)
+
+func TestMoveHistoryShard(t *testing.T) {
+	ctx := context.Background()
+	schema, err := fs.ReadFile(sqliteschema.SchemaFS, "cadence/schema.sql")
+	require.NoError(t, err)
+
+	p := &plugin{}
+	memory := map[string]string{modeAttrName: modeMemory}
+	var databases []config.MultipleDatabasesConfigEntry
+	for _, name := range []string{"move_shard_test_0", "move_shard_test_1", "move_shard_test_2"} {
+		adminDB, err := p.CreateAdminDB(&config.SQL{DatabaseName: name, ConnectAttributes: memory})
+		require.NoError(t, err)
+		require.NoError(t, adminDB.ExecSchemaOperationQuery(ctx, string(schema)))
+		defer func(name string) {
+			assert.NoError(t, adminDB.DropDatabase(name))
+		}(name)
+		databases = append(databases, config.MultipleDatabasesConfigEntry{DatabaseName: name})
+	}
+
+	// the third database is only used by the DB shard map
+	db, err := p.CreateDB(&config.SQL{
+		NumShards:               2,
+		UseMultipleDatabases:    true,
+		MultipleDatabasesConfig: databases,
+		ConnectAttributes:       memory,
+	})
+	require.NoError(t, err)
+	defer db.Close()
+	assert.Equal(t, 3, db.GetTotalNumDatabases())
+
+	historyShardID := 4
+	assert.Equal(t, 0, db.GetDBShardIDFromHistoryShardID(historyShardID))
+	_, err = db.InsertIntoShards(ctx, &sqlplugin.ShardsRow{ShardID: int64(historyShardID), RangeID: 3, Data: []byte("shard"), DataEncoding: "json"})
+	require.NoError(t, err)
+	bufferedEvents := []sqlplugin.BufferedEventsRow{
+		{ShardID: historyShardID, DomainID: serialization.MustParseUUID(uuid.New()), WorkflowID: "wid", RunID: serialization.MustParseUUID(uuid.New()), Data: []byte("event1"), DataEncoding: "json"},
+		{ShardID: historyShardID, DomainID: serialization.MustParseUUID(uuid.New()), WorkflowID: "wid", RunID: serialization.MustParseUUID(uuid.New()), Data: []byte("event2"), DataEncoding: "json"},
+	}
+	_, err = db.InsertIntoBufferedEvents(ctx, bufferedEvents)
+	require.NoError(t, err)
+
+	moving, err := db.GetDBShardMap().StartMove(historyShardID, 2)
+	require.NoError(t, err)
+	row, err := moving.ToRow()
+	require.NoError(t, err)
+	_, err = db.InsertIntoDBShardMaps(ctx, row)
+	require.NoError(t, err)
+	// the version is used to detect concurrent updates
+	_, err = db.InsertIntoDBShardMaps(ctx, row)
+	assert.True(t, db.IsDupEntryError(err))
+
+	shardMap, err := db.RefreshDBShardMap(ctx)
+	require.NoError(t, err)
+	_, ok := shardMap.GetMove(historyShardID)
+	assert.True(t, ok)
+
+	transferTasks := []sqlplugin.TransferTasksRow{
+		{ShardID: historyShardID, TaskID: 1, Data: []byte("task1"), DataEncoding: "json"},
+		{ShardID: historyShardID, TaskID: 2, Data: []byte("task2"), DataEncoding: "json"},
+		{ShardID: historyShardID, TaskID: 3, Data: []byte("task3"), DataEncoding: "json"},
+	}
+	_, err = db.InsertIntoTransferTasks(ctx, transferTasks)
+	require.NoError(t, err)
+
+	syncRows := func() int {
+		written := 0
+		for _, table := range sqlplugin.HistoryShardTables {
+			if table.AutoIncrementColumn != "" {
+				_, err := db.DeleteHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{Table: table.Name, HistoryShardID: historyShardID, DBShardID: 2})
+				require.NoError(t, err)
+			}
+			filter := &sqlplugin.HistoryShardRowsFilter{
+				Table:           table.Name,
+				HistoryShardID:  historyShardID,
+				DBShardID:       0,
+				TargetDBShardID: 2,
+				PageSize:        1,
+			}
+			for {
+				page, err := db.SyncHistoryShardRows(ctx, filter)
+				require.NoError(t, err)
+				written += page.Written
+				if page.LastKey == nil {
+					break
+				}
+				filter.AfterKey = page.LastKey
+			}
+			sourceCount, err := db.CountHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{Table: table.Name, HistoryShardID: historyShardID, DBShardID: 0})
+			require.NoError(t, err)
+			targetCount, err := db.CountHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{Table: table.Name, HistoryShardID: historyShardID, DBShardID: 2})
+			require.NoError(t, err)
+			assert.Equal(t, sourceCount, targetCount, table.Name)
+		}
+		return written
+	}
+	assert.Equal(t, 6, syncRows())
+
+	// only the rows changed since the last sync are written again, apart from the buffered events
+	_, err = db.UpdateShards(ctx, &sqlplugin.ShardsRow{ShardID: int64(historyShardID), RangeID: 3, Data: []byte("shard updated"), DataEncoding: "json"})
+	require.NoError(t, err)
+	_, err = db.DeleteFromTransferTasks(ctx, &sqlplugin.TransferTasksFilter{ShardID: historyShardID, TaskID: 2})
+	require.NoError(t, err)
+	_, err = db.InsertIntoTransferTasks(ctx, []sqlplugin.TransferTasksRow{{ShardID: historyShardID, TaskID: 4, Data: []byte("task4"), DataEncoding: "json"}})
+	require.NoError(t, err)
+	assert.Equal(t, 5, syncRows())
+	assert.Equal(t, 2, syncRows())
+
+	completed, err := moving.CompleteMove(historyShardID)
+	require.NoError(t, err)
+	row, err = completed.ToRow()
+	require.NoError(t, err)
+	_, err = db.InsertIntoDBShardMaps(ctx, row)
+	require.NoError(t, err)
+	_, err = db.RefreshDBShardMap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, db.GetDBShardIDFromHistoryShardID(historyShardID))
+
+	for _, table := range []string{"shards", "buffered_events", "transfer_tasks"} {
+		_, err := db.DeleteHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{Table: table, HistoryShardID: historyShardID, DBShardID: 0})
+		require.NoError(t, err)
+	}
+
+	shard, err := db.SelectFromShards(ctx, &sqlplugin.ShardsFilter{ShardID: int64(historyShardID)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), shard.RangeID)
+	assert.Equal(t, []byte("shard updated"), shard.Data)
+	tasks, err := db.SelectFromTransferTasks(ctx, &sqlplugin.TransferTasksFilter{ShardID: historyShardID, MinTaskID: 0, MaxTaskID: 10, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, tasks, 3)
+	assert.Equal(t, int64(4), tasks[2].TaskID)
+	for _, event := range bufferedEvents {
+		rows, err := db.SelectFromBufferedEvents(ctx, &sqlplugin.BufferedEventsFilter{
+			ShardID:    historyShardID,
+			DomainID:   event.DomainID,
+			WorkflowID: event.WorkflowID,
+			RunID:      event.RunID,
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		assert.Equal(t, event.Data, rows[0].Data)
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	_ "modernc.org/sqlite" // needed to register the sqlite driver, it's pure Go so the binaries don't need cgo
+
+	"github.com/uber/cadence/common/config"
+	pt "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "sqlite"
+	driverName = "sqlite"
+
+	// modeAttrName is the connect attribute to choose between a file backed and an in-memory database
+	modeAttrName = "mode"
+	// modeMemory keeps the database in memory, it lives as long as the process
+	modeMemory = "memory"
+
+	fileDSNFmt   = "file:%s"
+	memoryDSNFmt = "file:%s?mode=memory&cache=shared"
+	// privateMemoryDSN is used when no database name is given, e.g. when connecting to create a database
+	privateMemoryDSN = ":memory:"
+
+	jsonCheckQuery = `SELECT json('{}')`
+
+	// pragmaParamName is the parameter of the driver for the PRAGMA statements run on every connection
+	pragmaParamName = "_pragma"
+)
+
+// dsnAttrDefaults are applied unless overridden by the connect attributes. The attributes starting with
+// an underscore are parameters of the driver, the others are run as PRAGMA statements on every connection.
+// SQLite allows a single writer only, so transactions take the write lock upfront
+// and wait for it instead of failing with SQLITE_BUSY on lock upgrade.
+// Times are written in the format that SQLite date and time functions understand.
+var dsnAttrDefaults = map[string]string{
+	"busy_timeout": "10000",
+	"_txlock":      "immediate",
+	"_time_format": "sqlite",
+}
+
+// fileDSNAttrDefaults are applied on top of dsnAttrDefaults for file backed databases
+var fileDSNAttrDefaults = map[string]string{
+	"journal_mode": "WAL",
+}
+
+var (
+	// memoryDBs holds the connections to in-memory databases, keyed by database name.
+	// An in-memory database is dropped by SQLite once its last connection is closed,
+	// so the connection is shared by every DB object of the process and never closed
+	// until the database is dropped.
+	memoryDBs     = map[string]*sqlx.DB{}
+	memoryDBsLock sync.Mutex
+)
+
+type plugin struct{}
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
+	sharedConn := usesSharedConn(cfg)
+	conns, err := sqldriver.CreateDBConnections(cfg, func(cfg *config.SQL) (*sqlx.DB, error) {
+		return p.createSingleDBConn(cfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	db, err := newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards, isInMemory(cfg), sharedConn, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.dbShardMapper.Start(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// CreateAdminDB initialize the adminDb object
+func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
+	sharedConn := usesSharedConn(cfg)
+	conns, err := sqldriver.CreateDBConnections(cfg, func(cfg *config.SQL) (*sqlx.DB, error) {
+		return p.createSingleDBConn(cfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards, isInMemory(cfg), sharedConn, nil)
+}
+
+func (p *plugin) createSingleDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	if usesSharedConn(cfg) {
+		return getOrCreateMemoryDBConn(cfg)
+	}
+	return createDBConn(cfg)
+}
+
+func createDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	db, err := connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite serializes writes anyway, a single connection avoids lock contention
+	// between connections of the same process
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	if cfg.MaxConnLifetime > 0 {
+		db.SetConnMaxLifetime(cfg.MaxConnLifetime)
+	}
+
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
+
+func getOrCreateMemoryDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	memoryDBsLock.Lock()
+	defer memoryDBsLock.Unlock()
+
+	if db, ok := memoryDBs[cfg.DatabaseName]; ok {
+		return db, nil
+	}
+	db, err := connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// the connection must never be recycled, otherwise the database is lost
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+	db.MapperFunc(strcase.ToSnake)
+	memoryDBs[cfg.DatabaseName] = db
+	return db, nil
+}
+
+func connect(cfg *config.SQL) (*sqlx.DB, error) {
+	db, err := sqlx.Connect(driverName, buildDSN(cfg))
+	if err != nil {
+		return nil, err
+	}
+	// visibility queries rely on the JSON functions, fail early if SQLite was built without them
+	if _, err := db.Exec(jsonCheckQuery); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("sqlite plugin requires the JSON functions of SQLite: %v", err)
+	}
+	return db, nil
+}
+
+func dropMemoryDB(name string) error {
+	memoryDBsLock.Lock()
+	defer memoryDBsLock.Unlock()
+
+	db, ok := memoryDBs[name]
+	if !ok {
+		return nil
+	}
+	delete(memoryDBs, name)
+	return db.Close()
+}
+
+func dropFileDB(name string) error {
+	// WAL mode keeps two extra files next to the database file
+	for _, path := range []string{name, name + "-wal", name + "-shm"} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func isInMemory(cfg *config.SQL) bool {
+	return cfg.ConnectAttributes[modeAttrName] == modeMemory
+}
+
+// usesSharedConn returns true if the connection to a named in-memory database is needed,
+// connections without a database name are private to the DB object
+func usesSharedConn(cfg *config.SQL) bool {
+	return isInMemory(cfg) && (cfg.DatabaseName != "" || cfg.UseMultipleDatabases)
+}
+
+func buildDSN(cfg *config.SQL) string {
+	if cfg.DatabaseName == "" {
+		return privateMemoryDSN
+	}
+	if isInMemory(cfg) {
+		dsn := fmt.Sprintf(memoryDSNFmt, url.PathEscape(cfg.DatabaseName))
+		if attrs := buildDSNAttrs(cfg, dsnAttrDefaults); attrs != "" {
+			dsn = dsn + "&" + attrs
+		}
+		return dsn
+	}
+
+	defaults := make(map[string]string, len(dsnAttrDefaults)+len(fileDSNAttrDefaults))
+	for k, v := range dsnAttrDefaults {
+		defaults[k] = v
+	}
+	for k, v := range fileDSNAttrDefaults {
+		defaults[k] = v
+	}
+	dsn := fmt.Sprintf(fileDSNFmt, cfg.DatabaseName)
+	if attrs := buildDSNAttrs(cfg, defaults); attrs != "" {
+		dsn = dsn + "?" + attrs
+	}
+	return dsn
+}
+
+func buildDSNAttrs(cfg *config.SQL, defaults map[string]string) string {
+	attrs := make(map[string]string, len(defaults)+len(cfg.ConnectAttributes))
+	for k, v := range defaults {
+		attrs[k] = v
+	}
+	for k, v := range cfg.ConnectAttributes {
+		if k == modeAttrName {
+			continue
+		}
+		attrs[k] = v
+	}
+
+	values := url.Values{}
+	for k, v := range attrs {
+		if strings.HasPrefix(k, "_") {
+			values.Set(k, v)
+		} else {
+			values.Add(pragmaParamName, fmt.Sprintf("%v(%v)", k, v))
+		}
+	}
+	// the pragmas are run in order, keep it stable
+	sort.Strings(values[pragmaParamName])
+	// Encode sorts by key which keeps the DSN stable
+	return values.Encode()
+}
+
+const (
+	testSchemaDir = "schema/sqlite"
+)
+
+// GetTestClusterOption return test options
+func GetTestClusterOption() *pt.TestBaseOptions {
+	return &pt.TestBaseOptions{
+		DBPluginName: PluginName,
+		DBName:       filepath.Join(os.TempDir(), "cadence_test_"+pt.GenerateRandomDBName(10)+".db"),
+		SchemaDir:    testSchemaDir,
+		StoreType:    config.StoreTypeSQL,
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/config"
+)
+
+func TestBuildDSN(t *testing.T) {
+	testCases := []struct {
+		name string
+		in   config.SQL
+		out  string
+	}{
+		{
+			name: "no database name",
+			in:   config.SQL{},
+			out:  ":memory:",
+		},
+		{
+			name: "file",
+			in: config.SQL{
+				DatabaseName: "/tmp/cadence.db",
+			},
+			out: "file:/tmp/cadence.db?_pragma=busy_timeout%2810000%29&_pragma=journal_mode%28WAL%29&_time_format=sqlite&_txlock=immediate",
+		},
+		{
+			name: "file with connect attributes",
+			in: config.SQL{
+				DatabaseName:      "/tmp/cadence.db",
+				ConnectAttributes: map[string]string{"busy_timeout": "500", "foreign_keys": "1", "_txlock": "exclusive"},
+			},
+			out: "file:/tmp/cadence.db?_pragma=busy_timeout%28500%29&_pragma=foreign_keys%281%29&_pragma=journal_mode%28WAL%29&_time_format=sqlite&_txlock=exclusive",
+		},
+		{
+			name: "memory",
+			in: config.SQL{
+				DatabaseName:      "cadence",
+				ConnectAttributes: map[string]string{"mode": "memory"},
+			},
+			out: "file:cadence?mode=memory&cache=shared&_pragma=busy_timeout%2810000%29&_time_format=sqlite&_txlock=immediate",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.out, buildDSN(&tc.in))
+		})
+	}
+}
+
+func TestInMemoryDatabaseIsShared(t *testing.T) {
+	cfg := &config.SQL{
+		DatabaseName:      "shared_test",
+		ConnectAttributes: map[string]string{"mode": "memory"},
+	}
+	p := &plugin{}
+
+	adminDB, err := p.CreateAdminDB(cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, adminDB.ExecSchemaOperationQuery(context.Background(), "CREATE TABLE test (id INTEGER PRIMARY KEY)"))
+	// closing the admin connection must keep the in-memory database
+	assert.NoError(t, adminDB.Close())
+
+	db, err := p.CreateAdminDB(cfg)
+	assert.NoError(t, err)
+	tables, err := db.ListTables(cfg.DatabaseName)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test"}, tables)
+
+	assert.NoError(t, db.DropDatabase(cfg.DatabaseName))
+	assert.NoError(t, db.Close())
+}
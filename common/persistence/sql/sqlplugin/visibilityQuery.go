@@ -0,0 +1,555 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// VisibilityQueryDialect provides the database specific parts of the translation
+	// of an advanced visibility query into SQL
+	VisibilityQueryDialect interface {
+		// Placeholder returns the bind variable of the n-th argument, starting from 1
+		Placeholder(n int) string
+		// SearchAttribute returns an expression reading a custom search attribute from the
+		// search_attributes column, the result must be comparable with JSONValue
+		SearchAttribute(key string) string
+		// SearchAttributeText returns an expression reading a custom search attribute as text
+		SearchAttributeText(key string) string
+		// SearchAttributeContains returns a condition which is true if the custom search attribute is equal
+		// to the JSON encoded bind variable, or if it is an array containing that value
+		SearchAttributeContains(key string, placeholder string) string
+		// JSONValue converts a JSON encoded bind variable so that it is comparable with SearchAttribute
+		JSONValue(placeholder string) string
+		// ConvertTime converts a time argument to the representation of the datetime columns
+		ConvertTime(t time.Time) time.Time
+	}
+
+	// VisibilityQuery is a parsed advanced visibility query, it has the same syntax as the
+	// query of ListWorkflowExecutions on ElasticSearch
+	VisibilityQuery struct {
+		where   sqlparser.Expr
+		orderBy *sqlparser.Order
+	}
+
+	visibilityColumnType int
+
+	visibilityColumn struct {
+		name       string
+		columnType visibilityColumnType
+	}
+
+	visibilityQueryTranslator struct {
+		dialect VisibilityQueryDialect
+		args    []interface{}
+	}
+)
+
+const (
+	visibilityColumnTypeKeyword visibilityColumnType = iota
+	visibilityColumnTypeInt
+	visibilityColumnTypeBool
+	visibilityColumnTypeTime
+	visibilityColumnTypeCloseStatus
+)
+
+const (
+	visibilityQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, update_time, ` +
+		`close_time, close_status, history_length, task_list, search_attributes`
+	visibilityQueryDefaultOrderBy = `start_time DESC, run_id`
+	// missing is the value used by the query to check whether a field is set, e.g. CloseTime = missing
+	visibilityQueryMissingValue = "missing"
+)
+
+var (
+	visibilityColumns = map[string]visibilityColumn{
+		definition.DomainID:      {name: "domain_id", columnType: visibilityColumnTypeKeyword},
+		definition.WorkflowID:    {name: "workflow_id", columnType: visibilityColumnTypeKeyword},
+		definition.RunID:         {name: "run_id", columnType: visibilityColumnTypeKeyword},
+		definition.WorkflowType:  {name: "workflow_type_name", columnType: visibilityColumnTypeKeyword},
+		definition.TaskList:      {name: "task_list", columnType: visibilityColumnTypeKeyword},
+		definition.StartTime:     {name: "start_time", columnType: visibilityColumnTypeTime},
+		definition.ExecutionTime: {name: "execution_time", columnType: visibilityColumnTypeTime},
+		definition.CloseTime:     {name: "close_time", columnType: visibilityColumnTypeTime},
+		definition.UpdateTime:    {name: "update_time", columnType: visibilityColumnTypeTime},
+		definition.CloseStatus:   {name: "close_status", columnType: visibilityColumnTypeCloseStatus},
+		definition.HistoryLength: {name: "history_length", columnType: visibilityColumnTypeInt},
+		definition.NumClusters:   {name: "num_clusters", columnType: visibilityColumnTypeInt},
+		definition.IsCron:        {name: "is_cron", columnType: visibilityColumnTypeBool},
+	}
+
+	// search attribute keys are embedded into JSON paths, so only plain identifiers are accepted
+	searchAttributeKeyRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	visibilityComparisonOperators = map[string]bool{
+		sqlparser.EqualStr:        true,
+		sqlparser.NotEqualStr:     true,
+		sqlparser.LessThanStr:     true,
+		sqlparser.LessEqualStr:    true,
+		sqlparser.GreaterThanStr:  true,
+		sqlparser.GreaterEqualStr: true,
+	}
+)
+
+// ParseVisibilityQuery parses the where and order by clause of an advanced visibility query,
+// custom search attributes used by the query must be keys of validSearchAttributes
+func ParseVisibilityQuery(query string, validSearchAttributes map[string]interface{}) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &VisibilityQuery{}, nil
+	}
+
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid query: %v", err)}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, &types.BadRequestError{Message: "Invalid select query."}
+	}
+	if sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil, &types.BadRequestError{Message: "Invalid query: only where and order by clauses are supported."}
+	}
+	if err := validateVisibilityFields(sel, validSearchAttributes); err != nil {
+		return nil, err
+	}
+
+	visibilityQuery := &VisibilityQuery{}
+	if sel.Where != nil {
+		visibilityQuery.where = sel.Where.Expr
+	}
+	switch len(sel.OrderBy) {
+	case 0:
+	case 1:
+		visibilityQuery.orderBy = sel.OrderBy[0]
+	default:
+		return nil, &types.BadRequestError{Message: "Only one field can be used to sort."}
+	}
+	return visibilityQuery, nil
+}
+
+// HasOrderBy returns true if the query specifies an order by clause
+func (q *VisibilityQuery) HasOrderBy() bool {
+	return q.orderBy != nil
+}
+
+// BuildSelectFromVisibilityByQuery translates filter into a SELECT statement on executions_visibility table
+func BuildSelectFromVisibilityByQuery(filter *VisibilityQueryFilter, dialect VisibilityQueryDialect) (string, []interface{}, error) {
+	t := &visibilityQueryTranslator{dialect: dialect}
+	conditions, err := t.conditions(filter)
+	if err != nil {
+		return "", nil, err
+	}
+
+	orderBy := visibilityQueryDefaultOrderBy
+	if filter.Query.HasOrderBy() {
+		orderBy, err = t.orderBy(filter.Query.orderBy)
+		if err != nil {
+			return "", nil, err
+		}
+	} else if filter.LastStartTime != nil && filter.LastRunID != nil {
+		// RunID condition is needed for correct pagination
+		lastStartTime := dialect.ConvertTime(*filter.LastStartTime)
+		conditions = append(conditions, fmt.Sprintf("(start_time < %s OR (start_time = %s AND run_id > %s))",
+			t.bind(lastStartTime), t.bind(lastStartTime), t.bind(*filter.LastRunID)))
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM executions_visibility WHERE %s ORDER BY %s LIMIT %s",
+		visibilityQueryFieldNames, strings.Join(conditions, " AND "), orderBy, t.bind(filter.PageSize))
+	if filter.Query.HasOrderBy() {
+		query += " OFFSET " + t.bind(filter.Offset)
+	}
+	return query, t.args, nil
+}
+
+// BuildCountFromVisibilityByQuery translates filter into a SELECT COUNT statement on executions_visibility table
+func BuildCountFromVisibilityByQuery(filter *VisibilityQueryFilter, dialect VisibilityQueryDialect) (string, []interface{}, error) {
+	t := &visibilityQueryTranslator{dialect: dialect}
+	conditions, err := t.conditions(filter)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("SELECT COUNT(*) FROM executions_visibility WHERE %s", strings.Join(conditions, " AND ")), t.args, nil
+}
+
+func (t *visibilityQueryTranslator) conditions(filter *VisibilityQueryFilter) ([]string, error) {
+	conditions := []string{"domain_id = " + t.bind(filter.DomainID)}
+	if filter.Query != nil && filter.Query.where != nil {
+		where, err := t.where(filter.Query.where)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, "("+where+")")
+	}
+	return conditions, nil
+}
+
+func (t *visibilityQueryTranslator) bind(arg interface{}) string {
+	t.args = append(t.args, arg)
+	return t.dialect.Placeholder(len(t.args))
+}
+
+func (t *visibilityQueryTranslator) bindJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return t.bind(string(data)), nil
+}
+
+func (t *visibilityQueryTranslator) where(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return t.binary(expr.Left, "AND", expr.Right)
+	case *sqlparser.OrExpr:
+		return t.binary(expr.Left, "OR", expr.Right)
+	case *sqlparser.NotExpr:
+		inner, err := t.where(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + inner + ")", nil
+	case *sqlparser.ParenExpr:
+		inner, err := t.where(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "(" + inner + ")", nil
+	case *sqlparser.ComparisonExpr:
+		return t.comparison(expr)
+	case *sqlparser.RangeCond:
+		return t.rangeCond(expr)
+	default:
+		return "", newInvalidQueryError("unsupported expression %q", sqlparser.String(expr))
+	}
+}
+
+func (t *visibilityQueryTranslator) binary(left sqlparser.Expr, operator string, right sqlparser.Expr) (string, error) {
+	leftCond, err := t.where(left)
+	if err != nil {
+		return "", err
+	}
+	rightCond, err := t.where(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s", leftCond, operator, rightCond), nil
+}
+
+func (t *visibilityQueryTranslator) comparison(expr *sqlparser.ComparisonExpr) (string, error) {
+	column, searchAttribute, err := parseVisibilityField(expr.Left)
+	if err != nil {
+		return "", err
+	}
+
+	if isMissingValue(expr.Right) {
+		target := t.fieldExpr(column, searchAttribute)
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return target + " IS NULL", nil
+		case sqlparser.NotEqualStr:
+			return target + " IS NOT NULL", nil
+		default:
+			return "", newInvalidQueryError("operator %q is not supported for missing value", expr.Operator)
+		}
+	}
+
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return "", newInvalidQueryError("invalid value of %q", sqlparser.String(expr))
+		}
+		var conditions []string
+		for _, valueExpr := range tuple {
+			condition, err := t.compare(column, searchAttribute, sqlparser.EqualStr, valueExpr)
+			if err != nil {
+				return "", err
+			}
+			conditions = append(conditions, condition)
+		}
+		condition := "(" + strings.Join(conditions, " OR ") + ")"
+		if expr.Operator == sqlparser.NotInStr {
+			condition = "NOT " + condition
+		}
+		return condition, nil
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		value, err := parseVisibilityValue(expr.Right)
+		if err != nil {
+			return "", err
+		}
+		pattern, ok := value.(string)
+		if !ok {
+			return "", newInvalidQueryError("like pattern must be a string: %q", sqlparser.String(expr))
+		}
+		var target string
+		if column != nil {
+			if column.columnType != visibilityColumnTypeKeyword {
+				return "", newInvalidQueryError("like is only supported on keyword fields: %q", sqlparser.String(expr))
+			}
+			target = column.name
+		} else {
+			target = t.dialect.SearchAttributeText(searchAttribute)
+		}
+		operator := "LIKE"
+		if expr.Operator == sqlparser.NotLikeStr {
+			operator = "NOT LIKE"
+		}
+		return fmt.Sprintf("%s %s %s", target, operator, t.bind(pattern)), nil
+	default:
+		if !visibilityComparisonOperators[expr.Operator] {
+			return "", newInvalidQueryError("operator %q is not supported", expr.Operator)
+		}
+		return t.compare(column, searchAttribute, expr.Operator, expr.Right)
+	}
+}
+
+func (t *visibilityQueryTranslator) rangeCond(expr *sqlparser.RangeCond) (string, error) {
+	column, searchAttribute, err := parseVisibilityField(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	from, err := t.compare(column, searchAttribute, sqlparser.GreaterEqualStr, expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := t.compare(column, searchAttribute, sqlparser.LessEqualStr, expr.To)
+	if err != nil {
+		return "", err
+	}
+	condition := fmt.Sprintf("(%s AND %s)", from, to)
+	if expr.Operator == sqlparser.NotBetweenStr {
+		condition = "NOT " + condition
+	}
+	return condition, nil
+}
+
+// compare returns the condition comparing a column or custom search attribute with a value
+func (t *visibilityQueryTranslator) compare(
+	column *visibilityColumn,
+	searchAttribute string,
+	operator string,
+	valueExpr sqlparser.Expr,
+) (string, error) {
+	value, err := parseVisibilityValue(valueExpr)
+	if err != nil {
+		return "", err
+	}
+
+	if column == nil {
+		placeholder, err := t.bindJSON(value)
+		if err != nil {
+			return "", err
+		}
+		if operator == sqlparser.EqualStr {
+			return t.dialect.SearchAttributeContains(searchAttribute, placeholder), nil
+		}
+		return fmt.Sprintf("%s %s %s", t.dialect.SearchAttribute(searchAttribute), operator, t.dialect.JSONValue(placeholder)), nil
+	}
+
+	arg, err := t.columnValue(column, value)
+	if err != nil {
+		return "", err
+	}
+	if operator == sqlparser.NotEqualStr {
+		operator = "<>"
+	}
+	return fmt.Sprintf("%s %s %s", column.name, operator, t.bind(arg)), nil
+}
+
+// columnValue converts a query value to the type of the column
+func (t *visibilityQueryTranslator) columnValue(column *visibilityColumn, value interface{}) (interface{}, error) {
+	switch column.columnType {
+	case visibilityColumnTypeKeyword:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		default:
+			return fmt.Sprintf("%v", v), nil
+		}
+	case visibilityColumnTypeInt:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case string:
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return i, nil
+			}
+		}
+	case visibilityColumnTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b, nil
+			}
+		}
+	case visibilityColumnTypeTime:
+		switch v := value.(type) {
+		case int64:
+			return t.dialect.ConvertTime(time.Unix(0, v)), nil
+		case string:
+			// same as ElasticSearch, time can be either unix nano or RFC3339
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return t.dialect.ConvertTime(time.Unix(0, i)), nil
+			}
+			if parsed, err := time.Parse(time.RFC3339, v); err == nil {
+				return t.dialect.ConvertTime(parsed), nil
+			}
+		}
+	case visibilityColumnTypeCloseStatus:
+		switch v := value.(type) {
+		case int64:
+			return int32(v), nil
+		case string:
+			var status types.WorkflowExecutionCloseStatus
+			if err := status.UnmarshalText([]byte(v)); err == nil {
+				return int32(status), nil
+			}
+		}
+	}
+	return nil, newInvalidQueryError("invalid value %v for field %q", value, column.name)
+}
+
+func (t *visibilityQueryTranslator) orderBy(order *sqlparser.Order) (string, error) {
+	column, searchAttribute, err := parseVisibilityField(order.Expr)
+	if err != nil {
+		return "", err
+	}
+	direction := "ASC"
+	if order.Direction == sqlparser.DescScr {
+		direction = "DESC"
+	}
+	// RunID is used as tie-breaker
+	return fmt.Sprintf("%s %s, run_id", t.fieldExpr(column, searchAttribute), direction), nil
+}
+
+func (t *visibilityQueryTranslator) fieldExpr(column *visibilityColumn, searchAttribute string) string {
+	if column != nil {
+		return column.name
+	}
+	return t.dialect.SearchAttribute(searchAttribute)
+}
+
+// parseVisibilityField returns either the column or the custom search attribute key referenced by expr
+func parseVisibilityField(expr sqlparser.Expr) (*visibilityColumn, string, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, "", newInvalidQueryError("invalid field %q", sqlparser.String(expr))
+	}
+	name := colName.Name.String()
+	if colName.Qualifier.Name.String() != definition.Attr {
+		// frontend adds the attr prefix to custom search attributes
+		name = strings.TrimPrefix(name, definition.Attr+".")
+		if column, ok := visibilityColumns[name]; ok {
+			return &column, "", nil
+		}
+	}
+	if !searchAttributeKeyRegex.MatchString(name) {
+		return nil, "", newInvalidQueryError("invalid search attribute %q", name)
+	}
+	return nil, name, nil
+}
+
+// validateVisibilityFields checks that every custom search attribute referenced by the query is registered
+func validateVisibilityFields(sel *sqlparser.Select, validSearchAttributes map[string]interface{}) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		var field sqlparser.Expr
+		switch node := node.(type) {
+		case *sqlparser.ComparisonExpr:
+			field = node.Left
+		case *sqlparser.RangeCond:
+			field = node.Left
+		case *sqlparser.Order:
+			field = node.Expr
+		default:
+			return true, nil
+		}
+		_, searchAttribute, err := parseVisibilityField(field)
+		if err != nil {
+			return false, err
+		}
+		if searchAttribute != "" {
+			if _, ok := validSearchAttributes[searchAttribute]; !ok {
+				return false, newInvalidQueryError("invalid search attribute %q", searchAttribute)
+			}
+		}
+		return true, nil
+	}, sel.Where, sel.OrderBy)
+}
+
+func parseVisibilityValue(expr sqlparser.Expr) (interface{}, error) {
+	switch v := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch v.Type {
+		case sqlparser.StrVal:
+			return string(v.Val), nil
+		case sqlparser.IntVal:
+			return strconv.ParseInt(string(v.Val), 10, 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(v.Val), 64)
+		}
+	case sqlparser.BoolVal:
+		return bool(v), nil
+	case *sqlparser.UnaryExpr:
+		if v.Operator == sqlparser.UMinusStr {
+			value, err := parseVisibilityValue(v.Expr)
+			if err != nil {
+				return nil, err
+			}
+			switch n := value.(type) {
+			case int64:
+				return -n, nil
+			case float64:
+				return -n, nil
+			}
+		}
+	}
+	return nil, newInvalidQueryError("invalid value %q", sqlparser.String(expr))
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && colName.Name.EqualString(visibilityQueryMissingValue)
+}
+
+func newInvalidQueryError(format string, args ...interface{}) error {
+	return &types.BadRequestError{Message: "Invalid query: " + fmt.Sprintf(format, args...)}
+}
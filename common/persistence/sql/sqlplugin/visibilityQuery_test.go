@@ -0,0 +1,180 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+type testVisibilityQueryDialect struct{}
+
+func (d *testVisibilityQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (d *testVisibilityQueryDialect) SearchAttribute(key string) string {
+	return "sa." + key
+}
+
+func (d *testVisibilityQueryDialect) SearchAttributeText(key string) string {
+	return "text(sa." + key + ")"
+}
+
+func (d *testVisibilityQueryDialect) SearchAttributeContains(key string, placeholder string) string {
+	return fmt.Sprintf("contains(sa.%s, %s)", key, placeholder)
+}
+
+func (d *testVisibilityQueryDialect) JSONValue(placeholder string) string {
+	return "json(" + placeholder + ")"
+}
+
+func (d *testVisibilityQueryDialect) ConvertTime(t time.Time) time.Time {
+	return t.UTC()
+}
+
+func TestBuildSelectFromVisibilityByQuery(t *testing.T) {
+	startTime := time.Unix(0, 1600000000000000000).UTC()
+	tests := map[string]struct {
+		query         string
+		lastStartTime *time.Time
+		lastRunID     *string
+		offset        int
+		expectedWhere string
+		expectedOrder string
+		expectedArgs  []interface{}
+	}{
+		"empty query": {
+			query:         "",
+			expectedWhere: "domain_id = $1",
+			expectedOrder: "start_time DESC, run_id LIMIT $2",
+			expectedArgs:  []interface{}{"domain-id", 10},
+		},
+		"system attributes": {
+			query:         "WorkflowID = 'wid' and CloseStatus = 'FAILED' or HistoryLength > 5",
+			expectedWhere: "domain_id = $1 AND (workflow_id = $2 AND close_status = $3 OR history_length > $4)",
+			expectedOrder: "start_time DESC, run_id LIMIT $5",
+			expectedArgs:  []interface{}{"domain-id", "wid", int32(types.WorkflowExecutionCloseStatusFailed), int64(5), 10},
+		},
+		"time and missing value": {
+			query:         "StartTime >= 1600000000000000000 and CloseTime = missing",
+			expectedWhere: "domain_id = $1 AND (start_time >= $2 AND close_time IS NULL)",
+			expectedOrder: "start_time DESC, run_id LIMIT $3",
+			expectedArgs:  []interface{}{"domain-id", startTime, 10},
+		},
+		"custom search attributes": {
+			query:         "`Attr.CustomKeywordField` in ('a', 'b') and (`Attr.CustomIntField` between 1 and 5 or `Attr.CustomStringField` like '%foo%')",
+			expectedWhere: "domain_id = $1 AND ((contains(sa.CustomKeywordField, $2) OR contains(sa.CustomKeywordField, $3)) AND ((sa.CustomIntField >= json($4) AND sa.CustomIntField <= json($5)) OR text(sa.CustomStringField) LIKE $6))",
+			expectedOrder: "start_time DESC, run_id LIMIT $7",
+			expectedArgs:  []interface{}{"domain-id", `"a"`, `"b"`, "1", "5", "%foo%", 10},
+		},
+		"next page by start time": {
+			query:         "WorkflowType = 'type'",
+			lastStartTime: &startTime,
+			lastRunID:     common.StringPtr("run-id"),
+			expectedWhere: "domain_id = $1 AND (workflow_type_name = $2) AND (start_time < $3 OR (start_time = $4 AND run_id > $5))",
+			expectedOrder: "start_time DESC, run_id LIMIT $6",
+			expectedArgs:  []interface{}{"domain-id", "type", startTime, startTime, "run-id", 10},
+		},
+		"order by": {
+			query:         "CloseTime != missing order by CloseTime desc",
+			offset:        20,
+			expectedWhere: "domain_id = $1 AND (close_time IS NOT NULL)",
+			expectedOrder: "close_time DESC, run_id LIMIT $2 OFFSET $3",
+			expectedArgs:  []interface{}{"domain-id", 10, 20},
+		},
+		"order by custom search attribute": {
+			query:         "order by CustomIntField",
+			expectedWhere: "domain_id = $1",
+			expectedOrder: "sa.CustomIntField ASC, run_id LIMIT $2 OFFSET $3",
+			expectedArgs:  []interface{}{"domain-id", 10, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(test.query, definition.GetDefaultIndexedKeys())
+			require.NoError(t, err)
+			sql, args, err := BuildSelectFromVisibilityByQuery(&VisibilityQueryFilter{
+				DomainID:      "domain-id",
+				Query:         query,
+				LastStartTime: test.lastStartTime,
+				LastRunID:     test.lastRunID,
+				Offset:        test.offset,
+				PageSize:      10,
+			}, &testVisibilityQueryDialect{})
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("SELECT %s FROM executions_visibility WHERE %s ORDER BY %s",
+				visibilityQueryFieldNames, test.expectedWhere, test.expectedOrder), sql)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestBuildCountFromVisibilityByQuery(t *testing.T) {
+	query, err := ParseVisibilityQuery("not (IsCron = true) order by StartTime", definition.GetDefaultIndexedKeys())
+	require.NoError(t, err)
+	sql, args, err := BuildCountFromVisibilityByQuery(&VisibilityQueryFilter{
+		DomainID: "domain-id",
+		Query:    query,
+	}, &testVisibilityQueryDialect{})
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT COUNT(*) FROM executions_visibility WHERE domain_id = $1 AND (NOT ((is_cron = $2)))", sql)
+	assert.Equal(t, []interface{}{"domain-id", true}, args)
+}
+
+func TestInvalidVisibilityQuery(t *testing.T) {
+	tests := map[string]string{
+		"invalid syntax":           "WorkflowID = ",
+		"group by":                 "WorkflowID = 'wid' group by RunID",
+		"multiple order by fields": "order by StartTime, CloseTime",
+		"invalid attribute key":    "`Attr.a-b` = 'c'",
+		"unknown attribute":        "`Attr.UnknownField` = 'c'",
+		"unknown order by":         "order by UnknownField",
+		"invalid time":             "StartTime > 'yesterday'",
+		"invalid close status":     "CloseStatus = 'UNKNOWN'",
+		"unsupported operator":     "WorkflowID regexp 'w.*'",
+		"like on non keyword":      "HistoryLength like '1%'",
+		"missing with comparison":  "CloseTime > missing",
+	}
+
+	for name, queryString := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(queryString, definition.GetDefaultIndexedKeys())
+			if err == nil {
+				_, _, err = BuildSelectFromVisibilityByQuery(&VisibilityQueryFilter{
+					DomainID: "domain-id",
+					Query:    query,
+					PageSize: 10,
+				}, &testVisibilityQueryDialect{})
+			}
+			assert.IsType(t, &types.BadRequestError{}, err)
+		})
+	}
+}
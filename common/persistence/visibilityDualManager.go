@@ -43,6 +43,13 @@ type (
 
 var _ VisibilityManager = (*visibilityDualManager)(nil)
 
+const (
+	// VisibilityStoreDB is the basic visibility store backed by database
+	VisibilityStoreDB = "db"
+	// VisibilityStoreES is the advanced visibility store backed by ElasticSearch
+	VisibilityStoreES = "es"
+)
+
 // NewVisibilityDualManager create a visibility manager that operate on DB or ElasticSearch based on dynamic config.
 func NewVisibilityDualManager(
 	dbVisibilityManager VisibilityManager, // one of the VisibilityManager can be nil
@@ -321,3 +328,21 @@ func (v *visibilityDualManager) chooseVisibilityManagerForRead(domain string) Vi
 	}
 	return visibilityMgr
 }
+
+// GetVisibilityManagerForStore returns the visibility manager of the given store (VisibilityStoreDB or VisibilityStoreES)
+// that is wrapped by a dual visibility manager, or nil if that store is not configured.
+// It is used by components that need to operate on a single store regardless of read and write modes, e.g. visibility migration.
+func GetVisibilityManagerForStore(manager VisibilityManager, store string) VisibilityManager {
+	dualManager, ok := manager.(*visibilityDualManager)
+	if !ok {
+		return nil
+	}
+	switch store {
+	case VisibilityStoreDB:
+		return dualManager.dbVisibilityManager
+	case VisibilityStoreES:
+		return dualManager.esVisibilityManager
+	default:
+		return nil
+	}
+}
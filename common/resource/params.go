@@ -56,6 +56,8 @@ type (
 		ReplicatorConfig         config.Replicator
 		MetricsClient            metrics.Client
 		MessagingClient          messaging.Client
+		AsyncWorkflowQueueClient messaging.Client // NOTE: this can be nil. If nil, async workflow APIs are disabled
+		AsyncWorkflowQueueConfig config.AsyncWorkflowQueue
 		BlobstoreClient          blobstore.Client
 		ESClient                 es.GenericClient
 		ESConfig                 *config.ElasticSearchConfig
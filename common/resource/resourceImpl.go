@@ -83,6 +83,7 @@ type (
 		payloadSerializer       persistence.PayloadSerializer
 		metricsClient           metrics.Client
 		messagingClient         messaging.Client
+		asyncWorkflowQueue      messaging.Client
 		blobstoreClient         blobstore.Client
 		archivalMetadata        archiver.ArchivalMetadata
 		archiverProvider        provider.ArchiverProvider
@@ -259,6 +260,7 @@ func New(
 		payloadSerializer:       persistence.NewPayloadSerializer(),
 		metricsClient:           params.MetricsClient,
 		messagingClient:         params.MessagingClient,
+		asyncWorkflowQueue:      params.AsyncWorkflowQueueClient,
 		blobstoreClient:         params.BlobstoreClient,
 		archivalMetadata:        params.ArchivalMetadata,
 		archiverProvider:        params.ArchiverProvider,
@@ -406,6 +408,11 @@ func (h *Impl) GetMessagingClient() messaging.Client {
 	return h.messagingClient
 }
 
+// GetAsyncWorkflowQueueClient return the messaging client of async workflow queues
+func (h *Impl) GetAsyncWorkflowQueueClient() messaging.Client {
+	return h.asyncWorkflowQueue
+}
+
 // GetBlobstoreClient returns blobstore client
 func (h *Impl) GetBlobstoreClient() blobstore.Client {
 	return h.blobstoreClient
@@ -46,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/messaging/memory"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -69,6 +70,7 @@ type (
 		ArchivalMetadata        *archiver.MockArchivalMetadata
 		ArchiverProvider        *provider.MockArchiverProvider
 		BlobstoreClient         *blobstore.MockClient
+		AsyncWorkflowQueue      messaging.Client
 
 		// membership infos
 		MembershipResolver *membership.MockResolver
@@ -167,6 +169,7 @@ func NewTest(
 		ArchivalMetadata:        &archiver.MockArchivalMetadata{},
 		ArchiverProvider:        &provider.MockArchiverProvider{},
 		BlobstoreClient:         &blobstore.MockClient{},
+		AsyncWorkflowQueue:      memory.NewClient(),
 
 		// membership infos
 		MembershipResolver: membership.NewMockResolver(controller),
@@ -262,6 +265,11 @@ func (s *Test) GetMessagingClient() messaging.Client {
 	panic("user should implement this method for test")
 }
 
+// GetAsyncWorkflowQueueClient for testing
+func (s *Test) GetAsyncWorkflowQueueClient() messaging.Client {
+	return s.AsyncWorkflowQueue
+}
+
 // GetBlobstoreClient for testing
 func (s *Test) GetBlobstoreClient() blobstore.Client {
 	return s.BlobstoreClient
@@ -47,6 +47,9 @@ const (
 	ClientImplHeaderName = "cadence-client-name"
 	// AuthorizationTokenHeaderName refers to the jwt token in the request
 	AuthorizationTokenHeaderName = "cadence-authorization"
+	// ClientIsolationGroupHeaderName refers to the name of the
+	// header that contains the isolation group (e.g. zone) of the client
+	ClientIsolationGroupHeaderName = "cadence-client-isolation-group"
 )
 
 type (
@@ -0,0 +1,538 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// DeficitRoundRobinTaskSchedulerOptions configs DRR task scheduler
+	DeficitRoundRobinTaskSchedulerOptions struct {
+		Weights          dynamicconfig.MapPropertyFn
+		DomainQuantum    dynamicconfig.IntPropertyFnWithDomainFilter
+		DomainQueueRatio dynamicconfig.FloatPropertyFn
+		DomainFn         func(task PriorityTask) string
+		QueueSize        int
+		WorkerCount      dynamicconfig.IntPropertyFn
+		DispatcherCount  int
+		RetryPolicy      backoff.RetryPolicy
+	}
+
+	// deficitRoundRobinTaskSchedulerImpl dispatches tasks with weighted round robin across
+	// priorities like the WRR scheduler, and with deficit round robin across the domains of
+	// the tasks within a priority, so that a single hot domain can't crowd out other domains
+	// of the same priority
+	deficitRoundRobinTaskSchedulerImpl struct {
+		sync.Mutex
+
+		status        int32
+		weights       atomic.Value // store the currently used weights
+		queues        map[int]*drrPriorityQueue
+		domainDepths  map[string]int
+		domainScopes  map[string]metrics.Scope
+		admissionCond *sync.Cond // signaled when a domain frees room in a priority queue
+		shutdownCh    chan struct{}
+		notifyCh      chan struct{}
+		dispatcherWG  sync.WaitGroup
+		logger        log.Logger
+		metricsClient metrics.Client
+		metricsScope  metrics.Scope
+		options       *DeficitRoundRobinTaskSchedulerOptions
+
+		processor Processor
+	}
+
+	drrPriorityQueue struct {
+		slots    chan struct{}  // bounds the number of tasks queued for the priority
+		admitted map[string]int // bounds the number of tasks queued for the priority per domain
+		domains  map[string]*drrDomainQueue
+		active   []*drrDomainQueue // domains with queued tasks, in round robin order
+		cursor   int
+	}
+
+	drrDomainQueue struct {
+		domain  string
+		tasks   []drrTask
+		deficit int
+	}
+
+	drrTask struct {
+		task        PriorityTask
+		enqueueTime time.Time
+	}
+)
+
+const (
+	defaultDomainQuantum = 1
+)
+
+// NewDeficitRoundRobinTaskScheduler creates a new DRR task scheduler
+func NewDeficitRoundRobinTaskScheduler(
+	logger log.Logger,
+	metricsClient metrics.Client,
+	options *DeficitRoundRobinTaskSchedulerOptions,
+) (Scheduler, error) {
+	weights, err := common.ConvertDynamicConfigMapPropertyToIntMap(options.Weights())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(weights) == 0 {
+		return nil, errors.New("weight is not specified in the scheduler option")
+	}
+
+	if options.DomainFn == nil {
+		return nil, errors.New("domain function is not specified in the scheduler option")
+	}
+
+	scheduler := &deficitRoundRobinTaskSchedulerImpl{
+		status:        common.DaemonStatusInitialized,
+		queues:        make(map[int]*drrPriorityQueue),
+		domainDepths:  make(map[string]int),
+		domainScopes:  make(map[string]metrics.Scope),
+		shutdownCh:    make(chan struct{}),
+		notifyCh:      make(chan struct{}, 1),
+		logger:        logger,
+		metricsClient: metricsClient,
+		metricsScope:  metricsClient.Scope(metrics.TaskSchedulerScope),
+		options:       options,
+		processor: NewParallelTaskProcessor(
+			logger,
+			metricsClient,
+			&ParallelTaskProcessorOptions{
+				QueueSize:   wRRTaskProcessorQueueSize,
+				WorkerCount: options.WorkerCount,
+				RetryPolicy: options.RetryPolicy,
+			},
+		),
+	}
+	scheduler.admissionCond = sync.NewCond(&scheduler.Mutex)
+	scheduler.weights.Store(weights)
+
+	return scheduler, nil
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	d.processor.Start()
+
+	d.dispatcherWG.Add(d.options.DispatcherCount)
+	for i := 0; i != d.options.DispatcherCount; i++ {
+		go d.dispatcher()
+	}
+	go d.updateWeights()
+
+	d.logger.Info("Deficit round robin task scheduler started.")
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(d.shutdownCh)
+
+	d.processor.Stop()
+
+	d.drainAndNack()
+
+	if success := common.AwaitWaitGroup(&d.dispatcherWG, time.Minute); !success {
+		d.logger.Warn("Deficit round robin task scheduler timedout on shutdown.")
+	}
+
+	d.logger.Info("Deficit round robin task scheduler shutdown.")
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) Submit(task PriorityTask) error {
+	d.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
+	sw := d.metricsScope.StartTimer(metrics.PriorityTaskSubmitLatency)
+	defer sw.Stop()
+
+	if d.isStopped() {
+		return ErrTaskSchedulerClosed
+	}
+
+	queue, err := d.getOrCreatePriorityQueue(task.Priority())
+	if err != nil {
+		return err
+	}
+
+	domain := d.options.DomainFn(task)
+	if _, err := d.admit(queue, domain, true); err != nil {
+		return err
+	}
+
+	select {
+	case queue.slots <- struct{}{}:
+		if err := d.enqueue(queue, domain, task); err != nil {
+			return err
+		}
+		d.notifyDispatcher()
+		return nil
+	case <-d.shutdownCh:
+		d.cancelAdmission(queue, domain)
+		return ErrTaskSchedulerClosed
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) TrySubmit(
+	task PriorityTask,
+) (bool, error) {
+	if d.isStopped() {
+		return false, ErrTaskSchedulerClosed
+	}
+
+	queue, err := d.getOrCreatePriorityQueue(task.Priority())
+	if err != nil {
+		return false, err
+	}
+
+	domain := d.options.DomainFn(task)
+	if admitted, err := d.admit(queue, domain, false); !admitted {
+		return false, err
+	}
+
+	select {
+	case queue.slots <- struct{}{}:
+		if err := d.enqueue(queue, domain, task); err != nil {
+			return false, err
+		}
+		d.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
+		d.notifyDispatcher()
+		return true, nil
+	case <-d.shutdownCh:
+		d.cancelAdmission(queue, domain)
+		return false, ErrTaskSchedulerClosed
+	default:
+		d.cancelAdmission(queue, domain)
+		return false, nil
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) dispatcher() {
+	defer d.dispatcherWG.Done()
+
+	outstandingTasks := false
+
+	for {
+		if !outstandingTasks {
+			// if no task is dispatched in the last round,
+			// wait for a notification
+			select {
+			case <-d.notifyCh:
+				// block until there's a new task
+			case <-d.shutdownCh:
+				return
+			}
+		}
+
+		outstandingTasks = false
+		weights := d.getWeights()
+		for priority, weight := range weights {
+			for i := 0; i < weight; i++ {
+				select {
+				case <-d.shutdownCh:
+					return
+				default:
+				}
+
+				task := d.dequeue(priority)
+				if task == nil {
+					// if no task, skip to next priority
+					break
+				}
+				// dispatched at least one task in this round
+				outstandingTasks = true
+
+				if err := d.processor.Submit(task); err != nil {
+					d.logger.Error("fail to submit task to processor", tag.Error(err))
+					task.Nack()
+				}
+			}
+		}
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) getOrCreatePriorityQueue(
+	priority int,
+) (*drrPriorityQueue, error) {
+	if _, ok := d.getWeights()[priority]; !ok {
+		return nil, fmt.Errorf("unknown task priority: %v", priority)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	if queue, ok := d.queues[priority]; ok {
+		return queue, nil
+	}
+	queue := &drrPriorityQueue{
+		slots:    make(chan struct{}, d.options.QueueSize),
+		admitted: make(map[string]int),
+		domains:  make(map[string]*drrDomainQueue),
+	}
+	d.queues[priority] = queue
+	return queue, nil
+}
+
+// admit reserves room for a task of the domain in the priority queue, so that a single
+// domain can't take all the slots of the priority and block the submission of tasks of
+// other domains. It waits for room when block is true and returns false otherwise.
+func (d *deficitRoundRobinTaskSchedulerImpl) admit(
+	queue *drrPriorityQueue,
+	domain string,
+	block bool,
+) (bool, error) {
+	limit := d.domainQueueSize()
+
+	d.Lock()
+	defer d.Unlock()
+
+	for {
+		if d.isStopped() {
+			return false, ErrTaskSchedulerClosed
+		}
+		if queue.admitted[domain] < limit {
+			queue.admitted[domain]++
+			return true, nil
+		}
+		if !block {
+			return false, nil
+		}
+		d.admissionCond.Wait()
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) cancelAdmission(
+	queue *drrPriorityQueue,
+	domain string,
+) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.releaseAdmission(queue, domain)
+}
+
+// releaseAdmission frees the room reserved for a task of the domain,
+// the caller must hold the lock
+func (d *deficitRoundRobinTaskSchedulerImpl) releaseAdmission(
+	queue *drrPriorityQueue,
+	domain string,
+) {
+	if queue.admitted[domain] <= 1 {
+		delete(queue.admitted, domain)
+	} else {
+		queue.admitted[domain]--
+	}
+	d.admissionCond.Broadcast()
+}
+
+// enqueue adds the task to the queue of its domain, the caller must have been admitted
+// for the domain and acquired a slot of the priority queue
+func (d *deficitRoundRobinTaskSchedulerImpl) enqueue(
+	queue *drrPriorityQueue,
+	domain string,
+	task PriorityTask,
+) error {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.isStopped() {
+		// queued tasks have been or are about to be drained by Stop,
+		// the task is not accepted so that its owner can handle it
+		<-queue.slots
+		d.releaseAdmission(queue, domain)
+		return ErrTaskSchedulerClosed
+	}
+
+	domainQueue, ok := queue.domains[domain]
+	if !ok {
+		domainQueue = &drrDomainQueue{domain: domain}
+		queue.domains[domain] = domainQueue
+		queue.active = append(queue.active, domainQueue)
+		if len(queue.active) == 1 {
+			queue.cursor = 0
+			domainQueue.deficit = d.domainQuantum(domain)
+		}
+	}
+	domainQueue.tasks = append(domainQueue.tasks, drrTask{
+		task:        task,
+		enqueueTime: time.Now(),
+	})
+
+	d.domainDepths[domain]++
+	d.domainScope(domain).UpdateGauge(metrics.PriorityTaskDomainQueueDepth, float64(d.domainDepths[domain]))
+	return nil
+}
+
+// dequeue returns the next task of the given priority, or nil if there is no queued task.
+// Each domain is dispatched as many consecutive tasks as its quantum before moving on
+// to the next domain with queued tasks.
+func (d *deficitRoundRobinTaskSchedulerImpl) dequeue(
+	priority int,
+) PriorityTask {
+	d.Lock()
+	defer d.Unlock()
+
+	queue, ok := d.queues[priority]
+	if !ok || len(queue.active) == 0 {
+		return nil
+	}
+
+	domainQueue := queue.active[queue.cursor]
+	next := domainQueue.tasks[0]
+	domainQueue.tasks[0] = drrTask{}
+	domainQueue.tasks = domainQueue.tasks[1:]
+	domainQueue.deficit--
+	<-queue.slots
+	d.releaseAdmission(queue, domainQueue.domain)
+
+	if len(domainQueue.tasks) == 0 {
+		// the domain has no more tasks, forget it so that the domain set stays bounded
+		// by the queued tasks, the cursor now points to the next domain
+		delete(queue.domains, domainQueue.domain)
+		queue.active = append(queue.active[:queue.cursor], queue.active[queue.cursor+1:]...)
+		d.moveCursor(queue, queue.cursor)
+	} else if domainQueue.deficit <= 0 {
+		d.moveCursor(queue, queue.cursor+1)
+	}
+
+	domain := domainQueue.domain
+	d.domainDepths[domain]--
+	scope := d.domainScope(domain)
+	scope.UpdateGauge(metrics.PriorityTaskDomainQueueDepth, float64(d.domainDepths[domain]))
+	scope.RecordTimer(metrics.PriorityTaskDomainQueueLatency, time.Since(next.enqueueTime))
+	if d.domainDepths[domain] == 0 {
+		delete(d.domainDepths, domain)
+	}
+	return next.task
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) moveCursor(
+	queue *drrPriorityQueue,
+	cursor int,
+) {
+	if len(queue.active) == 0 {
+		queue.cursor = 0
+		return
+	}
+	queue.cursor = cursor % len(queue.active)
+	domainQueue := queue.active[queue.cursor]
+	domainQueue.deficit += d.domainQuantum(domainQueue.domain)
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) domainQuantum(domain string) int {
+	if d.options.DomainQuantum == nil {
+		return defaultDomainQuantum
+	}
+	return common.MaxInt(defaultDomainQuantum, d.options.DomainQuantum(domain))
+}
+
+// domainQueueSize returns the number of tasks of a single domain a priority queue can hold
+func (d *deficitRoundRobinTaskSchedulerImpl) domainQueueSize() int {
+	if d.options.DomainQueueRatio == nil {
+		return d.options.QueueSize
+	}
+	ratio := d.options.DomainQueueRatio()
+	if ratio <= 0 || ratio >= 1 {
+		return d.options.QueueSize
+	}
+	return common.MaxInt(1, int(ratio*float64(d.options.QueueSize)))
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) domainScope(domain string) metrics.Scope {
+	scope, ok := d.domainScopes[domain]
+	if !ok {
+		scope = d.metricsClient.Scope(metrics.TaskSchedulerScope, metrics.DomainTag(domain))
+		d.domainScopes[domain] = scope
+	}
+	return scope
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) drainAndNack() {
+	d.Lock()
+	defer d.Unlock()
+
+	for _, queue := range d.queues {
+		for _, domainQueue := range queue.active {
+			for _, queued := range domainQueue.tasks {
+				<-queue.slots
+				queued.task.Nack()
+			}
+		}
+		queue.admitted = make(map[string]int)
+		queue.domains = make(map[string]*drrDomainQueue)
+		queue.active = nil
+		queue.cursor = 0
+	}
+	d.domainDepths = make(map[string]int)
+	// wake up the submitters waiting for room so that they observe the shutdown
+	d.admissionCond.Broadcast()
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) notifyDispatcher() {
+	select {
+	case d.notifyCh <- struct{}{}:
+		// sent a notification to the dispatcher
+	default:
+		// do not block if there's already a notification
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) getWeights() map[int]int {
+	return d.weights.Load().(map[int]int)
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) updateWeights() {
+	ticker := time.NewTicker(defaultUpdateWeightsInterval)
+	for {
+		select {
+		case <-ticker.C:
+			weights, err := common.ConvertDynamicConfigMapPropertyToIntMap(d.options.Weights())
+			if err != nil {
+				d.logger.Error("failed to update weight for deficit round robin task scheduler", tag.Error(err))
+			} else {
+				d.weights.Store(weights)
+			}
+		case <-d.shutdownCh:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) isStopped() bool {
+	return atomic.LoadInt32(&d.status) == common.DaemonStatusStopped
+}
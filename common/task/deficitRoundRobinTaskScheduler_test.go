@@ -0,0 +1,457 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	deficitRoundRobinTaskSchedulerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller    *gomock.Controller
+		mockProcessor *MockProcessor
+
+		queueSize   int
+		taskDomains sync.Map
+
+		scheduler *deficitRoundRobinTaskSchedulerImpl
+	}
+)
+
+func TestDeficitRoundRobinTaskSchedulerSuite(t *testing.T) {
+	s := new(deficitRoundRobinTaskSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockProcessor = NewMockProcessor(s.controller)
+
+	s.queueSize = 1000
+	s.taskDomains = sync.Map{}
+	s.scheduler = s.newTestDeficitRoundRobinTaskScheduler(
+		&DeficitRoundRobinTaskSchedulerOptions{
+			Weights:         testSchedulerWeights,
+			DomainQuantum:   dynamicconfig.GetIntPropertyFilteredByDomain(1),
+			DomainFn:        s.taskDomain,
+			QueueSize:       s.queueSize,
+			WorkerCount:     dynamicconfig.GetIntPropertyFn(1),
+			DispatcherCount: 3,
+			RetryPolicy:     backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestNewScheduler_NoDomainFn() {
+	_, err := NewDeficitRoundRobinTaskScheduler(
+		loggerimpl.NewLoggerForTest(s.Suite),
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		&DeficitRoundRobinTaskSchedulerOptions{
+			Weights:         testSchedulerWeights,
+			QueueSize:       s.queueSize,
+			WorkerCount:     dynamicconfig.GetIntPropertyFn(1),
+			DispatcherCount: 1,
+			RetryPolicy:     backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+	s.Error(err)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestSubmit_Success() {
+	taskPriority := 1
+	mockTask := s.newMockPriorityTask(taskPriority, "some random domain")
+
+	err := s.scheduler.Submit(mockTask)
+	s.NoError(err)
+
+	s.Equal(1, s.scheduler.domainDepths["some random domain"])
+	s.Equal(mockTask, s.scheduler.dequeue(taskPriority))
+	s.Empty(s.scheduler.domainDepths)
+	for _, queue := range s.scheduler.queues {
+		s.Empty(queue.slots)
+		s.Empty(queue.domains)
+		s.Empty(queue.active)
+	}
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestSubmit_Fail_SchedulerShutDown() {
+	mockTask := NewMockPriorityTask(s.controller)
+	s.scheduler.Start()
+	s.scheduler.Stop()
+	err := s.scheduler.Submit(mockTask)
+	s.Equal(ErrTaskSchedulerClosed, err)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestSubmit_Fail_UnknownPriority() {
+	taskPriority := 5 // make sure the number is not in testSchedulerWeights
+	mockTask := NewMockPriorityTask(s.controller)
+	mockTask.EXPECT().Priority().Return(taskPriority)
+	err := s.scheduler.Submit(mockTask)
+	s.Error(err)
+	s.NotEqual(ErrTaskSchedulerClosed, err)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestTrySubmit() {
+	taskPriority := 1
+	for i := 0; i != s.queueSize; i++ {
+		mockTask := s.newMockPriorityTask(taskPriority, fmt.Sprintf("domain-%v", i%3))
+		submitted, err := s.scheduler.TrySubmit(mockTask)
+		s.NoError(err)
+		s.True(submitted)
+	}
+
+	// now the queue is full, submit one more task, should be non-blocking
+	mockTask := s.newMockPriorityTask(taskPriority, "domain-0")
+	submitted, err := s.scheduler.TrySubmit(mockTask)
+	s.NoError(err)
+	s.False(submitted)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestTrySubmit_DomainQueueFull() {
+	scheduler := s.newTestDeficitRoundRobinTaskScheduler(
+		&DeficitRoundRobinTaskSchedulerOptions{
+			Weights:          testSchedulerWeights,
+			DomainQuantum:    dynamicconfig.GetIntPropertyFilteredByDomain(1),
+			DomainQueueRatio: dynamicconfig.GetFloatPropertyFn(0.5),
+			DomainFn:         s.taskDomain,
+			QueueSize:        10,
+			WorkerCount:      dynamicconfig.GetIntPropertyFn(1),
+			DispatcherCount:  1,
+			RetryPolicy:      backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+
+	taskPriority := 1
+	for i := 0; i != 5; i++ {
+		submitted, err := scheduler.TrySubmit(s.newMockPriorityTask(taskPriority, "hot"))
+		s.NoError(err)
+		s.True(submitted)
+	}
+
+	// the hot domain has taken its share of the queue, other domains can still submit
+	submitted, err := scheduler.TrySubmit(s.newMockPriorityTask(taskPriority, "hot"))
+	s.NoError(err)
+	s.False(submitted)
+	submitted, err = scheduler.TrySubmit(s.newMockPriorityTask(taskPriority, "other"))
+	s.NoError(err)
+	s.True(submitted)
+	s.Equal(map[string]int{"hot": 5, "other": 1}, scheduler.queues[taskPriority].admitted)
+
+	for scheduler.dequeue(taskPriority) != nil {
+	}
+	s.Empty(scheduler.queues[taskPriority].admitted)
+	s.Empty(scheduler.queues[taskPriority].slots)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestSubmit_WaitForDomainQueue() {
+	scheduler := s.newTestDeficitRoundRobinTaskScheduler(
+		&DeficitRoundRobinTaskSchedulerOptions{
+			Weights:          testSchedulerWeights,
+			DomainQuantum:    dynamicconfig.GetIntPropertyFilteredByDomain(1),
+			DomainQueueRatio: dynamicconfig.GetFloatPropertyFn(0.5),
+			DomainFn:         s.taskDomain,
+			QueueSize:        4,
+			WorkerCount:      dynamicconfig.GetIntPropertyFn(1),
+			DispatcherCount:  1,
+			RetryPolicy:      backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+	s.mockProcessor.EXPECT().Stop()
+	scheduler.processor = s.mockProcessor
+	// started without dispatchers so that tasks stay queued
+	scheduler.status = common.DaemonStatusStarted
+
+	taskPriority := 0
+	hotTasks := []*MockPriorityTask{
+		s.newMockPriorityTask(taskPriority, "hot"),
+		s.newMockPriorityTask(taskPriority, "hot"),
+		s.newMockPriorityTask(taskPriority, "hot"),
+	}
+	s.NoError(scheduler.Submit(hotTasks[0]))
+	s.NoError(scheduler.Submit(hotTasks[1]))
+
+	submitCh := make(chan error, 1)
+	go func() {
+		submitCh <- scheduler.Submit(hotTasks[2])
+	}()
+
+	// the third hot task waits for room while tasks of other domains are still admitted
+	otherTask := s.newMockPriorityTask(taskPriority, "other")
+	s.NoError(scheduler.Submit(otherTask))
+	select {
+	case err := <-submitCh:
+		s.FailNow("submit should be waiting for room in the domain queue", "err: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	s.Equal(hotTasks[0], scheduler.dequeue(taskPriority))
+	s.NoError(<-submitCh)
+	s.Equal(2, scheduler.queues[taskPriority].admitted["hot"])
+
+	// a submitter waiting for room observes the shutdown
+	go func() {
+		submitCh <- scheduler.Submit(s.newMockPriorityTask(taskPriority, "hot"))
+	}()
+	hotTasks[1].EXPECT().Nack()
+	hotTasks[2].EXPECT().Nack()
+	otherTask.EXPECT().Nack()
+	time.Sleep(10 * time.Millisecond)
+	scheduler.Stop()
+	s.Equal(ErrTaskSchedulerClosed, <-submitCh)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestDequeue_DeficitRoundRobinAcrossDomains() {
+	scheduler := s.newTestDeficitRoundRobinTaskScheduler(
+		&DeficitRoundRobinTaskSchedulerOptions{
+			Weights: testSchedulerWeights,
+			DomainQuantum: func(domain string) int {
+				if domain == "important" {
+					return 3
+				}
+				return 0 // dispatched as if the quantum was 1
+			},
+			DomainFn:        s.taskDomain,
+			QueueSize:       s.queueSize,
+			WorkerCount:     dynamicconfig.GetIntPropertyFn(1),
+			DispatcherCount: 1,
+			RetryPolicy:     backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+
+	taskPriority := 0
+	var tasks []PriorityTask
+	for i := 0; i != 6; i++ {
+		tasks = append(tasks, s.newMockPriorityTask(taskPriority, "hot"))
+	}
+	for i := 0; i != 4; i++ {
+		tasks = append(tasks, s.newMockPriorityTask(taskPriority, "important"))
+	}
+	tasks = append(tasks, s.newMockPriorityTask(taskPriority, "other"))
+	for _, task := range tasks {
+		s.NoError(scheduler.Submit(task))
+	}
+	s.Equal(map[string]int{"hot": 6, "important": 4, "other": 1}, scheduler.domainDepths)
+
+	var dispatched []PriorityTask
+	for task := scheduler.dequeue(taskPriority); task != nil; task = scheduler.dequeue(taskPriority) {
+		dispatched = append(dispatched, task)
+	}
+	expected := []PriorityTask{
+		tasks[0], tasks[6], tasks[7], tasks[8], tasks[10],
+		tasks[1], tasks[9], tasks[2], tasks[3], tasks[4], tasks[5],
+	}
+	s.Equal(expected, dispatched)
+	s.Empty(scheduler.domainDepths)
+	s.Empty(scheduler.queues[taskPriority].domains)
+	s.Empty(scheduler.queues[taskPriority].slots)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestDispatcher_SubmitWithNoError() {
+	numTasks := 30
+	var taskWG sync.WaitGroup
+	mockFn := func(_ Task) error {
+		taskWG.Done()
+		return nil
+	}
+
+	for i := 0; i != numTasks; i++ {
+		mockTask := s.newMockPriorityTask(rand.Intn(len(testSchedulerWeights())), fmt.Sprintf("domain-%v", i%4))
+		s.NoError(s.scheduler.Submit(mockTask))
+		taskWG.Add(1)
+		s.mockProcessor.EXPECT().Submit(newMockPriorityTaskMatcher(mockTask)).DoAndReturn(mockFn)
+	}
+
+	s.scheduler.processor = s.mockProcessor
+
+	doneCh := make(chan struct{})
+	s.scheduler.dispatcherWG.Add(1)
+	go func() {
+		s.scheduler.dispatcher()
+		close(doneCh)
+	}()
+
+	taskWG.Wait()
+	close(s.scheduler.shutdownCh)
+
+	<-doneCh
+	s.Empty(s.scheduler.domainDepths)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestDispatcher_FailToSubmit() {
+	mockTask := s.newMockPriorityTask(0, "some random domain")
+	mockTask.EXPECT().Nack()
+
+	var taskWG sync.WaitGroup
+	s.NoError(s.scheduler.Submit(mockTask))
+	taskWG.Add(1)
+
+	mockFn := func(_ Task) error {
+		taskWG.Done()
+		return errors.New("some random error")
+	}
+	s.mockProcessor.EXPECT().Submit(newMockPriorityTaskMatcher(mockTask)).DoAndReturn(mockFn)
+	s.scheduler.processor = s.mockProcessor
+
+	doneCh := make(chan struct{})
+	s.scheduler.dispatcherWG.Add(1)
+	go func() {
+		s.scheduler.dispatcher()
+		close(doneCh)
+	}()
+
+	taskWG.Wait()
+	close(s.scheduler.shutdownCh)
+
+	<-doneCh
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestStop_NackQueuedTasks() {
+	s.mockProcessor.EXPECT().Stop()
+	s.scheduler.processor = s.mockProcessor
+	// started without dispatchers so that tasks stay queued
+	s.scheduler.status = common.DaemonStatusStarted
+
+	for i := 0; i != 10; i++ {
+		mockTask := s.newMockPriorityTask(i%3, fmt.Sprintf("domain-%v", i%2))
+		mockTask.EXPECT().Nack()
+		s.NoError(s.scheduler.Submit(mockTask))
+	}
+
+	s.scheduler.Stop()
+	s.Empty(s.scheduler.domainDepths)
+	for _, queue := range s.scheduler.queues {
+		s.Empty(queue.slots)
+		s.Empty(queue.active)
+	}
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestDRR() {
+	numTasks := 1000
+	var taskWG sync.WaitGroup
+
+	s.mockProcessor.EXPECT().Start()
+	s.mockProcessor.EXPECT().Stop()
+
+	tasks := []PriorityTask{}
+	mockFn := func(_ Task) error {
+		taskWG.Done()
+		return nil
+	}
+	for i := 0; i != numTasks; i++ {
+		mockTask := s.newMockPriorityTask(rand.Intn(len(testSchedulerWeights())), fmt.Sprintf("domain-%v", rand.Intn(5)))
+		tasks = append(tasks, mockTask)
+		taskWG.Add(1)
+		s.mockProcessor.EXPECT().Submit(newMockPriorityTaskMatcher(mockTask)).DoAndReturn(mockFn)
+	}
+
+	s.scheduler.processor = s.mockProcessor
+	s.scheduler.Start()
+	for _, task := range tasks {
+		if rand.Intn(2) == 0 {
+			s.NoError(s.scheduler.Submit(task))
+		} else {
+			submitted, err := s.scheduler.TrySubmit(task)
+			s.NoError(err)
+			s.True(submitted)
+		}
+	}
+	taskWG.Wait()
+	s.scheduler.Stop()
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestSchedulerContract() {
+	s.scheduler.options.DomainFn = func(_ PriorityTask) string {
+		return fmt.Sprintf("domain-%v", rand.Intn(5))
+	}
+	testSchedulerContract(s.Assertions, s.controller, s.scheduler)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) newMockPriorityTask(
+	priority int,
+	domain string,
+) *MockPriorityTask {
+	mockTask := NewMockPriorityTask(s.controller)
+	mockTask.EXPECT().Priority().Return(priority).AnyTimes()
+	s.taskDomains.Store(mockTask, domain)
+	return mockTask
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) taskDomain(task PriorityTask) string {
+	domain, _ := s.taskDomains.Load(task)
+	return domain.(string)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) newTestDeficitRoundRobinTaskScheduler(
+	options *DeficitRoundRobinTaskSchedulerOptions,
+) *deficitRoundRobinTaskSchedulerImpl {
+	scheduler, err := NewDeficitRoundRobinTaskScheduler(
+		loggerimpl.NewLoggerForTest(s.Suite),
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		options,
+	)
+	s.NoError(err)
+	return scheduler.(*deficitRoundRobinTaskSchedulerImpl)
+}
+
+func BenchmarkDeficitRoundRobinTaskScheduler(b *testing.B) {
+	scheduler, err := NewDeficitRoundRobinTaskScheduler(
+		loggerimpl.NewNopLogger(),
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		&DeficitRoundRobinTaskSchedulerOptions{
+			Weights:       testSchedulerWeights,
+			DomainQuantum: dynamicconfig.GetIntPropertyFilteredByDomain(1),
+			DomainFn: func(task PriorityTask) string {
+				return task.(*benchmarkPriorityTask).domain
+			},
+			QueueSize:       1000,
+			WorkerCount:     dynamicconfig.GetIntPropertyFn(10),
+			DispatcherCount: 1,
+			RetryPolicy:     backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+	require.NoError(b, err)
+	benchmarkScheduler(b, scheduler)
+}
@@ -98,6 +98,9 @@ const (
 	SchedulerTypeFIFO SchedulerType = iota + 1
 	// SchedulerTypeWRR is the scheduler type for weighted round robin scheduler implementation
 	SchedulerTypeWRR
+	// SchedulerTypeDRR is the scheduler type for deficit round robin scheduler implementation,
+	// which also round robins across the domains of the tasks within a priority
+	SchedulerTypeDRR
 )
 
 const (
@@ -56,6 +56,12 @@ type (
 	mockPriorityTaskMatcher struct {
 		task *MockPriorityTask
 	}
+
+	benchmarkPriorityTask struct {
+		priority int
+		domain   string
+		wg       *sync.WaitGroup
+	}
 )
 
 var (
@@ -361,6 +367,8 @@ func testSchedulerContract(
 		<-schedulerImpl.shutdownCh
 	case *weightedRoundRobinTaskSchedulerImpl:
 		<-schedulerImpl.shutdownCh
+	case *deficitRoundRobinTaskSchedulerImpl:
+		<-schedulerImpl.shutdownCh
 	default:
 		s.Fail("unknown task scheduler type")
 	}
@@ -370,6 +378,81 @@ func testSchedulerContract(
 	}
 }
 
+func BenchmarkWeightedRoundRobinTaskScheduler(b *testing.B) {
+	scheduler, err := NewWeightedRoundRobinTaskScheduler(
+		loggerimpl.NewNopLogger(),
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		&WeightedRoundRobinTaskSchedulerOptions{
+			Weights:         testSchedulerWeights,
+			QueueSize:       1000,
+			WorkerCount:     dynamicconfig.GetIntPropertyFn(10),
+			DispatcherCount: 1,
+			RetryPolicy:     backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+	require.NoError(b, err)
+	benchmarkScheduler(b, scheduler)
+}
+
+func benchmarkScheduler(b *testing.B, scheduler Scheduler) {
+	numPriorities := len(testSchedulerWeights())
+	numDomains := 10
+
+	var taskWG sync.WaitGroup
+	tasks := make([]PriorityTask, b.N)
+	for i := range tasks {
+		tasks[i] = &benchmarkPriorityTask{
+			priority: i % numPriorities,
+			domain:   fmt.Sprintf("domain-%v", i%numDomains),
+			wg:       &taskWG,
+		}
+	}
+
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	b.ResetTimer()
+	taskWG.Add(b.N)
+	for _, task := range tasks {
+		if err := scheduler.Submit(task); err != nil {
+			b.Fatal(err)
+		}
+	}
+	taskWG.Wait()
+}
+
+func (t *benchmarkPriorityTask) Execute() error {
+	return nil
+}
+
+func (t *benchmarkPriorityTask) HandleErr(err error) error {
+	return err
+}
+
+func (t *benchmarkPriorityTask) RetryErr(err error) bool {
+	return false
+}
+
+func (t *benchmarkPriorityTask) Ack() {
+	t.wg.Done()
+}
+
+func (t *benchmarkPriorityTask) Nack() {
+	t.wg.Done()
+}
+
+func (t *benchmarkPriorityTask) State() State {
+	return TaskStatePending
+}
+
+func (t *benchmarkPriorityTask) Priority() int {
+	return t.priority
+}
+
+func (t *benchmarkPriorityTask) SetPriority(priority int) {
+	t.priority = priority
+}
+
 func newMockPriorityTaskMatcher(mockTask *MockPriorityTask) gomock.Matcher {
 	return &mockPriorityTaskMatcher{
 		task: mockTask,
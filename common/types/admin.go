@@ -20,6 +20,12 @@
 
 package types
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // AddSearchAttributeRequest is an internal type (TBD...)
 type AddSearchAttributeRequest struct {
 	SearchAttribute map[string]IndexedValueType `json:"searchAttribute,omitempty"`
@@ -301,3 +307,216 @@ type ListDynamicConfigRequest struct {
 type ListDynamicConfigResponse struct {
 	Entries []*DynamicConfigEntry `json:"entries,omitempty"`
 }
+
+// ListWorkflowTasksRequest is an internal type (TBD...)
+type ListWorkflowTasksRequest struct {
+	Domain    string             `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListWorkflowTasksRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *ListWorkflowTasksRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// ListWorkflowTasksResponse is an internal type (TBD...)
+type ListWorkflowTasksResponse struct {
+	ShardID   int32            `json:"shardID,omitempty"`
+	Tasks     []*QueueTaskInfo `json:"tasks,omitempty"`
+	Truncated bool             `json:"truncated,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *ListWorkflowTasksResponse) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetTasks is an internal getter (TBD...)
+func (v *ListWorkflowTasksResponse) GetTasks() (o []*QueueTaskInfo) {
+	if v != nil && v.Tasks != nil {
+		return v.Tasks
+	}
+	return
+}
+
+// GetTruncated is an internal getter (TBD...)
+func (v *ListWorkflowTasksResponse) GetTruncated() (o bool) {
+	if v != nil {
+		return v.Truncated
+	}
+	return
+}
+
+// QueueTaskInfo is an internal type (TBD...)
+type QueueTaskInfo struct {
+	Type                *int32          `json:"type,omitempty"`
+	TaskType            int32           `json:"taskType,omitempty"`
+	TaskID              int64           `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64          `json:"visibilityTimestamp,omitempty"`
+	ClusterName         string          `json:"clusterName,omitempty"`
+	State               *QueueTaskState `json:"state,omitempty"`
+	Loaded              bool            `json:"loaded,omitempty"`
+	Attempt             int32           `json:"attempt,omitempty"`
+	RedispatchTimestamp *int64          `json:"redispatchTimestamp,omitempty"`
+}
+
+// GetType is an internal getter (TBD...)
+func (v *QueueTaskInfo) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+	return
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *QueueTaskInfo) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetVisibilityTimestamp is an internal getter (TBD...)
+func (v *QueueTaskInfo) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *QueueTaskInfo) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *QueueTaskInfo) GetState() (o QueueTaskState) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+	return
+}
+
+// QueueTaskState is an internal type (TBD...)
+type QueueTaskState int32
+
+// Ptr is a helper function for getting pointer value
+func (e QueueTaskState) Ptr() *QueueTaskState {
+	return &e
+}
+
+// String returns a readable string representation of QueueTaskState.
+func (e QueueTaskState) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "PENDING"
+	case 1:
+		return "ACKED"
+	case 2:
+		return "BACKOFF"
+	}
+	return fmt.Sprintf("QueueTaskState(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *QueueTaskState) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "PENDING":
+		*e = QueueTaskStatePending
+		return nil
+	case "ACKED":
+		*e = QueueTaskStateAcked
+		return nil
+	case "BACKOFF":
+		*e = QueueTaskStateBackoff
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueueTaskState", err)
+		}
+		*e = QueueTaskState(val)
+		return nil
+	}
+}
+
+// MarshalText encodes QueueTaskState to text.
+func (e QueueTaskState) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// QueueTaskStatePending is an option for QueueTaskState
+	QueueTaskStatePending QueueTaskState = iota
+	// QueueTaskStateAcked is an option for QueueTaskState
+	QueueTaskStateAcked
+	// QueueTaskStateBackoff is an option for QueueTaskState
+	QueueTaskStateBackoff
+)
+
+// RetryTaskRequest is an internal type (TBD...)
+type RetryTaskRequest struct {
+	ShardID             int32  `json:"shardID,omitempty"`
+	Type                *int32 `json:"type,omitempty"`
+	TaskID              int64  `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64 `json:"visibilityTimestamp,omitempty"`
+	ClusterName         string `json:"clusterName,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *RetryTaskRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetType is an internal getter (TBD...)
+func (v *RetryTaskRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+	return
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *RetryTaskRequest) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetVisibilityTimestamp is an internal getter (TBD...)
+func (v *RetryTaskRequest) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *RetryTaskRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
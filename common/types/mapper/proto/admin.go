@@ -228,6 +228,130 @@ func ToAdminDescribeQueueResponse(t *adminv1.DescribeQueueResponse) *types.Descr
 	}
 }
 
+func FromAdminListWorkflowTasksRequest(t *types.ListWorkflowTasksRequest) *adminv1.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListWorkflowTasksRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+func ToAdminListWorkflowTasksRequest(t *adminv1.ListWorkflowTasksRequest) *types.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksRequest{
+		Domain:    t.Domain,
+		Execution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func FromAdminListWorkflowTasksResponse(t *types.ListWorkflowTasksResponse) *adminv1.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListWorkflowTasksResponse{
+		ShardId:   t.ShardID,
+		Tasks:     FromQueueTaskArray(t.Tasks),
+		Truncated: t.Truncated,
+	}
+}
+
+func ToAdminListWorkflowTasksResponse(t *adminv1.ListWorkflowTasksResponse) *types.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksResponse{
+		ShardID:   t.ShardId,
+		Tasks:     ToQueueTaskArray(t.Tasks),
+		Truncated: t.Truncated,
+	}
+}
+
+func FromAdminRetryTaskRequest(t *types.RetryTaskRequest) *adminv1.RetryTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RetryTaskRequest{
+		ShardId:        t.ShardID,
+		TaskType:       FromTaskType(t.Type),
+		TaskId:         t.TaskID,
+		VisibilityTime: unixNanoToTime(t.VisibilityTimestamp),
+		ClusterName:    t.ClusterName,
+	}
+}
+
+func ToAdminRetryTaskRequest(t *adminv1.RetryTaskRequest) *types.RetryTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryTaskRequest{
+		ShardID:             t.ShardId,
+		Type:                ToTaskType(t.TaskType),
+		TaskID:              t.TaskId,
+		VisibilityTimestamp: timeToUnixNano(t.VisibilityTime),
+		ClusterName:         t.ClusterName,
+	}
+}
+
+func FromQueueTaskArray(t []*types.QueueTaskInfo) []*adminv1.QueueTask {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.QueueTask, len(t))
+	for i := range t {
+		v[i] = FromQueueTask(t[i])
+	}
+	return v
+}
+
+func ToQueueTaskArray(t []*adminv1.QueueTask) []*types.QueueTaskInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.QueueTaskInfo, len(t))
+	for i := range t {
+		v[i] = ToQueueTask(t[i])
+	}
+	return v
+}
+
+func FromQueueTask(t *types.QueueTaskInfo) *adminv1.QueueTask {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.QueueTask{
+		TaskType:       FromTaskType(t.Type),
+		Type:           t.TaskType,
+		TaskId:         t.TaskID,
+		VisibilityTime: unixNanoToTime(t.VisibilityTimestamp),
+		ClusterName:    t.ClusterName,
+		State:          FromQueueTaskState(t.State),
+		Loaded:         t.Loaded,
+		Attempt:        t.Attempt,
+		RedispatchTime: unixNanoToTime(t.RedispatchTimestamp),
+	}
+}
+
+func ToQueueTask(t *adminv1.QueueTask) *types.QueueTaskInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.QueueTaskInfo{
+		Type:                ToTaskType(t.TaskType),
+		TaskType:            t.Type,
+		TaskID:              t.TaskId,
+		VisibilityTimestamp: timeToUnixNano(t.VisibilityTime),
+		ClusterName:         t.ClusterName,
+		State:               ToQueueTaskState(t.State),
+		Loaded:              t.Loaded,
+		Attempt:             t.Attempt,
+		RedispatchTimestamp: timeToUnixNano(t.RedispatchTime),
+	}
+}
+
 func FromAdminDescribeWorkflowExecutionRequest(t *types.AdminDescribeWorkflowExecutionRequest) *adminv1.DescribeWorkflowExecutionRequest {
 	if t == nil {
 		return nil
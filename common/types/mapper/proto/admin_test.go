@@ -72,6 +72,21 @@ func TestAdminDescribeQueueResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminDescribeQueueResponse(FromAdminDescribeQueueResponse(item)))
 	}
 }
+func TestAdminListWorkflowTasksRequest(t *testing.T) {
+	for _, item := range []*types.ListWorkflowTasksRequest{nil, {}, &testdata.AdminListWorkflowTasksRequest} {
+		assert.Equal(t, item, ToAdminListWorkflowTasksRequest(FromAdminListWorkflowTasksRequest(item)))
+	}
+}
+func TestAdminListWorkflowTasksResponse(t *testing.T) {
+	for _, item := range []*types.ListWorkflowTasksResponse{nil, {}, &testdata.AdminListWorkflowTasksResponse} {
+		assert.Equal(t, item, ToAdminListWorkflowTasksResponse(FromAdminListWorkflowTasksResponse(item)))
+	}
+}
+func TestAdminRetryTaskRequest(t *testing.T) {
+	for _, item := range []*types.RetryTaskRequest{nil, {}, &testdata.AdminRetryTaskRequest} {
+		assert.Equal(t, item, ToAdminRetryTaskRequest(FromAdminRetryTaskRequest(item)))
+	}
+}
 func TestAdminDescribeWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.AdminDescribeWorkflowExecutionRequest{nil, {}, &testdata.AdminDescribeWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToAdminDescribeWorkflowExecutionRequest(FromAdminDescribeWorkflowExecutionRequest(item)))
@@ -164,6 +164,7 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
 		RetryPolicy:                  FromRetryPolicy(t.RetryPolicy),
 		Header:                       FromHeader(t.Header),
+		Priority:                     t.Priority,
 	}
 }
 
@@ -184,6 +185,7 @@ func ToActivityTaskScheduledEventAttributes(t *apiv1.ActivityTaskScheduledEventA
 		DecisionTaskCompletedEventID:  t.DecisionTaskCompletedEventId,
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		Priority:                      t.Priority,
 	}
 }
 
@@ -3194,6 +3196,7 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
 		Header:                 FromHeader(t.Header),
 		RequestLocalDispatch:   t.RequestLocalDispatch,
+		Priority:               t.Priority,
 	}
 }
 
@@ -3214,6 +3217,7 @@ func ToScheduleActivityTaskDecisionAttributes(t *apiv1.ScheduleActivityTaskDecis
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.RequestLocalDispatch,
+		Priority:                      t.Priority,
 	}
 }
 
@@ -3365,6 +3369,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 			Header:                       FromHeader(t.Header),
 			DelayStart:                   secondsToDuration(t.DelayStartSeconds),
 			JitterStart:                  secondsToDuration(t.JitterStartSeconds),
+			WorkflowIdConflictPolicy:     FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 		},
 		SignalName:  t.SignalName,
 		SignalInput: FromPayload(t.SignalInput),
@@ -3397,6 +3402,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *apiv1.SignalWithStartWorkflowE
 		Header:                              ToHeader(t.StartRequest.Header),
 		DelayStartSeconds:                   durationToSeconds(t.StartRequest.DelayStart),
 		JitterStartSeconds:                  durationToSeconds(t.StartRequest.JitterStart),
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.StartRequest.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -3418,6 +3424,56 @@ func ToSignalWithStartWorkflowExecutionResponse(t *apiv1.SignalWithStartWorkflow
 	}
 }
 
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *apiv1.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateId:          t.UpdateID,
+		UpdateName:        t.UpdateName,
+		Input:             FromPayload(t.Input),
+		Identity:          t.Identity,
+		RequestId:         t.RequestID,
+	}
+}
+
+func ToUpdateWorkflowExecutionRequest(t *apiv1.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		UpdateID:          t.UpdateId,
+		UpdateName:        t.UpdateName,
+		Input:             ToPayload(t.Input),
+		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *apiv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkflowExecutionResponse{
+		Result:  FromPayload(t.Result),
+		Failure: FromFailure(t.FailureMessage, nil),
+	}
+}
+
+func ToUpdateWorkflowExecutionResponse(t *apiv1.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result:         ToPayload(t.Result),
+		FailureMessage: ToFailureReason(t.Failure),
+	}
+}
+
 func FromSignalWorkflowExecutionRequest(t *types.SignalWorkflowExecutionRequest) *apiv1.SignalWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -3658,6 +3714,8 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		Header:                       FromHeader(t.Header),
 		DelayStart:                   secondsToDuration(t.DelayStartSeconds),
 		JitterStart:                  secondsToDuration(t.JitterStartSeconds),
+		WorkflowIdConflictPolicy:     FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
+		Priority:                     t.Priority,
 	}
 }
 
@@ -3683,6 +3741,8 @@ func ToStartWorkflowExecutionRequest(t *apiv1.StartWorkflowExecutionRequest) *ty
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   durationToSeconds(t.DelayStart),
 		JitterStartSeconds:                  durationToSeconds(t.JitterStart),
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
+		Priority:                            t.Priority,
 	}
 }
 
@@ -3868,11 +3928,12 @@ func FromTaskListStatus(t *types.TaskListStatus) *apiv1.TaskListStatus {
 		return nil
 	}
 	return &apiv1.TaskListStatus{
-		BacklogCountHint: t.BacklogCountHint,
-		ReadLevel:        t.ReadLevel,
-		AckLevel:         t.AckLevel,
-		RatePerSecond:    t.RatePerSecond,
-		TaskIdBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:           t.BacklogCountHint,
+		ReadLevel:                  t.ReadLevel,
+		AckLevel:                   t.AckLevel,
+		RatePerSecond:              t.RatePerSecond,
+		TaskIdBlock:                FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHintByPriority: t.BacklogCountHintByPriority,
 	}
 }
 
@@ -3881,11 +3942,12 @@ func ToTaskListStatus(t *apiv1.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint: t.BacklogCountHint,
-		ReadLevel:        t.ReadLevel,
-		AckLevel:         t.AckLevel,
-		RatePerSecond:    t.RatePerSecond,
-		TaskIDBlock:      ToTaskIDBlock(t.TaskIdBlock),
+		BacklogCountHint:           t.BacklogCountHint,
+		ReadLevel:                  t.ReadLevel,
+		AckLevel:                   t.AckLevel,
+		RatePerSecond:              t.RatePerSecond,
+		TaskIDBlock:                ToTaskIDBlock(t.TaskIdBlock),
+		BacklogCountHintByPriority: t.BacklogCountHintByPriority,
 	}
 }
 
@@ -4306,6 +4368,98 @@ func ToUpsertWorkflowSearchAttributesEventAttributes(t *apiv1.UpsertWorkflowSear
 	}
 }
 
+func FromWorkflowExecutionUpdateRequestedEventAttributes(t *types.WorkflowExecutionUpdateRequestedEventAttributes) *apiv1.WorkflowExecutionUpdateRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateId:   t.UpdateID,
+		UpdateName: t.UpdateName,
+		Input:      FromPayload(t.Input),
+		Identity:   t.Identity,
+		RequestId:  t.RequestID,
+	}
+}
+
+func ToWorkflowExecutionUpdateRequestedEventAttributes(t *apiv1.WorkflowExecutionUpdateRequestedEventAttributes) *types.WorkflowExecutionUpdateRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   t.UpdateId,
+		UpdateName: t.UpdateName,
+		Input:      ToPayload(t.Input),
+		Identity:   t.Identity,
+		RequestID:  t.RequestId,
+	}
+}
+
+func FromWorkflowExecutionUpdateCompletedEventAttributes(t *types.WorkflowExecutionUpdateCompletedEventAttributes) *apiv1.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateId:                     t.UpdateID,
+		RequestedEventId:             t.RequestedEventID,
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+		Result:                       FromPayload(t.Result),
+		Failure:                      FromFailure(t.FailureMessage, nil),
+	}
+}
+
+func ToWorkflowExecutionUpdateCompletedEventAttributes(t *apiv1.WorkflowExecutionUpdateCompletedEventAttributes) *types.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     t.UpdateId,
+		RequestedEventID:             t.RequestedEventId,
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+		Result:                       ToPayload(t.Result),
+		FailureMessage:               ToFailureReason(t.Failure),
+	}
+}
+
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *apiv1.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func ToWorkflowExecutionPausedEventAttributes(t *apiv1.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *apiv1.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func ToWorkflowExecutionUnpausedEventAttributes(t *apiv1.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
 func FromWorkerVersionInfo(t *types.WorkerVersionInfo) *apiv1.WorkerVersionInfo {
 	if t == nil {
 		return nil
@@ -4690,6 +4844,7 @@ func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *apiv1.WorkflowEx
 		SearchAttributes:    FromSearchAttributes(t.SearchAttributes),
 		AutoResetPoints:     FromResetPoints(t.AutoResetPoints),
 		TaskList:            t.TaskList,
+		Paused:              t.Paused,
 	}
 }
 
@@ -4713,6 +4868,7 @@ func ToWorkflowExecutionInfo(t *apiv1.WorkflowExecutionInfo) *types.WorkflowExec
 		SearchAttributes:  ToSearchAttributes(t.SearchAttributes),
 		AutoResetPoints:   ToResetPoints(t.AutoResetPoints),
 		TaskList:          t.TaskList,
+		Paused:            t.Paused,
 	}
 }
 
@@ -4767,6 +4923,7 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:          FromResetPoints(t.PrevAutoResetPoints),
 		Header:                       FromHeader(t.Header),
+		Priority:                     t.Priority,
 	}
 }
 
@@ -4802,6 +4959,7 @@ func ToWorkflowExecutionStartedEventAttributes(t *apiv1.WorkflowExecutionStarted
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		Priority:                            t.Priority,
 	}
 }
 
@@ -4845,6 +5003,35 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *apiv1.WorkflowExecutionTimedO
 	}
 }
 
+func FromWorkflowIDConflictPolicy(t *types.WorkflowIDConflictPolicy) apiv1.WorkflowIdConflictPolicy {
+	if t == nil {
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+	}
+	switch *t {
+	case types.WorkflowIDConflictPolicyFail:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL
+	case types.WorkflowIDConflictPolicyUseExisting:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	case types.WorkflowIDConflictPolicyTerminateExisting:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING
+	}
+	panic("unexpected enum value")
+}
+
+func ToWorkflowIDConflictPolicy(t apiv1.WorkflowIdConflictPolicy) *types.WorkflowIDConflictPolicy {
+	switch t {
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID:
+		return nil
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL:
+		return types.WorkflowIDConflictPolicyFail.Ptr()
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING:
+		return types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING:
+		return types.WorkflowIDConflictPolicyTerminateExisting.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) apiv1.WorkflowIdReusePolicy {
 	if t == nil {
 		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
@@ -5556,6 +5743,22 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
 			UpsertWorkflowSearchAttributesEventAttributes: FromUpsertWorkflowSearchAttributesEventAttributes(e.UpsertWorkflowSearchAttributesEventAttributes),
 		}
+	case types.EventTypeWorkflowExecutionUpdateRequested:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUpdateRequestedEventAttributes{
+			WorkflowExecutionUpdateRequestedEventAttributes: FromWorkflowExecutionUpdateRequestedEventAttributes(e.WorkflowExecutionUpdateRequestedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes{
+			WorkflowExecutionUpdateCompletedEventAttributes: FromWorkflowExecutionUpdateCompletedEventAttributes(e.WorkflowExecutionUpdateCompletedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionPaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes{
+			WorkflowExecutionPausedEventAttributes: FromWorkflowExecutionPausedEventAttributes(e.WorkflowExecutionPausedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUnpaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes{
+			WorkflowExecutionUnpausedEventAttributes: FromWorkflowExecutionUnpausedEventAttributes(e.WorkflowExecutionUnpausedEventAttributes),
+		}
 	}
 	return &event
 }
@@ -5697,6 +5900,18 @@ func ToHistoryEvent(e *apiv1.HistoryEvent) *types.HistoryEvent {
 	case *apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes:
 		event.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		event.UpsertWorkflowSearchAttributesEventAttributes = ToUpsertWorkflowSearchAttributesEventAttributes(attr.UpsertWorkflowSearchAttributesEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUpdateRequestedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUpdateRequested.Ptr()
+		event.WorkflowExecutionUpdateRequestedEventAttributes = ToWorkflowExecutionUpdateRequestedEventAttributes(attr.WorkflowExecutionUpdateRequestedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUpdateCompleted.Ptr()
+		event.WorkflowExecutionUpdateCompletedEventAttributes = ToWorkflowExecutionUpdateCompletedEventAttributes(attr.WorkflowExecutionUpdateCompletedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		event.WorkflowExecutionPausedEventAttributes = ToWorkflowExecutionPausedEventAttributes(attr.WorkflowExecutionPausedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		event.WorkflowExecutionUnpausedEventAttributes = ToWorkflowExecutionUnpausedEventAttributes(attr.WorkflowExecutionUnpausedEventAttributes)
 	}
 	return &event
 }
@@ -576,7 +576,9 @@ func TestSignalExternalWorkflowExecutionInitiatedEventAttributes(t *testing.T) {
 	}
 }
 func TestSignalWithStartWorkflowExecutionRequest(t *testing.T) {
-	for _, item := range []*types.SignalWithStartWorkflowExecutionRequest{nil, {}, &testdata.SignalWithStartWorkflowExecutionRequest} {
+	withPolicy := testdata.SignalWithStartWorkflowExecutionRequest
+	withPolicy.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	for _, item := range []*types.SignalWithStartWorkflowExecutionRequest{nil, {}, &testdata.SignalWithStartWorkflowExecutionRequest, &withPolicy} {
 		assert.Equal(t, item, ToSignalWithStartWorkflowExecutionRequest(FromSignalWithStartWorkflowExecutionRequest(item)))
 	}
 }
@@ -585,6 +587,16 @@ func TestSignalWithStartWorkflowExecutionResponse(t *testing.T) {
 		assert.Equal(t, item, ToSignalWithStartWorkflowExecutionResponse(FromSignalWithStartWorkflowExecutionResponse(item)))
 	}
 }
+func TestUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionRequest{nil, {}, &testdata.UpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToUpdateWorkflowExecutionRequest(FromUpdateWorkflowExecutionRequest(item)))
+	}
+}
+func TestUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.UpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToUpdateWorkflowExecutionResponse(FromUpdateWorkflowExecutionResponse(item)))
+	}
+}
 func TestSignalWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.SignalWorkflowExecutionRequest{nil, {}, &testdata.SignalWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToSignalWorkflowExecutionRequest(FromSignalWorkflowExecutionRequest(item)))
@@ -616,7 +628,9 @@ func TestStartTimerDecisionAttributes(t *testing.T) {
 	}
 }
 func TestStartWorkflowExecutionRequest(t *testing.T) {
-	for _, item := range []*types.StartWorkflowExecutionRequest{nil, {}, &testdata.StartWorkflowExecutionRequest} {
+	withPolicy := testdata.StartWorkflowExecutionRequest
+	withPolicy.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyTerminateExisting.Ptr()
+	for _, item := range []*types.StartWorkflowExecutionRequest{nil, {}, &testdata.StartWorkflowExecutionRequest, &withPolicy} {
 		assert.Equal(t, item, ToStartWorkflowExecutionRequest(FromStartWorkflowExecutionRequest(item)))
 	}
 }
@@ -705,6 +719,29 @@ func TestUpsertWorkflowSearchAttributesEventAttributes(t *testing.T) {
 		assert.Equal(t, item, ToUpsertWorkflowSearchAttributesEventAttributes(FromUpsertWorkflowSearchAttributesEventAttributes(item)))
 	}
 }
+func TestWorkflowExecutionUpdateRequestedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionUpdateRequestedEventAttributes{nil, {}, &testdata.WorkflowExecutionUpdateRequestedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionUpdateRequestedEventAttributes(FromWorkflowExecutionUpdateRequestedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionUpdateCompletedEventAttributes(t *testing.T) {
+	failed := testdata.WorkflowExecutionUpdateCompletedEventAttributes
+	failed.Result = nil
+	failed.FailureMessage = &testdata.FailureReason
+	for _, item := range []*types.WorkflowExecutionUpdateCompletedEventAttributes{nil, {}, &testdata.WorkflowExecutionUpdateCompletedEventAttributes, &failed} {
+		assert.Equal(t, item, ToWorkflowExecutionUpdateCompletedEventAttributes(FromWorkflowExecutionUpdateCompletedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionPausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionPausedEventAttributes{nil, {}, &testdata.WorkflowExecutionPausedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionPausedEventAttributes(FromWorkflowExecutionPausedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionUnpausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionUnpausedEventAttributes{nil, {}, &testdata.WorkflowExecutionUnpausedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionUnpausedEventAttributes(FromWorkflowExecutionUnpausedEventAttributes(item)))
+	}
+}
 func TestWorkerVersionInfo(t *testing.T) {
 	for _, item := range []*types.WorkerVersionInfo{nil, {}, &testdata.WorkerVersionInfo} {
 		assert.Equal(t, item, ToWorkerVersionInfo(FromWorkerVersionInfo(item)))
@@ -792,7 +829,9 @@ func TestParentExecutionInfoFields(t *testing.T) {
 	assert.Equal(t, testdata.ParentExecutionInfo.InitiatedID, *ToParentInitiatedID(info))
 }
 func TestWorkflowExecutionInfo(t *testing.T) {
-	for _, item := range []*types.WorkflowExecutionInfo{nil, {}, &testdata.WorkflowExecutionInfo} {
+	paused := testdata.WorkflowExecutionInfo
+	paused.Paused = true
+	for _, item := range []*types.WorkflowExecutionInfo{nil, {}, &testdata.WorkflowExecutionInfo, &paused} {
 		assert.Equal(t, item, ToWorkflowExecutionInfo(FromWorkflowExecutionInfo(item)))
 	}
 }
@@ -983,6 +1022,10 @@ func TestHistoryEvent(t *testing.T) {
 		&testdata.HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&testdata.HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&testdata.HistoryEvent_UpsertWorkflowSearchAttributes,
+		&testdata.HistoryEvent_WorkflowExecutionUpdateRequested,
+		&testdata.HistoryEvent_WorkflowExecutionUpdateCompleted,
+		&testdata.HistoryEvent_WorkflowExecutionPaused,
+		&testdata.HistoryEvent_WorkflowExecutionUnpaused,
 	} {
 		assert.Equal(t, item, ToHistoryEvent(FromHistoryEvent(item)))
 	}
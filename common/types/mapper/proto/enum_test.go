@@ -381,6 +381,18 @@ func TestWorkflowIDReusePolicy(t *testing.T) {
 	assert.Panics(t, func() { ToWorkflowIDReusePolicy(apiv1.WorkflowIdReusePolicy(UnknownValue)) })
 	assert.Panics(t, func() { FromWorkflowIDReusePolicy(types.WorkflowIDReusePolicy(UnknownValue).Ptr()) })
 }
+func TestWorkflowIDConflictPolicy(t *testing.T) {
+	for _, item := range []*types.WorkflowIDConflictPolicy{
+		nil,
+		types.WorkflowIDConflictPolicyFail.Ptr(),
+		types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+		types.WorkflowIDConflictPolicyTerminateExisting.Ptr(),
+	} {
+		assert.Equal(t, item, ToWorkflowIDConflictPolicy(FromWorkflowIDConflictPolicy(item)))
+	}
+	assert.Panics(t, func() { ToWorkflowIDConflictPolicy(apiv1.WorkflowIdConflictPolicy(UnknownValue)) })
+	assert.Panics(t, func() { FromWorkflowIDConflictPolicy(types.WorkflowIDConflictPolicy(UnknownValue).Ptr()) })
+}
 func TestWorkflowState(t *testing.T) {
 	for _, item := range []*int32{
 		nil,
@@ -409,3 +421,15 @@ func TestTaskType(t *testing.T) {
 	assert.Panics(t, func() { ToTaskType(adminv1.TaskType(UnknownValue)) })
 	assert.Panics(t, func() { FromTaskType(common.Int32Ptr(UnknownValue)) })
 }
+func TestQueueTaskState(t *testing.T) {
+	for _, item := range []*types.QueueTaskState{
+		nil,
+		types.QueueTaskStatePending.Ptr(),
+		types.QueueTaskStateAcked.Ptr(),
+		types.QueueTaskStateBackoff.Ptr(),
+	} {
+		assert.Equal(t, item, ToQueueTaskState(FromQueueTaskState(item)))
+	}
+	assert.Panics(t, func() { ToQueueTaskState(adminv1.QueueTaskState(UnknownValue)) })
+	assert.Panics(t, func() { FromQueueTaskState(types.QueueTaskState(UnknownValue).Ptr()) })
+}
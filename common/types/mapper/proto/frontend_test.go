@@ -0,0 +1,68 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestStartWorkflowExecutionAsyncRequest(t *testing.T) {
+	withPolicy := testdata.StartWorkflowExecutionRequest
+	withPolicy.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	for _, item := range []*types.StartWorkflowExecutionAsyncRequest{
+		nil,
+		{},
+		&testdata.StartWorkflowExecutionAsyncRequest,
+		{StartWorkflowExecutionRequest: &withPolicy},
+	} {
+		assert.Equal(t, item, ToStartWorkflowExecutionAsyncRequest(FromStartWorkflowExecutionAsyncRequest(item)))
+	}
+}
+
+func TestStartWorkflowExecutionAsyncResponse(t *testing.T) {
+	for _, item := range []*types.StartWorkflowExecutionAsyncResponse{nil, &testdata.StartWorkflowExecutionAsyncResponse} {
+		assert.Equal(t, item, ToStartWorkflowExecutionAsyncResponse(FromStartWorkflowExecutionAsyncResponse(item)))
+	}
+}
+
+func TestSignalWithStartWorkflowExecutionAsyncRequest(t *testing.T) {
+	withPolicy := testdata.SignalWithStartWorkflowExecutionRequest
+	withPolicy.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyTerminateExisting.Ptr()
+	for _, item := range []*types.SignalWithStartWorkflowExecutionAsyncRequest{
+		nil,
+		{},
+		&testdata.SignalWithStartWorkflowExecutionAsyncRequest,
+		{SignalWithStartWorkflowExecutionRequest: &withPolicy},
+	} {
+		assert.Equal(t, item, ToSignalWithStartWorkflowExecutionAsyncRequest(FromSignalWithStartWorkflowExecutionAsyncRequest(item)))
+	}
+}
+
+func TestSignalWithStartWorkflowExecutionAsyncResponse(t *testing.T) {
+	for _, item := range []*types.SignalWithStartWorkflowExecutionAsyncResponse{nil, &testdata.SignalWithStartWorkflowExecutionAsyncResponse} {
+		assert.Equal(t, item, ToSignalWithStartWorkflowExecutionAsyncResponse(FromSignalWithStartWorkflowExecutionAsyncResponse(item)))
+	}
+}
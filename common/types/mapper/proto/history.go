@@ -1271,8 +1271,9 @@ func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWi
 		return nil
 	}
 	return &historyv1.SignalWithStartWorkflowExecutionRequest{
-		Request:  FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
-		DomainId: t.DomainUUID,
+		Request:        FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
+		DomainId:       t.DomainUUID,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -1283,6 +1284,7 @@ func ToHistorySignalWithStartWorkflowExecutionRequest(t *historyv1.SignalWithSta
 	return &types.HistorySignalWithStartWorkflowExecutionRequest{
 		SignalWithStartRequest: ToSignalWithStartWorkflowExecutionRequest(t.Request),
 		DomainUUID:             t.DomainId,
+		IsolationGroup:         t.IsolationGroup,
 	}
 }
 
@@ -1335,6 +1337,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 	return &historyv1.StartWorkflowExecutionRequest{
 		Request:                  FromStartWorkflowExecutionRequest(t.StartRequest),
 		DomainId:                 t.DomainUUID,
+		IsolationGroup:           t.IsolationGroup,
 		ParentExecutionInfo:      FromParentExecutionInfo(t.ParentExecutionInfo),
 		Attempt:                  t.Attempt,
 		ExpirationTime:           unixNanoToTime(t.ExpirationTimestamp),
@@ -1352,6 +1355,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 	return &types.HistoryStartWorkflowExecutionRequest{
 		StartRequest:                    ToStartWorkflowExecutionRequest(t.Request),
 		DomainUUID:                      t.DomainId,
+		IsolationGroup:                  t.IsolationGroup,
 		ParentExecutionInfo:             ToParentExecutionInfo(t.ParentExecutionInfo),
 		Attempt:                         t.Attempt,
 		ExpirationTimestamp:             timeToUnixNano(t.ExpirationTime),
@@ -1471,6 +1475,129 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *historyv1.TerminateWorkflowEx
 	}
 }
 
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *historyv1.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	request := &historyv1.UpdateWorkflowExecutionRequest{
+		DomainId: t.DomainUUID,
+	}
+	if r := t.UpdateRequest; r != nil {
+		request.Domain = r.Domain
+		request.WorkflowExecution = FromWorkflowExecution(r.WorkflowExecution)
+		request.UpdateId = r.UpdateID
+		request.UpdateName = r.UpdateName
+		request.Input = FromPayload(r.Input)
+		request.Identity = r.Identity
+		request.RequestId = r.RequestID
+	}
+	return request
+}
+
+func ToHistoryUpdateWorkflowExecutionRequest(t *historyv1.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			UpdateID:          t.UpdateId,
+			UpdateName:        t.UpdateName,
+			Input:             ToPayload(t.Input),
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		},
+	}
+}
+
+func FromHistoryUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *historyv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionResponse{
+		Result:  FromPayload(t.Result),
+		Failure: FromFailure(t.FailureMessage, nil),
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionResponse(t *historyv1.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result:         ToPayload(t.Result),
+		FailureMessage: ToFailureReason(t.Failure),
+	}
+}
+
+// FromHistoryPauseWorkflowExecutionRequest converts internal HistoryPauseWorkflowExecutionRequest type to proto
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *historyv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	request := &historyv1.PauseWorkflowExecutionRequest{
+		DomainId: t.DomainUUID,
+	}
+	if r := t.PauseRequest; r != nil {
+		request.Domain = r.Domain
+		request.WorkflowExecution = FromWorkflowExecution(r.WorkflowExecution)
+		request.Reason = r.Reason
+		request.Identity = r.Identity
+	}
+	return request
+}
+
+// ToHistoryPauseWorkflowExecutionRequest converts proto PauseWorkflowExecutionRequest type to internal
+func ToHistoryPauseWorkflowExecutionRequest(t *historyv1.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+// FromHistoryUnpauseWorkflowExecutionRequest converts internal HistoryUnpauseWorkflowExecutionRequest type to proto
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *historyv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	request := &historyv1.UnpauseWorkflowExecutionRequest{
+		DomainId: t.DomainUUID,
+	}
+	if r := t.UnpauseRequest; r != nil {
+		request.Domain = r.Domain
+		request.WorkflowExecution = FromWorkflowExecution(r.WorkflowExecution)
+		request.Reason = r.Reason
+		request.Identity = r.Identity
+	}
+	return request
+}
+
+// ToHistoryUnpauseWorkflowExecutionRequest converts proto UnpauseWorkflowExecutionRequest type to internal
+func ToHistoryUnpauseWorkflowExecutionRequest(t *historyv1.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+		},
+	}
+}
+
 // FromHistoryGetCrossClusterTasksRequest converts internal GetCrossClusterTasksRequest type to proto
 func FromHistoryGetCrossClusterTasksRequest(t *types.GetCrossClusterTasksRequest) *historyv1.GetCrossClusterTasksRequest {
 	if t == nil {
@@ -1560,3 +1687,75 @@ func ToHistoryRespondCrossClusterTasksCompletedResponse(t *historyv1.RespondCros
 		Tasks: ToCrossClusterTaskRequestArray(t.Tasks),
 	}
 }
+
+func FromHistoryListWorkflowTasksRequest(t *types.HistoryListWorkflowTasksRequest) *historyv1.ListWorkflowTasksRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.ListWorkflowTasksRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.Execution),
+	}
+}
+
+func ToHistoryListWorkflowTasksRequest(t *historyv1.ListWorkflowTasksRequest) *types.HistoryListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryListWorkflowTasksRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.ListWorkflowTasksRequest{
+			Domain:    t.Domain,
+			Execution: ToWorkflowExecution(t.WorkflowExecution),
+		},
+	}
+}
+
+func FromHistoryListWorkflowTasksResponse(t *types.ListWorkflowTasksResponse) *historyv1.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ListWorkflowTasksResponse{
+		ShardId:   t.ShardID,
+		Tasks:     FromQueueTaskArray(t.Tasks),
+		Truncated: t.Truncated,
+	}
+}
+
+func ToHistoryListWorkflowTasksResponse(t *historyv1.ListWorkflowTasksResponse) *types.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksResponse{
+		ShardID:   t.ShardId,
+		Tasks:     ToQueueTaskArray(t.Tasks),
+		Truncated: t.Truncated,
+	}
+}
+
+func FromHistoryRetryTaskRequest(t *types.RetryTaskRequest) *historyv1.RetryTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RetryTaskRequest{
+		ShardId:        t.ShardID,
+		TaskType:       FromTaskType(t.Type),
+		TaskId:         t.TaskID,
+		VisibilityTime: unixNanoToTime(t.VisibilityTimestamp),
+		ClusterName:    t.ClusterName,
+	}
+}
+
+func ToHistoryRetryTaskRequest(t *historyv1.RetryTaskRequest) *types.RetryTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryTaskRequest{
+		ShardID:             t.ShardId,
+		Type:                ToTaskType(t.TaskType),
+		TaskID:              t.TaskId,
+		VisibilityTimestamp: timeToUnixNano(t.VisibilityTime),
+		ClusterName:         t.ClusterName,
+	}
+}
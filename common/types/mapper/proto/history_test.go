@@ -292,6 +292,13 @@ func TestHistorySignalWithStartWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistorySignalWithStartWorkflowExecutionRequest(FromHistorySignalWithStartWorkflowExecutionRequest(item)))
 	}
 }
+func TestHistorySignalWithStartWorkflowExecutionRequestWithConflictPolicy(t *testing.T) {
+	signalWithStartRequest := testdata.SignalWithStartWorkflowExecutionRequest
+	signalWithStartRequest.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	item := testdata.HistorySignalWithStartWorkflowExecutionRequest
+	item.SignalWithStartRequest = &signalWithStartRequest
+	assert.Equal(t, &item, ToHistorySignalWithStartWorkflowExecutionRequest(FromHistorySignalWithStartWorkflowExecutionRequest(&item)))
+}
 func TestHistorySignalWithStartWorkflowExecutionResponse(t *testing.T) {
 	for _, item := range []*types.StartWorkflowExecutionResponse{nil, {}, &testdata.HistorySignalWithStartWorkflowExecutionResponse} {
 		assert.Equal(t, item, ToHistorySignalWithStartWorkflowExecutionResponse(FromHistorySignalWithStartWorkflowExecutionResponse(item)))
@@ -307,6 +314,13 @@ func TestHistoryStartWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(item)))
 	}
 }
+func TestHistoryStartWorkflowExecutionRequestWithConflictPolicy(t *testing.T) {
+	startRequest := testdata.StartWorkflowExecutionRequest
+	startRequest.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyTerminateExisting.Ptr()
+	item := testdata.HistoryStartWorkflowExecutionRequest
+	item.StartRequest = &startRequest
+	assert.Equal(t, &item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(&item)))
+}
 func TestHistoryStartWorkflowExecutionResponse(t *testing.T) {
 	for _, item := range []*types.StartWorkflowExecutionResponse{nil, {}, &testdata.HistoryStartWorkflowExecutionResponse} {
 		assert.Equal(t, item, ToHistoryStartWorkflowExecutionResponse(FromHistoryStartWorkflowExecutionResponse(item)))
@@ -328,6 +342,30 @@ func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 	}
 }
 
+func TestHistoryPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryPauseWorkflowExecutionRequest(FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseWorkflowExecutionRequest(FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowExecutionRequest{nil, &testdata.HistoryUpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionRequest(FromHistoryUpdateWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.HistoryUpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionResponse(FromHistoryUpdateWorkflowExecutionResponse(item)))
+	}
+}
+
 func TestHistoryGetCrossClusterTasksRequest(t *testing.T) {
 	for _, item := range []*types.GetCrossClusterTasksRequest{nil, {}, &testdata.HistoryGetCrossClusterTasksRequest} {
 		assert.Equal(t, item, ToHistoryGetCrossClusterTasksRequest(FromHistoryGetCrossClusterTasksRequest(item)))
@@ -363,3 +401,22 @@ func TestHistoryGetTaskInfoResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryGetFailoverInfoResponse(FromHistoryGetFailoverInfoResponse(item)))
 	}
 }
+
+func TestHistoryListWorkflowTasksRequest(t *testing.T) {
+	for _, item := range []*types.HistoryListWorkflowTasksRequest{nil, &testdata.HistoryListWorkflowTasksRequest} {
+		assert.Equal(t, item, ToHistoryListWorkflowTasksRequest(FromHistoryListWorkflowTasksRequest(item)))
+	}
+	assert.Nil(t, FromHistoryListWorkflowTasksRequest(&types.HistoryListWorkflowTasksRequest{}))
+}
+
+func TestHistoryListWorkflowTasksResponse(t *testing.T) {
+	for _, item := range []*types.ListWorkflowTasksResponse{nil, {}, &testdata.HistoryListWorkflowTasksResponse} {
+		assert.Equal(t, item, ToHistoryListWorkflowTasksResponse(FromHistoryListWorkflowTasksResponse(item)))
+	}
+}
+
+func TestHistoryRetryTaskRequest(t *testing.T) {
+	for _, item := range []*types.RetryTaskRequest{nil, {}, &testdata.HistoryRetryTaskRequest} {
+		assert.Equal(t, item, ToHistoryRetryTaskRequest(FromHistoryRetryTaskRequest(item)))
+	}
+}
@@ -40,6 +40,9 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		Source:                   FromTaskSource(t.Source),
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
+		IsolationGroup:           t.IsolationGroup,
+		Priority:                 t.Priority,
+		FairnessKey:              t.FairnessKey,
 	}
 }
 
@@ -57,6 +60,9 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
+		IsolationGroup:                t.IsolationGroup,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -72,6 +78,9 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
+		IsolationGroup:         t.IsolationGroup,
+		Priority:               t.Priority,
+		FairnessKey:            t.FairnessKey,
 	}
 }
 
@@ -87,6 +96,9 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
+		IsolationGroup:                t.IsolationGroup,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -177,8 +189,9 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:        FromPollerInfoArray(t.Pollers),
-		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+		Pollers:         FromPollerInfoArray(t.Pollers),
+		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig: FromTaskListPartitionConfig(t.PartitionConfig),
 	}
 }
 
@@ -187,8 +200,31 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:        ToPollerInfoArray(t.Pollers),
-		TaskListStatus: ToTaskListStatus(t.TaskListStatus),
+		Pollers:         ToPollerInfoArray(t.Pollers),
+		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
+		PartitionConfig: ToTaskListPartitionConfig(t.PartitionConfig),
+	}
+}
+
+func FromTaskListPartitionConfig(t *types.TaskListPartitionConfig) *matchingv1.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.TaskListPartitionConfig{
+		Version:            t.Version,
+		NumReadPartitions:  t.NumReadPartitions,
+		NumWritePartitions: t.NumWritePartitions,
+	}
+}
+
+func ToTaskListPartitionConfig(t *matchingv1.TaskListPartitionConfig) *types.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListPartitionConfig{
+		Version:            t.Version,
+		NumReadPartitions:  t.NumReadPartitions,
+		NumWritePartitions: t.NumWritePartitions,
 	}
 }
 
@@ -299,10 +335,11 @@ func FromMatchingPollForActivityTaskRequest(t *types.MatchingPollForActivityTask
 		return nil
 	}
 	return &matchingv1.PollForActivityTaskRequest{
-		Request:       FromPollForActivityTaskRequest(t.PollRequest),
-		DomainId:      t.DomainUUID,
-		PollerId:      t.PollerID,
-		ForwardedFrom: t.ForwardedFrom,
+		Request:        FromPollForActivityTaskRequest(t.PollRequest),
+		DomainId:       t.DomainUUID,
+		PollerId:       t.PollerID,
+		ForwardedFrom:  t.ForwardedFrom,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -311,10 +348,11 @@ func ToMatchingPollForActivityTaskRequest(t *matchingv1.PollForActivityTaskReque
 		return nil
 	}
 	return &types.MatchingPollForActivityTaskRequest{
-		PollRequest:   ToPollForActivityTaskRequest(t.Request),
-		DomainUUID:    t.DomainId,
-		PollerID:      t.PollerId,
-		ForwardedFrom: t.ForwardedFrom,
+		PollRequest:    ToPollForActivityTaskRequest(t.Request),
+		DomainUUID:     t.DomainId,
+		PollerID:       t.PollerId,
+		ForwardedFrom:  t.ForwardedFrom,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -371,10 +409,11 @@ func FromMatchingPollForDecisionTaskRequest(t *types.MatchingPollForDecisionTask
 		return nil
 	}
 	return &matchingv1.PollForDecisionTaskRequest{
-		Request:       FromPollForDecisionTaskRequest(t.PollRequest),
-		DomainId:      t.DomainUUID,
-		PollerId:      t.PollerID,
-		ForwardedFrom: t.ForwardedFrom,
+		Request:        FromPollForDecisionTaskRequest(t.PollRequest),
+		DomainId:       t.DomainUUID,
+		PollerId:       t.PollerID,
+		ForwardedFrom:  t.ForwardedFrom,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -383,10 +422,11 @@ func ToMatchingPollForDecisionTaskRequest(t *matchingv1.PollForDecisionTaskReque
 		return nil
 	}
 	return &types.MatchingPollForDecisionTaskRequest{
-		PollRequest:   ToPollForDecisionTaskRequest(t.Request),
-		DomainUUID:    t.DomainId,
-		PollerID:      t.PollerId,
-		ForwardedFrom: t.ForwardedFrom,
+		PollRequest:    ToPollForDecisionTaskRequest(t.Request),
+		DomainUUID:     t.DomainId,
+		PollerID:       t.PollerId,
+		ForwardedFrom:  t.ForwardedFrom,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
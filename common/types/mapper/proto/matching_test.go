@@ -54,7 +54,7 @@ func TestMatchingDescribeTaskListRequest(t *testing.T) {
 }
 
 func TestMatchingDescribeTaskListResponse(t *testing.T) {
-	for _, item := range []*types.DescribeTaskListResponse{nil, {}, &testdata.MatchingDescribeTaskListResponse} {
+	for _, item := range []*types.DescribeTaskListResponse{nil, {}, &testdata.MatchingDescribeTaskListResponse, {PartitionConfig: &testdata.TaskListPartitionConfig}} {
 		assert.Equal(t, item, ToMatchingDescribeTaskListResponse(FromMatchingDescribeTaskListResponse(item)))
 	}
 }
@@ -1006,6 +1006,35 @@ func ToTaskType(t adminv1.TaskType) *int32 {
 	panic("unexpected enum value")
 }
 
+func FromQueueTaskState(t *types.QueueTaskState) adminv1.QueueTaskState {
+	if t == nil {
+		return adminv1.QueueTaskState_QUEUE_TASK_STATE_INVALID
+	}
+	switch *t {
+	case types.QueueTaskStatePending:
+		return adminv1.QueueTaskState_QUEUE_TASK_STATE_PENDING
+	case types.QueueTaskStateAcked:
+		return adminv1.QueueTaskState_QUEUE_TASK_STATE_ACKED
+	case types.QueueTaskStateBackoff:
+		return adminv1.QueueTaskState_QUEUE_TASK_STATE_BACKOFF
+	}
+	panic("unexpected enum value")
+}
+
+func ToQueueTaskState(t adminv1.QueueTaskState) *types.QueueTaskState {
+	switch t {
+	case adminv1.QueueTaskState_QUEUE_TASK_STATE_INVALID:
+		return nil
+	case adminv1.QueueTaskState_QUEUE_TASK_STATE_PENDING:
+		return types.QueueTaskStatePending.Ptr()
+	case adminv1.QueueTaskState_QUEUE_TASK_STATE_ACKED:
+		return types.QueueTaskStateAcked.Ptr()
+	case adminv1.QueueTaskState_QUEUE_TASK_STATE_BACKOFF:
+		return types.QueueTaskStateBackoff.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromFailoverMarkerTokenArray(t []*types.FailoverMarkerToken) []*adminv1.FailoverMarkerToken {
 	if t == nil {
 		return nil
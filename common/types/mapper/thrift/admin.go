@@ -685,3 +685,175 @@ func ToListDynamicConfigResponse(t *admin.ListDynamicConfigResponse) *types.List
 		Entries: ToDynamicConfigEntryArray(t.Entries),
 	}
 }
+
+// FromListWorkflowTasksRequest converts internal ListWorkflowTasksRequest type to thrift
+func FromListWorkflowTasksRequest(t *types.ListWorkflowTasksRequest) *admin.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListWorkflowTasksRequest{
+		Domain:    &t.Domain,
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToListWorkflowTasksRequest converts thrift ListWorkflowTasksRequest type to internal
+func ToListWorkflowTasksRequest(t *admin.ListWorkflowTasksRequest) *types.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksRequest{
+		Domain:    t.GetDomain(),
+		Execution: ToWorkflowExecution(t.Execution),
+	}
+}
+
+// FromListWorkflowTasksResponse converts internal ListWorkflowTasksResponse type to thrift
+func FromListWorkflowTasksResponse(t *types.ListWorkflowTasksResponse) *admin.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListWorkflowTasksResponse{
+		ShardID:   &t.ShardID,
+		Tasks:     FromQueueTaskInfoArray(t.Tasks),
+		Truncated: &t.Truncated,
+	}
+}
+
+// ToListWorkflowTasksResponse converts thrift ListWorkflowTasksResponse type to internal
+func ToListWorkflowTasksResponse(t *admin.ListWorkflowTasksResponse) *types.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksResponse{
+		ShardID:   t.GetShardID(),
+		Tasks:     ToQueueTaskInfoArray(t.Tasks),
+		Truncated: t.GetTruncated(),
+	}
+}
+
+// FromQueueTaskInfoArray converts internal QueueTaskInfo type array to thrift
+func FromQueueTaskInfoArray(t []*types.QueueTaskInfo) []*admin.QueueTaskInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.QueueTaskInfo, len(t))
+	for i := range t {
+		v[i] = FromQueueTaskInfo(t[i])
+	}
+	return v
+}
+
+// ToQueueTaskInfoArray converts thrift QueueTaskInfo type array to internal
+func ToQueueTaskInfoArray(t []*admin.QueueTaskInfo) []*types.QueueTaskInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.QueueTaskInfo, len(t))
+	for i := range t {
+		v[i] = ToQueueTaskInfo(t[i])
+	}
+	return v
+}
+
+// FromQueueTaskInfo converts internal QueueTaskInfo type to thrift
+func FromQueueTaskInfo(t *types.QueueTaskInfo) *admin.QueueTaskInfo {
+	if t == nil {
+		return nil
+	}
+	return &admin.QueueTaskInfo{
+		Type:                t.Type,
+		TaskType:            &t.TaskType,
+		TaskID:              &t.TaskID,
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		ClusterName:         &t.ClusterName,
+		State:               FromQueueTaskState(t.State),
+		Loaded:              &t.Loaded,
+		Attempt:             &t.Attempt,
+		RedispatchTimestamp: t.RedispatchTimestamp,
+	}
+}
+
+// ToQueueTaskInfo converts thrift QueueTaskInfo type to internal
+func ToQueueTaskInfo(t *admin.QueueTaskInfo) *types.QueueTaskInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.QueueTaskInfo{
+		Type:                t.Type,
+		TaskType:            t.GetTaskType(),
+		TaskID:              t.GetTaskID(),
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		ClusterName:         t.GetClusterName(),
+		State:               ToQueueTaskState(t.State),
+		Loaded:              t.GetLoaded(),
+		Attempt:             t.GetAttempt(),
+		RedispatchTimestamp: t.RedispatchTimestamp,
+	}
+}
+
+// FromQueueTaskState converts internal QueueTaskState type to thrift
+func FromQueueTaskState(t *types.QueueTaskState) *admin.QueueTaskState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.QueueTaskStatePending:
+		v := admin.QueueTaskStatePending
+		return &v
+	case types.QueueTaskStateAcked:
+		v := admin.QueueTaskStateAcked
+		return &v
+	case types.QueueTaskStateBackoff:
+		v := admin.QueueTaskStateBackoff
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToQueueTaskState converts thrift QueueTaskState type to internal
+func ToQueueTaskState(t *admin.QueueTaskState) *types.QueueTaskState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case admin.QueueTaskStatePending:
+		v := types.QueueTaskStatePending
+		return &v
+	case admin.QueueTaskStateAcked:
+		v := types.QueueTaskStateAcked
+		return &v
+	case admin.QueueTaskStateBackoff:
+		v := types.QueueTaskStateBackoff
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromRetryTaskRequest converts internal RetryTaskRequest type to thrift
+func FromRetryTaskRequest(t *types.RetryTaskRequest) *admin.RetryTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RetryTaskRequest{
+		ShardID:             &t.ShardID,
+		Type:                t.Type,
+		TaskID:              &t.TaskID,
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		ClusterName:         &t.ClusterName,
+	}
+}
+
+// ToRetryTaskRequest converts thrift RetryTaskRequest type to internal
+func ToRetryTaskRequest(t *admin.RetryTaskRequest) *types.RetryTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryTaskRequest{
+		ShardID:             t.GetShardID(),
+		Type:                t.Type,
+		TaskID:              t.GetTaskID(),
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		ClusterName:         t.GetClusterName(),
+	}
+}
@@ -1002,6 +1002,7 @@ func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWi
 	return &history.SignalWithStartWorkflowExecutionRequest{
 		DomainUUID:             &t.DomainUUID,
 		SignalWithStartRequest: FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
+		IsolationGroup:         &t.IsolationGroup,
 	}
 }
 
@@ -1013,6 +1014,7 @@ func ToHistorySignalWithStartWorkflowExecutionRequest(t *history.SignalWithStart
 	return &types.HistorySignalWithStartWorkflowExecutionRequest{
 		DomainUUID:             t.GetDomainUUID(),
 		SignalWithStartRequest: ToSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
+		IsolationGroup:         t.GetIsolationGroup(),
 	}
 }
 
@@ -1058,6 +1060,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		ContinuedFailureDetails:         t.ContinuedFailureDetails,
 		LastCompletionResult:            t.LastCompletionResult,
 		FirstDecisionTaskBackoffSeconds: t.FirstDecisionTaskBackoffSeconds,
+		IsolationGroup:                  &t.IsolationGroup,
 	}
 }
 
@@ -1077,6 +1080,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *history.StartWorkflowExecutionReq
 		ContinuedFailureDetails:         t.ContinuedFailureDetails,
 		LastCompletionResult:            t.LastCompletionResult,
 		FirstDecisionTaskBackoffSeconds: t.FirstDecisionTaskBackoffSeconds,
+		IsolationGroup:                  t.GetIsolationGroup(),
 	}
 }
 
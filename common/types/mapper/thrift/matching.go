@@ -42,6 +42,9 @@ func FromAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matching.AddAc
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
+		IsolationGroup:                &t.IsolationGroup,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -60,6 +63,9 @@ func ToAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types.AddActi
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
+		IsolationGroup:                t.GetIsolationGroup(),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -107,6 +113,9 @@ func FromAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matching.AddDe
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
+		IsolationGroup:                &t.IsolationGroup,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -123,6 +132,9 @@ func ToAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types.AddDeci
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
+		IsolationGroup:                t.GetIsolationGroup(),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -202,10 +214,11 @@ func FromMatchingPollForActivityTaskRequest(t *types.MatchingPollForActivityTask
 		return nil
 	}
 	return &matching.PollForActivityTaskRequest{
-		DomainUUID:    &t.DomainUUID,
-		PollerID:      &t.PollerID,
-		PollRequest:   FromPollForActivityTaskRequest(t.PollRequest),
-		ForwardedFrom: &t.ForwardedFrom,
+		DomainUUID:     &t.DomainUUID,
+		PollerID:       &t.PollerID,
+		PollRequest:    FromPollForActivityTaskRequest(t.PollRequest),
+		ForwardedFrom:  &t.ForwardedFrom,
+		IsolationGroup: &t.IsolationGroup,
 	}
 }
 
@@ -215,10 +228,11 @@ func ToMatchingPollForActivityTaskRequest(t *matching.PollForActivityTaskRequest
 		return nil
 	}
 	return &types.MatchingPollForActivityTaskRequest{
-		DomainUUID:    t.GetDomainUUID(),
-		PollerID:      t.GetPollerID(),
-		PollRequest:   ToPollForActivityTaskRequest(t.PollRequest),
-		ForwardedFrom: t.GetForwardedFrom(),
+		DomainUUID:     t.GetDomainUUID(),
+		PollerID:       t.GetPollerID(),
+		PollRequest:    ToPollForActivityTaskRequest(t.PollRequest),
+		ForwardedFrom:  t.GetForwardedFrom(),
+		IsolationGroup: t.GetIsolationGroup(),
 	}
 }
 
@@ -228,10 +242,11 @@ func FromMatchingPollForDecisionTaskRequest(t *types.MatchingPollForDecisionTask
 		return nil
 	}
 	return &matching.PollForDecisionTaskRequest{
-		DomainUUID:    &t.DomainUUID,
-		PollerID:      &t.PollerID,
-		PollRequest:   FromPollForDecisionTaskRequest(t.PollRequest),
-		ForwardedFrom: &t.ForwardedFrom,
+		DomainUUID:     &t.DomainUUID,
+		PollerID:       &t.PollerID,
+		PollRequest:    FromPollForDecisionTaskRequest(t.PollRequest),
+		ForwardedFrom:  &t.ForwardedFrom,
+		IsolationGroup: &t.IsolationGroup,
 	}
 }
 
@@ -241,10 +256,11 @@ func ToMatchingPollForDecisionTaskRequest(t *matching.PollForDecisionTaskRequest
 		return nil
 	}
 	return &types.MatchingPollForDecisionTaskRequest{
-		DomainUUID:    t.GetDomainUUID(),
-		PollerID:      t.GetPollerID(),
-		PollRequest:   ToPollForDecisionTaskRequest(t.PollRequest),
-		ForwardedFrom: t.GetForwardedFrom(),
+		DomainUUID:     t.GetDomainUUID(),
+		PollerID:       t.GetPollerID(),
+		PollRequest:    ToPollForDecisionTaskRequest(t.PollRequest),
+		ForwardedFrom:  t.GetForwardedFrom(),
+		IsolationGroup: t.GetIsolationGroup(),
 	}
 }
 
@@ -195,6 +195,7 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId:  &t.DecisionTaskCompletedEventID,
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
+		Priority:                      &t.Priority,
 	}
 }
 
@@ -216,6 +217,7 @@ func ToActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEvent
 		DecisionTaskCompletedEventID:  t.GetDecisionTaskCompletedEventId(),
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		Priority:                      t.GetPriority(),
 	}
 }
 
@@ -1735,8 +1737,9 @@ func FromDescribeTaskListResponse(t *types.DescribeTaskListResponse) *shared.Des
 		return nil
 	}
 	return &shared.DescribeTaskListResponse{
-		Pollers:        FromPollerInfoArray(t.Pollers),
-		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+		Pollers:         FromPollerInfoArray(t.Pollers),
+		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig: FromTaskListPartitionConfig(t.PartitionConfig),
 	}
 }
 
@@ -1746,8 +1749,9 @@ func ToDescribeTaskListResponse(t *shared.DescribeTaskListResponse) *types.Descr
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:        ToPollerInfoArray(t.Pollers),
-		TaskListStatus: ToTaskListStatus(t.TaskListStatus),
+		Pollers:         ToPollerInfoArray(t.Pollers),
+		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
+		PartitionConfig: ToTaskListPartitionConfig(t.PartitionConfig),
 	}
 }
 
@@ -2227,6 +2231,18 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeWorkflowExecutionUpdateRequested:
+		v := shared.EventTypeWorkflowExecutionUpdateRequested
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		v := shared.EventTypeWorkflowExecutionUpdateCompleted
+		return &v
+	case types.EventTypeWorkflowExecutionPaused:
+		v := shared.EventTypeWorkflowExecutionPaused
+		return &v
+	case types.EventTypeWorkflowExecutionUnpaused:
+		v := shared.EventTypeWorkflowExecutionUnpaused
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2363,6 +2379,18 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeWorkflowExecutionUpdateRequested:
+		v := types.EventTypeWorkflowExecutionUpdateRequested
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateCompleted:
+		v := types.EventTypeWorkflowExecutionUpdateCompleted
+		return &v
+	case shared.EventTypeWorkflowExecutionPaused:
+		v := types.EventTypeWorkflowExecutionPaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUnpaused:
+		v := types.EventTypeWorkflowExecutionUnpaused
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2658,6 +2686,10 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionUpdateRequestedEventAttributes:                FromWorkflowExecutionUpdateRequestedEventAttributes(t.WorkflowExecutionUpdateRequestedEventAttributes),
+		WorkflowExecutionUpdateCompletedEventAttributes:                FromWorkflowExecutionUpdateCompletedEventAttributes(t.WorkflowExecutionUpdateCompletedEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         FromWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       FromWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
 	}
 }
 
@@ -2714,6 +2746,10 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionUpdateRequestedEventAttributes:                ToWorkflowExecutionUpdateRequestedEventAttributes(t.WorkflowExecutionUpdateRequestedEventAttributes),
+		WorkflowExecutionUpdateCompletedEventAttributes:                ToWorkflowExecutionUpdateCompletedEventAttributes(t.WorkflowExecutionUpdateCompletedEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         ToWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       ToWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
 	}
 }
 
@@ -4789,6 +4825,7 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
 		RequestLocalDispatch:          &t.RequestLocalDispatch,
+		Priority:                      &t.Priority,
 	}
 }
 
@@ -4810,6 +4847,7 @@ func ToScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDeci
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.GetRequestLocalDispatch(),
+		Priority:                      t.GetPriority(),
 	}
 }
 
@@ -4995,6 +5033,9 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
+		DelayStartSeconds:                   t.DelayStartSeconds,
+		JitterStartSeconds:                  t.JitterStartSeconds,
+		WorkflowIdConflictPolicy:            FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 	}
 }
 
@@ -5022,9 +5063,48 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
+		DelayStartSeconds:                   t.DelayStartSeconds,
+		JitterStartSeconds:                  t.JitterStartSeconds,
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
+	}
+}
+
+// FromSignalWithStartWorkflowExecutionAsyncRequest converts internal SignalWithStartWorkflowExecutionAsyncRequest type to thrift
+func FromSignalWithStartWorkflowExecutionAsyncRequest(t *types.SignalWithStartWorkflowExecutionAsyncRequest) *shared.SignalWithStartWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalWithStartWorkflowExecutionAsyncRequest{
+		Request: FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartWorkflowExecutionRequest),
+	}
+}
+
+// ToSignalWithStartWorkflowExecutionAsyncRequest converts thrift SignalWithStartWorkflowExecutionAsyncRequest type to internal
+func ToSignalWithStartWorkflowExecutionAsyncRequest(t *shared.SignalWithStartWorkflowExecutionAsyncRequest) *types.SignalWithStartWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalWithStartWorkflowExecutionAsyncRequest{
+		SignalWithStartWorkflowExecutionRequest: ToSignalWithStartWorkflowExecutionRequest(t.Request),
 	}
 }
 
+// FromSignalWithStartWorkflowExecutionAsyncResponse converts internal SignalWithStartWorkflowExecutionAsyncResponse type to thrift
+func FromSignalWithStartWorkflowExecutionAsyncResponse(t *types.SignalWithStartWorkflowExecutionAsyncResponse) *shared.SignalWithStartWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalWithStartWorkflowExecutionAsyncResponse{}
+}
+
+// ToSignalWithStartWorkflowExecutionAsyncResponse converts thrift SignalWithStartWorkflowExecutionAsyncResponse type to internal
+func ToSignalWithStartWorkflowExecutionAsyncResponse(t *shared.SignalWithStartWorkflowExecutionAsyncResponse) *types.SignalWithStartWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalWithStartWorkflowExecutionAsyncResponse{}
+}
+
 // FromSignalWorkflowExecutionRequest converts internal SignalWorkflowExecutionRequest type to thrift
 func FromSignalWorkflowExecutionRequest(t *types.SignalWorkflowExecutionRequest) *shared.SignalWorkflowExecutionRequest {
 	if t == nil {
@@ -5254,6 +5334,8 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		Header:                              FromHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
 		JitterStartSeconds:                  t.JitterStartSeconds,
+		WorkflowIdConflictPolicy:            FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
+		Priority:                            &t.Priority,
 	}
 }
 
@@ -5280,7 +5362,45 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
 		JitterStartSeconds:                  t.JitterStartSeconds,
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
+		Priority:                            t.GetPriority(),
+	}
+}
+
+// FromStartWorkflowExecutionAsyncRequest converts internal StartWorkflowExecutionAsyncRequest type to thrift
+func FromStartWorkflowExecutionAsyncRequest(t *types.StartWorkflowExecutionAsyncRequest) *shared.StartWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionAsyncRequest{
+		Request: FromStartWorkflowExecutionRequest(t.StartWorkflowExecutionRequest),
+	}
+}
+
+// ToStartWorkflowExecutionAsyncRequest converts thrift StartWorkflowExecutionAsyncRequest type to internal
+func ToStartWorkflowExecutionAsyncRequest(t *shared.StartWorkflowExecutionAsyncRequest) *types.StartWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StartWorkflowExecutionAsyncRequest{
+		StartWorkflowExecutionRequest: ToStartWorkflowExecutionRequest(t.Request),
+	}
+}
+
+// FromStartWorkflowExecutionAsyncResponse converts internal StartWorkflowExecutionAsyncResponse type to thrift
+func FromStartWorkflowExecutionAsyncResponse(t *types.StartWorkflowExecutionAsyncResponse) *shared.StartWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionAsyncResponse{}
+}
+
+// ToStartWorkflowExecutionAsyncResponse converts thrift StartWorkflowExecutionAsyncResponse type to internal
+func ToStartWorkflowExecutionAsyncResponse(t *shared.StartWorkflowExecutionAsyncResponse) *types.StartWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
 	}
+	return &types.StartWorkflowExecutionAsyncResponse{}
 }
 
 // FromRestartWorkflowExecutionResponse converts internal RestartWorkflowExecutionResponse type to thrift
@@ -5453,6 +5573,30 @@ func ToTaskListMetadata(t *shared.TaskListMetadata) *types.TaskListMetadata {
 	}
 }
 
+// FromTaskListPartitionConfig converts internal TaskListPartitionConfig type to thrift
+func FromTaskListPartitionConfig(t *types.TaskListPartitionConfig) *shared.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskListPartitionConfig{
+		Version:            &t.Version,
+		NumReadPartitions:  &t.NumReadPartitions,
+		NumWritePartitions: &t.NumWritePartitions,
+	}
+}
+
+// ToTaskListPartitionConfig converts thrift TaskListPartitionConfig type to internal
+func ToTaskListPartitionConfig(t *shared.TaskListPartitionConfig) *types.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListPartitionConfig{
+		Version:            t.GetVersion(),
+		NumReadPartitions:  t.GetNumReadPartitions(),
+		NumWritePartitions: t.GetNumWritePartitions(),
+	}
+}
+
 // FromTaskListPartitionMetadata converts internal TaskListPartitionMetadata type to thrift
 func FromTaskListPartitionMetadata(t *types.TaskListPartitionMetadata) *shared.TaskListPartitionMetadata {
 	if t == nil {
@@ -5481,11 +5625,12 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint: &t.BacklogCountHint,
-		ReadLevel:        &t.ReadLevel,
-		AckLevel:         &t.AckLevel,
-		RatePerSecond:    &t.RatePerSecond,
-		TaskIDBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:           &t.BacklogCountHint,
+		ReadLevel:                  &t.ReadLevel,
+		AckLevel:                   &t.AckLevel,
+		RatePerSecond:              &t.RatePerSecond,
+		TaskIDBlock:                FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHintByPriority: t.BacklogCountHintByPriority,
 	}
 }
 
@@ -5495,11 +5640,12 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint: t.GetBacklogCountHint(),
-		ReadLevel:        t.GetReadLevel(),
-		AckLevel:         t.GetAckLevel(),
-		RatePerSecond:    t.GetRatePerSecond(),
-		TaskIDBlock:      ToTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:           t.GetBacklogCountHint(),
+		ReadLevel:                  t.GetReadLevel(),
+		AckLevel:                   t.GetAckLevel(),
+		RatePerSecond:              t.GetRatePerSecond(),
+		TaskIDBlock:                ToTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHintByPriority: t.BacklogCountHintByPriority,
 	}
 }
 
@@ -5829,6 +5975,60 @@ func ToUpdateDomainResponse(t *shared.UpdateDomainResponse) *types.UpdateDomainR
 	}
 }
 
+// FromUpdateWorkflowExecutionRequest converts internal UpdateWorkflowExecutionRequest type to thrift
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateID:          &t.UpdateID,
+		UpdateName:        &t.UpdateName,
+		Input:             t.Input,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToUpdateWorkflowExecutionRequest(t *shared.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		UpdateID:          t.GetUpdateID(),
+		UpdateName:        t.GetUpdateName(),
+		Input:             t.Input,
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromUpdateWorkflowExecutionResponse converts internal UpdateWorkflowExecutionResponse type to thrift
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *shared.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionResponse{
+		Result:         t.Result,
+		FailureMessage: t.FailureMessage,
+	}
+}
+
+// ToUpdateWorkflowExecutionResponse converts thrift UpdateWorkflowExecutionResponse type to internal
+func ToUpdateWorkflowExecutionResponse(t *shared.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result:         t.Result,
+		FailureMessage: t.FailureMessage,
+	}
+}
+
 // FromUpsertWorkflowSearchAttributesDecisionAttributes converts internal UpsertWorkflowSearchAttributesDecisionAttributes type to thrift
 func FromUpsertWorkflowSearchAttributesDecisionAttributes(t *types.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.UpsertWorkflowSearchAttributesDecisionAttributes {
 	if t == nil {
@@ -6270,6 +6470,7 @@ func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *shared.WorkflowE
 		TaskList:         &t.TaskList,
 		IsCron:           &t.IsCron,
 		UpdateTime:       t.UpdateTime,
+		Paused:           &t.Paused,
 	}
 }
 
@@ -6294,6 +6495,29 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 		TaskList:         t.GetTaskList(),
 		IsCron:           t.GetIsCron(),
 		UpdateTime:       t.UpdateTime,
+		Paused:           t.GetPaused(),
+	}
+}
+
+// FromWorkflowExecutionPausedEventAttributes converts internal WorkflowExecutionPausedEventAttributes type to thrift
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *shared.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionPausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionPausedEventAttributes converts thrift WorkflowExecutionPausedEventAttributes type to internal
+func ToWorkflowExecutionPausedEventAttributes(t *shared.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
 	}
 }
 
@@ -6354,6 +6578,8 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 FromResetPoints(t.PrevAutoResetPoints),
 		Header:                              FromHeader(t.Header),
+		IsolationGroup:                      &t.IsolationGroup,
+		Priority:                            &t.Priority,
 	}
 }
 
@@ -6390,6 +6616,8 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		IsolationGroup:                      t.GetIsolationGroup(),
+		Priority:                            t.GetPriority(),
 	}
 }
 
@@ -6437,6 +6665,122 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimed
 	}
 }
 
+// FromWorkflowExecutionUnpausedEventAttributes converts internal WorkflowExecutionUnpausedEventAttributes type to thrift
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *shared.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionUnpausedEventAttributes converts thrift WorkflowExecutionUnpausedEventAttributes type to internal
+func ToWorkflowExecutionUnpausedEventAttributes(t *shared.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// FromWorkflowExecutionUpdateCompletedEventAttributes converts internal WorkflowExecutionUpdateCompletedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateCompletedEventAttributes(t *types.WorkflowExecutionUpdateCompletedEventAttributes) *shared.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     &t.UpdateID,
+		RequestedEventId:             &t.RequestedEventID,
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		Result:                       t.Result,
+		FailureMessage:               t.FailureMessage,
+	}
+}
+
+// ToWorkflowExecutionUpdateCompletedEventAttributes converts thrift WorkflowExecutionUpdateCompletedEventAttributes type to internal
+func ToWorkflowExecutionUpdateCompletedEventAttributes(t *shared.WorkflowExecutionUpdateCompletedEventAttributes) *types.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     t.GetUpdateID(),
+		RequestedEventID:             t.GetRequestedEventId(),
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		Result:                       t.Result,
+		FailureMessage:               t.FailureMessage,
+	}
+}
+
+// FromWorkflowExecutionUpdateRequestedEventAttributes converts internal WorkflowExecutionUpdateRequestedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateRequestedEventAttributes(t *types.WorkflowExecutionUpdateRequestedEventAttributes) *shared.WorkflowExecutionUpdateRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   &t.UpdateID,
+		UpdateName: &t.UpdateName,
+		Input:      t.Input,
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToWorkflowExecutionUpdateRequestedEventAttributes converts thrift WorkflowExecutionUpdateRequestedEventAttributes type to internal
+func ToWorkflowExecutionUpdateRequestedEventAttributes(t *shared.WorkflowExecutionUpdateRequestedEventAttributes) *types.WorkflowExecutionUpdateRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   t.GetUpdateID(),
+		UpdateName: t.GetUpdateName(),
+		Input:      t.Input,
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
+// FromWorkflowIDConflictPolicy converts internal WorkflowIDConflictPolicy type to thrift
+func FromWorkflowIDConflictPolicy(t *types.WorkflowIDConflictPolicy) *shared.WorkflowIdConflictPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.WorkflowIDConflictPolicyFail:
+		v := shared.WorkflowIdConflictPolicyFail
+		return &v
+	case types.WorkflowIDConflictPolicyUseExisting:
+		v := shared.WorkflowIdConflictPolicyUseExisting
+		return &v
+	case types.WorkflowIDConflictPolicyTerminateExisting:
+		v := shared.WorkflowIdConflictPolicyTerminateExisting
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToWorkflowIDConflictPolicy converts thrift WorkflowIdConflictPolicy type to internal
+func ToWorkflowIDConflictPolicy(t *shared.WorkflowIdConflictPolicy) *types.WorkflowIDConflictPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.WorkflowIdConflictPolicyFail:
+		v := types.WorkflowIDConflictPolicyFail
+		return &v
+	case shared.WorkflowIdConflictPolicyUseExisting:
+		v := types.WorkflowIDConflictPolicyUseExisting
+		return &v
+	case shared.WorkflowIdConflictPolicyTerminateExisting:
+		v := types.WorkflowIDConflictPolicyTerminateExisting
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromWorkflowIDReusePolicy converts internal WorkflowIDReusePolicy type to thrift
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) *shared.WorkflowIdReusePolicy {
 	if t == nil {
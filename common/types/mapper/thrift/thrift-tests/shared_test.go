@@ -42,6 +42,87 @@ func TestDecisionTaskTimedOutEventAttributes(t *testing.T) {
 	}
 }
 
+func TestHistoryEventWithUpdateAttributes(t *testing.T) {
+	failed := testdata.WorkflowExecutionUpdateCompletedEventAttributes
+	failed.Result = nil
+	failed.FailureMessage = &testdata.FailureReason
+	for _, item := range []*types.HistoryEvent{
+		&testdata.HistoryEvent_WorkflowExecutionUpdateRequested,
+		&testdata.HistoryEvent_WorkflowExecutionUpdateCompleted,
+	} {
+		assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+	}
+	for _, item := range []*types.WorkflowExecutionUpdateCompletedEventAttributes{nil, {}, &failed} {
+		assert.Equal(t, item, thrift.ToWorkflowExecutionUpdateCompletedEventAttributes(thrift.FromWorkflowExecutionUpdateCompletedEventAttributes(item)))
+	}
+	for _, item := range []*types.WorkflowExecutionUpdateRequestedEventAttributes{nil, {}} {
+		assert.Equal(t, item, thrift.ToWorkflowExecutionUpdateRequestedEventAttributes(thrift.FromWorkflowExecutionUpdateRequestedEventAttributes(item)))
+	}
+}
+
+func TestHistoryEventWithPauseAttributes(t *testing.T) {
+	for _, item := range []*types.HistoryEvent{
+		&testdata.HistoryEvent_WorkflowExecutionPaused,
+		&testdata.HistoryEvent_WorkflowExecutionUnpaused,
+	} {
+		assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+	}
+}
+
+func TestStartWorkflowExecutionRequest(t *testing.T) {
+	item := testdata.StartWorkflowExecutionRequest
+	item.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyTerminateExisting.Ptr()
+	for _, item := range []*types.StartWorkflowExecutionRequest{nil, {}, &testdata.StartWorkflowExecutionRequest, &item} {
+		assert.Equal(t, item, thrift.ToStartWorkflowExecutionRequest(thrift.FromStartWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestSignalWithStartWorkflowExecutionRequest(t *testing.T) {
+	item := testdata.SignalWithStartWorkflowExecutionRequest
+	item.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	for _, item := range []*types.SignalWithStartWorkflowExecutionRequest{nil, {}, &testdata.SignalWithStartWorkflowExecutionRequest, &item} {
+		assert.Equal(t, item, thrift.ToSignalWithStartWorkflowExecutionRequest(thrift.FromSignalWithStartWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestStartWorkflowExecutionAsyncRequest(t *testing.T) {
+	for _, item := range []*types.StartWorkflowExecutionAsyncRequest{nil, {}, &testdata.StartWorkflowExecutionAsyncRequest} {
+		assert.Equal(t, item, thrift.ToStartWorkflowExecutionAsyncRequest(thrift.FromStartWorkflowExecutionAsyncRequest(item)))
+	}
+	for _, item := range []*types.StartWorkflowExecutionAsyncResponse{nil, &testdata.StartWorkflowExecutionAsyncResponse} {
+		assert.Equal(t, item, thrift.ToStartWorkflowExecutionAsyncResponse(thrift.FromStartWorkflowExecutionAsyncResponse(item)))
+	}
+}
+
+func TestSignalWithStartWorkflowExecutionAsyncRequest(t *testing.T) {
+	for _, item := range []*types.SignalWithStartWorkflowExecutionAsyncRequest{nil, {}, &testdata.SignalWithStartWorkflowExecutionAsyncRequest} {
+		assert.Equal(t, item, thrift.ToSignalWithStartWorkflowExecutionAsyncRequest(thrift.FromSignalWithStartWorkflowExecutionAsyncRequest(item)))
+	}
+	for _, item := range []*types.SignalWithStartWorkflowExecutionAsyncResponse{nil, &testdata.SignalWithStartWorkflowExecutionAsyncResponse} {
+		assert.Equal(t, item, thrift.ToSignalWithStartWorkflowExecutionAsyncResponse(thrift.FromSignalWithStartWorkflowExecutionAsyncResponse(item)))
+	}
+}
+
+func TestUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionRequest{nil, {}, &testdata.UpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToUpdateWorkflowExecutionRequest(thrift.FromUpdateWorkflowExecutionRequest(item)))
+	}
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.UpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, thrift.ToUpdateWorkflowExecutionResponse(thrift.FromUpdateWorkflowExecutionResponse(item)))
+	}
+}
+
+func TestWorkflowIDConflictPolicy(t *testing.T) {
+	for _, item := range []*types.WorkflowIDConflictPolicy{
+		nil,
+		types.WorkflowIDConflictPolicyFail.Ptr(),
+		types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+		types.WorkflowIDConflictPolicyTerminateExisting.Ptr(),
+	} {
+		assert.Equal(t, item, thrift.ToWorkflowIDConflictPolicy(thrift.FromWorkflowIDConflictPolicy(item)))
+	}
+}
+
 func TestRemoveTaskRequest(t *testing.T) {
 	for _, item := range []*types.RemoveTaskRequest{nil, {}, &testdata.AdminRemoveTaskRequest} {
 		assert.Equal(t, item, thrift.ToRemoveTaskRequest(thrift.FromRemoveTaskRequest(item)))
@@ -37,6 +37,9 @@ type AddActivityTaskRequest struct {
 	Source                        *TaskSource               `json:"source,omitempty"`
 	ForwardedFrom                 string                    `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
+	IsolationGroup                string                    `json:"isolationGroup,omitempty"`
+	Priority                      int32                     `json:"priority,omitempty"`
+	FairnessKey                   string                    `json:"fairnessKey,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -103,6 +106,30 @@ func (v *AddActivityTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // ActivityTaskDispatchInfo is an internal type (TBD...)
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent                  *HistoryEvent `json:"scheduledEvent,omitempty"`
@@ -123,6 +150,9 @@ type AddDecisionTaskRequest struct {
 	ScheduleToStartTimeoutSeconds *int32             `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
+	IsolationGroup                string             `json:"isolationGroup,omitempty"`
+	Priority                      int32              `json:"priority,omitempty"`
+	FairnessKey                   string             `json:"fairnessKey,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -181,6 +211,30 @@ func (v *AddDecisionTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // CancelOutstandingPollRequest is an internal type (TBD...)
 type CancelOutstandingPollRequest struct {
 	DomainUUID   string    `json:"domainUUID,omitempty"`
@@ -280,10 +334,11 @@ func (v *MatchingGetTaskListsByDomainRequest) GetDomain() (o string) {
 
 // MatchingPollForActivityTaskRequest is an internal type (TBD...)
 type MatchingPollForActivityTaskRequest struct {
-	DomainUUID    string                      `json:"domainUUID,omitempty"`
-	PollerID      string                      `json:"pollerID,omitempty"`
-	PollRequest   *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
-	ForwardedFrom string                      `json:"forwardedFrom,omitempty"`
+	DomainUUID     string                      `json:"domainUUID,omitempty"`
+	PollerID       string                      `json:"pollerID,omitempty"`
+	PollRequest    *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom  string                      `json:"forwardedFrom,omitempty"`
+	IsolationGroup string                      `json:"isolationGroup,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -318,12 +373,21 @@ func (v *MatchingPollForActivityTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *MatchingPollForActivityTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // MatchingPollForDecisionTaskRequest is an internal type (TBD...)
 type MatchingPollForDecisionTaskRequest struct {
-	DomainUUID    string                      `json:"domainUUID,omitempty"`
-	PollerID      string                      `json:"pollerID,omitempty"`
-	PollRequest   *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
-	ForwardedFrom string                      `json:"forwardedFrom,omitempty"`
+	DomainUUID     string                      `json:"domainUUID,omitempty"`
+	PollerID       string                      `json:"pollerID,omitempty"`
+	PollRequest    *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom  string                      `json:"forwardedFrom,omitempty"`
+	IsolationGroup string                      `json:"isolationGroup,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -358,6 +422,14 @@ func (v *MatchingPollForDecisionTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // MatchingPollForDecisionTaskResponse is an internal type (TBD...)
 type MatchingPollForDecisionTaskResponse struct {
 	TaskToken                 []byte                    `json:"taskToken,omitempty"`
@@ -143,6 +143,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventID  int64         `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      int32         `json:"priority,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -209,6 +210,14 @@ func (v *ActivityTaskScheduledEventAttributes) GetHeartbeatTimeoutSeconds() (o i
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 // ActivityTaskStartedEventAttributes is an internal type (TBD...)
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventID   int64   `json:"scheduledEventId,omitempty"`
@@ -1641,8 +1650,9 @@ func (v *DescribeTaskListRequest) GetIncludeTaskListStatus() (o bool) {
 
 // DescribeTaskListResponse is an internal type (TBD...)
 type DescribeTaskListResponse struct {
-	Pollers        []*PollerInfo   `json:"pollers,omitempty"`
-	TaskListStatus *TaskListStatus `json:"taskListStatus,omitempty"`
+	Pollers         []*PollerInfo            `json:"pollers,omitempty"`
+	TaskListStatus  *TaskListStatus          `json:"taskListStatus,omitempty"`
+	PartitionConfig *TaskListPartitionConfig `json:"partitionConfig,omitempty"`
 }
 
 // GetPollers is an internal getter (TBD...)
@@ -1661,6 +1671,14 @@ func (v *DescribeTaskListResponse) GetTaskListStatus() (o *TaskListStatus) {
 	return
 }
 
+// GetPartitionConfig is an internal getter (TBD...)
+func (v *DescribeTaskListResponse) GetPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
+	}
+	return
+}
+
 // DescribeWorkflowExecutionRequest is an internal type (TBD...)
 type DescribeWorkflowExecutionRequest struct {
 	Domain    string             `json:"domain,omitempty"`
@@ -2107,6 +2125,14 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionUpdateRequested"
+	case 43:
+		return "WorkflowExecutionUpdateCompleted"
+	case 44:
+		return "WorkflowExecutionPaused"
+	case 45:
+		return "WorkflowExecutionUnpaused"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -2240,6 +2266,18 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WORKFLOWEXECUTIONUPDATEREQUESTED":
+		*e = EventTypeWorkflowExecutionUpdateRequested
+		return nil
+	case "WORKFLOWEXECUTIONUPDATECOMPLETED":
+		*e = EventTypeWorkflowExecutionUpdateCompleted
+		return nil
+	case "WORKFLOWEXECUTIONPAUSED":
+		*e = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WORKFLOWEXECUTIONUNPAUSED":
+		*e = EventTypeWorkflowExecutionUnpaused
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -2340,6 +2378,14 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeWorkflowExecutionUpdateRequested is an option for EventType
+	EventTypeWorkflowExecutionUpdateRequested
+	// EventTypeWorkflowExecutionUpdateCompleted is an option for EventType
+	EventTypeWorkflowExecutionUpdateCompleted
+	// EventTypeWorkflowExecutionPaused is an option for EventType
+	EventTypeWorkflowExecutionPaused
+	// EventTypeWorkflowExecutionUnpaused is an option for EventType
+	EventTypeWorkflowExecutionUnpaused
 )
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
@@ -2637,6 +2683,10 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionUpdateRequestedEventAttributes                *WorkflowExecutionUpdateRequestedEventAttributes                `json:"workflowExecutionUpdateRequestedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateCompletedEventAttributes                *WorkflowExecutionUpdateCompletedEventAttributes                `json:"workflowExecutionUpdateCompletedEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionUnpausedEventAttributes                       *WorkflowExecutionUnpausedEventAttributes                       `json:"workflowExecutionUnpausedEventAttributes,omitempty"`
 }
 
 // GetTimestamp is an internal getter (TBD...)
@@ -2991,6 +3041,38 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetWorkflowExecutionUpdateRequestedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateRequestedEventAttributes() (o *WorkflowExecutionUpdateRequestedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateRequestedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateRequestedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUpdateCompletedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateCompletedEventAttributes() (o *WorkflowExecutionUpdateCompletedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateCompletedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() (o *WorkflowExecutionUnpausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil {
+		return v.WorkflowExecutionUnpausedEventAttributes
+	}
+	return
+}
+
 // HistoryEventFilterType is an internal type (TBD...)
 type HistoryEventFilterType int32
 
@@ -3511,6 +3593,46 @@ const (
 	ParentClosePolicyTerminate
 )
 
+// PauseWorkflowExecutionRequest is an internal type (TBD...)
+type PauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // PendingActivityInfo is an internal type (TBD...)
 type PendingActivityInfo struct {
 	ActivityID             string                `json:"activityID,omitempty"`
@@ -5333,6 +5455,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+	Priority                      int32         `json:"priority,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -5391,6 +5514,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetHeartbeatTimeoutSeconds() (o
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 // SearchAttributes is an internal type (TBD...)
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
@@ -5550,26 +5681,27 @@ func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkfl
 
 // SignalWithStartWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionRequest struct {
-	Domain                              string                 `json:"domain,omitempty"`
-	WorkflowID                          string                 `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                 `json:"identity,omitempty"`
-	RequestID                           string                 `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	SignalName                          string                 `json:"signalName,omitempty"`
-	SignalInput                         []byte                 `json:"signalInput,omitempty"`
-	Control                             []byte                 `json:"control,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                 `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
-	JitterStartSeconds                  *int32                 `json:"jitterStartSeconds,omitempty"`
+	Domain                              string                    `json:"domain,omitempty"`
+	WorkflowID                          string                    `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                    `json:"identity,omitempty"`
+	RequestID                           string                    `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	SignalName                          string                    `json:"signalName,omitempty"`
+	SignalInput                         []byte                    `json:"signalInput,omitempty"`
+	Control                             []byte                    `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                    `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	JitterStartSeconds                  *int32                    `json:"jitterStartSeconds,omitempty"`
+	WorkflowIDConflictPolicy            *WorkflowIDConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5644,6 +5776,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o
 	return
 }
 
+// GetWorkflowIDConflictPolicy is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDConflictPolicy() (o WorkflowIDConflictPolicy) {
+	if v != nil && v.WorkflowIDConflictPolicy != nil {
+		return *v.WorkflowIDConflictPolicy
+	}
+	return
+}
+
 // GetSignalName is an internal getter (TBD...)
 func (v *SignalWithStartWorkflowExecutionRequest) GetSignalName() (o string) {
 	if v != nil {
@@ -5668,6 +5808,31 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// SignalWithStartWorkflowExecutionAsyncRequest is an internal type (TBD...)
+type SignalWithStartWorkflowExecutionAsyncRequest struct {
+	*SignalWithStartWorkflowExecutionRequest
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.SignalWithStartWorkflowExecutionRequest.GetDomain()
+	}
+	return
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionAsyncRequest) GetWorkflowType() (o *WorkflowType) {
+	if v != nil {
+		return v.SignalWithStartWorkflowExecutionRequest.GetWorkflowType()
+	}
+	return
+}
+
+// SignalWithStartWorkflowExecutionAsyncResponse is an internal type (TBD...)
+type SignalWithStartWorkflowExecutionAsyncResponse struct {
+}
+
 // SignalWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWorkflowExecutionRequest struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -5943,23 +6108,25 @@ func (v *RestartWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExe
 
 // StartWorkflowExecutionRequest is an internal type (TBD...)
 type StartWorkflowExecutionRequest struct {
-	Domain                              string                 `json:"domain,omitempty"`
-	WorkflowID                          string                 `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                 `json:"identity,omitempty"`
-	RequestID                           string                 `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                 `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
-	JitterStartSeconds                  *int32                 `json:"jitterStartSeconds,omitempty"`
+	Domain                              string                    `json:"domain,omitempty"`
+	WorkflowID                          string                    `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                    `json:"identity,omitempty"`
+	RequestID                           string                    `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                    `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	JitterStartSeconds                  *int32                    `json:"jitterStartSeconds,omitempty"`
+	WorkflowIDConflictPolicy            *WorkflowIDConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
+	Priority                            int32                     `json:"priority,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5978,6 +6145,14 @@ func (v *StartWorkflowExecutionRequest) GetWorkflowID() (o string) {
 	return
 }
 
+// GetWorkflowType is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
 // GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
 	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
@@ -6026,6 +6201,14 @@ func (v *StartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o WorkflowID
 	return
 }
 
+// GetWorkflowIDConflictPolicy is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetWorkflowIDConflictPolicy() (o WorkflowIDConflictPolicy) {
+	if v != nil && v.WorkflowIDConflictPolicy != nil {
+		return *v.WorkflowIDConflictPolicy
+	}
+	return
+}
+
 // GetCronSchedule is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	if v != nil {
@@ -6034,6 +6217,14 @@ func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 // StartWorkflowExecutionResponse is an internal type (TBD...)
 type StartWorkflowExecutionResponse struct {
 	RunID string `json:"runId,omitempty"`
@@ -6047,6 +6238,31 @@ func (v *StartWorkflowExecutionResponse) GetRunID() (o string) {
 	return
 }
 
+// StartWorkflowExecutionAsyncRequest is an internal type (TBD...)
+type StartWorkflowExecutionAsyncRequest struct {
+	*StartWorkflowExecutionRequest
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *StartWorkflowExecutionAsyncRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.StartWorkflowExecutionRequest.GetDomain()
+	}
+	return
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *StartWorkflowExecutionAsyncRequest) GetWorkflowType() (o *WorkflowType) {
+	if v != nil {
+		return v.StartWorkflowExecutionRequest.GetWorkflowType()
+	}
+	return
+}
+
+// StartWorkflowExecutionAsyncResponse is an internal type (TBD...)
+type StartWorkflowExecutionAsyncResponse struct {
+}
+
 // RestartWorkflowExecutionResponse is an internal type (TBD...)
 type RestartWorkflowExecutionResponse struct {
 	RunID string `json:"runId,omitempty"`
@@ -6180,6 +6396,37 @@ type TaskListMetadata struct {
 	MaxTasksPerSecond *float64 `json:"maxTasksPerSecond,omitempty"`
 }
 
+// TaskListPartitionConfig is an internal type (TBD...)
+type TaskListPartitionConfig struct {
+	Version            int64 `json:"version,omitempty"`
+	NumReadPartitions  int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions int32 `json:"numWritePartitions,omitempty"`
+}
+
+// GetVersion is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+// GetNumReadPartitions is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetNumReadPartitions() (o int32) {
+	if v != nil {
+		return v.NumReadPartitions
+	}
+	return
+}
+
+// GetNumWritePartitions is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetNumWritePartitions() (o int32) {
+	if v != nil {
+		return v.NumWritePartitions
+	}
+	return
+}
+
 // TaskListPartitionMetadata is an internal type (TBD...)
 type TaskListPartitionMetadata struct {
 	Key           string `json:"key,omitempty"`
@@ -6204,11 +6451,12 @@ func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
 
 // TaskListStatus is an internal type (TBD...)
 type TaskListStatus struct {
-	BacklogCountHint int64        `json:"backlogCountHint,omitempty"`
-	ReadLevel        int64        `json:"readLevel,omitempty"`
-	AckLevel         int64        `json:"ackLevel,omitempty"`
-	RatePerSecond    float64      `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint           int64           `json:"backlogCountHint,omitempty"`
+	ReadLevel                  int64           `json:"readLevel,omitempty"`
+	AckLevel                   int64           `json:"ackLevel,omitempty"`
+	RatePerSecond              float64         `json:"ratePerSecond,omitempty"`
+	TaskIDBlock                *TaskIDBlock    `json:"taskIDBlock,omitempty"`
+	BacklogCountHintByPriority map[int32]int64 `json:"backlogCountHintByPriority,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -6251,6 +6499,14 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetBacklogCountHintByPriority is an internal getter (TBD...)
+func (v *TaskListStatus) GetBacklogCountHintByPriority() (o map[int32]int64) {
+	if v != nil {
+		return v.BacklogCountHintByPriority
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -6492,6 +6748,46 @@ type TransientDecisionInfo struct {
 	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
 }
 
+// UnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type UnpauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // UpdateDomainRequest is an internal type (TBD...)
 type UpdateDomainRequest struct {
 	Name                                   string                             `json:"name,omitempty"`
@@ -6577,6 +6873,95 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// UpdateWorkflowExecutionRequest is an internal type (TBD...)
+type UpdateWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	UpdateID          string             `json:"updateId,omitempty"`
+	UpdateName        string             `json:"updateName,omitempty"`
+	Input             []byte             `json:"input,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UpdateWorkflowExecutionResponse is an internal type (TBD...)
+type UpdateWorkflowExecutionResponse struct {
+	Result         []byte  `json:"result,omitempty"`
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetFailureMessage is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetFailureMessage() (o string) {
+	if v != nil && v.FailureMessage != nil {
+		return *v.FailureMessage
+	}
+	return
+}
+
 // UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
@@ -6911,6 +7296,7 @@ type WorkflowExecutionInfo struct {
 	TaskList          string                        `json:"taskList,omitempty"`
 	IsCron            bool                          `json:"isCron,omitempty"`
 	UpdateTime        *int64                        `json:"updateTime,omitempty"`
+	Paused            bool                          `json:"paused,omitempty"`
 }
 
 // GetExecution is an internal getter (TBD...)
@@ -6969,6 +7355,14 @@ func (v *WorkflowExecutionInfo) GetUpdateTime() (o int64) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // GetSearchAttributes is an internal getter (TBD...)
 func (v *WorkflowExecutionInfo) GetSearchAttributes() (o *SearchAttributes) {
 	if v != nil && v.SearchAttributes != nil {
@@ -6977,6 +7371,28 @@ func (v *WorkflowExecutionInfo) GetSearchAttributes() (o *SearchAttributes) {
 	return
 }
 
+// WorkflowExecutionPausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
@@ -7038,6 +7454,8 @@ type WorkflowExecutionStartedEventAttributes struct {
 	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
 	JitterStartSeconds                  *int32                  `json:"jitterStartSeconds,omitempty"`
+	IsolationGroup                      string                  `json:"isolationGroup,omitempty"`
+	Priority                            int32                   `json:"priority,omitempty"`
 }
 
 // GetParentWorkflowDomain is an internal getter (TBD...)
@@ -7167,6 +7585,22 @@ func (v *WorkflowExecutionStartedEventAttributes) GetPrevAutoResetPoints() (o *R
 	return
 }
 
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 // WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   string `json:"reason,omitempty"`
@@ -7190,6 +7624,126 @@ func (v *WorkflowExecutionTerminatedEventAttributes) GetIdentity() (o string) {
 	return
 }
 
+// WorkflowExecutionUnpausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// WorkflowExecutionUpdateCompletedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateCompletedEventAttributes struct {
+	UpdateID                     string  `json:"updateId,omitempty"`
+	RequestedEventID             int64   `json:"requestedEventId,omitempty"`
+	DecisionTaskCompletedEventID int64   `json:"decisionTaskCompletedEventId,omitempty"`
+	Result                       []byte  `json:"result,omitempty"`
+	FailureMessage               *string `json:"failureMessage,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetRequestedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetRequestedEventID() (o int64) {
+	if v != nil {
+		return v.RequestedEventID
+	}
+	return
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetFailureMessage is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetFailureMessage() (o string) {
+	if v != nil && v.FailureMessage != nil {
+		return *v.FailureMessage
+	}
+	return
+}
+
+// WorkflowExecutionUpdateRequestedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateRequestedEventAttributes struct {
+	UpdateID   string `json:"updateId,omitempty"`
+	UpdateName string `json:"updateName,omitempty"`
+	Input      []byte `json:"input,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // WorkflowExecutionTimedOutEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTimedOutEventAttributes struct {
 	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
@@ -7203,6 +7757,66 @@ func (v *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() (o TimeoutTy
 	return
 }
 
+// WorkflowIDConflictPolicy defines how to handle a start request when a workflow
+// with the same ID is still running. When unset, StartWorkflowExecution fails and
+// SignalWithStartWorkflowExecution signals the running workflow.
+type WorkflowIDConflictPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e WorkflowIDConflictPolicy) Ptr() *WorkflowIDConflictPolicy {
+	return &e
+}
+
+// String returns a readable string representation of WorkflowIDConflictPolicy.
+func (e WorkflowIDConflictPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "Fail"
+	case 1:
+		return "UseExisting"
+	case 2:
+		return "TerminateExisting"
+	}
+	return fmt.Sprintf("WorkflowIDConflictPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *WorkflowIDConflictPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "FAIL":
+		*e = WorkflowIDConflictPolicyFail
+		return nil
+	case "USEEXISTING":
+		*e = WorkflowIDConflictPolicyUseExisting
+		return nil
+	case "TERMINATEEXISTING":
+		*e = WorkflowIDConflictPolicyTerminateExisting
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowIDConflictPolicy", err)
+		}
+		*e = WorkflowIDConflictPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes WorkflowIDConflictPolicy to text.
+func (e WorkflowIDConflictPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// WorkflowIDConflictPolicyFail is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyFail WorkflowIDConflictPolicy = iota
+	// WorkflowIDConflictPolicyUseExisting is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyUseExisting
+	// WorkflowIDConflictPolicyTerminateExisting is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyTerminateExisting
+)
+
 // WorkflowIDReusePolicy is an internal type (TBD...)
 type WorkflowIDReusePolicy int32
 
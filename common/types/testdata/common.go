@@ -222,16 +222,22 @@ var (
 		&PollerInfo,
 	}
 	TaskListStatus = types.TaskListStatus{
-		BacklogCountHint: BacklogCountHint,
-		ReadLevel:        ReadLevel,
-		AckLevel:         AckLevel,
-		RatePerSecond:    RatePerSecond,
-		TaskIDBlock:      &TaskIDBlock,
+		BacklogCountHint:           BacklogCountHint,
+		ReadLevel:                  ReadLevel,
+		AckLevel:                   AckLevel,
+		RatePerSecond:              RatePerSecond,
+		TaskIDBlock:                &TaskIDBlock,
+		BacklogCountHintByPriority: map[int32]int64{0: BacklogCountHint - 1, 2: 1},
 	}
 	TaskIDBlock = types.TaskIDBlock{
 		StartID: 551,
 		EndID:   559,
 	}
+	TaskListPartitionConfig = types.TaskListPartitionConfig{
+		Version:            3,
+		NumReadPartitions:  4,
+		NumWritePartitions: 2,
+	}
 	TaskListPartitionMetadata = types.TaskListPartitionMetadata{
 		Key:           "Key",
 		OwnerHostName: "HostName",
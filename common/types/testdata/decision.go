@@ -154,6 +154,7 @@ var (
 		RetryPolicy:                   &RetryPolicy,
 		Header:                        &Header,
 		RequestLocalDispatch:          true,
+		Priority:                      TaskPriority,
 	}
 	SignalExternalWorkflowExecutionDecisionAttributes = types.SignalExternalWorkflowExecutionDecisionAttributes{
 		Domain:            DomainName,
@@ -245,6 +245,22 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_WorkflowExecutionUpdateRequested = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateRequested.Ptr()
+		e.WorkflowExecutionUpdateRequestedEventAttributes = &WorkflowExecutionUpdateRequestedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateCompleted = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateCompleted.Ptr()
+		e.WorkflowExecutionUpdateCompletedEventAttributes = &WorkflowExecutionUpdateCompletedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		e.WorkflowExecutionPausedEventAttributes = &WorkflowExecutionPausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		e.WorkflowExecutionUnpausedEventAttributes = &WorkflowExecutionUnpausedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -273,6 +289,7 @@ var (
 		SearchAttributes:                    &SearchAttributes,
 		PrevAutoResetPoints:                 &ResetPoints,
 		Header:                              &Header,
+		Priority:                            TaskPriority,
 	}
 	WorkflowExecutionCompletedEventAttributes = types.WorkflowExecutionCompletedEventAttributes{
 		Result:                       Payload1,
@@ -338,6 +355,7 @@ var (
 		DecisionTaskCompletedEventID:  EventID1,
 		RetryPolicy:                   &RetryPolicy,
 		Header:                        &Header,
+		Priority:                      TaskPriority,
 	}
 	ActivityTaskStartedEventAttributes = types.ActivityTaskStartedEventAttributes{
 		ScheduledEventID:   EventID1,
@@ -569,6 +587,27 @@ var (
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
 	}
+	WorkflowExecutionUpdateRequestedEventAttributes = types.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   RequestID,
+		UpdateName: "UpdateName",
+		Input:      Payload1,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	WorkflowExecutionUpdateCompletedEventAttributes = types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     RequestID,
+		RequestedEventID:             EventID1,
+		DecisionTaskCompletedEventID: EventID2,
+		Result:                       Payload1,
+	}
+	WorkflowExecutionPausedEventAttributes = types.WorkflowExecutionPausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
+	WorkflowExecutionUnpausedEventAttributes = types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
 	GetFailoverInfoRequest = types.GetFailoverInfoRequest{
 		DomainID: uuid.NewUUID().String(),
 	}
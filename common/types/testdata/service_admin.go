@@ -116,6 +116,34 @@ var (
 		History: HistoryCountDLQMessagesResponse.Entries,
 		Domain:  123456,
 	}
+	AdminListWorkflowTasksRequest = types.ListWorkflowTasksRequest{
+		Domain:    DomainName,
+		Execution: &WorkflowExecution,
+	}
+	AdminListWorkflowTasksResponse = types.ListWorkflowTasksResponse{
+		ShardID: ShardID,
+		Tasks: []*types.QueueTaskInfo{
+			{
+				Type:                common.Int32Ptr(QueueType),
+				TaskType:            3,
+				TaskID:              TaskID,
+				VisibilityTimestamp: &Timestamp1,
+				ClusterName:         ClusterName1,
+				State:               types.QueueTaskStateBackoff.Ptr(),
+				Loaded:              true,
+				Attempt:             Attempt,
+				RedispatchTimestamp: &Timestamp2,
+			},
+			{
+				Type:                common.Int32Ptr(QueueType),
+				TaskID:              TaskID + 1,
+				VisibilityTimestamp: &Timestamp1,
+				ClusterName:         ClusterName1,
+				State:               types.QueueTaskStatePending.Ptr(),
+			},
+		},
+		Truncated: true,
+	}
 	AdminMergeDLQMessagesRequest = types.MergeDLQMessagesRequest{
 		Type:                  types.DLQTypeDomain.Ptr(),
 		ShardID:               ShardID,
@@ -178,6 +206,13 @@ var (
 		ClusterName: ClusterName1,
 		Type:        common.Int32Ptr(QueueType),
 	}
+	AdminRetryTaskRequest = types.RetryTaskRequest{
+		ShardID:             ShardID,
+		Type:                common.Int32Ptr(QueueType),
+		TaskID:              TaskID,
+		VisibilityTimestamp: &Timestamp1,
+		ClusterName:         ClusterName1,
+	}
 	AdminGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	AdminGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	AdminRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
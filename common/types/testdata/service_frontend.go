@@ -320,11 +320,16 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		Priority:                            TaskPriority,
 	}
 	StartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
 	}
-	SignalWorkflowExecutionRequest = types.SignalWorkflowExecutionRequest{
+	StartWorkflowExecutionAsyncRequest = types.StartWorkflowExecutionAsyncRequest{
+		StartWorkflowExecutionRequest: &StartWorkflowExecutionRequest,
+	}
+	StartWorkflowExecutionAsyncResponse = types.StartWorkflowExecutionAsyncResponse{}
+	SignalWorkflowExecutionRequest      = types.SignalWorkflowExecutionRequest{
 		Domain:            DomainName,
 		WorkflowExecution: &WorkflowExecution,
 		SignalName:        SignalName,
@@ -353,6 +358,23 @@ var (
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
 	}
+	SignalWithStartWorkflowExecutionAsyncRequest = types.SignalWithStartWorkflowExecutionAsyncRequest{
+		SignalWithStartWorkflowExecutionRequest: &SignalWithStartWorkflowExecutionRequest,
+	}
+	SignalWithStartWorkflowExecutionAsyncResponse = types.SignalWithStartWorkflowExecutionAsyncResponse{}
+	UpdateWorkflowExecutionRequest                = types.UpdateWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		UpdateID:          RequestID,
+		UpdateName:        "UpdateName",
+		Input:             Payload1,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	UpdateWorkflowExecutionResponse = types.UpdateWorkflowExecutionResponse{
+		Result:         Payload1,
+		FailureMessage: &FailureReason,
+	}
 	ResetWorkflowExecutionRequest = types.ResetWorkflowExecutionRequest{
 		Domain:                DomainName,
 		WorkflowExecution:     &WorkflowExecution,
@@ -197,6 +197,7 @@ var (
 		ChildWorkflowOnly:         true,
 	}
 	HistoryResetQueueRequest          = AdminResetQueueRequest
+	HistoryRetryTaskRequest           = AdminRetryTaskRequest
 	HistoryResetStickyTaskListRequest = types.HistoryResetStickyTaskListRequest{
 		DomainUUID: DomainID,
 		Execution:  &WorkflowExecution,
@@ -240,6 +241,7 @@ var (
 	HistorySignalWithStartWorkflowExecutionRequest = types.HistorySignalWithStartWorkflowExecutionRequest{
 		DomainUUID:             DomainID,
 		SignalWithStartRequest: &SignalWithStartWorkflowExecutionRequest,
+		IsolationGroup:         IsolationGroup,
 	}
 	HistorySignalWithStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -261,6 +263,7 @@ var (
 		ContinuedFailureDetails:         FailureDetails,
 		LastCompletionResult:            Payload1,
 		FirstDecisionTaskBackoffSeconds: &Duration1,
+		IsolationGroup:                  IsolationGroup,
 	}
 	HistoryStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -293,6 +296,34 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryListWorkflowTasksRequest = types.HistoryListWorkflowTasksRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminListWorkflowTasksRequest,
+	}
+	HistoryListWorkflowTasksResponse     = AdminListWorkflowTasksResponse
+	HistoryPauseWorkflowExecutionRequest = types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: DomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            DomainName,
+			WorkflowExecution: &WorkflowExecution,
+			Reason:            Reason,
+			Identity:          Identity,
+		},
+	}
+	HistoryUnpauseWorkflowExecutionRequest = types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: DomainID,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            DomainName,
+			WorkflowExecution: &WorkflowExecution,
+			Reason:            Reason,
+			Identity:          Identity,
+		},
+	}
+	HistoryUpdateWorkflowExecutionRequest = types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		UpdateRequest: &UpdateWorkflowExecutionRequest,
+	}
+	HistoryUpdateWorkflowExecutionResponse           = UpdateWorkflowExecutionResponse
 	HistoryGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
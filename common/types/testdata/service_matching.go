@@ -26,8 +26,11 @@ import (
 )
 
 const (
-	ForwardedFrom = "ForwardedFrom"
-	PollerID      = "PollerID"
+	ForwardedFrom  = "ForwardedFrom"
+	IsolationGroup = "IsolationGroup"
+	PollerID       = "PollerID"
+	TaskPriority   = 2
+	FairnessKey    = "FairnessKey"
 )
 
 var (
@@ -40,6 +43,9 @@ var (
 		ScheduleToStartTimeoutSeconds: &Duration1,
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
+		IsolationGroup:                IsolationGroup,
+		Priority:                      TaskPriority,
+		FairnessKey:                   FairnessKey,
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -49,6 +55,9 @@ var (
 		ScheduleToStartTimeoutSeconds: &Duration1,
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
+		IsolationGroup:                IsolationGroup,
+		Priority:                      TaskPriority,
+		FairnessKey:                   FairnessKey,
 	}
 	MatchingCancelOutstandingPollRequest = types.CancelOutstandingPollRequest{
 		DomainUUID:   DomainID,
@@ -73,10 +82,11 @@ var (
 		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
 	}
 	MatchingPollForActivityTaskRequest = types.MatchingPollForActivityTaskRequest{
-		DomainUUID:    DomainID,
-		PollerID:      PollerID,
-		PollRequest:   &PollForActivityTaskRequest,
-		ForwardedFrom: ForwardedFrom,
+		DomainUUID:     DomainID,
+		PollerID:       PollerID,
+		PollRequest:    &PollForActivityTaskRequest,
+		ForwardedFrom:  ForwardedFrom,
+		IsolationGroup: IsolationGroup,
 	}
 	MatchingPollForActivityTaskResponse = types.PollForActivityTaskResponse{
 		TaskToken:                       TaskToken,
@@ -97,10 +107,11 @@ var (
 		Header:                          &Header,
 	}
 	MatchingPollForDecisionTaskRequest = types.MatchingPollForDecisionTaskRequest{
-		DomainUUID:    DomainID,
-		PollerID:      PollerID,
-		PollRequest:   &PollForDecisionTaskRequest,
-		ForwardedFrom: ForwardedFrom,
+		DomainUUID:     DomainID,
+		PollerID:       PollerID,
+		PollRequest:    &PollForDecisionTaskRequest,
+		ForwardedFrom:  ForwardedFrom,
+		IsolationGroup: IsolationGroup,
 	}
 	MatchingPollForDecisionTaskResponse = types.MatchingPollForDecisionTaskResponse{
 		TaskToken:                 TaskToken,
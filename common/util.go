@@ -776,6 +776,10 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		if event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes != nil {
 			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
 		}
+	case types.EventTypeWorkflowExecutionUpdateRequested:
+		res += len(event.WorkflowExecutionUpdateRequestedEventAttributes.Input)
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		res += len(event.WorkflowExecutionUpdateCompletedEventAttributes.Result)
 	}
 	return uint64(res)
 }
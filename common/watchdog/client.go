@@ -39,6 +39,7 @@ type (
 	// Client is used to send request to processor workflow
 	Client interface {
 		ReportCorruptWorkflow(domainName string, workflowID string, runID string) error
+		ReportChecksumFailure(report ChecksumFailureReport) error
 	}
 
 	clientImpl struct {
@@ -100,3 +101,18 @@ func (c *clientImpl) ReportCorruptWorkflow(
 	}
 	return c.cadenceClient.SignalWorkflow(signalCtx, WatchdogWFID, "", CorruptWorkflowWatchdogChannelName, request)
 }
+
+func (c *clientImpl) ReportChecksumFailure(
+	report ChecksumFailureReport,
+) error {
+	processedID := "checksum-" + c.getProcessedID(report.Workflow.GetWorkflowID(), report.Workflow.GetRunID())
+	if c.processed.Get(processedID) != nil {
+		// We already reported this workflow recently
+		return nil
+	}
+	c.processed.Put(processedID, struct{}{})
+
+	signalCtx, cancel := context.WithTimeout(context.Background(), SignalTimeout)
+	defer cancel()
+	return c.cadenceClient.SignalWorkflow(signalCtx, WatchdogWFID, "", ChecksumFailureWatchdogChannelName, report)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package watchdog holds what the services share with the watchdog workflow of the worker service
+package watchdog
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// WatchdogWFID is the workflow ID of the watchdog workflow
+	WatchdogWFID = "cadence-sys-watchdog"
+
+	// signals
+	CorruptWorkflowWatchdogChannelName = "CorruptWorkflowWatchdogChannelName"
+	ChecksumFailureWatchdogChannelName = "ChecksumFailureWatchdogChannelName"
+
+	// queries
+	ChecksumFailuresQueryType = "checksum-failures"
+)
+
+type (
+	// CorruptWFRequest is sent when a workflow is suspected to be corrupted
+	CorruptWFRequest struct {
+		Workflow   types.WorkflowExecution
+		DomainName string
+	}
+
+	// ChecksumFailureReport is sent by history when a mutable state fails checksum verification
+	// and describes the differences between the loaded mutable state and the one rebuilt from history
+	ChecksumFailureReport struct {
+		Workflow     types.WorkflowExecution
+		DomainName   string
+		ShardID      int
+		Mismatch     string
+		Diffs        []ChecksumFieldDiff
+		Error        string
+		Repaired     bool
+		ReportedTime time.Time
+	}
+
+	// ChecksumFieldDiff is a checksum payload field which differs after rebuilding mutable state from history
+	ChecksumFieldDiff struct {
+		Field  string
+		Before string
+		After  string
+	}
+)
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package esutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	// v8Client talks to ElasticSearch 8 and OpenSearch 2 with their REST API
+	v8Client struct {
+		url    string
+		client *http.Client
+	}
+
+	acknowledgedResponse struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+)
+
+func newV8Client(url string) (*v8Client, error) {
+	return &v8Client{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{},
+	}, nil
+}
+
+func (es *v8Client) PutIndexTemplate(s suite.Suite, templateConfigFile, templateName string) {
+	// This function is used exclusively in tests. Excluding it from security checks.
+	// #nosec
+	template, err := ioutil.ReadFile(templateConfigFile)
+	s.Require().NoError(err)
+	var resp acknowledgedResponse
+	s.Require().NoError(es.do(http.MethodPut, "/_template/"+templateName, template, &resp))
+	s.Require().True(resp.Acknowledged)
+}
+
+func (es *v8Client) CreateIndex(s suite.Suite, indexName string) {
+	err := es.do(http.MethodHead, "/"+indexName, nil, nil)
+	if err == nil {
+		var resp acknowledgedResponse
+		s.Require().NoError(es.do(http.MethodDelete, "/"+indexName, nil, &resp))
+		s.Require().True(resp.Acknowledged)
+	}
+
+	var resp acknowledgedResponse
+	s.Require().NoError(es.do(http.MethodPut, "/"+indexName, nil, &resp))
+	s.Require().True(resp.Acknowledged)
+}
+
+func (es *v8Client) DeleteIndex(s suite.Suite, indexName string) {
+	var resp acknowledgedResponse
+	err := es.do(http.MethodDelete, "/"+indexName, nil, &resp)
+	s.Nil(err)
+	s.True(resp.Acknowledged)
+}
+
+func (es *v8Client) PutMaxResultWindow(indexName string, maxResultWindow int) error {
+	body := []byte(fmt.Sprintf(`{"max_result_window" : %d}`, maxResultWindow))
+	return es.do(http.MethodPut, "/"+indexName+"/_settings", body, nil)
+}
+
+func (es *v8Client) GetMaxResultWindow(indexName string) (string, error) {
+	var settings map[string]struct {
+		Settings struct {
+			Index struct {
+				MaxResultWindow string `json:"max_result_window"`
+			} `json:"index"`
+		} `json:"settings"`
+	}
+	if err := es.do(http.MethodGet, "/"+indexName+"/_settings", nil, &settings); err != nil {
+		return "", err
+	}
+	return settings[indexName].Settings.Index.MaxResultWindow, nil
+}
+
+func (es *v8Client) do(method, path string, body []byte, result interface{}) error {
+	ctx := createContext()
+	req, err := http.NewRequestWithContext(ctx, method, es.url+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := es.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("request %v %v failed with status %v: %s", method, path, resp.StatusCode, data)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(data, result)
+}
@@ -48,6 +48,8 @@ func CreateESClient(s suite.Suite, url string, version string) ESClient {
 		client, err = newV6Client(url)
 	case "v7":
 		client, err = newV7Client(url)
+	case "v8", "os2":
+		client, err = newV8Client(url)
 	default:
 		s.Fail("not supported ES version")
 	}
@@ -29,12 +29,14 @@ var TestFlags struct {
 	FrontendAddr          string
 	PersistenceType       string
 	SQLPluginName         string
+	NoSQLPluginName       string
 	TestClusterConfigFile string
 }
 
 func init() {
 	flag.StringVar(&TestFlags.FrontendAddr, "frontendAddress", "", "host:port for cadence frontend service")
 	flag.StringVar(&TestFlags.PersistenceType, "persistenceType", "cassandra", "type of persistence store - [cassandra or sql]")
-	flag.StringVar(&TestFlags.SQLPluginName, "sqlPluginName", "mysql", "type of sql store - [mysql or postgres]")
+	flag.StringVar(&TestFlags.SQLPluginName, "sqlPluginName", "mysql", "type of sql store - [mysql, postgres or sqlite]")
+	flag.StringVar(&TestFlags.NoSQLPluginName, "nosqlPluginName", "cassandra", "type of nosql store when persistenceType is cassandra - [cassandra or memory]")
 	flag.StringVar(&TestFlags.TestClusterConfigFile, "TestClusterConfigFile", "", "test cluster config file location")
 }
@@ -54,10 +54,12 @@ import (
 
 	// the import is a test dependency
 	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/memory"
 	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
 )
 
 type (
@@ -203,23 +205,30 @@ func NewPersistenceTestCluster(t *testing.T, clusterConfig *TestClusterConfig) t
 
 	var testCluster testcluster.PersistenceTestCluster
 	if TestFlags.PersistenceType == config.StoreTypeCassandra {
-		// TODO refactor to support other NoSQL
 		ops := clusterConfig.Persistence
-		ops.DBPluginName = "cassandra"
-		testflags.RequireCassandra(t)
+		ops.DBPluginName = TestFlags.NoSQLPluginName
+		if ops.DBPluginName != memory.PluginName {
+			// the in-memory plugin doesn't need any external database
+			testflags.RequireCassandra(t)
+		}
 		testCluster = nosql.NewTestCluster(ops.DBPluginName, ops.DBName, ops.DBUsername, ops.DBPassword, ops.DBHost, ops.DBPort, ops.ProtoVersion, "")
 	} else if TestFlags.PersistenceType == config.StoreTypeSQL {
 		var ops *persistencetests.TestBaseOptions
+		dbName := clusterConfig.Persistence.DBName
 		if TestFlags.SQLPluginName == mysql.PluginName {
 			testflags.RequireMySQL(t)
 			ops = mysql.GetTestClusterOption()
 		} else if TestFlags.SQLPluginName == postgres.PluginName {
 			testflags.RequirePostgres(t)
 			ops = postgres.GetTestClusterOption()
+		} else if TestFlags.SQLPluginName == sqlite.PluginName {
+			ops = sqlite.GetTestClusterOption()
+			// sqlite is embedded, the database name is the path to a temporary database file
+			dbName = ops.DBName
 		} else {
 			panic("not supported plugin " + TestFlags.SQLPluginName)
 		}
-		testCluster = sql.NewTestCluster(TestFlags.SQLPluginName, clusterConfig.Persistence.DBName, ops.DBUsername, ops.DBPassword, ops.DBHost, ops.DBPort, ops.SchemaDir)
+		testCluster = sql.NewTestCluster(TestFlags.SQLPluginName, dbName, ops.DBUsername, ops.DBPassword, ops.DBHost, ops.DBPort, ops.SchemaDir)
 	} else {
 		panic("not supported storage type" + TestFlags.PersistenceType)
 	}
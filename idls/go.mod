@@ -0,0 +1,9 @@
+module github.com/uber/cadence-idl
+
+go 1.16
+
+require (
+	github.com/gogo/protobuf v1.3.2
+	go.uber.org/fx v1.10.0
+	go.uber.org/yarpc v1.55.0
+)
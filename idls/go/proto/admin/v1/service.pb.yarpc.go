@@ -0,0 +1,2823 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/admin/v1/service.proto
+
+package adminv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// AdminAPIYARPCClient is the YARPC client-side interface for the AdminAPI service.
+type AdminAPIYARPCClient interface {
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
+	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest, ...yarpc.CallOption) (*DescribeHistoryHostResponse, error)
+	DescribeShardDistribution(context.Context, *DescribeShardDistributionRequest, ...yarpc.CallOption) (*DescribeShardDistributionResponse, error)
+	CloseShard(context.Context, *CloseShardRequest, ...yarpc.CallOption) (*CloseShardResponse, error)
+	RemoveTask(context.Context, *RemoveTaskRequest, ...yarpc.CallOption) (*RemoveTaskResponse, error)
+	ResetQueue(context.Context, *ResetQueueRequest, ...yarpc.CallOption) (*ResetQueueResponse, error)
+	DescribeQueue(context.Context, *DescribeQueueRequest, ...yarpc.CallOption) (*DescribeQueueResponse, error)
+	ListWorkflowTasks(context.Context, *ListWorkflowTasksRequest, ...yarpc.CallOption) (*ListWorkflowTasksResponse, error)
+	RetryTask(context.Context, *RetryTaskRequest, ...yarpc.CallOption) (*RetryTaskResponse, error)
+	GetWorkflowExecutionRawHistoryV2(context.Context, *GetWorkflowExecutionRawHistoryV2Request, ...yarpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error)
+	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest, ...yarpc.CallOption) (*GetReplicationMessagesResponse, error)
+	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
+	GetDomainReplicationMessages(context.Context, *GetDomainReplicationMessagesRequest, ...yarpc.CallOption) (*GetDomainReplicationMessagesResponse, error)
+	ReapplyEvents(context.Context, *ReapplyEventsRequest, ...yarpc.CallOption) (*ReapplyEventsResponse, error)
+	AddSearchAttribute(context.Context, *AddSearchAttributeRequest, ...yarpc.CallOption) (*AddSearchAttributeResponse, error)
+	DescribeCluster(context.Context, *DescribeClusterRequest, ...yarpc.CallOption) (*DescribeClusterResponse, error)
+	CountDLQMessages(context.Context, *CountDLQMessagesRequest, ...yarpc.CallOption) (*CountDLQMessagesResponse, error)
+	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest, ...yarpc.CallOption) (*ReadDLQMessagesResponse, error)
+	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest, ...yarpc.CallOption) (*PurgeDLQMessagesResponse, error)
+	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest, ...yarpc.CallOption) (*MergeDLQMessagesResponse, error)
+	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest, ...yarpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest, ...yarpc.CallOption) (*ResendReplicationTasksResponse, error)
+	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest, ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error)
+	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error)
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest, ...yarpc.CallOption) (*GetDynamicConfigResponse, error)
+	UpdateDynamicConfig(context.Context, *UpdateDynamicConfigRequest, ...yarpc.CallOption) (*UpdateDynamicConfigResponse, error)
+	RestoreDynamicConfig(context.Context, *RestoreDynamicConfigRequest, ...yarpc.CallOption) (*RestoreDynamicConfigResponse, error)
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest, ...yarpc.CallOption) (*ListDynamicConfigResponse, error)
+	DeleteWorkflow(context.Context, *DeleteWorkflowRequest, ...yarpc.CallOption) (*DeleteWorkflowResponse, error)
+	MaintainCorruptWorkflow(context.Context, *MaintainCorruptWorkflowRequest, ...yarpc.CallOption) (*MaintainCorruptWorkflowResponse, error)
+}
+
+func newAdminAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) AdminAPIYARPCClient {
+	return &_AdminAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.admin.v1.AdminAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewAdminAPIYARPCClient builds a new YARPC client for the AdminAPI service.
+func NewAdminAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) AdminAPIYARPCClient {
+	return newAdminAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// AdminAPIYARPCServer is the YARPC server-side interface for the AdminAPI service.
+type AdminAPIYARPCServer interface {
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
+	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
+	DescribeShardDistribution(context.Context, *DescribeShardDistributionRequest) (*DescribeShardDistributionResponse, error)
+	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
+	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
+	ResetQueue(context.Context, *ResetQueueRequest) (*ResetQueueResponse, error)
+	DescribeQueue(context.Context, *DescribeQueueRequest) (*DescribeQueueResponse, error)
+	ListWorkflowTasks(context.Context, *ListWorkflowTasksRequest) (*ListWorkflowTasksResponse, error)
+	RetryTask(context.Context, *RetryTaskRequest) (*RetryTaskResponse, error)
+	GetWorkflowExecutionRawHistoryV2(context.Context, *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error)
+	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
+	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
+	GetDomainReplicationMessages(context.Context, *GetDomainReplicationMessagesRequest) (*GetDomainReplicationMessagesResponse, error)
+	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
+	AddSearchAttribute(context.Context, *AddSearchAttributeRequest) (*AddSearchAttributeResponse, error)
+	DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error)
+	CountDLQMessages(context.Context, *CountDLQMessagesRequest) (*CountDLQMessagesResponse, error)
+	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest) (*ReadDLQMessagesResponse, error)
+	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error)
+	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
+	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest) (*GetCrossClusterTasksResponse, error)
+	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest) (*RespondCrossClusterTasksCompletedResponse, error)
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	UpdateDynamicConfig(context.Context, *UpdateDynamicConfigRequest) (*UpdateDynamicConfigResponse, error)
+	RestoreDynamicConfig(context.Context, *RestoreDynamicConfigRequest) (*RestoreDynamicConfigResponse, error)
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+	DeleteWorkflow(context.Context, *DeleteWorkflowRequest) (*DeleteWorkflowResponse, error)
+	MaintainCorruptWorkflow(context.Context, *MaintainCorruptWorkflowRequest) (*MaintainCorruptWorkflowResponse, error)
+}
+
+type buildAdminAPIYARPCProceduresParams struct {
+	Server      AdminAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildAdminAPIYARPCProcedures(params buildAdminAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_AdminAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.admin.v1.AdminAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "DescribeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeWorkflowExecution,
+							NewRequest:  newAdminAPIServiceDescribeWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeHistoryHost",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeHistoryHost,
+							NewRequest:  newAdminAPIServiceDescribeHistoryHostYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeShardDistribution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeShardDistribution,
+							NewRequest:  newAdminAPIServiceDescribeShardDistributionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "CloseShard",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.CloseShard,
+							NewRequest:  newAdminAPIServiceCloseShardYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RemoveTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RemoveTask,
+							NewRequest:  newAdminAPIServiceRemoveTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetQueue",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetQueue,
+							NewRequest:  newAdminAPIServiceResetQueueYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeQueue",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeQueue,
+							NewRequest:  newAdminAPIServiceDescribeQueueYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListWorkflowTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListWorkflowTasks,
+							NewRequest:  newAdminAPIServiceListWorkflowTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RetryTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RetryTask,
+							NewRequest:  newAdminAPIServiceRetryTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkflowExecutionRawHistoryV2",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetWorkflowExecutionRawHistoryV2,
+							NewRequest:  newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetReplicationMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetReplicationMessages,
+							NewRequest:  newAdminAPIServiceGetReplicationMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDLQReplicationMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDLQReplicationMessages,
+							NewRequest:  newAdminAPIServiceGetDLQReplicationMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDomainReplicationMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainReplicationMessages,
+							NewRequest:  newAdminAPIServiceGetDomainReplicationMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ReapplyEvents",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ReapplyEvents,
+							NewRequest:  newAdminAPIServiceReapplyEventsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "AddSearchAttribute",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.AddSearchAttribute,
+							NewRequest:  newAdminAPIServiceAddSearchAttributeYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeCluster",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeCluster,
+							NewRequest:  newAdminAPIServiceDescribeClusterYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "CountDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.CountDLQMessages,
+							NewRequest:  newAdminAPIServiceCountDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ReadDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ReadDLQMessages,
+							NewRequest:  newAdminAPIServiceReadDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PurgeDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PurgeDLQMessages,
+							NewRequest:  newAdminAPIServicePurgeDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MergeDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MergeDLQMessages,
+							NewRequest:  newAdminAPIServiceMergeDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RefreshWorkflowTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RefreshWorkflowTasks,
+							NewRequest:  newAdminAPIServiceRefreshWorkflowTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResendReplicationTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResendReplicationTasks,
+							NewRequest:  newAdminAPIServiceResendReplicationTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetCrossClusterTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetCrossClusterTasks,
+							NewRequest:  newAdminAPIServiceGetCrossClusterTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondCrossClusterTasksCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondCrossClusterTasksCompleted,
+							NewRequest:  newAdminAPIServiceRespondCrossClusterTasksCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDynamicConfig,
+							NewRequest:  newAdminAPIServiceGetDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDynamicConfig,
+							NewRequest:  newAdminAPIServiceUpdateDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RestoreDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RestoreDynamicConfig,
+							NewRequest:  newAdminAPIServiceRestoreDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListDynamicConfig,
+							NewRequest:  newAdminAPIServiceListDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeleteWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeleteWorkflow,
+							NewRequest:  newAdminAPIServiceDeleteWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MaintainCorruptWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MaintainCorruptWorkflow,
+							NewRequest:  newAdminAPIServiceMaintainCorruptWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildAdminAPIYARPCProcedures prepares an implementation of the AdminAPI service for YARPC registration.
+func BuildAdminAPIYARPCProcedures(server AdminAPIYARPCServer) []transport.Procedure {
+	return buildAdminAPIYARPCProcedures(buildAdminAPIYARPCProceduresParams{Server: server})
+}
+
+// FxAdminAPIYARPCClientParams defines the input
+// for NewFxAdminAPIYARPCClient. It provides the
+// paramaters to get a AdminAPIYARPCClient in an
+// Fx application.
+type FxAdminAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxAdminAPIYARPCClientResult defines the output
+// of NewFxAdminAPIYARPCClient. It provides a
+// AdminAPIYARPCClient to an Fx application.
+type FxAdminAPIYARPCClientResult struct {
+	fx.Out
+
+	Client AdminAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxAdminAPIYARPCClient provides a AdminAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//  fx.Provide(
+//    adminv1.NewFxAdminAPIYARPCClient("service-name"),
+//    ...
+//  )
+func NewFxAdminAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxAdminAPIYARPCClientParams) FxAdminAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxAdminAPIYARPCClientResult{
+			Client: newAdminAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxAdminAPIYARPCProceduresParams defines the input
+// for NewFxAdminAPIYARPCProcedures. It provides the
+// paramaters to get AdminAPIYARPCServer procedures in an
+// Fx application.
+type FxAdminAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      AdminAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxAdminAPIYARPCProceduresResult defines the output
+// of NewFxAdminAPIYARPCProcedures. It provides
+// AdminAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxAdminAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxAdminAPIYARPCProcedures provides AdminAPIYARPCServer procedures to an Fx application.
+// It expects a AdminAPIYARPCServer to be present in the container.
+//
+//  fx.Provide(
+//    adminv1.NewFxAdminAPIYARPCProcedures(),
+//    ...
+//  )
+func NewFxAdminAPIYARPCProcedures() interface{} {
+	return func(params FxAdminAPIYARPCProceduresParams) FxAdminAPIYARPCProceduresResult {
+		return FxAdminAPIYARPCProceduresResult{
+			Procedures: buildAdminAPIYARPCProcedures(buildAdminAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: reflection.ServerMeta{
+				ServiceName:     "uber.cadence.admin.v1.AdminAPI",
+				FileDescriptors: yarpcFileDescriptorClosurec6fc96d64a8b67fd,
+			},
+		}
+	}
+}
+
+type _AdminAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newAdminAPIServiceDescribeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeHistoryHost(ctx context.Context, request *DescribeHistoryHostRequest, options ...yarpc.CallOption) (*DescribeHistoryHostResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeHistoryHost", request, newAdminAPIServiceDescribeHistoryHostYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeHistoryHostResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeHistoryHostYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeShardDistribution(ctx context.Context, request *DescribeShardDistributionRequest, options ...yarpc.CallOption) (*DescribeShardDistributionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeShardDistribution", request, newAdminAPIServiceDescribeShardDistributionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeShardDistributionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeShardDistributionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) CloseShard(ctx context.Context, request *CloseShardRequest, options ...yarpc.CallOption) (*CloseShardResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CloseShard", request, newAdminAPIServiceCloseShardYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CloseShardResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceCloseShardYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RemoveTask(ctx context.Context, request *RemoveTaskRequest, options ...yarpc.CallOption) (*RemoveTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RemoveTask", request, newAdminAPIServiceRemoveTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RemoveTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRemoveTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ResetQueue(ctx context.Context, request *ResetQueueRequest, options ...yarpc.CallOption) (*ResetQueueResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetQueue", request, newAdminAPIServiceResetQueueYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetQueueResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceResetQueueYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeQueue(ctx context.Context, request *DescribeQueueRequest, options ...yarpc.CallOption) (*DescribeQueueResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeQueue", request, newAdminAPIServiceDescribeQueueYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeQueueResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeQueueYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ListWorkflowTasks(ctx context.Context, request *ListWorkflowTasksRequest, options ...yarpc.CallOption) (*ListWorkflowTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListWorkflowTasks", request, newAdminAPIServiceListWorkflowTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkflowTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceListWorkflowTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RetryTask(ctx context.Context, request *RetryTaskRequest, options ...yarpc.CallOption) (*RetryTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RetryTask", request, newAdminAPIServiceRetryTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RetryTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRetryTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *GetWorkflowExecutionRawHistoryV2Request, options ...yarpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetWorkflowExecutionRawHistoryV2", request, newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkflowExecutionRawHistoryV2Response)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetReplicationMessages(ctx context.Context, request *GetReplicationMessagesRequest, options ...yarpc.CallOption) (*GetReplicationMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetReplicationMessages", request, newAdminAPIServiceGetReplicationMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetReplicationMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetReplicationMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDLQReplicationMessages(ctx context.Context, request *GetDLQReplicationMessagesRequest, options ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDLQReplicationMessages", request, newAdminAPIServiceGetDLQReplicationMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDLQReplicationMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDLQReplicationMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDomainReplicationMessages(ctx context.Context, request *GetDomainReplicationMessagesRequest, options ...yarpc.CallOption) (*GetDomainReplicationMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainReplicationMessages", request, newAdminAPIServiceGetDomainReplicationMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainReplicationMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainReplicationMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ReapplyEvents(ctx context.Context, request *ReapplyEventsRequest, options ...yarpc.CallOption) (*ReapplyEventsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ReapplyEvents", request, newAdminAPIServiceReapplyEventsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ReapplyEventsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceReapplyEventsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) AddSearchAttribute(ctx context.Context, request *AddSearchAttributeRequest, options ...yarpc.CallOption) (*AddSearchAttributeResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "AddSearchAttribute", request, newAdminAPIServiceAddSearchAttributeYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*AddSearchAttributeResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceAddSearchAttributeYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeCluster(ctx context.Context, request *DescribeClusterRequest, options ...yarpc.CallOption) (*DescribeClusterResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeCluster", request, newAdminAPIServiceDescribeClusterYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeClusterResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeClusterYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) CountDLQMessages(ctx context.Context, request *CountDLQMessagesRequest, options ...yarpc.CallOption) (*CountDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CountDLQMessages", request, newAdminAPIServiceCountDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CountDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceCountDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ReadDLQMessages(ctx context.Context, request *ReadDLQMessagesRequest, options ...yarpc.CallOption) (*ReadDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ReadDLQMessages", request, newAdminAPIServiceReadDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ReadDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceReadDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) PurgeDLQMessages(ctx context.Context, request *PurgeDLQMessagesRequest, options ...yarpc.CallOption) (*PurgeDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PurgeDLQMessages", request, newAdminAPIServicePurgeDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PurgeDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServicePurgeDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) MergeDLQMessages(ctx context.Context, request *MergeDLQMessagesRequest, options ...yarpc.CallOption) (*MergeDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MergeDLQMessages", request, newAdminAPIServiceMergeDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MergeDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceMergeDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RefreshWorkflowTasks(ctx context.Context, request *RefreshWorkflowTasksRequest, options ...yarpc.CallOption) (*RefreshWorkflowTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RefreshWorkflowTasks", request, newAdminAPIServiceRefreshWorkflowTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RefreshWorkflowTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRefreshWorkflowTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ResendReplicationTasks(ctx context.Context, request *ResendReplicationTasksRequest, options ...yarpc.CallOption) (*ResendReplicationTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResendReplicationTasks", request, newAdminAPIServiceResendReplicationTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResendReplicationTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceResendReplicationTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetCrossClusterTasks(ctx context.Context, request *GetCrossClusterTasksRequest, options ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetCrossClusterTasks", request, newAdminAPIServiceGetCrossClusterTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetCrossClusterTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetCrossClusterTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RespondCrossClusterTasksCompleted(ctx context.Context, request *RespondCrossClusterTasksCompletedRequest, options ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondCrossClusterTasksCompleted", request, newAdminAPIServiceRespondCrossClusterTasksCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondCrossClusterTasksCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRespondCrossClusterTasksCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDynamicConfig(ctx context.Context, request *GetDynamicConfigRequest, options ...yarpc.CallOption) (*GetDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDynamicConfig", request, newAdminAPIServiceGetDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, options ...yarpc.CallOption) (*UpdateDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDynamicConfig", request, newAdminAPIServiceUpdateDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RestoreDynamicConfig(ctx context.Context, request *RestoreDynamicConfigRequest, options ...yarpc.CallOption) (*RestoreDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RestoreDynamicConfig", request, newAdminAPIServiceRestoreDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RestoreDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRestoreDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ListDynamicConfig(ctx context.Context, request *ListDynamicConfigRequest, options ...yarpc.CallOption) (*ListDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDynamicConfig", request, newAdminAPIServiceListDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceListDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DeleteWorkflow(ctx context.Context, request *DeleteWorkflowRequest, options ...yarpc.CallOption) (*DeleteWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeleteWorkflow", request, newAdminAPIServiceDeleteWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeleteWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDeleteWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) MaintainCorruptWorkflow(ctx context.Context, request *MaintainCorruptWorkflowRequest, options ...yarpc.CallOption) (*MaintainCorruptWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MaintainCorruptWorkflow", request, newAdminAPIServiceMaintainCorruptWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MaintainCorruptWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceMaintainCorruptWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _AdminAPIYARPCHandler struct {
+	server AdminAPIYARPCServer
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeHistoryHost(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeHistoryHostRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeHistoryHostRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeHistoryHostYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeHistoryHost(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeShardDistribution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeShardDistributionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeShardDistributionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeShardDistributionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeShardDistribution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) CloseShard(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CloseShardRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CloseShardRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceCloseShardYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CloseShard(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RemoveTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RemoveTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RemoveTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRemoveTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RemoveTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ResetQueue(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetQueueRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetQueueRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceResetQueueYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetQueue(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeQueue(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeQueueRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeQueueRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeQueueYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeQueue(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ListWorkflowTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkflowTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkflowTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceListWorkflowTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListWorkflowTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RetryTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RetryTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RetryTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRetryTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RetryTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkflowExecutionRawHistoryV2Request
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkflowExecutionRawHistoryV2Request)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkflowExecutionRawHistoryV2(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetReplicationMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetReplicationMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetReplicationMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetReplicationMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetReplicationMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDLQReplicationMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDLQReplicationMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDLQReplicationMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDLQReplicationMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDLQReplicationMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDomainReplicationMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainReplicationMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainReplicationMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainReplicationMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainReplicationMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ReapplyEvents(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ReapplyEventsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ReapplyEventsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceReapplyEventsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ReapplyEvents(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) AddSearchAttribute(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *AddSearchAttributeRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*AddSearchAttributeRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceAddSearchAttributeYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.AddSearchAttribute(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeCluster(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeClusterRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeClusterRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeClusterYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeCluster(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) CountDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CountDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CountDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceCountDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CountDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ReadDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ReadDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ReadDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceReadDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ReadDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) PurgeDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PurgeDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PurgeDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServicePurgeDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PurgeDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) MergeDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MergeDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MergeDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceMergeDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MergeDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RefreshWorkflowTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RefreshWorkflowTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RefreshWorkflowTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRefreshWorkflowTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RefreshWorkflowTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ResendReplicationTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResendReplicationTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResendReplicationTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceResendReplicationTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResendReplicationTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetCrossClusterTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetCrossClusterTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetCrossClusterTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetCrossClusterTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetCrossClusterTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RespondCrossClusterTasksCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondCrossClusterTasksCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondCrossClusterTasksCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRespondCrossClusterTasksCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondCrossClusterTasksCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UpdateDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RestoreDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RestoreDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RestoreDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRestoreDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RestoreDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ListDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceListDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DeleteWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeleteWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeleteWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDeleteWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeleteWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) MaintainCorruptWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MaintainCorruptWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MaintainCorruptWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceMaintainCorruptWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MaintainCorruptWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newAdminAPIServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
+	return &DescribeWorkflowExecutionRequest{}
+}
+
+func newAdminAPIServiceDescribeWorkflowExecutionYARPCResponse() proto.Message {
+	return &DescribeWorkflowExecutionResponse{}
+}
+
+func newAdminAPIServiceDescribeHistoryHostYARPCRequest() proto.Message {
+	return &DescribeHistoryHostRequest{}
+}
+
+func newAdminAPIServiceDescribeHistoryHostYARPCResponse() proto.Message {
+	return &DescribeHistoryHostResponse{}
+}
+
+func newAdminAPIServiceDescribeShardDistributionYARPCRequest() proto.Message {
+	return &DescribeShardDistributionRequest{}
+}
+
+func newAdminAPIServiceDescribeShardDistributionYARPCResponse() proto.Message {
+	return &DescribeShardDistributionResponse{}
+}
+
+func newAdminAPIServiceCloseShardYARPCRequest() proto.Message {
+	return &CloseShardRequest{}
+}
+
+func newAdminAPIServiceCloseShardYARPCResponse() proto.Message {
+	return &CloseShardResponse{}
+}
+
+func newAdminAPIServiceRemoveTaskYARPCRequest() proto.Message {
+	return &RemoveTaskRequest{}
+}
+
+func newAdminAPIServiceRemoveTaskYARPCResponse() proto.Message {
+	return &RemoveTaskResponse{}
+}
+
+func newAdminAPIServiceResetQueueYARPCRequest() proto.Message {
+	return &ResetQueueRequest{}
+}
+
+func newAdminAPIServiceResetQueueYARPCResponse() proto.Message {
+	return &ResetQueueResponse{}
+}
+
+func newAdminAPIServiceDescribeQueueYARPCRequest() proto.Message {
+	return &DescribeQueueRequest{}
+}
+
+func newAdminAPIServiceDescribeQueueYARPCResponse() proto.Message {
+	return &DescribeQueueResponse{}
+}
+
+func newAdminAPIServiceListWorkflowTasksYARPCRequest() proto.Message {
+	return &ListWorkflowTasksRequest{}
+}
+
+func newAdminAPIServiceListWorkflowTasksYARPCResponse() proto.Message {
+	return &ListWorkflowTasksResponse{}
+}
+
+func newAdminAPIServiceRetryTaskYARPCRequest() proto.Message {
+	return &RetryTaskRequest{}
+}
+
+func newAdminAPIServiceRetryTaskYARPCResponse() proto.Message {
+	return &RetryTaskResponse{}
+}
+
+func newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest() proto.Message {
+	return &GetWorkflowExecutionRawHistoryV2Request{}
+}
+
+func newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse() proto.Message {
+	return &GetWorkflowExecutionRawHistoryV2Response{}
+}
+
+func newAdminAPIServiceGetReplicationMessagesYARPCRequest() proto.Message {
+	return &GetReplicationMessagesRequest{}
+}
+
+func newAdminAPIServiceGetReplicationMessagesYARPCResponse() proto.Message {
+	return &GetReplicationMessagesResponse{}
+}
+
+func newAdminAPIServiceGetDLQReplicationMessagesYARPCRequest() proto.Message {
+	return &GetDLQReplicationMessagesRequest{}
+}
+
+func newAdminAPIServiceGetDLQReplicationMessagesYARPCResponse() proto.Message {
+	return &GetDLQReplicationMessagesResponse{}
+}
+
+func newAdminAPIServiceGetDomainReplicationMessagesYARPCRequest() proto.Message {
+	return &GetDomainReplicationMessagesRequest{}
+}
+
+func newAdminAPIServiceGetDomainReplicationMessagesYARPCResponse() proto.Message {
+	return &GetDomainReplicationMessagesResponse{}
+}
+
+func newAdminAPIServiceReapplyEventsYARPCRequest() proto.Message {
+	return &ReapplyEventsRequest{}
+}
+
+func newAdminAPIServiceReapplyEventsYARPCResponse() proto.Message {
+	return &ReapplyEventsResponse{}
+}
+
+func newAdminAPIServiceAddSearchAttributeYARPCRequest() proto.Message {
+	return &AddSearchAttributeRequest{}
+}
+
+func newAdminAPIServiceAddSearchAttributeYARPCResponse() proto.Message {
+	return &AddSearchAttributeResponse{}
+}
+
+func newAdminAPIServiceDescribeClusterYARPCRequest() proto.Message {
+	return &DescribeClusterRequest{}
+}
+
+func newAdminAPIServiceDescribeClusterYARPCResponse() proto.Message {
+	return &DescribeClusterResponse{}
+}
+
+func newAdminAPIServiceCountDLQMessagesYARPCRequest() proto.Message {
+	return &CountDLQMessagesRequest{}
+}
+
+func newAdminAPIServiceCountDLQMessagesYARPCResponse() proto.Message {
+	return &CountDLQMessagesResponse{}
+}
+
+func newAdminAPIServiceReadDLQMessagesYARPCRequest() proto.Message {
+	return &ReadDLQMessagesRequest{}
+}
+
+func newAdminAPIServiceReadDLQMessagesYARPCResponse() proto.Message {
+	return &ReadDLQMessagesResponse{}
+}
+
+func newAdminAPIServicePurgeDLQMessagesYARPCRequest() proto.Message {
+	return &PurgeDLQMessagesRequest{}
+}
+
+func newAdminAPIServicePurgeDLQMessagesYARPCResponse() proto.Message {
+	return &PurgeDLQMessagesResponse{}
+}
+
+func newAdminAPIServiceMergeDLQMessagesYARPCRequest() proto.Message {
+	return &MergeDLQMessagesRequest{}
+}
+
+func newAdminAPIServiceMergeDLQMessagesYARPCResponse() proto.Message {
+	return &MergeDLQMessagesResponse{}
+}
+
+func newAdminAPIServiceRefreshWorkflowTasksYARPCRequest() proto.Message {
+	return &RefreshWorkflowTasksRequest{}
+}
+
+func newAdminAPIServiceRefreshWorkflowTasksYARPCResponse() proto.Message {
+	return &RefreshWorkflowTasksResponse{}
+}
+
+func newAdminAPIServiceResendReplicationTasksYARPCRequest() proto.Message {
+	return &ResendReplicationTasksRequest{}
+}
+
+func newAdminAPIServiceResendReplicationTasksYARPCResponse() proto.Message {
+	return &ResendReplicationTasksResponse{}
+}
+
+func newAdminAPIServiceGetCrossClusterTasksYARPCRequest() proto.Message {
+	return &GetCrossClusterTasksRequest{}
+}
+
+func newAdminAPIServiceGetCrossClusterTasksYARPCResponse() proto.Message {
+	return &GetCrossClusterTasksResponse{}
+}
+
+func newAdminAPIServiceRespondCrossClusterTasksCompletedYARPCRequest() proto.Message {
+	return &RespondCrossClusterTasksCompletedRequest{}
+}
+
+func newAdminAPIServiceRespondCrossClusterTasksCompletedYARPCResponse() proto.Message {
+	return &RespondCrossClusterTasksCompletedResponse{}
+}
+
+func newAdminAPIServiceGetDynamicConfigYARPCRequest() proto.Message {
+	return &GetDynamicConfigRequest{}
+}
+
+func newAdminAPIServiceGetDynamicConfigYARPCResponse() proto.Message {
+	return &GetDynamicConfigResponse{}
+}
+
+func newAdminAPIServiceUpdateDynamicConfigYARPCRequest() proto.Message {
+	return &UpdateDynamicConfigRequest{}
+}
+
+func newAdminAPIServiceUpdateDynamicConfigYARPCResponse() proto.Message {
+	return &UpdateDynamicConfigResponse{}
+}
+
+func newAdminAPIServiceRestoreDynamicConfigYARPCRequest() proto.Message {
+	return &RestoreDynamicConfigRequest{}
+}
+
+func newAdminAPIServiceRestoreDynamicConfigYARPCResponse() proto.Message {
+	return &RestoreDynamicConfigResponse{}
+}
+
+func newAdminAPIServiceListDynamicConfigYARPCRequest() proto.Message {
+	return &ListDynamicConfigRequest{}
+}
+
+func newAdminAPIServiceListDynamicConfigYARPCResponse() proto.Message {
+	return &ListDynamicConfigResponse{}
+}
+
+func newAdminAPIServiceDeleteWorkflowYARPCRequest() proto.Message {
+	return &DeleteWorkflowRequest{}
+}
+
+func newAdminAPIServiceDeleteWorkflowYARPCResponse() proto.Message {
+	return &DeleteWorkflowResponse{}
+}
+
+func newAdminAPIServiceMaintainCorruptWorkflowYARPCRequest() proto.Message {
+	return &MaintainCorruptWorkflowRequest{}
+}
+
+func newAdminAPIServiceMaintainCorruptWorkflowYARPCResponse() proto.Message {
+	return &MaintainCorruptWorkflowResponse{}
+}
+
+var (
+	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCRequest          = &DescribeWorkflowExecutionRequest{}
+	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCResponse         = &DescribeWorkflowExecutionResponse{}
+	emptyAdminAPIServiceDescribeHistoryHostYARPCRequest                = &DescribeHistoryHostRequest{}
+	emptyAdminAPIServiceDescribeHistoryHostYARPCResponse               = &DescribeHistoryHostResponse{}
+	emptyAdminAPIServiceDescribeShardDistributionYARPCRequest          = &DescribeShardDistributionRequest{}
+	emptyAdminAPIServiceDescribeShardDistributionYARPCResponse         = &DescribeShardDistributionResponse{}
+	emptyAdminAPIServiceCloseShardYARPCRequest                         = &CloseShardRequest{}
+	emptyAdminAPIServiceCloseShardYARPCResponse                        = &CloseShardResponse{}
+	emptyAdminAPIServiceRemoveTaskYARPCRequest                         = &RemoveTaskRequest{}
+	emptyAdminAPIServiceRemoveTaskYARPCResponse                        = &RemoveTaskResponse{}
+	emptyAdminAPIServiceResetQueueYARPCRequest                         = &ResetQueueRequest{}
+	emptyAdminAPIServiceResetQueueYARPCResponse                        = &ResetQueueResponse{}
+	emptyAdminAPIServiceDescribeQueueYARPCRequest                      = &DescribeQueueRequest{}
+	emptyAdminAPIServiceDescribeQueueYARPCResponse                     = &DescribeQueueResponse{}
+	emptyAdminAPIServiceListWorkflowTasksYARPCRequest                  = &ListWorkflowTasksRequest{}
+	emptyAdminAPIServiceListWorkflowTasksYARPCResponse                 = &ListWorkflowTasksResponse{}
+	emptyAdminAPIServiceRetryTaskYARPCRequest                          = &RetryTaskRequest{}
+	emptyAdminAPIServiceRetryTaskYARPCResponse                         = &RetryTaskResponse{}
+	emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest   = &GetWorkflowExecutionRawHistoryV2Request{}
+	emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse  = &GetWorkflowExecutionRawHistoryV2Response{}
+	emptyAdminAPIServiceGetReplicationMessagesYARPCRequest             = &GetReplicationMessagesRequest{}
+	emptyAdminAPIServiceGetReplicationMessagesYARPCResponse            = &GetReplicationMessagesResponse{}
+	emptyAdminAPIServiceGetDLQReplicationMessagesYARPCRequest          = &GetDLQReplicationMessagesRequest{}
+	emptyAdminAPIServiceGetDLQReplicationMessagesYARPCResponse         = &GetDLQReplicationMessagesResponse{}
+	emptyAdminAPIServiceGetDomainReplicationMessagesYARPCRequest       = &GetDomainReplicationMessagesRequest{}
+	emptyAdminAPIServiceGetDomainReplicationMessagesYARPCResponse      = &GetDomainReplicationMessagesResponse{}
+	emptyAdminAPIServiceReapplyEventsYARPCRequest                      = &ReapplyEventsRequest{}
+	emptyAdminAPIServiceReapplyEventsYARPCResponse                     = &ReapplyEventsResponse{}
+	emptyAdminAPIServiceAddSearchAttributeYARPCRequest                 = &AddSearchAttributeRequest{}
+	emptyAdminAPIServiceAddSearchAttributeYARPCResponse                = &AddSearchAttributeResponse{}
+	emptyAdminAPIServiceDescribeClusterYARPCRequest                    = &DescribeClusterRequest{}
+	emptyAdminAPIServiceDescribeClusterYARPCResponse                   = &DescribeClusterResponse{}
+	emptyAdminAPIServiceCountDLQMessagesYARPCRequest                   = &CountDLQMessagesRequest{}
+	emptyAdminAPIServiceCountDLQMessagesYARPCResponse                  = &CountDLQMessagesResponse{}
+	emptyAdminAPIServiceReadDLQMessagesYARPCRequest                    = &ReadDLQMessagesRequest{}
+	emptyAdminAPIServiceReadDLQMessagesYARPCResponse                   = &ReadDLQMessagesResponse{}
+	emptyAdminAPIServicePurgeDLQMessagesYARPCRequest                   = &PurgeDLQMessagesRequest{}
+	emptyAdminAPIServicePurgeDLQMessagesYARPCResponse                  = &PurgeDLQMessagesResponse{}
+	emptyAdminAPIServiceMergeDLQMessagesYARPCRequest                   = &MergeDLQMessagesRequest{}
+	emptyAdminAPIServiceMergeDLQMessagesYARPCResponse                  = &MergeDLQMessagesResponse{}
+	emptyAdminAPIServiceRefreshWorkflowTasksYARPCRequest               = &RefreshWorkflowTasksRequest{}
+	emptyAdminAPIServiceRefreshWorkflowTasksYARPCResponse              = &RefreshWorkflowTasksResponse{}
+	emptyAdminAPIServiceResendReplicationTasksYARPCRequest             = &ResendReplicationTasksRequest{}
+	emptyAdminAPIServiceResendReplicationTasksYARPCResponse            = &ResendReplicationTasksResponse{}
+	emptyAdminAPIServiceGetCrossClusterTasksYARPCRequest               = &GetCrossClusterTasksRequest{}
+	emptyAdminAPIServiceGetCrossClusterTasksYARPCResponse              = &GetCrossClusterTasksResponse{}
+	emptyAdminAPIServiceRespondCrossClusterTasksCompletedYARPCRequest  = &RespondCrossClusterTasksCompletedRequest{}
+	emptyAdminAPIServiceRespondCrossClusterTasksCompletedYARPCResponse = &RespondCrossClusterTasksCompletedResponse{}
+	emptyAdminAPIServiceGetDynamicConfigYARPCRequest                   = &GetDynamicConfigRequest{}
+	emptyAdminAPIServiceGetDynamicConfigYARPCResponse                  = &GetDynamicConfigResponse{}
+	emptyAdminAPIServiceUpdateDynamicConfigYARPCRequest                = &UpdateDynamicConfigRequest{}
+	emptyAdminAPIServiceUpdateDynamicConfigYARPCResponse               = &UpdateDynamicConfigResponse{}
+	emptyAdminAPIServiceRestoreDynamicConfigYARPCRequest               = &RestoreDynamicConfigRequest{}
+	emptyAdminAPIServiceRestoreDynamicConfigYARPCResponse              = &RestoreDynamicConfigResponse{}
+	emptyAdminAPIServiceListDynamicConfigYARPCRequest                  = &ListDynamicConfigRequest{}
+	emptyAdminAPIServiceListDynamicConfigYARPCResponse                 = &ListDynamicConfigResponse{}
+	emptyAdminAPIServiceDeleteWorkflowYARPCRequest                     = &DeleteWorkflowRequest{}
+	emptyAdminAPIServiceDeleteWorkflowYARPCResponse                    = &DeleteWorkflowResponse{}
+	emptyAdminAPIServiceMaintainCorruptWorkflowYARPCRequest            = &MaintainCorruptWorkflowRequest{}
+	emptyAdminAPIServiceMaintainCorruptWorkflowYARPCResponse           = &MaintainCorruptWorkflowResponse{}
+)
+
+var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
+	// uber/cadence/admin/v1/service.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0xcb, 0x6f, 0xdc, 0xc6,
+		0xf9, 0xe1, 0xea, 0x61, 0xe9, 0x5b, 0x4b, 0x96, 0xc6, 0xb2, 0x1e, 0x94, 0x1f, 0x32, 0x13, 0xc7,
+		0xd2, 0x2f, 0xf1, 0x2a, 0x5e, 0xc5, 0x8e, 0x13, 0x07, 0x3f, 0x47, 0x96, 0x64, 0x7b, 0x5b, 0xcb,
+		0xb1, 0x29, 0xc7, 0x29, 0x8a, 0x22, 0x0c, 0x77, 0x39, 0x2b, 0x31, 0xda, 0x25, 0x19, 0xce, 0xec,
+		0xda, 0x1b, 0x14, 0x7d, 0xa0, 0x0f, 0xb4, 0x40, 0x1f, 0x68, 0x2f, 0x3d, 0xe6, 0x50, 0xa0, 0x68,
+		0x8b, 0x02, 0x3d, 0xf4, 0xda, 0x73, 0x8f, 0x05, 0xfa, 0x47, 0xb4, 0x87, 0x22, 0x45, 0xd1, 0x5b,
+		0x6f, 0x2d, 0xe6, 0xc1, 0x25, 0xb9, 0x24, 0x77, 0xb9, 0xaa, 0x0b, 0x1b, 0xe9, 0x6d, 0x39, 0xf3,
+		0xbd, 0xe7, 0x9b, 0x6f, 0xbe, 0xf9, 0xbe, 0x59, 0x78, 0xb1, 0x55, 0xc5, 0xfe, 0x7a, 0xcd, 0xb4,
+		0xb0, 0x53, 0xc3, 0xeb, 0xa6, 0xd5, 0xb4, 0x9d, 0xf5, 0xf6, 0xe5, 0x75, 0x82, 0xfd, 0xb6, 0x5d,
+		0xc3, 0x25, 0xcf, 0x77, 0xa9, 0x8b, 0x4e, 0x31, 0xa0, 0x92, 0x04, 0x2a, 0x71, 0xa0, 0x52, 0xfb,
+		0xb2, 0x7a, 0x6e, 0xdf, 0x75, 0xf7, 0x1b, 0x78, 0x9d, 0x03, 0x55, 0x5b, 0xf5, 0x75, 0x6a, 0x37,
+		0x31, 0xa1, 0x66, 0xd3, 0x13, 0x78, 0xea, 0xd9, 0x5e, 0x80, 0xc7, 0xbe, 0xe9, 0x79, 0xd8, 0x27,
+		0x72, 0x7e, 0x25, 0xce, 0xdc, 0xb3, 0x19, 0xeb, 0x9a, 0xdb, 0x6c, 0xba, 0x8e, 0x84, 0x78, 0x29,
+		0x0d, 0xa2, 0x6d, 0x13, 0xbb, 0x6a, 0x37, 0x6c, 0xda, 0x91, 0x50, 0x19, 0x4a, 0xd4, 0x1a, 0x2d,
+		0x42, 0xb1, 0xdf, 0x1f, 0xe8, 0xc0, 0x26, 0xd4, 0xf5, 0x03, 0x4a, 0xe7, 0xd3, 0x81, 0x3e, 0x6e,
+		0xe1, 0x96, 0x34, 0x86, 0x7a, 0x31, 0x1d, 0xc4, 0xc7, 0x5e, 0xc3, 0xae, 0x99, 0xd4, 0x0e, 0x64,
+		0xd7, 0x7e, 0xa2, 0xc0, 0xca, 0x36, 0x26, 0x35, 0xdf, 0xae, 0xe2, 0xf7, 0x5d, 0xff, 0xb0, 0xde,
+		0x70, 0x1f, 0xef, 0x3c, 0xc1, 0xb5, 0x16, 0x83, 0xd1, 0xf1, 0xc7, 0x2d, 0x4c, 0x28, 0x9a, 0x87,
+		0x71, 0xcb, 0x6d, 0x9a, 0xb6, 0xb3, 0xa8, 0xac, 0x28, 0xab, 0x93, 0xba, 0xfc, 0x42, 0xef, 0x01,
+		0x7a, 0x2c, 0x71, 0x0c, 0x1c, 0x20, 0x2d, 0x16, 0x56, 0x94, 0xd5, 0x62, 0xf9, 0xe5, 0x52, 0x7c,
+		0x3d, 0x3c, 0xbb, 0xd4, 0xbe, 0x5c, 0x4a, 0xb2, 0x98, 0x7d, 0xdc, 0x3b, 0xa4, 0xfd, 0x49, 0x81,
+		0xf3, 0x7d, 0x64, 0x22, 0x9e, 0xeb, 0x10, 0x8c, 0x96, 0x60, 0x82, 0x1c, 0x98, 0xbe, 0x65, 0xd8,
+		0x16, 0x17, 0x6b, 0x4c, 0x3f, 0xc6, 0xbf, 0x2b, 0x16, 0x3a, 0x0f, 0xc7, 0xa5, 0xc5, 0x0c, 0xd3,
+		0xb2, 0x7c, 0x2e, 0xd1, 0xa4, 0x5e, 0x94, 0x63, 0x9b, 0x96, 0xe5, 0xa3, 0x0d, 0x98, 0x6f, 0xb6,
+		0xa8, 0x59, 0x6d, 0x60, 0x83, 0x50, 0x93, 0x62, 0xc3, 0x76, 0x8c, 0x9a, 0x59, 0x3b, 0xc0, 0x8b,
+		0x23, 0x1c, 0xf8, 0xa4, 0x9c, 0xdd, 0x63, 0x93, 0x15, 0x67, 0x8b, 0x4d, 0xa1, 0x37, 0x61, 0x29,
+		0x81, 0x64, 0x99, 0xd4, 0xac, 0x9a, 0x04, 0x2f, 0x8e, 0x72, 0xbc, 0xf9, 0x38, 0xde, 0xb6, 0x9c,
+		0xd5, 0xfe, 0xa0, 0x80, 0x1a, 0xe8, 0x74, 0x47, 0xc8, 0x71, 0xc7, 0x25, 0x34, 0xb0, 0xf0, 0x8b,
+		0x70, 0xfc, 0xc0, 0x25, 0x94, 0x8b, 0x8b, 0x09, 0x11, 0x76, 0xbe, 0xf3, 0x82, 0x5e, 0x64, 0xa3,
+		0x9b, 0x62, 0x10, 0x2d, 0x47, 0x34, 0x66, 0x2a, 0x8d, 0xdd, 0x79, 0x21, 0xd4, 0xf9, 0xfd, 0xd4,
+		0xb5, 0x18, 0x19, 0x66, 0x2d, 0xee, 0xbc, 0x90, 0xb2, 0x1a, 0x37, 0xa7, 0xa0, 0x68, 0x49, 0xc1,
+		0x8d, 0x6a, 0x47, 0xfb, 0x52, 0xe8, 0x2f, 0x7b, 0x8c, 0xf5, 0xb6, 0x4d, 0xa8, 0x6f, 0x57, 0x63,
+		0xfe, 0xb2, 0x0c, 0x93, 0x9e, 0xb9, 0x8f, 0x0d, 0x62, 0x7f, 0x82, 0xe5, 0xda, 0x4c, 0xb0, 0x81,
+		0x3d, 0xfb, 0x13, 0x8c, 0x16, 0xe0, 0x18, 0x9f, 0x0c, 0x94, 0xd0, 0xc7, 0xd9, 0x67, 0xc5, 0xd2,
+		0xfe, 0x12, 0x59, 0xf6, 0x14, 0xd2, 0x72, 0xd9, 0x57, 0x61, 0xc6, 0x69, 0x35, 0xab, 0xd8, 0x37,
+		0xdc, 0xba, 0xc1, 0x95, 0x27, 0x92, 0xc5, 0xb4, 0x18, 0x7f, 0xb7, 0xce, 0x91, 0x09, 0xfa, 0x0a,
+		0x8c, 0xcb, 0xf9, 0xc2, 0xca, 0xc8, 0x6a, 0xb1, 0xbc, 0x5d, 0x4a, 0x8d, 0x10, 0xa5, 0x81, 0x3c,
+		0x4b, 0x82, 0xe0, 0x8e, 0x43, 0xfd, 0x8e, 0x2e, 0x69, 0xaa, 0x6f, 0x42, 0x31, 0x32, 0x8c, 0x66,
+		0x60, 0xe4, 0x10, 0x77, 0xa4, 0x24, 0xec, 0x27, 0x9a, 0x83, 0xb1, 0xb6, 0xd9, 0x68, 0x61, 0xe9,
+		0x7d, 0xe2, 0xe3, 0xad, 0xc2, 0x35, 0x45, 0xfb, 0x66, 0x01, 0x96, 0x53, 0x7d, 0x61, 0x68, 0x15,
+		0x97, 0x61, 0x32, 0xf0, 0x08, 0xa1, 0xe5, 0x98, 0x3e, 0x21, 0x1d, 0x82, 0xa0, 0x0a, 0x1c, 0x17,
+		0xfb, 0x34, 0xe2, 0xd8, 0x49, 0x5f, 0xe8, 0x5a, 0x81, 0x83, 0x72, 0x3f, 0xaf, 0x38, 0x75, 0x57,
+		0x2f, 0x5a, 0xe1, 0x00, 0xba, 0x0a, 0x0b, 0x82, 0x4f, 0xcd, 0x75, 0xa8, 0xef, 0x36, 0x1a, 0xd8,
+		0xe7, 0x3b, 0xa0, 0x45, 0xa4, 0xdb, 0x9f, 0xe2, 0xd3, 0x5b, 0xdd, 0xd9, 0x3d, 0x3e, 0x89, 0x16,
+		0xe1, 0x58, 0xe0, 0xd1, 0x63, 0x1c, 0x2e, 0xf8, 0xd4, 0x4a, 0x30, 0xbb, 0xd5, 0x70, 0x89, 0x30,
+		0x7a, 0xe0, 0x37, 0xd9, 0x5b, 0x5a, 0x9b, 0x03, 0x14, 0x85, 0x17, 0x96, 0xd2, 0xfe, 0xa6, 0xc0,
+		0xac, 0x8e, 0x9b, 0x6e, 0x1b, 0x3f, 0x34, 0xc9, 0xe1, 0x60, 0x32, 0xe8, 0x6d, 0x98, 0xa4, 0x26,
+		0x39, 0x34, 0x68, 0xc7, 0x13, 0x0b, 0x33, 0x5d, 0x3e, 0x97, 0x61, 0x10, 0x46, 0xf1, 0x61, 0xc7,
+		0xc3, 0xfa, 0x04, 0x95, 0xbf, 0x98, 0xeb, 0x72, 0x6c, 0xdb, 0xe2, 0xc6, 0x1c, 0xd1, 0xc7, 0xd9,
+		0x67, 0xc5, 0x42, 0x5b, 0x70, 0x22, 0x8c, 0xf7, 0x06, 0x3b, 0x61, 0xb8, 0x5d, 0x8a, 0x65, 0xb5,
+		0x24, 0x4e, 0x97, 0x52, 0x70, 0xba, 0x94, 0x1e, 0x06, 0xc7, 0x8f, 0x3e, 0x1d, 0xa2, 0xb0, 0x41,
+		0x16, 0xb5, 0xe4, 0x61, 0x60, 0x38, 0x66, 0x13, 0x4b, 0x8b, 0x15, 0xe5, 0xd8, 0x3d, 0xb3, 0x89,
+		0x99, 0x15, 0xa2, 0xea, 0x4a, 0x2b, 0xfc, 0x98, 0x5b, 0x81, 0x60, 0xfa, 0xa0, 0x85, 0x5b, 0x38,
+		0x87, 0x15, 0x7a, 0x39, 0x15, 0x12, 0x9c, 0xe2, 0x86, 0x1a, 0x19, 0xd2, 0x50, 0x42, 0xce, 0x50,
+		0x20, 0x29, 0xe7, 0x4f, 0x15, 0x98, 0x0b, 0xfc, 0xfe, 0xb9, 0x11, 0xf5, 0x5d, 0x38, 0xd5, 0x23,
+		0x93, 0xdc, 0x85, 0x57, 0x61, 0xc1, 0xf3, 0xdd, 0x1a, 0x26, 0xc4, 0x76, 0xf6, 0x0d, 0x7e, 0xb8,
+		0x8a, 0xa8, 0xcf, 0x36, 0xe3, 0x08, 0xf3, 0xf9, 0x70, 0x9a, 0x63, 0xf2, 0x90, 0x4f, 0xb4, 0xef,
+		0x2b, 0xb0, 0x78, 0xd7, 0x26, 0x34, 0x08, 0xaf, 0x8c, 0x27, 0x79, 0x46, 0x27, 0xe9, 0x0f, 0x14,
+		0x58, 0x4a, 0x91, 0x65, 0xf0, 0x09, 0x7a, 0x15, 0xc6, 0x98, 0x85, 0x82, 0xd0, 0xb9, 0x92, 0x61,
+		0x4f, 0xae, 0x37, 0xf7, 0x45, 0x01, 0x8e, 0x4e, 0xc3, 0x24, 0xf5, 0x5b, 0x4e, 0xcd, 0xa4, 0x58,
+		0xec, 0x91, 0x09, 0x3d, 0x1c, 0xd0, 0x3e, 0x53, 0x60, 0x46, 0xc7, 0xd4, 0xef, 0xfc, 0x6f, 0xec,
+		0xd6, 0x93, 0x30, 0x1b, 0xd1, 0x56, 0x6e, 0x82, 0x7f, 0x14, 0xe0, 0xe2, 0x6d, 0x4c, 0x93, 0xcb,
+		0x67, 0x3e, 0x96, 0x67, 0xc1, 0xa3, 0xf2, 0xb3, 0xf1, 0x16, 0xf4, 0x05, 0x28, 0x12, 0x6a, 0xfa,
+		0xd4, 0xc0, 0x6d, 0xec, 0x50, 0x79, 0x5e, 0xac, 0x65, 0x18, 0xfc, 0x11, 0xf6, 0x09, 0xcb, 0x19,
+		0x84, 0xcc, 0x15, 0x8a, 0x9b, 0x3a, 0x70, 0xec, 0x1d, 0x86, 0x8c, 0x6e, 0xc1, 0x24, 0x76, 0x2c,
+		0x49, 0x69, 0x74, 0x58, 0x4a, 0x13, 0xd8, 0xb1, 0x04, 0x9d, 0x58, 0x2a, 0x31, 0xd6, 0x93, 0x4a,
+		0xbc, 0x0c, 0x27, 0x1c, 0xfc, 0x84, 0x1a, 0x1c, 0x82, 0xba, 0x87, 0xd8, 0x59, 0x1c, 0x5f, 0x51,
+		0x56, 0x8f, 0xeb, 0x53, 0x6c, 0xf8, 0xbe, 0xb9, 0x8f, 0x1f, 0xb2, 0x41, 0xed, 0xaf, 0x0a, 0xac,
+		0x0e, 0xb6, 0xb9, 0xdc, 0x15, 0x29, 0x44, 0x95, 0x14, 0xa2, 0xe8, 0x16, 0x9c, 0x08, 0x92, 0xcc,
+		0xaa, 0x49, 0x6b, 0x07, 0x38, 0xd8, 0x2c, 0x67, 0x52, 0x57, 0x80, 0x65, 0x82, 0x37, 0x1b, 0x6e,
+		0x55, 0x9f, 0x96, 0x58, 0x37, 0x05, 0x12, 0xba, 0x07, 0x27, 0xda, 0xc2, 0x02, 0x86, 0x9c, 0x91,
+		0x96, 0xbf, 0x90, 0xcb, 0x5e, 0xfa, 0x74, 0x3b, 0xf6, 0xad, 0x7d, 0x4b, 0x81, 0x33, 0xb7, 0x31,
+		0xd5, 0xc3, 0x54, 0x7f, 0x17, 0x13, 0x62, 0xee, 0xe3, 0x6e, 0x10, 0xba, 0x01, 0xe3, 0x5c, 0x2f,
+		0x11, 0xc8, 0x8a, 0xe5, 0x8b, 0x19, 0x8c, 0x22, 0x24, 0xb8, 0xca, 0xba, 0x44, 0xcb, 0x11, 0x94,
+		0xb5, 0x7f, 0x29, 0x70, 0x36, 0x4b, 0x0a, 0x69, 0x68, 0x17, 0xa6, 0xc5, 0xc6, 0x6f, 0xca, 0x19,
+		0x29, 0xce, 0x9d, 0x0c, 0x71, 0xfa, 0x93, 0x13, 0x49, 0x5a, 0x30, 0x2a, 0x72, 0xb5, 0x29, 0x12,
+		0x1d, 0x53, 0x1b, 0x80, 0x92, 0x40, 0x29, 0x99, 0xdb, 0x3b, 0xd1, 0xcc, 0xad, 0x58, 0xfe, 0xbf,
+		0xc1, 0xe6, 0xe9, 0x0a, 0x13, 0xc9, 0xf2, 0x9a, 0xb0, 0x72, 0x1b, 0xd3, 0xed, 0xbb, 0x0f, 0xfa,
+		0xac, 0x44, 0x05, 0x40, 0x84, 0x28, 0xa7, 0xee, 0x06, 0xea, 0xe7, 0x60, 0xc7, 0x02, 0x0a, 0x4f,
+		0xd2, 0x26, 0xa9, 0xfc, 0x45, 0xb4, 0x27, 0x70, 0xbe, 0x0f, 0x3b, 0x69, 0xf2, 0x3d, 0x98, 0x8d,
+		0x5c, 0x01, 0x0d, 0x11, 0xe2, 0x05, 0xdb, 0x97, 0xf3, 0xb1, 0xd5, 0x67, 0xfc, 0xf8, 0x00, 0xd1,
+		0xfe, 0xa9, 0xc0, 0x8b, 0x8c, 0x35, 0x8f, 0x4d, 0x7d, 0x94, 0x7d, 0x04, 0x4b, 0x0d, 0x93, 0x50,
+		0xc3, 0xc7, 0xd4, 0xb7, 0x71, 0x1b, 0x77, 0x17, 0x3e, 0x88, 0xfc, 0xc5, 0xf2, 0x72, 0x22, 0x00,
+		0x57, 0x1c, 0x7a, 0xf5, 0xf5, 0x47, 0xcc, 0xa8, 0xfa, 0x3c, 0xc3, 0xd6, 0x03, 0x64, 0x49, 0xbd,
+		0x62, 0x75, 0xe9, 0xca, 0xe3, 0x38, 0x4e, 0xb7, 0x90, 0x93, 0xee, 0xfd, 0x00, 0x39, 0xa4, 0xdb,
+		0xeb, 0xe5, 0x23, 0x49, 0x2f, 0x77, 0xe0, 0xa5, 0xfe, 0x9a, 0x4b, 0xbb, 0xdf, 0x82, 0x89, 0x88,
+		0x93, 0x0f, 0xeb, 0x54, 0x5d, 0x5c, 0xed, 0xf7, 0x0a, 0xcc, 0xe9, 0xd8, 0xf4, 0xbc, 0x46, 0x87,
+		0x87, 0xc7, 0x67, 0x94, 0x57, 0xa0, 0x2b, 0x30, 0xce, 0x23, 0x3b, 0x91, 0xa1, 0x6a, 0x40, 0xc8,
+		0x93, 0xc0, 0xda, 0x02, 0x9c, 0xea, 0x91, 0x5e, 0x1e, 0x8a, 0x9f, 0x16, 0x60, 0x69, 0xd3, 0xb2,
+		0xf6, 0xb0, 0xe9, 0xd7, 0x0e, 0x36, 0xa9, 0xb8, 0x81, 0x75, 0xd3, 0x43, 0x0f, 0x66, 0x08, 0x9f,
+		0x31, 0xcc, 0x60, 0x4a, 0x3a, 0xed, 0x4e, 0x86, 0x15, 0x33, 0x69, 0x95, 0x7a, 0x86, 0x45, 0x9c,
+		0x38, 0x41, 0xe2, 0xa3, 0xe8, 0x02, 0x4c, 0x13, 0x5c, 0x6b, 0xf9, 0x3c, 0x3f, 0xe0, 0x47, 0x80,
+		0x08, 0x71, 0x53, 0xc1, 0x28, 0x8f, 0x87, 0xaa, 0x0d, 0x73, 0x69, 0xf4, 0xa2, 0x21, 0x65, 0x52,
+		0x84, 0x94, 0xeb, 0xd1, 0x90, 0x32, 0x5d, 0xbe, 0x90, 0x6a, 0xaf, 0x8a, 0x63, 0xe1, 0x27, 0xd8,
+		0xe2, 0x5e, 0xc9, 0x73, 0x99, 0x48, 0x34, 0x39, 0x0d, 0x6a, 0x9a, 0x52, 0xd2, 0x7e, 0x8b, 0x30,
+		0x1f, 0x24, 0xb1, 0x5b, 0xc2, 0x3d, 0xa5, 0xbe, 0xda, 0x6f, 0x47, 0x60, 0x21, 0x31, 0x25, 0xbd,
+		0xf2, 0x00, 0x96, 0x48, 0xcb, 0xf3, 0x5c, 0x9f, 0x62, 0xcb, 0xa8, 0x35, 0x6c, 0xec, 0x50, 0x43,
+		0x1e, 0x26, 0x81, 0x9b, 0xbe, 0x9a, 0x2a, 0xe8, 0x5e, 0x80, 0xb5, 0xc5, 0x91, 0xe4, 0x81, 0x44,
+		0xf4, 0x05, 0x92, 0x3e, 0xc1, 0xce, 0xb8, 0x26, 0x66, 0x37, 0x57, 0x72, 0x60, 0x7b, 0x3c, 0xda,
+		0x49, 0x1f, 0xcc, 0x3a, 0xe3, 0x76, 0xbb, 0xd0, 0x3c, 0xce, 0x4d, 0x37, 0x63, 0xdf, 0xc8, 0x81,
+		0x19, 0x8f, 0xd1, 0x26, 0x94, 0xa1, 0x09, 0x82, 0x23, 0xdc, 0x23, 0xb6, 0x06, 0x5c, 0xf2, 0x7b,
+		0x6c, 0x50, 0xba, 0x1f, 0x92, 0x61, 0x94, 0xa5, 0x3f, 0x78, 0xf1, 0x51, 0xf5, 0x23, 0x98, 0x4b,
+		0x03, 0x4c, 0x59, 0xe8, 0xb7, 0xe3, 0x67, 0x47, 0x56, 0x54, 0xed, 0xa1, 0x16, 0x5d, 0xe9, 0xb7,
+		0x60, 0x61, 0xcb, 0x6d, 0x39, 0x2c, 0x94, 0xf7, 0x46, 0xd0, 0x73, 0x50, 0xac, 0xbb, 0x7e, 0x0d,
+		0x1b, 0x75, 0x4c, 0x6b, 0x07, 0x9c, 0xed, 0x84, 0x0e, 0x7c, 0xe8, 0x16, 0x1b, 0xd1, 0x3a, 0xb0,
+		0x98, 0xc4, 0x95, 0xab, 0xbd, 0x03, 0xc7, 0x82, 0xfc, 0x42, 0x6c, 0x9e, 0x57, 0x32, 0x64, 0x93,
+		0x89, 0xc4, 0xf6, 0xdd, 0x07, 0x9c, 0x96, 0x30, 0x49, 0x80, 0x1b, 0x89, 0x34, 0x05, 0x91, 0x54,
+		0x8b, 0x2f, 0xed, 0x57, 0x05, 0x98, 0xd7, 0xb1, 0x69, 0xa5, 0x88, 0x5d, 0x86, 0x51, 0x9e, 0xc1,
+		0x2b, 0xdc, 0xf7, 0xcf, 0x66, 0xad, 0xd0, 0xdd, 0x07, 0xdc, 0xe9, 0x39, 0x6c, 0xec, 0x56, 0x50,
+		0x88, 0xdf, 0x0a, 0xd8, 0xe6, 0x74, 0x5b, 0xcc, 0x0c, 0x32, 0x14, 0xcb, 0xc8, 0x3c, 0x25, 0x46,
+		0xe5, 0x0a, 0xa3, 0x87, 0xb0, 0x68, 0x3b, 0x0c, 0xc2, 0x6e, 0x63, 0x83, 0xe5, 0xa2, 0x91, 0x53,
+		0x61, 0x74, 0xf0, 0xa9, 0x70, 0xaa, 0x8b, 0xbc, 0xe3, 0x44, 0x0e, 0x85, 0xa7, 0x92, 0x8f, 0xfe,
+		0xa6, 0x00, 0x0b, 0x09, 0x5b, 0xc9, 0x65, 0x3a, 0x8a, 0xb1, 0x52, 0x8f, 0xf5, 0xc2, 0x7f, 0x76,
+		0xac, 0xa3, 0x0f, 0x61, 0x3e, 0x41, 0x34, 0xba, 0xd3, 0x86, 0xc9, 0x53, 0xe6, 0x7a, 0xa9, 0xf3,
+		0x5d, 0x9c, 0x62, 0xae, 0xd1, 0x34, 0x73, 0xfd, 0x59, 0x81, 0x85, 0xfb, 0x2d, 0x7f, 0x1f, 0x7f,
+		0xbe, 0x7d, 0x4b, 0x53, 0x61, 0x31, 0xa9, 0xa6, 0x8c, 0xf0, 0xbf, 0x2e, 0xc0, 0xc2, 0x2e, 0xfe,
+		0xdc, 0xdb, 0xe0, 0xe9, 0xec, 0xaf, 0x9b, 0xb0, 0xb8, 0x8b, 0xd3, 0x0d, 0x99, 0xf7, 0x7a, 0xc7,
+		0x4a, 0x27, 0xcb, 0x3a, 0xae, 0xfb, 0x98, 0x1c, 0x3c, 0x0f, 0x95, 0x9c, 0xb3, 0x70, 0x3a, 0x5d,
+		0x1a, 0xe9, 0x1f, 0x7f, 0x2c, 0xc0, 0x19, 0x1d, 0x13, 0xec, 0x58, 0x3d, 0xfb, 0x8f, 0x44, 0x8a,
+		0xf2, 0xb2, 0x1c, 0x2c, 0xd3, 0xed, 0x49, 0x7d, 0x42, 0x0c, 0x54, 0xac, 0xff, 0x56, 0x9e, 0x78,
+		0x01, 0xa6, 0x7d, 0xdc, 0x74, 0x69, 0xc2, 0x95, 0xc4, 0x68, 0xe0, 0x4a, 0x3d, 0x85, 0x87, 0xd1,
+		0xa7, 0x56, 0x78, 0x18, 0x3b, 0x72, 0xe1, 0x41, 0x5b, 0x81, 0xb3, 0x59, 0xf6, 0x94, 0x26, 0x37,
+		0x61, 0xf9, 0x36, 0xa6, 0x5b, 0xbe, 0x4b, 0x88, 0x54, 0xa4, 0xd7, 0xde, 0x61, 0x6d, 0x5e, 0xe9,
+		0xa9, 0xcd, 0x5f, 0x80, 0x69, 0x6a, 0xfa, 0xfb, 0x98, 0x76, 0x0d, 0x23, 0x13, 0x4c, 0x31, 0x2a,
+		0xe9, 0x69, 0x7f, 0x1f, 0x81, 0xd3, 0xe9, 0x3c, 0xa4, 0x37, 0x1f, 0xc2, 0xb4, 0x08, 0xcc, 0xd5,
+		0x8e, 0xe8, 0x14, 0x0c, 0x48, 0x8c, 0xfb, 0x11, 0xe3, 0x35, 0x34, 0x72, 0xb3, 0xc3, 0xef, 0xc8,
+		0xe2, 0xd4, 0x3f, 0x4e, 0x23, 0x43, 0xe8, 0x6b, 0x70, 0xaa, 0x6e, 0xda, 0x0d, 0x96, 0x2c, 0x9a,
+		0x2d, 0x82, 0x43, 0x9e, 0xe2, 0xa8, 0xf9, 0xe2, 0x51, 0x78, 0xde, 0xe2, 0x04, 0xb7, 0x18, 0xbd,
+		0x18, 0x67, 0x54, 0x4f, 0x4c, 0xa8, 0x1e, 0xcc, 0x26, 0x44, 0x4c, 0xb9, 0xbe, 0xef, 0xc4, 0x53,
+		0xb0, 0xf5, 0x0c, 0xb1, 0x7a, 0x65, 0x92, 0xeb, 0x16, 0xbd, 0xc3, 0xab, 0x1e, 0x2c, 0x64, 0x08,
+		0x98, 0xc2, 0xf7, 0x46, 0x3c, 0xc7, 0x5f, 0xcb, 0x36, 0x07, 0x63, 0x17, 0xa1, 0x1b, 0xcd, 0xfe,
+		0x3e, 0x53, 0x60, 0x55, 0x18, 0xc7, 0x4a, 0x18, 0x6d, 0xcb, 0x6d, 0x7a, 0x0d, 0x4c, 0x71, 0x8e,
+		0x7e, 0x49, 0x4e, 0x07, 0x43, 0x8f, 0x84, 0xff, 0x18, 0xbe, 0x5c, 0x0f, 0x22, 0x0f, 0xf7, 0xfc,
+		0x46, 0x13, 0x78, 0x8c, 0x6e, 0xf8, 0x45, 0x58, 0x94, 0xe5, 0x39, 0xaa, 0xe1, 0xe0, 0xc7, 0x32,
+		0x1f, 0x19, 0xe5, 0xd9, 0xea, 0x14, 0x1f, 0xbe, 0x87, 0x45, 0xf8, 0xd2, 0x7c, 0x58, 0xcb, 0xa1,
+		0x6d, 0x37, 0x83, 0x1d, 0x0b, 0x2a, 0x16, 0x47, 0x5b, 0x58, 0x8e, 0xad, 0x7d, 0x43, 0x81, 0x05,
+		0x76, 0x6b, 0xef, 0x38, 0x66, 0xd3, 0xae, 0x6d, 0xb9, 0x4e, 0xdd, 0xde, 0x8f, 0x64, 0xd8, 0x35,
+		0x3e, 0x20, 0xae, 0xfc, 0x22, 0x4c, 0x82, 0x18, 0xe2, 0xcd, 0x86, 0x6d, 0x38, 0x56, 0xb7, 0x1b,
+		0x14, 0xfb, 0x41, 0x82, 0x95, 0x95, 0x06, 0xc5, 0xc8, 0xdf, 0xe2, 0x28, 0x7a, 0x80, 0xaa, 0xbd,
+		0x0b, 0x8b, 0x49, 0x09, 0xa4, 0x96, 0x1b, 0x81, 0x1b, 0x29, 0x79, 0xae, 0xd6, 0x02, 0x56, 0xfb,
+		0xa1, 0x02, 0xea, 0x7b, 0x9e, 0x65, 0x52, 0x7c, 0x34, 0xb5, 0xee, 0xc1, 0x94, 0x04, 0xe0, 0xf4,
+		0x02, 0xe5, 0xd6, 0xf2, 0x28, 0x27, 0xce, 0xf3, 0xe3, 0xb5, 0xf0, 0x83, 0x68, 0x67, 0x60, 0x39,
+		0x55, 0x1c, 0x19, 0x3a, 0xbf, 0xcd, 0x0f, 0x57, 0x16, 0x76, 0xf1, 0xb3, 0x5c, 0x06, 0x7e, 0xa8,
+		0xa6, 0x49, 0x21, 0xc5, 0xfc, 0xae, 0xc2, 0x9a, 0x43, 0xcc, 0x09, 0x83, 0xd3, 0xee, 0x19, 0x9d,
+		0xfe, 0x9f, 0x2a, 0x30, 0xdf, 0x2b, 0x88, 0x74, 0x97, 0x8b, 0x61, 0x19, 0xda, 0xe2, 0x10, 0x96,
+		0xbc, 0x17, 0x06, 0x75, 0x66, 0x81, 0x67, 0xa1, 0x4b, 0x80, 0xba, 0x12, 0x91, 0x2e, 0x6c, 0x81,
+		0xc3, 0xce, 0x86, 0x33, 0x11, 0xf0, 0x48, 0x93, 0x24, 0x00, 0x17, 0x2d, 0x9d, 0xd9, 0x70, 0x46,
+		0x82, 0xb3, 0x1e, 0xe4, 0xd9, 0x5d, 0xd3, 0x76, 0x28, 0xeb, 0x19, 0xbb, 0xbe, 0xdf, 0xf2, 0xe8,
+		0x33, 0xb6, 0xd9, 0x2f, 0x15, 0x38, 0x97, 0x29, 0xd1, 0xf3, 0x65, 0xbc, 0xeb, 0xa2, 0x63, 0x78,
+		0xa4, 0xad, 0xa0, 0x7d, 0x08, 0x4b, 0x29, 0xc8, 0x52, 0xc1, 0x2d, 0x38, 0x86, 0x1d, 0xea, 0xdb,
+		0xdd, 0xe2, 0x7a, 0xae, 0x1d, 0x2d, 0xaf, 0xfc, 0x12, 0x53, 0x3b, 0x04, 0x94, 0x9c, 0x46, 0x08,
+		0x46, 0x23, 0x12, 0xf1, 0xdf, 0x68, 0x13, 0xc6, 0x65, 0xfc, 0x18, 0x19, 0x36, 0x7e, 0x48, 0x44,
+		0xe6, 0x48, 0x28, 0x39, 0x7d, 0xa4, 0xa8, 0xf8, 0x94, 0xa2, 0xc4, 0x07, 0x70, 0x32, 0x65, 0x3e,
+		0x55, 0xff, 0x8d, 0x78, 0xea, 0x91, 0x4b, 0xca, 0xf2, 0xef, 0xce, 0xc0, 0xc4, 0x26, 0x93, 0x64,
+		0xf3, 0x7e, 0x05, 0xfd, 0x48, 0x81, 0xa5, 0xcc, 0xb7, 0x4f, 0xe8, 0x8d, 0x01, 0xd5, 0xad, 0xac,
+		0x17, 0x5c, 0xea, 0xb5, 0xe1, 0x11, 0xa5, 0x07, 0x7d, 0x15, 0x4e, 0xa6, 0xbc, 0x55, 0x41, 0x97,
+		0x07, 0x10, 0x4c, 0xbe, 0x71, 0x52, 0xcb, 0xc3, 0xa0, 0x48, 0xee, 0x51, 0x73, 0x24, 0xde, 0xe7,
+		0x0c, 0x34, 0x47, 0xd6, 0x03, 0x25, 0xf5, 0xda, 0xf0, 0x88, 0x52, 0x20, 0x13, 0x20, 0x7c, 0x87,
+		0x82, 0x56, 0xb3, 0x52, 0x90, 0xde, 0xa7, 0x2d, 0xea, 0x5a, 0x0e, 0xc8, 0x90, 0x45, 0xf8, 0xc8,
+		0x23, 0x93, 0x45, 0xe2, 0xd9, 0x8b, 0xba, 0x96, 0x03, 0x32, 0xca, 0x22, 0x78, 0x9f, 0xd1, 0x87,
+		0x45, 0xcf, 0x9b, 0x12, 0x75, 0x2d, 0x07, 0xa4, 0x64, 0xf1, 0x11, 0x4c, 0xc5, 0xde, 0x55, 0xa0,
+		0x57, 0x06, 0xd8, 0x3c, 0xc6, 0xe8, 0xd5, 0x7c, 0xc0, 0x92, 0x57, 0x1b, 0x66, 0x13, 0xaf, 0x1c,
+		0x50, 0x56, 0x7a, 0x98, 0xf5, 0x36, 0x43, 0x7d, 0x2d, 0x3f, 0x82, 0xe4, 0xfb, 0x01, 0x4c, 0x76,
+		0x1b, 0xfc, 0x28, 0xbb, 0x8b, 0x1a, 0x7f, 0xf0, 0xa0, 0xae, 0x0e, 0x06, 0x94, 0xf4, 0x7f, 0xae,
+		0xf0, 0x1e, 0x62, 0xdf, 0xbe, 0x35, 0xfa, 0xff, 0xec, 0x7b, 0x46, 0x9e, 0x47, 0x06, 0xea, 0x8d,
+		0x23, 0xe3, 0x4b, 0x29, 0xbf, 0xa3, 0xc0, 0x7c, 0x7a, 0x6f, 0x16, 0xbd, 0x3e, 0x64, 0x2b, 0x57,
+		0x48, 0x74, 0xe5, 0x48, 0x0d, 0x60, 0x1e, 0x2b, 0x32, 0x5b, 0xa0, 0x99, 0xb1, 0x62, 0x50, 0x8f,
+		0x56, 0xbd, 0x36, 0x3c, 0xa2, 0x14, 0xe8, 0x67, 0x0a, 0x9c, 0xee, 0xd7, 0x1e, 0x44, 0x6f, 0xf5,
+		0x21, 0x3d, 0xa0, 0x9b, 0xaa, 0x5e, 0x3f, 0x12, 0x6e, 0xb8, 0x39, 0x63, 0x8d, 0xb8, 0xcc, 0xcd,
+		0x99, 0xd6, 0x6c, 0x54, 0x5f, 0xcd, 0x07, 0x2c, 0x79, 0x75, 0x00, 0x25, 0x3b, 0x57, 0xe8, 0xb5,
+		0x61, 0x3b, 0x77, 0xea, 0xe5, 0x21, 0x30, 0x24, 0x6b, 0x0f, 0x4e, 0xf4, 0xf4, 0x7d, 0xd0, 0xa5,
+		0xbc, 0xfd, 0x21, 0xc1, 0xb4, 0x34, 0x5c, 0x3b, 0x09, 0x11, 0x98, 0xe9, 0x6d, 0xc0, 0xa0, 0x2c,
+		0x1a, 0x19, 0x5d, 0x1e, 0x75, 0x3d, 0x37, 0x7c, 0xa8, 0x66, 0x4f, 0x37, 0x21, 0x53, 0xcd, 0xf4,
+		0x0e, 0x8d, 0x5a, 0xca, 0x0b, 0x1e, 0xaa, 0xd9, 0x5b, 0xa9, 0xce, 0x54, 0x33, 0xa3, 0x72, 0xaf,
+		0xae, 0xe7, 0x86, 0x0f, 0x99, 0xee, 0xe2, 0x9c, 0x4c, 0x77, 0xf1, 0x70, 0x4c, 0x33, 0xcb, 0xc5,
+		0x5f, 0x87, 0xb9, 0xb4, 0xba, 0x2b, 0x2a, 0x67, 0x5a, 0x2c, 0xb3, 0x64, 0xac, 0x6e, 0x0c, 0x85,
+		0x13, 0x89, 0xae, 0xe9, 0x85, 0xc8, 0xcc, 0xe8, 0xda, 0xb7, 0x0e, 0xac, 0x5e, 0x19, 0x12, 0x2b,
+		0x34, 0x44, 0x5a, 0x21, 0x2f, 0xd3, 0x10, 0x7d, 0x4a, 0xa3, 0xea, 0xc6, 0x50, 0x38, 0x52, 0x80,
+		0x5f, 0x28, 0x70, 0x7e, 0x60, 0xad, 0x08, 0xdd, 0xc8, 0xd6, 0x2e, 0x57, 0x4d, 0x4d, 0x7d, 0xe7,
+		0xe8, 0x04, 0x42, 0x3f, 0xed, 0x2d, 0xee, 0x64, 0xfa, 0x69, 0x46, 0x1d, 0x4a, 0x5d, 0xcf, 0x0d,
+		0x1f, 0xa6, 0xe9, 0x29, 0x05, 0x97, 0xcc, 0x34, 0x3d, 0xbb, 0x56, 0xa4, 0x96, 0x87, 0x41, 0x89,
+		0xee, 0x92, 0x64, 0x21, 0xa5, 0xcf, 0x2e, 0xc9, 0xac, 0xfd, 0xa8, 0x1b, 0x43, 0xe1, 0xc4, 0x33,
+		0xc0, 0x38, 0xf7, 0x7e, 0x19, 0x60, 0x2a, 0xeb, 0xd7, 0xf2, 0x23, 0x48, 0xbe, 0x4d, 0x98, 0x8e,
+		0xd7, 0x65, 0x50, 0x76, 0xe6, 0x9a, 0x52, 0x47, 0x52, 0x2f, 0xe5, 0x84, 0x96, 0xec, 0xbe, 0xa7,
+		0xc0, 0x42, 0x46, 0x4d, 0x03, 0x65, 0xed, 0xeb, 0xfe, 0x55, 0x19, 0xf5, 0xea, 0xb0, 0x68, 0x42,
+		0x94, 0x9b, 0x6f, 0x7c, 0xf9, 0xca, 0xbe, 0x4d, 0x0f, 0x5a, 0xd5, 0x52, 0xcd, 0x6d, 0xae, 0x47,
+		0xff, 0x6e, 0x74, 0xc9, 0xb6, 0x1a, 0xeb, 0xfb, 0xae, 0xf8, 0x43, 0x55, 0xf7, 0xbf, 0x47, 0xd7,
+		0xf9, 0x8f, 0xf6, 0xe5, 0xea, 0x38, 0x1f, 0xdf, 0xf8, 0xf7, 0x00, 0xf7, 0x87, 0x50, 0x75, 0xd5,
+		0x35, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x5d, 0x6f, 0xdb, 0x36,
+		0x14, 0x9d, 0xe2, 0xd8, 0x69, 0xaf, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xbe, 0x3c, 0x03, 0x43,
+		0xb3, 0x01, 0x93, 0x11, 0xf7, 0xa5, 0x58, 0x51, 0x0c, 0x49, 0xec, 0xac, 0x6a, 0xb7, 0xc4, 0x90,
+		0x8d, 0x06, 0xdb, 0x80, 0x09, 0xb4, 0x78, 0xe5, 0x72, 0x96, 0x48, 0x81, 0xa2, 0x9c, 0xf8, 0x6d,
+		0xbf, 0x64, 0x0f, 0xfb, 0x4b, 0xfb, 0x43, 0x03, 0x25, 0x3a, 0x76, 0x3a, 0x0f, 0x7d, 0x19, 0xf6,
+		0x46, 0xde, 0x73, 0xee, 0xb9, 0xe7, 0x12, 0x97, 0x24, 0xb4, 0xf3, 0x09, 0xaa, 0x6e, 0x48, 0x19,
+		0x8a, 0x10, 0xbb, 0x34, 0xe5, 0xdd, 0xf9, 0x71, 0x37, 0x94, 0x49, 0x22, 0x85, 0x9b, 0x2a, 0xa9,
+		0x25, 0xd9, 0x33, 0x0c, 0xd7, 0x32, 0x5c, 0x9a, 0x72, 0x77, 0x7e, 0x7c, 0xf8, 0xd9, 0x54, 0xca,
+		0x69, 0x8c, 0xdd, 0x82, 0x32, 0xc9, 0xa3, 0x2e, 0xcb, 0x15, 0xd5, 0x7c, 0x99, 0xd4, 0x79, 0x0d,
+		0x1f, 0x5e, 0x49, 0x35, 0x8b, 0x62, 0x79, 0x3d, 0xb8, 0xc1, 0x30, 0x37, 0x10, 0xf9, 0x1c, 0xea,
+		0xd7, 0x36, 0x18, 0x70, 0xd6, 0x72, 0xda, 0xce, 0xd1, 0x7d, 0x1f, 0x96, 0x21, 0x8f, 0x91, 0x47,
+		0x50, 0x53, 0xb9, 0x30, 0xd8, 0x56, 0x81, 0x55, 0x55, 0x2e, 0x3c, 0xd6, 0xe9, 0x40, 0x63, 0x29,
+		0x36, 0x5e, 0xa4, 0x48, 0x08, 0x6c, 0x0b, 0x9a, 0xa0, 0x15, 0x28, 0xd6, 0x86, 0x73, 0x12, 0x6a,
+		0x3e, 0xe7, 0x7a, 0xf1, 0xaf, 0x9c, 0x4f, 0x61, 0x67, 0x48, 0x17, 0xb1, 0xa4, 0xcc, 0xc0, 0x8c,
+		0x6a, 0x5a, 0xc0, 0x0d, 0xbf, 0x58, 0x77, 0x9e, 0xc3, 0xce, 0x39, 0xe5, 0x71, 0xae, 0x90, 0xec,
+		0x43, 0x4d, 0x21, 0xcd, 0xa4, 0xb0, 0xf9, 0x76, 0x47, 0x5a, 0xb0, 0xc3, 0x50, 0x53, 0x1e, 0x67,
+		0x85, 0xc3, 0x86, 0xbf, 0xdc, 0x76, 0xfe, 0x70, 0x60, 0xfb, 0x47, 0x4c, 0x24, 0x79, 0x01, 0xb5,
+		0x88, 0x63, 0xcc, 0xb2, 0x96, 0xd3, 0xae, 0x1c, 0xd5, 0x7b, 0x5f, 0xba, 0x1b, 0xce, 0xcf, 0x35,
+		0x54, 0xf7, 0xbc, 0xe0, 0x0d, 0x84, 0x56, 0x0b, 0xdf, 0x26, 0x1d, 0x5e, 0x41, 0x7d, 0x2d, 0x4c,
+		0x9a, 0x50, 0x99, 0xe1, 0xc2, 0xba, 0x30, 0x4b, 0xd2, 0x83, 0xea, 0x9c, 0xc6, 0x39, 0x16, 0x06,
+		0xea, 0xbd, 0x4f, 0x36, 0xca, 0xdb, 0x36, 0xfd, 0x92, 0xfa, 0xed, 0xd6, 0x33, 0xa7, 0xf3, 0xa7,
+		0x03, 0xb5, 0x97, 0x48, 0x19, 0x2a, 0xf2, 0xdd, 0x3b, 0x16, 0x9f, 0x6c, 0xd4, 0x28, 0xc9, 0xff,
+		0xaf, 0xc9, 0xbf, 0x1c, 0x68, 0x8e, 0x90, 0xaa, 0xf0, 0xed, 0x89, 0xd6, 0x8a, 0x4f, 0x72, 0x8d,
+		0x19, 0x09, 0x60, 0x97, 0x0b, 0x86, 0x37, 0xc8, 0x82, 0x3b, 0xb6, 0x9f, 0x6d, 0x54, 0x7d, 0x37,
+		0xdd, 0xf5, 0xca, 0xdc, 0xf5, 0x3e, 0x1e, 0xf0, 0xf5, 0xd8, 0xe1, 0xaf, 0x40, 0xfe, 0x49, 0xfa,
+		0x0f, 0xbb, 0x8a, 0xe0, 0x5e, 0x9f, 0x6a, 0x7a, 0x1a, 0xcb, 0x09, 0x39, 0x87, 0x07, 0x28, 0x42,
+		0xc9, 0xb8, 0x98, 0x06, 0x7a, 0x91, 0x96, 0x03, 0xba, 0xdb, 0xfb, 0x62, 0xa3, 0xd6, 0xc0, 0x32,
+		0xcd, 0x44, 0xfb, 0x0d, 0x5c, 0xdb, 0xdd, 0x0e, 0xf0, 0xd6, 0xda, 0x00, 0x0f, 0xcb, 0x4b, 0x87,
+		0xea, 0x0d, 0xaa, 0x8c, 0x4b, 0xe1, 0x89, 0x48, 0x1a, 0x22, 0x4f, 0xd2, 0x78, 0x79, 0x11, 0xcc,
+		0x9a, 0x3c, 0x81, 0x87, 0x11, 0x52, 0x9d, 0x2b, 0x0c, 0xe6, 0x25, 0xd5, 0x5e, 0xb8, 0x5d, 0x1b,
+		0xb6, 0x02, 0x9d, 0xd7, 0xf0, 0x78, 0x94, 0xa7, 0xa9, 0x54, 0x1a, 0xd9, 0x59, 0xcc, 0x51, 0x68,
+		0x8b, 0x64, 0xe6, 0xae, 0x4e, 0x65, 0x90, 0xb1, 0x99, 0x55, 0xae, 0x4e, 0xe5, 0x88, 0xcd, 0xc8,
+		0x01, 0xdc, 0xfb, 0x8d, 0xce, 0x69, 0x01, 0x94, 0x9a, 0x3b, 0x66, 0x3f, 0x62, 0xb3, 0xce, 0xef,
+		0x15, 0xa8, 0xfb, 0xa8, 0xd5, 0x62, 0x28, 0x63, 0x1e, 0x2e, 0x48, 0x1f, 0x9a, 0x5c, 0x70, 0xcd,
+		0x69, 0x1c, 0x70, 0xa1, 0x51, 0xcd, 0x69, 0xe9, 0xb2, 0xde, 0x3b, 0x70, 0xcb, 0xe7, 0xc5, 0x5d,
+		0x3e, 0x2f, 0x6e, 0xdf, 0x3e, 0x2f, 0xfe, 0x43, 0x9b, 0xe2, 0xd9, 0x0c, 0xd2, 0x85, 0xbd, 0x09,
+		0x0d, 0x67, 0x32, 0x8a, 0x82, 0x50, 0x62, 0x14, 0xf1, 0xd0, 0xd8, 0x2c, 0x6a, 0x3b, 0x3e, 0xb1,
+		0xd0, 0xd9, 0x0a, 0x31, 0x65, 0x13, 0x7a, 0xc3, 0x93, 0x3c, 0x59, 0x95, 0xad, 0xbc, 0xb7, 0xac,
+		0x4d, 0xb9, 0x2d, 0xfb, 0xd5, 0x4a, 0x85, 0x6a, 0x8d, 0x49, 0xaa, 0xb3, 0xd6, 0x76, 0xdb, 0x39,
+		0xaa, 0xde, 0x52, 0x4f, 0x6c, 0x98, 0xbc, 0x80, 0x8f, 0x85, 0x14, 0x81, 0x32, 0xad, 0xd3, 0x49,
+		0x8c, 0x01, 0x2a, 0x25, 0x55, 0x50, 0x3e, 0x29, 0x59, 0xab, 0xda, 0xae, 0x1c, 0xdd, 0xf7, 0x5b,
+		0x42, 0x0a, 0x7f, 0xc9, 0x18, 0x18, 0x82, 0x5f, 0xe2, 0xe4, 0x15, 0xec, 0xe1, 0x4d, 0xca, 0x4b,
+		0x23, 0x2b, 0xcb, 0xb5, 0xf7, 0x59, 0x26, 0xab, 0xac, 0xa5, 0xeb, 0xaf, 0xaf, 0xa1, 0xb1, 0x3e,
+		0x53, 0xe4, 0x00, 0x1e, 0x0d, 0x2e, 0xce, 0x2e, 0xfb, 0xde, 0xc5, 0xf7, 0xc1, 0xf8, 0xa7, 0xe1,
+		0x20, 0xf0, 0x2e, 0xde, 0x9c, 0xfc, 0xe0, 0xf5, 0x9b, 0x1f, 0x90, 0x43, 0xd8, 0xbf, 0x0b, 0x8d,
+		0x5f, 0xfa, 0xde, 0xf9, 0xd8, 0xbf, 0x6a, 0x3a, 0x64, 0x1f, 0xc8, 0x5d, 0xec, 0xd5, 0xe8, 0xf2,
+		0xa2, 0xb9, 0x45, 0x5a, 0xf0, 0xd1, 0xdd, 0xf8, 0xd0, 0xbf, 0x1c, 0x5f, 0x3e, 0x6d, 0x56, 0x4e,
+		0x7f, 0x81, 0xc7, 0xa1, 0x4c, 0x36, 0x0d, 0xf9, 0x69, 0xfd, 0xac, 0xf8, 0x6d, 0x86, 0xa6, 0x81,
+		0xa1, 0xf3, 0xf3, 0xf1, 0x94, 0xeb, 0xb7, 0xf9, 0xc4, 0x0d, 0x65, 0xd2, 0x5d, 0xff, 0x9b, 0xbe,
+		0xe1, 0x2c, 0xee, 0x4e, 0x65, 0xf9, 0xe3, 0xd8, 0x8f, 0xea, 0x39, 0x4d, 0xf9, 0xfc, 0x78, 0x52,
+		0x2b, 0x62, 0x4f, 0xff, 0x1e, 0x00, 0x13, 0xdb, 0xef, 0xb7, 0xcc, 0x06, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/visibility.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+		0x14, 0x85, 0x71, 0x69, 0x23, 0x71, 0x53, 0xa8, 0x35, 0x08, 0x02, 0xae, 0x20, 0xc8, 0x62, 0x51,
+		0x21, 0x31, 0x96, 0xcb, 0xb2, 0x0b, 0x94, 0x60, 0x83, 0x46, 0x84, 0x24, 0x38, 0x6e, 0x4a, 0xd8,
+		0x58, 0x63, 0x7b, 0x1a, 0x46, 0x8c, 0x3d, 0x96, 0x3d, 0x4e, 0xdb, 0xa7, 0xe0, 0x3d, 0x79, 0x0a,
+		0xe4, 0xbf, 0x0a, 0x09, 0x57, 0xec, 0xec, 0x73, 0xcf, 0xf9, 0x34, 0xf7, 0x07, 0x5e, 0x97, 0x21,
+		0xcb, 0xad, 0x88, 0xc6, 0x2c, 0x8d, 0x98, 0x45, 0x33, 0x6e, 0xed, 0x6c, 0x6b, 0xc7, 0x0b, 0x1e,
+		0x72, 0xc1, 0xd5, 0x0d, 0xce, 0x72, 0xa9, 0x24, 0x7a, 0x5c, 0xb9, 0x70, 0xeb, 0xc2, 0x34, 0xe3,
+		0x78, 0x67, 0x1b, 0xe3, 0xad, 0x94, 0x5b, 0xc1, 0xac, 0xda, 0x12, 0x96, 0x97, 0x96, 0xe2, 0x09,
+		0x2b, 0x14, 0x4d, 0xb2, 0x26, 0x65, 0x98, 0x7d, 0xec, 0x2b, 0x99, 0xff, 0xbc, 0x14, 0xf2, 0xaa,
+		0xf1, 0x98, 0x5f, 0x61, 0x74, 0xd1, 0x2a, 0xee, 0x35, 0x8b, 0x4a, 0xc5, 0x65, 0xfa, 0x91, 0x0b,
+		0xc5, 0x72, 0x34, 0x86, 0x61, 0x67, 0x0e, 0x78, 0xfc, 0x4c, 0x7b, 0xa5, 0x9d, 0x3c, 0xf0, 0xa0,
+		0x93, 0x48, 0x8c, 0x9e, 0xc0, 0x20, 0x2f, 0xd3, 0xaa, 0xb6, 0x57, 0xd7, 0x0e, 0xf2, 0x32, 0x25,
+		0xb1, 0x79, 0x02, 0xa8, 0x43, 0xfa, 0x37, 0x19, 0x6b, 0x69, 0x08, 0xf6, 0x53, 0x9a, 0xb0, 0x16,
+		0x53, 0x7f, 0x9b, 0xbf, 0x34, 0x38, 0x5a, 0x29, 0x9a, 0x2b, 0x9f, 0x27, 0x9d, 0xef, 0x3d, 0x3c,
+		0x64, 0x34, 0x17, 0x9c, 0x15, 0x2a, 0x50, 0xbc, 0x0d, 0x0c, 0x4f, 0x0d, 0xdc, 0x74, 0x8b, 0xbb,
+		0x6e, 0xb1, 0xdf, 0x75, 0xeb, 0x1d, 0x76, 0x81, 0x4a, 0x42, 0x67, 0x30, 0x14, 0x54, 0xdd, 0xc6,
+		0xf7, 0xfe, 0x1b, 0x87, 0xc6, 0x5e, 0x09, 0xe6, 0x06, 0x0e, 0x57, 0x8a, 0xaa, 0xb2, 0x68, 0x5f,
+		0x43, 0x60, 0x50, 0xd4, 0xff, 0xf5, 0x33, 0x1e, 0x9d, 0xda, 0xb8, 0x67, 0x13, 0xf8, 0x9f, 0x09,
+		0x7e, 0x10, 0xb2, 0x60, 0x0d, 0xc8, 0x6b, 0x01, 0x6f, 0x7e, 0x6b, 0xa0, 0x93, 0x34, 0x66, 0xd7,
+		0x2c, 0x5e, 0x53, 0x51, 0xb2, 0x6a, 0x36, 0xe8, 0x25, 0x18, 0x64, 0xee, 0xb8, 0xdf, 0x5c, 0x27,
+		0x58, 0x4f, 0x66, 0xe7, 0x6e, 0xe0, 0x6f, 0x96, 0x6e, 0x40, 0xe6, 0xeb, 0xc9, 0x8c, 0x38, 0xfa,
+		0x3d, 0xf4, 0x02, 0x9e, 0xf7, 0xd4, 0x57, 0xbe, 0x47, 0xe6, 0x9f, 0x74, 0xed, 0x8e, 0xf8, 0x67,
+		0x77, 0x73, 0xb1, 0xf0, 0x1c, 0x7d, 0x0f, 0x19, 0xf0, 0xb4, 0x17, 0xef, 0xeb, 0xf7, 0xef, 0x40,
+		0x3b, 0x8b, 0xf3, 0xe9, 0xcc, 0xd5, 0xf7, 0xd1, 0x31, 0x8c, 0x7a, 0xca, 0xd3, 0xc5, 0x62, 0xa6,
+		0x1f, 0xa0, 0x31, 0x1c, 0xf7, 0x65, 0x27, 0xbe, 0xeb, 0x93, 0x2f, 0xae, 0x3e, 0x98, 0x06, 0x30,
+		0x8a, 0x64, 0xd2, 0x37, 0xac, 0xe9, 0xd1, 0xfa, 0xf6, 0xba, 0x97, 0xd5, 0x32, 0x96, 0xda, 0x77,
+		0x7b, 0xcb, 0xd5, 0x8f, 0x32, 0xc4, 0x91, 0x4c, 0xac, 0xbf, 0x6f, 0xf6, 0x2d, 0x8f, 0x85, 0xb5,
+		0x95, 0xcd, 0x85, 0xb7, 0x07, 0x7c, 0x46, 0x33, 0xbe, 0xb3, 0xc3, 0x41, 0xad, 0xbd, 0xfb, 0x33,
+		0x00, 0x46, 0x40, 0x75, 0x5d, 0x40, 0x03, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+		0x15, 0x2f, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x41, 0x8f, 0xe3, 0x58, 0xf9, 0x76, 0xb4, 0x9b, 0xc4,
+		0x51, 0xd7, 0xf2, 0xda, 0xd9, 0x6c, 0x9a, 0x75, 0xd3, 0x94, 0x26, 0xe9, 0x98, 0x89, 0x4c, 0xa9,
+		0x43, 0x2a, 0x8e, 0x17, 0x6d, 0x09, 0x5a, 0xa2, 0x2d, 0x22, 0x12, 0x29, 0x90, 0xa3, 0x24, 0xbe,
+		0x17, 0xe8, 0xb9, 0x87, 0x02, 0x45, 0x4f, 0xfd, 0x03, 0x0a, 0x14, 0x45, 0xcf, 0x45, 0x81, 0x1e,
+		0x7a, 0x29, 0x7a, 0xed, 0xb1, 0xf7, 0xfe, 0x17, 0xc5, 0x0c, 0x3f, 0x44, 0x7d, 0x52, 0x69, 0x81,
+		0xed, 0xcd, 0x7c, 0xf3, 0xfb, 0x3d, 0xbe, 0xf7, 0xe6, 0xbd, 0xdf, 0x8c, 0x68, 0x28, 0x74, 0xcf,
+		0x2c, 0x6f, 0xa7, 0x6e, 0x36, 0x2c, 0xa7, 0x6e, 0xed, 0x98, 0x1d, 0x7b, 0xe7, 0xfd, 0xee, 0xce,
+		0x07, 0xd7, 0x7b, 0x77, 0xde, 0x72, 0x3f, 0x94, 0x3a, 0x9e, 0x4b, 0x5c, 0xb4, 0x46, 0x31, 0xa5,
+		0x10, 0x53, 0x32, 0x3b, 0x76, 0xe9, 0xfd, 0xee, 0x8d, 0x3b, 0x17, 0xae, 0x7b, 0xd1, 0xb2, 0x76,
+		0x18, 0xe4, 0xac, 0x7b, 0xbe, 0xd3, 0xe8, 0x7a, 0x26, 0xb1, 0x5d, 0x27, 0x20, 0xdd, 0xb8, 0x3b,
+		0xb8, 0x4e, 0xec, 0xb6, 0xe5, 0x13, 0xb3, 0xdd, 0x09, 0x01, 0x9b, 0xa3, 0xde, 0x5c, 0x77, 0xdb,
+		0xed, 0xd8, 0xc5, 0xc8, 0xd8, 0x88, 0xe9, 0xbf, 0x6b, 0xd9, 0x3e, 0x09, 0x30, 0x85, 0x5f, 0x5f,
+		0x81, 0xf5, 0x93, 0x30, 0x5c, 0xf9, 0xa3, 0x55, 0xef, 0xd2, 0x10, 0x14, 0xe7, 0xdc, 0x45, 0x35,
+		0x40, 0x51, 0x1e, 0x86, 0x15, 0xad, 0xe4, 0xb9, 0x4d, 0x6e, 0x2b, 0xb7, 0xf7, 0xa0, 0x34, 0x22,
+		0xa5, 0xd2, 0x90, 0x1f, 0xbc, 0xfa, 0x61, 0xd0, 0x84, 0x9e, 0xc0, 0x0c, 0xb9, 0xec, 0x58, 0xf9,
+		0x0c, 0x73, 0x74, 0x6f, 0xa2, 0x23, 0xfd, 0xb2, 0x63, 0x61, 0x06, 0x47, 0xcf, 0x00, 0x7c, 0x62,
+		0x7a, 0xc4, 0xa0, 0x65, 0xc8, 0x67, 0x19, 0xf9, 0x46, 0x29, 0xa8, 0x51, 0x29, 0xaa, 0x51, 0x49,
+		0x8f, 0x6a, 0x84, 0x17, 0x18, 0x9a, 0x3e, 0x53, 0x6a, 0xbd, 0xe5, 0xfa, 0x56, 0x40, 0x9d, 0x49,
+		0xa7, 0x32, 0x34, 0xa3, 0xea, 0xb0, 0x18, 0x50, 0x7d, 0x62, 0x92, 0xae, 0x9f, 0x9f, 0xdd, 0xe4,
+		0xb6, 0x96, 0xf7, 0x76, 0xa7, 0xcb, 0x5e, 0xa4, 0x4c, 0x8d, 0x11, 0x71, 0xae, 0xde, 0x7b, 0x40,
+		0xf7, 0x61, 0xb9, 0x69, 0xfb, 0xc4, 0xf5, 0x2e, 0x8d, 0x96, 0xe5, 0x5c, 0x90, 0x66, 0x7e, 0x6e,
+		0x93, 0xdb, 0xca, 0xe2, 0xa5, 0xd0, 0x5a, 0x66, 0x46, 0xf4, 0x53, 0x58, 0xef, 0x98, 0x9e, 0xe5,
+		0x90, 0x5e, 0xf9, 0x0d, 0xdb, 0x39, 0x77, 0xf3, 0x57, 0x58, 0x0a, 0x5b, 0x23, 0xa3, 0xa8, 0x32,
+		0x46, 0xdf, 0x4e, 0xe2, 0xb5, 0xce, 0xb0, 0x11, 0x09, 0xb0, 0xdc, 0x73, 0xcb, 0x2a, 0x33, 0x9f,
+		0x5a, 0x99, 0xa5, 0x98, 0xc1, 0xaa, 0xb3, 0x0d, 0x33, 0x6d, 0xab, 0xed, 0xe6, 0x17, 0x18, 0xf1,
+		0xfa, 0xc8, 0x78, 0x8e, 0xad, 0xb6, 0x8b, 0x19, 0x0c, 0x61, 0x58, 0xf5, 0x2d, 0xd3, 0xab, 0x37,
+		0x0d, 0x93, 0x10, 0xcf, 0x3e, 0xeb, 0x12, 0xcb, 0xcf, 0x03, 0xe3, 0xde, 0x1f, 0xc9, 0xd5, 0x18,
+		0x5a, 0x88, 0xc1, 0x98, 0xf7, 0x07, 0x2c, 0xa8, 0x0c, 0xab, 0x66, 0x97, 0xb8, 0x86, 0x67, 0xf9,
+		0x16, 0x31, 0x3a, 0xae, 0xed, 0x10, 0x3f, 0x9f, 0x63, 0x3e, 0x37, 0x47, 0xfa, 0xc4, 0x14, 0x58,
+		0x65, 0x38, 0xbc, 0x42, 0xa9, 0x09, 0x03, 0xba, 0x09, 0x0b, 0x74, 0x3c, 0x0c, 0x3a, 0x1f, 0xf9,
+		0xc5, 0x4d, 0x6e, 0x6b, 0x01, 0xcf, 0x53, 0x43, 0xd9, 0xf6, 0x09, 0xda, 0x80, 0x2b, 0xb6, 0x6f,
+		0xd4, 0x3d, 0xd7, 0xc9, 0x2f, 0x6d, 0x72, 0x5b, 0xf3, 0x78, 0xce, 0xf6, 0x45, 0xcf, 0x75, 0xd0,
+		0x3e, 0xe4, 0xba, 0x9d, 0x86, 0x49, 0xc2, 0x06, 0x5b, 0x4e, 0x2d, 0x23, 0x04, 0x70, 0x56, 0xc3,
+		0x6b, 0x30, 0xd7, 0x31, 0xbb, 0xbe, 0xd5, 0xc8, 0xaf, 0x04, 0x4e, 0x83, 0xa7, 0xc2, 0x6f, 0x32,
+		0x70, 0x67, 0xb8, 0xa3, 0x5c, 0xe7, 0xdc, 0xbe, 0x08, 0x75, 0x02, 0x7d, 0x93, 0x8c, 0x36, 0x98,
+		0xcb, 0xdb, 0x23, 0x73, 0xd6, 0xc3, 0x14, 0x12, 0xc9, 0x98, 0xb0, 0xd9, 0xdb, 0xfd, 0x70, 0xb0,
+		0x5c, 0xa3, 0x37, 0x26, 0x6e, 0x97, 0x84, 0x13, 0x7a, 0x7d, 0x28, 0x11, 0x29, 0x0c, 0x00, 0xdf,
+		0x8a, 0x5d, 0x68, 0x6c, 0xd8, 0x5c, 0x31, 0x1a, 0x1c, 0xb7, 0x4b, 0xd0, 0x09, 0xdc, 0x64, 0xe1,
+		0x8d, 0xf1, 0x9e, 0x4d, 0xf3, 0xbe, 0x41, 0xd9, 0x23, 0x1c, 0x17, 0xfe, 0xc1, 0xc1, 0xda, 0x88,
+		0x36, 0xa7, 0xbb, 0xd7, 0x70, 0xdb, 0xa6, 0xed, 0x18, 0x76, 0x83, 0xd5, 0x63, 0x01, 0xcf, 0x07,
+		0x06, 0xa5, 0x81, 0xee, 0x42, 0x2e, 0x5c, 0x74, 0xcc, 0x76, 0xa0, 0x3e, 0x0b, 0x18, 0x02, 0x93,
+		0x6a, 0xb6, 0xad, 0x31, 0x72, 0x97, 0xfd, 0x5f, 0xe5, 0xee, 0x1e, 0x2c, 0xda, 0x8e, 0x4d, 0x6c,
+		0x93, 0x58, 0x0d, 0x1a, 0xd7, 0x0c, 0x9b, 0xf4, 0x5c, 0x6c, 0x53, 0x1a, 0x85, 0x5f, 0x71, 0xb0,
+		0x2e, 0x7f, 0x24, 0x96, 0xe7, 0x98, 0xad, 0xef, 0x44, 0x82, 0x07, 0x63, 0xca, 0x0c, 0xc7, 0xf4,
+		0xaf, 0x59, 0x58, 0xab, 0x5a, 0x4e, 0xc3, 0x76, 0x2e, 0x84, 0x3a, 0xb1, 0xdf, 0xdb, 0xe4, 0x92,
+		0x45, 0x74, 0x17, 0x72, 0x66, 0xf8, 0xdc, 0xab, 0x32, 0x44, 0x26, 0xa5, 0x81, 0x0e, 0x61, 0x29,
+		0x06, 0xa4, 0xea, 0x7c, 0xe4, 0x9a, 0xe9, 0xfc, 0xa2, 0x99, 0x78, 0x42, 0x2f, 0x60, 0x96, 0x6a,
+		0x6e, 0x20, 0xf5, 0xcb, 0x7b, 0x8f, 0x46, 0x8b, 0x5d, 0x7f, 0x84, 0x54, 0x5e, 0x2d, 0x1c, 0xf0,
+		0x90, 0x02, 0xab, 0x4d, 0xcb, 0xf4, 0xc8, 0x99, 0x65, 0x12, 0xa3, 0x61, 0x11, 0xd3, 0x6e, 0xf9,
+		0xa1, 0xf8, 0xdf, 0x1a, 0xa3, 0x9c, 0x97, 0x2d, 0xd7, 0x6c, 0x60, 0x3e, 0xa6, 0x49, 0x01, 0x0b,
+		0xbd, 0x82, 0xb5, 0x96, 0xe9, 0x13, 0xa3, 0xe7, 0x8f, 0x0d, 0xfa, 0x6c, 0xea, 0xa0, 0xaf, 0x52,
+		0xda, 0x51, 0xc4, 0x62, 0xf3, 0x7e, 0x08, 0xcc, 0x18, 0x4c, 0x85, 0xd5, 0x08, 0x3c, 0xcd, 0xa5,
+		0x7a, 0x5a, 0xa1, 0x24, 0x2d, 0xe0, 0x30, 0x3f, 0x79, 0xb8, 0x62, 0x12, 0x62, 0xb5, 0x3b, 0x84,
+		0x1d, 0x07, 0xb3, 0x38, 0x7a, 0x44, 0x8f, 0x80, 0x6f, 0x9b, 0x1f, 0xed, 0x76, 0xb7, 0x6d, 0x84,
+		0x26, 0x9f, 0x49, 0xfb, 0x2c, 0x5e, 0x09, 0xed, 0x42, 0x68, 0xa6, 0x67, 0x80, 0x5f, 0x6f, 0x5a,
+		0x8d, 0x6e, 0x2b, 0x8a, 0x64, 0x21, 0xfd, 0x0c, 0x88, 0x19, 0x2c, 0x0e, 0x11, 0x56, 0xac, 0x8f,
+		0x1d, 0x3b, 0x98, 0xd9, 0xc0, 0x07, 0xa4, 0xfa, 0x58, 0xee, 0x51, 0x98, 0x93, 0x17, 0xb0, 0xc8,
+		0x8a, 0x72, 0x6e, 0xda, 0xad, 0xae, 0x67, 0xe5, 0x73, 0x13, 0xb6, 0xe9, 0x30, 0xc0, 0xe0, 0x1c,
+		0x65, 0x84, 0x0f, 0xe8, 0x4b, 0xb8, 0xca, 0x1c, 0xd0, 0x5e, 0xb7, 0x3c, 0xc3, 0x6e, 0x58, 0x0e,
+		0xb1, 0xc9, 0x65, 0xa8, 0xe1, 0x88, 0xae, 0x9d, 0xb0, 0x25, 0x25, 0x5c, 0x29, 0xfc, 0x29, 0x03,
+		0xd7, 0xc3, 0xf6, 0x11, 0x9b, 0x76, 0xab, 0xf1, 0x9d, 0x0c, 0xde, 0x17, 0x09, 0xb7, 0x74, 0x38,
+		0x92, 0x5a, 0xc4, 0x7f, 0x48, 0x5c, 0x7a, 0x98, 0x22, 0x0d, 0x8e, 0x69, 0x76, 0x68, 0x4c, 0xd1,
+		0x1b, 0x08, 0xcf, 0xf6, 0x50, 0x5c, 0x3b, 0x6e, 0xcb, 0xae, 0x5f, 0xb2, 0x36, 0x5f, 0x1e, 0x13,
+		0x68, 0xa0, 0x9c, 0x4c, 0x50, 0xab, 0x0c, 0x8d, 0x57, 0x3b, 0x83, 0x26, 0x7a, 0x2a, 0x05, 0xd2,
+		0xc8, 0x9a, 0x7c, 0x01, 0x87, 0x4f, 0x85, 0xbf, 0x65, 0x62, 0x59, 0x90, 0xac, 0xba, 0xed, 0x47,
+		0xf5, 0x8a, 0xa7, 0x95, 0x4b, 0x9f, 0xd6, 0x88, 0xd8, 0x37, 0xad, 0xc3, 0x9d, 0x98, 0xf9, 0xd4,
+		0x4e, 0x7c, 0x0e, 0x8b, 0x7d, 0x43, 0x95, 0x7e, 0x47, 0xcc, 0xf9, 0xa3, 0x07, 0x6a, 0xa6, 0x7f,
+		0xa0, 0x30, 0x6c, 0xb8, 0x9e, 0x7d, 0x61, 0x3b, 0x66, 0xcb, 0x18, 0x08, 0x32, 0x5d, 0x02, 0xd6,
+		0x23, 0xaa, 0x96, 0x0c, 0xb6, 0xf0, 0xe7, 0x0c, 0x5c, 0x8f, 0x64, 0xab, 0xec, 0xd6, 0xcd, 0x96,
+		0x64, 0xfb, 0x1d, 0x93, 0xd4, 0x9b, 0xd3, 0xa9, 0xec, 0xff, 0xbf, 0x5c, 0x3f, 0x87, 0x3b, 0xfd,
+		0x11, 0x18, 0xee, 0xb9, 0x41, 0x9a, 0xb6, 0x6f, 0x24, 0xab, 0x38, 0xd9, 0xe1, 0x8d, 0xbe, 0x88,
+		0x2a, 0xe7, 0x7a, 0xd3, 0xf6, 0x43, 0x6d, 0x42, 0xb7, 0x01, 0xd8, 0xed, 0x81, 0xb8, 0xef, 0xac,
+		0xa0, 0x0b, 0x17, 0x31, 0xbb, 0xee, 0xe8, 0xd4, 0x50, 0x78, 0x05, 0xb9, 0xe4, 0xc5, 0x6d, 0x1f,
+		0xe6, 0xc2, 0xbb, 0x1f, 0xb7, 0x99, 0xdd, 0xca, 0xed, 0x7d, 0x96, 0x72, 0xf7, 0x63, 0xd7, 0xe2,
+		0x90, 0x52, 0xf8, 0x43, 0x06, 0x96, 0xfb, 0x97, 0xd0, 0x43, 0x58, 0x39, 0xb3, 0x1d, 0xd3, 0xbb,
+		0x34, 0xea, 0x4d, 0xab, 0xfe, 0xce, 0xef, 0xb6, 0xc3, 0x4d, 0x58, 0x0e, 0xcc, 0x62, 0x68, 0x45,
+		0xeb, 0x30, 0xe7, 0x75, 0x9d, 0xe8, 0x10, 0x5d, 0xc0, 0xb3, 0x5e, 0x97, 0xde, 0x36, 0x9e, 0xc3,
+		0xcd, 0x73, 0xdb, 0xf3, 0xe9, 0xc1, 0x13, 0x34, 0xbb, 0x51, 0x77, 0xdb, 0x9d, 0x96, 0xd5, 0x37,
+		0xc9, 0x79, 0x06, 0x89, 0xc6, 0x41, 0x8c, 0x00, 0x8c, 0xbe, 0x58, 0xf7, 0x2c, 0x33, 0xde, 0x9b,
+		0xf4, 0x52, 0xe6, 0x42, 0x7c, 0x28, 0xa7, 0x4b, 0x4c, 0x60, 0x6d, 0xe7, 0x62, 0xda, 0x36, 0x5d,
+		0x8c, 0x08, 0xcc, 0xc1, 0x1d, 0x00, 0x76, 0xa1, 0x26, 0xe6, 0x59, 0x2b, 0x38, 0x9d, 0xe6, 0x71,
+		0xc2, 0x52, 0xfc, 0x23, 0x07, 0x57, 0x47, 0x9d, 0xbd, 0xa8, 0x00, 0x77, 0xaa, 0xb2, 0x2a, 0x29,
+		0xea, 0x4b, 0x43, 0x10, 0x75, 0xe5, 0x8d, 0xa2, 0x9f, 0x1a, 0x9a, 0x2e, 0xe8, 0xb2, 0xa1, 0xa8,
+		0x6f, 0x84, 0xb2, 0x22, 0xf1, 0xdf, 0x43, 0x9f, 0xc3, 0xe6, 0x18, 0x8c, 0x26, 0x1e, 0xc9, 0x52,
+		0xad, 0x2c, 0x4b, 0x3c, 0x37, 0xc1, 0x93, 0xa6, 0x0b, 0x58, 0x97, 0x25, 0x3e, 0x83, 0xbe, 0x0f,
+		0x0f, 0xc7, 0x60, 0x44, 0x41, 0x15, 0xe5, 0xb2, 0x81, 0xe5, 0x9f, 0xd4, 0x64, 0x8d, 0x82, 0xb3,
+		0xc5, 0x5f, 0xf4, 0x62, 0xee, 0x53, 0xa0, 0xe4, 0x9b, 0x24, 0x59, 0x54, 0x34, 0xa5, 0xa2, 0x4e,
+		0x8a, 0x79, 0x00, 0x33, 0x26, 0xe6, 0x41, 0x54, 0x14, 0x73, 0xf1, 0x97, 0x99, 0xde, 0xef, 0x6d,
+		0xa5, 0x81, 0xad, 0x6e, 0xac, 0xb9, 0x9f, 0xc3, 0xe6, 0x49, 0x05, 0xbf, 0x3e, 0x2c, 0x57, 0x4e,
+		0x0c, 0x45, 0x32, 0xb0, 0x5c, 0xd3, 0x64, 0xa3, 0x5a, 0x29, 0x2b, 0xe2, 0x69, 0x22, 0x92, 0x1f,
+		0xc0, 0x57, 0x63, 0x51, 0x42, 0x99, 0x5a, 0xa5, 0x5a, 0xb5, 0xac, 0x88, 0xf4, 0xad, 0x87, 0x82,
+		0x52, 0x96, 0x25, 0xa3, 0xa2, 0x96, 0x4f, 0x79, 0x0e, 0x7d, 0x01, 0x5b, 0xd3, 0x32, 0xf9, 0x0c,
+		0xda, 0x86, 0x47, 0x63, 0xd1, 0x58, 0x7e, 0x25, 0x8b, 0x7a, 0x02, 0x9e, 0x45, 0xbb, 0xb0, 0x3d,
+		0x16, 0xae, 0xcb, 0xf8, 0x58, 0x51, 0x59, 0x41, 0x0f, 0x0d, 0x5c, 0x53, 0x55, 0x45, 0x7d, 0xc9,
+		0xcf, 0x14, 0xff, 0xce, 0x41, 0xbe, 0x57, 0x09, 0xfa, 0xd3, 0xa6, 0x65, 0xd7, 0x49, 0x58, 0x8c,
+		0x87, 0xf0, 0x59, 0xd2, 0x9f, 0x58, 0x51, 0x0f, 0xcb, 0x8a, 0xa8, 0x0f, 0xd7, 0x63, 0xa0, 0x6a,
+		0x83, 0x40, 0x5a, 0x82, 0xe1, 0xdc, 0x07, 0x51, 0x34, 0x58, 0xf9, 0xad, 0xa2, 0xe9, 0x34, 0xb2,
+		0x0c, 0xda, 0x83, 0xd2, 0x24, 0x74, 0x2f, 0x9f, 0x98, 0x93, 0x2d, 0xfe, 0x8e, 0x83, 0xd5, 0xa1,
+		0xa3, 0x15, 0xdd, 0x85, 0x9b, 0x55, 0x01, 0xcb, 0xaa, 0x6e, 0x88, 0xe5, 0xca, 0xa8, 0xed, 0x1c,
+		0x03, 0x10, 0x0e, 0x04, 0x55, 0xaa, 0xa8, 0x3c, 0x87, 0x1e, 0x40, 0x61, 0x14, 0x20, 0xec, 0xec,
+		0xb0, 0xd1, 0xf9, 0x0c, 0xba, 0x07, 0xb7, 0x47, 0xe1, 0xe2, 0x58, 0xf9, 0x6c, 0xf1, 0xdf, 0x19,
+		0xb8, 0x35, 0xe9, 0x23, 0x05, 0x9d, 0xa7, 0x38, 0x6f, 0xf9, 0xad, 0x2c, 0xd6, 0x74, 0xda, 0xc1,
+		0x81, 0x3f, 0xda, 0xc7, 0x35, 0x2d, 0x11, 0x79, 0xb2, 0x41, 0xc6, 0x80, 0xc5, 0xca, 0x71, 0xb5,
+		0x2c, 0xeb, 0x6c, 0x36, 0x8a, 0xf0, 0x20, 0x0d, 0x1e, 0xb4, 0x2b, 0x9f, 0xe9, 0xdb, 0xad, 0x71,
+		0xae, 0x59, 0xde, 0x74, 0xb0, 0x51, 0x09, 0x8a, 0x69, 0xe8, 0xb8, 0x0a, 0x12, 0x3f, 0x83, 0xbe,
+		0x82, 0x2f, 0xd3, 0x03, 0x57, 0x75, 0x45, 0xad, 0xc9, 0x92, 0x21, 0x68, 0x86, 0x2a, 0x9f, 0xf0,
+		0xb3, 0xd3, 0xa4, 0xab, 0x2b, 0xc7, 0x74, 0xda, 0x6a, 0x3a, 0x3f, 0x57, 0xfc, 0x0b, 0x07, 0xd7,
+		0x44, 0xd7, 0x21, 0xb6, 0xd3, 0xb5, 0x04, 0x5f, 0xb5, 0x3e, 0x28, 0xc1, 0xad, 0xcd, 0xf5, 0xd0,
+		0x7d, 0xb8, 0x17, 0xf9, 0x0f, 0xdd, 0x1b, 0x8a, 0xaa, 0xe8, 0x8a, 0xa0, 0x57, 0x70, 0xa2, 0xbe,
+		0x13, 0x61, 0x54, 0x5e, 0x24, 0x19, 0x07, 0x75, 0x1d, 0x0f, 0xc3, 0xb2, 0x8e, 0x4f, 0xc3, 0x56,
+		0x08, 0xf4, 0x72, 0x3c, 0x56, 0xc4, 0x15, 0x35, 0x56, 0x33, 0x3e, 0x5b, 0xfc, 0x3d, 0x07, 0xb9,
+		0xf0, 0x17, 0x37, 0xfb, 0x41, 0x96, 0x87, 0xab, 0x34, 0xc1, 0x4a, 0x4d, 0x37, 0xf4, 0xd3, 0xaa,
+		0xdc, 0xdf, 0xc3, 0x7d, 0x2b, 0x4c, 0xec, 0x0c, 0xbd, 0x12, 0x54, 0x27, 0xd0, 0xc5, 0x7e, 0x40,
+		0xf8, 0x16, 0x8a, 0x61, 0x60, 0x3e, 0x33, 0x11, 0x13, 0xf8, 0xc9, 0xa2, 0x1b, 0x70, 0xad, 0x0f,
+		0x73, 0x24, 0x0b, 0x58, 0x3f, 0x90, 0x05, 0x9d, 0x9f, 0x29, 0xfe, 0x96, 0x83, 0xeb, 0x91, 0xae,
+		0xd3, 0xef, 0x1d, 0x34, 0xf4, 0x46, 0xa5, 0x4b, 0x44, 0xfa, 0x39, 0x05, 0x3d, 0x82, 0xfb, 0xb1,
+		0x22, 0xeb, 0x82, 0xf6, 0xba, 0xb7, 0x57, 0x86, 0x28, 0xd4, 0xb4, 0x64, 0x36, 0xa9, 0xd0, 0x30,
+		0x04, 0x9e, 0xa3, 0x22, 0x35, 0x19, 0x8a, 0x65, 0x4d, 0xd6, 0xf9, 0x4c, 0xf1, 0x9f, 0x39, 0xd8,
+		0x48, 0x06, 0x47, 0x7f, 0xb6, 0x58, 0x8d, 0x20, 0xb4, 0x07, 0x50, 0xe8, 0x77, 0x12, 0xaa, 0xf6,
+		0x60, 0x5c, 0xbb, 0xb0, 0x3d, 0x01, 0x57, 0x53, 0x8f, 0x04, 0x55, 0xa2, 0xcf, 0x11, 0x88, 0xe7,
+		0xd0, 0x0b, 0xd8, 0x9f, 0x40, 0x39, 0x10, 0xa4, 0x5e, 0x95, 0xe3, 0xf3, 0x53, 0xd0, 0x75, 0xac,
+		0x1c, 0xd4, 0x74, 0x59, 0xe3, 0x33, 0x48, 0x06, 0x21, 0xc5, 0x41, 0xbf, 0x0e, 0x8d, 0x74, 0x93,
+		0x45, 0xcf, 0xe0, 0x49, 0x5a, 0x1c, 0x41, 0xcb, 0x28, 0xc7, 0x32, 0x4e, 0x52, 0x67, 0xd0, 0x37,
+		0xf0, 0x75, 0x0a, 0x35, 0x7c, 0xf3, 0x10, 0x77, 0x16, 0xed, 0xc3, 0xd3, 0xd4, 0xe8, 0xc5, 0x0a,
+		0x96, 0x8c, 0x63, 0x01, 0xbf, 0xee, 0x27, 0xcf, 0x21, 0x05, 0xe4, 0xb4, 0x17, 0x87, 0xea, 0x66,
+		0x8c, 0xd0, 0x85, 0x84, 0xab, 0x2b, 0x53, 0x54, 0x91, 0x1a, 0x52, 0xdc, 0xcc, 0xa3, 0x97, 0x20,
+		0x4e, 0x57, 0x8a, 0xc9, 0x8e, 0x16, 0xd0, 0x5b, 0xd0, 0x3f, 0x6d, 0x57, 0xe5, 0xb7, 0xba, 0x8c,
+		0x55, 0x21, 0xcd, 0x33, 0xa0, 0xe7, 0xf0, 0x2c, 0xb5, 0x68, 0xfd, 0xfa, 0x93, 0xa0, 0xe7, 0xd0,
+		0x53, 0x78, 0x3c, 0x81, 0x9e, 0xec, 0x91, 0xde, 0x1d, 0x47, 0x91, 0xf8, 0x45, 0xf4, 0x04, 0x76,
+		0x27, 0x10, 0xd9, 0x14, 0x1a, 0x9a, 0xae, 0x88, 0xaf, 0x4f, 0x83, 0xe5, 0xb2, 0xa2, 0xe9, 0xfc,
+		0x12, 0xfa, 0x31, 0xfc, 0x70, 0x02, 0x2d, 0x4e, 0x96, 0xfe, 0x21, 0xe3, 0xc4, 0x88, 0x51, 0x58,
+		0x0d, 0xcb, 0xfc, 0xf2, 0x14, 0x7b, 0xa2, 0x29, 0x2f, 0xd3, 0x2b, 0xb7, 0x82, 0x44, 0x78, 0x31,
+		0xd5, 0x88, 0x88, 0x47, 0x4a, 0x59, 0x1a, 0xed, 0x84, 0x47, 0x8f, 0x61, 0x67, 0x82, 0x93, 0xc3,
+		0x0a, 0x16, 0xe5, 0xf0, 0xc4, 0x8a, 0x45, 0x62, 0x15, 0x7d, 0x0d, 0x7b, 0x93, 0x48, 0x82, 0x52,
+		0xae, 0xbc, 0x91, 0xf1, 0x20, 0x0f, 0xd1, 0x63, 0x74, 0xba, 0xd4, 0x15, 0xb5, 0x5a, 0xd3, 0x0d,
+		0x4d, 0xf9, 0x56, 0xe6, 0xd7, 0xe8, 0x31, 0x9a, 0xba, 0x53, 0x51, 0xad, 0xf8, 0xab, 0xc3, 0x62,
+		0x3c, 0xf4, 0x92, 0x03, 0x45, 0x15, 0xf0, 0x29, 0xbf, 0x9e, 0xd2, 0x7b, 0xc3, 0x42, 0xd7, 0xd7,
+		0x42, 0xd7, 0xa6, 0x49, 0x47, 0x16, 0xb0, 0x78, 0x94, 0xac, 0xf8, 0x06, 0x3d, 0x75, 0xee, 0xb1,
+		0xcf, 0x47, 0x43, 0xf7, 0xaa, 0xa4, 0xc4, 0xef, 0xc2, 0x76, 0xb0, 0x6f, 0x23, 0xba, 0x60, 0x8c,
+		0xda, 0x1f, 0xc0, 0x8f, 0xa6, 0xa3, 0xc4, 0xeb, 0x42, 0x19, 0xcb, 0x82, 0x74, 0x1a, 0x5f, 0xb0,
+		0xb9, 0xe2, 0x5f, 0x39, 0x28, 0x8a, 0xa6, 0x53, 0xb7, 0x5a, 0xd1, 0xd7, 0xe5, 0x89, 0x51, 0xee,
+		0xc3, 0xd3, 0x29, 0xe6, 0x7d, 0x4c, 0xbc, 0x27, 0xa0, 0x7d, 0x2a, 0xb9, 0xa6, 0xbe, 0x56, 0x2b,
+		0x27, 0xea, 0x24, 0x42, 0x98, 0x84, 0x66, 0x5f, 0x38, 0xe6, 0xd4, 0x49, 0x84, 0x6d, 0xf7, 0xdf,
+		0x25, 0xf1, 0xa9, 0xe4, 0xa9, 0x92, 0x38, 0xf8, 0x19, 0x6c, 0xd4, 0xdd, 0xf6, 0xa8, 0x6f, 0x12,
+		0x07, 0x4b, 0x51, 0x3a, 0x55, 0xfa, 0xa3, 0xbc, 0xca, 0x7d, 0xbb, 0x7b, 0x61, 0x93, 0x66, 0xf7,
+		0xac, 0x54, 0x77, 0xdb, 0x3b, 0xc9, 0xff, 0xdf, 0x6e, 0xdb, 0x8d, 0xd6, 0xce, 0x85, 0x1b, 0xfc,
+		0x3f, 0x38, 0xfc, 0x67, 0xee, 0xbe, 0xd9, 0xb1, 0xdf, 0xef, 0x9e, 0xcd, 0x31, 0xdb, 0xe3, 0xff,
+		0x0c, 0x00, 0xcd, 0x3f, 0x1d, 0x32, 0x8c, 0x1e, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x6d, 0x6f, 0xdb, 0x36,
+		0x10, 0x9e, 0xec, 0x24, 0x4b, 0xce, 0x4b, 0xaa, 0x71, 0x7d, 0x89, 0xdd, 0xb5, 0xf5, 0xfc, 0xa1,
+		0x08, 0x8a, 0x4d, 0x46, 0x32, 0x0c, 0x18, 0xb6, 0x0f, 0x83, 0x5f, 0x82, 0x55, 0x88, 0xe3, 0x1a,
+		0xb2, 0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x87, 0x90, 0x2c, 0x0a, 0x24, 0xe5, 0xc4, 0xbf,
+		0x60, 0xff, 0x60, 0x3f, 0x66, 0xdf, 0xf6, 0xcf, 0x06, 0x52, 0x72, 0xe6, 0x25, 0x6e, 0xfa, 0x4d,
+		0xbc, 0xe7, 0x9e, 0x3b, 0xde, 0x73, 0xc7, 0x13, 0x74, 0x8a, 0x88, 0xc9, 0x6e, 0x4c, 0x28, 0xcb,
+		0x62, 0xd6, 0x25, 0x39, 0xef, 0x2e, 0x8e, 0xbb, 0x9a, 0xa8, 0x24, 0xe5, 0x4a, 0x7b, 0xb9, 0x14,
+		0x5a, 0xa0, 0xaf, 0x8c, 0x8f, 0x57, 0xf9, 0x78, 0x24, 0xe7, 0xde, 0xe2, 0xb8, 0xf5, 0x72, 0x26,
+		0xc4, 0x2c, 0x65, 0x5d, 0xeb, 0x12, 0x15, 0x1f, 0xba, 0xb4, 0x90, 0x44, 0x73, 0x91, 0x95, 0xa4,
+		0xd6, 0xab, 0xbb, 0xb8, 0xe6, 0x73, 0xa6, 0x34, 0x99, 0xe7, 0x95, 0xc3, 0xbd, 0x00, 0xd7, 0x92,
+		0xe4, 0x39, 0x93, 0xaa, 0xc4, 0x3b, 0xef, 0x61, 0x37, 0x24, 0x2a, 0x19, 0x71, 0xa5, 0x11, 0x82,
+		0xad, 0x8c, 0xcc, 0xd9, 0xa1, 0xd3, 0x76, 0x8e, 0xf6, 0x02, 0xfb, 0x8d, 0x7e, 0x80, 0xad, 0x84,
+		0x67, 0xf4, 0xb0, 0xd6, 0x76, 0x8e, 0x0e, 0x4e, 0xbe, 0xf1, 0x36, 0x5c, 0xd2, 0x5b, 0x05, 0x38,
+		0xe3, 0x19, 0x0d, 0xac, 0x7b, 0x87, 0x80, 0xbb, 0xb2, 0x9e, 0x33, 0x4d, 0x28, 0xd1, 0x04, 0x9d,
+		0xc3, 0xe3, 0x39, 0xb9, 0xc1, 0xa6, 0x6c, 0x85, 0x73, 0x26, 0xb1, 0x62, 0xb1, 0xc8, 0xa8, 0x4d,
+		0xd7, 0x38, 0xf9, 0xda, 0x2b, 0x6f, 0xea, 0xad, 0x6e, 0xea, 0x0d, 0x45, 0x11, 0xa5, 0xec, 0x82,
+		0xa4, 0x05, 0x0b, 0xbe, 0x9c, 0x93, 0x1b, 0x13, 0x50, 0x4d, 0x98, 0x9c, 0x5a, 0x5a, 0xe7, 0x3d,
+		0x34, 0x57, 0x29, 0x26, 0x44, 0x6a, 0x6e, 0x54, 0xb9, 0xcd, 0xe5, 0x42, 0x3d, 0x61, 0xcb, 0xaa,
+		0x12, 0xf3, 0x89, 0x5e, 0xc3, 0x23, 0x71, 0x9d, 0x31, 0x89, 0xaf, 0x84, 0xd2, 0xd8, 0xd6, 0x59,
+		0xb3, 0xe8, 0xbe, 0x35, 0xbf, 0x15, 0x4a, 0x8f, 0xc9, 0x9c, 0x75, 0xfe, 0xa9, 0xc3, 0xc1, 0x2a,
+		0xee, 0x54, 0x13, 0x5d, 0x28, 0xf4, 0x2d, 0xa0, 0x88, 0xc4, 0x49, 0x2a, 0x66, 0x38, 0x16, 0x45,
+		0xa6, 0xf1, 0x15, 0xcf, 0xb4, 0x8d, 0x5d, 0x0f, 0xdc, 0x0a, 0x19, 0x18, 0xe0, 0x2d, 0xcf, 0x34,
+		0x7a, 0x01, 0x20, 0x19, 0xa1, 0x38, 0x65, 0x0b, 0x96, 0xda, 0x1c, 0xf5, 0x60, 0xcf, 0x58, 0x46,
+		0xc6, 0x80, 0x9e, 0xc3, 0x1e, 0x89, 0x93, 0x0a, 0xad, 0x5b, 0x74, 0x97, 0xc4, 0x49, 0x09, 0xbe,
+		0x86, 0x47, 0x92, 0x68, 0xb6, 0xae, 0xce, 0x56, 0xdb, 0x39, 0x72, 0x82, 0x7d, 0x63, 0xbe, 0xad,
+		0x1d, 0x0d, 0x61, 0xdf, 0xc8, 0x88, 0x39, 0xc5, 0x51, 0x2a, 0xe2, 0xe4, 0x70, 0xdb, 0x6a, 0xd8,
+		0xfe, 0x68, 0x7b, 0xfc, 0x61, 0xdf, 0xf8, 0x05, 0x0d, 0x43, 0xf3, 0xa9, 0x3d, 0xa0, 0x3f, 0x1d,
+		0x78, 0x79, 0xbf, 0x30, 0x1c, 0x2d, 0x71, 0x2e, 0xb9, 0x90, 0x5c, 0x2f, 0x0f, 0x77, 0xda, 0xf5,
+		0xa3, 0xc6, 0xc9, 0xe0, 0xc1, 0xb6, 0x97, 0x2a, 0x79, 0xfd, 0x3b, 0x42, 0xf4, 0x97, 0x93, 0x2a,
+		0xca, 0x69, 0xa6, 0xe5, 0x32, 0x68, 0x45, 0x1f, 0x75, 0x68, 0x9d, 0xc3, 0xab, 0x4f, 0xd0, 0xd7,
+		0x3b, 0xba, 0x5d, 0x76, 0xf4, 0x31, 0x6c, 0x2f, 0xcc, 0x70, 0x54, 0x1a, 0x97, 0x87, 0x9f, 0x6a,
+		0x3f, 0x3a, 0x9d, 0x5f, 0xa0, 0xb1, 0x56, 0x34, 0x6a, 0xc2, 0xae, 0xd2, 0x44, 0x6a, 0xcc, 0x69,
+		0xd5, 0xb5, 0xcf, 0xed, 0xd9, 0xa7, 0xe8, 0x09, 0xec, 0xb0, 0x8c, 0x1a, 0xa0, 0x0a, 0xc2, 0x32,
+		0xea, 0xd3, 0xce, 0x5f, 0x0e, 0xc0, 0x44, 0xa4, 0x29, 0x93, 0x7e, 0xf6, 0x41, 0xa0, 0x21, 0xb8,
+		0x29, 0x51, 0x1a, 0x93, 0x38, 0x66, 0x4a, 0x61, 0xf3, 0xc6, 0xaa, 0xa9, 0x6d, 0xdd, 0x9b, 0xda,
+		0x70, 0xf5, 0x00, 0x83, 0x03, 0xc3, 0xe9, 0x59, 0x8a, 0x31, 0xa2, 0x16, 0xec, 0x72, 0xca, 0x32,
+		0x6d, 0x74, 0x2d, 0x47, 0xef, 0xf6, 0xbc, 0xa9, 0xf1, 0xf5, 0x0d, 0x8d, 0xef, 0xfc, 0xed, 0x40,
+		0x73, 0xaa, 0x79, 0x9c, 0x2c, 0x4f, 0x6f, 0x58, 0x5c, 0x98, 0x99, 0xef, 0x69, 0x2d, 0x79, 0x54,
+		0x68, 0xa6, 0xd0, 0xaf, 0xe0, 0x5e, 0x0b, 0x99, 0x30, 0x69, 0x1f, 0x19, 0x36, 0xcb, 0xa5, 0xba,
+		0xe7, 0x8b, 0x07, 0x3b, 0x18, 0x1c, 0x94, 0xb4, 0xd5, 0x19, 0x85, 0xd0, 0x54, 0xf1, 0x15, 0xa3,
+		0x45, 0xca, 0xb0, 0x16, 0xb8, 0x54, 0xcf, 0x94, 0x2d, 0x0a, 0x6d, 0xef, 0xde, 0x38, 0x69, 0xde,
+		0x7f, 0xaf, 0xd5, 0x6a, 0x0a, 0x9e, 0xae, 0xb8, 0xa1, 0x98, 0x1a, 0x66, 0x58, 0x12, 0xdf, 0xfc,
+		0x01, 0x5f, 0xac, 0xaf, 0x0a, 0xd4, 0x82, 0xa7, 0x61, 0x6f, 0x7a, 0x86, 0x47, 0xfe, 0x34, 0xc4,
+		0x67, 0xfe, 0x78, 0x88, 0xfd, 0xf1, 0x45, 0x6f, 0xe4, 0x0f, 0xdd, 0xcf, 0x50, 0x13, 0x9e, 0xdc,
+		0xc1, 0xc6, 0xef, 0x82, 0xf3, 0xde, 0xc8, 0x75, 0x36, 0x40, 0xd3, 0xd0, 0x1f, 0x9c, 0x5d, 0xba,
+		0xb5, 0x37, 0xf4, 0xbf, 0x0c, 0xe1, 0x32, 0x67, 0xff, 0xcf, 0x10, 0x5e, 0x4e, 0x4e, 0xd7, 0x32,
+		0x3c, 0x87, 0x67, 0x77, 0xb0, 0xe1, 0xe9, 0xc0, 0x9f, 0xfa, 0xef, 0xc6, 0xae, 0xb3, 0x01, 0xec,
+		0x0d, 0x42, 0xff, 0xc2, 0x0f, 0x2f, 0xdd, 0x5a, 0xff, 0x77, 0x78, 0x16, 0x8b, 0xf9, 0x26, 0x45,
+		0xfb, 0xfb, 0xb7, 0x2b, 0xc9, 0xa8, 0x32, 0x71, 0x7e, 0x3b, 0x9e, 0x71, 0x7d, 0x55, 0x44, 0x5e,
+		0x2c, 0xe6, 0xdd, 0xf5, 0x9f, 0xc0, 0x77, 0x9c, 0xa6, 0xdd, 0x99, 0x28, 0xf7, 0x72, 0xf5, 0x47,
+		0xf8, 0x99, 0xe4, 0x7c, 0x71, 0x1c, 0xed, 0x58, 0xdb, 0xf7, 0xff, 0x0e, 0x00, 0x09, 0x2b, 0x99,
+		0xfc, 0x35, 0x06, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/cluster.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x6d, 0x8b, 0xd3, 0x40,
+		0x10, 0x26, 0x17, 0x6b, 0xdb, 0xe9, 0xe1, 0x9d, 0x8b, 0x87, 0x45, 0x11, 0x6b, 0x04, 0xa9, 0x88,
+		0x09, 0x3d, 0x39, 0xc4, 0x17, 0x44, 0x7a, 0x2a, 0xf6, 0x83, 0x2f, 0xac, 0xdf, 0xfc, 0x12, 0x36,
+		0x9b, 0x69, 0xbb, 0x5c, 0x76, 0xf7, 0xd8, 0x6c, 0x0a, 0x05, 0xff, 0x82, 0xff, 0x47, 0xff, 0x9d,
+		0x64, 0x37, 0x09, 0x27, 0xf6, 0xf0, 0xbe, 0xcd, 0xcc, 0x3e, 0xcf, 0xb3, 0xcf, 0xcc, 0x30, 0xf0,
+		0xb0, 0xca, 0xd0, 0x24, 0x9c, 0xe5, 0xa8, 0x38, 0x26, 0x2c, 0x97, 0x42, 0x25, 0x9b, 0x59, 0xc2,
+		0x8b, 0xaa, 0xb4, 0x68, 0xe2, 0x73, 0xa3, 0xad, 0x26, 0x47, 0x35, 0x28, 0x6e, 0x40, 0xb1, 0x03,
+		0xc5, 0x9b, 0x59, 0xf4, 0x08, 0x06, 0x1f, 0x75, 0x69, 0x17, 0x6a, 0xa9, 0xc9, 0x1d, 0x18, 0x88,
+		0x1c, 0x95, 0x15, 0x76, 0x3b, 0x0e, 0x26, 0xc1, 0x74, 0x48, 0xbb, 0x3c, 0xfa, 0x01, 0x03, 0x2a,
+		0xd4, 0xca, 0xe1, 0x08, 0x5c, 0x33, 0xba, 0xc0, 0x06, 0xe3, 0x62, 0xf2, 0x00, 0xf6, 0x25, 0xca,
+		0x0c, 0x4d, 0xca, 0x75, 0xa5, 0xec, 0x78, 0x6f, 0x12, 0x4c, 0x7b, 0x74, 0xe4, 0x6b, 0xa7, 0x75,
+		0x89, 0xbc, 0x80, 0xbe, 0x4f, 0xcb, 0x71, 0x38, 0x09, 0xa7, 0xa3, 0xe3, 0xfb, 0xf1, 0x4e, 0x4f,
+		0x71, 0x6b, 0x88, 0xb6, 0xf8, 0xe8, 0x57, 0x00, 0x37, 0x3e, 0xf9, 0x78, 0x2d, 0xce, 0x9d, 0x89,
+		0x39, 0xec, 0xf3, 0xca, 0x18, 0x54, 0x36, 0x5d, 0xeb, 0xd2, 0x3a, 0x33, 0x57, 0x90, 0x1c, 0x35,
+		0xa4, 0xba, 0x40, 0x9e, 0xc0, 0x4d, 0x83, 0x8c, 0xaf, 0x59, 0x56, 0x60, 0xda, 0x7a, 0xdb, 0x9b,
+		0x84, 0xd3, 0x21, 0x3d, 0xec, 0x1e, 0x9a, 0x7f, 0xc9, 0x09, 0xf4, 0x8c, 0x50, 0xab, 0xff, 0x99,
+		0x6f, 0xa7, 0x44, 0x3d, 0x3a, 0xfa, 0x19, 0xc0, 0xc1, 0x3b, 0x2d, 0x99, 0x50, 0xa7, 0x8c, 0xaf,
+		0xd1, 0x79, 0x7f, 0x09, 0x77, 0x55, 0x25, 0x53, 0xbd, 0x4c, 0x85, 0x45, 0x59, 0xa6, 0x42, 0xa5,
+		0xbc, 0x7e, 0x4c, 0xb3, 0x6d, 0x2a, 0x72, 0xd7, 0x4a, 0x48, 0x8f, 0x54, 0x25, 0xbf, 0x2c, 0x17,
+		0x35, 0x60, 0xe1, 0xb9, 0xf3, 0xed, 0x22, 0x27, 0x6f, 0xe0, 0xde, 0xa5, 0x5c, 0xc5, 0x24, 0xba,
+		0xc9, 0x87, 0xf4, 0xf6, 0x0e, 0xf6, 0x67, 0x26, 0x31, 0x7a, 0x0d, 0xe4, 0x2b, 0x9a, 0x52, 0x94,
+		0xb6, 0xf6, 0xfd, 0x0d, 0xad, 0x15, 0x6a, 0x45, 0x0e, 0x21, 0x3c, 0xc3, 0x76, 0xeb, 0x75, 0x48,
+		0x6e, 0x41, 0x6f, 0xc3, 0x8a, 0xca, 0xeb, 0x0d, 0xa9, 0x4f, 0xa2, 0xb7, 0x7f, 0xb1, 0x3f, 0x20,
+		0xb3, 0x95, 0xc1, 0x1d, 0xec, 0x31, 0xf4, 0x51, 0xd5, 0xd3, 0xcb, 0x1d, 0x7f, 0x40, 0xdb, 0x34,
+		0xfa, 0x1d, 0xc0, 0xc1, 0x05, 0x09, 0x37, 0x8f, 0x31, 0xf4, 0x33, 0xc6, 0xcf, 0x50, 0xe5, 0x8d,
+		0x46, 0x9b, 0x92, 0xf7, 0x30, 0x28, 0xbd, 0x45, 0xbf, 0x98, 0xd1, 0xf1, 0xe3, 0x4b, 0xe6, 0xfe,
+		0x6f, 0x53, 0xb4, 0xa3, 0xd6, 0x32, 0x4b, 0xef, 0xb5, 0x5d, 0xdf, 0x15, 0x64, 0x9a, 0xee, 0x68,
+		0x47, 0x9d, 0x3f, 0xff, 0x7e, 0xb2, 0x12, 0x76, 0x5d, 0x65, 0x31, 0xd7, 0x32, 0xb9, 0x78, 0x75,
+		0x4f, 0x45, 0x5e, 0x24, 0x2b, 0x9d, 0xb8, 0x5b, 0xeb, 0x4e, 0xf0, 0x95, 0x0b, 0x36, 0xb3, 0xec,
+		0xba, 0xab, 0x3f, 0xfb, 0x33, 0x00, 0xaf, 0x49, 0xfd, 0x6d, 0xaa, 0x03, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4d, 0x4a, 0x2d,
+		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0x4c, 0xc9, 0xcd, 0xcc, 0xd3, 0x2f,
+		0x33, 0xd4, 0xcf, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+		0x12, 0x05, 0x29, 0xd2, 0x83, 0x2a, 0xd2, 0x03, 0x2b, 0xd2, 0x2b, 0x33, 0x54, 0xf2, 0xe4, 0x12,
+		0x0a, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0xf3, 0x80, 0x28, 0xf7, 0x2c, 0x49, 0xcd, 0x15, 0x92,
+		0xe4, 0xe2, 0x48, 0x2d, 0x4b, 0xcd, 0x2b, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60,
+		0x0e, 0x62, 0x07, 0xf3, 0x3d, 0x53, 0x84, 0x24, 0xb8, 0xd8, 0xcb, 0x20, 0x1a, 0x24, 0x98, 0x20,
+		0x32, 0x50, 0xae, 0x52, 0x09, 0x17, 0x1f, 0xaa, 0x51, 0x42, 0x8a, 0x5c, 0x3c, 0x49, 0x45, 0x89,
+		0x79, 0xc9, 0x19, 0xf1, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0x60, 0xa3, 0x78, 0x82, 0xb8, 0x21, 0x62,
+		0x21, 0x20, 0x21, 0x21, 0x7b, 0x2e, 0xd6, 0xcc, 0x92, 0xd4, 0xdc, 0x62, 0x09, 0x26, 0x05, 0x66,
+		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
+		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
+		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x18, 0x00, 0x44, 0x14, 0xd7, 0xd4, 0x3e, 0x01, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/queue.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6e, 0xdb, 0xd8,
+		0xf5, 0x36, 0xf5, 0xc7, 0x96, 0x8e, 0x3d, 0x36, 0x7d, 0x33, 0x49, 0x14, 0x27, 0x46, 0x64, 0x0d,
+		0x7e, 0x89, 0xe0, 0x49, 0xe4, 0x9f, 0xed, 0x09, 0x52, 0x34, 0x83, 0xb6, 0x34, 0x45, 0x5b, 0x1c,
+		0xcb, 0x92, 0xe6, 0x92, 0x4a, 0xe2, 0x41, 0x01, 0x82, 0x16, 0xaf, 0x1d, 0x22, 0x12, 0xa9, 0x21,
+		0x29, 0xa7, 0x7a, 0x80, 0xa6, 0xfb, 0x99, 0x6e, 0xbb, 0xe9, 0xb6, 0x40, 0x57, 0xed, 0xa2, 0xe8,
+		0xb6, 0x40, 0x57, 0xdd, 0x4e, 0x17, 0xf3, 0x12, 0x7d, 0x84, 0x82, 0x97, 0xa4, 0x44, 0x51, 0x94,
+		0x45, 0x27, 0x2e, 0xd0, 0x45, 0x77, 0xe2, 0xe1, 0x39, 0x87, 0xdf, 0xf9, 0xce, 0xbd, 0x1f, 0xcf,
+		0xa5, 0x60, 0x6b, 0x70, 0x46, 0xac, 0x9d, 0x8e, 0xaa, 0x11, 0xa3, 0x43, 0x76, 0x54, 0xad, 0xa7,
+		0x1b, 0x3b, 0x97, 0xbb, 0x3b, 0xdf, 0x0e, 0xc8, 0x80, 0x54, 0xfa, 0x96, 0xe9, 0x98, 0xe8, 0xb6,
+		0xeb, 0x52, 0xf1, 0x5d, 0x2a, 0xd4, 0xa5, 0x72, 0xb9, 0xbb, 0xf1, 0xf0, 0xc2, 0x34, 0x2f, 0xba,
+		0x64, 0x87, 0x3a, 0x9d, 0x0d, 0xce, 0x77, 0x1c, 0xbd, 0x47, 0x6c, 0x47, 0xed, 0xf5, 0xbd, 0xb8,
+		0x8d, 0xe2, 0x64, 0xea, 0xbe, 0xee, 0x26, 0xee, 0x98, 0xbd, 0x9e, 0x69, 0xf8, 0x1e, 0x5b, 0x71,
+		0x1e, 0x6f, 0x74, 0xdb, 0x31, 0xad, 0xa1, 0xef, 0x52, 0x8a, 0x73, 0x79, 0x67, 0x5a, 0x6f, 0xcf,
+		0xbb, 0xe6, 0x3b, 0xcf, 0xa7, 0xf4, 0x63, 0x0a, 0x3e, 0xe5, 0x2d, 0xd3, 0xb6, 0xf9, 0xee, 0xc0,
+		0x76, 0x88, 0x25, 0xab, 0xf6, 0x5b, 0xd1, 0x38, 0x37, 0xd1, 0x7d, 0xc8, 0x6b, 0x66, 0x4f, 0xd5,
+		0x0d, 0x45, 0xd7, 0x0a, 0x4c, 0x91, 0x29, 0xe7, 0x71, 0xce, 0x33, 0x88, 0x1a, 0x6a, 0x03, 0x0a,
+		0xf2, 0x28, 0xe4, 0x57, 0xa4, 0x33, 0x70, 0x74, 0xd3, 0x28, 0xa4, 0x8a, 0x4c, 0x79, 0x79, 0xef,
+		0x51, 0x65, 0xb2, 0xe6, 0xbe, 0x5e, 0xb9, 0xdc, 0xad, 0xbc, 0xf2, 0xdd, 0x85, 0xc0, 0x1b, 0xaf,
+		0xbf, 0x8b, 0x9a, 0x50, 0x0d, 0xf2, 0x8e, 0x6a, 0xbf, 0x55, 0x9c, 0x61, 0x9f, 0x14, 0xd2, 0x45,
+		0xa6, 0xbc, 0xba, 0xf7, 0x79, 0x25, 0x96, 0xc1, 0x4a, 0x14, 0xb3, 0x3c, 0xec, 0x13, 0x9c, 0x73,
+		0xfc, 0x5f, 0x68, 0x13, 0x80, 0x66, 0xb2, 0x1d, 0xd5, 0x21, 0x85, 0x4c, 0x91, 0x29, 0x67, 0x31,
+		0xcd, 0x2d, 0xb9, 0x06, 0x74, 0x17, 0x96, 0xe8, 0x6d, 0x5d, 0x2b, 0x64, 0x8b, 0x4c, 0x39, 0x8d,
+		0x17, 0xdd, 0x4b, 0x51, 0x43, 0x27, 0xf0, 0xe9, 0xa5, 0x6e, 0xeb, 0x67, 0x7a, 0x57, 0x77, 0x86,
+		0xca, 0xa8, 0x2b, 0x85, 0x45, 0x5a, 0xda, 0x46, 0xc5, 0xeb, 0x5b, 0x25, 0xe8, 0x5b, 0x45, 0x0e,
+		0x3c, 0xf0, 0xad, 0x71, 0xdc, 0xc8, 0x58, 0xfa, 0x21, 0x05, 0xff, 0x1f, 0x46, 0x2a, 0x39, 0xaa,
+		0xe5, 0xf0, 0x6f, 0xf4, 0xae, 0x36, 0xe6, 0x81, 0x7c, 0x3b, 0x20, 0xb6, 0xc3, 0x39, 0x8e, 0xa5,
+		0x9f, 0x0d, 0x1c, 0x62, 0xa3, 0x32, 0xb0, 0x8e, 0x6a, 0x5d, 0x10, 0x47, 0x89, 0x36, 0x60, 0xd5,
+		0xb3, 0x57, 0x83, 0x36, 0x6c, 0x02, 0x58, 0x5e, 0xb8, 0xeb, 0x93, 0xa2, 0x3e, 0x79, 0xdf, 0x22,
+		0x6a, 0xe8, 0x09, 0x20, 0xdd, 0xd0, 0x1d, 0x5d, 0x75, 0x88, 0xa6, 0x90, 0x4b, 0x62, 0x50, 0xb7,
+		0x34, 0x2d, 0x98, 0x1d, 0xdd, 0x11, 0xdc, 0x1b, 0xa2, 0x86, 0xde, 0x33, 0xb0, 0x11, 0x75, 0x57,
+		0x47, 0xa8, 0x28, 0x87, 0xcb, 0x7b, 0xb5, 0xd8, 0xe6, 0x8e, 0xcb, 0x9a, 0x6a, 0xb3, 0x38, 0xf1,
+		0x98, 0x71, 0x95, 0xb8, 0xa0, 0xcf, 0xb8, 0x83, 0x4a, 0xf0, 0x89, 0x5f, 0xbf, 0x35, 0x30, 0x82,
+		0x16, 0xe5, 0xf1, 0xb2, 0x67, 0xc4, 0x03, 0x43, 0xd4, 0x4a, 0x5f, 0xc1, 0xee, 0x5c, 0x5e, 0xed,
+		0xbe, 0x69, 0xd8, 0x24, 0x94, 0xf8, 0x36, 0x2c, 0x5a, 0x83, 0x10, 0x9d, 0x59, 0x8b, 0xe6, 0xfa,
+		0x4b, 0x0a, 0x9e, 0x84, 0x93, 0xf1, 0xaa, 0xd1, 0x21, 0xdd, 0x1b, 0x69, 0xd0, 0x19, 0xdc, 0xf3,
+		0x3d, 0x3f, 0x7a, 0xbb, 0xdc, 0xf5, 0x12, 0x4d, 0xdd, 0x88, 0x2c, 0x82, 0x74, 0xb2, 0x45, 0x90,
+		0x99, 0xb1, 0x08, 0x2a, 0x70, 0xab, 0xe3, 0xd2, 0x38, 0xc6, 0x6b, 0x1a, 0xdd, 0x21, 0xed, 0x40,
+		0x0e, 0xaf, 0x77, 0xc2, 0x2d, 0x6e, 0x1a, 0xdd, 0x61, 0x69, 0x07, 0x9e, 0x5e, 0x49, 0x5d, 0xb4,
+		0x07, 0xa5, 0x3f, 0xa7, 0x27, 0xc9, 0x96, 0xf4, 0x0b, 0x43, 0xfd, 0x1f, 0xd9, 0x49, 0xc8, 0x46,
+		0x0f, 0x61, 0xd9, 0xa6, 0x74, 0x29, 0x86, 0xda, 0x23, 0x54, 0x93, 0xf2, 0x18, 0x3c, 0x53, 0x43,
+		0xed, 0x11, 0xf4, 0x73, 0x58, 0xf1, 0x1d, 0x74, 0xa3, 0x3f, 0x70, 0x0a, 0x4b, 0xb4, 0xe8, 0x07,
+		0xb1, 0x45, 0xb7, 0xd4, 0x61, 0xd7, 0x54, 0x35, 0xec, 0xa7, 0x14, 0xdd, 0x00, 0x54, 0x80, 0xa5,
+		0x8e, 0x69, 0x38, 0x96, 0xd9, 0x2d, 0xe4, 0x8a, 0x4c, 0x79, 0x05, 0x07, 0x97, 0xd1, 0x46, 0x4f,
+		0xb5, 0x6d, 0xaa, 0xd1, 0xff, 0x48, 0x01, 0x17, 0x8e, 0xc0, 0xa4, 0x63, 0x5a, 0x5a, 0xbc, 0x48,
+		0xf0, 0x66, 0xaf, 0xdf, 0x25, 0x0e, 0xf9, 0x6f, 0xef, 0xfe, 0xf5, 0x04, 0xb5, 0x0e, 0x6c, 0xc7,
+		0x2b, 0x4c, 0x37, 0x0d, 0xcf, 0xdd, 0x57, 0xd1, 0xad, 0x58, 0x20, 0x35, 0xef, 0xe5, 0x4d, 0xc3,
+		0xf1, 0xda, 0x38, 0x94, 0x1a, 0x4a, 0x55, 0x38, 0xb8, 0x3e, 0x9d, 0x53, 0x5d, 0xf9, 0x17, 0x03,
+		0x45, 0xae, 0xdf, 0xef, 0x0e, 0x5b, 0xaa, 0x45, 0x0c, 0x87, 0xef, 0x9a, 0x36, 0x69, 0x99, 0x5d,
+		0xbd, 0x33, 0x0c, 0x91, 0xfe, 0x08, 0xd6, 0xbc, 0x75, 0x19, 0xe5, 0xfc, 0x13, 0x6a, 0x1e, 0x51,
+		0xbe, 0x0d, 0xeb, 0x91, 0xf5, 0x3b, 0x7a, 0x0b, 0xad, 0x4d, 0xac, 0x5e, 0x51, 0x43, 0x45, 0x58,
+		0xf1, 0x7c, 0x7d, 0x05, 0xf6, 0xb6, 0x0e, 0x50, 0x1b, 0x95, 0x74, 0xf4, 0x12, 0x6e, 0xf5, 0x29,
+		0x28, 0xa5, 0xe3, 0xa2, 0x52, 0xfa, 0x14, 0x16, 0x65, 0x6c, 0x75, 0x46, 0xeb, 0xa6, 0x8a, 0xc0,
+		0xeb, 0xfd, 0xa8, 0xa9, 0xf4, 0x3d, 0x03, 0x0f, 0xe2, 0x4b, 0x76, 0x67, 0x81, 0x81, 0x8d, 0x1e,
+		0x40, 0xde, 0x27, 0x9b, 0x78, 0x85, 0xe6, 0xf0, 0xd8, 0x80, 0xda, 0xb0, 0x72, 0xae, 0xea, 0x5d,
+		0xa2, 0x29, 0x1d, 0x75, 0x60, 0x13, 0x5a, 0xdf, 0xea, 0xde, 0x5e, 0xc2, 0xb1, 0xe4, 0x90, 0x86,
+		0xf2, 0x6e, 0x24, 0x5e, 0x3e, 0x1f, 0x5f, 0x94, 0xfe, 0xca, 0xc0, 0x66, 0x3c, 0x2a, 0x7f, 0x13,
+		0xa0, 0x13, 0xc8, 0x52, 0x76, 0x28, 0xa4, 0xe5, 0xbd, 0xe7, 0x33, 0x9e, 0x38, 0xaf, 0x9b, 0xd8,
+		0xcb, 0x82, 0x8e, 0x61, 0xd1, 0xa6, 0xf5, 0xfa, 0x9b, 0x61, 0xff, 0x5a, 0xf9, 0x3c, 0xaa, 0xb0,
+		0x9f, 0xa2, 0xf4, 0x1b, 0x06, 0xf6, 0xc3, 0xa5, 0x5e, 0x59, 0x49, 0x68, 0x65, 0xb5, 0x20, 0x47,
+		0xd1, 0x58, 0xc4, 0x28, 0x30, 0xc5, 0x74, 0x79, 0x79, 0xef, 0x8b, 0x6b, 0xc1, 0xf0, 0x33, 0xe2,
+		0x51, 0x96, 0xd2, 0xdf, 0x66, 0x76, 0x17, 0x13, 0x7b, 0xd0, 0xbd, 0x71, 0x1a, 0xff, 0x43, 0xcb,
+		0xe1, 0xb7, 0x0c, 0x7c, 0x91, 0x84, 0xd0, 0xa9, 0x99, 0xe6, 0x97, 0xfe, 0x5e, 0xb5, 0x88, 0xa1,
+		0xf8, 0xfd, 0xf5, 0x88, 0xdd, 0xbf, 0x26, 0xb1, 0x2e, 0x59, 0x78, 0x35, 0xc8, 0xe5, 0xf5, 0xbb,
+		0xf4, 0xbb, 0x25, 0xb8, 0x1b, 0xad, 0x21, 0x58, 0x9f, 0xc1, 0xb0, 0xae, 0x1b, 0xe7, 0xa6, 0x4f,
+		0x6e, 0xd2, 0x61, 0xdd, 0x3d, 0x60, 0x78, 0xc3, 0xba, 0xfb, 0x0b, 0x7d, 0xc7, 0x40, 0xd1, 0x76,
+		0x27, 0x38, 0xc5, 0x93, 0x88, 0x91, 0x6e, 0x87, 0xe7, 0x4f, 0x6f, 0xd5, 0x1e, 0x25, 0x78, 0x42,
+		0x92, 0x21, 0xbb, 0xb6, 0x80, 0x37, 0xed, 0x69, 0xbf, 0x10, 0xb1, 0xbf, 0x66, 0xe0, 0x7e, 0x87,
+		0x8e, 0x33, 0xf1, 0x78, 0xd2, 0x14, 0x0f, 0x9f, 0x00, 0xcf, 0xbc, 0x79, 0xb2, 0xb6, 0x80, 0xef,
+		0x75, 0x26, 0x7d, 0x22, 0x38, 0xfc, 0x97, 0x7a, 0x2c, 0x8e, 0x4c, 0x62, 0x1c, 0xf3, 0x46, 0x2d,
+		0x17, 0x87, 0x3d, 0xe9, 0x13, 0xc2, 0xf1, 0x03, 0x03, 0x2f, 0x2c, 0xfa, 0xd2, 0x51, 0x22, 0xa2,
+		0x3f, 0x86, 0x15, 0xa8, 0xa6, 0x12, 0xcc, 0x48, 0x21, 0x9c, 0x59, 0x8a, 0xf3, 0x75, 0x02, 0x9c,
+		0x1f, 0x34, 0x29, 0xd4, 0x16, 0xf0, 0x33, 0xeb, 0x43, 0x02, 0xd1, 0x1f, 0x18, 0x78, 0xa2, 0xba,
+		0x9b, 0x42, 0x89, 0x79, 0xfd, 0xc4, 0x55, 0xe2, 0x9d, 0x05, 0xbf, 0x4a, 0x50, 0x49, 0x42, 0x59,
+		0xac, 0x2d, 0xe0, 0xc7, 0x6a, 0x32, 0xd7, 0x83, 0x15, 0x80, 0x31, 0x94, 0xd2, 0x9f, 0x72, 0x50,
+		0x98, 0xde, 0x9f, 0x9e, 0x48, 0x84, 0x0f, 0xb9, 0xcc, 0xc4, 0x21, 0x77, 0xe2, 0x98, 0x9d, 0xba,
+		0xb9, 0x63, 0x76, 0x3a, 0x7a, 0xcc, 0x8e, 0x8a, 0x65, 0xe6, 0x46, 0xc4, 0x12, 0x7d, 0x9f, 0x44,
+		0x2f, 0xb2, 0xb1, 0xe7, 0xd5, 0xc4, 0x7a, 0x11, 0x15, 0xda, 0xf9, 0x82, 0xf1, 0x7e, 0x8e, 0x60,
+		0x78, 0xcb, 0xa6, 0xfa, 0x21, 0x82, 0x11, 0x03, 0xe6, 0x0a, 0xc5, 0x78, 0x3f, 0x47, 0x31, 0x96,
+		0x12, 0x03, 0x99, 0x3b, 0xe5, 0x5f, 0x2d, 0x19, 0xff, 0xfc, 0x48, 0xc9, 0xc8, 0x51, 0xa0, 0xa7,
+		0x37, 0x26, 0x19, 0x31, 0xe8, 0x3f, 0x50, 0x33, 0xfe, 0xc8, 0xc0, 0xd3, 0xab, 0x34, 0xc3, 0x7b,
+		0x52, 0xb8, 0x96, 0x3c, 0xad, 0xe5, 0xf8, 0x23, 0x44, 0x23, 0x06, 0x7d, 0x59, 0x4d, 0xe8, 0x1b,
+		0x91, 0x0d, 0x33, 0x4e, 0x35, 0x68, 0x8d, 0x36, 0x92, 0xdc, 0xaf, 0x2f, 0xf6, 0xdb, 0xa0, 0x1f,
+		0xc1, 0x38, 0x51, 0x49, 0xb8, 0x69, 0x83, 0x09, 0x6d, 0xc5, 0x19, 0x5f, 0xd8, 0xa5, 0xef, 0xd2,
+		0x90, 0xff, 0x7a, 0x40, 0x06, 0xc4, 0x75, 0x41, 0x5f, 0x86, 0xf5, 0x87, 0xa1, 0x9a, 0xf0, 0x70,
+		0x46, 0xfa, 0x18, 0xcd, 0x41, 0x90, 0x19, 0x09, 0x57, 0x16, 0xd3, 0xdf, 0x61, 0xa9, 0x4b, 0x4f,
+		0x48, 0x1d, 0x0f, 0x6b, 0x91, 0xef, 0x79, 0x85, 0xcc, 0xdc, 0x4f, 0x79, 0xab, 0x93, 0x9f, 0xf2,
+		0xd0, 0x16, 0xac, 0x74, 0xbc, 0x0a, 0xbd, 0x83, 0xb7, 0xff, 0x3d, 0xca, 0xb7, 0xd1, 0x93, 0xf7,
+		0x0b, 0xc8, 0x7a, 0x1a, 0xb8, 0x48, 0xcb, 0xf9, 0xbf, 0x19, 0xe5, 0x8c, 0x38, 0xa0, 0xfa, 0x88,
+		0xbd, 0x18, 0x74, 0x07, 0x16, 0xdd, 0xa3, 0x38, 0xd1, 0xe8, 0xd6, 0xcc, 0x61, 0xff, 0xca, 0x3d,
+		0x8d, 0xab, 0x8e, 0x43, 0x7a, 0x7d, 0x87, 0x6e, 0x85, 0x2c, 0x0e, 0x2e, 0xdd, 0xb2, 0x2c, 0xa2,
+		0xe9, 0x76, 0x5f, 0x75, 0x3a, 0x6f, 0xbc, 0xb2, 0xf2, 0xf3, 0xcb, 0x1a, 0x87, 0xb8, 0xc6, 0xed,
+		0xf7, 0x0c, 0xe4, 0x02, 0x7e, 0xd1, 0x6d, 0x58, 0x97, 0x39, 0xe9, 0x58, 0x91, 0x4f, 0x5b, 0x82,
+		0x22, 0x36, 0x5e, 0x72, 0x75, 0xb1, 0xca, 0x2e, 0xa0, 0x3b, 0x80, 0xc6, 0x66, 0x19, 0x73, 0x0d,
+		0xe9, 0x50, 0xc0, 0x2c, 0x83, 0x6e, 0xc1, 0x5a, 0xc8, 0x2e, 0x9e, 0x08, 0x98, 0x4d, 0xa1, 0x7b,
+		0x70, 0x7b, 0x6c, 0xc4, 0x42, 0xab, 0x2e, 0xf2, 0x9c, 0x2c, 0x36, 0x1b, 0x6c, 0x1a, 0xdd, 0x87,
+		0xbb, 0xe3, 0x5b, 0x3c, 0x6e, 0x4a, 0x92, 0xc2, 0xd7, 0xdb, 0x92, 0x2c, 0x60, 0x36, 0xe3, 0x02,
+		0x59, 0x9d, 0x64, 0x06, 0x3d, 0x80, 0xc2, 0xd7, 0x6d, 0xa1, 0x2d, 0x28, 0x34, 0x4a, 0x92, 0x39,
+		0x39, 0x8c, 0x2a, 0xee, 0x6e, 0x4b, 0x68, 0x54, 0xc5, 0xc6, 0x11, 0xcb, 0xa0, 0x0d, 0xb8, 0x33,
+		0x75, 0x97, 0xe3, 0x8f, 0x85, 0x2a, 0x9b, 0x8a, 0x8d, 0x3c, 0xe0, 0xf8, 0xe3, 0xe6, 0xe1, 0x21,
+		0x9b, 0xde, 0xfe, 0x7b, 0xcc, 0xc7, 0x70, 0xca, 0xce, 0x67, 0xf0, 0x70, 0x02, 0xb4, 0x12, 0xc7,
+		0xd5, 0x2e, 0x3c, 0x9d, 0xe5, 0x24, 0xc9, 0x1c, 0x96, 0x15, 0xbe, 0x26, 0xd6, 0xab, 0x8a, 0xf0,
+		0x5a, 0xe0, 0xdb, 0x94, 0x16, 0x06, 0x3d, 0x81, 0xf2, 0xac, 0x10, 0x9e, 0x6b, 0xf0, 0x42, 0x3d,
+		0xe4, 0x9d, 0xba, 0xca, 0x5b, 0x12, 0x8f, 0x1a, 0x5c, 0xd8, 0x3b, 0x8d, 0xaa, 0xf0, 0x8b, 0x59,
+		0xde, 0x58, 0xe0, 0x9b, 0xb8, 0xea, 0xe3, 0x79, 0xd5, 0xc4, 0xc7, 0xc7, 0xf5, 0xe6, 0xab, 0x71,
+		0xb0, 0xc2, 0x37, 0x4f, 0x5a, 0x75, 0x41, 0x16, 0xd8, 0x0c, 0x7a, 0x06, 0xbb, 0xb3, 0xb2, 0x70,
+		0xad, 0x56, 0xfd, 0x54, 0x69, 0x71, 0x58, 0x68, 0xc8, 0x0a, 0x5f, 0x6f, 0x4a, 0x82, 0xd2, 0x6a,
+		0xd6, 0x45, 0xfe, 0x94, 0xcd, 0x6e, 0xff, 0x3e, 0x0d, 0xf7, 0xaf, 0x78, 0x9f, 0xa3, 0xcf, 0xe1,
+		0x71, 0x4c, 0xda, 0x43, 0x4e, 0xac, 0x0b, 0x55, 0x85, 0xe7, 0xda, 0x52, 0x98, 0xd8, 0x78, 0x0c,
+		0x13, 0xce, 0xd5, 0xe6, 0x09, 0x27, 0x36, 0x94, 0x46, 0x53, 0x56, 0x38, 0x5e, 0x16, 0x5f, 0x0a,
+		0x2c, 0x73, 0xcd, 0x30, 0xe1, 0xb5, 0x28, 0xc9, 0x12, 0x9b, 0x42, 0x5f, 0xc2, 0x4f, 0xe6, 0x85,
+		0xb9, 0x94, 0x1d, 0xba, 0x94, 0x71, 0x75, 0x2c, 0x70, 0xd5, 0x53, 0x05, 0xb7, 0x1b, 0x0d, 0x77,
+		0xf1, 0xa5, 0xd1, 0x73, 0xd8, 0x4f, 0x1c, 0x1d, 0x7a, 0x6c, 0x06, 0xfd, 0x0c, 0x7e, 0x7a, 0xed,
+		0xc7, 0x06, 0x7d, 0xaa, 0xb2, 0xd9, 0x19, 0xab, 0x6f, 0x22, 0xbe, 0xdd, 0xe0, 0x39, 0x59, 0x38,
+		0x6a, 0x62, 0xf1, 0x1b, 0xa1, 0xca, 0x2e, 0x6e, 0xff, 0xc8, 0x00, 0x3a, 0x22, 0x4e, 0xb4, 0x37,
+		0x5b, 0xb0, 0x79, 0x24, 0xc8, 0x57, 0x76, 0xe4, 0x11, 0x94, 0xe2, 0x5d, 0x24, 0x01, 0xbf, 0x14,
+		0x79, 0x41, 0x39, 0x68, 0x4b, 0xa7, 0x2c, 0x33, 0x3b, 0x95, 0x2b, 0x19, 0xcd, 0xb6, 0xcc, 0xa6,
+		0x50, 0x05, 0xb6, 0x67, 0xa4, 0xaa, 0x71, 0xb8, 0xaa, 0x34, 0x5f, 0x35, 0x04, 0x2c, 0xd5, 0xc4,
+		0x96, 0x52, 0x6f, 0x4a, 0x32, 0x9b, 0x46, 0x8f, 0xe1, 0xb3, 0x78, 0xff, 0xc9, 0xea, 0x32, 0x07,
+		0xcf, 0xbf, 0x79, 0x76, 0xa1, 0x3b, 0x6f, 0x06, 0x67, 0x95, 0x8e, 0xd9, 0xdb, 0x09, 0xff, 0x15,
+		0xf6, 0x54, 0xd7, 0xba, 0x3b, 0x17, 0xa6, 0xf7, 0xef, 0xdb, 0xe8, 0x7f, 0xbb, 0x17, 0xf4, 0xc7,
+		0xe5, 0xee, 0xd9, 0x22, 0xb5, 0xef, 0xff, 0x7b, 0x00, 0x4f, 0xbd, 0x23, 0xbd, 0xdf, 0x1b, 0x00,
+		0x00,
+	},
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x1c, 0x49,
+		0xf5, 0xdf, 0x9e, 0xb1, 0xc7, 0x9e, 0x37, 0x8e, 0x63, 0x97, 0x13, 0xc7, 0x8e, 0x9d, 0xc4, 0xe9,
+		0x64, 0x13, 0xaf, 0x63, 0xcf, 0x24, 0x4e, 0x36, 0xf9, 0x67, 0xb3, 0x1f, 0xff, 0xc4, 0xb1, 0x95,
+		0x91, 0x4c, 0x62, 0x75, 0x9c, 0x2c, 0xa0, 0x15, 0x43, 0x7b, 0xba, 0x1c, 0x37, 0x9e, 0x99, 0x9e,
+		0xed, 0xae, 0xf1, 0xc4, 0x48, 0x9c, 0x38, 0x20, 0xa1, 0x5d, 0xc1, 0x6a, 0x85, 0x60, 0x05, 0x08,
+		0x84, 0x04, 0xda, 0x45, 0x48, 0x8b, 0x16, 0x21, 0x58, 0x71, 0x01, 0x24, 0x04, 0x02, 0xb4, 0x70,
+		0xe2, 0x82, 0xc4, 0x89, 0x03, 0xdc, 0x38, 0xb0, 0xdc, 0x90, 0x50, 0x57, 0x57, 0xcf, 0x47, 0x77,
+		0x55, 0x77, 0xf5, 0xd8, 0xd9, 0x05, 0x6d, 0x6e, 0xee, 0xea, 0xf7, 0x5e, 0xff, 0xaa, 0xea, 0xbd,
+		0x57, 0xef, 0xd5, 0x7b, 0x63, 0x38, 0xd9, 0xd8, 0xc0, 0x76, 0xa1, 0xac, 0x1b, 0xb8, 0x56, 0xc6,
+		0x05, 0xbd, 0x6e, 0x16, 0x76, 0x2e, 0x14, 0xb6, 0x4c, 0x87, 0x58, 0xf6, 0x6e, 0xbe, 0x6e, 0x5b,
+		0xc4, 0x42, 0x63, 0x2e, 0x49, 0x9e, 0x91, 0xe4, 0xf5, 0xba, 0x99, 0xdf, 0xb9, 0x70, 0xf4, 0xf8,
+		0x03, 0xcb, 0x7a, 0x50, 0xc1, 0x05, 0x4a, 0xb2, 0xd1, 0xd8, 0x2c, 0x18, 0x0d, 0x5b, 0x27, 0xa6,
+		0x55, 0xf3, 0x98, 0x8e, 0x9e, 0x08, 0xbe, 0x27, 0x66, 0x15, 0x3b, 0x44, 0xaf, 0xd6, 0x19, 0xc1,
+		0x0c, 0xef, 0xc3, 0x65, 0xab, 0x5a, 0x6d, 0x89, 0x50, 0x79, 0x14, 0x44, 0x77, 0xb6, 0x2b, 0xa6,
+		0x43, 0xa2, 0x68, 0x9a, 0x96, 0xbd, 0xbd, 0x59, 0xb1, 0x9a, 0x1e, 0x8d, 0x7a, 0x13, 0x06, 0x6e,
+		0x79, 0x13, 0x42, 0x57, 0x21, 0x83, 0x77, 0x70, 0x8d, 0x38, 0x13, 0xca, 0x4c, 0x7a, 0x36, 0xb7,
+		0x78, 0x32, 0xcf, 0x99, 0x5b, 0x9e, 0x51, 0x2f, 0xbb, 0x94, 0x1a, 0x63, 0x50, 0xbf, 0xfa, 0x1c,
+		0x0c, 0x75, 0xbe, 0x40, 0x93, 0x30, 0x48, 0x5f, 0x95, 0x4c, 0x63, 0x42, 0x99, 0x51, 0x66, 0xd3,
+		0xda, 0x00, 0x7d, 0x2e, 0x1a, 0xe8, 0x2a, 0x80, 0xf7, 0xca, 0x9d, 0xf4, 0x44, 0x6a, 0x46, 0x99,
+		0xcd, 0x2d, 0x1e, 0xcd, 0x7b, 0x2b, 0x92, 0xf7, 0x57, 0x24, 0xbf, 0xee, 0xaf, 0x88, 0x96, 0xa5,
+		0xd4, 0xee, 0x33, 0x9a, 0x80, 0x81, 0x1d, 0x6c, 0x3b, 0xa6, 0x55, 0x9b, 0x48, 0x7b, 0x42, 0xd9,
+		0x23, 0x3a, 0x02, 0x03, 0xee, 0xe4, 0xdd, 0xcf, 0xf5, 0xd1, 0x37, 0x19, 0xf7, 0xb1, 0x68, 0xa0,
+		0x6f, 0x28, 0x70, 0xce, 0x9f, 0x72, 0x09, 0x3f, 0xc4, 0xe5, 0x86, 0xbb, 0x0f, 0x25, 0x87, 0xe8,
+		0x36, 0xc1, 0x46, 0xc9, 0x43, 0xa2, 0x13, 0x62, 0x9b, 0x1b, 0x0d, 0x82, 0x9d, 0x89, 0x7e, 0x8a,
+		0xe7, 0x59, 0xee, 0xd4, 0x5f, 0x64, 0x72, 0x96, 0x7d, 0x31, 0x77, 0x3d, 0x29, 0x74, 0xca, 0xd7,
+		0x5b, 0x32, 0x6e, 0x3d, 0xa1, 0x9d, 0x6d, 0xca, 0x91, 0xa2, 0xef, 0x28, 0xb0, 0xc0, 0x81, 0x57,
+		0xb6, 0xaa, 0xf5, 0x0a, 0xe6, 0x02, 0xcc, 0x50, 0x80, 0xcf, 0xcb, 0x01, 0x5c, 0xf2, 0xe5, 0x84,
+		0x21, 0x3e, 0xd5, 0x94, 0x25, 0x46, 0x6f, 0x28, 0x30, 0xc7, 0x01, 0xb9, 0xa9, 0x9b, 0x15, 0x1e,
+		0xc2, 0x01, 0x8a, 0xf0, 0x9a, 0x1c, 0xc2, 0x15, 0x2a, 0x24, 0x0c, 0xef, 0x4c, 0x53, 0x8a, 0x12,
+		0x7d, 0x9b, 0xbf, 0x80, 0xae, 0x6e, 0x19, 0x25, 0xab, 0x41, 0xc2, 0xf0, 0x06, 0x29, 0xbc, 0xe7,
+		0xe4, 0xe0, 0xb9, 0x6a, 0x67, 0xdc, 0x69, 0x90, 0x30, 0xc0, 0xd9, 0xa6, 0x24, 0x2d, 0x7a, 0x5d,
+		0x81, 0x59, 0x03, 0x97, 0x4d, 0x87, 0x02, 0x73, 0xb5, 0xd4, 0x29, 0x6f, 0x61, 0xa3, 0xc1, 0x5d,
+		0xbc, 0x2c, 0x45, 0x77, 0x95, 0x8b, 0xee, 0x26, 0x13, 0xb2, 0xae, 0x3b, 0xdb, 0x77, 0x7d, 0x11,
+		0x61, 0x64, 0xa7, 0x0d, 0x09, 0x3a, 0xf4, 0xaa, 0x02, 0x67, 0x02, 0xa8, 0x44, 0x36, 0x01, 0x14,
+		0xd3, 0x95, 0x78, 0x4c, 0x22, 0x73, 0x50, 0x8d, 0x58, 0x2a, 0xce, 0x2a, 0x45, 0x18, 0x41, 0x4e,
+		0x72, 0x95, 0x22, 0xf4, 0xff, 0xb4, 0x21, 0x41, 0x87, 0x5e, 0x0b, 0xa1, 0x8a, 0xd0, 0xac, 0x21,
+		0x8a, 0xea, 0xff, 0x62, 0x51, 0x89, 0x95, 0xea, 0x94, 0x11, 0x4f, 0x86, 0xbe, 0xa8, 0xc0, 0x93,
+		0xdd, 0x98, 0x44, 0x96, 0x78, 0x80, 0x02, 0xba, 0x1c, 0x0b, 0x48, 0x64, 0x84, 0x27, 0x8d, 0x38,
+		0x22, 0xba, 0x6d, 0x7a, 0x99, 0x98, 0x3b, 0x26, 0xd9, 0x8d, 0x55, 0xee, 0xe1, 0x88, 0x6d, 0xbb,
+		0xce, 0x84, 0xc4, 0x29, 0xb7, 0x2e, 0x41, 0x47, 0x95, 0x3b, 0x80, 0x4a, 0xa4, 0xdc, 0x07, 0x23,
+		0x94, 0xbb, 0x0b, 0x93, 0x50, 0xb9, 0xf5, 0x58, 0x2a, 0xce, 0x2a, 0x45, 0x28, 0xf7, 0x88, 0xe4,
+		0x2a, 0x45, 0x29, 0xb7, 0x2e, 0x41, 0x47, 0x15, 0xa9, 0x1b, 0x95, 0x48, 0x91, 0x46, 0x23, 0x14,
+		0xa9, 0x13, 0x92, 0x50, 0x91, 0xf4, 0x38, 0x22, 0x6a, 0x69, 0xdd, 0x60, 0x22, 0x2c, 0x0d, 0x45,
+		0x58, 0x5a, 0x27, 0x9e, 0x08, 0x4b, 0xd3, 0xe3, 0xc9, 0x50, 0x13, 0x8e, 0xbb, 0x20, 0x6c, 0xb1,
+		0xf6, 0x8c, 0x51, 0x20, 0xe7, 0xb9, 0x40, 0x5c, 0xa9, 0xb6, 0x50, 0x6d, 0xa6, 0x88, 0xf8, 0x35,
+		0x7a, 0x19, 0xa6, 0xbd, 0x0f, 0x6f, 0x9a, 0x36, 0xef, 0xb3, 0x87, 0xe8, 0x67, 0xf3, 0xe2, 0xcf,
+		0xae, 0x98, 0x76, 0x48, 0xea, 0xad, 0x27, 0xb4, 0x49, 0x22, 0x7a, 0x89, 0xbe, 0xa7, 0x40, 0x21,
+		0xa0, 0xa2, 0x7a, 0xad, 0x8c, 0x2b, 0x25, 0x1b, 0xbf, 0xdc, 0xc0, 0x0e, 0x77, 0xf6, 0x87, 0x29,
+		0x8c, 0x17, 0xe2, 0x35, 0x95, 0x4a, 0xd2, 0x7c, 0x41, 0x61, 0x5c, 0x73, 0xba, 0x34, 0x35, 0xfa,
+		0x91, 0x02, 0x97, 0x18, 0x26, 0x1f, 0xa2, 0x9c, 0x12, 0x8f, 0x53, 0xb4, 0x4b, 0x5c, 0xb4, 0xec,
+		0x6b, 0xde, 0xa7, 0x65, 0x34, 0x3a, 0x6f, 0x27, 0xe2, 0x40, 0x5f, 0x56, 0xe0, 0x2c, 0x6f, 0x79,
+		0x79, 0x40, 0x8f, 0x48, 0x6a, 0xf7, 0x12, 0x93, 0x10, 0xa3, 0xdd, 0x02, 0x32, 0xf4, 0x59, 0x38,
+		0xe1, 0x29, 0x99, 0x18, 0xc9, 0x04, 0x45, 0x72, 0x41, 0xac, 0x67, 0x62, 0x08, 0xd3, 0x24, 0xe2,
+		0x3d, 0xfa, 0x82, 0x02, 0xa7, 0xd9, 0xe6, 0x31, 0x45, 0x17, 0x6c, 0xda, 0x24, 0x45, 0xf0, 0x34,
+		0x17, 0x81, 0x27, 0xdc, 0xd3, 0x77, 0xc1, 0x36, 0xcd, 0x94, 0x63, 0x68, 0xd0, 0xe7, 0x60, 0xa6,
+		0xaa, 0xdb, 0xdb, 0xd8, 0x2e, 0xd9, 0xb8, 0x6c, 0xd9, 0x06, 0x0f, 0xc4, 0x51, 0x0a, 0x62, 0x91,
+		0x0b, 0xe2, 0x63, 0x94, 0x59, 0x63, 0xbc, 0x61, 0x04, 0xc7, 0xaa, 0x51, 0x04, 0xe8, 0x5b, 0x0a,
+		0xcc, 0xf3, 0xf2, 0x13, 0xf3, 0x41, 0x4d, 0xe7, 0x2e, 0xc8, 0x54, 0x92, 0xf0, 0xf5, 0x2e, 0x13,
+		0x23, 0x13, 0xbe, 0x0a, 0x68, 0xd1, 0x77, 0x15, 0xc8, 0x73, 0x10, 0x12, 0x6c, 0x57, 0xcd, 0x9a,
+		0xce, 0xf5, 0x0b, 0xd3, 0x11, 0x7e, 0x21, 0x1c, 0x62, 0xb7, 0x04, 0x71, 0xfc, 0x42, 0x53, 0x9a,
+		0x1a, 0xfd, 0x58, 0x81, 0x4b, 0xbc, 0x54, 0x2a, 0xd6, 0x8b, 0x1d, 0xa3, 0x68, 0x6f, 0x4a, 0x66,
+		0x54, 0x71, 0xae, 0xac, 0xd0, 0x4c, 0xc6, 0x22, 0xd2, 0x00, 0xb1, 0x51, 0x1e, 0x4f, 0xa2, 0x01,
+		0x62, 0x03, 0x9d, 0x6d, 0x4a, 0xd2, 0xa2, 0xbf, 0x2a, 0xb0, 0x1c, 0xf0, 0xb8, 0xf8, 0x21, 0xc1,
+		0x76, 0x4d, 0xaf, 0x94, 0x38, 0xc8, 0xcd, 0x9a, 0x49, 0x4c, 0xbe, 0x62, 0x9c, 0xa0, 0xd0, 0xef,
+		0xc6, 0xbb, 0xe0, 0x65, 0x26, 0x3f, 0x34, 0x9f, 0xa2, 0x2f, 0x3c, 0x3c, 0xa1, 0xe7, 0xed, 0x3d,
+		0x49, 0x40, 0x7f, 0x56, 0xe0, 0x46, 0x82, 0x69, 0x8a, 0x3c, 0xd6, 0x0c, 0x9d, 0xe3, 0xda, 0x1e,
+		0xe6, 0x28, 0x72, 0x66, 0xd7, 0xec, 0xde, 0xd9, 0xd1, 0x7b, 0x0a, 0x3c, 0x17, 0x35, 0x9d, 0x78,
+		0x3b, 0x39, 0x49, 0x27, 0xb6, 0xca, 0x9d, 0x98, 0x10, 0x4c, 0xac, 0xbd, 0x5c, 0xc1, 0xbd, 0xb1,
+		0xd2, 0x38, 0x80, 0x37, 0x0f, 0xab, 0x46, 0xcc, 0x5a, 0x03, 0x1b, 0x25, 0xdd, 0x29, 0xd5, 0x70,
+		0x33, 0x3c, 0x0f, 0x35, 0x22, 0x0e, 0x08, 0x83, 0xf0, 0xc5, 0x5d, 0x77, 0x6e, 0xe3, 0x66, 0x18,
+		0x7e, 0xbe, 0x99, 0x88, 0x03, 0xfd, 0x52, 0x81, 0xab, 0x34, 0x9a, 0x2c, 0x95, 0xb7, 0xcc, 0x8a,
+		0x91, 0xd0, 0x7e, 0x4e, 0x51, 0xe8, 0xb7, 0xb8, 0xd0, 0x69, 0x28, 0xb9, 0xe4, 0x0a, 0x4d, 0x62,
+		0x34, 0x17, 0x9d, 0xe4, 0x6c, 0xe8, 0x5d, 0x05, 0x2e, 0xc7, 0x4c, 0x42, 0x64, 0x1d, 0xa7, 0xe9,
+		0x0c, 0x96, 0x93, 0xce, 0x40, 0x64, 0x12, 0xe7, 0x9d, 0x84, 0x3c, 0xe8, 0x07, 0x0a, 0x5c, 0x10,
+		0xa2, 0x16, 0xc6, 0xf9, 0x4f, 0x52, 0xd8, 0xd7, 0xf9, 0x61, 0x08, 0xf7, 0xeb, 0xc2, 0xc0, 0x7f,
+		0xbe, 0x9c, 0x80, 0x1e, 0xbd, 0xa3, 0xc0, 0x45, 0x21, 0xdc, 0x88, 0x24, 0xf2, 0x4c, 0x84, 0x92,
+		0xf3, 0x01, 0x47, 0xa4, 0x93, 0xf9, 0x72, 0x22, 0x0e, 0xf4, 0x96, 0x02, 0xe7, 0x13, 0x6b, 0xc6,
+		0x59, 0x8a, 0xf8, 0xff, 0x13, 0x20, 0x16, 0x29, 0xc5, 0xb9, 0x72, 0x02, 0x7d, 0x78, 0x5b, 0x81,
+		0x45, 0xf1, 0x02, 0x0b, 0x0f, 0xe1, 0x59, 0x8a, 0xf6, 0x46, 0x92, 0xf5, 0x15, 0x9e, 0xc4, 0x0b,
+		0xe5, 0x24, 0x0c, 0xe8, 0x87, 0x51, 0x2a, 0x11, 0x91, 0x34, 0x3f, 0x95, 0x18, 0xb2, 0x38, 0x7d,
+		0x5e, 0x28, 0x27, 0x61, 0xa0, 0xb1, 0x99, 0x18, 0x72, 0x44, 0x24, 0x39, 0x17, 0x11, 0x9b, 0x09,
+		0x30, 0x47, 0x84, 0x93, 0x85, 0x72, 0x32, 0x16, 0x7a, 0x68, 0x7a, 0xa1, 0x78, 0xaf, 0x11, 0xcf,
+		0xb9, 0x88, 0x43, 0xd3, 0x8b, 0xb8, 0x7b, 0x09, 0x75, 0xae, 0x38, 0xbd, 0xb1, 0xa2, 0x5f, 0x29,
+		0xf0, 0x8c, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x4f, 0x67, 0x53, 0xec, 0x65, 0x36, 0x22, 0x63, 0xbd,
+		0xe4, 0xf4, 0xc0, 0x87, 0x7e, 0xaa, 0xc0, 0xd3, 0x51, 0x13, 0x10, 0xe7, 0x4f, 0x0b, 0x11, 0x07,
+		0x90, 0x10, 0x84, 0x38, 0x8f, 0x3a, 0x8f, 0x13, 0xf2, 0x50, 0x87, 0xd3, 0xa8, 0x3b, 0xd8, 0x26,
+		0x6d, 0xe0, 0x0e, 0xd6, 0xed, 0xf2, 0x56, 0x07, 0xcc, 0x30, 0xee, 0x7c, 0x84, 0xf5, 0xde, 0xa3,
+		0xe2, 0x7c, 0x04, 0x77, 0xa9, 0xb0, 0xf6, 0x17, 0x39, 0xd6, 0xdb, 0x48, 0xc2, 0x20, 0xca, 0xac,
+		0x1a, 0x75, 0x43, 0x27, 0x38, 0x2a, 0x62, 0x2c, 0x24, 0xc9, 0xac, 0xee, 0x51, 0x71, 0x89, 0x32,
+		0xab, 0x68, 0x96, 0x18, 0xdc, 0x11, 0x87, 0xe7, 0xf9, 0xe4, 0xb8, 0x23, 0x4e, 0xcf, 0x42, 0x33,
+		0x19, 0x8b, 0xa8, 0xde, 0x56, 0xd7, 0x1b, 0x0e, 0x0f, 0xed, 0x85, 0x24, 0xf5, 0xb6, 0x35, 0x2a,
+		0x44, 0xa6, 0xde, 0xc6, 0xa5, 0x14, 0x65, 0xab, 0x8d, 0x9a, 0x08, 0xdd, 0x62, 0x92, 0x6c, 0xf5,
+		0x5e, 0xad, 0xce, 0xfb, 0x2a, 0x37, 0x5b, 0x15, 0xd0, 0xde, 0x18, 0x02, 0x68, 0x7f, 0x5e, 0xfd,
+		0xed, 0x10, 0x9c, 0x95, 0x8d, 0xb5, 0x56, 0xe0, 0x40, 0x6b, 0x6a, 0x64, 0xb7, 0x8e, 0x69, 0xe5,
+		0x5a, 0x54, 0x07, 0xf7, 0x85, 0xae, 0xef, 0xd6, 0xb1, 0x36, 0xd4, 0xec, 0x78, 0x42, 0x2f, 0xc1,
+		0xe1, 0xba, 0x6e, 0xbb, 0xeb, 0xd0, 0x79, 0x44, 0x6c, 0x5a, 0xac, 0xd8, 0x3d, 0xcb, 0x95, 0xb7,
+		0x46, 0x39, 0x3a, 0x3c, 0xf8, 0xa6, 0xa5, 0x8d, 0xd5, 0xc3, 0x83, 0xe8, 0x19, 0xc8, 0xd2, 0xfb,
+		0xc3, 0x8a, 0xe9, 0x10, 0x5a, 0x06, 0xcf, 0x2d, 0x1e, 0xe3, 0x5f, 0xd0, 0xe9, 0xce, 0xf6, 0xaa,
+		0xe9, 0x10, 0x6d, 0x90, 0xb0, 0xbf, 0xd0, 0x22, 0xf4, 0x9b, 0xb5, 0x7a, 0x83, 0xd0, 0x22, 0x79,
+		0x6e, 0x71, 0x5a, 0x80, 0x64, 0xb7, 0x62, 0xe9, 0x86, 0xe6, 0x91, 0x22, 0x1d, 0x66, 0x02, 0x01,
+		0x72, 0x89, 0x58, 0xa5, 0x72, 0xc5, 0x72, 0x30, 0x8d, 0x36, 0xac, 0x06, 0x61, 0x55, 0xf3, 0xc9,
+		0x50, 0x15, 0xff, 0x26, 0xeb, 0x7b, 0xd0, 0xa6, 0x71, 0xd7, 0xda, 0xaf, 0x5b, 0x4b, 0x2e, 0xff,
+		0xba, 0xc7, 0x8e, 0x5e, 0x84, 0xa9, 0x76, 0x91, 0x26, 0x2c, 0x3d, 0x13, 0x27, 0xfd, 0x08, 0xf1,
+		0x4b, 0x2f, 0x01, 0xc1, 0xd7, 0xe0, 0x68, 0x3b, 0x1f, 0x6c, 0xcf, 0xc2, 0x6e, 0xd4, 0xdc, 0x4e,
+		0x01, 0xb7, 0x50, 0x9d, 0xd5, 0x8e, 0xb4, 0x28, 0x5a, 0xeb, 0xac, 0x35, 0x6a, 0x45, 0x03, 0x15,
+		0x21, 0xcb, 0x0e, 0x76, 0xcb, 0xa6, 0x55, 0xe3, 0xe1, 0xc5, 0x73, 0xfc, 0x40, 0x84, 0x09, 0xa0,
+		0x09, 0x5f, 0xd1, 0x67, 0xd1, 0xda, 0xdc, 0xa8, 0x08, 0xa3, 0x6d, 0x1c, 0xee, 0xe1, 0xda, 0xb0,
+		0xf1, 0x44, 0x36, 0x62, 0x0f, 0x56, 0x3c, 0x1a, 0x6d, 0xa4, 0xc5, 0xc6, 0x46, 0x90, 0x06, 0xe3,
+		0x15, 0xdd, 0xbd, 0xa1, 0xf0, 0xbc, 0x07, 0x9d, 0x0e, 0x76, 0x1a, 0x15, 0x32, 0x01, 0x11, 0xf2,
+		0xfc, 0x3d, 0x3d, 0xe4, 0xf2, 0x2e, 0xb5, 0x58, 0x35, 0xca, 0x89, 0xae, 0xc2, 0xa4, 0x65, 0x9b,
+		0x0f, 0x4c, 0x2f, 0x2c, 0x08, 0xac, 0x52, 0x8e, 0xae, 0xd2, 0xb8, 0x4f, 0x10, 0x58, 0xa4, 0xa3,
+		0x30, 0x68, 0x1a, 0xb8, 0x46, 0x4c, 0xb2, 0x4b, 0xeb, 0x9f, 0x59, 0xad, 0xf5, 0x8c, 0x2e, 0xc2,
+		0xf8, 0xa6, 0x69, 0x3b, 0x24, 0x2c, 0xf3, 0x00, 0xa5, 0x1c, 0xa3, 0x6f, 0x03, 0x02, 0x97, 0x60,
+		0xc8, 0xc6, 0xc4, 0xde, 0x2d, 0xd5, 0xad, 0x8a, 0x59, 0xde, 0x65, 0x35, 0xc3, 0x19, 0xc1, 0x75,
+		0x0a, 0xb1, 0x77, 0xd7, 0x28, 0x9d, 0x96, 0xb3, 0xdb, 0x0f, 0x6e, 0xa3, 0x88, 0x4e, 0x08, 0xae,
+		0xd6, 0x09, 0xad, 0xef, 0xf5, 0x6b, 0xfe, 0x23, 0x5a, 0x82, 0x83, 0xf8, 0x61, 0xdd, 0xf4, 0x14,
+		0xc7, 0x6b, 0x41, 0x19, 0x89, 0x6d, 0x41, 0x19, 0x6e, 0xb3, 0xb8, 0x83, 0xe8, 0x14, 0x1c, 0x28,
+		0xdb, 0xae, 0x35, 0xb0, 0xfa, 0x23, 0xad, 0x8f, 0x65, 0xb5, 0x21, 0x77, 0xd0, 0xaf, 0x49, 0xa2,
+		0x8f, 0xc3, 0x94, 0x37, 0xfb, 0xee, 0x5a, 0xed, 0x86, 0x5e, 0xde, 0xb6, 0x36, 0x37, 0x27, 0x50,
+		0x9c, 0x52, 0x4f, 0x50, 0xee, 0xce, 0x32, 0xed, 0x0d, 0x8f, 0x15, 0x2d, 0x40, 0x5f, 0x15, 0x57,
+		0x2d, 0x56, 0x7c, 0x9a, 0xe4, 0x5f, 0x4b, 0xe3, 0xaa, 0xa5, 0x51, 0x32, 0xa4, 0xc1, 0x68, 0x28,
+		0xbe, 0x60, 0x15, 0xa4, 0x27, 0xf9, 0x91, 0x5c, 0x20, 0x1e, 0xd0, 0x46, 0x9c, 0xc0, 0x08, 0xba,
+		0x07, 0xe3, 0x75, 0x1b, 0xef, 0x94, 0xf4, 0x06, 0xb1, 0x5c, 0xfd, 0xc3, 0xa4, 0x54, 0xb7, 0xcc,
+		0x1a, 0xf1, 0x6b, 0x42, 0xa2, 0xfd, 0x72, 0x30, 0x59, 0xa3, 0x74, 0xda, 0x98, 0xcb, 0x7f, 0xbd,
+		0x41, 0xac, 0x8e, 0x41, 0x74, 0x11, 0x32, 0x5b, 0x58, 0x37, 0xb0, 0xcd, 0x8a, 0x35, 0x53, 0xfc,
+		0x16, 0x24, 0x4a, 0xa2, 0x31, 0x52, 0xb4, 0x0a, 0x87, 0xbc, 0x85, 0x6e, 0x57, 0x9e, 0xe9, 0xbe,
+		0x1e, 0x89, 0xdd, 0x57, 0x44, 0xf9, 0x5a, 0x55, 0x64, 0xba, 0xb7, 0x47, 0x61, 0xb0, 0x6e, 0x9b,
+		0x96, 0xed, 0x2a, 0xf4, 0x04, 0xd5, 0x9d, 0xd6, 0xb3, 0xfa, 0x96, 0x02, 0x4f, 0xc9, 0x67, 0xc1,
+		0x97, 0x20, 0xc3, 0x2c, 0x53, 0x91, 0xb0, 0x4c, 0x46, 0x8b, 0x56, 0x60, 0x26, 0xba, 0x0d, 0xc2,
+		0x34, 0xe8, 0x39, 0x92, 0xd6, 0xa6, 0xc5, 0x1d, 0x0c, 0x45, 0x43, 0x7d, 0x53, 0x81, 0x33, 0x92,
+		0xc1, 0xf4, 0x65, 0x18, 0xf0, 0x7d, 0x92, 0x22, 0xe1, 0x93, 0x7c, 0xe2, 0x7d, 0x83, 0x6a, 0xc1,
+		0xac, 0x74, 0x26, 0xb9, 0x04, 0x43, 0xec, 0x58, 0x68, 0x1f, 0xd1, 0xc3, 0x02, 0x75, 0x63, 0xa7,
+		0x00, 0x3d, 0xa1, 0x73, 0xa4, 0xfd, 0xa0, 0xfe, 0x5e, 0x81, 0xd3, 0x32, 0xcd, 0x34, 0xdd, 0x67,
+		0xad, 0x92, 0xec, 0xac, 0xbd, 0x0d, 0xe3, 0x82, 0xf3, 0x2c, 0x15, 0x67, 0xfa, 0x63, 0x0e, 0xe7,
+		0x2c, 0xeb, 0xf0, 0x69, 0xe9, 0x2e, 0x9f, 0xa6, 0xbe, 0xaa, 0x80, 0x1a, 0xdf, 0x87, 0x83, 0xe6,
+		0x01, 0x05, 0x7b, 0x33, 0x5a, 0xdd, 0x79, 0x23, 0x4e, 0xd7, 0x12, 0x04, 0x1c, 0x7b, 0x2a, 0xe0,
+		0xd8, 0x8f, 0x01, 0xf8, 0x17, 0xe5, 0xa6, 0x41, 0xd1, 0x64, 0xb5, 0x2c, 0x1b, 0x29, 0x1a, 0xea,
+		0x3f, 0x02, 0xcb, 0x2b, 0xb4, 0x90, 0x64, 0x88, 0x66, 0x61, 0xa4, 0xfb, 0x7e, 0xae, 0xa5, 0x5e,
+		0xc3, 0x4e, 0xc7, 0x8c, 0x03, 0xd8, 0xd3, 0x01, 0xec, 0x67, 0xe1, 0xe0, 0x86, 0x59, 0xd3, 0xed,
+		0xdd, 0x52, 0x79, 0x0b, 0x97, 0xb7, 0x9d, 0x46, 0x95, 0x06, 0x43, 0x59, 0x6d, 0xd8, 0x1b, 0x5e,
+		0x62, 0xa3, 0xe8, 0x1c, 0x8c, 0x76, 0xdf, 0x2a, 0xe3, 0x87, 0x5e, 0xa0, 0x33, 0xa4, 0x8d, 0xe0,
+		0xce, 0xcb, 0x5e, 0xfc, 0x90, 0xa8, 0xaf, 0xa4, 0xe1, 0x94, 0x44, 0x8b, 0xcf, 0x23, 0x9b, 0x71,
+		0xd0, 0x2c, 0xd2, 0x3d, 0x98, 0x05, 0x3a, 0x0e, 0xb9, 0x0d, 0xdd, 0xc1, 0xfe, 0x21, 0xed, 0x2d,
+		0x4b, 0xd6, 0x1d, 0xf2, 0x8e, 0xe6, 0x69, 0x00, 0xf7, 0x42, 0x9d, 0xbd, 0xee, 0xf7, 0x16, 0xb6,
+		0x86, 0x9b, 0xde, 0xdb, 0x79, 0x40, 0x9b, 0x96, 0xbd, 0xcd, 0x90, 0xfa, 0x7d, 0x9a, 0x19, 0x6f,
+		0x6a, 0xee, 0x1b, 0x8a, 0xf5, 0xbe, 0x37, 0x8e, 0xc6, 0x5d, 0xe7, 0xa8, 0x3b, 0x56, 0x8d, 0x45,
+		0x61, 0xec, 0x09, 0xdd, 0x84, 0xfe, 0xb2, 0x1b, 0xd6, 0xb3, 0x80, 0x2b, 0x2f, 0xdd, 0x4c, 0xb5,
+		0xe4, 0x72, 0x69, 0x1e, 0xb3, 0xfa, 0x66, 0x1a, 0x4e, 0xc6, 0x36, 0x38, 0x3d, 0xb2, 0xcd, 0xb8,
+		0xe1, 0xcf, 0xc1, 0xdb, 0x85, 0x79, 0xc9, 0xfe, 0xab, 0xce, 0x19, 0x74, 0xfa, 0xe4, 0xbe, 0x24,
+		0x3e, 0xb9, 0x53, 0xf5, 0xfb, 0x03, 0xaa, 0x1f, 0xd8, 0xdf, 0x4c, 0xf4, 0xfe, 0x0e, 0x48, 0xed,
+		0xef, 0xa0, 0x60, 0x7f, 0x39, 0x66, 0x96, 0xe5, 0x99, 0x99, 0xfa, 0x6e, 0x06, 0x4e, 0xcb, 0xf4,
+		0x7e, 0xa1, 0x13, 0x90, 0x6b, 0x35, 0x50, 0xb0, 0x6d, 0xca, 0x6a, 0xe0, 0x0f, 0x15, 0x0d, 0x37,
+		0x7d, 0x6b, 0x11, 0x50, 0x23, 0x48, 0x45, 0xa4, 0x6f, 0xad, 0x4f, 0xd2, 0xf4, 0x4d, 0xef, 0x78,
+		0x72, 0x55, 0xd3, 0xb0, 0xaa, 0xba, 0x59, 0x63, 0xbe, 0x83, 0x3d, 0x75, 0x1f, 0x06, 0x7d, 0x3d,
+		0x26, 0x5e, 0x19, 0xf9, 0xc4, 0x6b, 0x1d, 0x26, 0x7d, 0x25, 0x0c, 0x9f, 0x21, 0x03, 0x71, 0x67,
+		0xc8, 0xb8, 0xcf, 0x1b, 0x38, 0x46, 0x02, 0x52, 0xd9, 0x11, 0xc5, 0xa4, 0x0e, 0x26, 0x90, 0xea,
+		0xe5, 0x5b, 0x4c, 0xaa, 0xf8, 0xb0, 0xcb, 0xf6, 0x74, 0xd8, 0xad, 0xc0, 0xe8, 0x16, 0xd6, 0x6d,
+		0xb2, 0x81, 0xf5, 0x36, 0x3a, 0x88, 0x13, 0x35, 0xd2, 0xe2, 0x69, 0xcb, 0x89, 0x0f, 0x51, 0x72,
+		0xf1, 0x21, 0x4a, 0x28, 0x2b, 0x19, 0xea, 0x25, 0x2b, 0x69, 0x47, 0xb7, 0x07, 0xe4, 0xa3, 0xdb,
+		0xce, 0x78, 0x74, 0x38, 0x10, 0x8f, 0xfe, 0x4d, 0x01, 0x35, 0xbe, 0x47, 0xf1, 0x03, 0x3b, 0xf8,
+		0x3b, 0x43, 0x94, 0xbe, 0xee, 0xb4, 0xeb, 0x05, 0x18, 0xa2, 0x59, 0xab, 0xef, 0xd3, 0xfa, 0x25,
+		0x7c, 0x5a, 0xce, 0xe5, 0x60, 0x0f, 0xea, 0x1f, 0x95, 0x6e, 0x37, 0xb1, 0xcf, 0x51, 0x37, 0x7f,
+		0x89, 0x52, 0x09, 0x8e, 0x82, 0x74, 0x6c, 0x24, 0xd2, 0xd7, 0xbd, 0x98, 0xea, 0x1f, 0x14, 0x38,
+		0x19, 0xdf, 0x38, 0xd6, 0x6b, 0x70, 0xfe, 0x61, 0xcc, 0xe8, 0x67, 0x29, 0x38, 0x25, 0xd1, 0x7e,
+		0xe9, 0xce, 0xc9, 0xc0, 0x44, 0x37, 0x2b, 0x8e, 0xd4, 0x26, 0xf9, 0xc4, 0x8f, 0x6c, 0x4e, 0xc1,
+		0xe8, 0xa9, 0xaf, 0x97, 0xe8, 0x69, 0xcf, 0x2a, 0xfe, 0x15, 0x05, 0xe6, 0xe4, 0xbb, 0x26, 0x65,
+		0xce, 0xc3, 0xfd, 0x49, 0xcf, 0xde, 0x56, 0x20, 0x61, 0x7f, 0x64, 0x3c, 0xb6, 0x43, 0x7e, 0x88,
+		0xe4, 0x79, 0x18, 0xef, 0x41, 0x0a, 0x71, 0x5a, 0x02, 0xf1, 0x1b, 0x01, 0x3d, 0x14, 0x55, 0x52,
+		0x7b, 0xd5, 0xc3, 0x15, 0x98, 0xa9, 0xe8, 0xa4, 0xa3, 0x4f, 0x28, 0x58, 0x03, 0x69, 0xaf, 0xac,
+		0x47, 0xc7, 0xdb, 0x4a, 0x2f, 0xa4, 0xe2, 0xe8, 0x73, 0x3a, 0x81, 0x3e, 0xf7, 0xc5, 0xda, 0x68,
+		0x20, 0x08, 0x54, 0xdf, 0x53, 0x60, 0x2a, 0xa2, 0x33, 0xd9, 0xfd, 0xe5, 0x96, 0xd7, 0x91, 0xd9,
+		0xda, 0xb7, 0x01, 0xfa, 0x5c, 0x34, 0xd0, 0x2a, 0x1c, 0x6e, 0x1d, 0xf2, 0x9b, 0xa6, 0x9d, 0x20,
+		0xa1, 0x45, 0xec, 0x8c, 0x77, 0x3b, 0x8f, 0x93, 0x1c, 0xcd, 0x32, 0x9b, 0xfd, 0x69, 0x98, 0x14,
+		0xb6, 0x3c, 0x47, 0xcd, 0x46, 0x3a, 0x9e, 0x57, 0x7f, 0xad, 0xc0, 0x74, 0x54, 0xb7, 0xeb, 0xbe,
+		0x7c, 0x65, 0xbf, 0xd6, 0x23, 0xd2, 0x41, 0xff, 0x44, 0x81, 0x99, 0xb8, 0xae, 0xd9, 0xa8, 0xd9,
+		0x3c, 0x52, 0xb3, 0x8d, 0x44, 0xfe, 0xef, 0x01, 0x48, 0xd8, 0x9c, 0x85, 0x0a, 0x70, 0x88, 0xf6,
+		0x7f, 0x05, 0x2f, 0x9f, 0xbd, 0x39, 0x8d, 0xd6, 0x70, 0x33, 0x70, 0xf5, 0x1c, 0xaa, 0xff, 0xa4,
+		0x7a, 0xab, 0xff, 0x3c, 0xae, 0xd0, 0xc8, 0x57, 0x68, 0x64, 0x74, 0x67, 0x40, 0x42, 0x77, 0xee,
+		0xc0, 0x38, 0xbb, 0x59, 0x67, 0x18, 0xcd, 0x1a, 0xc1, 0xf6, 0x8e, 0x5e, 0x89, 0xcf, 0x69, 0x0e,
+		0x31, 0x46, 0x0a, 0xaf, 0xc8, 0xd8, 0xba, 0xab, 0x3f, 0xd9, 0x3d, 0x55, 0x7f, 0x3a, 0x42, 0x38,
+		0x48, 0x12, 0xc2, 0x89, 0x4b, 0x3d, 0xb9, 0x9e, 0x4b, 0x3d, 0xed, 0x1c, 0x64, 0x48, 0x3e, 0x07,
+		0xf1, 0x0b, 0x0e, 0x07, 0xf6, 0x50, 0x70, 0x18, 0xde, 0x53, 0xc1, 0xc1, 0xf5, 0xc1, 0x85, 0xa4,
+		0x1d, 0xa2, 0x2d, 0x6f, 0xa5, 0x74, 0x7a, 0xab, 0xa8, 0xfc, 0x66, 0x03, 0x8e, 0xb4, 0xba, 0x4a,
+		0x02, 0xb5, 0x5b, 0xcf, 0x8e, 0xe7, 0x22, 0xfb, 0x46, 0xba, 0xab, 0xb7, 0x87, 0x31, 0x6f, 0x58,
+		0xfd, 0xbe, 0x02, 0xb3, 0x82, 0x99, 0xf0, 0x4a, 0xd2, 0xf1, 0xe6, 0xa1, 0x48, 0x98, 0x47, 0x47,
+		0xa4, 0x93, 0x4a, 0x10, 0xe9, 0xa8, 0xef, 0x2b, 0x70, 0x2c, 0xf2, 0x17, 0x0e, 0x6e, 0xa8, 0xc7,
+		0x7e, 0x3f, 0x51, 0xd3, 0xab, 0xfe, 0x52, 0x83, 0x37, 0x74, 0x5b, 0xaf, 0xe2, 0x5e, 0x3f, 0xbd,
+		0x6f, 0xa7, 0x4a, 0x5b, 0xe3, 0xfb, 0xa4, 0x35, 0x5e, 0xfd, 0x3a, 0x6f, 0x93, 0x44, 0x1d, 0x3d,
+		0x27, 0x20, 0xc7, 0x7a, 0xaa, 0x3a, 0x97, 0xc0, 0x1b, 0xa2, 0x4b, 0xd0, 0x72, 0xea, 0x29, 0x79,
+		0xa7, 0x1e, 0x71, 0x87, 0xad, 0x7e, 0x4d, 0x81, 0xb9, 0x04, 0x5d, 0x6c, 0xed, 0xbb, 0x56, 0xa5,
+		0xeb, 0xae, 0xb5, 0xd7, 0x9d, 0x89, 0x82, 0xf6, 0x8b, 0x14, 0x3c, 0xbf, 0xb7, 0x4e, 0xfe, 0x7d,
+		0xd3, 0xf9, 0xf6, 0x3d, 0x5e, 0xaa, 0xeb, 0x1e, 0xef, 0x1e, 0xa0, 0x70, 0x07, 0x0b, 0xb3, 0xef,
+		0x33, 0x72, 0x7d, 0x2a, 0xda, 0x68, 0xa8, 0x1d, 0xc5, 0xbd, 0xfc, 0x28, 0x5b, 0x35, 0x62, 0x5b,
+		0x15, 0xaa, 0x68, 0x43, 0x9a, 0xff, 0x88, 0xf2, 0x30, 0x16, 0x68, 0x7e, 0xb4, 0x6a, 0x15, 0x2f,
+		0x32, 0x1f, 0xd4, 0x46, 0xbb, 0x7a, 0x12, 0xef, 0xd4, 0x2a, 0xbb, 0xea, 0xeb, 0x69, 0xb8, 0xb6,
+		0x87, 0x5f, 0x0a, 0xa0, 0x7b, 0x9d, 0x7e, 0x6f, 0x58, 0xf0, 0x3b, 0x1c, 0x29, 0xc9, 0x5d, 0x57,
+		0xd2, 0xfb, 0x94, 0x4f, 0x0a, 0xef, 0x57, 0xf9, 0xfb, 0xd2, 0xb7, 0xd7, 0x7d, 0x99, 0x07, 0x14,
+		0xec, 0xcf, 0x64, 0xd5, 0x8b, 0xb4, 0x36, 0x62, 0x76, 0x29, 0xa1, 0x77, 0x85, 0xe5, 0xef, 0x62,
+		0xa6, 0x6b, 0x17, 0xd5, 0x3f, 0x29, 0x70, 0xa5, 0xc7, 0x9f, 0x39, 0x08, 0x30, 0x28, 0x02, 0x0c,
+		0x1f, 0xac, 0xe2, 0xaa, 0x5f, 0x4a, 0xc3, 0x95, 0x1e, 0x5b, 0x51, 0xff, 0x57, 0x6d, 0x35, 0xe0,
+		0xb1, 0xfb, 0xc4, 0x1e, 0xbb, 0x5f, 0xde, 0x63, 0x0b, 0x55, 0x47, 0xe4, 0x00, 0x06, 0x44, 0x0e,
+		0xe0, 0x95, 0x34, 0x5c, 0xea, 0xa5, 0x9d, 0x56, 0xce, 0xf2, 0xa5, 0x24, 0x3f, 0xb6, 0xfc, 0xb6,
+		0xe5, 0xff, 0x5d, 0x81, 0xf3, 0x49, 0x5b, 0x83, 0xff, 0xab, 0x4d, 0x5e, 0x7c, 0x56, 0xa9, 0xbf,
+		0x53, 0x60, 0x21, 0x51, 0x3b, 0xf1, 0xbe, 0xb9, 0x00, 0x6e, 0xd6, 0x90, 0xda, 0x5b, 0xd6, 0xf0,
+		0x17, 0x5e, 0xd6, 0x10, 0xd3, 0x35, 0x3c, 0x05, 0x59, 0xd6, 0x21, 0xdc, 0xba, 0x2b, 0x18, 0xf4,
+		0x06, 0x8a, 0x86, 0xeb, 0x38, 0xd8, 0x4b, 0xea, 0x38, 0xbc, 0xcd, 0x02, 0x6f, 0xa8, 0xdb, 0x71,
+		0xa4, 0x7b, 0x0b, 0xf5, 0xfa, 0x22, 0x2b, 0x2e, 0xfd, 0xc1, 0x56, 0x8b, 0x77, 0x52, 0xc2, 0x09,
+		0x0a, 0x2b, 0x24, 0x91, 0x13, 0x9c, 0x07, 0x24, 0xbc, 0xcc, 0x1c, 0xb1, 0x83, 0x17, 0x98, 0xfb,
+		0x15, 0xa3, 0xb7, 0x8b, 0x36, 0x7d, 0x09, 0x8a, 0x36, 0x1d, 0x79, 0x75, 0x7f, 0x82, 0xbc, 0x5a,
+		0x7d, 0x89, 0xd3, 0x19, 0xc5, 0xef, 0x76, 0x16, 0x45, 0xce, 0x11, 0x39, 0xa4, 0xfa, 0x29, 0x4e,
+		0xe6, 0x20, 0xe8, 0x55, 0xee, 0x49, 0xfe, 0x37, 0xb3, 0x70, 0xb1, 0x87, 0xdf, 0xfa, 0x75, 0xb8,
+		0x18, 0xa5, 0xcb, 0xc5, 0x9c, 0x80, 0x5c, 0xcb, 0xc5, 0xb0, 0xad, 0xce, 0x6a, 0xe0, 0x0f, 0xf1,
+		0xae, 0xc5, 0xd2, 0xfb, 0x70, 0x2d, 0xd6, 0x6b, 0xfd, 0xbc, 0x7f, 0x7f, 0xaf, 0xc5, 0x32, 0x8f,
+		0xf4, 0x5a, 0x6c, 0xa0, 0xe7, 0x6b, 0xb1, 0xfb, 0xc0, 0x7a, 0xbf, 0x99, 0x44, 0x56, 0x76, 0xf6,
+		0x9a, 0x62, 0xce, 0x44, 0x34, 0x90, 0x53, 0x29, 0xac, 0xf8, 0x3c, 0x5a, 0x0f, 0x0e, 0x75, 0x3a,
+		0xfe, 0x6c, 0x77, 0x8c, 0x22, 0x63, 0xca, 0x20, 0x61, 0xca, 0x65, 0x98, 0xe8, 0x50, 0xa7, 0x92,
+		0x8d, 0x1b, 0x6d, 0xf8, 0x39, 0x0a, 0x7f, 0x2e, 0x52, 0x71, 0x8a, 0x86, 0x86, 0x1b, 0x3e, 0x5e,
+		0xed, 0x70, 0x93, 0x37, 0x1c, 0x2a, 0xc7, 0x1f, 0xe8, 0xa5, 0x1c, 0x1f, 0xea, 0xe2, 0x1d, 0xe6,
+		0x74, 0xf1, 0xb6, 0x6f, 0x0f, 0x0e, 0x26, 0xbf, 0x2f, 0x1b, 0xd9, 0xc3, 0x7d, 0xd9, 0xe8, 0xde,
+		0x1a, 0x74, 0x9f, 0x81, 0x9c, 0x81, 0x2b, 0xfa, 0xae, 0xa7, 0x9a, 0xf1, 0xdd, 0xc6, 0x40, 0xa9,
+		0xa9, 0x2a, 0xa2, 0x67, 0x61, 0xe8, 0x33, 0x26, 0x21, 0xfe, 0xff, 0xbd, 0x99, 0x18, 0x8b, 0x63,
+		0xce, 0x79, 0xe4, 0x94, 0x5b, 0x7d, 0x2d, 0x0d, 0xe7, 0x93, 0xfe, 0x92, 0xf7, 0xc3, 0x77, 0x4e,
+		0xab, 0x7e, 0xe4, 0xec, 0xd5, 0x7e, 0x2f, 0x27, 0xfe, 0x19, 0x6a, 0x57, 0xc0, 0xdc, 0x61, 0x66,
+		0xfd, 0xdd, 0x66, 0xc6, 0x0f, 0x0b, 0x33, 0x82, 0xb0, 0x70, 0x9f, 0x6e, 0xc7, 0xd5, 0xdf, 0xa4,
+		0x60, 0x3e, 0xc9, 0xcf, 0x94, 0x85, 0xfb, 0xc1, 0x8f, 0x47, 0x53, 0x7b, 0x8d, 0x47, 0xf7, 0x6b,
+		0x17, 0xf9, 0xab, 0xdb, 0x27, 0x58, 0xdd, 0xb6, 0x6d, 0xf7, 0xcb, 0xdf, 0x0c, 0xbe, 0x9f, 0x82,
+		0x84, 0x3f, 0xa0, 0xfe, 0x68, 0x2c, 0x26, 0xaf, 0xd0, 0xd9, 0xcf, 0x2d, 0x74, 0xb6, 0x83, 0xbd,
+		0x8c, 0x7c, 0xb0, 0xa7, 0xfe, 0x33, 0x05, 0xe7, 0xf6, 0xc3, 0xa3, 0x7c, 0x44, 0x17, 0xbd, 0x23,
+		0x56, 0xce, 0x24, 0x89, 0x95, 0xff, 0x95, 0x82, 0x85, 0x44, 0xbf, 0x67, 0x7f, 0xbc, 0xf0, 0xa1,
+		0x85, 0xf7, 0x2f, 0xd9, 0x33, 0x49, 0x2a, 0x2f, 0x9f, 0x4f, 0x8b, 0x16, 0x5e, 0xd4, 0x55, 0xf5,
+		0x78, 0xe1, 0x23, 0x9b, 0xba, 0x32, 0xbd, 0xfc, 0x52, 0xe4, 0xe7, 0x29, 0x28, 0x24, 0xfc, 0x3f,
+		0x03, 0x8f, 0xf7, 0xa1, 0x6b, 0x1f, 0xe6, 0x08, 0x1c, 0xa4, 0x7f, 0xae, 0x98, 0x15, 0x82, 0x6d,
+		0xfa, 0xa9, 0x63, 0x30, 0xb9, 0x7c, 0x7f, 0xf9, 0xf6, 0x7a, 0x69, 0xa5, 0xb8, 0xba, 0xbe, 0xac,
+		0x95, 0xd6, 0x3f, 0xb1, 0xb6, 0x5c, 0x2a, 0xde, 0xbe, 0x7f, 0x7d, 0xb5, 0x78, 0x73, 0xe4, 0x09,
+		0x74, 0x02, 0xa6, 0xc2, 0xaf, 0xaf, 0xaf, 0xae, 0x96, 0xe8, 0xe8, 0x88, 0x82, 0x4e, 0xc2, 0xb1,
+		0x30, 0xc1, 0xd2, 0xea, 0x9d, 0xbb, 0xcb, 0x8c, 0x24, 0x75, 0xe3, 0x25, 0x38, 0x52, 0xb6, 0xaa,
+		0xbc, 0x35, 0xb8, 0xe1, 0xff, 0xa7, 0xea, 0x35, 0xdb, 0x22, 0xd6, 0x9a, 0xf2, 0xc9, 0x0b, 0x0f,
+		0x4c, 0xb2, 0xd5, 0xd8, 0xc8, 0x97, 0xad, 0x6a, 0xa1, 0xf3, 0x3f, 0x66, 0x2f, 0x98, 0x46, 0xa5,
+		0xf0, 0xc0, 0xf2, 0xfe, 0x4b, 0x37, 0xfb, 0xf7, 0xd9, 0xd7, 0xf4, 0xba, 0xb9, 0x73, 0x61, 0x23,
+		0x43, 0xc7, 0x2e, 0xfe, 0x67, 0x00, 0x78, 0x26, 0xc1, 0x73, 0x21, 0x5c, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/replication.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0xdb, 0x46,
+		0x13, 0x0f, 0x25, 0xeb, 0xe1, 0xb1, 0x2c, 0xc9, 0x6b, 0x27, 0x66, 0xec, 0x18, 0x9f, 0xa2, 0xbc,
+		0x1c, 0x07, 0x9f, 0xf4, 0xd9, 0xf9, 0xf2, 0x3d, 0x51, 0x04, 0x8c, 0x25, 0xd7, 0x6c, 0xfc, 0x5c,
+		0x29, 0x4e, 0x5d, 0xa0, 0x20, 0x68, 0x72, 0x6d, 0x11, 0x96, 0x48, 0x81, 0xbb, 0x92, 0xa3, 0x5b,
+		0x7b, 0x2f, 0xd0, 0x4b, 0x6f, 0x3d, 0xf6, 0xdf, 0xe8, 0xb9, 0xd7, 0xf6, 0x4f, 0x2a, 0xb8, 0xbb,
+		0x94, 0x44, 0xbd, 0xe2, 0x36, 0x87, 0xde, 0xb4, 0x33, 0xbf, 0xdf, 0xcc, 0xec, 0xec, 0xec, 0xcc,
+		0x8a, 0xf0, 0xac, 0x73, 0x41, 0xfc, 0xb2, 0x65, 0xda, 0xc4, 0xb5, 0x48, 0xd9, 0xb4, 0x5b, 0x8e,
+		0x5b, 0xee, 0x6e, 0x97, 0x7d, 0xd2, 0x6e, 0x3a, 0x96, 0xc9, 0x1c, 0xcf, 0x2d, 0xb5, 0x7d, 0x8f,
+		0x79, 0xe8, 0x6e, 0x00, 0x2c, 0x49, 0x60, 0x89, 0x03, 0x4b, 0xdd, 0xed, 0xb5, 0xbf, 0x5d, 0x79,
+		0xde, 0x55, 0x93, 0x94, 0x39, 0xe8, 0xa2, 0x73, 0x59, 0x66, 0x4e, 0x8b, 0x50, 0x66, 0xb6, 0xda,
+		0x82, 0xb7, 0x56, 0x88, 0x3a, 0x68, 0x3b, 0x81, 0x79, 0xcb, 0x6b, 0xb5, 0x3c, 0x77, 0x16, 0xc2,
+		0xf6, 0x5a, 0xa6, 0x13, 0x22, 0x1e, 0x4d, 0x0e, 0xb2, 0xe1, 0x50, 0xe6, 0xf9, 0x3d, 0x01, 0x2a,
+		0xfe, 0x10, 0x83, 0x65, 0x3c, 0x08, 0xfb, 0x90, 0x50, 0x6a, 0x5e, 0x11, 0x8a, 0x6a, 0xb0, 0x34,
+		0xb4, 0x1b, 0x83, 0x99, 0xf4, 0x9a, 0xaa, 0x4a, 0x21, 0xbe, 0xb9, 0xb0, 0xf3, 0xb4, 0x34, 0x71,
+		0x53, 0xa5, 0x21, 0x33, 0x75, 0x93, 0x5e, 0xe3, 0xbc, 0x1f, 0x15, 0x50, 0xf4, 0x5f, 0xb8, 0xdf,
+		0x34, 0x29, 0x33, 0x7c, 0xc2, 0x7c, 0x87, 0x74, 0x89, 0x6d, 0xb4, 0x84, 0x3f, 0xc3, 0xb1, 0xd5,
+		0x58, 0x41, 0xd9, 0x8c, 0xe3, 0x7b, 0x01, 0x00, 0x87, 0x7a, 0x19, 0x8e, 0x6e, 0xa3, 0xfb, 0x90,
+		0x6e, 0x98, 0xd4, 0x68, 0x79, 0x3e, 0x51, 0xe3, 0x05, 0x65, 0x33, 0x8d, 0x53, 0x0d, 0x93, 0x1e,
+		0x7a, 0x3e, 0x41, 0x18, 0x96, 0x68, 0xcf, 0xb5, 0x0c, 0xda, 0x30, 0x7d, 0xdb, 0xa0, 0xcc, 0x64,
+		0x1d, 0xaa, 0xce, 0x15, 0x94, 0x19, 0xa1, 0xd6, 0x7a, 0xae, 0x55, 0x0b, 0xe0, 0x35, 0x8e, 0xc6,
+		0x39, 0x1a, 0x15, 0x14, 0xbf, 0x4f, 0x42, 0x6e, 0x64, 0x3f, 0xe8, 0x73, 0x98, 0x0f, 0xd2, 0x60,
+		0xb0, 0x5e, 0x9b, 0xa8, 0x4a, 0x41, 0xd9, 0xcc, 0xee, 0x6c, 0xdd, 0x2e, 0x15, 0xf5, 0x5e, 0x9b,
+		0xe0, 0x34, 0x93, 0xbf, 0xd0, 0x63, 0xc8, 0x52, 0xaf, 0xe3, 0x5b, 0x84, 0xa7, 0x75, 0xb0, 0xf7,
+		0x8c, 0x90, 0x06, 0x0c, 0xdd, 0x46, 0xaf, 0x61, 0xd1, 0xf2, 0x89, 0x4c, 0xbf, 0xd3, 0x12, 0xdb,
+		0x5e, 0xd8, 0x59, 0x2b, 0x89, 0xda, 0x29, 0x85, 0xb5, 0x53, 0xaa, 0x87, 0xb5, 0x83, 0x33, 0x21,
+		0x21, 0x10, 0x21, 0x0b, 0xee, 0x89, 0x7a, 0x10, 0x6e, 0x4c, 0xc6, 0x7c, 0xe7, 0xa2, 0xc3, 0x48,
+		0x98, 0x9c, 0x17, 0x53, 0x82, 0xaf, 0x70, 0x52, 0x10, 0x85, 0xd6, 0xa7, 0xec, 0xdf, 0xc1, 0x2b,
+		0xf6, 0x04, 0x39, 0xfa, 0x46, 0x81, 0x87, 0x63, 0xd9, 0x1f, 0x73, 0x98, 0xe0, 0x0e, 0xff, 0x79,
+		0xbb, 0xd3, 0x18, 0xf3, 0xbc, 0x41, 0x67, 0x01, 0x50, 0x17, 0x38, 0xc0, 0x30, 0x2d, 0xe6, 0x74,
+		0x1d, 0xd6, 0x1b, 0xf3, 0x9e, 0xe4, 0xde, 0xb7, 0x67, 0x78, 0xd7, 0x24, 0x75, 0xcc, 0xf5, 0x1a,
+		0x9d, 0xaa, 0x45, 0x2d, 0x58, 0x93, 0x77, 0x49, 0x78, 0xec, 0xee, 0x0c, 0x3b, 0x4d, 0x71, 0xa7,
+		0xa5, 0x29, 0x4e, 0xf7, 0x05, 0x31, 0xb0, 0x78, 0xb6, 0x13, 0xf1, 0xb8, 0xda, 0x98, 0xac, 0x42,
+		0x1e, 0xac, 0x5d, 0x9a, 0x4e, 0xd3, 0xeb, 0x12, 0xdf, 0x68, 0x99, 0xfe, 0x35, 0xf1, 0x87, 0xdd,
+		0xa5, 0xb9, 0xbb, 0xf2, 0x14, 0x77, 0x7b, 0x92, 0x78, 0xc8, 0x79, 0x11, 0x7f, 0xea, 0xe5, 0x14,
+		0xdd, 0x9b, 0x0c, 0xc0, 0xc0, 0x41, 0xf1, 0xe7, 0x18, 0xac, 0x4c, 0xaa, 0x0c, 0x74, 0x0a, 0x79,
+		0x59, 0x66, 0x5e, 0x9b, 0xf8, 0xbc, 0xfc, 0xe4, 0xed, 0x78, 0x3a, 0xb3, 0xc0, 0x8e, 0x43, 0x34,
+		0xce, 0xd9, 0x51, 0x01, 0xca, 0x42, 0x4c, 0x5e, 0x8a, 0x79, 0x1c, 0x73, 0x6c, 0xf4, 0x12, 0x92,
+		0x02, 0x22, 0xef, 0xc0, 0xfa, 0x88, 0xe1, 0xb6, 0x33, 0x30, 0x8b, 0x25, 0x14, 0x3d, 0x81, 0xac,
+		0xe5, 0xb9, 0x97, 0xce, 0x95, 0xd1, 0x25, 0x3e, 0x0d, 0xa2, 0x9a, 0xe3, 0xb7, 0x6c, 0x51, 0x48,
+		0xcf, 0x84, 0x10, 0x3d, 0x87, 0x7c, 0x3f, 0xad, 0x21, 0x30, 0xc1, 0x81, 0xb9, 0x50, 0x1e, 0x42,
+		0xff, 0x07, 0xf7, 0xdb, 0x3e, 0xe9, 0x3a, 0x5e, 0x87, 0x1a, 0x63, 0x9c, 0x24, 0xe7, 0xac, 0x86,
+		0x80, 0xbd, 0x28, 0xb7, 0xf8, 0xa3, 0x02, 0x1b, 0x33, 0xeb, 0x3c, 0x88, 0x57, 0x76, 0x05, 0xab,
+		0xd9, 0xa1, 0x8c, 0xf8, 0x3c, 0x8b, 0xf3, 0x78, 0x51, 0x48, 0x77, 0x85, 0x30, 0x68, 0x84, 0xe2,
+		0xaa, 0xc9, 0x0c, 0x25, 0x70, 0x8a, 0xaf, 0x75, 0x1b, 0xfd, 0x07, 0xe6, 0xfb, 0x73, 0xe4, 0x16,
+		0xdd, 0x62, 0x00, 0x2e, 0xfe, 0x9a, 0x80, 0xb5, 0xe9, 0xf7, 0x00, 0xad, 0xc3, 0xbc, 0x3c, 0x62,
+		0xc7, 0x96, 0x51, 0xa5, 0x85, 0x40, 0xb7, 0xd1, 0x3b, 0x40, 0x37, 0x9e, 0x7f, 0x7d, 0xd9, 0xf4,
+		0x6e, 0x0c, 0xf2, 0x81, 0x58, 0x1d, 0x5e, 0x01, 0xb1, 0x89, 0xfd, 0x57, 0x1c, 0xd4, 0x7b, 0x09,
+		0xaf, 0x86, 0x68, 0xbc, 0x74, 0x33, 0x2a, 0x42, 0x2a, 0xa4, 0xc2, 0xd4, 0xc6, 0x79, 0x6a, 0xc3,
+		0x25, 0x7a, 0x08, 0x19, 0x6a, 0x35, 0x88, 0xdd, 0x69, 0x12, 0x9e, 0x05, 0x71, 0xac, 0x0b, 0x7d,
+		0x99, 0x6e, 0x23, 0x0d, 0xb2, 0x03, 0x08, 0x6f, 0x9e, 0x89, 0x8f, 0xa6, 0x63, 0xb1, 0xcf, 0x08,
+		0x64, 0x68, 0x03, 0x80, 0x32, 0xd3, 0x67, 0xc2, 0x87, 0x38, 0xdd, 0x79, 0x29, 0xd1, 0x6d, 0xf4,
+		0x19, 0x64, 0x42, 0x35, 0xb7, 0x9f, 0xfa, 0xa8, 0xfd, 0x05, 0x89, 0xe7, 0xd6, 0xbf, 0x80, 0x65,
+		0x3e, 0x09, 0x1b, 0xc4, 0xf4, 0xd9, 0x05, 0x31, 0x99, 0xb0, 0x92, 0xfe, 0xa8, 0x95, 0xa5, 0x80,
+		0xb6, 0x1f, 0xb2, 0xb8, 0xad, 0x7f, 0x41, 0xca, 0x26, 0xcc, 0x74, 0x9a, 0x54, 0x9d, 0xe7, 0xfc,
+		0x07, 0x13, 0xb3, 0x7e, 0x62, 0xf6, 0x9a, 0x9e, 0x69, 0xe3, 0x10, 0x1c, 0x64, 0xd8, 0x64, 0x8c,
+		0xb4, 0xda, 0x4c, 0x05, 0x51, 0x48, 0x72, 0x89, 0x5e, 0x43, 0x86, 0x47, 0x17, 0x14, 0x79, 0xc7,
+		0x27, 0xea, 0xc2, 0x0c, 0xb3, 0x7b, 0x02, 0x83, 0x17, 0x02, 0x86, 0x5c, 0xa0, 0x7f, 0xc0, 0x0a,
+		0x37, 0x10, 0x1c, 0x2b, 0xf1, 0x0d, 0xc7, 0x26, 0x2e, 0x73, 0x58, 0x4f, 0xcd, 0xf0, 0xda, 0x41,
+		0x81, 0xee, 0x3d, 0x57, 0xe9, 0x52, 0x83, 0x8e, 0x20, 0x27, 0xcf, 0xd7, 0x90, 0x0d, 0x50, 0x5d,
+		0xe4, 0x5e, 0x9f, 0x4c, 0x69, 0x22, 0xf2, 0x62, 0xc9, 0x46, 0x8a, 0xb3, 0xdd, 0xc8, 0xba, 0xf8,
+		0x6d, 0x1c, 0x56, 0xa7, 0x34, 0x59, 0xb4, 0x0a, 0xa9, 0x70, 0xf0, 0x2a, 0xfc, 0x5c, 0x93, 0x4c,
+		0x8c, 0xdc, 0x48, 0x9d, 0xc7, 0x6e, 0x55, 0xe7, 0xf1, 0x4f, 0xad, 0xf3, 0xaf, 0xe1, 0xee, 0xc8,
+		0xc6, 0x0d, 0x87, 0x91, 0x56, 0x30, 0xa4, 0x83, 0xc7, 0xd6, 0xf3, 0x5b, 0x6d, 0x5f, 0x67, 0xa4,
+		0x85, 0x97, 0xbb, 0x63, 0x32, 0x8a, 0x5e, 0x41, 0x92, 0x74, 0x89, 0xcb, 0xc2, 0x19, 0xbc, 0x31,
+		0xb9, 0x75, 0x9a, 0xcc, 0x7c, 0xd3, 0xf4, 0x2e, 0xb0, 0x04, 0xa3, 0x5d, 0xc8, 0xba, 0xe4, 0xc6,
+		0xf0, 0x3b, 0xae, 0x21, 0xe9, 0xc9, 0xdb, 0xd0, 0x33, 0x2e, 0xb9, 0xc1, 0x1d, 0xb7, 0xca, 0x29,
+		0xc5, 0x9f, 0x14, 0x50, 0xa7, 0x4d, 0x9e, 0xd9, 0x3d, 0x65, 0x52, 0x53, 0x8e, 0x4d, 0x6e, 0xca,
+		0x9f, 0xfa, 0x4c, 0x2a, 0x7e, 0xa7, 0xc0, 0x72, 0x34, 0xca, 0xba, 0x77, 0x4d, 0xdc, 0x20, 0xc0,
+		0xb0, 0xd1, 0x8a, 0x97, 0x6f, 0x02, 0xa7, 0x65, 0xa7, 0xa5, 0xe8, 0x4b, 0xc8, 0x8d, 0x0c, 0x63,
+		0x35, 0xf6, 0xa7, 0x26, 0x30, 0xce, 0x46, 0xe7, 0x6f, 0xf1, 0x97, 0xe8, 0x83, 0x9c, 0x3f, 0x06,
+		0xdd, 0x4b, 0xef, 0x2f, 0xe9, 0xc1, 0xeb, 0xc3, 0x2f, 0xde, 0x38, 0xef, 0x11, 0x83, 0x57, 0xec,
+		0xd0, 0x2d, 0x9a, 0x8b, 0xdc, 0xa2, 0xa1, 0xce, 0x9d, 0x88, 0x76, 0xee, 0xc7, 0x90, 0xbd, 0x74,
+		0x7c, 0xca, 0x44, 0x4d, 0x0d, 0xfa, 0x6a, 0x86, 0x4b, 0x79, 0xd5, 0xe8, 0x36, 0x2a, 0xc2, 0xa2,
+		0x4b, 0x3e, 0x0c, 0x81, 0x52, 0xa2, 0xc1, 0x07, 0xc2, 0x10, 0x33, 0x3a, 0x03, 0xd2, 0x63, 0x33,
+		0x20, 0xa8, 0xbe, 0xfc, 0x70, 0x22, 0xf9, 0xa1, 0x0e, 0x4f, 0x4f, 0x25, 0x3a, 0x3d, 0x3f, 0xe1,
+		0xcf, 0x49, 0x48, 0x6d, 0xfb, 0x9e, 0x45, 0x28, 0x8d, 0x52, 0xe3, 0x03, 0xea, 0x49, 0xa8, 0xef,
+		0x53, 0x8b, 0x6f, 0x21, 0x37, 0xf2, 0x2c, 0x88, 0x8e, 0x71, 0xe5, 0x8f, 0x8c, 0x71, 0x17, 0x56,
+		0xe4, 0xe5, 0xaf, 0x1c, 0x9c, 0xee, 0x7a, 0x1d, 0x97, 0x55, 0x5d, 0xe6, 0xf7, 0xd0, 0x0a, 0x24,
+		0xac, 0x60, 0x25, 0xdb, 0x9d, 0x58, 0xcc, 0x7a, 0x49, 0x8c, 0xbf, 0x45, 0xe2, 0x13, 0xde, 0x22,
+		0x5b, 0xbf, 0x8d, 0xd7, 0x2a, 0x2f, 0x8d, 0x87, 0xb0, 0x81, 0xab, 0x27, 0x07, 0xfa, 0xae, 0x56,
+		0xd7, 0x8f, 0x8f, 0x8c, 0xba, 0x56, 0x7b, 0x6b, 0xd4, 0xcf, 0x4f, 0xaa, 0x86, 0x7e, 0x74, 0xa6,
+		0x1d, 0xe8, 0x95, 0xfc, 0x1d, 0x54, 0x80, 0x07, 0x93, 0x21, 0x95, 0xe3, 0x43, 0x4d, 0x3f, 0xca,
+		0x2b, 0xd3, 0x8d, 0xec, 0xeb, 0xb5, 0xfa, 0x31, 0x3e, 0xcf, 0xc7, 0xd0, 0x0b, 0x78, 0x36, 0x19,
+		0x52, 0x3b, 0x3f, 0xda, 0x35, 0x6a, 0xfb, 0x1a, 0xae, 0x18, 0xb5, 0xba, 0x56, 0x7f, 0x57, 0xcb,
+		0xc7, 0xd1, 0x33, 0x78, 0x34, 0x03, 0xac, 0xed, 0xd6, 0xf5, 0x33, 0xbd, 0x7e, 0x9e, 0x9f, 0x43,
+		0x5b, 0xf0, 0x74, 0xa6, 0x63, 0xe3, 0xb0, 0x5a, 0xd7, 0x2a, 0x5a, 0x5d, 0xcb, 0x27, 0xd0, 0x63,
+		0x28, 0xcc, 0xc6, 0x9e, 0xed, 0xe4, 0x93, 0xe8, 0x39, 0x3c, 0x99, 0x8c, 0xda, 0xd3, 0xf4, 0x83,
+		0xe3, 0xb3, 0x2a, 0x36, 0x0e, 0x35, 0xfc, 0xb6, 0x8a, 0xf3, 0xa9, 0x2d, 0x07, 0x72, 0x23, 0xcf,
+		0x63, 0xf4, 0x00, 0x54, 0x91, 0x14, 0xe3, 0xf8, 0xa4, 0x8a, 0x85, 0x89, 0x41, 0x22, 0xd7, 0x61,
+		0x75, 0x4c, 0xbb, 0x8b, 0xab, 0x5a, 0xbd, 0x9a, 0x57, 0x26, 0x2a, 0xdf, 0x9d, 0x54, 0x02, 0x65,
+		0x6c, 0xeb, 0x08, 0x52, 0x95, 0x83, 0x53, 0x7e, 0x60, 0x2b, 0x90, 0xaf, 0x1c, 0x9c, 0x8e, 0x9e,
+		0x91, 0x0a, 0x2b, 0x7d, 0xe9, 0x50, 0xfc, 0x79, 0x05, 0x2d, 0x43, 0xae, 0xaf, 0x91, 0x07, 0x16,
+		0x7b, 0xf3, 0xef, 0xaf, 0x5e, 0x5d, 0x39, 0xac, 0xd1, 0xb9, 0x28, 0x59, 0x5e, 0xab, 0x3c, 0xfc,
+		0xf1, 0xe1, 0xef, 0x8e, 0xdd, 0x2c, 0x5f, 0x79, 0xe2, 0x73, 0x47, 0xff, 0x4b, 0xc4, 0xff, 0xf9,
+		0x8f, 0xee, 0xf6, 0x45, 0x92, 0xcb, 0x5f, 0xfe, 0x3e, 0x00, 0x40, 0x11, 0xc6, 0x58, 0x56, 0x11,
+		0x00, 0x00,
+	},
+	// uber/cadence/api/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+		0x17, 0xfd, 0x64, 0x27, 0xf9, 0x9c, 0x2b, 0xc7, 0x75, 0x99, 0xa6, 0x51, 0xbc, 0x61, 0x51, 0x53,
+		0x14, 0xf0, 0x0a, 0x4c, 0x5e, 0xbc, 0x61, 0x6b, 0x37, 0xec, 0xc1, 0xb1, 0xd4, 0xce, 0x43, 0x96,
+		0x05, 0xb2, 0x9b, 0x87, 0xf5, 0x41, 0xa0, 0x25, 0xda, 0x26, 0x2a, 0x8b, 0x02, 0x45, 0x39, 0xcd,
+		0xdb, 0xb0, 0x1f, 0xb1, 0x1f, 0xb3, 0xc7, 0xfd, 0xb2, 0x41, 0x14, 0xa5, 0x38, 0xb1, 0xd0, 0xec,
+		0x8d, 0xbc, 0xf7, 0x9e, 0xc3, 0xa3, 0xa3, 0x7b, 0x29, 0x81, 0x99, 0x4e, 0x09, 0xef, 0xf9, 0x38,
+		0x20, 0x91, 0x4f, 0x7a, 0x38, 0xa6, 0xbd, 0xd5, 0x69, 0x2f, 0x60, 0x4b, 0x4c, 0x23, 0x2b, 0xe6,
+		0x4c, 0x30, 0xb4, 0x9f, 0x55, 0x58, 0xaa, 0xc2, 0xc2, 0x31, 0xb5, 0x56, 0xa7, 0x9d, 0x2f, 0xe6,
+		0x8c, 0xcd, 0x43, 0xd2, 0x93, 0x25, 0xd3, 0x74, 0xd6, 0x0b, 0x52, 0x8e, 0x05, 0x65, 0x0a, 0xd4,
+		0x39, 0xbe, 0x9f, 0x17, 0x74, 0x49, 0x12, 0x81, 0x97, 0x71, 0x5e, 0x70, 0xf2, 0x57, 0x03, 0x76,
+		0x6c, 0x79, 0x0c, 0x6a, 0x41, 0x8d, 0x06, 0x86, 0x66, 0x6a, 0xdd, 0x5d, 0xb7, 0x46, 0x03, 0x84,
+		0x60, 0x2b, 0xc2, 0x4b, 0x62, 0xd4, 0x64, 0x44, 0xae, 0xd1, 0x6b, 0xd8, 0x49, 0x04, 0x16, 0x69,
+		0x62, 0xd4, 0x4d, 0xad, 0xdb, 0xea, 0x3f, 0xb3, 0x2a, 0x54, 0x59, 0x39, 0xe1, 0x58, 0x16, 0xba,
+		0x0a, 0x80, 0x4c, 0xd0, 0x03, 0x92, 0xf8, 0x9c, 0xc6, 0x99, 0x3e, 0x63, 0x4b, 0xb2, 0xae, 0x87,
+		0xd0, 0x31, 0xe8, 0xec, 0x3a, 0x22, 0xdc, 0x23, 0x4b, 0x4c, 0x43, 0x63, 0x5b, 0x56, 0x80, 0x0c,
+		0x39, 0x59, 0x04, 0xbd, 0x86, 0xad, 0x00, 0x0b, 0x6c, 0xec, 0x98, 0xf5, 0xae, 0xde, 0x7f, 0xf1,
+		0x89, 0xb3, 0x2d, 0x1b, 0x0b, 0xec, 0x44, 0x82, 0xdf, 0xb8, 0x12, 0x82, 0x16, 0xf0, 0xfc, 0x9a,
+		0xf1, 0x0f, 0xb3, 0x90, 0x5d, 0x7b, 0xe4, 0x23, 0xf1, 0xd3, 0xec, 0x44, 0x8f, 0x13, 0x41, 0x22,
+		0xb9, 0x8a, 0x09, 0xa7, 0x2c, 0x30, 0xfe, 0x6f, 0x6a, 0x5d, 0xbd, 0x7f, 0x64, 0xe5, 0xb6, 0x59,
+		0x85, 0x6d, 0x96, 0xad, 0x6c, 0x75, 0xcd, 0x82, 0xc5, 0x29, 0x48, 0xdc, 0x82, 0xe3, 0x52, 0x52,
+		0xa0, 0x21, 0x34, 0xa7, 0x38, 0xf0, 0xa6, 0x34, 0xc2, 0x9c, 0x92, 0xc4, 0x68, 0x48, 0x4a, 0xb3,
+		0x52, 0xec, 0x19, 0x0e, 0xce, 0x54, 0x9d, 0xab, 0x4f, 0x6f, 0x37, 0xe8, 0x3d, 0x1c, 0x2e, 0x68,
+		0x22, 0x18, 0xbf, 0xf1, 0x30, 0xf7, 0x17, 0x74, 0x85, 0x43, 0x4f, 0x19, 0xbf, 0x2b, 0x8d, 0x7f,
+		0x5e, 0xc9, 0x37, 0x50, 0xb5, 0xca, 0xfa, 0x03, 0xc5, 0x71, 0x37, 0x8c, 0xbe, 0x86, 0x27, 0x1b,
+		0xe4, 0x29, 0xa7, 0x06, 0x48, 0xc3, 0xd1, 0x3d, 0xd0, 0x3b, 0x4e, 0x11, 0x86, 0xce, 0x8a, 0x26,
+		0x74, 0x4a, 0x43, 0x2a, 0x36, 0x15, 0xe9, 0xff, 0x5d, 0x91, 0x71, 0x4b, 0x73, 0x4f, 0xd4, 0x77,
+		0x70, 0x58, 0x75, 0x44, 0xa6, 0xab, 0x29, 0x75, 0x1d, 0x6c, 0x42, 0x33, 0x69, 0x16, 0xec, 0x63,
+		0x5f, 0xd0, 0x15, 0xf1, 0xfc, 0x30, 0x4d, 0x04, 0xe1, 0x9e, 0x6c, 0xda, 0x3d, 0x89, 0x79, 0x9c,
+		0xa7, 0x86, 0x79, 0xe6, 0x22, 0xeb, 0xe0, 0x4b, 0x68, 0xa8, 0xc2, 0xc4, 0x68, 0xc9, 0x3e, 0xfa,
+		0xb6, 0x52, 0xb8, 0xc2, 0xb8, 0x24, 0x0e, 0xa9, 0x2f, 0xdf, 0xfd, 0x90, 0x45, 0x33, 0x3a, 0x2f,
+		0x1a, 0xa1, 0x64, 0x41, 0x5f, 0x42, 0x7b, 0x86, 0x69, 0xc8, 0x56, 0x84, 0x7b, 0x2b, 0xc2, 0x93,
+		0xac, 0xbb, 0x1f, 0x99, 0x5a, 0xb7, 0xee, 0x3e, 0x2a, 0xe2, 0x57, 0x79, 0x18, 0x75, 0xa1, 0x4d,
+		0x13, 0x6f, 0x1e, 0xb2, 0x29, 0x0e, 0xbd, 0x7c, 0xba, 0x8d, 0xb6, 0xa9, 0x75, 0x1b, 0x6e, 0x8b,
+		0x26, 0x6f, 0x65, 0x58, 0x0d, 0xe3, 0x1b, 0xd8, 0x2b, 0x49, 0x69, 0x34, 0x63, 0xc6, 0x63, 0xd9,
+		0x46, 0xd5, 0xf3, 0xf6, 0x46, 0x55, 0x8e, 0xa2, 0x19, 0x73, 0x9b, 0xb3, 0xb5, 0x5d, 0xe7, 0x7b,
+		0xd8, 0x2d, 0x47, 0x01, 0xb5, 0xa1, 0xfe, 0x81, 0xdc, 0xa8, 0x11, 0xcf, 0x96, 0xe8, 0x09, 0x6c,
+		0xaf, 0x70, 0x98, 0x16, 0x43, 0x9e, 0x6f, 0x7e, 0xa8, 0xbd, 0xd2, 0x4e, 0x6c, 0x38, 0x7e, 0xc0,
+		0x02, 0xf4, 0x0c, 0x9a, 0x77, 0x3c, 0xcf, 0x79, 0x75, 0xff, 0xd6, 0xed, 0x93, 0xbf, 0x35, 0xd0,
+		0xd7, 0x9a, 0x1c, 0xfd, 0x02, 0x8d, 0x72, 0x30, 0x34, 0xe9, 0xbe, 0xf5, 0xd0, 0x60, 0x58, 0xc5,
+		0x22, 0x1f, 0xe7, 0x12, 0xdf, 0xf1, 0x60, 0xef, 0x4e, 0xaa, 0xe2, 0xf1, 0x5e, 0xad, 0x3f, 0x9e,
+		0xde, 0x3f, 0xf9, 0xe4, 0x59, 0x37, 0xd2, 0xbe, 0x35, 0x0b, 0xfe, 0xd4, 0x60, 0xef, 0x4e, 0x12,
+		0x3d, 0x85, 0x1d, 0x4e, 0x70, 0xc2, 0x22, 0x75, 0x88, 0xda, 0xa1, 0x0e, 0x34, 0x58, 0x4c, 0x38,
+		0x16, 0x8c, 0x2b, 0x27, 0xcb, 0x3d, 0xfa, 0x09, 0x9a, 0x3e, 0x27, 0x58, 0x90, 0xc0, 0xcb, 0x2e,
+		0x5f, 0x79, 0x71, 0xea, 0xfd, 0xce, 0xc6, 0x15, 0x33, 0x29, 0x6e, 0x66, 0x57, 0x57, 0xf5, 0x59,
+		0xe4, 0xe4, 0x9f, 0x1a, 0x34, 0xd7, 0xdf, 0x6f, 0x65, 0xbb, 0x69, 0xd5, 0xed, 0x36, 0x01, 0xa3,
+		0x2c, 0x4d, 0x04, 0xe6, 0xc2, 0x2b, 0xaf, 0x7f, 0xa3, 0xf6, 0xa0, 0x8c, 0xa7, 0x05, 0x76, 0x9c,
+		0x41, 0xcb, 0x38, 0xba, 0x82, 0xa3, 0x92, 0x95, 0x7c, 0x8c, 0x29, 0x27, 0x6b, 0xb4, 0x0f, 0x3f,
+		0xdd, 0x61, 0x01, 0x76, 0x24, 0xf6, 0x96, 0xb7, 0x0f, 0x07, 0x3e, 0x5b, 0xc6, 0x21, 0xc9, 0xac,
+		0x4a, 0x16, 0x98, 0x07, 0x9e, 0xcf, 0xd2, 0x48, 0xc8, 0x4f, 0xc5, 0xb6, 0xbb, 0x5f, 0x26, 0xc7,
+		0x59, 0x6e, 0x98, 0xa5, 0xd0, 0x0b, 0x68, 0xc5, 0x24, 0x0a, 0x68, 0x34, 0xcf, 0x11, 0x89, 0xb1,
+		0x6d, 0xd6, 0xbb, 0xdb, 0xee, 0x9e, 0x8a, 0xca, 0xd2, 0xe4, 0xe5, 0x1f, 0x1a, 0x34, 0xd7, 0x3f,
+		0x4a, 0xe8, 0x08, 0x0e, 0xec, 0xdf, 0x7e, 0x1d, 0x8c, 0x2e, 0xbc, 0xf1, 0x64, 0x30, 0x79, 0x37,
+		0xf6, 0x46, 0x17, 0x57, 0x83, 0xf3, 0x91, 0xdd, 0xfe, 0x1f, 0xfa, 0x1c, 0x8c, 0xbb, 0x29, 0xd7,
+		0x79, 0x3b, 0x1a, 0x4f, 0x1c, 0xd7, 0xb1, 0xdb, 0xda, 0x66, 0xd6, 0x76, 0x2e, 0x5d, 0x67, 0x38,
+		0x98, 0x38, 0x76, 0xbb, 0xb6, 0x49, 0x6b, 0x3b, 0xe7, 0x4e, 0x96, 0xaa, 0xbf, 0x5c, 0x40, 0xeb,
+		0xde, 0x8d, 0xf7, 0x19, 0x1c, 0x0e, 0xdc, 0xe1, 0xcf, 0xa3, 0xab, 0xc1, 0x79, 0xa5, 0x8a, 0xfb,
+		0x49, 0x7b, 0x34, 0x1e, 0x9c, 0x9d, 0x4b, 0x15, 0x15, 0x50, 0xe7, 0x22, 0x4f, 0xd6, 0xce, 0xde,
+		0xc3, 0xa1, 0xcf, 0x96, 0x55, 0xad, 0x7e, 0xa6, 0xe7, 0x26, 0x5c, 0x66, 0x6f, 0xe5, 0x52, 0xfb,
+		0xfd, 0x74, 0x4e, 0xc5, 0x22, 0x9d, 0x5a, 0x3e, 0x5b, 0xf6, 0xd6, 0xff, 0x3f, 0xbe, 0xa2, 0x41,
+		0xd8, 0x9b, 0xb3, 0xfc, 0xaf, 0x41, 0xfd, 0x8c, 0xfc, 0x88, 0x63, 0xba, 0x3a, 0x9d, 0xee, 0xc8,
+		0xd8, 0x37, 0xff, 0x0e, 0x00, 0xb2, 0x01, 0x9f, 0x23, 0xb0, 0x08, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) AdminAPIYARPCClient {
+			return NewAdminAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
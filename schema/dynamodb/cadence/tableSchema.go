@@ -0,0 +1,289 @@
+// Copyright (c) 2022 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// below are the names of all DynamoDB tables
+const (
+	ClusterConfigTableName        = "cluster_config"
+	DomainTableName               = "domain"
+	ShardTableName                = "shard"
+	CurrentWorkflowTableName      = "current_workflow"
+	WorkflowExecutionTableName    = "workflow_execution"
+	WorkflowExecutionMapTableName = "workflow_execution_map"
+	TransferTaskTableName         = "transfer_task"
+	CrossClusterTaskTableName     = "cross_cluster_task"
+	ReplicationTaskTableName      = "replication_task"
+	ReplicationDLQTaskTableName   = "replication_dlq_task"
+	TimerTaskTableName            = "timer_task"
+	TaskListTableName             = "tasklist"
+	TaskTableName                 = "task"
+	QueueMessageTableName         = "queue_message"
+	QueueMetadataTableName        = "queue_metadata"
+	HistoryTreeTableName          = "history_tree"
+	HistoryNodeTableName          = "history_node"
+	VisibilityTableName           = "visibility"
+)
+
+// AllTableNames is the list of all DynamoDB tables
+var AllTableNames = []string{
+	ClusterConfigTableName,
+	DomainTableName,
+	ShardTableName,
+	CurrentWorkflowTableName,
+	WorkflowExecutionTableName,
+	WorkflowExecutionMapTableName,
+	TransferTaskTableName,
+	CrossClusterTaskTableName,
+	ReplicationTaskTableName,
+	ReplicationDLQTaskTableName,
+	TimerTaskTableName,
+	TaskListTableName,
+	TaskTableName,
+	QueueMessageTableName,
+	QueueMetadataTableName,
+	HistoryTreeTableName,
+	HistoryNodeTableName,
+	VisibilityTableName,
+}
+
+// below are the attribute names that are shared by the tables
+const (
+	// PartitionKey is the hash key attribute of every table
+	PartitionKey = "pk"
+	// SortKey is the range key attribute of the tables that have one
+	SortKey = "sk"
+	// ExpiryAttribute is the TTL attribute(epoch seconds) of tasklist, task and visibility tables
+	ExpiryAttribute = "expiry"
+)
+
+// below are the local secondary indexes of visibility table
+const (
+	VisibilityStartTimeIndexName = "start_time_index"
+	VisibilityCloseTimeIndexName = "close_time_index"
+)
+
+// TableSchema is the format of each entry in schema JSON files
+type TableSchema struct {
+	CreateTable *dynamodb.CreateTableInput
+	// optional TTL setting of the table
+	TimeToLive *dynamodb.TimeToLiveSpecification
+}
+
+// NOTE1: DynamoDB table is schemaless except for the key attributes. We use Go lang structs to define the item attributes.
+
+// NOTE2: Data attributes are JSON encoded structs of the nosqlplugin rows. Only key attributes and attributes
+// that are used in condition/filter expressions are stored as separate attributes.
+
+// IMPORTANT: making change to the structs below is changing the DynamoDB table schema.
+// Please make sure it's backward compatible(e.g., don't delete the field, or change the annotation value).
+
+// ClusterConfigItem is the schema of cluster_config table
+type ClusterConfigItem struct {
+	RowType              int    `dynamodbav:"pk"`
+	Version              int64  `dynamodbav:"sk"`
+	UnixTimestampSeconds int64  `dynamodbav:"timestamp"`
+	Data                 []byte `dynamodbav:"data"`
+	DataEncoding         string `dynamodbav:"data_encoding"`
+}
+
+// DomainItem is the schema of domain table. There are three kinds of items in the table:
+// 1. DomainNameKeyPrefix+name: the domain data
+// 2. DomainIDKeyPrefix+ID: the name of the domain, for looking up by ID
+// 3. DomainMetadataKey: the notification version of all domains
+type DomainItem struct {
+	PK                  string `dynamodbav:"pk"`
+	ID                  string `dynamodbav:"id,omitempty"`
+	Name                string `dynamodbav:"name,omitempty"`
+	NotificationVersion int64  `dynamodbav:"notification_version"`
+	Data                []byte `dynamodbav:"data,omitempty"`
+}
+
+// below are the partition key values of domain table
+const (
+	DomainNameKeyPrefix = "name#"
+	DomainIDKeyPrefix   = "id#"
+	DomainMetadataKey   = "metadata"
+)
+
+// ShardItem is the schema of shard table
+type ShardItem struct {
+	ShardID int    `dynamodbav:"pk"`
+	RangeID int64  `dynamodbav:"range_id"`
+	Data    []byte `dynamodbav:"data"`
+}
+
+// CurrentWorkflowItem is the schema of current_workflow table, sort key is domainID#workflowID.
+// All the attributes are significant because they are used as conditions of WorkflowCRUD
+type CurrentWorkflowItem struct {
+	ShardID          int    `dynamodbav:"pk"`
+	SK               string `dynamodbav:"sk"`
+	DomainID         string `dynamodbav:"domain_id"`
+	WorkflowID       string `dynamodbav:"workflow_id"`
+	RunID            string `dynamodbav:"run_id"`
+	State            int    `dynamodbav:"state"`
+	CloseStatus      int    `dynamodbav:"close_status"`
+	CreateRequestID  string `dynamodbav:"create_request_id"`
+	LastWriteVersion int64  `dynamodbav:"last_write_version"`
+}
+
+// WorkflowExecutionItem is the schema of workflow_execution table, sort key is domainID#workflowID#runID.
+// Data is the JSON encoded mutable state except the maps and the buffered events.
+// Version is incremented by every write of the execution, the items of workflow_execution_map table
+// are only visible once the version of the execution reaches their version
+type WorkflowExecutionItem struct {
+	ShardID     int    `dynamodbav:"pk"`
+	SK          string `dynamodbav:"sk"`
+	DomainID    string `dynamodbav:"domain_id"`
+	WorkflowID  string `dynamodbav:"workflow_id"`
+	RunID       string `dynamodbav:"run_id"`
+	NextEventID int64  `dynamodbav:"next_event_id"`
+	Version     int64  `dynamodbav:"version"`
+	Data        []byte `dynamodbav:"data"`
+}
+
+// WorkflowExecutionMapItem is the schema of workflow_execution_map table, partition key is
+// shardID#domainID#workflowID#runID and sort key is name#key#version, e.g. activity_infos#5#00000000000000000003.
+// Each entry of the maps of the mutable state and each batch of buffered events is a separate item, so that
+// a large workflow doesn't exceed the item size limit. The entries are never updated in place, a write of the
+// execution puts the changed entries with its version and marks the deleted ones as Deleted.
+// Data is the JSON encoded entry
+type WorkflowExecutionMapItem struct {
+	PK      string `dynamodbav:"pk"`
+	SK      string `dynamodbav:"sk"`
+	Name    string `dynamodbav:"name"`
+	Key     string `dynamodbav:"key"`
+	Version int64  `dynamodbav:"version"`
+	Deleted bool   `dynamodbav:"deleted,omitempty"`
+	Data    []byte `dynamodbav:"data,omitempty"`
+}
+
+// ShardTaskItem is the schema of transfer_task and replication_task tables.
+// Data is the JSON encoded task
+type ShardTaskItem struct {
+	ShardID int    `dynamodbav:"pk"`
+	TaskID  int64  `dynamodbav:"sk"`
+	Data    []byte `dynamodbav:"data"`
+}
+
+// ClusterTaskItem is the schema of cross_cluster_task(target cluster) and replication_dlq_task(source cluster) tables,
+// partition key is shardID#cluster.
+// Data is the JSON encoded task
+type ClusterTaskItem struct {
+	PK      string `dynamodbav:"pk"`
+	TaskID  int64  `dynamodbav:"sk"`
+	ShardID int    `dynamodbav:"shard_id"`
+	Cluster string `dynamodbav:"cluster"`
+	Data    []byte `dynamodbav:"data"`
+}
+
+// TimerTaskItem is the schema of timer_task table, sort key is the zero-padded visibilityTimestamp#taskID
+// so that the tasks are ordered by visibility timestamp.
+// Data is the JSON encoded timer task
+type TimerTaskItem struct {
+	ShardID             int    `dynamodbav:"pk"`
+	SK                  string `dynamodbav:"sk"`
+	VisibilityTimestamp int64  `dynamodbav:"visibility_timestamp"`
+	TaskID              int64  `dynamodbav:"task_id"`
+	Data                []byte `dynamodbav:"data"`
+}
+
+// TaskListItem is the schema of tasklist table, partition key is domainID#taskListName#taskListType
+type TaskListItem struct {
+	PK                     string `dynamodbav:"pk"`
+	DomainID               string `dynamodbav:"domain_id"`
+	TaskListName           string `dynamodbav:"tasklist_name"`
+	TaskListType           int    `dynamodbav:"tasklist_type"`
+	RangeID                int64  `dynamodbav:"range_id"`
+	TaskListKind           int    `dynamodbav:"tasklist_kind"`
+	AckLevel               int64  `dynamodbav:"ack_level"`
+	LastUpdatedTime        int64  `dynamodbav:"last_updated_time"`
+	Expiry                 int64  `dynamodbav:"expiry,omitempty"`
+	PartitionConfigVersion int64  `dynamodbav:"partition_config_version,omitempty"`
+	NumReadPartitions      int    `dynamodbav:"num_read_partitions,omitempty"`
+	NumWritePartitions     int    `dynamodbav:"num_write_partitions,omitempty"`
+}
+
+// TaskItem is the schema of task table, partition key is the same as tasklist table.
+// Data is the JSON encoded task
+type TaskItem struct {
+	PK     string `dynamodbav:"pk"`
+	TaskID int64  `dynamodbav:"sk"`
+	Data   []byte `dynamodbav:"data"`
+	Expiry int64  `dynamodbav:"expiry,omitempty"`
+}
+
+// QueueMessageItem is the schema of queue_message table
+type QueueMessageItem struct {
+	QueueType int    `dynamodbav:"pk"`
+	MessageID int64  `dynamodbav:"sk"`
+	Payload   []byte `dynamodbav:"payload"`
+}
+
+// QueueMetadataItem is the schema of queue_metadata table
+type QueueMetadataItem struct {
+	QueueType        int              `dynamodbav:"pk"`
+	ClusterAckLevels map[string]int64 `dynamodbav:"cluster_ack_levels"`
+	Version          int64            `dynamodbav:"version"`
+}
+
+// HistoryTreeItem is the schema of history_tree table.
+// Ancestors is the JSON encoded ancestors
+type HistoryTreeItem struct {
+	TreeID          string `dynamodbav:"pk"`
+	BranchID        string `dynamodbav:"sk"`
+	ShardID         int    `dynamodbav:"shard_id"`
+	Ancestors       []byte `dynamodbav:"ancestors"`
+	CreateTimestamp int64  `dynamodbav:"create_timestamp"`
+	Info            string `dynamodbav:"info"`
+}
+
+// HistoryNodeItem is the schema of history_node table, partition key is treeID#branchID,
+// sort key is the zero-padded nodeID#(MaxInt64-txnID), so that nodes are ordered by nodeID ASC and txnID DESC
+type HistoryNodeItem struct {
+	PK           string `dynamodbav:"pk"`
+	SK           string `dynamodbav:"sk"`
+	ShardID      int    `dynamodbav:"shard_id"`
+	NodeID       int64  `dynamodbav:"node_id"`
+	TxnID        int64  `dynamodbav:"txn_id"`
+	Data         []byte `dynamodbav:"data"`
+	DataEncoding string `dynamodbav:"data_encoding"`
+}
+
+// VisibilityItem is the schema of visibility table, partition key is domainID, sort key is workflowID#runID.
+// StartTime and CloseTime(nanoseconds) are the sort keys of the local secondary indexes.
+// Data is the JSON encoded visibility record
+type VisibilityItem struct {
+	DomainID     string `dynamodbav:"pk"`
+	SK           string `dynamodbav:"sk"`
+	WorkflowID   string `dynamodbav:"workflow_id"`
+	RunID        string `dynamodbav:"run_id"`
+	WorkflowType string `dynamodbav:"workflow_type"`
+	StartTime    int64  `dynamodbav:"start_time"`
+	CloseTime    int64  `dynamodbav:"close_time,omitempty"`
+	CloseStatus  int32  `dynamodbav:"close_status"`
+	Closed       bool   `dynamodbav:"closed"`
+	Data         []byte `dynamodbav:"data"`
+	Expiry       int64  `dynamodbav:"expiry,omitempty"`
+}
@@ -20,11 +20,33 @@
 
 package cadence
 
+import "time"
+
 // below are the names of all mongoDB collections
 const (
-	ClusterConfigCollectionName = "cluster_config"
+	ClusterConfigCollectionName      = "cluster_config"
+	DomainCollectionName             = "domain"
+	DomainMetadataCollectionName     = "domain_metadata"
+	ShardCollectionName              = "shard"
+	CurrentWorkflowCollectionName    = "current_workflow"
+	WorkflowExecutionCollectionName  = "workflow_execution"
+	TransferTaskCollectionName       = "transfer_task"
+	CrossClusterTaskCollectionName   = "cross_cluster_task"
+	ReplicationTaskCollectionName    = "replication_task"
+	ReplicationDLQTaskCollectionName = "replication_dlq_task"
+	TimerTaskCollectionName          = "timer_task"
+	TaskListCollectionName           = "tasklist"
+	TaskCollectionName               = "task"
+	QueueMessageCollectionName       = "queue_message"
+	QueueMetadataCollectionName      = "queue_metadata"
+	HistoryTreeCollectionName        = "history_tree"
+	HistoryNodeCollectionName        = "history_node"
+	VisibilityCollectionName         = "visibility"
 )
 
+// DomainMetadataDocumentID is the ID of the only document in domain_metadata collection
+const DomainMetadataDocumentID = "cadence-domain-metadata"
+
 // NOTE1: MongoDB collection is schemaless -- there is no schema file for collection. We use Go lang structs to define the collection fields.
 
 // NOTE2: MongoDB doesn't allow using camel case or underscore in the field names
@@ -39,3 +61,147 @@ type ClusterConfigCollectionEntry struct {
 	DataEncoding         string `json:"dataencoding"`
 	UnixTimestampSeconds int64  `json:"unixtimestampseconds"`
 }
+
+// DomainCollectionEntry is the schema of domain collection.
+// Data is the JSON encoded nosqlplugin.DomainRow, only the name and ID are significant.
+type DomainCollectionEntry struct {
+	ID                  string `bson:"_id"`
+	Name                string `bson:"name"`
+	NotificationVersion int64  `bson:"notificationversion"`
+	Data                []byte `bson:"data"`
+}
+
+// DomainMetadataCollectionEntry is the schema of domain_metadata collection, which has only one document
+type DomainMetadataCollectionEntry struct {
+	ID                  string `bson:"_id"`
+	NotificationVersion int64  `bson:"notificationversion"`
+}
+
+// ShardCollectionEntry is the schema of shard collection.
+// Data is the JSON encoded nosqlplugin.ShardRow
+type ShardCollectionEntry struct {
+	ShardID      int    `bson:"_id"`
+	RangeID      int64  `bson:"rangeid"`
+	LeaseCounter int64  `bson:"leasecounter"`
+	Data         []byte `bson:"data"`
+}
+
+// CurrentWorkflowCollectionEntry is the schema of current_workflow collection.
+// All the fields are significant because they are used as conditions of WorkflowCRUD
+type CurrentWorkflowCollectionEntry struct {
+	ShardID          int    `bson:"shardid"`
+	DomainID         string `bson:"domainid"`
+	WorkflowID       string `bson:"workflowid"`
+	RunID            string `bson:"runid"`
+	State            int    `bson:"state"`
+	CloseStatus      int    `bson:"closestatus"`
+	CreateRequestID  string `bson:"createrequestid"`
+	LastWriteVersion int64  `bson:"lastwriteversion"`
+}
+
+// WorkflowExecutionCollectionEntry is the schema of workflow_execution collection.
+// Data is the JSON encoded mutable state, including the 6 maps and buffered events
+type WorkflowExecutionCollectionEntry struct {
+	ShardID     int    `bson:"shardid"`
+	DomainID    string `bson:"domainid"`
+	WorkflowID  string `bson:"workflowid"`
+	RunID       string `bson:"runid"`
+	NextEventID int64  `bson:"nexteventid"`
+	Data        []byte `bson:"data"`
+}
+
+// TaskCollectionEntry is the schema of transfer_task, cross_cluster_task, replication_task
+// and replication_dlq_task collections. Cluster is only used by cross_cluster_task(target cluster)
+// and replication_dlq_task(source cluster).
+// Data is the JSON encoded task
+type TaskCollectionEntry struct {
+	ShardID int    `bson:"shardid"`
+	Cluster string `bson:"cluster,omitempty"`
+	TaskID  int64  `bson:"taskid"`
+	Data    []byte `bson:"data"`
+}
+
+// TimerTaskCollectionEntry is the schema of timer_task collection.
+// Data is the JSON encoded timer task
+type TimerTaskCollectionEntry struct {
+	ShardID                 int    `bson:"shardid"`
+	VisibilityTimestampNano int64  `bson:"visibilitytimestampnano"`
+	TaskID                  int64  `bson:"taskid"`
+	Data                    []byte `bson:"data"`
+}
+
+// TaskListCollectionEntry is the schema of tasklist collection
+type TaskListCollectionEntry struct {
+	DomainID               string     `bson:"domainid"`
+	TaskListName           string     `bson:"tasklistname"`
+	TaskListType           int        `bson:"tasklisttype"`
+	RangeID                int64      `bson:"rangeid"`
+	TaskListKind           int        `bson:"tasklistkind"`
+	AckLevel               int64      `bson:"acklevel"`
+	LastUpdatedTime        time.Time  `bson:"lastupdatedtime"`
+	ExpireAt               *time.Time `bson:"expireat,omitempty"`
+	PartitionConfigVersion int64      `bson:"partitionconfigversion,omitempty"`
+	NumReadPartitions      int        `bson:"numreadpartitions,omitempty"`
+	NumWritePartitions     int        `bson:"numwritepartitions,omitempty"`
+}
+
+// MatchingTaskCollectionEntry is the schema of task collection
+type MatchingTaskCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	TaskListName string     `bson:"tasklistname"`
+	TaskListType int        `bson:"tasklisttype"`
+	TaskID       int64      `bson:"taskid"`
+	Data         []byte     `bson:"data"`
+	ExpireAt     *time.Time `bson:"expireat,omitempty"`
+}
+
+// QueueMessageCollectionEntry is the schema of queue_message collection
+type QueueMessageCollectionEntry struct {
+	QueueType int    `bson:"queuetype"`
+	MessageID int64  `bson:"messageid"`
+	Payload   []byte `bson:"payload"`
+}
+
+// QueueMetadataCollectionEntry is the schema of queue_metadata collection
+type QueueMetadataCollectionEntry struct {
+	QueueType        int              `bson:"_id"`
+	ClusterAckLevels map[string]int64 `bson:"clusteracklevels"`
+	Version          int64            `bson:"version"`
+}
+
+// HistoryTreeCollectionEntry is the schema of history_tree collection.
+// Data is the JSON encoded ancestors
+type HistoryTreeCollectionEntry struct {
+	ShardID             int    `bson:"shardid"`
+	TreeID              string `bson:"treeid"`
+	BranchID            string `bson:"branchid"`
+	Ancestors           []byte `bson:"ancestors"`
+	CreateTimestampNano int64  `bson:"createtimestampnano"`
+	Info                string `bson:"info"`
+}
+
+// HistoryNodeCollectionEntry is the schema of history_node collection
+type HistoryNodeCollectionEntry struct {
+	ShardID      int    `bson:"shardid"`
+	TreeID       string `bson:"treeid"`
+	BranchID     string `bson:"branchid"`
+	NodeID       int64  `bson:"nodeid"`
+	TxnID        int64  `bson:"txnid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+}
+
+// VisibilityCollectionEntry is the schema of visibility collection.
+// Data is the JSON encoded visibility record
+type VisibilityCollectionEntry struct {
+	DomainID      string     `bson:"domainid"`
+	WorkflowID    string     `bson:"workflowid"`
+	RunID         string     `bson:"runid"`
+	WorkflowType  string     `bson:"workflowtype"`
+	StartTimeNano int64      `bson:"starttimenano"`
+	CloseTimeNano int64      `bson:"closetimenano"`
+	CloseStatus   int32      `bson:"closestatus"`
+	Closed        bool       `bson:"closed"`
+	Data          []byte     `bson:"data"`
+	ExpireAt      *time.Time `bson:"expireat,omitempty"`
+}
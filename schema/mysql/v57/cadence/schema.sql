@@ -265,3 +265,11 @@ CREATE TABLE queue_metadata (
   data MEDIUMBLOB NOT NULL,
   PRIMARY KEY(queue_type)
 );
+
+CREATE TABLE db_shard_maps (
+  version BIGINT NOT NULL,
+  --
+  data MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (version)
+);
@@ -263,3 +263,11 @@ CREATE TABLE queue_metadata (
   data BYTEA NOT NULL,
   PRIMARY KEY(queue_type)
 );
+
+CREATE TABLE db_shard_maps (
+  version BIGINT NOT NULL,
+  --
+  data BYTEA NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (version)
+);
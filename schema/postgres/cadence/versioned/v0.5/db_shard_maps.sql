@@ -0,0 +1,7 @@
+CREATE TABLE db_shard_maps (
+  version BIGINT NOT NULL,
+  --
+  data BYTEA NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (version)
+);
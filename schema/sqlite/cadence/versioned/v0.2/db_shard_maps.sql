@@ -0,0 +1,7 @@
+CREATE TABLE db_shard_maps (
+  version BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (version)
+);
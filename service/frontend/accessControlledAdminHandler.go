@@ -470,6 +470,38 @@ func (a *AccessControlledWorkflowAdminHandler) ListDynamicConfig(ctx context.Con
 	return a.AdminHandler.ListDynamicConfig(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) ListWorkflowTasks(ctx context.Context, request *types.ListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ListWorkflowTasks",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ListWorkflowTasks(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) RetryTask(ctx context.Context, request *types.RetryTaskRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "RetryTask",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.RetryTask(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
@@ -668,6 +668,31 @@ func (a *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
 	return a.frontendHandler.SignalWithStartWorkflowExecution(ctx, request)
 }
 
+// SignalWithStartWorkflowExecutionAsync API call
+func (a *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.SignalWithStartWorkflowExecutionAsyncRequest,
+) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendSignalWithStartWorkflowExecutionAsyncScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:      "SignalWithStartWorkflowExecutionAsync",
+		DomainName:   request.GetDomain(),
+		Permission:   authorization.PermissionWrite,
+		WorkflowType: request.GetWorkflowType(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.SignalWithStartWorkflowExecutionAsync(ctx, request)
+}
+
 // SignalWorkflowExecution API call
 func (a *AccessControlledWorkflowHandler) SignalWorkflowExecution(
 	ctx context.Context,
@@ -717,6 +742,31 @@ func (a *AccessControlledWorkflowHandler) StartWorkflowExecution(
 	return a.frontendHandler.StartWorkflowExecution(ctx, request)
 }
 
+// StartWorkflowExecutionAsync API call
+func (a *AccessControlledWorkflowHandler) StartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.StartWorkflowExecutionAsyncRequest,
+) (*types.StartWorkflowExecutionAsyncResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendStartWorkflowExecutionAsyncScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:      "StartWorkflowExecutionAsync",
+		DomainName:   request.GetDomain(),
+		Permission:   authorization.PermissionWrite,
+		WorkflowType: request.GetWorkflowType(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.StartWorkflowExecutionAsync(ctx, request)
+}
+
 // TerminateWorkflowExecution API call
 func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 	ctx context.Context,
@@ -833,6 +883,30 @@ func (a *AccessControlledWorkflowHandler) UpdateDomain(
 	return a.frontendHandler.UpdateDomain(ctx, request)
 }
 
+// UpdateWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UpdateWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateWorkflowExecution(ctx, request)
+}
+
 func (a *AccessControlledWorkflowHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
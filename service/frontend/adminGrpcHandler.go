@@ -71,6 +71,16 @@ func (g adminGRPCHandler) DescribeQueue(ctx context.Context, request *adminv1.De
 	return proto.FromAdminDescribeQueueResponse(response), proto.FromError(err)
 }
 
+func (g adminGRPCHandler) ListWorkflowTasks(ctx context.Context, request *adminv1.ListWorkflowTasksRequest) (*adminv1.ListWorkflowTasksResponse, error) {
+	response, err := g.h.ListWorkflowTasks(ctx, proto.ToAdminListWorkflowTasksRequest(request))
+	return proto.FromAdminListWorkflowTasksResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) RetryTask(ctx context.Context, request *adminv1.RetryTaskRequest) (*adminv1.RetryTaskResponse, error) {
+	err := g.h.RetryTask(ctx, proto.ToAdminRetryTaskRequest(request))
+	return &adminv1.RetryTaskResponse{}, proto.FromError(err)
+}
+
 func (g adminGRPCHandler) DescribeWorkflowExecution(ctx context.Context, request *adminv1.DescribeWorkflowExecutionRequest) (*adminv1.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.h.DescribeWorkflowExecution(ctx, proto.ToAdminDescribeWorkflowExecutionRequest(request))
 	return proto.FromAdminDescribeWorkflowExecutionResponse(response), proto.FromError(err)
@@ -96,6 +96,8 @@ type (
 		ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
 		DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error)
 		MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error)
+		ListWorkflowTasks(context.Context, *types.ListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error)
+		RetryTask(context.Context, *types.RetryTaskRequest) error
 	}
 
 	// adminHandlerImpl is an implementation for admin service independent of wire protocol
@@ -335,6 +337,25 @@ func (adh *adminHandlerImpl) RemoveTask(
 	return nil
 }
 
+// RetryTask redispatches a task which is backing off in the history queue processor
+func (adh *adminHandlerImpl) RetryTask(
+	ctx context.Context,
+	request *types.RetryTaskRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRetryTaskScope)
+	defer sw.Stop()
+
+	if request == nil || request.Type == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.GetHistoryClient().RetryTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 func (adh *adminHandlerImpl) getCorruptWorkflowQueryTemplates(
 	ctx context.Context, request *types.AdminMaintainWorkflowRequest,
 ) []workflowQueryTemplate {
@@ -1316,6 +1337,36 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// ListWorkflowTasks lists the pending history queue tasks of a workflow
+func (adh *adminHandlerImpl) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.ListWorkflowTasksRequest,
+) (resp *types.ListWorkflowTasksResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListWorkflowTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.GetHistoryClient().ListWorkflowTasks(ctx, &types.HistoryListWorkflowTasksRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
@@ -296,6 +296,21 @@ func (mr *MockAdminHandlerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).ListDynamicConfig), arg0, arg1)
 }
 
+// ListWorkflowTasks mocks base method.
+func (m *MockAdminHandler) ListWorkflowTasks(arg0 context.Context, arg1 *types.ListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowTasks indicates an expected call of ListWorkflowTasks.
+func (mr *MockAdminHandlerMockRecorder) ListWorkflowTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowTasks", reflect.TypeOf((*MockAdminHandler)(nil).ListWorkflowTasks), arg0, arg1)
+}
+
 // MaintainCorruptWorkflow mocks base method.
 func (m *MockAdminHandler) MaintainCorruptWorkflow(arg0 context.Context, arg1 *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +469,20 @@ func (mr *MockAdminHandlerMockRecorder) RestoreDynamicConfig(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).RestoreDynamicConfig), arg0, arg1)
 }
 
+// RetryTask mocks base method.
+func (m *MockAdminHandler) RetryTask(arg0 context.Context, arg1 *types.RetryTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryTask indicates an expected call of RetryTask.
+func (mr *MockAdminHandlerMockRecorder) RetryTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTask", reflect.TypeOf((*MockAdminHandler)(nil).RetryTask), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockAdminHandler) Start() {
 	m.ctrl.T.Helper()
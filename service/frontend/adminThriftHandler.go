@@ -207,3 +207,15 @@ func (t AdminThriftHandler) ListDynamicConfig(ctx context.Context, request *admi
 	response, err := t.h.ListDynamicConfig(ctx, thrift.ToListDynamicConfigRequest(request))
 	return thrift.FromListDynamicConfigResponse(response), thrift.FromError(err)
 }
+
+// ListWorkflowTasks forwards request to the underlying handler
+func (t AdminThriftHandler) ListWorkflowTasks(ctx context.Context, request *admin.ListWorkflowTasksRequest) (*admin.ListWorkflowTasksResponse, error) {
+	response, err := t.h.ListWorkflowTasks(ctx, thrift.ToListWorkflowTasksRequest(request))
+	return thrift.FromListWorkflowTasksResponse(response), thrift.FromError(err)
+}
+
+// RetryTask forwards request to the underlying handler
+func (t AdminThriftHandler) RetryTask(ctx context.Context, request *admin.RetryTaskRequest) error {
+	err := t.h.RetryTask(ctx, thrift.ToRetryTaskRequest(request))
+	return thrift.FromError(err)
+}
@@ -102,6 +102,12 @@ func TestAdminThriftHandler(t *testing.T) {
 		assert.Equal(t, admin.GetWorkflowExecutionRawHistoryV2Response{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ListWorkflowTasks", func(t *testing.T) {
+		h.EXPECT().ListWorkflowTasks(ctx, &types.ListWorkflowTasksRequest{}).Return(&types.ListWorkflowTasksResponse{}, internalErr).Times(1)
+		resp, err := th.ListWorkflowTasks(ctx, &admin.ListWorkflowTasksRequest{})
+		assert.Equal(t, admin.ListWorkflowTasksResponse{ShardID: common.Int32Ptr(0), Truncated: common.BoolPtr(false)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("MergeDLQMessages", func(t *testing.T) {
 		h.EXPECT().MergeDLQMessages(ctx, &types.MergeDLQMessagesRequest{}).Return(&types.MergeDLQMessagesResponse{}, internalErr).Times(1)
 		resp, err := th.MergeDLQMessages(ctx, &replicator.MergeDLQMessagesRequest{})
@@ -144,6 +150,11 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.ResetQueue(ctx, &shared.ResetQueueRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RetryTask", func(t *testing.T) {
+		h.EXPECT().RetryTask(ctx, &types.RetryTaskRequest{}).Return(internalErr).Times(1)
+		err := th.RetryTask(ctx, &admin.RetryTaskRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("GetCrossClusterTasks", func(t *testing.T) {
 		h.EXPECT().GetCrossClusterTasks(ctx, &types.GetCrossClusterTasksRequest{}).Return(&types.GetCrossClusterTasksResponse{}, internalErr).Times(1)
 		resp, err := th.GetCrossClusterTasks(ctx, &shared.GetCrossClusterTasksRequest{})
@@ -22,6 +22,7 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -1048,6 +1049,40 @@ func (handler *ClusterRedirectionHandlerImpl) SignalWithStartWorkflowExecution(
 	return resp, err
 }
 
+// SignalWithStartWorkflowExecutionAsync API call
+func (handler *ClusterRedirectionHandlerImpl) SignalWithStartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.SignalWithStartWorkflowExecutionAsyncRequest,
+) (resp *types.SignalWithStartWorkflowExecutionAsyncResponse, retError error) {
+
+	var apiName = "SignalWithStartWorkflowExecutionAsync"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionSignalWithStartWorkflowExecutionAsyncScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.SignalWithStartWorkflowExecutionAsync(ctx, request)
+		default:
+			// queues are local to a cluster, so the remote cluster gets the request synchronously
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			_, err = remoteClient.SignalWithStartWorkflowExecution(ctx, request.SignalWithStartWorkflowExecutionRequest)
+			if err == nil {
+				resp = &types.SignalWithStartWorkflowExecutionAsyncResponse{}
+			}
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // SignalWorkflowExecution API call
 func (handler *ClusterRedirectionHandlerImpl) SignalWorkflowExecution(
 	ctx context.Context,
@@ -1107,6 +1142,40 @@ func (handler *ClusterRedirectionHandlerImpl) StartWorkflowExecution(
 	return resp, err
 }
 
+// StartWorkflowExecutionAsync API call
+func (handler *ClusterRedirectionHandlerImpl) StartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.StartWorkflowExecutionAsyncRequest,
+) (resp *types.StartWorkflowExecutionAsyncResponse, retError error) {
+
+	var apiName = "StartWorkflowExecutionAsync"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionStartWorkflowExecutionAsyncScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.StartWorkflowExecutionAsync(ctx, request)
+		default:
+			// queues are local to a cluster, so the remote cluster gets the request synchronously
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			_, err = remoteClient.StartWorkflowExecution(ctx, request.StartWorkflowExecutionRequest)
+			if err == nil {
+				resp = &types.StartWorkflowExecutionAsyncResponse{}
+			}
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // TerminateWorkflowExecution API call
 func (handler *ClusterRedirectionHandlerImpl) TerminateWorkflowExecution(
 	ctx context.Context,
@@ -1137,6 +1206,36 @@ func (handler *ClusterRedirectionHandlerImpl) TerminateWorkflowExecution(
 	return err
 }
 
+// UpdateWorkflowExecution API call
+func (handler *ClusterRedirectionHandlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+
+	var apiName = "UpdateWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+		default:
+			// remote frontend clients do not expose the API yet
+			err = &types.BadRequestError{Message: fmt.Sprintf("UpdateWorkflowExecution cannot be forwarded to cluster %v.", targetDC)}
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // ListTaskListPartitions API call
 func (handler *ClusterRedirectionHandlerImpl) ListTaskListPartitions(
 	ctx context.Context,
@@ -43,6 +43,8 @@ const (
 	// 5. TerminateWorkflowExecution
 	// 6. QueryWorkflowStrongConsistency
 	// 7. ResetWorkflow
+	// 8. StartWorkflowExecutionAsync
+	// 9. SignalWithStartWorkflowExecutionAsync
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlist and DCRedirectionPolicySelectedAPIsForwardingV2
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 	// DCRedirectionPolicySelectedAPIsForwardingV2 forwards everything in DCRedirectionPolicySelectedAPIsForwarding,
@@ -100,26 +102,30 @@ type (
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlist contains a list of non-worker APIs which can be redirected.
 // This is paired with DCRedirectionPolicySelectedAPIsForwarding - keep both lists up to date.
 var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
-	"StartWorkflowExecution":           {},
-	"SignalWithStartWorkflowExecution": {},
-	"SignalWorkflowExecution":          {},
-	"RequestCancelWorkflowExecution":   {},
-	"TerminateWorkflowExecution":       {},
-	"QueryWorkflowStrongConsistency":   {},
-	"ResetWorkflowExecution":           {},
+	"StartWorkflowExecution":                {},
+	"SignalWithStartWorkflowExecution":      {},
+	"SignalWorkflowExecution":               {},
+	"RequestCancelWorkflowExecution":        {},
+	"TerminateWorkflowExecution":            {},
+	"QueryWorkflowStrongConsistency":        {},
+	"ResetWorkflowExecution":                {},
+	"StartWorkflowExecutionAsync":           {},
+	"SignalWithStartWorkflowExecutionAsync": {},
 }
 
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 contains a list of non-worker APIs which can be redirected.
 // This is paired with DCRedirectionPolicySelectedAPIsForwardingV2 - keep both lists up to date.
 var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	// from selectedAPIsForwardingRedirectionPolicyAPIAllowlist
-	"StartWorkflowExecution":           {},
-	"SignalWithStartWorkflowExecution": {},
-	"SignalWorkflowExecution":          {},
-	"RequestCancelWorkflowExecution":   {},
-	"TerminateWorkflowExecution":       {},
-	"QueryWorkflowStrongConsistency":   {},
-	"ResetWorkflowExecution":           {},
+	"StartWorkflowExecution":                {},
+	"SignalWithStartWorkflowExecution":      {},
+	"SignalWorkflowExecution":               {},
+	"RequestCancelWorkflowExecution":        {},
+	"TerminateWorkflowExecution":            {},
+	"QueryWorkflowStrongConsistency":        {},
+	"ResetWorkflowExecution":                {},
+	"StartWorkflowExecutionAsync":           {},
+	"SignalWithStartWorkflowExecutionAsync": {},
 	// additional endpoints
 	"RecordActivityTaskHeartbeat":      {},
 	"RecordActivityTaskHeartbeatByID":  {},
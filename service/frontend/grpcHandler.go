@@ -26,6 +26,7 @@ import (
 	"go.uber.org/yarpc"
 
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
@@ -43,6 +44,7 @@ func (g grpcHandler) register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(apiv1.BuildWorkerAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildVisibilityAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildMetaAPIYARPCProcedures(g))
+	dispatcher.Register(frontendv1.BuildWorkflowAPIYARPCProcedures(g))
 }
 
 func (g grpcHandler) Health(ctx context.Context, _ *apiv1.HealthRequest) (*apiv1.HealthResponse, error) {
@@ -235,6 +237,11 @@ func (g grpcHandler) SignalWithStartWorkflowExecution(ctx context.Context, reque
 	return proto.FromSignalWithStartWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, request *frontendv1.SignalWithStartWorkflowExecutionAsyncRequest) (*frontendv1.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+	response, err := g.h.SignalWithStartWorkflowExecutionAsync(ctx, proto.ToSignalWithStartWorkflowExecutionAsyncRequest(request))
+	return proto.FromSignalWithStartWorkflowExecutionAsyncResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) SignalWorkflowExecution(ctx context.Context, request *apiv1.SignalWorkflowExecutionRequest) (*apiv1.SignalWorkflowExecutionResponse, error) {
 	err := g.h.SignalWorkflowExecution(ctx, proto.ToSignalWorkflowExecutionRequest(request))
 	return &apiv1.SignalWorkflowExecutionResponse{}, proto.FromError(err)
@@ -245,6 +252,11 @@ func (g grpcHandler) StartWorkflowExecution(ctx context.Context, request *apiv1.
 	return proto.FromStartWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) StartWorkflowExecutionAsync(ctx context.Context, request *frontendv1.StartWorkflowExecutionAsyncRequest) (*frontendv1.StartWorkflowExecutionAsyncResponse, error) {
+	response, err := g.h.StartWorkflowExecutionAsync(ctx, proto.ToStartWorkflowExecutionAsyncRequest(request))
+	return proto.FromStartWorkflowExecutionAsyncResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) TerminateWorkflowExecution(ctx context.Context, request *apiv1.TerminateWorkflowExecutionRequest) (*apiv1.TerminateWorkflowExecutionResponse, error) {
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToTerminateWorkflowExecutionRequest(request))
 	return &apiv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
@@ -254,3 +266,8 @@ func (g grpcHandler) UpdateDomain(ctx context.Context, request *apiv1.UpdateDoma
 	response, err := g.h.UpdateDomain(ctx, proto.ToUpdateDomainRequest(request))
 	return proto.FromUpdateDomainResponse(response), proto.FromError(err)
 }
+
+func (g grpcHandler) UpdateWorkflowExecution(ctx context.Context, request *apiv1.UpdateWorkflowExecutionRequest) (*apiv1.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, proto.ToUpdateWorkflowExecutionRequest(request))
+	return proto.FromUpdateWorkflowExecutionResponse(response), proto.FromError(err)
+}
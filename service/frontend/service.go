@@ -104,11 +104,24 @@ type Config struct {
 	// VisibilityArchival system protection
 	VisibilityArchivalQueryMaxPageSize dynamicconfig.IntPropertyFn
 
+	// ArchivedHistoryCacheMaxSize is read once when the frontend starts
+	ArchivedHistoryCacheMaxSize dynamicconfig.IntPropertyFn
+	// EnableArchivedRunIDLookup gates the visibility archive queries for workflows requested without a run ID
+	EnableArchivedRunIDLookup dynamicconfig.BoolPropertyFnWithDomainFilter
+
 	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	// max number of decisions per RespondDecisionTaskCompleted request (unlimited by default)
 	DecisionResultCountLimit dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// Async workflow APIs
+	EnableAsyncWorkflowStart dynamicconfig.BoolPropertyFnWithDomainFilter
+	AsyncWorkflowQueueName   dynamicconfig.StringPropertyFnWithDomainFilter
+	// AsyncWorkflowQueues are the queues drained by the worker service, async requests to other queues are rejected
+	AsyncWorkflowQueues []string
+
+	EnableUpdateWorkflowExecution dynamicconfig.BoolPropertyFnWithDomainFilter
+
 	// Debugging
 
 	// Emit signal related metrics with signal name tag. Be aware of cardinality.
@@ -165,11 +178,16 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		SearchAttributesSizeOfValueLimit:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit),
 		SearchAttributesTotalSizeLimit:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit),
 		VisibilityArchivalQueryMaxPageSize:          dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize),
+		ArchivedHistoryCacheMaxSize:                 dc.GetIntProperty(dynamicconfig.FrontendArchivedHistoryCacheMaxSize),
+		EnableArchivedRunIDLookup:                   dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEnableArchivedRunIDLookup),
 		DisallowQuery:                               dc.GetBoolPropertyFilteredByDomain(dynamicconfig.DisallowQuery),
 		SendRawWorkflowHistory:                      dc.GetBoolPropertyFilteredByDomain(dynamicconfig.SendRawWorkflowHistory),
 		DecisionResultCountLimit:                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDecisionResultCountLimit),
 		EmitSignalNameMetricsTag:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEmitSignalNameMetricsTag),
 		Lockdown:                                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.Lockdown),
+		EnableAsyncWorkflowStart:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableAsyncWorkflowStart),
+		AsyncWorkflowQueueName:                      dc.GetStringPropertyFilteredByDomain(dynamicconfig.AsyncWorkflowQueueName),
+		EnableUpdateWorkflowExecution:               dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableUpdateWorkflowExecution),
 		domainConfig: domain.Config{
 			MaxBadBinaryCount:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries),
 			MinRetentionDays:       dc.GetIntProperty(dynamicconfig.MinRetentionDays),
@@ -219,6 +237,7 @@ func NewService(
 		isAdvancedVisExistInConfig,
 	)
 	params.PersistenceConfig.HistoryMaxConns = serviceConfig.HistoryMgrNumConns()
+	serviceConfig.AsyncWorkflowQueues = params.AsyncWorkflowQueueConfig.Queues
 
 	serviceResource, err := resource.New(
 		params,
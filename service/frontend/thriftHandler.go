@@ -276,6 +276,12 @@ func (t ThriftHandler) SignalWithStartWorkflowExecution(ctx context.Context, req
 	return thrift.FromStartWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+// SignalWithStartWorkflowExecutionAsync forwards request to the underlying handler
+func (t ThriftHandler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, request *shared.SignalWithStartWorkflowExecutionAsyncRequest) (*shared.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+	response, err := t.h.SignalWithStartWorkflowExecutionAsync(ctx, thrift.ToSignalWithStartWorkflowExecutionAsyncRequest(request))
+	return thrift.FromSignalWithStartWorkflowExecutionAsyncResponse(response), thrift.FromError(err)
+}
+
 // SignalWorkflowExecution forwards request to the underlying handler
 func (t ThriftHandler) SignalWorkflowExecution(ctx context.Context, request *shared.SignalWorkflowExecutionRequest) error {
 	err := t.h.SignalWorkflowExecution(ctx, thrift.ToSignalWorkflowExecutionRequest(request))
@@ -288,6 +294,12 @@ func (t ThriftHandler) StartWorkflowExecution(ctx context.Context, request *shar
 	return thrift.FromStartWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+// StartWorkflowExecutionAsync forwards request to the underlying handler
+func (t ThriftHandler) StartWorkflowExecutionAsync(ctx context.Context, request *shared.StartWorkflowExecutionAsyncRequest) (*shared.StartWorkflowExecutionAsyncResponse, error) {
+	response, err := t.h.StartWorkflowExecutionAsync(ctx, thrift.ToStartWorkflowExecutionAsyncRequest(request))
+	return thrift.FromStartWorkflowExecutionAsyncResponse(response), thrift.FromError(err)
+}
+
 // TerminateWorkflowExecution forwards request to the underlying handler
 func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *shared.TerminateWorkflowExecutionRequest) error {
 	err := t.h.TerminateWorkflowExecution(ctx, thrift.ToTerminateWorkflowExecutionRequest(request))
@@ -299,3 +311,9 @@ func (t ThriftHandler) UpdateDomain(ctx context.Context, request *shared.UpdateD
 	response, err := t.h.UpdateDomain(ctx, thrift.ToUpdateDomainRequest(request))
 	return thrift.FromUpdateDomainResponse(response), thrift.FromError(err)
 }
+
+// UpdateWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UpdateWorkflowExecution(ctx context.Context, request *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	response, err := t.h.UpdateWorkflowExecution(ctx, thrift.ToUpdateWorkflowExecutionRequest(request))
+	return thrift.FromUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
@@ -244,6 +244,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("")}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("SignalWithStartWorkflowExecutionAsync", func(t *testing.T) {
+		h.EXPECT().SignalWithStartWorkflowExecutionAsync(ctx, &types.SignalWithStartWorkflowExecutionAsyncRequest{}).Return(&types.SignalWithStartWorkflowExecutionAsyncResponse{}, internalErr).Times(1)
+		resp, err := th.SignalWithStartWorkflowExecutionAsync(ctx, &shared.SignalWithStartWorkflowExecutionAsyncRequest{})
+		assert.Equal(t, shared.SignalWithStartWorkflowExecutionAsyncResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("SignalWorkflowExecution", func(t *testing.T) {
 		h.EXPECT().SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{}).Return(internalErr).Times(1)
 		err := th.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{})
@@ -255,6 +261,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("")}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("StartWorkflowExecutionAsync", func(t *testing.T) {
+		h.EXPECT().StartWorkflowExecutionAsync(ctx, &types.StartWorkflowExecutionAsyncRequest{}).Return(&types.StartWorkflowExecutionAsyncResponse{}, internalErr).Times(1)
+		resp, err := th.StartWorkflowExecutionAsync(ctx, &shared.StartWorkflowExecutionAsyncRequest{})
+		assert.Equal(t, shared.StartWorkflowExecutionAsyncResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("TerminateWorkflowExecution", func(t *testing.T) {
 		h.EXPECT().TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{}).Return(internalErr).Times(1)
 		err := th.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{})
@@ -266,4 +278,10 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.UpdateDomainResponse{IsGlobalDomain: common.BoolPtr(false), FailoverVersion: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowExecution(ctx, &types.UpdateWorkflowExecutionRequest{}).Return(&types.UpdateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateWorkflowExecution(ctx, &shared.UpdateWorkflowExecutionRequest{})
+		assert.Equal(t, shared.UpdateWorkflowExecutionResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/asyncworkflow"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
@@ -94,6 +96,11 @@ type (
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
 		throttleRetry             *backoff.ThrottleRetry
+		// archivedHistoryCache is nil when caching of archived history is disabled
+		archivedHistoryCache cache.Cache
+
+		asyncWorkflowProducersLock sync.Mutex
+		asyncWorkflowProducers     map[string]messaging.Producer
 	}
 
 	getHistoryContinuationToken struct {
@@ -104,6 +111,8 @@ type (
 		PersistenceToken  []byte
 		TransientDecision *types.TransientDecisionInfo
 		BranchToken       []byte
+		// ArchivalToken is the history archiver page token, only set when history is read from archival
+		ArchivalToken []byte
 	}
 
 	domainGetter interface {
@@ -139,11 +148,19 @@ var (
 	errInvalidDelayStartSeconds                   = &types.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
 	errInvalidJitterStartSeconds                  = &types.BadRequestError{Message: "A valid JitterStartSeconds is not set on request (negative)."}
 	errInvalidJitterStartSeconds2                 = &types.BadRequestError{Message: "A valid JitterStartSeconds is not set on request (larger than cron duration)."}
+	errInvalidWorkflowIDConflictPolicy            = &types.BadRequestError{Message: "WorkflowIDConflictPolicy is invalid."}
+	errInvalidPriority                            = &types.BadRequestError{Message: "A valid Priority is not set on request (negative)."}
+	errConflictPolicyWithTerminateIfRunning       = &types.BadRequestError{Message: "WorkflowIDReusePolicy TerminateIfRunning can only be combined with WorkflowIDConflictPolicy TerminateExisting."}
 	errQueryDisallowedForDomain                   = &types.BadRequestError{Message: "Domain is not allowed to query, please contact cadence team to re-enable queries."}
 	errClusterNameNotSet                          = &types.BadRequestError{Message: "Cluster name is not set."}
 	errEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
 	errEmptyQueueType                             = &types.BadRequestError{Message: "Queue type is not set."}
 	errDomainInLockdown                           = &types.BadRequestError{Message: "Domain is not accepting fail overs at this time due to lockdown."}
+	errAsyncWorkflowNotEnabled                    = &types.BadRequestError{Message: "Async workflow APIs are not enabled for the domain."}
+	errAsyncWorkflowQueueNotConfigured            = &types.InternalServiceError{Message: "Async workflow queue is not configured."}
+	errUpdateWorkflowExecutionNotEnabled          = &types.BadRequestError{Message: "UpdateWorkflowExecution is not enabled for the domain."}
+	errUpdateIDNotSet                             = &types.BadRequestError{Message: "UpdateID is not set on request."}
+	errUpdateNameNotSet                           = &types.BadRequestError{Message: "UpdateName is not set on request."}
 	errShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
 
 	// err for archival
@@ -154,6 +171,8 @@ var (
 	errWorkflowTypeTooLong = &types.BadRequestError{Message: "WorkflowType length exceeds limit."}
 	errWorkflowIDTooLong   = &types.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	errSignalNameTooLong   = &types.BadRequestError{Message: "SignalName length exceeds limit."}
+	errUpdateIDTooLong     = &types.BadRequestError{Message: "UpdateID length exceeds limit."}
+	errUpdateNameTooLong   = &types.BadRequestError{Message: "UpdateName length exceeds limit."}
 	errTaskListTooLong     = &types.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &types.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &types.BadRequestError{Message: "Identity length exceeds limit."}
@@ -168,6 +187,11 @@ func NewWorkflowHandler(
 	replicationMessageSink messaging.Producer,
 	versionChecker client.VersionChecker,
 ) *WorkflowHandler {
+	var archivedHistoryCache cache.Cache
+	if maxSize := config.ArchivedHistoryCacheMaxSize(); maxSize > 0 {
+		archivedHistoryCache = archiver.NewHistoryCache(maxSize)
+	}
+
 	return &WorkflowHandler{
 		Resource:        resource,
 		config:          config,
@@ -229,6 +253,8 @@ func NewWorkflowHandler(
 			backoff.WithRetryPolicy(frontendServiceRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		archivedHistoryCache:   archivedHistoryCache,
+		asyncWorkflowProducers: make(map[string]messaging.Producer),
 	}
 }
 
@@ -608,11 +634,13 @@ func (wh *WorkflowHandler) PollForActivityTask(
 	}
 
 	pollerID := uuid.New()
+	isolationGroup := getIsolationGroup(ctx)
 	op := func() error {
 		resp, err = wh.GetMatchingClient().PollForActivityTask(ctx, &types.MatchingPollForActivityTaskRequest{
-			DomainUUID:  domainID,
-			PollerID:    pollerID,
-			PollRequest: pollRequest,
+			DomainUUID:     domainID,
+			PollerID:       pollerID,
+			PollRequest:    pollRequest,
+			IsolationGroup: isolationGroup,
 		})
 		return err
 	}
@@ -723,12 +751,14 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	}
 
 	pollerID := uuid.New()
+	isolationGroup := getIsolationGroup(ctx)
 	var matchingResp *types.MatchingPollForDecisionTaskResponse
 	op := func() error {
 		matchingResp, err = wh.GetMatchingClient().PollForDecisionTask(ctx, &types.MatchingPollForDecisionTaskRequest{
-			DomainUUID:  domainID,
-			PollerID:    pollerID,
-			PollRequest: pollRequest,
+			DomainUUID:     domainID,
+			PollerID:       pollerID,
+			PollRequest:    pollRequest,
+			IsolationGroup: isolationGroup,
 		})
 		return err
 	}
@@ -2033,6 +2063,85 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
+	domainID, err := wh.validateStartWorkflowExecutionRequest(startRequest, scope)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	wh.GetLogger().Debug("Start workflow execution request domainID", tag.WorkflowDomainID(domainID))
+	historyRequest := common.CreateHistoryStartWorkflowRequest(
+		domainID, startRequest, time.Now())
+	historyRequest.IsolationGroup = getIsolationGroup(ctx)
+
+	resp, err = wh.GetHistoryClient().StartWorkflowExecution(ctx, historyRequest)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	return resp, nil
+}
+
+// StartWorkflowExecutionAsync - Validates a start request and writes it to the async workflow queue of
+// the domain. The worker service forwards queued requests to history.
+func (wh *WorkflowHandler) StartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.StartWorkflowExecutionAsyncRequest,
+) (resp *types.StartWorkflowExecutionAsyncResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), wh.GetLogger(), &retError) }()
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendStartWorkflowExecutionAsyncScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil || request.StartWorkflowExecutionRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	startRequest := request.StartWorkflowExecutionRequest
+	domainName := startRequest.GetDomain()
+	wfExecution := &types.WorkflowExecution{
+		WorkflowID: startRequest.GetWorkflowID(),
+	}
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if !wh.config.EnableAsyncWorkflowStart(domainName) {
+		return nil, wh.error(errAsyncWorkflowNotEnabled, scope, tags...)
+	}
+
+	if ok := wh.allow(ratelimitTypeUser, startRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	if _, err := wh.validateStartWorkflowExecutionRequest(startRequest, scope); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	message, err := asyncworkflow.NewStartWorkflowExecutionMessage(startRequest, time.Now())
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	if err := wh.publishAsyncWorkflowMessage(ctx, domainName, message); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	return &types.StartWorkflowExecutionAsyncResponse{}, nil
+}
+
+// validateStartWorkflowExecutionRequest validates a start request and returns the ID of its domain
+func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(
+	startRequest *types.StartWorkflowExecutionRequest,
+	scope metrics.Scope,
+) (string, error) {
+	domainName := startRequest.GetDomain()
 	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
 	if !common.ValidIDLength(
 		domainName,
@@ -2043,11 +2152,11 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeDomainName) {
-		return nil, wh.error(errDomainTooLong, scope, tags...)
+		return "", errDomainTooLong
 	}
 
 	if startRequest.GetWorkflowID() == "" {
-		return nil, wh.error(errWorkflowIDNotSet, scope, tags...)
+		return "", errWorkflowIDNotSet
 	}
 
 	if !common.ValidIDLength(
@@ -2059,15 +2168,19 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeWorkflowID) {
-		return nil, wh.error(errWorkflowIDTooLong, scope, tags...)
+		return "", errWorkflowIDTooLong
 	}
 
 	if err := common.ValidateRetryPolicy(startRequest.RetryPolicy); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 
 	if err := backoff.ValidateSchedule(startRequest.GetCronSchedule()); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
+	}
+
+	if err := validateWorkflowIDConflictPolicy(startRequest.WorkflowIDConflictPolicy, startRequest.WorkflowIDReusePolicy); err != nil {
+		return "", err
 	}
 
 	wh.GetLogger().Debug(
@@ -2075,7 +2188,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		tag.WorkflowID(startRequest.GetWorkflowID()))
 
 	if startRequest.WorkflowType == nil || startRequest.WorkflowType.GetName() == "" {
-		return nil, wh.error(errWorkflowTypeNotSet, scope, tags...)
+		return "", errWorkflowTypeNotSet
 	}
 
 	if !common.ValidIDLength(
@@ -2087,27 +2200,31 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeWorkflowType) {
-		return nil, wh.error(errWorkflowTypeTooLong, scope, tags...)
+		return "", errWorkflowTypeTooLong
 	}
 
 	if err := wh.validateTaskList(startRequest.TaskList, scope, domainName); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 
 	if startRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
-		return nil, wh.error(errInvalidExecutionStartToCloseTimeoutSeconds, scope, tags...)
+		return "", errInvalidExecutionStartToCloseTimeoutSeconds
 	}
 
 	if startRequest.GetTaskStartToCloseTimeoutSeconds() <= 0 {
-		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope, tags...)
+		return "", errInvalidTaskStartToCloseTimeoutSeconds
 	}
 
 	if startRequest.GetDelayStartSeconds() < 0 {
-		return nil, wh.error(errInvalidDelayStartSeconds, scope, tags...)
+		return "", errInvalidDelayStartSeconds
 	}
 
 	if startRequest.GetJitterStartSeconds() < 0 {
-		return nil, wh.error(errInvalidJitterStartSeconds, scope, tags...)
+		return "", errInvalidJitterStartSeconds
+	}
+
+	if startRequest.GetPriority() < 0 {
+		return "", errInvalidPriority
 	}
 
 	jitter := startRequest.GetJitterStartSeconds()
@@ -2120,12 +2237,12 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		// middle of a minute)
 		backoffSeconds := backoff.GetBackoffForNextScheduleInSeconds(cron, time.Time{}, time.Time{}, jitter)
 		if jitter > backoffSeconds {
-			return nil, wh.error(errInvalidJitterStartSeconds2, scope, tags...)
+			return "", errInvalidJitterStartSeconds2
 		}
 	}
 
 	if startRequest.GetRequestID() == "" {
-		return nil, wh.error(errRequestIDNotSet, scope, tags...)
+		return "", errRequestIDNotSet
 	}
 
 	if !common.ValidIDLength(
@@ -2137,17 +2254,17 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeRequestID) {
-		return nil, wh.error(errRequestIDTooLong, scope, tags...)
+		return "", errRequestIDTooLong
 	}
 
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(startRequest.SearchAttributes, domainName); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 
 	wh.GetLogger().Debug("Start workflow execution request domain", tag.WorkflowDomainName(domainName))
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
@@ -2167,18 +2284,9 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		wh.GetThrottledLogger(),
 		tag.BlobSizeViolationOperation("StartWorkflowExecution"),
 	); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
-
-	wh.GetLogger().Debug("Start workflow execution request domainID", tag.WorkflowDomainID(domainID))
-	historyRequest := common.CreateHistoryStartWorkflowRequest(
-		domainID, startRequest, time.Now())
-
-	resp, err = wh.GetHistoryClient().StartWorkflowExecution(ctx, historyRequest)
-	if err != nil {
-		return nil, wh.error(err, scope, tags...)
-	}
-	return resp, nil
+	return domainID, nil
 }
 
 // GetWorkflowExecutionHistory - retrieves the history of workflow execution
@@ -2237,11 +2345,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		getRequest.MaximumPageSize = common.GetHistoryMaxPageSize
 	}
 
-	if !getRequest.GetSkipArchival() {
-		enableArchivalRead := wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled()
-		historyArchived := wh.historyArchived(ctx, getRequest, domainID)
-		if enableArchivalRead && historyArchived {
-			return wh.getArchivedHistory(ctx, getRequest, domainID, scope, tags...)
+	if !getRequest.GetSkipArchival() && wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled() {
+		if runID, archived := wh.historyArchived(ctx, getRequest, domainID); archived {
+			return wh.getArchivedHistory(ctx, getRequest, domainID, runID, scope, tags...)
 		}
 	}
 
@@ -2567,6 +2673,141 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowExecution is used to send a validated request to a running workflow execution and wait for its result.
+// This results in WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created
+// for the execution. The call returns once the workflow responds to the update on a decision task completion, which
+// is recorded as WorkflowExecutionUpdateCompleted event.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *types.UpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), wh.GetLogger(), &retError) }()
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUpdateWorkflowExecutionScope, updateRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := updateRequest.GetDomain()
+	wfExecution := updateRequest.GetWorkflowExecution()
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if !wh.config.EnableUpdateWorkflowExecution(domainName) {
+		return nil, wh.error(errUpdateWorkflowExecutionNotEnabled, scope, tags...)
+	}
+
+	if ok := wh.allow(ratelimitTypeUser, updateRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	if err := validateExecution(wfExecution); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
+	if !common.ValidIDLength(
+		domainName,
+		scope,
+		idLengthWarnLimit,
+		wh.config.DomainNameMaxLength(domainName),
+		metrics.CadenceErrDomainNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeDomainName) {
+		return nil, wh.error(errDomainTooLong, scope, tags...)
+	}
+
+	if updateRequest.GetUpdateID() == "" {
+		return nil, wh.error(errUpdateIDNotSet, scope, tags...)
+	}
+
+	// update ID is used to deduplicate updates the same way request ID is used to deduplicate signals
+	if !common.ValidIDLength(
+		updateRequest.GetUpdateID(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return nil, wh.error(errUpdateIDTooLong, scope, tags...)
+	}
+
+	if updateRequest.GetUpdateName() == "" {
+		return nil, wh.error(errUpdateNameNotSet, scope, tags...)
+	}
+
+	if !common.ValidIDLength(
+		updateRequest.GetUpdateName(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.SignalNameMaxLength(domainName),
+		metrics.CadenceErrSignalNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeSignalName) {
+		return nil, wh.error(errUpdateNameTooLong, scope, tags...)
+	}
+
+	if !common.ValidIDLength(
+		updateRequest.GetRequestID(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return nil, wh.error(errRequestIDTooLong, scope, tags...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		wfExecution.GetWorkflowID(),
+		wfExecution.GetRunID(),
+		scope,
+		wh.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation("UpdateWorkflowExecution"),
+	); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	resp, err = wh.GetHistoryClient().UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -2607,8 +2848,87 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
+	domainID, err := wh.validateSignalWithStartWorkflowExecutionRequest(signalWithStartRequest, scope)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	resp, err = wh.GetHistoryClient().SignalWithStartWorkflowExecution(ctx, &types.HistorySignalWithStartWorkflowExecutionRequest{
+		DomainUUID:             domainID,
+		SignalWithStartRequest: signalWithStartRequest,
+		IsolationGroup:         getIsolationGroup(ctx),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	return resp, nil
+}
+
+// SignalWithStartWorkflowExecutionAsync - Validates a signal with start request and writes it to the async
+// workflow queue of the domain. The worker service forwards queued requests to history.
+func (wh *WorkflowHandler) SignalWithStartWorkflowExecutionAsync(
+	ctx context.Context,
+	request *types.SignalWithStartWorkflowExecutionAsyncRequest,
+) (resp *types.SignalWithStartWorkflowExecutionAsyncResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), wh.GetLogger(), &retError) }()
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendSignalWithStartWorkflowExecutionAsyncScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil || request.SignalWithStartWorkflowExecutionRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	signalWithStartRequest := request.SignalWithStartWorkflowExecutionRequest
+	domainName := signalWithStartRequest.GetDomain()
+	wfExecution := &types.WorkflowExecution{
+		WorkflowID: signalWithStartRequest.GetWorkflowID(),
+	}
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if !wh.config.EnableAsyncWorkflowStart(domainName) {
+		return nil, wh.error(errAsyncWorkflowNotEnabled, scope, tags...)
+	}
+
+	if ok := wh.allow(ratelimitTypeUser, signalWithStartRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	if _, err := wh.validateSignalWithStartWorkflowExecutionRequest(signalWithStartRequest, scope); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	message, err := asyncworkflow.NewSignalWithStartWorkflowExecutionMessage(signalWithStartRequest, time.Now())
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	if err := wh.publishAsyncWorkflowMessage(ctx, domainName, message); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	return &types.SignalWithStartWorkflowExecutionAsyncResponse{}, nil
+}
+
+// validateSignalWithStartWorkflowExecutionRequest validates a signal with start request and returns the ID of its domain
+func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(
+	signalWithStartRequest *types.SignalWithStartWorkflowExecutionRequest,
+	scope metrics.Scope,
+) (string, error) {
+	domainName := signalWithStartRequest.GetDomain()
 	if signalWithStartRequest.GetWorkflowID() == "" {
-		return nil, wh.error(errWorkflowIDNotSet, scope, tags...)
+		return "", errWorkflowIDNotSet
 	}
 
 	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
@@ -2621,7 +2941,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeDomainName) {
-		return nil, wh.error(errDomainTooLong, scope, tags...)
+		return "", errDomainTooLong
 	}
 
 	if !common.ValidIDLength(
@@ -2633,11 +2953,11 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeWorkflowID) {
-		return nil, wh.error(errWorkflowIDTooLong, scope, tags...)
+		return "", errWorkflowIDTooLong
 	}
 
 	if signalWithStartRequest.GetSignalName() == "" {
-		return nil, wh.error(errSignalNameNotSet, scope, tags...)
+		return "", errSignalNameNotSet
 	}
 
 	if !common.ValidIDLength(
@@ -2649,11 +2969,11 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeSignalName) {
-		return nil, wh.error(errSignalNameTooLong, scope, tags...)
+		return "", errSignalNameTooLong
 	}
 
 	if signalWithStartRequest.WorkflowType == nil || signalWithStartRequest.WorkflowType.GetName() == "" {
-		return nil, wh.error(errWorkflowTypeNotSet, scope, tags...)
+		return "", errWorkflowTypeNotSet
 	}
 
 	if !common.ValidIDLength(
@@ -2665,11 +2985,11 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeWorkflowType) {
-		return nil, wh.error(errWorkflowTypeTooLong, scope, tags...)
+		return "", errWorkflowTypeTooLong
 	}
 
 	if err := wh.validateTaskList(signalWithStartRequest.TaskList, scope, domainName); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 
 	if !common.ValidIDLength(
@@ -2681,32 +3001,36 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeRequestID) {
-		return nil, wh.error(errRequestIDTooLong, scope, tags...)
+		return "", errRequestIDTooLong
 	}
 
 	if signalWithStartRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
-		return nil, wh.error(errInvalidExecutionStartToCloseTimeoutSeconds, scope, tags...)
+		return "", errInvalidExecutionStartToCloseTimeoutSeconds
 	}
 
 	if signalWithStartRequest.GetTaskStartToCloseTimeoutSeconds() <= 0 {
-		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope, tags...)
+		return "", errInvalidTaskStartToCloseTimeoutSeconds
 	}
 
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 
 	if err := backoff.ValidateSchedule(signalWithStartRequest.GetCronSchedule()); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
+	}
+
+	if err := validateWorkflowIDConflictPolicy(signalWithStartRequest.WorkflowIDConflictPolicy, signalWithStartRequest.WorkflowIDReusePolicy); err != nil {
+		return "", err
 	}
 
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(signalWithStartRequest.SearchAttributes, domainName); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
@@ -2722,7 +3046,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		wh.GetThrottledLogger(),
 		tag.BlobSizeViolationOperation("SignalWithStartWorkflowExecution"),
 	); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
 	actualSize := len(signalWithStartRequest.Input) + common.GetSizeOfMapStringToByteArray(signalWithStartRequest.Memo.GetFields())
 	if err := common.CheckEventBlobSizeLimit(
@@ -2736,18 +3060,57 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		wh.GetThrottledLogger(),
 		tag.BlobSizeViolationOperation("SignalWithStartWorkflowExecution"),
 	); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return "", err
 	}
+	return domainID, nil
+}
 
-	resp, err = wh.GetHistoryClient().SignalWithStartWorkflowExecution(ctx, &types.HistorySignalWithStartWorkflowExecutionRequest{
-		DomainUUID:             domainID,
-		SignalWithStartRequest: signalWithStartRequest,
-	})
+// publishAsyncWorkflowMessage writes the message to the async workflow queue configured for the domain
+func (wh *WorkflowHandler) publishAsyncWorkflowMessage(
+	ctx context.Context,
+	domainName string,
+	message *asyncworkflow.Message,
+) error {
+	queueName := wh.config.AsyncWorkflowQueueName(domainName)
+	if !wh.hasAsyncWorkflowConsumer(queueName) {
+		return &types.BadRequestError{Message: fmt.Sprintf("Async workflow queue %v of the domain has no consumer.", queueName)}
+	}
+	producer, err := wh.getAsyncWorkflowProducer(queueName)
 	if err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return err
 	}
+	return producer.Publish(ctx, message)
+}
 
-	return resp, nil
+// hasAsyncWorkflowConsumer returns true if the worker service drains the queue, otherwise requests
+// written to it would never be started
+func (wh *WorkflowHandler) hasAsyncWorkflowConsumer(queueName string) bool {
+	for _, queue := range wh.config.AsyncWorkflowQueues {
+		if queue == queueName {
+			return true
+		}
+	}
+	return false
+}
+
+func (wh *WorkflowHandler) getAsyncWorkflowProducer(queueName string) (messaging.Producer, error) {
+	wh.asyncWorkflowProducersLock.Lock()
+	defer wh.asyncWorkflowProducersLock.Unlock()
+
+	if producer, ok := wh.asyncWorkflowProducers[queueName]; ok {
+		return producer, nil
+	}
+
+	client := wh.GetAsyncWorkflowQueueClient()
+	if client == nil {
+		return nil, errAsyncWorkflowQueueNotConfigured
+	}
+	producer, err := client.NewProducer(queueName)
+	if err != nil {
+		return nil, err
+	}
+	wh.asyncWorkflowProducers[queueName] = producer
+	return producer, nil
 }
 
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
@@ -2853,6 +3216,20 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 		DomainUUID:   domainID,
 		ResetRequest: resetRequest,
 	})
+	if _, ok := err.(*types.EntityNotExistsError); ok && wfExecution.GetRunID() == "" && wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled() {
+		// history resets an archived run from its archived history, but it needs to be told which run it is
+		if runID, archived := wh.getLatestArchivedRunID(ctx, domainID, wfExecution.GetWorkflowID()); archived {
+			archivedRequest := *resetRequest
+			archivedRequest.WorkflowExecution = &types.WorkflowExecution{
+				WorkflowID: wfExecution.GetWorkflowID(),
+				RunID:      runID,
+			}
+			resp, err = wh.GetHistoryClient().ResetWorkflowExecution(ctx, &types.HistoryResetWorkflowExecutionRequest{
+				DomainUUID:   domainID,
+				ResetRequest: &archivedRequest,
+			})
+		}
+	}
 	if err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
@@ -3331,7 +3708,7 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(
 	return resp, nil
 }
 
-// RestartWorkflowExecution - retrieves info for an existing workflow then restarts it
+// RestartWorkflowExecution - retrieves info for an existing or archived workflow then restarts it
 func (wh *WorkflowHandler) RestartWorkflowExecution(ctx context.Context, request *types.RestartWorkflowExecutionRequest) (resp *types.RestartWorkflowExecutionResponse, retError error) {
 	defer func() { log.CapturePanic(recover(), wh.GetLogger(), &retError) }()
 
@@ -3377,7 +3754,6 @@ func (wh *WorkflowHandler) RestartWorkflowExecution(ctx context.Context, request
 			WorkflowID: wfExecution.WorkflowID,
 			RunID:      wfExecution.RunID,
 		},
-		SkipArchival: true,
 	})
 	if err != nil {
 		return nil, wh.error(errHistoryNotFound, scope, tags...)
@@ -3727,6 +4103,11 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(
 	})
 
 	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok && wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled() {
+			if archivedResponse, archivedErr := wh.describeArchivedWorkflowExecution(ctx, domainID, wfExecution); archivedErr == nil {
+				return archivedResponse, nil
+			}
+		}
 		return nil, wh.error(err, scope, tags...)
 	}
 
@@ -4147,6 +4528,27 @@ func (wh *WorkflowHandler) validateTaskList(t *types.TaskList, scope metrics.Sco
 	return nil
 }
 
+func validateWorkflowIDConflictPolicy(
+	conflictPolicy *types.WorkflowIDConflictPolicy,
+	reusePolicy *types.WorkflowIDReusePolicy,
+) error {
+	if conflictPolicy == nil {
+		return nil
+	}
+	switch *conflictPolicy {
+	case types.WorkflowIDConflictPolicyFail,
+		types.WorkflowIDConflictPolicyUseExisting,
+		types.WorkflowIDConflictPolicyTerminateExisting:
+	default:
+		return errInvalidWorkflowIDConflictPolicy
+	}
+	if reusePolicy != nil && *reusePolicy == types.WorkflowIDReusePolicyTerminateIfRunning &&
+		*conflictPolicy != types.WorkflowIDConflictPolicyTerminateExisting {
+		return errConflictPolicyWithTerminateIfRunning
+	}
+	return nil
+}
+
 func validateExecution(w *types.WorkflowExecution) error {
 	if w == nil {
 		return errExecutionNotSet
@@ -4341,6 +4743,11 @@ func createServiceBusyError() *types.ServiceBusyError {
 	return err
 }
 
+// getIsolationGroup returns the isolation group the caller belongs to, as set by the client in the request header
+func getIsolationGroup(ctx context.Context) string {
+	return yarpc.CallFromContext(ctx).Header(common.ClientIsolationGroupHeaderName)
+}
+
 func isFailoverRequest(updateRequest *types.UpdateDomainRequest) bool {
 	return updateRequest.ActiveClusterName != nil
 }
@@ -4390,36 +4797,87 @@ func (wh *WorkflowHandler) checkOngoingFailover(
 	return nil
 }
 
-func (wh *WorkflowHandler) historyArchived(ctx context.Context, request *types.GetWorkflowExecutionHistoryRequest, domainID string) bool {
-	if request.GetExecution() == nil || request.GetExecution().GetRunID() == "" {
-		return false
+// historyArchived returns the run ID and true if the requested history has to be read from archival
+func (wh *WorkflowHandler) historyArchived(ctx context.Context, request *types.GetWorkflowExecutionHistoryRequest, domainID string) (string, bool) {
+	if request.GetExecution() == nil || request.GetExecution().GetWorkflowID() == "" {
+		return "", false
+	}
+	if request.NextPageToken != nil {
+		token, err := deserializeHistoryToken(request.NextPageToken)
+		if err == nil && len(token.ArchivalToken) != 0 {
+			// following pages of an archived history carry the archiver page token
+			return token.RunID, true
+		}
+		if err == nil && token.RunID != "" {
+			// following pages of a history read from persistence
+			return "", false
+		}
+		// tokens issued before the archiver page token was wrapped are the archiver page token itself,
+		// they are recognized by looking up the run like for the first page
 	}
+
 	getMutableStateRequest := &types.GetMutableStateRequest{
 		DomainUUID: domainID,
 		Execution:  request.Execution,
 	}
 	_, err := wh.GetHistoryClient().GetMutableState(ctx, getMutableStateRequest)
 	if err == nil {
-		return false
+		return "", false
 	}
 	switch err.(type) {
 	case *types.EntityNotExistsError:
 		// the only case in which history is assumed to be archived is if getting mutable state returns entity not found error
-		return true
+		if runID := request.GetExecution().GetRunID(); runID != "" {
+			return runID, true
+		}
+		return wh.getLatestArchivedRunID(ctx, domainID, request.GetExecution().GetWorkflowID())
 	}
-	return false
+	return "", false
 }
 
-func (wh *WorkflowHandler) getArchivedHistory(
-	ctx context.Context,
-	request *types.GetWorkflowExecutionHistoryRequest,
-	domainID string,
-	scope metrics.Scope,
-	tags ...tag.Tag,
-) (*types.GetWorkflowExecutionHistoryResponse, error) {
+// getLatestArchivedRunID looks up the most recently closed run of a workflow which no longer exists
+// in persistence, this requires visibility archival to be enabled for the domain and the lookup to be
+// enabled by dynamic config, as every lookup queries the visibility archive
+func (wh *WorkflowHandler) getLatestArchivedRunID(ctx context.Context, domainID string, workflowID string) (string, bool) {
+	if !wh.GetArchivalMetadata().GetVisibilityConfig().ReadEnabled() {
+		return "", false
+	}
 	entry, err := wh.GetDomainCache().GetDomainByID(domainID)
+	if err != nil || entry.GetConfig().VisibilityArchivalStatus != types.ArchivalStatusEnabled {
+		return "", false
+	}
+	if !wh.config.EnableArchivedRunIDLookup(entry.GetInfo().Name) {
+		return "", false
+	}
+	URI, err := archiver.NewURI(entry.GetConfig().VisibilityArchivalURI)
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return "", false
+	}
+	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), service.Frontend)
+	if err != nil {
+		return "", false
+	}
+
+	// archived visibility records are returned with the most recently closed run first
+	resp, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
+		DomainID: domainID,
+		PageSize: 1,
+		Query:    fmt.Sprintf("WorkflowID = '%s'", archiver.EscapeVisibilityQueryString(workflowID)),
+	})
+	if err != nil || len(resp.Executions) == 0 {
+		return "", false
+	}
+	execution := resp.Executions[0].GetExecution()
+	if execution.GetWorkflowID() != workflowID {
+		return "", false
+	}
+	return execution.GetRunID(), true
+}
+
+func (wh *WorkflowHandler) getHistoryArchiver(domainID string) (archiver.HistoryArchiver, archiver.URI, error) {
+	entry, err := wh.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	URIString := entry.GetConfig().HistoryArchivalURI
@@ -4427,41 +4885,207 @@ func (wh *WorkflowHandler) getArchivedHistory(
 		// if URI is empty, it means the domain has never enabled for archival.
 		// the error is not "workflow has passed retention period", because
 		// we have no way to tell if the requested workflow exists or not.
-		return nil, wh.error(errHistoryNotFound, scope, tags...)
+		return nil, nil, errHistoryNotFound
 	}
 
 	URI, err := archiver.NewURI(URIString)
 	if err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return nil, nil, err
 	}
 
 	historyArchiver, err := wh.GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), service.Frontend)
 	if err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return nil, nil, err
 	}
+	if wh.archivedHistoryCache != nil {
+		historyArchiver = archiver.NewCachedHistoryArchiver(historyArchiver, wh.archivedHistoryCache)
+	}
+	return historyArchiver, URI, nil
+}
 
-	resp, err := historyArchiver.Get(ctx, URI, &archiver.GetHistoryRequest{
-		DomainID:      domainID,
-		WorkflowID:    request.GetExecution().GetWorkflowID(),
-		RunID:         request.GetExecution().GetRunID(),
-		NextPageToken: request.GetNextPageToken(),
-		PageSize:      int(request.GetMaximumPageSize()),
-	})
+func (wh *WorkflowHandler) getArchivedHistory(
+	ctx context.Context,
+	request *types.GetWorkflowExecutionHistoryRequest,
+	domainID string,
+	runID string,
+	scope metrics.Scope,
+	tags ...tag.Tag,
+) (*types.GetWorkflowExecutionHistoryResponse, error) {
+	historyArchiver, URI, err := wh.getHistoryArchiver(domainID)
 	if err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: request.GetExecution().GetWorkflowID(),
+		RunID:      runID,
+		PageSize:   int(request.GetMaximumPageSize()),
+	}
+	if request.NextPageToken != nil {
+		// tokens issued before the archiver page token was wrapped are the archiver page token itself
+		getRequest.NextPageToken = request.NextPageToken
+		if token, err := deserializeHistoryToken(request.NextPageToken); err == nil && len(token.ArchivalToken) != 0 {
+			getRequest.NextPageToken = token.ArchivalToken
+		}
+	}
+
 	history := &types.History{}
+	if request.GetHistoryEventFilterType() == types.HistoryEventFilterTypeCloseEvent {
+		// archived workflows are always closed, the close event is the last event of the last page.
+		// With the archived history cache enabled the pages are served from the cached history of the run
+		var lastEvent *types.HistoryEvent
+		for {
+			resp, err := historyArchiver.Get(ctx, URI, getRequest)
+			if err != nil {
+				return nil, wh.error(err, scope, tags...)
+			}
+			for _, batch := range resp.HistoryBatches {
+				if len(batch.Events) != 0 {
+					lastEvent = batch.Events[len(batch.Events)-1]
+				}
+			}
+			if resp.NextPageToken == nil {
+				break
+			}
+			getRequest.NextPageToken = resp.NextPageToken
+		}
+		if lastEvent != nil {
+			history.Events = []*types.HistoryEvent{lastEvent}
+		}
+		return &types.GetWorkflowExecutionHistoryResponse{
+			History:  history,
+			Archived: true,
+		}, nil
+	}
+
+	resp, err := historyArchiver.Get(ctx, URI, getRequest)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
 	for _, batch := range resp.HistoryBatches {
 		history.Events = append(history.Events, batch.Events...)
 	}
+
+	var nextPageToken []byte
+	if resp.NextPageToken != nil {
+		nextPageToken, err = serializeHistoryToken(&getHistoryContinuationToken{
+			RunID:         runID,
+			ArchivalToken: resp.NextPageToken,
+		})
+		if err != nil {
+			return nil, wh.error(err, scope, tags...)
+		}
+	}
 	return &types.GetWorkflowExecutionHistoryResponse{
 		History:       history,
-		NextPageToken: resp.NextPageToken,
+		NextPageToken: nextPageToken,
 		Archived:      true,
 	}, nil
 }
 
+// describeArchivedWorkflowExecution builds the description of a closed workflow execution from its archived history
+func (wh *WorkflowHandler) describeArchivedWorkflowExecution(
+	ctx context.Context,
+	domainID string,
+	execution *types.WorkflowExecution,
+) (*types.DescribeWorkflowExecutionResponse, error) {
+	runID := execution.GetRunID()
+	if runID == "" {
+		var ok bool
+		if runID, ok = wh.getLatestArchivedRunID(ctx, domainID, execution.GetWorkflowID()); !ok {
+			return nil, errHistoryNotFound
+		}
+	}
+
+	historyArchiver, URI, err := wh.getHistoryArchiver(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      runID,
+		PageSize:   common.GetHistoryMaxPageSize,
+	}
+	var firstEvent, lastEvent *types.HistoryEvent
+	historyLength := int64(0)
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, getRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, batch := range resp.HistoryBatches {
+			for _, event := range batch.Events {
+				if firstEvent == nil {
+					firstEvent = event
+				}
+				lastEvent = event
+				historyLength++
+			}
+		}
+		if resp.NextPageToken == nil {
+			break
+		}
+		getRequest.NextPageToken = resp.NextPageToken
+	}
+	if firstEvent == nil || firstEvent.WorkflowExecutionStartedEventAttributes == nil {
+		return nil, errHistoryNotFound
+	}
+
+	attributes := firstEvent.WorkflowExecutionStartedEventAttributes
+	executionInfo := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: execution.GetWorkflowID(),
+			RunID:      runID,
+		},
+		Type:              attributes.WorkflowType,
+		StartTime:         firstEvent.Timestamp,
+		CloseTime:         lastEvent.Timestamp,
+		CloseStatus:       getArchivedCloseStatus(lastEvent),
+		HistoryLength:     historyLength,
+		ParentDomain:      attributes.ParentWorkflowDomain,
+		ParentExecution:   attributes.ParentWorkflowExecution,
+		ParentInitiatedID: attributes.ParentInitiatedEventID,
+		Memo:              attributes.Memo,
+		SearchAttributes:  attributes.SearchAttributes,
+		AutoResetPoints:   attributes.PrevAutoResetPoints,
+		TaskList:          attributes.TaskList.GetName(),
+		IsCron:            attributes.CronSchedule != "",
+	}
+	if firstEvent.Timestamp != nil {
+		executionInfo.ExecutionTime = common.Int64Ptr(firstEvent.GetTimestamp() + int64(attributes.GetFirstDecisionTaskBackoffSeconds())*int64(time.Second))
+	}
+	return &types.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: &types.WorkflowExecutionConfiguration{
+			TaskList:                            attributes.TaskList,
+			ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
+			TaskStartToCloseTimeoutSeconds:      attributes.TaskStartToCloseTimeoutSeconds,
+		},
+		WorkflowExecutionInfo: executionInfo,
+	}, nil
+}
+
+func getArchivedCloseStatus(closeEvent *types.HistoryEvent) *types.WorkflowExecutionCloseStatus {
+	switch closeEvent.GetEventType() {
+	case types.EventTypeWorkflowExecutionCompleted:
+		return types.WorkflowExecutionCloseStatusCompleted.Ptr()
+	case types.EventTypeWorkflowExecutionFailed:
+		return types.WorkflowExecutionCloseStatusFailed.Ptr()
+	case types.EventTypeWorkflowExecutionCanceled:
+		return types.WorkflowExecutionCloseStatusCanceled.Ptr()
+	case types.EventTypeWorkflowExecutionTerminated:
+		return types.WorkflowExecutionCloseStatusTerminated.Ptr()
+	case types.EventTypeWorkflowExecutionContinuedAsNew:
+		return types.WorkflowExecutionCloseStatusContinuedAsNew.Ptr()
+	case types.EventTypeWorkflowExecutionTimedOut:
+		return types.WorkflowExecutionCloseStatusTimedOut.Ptr()
+	default:
+		return nil
+	}
+}
+
 func (wh *WorkflowHandler) convertIndexedKeyToThrift(keys map[string]interface{}) map[string]types.IndexedValueType {
 	converted := make(map[string]types.IndexedValueType)
 	for k, v := range keys {
@@ -21,6 +21,7 @@
 package frontend
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -32,11 +33,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/asyncworkflow"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/cluster"
@@ -294,6 +297,60 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadDelayStartSe
 	s.Equal(errInvalidDelayStartSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadPriority() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		Priority:                            -1,
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(errInvalidPriority, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidWorkflowIDConflictPolicy() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicy(100).Ptr(),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(errInvalidWorkflowIDConflictPolicy, err)
+
+	startWorkflowExecutionRequest.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	startWorkflowExecutionRequest.WorkflowIDReusePolicy = types.WorkflowIDReusePolicyTerminateIfRunning.Ptr()
+	_, err = wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(errConflictPolicyWithTerminateIfRunning, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dc.GetIntPropertyFn(10)
@@ -482,6 +539,177 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	s.Equal(errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecutionAsync_Failed_NotEnabled() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.StartWorkflowExecutionAsync(context.Background(), &types.StartWorkflowExecutionAsyncRequest{
+		StartWorkflowExecutionRequest: s.newStartWorkflowExecutionRequest(),
+	})
+	s.Error(err)
+	s.Equal(errAsyncWorkflowNotEnabled, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecutionAsync_Failed_StartRequestNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	config.EnableAsyncWorkflowStart = dc.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.StartWorkflowExecutionAsync(context.Background(), &types.StartWorkflowExecutionAsyncRequest{})
+	s.Error(err)
+	s.Equal(errRequestNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecutionAsync_Failed_NoConsumer() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	config.EnableAsyncWorkflowStart = dc.GetBoolPropertyFnFilteredByDomain(true)
+	config.AsyncWorkflowQueueName = dc.GetStringPropertyFnFilteredByDomain("test-queue")
+	config.AsyncWorkflowQueues = []string{"other-queue"}
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+
+	_, err := wh.StartWorkflowExecutionAsync(context.Background(), &types.StartWorkflowExecutionAsyncRequest{
+		StartWorkflowExecutionRequest: s.newStartWorkflowExecutionRequest(),
+	})
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecutionAsync_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	config.EnableAsyncWorkflowStart = dc.GetBoolPropertyFnFilteredByDomain(true)
+	config.AsyncWorkflowQueueName = dc.GetStringPropertyFnFilteredByDomain("test-queue")
+	config.AsyncWorkflowQueues = []string{"test-queue"}
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+
+	consumer, err := s.mockResource.AsyncWorkflowQueue.NewConsumer("test-queue", "test-consumer")
+	s.NoError(err)
+	s.NoError(consumer.Start())
+	defer consumer.Stop()
+
+	startRequest := s.newStartWorkflowExecutionRequest()
+	resp, err := wh.StartWorkflowExecutionAsync(context.Background(), &types.StartWorkflowExecutionAsyncRequest{
+		StartWorkflowExecutionRequest: startRequest,
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+
+	select {
+	case msg := <-consumer.Messages():
+		message, err := asyncworkflow.DecodeMessage(msg.Value())
+		s.NoError(err)
+		request, err := message.GetStartWorkflowExecutionRequest()
+		s.NoError(err)
+		s.Equal(startRequest, request)
+	case <-time.After(time.Second):
+		s.Fail("message was not published to the queue")
+	}
+}
+
+func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecutionAsync_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	config.EnableAsyncWorkflowStart = dc.GetBoolPropertyFnFilteredByDomain(true)
+	config.AsyncWorkflowQueueName = dc.GetStringPropertyFnFilteredByDomain("test-queue")
+	config.AsyncWorkflowQueues = []string{"test-queue"}
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+
+	consumer, err := s.mockResource.AsyncWorkflowQueue.NewConsumer("test-queue", "test-consumer")
+	s.NoError(err)
+	s.NoError(consumer.Start())
+	defer consumer.Stop()
+
+	startRequest := s.newStartWorkflowExecutionRequest()
+	signalWithStartRequest := &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              startRequest.Domain,
+		WorkflowID:                          startRequest.WorkflowID,
+		WorkflowType:                        startRequest.WorkflowType,
+		TaskList:                            startRequest.TaskList,
+		ExecutionStartToCloseTimeoutSeconds: startRequest.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      startRequest.TaskStartToCloseTimeoutSeconds,
+		RequestID:                           startRequest.RequestID,
+		SignalName:                          "signal-name",
+		SignalInput:                         []byte("signal-input"),
+	}
+	resp, err := wh.SignalWithStartWorkflowExecutionAsync(context.Background(), &types.SignalWithStartWorkflowExecutionAsyncRequest{
+		SignalWithStartWorkflowExecutionRequest: signalWithStartRequest,
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+
+	select {
+	case msg := <-consumer.Messages():
+		message, err := asyncworkflow.DecodeMessage(msg.Value())
+		s.NoError(err)
+		request, err := message.GetSignalWithStartWorkflowExecutionRequest()
+		s.NoError(err)
+		s.Equal(signalWithStartRequest, request)
+	case <-time.After(time.Second):
+		s.Fail("message was not published to the queue")
+	}
+}
+
+func (s *workflowHandlerSuite) TestUpdateWorkflowExecution_Failed_NotEnabled() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.UpdateWorkflowExecution(context.Background(), s.newUpdateWorkflowExecutionRequest())
+	s.Error(err)
+	s.Equal(errUpdateWorkflowExecutionNotEnabled, err)
+}
+
+func (s *workflowHandlerSuite) TestUpdateWorkflowExecution_Failed_UpdateIDNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	config.EnableUpdateWorkflowExecution = dc.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+
+	updateRequest := s.newUpdateWorkflowExecutionRequest()
+	updateRequest.UpdateID = ""
+	_, err := wh.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.Error(err)
+	s.Equal(errUpdateIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestUpdateWorkflowExecution_Failed_UpdateNameNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	config.EnableUpdateWorkflowExecution = dc.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+
+	updateRequest := s.newUpdateWorkflowExecutionRequest()
+	updateRequest.UpdateName = ""
+	_, err := wh.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.Error(err)
+	s.Equal(errUpdateNameNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestUpdateWorkflowExecution_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	config.EnableUpdateWorkflowExecution = dc.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+
+	updateRequest := s.newUpdateWorkflowExecutionRequest()
+	expectedResponse := &types.UpdateWorkflowExecutionResponse{Result: []byte("update-result")}
+	s.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    s.testDomainID,
+		UpdateRequest: updateRequest,
+	}).Return(expectedResponse, nil).Times(1)
+
+	resp, err := wh.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.NoError(err)
+	s.Equal(expectedResponse, resp)
+}
+
 func (s *workflowHandlerSuite) TestRegisterDomain_Failure_MissingDomainDataKey() {
 	dynamicClient := dc.NewInMemoryClient()
 	err := dynamicClient.UpdateValue(dc.RequiredDomainDataKeys, map[string]interface{}{"Tier": true})
@@ -919,12 +1147,14 @@ func (s *workflowHandlerSuite) TestHistoryArchived() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
 	getHistoryRequest := &types.GetWorkflowExecutionHistoryRequest{}
-	s.False(wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain))
+	_, archived := wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain)
+	s.False(archived)
 
 	getHistoryRequest = &types.GetWorkflowExecutionHistoryRequest{
 		Execution: &types.WorkflowExecution{},
 	}
-	s.False(wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain))
+	_, archived = wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain)
+	s.False(archived)
 
 	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 	getHistoryRequest = &types.GetWorkflowExecutionHistoryRequest{
@@ -933,7 +1163,8 @@ func (s *workflowHandlerSuite) TestHistoryArchived() {
 			RunID:      testRunID,
 		},
 	}
-	s.False(wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain))
+	_, archived = wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain)
+	s.False(archived)
 
 	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "got archival indication error"}).Times(1)
 	getHistoryRequest = &types.GetWorkflowExecutionHistoryRequest{
@@ -942,7 +1173,9 @@ func (s *workflowHandlerSuite) TestHistoryArchived() {
 			RunID:      testRunID,
 		},
 	}
-	s.True(wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain))
+	runID, archived := wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain)
+	s.True(archived)
+	s.Equal(testRunID, runID)
 
 	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, errors.New("got non-archival indication error")).Times(1)
 	getHistoryRequest = &types.GetWorkflowExecutionHistoryRequest{
@@ -951,7 +1184,125 @@ func (s *workflowHandlerSuite) TestHistoryArchived() {
 			RunID:      testRunID,
 		},
 	}
-	s.False(wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain))
+	_, archived = wh.historyArchived(context.Background(), getHistoryRequest, s.testDomain)
+	s.False(archived)
+}
+
+func (s *workflowHandlerSuite) TestHistoryArchived_ArchivalPageToken() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	token, err := serializeHistoryToken(&getHistoryContinuationToken{
+		RunID:         testRunID,
+		ArchivalToken: []byte{'1', '2', '3'},
+	})
+	s.NoError(err)
+	getHistoryRequest := &types.GetWorkflowExecutionHistoryRequest{
+		Execution:     &types.WorkflowExecution{WorkflowID: testWorkflowID},
+		NextPageToken: token,
+	}
+	runID, archived := wh.historyArchived(context.Background(), getHistoryRequest, s.testDomainID)
+	s.True(archived)
+	s.Equal(testRunID, runID)
+
+	token, err = serializeHistoryToken(&getHistoryContinuationToken{
+		RunID:       testRunID,
+		NextEventID: 10,
+	})
+	s.NoError(err)
+	getHistoryRequest.NextPageToken = token
+	_, archived = wh.historyArchived(context.Background(), getHistoryRequest, s.testDomainID)
+	s.False(archived)
+
+	// tokens issued before the archiver page token was wrapped are the archiver page token itself
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	getHistoryRequest = &types.GetWorkflowExecutionHistoryRequest{
+		Execution:     &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID},
+		NextPageToken: []byte(`{"CloseFailoverVersion":1,"NextBatchIdx":2}`),
+	}
+	runID, archived = wh.historyArchived(context.Background(), getHistoryRequest, s.testDomainID)
+	s.True(archived)
+	s.Equal(testRunID, runID)
+}
+
+func (s *workflowHandlerSuite) TestHistoryArchived_ResolveLatestRun() {
+	// quotes and backslashes of the workflow ID must not change the archived visibility query
+	workflowID := `it's a \' or WorkflowID = 'workflowID`
+	s.mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain},
+		&persistence.DomainConfig{
+			VisibilityArchivalStatus: types.ArchivalStatusEnabled,
+			VisibilityArchivalURI:    testVisibilityArchivalURI,
+		},
+		"",
+	), nil).AnyTimes()
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), true, dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.QueryVisibilityRequest) bool {
+		query, err := archiver.NewVisibilityQueryParser().Parse(request.Query)
+		return err == nil && request.PageSize == 1 && query.WorkflowID != nil && *query.WorkflowID == workflowID
+	})).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: workflowID, RunID: testRunID}},
+		},
+	}, nil).Once()
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(2)
+
+	dynamicClient := dc.NewInMemoryClient()
+	wh := s.getWorkflowHandler(s.newConfig(dynamicClient))
+
+	getHistoryRequest := &types.GetWorkflowExecutionHistoryRequest{
+		Execution: &types.WorkflowExecution{WorkflowID: workflowID},
+	}
+	// the visibility archive is not queried unless the lookup is enabled
+	_, archived := wh.historyArchived(context.Background(), getHistoryRequest, s.testDomainID)
+	s.False(archived)
+
+	s.NoError(dynamicClient.UpdateValue(dc.FrontendEnableArchivedRunIDLookup, true))
+	runID, archived := wh.historyArchived(context.Background(), getHistoryRequest, s.testDomainID)
+	s.True(archived)
+	s.Equal(testRunID, runID)
+}
+
+func (s *workflowHandlerSuite) TestResetWorkflowExecution_ArchivedLatestRun() {
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain},
+		&persistence.DomainConfig{
+			VisibilityArchivalStatus: types.ArchivalStatusEnabled,
+			VisibilityArchivalURI:    testVisibilityArchivalURI,
+		},
+		"",
+	), nil).AnyTimes()
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), true, dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), true, dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID}},
+		},
+	}, nil).Once()
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockHistoryClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.HistoryResetWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error) {
+			if request.ResetRequest.WorkflowExecution.GetRunID() == "" {
+				return nil, &types.EntityNotExistsError{}
+			}
+			s.Equal(testRunID, request.ResetRequest.WorkflowExecution.GetRunID())
+			return &types.ResetWorkflowExecutionResponse{RunID: "reset-run-id"}, nil
+		}).Times(2)
+
+	dynamicClient := dc.NewInMemoryClient()
+	s.NoError(dynamicClient.UpdateValue(dc.FrontendEnableArchivedRunIDLookup, true))
+	wh := s.getWorkflowHandler(s.newConfig(dynamicClient))
+
+	resp, err := wh.ResetWorkflowExecution(context.Background(), &types.ResetWorkflowExecutionRequest{
+		Domain:                s.testDomain,
+		WorkflowExecution:     &types.WorkflowExecution{WorkflowID: testWorkflowID},
+		Reason:                "reset archived run",
+		DecisionFinishEventID: 4,
+		RequestID:             uuid.New(),
+	})
+	s.NoError(err)
+	s.Equal("reset-run-id", resp.GetRunID())
 }
 
 func (s *workflowHandlerSuite) TestGetArchivedHistory_Failure_DomainCacheEntryError() {
@@ -959,7 +1310,7 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Failure_DomainCacheEntryEr
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
-	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testDomainID, metrics.NoopScope(metrics.Frontend))
+	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testDomainID, testRunID, metrics.NoopScope(metrics.Frontend))
 	s.Nil(resp)
 	s.Error(err)
 }
@@ -979,7 +1330,7 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Failure_ArchivalURIEmpty()
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
-	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testDomainID, metrics.NoopScope(metrics.Frontend))
+	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testDomainID, testRunID, metrics.NoopScope(metrics.Frontend))
 	s.Nil(resp)
 	s.Error(err)
 }
@@ -999,7 +1350,7 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Failure_InvalidURI() {
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
-	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testDomainID, metrics.NoopScope(metrics.Frontend))
+	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testDomainID, testRunID, metrics.NoopScope(metrics.Frontend))
 	s.Nil(resp)
 	s.Error(err)
 }
@@ -1031,24 +1382,188 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_GetFirstPage() {
 			{ID: 5},
 		},
 	}
+	historyBatch3 := &types.History{
+		Events: []*types.HistoryEvent{
+			{ID: 6},
+		},
+	}
 	history := &types.History{}
 	history.Events = append(history.Events, historyBatch1.Events...)
 	history.Events = append(history.Events, historyBatch2.Events...)
-	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.NextPageToken == nil
+	})).Return(&archiver.GetHistoryResponse{
 		NextPageToken:  nextPageToken,
 		HistoryBatches: []*types.History{historyBatch1, historyBatch2},
-	}, nil)
+	}, nil).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return bytes.Equal(request.NextPageToken, nextPageToken)
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{historyBatch3},
+	}, nil).Once()
 	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
-	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testDomainID, metrics.NoopScope(metrics.Frontend))
+	request := getHistoryRequest(nil)
+	request.MaximumPageSize = 5
+	resp, err := wh.getArchivedHistory(context.Background(), request, s.testDomainID, testRunID, metrics.NoopScope(metrics.Frontend))
 	s.NoError(err)
 	s.NotNil(resp)
 	s.NotNil(resp.History)
 	s.Equal(history, resp.History)
-	s.Equal(nextPageToken, resp.NextPageToken)
 	s.True(resp.GetArchived())
+	token, err := deserializeHistoryToken(resp.NextPageToken)
+	s.NoError(err)
+	s.Equal(testRunID, token.RunID)
+	s.NotEmpty(token.ArchivalToken)
+
+	request.NextPageToken = resp.NextPageToken
+	resp, err = wh.getArchivedHistory(context.Background(), request, s.testDomainID, testRunID, metrics.NoopScope(metrics.Frontend))
+	s.NoError(err)
+	s.Equal(historyBatch3.Events, resp.History.Events)
+	s.Nil(resp.NextPageToken)
+}
+
+func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_ArchiverPageToken() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    testHistoryArchivalURI,
+		},
+		"",
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(domainEntry, nil).AnyTimes()
+
+	// tokens issued before the archiver page token was wrapped are passed to the archiver as they are
+	archiverToken := []byte(`{"CloseFailoverVersion":1,"NextBatchIdx":2}`)
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return bytes.Equal(request.NextPageToken, archiverToken)
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{{ID: 3}, {ID: 4}}}},
+	}, nil).Once()
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(archiverToken), s.testDomainID, testRunID, metrics.NoopScope(metrics.Frontend))
+	s.NoError(err)
+	s.Equal([]*types.HistoryEvent{{ID: 3}, {ID: 4}}, resp.History.Events)
+	s.Nil(resp.NextPageToken)
+	s.True(resp.GetArchived())
+}
+
+func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_CloseEventOnly() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    testHistoryArchivalURI,
+		},
+		"",
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(domainEntry, nil).AnyTimes()
+
+	nextPageToken := []byte{'1', '2', '3'}
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.NextPageToken == nil
+	})).Return(&archiver.GetHistoryResponse{
+		NextPageToken:  nextPageToken,
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{{ID: 1}, {ID: 2}}}},
+	}, nil).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return bytes.Equal(request.NextPageToken, nextPageToken)
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{{ID: 3}, {ID: 4}}}},
+	}, nil).Once()
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	request := getHistoryRequest(nil)
+	request.HistoryEventFilterType = types.HistoryEventFilterTypeCloseEvent.Ptr()
+	resp, err := wh.getArchivedHistory(context.Background(), request, s.testDomainID, testRunID, metrics.NoopScope(metrics.Frontend))
+	s.NoError(err)
+	s.Equal([]*types.HistoryEvent{{ID: 4}}, resp.History.Events)
+	s.Nil(resp.NextPageToken)
+	s.True(resp.GetArchived())
+}
+
+func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_Cached() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    testHistoryArchivalURI,
+		},
+		"",
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(domainEntry, nil).AnyTimes()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{{ID: 1}, {ID: 2}}}},
+	}, nil).Once()
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	for i := 0; i < 2; i++ {
+		resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testDomainID, testRunID, metrics.NoopScope(metrics.Frontend))
+		s.NoError(err)
+		s.Len(resp.History.Events, 2)
+	}
+	s.mockHistoryArchiver.AssertNumberOfCalls(s.T(), "Get", 1)
+}
+
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_Archived() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.testDomainID, Name: s.testDomain},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    testHistoryArchivalURI,
+		},
+		"",
+	)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(domainEntry, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), true, dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{
+			{
+				ID:        1,
+				Timestamp: common.Int64Ptr(100),
+				EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+				WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+					WorkflowType: &types.WorkflowType{Name: "workflow-type"},
+					TaskList:     &types.TaskList{Name: "task-list"},
+				},
+			},
+			{
+				ID:        2,
+				Timestamp: common.Int64Ptr(200),
+				EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+				WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{},
+			},
+		}}},
+	}, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	resp, err := wh.DescribeWorkflowExecution(context.Background(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    s.testDomain,
+		Execution: &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID},
+	})
+	s.NoError(err)
+	info := resp.WorkflowExecutionInfo
+	s.Equal(testRunID, info.Execution.GetRunID())
+	s.Equal("workflow-type", info.Type.GetName())
+	s.Equal(int64(100), info.GetStartTime())
+	s.Equal(int64(200), info.GetCloseTime())
+	s.Equal(types.WorkflowExecutionCloseStatusCompleted, info.GetCloseStatus())
+	s.Equal(int64(2), info.HistoryLength)
+	s.Equal("task-list", resp.ExecutionConfiguration.TaskList.GetName())
 }
 
 func (s *workflowHandlerSuite) TestGetHistory() {
@@ -1223,6 +1738,7 @@ func (s *workflowHandlerSuite) TestRestartWorkflowExecution__Success() {
 		NextEventID:      2,
 	}, nil).AnyTimes()
 	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(s.testDomainID, nil).AnyTimes()
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
 	s.mockVersionChecker.EXPECT().SupportsRawHistoryQuery(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents: []*types.HistoryEvent{&types.HistoryEvent{
@@ -1551,6 +2067,35 @@ func (s *workflowHandlerSuite) TestSignalMetricHasSignalName() {
 	s.True(expectedMetrics["test.cadence_errors_bad_request"])
 }
 
+func (s *workflowHandlerSuite) newStartWorkflowExecutionRequest() *types.StartWorkflowExecutionRequest {
+	return &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+	}
+}
+
+func (s *workflowHandlerSuite) newUpdateWorkflowExecutionRequest() *types.UpdateWorkflowExecutionRequest {
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "workflow-id",
+		},
+		UpdateID:   "update-id",
+		UpdateName: "update-name",
+		Input:      []byte("update-input"),
+		RequestID:  uuid.New(),
+	}
+}
+
 func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
 	config := NewConfig(
 		dc.NewCollection(
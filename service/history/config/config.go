@@ -96,6 +96,8 @@ type Config struct {
 	TaskSchedulerShardQueueSize             dynamicconfig.IntPropertyFn
 	TaskSchedulerDispatcherCount            dynamicconfig.IntPropertyFn
 	TaskSchedulerRoundRobinWeights          dynamicconfig.MapPropertyFn
+	TaskSchedulerDomainQuantum              dynamicconfig.IntPropertyFnWithDomainFilter
+	TaskSchedulerDomainQueueRatio           dynamicconfig.FloatPropertyFn
 	TaskCriticalRetryCount                  dynamicconfig.IntPropertyFn
 	ActiveTaskRedispatchInterval            dynamicconfig.DurationPropertyFn
 	StandbyTaskRedispatchInterval           dynamicconfig.DurationPropertyFn
@@ -191,13 +193,17 @@ type Config struct {
 	ReplicatorUpperLatency                 dynamicconfig.DurationPropertyFn
 	ReplicatorCacheCapacity                dynamicconfig.IntPropertyFn
 
+	// ListWorkflowTasks settings
+	ListWorkflowTasksMaxScanSize dynamicconfig.IntPropertyFn
+
 	// Persistence settings
 	ExecutionMgrNumConns dynamicconfig.IntPropertyFn
 	HistoryMgrNumConns   dynamicconfig.IntPropertyFn
 
 	// System Limits
-	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
-	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
+	MaximumBufferedEventsBatch          dynamicconfig.IntPropertyFn
+	MaximumSignalsPerExecution          dynamicconfig.IntPropertyFnWithDomainFilter
+	MaximumCompletedUpdatesPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
@@ -296,6 +302,8 @@ type Config struct {
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithDomainFilter
 	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithDomainFilter
 	MutableStateChecksumInvalidateBefore  dynamicconfig.FloatPropertyFn
+	MutableStateChecksumVersion           dynamicconfig.IntPropertyFnWithDomainFilter
+	EnableMutableStateChecksumRepair      dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	// History check for corruptions
 	EnableHistoryCorruptionCheck dynamicconfig.BoolPropertyFnWithDomainFilter
@@ -309,6 +317,8 @@ type Config struct {
 	EnableActivityLocalDispatchByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 	// Max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.
 	MaxActivityCountDispatchByDomain dynamicconfig.IntPropertyFnWithDomainFilter
+	// Attach the isolation group of a workflow to the decision and activity tasks pushed to matching.
+	EnableTaskIsolationByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	ActivityMaxScheduleToStartTimeoutForRetry dynamicconfig.DurationPropertyFnWithDomainFilter
 
@@ -373,6 +383,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		TaskSchedulerShardQueueSize:             dc.GetIntProperty(dynamicconfig.TaskSchedulerShardQueueSize),
 		TaskSchedulerDispatcherCount:            dc.GetIntProperty(dynamicconfig.TaskSchedulerDispatcherCount),
 		TaskSchedulerRoundRobinWeights:          dc.GetMapProperty(dynamicconfig.TaskSchedulerRoundRobinWeights),
+		TaskSchedulerDomainQuantum:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskSchedulerDomainQuantum),
+		TaskSchedulerDomainQueueRatio:           dc.GetFloat64Property(dynamicconfig.TaskSchedulerDomainQueueRatio),
 		TaskCriticalRetryCount:                  dc.GetIntProperty(dynamicconfig.TaskCriticalRetryCount),
 		ActiveTaskRedispatchInterval:            dc.GetDurationProperty(dynamicconfig.ActiveTaskRedispatchInterval),
 		StandbyTaskRedispatchInterval:           dc.GetDurationProperty(dynamicconfig.StandbyTaskRedispatchInterval),
@@ -461,13 +473,16 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		ReplicatorUpperLatency:                 dc.GetDurationProperty(dynamicconfig.ReplicatorUpperLatency),
 		ReplicatorCacheCapacity:                dc.GetIntProperty(dynamicconfig.ReplicatorCacheCapacity),
 
-		ExecutionMgrNumConns:            dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns),
-		HistoryMgrNumConns:              dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns),
-		MaximumBufferedEventsBatch:      dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch),
-		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution),
-		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval),
-		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval),
-		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient),
+		ListWorkflowTasksMaxScanSize: dc.GetIntProperty(dynamicconfig.ListWorkflowTasksMaxScanSize),
+
+		ExecutionMgrNumConns:                dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns),
+		HistoryMgrNumConns:                  dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns),
+		MaximumBufferedEventsBatch:          dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch),
+		MaximumSignalsPerExecution:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution),
+		MaximumCompletedUpdatesPerExecution: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumCompletedUpdatesPerExecution),
+		ShardUpdateMinInterval:              dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval),
+		ShardSyncMinInterval:                dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval),
+		ShardSyncTimerJitterCoefficient:     dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient),
 
 		// history client: client/history/client.go set the client timeout 30s
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval),
@@ -537,6 +552,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumGenProbability),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumVerifyProbability),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore),
+		MutableStateChecksumVersion:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumVersion),
+		EnableMutableStateChecksumRepair:      dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableMutableStateChecksumRepair),
 
 		EnableHistoryCorruptionCheck: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableHistoryCorruptionCheck),
 
@@ -546,6 +563,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 
 		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityLocalDispatchByDomain),
 		MaxActivityCountDispatchByDomain:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxActivityCountDispatchByDomain),
+		EnableTaskIsolationByDomain:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTaskIsolationByDomain),
 
 		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry),
 
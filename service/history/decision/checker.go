@@ -266,6 +266,10 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 		return &types.BadRequestError{Message: "A valid timeout may not be negative."}
 	}
 
+	if attributes.GetPriority() < 0 {
+		return &types.BadRequestError{Message: "A valid Priority may not be negative."}
+	}
+
 	// ensure activity timeout never larger than workflow timeout
 	if attributes.GetScheduleToCloseTimeoutSeconds() > wfTimeout {
 		attributes.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(wfTimeout)
@@ -222,6 +222,11 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, &types.EventAlreadyStartedError{Message: "Decision task already started."}
 			}
 
+			if mutableState.GetExecutionInfo().Paused {
+				// the decision task is regenerated when the workflow is unpaused
+				return nil, workflow.ErrPaused
+			}
+
 			_, decision, err = mutableState.AddDecisionTaskStartedEvent(scheduleID, requestID, req.PollRequest)
 			if err != nil {
 				// Unable to add DecisionTaskStarted event to history
@@ -397,8 +402,10 @@ Update_History_Loop:
 			continueAsNewBuilder        execution.MutableState
 			hasUnhandledEvents          bool
 			decisionResults             []*decisionResult
+			completedUpdates            map[string]*types.WorkflowQueryResult
 		)
 		hasUnhandledEvents = msBuilder.HasBufferedEvents()
+		updateResults, queryResults := splitUpdateResults(msBuilder, req.GetCompleteRequest().GetQueryResults())
 
 		if request.StickyAttributes == nil || request.StickyAttributes.WorkerTaskList == nil {
 			handler.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.CompleteDecisionWithStickyDisabledCounter)
@@ -435,6 +442,17 @@ Update_History_Loop:
 				handler.throttledLogger,
 			)
 
+			if !decisionHeartbeatTimeout {
+				if completedUpdates, err = handler.handleUpdateResults(
+					msBuilder,
+					completedEvent.ID,
+					updateResults,
+					domainEntry,
+				); err != nil {
+					return nil, err
+				}
+			}
+
 			decisionTaskHandler := newDecisionTaskHandler(
 				request.GetIdentity(),
 				completedEvent.ID,
@@ -487,21 +505,24 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			completedUpdates = nil
 		}
 
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+		// a paused workflow only gets the decision scheduled, it is dispatched after the workflow is unpaused
+		returnNewDecisionTask := request.GetReturnNewDecisionTask() && !msBuilder.GetExecutionInfo().Paused
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			var newDecision *execution.DecisionInfo
 			var err error
 			if decisionHeartbeating && !decisionHeartbeatTimeout {
 				newDecision, err = msBuilder.AddDecisionTaskScheduledEventAsHeartbeat(
-					request.GetReturnNewDecisionTask(),
+					returnNewDecisionTask,
 					currentDecision.OriginalScheduledTimestamp,
 				)
 			} else {
 				newDecision, err = msBuilder.AddDecisionTaskScheduledEvent(
-					request.GetReturnNewDecisionTask(),
+					returnNewDecisionTask,
 				)
 			}
 			if err != nil {
@@ -510,7 +531,7 @@ Update_History_Loop:
 
 			newDecisionTaskScheduledID = newDecision.ScheduleID
 			// skip transfer task for decision if request asking to return new decision task
-			if request.GetReturnNewDecisionTask() {
+			if returnNewDecisionTask {
 				// start the new decision task if request asked to do so
 				// TODO: replace the poll request
 				_, _, err := msBuilder.AddDecisionTaskStartedEvent(newDecision.ScheduleID, "request-from-RespondDecisionTaskCompleted", &types.PollForDecisionTaskRequest{
@@ -588,10 +609,11 @@ Update_History_Loop:
 			msBuilder,
 			clientImpl,
 			clientFeatureVersion,
-			req.GetCompleteRequest().GetQueryResults(),
+			queryResults,
 			createNewDecisionTask,
 			domainEntry,
 			decisionHeartbeating)
+		handler.handleUpdateWaiters(msBuilder, completedUpdates, domainEntry)
 
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
@@ -614,7 +636,7 @@ Update_History_Loop:
 		}
 		resp.ActivitiesToDispatchLocally = activitiesToDispatchLocally
 
-		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
+		if returnNewDecisionTask && createNewDecisionTask {
 			decision, _ := msBuilder.GetDecisionInfo(newDecisionTaskScheduledID)
 			resp.StartedResponse, err = handler.createRecordDecisionTaskStartedResponse(domainID, msBuilder, decision, request.GetIdentity())
 			if err != nil {
@@ -831,6 +853,172 @@ func (handler *handlerImpl) handleBufferedQueries(
 	}
 }
 
+// splitUpdateResults separates the results for pending updates from the results for buffered queries,
+// both are returned by the worker as query results keyed by the update or query ID.
+func splitUpdateResults(
+	msBuilder execution.MutableState,
+	results map[string]*types.WorkflowQueryResult,
+) (map[string]*types.WorkflowQueryResult, map[string]*types.WorkflowQueryResult) {
+
+	updateResults := make(map[string]*types.WorkflowQueryResult)
+	queryResults := make(map[string]*types.WorkflowQueryResult)
+	queryRegistry := msBuilder.GetQueryRegistry()
+	for id, result := range results {
+		if isUpdateID(msBuilder, queryRegistry, id) {
+			updateResults[id] = result
+		} else {
+			queryResults[id] = result
+		}
+	}
+	return updateResults, queryResults
+}
+
+func isUpdateID(
+	msBuilder execution.MutableState,
+	queryRegistry query.Registry,
+	id string,
+) bool {
+	if _, ok := msBuilder.GetPendingUpdateRequestedEventID(id); ok {
+		return true
+	}
+	if _, ok := msBuilder.GetCompletedUpdateBatchID(id); ok {
+		return true
+	}
+	return queryRegistry.HasUpdate(id)
+}
+
+// handleUpdateResults records a WorkflowExecutionUpdateCompleted event for each update result
+// and returns the results to be delivered to the update callers once the events are persisted.
+// Results of updates which are already completed are dropped.
+func (handler *handlerImpl) handleUpdateResults(
+	msBuilder execution.MutableState,
+	decisionCompletedEventID int64,
+	updateResults map[string]*types.WorkflowQueryResult,
+	domainEntry *cache.DomainCacheEntry,
+) (map[string]*types.WorkflowQueryResult, error) {
+
+	if len(updateResults) == 0 {
+		return nil, nil
+	}
+
+	domainName := domainEntry.GetInfo().Name
+	executionInfo := msBuilder.GetExecutionInfo()
+	scope := handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DomainTag(domainName),
+		metrics.DecisionTypeTag("UpdateWorkflowExecution"))
+
+	completedUpdates := make(map[string]*types.WorkflowQueryResult, len(updateResults))
+	for id, result := range updateResults {
+		if _, ok := msBuilder.GetCompletedUpdateBatchID(id); ok {
+			continue
+		}
+
+		var answer []byte
+		var failureMessage *string
+		switch result.GetResultType() {
+		case types.QueryResultTypeAnswered:
+			answer = result.GetAnswer()
+			if answer == nil {
+				answer = []byte{}
+			}
+			if err := common.CheckEventBlobSizeLimit(
+				len(answer),
+				handler.config.BlobSizeLimitWarn(domainName),
+				handler.config.BlobSizeLimitError(domainName),
+				executionInfo.DomainID,
+				executionInfo.WorkflowID,
+				executionInfo.RunID,
+				scope,
+				handler.throttledLogger,
+				tag.BlobSizeViolationOperation("UpdateWorkflowExecution"),
+			); err != nil {
+				answer = nil
+				failureMessage = common.StringPtr(err.Error())
+			}
+		default:
+			failureMessage = common.StringPtr(result.GetErrorMessage())
+			if result.GetErrorMessage() == "" {
+				failureMessage = common.StringPtr("update failed")
+			}
+		}
+
+		if _, err := msBuilder.AddWorkflowExecutionUpdateCompletedEvent(
+			decisionCompletedEventID,
+			id,
+			answer,
+			failureMessage,
+		); err != nil {
+			return nil, err
+		}
+
+		if failureMessage != nil {
+			completedUpdates[id] = &types.WorkflowQueryResult{
+				ResultType:   types.QueryResultTypeFailed.Ptr(),
+				ErrorMessage: *failureMessage,
+			}
+		} else {
+			completedUpdates[id] = &types.WorkflowQueryResult{
+				ResultType: types.QueryResultTypeAnswered.Ptr(),
+				Answer:     answer,
+			}
+		}
+	}
+	return completedUpdates, nil
+}
+
+// handleUpdateWaiters notifies the callers waiting on the completed updates. If the workflow is closed
+// by this decision, callers waiting on updates that will never be completed are notified as well.
+func (handler *handlerImpl) handleUpdateWaiters(
+	msBuilder execution.MutableState,
+	completedUpdates map[string]*types.WorkflowQueryResult,
+	domainEntry *cache.DomainCacheEntry,
+) {
+
+	queryRegistry := msBuilder.GetQueryRegistry()
+	for id, result := range completedUpdates {
+		if !queryRegistry.HasUpdate(id) {
+			continue
+		}
+		handler.setUpdateTerminationState(msBuilder, queryRegistry, id, &query.TerminationState{
+			TerminationType: query.TerminationTypeCompleted,
+			QueryResult:     result,
+		}, domainEntry)
+	}
+
+	if msBuilder.IsWorkflowExecutionRunning() {
+		return
+	}
+	for _, id := range queryRegistry.GetUpdateIDs() {
+		if _, ok := completedUpdates[id]; ok {
+			continue
+		}
+		handler.setUpdateTerminationState(msBuilder, queryRegistry, id, &query.TerminationState{
+			TerminationType: query.TerminationTypeFailed,
+			Failure:         workflow.ErrAlreadyCompleted,
+		}, domainEntry)
+	}
+}
+
+func (handler *handlerImpl) setUpdateTerminationState(
+	msBuilder execution.MutableState,
+	queryRegistry query.Registry,
+	updateID string,
+	terminationState *query.TerminationState,
+	domainEntry *cache.DomainCacheEntry,
+) {
+
+	if err := queryRegistry.SetUpdateTerminationState(updateID, terminationState); err != nil {
+		handler.logger.Warn(
+			"failed to set update termination state",
+			tag.WorkflowDomainName(domainEntry.GetInfo().Name),
+			tag.WorkflowID(msBuilder.GetExecutionInfo().WorkflowID),
+			tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID),
+			tag.QueryID(updateID),
+			tag.Error(err))
+	}
+}
+
 func (handler *handlerImpl) failDecisionHelper(
 	ctx context.Context,
 	wfContext execution.Context,
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
@@ -119,6 +120,86 @@ func (s *DecisionHandlerSuite) TestHandleBufferedQueries_QueryTooLarge() {
 	s.assertQueryCounts(s.queryRegistry, 0, 5, 0, 5)
 }
 
+func (s *DecisionHandlerSuite) TestSplitUpdateResults() {
+	bufferedIDs := s.queryRegistry.GetBufferedIDs()
+	s.queryRegistry.BufferUpdate("update-1")
+	results := s.constructQueryResults(append([]string{"update-1", "update-2", "update-3"}, bufferedIDs...), 10)
+	s.mockMutableState.EXPECT().GetPendingUpdateRequestedEventID(gomock.Any()).DoAndReturn(func(updateID string) (int64, bool) {
+		return 5, updateID == "update-2"
+	}).AnyTimes()
+	// update-3 is completed and its waiter is gone, e.g. after the mutable state is reloaded
+	s.mockMutableState.EXPECT().GetCompletedUpdateBatchID(gomock.Any()).DoAndReturn(func(updateID string) (int64, bool) {
+		return 8, updateID == "update-3"
+	}).AnyTimes()
+
+	updateResults, queryResults := splitUpdateResults(s.mockMutableState, results)
+	s.Len(updateResults, 3)
+	s.Contains(updateResults, "update-1")
+	s.Contains(updateResults, "update-2")
+	s.Contains(updateResults, "update-3")
+	s.Len(queryResults, len(bufferedIDs))
+}
+
+func (s *DecisionHandlerSuite) TestHandleUpdateResults() {
+	updateResults := map[string]*types.WorkflowQueryResult{
+		"update-1": {
+			ResultType: types.QueryResultTypeAnswered.Ptr(),
+			Answer:     []byte{1, 2, 3},
+		},
+		"update-2": {
+			ResultType:   types.QueryResultTypeFailed.Ptr(),
+			ErrorMessage: "some error",
+		},
+	}
+	s.mockMutableState.EXPECT().GetCompletedUpdateBatchID(gomock.Any()).Return(int64(0), false).Times(2)
+	s.mockMutableState.EXPECT().AddWorkflowExecutionUpdateCompletedEvent(int64(10), "update-1", []byte{1, 2, 3}, nil).Return(&types.HistoryEvent{}, nil).Times(1)
+	s.mockMutableState.EXPECT().AddWorkflowExecutionUpdateCompletedEvent(int64(10), "update-2", nil, common.StringPtr("some error")).Return(&types.HistoryEvent{}, nil).Times(1)
+
+	completedUpdates, err := s.decisionHandler.handleUpdateResults(s.mockMutableState, 10, updateResults, constants.TestGlobalDomainEntry)
+	s.NoError(err)
+	s.Equal(updateResults, completedUpdates)
+	// waiters are only notified once the completed events are persisted
+	s.Empty(s.mockMutableState.GetQueryRegistry().GetUpdateIDs())
+}
+
+func (s *DecisionHandlerSuite) TestHandleUpdateResults_AlreadyCompleted() {
+	updateResults := map[string]*types.WorkflowQueryResult{
+		"update-1": {
+			ResultType: types.QueryResultTypeAnswered.Ptr(),
+			Answer:     []byte{1, 2, 3},
+		},
+	}
+	s.mockMutableState.EXPECT().GetCompletedUpdateBatchID("update-1").Return(int64(8), true).Times(1)
+	s.mockMutableState.EXPECT().AddWorkflowExecutionUpdateCompletedEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	completedUpdates, err := s.decisionHandler.handleUpdateResults(s.mockMutableState, 10, updateResults, constants.TestGlobalDomainEntry)
+	s.NoError(err)
+	s.Empty(completedUpdates)
+	s.Empty(s.mockMutableState.GetQueryRegistry().GetUpdateIDs())
+}
+
+func (s *DecisionHandlerSuite) TestHandleUpdateWaiters_WorkflowClosed() {
+	termCh1 := s.queryRegistry.BufferUpdate("update-1")
+	termCh2 := s.queryRegistry.BufferUpdate("update-2")
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(false).Times(1)
+
+	s.decisionHandler.handleUpdateWaiters(s.mockMutableState, map[string]*types.WorkflowQueryResult{
+		"update-1": {
+			ResultType: types.QueryResultTypeAnswered.Ptr(),
+			Answer:     []byte{1, 2, 3},
+		},
+	}, constants.TestGlobalDomainEntry)
+
+	<-termCh1
+	<-termCh2
+	state, err := s.queryRegistry.GetUpdateTerminationState("update-1")
+	s.NoError(err)
+	s.Equal(query.TerminationTypeCompleted, state.TerminationType)
+	state, err = s.queryRegistry.GetUpdateTerminationState("update-2")
+	s.NoError(err)
+	s.Equal(query.TerminationTypeFailed, state.TerminationType)
+}
+
 func (s *DecisionHandlerSuite) constructQueryResults(ids []string, resultSize int) map[string]*types.WorkflowQueryResult {
 	results := make(map[string]*types.WorkflowQueryResult)
 	for _, id := range ids {
@@ -210,6 +210,21 @@ func (mr *MockEngineMockRecorder) GetReplicationMessages(ctx, pollingCluster, la
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetReplicationMessages), ctx, pollingCluster, lastReadMessageID)
 }
 
+// ListWorkflowTasks mocks base method.
+func (m *MockEngine) ListWorkflowTasks(ctx context.Context, domainUUID string, execution types.WorkflowExecution) (*types.ListWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", ctx, domainUUID, execution)
+	ret0, _ := ret[0].(*types.ListWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowTasks indicates an expected call of ListWorkflowTasks.
+func (mr *MockEngineMockRecorder) ListWorkflowTasks(ctx, domainUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowTasks", reflect.TypeOf((*MockEngine)(nil).ListWorkflowTasks), ctx, domainUUID, execution)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockEngine) MergeDLQMessages(ctx context.Context, messagesRequest *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -285,6 +300,20 @@ func (mr *MockEngineMockRecorder) NotifyNewTransferTasks(info interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTransferTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewTransferTasks), info)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockEngine) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) PauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PauseWorkflowExecution), ctx, request)
+}
+
 // PollMutableState mocks base method.
 func (m *MockEngine) PollMutableState(ctx context.Context, request *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -630,6 +659,48 @@ func (mr *MockEngineMockRecorder) RespondDecisionTaskFailed(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondDecisionTaskFailed), ctx, request)
 }
 
+// RetryCrossClusterTask mocks base method.
+func (m *MockEngine) RetryCrossClusterTask(ctx context.Context, clusterName string, taskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryCrossClusterTask", ctx, clusterName, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryCrossClusterTask indicates an expected call of RetryCrossClusterTask.
+func (mr *MockEngineMockRecorder) RetryCrossClusterTask(ctx, clusterName, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryCrossClusterTask", reflect.TypeOf((*MockEngine)(nil).RetryCrossClusterTask), ctx, clusterName, taskID)
+}
+
+// RetryTimerTask mocks base method.
+func (m *MockEngine) RetryTimerTask(ctx context.Context, clusterName string, taskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryTimerTask", ctx, clusterName, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryTimerTask indicates an expected call of RetryTimerTask.
+func (mr *MockEngineMockRecorder) RetryTimerTask(ctx, clusterName, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTimerTask", reflect.TypeOf((*MockEngine)(nil).RetryTimerTask), ctx, clusterName, taskID)
+}
+
+// RetryTransferTask mocks base method.
+func (m *MockEngine) RetryTransferTask(ctx context.Context, clusterName string, taskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryTransferTask", ctx, clusterName, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryTransferTask indicates an expected call of RetryTransferTask.
+func (mr *MockEngineMockRecorder) RetryTransferTask(ctx, clusterName, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTransferTask", reflect.TypeOf((*MockEngine)(nil).RetryTransferTask), ctx, clusterName, taskID)
+}
+
 // ScheduleDecisionTask mocks base method.
 func (m *MockEngine) ScheduleDecisionTask(ctx context.Context, request *types.ScheduleDecisionTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -753,3 +824,32 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockEngine) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) UnpauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockEngine) UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockEngineMockRecorder) UpdateWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowExecution), ctx, request)
+}
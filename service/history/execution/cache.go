@@ -53,6 +53,7 @@ type (
 		logger           log.Logger
 		metricsClient    metrics.Client
 		config           *config.Config
+		checksumRepairer ChecksumRepairer
 	}
 )
 
@@ -75,7 +76,7 @@ func NewCache(shard shard.Context) *Cache {
 	opts.Pin = true
 	opts.MaxCount = config.HistoryCacheMaxSize()
 
-	return &Cache{
+	c := &Cache{
 		Cache:            cache.New(opts),
 		shard:            shard,
 		executionManager: shard.GetExecutionManager(),
@@ -83,6 +84,8 @@ func NewCache(shard shard.Context) *Cache {
 		metricsClient:    shard.GetMetricsClient(),
 		config:           config,
 	}
+	c.checksumRepairer = newChecksumRepairer(shard, c)
+	return c
 }
 
 // GetOrCreateCurrentWorkflowExecution gets or creates workflow execution context for the current run
@@ -214,7 +217,7 @@ func (c *Cache) getOrCreateWorkflowExecutionInternal(
 
 	// Test hook for disabling the cache
 	if c.disabled {
-		return NewContext(domainID, execution, c.shard, c.executionManager, c.logger), NoopReleaseFn, nil
+		return c.newContext(domainID, execution), NoopReleaseFn, nil
 	}
 
 	key := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowID(), execution.GetRunID())
@@ -222,7 +225,7 @@ func (c *Cache) getOrCreateWorkflowExecutionInternal(
 	if !cacheHit {
 		c.metricsClient.IncCounter(scope, metrics.CacheMissCounter)
 		// Let's create the workflow execution workflowCtx
-		workflowCtx = NewContext(domainID, execution, c.shard, c.executionManager, c.logger)
+		workflowCtx = c.newContext(domainID, execution)
 		elem, err := c.PutIfNotExist(key, workflowCtx)
 		if err != nil {
 			c.metricsClient.IncCounter(scope, metrics.CacheFailures)
@@ -305,6 +308,13 @@ func (c *Cache) makeReleaseFunc(
 	}
 }
 
+func (c *Cache) newContext(
+	domainID string,
+	execution types.WorkflowExecution,
+) Context {
+	return newContext(domainID, execution, c.shard, c.executionManager, c.checksumRepairer, c.logger)
+}
+
 func (c *Cache) getCurrentExecutionWithRetry(
 	ctx context.Context,
 	request *persistence.GetCurrentExecutionRequest,
@@ -22,6 +22,7 @@ package execution
 
 import (
 	"fmt"
+	"sort"
 
 	checksumgen "github.com/uber/cadence/.gen/go/checksum"
 	"github.com/uber/cadence/common"
@@ -30,12 +31,21 @@ import (
 )
 
 const (
+	// mutableStateChecksumPayloadV1 covers execution counters, version histories
+	// and the IDs of pending timers, activities, children, request cancels and signals
 	mutableStateChecksumPayloadV1 = 1
+	// mutableStateChecksumPayloadV2 additionally covers the fields of pending
+	// timers, activities, children, request cancels and signals which are
+	// derived from history events
+	mutableStateChecksumPayloadV2 = 2
 )
 
-func generateMutableStateChecksum(ms MutableState) (checksum.Checksum, error) {
-	payload := newMutableStateChecksumPayload(ms)
-	csum, err := checksum.GenerateCRC32(payload, mutableStateChecksumPayloadV1)
+func generateMutableStateChecksum(ms MutableState, version int) (checksum.Checksum, error) {
+	payload, err := newMutableStateChecksumPayloadWithVersion(ms, version)
+	if err != nil {
+		return checksum.Checksum{}, err
+	}
+	csum, err := checksum.GenerateCRC32(payload, version)
 	if err != nil {
 		return checksum.Checksum{}, err
 	}
@@ -46,13 +56,27 @@ func verifyMutableStateChecksum(
 	ms MutableState,
 	csum checksum.Checksum,
 ) error {
-	if csum.Version != mutableStateChecksumPayloadV1 {
-		return fmt.Errorf("invalid checksum payload version %v", csum.Version)
+	payload, err := newMutableStateChecksumPayloadWithVersion(ms, csum.Version)
+	if err != nil {
+		return err
 	}
-	payload := newMutableStateChecksumPayload(ms)
 	return checksum.Verify(payload, csum)
 }
 
+func newMutableStateChecksumPayloadWithVersion(
+	ms MutableState,
+	version int,
+) (*checksumgen.MutableStateChecksumPayload, error) {
+	switch version {
+	case mutableStateChecksumPayloadV1:
+		return newMutableStateChecksumPayload(ms), nil
+	case mutableStateChecksumPayloadV2:
+		return newMutableStateChecksumPayloadV2(ms), nil
+	default:
+		return nil, fmt.Errorf("invalid checksum payload version %v", version)
+	}
+}
+
 func newMutableStateChecksumPayload(ms MutableState) *checksumgen.MutableStateChecksumPayload {
 	executionInfo := ms.GetExecutionInfo()
 	payload := &checksumgen.MutableStateChecksumPayload{
@@ -112,3 +136,88 @@ func newMutableStateChecksumPayload(ms MutableState) *checksumgen.MutableStateCh
 	payload.PendingReqCancelInitiatedIDs = requestCancelIDs
 	return payload
 }
+
+func newMutableStateChecksumPayloadV2(ms MutableState) *checksumgen.MutableStateChecksumPayload {
+	payload := newMutableStateChecksumPayload(ms)
+
+	// the detailed infos below are sorted by the same ids as the v1 payload,
+	// only fields which can be derived from history events are included so that
+	// the payload of a mutable state rebuilt from history is comparable
+	pendingTimers := make([]*checksumgen.TimerChecksumInfo, 0, len(ms.GetPendingTimerInfos()))
+	for _, ti := range ms.GetPendingTimerInfos() {
+		pendingTimers = append(pendingTimers, &checksumgen.TimerChecksumInfo{
+			TimerID:         common.StringPtr(ti.TimerID),
+			StartedID:       common.Int64Ptr(ti.StartedID),
+			ExpiryTimeNanos: common.Int64Ptr(ti.ExpiryTime.UnixNano()),
+			Version:         common.Int64Ptr(ti.Version),
+		})
+	}
+	sort.Slice(pendingTimers, func(i, j int) bool {
+		return pendingTimers[i].GetStartedID() < pendingTimers[j].GetStartedID()
+	})
+	payload.PendingTimers = pendingTimers
+
+	pendingActivities := make([]*checksumgen.ActivityChecksumInfo, 0, len(ms.GetPendingActivityInfos()))
+	for _, ai := range ms.GetPendingActivityInfos() {
+		pendingActivities = append(pendingActivities, &checksumgen.ActivityChecksumInfo{
+			ScheduleID:             common.Int64Ptr(ai.ScheduleID),
+			ActivityID:             common.StringPtr(ai.ActivityID),
+			TaskList:               common.StringPtr(ai.TaskList),
+			ScheduleToStartTimeout: common.Int32Ptr(ai.ScheduleToStartTimeout),
+			ScheduleToCloseTimeout: common.Int32Ptr(ai.ScheduleToCloseTimeout),
+			StartToCloseTimeout:    common.Int32Ptr(ai.StartToCloseTimeout),
+			HeartbeatTimeout:       common.Int32Ptr(ai.HeartbeatTimeout),
+			CancelRequested:        common.BoolPtr(ai.CancelRequested),
+			CancelRequestID:        common.Int64Ptr(ai.CancelRequestID),
+			Version:                common.Int64Ptr(ai.Version),
+		})
+	}
+	sort.Slice(pendingActivities, func(i, j int) bool {
+		return pendingActivities[i].GetScheduleID() < pendingActivities[j].GetScheduleID()
+	})
+	payload.PendingActivities = pendingActivities
+
+	pendingChildren := make([]*checksumgen.ChildExecutionChecksumInfo, 0, len(ms.GetPendingChildExecutionInfos()))
+	for _, ci := range ms.GetPendingChildExecutionInfos() {
+		pendingChildren = append(pendingChildren, &checksumgen.ChildExecutionChecksumInfo{
+			InitiatedID:       common.Int64Ptr(ci.InitiatedID),
+			StartedID:         common.Int64Ptr(ci.StartedID),
+			StartedWorkflowID: common.StringPtr(ci.StartedWorkflowID),
+			StartedRunID:      common.StringPtr(ci.StartedRunID),
+			DomainID:          common.StringPtr(ci.DomainID),
+			WorkflowTypeName:  common.StringPtr(ci.WorkflowTypeName),
+			ParentClosePolicy: common.Int32Ptr(int32(ci.ParentClosePolicy)),
+			Version:           common.Int64Ptr(ci.Version),
+		})
+	}
+	sort.Slice(pendingChildren, func(i, j int) bool {
+		return pendingChildren[i].GetInitiatedID() < pendingChildren[j].GetInitiatedID()
+	})
+	payload.PendingChildren = pendingChildren
+
+	pendingSignals := make([]*checksumgen.SignalChecksumInfo, 0, len(ms.GetPendingSignalExternalInfos()))
+	for _, si := range ms.GetPendingSignalExternalInfos() {
+		pendingSignals = append(pendingSignals, &checksumgen.SignalChecksumInfo{
+			InitiatedID: common.Int64Ptr(si.InitiatedID),
+			SignalName:  common.StringPtr(si.SignalName),
+			Version:     common.Int64Ptr(si.Version),
+		})
+	}
+	sort.Slice(pendingSignals, func(i, j int) bool {
+		return pendingSignals[i].GetInitiatedID() < pendingSignals[j].GetInitiatedID()
+	})
+	payload.PendingSignals = pendingSignals
+
+	pendingRequestCancels := make([]*checksumgen.RequestCancelChecksumInfo, 0, len(ms.GetPendingRequestCancelExternalInfos()))
+	for _, ri := range ms.GetPendingRequestCancelExternalInfos() {
+		pendingRequestCancels = append(pendingRequestCancels, &checksumgen.RequestCancelChecksumInfo{
+			InitiatedID: common.Int64Ptr(ri.InitiatedID),
+			Version:     common.Int64Ptr(ri.Version),
+		})
+	}
+	sort.Slice(pendingRequestCancels, func(i, j int) bool {
+		return pendingRequestCancels[i].GetInitiatedID() < pendingRequestCancels[j].GetInitiatedID()
+	})
+	payload.PendingRequestCancels = pendingRequestCancels
+	return payload
+}
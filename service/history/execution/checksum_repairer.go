@@ -0,0 +1,394 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination checksum_repairer_mock.go -self_package github.com/uber/cadence/service/history/execution
+
+package execution
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	checksumgen "github.com/uber/cadence/.gen/go/checksum"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/watchdog"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	checksumRepairTimeout        = time.Minute
+	maxConcurrentChecksumRepairs = 10
+)
+
+type (
+	// ChecksumRepairer rebuilds workflows failing mutable state checksum
+	// verification from their history, reports the differences between the
+	// loaded and the rebuilt mutable state to the watchdog workflow and
+	// optionally persists the rebuilt mutable state
+	ChecksumRepairer interface {
+		Repair(domainID string, execution types.WorkflowExecution, mismatch error)
+	}
+
+	checksumRepairerImpl struct {
+		sync.Mutex
+
+		shard             shard.Context
+		executionCache    *Cache
+		newStateRebuilder func() StateRebuilder
+		watchdogClient    watchdog.Client
+		logger            log.Logger
+		metricsClient     metrics.Client
+
+		inProgress map[definition.WorkflowIdentifier]struct{}
+	}
+)
+
+var _ ChecksumRepairer = (*checksumRepairerImpl)(nil)
+
+// checksumFieldsNotRebuildable are the checksum payload fields
+// which are not derived from history events
+var checksumFieldsNotRebuildable = map[string]struct{}{
+	"DecisionAttempt":    {},
+	"StickyTaskListName": {},
+}
+
+func newChecksumRepairer(
+	shard shard.Context,
+	executionCache *Cache,
+) ChecksumRepairer {
+	logger := shard.GetLogger().WithTags(tag.ComponentChecksumRepairer)
+	return &checksumRepairerImpl{
+		shard:          shard,
+		executionCache: executionCache,
+		newStateRebuilder: func() StateRebuilder {
+			return NewStateRebuilder(shard, logger)
+		},
+		watchdogClient: watchdog.NewClient(logger, shard.GetService().GetSDKClient()),
+		logger:         logger,
+		metricsClient:  shard.GetMetricsClient(),
+		inProgress:     make(map[definition.WorkflowIdentifier]struct{}),
+	}
+}
+
+// Repair schedules the repair of the given workflow in the background,
+// it never blocks the caller which may still be holding the workflow lock
+func (r *checksumRepairerImpl) Repair(
+	domainID string,
+	execution types.WorkflowExecution,
+	mismatch error,
+) {
+	key := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowID(), execution.GetRunID())
+
+	r.Lock()
+	if _, ok := r.inProgress[key]; ok {
+		r.Unlock()
+		return
+	}
+	if len(r.inProgress) >= maxConcurrentChecksumRepairs {
+		r.Unlock()
+		r.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumRepairDropped)
+		return
+	}
+	r.inProgress[key] = struct{}{}
+	r.Unlock()
+
+	go func() {
+		defer func() {
+			r.Lock()
+			delete(r.inProgress, key)
+			r.Unlock()
+		}()
+
+		report := r.repair(domainID, execution, mismatch)
+		if err := r.watchdogClient.ReportChecksumFailure(report); err != nil {
+			r.logger.Warn("failed to report mutable state checksum failure to watchdog",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(execution.GetWorkflowID()),
+				tag.WorkflowRunID(execution.GetRunID()),
+				tag.Error(err),
+			)
+		}
+	}()
+}
+
+func (r *checksumRepairerImpl) repair(
+	domainID string,
+	execution types.WorkflowExecution,
+	mismatch error,
+) watchdog.ChecksumFailureReport {
+
+	report := watchdog.ChecksumFailureReport{
+		Workflow:     execution,
+		ShardID:      r.shard.GetShardID(),
+		ReportedTime: r.shard.GetTimeSource().Now(),
+	}
+	if mismatch != nil {
+		report.Mismatch = mismatch.Error()
+	}
+	logger := r.logger.WithTags(
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(execution.GetWorkflowID()),
+		tag.WorkflowRunID(execution.GetRunID()),
+	)
+
+	domainName, err := r.shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	report.DomainName = domainName
+
+	if err := r.rebuildAndRepair(domainID, domainName, execution, &report); err != nil {
+		r.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumRebuildFailed)
+		logger.Error("failed to rebuild mutable state after checksum mismatch", tag.Error(err))
+		report.Error = err.Error()
+		return report
+	}
+
+	if len(report.Diffs) != 0 {
+		logger.Error("mutable state rebuilt from history differs from the loaded mutable state",
+			tag.Counter(len(report.Diffs)),
+			tag.Value(report.Diffs),
+		)
+	}
+	return report
+}
+
+func (r *checksumRepairerImpl) rebuildAndRepair(
+	domainID string,
+	domainName string,
+	execution types.WorkflowExecution,
+	report *watchdog.ChecksumFailureReport,
+) (retError error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), checksumRepairTimeout)
+	defer cancel()
+
+	wfContext, release, err := r.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+
+	rebuiltMutableState, rebuiltHistorySize, err := r.rebuild(ctx, mutableState)
+	if err != nil {
+		return err
+	}
+
+	report.Diffs = diffMutableStateChecksumPayload(
+		newMutableStateChecksumPayloadV2(mutableState),
+		newMutableStateChecksumPayloadV2(rebuiltMutableState),
+	)
+	if len(report.Diffs) == 0 {
+		return nil
+	}
+	r.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumRebuildDiffers)
+
+	if !mutableState.IsWorkflowExecutionRunning() ||
+		!r.shard.GetConfig().EnableMutableStateChecksumRepair(domainName) {
+		return nil
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	resp, err := r.executionCache.getCurrentExecutionWithRetry(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: executionInfo.WorkflowID,
+		DomainName: domainName,
+	})
+	if err != nil {
+		return err
+	}
+	conflictResolveMode := persistence.ConflictResolveWorkflowModeBypassCurrent
+	if resp.RunID == executionInfo.RunID {
+		conflictResolveMode = persistence.ConflictResolveWorkflowModeUpdateCurrent
+	}
+
+	wfContext.Clear()
+	wfContext.SetHistorySize(rebuiltHistorySize)
+	if err := wfContext.ConflictResolveWorkflowExecution(
+		ctx,
+		r.shard.GetTimeSource().Now(),
+		conflictResolveMode,
+		rebuiltMutableState,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	); err != nil {
+		return err
+	}
+
+	report.Repaired = true
+	r.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumRepaired)
+	return nil
+}
+
+func (r *checksumRepairerImpl) rebuild(
+	ctx context.Context,
+	mutableState MutableState,
+) (MutableState, int64, error) {
+
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		return nil, 0, ErrMissingVersionHistories
+	}
+	currentVersionHistory, err := versionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return nil, 0, err
+	}
+	lastItem, err := currentVersionHistory.GetLastItem()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	workflowIdentifier := definition.NewWorkflowIdentifier(
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+	)
+	rebuiltMutableState, rebuiltHistorySize, err := r.newStateRebuilder().Rebuild(
+		ctx,
+		executionInfo.StartTimestamp,
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		lastItem.EventID,
+		lastItem.Version,
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		uuid.New(),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rebuiltVersionHistories := rebuiltMutableState.GetVersionHistories()
+	if rebuiltVersionHistories == nil {
+		return nil, 0, ErrMissingVersionHistories
+	}
+	rebuiltVersionHistory, err := rebuiltVersionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return nil, 0, err
+	}
+	if !rebuiltVersionHistory.Equals(currentVersionHistory) {
+		return nil, 0, &types.InternalServiceError{
+			Message: "checksum repairer encounter mismatch version history after rebuild",
+		}
+	}
+
+	// the rebuilt mutable state only knows about the current branch,
+	// keep the other branches of the loaded mutable state
+	if err := rebuiltMutableState.SetVersionHistories(versionHistories.Duplicate()); err != nil {
+		return nil, 0, err
+	}
+	rebuiltMutableState.SetUpdateCondition(mutableState.GetUpdateCondition())
+	return rebuiltMutableState, rebuiltHistorySize, nil
+}
+
+func diffMutableStateChecksumPayload(
+	before *checksumgen.MutableStateChecksumPayload,
+	after *checksumgen.MutableStateChecksumPayload,
+) []watchdog.ChecksumFieldDiff {
+
+	var diffs []watchdog.ChecksumFieldDiff
+	beforeValue := reflect.ValueOf(before).Elem()
+	afterValue := reflect.ValueOf(after).Elem()
+	for i := 0; i < beforeValue.NumField(); i++ {
+		field := beforeValue.Type().Field(i).Name
+		if _, ok := checksumFieldsNotRebuildable[field]; ok {
+			continue
+		}
+
+		beforeField := beforeValue.Field(i)
+		afterField := afterValue.Field(i)
+		if reflect.DeepEqual(beforeField.Interface(), afterField.Interface()) {
+			continue
+		}
+
+		diff := watchdog.ChecksumFieldDiff{Field: field}
+		if beforeField.Kind() == reflect.Slice {
+			// only report the list elements which are not on both sides
+			diff.Before, diff.After = diffChecksumPayloadList(beforeField, afterField)
+		} else {
+			diff.Before = formatChecksumPayloadValue(beforeField)
+			diff.After = formatChecksumPayloadValue(afterField)
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+func diffChecksumPayloadList(
+	before reflect.Value,
+	after reflect.Value,
+) (string, string) {
+
+	counts := make(map[string]int)
+	for i := 0; i < after.Len(); i++ {
+		counts[formatChecksumPayloadValue(after.Index(i))]++
+	}
+	var removed []string
+	for i := 0; i < before.Len(); i++ {
+		element := formatChecksumPayloadValue(before.Index(i))
+		if counts[element] > 0 {
+			counts[element]--
+			continue
+		}
+		removed = append(removed, element)
+	}
+	var added []string
+	for element, count := range counts {
+		for ; count > 0; count-- {
+			added = append(added, element)
+		}
+	}
+	sort.Strings(added)
+	return "[" + strings.Join(removed, ", ") + "]", "[" + strings.Join(added, ", ") + "]"
+}
+
+func formatChecksumPayloadValue(value reflect.Value) string {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return "<nil>"
+		}
+		if value.Elem().Kind() != reflect.Struct {
+			value = value.Elem()
+		}
+	}
+	return fmt.Sprint(value.Interface())
+}
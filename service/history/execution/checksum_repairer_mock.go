@@ -21,51 +21,50 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: queryParser.go
+// Source: checksum_repairer.go
 
-// Package s3store is a generated GoMock package.
-package s3store
+// Package execution is a generated GoMock package.
+package execution
 
 import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+
+	types "github.com/uber/cadence/common/types"
 )
 
-// MockQueryParser is a mock of QueryParser interface.
-type MockQueryParser struct {
+// MockChecksumRepairer is a mock of ChecksumRepairer interface.
+type MockChecksumRepairer struct {
 	ctrl     *gomock.Controller
-	recorder *MockQueryParserMockRecorder
+	recorder *MockChecksumRepairerMockRecorder
 }
 
-// MockQueryParserMockRecorder is the mock recorder for MockQueryParser.
-type MockQueryParserMockRecorder struct {
-	mock *MockQueryParser
+// MockChecksumRepairerMockRecorder is the mock recorder for MockChecksumRepairer.
+type MockChecksumRepairerMockRecorder struct {
+	mock *MockChecksumRepairer
 }
 
-// NewMockQueryParser creates a new mock instance.
-func NewMockQueryParser(ctrl *gomock.Controller) *MockQueryParser {
-	mock := &MockQueryParser{ctrl: ctrl}
-	mock.recorder = &MockQueryParserMockRecorder{mock}
+// NewMockChecksumRepairer creates a new mock instance.
+func NewMockChecksumRepairer(ctrl *gomock.Controller) *MockChecksumRepairer {
+	mock := &MockChecksumRepairer{ctrl: ctrl}
+	mock.recorder = &MockChecksumRepairerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockQueryParser) EXPECT() *MockQueryParserMockRecorder {
+func (m *MockChecksumRepairer) EXPECT() *MockChecksumRepairerMockRecorder {
 	return m.recorder
 }
 
-// Parse mocks base method.
-func (m *MockQueryParser) Parse(query string) (*parsedQuery, error) {
+// Repair mocks base method.
+func (m *MockChecksumRepairer) Repair(domainID string, execution types.WorkflowExecution, mismatch error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Parse", query)
-	ret0, _ := ret[0].(*parsedQuery)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	m.ctrl.Call(m, "Repair", domainID, execution, mismatch)
 }
 
-// Parse indicates an expected call of Parse.
-func (mr *MockQueryParserMockRecorder) Parse(query interface{}) *gomock.Call {
+// Repair indicates an expected call of Repair.
+func (mr *MockChecksumRepairerMockRecorder) Repair(domainID, execution, mismatch interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQueryParser)(nil).Parse), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*MockChecksumRepairer)(nil).Repair), domainID, execution, mismatch)
 }
@@ -0,0 +1,257 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	checksumgen "github.com/uber/cadence/.gen/go/checksum"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/watchdog"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	checksumRepairerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller         *gomock.Controller
+		mockShard          *shard.TestContext
+		mockEngine         *engine.MockEngine
+		mockStateRebuilder *MockStateRebuilder
+		mockExecutionMgr   *mocks.ExecutionManager
+
+		repairer *checksumRepairerImpl
+	}
+)
+
+func TestChecksumRepairerSuite(t *testing.T) {
+	s := new(checksumRepairerSuite)
+	suite.Run(t, s)
+}
+
+func (s *checksumRepairerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID:          0,
+			RangeID:          1,
+			TransferAckLevel: 0,
+		},
+		config.NewForTest(),
+	)
+	s.mockEngine = engine.NewMockEngine(s.controller)
+	s.mockShard.SetEngine(s.mockEngine)
+	s.mockStateRebuilder = NewMockStateRebuilder(s.controller)
+	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
+
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(constants.TestDomainID).Return(constants.TestDomainName, nil).AnyTimes()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestLocalDomainEntry, nil).AnyTimes()
+
+	s.repairer = NewCache(s.mockShard).checksumRepairer.(*checksumRepairerImpl)
+	s.repairer.newStateRebuilder = func() StateRebuilder {
+		return s.mockStateRebuilder
+	}
+}
+
+func (s *checksumRepairerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *checksumRepairerSuite) TestRepair_NoDiff() {
+	s.prepareLoadedMutableState(s.newActivityInfos("tasklist"))
+	s.prepareRebuiltMutableState(s.newActivityInfos("tasklist"))
+
+	report := s.repairer.repair(constants.TestDomainID, s.workflowExecution(), errors.New("checksum mismatch"))
+	s.Equal(constants.TestDomainName, report.DomainName)
+	s.Equal("checksum mismatch", report.Mismatch)
+	s.Empty(report.Error)
+	s.Empty(report.Diffs)
+	s.False(report.Repaired)
+}
+
+func (s *checksumRepairerSuite) TestRepair_RebuildFailed() {
+	s.prepareLoadedMutableState(s.newActivityInfos("tasklist"))
+	s.mockStateRebuilder.EXPECT().Rebuild(
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(nil, int64(0), errors.New("some random error"))
+
+	report := s.repairer.repair(constants.TestDomainID, s.workflowExecution(), errors.New("checksum mismatch"))
+	s.Equal("some random error", report.Error)
+	s.Empty(report.Diffs)
+	s.False(report.Repaired)
+}
+
+func (s *checksumRepairerSuite) TestRepair_DiffRepairDisabled() {
+	s.prepareLoadedMutableState(s.newActivityInfos("tasklist"))
+	s.prepareRebuiltMutableState(s.newActivityInfos("another tasklist"))
+
+	report := s.repairer.repair(constants.TestDomainID, s.workflowExecution(), errors.New("checksum mismatch"))
+	s.Empty(report.Error)
+	s.Len(report.Diffs, 1)
+	s.Equal("PendingActivities", report.Diffs[0].Field)
+	s.Contains(report.Diffs[0].Before, "TaskList: tasklist")
+	s.Contains(report.Diffs[0].After, "TaskList: another tasklist")
+	s.False(report.Repaired)
+}
+
+func (s *checksumRepairerSuite) TestRepair_DiffRepaired() {
+	s.mockShard.GetConfig().EnableMutableStateChecksumRepair = func(domain string) bool { return true }
+	s.prepareLoadedMutableState(s.newActivityInfos("tasklist"))
+	rebuiltMutableState := s.prepareRebuiltMutableState(s.newActivityInfos("another tasklist"))
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, &persistence.GetCurrentExecutionRequest{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		DomainName: constants.TestDomainName,
+	}).Return(&persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}, nil).Once()
+	rebuiltMutableState.EXPECT().CloseTransactionAsSnapshot(gomock.Any(), TransactionPolicyPassive).Return(&persistence.WorkflowSnapshot{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:   constants.TestDomainID,
+			WorkflowID: constants.TestWorkflowID,
+			RunID:      constants.TestRunID,
+			State:      persistence.WorkflowStateRunning,
+		},
+	}, nil, nil)
+	s.mockExecutionMgr.On("ConflictResolveWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.ConflictResolveWorkflowExecutionRequest) bool {
+		return request.Mode == persistence.ConflictResolveWorkflowModeUpdateCurrent
+	})).Return(&persistence.ConflictResolveWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	rebuiltMutableState.EXPECT().GetCurrentBranchToken().Return([]byte("branch token"), nil).AnyTimes()
+	rebuiltMutableState.EXPECT().GetWorkflowStateCloseStatus().Return(persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone).AnyTimes()
+	rebuiltMutableState.EXPECT().GetLastFirstEventID().Return(int64(9)).AnyTimes()
+	rebuiltMutableState.EXPECT().GetNextEventID().Return(int64(10)).AnyTimes()
+	rebuiltMutableState.EXPECT().GetPreviousStartedEventID().Return(common.EmptyEventID).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewHistoryEvent(gomock.Any()).Times(1)
+	s.mockEngine.EXPECT().NotifyNewTransferTasks(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewTimerTasks(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewCrossClusterTasks(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewReplicationTasks(gomock.Any()).AnyTimes()
+
+	report := s.repairer.repair(constants.TestDomainID, s.workflowExecution(), errors.New("checksum mismatch"))
+	s.Empty(report.Error)
+	s.Len(report.Diffs, 1)
+	s.True(report.Repaired)
+}
+
+func (s *checksumRepairerSuite) TestRepair_Deduplicated() {
+	key := definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID)
+	s.repairer.inProgress[key] = struct{}{}
+
+	// no expectations are set, the repair of a workflow already in progress is skipped
+	s.repairer.Repair(constants.TestDomainID, s.workflowExecution(), errors.New("checksum mismatch"))
+	s.Len(s.repairer.inProgress, 1)
+}
+
+func (s *checksumRepairerSuite) TestDiffMutableStateChecksumPayload() {
+	before := &checksumgen.MutableStateChecksumPayload{
+		NextEventID:        common.Int64Ptr(10),
+		StickyTaskListName: common.StringPtr("sticky"),
+		PendingTimerStartedIDs: []int64{
+			5, 6,
+		},
+		PendingSignals: []*checksumgen.SignalChecksumInfo{},
+	}
+	after := &checksumgen.MutableStateChecksumPayload{
+		NextEventID: common.Int64Ptr(12),
+		PendingTimerStartedIDs: []int64{
+			6, 7,
+		},
+		PendingSignals: []*checksumgen.SignalChecksumInfo{},
+	}
+
+	s.Equal([]watchdog.ChecksumFieldDiff{
+		{Field: "NextEventID", Before: "10", After: "12"},
+		{Field: "PendingTimerStartedIDs", Before: "[5]", After: "[7]"},
+	}, diffMutableStateChecksumPayload(before, after))
+	s.Empty(diffMutableStateChecksumPayload(before, before))
+}
+
+func (s *checksumRepairerSuite) workflowExecution() types.WorkflowExecution {
+	return types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+}
+
+func (s *checksumRepairerSuite) newActivityInfos(taskList string) map[int64]*persistence.ActivityInfo {
+	return map[int64]*persistence.ActivityInfo{
+		6: {ScheduleID: 6, ActivityID: "activity", TaskList: taskList, Version: constants.TestVersion},
+	}
+}
+
+func (s *checksumRepairerSuite) prepareLoadedMutableState(
+	activityInfos map[int64]*persistence.ActivityInfo,
+) *MockMutableState {
+	mutableState := newChecksumTestMutableState(s.controller, activityInfos)
+	mutableState.EXPECT().StartTransaction(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	mutableState.EXPECT().GetUpdateCondition().Return(int64(10)).AnyTimes()
+
+	wfContext, release, err := s.repairer.executionCache.GetOrCreateWorkflowExecutionForBackground(
+		constants.TestDomainID,
+		s.workflowExecution(),
+	)
+	s.NoError(err)
+	wfContext.(*contextImpl).mutableState = mutableState
+	release(nil)
+	return mutableState
+}
+
+func (s *checksumRepairerSuite) prepareRebuiltMutableState(
+	activityInfos map[int64]*persistence.ActivityInfo,
+) *MockMutableState {
+	mutableState := newChecksumTestMutableState(s.controller, activityInfos)
+	mutableState.EXPECT().SetVersionHistories(gomock.Any()).Return(nil).Times(1)
+	mutableState.EXPECT().SetUpdateCondition(int64(10)).Times(1)
+
+	s.mockStateRebuilder.EXPECT().Rebuild(
+		gomock.Any(),
+		gomock.Any(),
+		definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID),
+		[]byte("branch token"),
+		int64(9),
+		constants.TestVersion,
+		definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID),
+		[]byte("branch token"),
+		gomock.Any(),
+	).Return(mutableState, int64(100), nil).Times(1)
+	return mutableState
+}
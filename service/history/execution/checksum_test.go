@@ -0,0 +1,90 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/constants"
+)
+
+func newChecksumTestMutableState(
+	controller *gomock.Controller,
+	activityInfos map[int64]*persistence.ActivityInfo,
+) *MockMutableState {
+	versionHistories := persistence.NewVersionHistories(persistence.NewVersionHistory(
+		[]byte("branch token"),
+		[]*persistence.VersionHistoryItem{persistence.NewVersionHistoryItem(9, constants.TestVersion)},
+	))
+	mutableState := NewMockMutableState(controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		DomainID:    constants.TestDomainID,
+		WorkflowID:  constants.TestWorkflowID,
+		RunID:       constants.TestRunID,
+		State:       persistence.WorkflowStateRunning,
+		NextEventID: 10,
+	}).AnyTimes()
+	mutableState.EXPECT().GetVersionHistories().Return(versionHistories).AnyTimes()
+	mutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistence.TimerInfo{
+		"timer": {TimerID: "timer", StartedID: 5, ExpiryTime: time.Unix(0, 100), Version: constants.TestVersion},
+	}).AnyTimes()
+	mutableState.EXPECT().GetPendingActivityInfos().Return(activityInfos).AnyTimes()
+	mutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{}).AnyTimes()
+	mutableState.EXPECT().GetPendingSignalExternalInfos().Return(map[int64]*persistence.SignalInfo{}).AnyTimes()
+	mutableState.EXPECT().GetPendingRequestCancelExternalInfos().Return(map[int64]*persistence.RequestCancelInfo{}).AnyTimes()
+	return mutableState
+}
+
+func TestMutableStateChecksumVersions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	activityInfos := map[int64]*persistence.ActivityInfo{
+		6: {ScheduleID: 6, ActivityID: "activity", TaskList: "tasklist", Version: constants.TestVersion},
+	}
+	mutableState := newChecksumTestMutableState(controller, activityInfos)
+
+	csumV1, err := generateMutableStateChecksum(mutableState, mutableStateChecksumPayloadV1)
+	require.NoError(t, err)
+	require.Equal(t, mutableStateChecksumPayloadV1, csumV1.Version)
+	require.NoError(t, verifyMutableStateChecksum(mutableState, csumV1))
+
+	csumV2, err := generateMutableStateChecksum(mutableState, mutableStateChecksumPayloadV2)
+	require.NoError(t, err)
+	require.Equal(t, mutableStateChecksumPayloadV2, csumV2.Version)
+	require.NotEqual(t, csumV1.Value, csumV2.Value)
+	require.NoError(t, verifyMutableStateChecksum(mutableState, csumV2))
+
+	// v1 only covers the ids of pending activities
+	activityInfos[6].TaskList = "another tasklist"
+	require.NoError(t, verifyMutableStateChecksum(mutableState, csumV1))
+	require.Error(t, verifyMutableStateChecksum(mutableState, csumV2))
+
+	_, err = generateMutableStateChecksum(mutableState, 3)
+	require.Error(t, err)
+	csumV2.Version = 3
+	require.Error(t, verifyMutableStateChecksum(mutableState, csumV2))
+}
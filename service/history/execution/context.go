@@ -165,6 +165,7 @@ type (
 		workflowExecution types.WorkflowExecution
 		shard             shard.Context
 		executionManager  persistence.ExecutionManager
+		checksumRepairer  ChecksumRepairer
 		logger            log.Logger
 		metricsClient     metrics.Client
 
@@ -184,12 +185,24 @@ func NewContext(
 	shard shard.Context,
 	executionManager persistence.ExecutionManager,
 	logger log.Logger,
+) Context {
+	return newContext(domainID, execution, shard, executionManager, nil, logger)
+}
+
+func newContext(
+	domainID string,
+	execution types.WorkflowExecution,
+	shard shard.Context,
+	executionManager persistence.ExecutionManager,
+	checksumRepairer ChecksumRepairer,
+	logger log.Logger,
 ) Context {
 	return &contextImpl{
 		domainID:          domainID,
 		workflowExecution: execution,
 		shard:             shard,
 		executionManager:  executionManager,
+		checksumRepairer:  checksumRepairer,
 		logger:            logger,
 		metricsClient:     shard.GetMetricsClient(),
 		mutex:             locks.NewMutex(),
@@ -276,6 +289,13 @@ func (c *contextImpl) LoadWorkflowExecutionWithTaskVersion(
 		)
 
 		c.mutableState.Load(response.State)
+		if mismatch := c.mutableState.GetChecksumMismatch(); mismatch != nil && c.checksumRepairer != nil {
+			executionInfo := c.mutableState.GetExecutionInfo()
+			c.checksumRepairer.Repair(c.domainID, types.WorkflowExecution{
+				WorkflowID: executionInfo.WorkflowID,
+				RunID:      executionInfo.RunID,
+			}, mismatch)
+		}
 
 		c.stats = response.State.ExecutionStats
 		c.updateCondition = response.State.ExecutionInfo.NextEventID
@@ -99,6 +99,8 @@ func (b *HistoryBuilder) AddWorkflowExecutionStartedEvent(startRequest *types.Hi
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
 		JitterStartSeconds:                  request.JitterStartSeconds,
+		IsolationGroup:                      startRequest.IsolationGroup,
+		Priority:                            request.Priority,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -222,6 +224,7 @@ func (b *HistoryBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 		DecisionTaskCompletedEventID:  decisionCompletedEventID,
 		RetryPolicy:                   attributes.RetryPolicy,
 		Domain:                        domain,
+		Priority:                      attributes.Priority,
 	}
 
 	return b.addEventToHistory(event)
@@ -660,6 +663,64 @@ func (b *HistoryBuilder) AddWorkflowExecutionSignaledEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionPausedEvent adds WorkflowExecutionPaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionPausedEvent(reason string, identity string) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionPaused)
+	event.WorkflowExecutionPausedEventAttributes = &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   reason,
+		Identity: identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUnpausedEvent adds WorkflowExecutionUnpaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUnpausedEvent(reason string, identity string) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUnpaused)
+	event.WorkflowExecutionUnpausedEventAttributes = &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   reason,
+		Identity: identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateRequestedEvent adds WorkflowExecutionUpdateRequested event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateRequestedEvent(
+	request *types.UpdateWorkflowExecutionRequest,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateRequested)
+	event.WorkflowExecutionUpdateRequestedEventAttributes = &types.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   request.GetUpdateID(),
+		UpdateName: request.GetUpdateName(),
+		Input:      request.Input,
+		Identity:   request.GetIdentity(),
+		RequestID:  request.GetRequestID(),
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent adds WorkflowExecutionUpdateCompleted event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	requestedEventID int64,
+	updateID string,
+	result []byte,
+	failureMessage *string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateCompleted)
+	event.WorkflowExecutionUpdateCompletedEventAttributes = &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     updateID,
+		RequestedEventID:             requestedEventID,
+		DecisionTaskCompletedEventID: decisionCompletedEventID,
+		Result:                       result,
+		FailureMessage:               failureMessage,
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddStartChildWorkflowExecutionInitiatedEvent adds ChildWorkflowExecutionInitiated event to history
 func (b *HistoryBuilder) AddStartChildWorkflowExecutionInitiatedEvent(
 	decisionCompletedEventID int64,
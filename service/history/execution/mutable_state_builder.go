@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/rand"
 	"time"
 
@@ -147,6 +148,9 @@ type (
 		// a transaction is in progress, this value will be
 		// wrong. This exist primarily for visibility via CLI
 		checksum checksum.Checksum
+		// set by Load() when the loaded mutable state
+		// fails checksum verification
+		checksumMismatch error
 
 		taskGenerator       MutableStateTaskGenerator
 		decisionTaskManager mutableStateDecisionTaskManager
@@ -333,6 +337,7 @@ func (e *mutableStateBuilder) Load(
 	// TODO: remove this after all 2DC workflows complete
 	e.replicationState = state.ReplicationState
 	e.checksum = state.Checksum
+	e.checksumMismatch = nil
 
 	e.fillForBackwardsCompatibility()
 
@@ -343,9 +348,9 @@ func (e *mutableStateBuilder) Load(
 			e.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumInvalidated)
 		case e.shouldVerifyChecksum():
 			if err := verifyMutableStateChecksum(e, state.Checksum); err != nil {
-				// we ignore checksum verification errors for now until this
-				// feature is tested and/or we have mechanisms in place to deal
-				// with these types of errors
+				// the mismatch is only recorded here, the workflow context
+				// decides whether to rebuild the mutable state from history
+				e.checksumMismatch = err
 				e.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumMismatch)
 				e.logError("mutable state checksum mismatch", tag.Error(err))
 			}
@@ -704,6 +709,11 @@ func (e *mutableStateBuilder) assignEventIDToBufferedEvents() {
 				ci.StartedID = eventID
 				e.updateChildExecutionInfos[ci.InitiatedID] = ci
 			}
+		case types.EventTypeWorkflowExecutionUpdateRequested:
+			updateID := event.WorkflowExecutionUpdateRequestedEventAttributes.GetUpdateID()
+			if _, ok := e.executionInfo.PendingUpdateIDs[updateID]; ok {
+				e.executionInfo.PendingUpdateIDs[updateID] = eventID
+			}
 		case types.EventTypeActivityTaskCompleted:
 			attributes := event.ActivityTaskCompletedEventAttributes
 			if startedID, ok := scheduledIDToStartedID[attributes.GetScheduledEventID()]; ok {
@@ -902,7 +912,8 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeMarkerRecorded,
 		types.EventTypeStartChildWorkflowExecutionInitiated,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated,
-		types.EventTypeUpsertWorkflowSearchAttributes:
+		types.EventTypeUpsertWorkflowSearchAttributes,
+		types.EventTypeWorkflowExecutionUpdateCompleted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -1494,6 +1505,19 @@ func (e *mutableStateBuilder) GetPendingSignalExternalInfos() map[int64]*persist
 	return e.pendingSignalInfoIDs
 }
 
+// GetPendingUpdateRequestedEventID returns the WorkflowExecutionUpdateRequested event ID of a pending update
+func (e *mutableStateBuilder) GetPendingUpdateRequestedEventID(updateID string) (int64, bool) {
+	eventID, ok := e.executionInfo.PendingUpdateIDs[updateID]
+	return eventID, ok
+}
+
+// GetCompletedUpdateBatchID returns the first event ID of the batch the
+// WorkflowExecutionUpdateCompleted event of a completed update belongs to
+func (e *mutableStateBuilder) GetCompletedUpdateBatchID(updateID string) (int64, bool) {
+	batchID, ok := e.executionInfo.CompletedUpdateIDs[updateID]
+	return batchID, ok
+}
+
 func (e *mutableStateBuilder) HasProcessedOrPendingDecision() bool {
 	return e.decisionTaskManager.HasProcessedOrPendingDecision()
 }
@@ -1626,6 +1650,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes,
 	firstRunID string,
 	firstScheduledTime time.Time,
+	isolationGroup string,
+	priority int32,
 ) (*types.HistoryEvent, error) {
 
 	previousExecutionInfo := previousExecutionState.GetExecutionInfo()
@@ -1663,6 +1689,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
 		JitterStartSeconds:                  attributes.JitterStartSeconds,
+		// the new run keeps the priority the workflow was started with
+		Priority: priority,
 	}
 
 	req := &types.HistoryStartWorkflowExecutionRequest{
@@ -1674,6 +1702,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		ContinuedFailureDetails:         attributes.FailureDetails,
 		ContinueAsNewInitiator:          attributes.Initiator,
 		FirstDecisionTaskBackoffSeconds: attributes.BackoffStartIntervalInSeconds,
+		// the new run stays in the isolation group the workflow was started in
+		IsolationGroup: isolationGroup,
 	}
 
 	// if ContinueAsNew as Cron or decider, recalculate the expiration timestamp and set attempts to 0
@@ -2182,7 +2212,7 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(
 		return nil, nil, nil, false, false, err
 	}
 	activityStartedScope := e.metricsClient.Scope(metrics.HistoryRecordActivityTaskStartedScope)
-	if e.config.EnableActivityLocalDispatchByDomain(e.domainEntry.GetInfo().Name) && attributes.RequestLocalDispatch {
+	if e.config.EnableActivityLocalDispatchByDomain(e.domainEntry.GetInfo().Name) && attributes.RequestLocalDispatch && !e.executionInfo.Paused {
 		activityStartedScope.IncCounter(metrics.CadenceRequests)
 		return event, ai, &types.ActivityLocalDispatchInfo{ActivityID: ai.ActivityID}, false, false, nil
 	}
@@ -2207,6 +2237,10 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 	scheduledEvent *types.HistoryEvent,
 	ai *persistence.ActivityInfo,
 ) bool {
+	if e.executionInfo.Paused {
+		// the transfer task is dropped while paused and regenerated when the workflow is unpaused
+		return false
+	}
 	taggedScope := e.metricsClient.Scope(metrics.HistoryScheduleDecisionTaskScope).Tagged(
 		metrics.DomainTag(e.domainEntry.GetInfo().Name),
 		metrics.WorkflowTypeTag(e.GetWorkflowType().Name),
@@ -2222,6 +2256,8 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 		TaskList:                      &types.TaskList{Name: ai.TaskList},
 		ScheduleID:                    scheduledEvent.ID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(ai.ScheduleToStartTimeout),
+		IsolationGroup:                GetTaskIsolationGroup(ctx, e.config, e),
+		Priority:                      scheduledEvent.GetActivityTaskScheduledEventAttributes().GetPriority(),
 		ActivityTaskDispatchInfo: &types.ActivityTaskDispatchInfo{
 			ScheduledEvent:                  scheduledEvent,
 			StartedTimestamp:                common.Int64Ptr(e.timeSource.Now().UnixNano()),
@@ -3333,6 +3369,142 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	// the paused flag is derived from history, so that it is replicated
+	// to the standby clusters and survives a failover or a rebuild
+	e.executionInfo.Paused = true
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = false
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateRequestedEvent(
+	request *types.UpdateWorkflowExecutionRequest,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateRequested
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateRequestedEvent(request)
+	if err := e.ReplicateWorkflowExecutionUpdateRequestedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateRequestedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	// event ID is still common.BufferedEventID if the event is buffered,
+	// it will be updated once the buffered events are flushed
+	updateID := event.WorkflowExecutionUpdateRequestedEventAttributes.GetUpdateID()
+	if e.executionInfo.PendingUpdateIDs == nil {
+		e.executionInfo.PendingUpdateIDs = make(map[string]int64)
+	}
+	e.executionInfo.PendingUpdateIDs[updateID] = event.ID
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	updateID string,
+	result []byte,
+	failureMessage *string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateCompleted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	requestedEventID, ok := e.executionInfo.PendingUpdateIDs[updateID]
+	if !ok {
+		requestedEventID = common.EmptyEventID
+	}
+	event := e.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, requestedEventID, updateID, result, failureMessage)
+	if err := e.ReplicateWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateCompletedEvent(
+	firstEventID int64,
+	event *types.HistoryEvent,
+) error {
+
+	// the completed update IDs are kept, so that an update retried by
+	// its caller is answered from history instead of being applied twice
+	updateID := event.WorkflowExecutionUpdateCompletedEventAttributes.GetUpdateID()
+	delete(e.executionInfo.PendingUpdateIDs, updateID)
+	if e.executionInfo.CompletedUpdateIDs == nil {
+		e.executionInfo.CompletedUpdateIDs = make(map[string]int64)
+	}
+	e.executionInfo.CompletedUpdateIDs[updateID] = firstEventID
+
+	// only the most recent updates are deduplicated, the IDs of the oldest ones are dropped
+	// so that the execution info does not grow with every update of a long running workflow
+	maxCompletedUpdates := e.config.MaximumCompletedUpdatesPerExecution(e.GetDomainEntry().GetInfo().Name)
+	for len(e.executionInfo.CompletedUpdateIDs) > maxCompletedUpdates {
+		oldestUpdateID := ""
+		oldestBatchID := int64(math.MaxInt64)
+		for completedUpdateID, batchID := range e.executionInfo.CompletedUpdateIDs {
+			if batchID < oldestBatchID || (batchID == oldestBatchID && completedUpdateID < oldestUpdateID) {
+				oldestUpdateID = completedUpdateID
+				oldestBatchID = batchID
+			}
+		}
+		delete(e.executionInfo.CompletedUpdateIDs, oldestUpdateID)
+	}
+	return nil
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	ctx context.Context,
 	firstEventID int64,
@@ -3394,6 +3566,8 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 		attributes,
 		firstRunID,
 		firstScheduleTime,
+		currentStartEvent.GetWorkflowExecutionStartedEventAttributes().GetIsolationGroup(),
+		currentStartEvent.GetWorkflowExecutionStartedEventAttributes().GetPriority(),
 	); err != nil {
 		return nil, nil, &types.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
@@ -4698,7 +4872,7 @@ func (e *mutableStateBuilder) generateChecksum() checksum.Checksum {
 	if !e.shouldGenerateChecksum() {
 		return checksum.Checksum{}
 	}
-	csum, err := generateMutableStateChecksum(e)
+	csum, err := generateMutableStateChecksum(e, e.config.MutableStateChecksumVersion(e.domainEntry.GetInfo().Name))
 	if err != nil {
 		e.logWarn("error generating mutableState checksum", tag.Error(err))
 		return checksum.Checksum{}
@@ -4706,6 +4880,10 @@ func (e *mutableStateBuilder) generateChecksum() checksum.Checksum {
 	return csum
 }
 
+func (e *mutableStateBuilder) GetChecksumMismatch() error {
+	return e.checksumMismatch
+}
+
 func (e *mutableStateBuilder) shouldGenerateChecksum() bool {
 	if e.domainEntry == nil {
 		return false
@@ -21,6 +21,8 @@
 package execution
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -115,6 +117,18 @@ func (s *mutableStateSuite) TestErrorReturnedWhenSchedulingTooManyPendingActivit
 	assert.Equal(s.T(), "Too many pending activities", err.Error())
 }
 
+func (s *mutableStateSuite) TestTryDispatchActivityTask_Paused() {
+	s.msBuilder.executionInfo.Paused = true
+
+	// the mock matching client fails the test if the activity is dispatched
+	started := s.msBuilder.tryDispatchActivityTask(
+		context.Background(),
+		&types.HistoryEvent{ID: 5, ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{}},
+		&persistence.ActivityInfo{ScheduleID: 5, TaskList: "testTaskList"},
+	)
+	s.False(started)
+}
+
 func (s *mutableStateSuite) TestTransientDecisionCompletionFirstBatchReplicated_ReplicateDecisionCompleted() {
 	version := int64(12)
 	runID := uuid.New()
@@ -207,6 +221,8 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		types.EventTypeDecisionTaskCompleted: true,
 		types.EventTypeDecisionTaskFailed:    true,
 		types.EventTypeDecisionTaskTimedOut:  true,
+		// update completion is recorded while completing the decision task
+		types.EventTypeWorkflowExecutionUpdateCompleted: true,
 	}
 
 	// events corresponding to decisions from client will be assign event ID immediately
@@ -413,6 +429,7 @@ func (s *mutableStateSuite) TestChecksum() {
 			dbState.Checksum = csum
 			s.msBuilder.Load(dbState)
 			s.Equal(loadErrors, loadErrorsFunc())
+			s.NoError(s.msBuilder.GetChecksumMismatch())
 
 			// generate checksum again and verify its the same
 			csum, err = tc.closeTxFunc(s.msBuilder)
@@ -425,6 +442,7 @@ func (s *mutableStateSuite) TestChecksum() {
 			s.msBuilder.Load(dbState)
 			s.Equal(loadErrors+1, loadErrorsFunc())
 			s.EqualValues(dbState.Checksum, s.msBuilder.checksum)
+			s.Error(s.msBuilder.GetChecksumMismatch())
 
 			// test checksum is invalidated
 			loadErrors = loadErrorsFunc()
@@ -811,6 +829,82 @@ func (s *mutableStateSuite) TestLoad_BackwardsCompatibility() {
 	s.Equal(constants.TestDomainID, s.msBuilder.pendingChildExecutionInfoIDs[81].DomainID)
 }
 
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionUpdateEvents() {
+	updateID := "update-1"
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionUpdateRequestedEvent(&types.HistoryEvent{
+		ID:        5,
+		EventType: types.EventTypeWorkflowExecutionUpdateRequested.Ptr(),
+		WorkflowExecutionUpdateRequestedEventAttributes: &types.WorkflowExecutionUpdateRequestedEventAttributes{
+			UpdateID: updateID,
+		},
+	}))
+	eventID, ok := s.msBuilder.GetPendingUpdateRequestedEventID(updateID)
+	s.True(ok)
+	s.Equal(int64(5), eventID)
+	_, ok = s.msBuilder.GetCompletedUpdateBatchID(updateID)
+	s.False(ok)
+
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(8, &types.HistoryEvent{
+		ID:        9,
+		EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+		WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+			UpdateID: updateID,
+		},
+	}))
+	_, ok = s.msBuilder.GetPendingUpdateRequestedEventID(updateID)
+	s.False(ok)
+	batchID, ok := s.msBuilder.GetCompletedUpdateBatchID(updateID)
+	s.True(ok)
+	s.Equal(int64(8), batchID)
+	// update IDs are part of the persisted execution info
+	s.Equal(map[string]int64{updateID: 8}, s.msBuilder.GetExecutionInfo().CompletedUpdateIDs)
+	s.Empty(s.msBuilder.GetExecutionInfo().PendingUpdateIDs)
+}
+
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionUpdateCompletedEvent_EvictOldest() {
+	s.msBuilder.config.MaximumCompletedUpdatesPerExecution = func(domain string) int { return 2 }
+	for i, batchID := range []int64{10, 5, 20} {
+		s.NoError(s.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(batchID, &types.HistoryEvent{
+			ID:        batchID + 1,
+			EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+			WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+				UpdateID: fmt.Sprintf("update-%v", i),
+			},
+		}))
+	}
+	// update-1 has the oldest batch, so it is dropped first
+	s.Equal(map[string]int64{"update-0": 10, "update-2": 20}, s.msBuilder.GetExecutionInfo().CompletedUpdateIDs)
+	_, ok := s.msBuilder.GetCompletedUpdateBatchID("update-1")
+	s.False(ok)
+
+	s.msBuilder.config.MaximumCompletedUpdatesPerExecution = func(domain string) int { return 1 }
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(30, &types.HistoryEvent{
+		ID:        31,
+		EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+		WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+			UpdateID: "update-3",
+		},
+	}))
+	s.Equal(map[string]int64{"update-3": 30}, s.msBuilder.GetExecutionInfo().CompletedUpdateIDs)
+}
+
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionPauseEvents() {
+	// the paused flag is rebuilt from the replicated events on the standby cluster
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionPausedEvent(&types.HistoryEvent{
+		ID:                                     5,
+		EventType:                              types.EventTypeWorkflowExecutionPaused.Ptr(),
+		WorkflowExecutionPausedEventAttributes: &types.WorkflowExecutionPausedEventAttributes{},
+	}))
+	s.True(s.msBuilder.GetExecutionInfo().Paused)
+
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionUnpausedEvent(&types.HistoryEvent{
+		ID:                                       6,
+		EventType:                                types.EventTypeWorkflowExecutionUnpaused.Ptr(),
+		WorkflowExecutionUnpausedEventAttributes: &types.WorkflowExecutionUnpausedEventAttributes{},
+	}))
+	s.False(s.msBuilder.GetExecutionInfo().Paused)
+}
+
 func (s *mutableStateSuite) TestUpdateCurrentVersion_WorkflowOpen() {
 	mutableState := s.buildWorkflowMutableState()
 
@@ -22,12 +22,15 @@
 package execution
 
 import (
+	"context"
 	"encoding/json"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
 )
 
 type (
@@ -358,6 +361,9 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		DecisionOriginalScheduledTimestamp: sourceInfo.DecisionOriginalScheduledTimestamp,
 		CancelRequested:                    sourceInfo.CancelRequested,
 		CancelRequestID:                    sourceInfo.CancelRequestID,
+		Paused:                             sourceInfo.Paused,
+		PendingUpdateIDs:                   copyUpdateIDs(sourceInfo.PendingUpdateIDs),
+		CompletedUpdateIDs:                 copyUpdateIDs(sourceInfo.CompletedUpdateIDs),
 		CronSchedule:                       sourceInfo.CronSchedule,
 		ClientLibraryVersion:               sourceInfo.ClientLibraryVersion,
 		ClientFeatureVersion:               sourceInfo.ClientFeatureVersion,
@@ -378,6 +384,17 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 	}
 }
 
+func copyUpdateIDs(updateIDs map[string]int64) map[string]int64 {
+	if updateIDs == nil {
+		return nil
+	}
+	result := make(map[string]int64, len(updateIDs))
+	for updateID, eventID := range updateIDs {
+		result[updateID] = eventID
+	}
+	return result
+}
+
 // CopyActivityInfo copies ActivityInfo
 func CopyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.ActivityInfo {
 	details := make([]byte, len(sourceInfo.Details))
@@ -551,3 +568,66 @@ func GetChildExecutionDomainEntry(
 
 	return parentDomainEntry, nil
 }
+
+// GetTaskIsolationGroup gets the isolation group to attach to the tasks of the workflow
+// NOTE: task isolation is best effort, an empty group is returned when isolation
+// is disabled for the domain or the workflow start event can't be loaded
+func GetTaskIsolationGroup(
+	ctx context.Context,
+	config *config.Config,
+	mutableState MutableState,
+) string {
+	if !config.EnableTaskIsolationByDomain(mutableState.GetDomainEntry().GetInfo().Name) {
+		return ""
+	}
+
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return ""
+	}
+	return startEvent.GetWorkflowExecutionStartedEventAttributes().GetIsolationGroup()
+}
+
+// GetTaskFairnessKey gets the fairness key to attach to the tasks of the workflow from its
+// start header, an empty key is returned when the workflow start event can't be loaded
+func GetTaskFairnessKey(
+	ctx context.Context,
+	mutableState MutableState,
+) string {
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return ""
+	}
+	attributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+	if attributes == nil || attributes.Header == nil {
+		return ""
+	}
+	return string(attributes.Header.Fields[common.TaskFairnessKeyHeaderName])
+}
+
+// GetDecisionTaskPriority gets the priority to attach to the decision tasks of the workflow,
+// the default priority is returned when the workflow start event can't be loaded
+func GetDecisionTaskPriority(
+	ctx context.Context,
+	mutableState MutableState,
+) int32 {
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return 0
+	}
+	return startEvent.GetWorkflowExecutionStartedEventAttributes().GetPriority()
+}
+
+// GetActivityTaskPriority gets the priority to attach to the task of an activity,
+// the default priority is returned when the activity scheduled event can't be loaded
+func GetActivityTaskPriority(
+	ctx context.Context,
+	mutableState MutableState,
+	scheduleID int64,
+) int32 {
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
+	if err != nil {
+		return 0
+	}
+	return scheduledEvent.GetActivityTaskScheduledEventAttributes().GetPriority()
+}
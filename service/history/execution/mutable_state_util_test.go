@@ -21,9 +21,11 @@
 package execution
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/uber/cadence/common"
@@ -148,3 +150,26 @@ func TestFindAutoResetPoint(t *testing.T) {
 	})
 	assert.Equal(t, pt, pt5)
 }
+
+func TestGetTaskFairnessKey(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mutableState := NewMockMutableState(controller)
+	mutableState.EXPECT().GetStartEvent(gomock.Any()).Return(&types.HistoryEvent{
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+			Header: &types.Header{
+				Fields: map[string][]byte{common.TaskFairnessKeyHeaderName: []byte("tenant-a")},
+			},
+		},
+	}, nil)
+	assert.Equal(t, "tenant-a", GetTaskFairnessKey(context.Background(), mutableState))
+
+	mutableState.EXPECT().GetStartEvent(gomock.Any()).Return(&types.HistoryEvent{
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+	}, nil)
+	assert.Empty(t, GetTaskFairnessKey(context.Background(), mutableState))
+
+	mutableState.EXPECT().GetStartEvent(gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	assert.Empty(t, GetTaskFairnessKey(context.Background(), mutableState))
+}
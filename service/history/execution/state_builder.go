@@ -415,6 +415,35 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowExecutionPaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionPausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUnpaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionUnpausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUpdateRequested:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateRequestedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUpdateCompleted:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateCompletedEvent(
+				firstEvent.ID,
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCancelRequested:
 			if err := b.mutableState.ReplicateWorkflowExecutionCancelRequestedEvent(
 				event,
@@ -103,6 +103,11 @@ func (g grpcHandler) GetReplicationMessages(ctx context.Context, request *histor
 	return proto.FromHistoryGetReplicationMessagesResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) ListWorkflowTasks(ctx context.Context, request *historyv1.ListWorkflowTasksRequest) (*historyv1.ListWorkflowTasksResponse, error) {
+	response, err := g.h.ListWorkflowTasks(ctx, proto.ToHistoryListWorkflowTasksRequest(request))
+	return proto.FromHistoryListWorkflowTasksResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) MergeDLQMessages(ctx context.Context, request *historyv1.MergeDLQMessagesRequest) (*historyv1.MergeDLQMessagesResponse, error) {
 	response, err := g.h.MergeDLQMessages(ctx, proto.ToHistoryMergeDLQMessagesRequest(request))
 	return proto.FromHistoryMergeDLQMessagesResponse(response), proto.FromError(err)
@@ -173,6 +178,11 @@ func (g grpcHandler) RemoveTask(ctx context.Context, request *historyv1.RemoveTa
 	return &historyv1.RemoveTaskResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) RetryTask(ctx context.Context, request *historyv1.RetryTaskRequest) (*historyv1.RetryTaskResponse, error) {
+	err := g.h.RetryTask(ctx, proto.ToHistoryRetryTaskRequest(request))
+	return &historyv1.RetryTaskResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) ReplicateEventsV2(ctx context.Context, request *historyv1.ReplicateEventsV2Request) (*historyv1.ReplicateEventsV2Response, error) {
 	err := g.h.ReplicateEventsV2(ctx, proto.ToHistoryReplicateEventsV2Request(request))
 	return &historyv1.ReplicateEventsV2Response{}, proto.FromError(err)
@@ -258,7 +268,22 @@ func (g grpcHandler) SyncShardStatus(ctx context.Context, request *historyv1.Syn
 	return &historyv1.SyncShardStatusResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) PauseWorkflowExecution(ctx context.Context, request *historyv1.PauseWorkflowExecutionRequest) (*historyv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(ctx, proto.ToHistoryPauseWorkflowExecutionRequest(request))
+	return &historyv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyv1.UnpauseWorkflowExecutionRequest) (*historyv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(ctx, proto.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return &historyv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) TerminateWorkflowExecution(ctx context.Context, request *historyv1.TerminateWorkflowExecutionRequest) (*historyv1.TerminateWorkflowExecutionResponse, error) {
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g grpcHandler) UpdateWorkflowExecution(ctx context.Context, request *historyv1.UpdateWorkflowExecutionRequest) (*historyv1.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, proto.ToHistoryUpdateWorkflowExecutionRequest(request))
+	return proto.FromHistoryUpdateWorkflowExecutionResponse(response), proto.FromError(err)
+}
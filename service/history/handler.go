@@ -78,8 +78,10 @@ type (
 		GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 		GetMutableState(context.Context, *types.GetMutableStateRequest) (*types.GetMutableStateResponse, error)
 		GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
+		ListWorkflowTasks(context.Context, *types.HistoryListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error)
 		MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 		NotifyFailoverMarkers(context.Context, *types.NotifyFailoverMarkersRequest) error
+		PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest) error
 		PollMutableState(context.Context, *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error)
 		PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest) error
 		QueryWorkflow(context.Context, *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
@@ -97,6 +99,7 @@ type (
 		ResetQueue(context.Context, *types.ResetQueueRequest) error
 		ResetStickyTaskList(context.Context, *types.HistoryResetStickyTaskListRequest) (*types.HistoryResetStickyTaskListResponse, error)
 		ResetWorkflowExecution(context.Context, *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
+		RetryTask(context.Context, *types.RetryTaskRequest) error
 		RespondActivityTaskCanceled(context.Context, *types.HistoryRespondActivityTaskCanceledRequest) error
 		RespondActivityTaskCompleted(context.Context, *types.HistoryRespondActivityTaskCompletedRequest) error
 		RespondActivityTaskFailed(context.Context, *types.HistoryRespondActivityTaskFailedRequest) error
@@ -110,6 +113,8 @@ type (
 		SyncActivity(context.Context, *types.SyncActivityRequest) error
 		SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 		TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.HistoryUnpauseWorkflowExecutionRequest) error
+		UpdateWorkflowExecution(context.Context, *types.HistoryUpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
 		GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
 	}
 
@@ -886,6 +891,81 @@ func (h *handlerImpl) DescribeQueue(
 	return resp, nil
 }
 
+// ListWorkflowTasks lists the outstanding queue tasks of a workflow
+func (h *handlerImpl) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.HistoryListWorkflowTasksRequest,
+) (resp *types.ListWorkflowTasksResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryListWorkflowTasksScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowID()
+	if workflowID == "" {
+		return nil, h.error(errWorkflowIDNotSet, scope, domainID, workflowID)
+	}
+
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID)
+	}
+
+	resp, err = engine.ListWorkflowTasks(ctx, domainID, *execution)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
+// RetryTask redispatches a task waiting in the redispatcher backoff
+func (h *handlerImpl) RetryTask(
+	ctx context.Context,
+	request *types.RetryTaskRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRetryTaskScope)
+	defer sw.Stop()
+
+	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	switch taskType := common.TaskType(request.GetType()); taskType {
+	case common.TaskTypeTransfer:
+		err = engine.RetryTransferTask(ctx, request.GetClusterName(), request.GetTaskID())
+	case common.TaskTypeTimer:
+		err = engine.RetryTimerTask(ctx, request.GetClusterName(), request.GetTaskID())
+	case common.TaskTypeCrossCluster:
+		err = engine.RetryCrossClusterTask(ctx, request.GetClusterName(), request.GetTaskID())
+	case common.TaskTypeReplication:
+		err = &types.BadRequestError{Message: "Replication tasks are read by remote clusters and can't be retried"}
+	default:
+		err = errInvalidTaskType
+	}
+
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
 // DescribeMutableState - returns the internal analysis of workflow execution state
 func (h *handlerImpl) DescribeMutableState(
 	ctx context.Context,
@@ -1251,6 +1331,125 @@ func (h *handlerImpl) TerminateWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowExecution records a WorkflowExecutionUpdateRequested event, delivers it to the next decision task
+// and blocks until the workflow responds with the update result.
+func (h *handlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := wrappedRequest.GetUpdateRequest().GetWorkflowExecution().GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+
+	return resp, nil
+}
+
+// PauseWorkflowExecution pauses a running workflow execution. While paused no new decision
+// or activity tasks are dispatched and timers are deferred, signals are still recorded.
+func (h *handlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryPauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := wrappedRequest.GetPauseRequest().GetWorkflowExecution().GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution and regenerates its pending tasks.
+func (h *handlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := wrappedRequest.GetUnpauseRequest().GetWorkflowExecution().GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *handlerImpl) ResetWorkflowExecution(
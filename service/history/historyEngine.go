@@ -27,6 +27,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -37,6 +38,7 @@ import (
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -75,6 +77,8 @@ const (
 	queryFirstDecisionTaskCheckInterval   = 200 * time.Millisecond
 	contextLockTimeout                    = 500 * time.Millisecond
 	longPollCompletionBuffer              = 50 * time.Millisecond
+	archivedHistoryBranchDeleteTimeout    = 10 * time.Second
+	updateCompletionCheckInterval         = time.Second
 
 	// TerminateIfRunningReason reason for terminateIfRunning
 	TerminateIfRunningReason = "TerminateIfRunning Policy"
@@ -771,6 +775,11 @@ func (e *historyEngineImpl) startWorkflowHelper(
 		}
 
 		prevRunID = t.RunID
+		if shouldUseExistingWorkflow(startRequest, t.State) {
+			return &types.StartWorkflowExecutionResponse{
+				RunID: prevRunID,
+			}, nil
+		}
 		if shouldTerminateAndStart(startRequest, t.State) {
 			runningWFCtx, err := workflow.LoadOnce(ctx, e.executionCache, domainID, workflowID, prevRunID)
 			if err != nil {
@@ -830,7 +839,17 @@ func shouldTerminateAndStart(
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
 	state int,
 ) bool {
-	return startRequest.StartRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning &&
+	request := startRequest.StartRequest
+	return (request.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning ||
+		request.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyTerminateExisting) &&
+		(state == persistence.WorkflowStateRunning || state == persistence.WorkflowStateCreated)
+}
+
+func shouldUseExistingWorkflow(
+	startRequest *types.HistoryStartWorkflowExecutionRequest,
+	state int,
+) bool {
+	return startRequest.StartRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyUseExisting &&
 		(state == persistence.WorkflowStateRunning || state == persistence.WorkflowStateCreated)
 }
 
@@ -880,7 +899,7 @@ UpdateWorkflowLoop:
 		}
 
 		if signalWithStartRequest != nil {
-			startRequest, err = getStartRequest(domainID, signalWithStartRequest.SignalWithStartRequest)
+			startRequest, err = getStartRequest(domainID, signalWithStartRequest)
 			if err != nil {
 				return nil, err
 			}
@@ -1616,6 +1635,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			IsCron:           len(executionInfo.CronSchedule) > 0,
 			UpdateTime:       common.Int64Ptr(executionInfo.LastUpdatedTimestamp.UnixNano()),
 			SearchAttributes: &types.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
+			Paused:           executionInfo.Paused,
 		},
 	}
 
@@ -1855,6 +1875,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &types.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if mutableState.GetExecutionInfo().Paused {
+				// the activity task is regenerated when the workflow is unpaused
+				return workflow.ErrPaused
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -2458,6 +2483,261 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 		})
 }
 
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.getActiveDomainByID(request.GetDomainUUID())
+	if err != nil {
+		return nil, err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return nil, errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+	updateRequest := request.GetUpdateRequest()
+	updateID := updateRequest.GetUpdateID()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: updateRequest.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      updateRequest.GetWorkflowExecution().GetRunID(),
+	}
+
+	// the waiter is registered while holding the workflow lock, so that the update
+	// result cannot be delivered by a decision task before the caller starts waiting
+	var queryRegistry query.Registry
+	var termCh <-chan struct{}
+	var completedUpdate *updateCompletedEventLocation
+	var runID string
+	defer func() {
+		if queryRegistry != nil {
+			queryRegistry.RemoveUpdate(updateID)
+		}
+	}()
+
+	err = workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if queryRegistry != nil {
+				// action is retried on conflict, drop the waiter registered by the previous attempt
+				queryRegistry.RemoveUpdate(updateID)
+				queryRegistry = nil
+			}
+			completedUpdate = nil
+			runID = mutableState.GetExecutionInfo().RunID
+
+			// an update with the same ID is already completed, answer it from history
+			if batchID, ok := mutableState.GetCompletedUpdateBatchID(updateID); ok {
+				branchToken, err := mutableState.GetCurrentBranchToken()
+				if err != nil {
+					return nil, err
+				}
+				completedUpdate = &updateCompletedEventLocation{
+					branchToken: branchToken,
+					batchID:     batchID,
+					nextEventID: mutableState.GetNextEventID(),
+				}
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			// If history is corrupted, update will be rejected
+			if corrupted, err := e.checkForHistoryCorruptions(ctx, mutableState); err != nil {
+				return nil, err
+			} else if corrupted {
+				return nil, &types.EntityNotExistsError{Message: "Workflow execution corrupted."}
+			}
+
+			queryRegistry = mutableState.GetQueryRegistry()
+			termCh = queryRegistry.BufferUpdate(updateID)
+
+			// an update with the same ID is already delivered to the workflow, wait for its result
+			if _, ok := mutableState.GetPendingUpdateRequestedEventID(updateID); ok {
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+
+			createDecisionTask := true
+			// Do not create decision task when the workflow is cron and the cron has not been started yet
+			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
+				createDecisionTask = false
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionUpdateRequestedEvent(updateRequest); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to request workflow execution update."}
+			}
+
+			return &workflow.UpdateAction{
+				Noop:           false,
+				CreateDecision: createDecisionTask,
+			}, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if completedUpdate != nil {
+		return e.getCompletedUpdateResponse(ctx, domainEntry, updateID, completedUpdate)
+	}
+
+	// the waiter only lives in the cached mutable state and is lost when the mutable state is reloaded,
+	// in which case the update is completed without notifying it, so the persisted completed update IDs
+	// are checked periodically as well
+	checkTicker := time.NewTicker(updateCompletionCheckInterval)
+	defer checkTicker.Stop()
+	runExecution := types.WorkflowExecution{
+		WorkflowID: workflowExecution.WorkflowID,
+		RunID:      runID,
+	}
+	for {
+		select {
+		case <-termCh:
+			return getUpdateResponseFromRegistry(queryRegistry, updateID)
+		case <-checkTicker.C:
+			completedUpdate, queryRegistry, termCh, err = e.checkUpdateCompletion(ctx, domainID, runExecution, updateID, queryRegistry, termCh)
+			if err != nil {
+				return nil, err
+			}
+			if completedUpdate != nil {
+				return e.getCompletedUpdateResponse(ctx, domainEntry, updateID, completedUpdate)
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// checkUpdateCompletion checks the persisted completed update IDs of a workflow execution, it returns
+// the location of the completion event if the update is completed. If the mutable state was reloaded
+// since the waiter was registered, the waiter is registered again on the reloaded mutable state.
+func (e *historyEngineImpl) checkUpdateCompletion(
+	ctx context.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+	updateID string,
+	queryRegistry query.Registry,
+	termCh <-chan struct{},
+) (_ *updateCompletedEventLocation, _ query.Registry, _ <-chan struct{}, retError error) {
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if batchID, ok := mutableState.GetCompletedUpdateBatchID(updateID); ok {
+		branchToken, err := mutableState.GetCurrentBranchToken()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return &updateCompletedEventLocation{
+			branchToken: branchToken,
+			batchID:     batchID,
+			nextEventID: mutableState.GetNextEventID(),
+		}, queryRegistry, termCh, nil
+	}
+	if !mutableState.IsWorkflowExecutionRunning() {
+		return nil, nil, nil, workflow.ErrAlreadyCompleted
+	}
+
+	if currentRegistry := mutableState.GetQueryRegistry(); currentRegistry != queryRegistry {
+		queryRegistry.RemoveUpdate(updateID)
+		queryRegistry = currentRegistry
+		termCh = queryRegistry.BufferUpdate(updateID)
+	}
+	return nil, queryRegistry, termCh, nil
+}
+
+func getUpdateResponseFromRegistry(
+	queryRegistry query.Registry,
+	updateID string,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	state, err := queryRegistry.GetUpdateTerminationState(updateID)
+	if err != nil {
+		return nil, err
+	}
+	switch state.TerminationType {
+	case query.TerminationTypeCompleted:
+		result := state.QueryResult
+		switch result.GetResultType() {
+		case types.QueryResultTypeAnswered:
+			return &types.UpdateWorkflowExecutionResponse{
+				Result: result.GetAnswer(),
+			}, nil
+		case types.QueryResultTypeFailed:
+			return &types.UpdateWorkflowExecutionResponse{
+				FailureMessage: common.StringPtr(result.GetErrorMessage()),
+			}, nil
+		default:
+			return nil, workflow.ErrQueryEnteredInvalidState
+		}
+	case query.TerminationTypeFailed:
+		return nil, state.Failure
+	default:
+		return nil, workflow.ErrQueryEnteredInvalidState
+	}
+}
+
+type updateCompletedEventLocation struct {
+	branchToken []byte
+	batchID     int64
+	nextEventID int64
+}
+
+// getCompletedUpdateResponse reads the WorkflowExecutionUpdateCompleted event of an update
+// from history, it is used to answer an update which is retried after its completion
+func (e *historyEngineImpl) getCompletedUpdateResponse(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	updateID string,
+	location *updateCompletedEventLocation,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	response, err := e.historyV2Mgr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: location.branchToken,
+		MinEventID:  location.batchID,
+		MaxEventID:  location.nextEventID,
+		PageSize:    1,
+		ShardID:     common.IntPtr(e.shard.GetShardID()),
+		DomainName:  domainEntry.GetInfo().Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range response.HistoryEvents {
+		if event.GetEventType() != types.EventTypeWorkflowExecutionUpdateCompleted {
+			continue
+		}
+		attributes := event.WorkflowExecutionUpdateCompletedEventAttributes
+		if attributes.GetUpdateID() != updateID {
+			continue
+		}
+		return &types.UpdateWorkflowExecutionResponse{
+			Result:         attributes.Result,
+			FailureMessage: attributes.FailureMessage,
+		}, nil
+	}
+	return nil, &types.InternalServiceError{Message: "Unable to find the completion event of the workflow execution update."}
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	signalWithStartRequest *types.HistorySignalWithStartWorkflowExecutionRequest,
@@ -2513,8 +2793,20 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				break
 			}
 
-			// workflow is running, if policy is TerminateIfRunning, terminate current run then signalWithStart
-			if sRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning {
+			// workflow is running, if conflict policy is explicitly Fail, reject the request without signaling
+			if sRequest.WorkflowIDConflictPolicy != nil && *sRequest.WorkflowIDConflictPolicy == types.WorkflowIDConflictPolicyFail {
+				msg := "Workflow execution is already running. WorkflowId: %v, RunId: %v."
+				return nil, getWorkflowAlreadyStartedError(
+					msg,
+					mutableState.GetExecutionInfo().CreateRequestID,
+					workflowExecution.GetWorkflowID(),
+					wfContext.GetExecution().GetRunID(),
+				)
+			}
+
+			// workflow is running, if policy is TerminateIfRunning or TerminateExisting, terminate current run then signalWithStart
+			if sRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning ||
+				sRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyTerminateExisting {
 				workflowExecution.RunID = uuid.New()
 				runningWFCtx := workflow.NewContext(wfContext, release, mutableState)
 				resp, errTerm := e.terminateAndStartWorkflow(
@@ -2579,7 +2871,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	}
 
 	// Start workflow and signal
-	startRequest, err := getStartRequest(domainID, sRequest)
+	startRequest, err := getStartRequest(domainID, signalWithStartRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -2727,6 +3019,103 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// PauseWorkflowExecution marks a running workflow as paused. The paused flag is persisted in mutable state
+// and checked by the transfer and timer queue processors, which defer decision and activity dispatch,
+// user timers and retry and backoff timers. Workflow and activity timeouts still fire while paused.
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      request.GetWorkflowExecution().GetRunID(),
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			if mutableState.GetExecutionInfo().Paused {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(request.GetReason(), request.GetIdentity()); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// UnpauseWorkflowExecution clears the paused flag and regenerates the decision, activity and timer tasks
+// which were dropped by the queue processors while the workflow was paused.
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(unpauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := unpauseRequest.UnpauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      request.GetWorkflowExecution().GetRunID(),
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			executionInfo := mutableState.GetExecutionInfo()
+			if !executionInfo.Paused {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			if _, err := mutableState.AddWorkflowExecutionUnpausedEvent(request.GetReason(), request.GetIdentity()); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to unpause workflow execution."}
+			}
+
+			mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetClusterMetadata(),
+				e.shard.GetDomainCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetShardID(),
+			)
+			if err := mutableStateTaskRefresher.RefreshTasks(ctx, executionInfo.StartTimestamp, mutableState); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx context.Context,
@@ -2846,6 +3235,10 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 
 	baseMutableState, err := baseContext.LoadWorkflowExecution(ctx)
 	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok && baseRunID != "" {
+			// the base run may be past retention, in which case it is reset from its archived history
+			return e.resetArchivedWorkflowExecution(ctx, domainID, request, err)
+		}
 		return nil, err
 	}
 	if ok := baseMutableState.HasProcessedOrPendingDecision(); !ok {
@@ -2962,6 +3355,209 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	}, nil
 }
 
+// resetArchivedWorkflowExecution resets a run which no longer exists in persistence. The archived history of the run
+// is restored into a new branch, the reset run is forked from it as usual and the restored branch is deleted afterwards.
+func (e *historyEngineImpl) resetArchivedWorkflowExecution(
+	ctx context.Context,
+	domainID string,
+	request *types.ResetWorkflowExecutionRequest,
+	notExistsErr error,
+) (response *types.ResetWorkflowExecutionResponse, retError error) {
+
+	workflowID := request.WorkflowExecution.GetWorkflowID()
+	baseRunID := request.WorkflowExecution.GetRunID()
+
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+	URIString := domainEntry.GetConfig().HistoryArchivalURI
+	if URIString == "" || !e.shard.GetService().GetArchivalMetadata().GetHistoryConfig().ReadEnabled() {
+		return nil, notExistsErr
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := e.shard.GetService().GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), service.History)
+	if err != nil {
+		return nil, err
+	}
+
+	// the current run can be gone as well when the whole workflow is past retention
+	var currentWorkflow execution.Workflow
+	resp, err := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		DomainName: domainEntry.GetInfo().Name,
+	})
+	switch err.(type) {
+	case nil:
+		currentContext, currentReleaseFn, err := e.executionCache.GetOrCreateWorkflowExecution(
+			ctx,
+			domainID,
+			types.WorkflowExecution{
+				WorkflowID: workflowID,
+				RunID:      resp.RunID,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { currentReleaseFn(retError) }()
+
+		currentMutableState, err := currentContext.LoadWorkflowExecution(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// dedup by requestID
+		if currentMutableState.GetExecutionInfo().CreateRequestID == request.GetRequestID() {
+			return &types.ResetWorkflowExecutionResponse{
+				RunID: resp.RunID,
+			}, nil
+		}
+		currentWorkflow = execution.NewWorkflow(
+			ctx,
+			e.shard.GetClusterMetadata(),
+			currentContext,
+			currentMutableState,
+			currentReleaseFn,
+		)
+	case *types.EntityNotExistsError:
+	default:
+		return nil, err
+	}
+
+	baseBranchToken, err := persistence.NewHistoryBranchToken(baseRunID)
+	if err != nil {
+		return nil, err
+	}
+	branchCreated := false
+	defer func() {
+		if !branchCreated {
+			return
+		}
+		// the reset run keeps the restored events it was forked from, the rest of the branch is removed,
+		// also when the restore or the reset failed. ctx may already be done at this point.
+		deleteCtx, cancel := context.WithTimeout(context.Background(), archivedHistoryBranchDeleteTimeout)
+		defer cancel()
+		if err := e.historyV2Mgr.DeleteHistoryBranch(deleteCtx, &persistence.DeleteHistoryBranchRequest{
+			BranchToken: baseBranchToken,
+			ShardID:     common.IntPtr(e.shard.GetShardID()),
+			DomainName:  domainEntry.GetInfo().Name,
+		}); err != nil {
+			e.logger.Warn("Failed to delete history branch restored from archival",
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(baseRunID),
+				tag.WorkflowDomainID(domainID),
+				tag.Error(err))
+		}
+	}()
+
+	domainName := domainEntry.GetInfo().Name
+	historySizeLimit := e.config.HistorySizeLimitError(domainName)
+	historyCountLimit := e.config.HistoryCountLimitError(domainName)
+	historySize := 0
+	baseRebuildLastEventID := request.GetDecisionFinishEventID() - 1
+	baseRebuildLastEventVersion := common.EmptyVersion
+	baseNextEventID := common.FirstEventID
+	hasDecision := false
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      baseRunID,
+		PageSize:   execution.NDCDefaultPageSize,
+	}
+	// the archived history is restored page by page, so that a run which is too large to be reset
+	// is rejected before all of its history has been downloaded and written
+	for {
+		getResp, err := historyArchiver.Get(ctx, URI, getRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, batch := range getResp.HistoryBatches {
+			if len(batch.Events) == 0 {
+				continue
+			}
+			if batch.Events[len(batch.Events)-1].ID > int64(historyCountLimit) {
+				return nil, &types.BadRequestError{
+					Message: "Cannot reset workflow, archived history exceeds the history count limit.",
+				}
+			}
+			appendResp, err := e.shard.AppendHistoryV2Events(
+				ctx,
+				&persistence.AppendHistoryNodesRequest{
+					IsNewBranch: !branchCreated,
+					Info:        persistence.BuildHistoryGarbageCleanupInfo(domainID, workflowID, baseRunID),
+					BranchToken: baseBranchToken,
+					Events:      batch.Events,
+				},
+				domainID,
+				*request.WorkflowExecution,
+			)
+			// the branch may be partially written even if the append failed
+			branchCreated = true
+			if err != nil {
+				return nil, err
+			}
+			historySize += len(appendResp.DataBlob.Data)
+			if historySize > historySizeLimit {
+				return nil, &types.BadRequestError{
+					Message: "Cannot reset workflow, archived history exceeds the history size limit.",
+				}
+			}
+			for _, event := range batch.Events {
+				if event.ID == baseRebuildLastEventID {
+					baseRebuildLastEventVersion = event.Version
+				}
+				if event.ID <= baseRebuildLastEventID && event.GetEventType() == types.EventTypeDecisionTaskScheduled {
+					hasDecision = true
+				}
+			}
+			baseNextEventID = batch.Events[len(batch.Events)-1].ID + 1
+		}
+		if getResp.NextPageToken == nil {
+			break
+		}
+		getRequest.NextPageToken = getResp.NextPageToken
+	}
+
+	if !hasDecision {
+		return nil, &types.BadRequestError{
+			Message: "Cannot reset workflow without a decision task schedule.",
+		}
+	}
+	if request.GetDecisionFinishEventID() <= common.FirstEventID ||
+		request.GetDecisionFinishEventID() > baseNextEventID {
+		return nil, &types.BadRequestError{
+			Message: "Decision finish ID must be > 1 && <= workflow next event ID.",
+		}
+	}
+
+	resetRunID := uuid.New()
+	if err := e.workflowResetter.ResetWorkflow(
+		ctx,
+		domainID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		baseNextEventID,
+		resetRunID,
+		request.GetRequestID(),
+		currentWorkflow,
+		request.GetReason(),
+		nil,
+		request.GetSkipSignalReapply(),
+	); err != nil {
+		return nil, err
+	}
+	return &types.ResetWorkflowExecutionResponse{
+		RunID: resetRunID,
+	}, nil
+}
+
 func (e *historyEngineImpl) NotifyNewHistoryEvent(
 	event *events.Notification,
 ) {
@@ -3144,6 +3740,309 @@ func (e *historyEngineImpl) serializeQueueState(
 	return fmt.Sprintf("%v", state)
 }
 
+func (e *historyEngineImpl) RetryTransferTask(
+	ctx context.Context,
+	clusterName string,
+	taskID int64,
+) error {
+	_, err := e.txProcessor.HandleAction(ctx, clusterName, queue.NewRetryTaskAction(taskID))
+	return err
+}
+
+func (e *historyEngineImpl) RetryTimerTask(
+	ctx context.Context,
+	clusterName string,
+	taskID int64,
+) error {
+	_, err := e.timerProcessor.HandleAction(ctx, clusterName, queue.NewRetryTaskAction(taskID))
+	return err
+}
+
+func (e *historyEngineImpl) RetryCrossClusterTask(
+	ctx context.Context,
+	clusterName string,
+	taskID int64,
+) error {
+	_, err := e.crossClusterProcessor.HandleAction(ctx, clusterName, queue.NewRetryTaskAction(taskID))
+	return err
+}
+
+// ListWorkflowTasks returns the persisted transfer, timer, replication and cross cluster
+// tasks of a workflow along with their states in the queue processors. At most
+// ListWorkflowTasksMaxScanSize persisted tasks are scanned per queue, the response is
+// marked as truncated when a queue has more tasks left
+func (e *historyEngineImpl) ListWorkflowTasks(
+	ctx context.Context,
+	domainUUID string,
+	execution types.WorkflowExecution,
+) (*types.ListWorkflowTasksResponse, error) {
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainUUID)
+	if err != nil {
+		return nil, err
+	}
+	// transfer and timer tasks of a domain are processed by the queue processor
+	// of its active cluster, the standby processors for other clusters
+	processingCluster := e.currentClusterName
+	if domainEntry.IsGlobalDomain() {
+		processingCluster = domainEntry.GetReplicationConfig().ActiveClusterName
+	}
+	belongsToWorkflow := func(info task.Info) bool {
+		return info.GetDomainID() == domainUUID &&
+			info.GetWorkflowID() == execution.GetWorkflowID() &&
+			(execution.GetRunID() == "" || info.GetRunID() == execution.GetRunID())
+	}
+
+	maxScanSize := e.config.ListWorkflowTasksMaxScanSize()
+	var result []*types.QueueTaskInfo
+	transferTasks, truncated, err := e.listTransferTasks(ctx, maxScanSize, belongsToWorkflow)
+	if err != nil {
+		return nil, err
+	}
+	taskInfos, err := e.getQueueTaskInfos(ctx, e.txProcessor, processingCluster, common.TaskTypeTransfer, transferTasks)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, taskInfos...)
+
+	timerTasks, timerTruncated, err := e.listTimerTasks(ctx, maxScanSize, belongsToWorkflow)
+	if err != nil {
+		return nil, err
+	}
+	truncated = truncated || timerTruncated
+	taskInfos, err = e.getQueueTaskInfos(ctx, e.timerProcessor, processingCluster, common.TaskTypeTimer, timerTasks)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, taskInfos...)
+
+	for clusterName := range e.clusterMetadata.GetEnabledClusterInfo() {
+		if clusterName == e.currentClusterName {
+			continue
+		}
+		crossClusterTasks, crossClusterTruncated, err := e.listCrossClusterTasks(ctx, clusterName, maxScanSize, belongsToWorkflow)
+		if err != nil {
+			return nil, err
+		}
+		truncated = truncated || crossClusterTruncated
+		taskInfos, err = e.getQueueTaskInfos(ctx, e.crossClusterProcessor, clusterName, common.TaskTypeCrossCluster, crossClusterTasks)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, taskInfos...)
+	}
+
+	replicationTasks, replicationTruncated, err := e.listReplicationTasks(ctx, maxScanSize, belongsToWorkflow)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ListWorkflowTasksResponse{
+		ShardID:   int32(e.shard.GetShardID()),
+		Tasks:     append(result, replicationTasks...),
+		Truncated: truncated || replicationTruncated,
+	}, nil
+}
+
+func (e *historyEngineImpl) getQueueTaskInfos(
+	ctx context.Context,
+	queueProcessor queue.Processor,
+	clusterName string,
+	taskType common.TaskType,
+	tasks []task.Info,
+) ([]*types.QueueTaskInfo, error) {
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+
+	resp, err := queueProcessor.HandleAction(ctx, clusterName, queue.NewGetTaskStatesAction(tasks))
+	if err != nil {
+		return nil, err
+	}
+
+	taskInfos := make([]*types.QueueTaskInfo, 0, len(tasks))
+	for idx, info := range tasks {
+		taskState := resp.GetTaskStatesResult.TaskStates[idx]
+		taskInfo := newQueueTaskInfo(taskType, info, clusterName, taskState.State)
+		taskInfo.Loaded = taskState.Loaded
+		taskInfo.Attempt = int32(taskState.Attempt)
+		if !taskState.RedispatchTime.IsZero() {
+			taskInfo.RedispatchTimestamp = common.Int64Ptr(taskState.RedispatchTime.UnixNano())
+		}
+		taskInfos = append(taskInfos, taskInfo)
+	}
+	return taskInfos, nil
+}
+
+func (e *historyEngineImpl) listTransferTasks(
+	ctx context.Context,
+	maxScanSize int,
+	filter func(task.Info) bool,
+) ([]task.Info, bool, error) {
+	var tasks []task.Info
+	scanned := 0
+	request := &persistence.GetTransferTasksRequest{
+		ReadLevel:    e.shard.GetTransferAckLevel(),
+		MaxReadLevel: e.shard.GetTransferMaxReadLevel(),
+		BatchSize:    e.config.TransferTaskBatchSize(),
+	}
+	for {
+		resp, err := e.executionManager.GetTransferTasks(ctx, request)
+		if err != nil {
+			return nil, false, err
+		}
+		scanned += len(resp.Tasks)
+		for _, info := range resp.Tasks {
+			if filter(info) {
+				tasks = append(tasks, info)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return tasks, false, nil
+		}
+		if scanned >= maxScanSize {
+			return tasks, true, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func (e *historyEngineImpl) listTimerTasks(
+	ctx context.Context,
+	maxScanSize int,
+	filter func(task.Info) bool,
+) ([]task.Info, bool, error) {
+	var tasks []task.Info
+	scanned := 0
+	request := &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: e.shard.GetTimerAckLevel(),
+		MaxTimestamp: time.Unix(0, math.MaxInt64),
+		BatchSize:    e.config.TimerTaskBatchSize(),
+	}
+	for {
+		resp, err := e.executionManager.GetTimerIndexTasks(ctx, request)
+		if err != nil {
+			return nil, false, err
+		}
+		scanned += len(resp.Timers)
+		for _, info := range resp.Timers {
+			if filter(info) {
+				tasks = append(tasks, info)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return tasks, false, nil
+		}
+		if scanned >= maxScanSize {
+			return tasks, true, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func (e *historyEngineImpl) listCrossClusterTasks(
+	ctx context.Context,
+	targetCluster string,
+	maxScanSize int,
+	filter func(task.Info) bool,
+) ([]task.Info, bool, error) {
+	var readLevel int64
+	for idx, state := range e.shard.GetCrossClusterProcessingQueueStates(targetCluster) {
+		if idx == 0 || state.GetAckLevel() < readLevel {
+			readLevel = state.GetAckLevel()
+		}
+	}
+
+	var tasks []task.Info
+	scanned := 0
+	request := &persistence.GetCrossClusterTasksRequest{
+		TargetCluster: targetCluster,
+		ReadLevel:     readLevel,
+		MaxReadLevel:  e.shard.GetTransferMaxReadLevel(),
+		BatchSize:     e.config.CrossClusterTaskBatchSize(),
+	}
+	for {
+		resp, err := e.executionManager.GetCrossClusterTasks(ctx, request)
+		if err != nil {
+			return nil, false, err
+		}
+		scanned += len(resp.Tasks)
+		for _, info := range resp.Tasks {
+			if filter(info) {
+				tasks = append(tasks, info)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return tasks, false, nil
+		}
+		if scanned >= maxScanSize {
+			return tasks, true, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// listReplicationTasks returns the replication tasks of a workflow, replication tasks are
+// pulled by remote clusters so a task is acked once all remote clusters have read it
+func (e *historyEngineImpl) listReplicationTasks(
+	ctx context.Context,
+	maxScanSize int,
+	filter func(task.Info) bool,
+) ([]*types.QueueTaskInfo, bool, error) {
+	readLevel := int64(math.MaxInt64)
+	for clusterName := range e.clusterMetadata.GetEnabledClusterInfo() {
+		if clusterName == e.currentClusterName {
+			continue
+		}
+		readLevel = common.MinInt64(readLevel, e.shard.GetClusterReplicationLevel(clusterName))
+	}
+	if readLevel == math.MaxInt64 {
+		// no remote cluster to replicate to
+		return nil, false, nil
+	}
+
+	var tasks []*types.QueueTaskInfo
+	scanned := 0
+	request := &persistence.GetReplicationTasksRequest{
+		ReadLevel:    readLevel,
+		MaxReadLevel: e.shard.GetTransferMaxReadLevel(),
+		BatchSize:    e.config.ReplicatorProcessorFetchTasksBatchSize(e.shard.GetShardID()),
+	}
+	for {
+		resp, err := e.executionManager.GetReplicationTasks(ctx, request)
+		if err != nil {
+			return nil, false, err
+		}
+		scanned += len(resp.Tasks)
+		for _, info := range resp.Tasks {
+			if filter(info) {
+				tasks = append(tasks, newQueueTaskInfo(common.TaskTypeReplication, info, "", types.QueueTaskStatePending))
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return tasks, false, nil
+		}
+		if scanned >= maxScanSize {
+			return tasks, true, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func newQueueTaskInfo(
+	taskType common.TaskType,
+	info task.Info,
+	clusterName string,
+	state types.QueueTaskState,
+) *types.QueueTaskInfo {
+	return &types.QueueTaskInfo{
+		Type:                common.Int32Ptr(int32(taskType)),
+		TaskType:            int32(info.GetTaskType()),
+		TaskID:              info.GetTaskID(),
+		VisibilityTimestamp: common.Int64Ptr(info.GetVisibilityTimestamp().UnixNano()),
+		ClusterName:         clusterName,
+		State:               state.Ptr(),
+	}
+}
+
 func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(
 	request *types.StartWorkflowExecutionRequest,
 	metricsScope int,
@@ -3253,9 +4152,10 @@ func getScheduleID(
 
 func getStartRequest(
 	domainID string,
-	request *types.SignalWithStartWorkflowExecutionRequest,
+	signalWithStartRequest *types.HistorySignalWithStartWorkflowExecutionRequest,
 ) (*types.HistoryStartWorkflowExecutionRequest, error) {
 
+	request := signalWithStartRequest.SignalWithStartRequest
 	req := &types.StartWorkflowExecutionRequest{
 		Domain:                              request.Domain,
 		WorkflowID:                          request.WorkflowID,
@@ -3274,9 +4174,11 @@ func getStartRequest(
 		Header:                              request.Header,
 		DelayStartSeconds:                   request.DelayStartSeconds,
 		JitterStartSeconds:                  request.JitterStartSeconds,
+		WorkflowIDConflictPolicy:            request.WorkflowIDConflictPolicy,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now())
+	startRequest.IsolationGroup = signalWithStartRequest.IsolationGroup
 
 	return startRequest, nil
 }
@@ -40,6 +40,7 @@ import (
 	hclient "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -48,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/service/history/config"
@@ -60,6 +62,7 @@ import (
 	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/task"
 	test "github.com/uber/cadence/service/history/testing"
 	"github.com/uber/cadence/service/history/workflow"
 )
@@ -5070,6 +5073,218 @@ func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
 	s.EqualError(err, "workflow execution already completed")
 }
 
+func (s *engineSuite) TestUpdateWorkflowExecution_Timeout() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	updateRequest := &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			UpdateID:          "test update ID",
+			UpdateName:        "my update name",
+			Input:             []byte("test input"),
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(ctx, updateRequest)
+	s.Nil(resp)
+	s.Equal(context.DeadlineExceeded, err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_CompletedAfterReload() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	updateID := "test update ID"
+	updateRequest := &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			UpdateID:          updateID,
+			UpdateName:        "my update name",
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// the reloaded mutable state has the update completed by a decision task, the waiter is not notified
+	completedMS := execution.CreatePersistenceMutableState(msBuilder)
+	completedMS.ExecutionInfo.DomainID = constants.TestDomainID
+	completedMS.ExecutionInfo.CompletedUpdateIDs = map[string]int64{updateID: 4}
+	completedResponse := &persistence.GetWorkflowExecutionResponse{State: completedMS}
+
+	updated := make(chan struct{})
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(mock.Arguments) {
+		close(updated)
+	}).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(completedResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return req.MinEventID == 4
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{
+				ID:        5,
+				EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+				WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+					UpdateID: updateID,
+					Result:   []byte("result"),
+				},
+			},
+		},
+	}, nil).Once()
+
+	go func() {
+		<-updated
+		wfContext, release, err := s.mockHistoryEngine.executionCache.GetOrCreateWorkflowExecutionForBackground(constants.TestDomainID, we)
+		s.NoError(err)
+		wfContext.Clear()
+		release(nil)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*updateCompletionCheckInterval)
+	defer cancel()
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(ctx, updateRequest)
+	s.NoError(err)
+	s.Equal([]byte("result"), resp.GetResult())
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_WorkflowCompleted() {
+	we := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	updateRequest := &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: we,
+			UpdateID:          "test update ID",
+			UpdateName:        "my update name",
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, *we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	_, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.EqualError(err, "workflow execution already completed")
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_AlreadyCompleted() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	updateID := "test update ID"
+	updateRequest := &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			UpdateID:          updateID,
+			UpdateName:        "my update name",
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.CompletedUpdateIDs = map[string]int64{updateID: 4}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return req.MinEventID == 4
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{
+				ID:        4,
+				EventType: types.EventTypeDecisionTaskCompleted.Ptr(),
+			},
+			{
+				ID:        5,
+				EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+				WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+					UpdateID: updateID,
+					Result:   []byte("result"),
+				},
+			},
+		},
+	}, nil).Once()
+
+	// the update is answered from history, no new event is recorded
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.NoError(err)
+	s.Equal([]byte("result"), resp.GetResult())
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything, mock.Anything)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything, mock.Anything)
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	removeRequest := &types.RemoveSignalMutableStateRequest{}
 	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
@@ -5107,6 +5322,153 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestPauseWorkflowExecution() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &workflowExecution,
+			Reason:            "testReason",
+			Identity:          "testIdentity",
+		},
+	}
+
+	ms := s.createPauseTestMutableState(workflowExecution, false)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	var appendRequest *persistence.AppendHistoryNodesRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Run(func(args mock.Arguments) {
+		appendRequest = args.Get(1).(*persistence.AppendHistoryNodesRequest)
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.NoError(err)
+	s.NotNil(updateRequest)
+	s.True(updateRequest.UpdateWorkflowMutation.ExecutionInfo.Paused)
+	// the pause is recorded in history, so that it is replicated
+	s.Len(appendRequest.Events, 1)
+	s.Equal(types.EventTypeWorkflowExecutionPaused, appendRequest.Events[0].GetEventType())
+	s.Equal("testReason", appendRequest.Events[0].WorkflowExecutionPausedEventAttributes.GetReason())
+	s.Equal("testIdentity", appendRequest.Events[0].WorkflowExecutionPausedEventAttributes.GetIdentity())
+}
+
+func (s *engineSuite) TestPauseWorkflowExecution_AlreadyPaused() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &workflowExecution,
+		},
+	}
+
+	ms := s.createPauseTestMutableState(workflowExecution, true)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.NoError(err)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything, mock.Anything)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	unpauseRequest := &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &workflowExecution,
+			Identity:          "testIdentity",
+		},
+	}
+
+	ms := s.createPauseTestMutableState(workflowExecution, true)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	var appendRequest *persistence.AppendHistoryNodesRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Run(func(args mock.Arguments) {
+		appendRequest = args.Get(1).(*persistence.AppendHistoryNodesRequest)
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.NoError(err)
+	s.NotNil(updateRequest)
+	s.False(updateRequest.UpdateWorkflowMutation.ExecutionInfo.Paused)
+	s.Len(appendRequest.Events, 1)
+	s.Equal(types.EventTypeWorkflowExecutionUnpaused, appendRequest.Events[0].GetEventType())
+	// the pending decision task dropped while paused is regenerated
+	hasDecisionTask := false
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		if task.GetType() == persistence.TransferTaskTypeDecisionTask {
+			hasDecisionTask = true
+		}
+	}
+	s.True(hasDecisionTask)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution_Completed() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	unpauseRequest := &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &workflowExecution,
+		},
+	}
+
+	ms := s.createPauseTestMutableState(workflowExecution, true)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = persistence.WorkflowCloseStatusCompleted
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.IsType(&types.WorkflowExecutionAlreadyCompletedError{}, err)
+}
+
+func (s *engineSuite) createPauseTestMutableState(
+	workflowExecution types.WorkflowExecution,
+	paused bool,
+) *persistence.WorkflowMutableState {
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	startEvent := test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	// task refresh on unpause reads the started event
+	s.eventsCache.PutEvent(constants.TestDomainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID(), startEvent.ID, startEvent)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.Paused = paused
+	return ms
+}
+
 func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: "test-reapply",
@@ -5223,6 +5585,126 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	s.NoError(err)
 }
 
+func (s *engineSuite) TestResetWorkflowExecution_Archived() {
+	domainID := "archived-domain-id"
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: "archived-domain"},
+		&persistence.DomainConfig{
+			Retention:             1,
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    "test:///history/archival",
+		},
+		"",
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainName(domainID).Return("archived-domain", nil).AnyTimes()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-reset-archived-workflow",
+		RunID:      constants.TestRunID,
+	}
+	historyBatches := []*types.History{
+		{Events: []*types.HistoryEvent{
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr(), Version: 10},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr(), Version: 10},
+		}},
+		{Events: []*types.HistoryEvent{{ID: 3, EventType: types.EventTypeDecisionTaskStarted.Ptr(), Version: 10}}},
+		{Events: []*types.HistoryEvent{
+			{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Version: 10},
+			{ID: 5, EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(), Version: 10},
+		}},
+	}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.mockShard.Resource.ArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig(
+		"enabled", dynamicconfig.GetStringPropertyFn("enabled"), true, dynamicconfig.GetBoolPropertyFn(true), "disabled", ""))
+	historyArchiver := &archiver.HistoryArchiverMock{}
+	historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.DomainID == domainID && request.RunID == workflowExecution.RunID
+	})).Return(&archiver.GetHistoryResponse{HistoryBatches: historyBatches}, nil).Once()
+	s.mockShard.Resource.ArchiverProvider.On("GetHistoryArchiver", "test", service.History).Return(historyArchiver, nil).Once()
+	for i := range historyBatches {
+		i := i
+		s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+			return request.Events[0].ID == historyBatches[i].Events[0].ID && request.IsNewBranch == (i == 0)
+		})).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	}
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockWorkflowResetter.EXPECT().ResetWorkflow(gomock.Any(), domainID, workflowExecution.WorkflowID, workflowExecution.RunID, gomock.Any(),
+		int64(3), int64(10), int64(6), gomock.Any(), "reset-request-id", nil, "reset archived run", gomock.Nil(),
+		false,
+	).Return(nil).Times(1)
+
+	resp, err := s.mockHistoryEngine.ResetWorkflowExecution(context.Background(), &types.HistoryResetWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		ResetRequest: &types.ResetWorkflowExecutionRequest{
+			Domain:                "archived-domain",
+			WorkflowExecution:     &workflowExecution,
+			Reason:                "reset archived run",
+			DecisionFinishEventID: 4,
+			RequestID:             "reset-request-id",
+		},
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.GetRunID())
+	historyArchiver.AssertExpectations(s.T())
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_Archived_ExceedsHistorySizeLimit() {
+	domainID := "archived-domain-id"
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: "archived-domain"},
+		&persistence.DomainConfig{
+			Retention:             1,
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    "test:///history/archival",
+		},
+		"",
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainName(domainID).Return("archived-domain", nil).AnyTimes()
+	s.mockHistoryEngine.config.HistorySizeLimitError = dynamicconfig.GetIntPropertyFilteredByDomain(10)
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-reset-archived-workflow",
+		RunID:      constants.TestRunID,
+	}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.mockShard.Resource.ArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig(
+		"enabled", dynamicconfig.GetStringPropertyFn("enabled"), true, dynamicconfig.GetBoolPropertyFn(true), "disabled", ""))
+	historyArchiver := &archiver.HistoryArchiverMock{}
+	// only the first page is read, the restore stops once the size limit is exceeded
+	historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return len(request.NextPageToken) == 0
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr(), Version: 10},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr(), Version: 10},
+		}}},
+		NextPageToken: []byte("next-page"),
+	}, nil).Once()
+	s.mockShard.Resource.ArchiverProvider.On("GetHistoryArchiver", "test", service.History).Return(historyArchiver, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{
+		DataBlob: persistence.DataBlob{Data: make([]byte, 20)},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.mockHistoryEngine.ResetWorkflowExecution(context.Background(), &types.HistoryResetWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		ResetRequest: &types.ResetWorkflowExecutionRequest{
+			Domain:                "archived-domain",
+			WorkflowExecution:     &workflowExecution,
+			Reason:                "reset archived run",
+			DecisionFinishEventID: 4,
+			RequestID:             "reset-request-id",
+		},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+	historyArchiver.AssertExpectations(s.T())
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+}
+
 func (s *engineSuite) getBuilder(testDomainID string, we types.WorkflowExecution) execution.MutableState {
 	context, release, err := s.mockHistoryEngine.executionCache.GetOrCreateWorkflowExecutionForBackground(testDomainID, we)
 	if err != nil {
@@ -5244,3 +5726,38 @@ func (s *engineSuite) getActivityScheduledEvent(
 func (s *engineSuite) printHistory(builder execution.MutableState) string {
 	return thrift.FromHistory(builder.GetHistoryBuilder().GetHistory()).String()
 }
+
+func (s *engineSuite) TestListTransferTasks_MaxScanSize() {
+	ctx := context.Background()
+	firstPage := &persistence.GetTransferTasksResponse{
+		Tasks: []*persistence.TransferTaskInfo{
+			{DomainID: constants.TestDomainID, WorkflowID: "other-wid", TaskID: 1},
+			{DomainID: constants.TestDomainID, WorkflowID: "wid", TaskID: 2},
+		},
+		NextPageToken: []byte("next-page"),
+	}
+	lastPage := &persistence.GetTransferTasksResponse{
+		Tasks: []*persistence.TransferTaskInfo{
+			{DomainID: constants.TestDomainID, WorkflowID: "wid", TaskID: 3},
+		},
+	}
+	filter := func(info task.Info) bool {
+		return info.GetWorkflowID() == "wid"
+	}
+
+	// the scan stops once the max scan size is reached while more tasks are left
+	s.mockExecutionMgr.On("GetTransferTasks", mock.Anything, mock.Anything).Return(firstPage, nil).Once()
+	tasks, truncated, err := s.mockHistoryEngine.listTransferTasks(ctx, 2, filter)
+	s.NoError(err)
+	s.True(truncated)
+	s.Len(tasks, 1)
+	s.Equal(int64(2), tasks[0].GetTaskID())
+
+	s.mockExecutionMgr.On("GetTransferTasks", mock.Anything, mock.Anything).Return(firstPage, nil).Once()
+	s.mockExecutionMgr.On("GetTransferTasks", mock.Anything, mock.Anything).Return(lastPage, nil).Once()
+	tasks, truncated, err = s.mockHistoryEngine.listTransferTasks(ctx, 3, filter)
+	s.NoError(err)
+	s.False(truncated)
+	s.Len(tasks, 2)
+	s.Equal(int64(3), tasks[1].GetTaskID())
+}
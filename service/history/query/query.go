@@ -74,8 +74,12 @@ type (
 )
 
 func newQuery(queryInput *types.WorkflowQuery) query {
+	return newQueryWithID(uuid.New(), queryInput)
+}
+
+func newQueryWithID(id string, queryInput *types.WorkflowQuery) query {
 	return &queryImpl{
-		id:         uuid.New(),
+		id:         id,
 		queryInput: queryInput,
 		termCh:     make(chan struct{}),
 	}
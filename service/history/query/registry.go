@@ -29,7 +29,8 @@ import (
 )
 
 var (
-	errQueryNotExists = &types.InternalServiceError{Message: "query does not exist"}
+	errQueryNotExists  = &types.InternalServiceError{Message: "query does not exist"}
+	errUpdateNotExists = &types.InternalServiceError{Message: "update does not exist"}
 )
 
 type (
@@ -51,6 +52,16 @@ type (
 		BufferQuery(queryInput *types.WorkflowQuery) (string, <-chan struct{})
 		SetTerminationState(string, *TerminationState) error
 		RemoveQuery(id string)
+
+		// Updates are tracked separately from queries: they are identified by the caller supplied update ID,
+		// are never dispatched as buffered queries and can have multiple waiters for the same ID.
+		HasUpdate(updateID string) bool
+		GetUpdateIDs() []string
+		GetUpdateTerminationState(updateID string) (*TerminationState, error)
+
+		BufferUpdate(updateID string) <-chan struct{}
+		SetUpdateTerminationState(updateID string, terminationState *TerminationState) error
+		RemoveUpdate(updateID string)
 	}
 
 	registryImpl struct {
@@ -60,6 +71,13 @@ type (
 		completed map[string]query
 		unblocked map[string]query
 		failed    map[string]query
+
+		updates map[string]*updateWaiter
+	}
+
+	updateWaiter struct {
+		query   query
+		waiters int
 	}
 )
 
@@ -70,6 +88,7 @@ func NewRegistry() Registry {
 		completed: make(map[string]query),
 		unblocked: make(map[string]query),
 		failed:    make(map[string]query),
+		updates:   make(map[string]*updateWaiter),
 	}
 }
 
@@ -191,6 +210,68 @@ func (r *registryImpl) RemoveQuery(id string) {
 	delete(r.failed, id)
 }
 
+func (r *registryImpl) HasUpdate(updateID string) bool {
+	r.RLock()
+	defer r.RUnlock()
+	_, ok := r.updates[updateID]
+	return ok
+}
+
+func (r *registryImpl) GetUpdateIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	result := make([]string, 0, len(r.updates))
+	for id := range r.updates {
+		result = append(result, id)
+	}
+	return result
+}
+
+func (r *registryImpl) GetUpdateTerminationState(updateID string) (*TerminationState, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, ok := r.updates[updateID]
+	if !ok {
+		return nil, errUpdateNotExists
+	}
+	return u.query.getTerminationState()
+}
+
+func (r *registryImpl) BufferUpdate(updateID string) <-chan struct{} {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.updates[updateID]
+	if !ok {
+		u = &updateWaiter{query: newQueryWithID(updateID, nil)}
+		r.updates[updateID] = u
+	}
+	u.waiters++
+	return u.query.getQueryTermCh()
+}
+
+func (r *registryImpl) SetUpdateTerminationState(updateID string, terminationState *TerminationState) error {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.updates[updateID]
+	if !ok {
+		return errUpdateNotExists
+	}
+	return u.query.setTerminationState(terminationState)
+}
+
+func (r *registryImpl) RemoveUpdate(updateID string) {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.updates[updateID]
+	if !ok {
+		return
+	}
+	u.waiters--
+	if u.waiters <= 0 {
+		delete(r.updates, updateID)
+	}
+}
+
 func (r *registryImpl) getQueryNoLock(id string) (query, error) {
 	if q, ok := r.buffered[id]; ok {
 		return q, nil
@@ -155,6 +155,49 @@ func (s *QueryRegistrySuite) TestQueryRegistry() {
 	s.assertChanState(false, termChans[75:]...)
 }
 
+func (s *QueryRegistrySuite) TestUpdateRegistry() {
+	qr := NewRegistry()
+	termCh1 := qr.BufferUpdate("update-1")
+	termCh2 := qr.BufferUpdate("update-1")
+	termCh3 := qr.BufferUpdate("update-2")
+	s.True(qr.HasUpdate("update-1"))
+	s.ElementsMatch([]string{"update-1", "update-2"}, qr.GetUpdateIDs())
+	s.False(qr.HasBufferedQuery())
+	s.assertChanState(false, termCh1, termCh2, termCh3)
+
+	_, err := qr.GetUpdateTerminationState("update-1")
+	s.Equal(errQueryNotInTerminalState, err)
+	s.Equal(errUpdateNotExists, qr.SetUpdateTerminationState("update-3", &TerminationState{
+		TerminationType: TerminationTypeUnblocked,
+	}))
+
+	terminationState := &TerminationState{
+		TerminationType: TerminationTypeCompleted,
+		QueryResult: &types.WorkflowQueryResult{
+			ResultType: types.QueryResultTypeAnswered.Ptr(),
+			Answer:     []byte{1, 2, 3},
+		},
+	}
+	s.NoError(qr.SetUpdateTerminationState("update-1", terminationState))
+	s.assertChanState(true, termCh1, termCh2)
+	s.assertChanState(false, termCh3)
+	actual, err := qr.GetUpdateTerminationState("update-1")
+	s.NoError(err)
+	s.Equal(terminationState, actual)
+
+	// late waiters on a terminated update get notified immediately
+	termCh4 := qr.BufferUpdate("update-1")
+	s.assertChanState(true, termCh4)
+
+	qr.RemoveUpdate("update-1")
+	qr.RemoveUpdate("update-1")
+	s.True(qr.HasUpdate("update-1"))
+	qr.RemoveUpdate("update-1")
+	s.False(qr.HasUpdate("update-1"))
+	qr.RemoveUpdate("update-2")
+	s.Empty(qr.GetUpdateIDs())
+}
+
 func (s *QueryRegistrySuite) assertBufferedState(qr Registry, ids ...string) {
 	for _, id := range ids {
 		termCh, err := qr.GetQueryTermCh(id)
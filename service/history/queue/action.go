@@ -21,7 +21,10 @@
 package queue
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/task"
 )
 
 type (
@@ -35,6 +38,8 @@ type (
 		GetStateActionAttributes *GetStateActionAttributes
 		GetTasksAttributes       *GetTasksAttributes
 		UpdateTaskAttributes     *UpdateTasksAttributes
+		GetTaskStatesAttributes  *GetTaskStatesAttributes
+		RetryTaskAttributes      *RetryTaskAttributes
 		// add attributes for other action types here
 	}
 
@@ -45,6 +50,8 @@ type (
 		GetStateActionResult *GetStateActionResult
 		GetTasksResult       *GetTasksResult
 		UpdateTaskResult     *UpdateTasksResult
+		GetTaskStatesResult  *GetTaskStatesResult
+		RetryTaskResult      *RetryTaskResult
 	}
 
 	// ResetActionAttributes contains the parameter for performing Reset Action
@@ -72,6 +79,32 @@ type (
 	// UpdateTasksResult is the result for performing UpdateTask Action
 	UpdateTasksResult struct {
 	}
+
+	// GetTaskStatesAttributes contains the persisted tasks to get processing states for
+	GetTaskStatesAttributes struct {
+		Tasks []task.Info
+	}
+	// GetTaskStatesResult is the result for performing GetTaskStates Action,
+	// states are in the same order as the tasks in the attributes
+	GetTaskStatesResult struct {
+		TaskStates []TaskState
+	}
+	// TaskState is the processing state of a task in the processor
+	TaskState struct {
+		State  types.QueueTaskState
+		Loaded bool
+		// Attempt and RedispatchTime are only set for loaded tasks, RedispatchTime
+		// is zero unless the task is waiting in the redispatcher backoff
+		Attempt        int
+		RedispatchTime time.Time
+	}
+
+	// RetryTaskAttributes contains the parameter to retry a task in redispatcher backoff
+	RetryTaskAttributes struct {
+		TaskID int64
+	}
+	// RetryTaskResult is the result for performing RetryTask Action
+	RetryTaskResult struct{}
 )
 
 const (
@@ -83,6 +116,10 @@ const (
 	ActionTypeGetTasks
 	// ActionTypeUpdateTask is the ActionType to update outstanding task
 	ActionTypeUpdateTask
+	// ActionTypeGetTaskStates is the ActionType for reading processing states of persisted tasks
+	ActionTypeGetTaskStates
+	// ActionTypeRetryTask is the ActionType for redispatching a task without waiting for its backoff
+	ActionTypeRetryTask
 	// add more ActionType here
 )
 
@@ -122,3 +159,29 @@ func NewUpdateTasksAction(
 		},
 	}
 }
+
+// NewGetTaskStatesAction creates a queue action for reading the processing
+// states of the given persisted tasks
+func NewGetTaskStatesAction(
+	tasks []task.Info,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeGetTaskStates,
+		GetTaskStatesAttributes: &GetTaskStatesAttributes{
+			Tasks: tasks,
+		},
+	}
+}
+
+// NewRetryTaskAction creates a queue action for redispatching a task
+// waiting in the redispatcher backoff
+func NewRetryTaskAction(
+	taskID int64,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeRetryTask,
+		RetryTaskAttributes: &RetryTaskAttributes{
+			TaskID: taskID,
+		},
+	}
+}
@@ -31,7 +31,10 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	t "github.com/uber/cadence/common/task"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -377,6 +380,10 @@ func (p *processorBase) handleActionNotification(
 		result, err = p.resetProcessingQueueStates()
 	case ActionTypeGetState:
 		result = p.getProcessingQueueStates()
+	case ActionTypeGetTaskStates:
+		result = p.getTaskStates(notification.action.GetTaskStatesAttributes.Tasks)
+	case ActionTypeRetryTask:
+		result, err = p.retryTask(notification.action.RetryTaskAttributes.TaskID)
 	default:
 		err = fmt.Errorf("unknown queue action type: %v", notification.action.ActionType)
 	}
@@ -460,6 +467,102 @@ func (p *processorBase) getProcessingQueueStates() *ActionResult {
 	}
 }
 
+func (p *processorBase) getTaskStates(
+	tasks []task.Info,
+) *ActionResult {
+	outstandingTasks := make(map[int64]task.Task)
+	for _, queueCollection := range p.processingQueueCollections {
+		for _, outstandingTask := range queueCollection.GetTasks() {
+			outstandingTasks[outstandingTask.GetTaskID()] = outstandingTask
+		}
+	}
+
+	taskStates := make([]TaskState, 0, len(tasks))
+	for _, taskInfo := range tasks {
+		if outstandingTask, ok := outstandingTasks[taskInfo.GetTaskID()]; ok {
+			taskStates = append(taskStates, p.getLoadedTaskState(outstandingTask))
+		} else {
+			taskStates = append(taskStates, p.getUnloadedTaskState(taskInfo))
+		}
+	}
+
+	return &ActionResult{
+		ActionType: ActionTypeGetTaskStates,
+		GetTaskStatesResult: &GetTaskStatesResult{
+			TaskStates: taskStates,
+		},
+	}
+}
+
+func (p *processorBase) getLoadedTaskState(
+	outstandingTask task.Task,
+) TaskState {
+	taskState := TaskState{
+		State:   types.QueueTaskStatePending,
+		Loaded:  true,
+		Attempt: outstandingTask.GetAttempt(),
+	}
+	if outstandingTask.State() == t.TaskStateAcked {
+		taskState.State = types.QueueTaskStateAcked
+	} else if redispatchTime, ok := p.redispatcher.RedispatchTime(outstandingTask); ok {
+		taskState.State = types.QueueTaskStateBackoff
+		taskState.RedispatchTime = redispatchTime
+	}
+	return taskState
+}
+
+// getUnloadedTaskState determines if a task not in any processing queue is yet
+// to be read or has already been acked and is waiting for range deletion
+func (p *processorBase) getUnloadedTaskState(
+	taskInfo task.Info,
+) TaskState {
+	key := newTaskKey(taskInfo)
+	beyondMaxLevel := true
+	for _, queueCollection := range p.processingQueueCollections {
+		for _, queue := range queueCollection.Queues() {
+			state := queue.State()
+			if !state.DomainFilter().Filter(taskInfo.GetDomainID()) || state.MaxLevel().Less(key) {
+				continue
+			}
+
+			beyondMaxLevel = false
+			if state.AckLevel().Less(key) && state.ReadLevel().Less(key) {
+				return TaskState{State: types.QueueTaskStatePending}
+			}
+		}
+	}
+
+	if beyondMaxLevel {
+		return TaskState{State: types.QueueTaskStatePending}
+	}
+	return TaskState{State: types.QueueTaskStateAcked}
+}
+
+func (p *processorBase) retryTask(
+	taskID int64,
+) (*ActionResult, error) {
+	for _, queueCollection := range p.processingQueueCollections {
+		for _, outstandingTask := range queueCollection.GetTasks() {
+			if outstandingTask.GetTaskID() != taskID {
+				continue
+			}
+
+			if outstandingTask.State() == t.TaskStateAcked {
+				return nil, &types.BadRequestError{Message: "task is already acked"}
+			}
+			if !p.redispatcher.RedispatchNow(outstandingTask) {
+				return nil, &types.BadRequestError{Message: "task is not waiting for redispatch"}
+			}
+			return &ActionResult{
+				ActionType:      ActionTypeRetryTask,
+				RetryTaskResult: &RetryTaskResult{},
+			}, nil
+		}
+	}
+
+	return nil, &types.EntityNotExistsError{Message: "task is not loaded by the queue processor"}
+}
+
 func (p *processorBase) submitTask(
 	task task.Task,
 ) (bool, error) {
@@ -527,3 +630,12 @@ func getPendingTasksMetricIdx(
 		panic("unknown queue processor metric scope")
 	}
 }
+
+func newTaskKey(
+	taskInfo task.Info,
+) task.Key {
+	if _, ok := taskInfo.(*persistence.TimerTaskInfo); ok {
+		return newTimerTaskKey(taskInfo.GetVisibilityTimestamp(), taskInfo.GetTaskID())
+	}
+	return newTransferTaskKey(taskInfo.GetTaskID())
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	t "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/shard"
@@ -331,6 +332,108 @@ func (s *processorBaseSuite) TestUpdateAckLevel_Timer_UpdateQueueStates() {
 	s.Equal(now.Add(-5*time.Second), ackLevel.(timerTaskKey).visibilityTimestamp)
 }
 
+func (s *processorBaseSuite) TestGetTaskStates() {
+	processingQueueStates := []ProcessingQueueState{
+		NewProcessingQueueState(
+			0,
+			newTransferTaskKey(10),
+			newTransferTaskKey(100),
+			NewDomainFilter(nil, true),
+		),
+	}
+	processorBase := s.newTestProcessorBase(processingQueueStates, nil, nil, nil, nil)
+	mockRedispatcher := task.NewMockRedispatcher(s.controller)
+	processorBase.redispatcher = mockRedispatcher
+
+	redispatchTime := time.Now()
+	ackedTask := s.newMockTask(15, t.TaskStateAcked, 0)
+	backoffTask := s.newMockTask(16, t.TaskStatePending, 3)
+	processingTask := s.newMockTask(17, t.TaskStatePending, 1)
+	mockRedispatcher.EXPECT().RedispatchTime(backoffTask).Return(redispatchTime, true).Times(1)
+	mockRedispatcher.EXPECT().RedispatchTime(processingTask).Return(time.Time{}, false).Times(1)
+	processorBase.processingQueueCollections[0].AddTasks(map[task.Key]task.Task{
+		newTransferTaskKey(15): ackedTask,
+		newTransferTaskKey(16): backoffTask,
+		newTransferTaskKey(17): processingTask,
+	}, newTransferTaskKey(20))
+
+	var taskInfos []task.Info
+	for _, taskID := range []int64{5, 15, 16, 17, 30, 200} {
+		taskInfos = append(taskInfos, &persistence.TransferTaskInfo{
+			DomainID: "testDomain",
+			TaskID:   taskID,
+		})
+	}
+	resultNotificationCh := make(chan actionResultNotification, 1)
+	processorBase.handleActionNotification(actionNotification{
+		action:               NewGetTaskStatesAction(taskInfos),
+		resultNotificationCh: resultNotificationCh,
+	}, func() {})
+
+	resultNotification := <-resultNotificationCh
+	s.NoError(resultNotification.err)
+	s.Equal([]TaskState{
+		{State: types.QueueTaskStateAcked},
+		{State: types.QueueTaskStateAcked, Loaded: true},
+		{State: types.QueueTaskStateBackoff, Loaded: true, Attempt: 3, RedispatchTime: redispatchTime},
+		{State: types.QueueTaskStatePending, Loaded: true, Attempt: 1},
+		{State: types.QueueTaskStatePending},
+		{State: types.QueueTaskStatePending},
+	}, resultNotification.result.GetTaskStatesResult.TaskStates)
+}
+
+func (s *processorBaseSuite) TestRetryTask() {
+	processingQueueStates := []ProcessingQueueState{
+		NewProcessingQueueState(
+			0,
+			newTransferTaskKey(10),
+			newTransferTaskKey(100),
+			NewDomainFilter(nil, true),
+		),
+	}
+	processorBase := s.newTestProcessorBase(processingQueueStates, nil, nil, nil, nil)
+	mockRedispatcher := task.NewMockRedispatcher(s.controller)
+	processorBase.redispatcher = mockRedispatcher
+
+	ackedTask := s.newMockTask(15, t.TaskStateAcked, 0)
+	backoffTask := s.newMockTask(16, t.TaskStatePending, 3)
+	processingTask := s.newMockTask(17, t.TaskStatePending, 1)
+	mockRedispatcher.EXPECT().RedispatchNow(backoffTask).Return(true).Times(1)
+	mockRedispatcher.EXPECT().RedispatchNow(processingTask).Return(false).Times(1)
+	processorBase.processingQueueCollections[0].AddTasks(map[task.Key]task.Task{
+		newTransferTaskKey(15): ackedTask,
+		newTransferTaskKey(16): backoffTask,
+		newTransferTaskKey(17): processingTask,
+	}, newTransferTaskKey(20))
+
+	retryTask := func(taskID int64) error {
+		resultNotificationCh := make(chan actionResultNotification, 1)
+		processorBase.handleActionNotification(actionNotification{
+			action:               NewRetryTaskAction(taskID),
+			resultNotificationCh: resultNotificationCh,
+		}, func() {})
+		return (<-resultNotificationCh).err
+	}
+
+	s.NoError(retryTask(16))
+	s.IsType(&types.BadRequestError{}, retryTask(15))
+	s.IsType(&types.BadRequestError{}, retryTask(17))
+	s.IsType(&types.EntityNotExistsError{}, retryTask(30))
+}
+
+func (s *processorBaseSuite) newMockTask(
+	taskID int64,
+	state t.State,
+	attempt int,
+) *task.MockTask {
+	mockTask := task.NewMockTask(s.controller)
+	mockTask.EXPECT().GetTaskID().Return(taskID).AnyTimes()
+	mockTask.EXPECT().GetDomainID().Return("testDomain").AnyTimes()
+	mockTask.EXPECT().State().Return(state).AnyTimes()
+	mockTask.EXPECT().GetAttempt().Return(attempt).AnyTimes()
+	return mockTask
+}
+
 func (s *processorBaseSuite) newTestProcessorBase(
 	processingQueueStates []ProcessingQueueState,
 	updateMaxReadLevel updateMaxReadLevelFn,
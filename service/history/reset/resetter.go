@@ -116,9 +116,9 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	}
 	resetWorkflowVersion := domainEntry.GetFailoverVersion()
 
-	currentMutableState := currentWorkflow.GetMutableState()
 	currentWorkflowTerminated := false
-	if currentMutableState.IsWorkflowExecutionRunning() {
+	if currentWorkflow != nil && currentWorkflow.GetMutableState().IsWorkflowExecutionRunning() {
+		currentMutableState := currentWorkflow.GetMutableState()
 		if err := r.terminateWorkflow(
 			currentMutableState,
 			resetReason,
@@ -267,13 +267,6 @@ func (r *workflowResetterImpl) persistToDB(
 		)
 	}
 
-	currentMutableState := currentWorkflow.GetMutableState()
-	currentRunID := currentMutableState.GetExecutionInfo().RunID
-	currentLastWriteVersion, err := currentMutableState.GetLastWriteVersion()
-	if err != nil {
-		return err
-	}
-
 	now := r.shard.GetTimeSource().Now()
 	resetWorkflowSnapshot, resetWorkflowEventsSeq, err := resetWorkflow.GetMutableState().CloseTransactionAsSnapshot(
 		now,
@@ -295,6 +288,25 @@ func (r *workflowResetterImpl) persistToDB(
 		return err
 	}
 
+	if currentWorkflow == nil {
+		// the workflow has no current run left, e.g. when resetting a run from its archived history
+		return resetWorkflow.GetContext().CreateWorkflowExecution(
+			ctx,
+			resetWorkflowSnapshot,
+			resetWorkflowHistory,
+			persistence.CreateWorkflowModeBrandNew,
+			"",
+			common.EmptyVersion,
+		)
+	}
+
+	currentMutableState := currentWorkflow.GetMutableState()
+	currentRunID := currentMutableState.GetExecutionInfo().RunID
+	currentLastWriteVersion, err := currentMutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+
 	return resetWorkflow.GetContext().CreateWorkflowExecution(
 		ctx,
 		resetWorkflowSnapshot,
@@ -506,6 +518,10 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 	// second for remaining continue as new workflow, reapply eligible events
 	for len(nextRunID) != 0 {
 		nextWorkflowNextEventID, nextWorkflowBranchToken, err := getNextEventIDBranchToken(nextRunID)
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// the following runs are past retention, there are no events left to reapply
+			return nil
+		}
 		if err != nil {
 			return err
 		}
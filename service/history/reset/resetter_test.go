@@ -211,6 +211,42 @@ func (s *workflowResetterSuite) TestPersistToDB_CurrentNotTerminated() {
 	s.False(resetReleaseCalled)
 }
 
+func (s *workflowResetterSuite) TestPersistToDB_NoCurrentWorkflow() {
+	resetWorkflow := execution.NewMockWorkflow(s.controller)
+	resetContext := execution.NewMockContext(s.controller)
+	resetMutableState := execution.NewMockMutableState(s.controller)
+	resetWorkflow.EXPECT().GetContext().Return(resetContext).AnyTimes()
+	resetWorkflow.EXPECT().GetMutableState().Return(resetMutableState).AnyTimes()
+
+	resetSnapshot := &persistence.WorkflowSnapshot{}
+	resetEventsSeq := []*persistence.WorkflowEvents{{
+		DomainID:    s.domainID,
+		WorkflowID:  s.workflowID,
+		RunID:       s.resetRunID,
+		BranchToken: []byte("some random reset branch token"),
+		Events: []*types.HistoryEvent{{
+			ID: 123,
+		}},
+	}}
+	resetEvents := events.PersistedBlob{DataBlob: persistence.DataBlob{Data: make([]byte, 4321)}}
+	resetMutableState.EXPECT().CloseTransactionAsSnapshot(
+		gomock.Any(),
+		execution.TransactionPolicyActive,
+	).Return(resetSnapshot, resetEventsSeq, nil).Times(1)
+	resetContext.EXPECT().PersistNonStartWorkflowBatchEvents(gomock.Any(), resetEventsSeq[0]).Return(resetEvents, nil).Times(1)
+	resetContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
+		resetSnapshot,
+		resetEvents,
+		persistence.CreateWorkflowModeBrandNew,
+		"",
+		common.EmptyVersion,
+	).Return(nil).Times(1)
+
+	err := s.workflowResetter.persistToDB(context.Background(), false, nil, resetWorkflow)
+	s.NoError(err)
+}
+
 func (s *workflowResetterSuite) TestReplayResetWorkflow() {
 	ctx := context.Background()
 	baseBranchToken := []byte("some random base branch token")
@@ -168,6 +168,7 @@ func (t *crossClusterTargetTaskExecutor) executeStartChildExecutionTask(
 			targetDomainName,
 			attributes.RequestID,
 			attributes.GetInitiatedEventAttributes(),
+			"", // isolation groups are local to a cluster
 		)
 		if err != nil {
 			return nil, err
@@ -99,6 +99,8 @@ type (
 		common.Daemon
 		AddTask(Task)
 		Redispatch(targetSize int)
+		RedispatchTime(Task) (time.Time, bool)
+		RedispatchNow(Task) bool
 		Size() int
 	}
 
@@ -1028,6 +1028,35 @@ func (mr *MockRedispatcherMockRecorder) Redispatch(targetSize interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redispatch", reflect.TypeOf((*MockRedispatcher)(nil).Redispatch), targetSize)
 }
 
+// RedispatchNow mocks base method.
+func (m *MockRedispatcher) RedispatchNow(arg0 Task) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedispatchNow", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// RedispatchNow indicates an expected call of RedispatchNow.
+func (mr *MockRedispatcherMockRecorder) RedispatchNow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedispatchNow", reflect.TypeOf((*MockRedispatcher)(nil).RedispatchNow), arg0)
+}
+
+// RedispatchTime mocks base method.
+func (m *MockRedispatcher) RedispatchTime(arg0 Task) (time.Time, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedispatchTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// RedispatchTime indicates an expected call of RedispatchTime.
+func (mr *MockRedispatcherMockRecorder) RedispatchTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedispatchTime", reflect.TypeOf((*MockRedispatcher)(nil).RedispatchTime), arg0)
+}
+
 // Size mocks base method.
 func (m *MockRedispatcher) Size() int {
 	m.ctrl.T.Helper()
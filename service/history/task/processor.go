@@ -40,6 +40,7 @@ type (
 		schedulerType        task.SchedulerType
 		fifoSchedulerOptions *task.FIFOTaskSchedulerOptions
 		wrrSchedulerOptions  *task.WeightedRoundRobinTaskSchedulerOptions
+		drrSchedulerOptions  *task.DeficitRoundRobinTaskSchedulerOptions
 	}
 
 	processorImpl struct {
@@ -74,6 +75,8 @@ func NewProcessor(
 		config.TaskSchedulerWorkerCount,
 		config.TaskSchedulerDispatcherCount(),
 		config.TaskSchedulerRoundRobinWeights,
+		config.TaskSchedulerDomainQuantum,
+		config.TaskSchedulerDomainQueueRatio,
 	)
 	if err != nil {
 		return nil, err
@@ -87,6 +90,8 @@ func NewProcessor(
 			config.TaskSchedulerShardWorkerCount,
 			1,
 			config.TaskSchedulerRoundRobinWeights,
+			config.TaskSchedulerDomainQuantum,
+			config.TaskSchedulerDomainQueueRatio,
 		)
 		if err != nil {
 			return nil, err
@@ -262,6 +267,8 @@ func newSchedulerOptions(
 	workerCount dynamicconfig.IntPropertyFn,
 	dispatcherCount int,
 	weights dynamicconfig.MapPropertyFn,
+	domainQuantum dynamicconfig.IntPropertyFnWithDomainFilter,
+	domainQueueRatio dynamicconfig.FloatPropertyFn,
 ) (*schedulerOptions, error) {
 	options := &schedulerOptions{
 		schedulerType: task.SchedulerType(schedulerType),
@@ -282,6 +289,17 @@ func newSchedulerOptions(
 			DispatcherCount: dispatcherCount,
 			RetryPolicy:     common.CreateTaskProcessingRetryPolicy(),
 		}
+	case task.SchedulerTypeDRR:
+		options.drrSchedulerOptions = &task.DeficitRoundRobinTaskSchedulerOptions{
+			Weights:          weights,
+			DomainQuantum:    domainQuantum,
+			DomainQueueRatio: domainQueueRatio,
+			DomainFn:         getTaskDomainName,
+			QueueSize:        queueSize,
+			WorkerCount:      workerCount,
+			DispatcherCount:  dispatcherCount,
+			RetryPolicy:      common.CreateTaskProcessingRetryPolicy(),
+		}
 	default:
 		return nil, fmt.Errorf("unknown task scheduler type: %v", schedulerType)
 	}
@@ -308,6 +326,12 @@ func createTaskScheduler(
 			metricsClient,
 			options.wrrSchedulerOptions,
 		)
+	case task.SchedulerTypeDRR:
+		scheduler, err = task.NewDeficitRoundRobinTaskScheduler(
+			logger,
+			metricsClient,
+			options.drrSchedulerOptions,
+		)
 	default:
 		// the scheduler type has already been verified when initializing the processor
 		panic(fmt.Sprintf("Unknown task scheduler type, %v", options.schedulerType))
@@ -315,3 +339,24 @@ func createTaskScheduler(
 
 	return scheduler, err
 }
+
+// getTaskDomainName returns the domain name of a task as domain quantum and metrics
+// are keyed by domain name, it falls back to the domain ID if the name is unknown
+func getTaskDomainName(
+	priorityTask task.PriorityTask,
+) string {
+	historyTask, ok := priorityTask.(Task)
+	if !ok {
+		return ""
+	}
+	domainID := historyTask.GetDomainID()
+	shard := historyTask.GetShard()
+	if shard == nil {
+		return domainID
+	}
+	domainName, err := shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return domainID
+	}
+	return domainName
+}
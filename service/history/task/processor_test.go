@@ -205,11 +205,44 @@ func (s *queueTaskProcessorSuite) TestTrySubmit_Fail() {
 }
 
 func (s *queueTaskProcessorSuite) TestNewSchedulerOptions_UnknownSchedulerType() {
-	options, err := newSchedulerOptions(0, 100, dynamicconfig.GetIntPropertyFn(10), 1, nil)
+	options, err := newSchedulerOptions(0, 100, dynamicconfig.GetIntPropertyFn(10), 1, nil, nil, nil)
 	s.Error(err)
 	s.Nil(options)
 }
 
+func (s *queueTaskProcessorSuite) TestNewSchedulerOptions_DeficitRoundRobin() {
+	options, err := newSchedulerOptions(
+		int(task.SchedulerTypeDRR),
+		100,
+		dynamicconfig.GetIntPropertyFn(10),
+		1,
+		dynamicconfig.GetMapPropertyFn(map[string]interface{}{"0": 1}),
+		dynamicconfig.GetIntPropertyFilteredByDomain(1),
+		dynamicconfig.GetFloatPropertyFn(0.5),
+	)
+	s.NoError(err)
+	s.NotNil(options.drrSchedulerOptions)
+
+	scheduler, err := createTaskScheduler(options, s.logger, s.metricsClient)
+	s.NoError(err)
+	s.NotNil(scheduler)
+}
+
+func (s *queueTaskProcessorSuite) TestGetTaskDomainName() {
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName("domain-id").Return("domain-name", nil).Times(1)
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName("unknown-id").Return("", errors.New("some random error")).Times(1)
+
+	mockTask := NewMockTask(s.controller)
+	mockTask.EXPECT().GetDomainID().Return("domain-id").Times(1)
+	mockTask.EXPECT().GetShard().Return(s.mockShard).Times(1)
+	s.Equal("domain-name", getTaskDomainName(mockTask))
+
+	mockTask = NewMockTask(s.controller)
+	mockTask.EXPECT().GetDomainID().Return("unknown-id").Times(1)
+	mockTask.EXPECT().GetShard().Return(s.mockShard).Times(1)
+	s.Equal("unknown-id", getTaskDomainName(mockTask))
+}
+
 func (s *queueTaskProcessorSuite) newTestQueueTaskProcessor() *processorImpl {
 	config := config.NewForTest()
 	config.TaskSchedulerShardWorkerCount = dynamicconfig.GetIntPropertyFn(1)
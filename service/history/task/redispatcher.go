@@ -178,6 +178,56 @@ func (r *redispatcherImpl) Redispatch(
 	<-doneCh
 }
 
+// RedispatchTime returns the time the task is scheduled to be redispatched,
+// the returned bool is false if the task is not in the redispatch queue
+func (r *redispatcherImpl) RedispatchTime(
+	task Task,
+) (time.Time, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, queue := range r.taskQueues {
+		for _, redispatchTask := range queue {
+			if redispatchTask.task == task {
+				return redispatchTask.redispatchTime, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// RedispatchNow skips the remaining backoff of the task and triggers a redispatch,
+// the returned bool is false if the task is not in the redispatch queue
+func (r *redispatcherImpl) RedispatchNow(
+	task Task,
+) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	found := false
+	for _, queue := range r.taskQueues {
+		for idx := range queue {
+			if queue[idx].task == task {
+				queue[idx].redispatchTime = r.timeSource.Now()
+				found = true
+			}
+		}
+	}
+	if !found || r.isStopped() {
+		return found
+	}
+
+	select {
+	case r.redispatchCh <- redispatchNotification{
+		targetSize: 0,
+		doneCh:     nil,
+	}:
+	default:
+		// a redispatch is already pending and will pick up the task
+	}
+	return true
+}
+
 func (r *redispatcherImpl) Size() int {
 	r.Lock()
 	defer r.Unlock()
@@ -190,6 +190,38 @@ func (s *redispatcherSuite) TestRedispatch_Random() {
 	s.True(s.redispatcher.Size() >= numTasks-dispatched)
 }
 
+func (s *redispatcherSuite) TestRedispatchNow() {
+	backoffTask := NewMockTask(s.controller)
+	backoffTask.EXPECT().Priority().Return(0).AnyTimes()
+	backoffTask.EXPECT().GetAttempt().Return(100).Times(1)
+	s.redispatcher.AddTask(backoffTask)
+
+	retriedTask := NewMockTask(s.controller)
+	retriedTask.EXPECT().Priority().Return(0).AnyTimes()
+	retriedTask.EXPECT().GetAttempt().Return(100).Times(1)
+	s.redispatcher.AddTask(retriedTask)
+
+	redispatchTime, ok := s.redispatcher.RedispatchTime(retriedTask)
+	s.True(ok)
+	s.True(redispatchTime.After(s.mockTimeSource.Now()))
+	_, ok = s.redispatcher.RedispatchTime(NewMockTask(s.controller))
+	s.False(ok)
+
+	s.False(s.redispatcher.RedispatchNow(NewMockTask(s.controller)))
+	s.True(s.redispatcher.RedispatchNow(retriedTask))
+	redispatchTime, ok = s.redispatcher.RedispatchTime(retriedTask)
+	s.True(ok)
+	s.Equal(s.mockTimeSource.Now(), redispatchTime)
+
+	s.mockProcessor.EXPECT().TrySubmit(NewMockTaskMatcher(retriedTask)).Return(true, nil).Times(1)
+	s.redispatcher.Start()
+	s.redispatcher.Redispatch(0)
+
+	s.Equal(1, s.redispatcher.Size())
+	_, ok = s.redispatcher.RedispatchTime(backoffTask)
+	s.True(ok)
+}
+
 func (s *redispatcherSuite) newTestRedispatcher() *redispatcherImpl {
 	return NewRedispatcher(
 		s.mockProcessor,
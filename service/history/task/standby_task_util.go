@@ -114,31 +114,49 @@ type (
 
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
+		isolationGroup                 string
+		priority                       int32
+		fairnessKey                    string
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
+		isolationGroup                 string
+		priority                       int32
+		fairnessKey                    string
 	}
 )
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
+	isolationGroup string,
+	priority int32,
+	fairnessKey string,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
+		isolationGroup:                 isolationGroup,
+		priority:                       priority,
+		fairnessKey:                    fairnessKey,
 	}
 }
 
 func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
+	isolationGroup string,
+	priority int32,
+	fairnessKey string,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
+		isolationGroup:                 isolationGroup,
+		priority:                       priority,
+		fairnessKey:                    fairnessKey,
 	}
 }
 
@@ -135,6 +135,11 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.GetExecutionInfo().Paused {
+		// user timers are deferred while the workflow is paused,
+		// timer tasks will be regenerated when it is unpaused
+		return nil
+	}
 
 	timerSequence := execution.NewTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -212,6 +217,8 @@ Loop:
 	return t.updateWorkflowExecution(ctx, wfContext, mutableState, updateMutableState)
 }
 
+// executeActivityTimeoutTask is not deferred while the workflow is paused: activities which were
+// already dispatched keep running on the workers, so their timeouts still apply
 func (t *timerActiveTaskExecutor) executeActivityTimeoutTask(
 	ctx context.Context,
 	task *persistence.TimerTaskInfo,
@@ -506,6 +513,10 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 		// already has decision task
 		return nil
 	}
+	if mutableState.GetExecutionInfo().Paused {
+		// backoff timer will be regenerated when the workflow is unpaused
+		return nil
+	}
 
 	// schedule first decision task
 	return t.updateWorkflowExecution(ctx, wfContext, mutableState, true)
@@ -558,6 +569,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.GetExecutionInfo().Paused {
+		// the activity task will be regenerated when the workflow is unpaused
+		return nil
+	}
 
 	domainID := task.DomainID
 	targetDomainID := domainID
@@ -581,6 +596,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		}
 	}
 
+	isolationGroup := execution.GetTaskIsolationGroup(ctx, t.config, mutableState)
+	priority := execution.GetActivityTaskPriority(ctx, mutableState, scheduledID)
+	fairnessKey := execution.GetTaskFairnessKey(ctx, mutableState)
+
 	execution := types.WorkflowExecution{
 		WorkflowID: task.WorkflowID,
 		RunID:      task.RunID}
@@ -598,9 +617,14 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		TaskList:                      taskList,
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+		IsolationGroup:                isolationGroup,
+		Priority:                      priority,
+		FairnessKey:                   fairnessKey,
 	})
 }
 
+// executeWorkflowTimeoutTask is not deferred while the workflow is paused,
+// pausing a workflow does not extend its execution timeout
 func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 	ctx context.Context,
 	task *persistence.TimerTaskInfo,
@@ -181,6 +181,8 @@ func (t *timerTaskExecutorBase) archiveWorkflow(
 			NextEventID:          msBuilder.GetNextEventID(),
 			BranchToken:          branchToken,
 			CloseFailoverVersion: closeFailoverVersion,
+			// the close status is recorded so the archived copy can be verified
+			HistoryCloseStatus: persistence.ToInternalWorkflowExecutionCloseStatus(msBuilder.GetExecutionInfo().CloseStatus),
 		},
 		CallerService:        service.History,
 		AttemptArchiveInline: false, // archive in workflow by default
@@ -187,12 +187,19 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.GetExecutionInfo().Paused {
+		// the activity task will be regenerated when the workflow is unpaused
+		return nil
+	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	isolationGroup := execution.GetTaskIsolationGroup(ctx, t.config, mutableState)
+	priority := execution.GetActivityTaskPriority(ctx, mutableState, task.ScheduleID)
+	fairnessKey := execution.GetTaskFairnessKey(ctx, mutableState)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(ctx, task, timeout)
+	return t.pushActivity(ctx, task, timeout, isolationGroup, priority, fairnessKey)
 }
 
 func (t *transferActiveTaskExecutor) processDecisionTask(
@@ -230,6 +237,10 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.GetExecutionInfo().Paused {
+		// the decision task will be regenerated when the workflow is unpaused
+		return nil
+	}
 
 	executionInfo := mutableState.GetExecutionInfo()
 	workflowTimeout := executionInfo.WorkflowTimeout
@@ -258,10 +269,13 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// for the decision. Using MaxTaskTimeout here for now so at least no
 	// decision will be lost.
 
+	isolationGroup := execution.GetTaskIsolationGroup(ctx, t.config, mutableState)
+	priority := execution.GetDecisionTaskPriority(ctx, mutableState)
+	fairnessKey := execution.GetTaskFairnessKey(ctx, mutableState)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	err = t.pushDecision(ctx, task, taskList, decisionTimeout)
+	err = t.pushDecision(ctx, task, taskList, decisionTimeout, isolationGroup, priority, fairnessKey)
 	if _, ok := err.(*types.StickyWorkerUnavailableError); ok {
 		// sticky worker is unavailable, switch to non-sticky task list
 		taskList = &types.TaskList{
@@ -273,7 +287,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		// There is no need to reset sticky, because if this task is picked by new worker, the new worker will reset
 		// the sticky queue to a new one. However, if worker is completely down, that schedule_to_start timeout task
 		// will re-create a new non-sticky task and reset sticky.
-		err = t.pushDecision(ctx, task, taskList, decisionTimeout)
+		err = t.pushDecision(ctx, task, taskList, decisionTimeout, isolationGroup, priority, fairnessKey)
 	}
 	return err
 }
@@ -868,6 +882,8 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 	}
 
 	attributes := initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes
+	// child workflow is started in the same isolation group as its parent
+	isolationGroup := execution.GetTaskIsolationGroup(ctx, t.config, mutableState)
 	childRunID, err := startWorkflowWithRetry(
 		ctx,
 		t.historyClient,
@@ -877,6 +893,7 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 		targetDomainName,
 		childInfo.CreateRequestID,
 		attributes,
+		isolationGroup,
 	)
 	if err != nil {
 		t.logger.Error("Failed to start child workflow execution",
@@ -1706,6 +1723,7 @@ func startWorkflowWithRetry(
 	targetDomain string,
 	requestID string,
 	attributes *types.StartChildWorkflowExecutionInitiatedEventAttributes,
+	isolationGroup string,
 ) (string, error) {
 
 	// Get parent domain name
@@ -1748,6 +1766,7 @@ func startWorkflowWithRetry(
 		},
 		InitiatedID: task.ScheduleID,
 	}
+	historyStartReq.IsolationGroup = isolationGroup
 
 	startWorkflowCtx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
 	defer cancel()
@@ -139,6 +139,9 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 		if activityInfo.StartedID == common.EmptyEventID {
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
+				execution.GetTaskIsolationGroup(ctx, t.config, mutableState),
+				execution.GetActivityTaskPriority(ctx, mutableState, transferTask.ScheduleID),
+				execution.GetTaskFairnessKey(ctx, mutableState),
 			), nil
 		}
 
@@ -193,6 +196,9 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
 				types.TaskList{Name: executionInfo.TaskList}, // at standby, always use non-sticky tasklist
+				execution.GetTaskIsolationGroup(ctx, t.config, mutableState),
+				execution.GetDecisionTaskPriority(ctx, mutableState),
+				execution.GetTaskFairnessKey(ctx, mutableState),
 			), nil
 		}
 
@@ -589,6 +595,9 @@ func (t *transferStandbyTaskExecutor) pushActivity(
 		ctx,
 		task.(*persistence.TransferTaskInfo),
 		timeout,
+		pushActivityInfo.isolationGroup,
+		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
 	)
 }
 
@@ -610,6 +619,9 @@ func (t *transferStandbyTaskExecutor) pushDecision(
 		task.(*persistence.TransferTaskInfo),
 		&pushDecisionInfo.tasklist,
 		timeout,
+		pushDecisionInfo.isolationGroup,
+		pushDecisionInfo.priority,
+		pushDecisionInfo.fairnessKey,
 	)
 }
 
@@ -37,6 +37,8 @@ var (
 	ErrNotExists = &types.EntityNotExistsError{Message: "workflow execution already completed"}
 	// ErrAlreadyCompleted is the error to indicate workflow execution already completed
 	ErrAlreadyCompleted = &types.WorkflowExecutionAlreadyCompletedError{Message: "workflow execution already completed"}
+	// ErrPaused is the error to indicate a task can not be started because the workflow is paused, the task is regenerated on unpause
+	ErrPaused = &types.EntityNotExistsError{Message: "workflow execution is paused"}
 	// ErrParentMismatch is the error to parent execution is given and mismatch
 	ErrParentMismatch = &types.EntityNotExistsError{Message: "workflow parent does not match"}
 	// ErrDeserializingToken is the error to indicate task token is invalid
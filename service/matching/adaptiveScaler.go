@@ -0,0 +1,262 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const describePartitionTimeout = 5 * time.Second
+
+type (
+	// adaptiveScaler runs on the root partition of a normal task list. It measures
+	// the add and dispatch rates of the task list and scales the number of
+	// partitions up and down. The partition config is persisted in the task list
+	// record of the root partition, from where matching clients load it.
+	//
+	// Write partitions are added together with read partitions, but they are
+	// removed first. The read partitions are only removed once clients had time
+	// to stop writing to them and they have no backlog left
+	adaptiveScaler struct {
+		tlMgr      *taskListManagerImpl
+		timeSource clock.TimeSource
+		// number of tasks added to and dispatched from the root partition without
+		// being forwarded, accessed atomically
+		addCount      int64
+		dispatchCount int64
+
+		lastSampleTime time.Time
+		upscaleSince   time.Time
+		downscaleSince time.Time
+		// last time the number of write partitions changed, read partitions
+		// are only drained after clients have refreshed the partition config
+		writePartitionsUpdateTime time.Time
+
+		// dynamic config values, used until the scaler persists a partition config
+		defaultNumReadPartitions  func() int
+		defaultNumWritePartitions func() int
+
+		describePartition func(partition string) (*types.DescribeTaskListResponse, error)
+	}
+)
+
+func newAdaptiveScaler(tlMgr *taskListManagerImpl) *adaptiveScaler {
+	timeSource := clock.NewRealTimeSource()
+	now := timeSource.Now()
+	s := &adaptiveScaler{
+		tlMgr:                     tlMgr,
+		timeSource:                timeSource,
+		lastSampleTime:            now,
+		writePartitionsUpdateTime: now,
+		defaultNumReadPartitions:  tlMgr.config.NumReadPartitions,
+		defaultNumWritePartitions: tlMgr.config.NumWritePartitions,
+	}
+	s.describePartition = s.describePartitionFromMatching
+	// the persisted partition config takes precedence over dynamic config
+	tlMgr.config.NumReadPartitions = func() int {
+		return s.partitionConfig().NumReadPartitions
+	}
+	tlMgr.config.NumWritePartitions = func() int {
+		return s.partitionConfig().NumWritePartitions
+	}
+	return s
+}
+
+func (s *adaptiveScaler) Start() {
+	go s.updatePump()
+}
+
+// recordAdd is called for every task added to the root partition which is not forwarded from a child partition
+func (s *adaptiveScaler) recordAdd() {
+	atomic.AddInt64(&s.addCount, 1)
+}
+
+// recordDispatch is called for every task dispatched from the root partition which is not forwarded from a child partition
+func (s *adaptiveScaler) recordDispatch() {
+	atomic.AddInt64(&s.dispatchCount, 1)
+}
+
+// partitionConfig returns the partition config in effect for the task list
+func (s *adaptiveScaler) partitionConfig() *persistence.TaskListPartitionConfig {
+	if s.tlMgr.config.EnableAdaptiveScaler() {
+		if partitionConfig := s.tlMgr.db.PartitionConfig(); partitionConfig != nil {
+			return partitionConfig
+		}
+	}
+	return &persistence.TaskListPartitionConfig{
+		NumReadPartitions:  s.defaultNumReadPartitions(),
+		NumWritePartitions: s.defaultNumWritePartitions(),
+	}
+}
+
+func (s *adaptiveScaler) updatePump() {
+	s.tlMgr.startWG.Wait()
+
+	updateTimer := time.NewTimer(s.tlMgr.config.AdaptiveScalerUpdateInterval())
+	defer updateTimer.Stop()
+	for {
+		select {
+		case <-s.tlMgr.shutdownCh:
+			return
+		case <-updateTimer.C:
+			s.update()
+			updateTimer.Reset(s.tlMgr.config.AdaptiveScalerUpdateInterval())
+		}
+	}
+}
+
+func (s *adaptiveScaler) update() {
+	now := s.timeSource.Now()
+	addCount := atomic.SwapInt64(&s.addCount, 0)
+	dispatchCount := atomic.SwapInt64(&s.dispatchCount, 0)
+	elapsed := now.Sub(s.lastSampleTime)
+	s.lastSampleTime = now
+
+	config := s.tlMgr.config
+	if !config.EnableAdaptiveScaler() || elapsed <= 0 {
+		s.upscaleSince = time.Time{}
+		s.downscaleSince = time.Time{}
+		return
+	}
+
+	current := s.partitionConfig()
+	// tasks are spread evenly across write partitions, so the root partition
+	// only sees its share of the traffic of the task list
+	addQPS := float64(addCount) / elapsed.Seconds() * float64(current.NumWritePartitions)
+	dispatchQPS := float64(dispatchCount) / elapsed.Seconds() * float64(current.NumWritePartitions)
+	qps := math.Max(addQPS, dispatchQPS)
+
+	scope := s.tlMgr.metricScope().Tagged(getTaskListTypeTag(s.tlMgr.taskListID.taskType))
+	scope.UpdateGauge(metrics.EstimatedAddTaskQPSPerTaskListGauge, addQPS)
+	scope.UpdateGauge(metrics.EstimatedDispatchTaskQPSPerTaskListGauge, dispatchQPS)
+	scope.UpdateGauge(metrics.ReadPartitionsPerTaskListGauge, float64(current.NumReadPartitions))
+	scope.UpdateGauge(metrics.WritePartitionsPerTaskListGauge, float64(current.NumWritePartitions))
+
+	upscaleRPS := float64(config.PartitionUpscaleRPS())
+	maxPartitions := config.AdaptiveScalerMaxPartitions()
+	upscaleTarget := common.MinInt(maxPartitions, int(math.Ceil(qps/upscaleRPS)))
+	downscaleTarget := current.NumWritePartitions
+	if downscaleFactor := config.PartitionDownscaleFactor(); downscaleFactor > 0 {
+		downscaleTarget = common.MinInt(maxPartitions, common.MaxInt(1, int(math.Ceil(qps/(upscaleRPS*downscaleFactor)))))
+	}
+
+	switch {
+	case upscaleTarget > current.NumWritePartitions:
+		s.downscaleSince = time.Time{}
+		if s.isSustained(&s.upscaleSince, now, config.PartitionUpscaleSustainedDuration()) {
+			scope.IncCounter(metrics.PartitionUpscaleCounter)
+			s.updatePartitionConfig(current, common.MaxInt(current.NumReadPartitions, upscaleTarget), upscaleTarget)
+			return
+		}
+	case downscaleTarget < current.NumWritePartitions:
+		s.upscaleSince = time.Time{}
+		if s.isSustained(&s.downscaleSince, now, config.PartitionDownscaleSustainedDuration()) {
+			scope.IncCounter(metrics.PartitionDownscaleCounter)
+			s.updatePartitionConfig(current, current.NumReadPartitions, downscaleTarget)
+			return
+		}
+	default:
+		s.upscaleSince = time.Time{}
+		s.downscaleSince = time.Time{}
+	}
+
+	if current.NumReadPartitions > current.NumWritePartitions &&
+		now.Sub(s.writePartitionsUpdateTime) >= 2*config.PartitionConfigRefreshInterval() &&
+		s.isDrained(current.NumWritePartitions, current.NumReadPartitions) {
+		s.updatePartitionConfig(current, current.NumWritePartitions, current.NumWritePartitions)
+	}
+}
+
+// isSustained returns true when the condition tracked by since has held for at least the given duration
+func (s *adaptiveScaler) isSustained(since *time.Time, now time.Time, duration time.Duration) bool {
+	if since.IsZero() {
+		*since = now
+	}
+	if now.Sub(*since) < duration {
+		return false
+	}
+	*since = time.Time{}
+	return true
+}
+
+// isDrained returns true when the partitions in range [from, to) have no backlog left
+func (s *adaptiveScaler) isDrained(from int, to int) bool {
+	for i := from; i < to; i++ {
+		partition := fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, s.tlMgr.taskListID.name, i)
+		resp, err := s.describePartition(partition)
+		if err != nil {
+			s.tlMgr.logger.Warn("Failed to describe task list partition", tag.WorkflowTaskListName(partition), tag.Error(err))
+			return false
+		}
+		status := resp.GetTaskListStatus()
+		if status == nil || status.GetBacklogCountHint() > 0 || status.GetReadLevel() > status.GetAckLevel() {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *adaptiveScaler) updatePartitionConfig(current *persistence.TaskListPartitionConfig, numRead int, numWrite int) {
+	partitionConfig := &persistence.TaskListPartitionConfig{
+		Version:            current.Version + 1,
+		NumReadPartitions:  numRead,
+		NumWritePartitions: numWrite,
+	}
+	_, err := s.tlMgr.executeWithRetry(func() (interface{}, error) {
+		return nil, s.tlMgr.db.UpdatePartitionConfig(partitionConfig)
+	})
+	if err != nil {
+		s.tlMgr.logger.Error("Failed to update task list partition config",
+			tag.TaskListPartitionConfig(partitionConfig),
+			tag.Error(err))
+		return
+	}
+	if numWrite != current.NumWritePartitions {
+		s.writePartitionsUpdateTime = s.timeSource.Now()
+	}
+	s.tlMgr.logger.Info("Task list partition config updated",
+		tag.TaskListPartitionConfig(partitionConfig))
+}
+
+func (s *adaptiveScaler) describePartitionFromMatching(partition string) (*types.DescribeTaskListResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), describePartitionTimeout)
+	defer cancel()
+	taskListType := types.TaskListType(s.tlMgr.taskListID.taskType)
+	return s.tlMgr.engine.matchingClient.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: s.tlMgr.taskListID.domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:              &types.TaskList{Name: partition, Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: true,
+		},
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func adaptiveScalerTestConfig(enabled bool) *Config {
+	config := defaultTestConfig()
+	config.EnableAdaptiveScaler = dynamicconfig.GetBoolPropertyFnFilteredByTaskListInfo(enabled)
+	config.PartitionUpscaleRPS = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(10)
+	config.PartitionDownscaleFactor = dynamicconfig.GetFloatPropertyFn(0.75)
+	config.AdaptiveScalerMaxPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(4)
+	config.PartitionUpscaleSustainedDuration = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+	config.PartitionDownscaleSustainedDuration = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(2 * time.Minute)
+	config.PartitionConfigRefreshInterval = dynamicconfig.GetDurationPropertyFn(10 * time.Second)
+	return config
+}
+
+func setupAdaptiveScaler(t *testing.T, enabled bool) (*taskListManagerImpl, *clock.EventTimeSource) {
+	controller := gomock.NewController(t)
+	tlm := createTestTaskListManagerWithConfig(controller, adaptiveScalerTestConfig(enabled))
+	_, err := tlm.db.RenewLease()
+	require.NoError(t, err)
+	require.NotNil(t, tlm.adaptiveScaler)
+
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	tlm.adaptiveScaler.timeSource = timeSource
+	tlm.adaptiveScaler.lastSampleTime = now
+	tlm.adaptiveScaler.writePartitionsUpdateTime = now
+	return tlm, timeSource
+}
+
+// sample records the given number of adds on the root partition and runs the scaler after the given duration
+func sample(tlm *taskListManagerImpl, timeSource *clock.EventTimeSource, adds int, d time.Duration) {
+	for i := 0; i < adds; i++ {
+		tlm.adaptiveScaler.recordAdd()
+	}
+	timeSource.Update(timeSource.Now().Add(d))
+	tlm.adaptiveScaler.update()
+}
+
+func TestAdaptiveScalerUpscale(t *testing.T) {
+	tlm, timeSource := setupAdaptiveScaler(t, true)
+
+	// 30 qps needs 3 partitions, but it has to be sustained
+	sample(tlm, timeSource, 300, 10*time.Second)
+	assert.Nil(t, tlm.db.PartitionConfig())
+	assert.Equal(t, 1, tlm.config.NumReadPartitions())
+
+	sample(tlm, timeSource, 1800, time.Minute)
+	assert.Equal(t, &persistence.TaskListPartitionConfig{Version: 1, NumReadPartitions: 3, NumWritePartitions: 3}, tlm.db.PartitionConfig())
+	assert.Equal(t, 3, tlm.config.NumReadPartitions())
+	assert.Equal(t, 3, tlm.config.NumWritePartitions())
+	assert.Equal(t, &types.TaskListPartitionConfig{Version: 1, NumReadPartitions: 3, NumWritePartitions: 3}, tlm.DescribeTaskList(false).GetPartitionConfig())
+
+	// the root partition sees a third of the traffic, which is capped by the max number of partitions
+	sample(tlm, timeSource, 6000, time.Minute)
+	sample(tlm, timeSource, 6000, time.Minute)
+	assert.Equal(t, &persistence.TaskListPartitionConfig{Version: 2, NumReadPartitions: 4, NumWritePartitions: 4}, tlm.db.PartitionConfig())
+}
+
+func TestAdaptiveScalerDownscale(t *testing.T) {
+	tlm, timeSource := setupAdaptiveScaler(t, true)
+	require.NoError(t, tlm.db.UpdatePartitionConfig(&persistence.TaskListPartitionConfig{Version: 1, NumReadPartitions: 3, NumWritePartitions: 3}))
+
+	var described []string
+	backlog := int64(5)
+	tlm.adaptiveScaler.describePartition = func(partition string) (*types.DescribeTaskListResponse, error) {
+		described = append(described, partition)
+		return &types.DescribeTaskListResponse{
+			TaskListStatus: &types.TaskListStatus{BacklogCountHint: backlog},
+		}, nil
+	}
+
+	// 6 qps fits into a single partition, write partitions are removed first
+	sample(tlm, timeSource, 20, 10*time.Second)
+	assert.Equal(t, 3, tlm.db.PartitionConfig().NumWritePartitions)
+	sample(tlm, timeSource, 240, 2*time.Minute)
+	assert.Equal(t, &persistence.TaskListPartitionConfig{Version: 2, NumReadPartitions: 3, NumWritePartitions: 1}, tlm.db.PartitionConfig())
+
+	// read partitions are kept until clients have refreshed the partition config
+	sample(tlm, timeSource, 20, 10*time.Second)
+	assert.Empty(t, described)
+	assert.Equal(t, 3, tlm.db.PartitionConfig().NumReadPartitions)
+
+	// and until the removed partitions are drained
+	sample(tlm, timeSource, 20, 10*time.Second)
+	assert.Equal(t, []string{"/__cadence_sys/tl/1"}, described)
+	assert.Equal(t, 3, tlm.db.PartitionConfig().NumReadPartitions)
+
+	backlog = 0
+	described = nil
+	sample(tlm, timeSource, 20, 10*time.Second)
+	assert.Equal(t, []string{"/__cadence_sys/tl/1", "/__cadence_sys/tl/2"}, described)
+	assert.Equal(t, &persistence.TaskListPartitionConfig{Version: 3, NumReadPartitions: 1, NumWritePartitions: 1}, tlm.db.PartitionConfig())
+	assert.Equal(t, 1, tlm.config.NumReadPartitions())
+}
+
+func TestAdaptiveScalerDisabled(t *testing.T) {
+	tlm, timeSource := setupAdaptiveScaler(t, false)
+	require.NoError(t, tlm.db.UpdatePartitionConfig(&persistence.TaskListPartitionConfig{Version: 1, NumReadPartitions: 3, NumWritePartitions: 3}))
+
+	// the persisted partition config is ignored and never updated
+	assert.Equal(t, 1, tlm.config.NumReadPartitions())
+	assert.Nil(t, tlm.DescribeTaskList(false).GetPartitionConfig())
+	sample(tlm, timeSource, 3000, time.Minute)
+	sample(tlm, timeSource, 3000, time.Minute)
+	assert.Equal(t, int64(1), tlm.db.PartitionConfig().Version)
+}
+
+func TestAdaptiveScalerOwnershipChange(t *testing.T) {
+	tlm, _ := setupAdaptiveScaler(t, true)
+	require.NoError(t, tlm.db.UpdatePartitionConfig(&persistence.TaskListPartitionConfig{Version: 2, NumReadPartitions: 3, NumWritePartitions: 1}))
+
+	// the new owner of the root partition loads the partition config from the task list record,
+	// the removed partitions are still read from until they are drained
+	tlKind := types.TaskListKindNormal
+	newOwner, err := newTaskListManager(tlm.engine, tlm.taskListID, &tlKind, adaptiveScalerTestConfig(true))
+	require.NoError(t, err)
+	tlm = newOwner.(*taskListManagerImpl)
+	_, err = tlm.db.RenewLease()
+	require.NoError(t, err)
+	assert.Equal(t, &persistence.TaskListPartitionConfig{Version: 2, NumReadPartitions: 3, NumWritePartitions: 1}, tlm.db.PartitionConfig())
+	assert.Equal(t, 3, tlm.config.NumReadPartitions())
+	assert.Equal(t, 1, tlm.config.NumWritePartitions())
+
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	tlm.adaptiveScaler.timeSource = timeSource
+	tlm.adaptiveScaler.lastSampleTime = now
+	tlm.adaptiveScaler.writePartitionsUpdateTime = now
+	tlm.adaptiveScaler.describePartition = func(partition string) (*types.DescribeTaskListResponse, error) {
+		return &types.DescribeTaskListResponse{TaskListStatus: &types.TaskListStatus{}}, nil
+	}
+
+	// the new owner doesn't know when the write partitions were removed, so it waits for clients to refresh again
+	sample(tlm, timeSource, 20, 10*time.Second)
+	assert.Equal(t, 3, tlm.db.PartitionConfig().NumReadPartitions)
+	sample(tlm, timeSource, 20, 10*time.Second)
+	assert.Equal(t, &persistence.TaskListPartitionConfig{Version: 3, NumReadPartitions: 1, NumWritePartitions: 1}, tlm.db.PartitionConfig())
+}
@@ -21,6 +21,7 @@
 package matching
 
 import (
+	"strings"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -53,6 +54,16 @@ type (
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
+		// adaptive scaler configuration
+		EnableAdaptiveScaler                dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleRPS                 dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleFactor            dynamicconfig.FloatPropertyFn
+		AdaptiveScalerMaxPartitions         dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		AdaptiveScalerUpdateInterval        dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleSustainedDuration   dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleSustainedDuration dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionConfigRefreshInterval      dynamicconfig.DurationPropertyFn
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
@@ -69,6 +80,21 @@ type (
 		EnableTaskInfoLogByDomainID dynamicconfig.BoolPropertyFnWithDomainIDFilter
 
 		ActivityTaskSyncMatchWaitTime dynamicconfig.DurationPropertyFnWithDomainFilter
+
+		// isolation group configuration
+		EnableTaskIsolation    dynamicconfig.BoolPropertyFnWithDomainFilter
+		TaskIsolationDuration  dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		AllIsolationGroups     dynamicconfig.StringPropertyFnWithDomainFilter
+		DrainedIsolationGroups dynamicconfig.StringPropertyFnWithDomainFilter
+
+		// task priority configuration
+		TaskPriorityWeights dynamicconfig.MapPropertyFn
+
+		// task fairness configuration
+		TaskFairnessKey                 dynamicconfig.StringPropertyFnWithDomainFilter
+		TaskFairnessWorkflowIDDelimiter dynamicconfig.StringPropertyFnWithDomainFilter
+		TaskFairnessWeights             dynamicconfig.MapPropertyFn
+		TaskFairnessBacklogs            dynamicconfig.IntPropertyFnWithDomainFilter
 	}
 
 	forwarderConfig struct {
@@ -95,40 +121,78 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// adaptive scaler configuration
+		EnableAdaptiveScaler                func() bool
+		PartitionUpscaleRPS                 func() int
+		PartitionDownscaleFactor            func() float64
+		AdaptiveScalerMaxPartitions         func() int
+		AdaptiveScalerUpdateInterval        func() time.Duration
+		PartitionUpscaleSustainedDuration   func() time.Duration
+		PartitionDownscaleSustainedDuration func() time.Duration
+		PartitionConfigRefreshInterval      func() time.Duration
+		// isolation group configuration
+		EnableTaskIsolation    func() bool
+		TaskIsolationDuration  func() time.Duration
+		AllIsolationGroups     func() map[string]struct{}
+		DrainedIsolationGroups func() map[string]struct{}
+		// task priority configuration
+		TaskPriorityWeights func() map[int]int
+		// task fairness configuration
+		TaskFairnessKey                 func() string
+		TaskFairnessWorkflowIDDelimiter func() string
+		TaskFairnessWeights             func() map[string]int
+		TaskFairnessBacklogs            func() int
 	}
 )
 
 // NewConfig returns new service config with default values
 func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS),
-		PersistenceGlobalMaxQPS:         dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS),
-		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch),
-		UserRPS:                         dc.GetIntProperty(dynamicconfig.MatchingUserRPS),
-		WorkerRPS:                       dc.GetIntProperty(dynamicconfig.MatchingWorkerRPS),
-		DomainUserRPS:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainUserRPS),
-		DomainWorkerRPS:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainWorkerRPS),
-		RangeSize:                       100000,
-		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize),
-		UpdateAckInterval:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval),
-		IdleTasklistCheckInterval:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval),
-		MaxTasklistIdleTime:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime),
-		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval),
-		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize),
-		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize),
-		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold),
-		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize),
-		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS),
-		NumTasklistWritePartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
-		NumTasklistReadPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
-		ForwarderMaxOutstandingPolls:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls),
-		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks),
-		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond),
-		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode),
-		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration),
-		EnableDebugMode:                 dc.GetBoolProperty(dynamicconfig.EnableDebugMode)(),
-		EnableTaskInfoLogByDomainID:     dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID),
-		ActivityTaskSyncMatchWaitTime:   dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingActivityTaskSyncMatchWaitTime),
+		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS),
+		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS),
+		EnableSyncMatch:                     dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch),
+		UserRPS:                             dc.GetIntProperty(dynamicconfig.MatchingUserRPS),
+		WorkerRPS:                           dc.GetIntProperty(dynamicconfig.MatchingWorkerRPS),
+		DomainUserRPS:                       dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainUserRPS),
+		DomainWorkerRPS:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainWorkerRPS),
+		RangeSize:                           100000,
+		GetTasksBatchSize:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize),
+		UpdateAckInterval:                   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval),
+		IdleTasklistCheckInterval:           dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval),
+		MaxTasklistIdleTime:                 dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime),
+		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval),
+		MinTaskThrottlingBurstSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize),
+		MaxTaskDeleteBatchSize:              dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize),
+		OutstandingTaskAppendsThreshold:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold),
+		MaxTaskBatchSize:                    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize),
+		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS),
+		NumTasklistWritePartitions:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
+		NumTasklistReadPartitions:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
+		ForwarderMaxOutstandingPolls:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls),
+		ForwarderMaxOutstandingTasks:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks),
+		ForwarderMaxRatePerSecond:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond),
+		ForwarderMaxChildrenPerNode:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode),
+		EnableAdaptiveScaler:                dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableAdaptiveScaler),
+		PartitionUpscaleRPS:                 dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleRPS),
+		PartitionDownscaleFactor:            dc.GetFloat64Property(dynamicconfig.MatchingPartitionDownscaleFactor),
+		AdaptiveScalerMaxPartitions:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveScalerMaxPartitions),
+		AdaptiveScalerUpdateInterval:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveScalerUpdateInterval),
+		PartitionUpscaleSustainedDuration:   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleSustainedDuration),
+		PartitionDownscaleSustainedDuration: dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleSustainedDuration),
+		PartitionConfigRefreshInterval:      dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval),
+		ShutdownDrainDuration:               dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration),
+		EnableDebugMode:                     dc.GetBoolProperty(dynamicconfig.EnableDebugMode)(),
+		EnableTaskInfoLogByDomainID:         dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID),
+		ActivityTaskSyncMatchWaitTime:       dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingActivityTaskSyncMatchWaitTime),
+		EnableTaskIsolation:                 dc.GetBoolPropertyFilteredByDomain(dynamicconfig.MatchingEnableTaskIsolation),
+		TaskIsolationDuration:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskIsolationDuration),
+		AllIsolationGroups:                  dc.GetStringPropertyFilteredByDomain(dynamicconfig.MatchingAllIsolationGroups),
+		DrainedIsolationGroups:              dc.GetStringPropertyFilteredByDomain(dynamicconfig.MatchingDrainedIsolationGroups),
+		TaskPriorityWeights:                 dc.GetMapProperty(dynamicconfig.MatchingTaskPriorityWeights),
+		TaskFairnessKey:                     dc.GetStringPropertyFilteredByDomain(dynamicconfig.MatchingTaskFairnessKey),
+		TaskFairnessWorkflowIDDelimiter:     dc.GetStringPropertyFilteredByDomain(dynamicconfig.MatchingTaskFairnessWorkflowIDDelimiter),
+		TaskFairnessWeights:                 dc.GetMapProperty(dynamicconfig.MatchingTaskFairnessWeights),
+		TaskFairnessBacklogs:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingTaskFairnessBacklogs),
 	}
 }
 
@@ -178,6 +242,57 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTasklistReadPartitions(domainName, taskListName, taskType))
 		},
+		EnableAdaptiveScaler: func() bool {
+			return config.EnableAdaptiveScaler(domainName, taskListName, taskType)
+		},
+		PartitionUpscaleRPS: func() int {
+			return common.MaxInt(1, config.PartitionUpscaleRPS(domainName, taskListName, taskType))
+		},
+		PartitionDownscaleFactor: func() float64 {
+			return config.PartitionDownscaleFactor()
+		},
+		AdaptiveScalerMaxPartitions: func() int {
+			return common.MaxInt(1, config.AdaptiveScalerMaxPartitions(domainName, taskListName, taskType))
+		},
+		AdaptiveScalerUpdateInterval: func() time.Duration {
+			return config.AdaptiveScalerUpdateInterval(domainName, taskListName, taskType)
+		},
+		PartitionUpscaleSustainedDuration: func() time.Duration {
+			return config.PartitionUpscaleSustainedDuration(domainName, taskListName, taskType)
+		},
+		PartitionDownscaleSustainedDuration: func() time.Duration {
+			return config.PartitionDownscaleSustainedDuration(domainName, taskListName, taskType)
+		},
+		PartitionConfigRefreshInterval: func() time.Duration {
+			return config.PartitionConfigRefreshInterval()
+		},
+		EnableTaskIsolation: func() bool {
+			return config.EnableTaskIsolation(domainName)
+		},
+		TaskIsolationDuration: func() time.Duration {
+			return config.TaskIsolationDuration(domainName, taskListName, taskType)
+		},
+		AllIsolationGroups: func() map[string]struct{} {
+			return parseIsolationGroups(config.AllIsolationGroups(domainName))
+		},
+		DrainedIsolationGroups: func() map[string]struct{} {
+			return parseIsolationGroups(config.DrainedIsolationGroups(domainName))
+		},
+		TaskPriorityWeights: func() map[int]int {
+			return parsePriorityWeights(config.TaskPriorityWeights())
+		},
+		TaskFairnessKey: func() string {
+			return config.TaskFairnessKey(domainName)
+		},
+		TaskFairnessWorkflowIDDelimiter: func() string {
+			return config.TaskFairnessWorkflowIDDelimiter(domainName)
+		},
+		TaskFairnessWeights: func() map[string]int {
+			return parseFairnessWeights(config.TaskFairnessWeights())
+		},
+		TaskFairnessBacklogs: func() int {
+			return config.TaskFairnessBacklogs(domainName)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(domainName, taskListName, taskType)
@@ -194,3 +309,43 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		},
 	}, nil
 }
+
+// parseIsolationGroups converts a comma separated list of isolation groups into a set
+func parseIsolationGroups(groups string) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, group := range strings.Split(groups, ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			result[group] = struct{}{}
+		}
+	}
+	return result
+}
+
+// parsePriorityWeights converts the priority weights from dynamic config, falling back to
+// a single default level when the value is invalid
+func parsePriorityWeights(weights map[string]interface{}) map[int]int {
+	result, err := common.ConvertDynamicConfigMapPropertyToIntMap(weights)
+	if err != nil || len(result) == 0 {
+		return map[int]int{0: 1}
+	}
+	return result
+}
+
+// parseFairnessWeights converts the fairness weights from dynamic config, values which
+// are not numbers are ignored so that their keys get the default weight
+func parseFairnessWeights(weights map[string]interface{}) map[string]int {
+	result := make(map[string]int, len(weights))
+	for key, value := range weights {
+		switch value := value.(type) {
+		case float64:
+			result[key] = int(value)
+		case int:
+			result[key] = value
+		case int32:
+			result[key] = int(value)
+		case int64:
+			result[key] = int(value)
+		}
+	}
+	return result
+}
@@ -40,8 +40,10 @@ type (
 		taskType     int
 		rangeID      int64
 		ackLevel     int64
-		store        persistence.TaskManager
-		logger       log.Logger
+		// partitionConfig is only persisted for the root partition
+		partitionConfig *persistence.TaskListPartitionConfig
+		store           persistence.TaskManager
+		logger          log.Logger
 	}
 	taskListState struct {
 		rangeID  int64
@@ -78,6 +80,13 @@ func (db *taskListDB) RangeID() int64 {
 	return db.rangeID
 }
 
+// PartitionConfig returns the current persistence view of partition config
+func (db *taskListDB) PartitionConfig() *persistence.TaskListPartitionConfig {
+	db.Lock()
+	defer db.Unlock()
+	return db.partitionConfig
+}
+
 // RenewLease renews the lease on a tasklist. If there is no previous lease,
 // this method will attempt to steal tasklist from current owner
 func (db *taskListDB) RenewLease() (taskListState, error) {
@@ -96,6 +105,7 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
+	db.partitionConfig = resp.TaskListInfo.PartitionConfig
 	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
 }
 
@@ -105,12 +115,13 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:        db.domainID,
+			Name:            db.taskListName,
+			TaskType:        db.taskType,
+			AckLevel:        ackLevel,
+			RangeID:         db.rangeID,
+			Kind:            db.taskListKind,
+			PartitionConfig: db.partitionConfig,
 		},
 		DomainName: db.domainName,
 	})
@@ -120,18 +131,41 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// UpdatePartitionConfig updates the partition config of the taskList with the given value
+func (db *taskListDB) UpdatePartitionConfig(partitionConfig *persistence.TaskListPartitionConfig) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:        db.domainID,
+			Name:            db.taskListName,
+			TaskType:        db.taskType,
+			AckLevel:        db.ackLevel,
+			RangeID:         db.rangeID,
+			Kind:            db.taskListKind,
+			PartitionConfig: partitionConfig,
+		},
+		DomainName: db.domainName,
+	})
+	if err == nil {
+		db.partitionConfig = partitionConfig
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
 	defer db.Unlock()
 	return db.store.CreateTasks(context.Background(), &persistence.CreateTasksRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: db.ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:        db.domainID,
+			Name:            db.taskListName,
+			TaskType:        db.taskType,
+			AckLevel:        db.ackLevel,
+			RangeID:         db.rangeID,
+			Kind:            db.taskListKind,
+			PartitionConfig: db.partitionConfig,
 		},
 		Tasks:      tasks,
 		DomainName: db.domainName,
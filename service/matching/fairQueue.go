@@ -0,0 +1,162 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"strings"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// fairnessKeyDomain dispatches the backlog fairly across the domains of the workflows, which
+	// differ from the task list domain for activities scheduled from other domains
+	fairnessKeyDomain = "domain"
+	// fairnessKeyWorkflowIDPrefix dispatches the backlog fairly across workflow ID prefixes
+	fairnessKeyWorkflowIDPrefix = "workflowIDPrefix"
+	// fairnessKeyHeader dispatches the backlog fairly across the values of the
+	// common.TaskFairnessKeyHeaderName header set when the workflow was started
+	fairnessKeyHeader = "header"
+)
+
+type (
+	// fairQueue holds the staged tasks of a priority level and dispatches them with weighted
+	// round robin across their fairness keys, a key gets as many consecutive dispatches as its
+	// weight so that a single noisy key can't take over the tasks read from the backlogs of the
+	// level. Tasks sync matched to a waiting poller never reach it.
+	// It is not thread safe.
+	fairQueue struct {
+		keys   []string // keys with staged tasks, in the order they were first staged
+		tasks  map[string][]*stagedTask
+		size   int
+		cursor int
+		credit int
+		weight func(key string) int
+	}
+
+	// fairnessKeyFn returns the fairness key of a task
+	fairnessKeyFn func(task *persistence.TaskInfo) string
+)
+
+func newFairQueue(weight func(key string) int) *fairQueue {
+	return &fairQueue{
+		tasks:  make(map[string][]*stagedTask),
+		weight: weight,
+	}
+}
+
+// Len returns the number of staged tasks
+func (q *fairQueue) Len() int {
+	return q.size
+}
+
+// Push stages a task under the given fairness key
+func (q *fairQueue) Push(key string, task *stagedTask) {
+	if _, ok := q.tasks[key]; !ok {
+		q.keys = append(q.keys, key)
+		if len(q.keys) == 1 {
+			q.cursor = 0
+			q.credit = q.keyWeight(key)
+		}
+	}
+	q.tasks[key] = append(q.tasks[key], task)
+	q.size++
+}
+
+// Pop returns the next task to dispatch, or nil if there is no staged task
+func (q *fairQueue) Pop() *stagedTask {
+	if q.size == 0 {
+		return nil
+	}
+
+	key := q.keys[q.cursor]
+	tasks := q.tasks[key]
+	task := tasks[0]
+	tasks[0] = nil
+	q.size--
+
+	if len(tasks) == 1 {
+		// the key has no more tasks, forget it so that the key set stays bounded
+		// by the staged tasks, the cursor now points to the next key
+		delete(q.tasks, key)
+		q.keys = append(q.keys[:q.cursor], q.keys[q.cursor+1:]...)
+		q.moveCursor(q.cursor)
+		return task
+	}
+
+	q.tasks[key] = tasks[1:]
+	if q.credit--; q.credit <= 0 {
+		q.moveCursor(q.cursor + 1)
+	}
+	return task
+}
+
+func (q *fairQueue) moveCursor(cursor int) {
+	if len(q.keys) == 0 {
+		q.cursor = 0
+		q.credit = 0
+		return
+	}
+	q.cursor = cursor % len(q.keys)
+	q.credit = q.keyWeight(q.keys[q.cursor])
+}
+
+func (q *fairQueue) keyWeight(key string) int {
+	return common.MaxInt(1, q.weight(key))
+}
+
+// newFairnessKeyFn returns the function computing the fairness key of tasks,
+// all tasks share the same key when fair dispatch is disabled
+func newFairnessKeyFn(
+	keyType string,
+	workflowIDDelimiter string,
+	domainCache cache.DomainCache,
+) fairnessKeyFn {
+	switch keyType {
+	case fairnessKeyDomain:
+		return func(task *persistence.TaskInfo) string {
+			// weights are configured by domain name, fall back to the ID if the name is unknown
+			domainName, err := domainCache.GetDomainName(task.DomainID)
+			if err != nil {
+				return task.DomainID
+			}
+			return domainName
+		}
+	case fairnessKeyWorkflowIDPrefix:
+		return func(task *persistence.TaskInfo) string {
+			if workflowIDDelimiter == "" {
+				return task.WorkflowID
+			}
+			if i := strings.Index(task.WorkflowID, workflowIDDelimiter); i >= 0 {
+				return task.WorkflowID[:i]
+			}
+			return task.WorkflowID
+		}
+	case fairnessKeyHeader:
+		return func(task *persistence.TaskInfo) string {
+			return task.FairnessKey
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestFairQueue(t *testing.T) {
+	weights := map[string]int{"noisy": 1, "important": 3}
+	q := newFairQueue(func(key string) int { return weights[key] })
+	require.Nil(t, q.Pop())
+
+	for i := int64(1); i <= 6; i++ {
+		q.Push("noisy", &stagedTask{info: &persistence.TaskInfo{TaskID: i}})
+	}
+	for i := int64(7); i <= 10; i++ {
+		q.Push("important", &stagedTask{info: &persistence.TaskInfo{TaskID: i}})
+	}
+	// keys without a weight are dispatched as if their weight was 1
+	q.Push("other", &stagedTask{info: &persistence.TaskInfo{TaskID: 11}})
+	require.Equal(t, 11, q.Len())
+
+	var dispatched []int64
+	for q.Len() > 0 {
+		dispatched = append(dispatched, q.Pop().info.TaskID)
+	}
+	require.Equal(t, []int64{1, 7, 8, 9, 11, 2, 10, 3, 4, 5, 6}, dispatched)
+	require.Empty(t, q.keys)
+	require.Empty(t, q.tasks)
+	require.Nil(t, q.Pop())
+
+	// the queue starts from the first staged key again once drained
+	q.Push("important", &stagedTask{info: &persistence.TaskInfo{TaskID: 12}})
+	q.Push("noisy", &stagedTask{info: &persistence.TaskInfo{TaskID: 13}})
+	require.Equal(t, int64(12), q.Pop().info.TaskID)
+	require.Equal(t, int64(13), q.Pop().info.TaskID)
+}
+
+func TestNewFairnessKeyFn(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	domainCache := cache.NewMockDomainCache(controller)
+	domainCache.EXPECT().GetDomainName("domain-id").Return("domain-name", nil).AnyTimes()
+	domainCache.EXPECT().GetDomainName("unknown-id").Return("", errors.New("not found")).AnyTimes()
+
+	require.Nil(t, newFairnessKeyFn("", ":", domainCache))
+
+	keyFn := newFairnessKeyFn(fairnessKeyDomain, ":", domainCache)
+	require.Equal(t, "domain-name", keyFn(&persistence.TaskInfo{DomainID: "domain-id"}))
+	require.Equal(t, "unknown-id", keyFn(&persistence.TaskInfo{DomainID: "unknown-id"}))
+
+	keyFn = newFairnessKeyFn(fairnessKeyWorkflowIDPrefix, ":", domainCache)
+	require.Equal(t, "tenant", keyFn(&persistence.TaskInfo{WorkflowID: "tenant:order:1"}))
+	require.Equal(t, "no-delimiter", keyFn(&persistence.TaskInfo{WorkflowID: "no-delimiter"}))
+
+	keyFn = newFairnessKeyFn(fairnessKeyWorkflowIDPrefix, "", domainCache)
+	require.Equal(t, "tenant:order:1", keyFn(&persistence.TaskInfo{WorkflowID: "tenant:order:1"}))
+
+	keyFn = newFairnessKeyFn(fairnessKeyHeader, ":", domainCache)
+	require.Equal(t, "tenant-a", keyFn(&persistence.TaskInfo{WorkflowID: "tenant:order:1", FairnessKey: "tenant-a"}))
+	require.Equal(t, "", keyFn(&persistence.TaskInfo{WorkflowID: "tenant:order:1"}))
+}
@@ -137,6 +137,9 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
+			IsolationGroup:                task.isolationGroup,
+			Priority:                      task.event.Priority,
+			FairnessKey:                   task.event.FairnessKey,
 		})
 	case persistence.TaskListTypeActivity:
 		err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -151,6 +154,9 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
+			IsolationGroup:                task.isolationGroup,
+			Priority:                      task.event.Priority,
+			FairnessKey:                   task.event.FairnessKey,
 		})
 	default:
 		return errInvalidTaskListType
@@ -200,6 +206,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	isolationGroup, _ := ctx.Value(isolationGroupKey).(string)
 
 	switch fwdr.taskListID.taskType {
 	case persistence.TaskListTypeDecision:
@@ -213,7 +220,8 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 				},
 				Identity: identity,
 			},
-			ForwardedFrom: fwdr.taskListID.name,
+			ForwardedFrom:  fwdr.taskListID.name,
+			IsolationGroup: isolationGroup,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -230,7 +238,8 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 				},
 				Identity: identity,
 			},
-			ForwardedFrom: fwdr.taskListID.name,
+			ForwardedFrom:  fwdr.taskListID.name,
+			IsolationGroup: isolationGroup,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
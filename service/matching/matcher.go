@@ -23,6 +23,7 @@ package matching
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -43,12 +44,23 @@ type TaskMatcher struct {
 	// are interested in queryTasks but not others. Example is when domain is
 	// not active in a cluster
 	queryTaskC chan *InternalTask
+	// synchronous task channels keyed by isolation group. Pollers that belong to
+	// an isolation group listen on the channel of their group in addition to taskC,
+	// so that tasks of a workflow are handed to pollers of the same group first.
+	// Channels are only created for the configured isolation groups
+	isolatedTaskCLock sync.RWMutex
+	isolatedTaskC     map[string]chan *InternalTask
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter *quotas.RateLimiter
 
 	fwdr          *Forwarder
 	scope         func() metrics.Scope // domain metric scope
 	numPartitions func() int           // number of task list partitions
+
+	enableIsolation        func() bool
+	isolationDuration      func() time.Duration
+	allIsolationGroups     func() map[string]struct{}
+	drainedIsolationGroups func() map[string]struct{}
 }
 
 const (
@@ -70,11 +82,19 @@ func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() me
 		fwdr:          fwdr,
 		taskC:         make(chan *InternalTask),
 		queryTaskC:    make(chan *InternalTask),
+		isolatedTaskC: make(map[string]chan *InternalTask),
 		numPartitions: config.NumReadPartitions,
+
+		enableIsolation:        config.EnableTaskIsolation,
+		isolationDuration:      config.TaskIsolationDuration,
+		allIsolationGroups:     config.AllIsolationGroups,
+		drainedIsolationGroups: config.DrainedIsolationGroups,
 	}
 }
 
 // Offer offers a task to a potential consumer (poller)
+// If the task belongs to an isolation group, only pollers of
+// the same group are considered for the match.
 // If the task is successfully matched with a consumer, this
 // method will return true and no error. If the task is matched
 // but consumer returned error, then this method will return
@@ -115,7 +135,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 	}
 
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.offerTaskC(task) <- task: // poller picked up the task
 		if task.responseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -155,7 +175,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *InternalTask) (bool, error) {
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.offerTaskC(task) <- task: // poller picked up the task
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
@@ -210,15 +230,35 @@ func (tm *TaskMatcher) OfferQuery(ctx context.Context, task *InternalTask) (*typ
 // MustOffer blocks until a consumer is found to handle this task
 // Returns error only when context is canceled or the ratelimit is set to zero (allow nothing)
 // The passed in context MUST NOT have a deadline associated with it
+//
+// A task that belongs to an isolation group is only offered to pollers of
+// the same group until the task isolation duration elapses, after which it
+// can be matched with any poller. The duration counts from the creation of
+// the task, so a backlog of tasks whose group has no pollers doesn't block
+// the dispatch for one isolation duration per task
 func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error {
 	if _, err := tm.ratelimit(ctx); err != nil {
 		return err
 	}
 
+	taskC := tm.offerTaskC(task)
+	var isolationExpiredC <-chan time.Time
+	if taskC != tm.taskC {
+		if timeout := tm.isolationTimeout(task); timeout > 0 {
+			timer := time.NewTimer(timeout)
+			defer timer.Stop()
+			isolationExpiredC = timer.C
+		} else {
+			tm.scope().IncCounter(metrics.IsolationTaskExpiredPerTaskListCounter)
+			task.isolationGroup = ""
+			taskC = tm.taskC
+		}
+	}
+
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
-	case tm.taskC <- task:
+	case taskC <- task:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -228,8 +268,15 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error
 forLoop:
 	for {
 		select {
-		case tm.taskC <- task:
+		case taskC <- task:
 			return nil
+		case <-isolationExpiredC:
+			// no poller of the isolation group picked up the task in time,
+			// from now on the task can be dispatched to any poller
+			tm.scope().IncCounter(metrics.IsolationTaskExpiredPerTaskListCounter)
+			task.isolationGroup = ""
+			taskC = tm.taskC
+			isolationExpiredC = nil
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithDeadline(ctx, time.Now().Add(time.Second*2))
 			err := tm.fwdr.ForwardTask(childCtx, task)
@@ -240,7 +287,7 @@ forLoop:
 				// the next forwarded call after this childCtx expires. Till then, we block
 				// hoping for a local poller match
 				select {
-				case tm.taskC <- task:
+				case taskC <- task:
 					cancel()
 					return nil
 				case <-childCtx.Done():
@@ -266,28 +313,37 @@ forLoop:
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
 // Returns ErrNoTasks when context deadline is exceeded
+//
+// When the poller belongs to an isolation group (see isolationGroupKey), tasks
+// of the same group are preferred. Pollers of a drained isolation group never
+// receive any task and are returned ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) Poll(ctx context.Context) (*InternalTask, error) {
+	isolationGroup, drained := tm.pollerIsolationGroup(ctx)
+	if drained {
+		return tm.poll(ctx, nil, nil, nil)
+	}
+	isolatedTaskC := tm.getIsolatedTaskC(isolationGroup)
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, tm.taskC, isolatedTaskC, tm.queryTaskC); err == nil {
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC)
+	return tm.pollOrForward(ctx, tm.taskC, isolatedTaskC, tm.queryTaskC)
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*InternalTask, error) {
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, nil, tm.queryTaskC); err == nil {
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctx, nil, nil, tm.queryTaskC)
 }
 
 // UpdateRatelimit updates the task dispatch rate
@@ -312,6 +368,7 @@ func (tm *TaskMatcher) Rate() float64 {
 func (tm *TaskMatcher) pollOrForward(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
@@ -321,6 +378,13 @@ func (tm *TaskMatcher) pollOrForward(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-isolatedTaskC:
+		tm.scope().IncCounter(metrics.IsolationTaskMatchPerTaskListCounter)
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -334,13 +398,14 @@ func (tm *TaskMatcher) pollOrForward(
 			return task, nil
 		}
 		token.release()
-		return tm.poll(ctx, taskC, queryTaskC)
+		return tm.poll(ctx, taskC, isolatedTaskC, queryTaskC)
 	}
 }
 
 func (tm *TaskMatcher) poll(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
@@ -350,6 +415,13 @@ func (tm *TaskMatcher) poll(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-isolatedTaskC:
+		tm.scope().IncCounter(metrics.IsolationTaskMatchPerTaskListCounter)
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -363,8 +435,21 @@ func (tm *TaskMatcher) poll(
 func (tm *TaskMatcher) pollNonBlocking(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
+	// tasks of the poller's own isolation group take precedence
+	select {
+	case task := <-isolatedTaskC:
+		tm.scope().IncCounter(metrics.IsolationTaskMatchPerTaskListCounter)
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
+	default:
+	}
+
 	select {
 	case task := <-taskC:
 		if task.responseC != nil {
@@ -381,6 +466,73 @@ func (tm *TaskMatcher) pollNonBlocking(
 	}
 }
 
+// offerTaskC returns the channel a task should be offered on. Tasks of an
+// isolation group are offered on the channel of the group, unless isolation
+// is disabled or the group is unknown or drained
+func (tm *TaskMatcher) offerTaskC(task *InternalTask) chan *InternalTask {
+	group := task.isolationGroup
+	if !tm.isValidIsolationGroup(group) {
+		return tm.taskC
+	}
+	if _, ok := tm.drainedIsolationGroups()[group]; ok {
+		return tm.taskC
+	}
+	return tm.getIsolatedTaskC(group)
+}
+
+// isolationTimeout returns how much longer the task stays isolated to its group
+func (tm *TaskMatcher) isolationTimeout(task *InternalTask) time.Duration {
+	timeout := tm.isolationDuration()
+	if task.event != nil && !task.event.CreatedTime.IsZero() {
+		timeout -= time.Since(task.event.CreatedTime)
+	}
+	return timeout
+}
+
+// pollerIsolationGroup returns the isolation group of the poller found in ctx
+// and whether that group is drained. The group is empty when isolation is disabled
+// or the group is unknown
+func (tm *TaskMatcher) pollerIsolationGroup(ctx context.Context) (string, bool) {
+	group, _ := ctx.Value(isolationGroupKey).(string)
+	if !tm.isValidIsolationGroup(group) {
+		return "", false
+	}
+	_, drained := tm.drainedIsolationGroups()[group]
+	return group, drained
+}
+
+// isValidIsolationGroup returns true when isolation is enabled and the group
+// is one of the configured isolation groups
+func (tm *TaskMatcher) isValidIsolationGroup(group string) bool {
+	if group == "" || !tm.enableIsolation() {
+		return false
+	}
+	_, ok := tm.allIsolationGroups()[group]
+	return ok
+}
+
+// getIsolatedTaskC returns the task channel of an isolation group, creating it
+// if needed. Returns nil for an empty group, which blocks forever in a select
+func (tm *TaskMatcher) getIsolatedTaskC(group string) chan *InternalTask {
+	if group == "" {
+		return nil
+	}
+	tm.isolatedTaskCLock.RLock()
+	taskC, ok := tm.isolatedTaskC[group]
+	tm.isolatedTaskCLock.RUnlock()
+	if ok {
+		return taskC
+	}
+
+	tm.isolatedTaskCLock.Lock()
+	defer tm.isolatedTaskCLock.Unlock()
+	if taskC, ok = tm.isolatedTaskC[group]; !ok {
+		taskC = make(chan *InternalTask)
+		tm.isolatedTaskC[group] = taskC
+	}
+	return taskC
+}
+
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return noopForwarderTokenC
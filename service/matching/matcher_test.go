@@ -427,6 +427,141 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 	t.True(task.isStarted())
 }
 
+func (t *MatcherTestSuite) TestIsolationSyncMatch() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.matcher.enableIsolation = func() bool { return true }
+	t.matcher.allIsolationGroups = func() map[string]struct{} { return map[string]struct{}{"zone-a": {}, "zone-b": {}} }
+
+	otherGroupWait := ensureAsyncReady(time.Millisecond*200, func(ctx context.Context) {
+		task, err := t.matcher.Poll(context.WithValue(ctx, isolationGroupKey, "zone-b"))
+		t.Equal(ErrNoTasks, err)
+		t.Nil(task)
+	})
+	sameGroupWait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(context.WithValue(ctx, isolationGroupKey, "zone-a"))
+		if err == nil {
+			task.finish(nil)
+		}
+	})
+
+	taskInfo := t.newTaskInfo()
+	taskInfo.IsolationGroup = "zone-a"
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	sameGroupWait()
+	otherGroupWait()
+	t.NoError(err)
+	t.True(syncMatch)
+}
+
+func (t *MatcherTestSuite) TestIsolationMustOfferFallback() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.matcher.enableIsolation = func() bool { return true }
+	t.matcher.allIsolationGroups = func() map[string]struct{} { return map[string]struct{}{"zone-a": {}, "zone-b": {}} }
+	t.matcher.isolationDuration = func() time.Duration { return time.Millisecond * 50 }
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ctx)
+		if err == nil {
+			task.finish(nil)
+		}
+	})
+
+	taskInfo := t.newTaskInfo()
+	taskInfo.IsolationGroup = "zone-a"
+	task := newInternalTask(taskInfo, nil, types.TaskSourceDbBacklog, "", false, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	err := t.matcher.MustOffer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.Empty(task.isolationGroup)
+}
+
+func (t *MatcherTestSuite) TestIsolationMustOfferExpiredTask() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.matcher.enableIsolation = func() bool { return true }
+	t.matcher.allIsolationGroups = func() map[string]struct{} { return map[string]struct{}{"zone-a": {}, "zone-b": {}} }
+	t.matcher.isolationDuration = func() time.Duration { return time.Minute }
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ctx)
+		if err == nil {
+			task.finish(nil)
+		}
+	})
+
+	// the task was created before the isolation duration, it must go to any poller right away
+	taskInfo := t.newTaskInfo()
+	taskInfo.IsolationGroup = "zone-a"
+	taskInfo.CreatedTime = time.Now().Add(-2 * time.Minute)
+	task := newInternalTask(taskInfo, nil, types.TaskSourceDbBacklog, "", false, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	err := t.matcher.MustOffer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.Empty(task.isolationGroup)
+}
+
+func (t *MatcherTestSuite) TestIsolationDrainedGroupPoll() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.matcher.enableIsolation = func() bool { return true }
+	t.matcher.allIsolationGroups = func() map[string]struct{} { return map[string]struct{}{"zone-a": {}, "zone-b": {}} }
+	t.matcher.drainedIsolationGroups = func() map[string]struct{} { return map[string]struct{}{"zone-a": {}} }
+
+	offerCtx, offerCancel := context.WithCancel(context.Background())
+	offerErrC := make(chan error, 1)
+	go func() {
+		offerErrC <- t.matcher.MustOffer(offerCtx, newInternalTask(t.newTaskInfo(), nil, types.TaskSourceDbBacklog, "", false, nil))
+	}()
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), isolationGroupKey, "zone-a"), time.Millisecond*100)
+	task, err := t.matcher.Poll(ctx)
+	cancel()
+	t.Equal(ErrNoTasks, err)
+	t.Nil(task)
+
+	offerCancel()
+	t.Equal(context.Canceled, <-offerErrC)
+}
+
+func (t *MatcherTestSuite) TestIsolationUnknownGroup() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.matcher.enableIsolation = func() bool { return true }
+	t.matcher.allIsolationGroups = func() map[string]struct{} { return map[string]struct{}{"zone-a": {}} }
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(context.WithValue(ctx, isolationGroupKey, "zone-unknown-poller"))
+		if err == nil {
+			task.finish(nil)
+		}
+	})
+
+	taskInfo := t.newTaskInfo()
+	taskInfo.IsolationGroup = "zone-unknown-task"
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.True(syncMatch)
+	t.Empty(t.matcher.isolatedTaskC)
+}
+
 func (t *MatcherTestSuite) newDomainCache() cache.DomainCache {
 	domainName := "test-domain"
 	dc := cache.NewMockDomainCache(t.controller)
@@ -56,8 +56,9 @@ const _stickyPollerUnavailableWindow = 10 * time.Second
 // TODO: Switch implementation from lock/channel based to a partitioned agent
 // to simplify code and reduce possibility of synchronization errors.
 type (
-	pollerIDCtxKey string
-	identityCtxKey string
+	pollerIDCtxKey       string
+	identityCtxKey       string
+	isolationGroupCtxKey string
 
 	queryResult struct {
 		workerResponse *types.MatchingRespondQueryTaskCompletedRequest
@@ -103,8 +104,9 @@ var (
 	ErrNoTasks    = errors.New("no tasks")
 	errPumpClosed = errors.New("task list pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey       pollerIDCtxKey       = "pollerID"
+	identityKey       identityCtxKey       = "identity"
+	isolationGroupKey isolationGroupCtxKey = "isolationGroup"
 
 	_stickyPollerUnavailableError = &types.StickyWorkerUnavailableError{Message: "sticky worker is unavailable, please use non-sticky task list."}
 )
@@ -310,6 +312,12 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleID:             request.GetScheduleID(),
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               request.GetPriority(),
+		FairnessKey:            request.GetFairnessKey(),
+	}
+	if taskListKind == nil || *taskListKind != types.TaskListKindSticky {
+		// sticky task lists have a single poller, there is nothing to isolate
+		taskInfo.IsolationGroup = request.GetIsolationGroup()
 	}
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
 		execution:     request.Execution,
@@ -358,6 +366,9 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleID:             request.GetScheduleID(),
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		IsolationGroup:         request.GetIsolationGroup(),
+		Priority:               request.GetPriority(),
+		FairnessKey:            request.GetFairnessKey(),
 	}
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
 		execution:                request.Execution,
@@ -397,6 +408,7 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, isolationGroupKey, req.GetIsolationGroup())
 		task, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
@@ -506,6 +518,7 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, isolationGroupKey, req.GetIsolationGroup())
 		taskListKind := request.TaskList.Kind
 		task, err := e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
 		if err != nil {
@@ -812,8 +825,17 @@ func (e *matchingEngineImpl) getAllPartitions(
 
 	partitionKeys = append(partitionKeys, rootPartition)
 
-	nWritePartitions := e.config.NumTasklistWritePartitions
-	n := nWritePartitions(request.GetDomain(), rootPartition, taskListType)
+	n := e.config.NumTasklistWritePartitions(request.GetDomain(), rootPartition, taskListType)
+	if e.config.EnableAdaptiveScaler(request.GetDomain(), rootPartition, taskListType) {
+		partitionConfig, err := e.getPartitionConfig(domainID, rootPartition, taskListType)
+		if err != nil {
+			return partitionKeys, err
+		}
+		if partitionConfig != nil {
+			// read partitions are a superset of write partitions
+			n = int(partitionConfig.NumReadPartitions)
+		}
+	}
 	if n <= 0 {
 		return partitionKeys, nil
 	}
@@ -825,6 +847,26 @@ func (e *matchingEngineImpl) getAllPartitions(
 	return partitionKeys, nil
 }
 
+// getPartitionConfig returns the partition config persisted by the adaptive scaler of the root partition
+func (e *matchingEngineImpl) getPartitionConfig(
+	domainID string,
+	rootPartition string,
+	taskListType int,
+) (*types.TaskListPartitionConfig, error) {
+	descTaskListType := types.TaskListType(taskListType)
+	resp, err := e.matchingClient.DescribeTaskList(context.Background(), &types.MatchingDescribeTaskListRequest{
+		DomainUUID: domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:     &types.TaskList{Name: rootPartition, Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType: &descTaskListType,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPartitionConfig(), nil
+}
+
 // Loads a task from persistence and wraps it in a task context
 func (e *matchingEngineImpl) getTask(
 	ctx context.Context, taskList *taskListID, maxDispatchPerSecond *float64, taskListKind *types.TaskListKind,
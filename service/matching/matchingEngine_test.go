@@ -1712,6 +1712,7 @@ type testTaskListManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	partitionConfig *persistence.TaskListPartitionConfig
 	createTaskCount int
 	tasks           *treemap.Map
 }
@@ -1741,12 +1742,25 @@ func newTestTaskListID(domainID string, name string, taskType int) *taskListID {
 	return result
 }
 
+// newTestStoreTaskListID returns the ID of a task list persisted by testTaskManager, which
+// includes the task lists persisting the backlog of a priority level
+func newTestStoreTaskListID(domainID string, name string, taskType int) *taskListID {
+	if result, err := newTaskListID(domainID, name, taskType); err == nil {
+		return result
+	}
+	return &taskListID{
+		qualifiedTaskListName: qualifiedTaskListName{name: name, baseName: name},
+		domainID:              domainID,
+		taskType:              taskType,
+	}
+}
+
 // LeaseTaskList provides a mock function with given fields: ctx, request
 func (m *testTaskManager) LeaseTaskList(
 	_ context.Context,
 	request *persistence.LeaseTaskListRequest,
 ) (*persistence.LeaseTaskListResponse, error) {
-	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(newTestStoreTaskListID(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	tlm.rangeID++
@@ -1754,12 +1768,13 @@ func (m *testTaskManager) LeaseTaskList(
 
 	return &persistence.LeaseTaskListResponse{
 		TaskListInfo: &persistence.TaskListInfo{
-			AckLevel: tlm.ackLevel,
-			DomainID: request.DomainID,
-			Name:     request.TaskList,
-			TaskType: request.TaskType,
-			RangeID:  tlm.rangeID,
-			Kind:     request.TaskListKind,
+			AckLevel:        tlm.ackLevel,
+			DomainID:        request.DomainID,
+			Name:            request.TaskList,
+			TaskType:        request.TaskType,
+			RangeID:         tlm.rangeID,
+			Kind:            request.TaskListKind,
+			PartitionConfig: tlm.partitionConfig,
 		},
 	}, nil
 }
@@ -1772,7 +1787,7 @@ func (m *testTaskManager) UpdateTaskList(
 	m.logger.Debug(fmt.Sprintf("UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel))
 
 	tli := request.TaskListInfo
-	tlm := m.getTaskListManager(newTestTaskListID(tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManager(newTestStoreTaskListID(tli.DomainID, tli.Name, tli.TaskType))
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1782,6 +1797,7 @@ func (m *testTaskManager) UpdateTaskList(
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.partitionConfig = tli.PartitionConfig
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -1796,7 +1812,7 @@ func (m *testTaskManager) CompleteTask(
 	}
 
 	tli := request.TaskList
-	tlm := m.getTaskListManager(newTestTaskListID(tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManager(newTestStoreTaskListID(tli.DomainID, tli.Name, tli.TaskType))
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1810,7 +1826,7 @@ func (m *testTaskManager) CompleteTasksLessThan(
 	_ context.Context,
 	request *persistence.CompleteTasksLessThanRequest,
 ) (*persistence.CompleteTasksLessThanResponse, error) {
-	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskListName, request.TaskType))
+	tlm := m.getTaskListManager(newTestStoreTaskListID(request.DomainID, request.TaskListName, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	rowsDeleted := 0
@@ -1840,7 +1856,7 @@ func (m *testTaskManager) DeleteTaskList(
 ) error {
 	m.Lock()
 	defer m.Unlock()
-	key := newTestTaskListID(request.DomainID, request.TaskListName, request.TaskListType)
+	key := newTestStoreTaskListID(request.DomainID, request.TaskListName, request.TaskListType)
 	delete(m.taskLists, *key)
 	return nil
 }
@@ -1855,7 +1871,7 @@ func (m *testTaskManager) CreateTasks(
 	taskType := request.TaskListInfo.TaskType
 	rangeID := request.TaskListInfo.RangeID
 
-	tlm := m.getTaskListManager(newTestTaskListID(domainID, taskList, taskType))
+	tlm := m.getTaskListManager(newTestStoreTaskListID(domainID, taskList, taskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -1890,6 +1906,7 @@ func (m *testTaskManager) CreateTasks(
 			ScheduleID: scheduleID,
 			TaskID:     task.TaskID,
 			WorkflowID: task.Execution.WorkflowID,
+			Priority:   task.Data.Priority,
 		}
 		if task.Data.ScheduleToStartTimeout != 0 {
 			info.Expiry = time.Now().Add(time.Duration(task.Data.ScheduleToStartTimeout) * time.Second)
@@ -1908,7 +1925,7 @@ func (m *testTaskManager) GetTasks(
 ) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, request.MaxReadLevel))
 
-	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(newTestStoreTaskListID(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	var tasks []*persistence.TaskInfo
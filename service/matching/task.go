@@ -52,6 +52,7 @@ type (
 		domainName               string
 		source                   types.TaskSource
 		forwardedFrom            string     // name of the child partition this task is forwarded from (empty if not forwarded)
+		isolationGroup           string     // isolation group of the workflow this task belongs to (empty if none)
 		responseC                chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint         int64
 		activityTaskDispatchInfo *types.ActivityTaskDispatchInfo
@@ -73,6 +74,7 @@ func newInternalTask(
 		},
 		source:                   source,
 		forwardedFrom:            forwardedFrom,
+		isolationGroup:           info.IsolationGroup,
 		activityTaskDispatchInfo: activityTaskDispatchInfo,
 	}
 	if forSyncMatch {
@@ -95,6 +95,7 @@ type (
 		taskWriter       *taskWriter
 		taskReader       *taskReader // reads tasks from db and async matches it with poller
 		taskGC           *taskGC
+		adaptiveScaler   *adaptiveScaler      // only set for the root partition of a normal task list
 		taskAckManager   messaging.AckManager // tracks ackLevel for delivered messages
 		matcher          *TaskMatcher         // for matching a task producer with a poller
 		domainCache      cache.DomainCache
@@ -183,8 +184,11 @@ func newTaskListManager(
 		taskListTypeMetricScope.UpdateGauge(metrics.PollerPerTaskListCounter,
 			float64(len(tlMgr.pollerHistory.getPollerInfo(time.Time{}))))
 	})
-	tlMgr.taskWriter = newTaskWriter(tlMgr)
+	tlMgr.taskWriter = newTaskWriter(tlMgr, db)
 	tlMgr.taskReader = newTaskReader(tlMgr)
+	if taskList.IsRoot() && *taskListKind == types.TaskListKindNormal {
+		tlMgr.adaptiveScaler = newAdaptiveScaler(tlMgr)
+	}
 	var fwdr *Forwarder
 	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
 		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient)
@@ -200,15 +204,20 @@ func (c *taskListManagerImpl) Start() error {
 	defer c.startWG.Done()
 
 	// Make sure to grab the range first before starting task writer, as it needs the range to initialize maxReadLevel
-	state, err := c.renewLeaseWithRetry()
-	if err != nil {
-		c.Stop()
-		return err
-	}
+	for _, reader := range c.backlogReaders() {
+		state, err := c.renewLeaseWithRetry(reader.db)
+		if err != nil {
+			c.Stop()
+			return err
+		}
 
-	c.taskAckManager.SetAckLevel(state.ackLevel)
-	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
+		reader.ackManager.SetAckLevel(state.ackLevel)
+		reader.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
+	}
 	c.taskReader.Start()
+	if c.adaptiveScaler != nil {
+		c.adaptiveScaler.Start()
+	}
 
 	return nil
 }
@@ -219,7 +228,9 @@ func (c *taskListManagerImpl) Stop() {
 		return
 	}
 	close(c.shutdownCh)
-	c.taskWriter.Stop()
+	for _, reader := range c.backlogReaders() {
+		reader.taskWriter.Stop()
+	}
 	c.taskReader.Stop()
 	c.engine.removeTaskListManager(c.taskListID)
 	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
@@ -231,6 +242,7 @@ func (c *taskListManagerImpl) Stop() {
 func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams) (bool, error) {
 	c.startWG.Wait()
 	var syncMatch bool
+	backlog := c.taskReader.backlogFor(params.taskInfo)
 	_, err := c.executeWithRetry(func() (interface{}, error) {
 		if err := ctx.Err(); err != nil {
 			return nil, err
@@ -250,7 +262,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 				return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 			}
 
-			r, err := c.taskWriter.appendTask(params.execution, params.taskInfo)
+			r, err := backlog.taskWriter.appendTask(params.execution, params.taskInfo)
 			return r, err
 		}
 
@@ -269,7 +281,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 		}
 
-		return c.taskWriter.appendTask(params.execution, params.taskInfo)
+		return backlog.taskWriter.appendTask(params.execution, params.taskInfo)
 	})
 
 	if err != nil {
@@ -280,6 +292,10 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 		)
 	} else {
 		c.taskReader.Signal()
+		backlog.Signal()
+		if c.adaptiveScaler != nil && params.forwardedFrom == "" {
+			c.adaptiveScaler.recordAdd()
+		}
 	}
 
 	return syncMatch, err
@@ -317,7 +333,10 @@ func (c *taskListManagerImpl) GetTask(
 		return nil, err
 	}
 	task.domainName = c.domainName()
-	task.backlogCountHint = c.taskAckManager.GetBacklogCount()
+	task.backlogCountHint = c.getBacklogCount()
+	if c.adaptiveScaler != nil && !task.isForwarded() && !task.isQuery() {
+		c.adaptiveScaler.recordDispatch()
+	}
 	return task, nil
 }
 
@@ -401,10 +420,20 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 }
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
-// pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// pollers which polled this tasklist in last few minutes, status of tasklist's ackManager
+// (readLevel, ackLevel, backlogCountHint, backlog per priority level and taskIDBlock) and the partition config persisted
+// by the adaptive scaler of the root partition.
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse {
 	response := &types.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
+	if c.adaptiveScaler != nil && c.config.EnableAdaptiveScaler() {
+		if partitionConfig := c.db.PartitionConfig(); partitionConfig != nil {
+			response.PartitionConfig = &types.TaskListPartitionConfig{
+				Version:            partitionConfig.Version,
+				NumReadPartitions:  int32(partitionConfig.NumReadPartitions),
+				NumWritePartitions: int32(partitionConfig.NumWritePartitions),
+			}
+		}
+	}
 	if !includeTaskListStatus {
 		return response
 	}
@@ -413,17 +442,57 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
-		BacklogCountHint: c.taskAckManager.GetBacklogCount(),
+		BacklogCountHint: c.getBacklogCount(),
 		RatePerSecond:    c.matcher.Rate(),
 		TaskIDBlock: &types.TaskIDBlock{
 			StartID: taskIDBlock.start,
 			EndID:   taskIDBlock.end,
 		},
+		BacklogCountHintByPriority: c.getBacklogCountHintByPriority(),
 	}
 
 	return response
 }
 
+// getBacklogCountHintByPriority returns an estimate of the backlog of each priority level with backlogs
+// of its own, the backlog of the lowest level includes the tasks of every priority of a task list without
+// lanes. Only levels with a backlog are included.
+func (c *taskListManagerImpl) getBacklogCountHintByPriority() map[int32]int64 {
+	var result map[int32]int64
+	levels := c.taskReader.priorityLevels
+	for _, level := range levels {
+		// without lanes, the levels above the lowest one share its backlog
+		if level.readers[0] == c.taskReader && level != levels[len(levels)-1] {
+			continue
+		}
+		count := int64(0)
+		for _, reader := range level.readers {
+			count += reader.getBacklogCountHint()
+		}
+		if count > 0 {
+			if result == nil {
+				result = make(map[int32]int64)
+			}
+			result[level.priority] = count
+		}
+	}
+	return result
+}
+
+// getBacklogCount returns the number of tasks read from the backlogs of the task list but not completed yet
+func (c *taskListManagerImpl) getBacklogCount() int64 {
+	count := int64(0)
+	for _, reader := range c.backlogReaders() {
+		count += reader.ackManager.GetBacklogCount()
+	}
+	return count
+}
+
+// backlogReaders returns the readers of the task list backlogs, the one of the lowest priority level first
+func (c *taskListManagerImpl) backlogReaders() []*taskReader {
+	return append([]*taskReader{c.taskReader}, c.taskReader.lanes...)
+}
+
 func (c *taskListManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskListID.taskType == persistence.TaskListTypeActivity {
@@ -445,45 +514,15 @@ func (c *taskListManagerImpl) GetTaskListKind() types.TaskListKind {
 	return c.taskListKind
 }
 
-// completeTask marks a task as processed. Only tasks created by taskReader (i.e. backlog from db) reach
-// here. As part of completion:
-//   - task is deleted from the database when err is nil
-//   - new task is created and current task is deleted when err is not nil
+// completeTask marks a task read from the backlog of the lowest priority level as processed
 func (c *taskListManagerImpl) completeTask(task *persistence.TaskInfo, err error) {
-	if err != nil {
-		// failed to start the task.
-		// We cannot just remove it from persistence because then it will be lost.
-		// We handle this by writing the task back to persistence with a higher taskID.
-		// This will allow subsequent tasks to make progress, and hopefully by the time this task is picked-up
-		// again the underlying reason for failing to start will be resolved.
-		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
-		// re-written to persistence frequently.
-		_, err = c.executeWithRetry(func() (interface{}, error) {
-			wf := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
-			return c.taskWriter.appendTask(wf, task)
-		})
-
-		if err != nil {
-			// OK, we also failed to write to persistence.
-			// This should only happen in very extreme cases where persistence is completely down.
-			// We still can't lose the old task so we just unload the entire task list
-			c.logger.Error("Failed to complete task",
-				tag.Error(err),
-				tag.WorkflowTaskListName(c.taskListID.name),
-				tag.WorkflowTaskListType(c.taskListID.taskType))
-			c.Stop()
-			return
-		}
-		c.taskReader.Signal()
-	}
-	ackLevel := c.taskAckManager.AckItem(task.TaskID)
-	c.taskGC.Run(ackLevel)
+	c.taskReader.completeTask(task, err)
 }
 
-func (c *taskListManagerImpl) renewLeaseWithRetry() (taskListState, error) {
+func (c *taskListManagerImpl) renewLeaseWithRetry(db *taskListDB) (taskListState, error) {
 	var newState taskListState
 	op := func() (err error) {
-		newState, err = c.db.RenewLease()
+		newState, err = db.RenewLease()
 		return
 	}
 	c.metricScope().IncCounter(metrics.LeaseRequestPerTaskListCounter)
@@ -507,13 +546,13 @@ func (c *taskListManagerImpl) rangeIDToTaskIDBlock(rangeID int64) taskIDBlock {
 	}
 }
 
-func (c *taskListManagerImpl) allocTaskIDBlock(prevBlockEnd int64) (taskIDBlock, error) {
-	currBlock := c.rangeIDToTaskIDBlock(c.db.RangeID())
+func (c *taskListManagerImpl) allocTaskIDBlock(db *taskListDB, prevBlockEnd int64) (taskIDBlock, error) {
+	currBlock := c.rangeIDToTaskIDBlock(db.RangeID())
 	if currBlock.end != prevBlockEnd {
 		return taskIDBlock{},
 			fmt.Errorf("allocTaskIDBlock: invalid state: prevBlockEnd:%v != currTaskIDBlock:%+v", prevBlockEnd, currBlock)
 	}
-	state, err := c.renewLeaseWithRetry()
+	state, err := c.renewLeaseWithRetry(db)
 	if err != nil {
 		return taskIDBlock{}, err
 	}
@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -135,6 +136,201 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	require.Equal(t, int64(14), tlm.taskAckManager.GetReadLevel())
 }
 
+func TestDispatchBufferedTasksByPriority(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.TaskPriorityWeights = dynamicconfig.GetMapPropertyFn(map[string]interface{}{"0": 1, "5": 2})
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+
+	// tasks with a priority between two levels belong to the lower one
+	priorities := []int32{0, 0, 3, 5, 5, 9, 0}
+	for i, priority := range priorities {
+		task := &persistence.TaskInfo{TaskID: int64(i + 1), Priority: priority}
+		tlm.taskReader.backlogFor(task).taskBuffer <- task
+	}
+	require.Equal(t, 3, len(tlm.taskReader.lanes[0].taskBuffer))
+	require.True(t, tlm.taskReader.stageBufferedTasks())
+	require.Equal(t, len(priorities), tlm.taskReader.stagedTasks)
+
+	// level 5 gets two dispatches for each dispatch of level 0
+	require.Equal(t, []int64{4, 5, 1, 6, 2, 3, 7}, dispatchStagedTasks(tlm))
+
+	close(tlm.taskReader.taskBuffer)
+	require.False(t, tlm.taskReader.stageBufferedTasks())
+}
+
+func TestDispatchBufferedTasksSingleLevel(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	for i := int64(1); i <= 3; i++ {
+		tlm.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: i, Priority: int32(i)}
+	}
+	tlm.taskReader.stageTask(<-tlm.taskReader.taskBuffer)
+	// a single level never stages more than the task being dispatched
+	require.True(t, tlm.taskReader.stageBufferedTasks())
+	require.Equal(t, 1, tlm.taskReader.stagedTasks)
+	require.Equal(t, 2, len(tlm.taskReader.taskBuffer))
+	require.Equal(t, []int64{1}, dispatchStagedTasks(tlm))
+}
+
+func TestDispatchBufferedTasksFairly(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.TaskPriorityWeights = dynamicconfig.GetMapPropertyFn(map[string]interface{}{"0": 1, "1": 1})
+	cfg.TaskFairnessKey = dynamicconfig.GetStringPropertyFnFilteredByDomain(fairnessKeyWorkflowIDPrefix)
+	cfg.TaskFairnessWeights = dynamicconfig.GetMapPropertyFn(map[string]interface{}{"b": 2})
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+
+	workflowIDs := []string{"a:1", "a:2", "a:3", "b:1", "b:2", "b:3"}
+	for i, workflowID := range workflowIDs {
+		tlm.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: int64(i + 1), WorkflowID: workflowID}
+	}
+	// the high priority task is dispatched first, regardless of its fairness key
+	task := &persistence.TaskInfo{TaskID: 7, WorkflowID: "a:4", Priority: 1}
+	tlm.taskReader.backlogFor(task).taskBuffer <- task
+	require.True(t, tlm.taskReader.stageBufferedTasks())
+	require.Equal(t, []int64{7, 1, 4, 5, 2, 6, 3}, dispatchStagedTasks(tlm))
+}
+
+func dispatchStagedTasks(tlm *taskListManagerImpl) []int64 {
+	var dispatched []int64
+	for tlm.taskReader.stagedTasks > 0 {
+		dispatched = append(dispatched, tlm.taskReader.nextStagedTask().info.TaskID)
+	}
+	return dispatched
+}
+
+func TestBacklogCountHintByPriority(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.TaskPriorityWeights = dynamicconfig.GetMapPropertyFn(map[string]interface{}{"0": 1, "2": 1})
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	require.Equal(t, 1, len(tlm.taskReader.lanes))
+	lane := tlm.taskReader.lanes[0]
+
+	// two tasks read and three more not read yet in the backlog of level 0
+	tlm.taskAckManager.SetAckLevel(0)
+	require.NoError(t, tlm.taskAckManager.ReadItem(1))
+	require.NoError(t, tlm.taskAckManager.ReadItem(2))
+	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, 5)
+	lane.ackManager.SetAckLevel(100)
+	atomic.StoreInt64(&lane.taskWriter.maxReadLevel, 100)
+	status := tlm.DescribeTaskList(true).GetTaskListStatus()
+	require.Equal(t, int64(2), status.GetBacklogCountHint())
+	require.Equal(t, map[int32]int64{0: 5}, status.GetBacklogCountHintByPriority())
+
+	// the backlog of level 2 is reported even though none of it is read yet
+	atomic.StoreInt64(&lane.taskWriter.maxReadLevel, 103)
+	status = tlm.DescribeTaskList(true).GetTaskListStatus()
+	require.Equal(t, map[int32]int64{0: 5, 2: 3}, status.GetBacklogCountHintByPriority())
+
+	task := &persistence.TaskInfo{TaskID: 101, Priority: 2}
+	require.True(t, lane.addTasksToBuffer([]*persistence.TaskInfo{task}, time.Now(), time.NewTimer(time.Minute)))
+	status = tlm.DescribeTaskList(true).GetTaskListStatus()
+	require.Equal(t, int64(3), status.GetBacklogCountHint())
+	require.Equal(t, map[int32]int64{0: 5, 2: 3}, status.GetBacklogCountHintByPriority())
+
+	lane.completeTask(task, nil)
+	require.Equal(t, int64(101), lane.ackManager.GetAckLevel())
+	status = tlm.DescribeTaskList(true).GetTaskListStatus()
+	require.Equal(t, map[int32]int64{0: 5, 2: 2}, status.GetBacklogCountHintByPriority())
+}
+
+func TestPriorityLanes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	cfg.TaskPriorityWeights = dynamicconfig.GetMapPropertyFn(map[string]interface{}{"0": 1, "5": 1})
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	lane := tlm.taskReader.lanes[0]
+	require.Equal(t, "/__cadence_sys/tl/0/priority5", lane.db.taskListName)
+
+	// an urgent task behind a backlog much larger than the buffer of the task reader
+	for i := 0; i < 10; i++ {
+		_, err := tlm.taskWriter.appendTask(&types.WorkflowExecution{}, &persistence.TaskInfo{ScheduleID: int64(i)})
+		require.NoError(t, err)
+	}
+	urgentTask := &persistence.TaskInfo{ScheduleID: 100, Priority: 7}
+	backlog := tlm.taskReader.backlogFor(urgentTask)
+	require.Equal(t, lane, backlog)
+	_, err := backlog.taskWriter.appendTask(&types.WorkflowExecution{}, urgentTask)
+	require.NoError(t, err)
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, 10, tm.getTaskCount(tlm.taskListID))
+	require.Equal(t, 1, tm.getTaskCount(newTestStoreTaskListID(tlm.taskListID.domainID, lane.db.taskListName, tlm.taskListID.taskType)))
+
+	// the urgent task is read from its own backlog and dispatched by the second poll at the latest
+	tlm.taskReader.Signal()
+	lane.Signal()
+	require.Eventually(t, func() bool { return lane.ackManager.GetReadLevel() == lane.taskWriter.GetMaxReadLevel() }, time.Second, time.Millisecond)
+	var scheduleIDs []int64
+	for i := 0; i < 2; i++ {
+		task, err := tlm.GetTask(context.Background(), nil)
+		require.NoError(t, err)
+		scheduleIDs = append(scheduleIDs, task.event.ScheduleID)
+		task.finish(nil)
+	}
+	require.Contains(t, scheduleIDs, int64(100))
+	require.Eventually(t, func() bool {
+		return tm.getTaskCount(newTestStoreTaskListID(tlm.taskListID.domainID, lane.db.taskListName, tlm.taskListID.taskType)) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestFairnessBacklogs(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	cfg.TaskFairnessKey = dynamicconfig.GetStringPropertyFnFilteredByDomain(fairnessKeyWorkflowIDPrefix)
+	cfg.TaskFairnessBacklogs = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	require.Equal(t, 1, len(tlm.taskReader.lanes))
+	lane := tlm.taskReader.lanes[0]
+	require.Equal(t, "/__cadence_sys/tl/0/priority0/fair1", lane.db.taskListName)
+
+	// a noisy key fills its backlog with much more than the buffer of the task reader
+	for i := 0; i < 10; i++ {
+		task := &persistence.TaskInfo{WorkflowID: fmt.Sprintf("noisy:%v", i), ScheduleID: int64(i)}
+		require.Equal(t, tlm.taskReader, tlm.taskReader.backlogFor(task))
+		_, err := tlm.taskWriter.appendTask(&types.WorkflowExecution{}, task)
+		require.NoError(t, err)
+	}
+	quietTask := &persistence.TaskInfo{WorkflowID: "quiet:1", ScheduleID: 100}
+	require.Equal(t, lane, tlm.taskReader.backlogFor(quietTask))
+	_, err := lane.taskWriter.appendTask(&types.WorkflowExecution{}, quietTask)
+	require.NoError(t, err)
+
+	// the task of the other key is read from its own backlog and dispatched right after
+	// the noisy tasks staged before it, by the third poll at the latest
+	tlm.taskReader.Signal()
+	lane.Signal()
+	require.Eventually(t, func() bool { return lane.ackManager.GetReadLevel() == lane.taskWriter.GetMaxReadLevel() }, time.Second, time.Millisecond)
+	var scheduleIDs []int64
+	for i := 0; i < 3; i++ {
+		task, err := tlm.GetTask(context.Background(), nil)
+		require.NoError(t, err)
+		scheduleIDs = append(scheduleIDs, task.event.ScheduleID)
+		task.finish(nil)
+	}
+	require.Contains(t, scheduleIDs, int64(100))
+	require.Equal(t, map[int32]int64{0: 8}, tlm.DescribeTaskList(true).GetTaskListStatus().GetBacklogCountHintByPriority())
+}
+
 func createTestTaskListManager(controller *gomock.Controller) *taskListManagerImpl {
 	return createTestTaskListManagerWithConfig(controller, defaultTestConfig())
 }
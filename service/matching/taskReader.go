@@ -23,10 +23,16 @@ package matching
 import (
 	"context"
 	"runtime"
+	"sort"
+	"sync/atomic"
 	"time"
 
+	"github.com/dgryski/go-farm"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -39,6 +45,16 @@ type (
 		taskBuffer chan *persistence.TaskInfo // tasks loaded from persistence
 		notifyC    chan struct{}              // Used as signal to notify pump of new tasks
 		tlMgr      *taskListManagerImpl
+		// the backlog read by this reader. Each priority level of a normal task list persists its
+		// backlog in task lists of its own, read by lane readers, so that the tasks of a level are
+		// read regardless of the backlog of the lower levels. With fair dispatch, the backlog of a
+		// level is further split by the hash of the fairness key so that a noisy key only delays
+		// the keys sharing its backlog. The lowest level reuses the task list for its first backlog
+		db         *taskListDB
+		taskWriter *taskWriter
+		ackManager messaging.AckManager
+		taskGC     *taskGC
+		isLane     bool
 		// The cancel objects are to cancel the ratelimiter Wait in dispatchBufferedTasks. The ideal
 		// approach is to use request-scoped contexts and use a unique one for each call to Wait. However
 		// in order to cancel it on shutdown, we need a new goroutine for each call that would wait on
@@ -49,29 +65,142 @@ type (
 		// separate shutdownC needed for dispatchTasks go routine to allow
 		// getTasksPump to be stopped without stopping dispatchTasks in unit tests
 		dispatcherShutdownC chan struct{}
+		dispatchC           chan struct{} // Used by the lane readers to notify the dispatcher of buffered tasks
+
+		// tasks taken off the buffers are staged per priority level and dispatched with weighted
+		// round robin, these fields are only accessed by dispatchBufferedTasks. Tasks sync matched
+		// to a waiting poller are never staged
+		priorityLevels []*priorityLevel // sorted by priority, highest first
+		lanes          []*taskReader    // readers of the backlogs but the one of this reader, highest level first
+		stagedTasks    int
+		levelCursor    int
+		levelCredit    int
+		// within a priority level, tasks are dispatched fairly across their fairness key
+		fairnessKey     fairnessKeyFn // nil when fair dispatch is disabled
+		fairnessWeights atomic.Value  // map[string]int
+		// staged tasks taken off the buffer of this reader, set by the dispatcher
+		bufferStagedTasks int
+	}
+
+	// priorityLevel holds the staged tasks of a priority level, a level receives
+	// tasks whose priority is at or above its own but below the next higher level
+	priorityLevel struct {
+		priority int32
+		weight   int
+		tasks    *fairQueue
+		readers  []*taskReader // read the backlogs of the level, indexed by fairness backlog
+	}
+
+	// stagedTask is a task waiting for dispatch along with the reader it was read by
+	stagedTask struct {
+		info   *persistence.TaskInfo
+		reader *taskReader
 	}
 )
 
+// newTaskReader creates the reader of the task list backlog which dispatches the tasks
+// of all priority levels, along with the lane readers of the other backlogs
 func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
+	tr := newBacklogReader(tlMgr, tlMgr.db, tlMgr.taskWriter, tlMgr.taskAckManager, tlMgr.taskGC)
+	tr.dispatcherShutdownC = make(chan struct{})
+	tr.dispatchC = make(chan struct{}, 1)
+	tr.fairnessKey = newFairnessKeyFn(
+		tlMgr.config.TaskFairnessKey(),
+		tlMgr.config.TaskFairnessWorkflowIDDelimiter(),
+		tlMgr.domainCache,
+	)
+	tr.fairnessWeights.Store(tlMgr.config.TaskFairnessWeights())
+	tr.priorityLevels = newPriorityLevels(tlMgr.config.TaskPriorityWeights(), tr.getFairnessWeight)
+	tr.levelCredit = tr.priorityLevels[0].weight
+	fairnessBacklogs := 1
+	if tr.fairnessKey != nil {
+		fairnessBacklogs = common.MaxInt(1, tlMgr.config.TaskFairnessBacklogs())
+	}
+	for i, level := range tr.priorityLevels {
+		// sticky task lists are short lived and never get lanes
+		if tlMgr.taskListKind != types.TaskListKindNormal {
+			level.readers = []*taskReader{tr}
+			continue
+		}
+		for fairnessBacklog := 0; fairnessBacklog < fairnessBacklogs; fairnessBacklog++ {
+			if i == len(tr.priorityLevels)-1 && fairnessBacklog == 0 {
+				level.readers = append(level.readers, tr)
+				continue
+			}
+			lane := newLaneReader(tlMgr, tlMgr.taskListID.backlogName(level.priority, fairnessBacklog))
+			level.readers = append(level.readers, lane)
+			tr.lanes = append(tr.lanes, lane)
+		}
+	}
+	return tr
+}
+
+func newLaneReader(tlMgr *taskListManagerImpl, taskListName string) *taskReader {
+	db := newTaskListDB(
+		tlMgr.engine.taskManager,
+		tlMgr.taskListID.domainID,
+		tlMgr.db.domainName,
+		taskListName,
+		tlMgr.taskListID.taskType,
+		int(types.TaskListKindNormal),
+		tlMgr.logger,
+	)
+	lane := newBacklogReader(tlMgr, db, newTaskWriter(tlMgr, db), messaging.NewAckManager(tlMgr.logger), newTaskGC(db, tlMgr.config))
+	lane.isLane = true
+	return lane
+}
+
+func newBacklogReader(
+	tlMgr *taskListManagerImpl,
+	db *taskListDB,
+	writer *taskWriter,
+	ackManager messaging.AckManager,
+	gc *taskGC,
+) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &taskReader{
-		tlMgr:               tlMgr,
-		cancelCtx:           ctx,
-		cancelFunc:          cancel,
-		notifyC:             make(chan struct{}, 1),
-		dispatcherShutdownC: make(chan struct{}),
+		tlMgr:      tlMgr,
+		db:         db,
+		taskWriter: writer,
+		ackManager: ackManager,
+		taskGC:     gc,
+		cancelCtx:  ctx,
+		cancelFunc: cancel,
+		notifyC:    make(chan struct{}, 1),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffer: make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1),
 	}
 }
 
+// newPriorityLevels creates the priority levels from the configured weights, the levels
+// and the fairness key are fixed for the lifetime of the task list manager
+func newPriorityLevels(weights map[int]int, fairnessWeight func(key string) int) []*priorityLevel {
+	levels := make([]*priorityLevel, 0, len(weights))
+	for priority, weight := range weights {
+		levels = append(levels, &priorityLevel{
+			priority: int32(priority),
+			weight:   common.MaxInt(1, weight),
+			tasks:    newFairQueue(fairnessWeight),
+		})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].priority > levels[j].priority
+	})
+	return levels
+}
+
 func (tr *taskReader) Start() {
 	tr.Signal()
 	go tr.dispatchBufferedTasks()
 	go tr.getTasksPump()
+	for _, lane := range tr.lanes {
+		lane.Signal()
+		go lane.getTasksPump()
+	}
 }
 
+// Stop stops the dispatcher, the pumps of the task list stop on its shutdown
 func (tr *taskReader) Stop() {
 	tr.cancelFunc()
 	close(tr.dispatcherShutdownC)
@@ -88,30 +217,157 @@ func (tr *taskReader) Signal() {
 func (tr *taskReader) dispatchBufferedTasks() {
 dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
-			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, types.TaskSourceDbBacklog, "", false, nil)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+		if tr.stagedTasks == 0 {
+			select {
+			case taskInfo, ok := <-tr.taskBuffer:
+				if !ok { // Task list getTasks pump is shutdown
 					break dispatchLoop
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
+				tr.stageTask(taskInfo)
+			case <-tr.dispatchC:
+				// a lane buffered tasks, they are staged below
+			case <-tr.dispatcherShutdownC:
+				break dispatchLoop
 			}
-		case <-tr.dispatcherShutdownC:
+		}
+		if !tr.stageBufferedTasks() {
 			break dispatchLoop
 		}
+		if tr.stagedTasks == 0 {
+			continue dispatchLoop
+		}
+		staged := tr.nextStagedTask()
+		task := newInternalTask(staged.info, staged.reader.completeTask, types.TaskSourceDbBacklog, "", false, nil)
+		for {
+			err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+			if err == nil {
+				break
+			}
+			if err == context.Canceled {
+				tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+				break dispatchLoop
+			}
+			// this should never happen unless there is a bug - don't drop the task
+			tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
+			tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+			runtime.Gosched()
+		}
+	}
+}
+
+// stageBufferedTasks moves the tasks available in the buffers of the task list to their priority
+// level without blocking. With a single level and no fair dispatch only one task is staged at a
+// time so that backlog is dispatched exactly as it is read, otherwise up to one buffer worth of
+// tasks of each reader is staged to be ordered by priority and fairness key. Returns false when
+// taskBuffer is closed.
+func (tr *taskReader) stageBufferedTasks() bool {
+	maxStagedTasks := 1
+	if len(tr.priorityLevels) > 1 || tr.fairnessKey != nil {
+		maxStagedTasks = cap(tr.taskBuffer) + 1
+	}
+	for _, level := range tr.priorityLevels {
+		for _, lane := range level.readers {
+			if lane != tr {
+				tr.stageLaneTasks(level, lane, maxStagedTasks)
+			}
+		}
+	}
+	for tr.bufferStagedTasks < maxStagedTasks {
+		select {
+		case taskInfo, ok := <-tr.taskBuffer:
+			if !ok {
+				return false
+			}
+			tr.stageTask(taskInfo)
+		default:
+			return true
+		}
+	}
+	return true
+}
+
+func (tr *taskReader) stageLaneTasks(level *priorityLevel, lane *taskReader, maxStagedTasks int) {
+	for lane.bufferStagedTasks < maxStagedTasks {
+		select {
+		case taskInfo, ok := <-lane.taskBuffer:
+			if !ok { // the lane pump is shutdown along with the task list
+				return
+			}
+			tr.stageLevelTask(level, lane, taskInfo)
+		default:
+			return
+		}
+	}
+}
+
+// stageTask stages a task taken off taskBuffer. Without lanes the tasks of every priority are read
+// from taskBuffer, otherwise it only holds the first backlog of the lowest level.
+func (tr *taskReader) stageTask(task *persistence.TaskInfo) {
+	level := tr.priorityLevels[len(tr.priorityLevels)-1]
+	if len(tr.lanes) == 0 {
+		level = tr.levelFor(task.Priority)
+	}
+	tr.stageLevelTask(level, tr, task)
+}
+
+func (tr *taskReader) stageLevelTask(level *priorityLevel, reader *taskReader, task *persistence.TaskInfo) {
+	level.tasks.Push(tr.getFairnessKey(task), &stagedTask{info: task, reader: reader})
+	reader.bufferStagedTasks++
+	tr.stagedTasks++
+}
+
+// nextStagedTask picks the next task to dispatch with weighted round robin over the priority
+// levels, starting from the highest level. It must only be called when a task is staged.
+func (tr *taskReader) nextStagedTask() *stagedTask {
+	for {
+		level := tr.priorityLevels[tr.levelCursor]
+		if level.tasks.Len() > 0 && tr.levelCredit > 0 {
+			task := level.tasks.Pop()
+			tr.levelCredit--
+			tr.stagedTasks--
+			task.reader.bufferStagedTasks--
+			return task
+		}
+		tr.levelCursor = (tr.levelCursor + 1) % len(tr.priorityLevels)
+		tr.levelCredit = tr.priorityLevels[tr.levelCursor].weight
+	}
+}
+
+// levelFor returns the priority level of tasks with the given priority, tasks with a
+// priority between two levels belong to the lower one
+func (tr *taskReader) levelFor(priority int32) *priorityLevel {
+	for _, level := range tr.priorityLevels {
+		if priority >= level.priority {
+			return level
+		}
+	}
+	return tr.priorityLevels[len(tr.priorityLevels)-1]
+}
+
+// backlogFor returns the reader of the backlog the task is persisted to, picked by its
+// priority and the hash of its fairness key
+func (tr *taskReader) backlogFor(task *persistence.TaskInfo) *taskReader {
+	readers := tr.levelFor(task.Priority).readers
+	if len(readers) == 1 {
+		return readers[0]
+	}
+	hash := farm.Fingerprint32([]byte(tr.getFairnessKey(task)))
+	return readers[hash%uint32(len(readers))]
+}
+
+// getFairnessKey returns the fairness key of the task, all tasks share the same key when fair dispatch is disabled
+func (tr *taskReader) getFairnessKey(task *persistence.TaskInfo) string {
+	if tr.fairnessKey == nil {
+		return ""
+	}
+	return tr.fairnessKey(task)
+}
+
+func (tr *taskReader) getFairnessWeight(key string) int {
+	if weight, ok := tr.fairnessWeights.Load().(map[string]int)[key]; ok {
+		return weight
 	}
+	return 1
 }
 
 func (tr *taskReader) getTasksPump() {
@@ -120,6 +376,10 @@ func (tr *taskReader) getTasksPump() {
 
 	updateAckTimer := time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 	checkIdleTaskListTimer := time.NewTimer(tr.tlMgr.config.IdleTasklistCheckInterval())
+	if tr.isLane {
+		// only the reader of the lowest level unloads an idle task list
+		checkIdleTaskListTimer.Stop()
+	}
 	lastTimeWriteTask := time.Time{}
 getTasksPumpLoop:
 	for {
@@ -138,7 +398,7 @@ getTasksPumpLoop:
 				}
 
 				if len(tasks) == 0 {
-					tr.tlMgr.taskAckManager.SetReadLevel(readLevel)
+					tr.ackManager.SetReadLevel(readLevel)
 					if !isReadBatchDone {
 						tr.Signal()
 					}
@@ -165,6 +425,9 @@ getTasksPumpLoop:
 					}
 					// keep going as saving ack is not critical
 				}
+				if !tr.isLane {
+					tr.fairnessWeights.Store(tr.tlMgr.config.TaskFairnessWeights())
+				}
 				tr.Signal() // periodically signal pump to check persistence for tasks
 				updateAckTimer = time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 			}
@@ -177,8 +440,10 @@ getTasksPumpLoop:
 				checkIdleTaskListTimer = time.NewTimer(tr.tlMgr.config.IdleTasklistCheckInterval())
 			}
 		}
-		scope := tr.scope().Tagged(getTaskListTypeTag(tr.tlMgr.taskListID.taskType))
-		scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.tlMgr.taskAckManager.GetBacklogCount()))
+		if !tr.isLane {
+			scope := tr.scope().Tagged(getTaskListTypeTag(tr.tlMgr.taskListID.taskType))
+			scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.tlMgr.getBacklogCount()))
+		}
 	}
 
 	updateAckTimer.Stop()
@@ -187,13 +452,13 @@ getTasksPumpLoop:
 
 func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
 	response, err := tr.tlMgr.executeWithRetry(func() (interface{}, error) {
-		return tr.tlMgr.db.GetTasks(readLevel, maxReadLevel, tr.tlMgr.config.GetTasksBatchSize())
+		return tr.db.GetTasks(readLevel, maxReadLevel, tr.tlMgr.config.GetTasksBatchSize())
 	})
 	if err != nil {
 		tr.logger().Error("Persistent store operation failure",
 			tag.StoreOperationGetTasks,
 			tag.Error(err),
-			tag.WorkflowTaskListName(tr.tlMgr.taskListID.name),
+			tag.WorkflowTaskListName(tr.db.taskListName),
 			tag.WorkflowTaskListType(tr.tlMgr.taskListID.taskType))
 		return nil, err
 	}
@@ -205,8 +470,8 @@ func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64)
 // Also return a bool to indicate whether read is finished
 func (tr *taskReader) getTaskBatch() ([]*persistence.TaskInfo, int64, bool, error) {
 	var tasks []*persistence.TaskInfo
-	readLevel := tr.tlMgr.taskAckManager.GetReadLevel()
-	maxReadLevel := tr.tlMgr.taskWriter.GetMaxReadLevel()
+	readLevel := tr.ackManager.GetReadLevel()
+	maxReadLevel := tr.taskWriter.GetMaxReadLevel()
 
 	// counter i is used to break and let caller check whether tasklist is still alive and need resume read.
 	for i := 0; i < 10 && readLevel < maxReadLevel; i++ {
@@ -236,8 +501,10 @@ func (tr *taskReader) isIdle(lastWriteTime time.Time) bool {
 }
 
 func (tr *taskReader) handleIdleTimeout() {
-	tr.persistAckLevel() //nolint:errcheck
-	tr.tlMgr.taskGC.RunNow(tr.tlMgr.taskAckManager.GetAckLevel())
+	for _, reader := range tr.tlMgr.backlogReaders() {
+		reader.persistAckLevel() //nolint:errcheck
+		reader.taskGC.RunNow(reader.ackManager.GetAckLevel())
+	}
 	tr.tlMgr.Stop()
 }
 
@@ -249,7 +516,7 @@ func (tr *taskReader) addTasksToBuffer(
 			tr.scope().IncCounter(metrics.ExpiredTasksPerTaskListCounter)
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
-			tr.tlMgr.taskAckManager.SetReadLevel(t.TaskID)
+			tr.ackManager.SetReadLevel(t.TaskID)
 			continue
 		}
 		if !tr.addSingleTaskToBuffer(t, lastWriteTime, idleTimer) {
@@ -261,13 +528,16 @@ func (tr *taskReader) addTasksToBuffer(
 
 func (tr *taskReader) addSingleTaskToBuffer(
 	task *persistence.TaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
-	err := tr.tlMgr.taskAckManager.ReadItem(task.TaskID)
+	err := tr.ackManager.ReadItem(task.TaskID)
 	if err != nil {
 		tr.logger().Fatal("critical bug when adding item to ackManager")
 	}
 	for {
 		select {
 		case tr.taskBuffer <- task:
+			if tr.isLane {
+				tr.tlMgr.taskReader.signalDispatcher()
+			}
 			return true
 		case <-idleTimer.C:
 			if tr.isIdle(lastWriteTime) {
@@ -281,14 +551,69 @@ func (tr *taskReader) addSingleTaskToBuffer(
 }
 
 func (tr *taskReader) persistAckLevel() error {
-	ackLevel := tr.tlMgr.taskAckManager.GetAckLevel()
-	maxReadLevel := tr.tlMgr.taskWriter.GetMaxReadLevel()
-	scope := tr.scope().Tagged(getTaskListTypeTag(tr.tlMgr.taskListID.taskType))
-	// note: this metrics is only an estimation for the lag. taskID in DB may not be continuous,
-	// especially when task list ownership changes.
-	scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(maxReadLevel-ackLevel))
-
-	return tr.tlMgr.db.UpdateState(ackLevel)
+	ackLevel := tr.ackManager.GetAckLevel()
+	if !tr.isLane {
+		lag := int64(0)
+		for _, reader := range tr.tlMgr.backlogReaders() {
+			lag += reader.taskWriter.GetMaxReadLevel() - reader.ackManager.GetAckLevel()
+		}
+		scope := tr.scope().Tagged(getTaskListTypeTag(tr.tlMgr.taskListID.taskType))
+		// note: this metrics is only an estimation for the lag. taskID in DB may not be continuous,
+		// especially when task list ownership changes.
+		scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(lag))
+	}
+
+	return tr.db.UpdateState(ackLevel)
+}
+
+// getBacklogCountHint returns an estimate of the tasks in the backlog read by this reader: the tasks read
+// but not completed yet and the ones which are not read yet. Like the task list lag, the tasks not read
+// yet are estimated from their task IDs, which may not be continuous when task list ownership changes.
+func (tr *taskReader) getBacklogCountHint() int64 {
+	unread := tr.taskWriter.GetMaxReadLevel() - tr.ackManager.GetReadLevel()
+	return tr.ackManager.GetBacklogCount() + common.MaxInt64(0, unread)
+}
+
+// completeTask marks a task read by this reader as processed. As part of completion:
+//   - task is deleted from the database when err is nil
+//   - new task is created and current task is deleted when err is not nil
+func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
+	if err != nil {
+		// failed to start the task.
+		// We cannot just remove it from persistence because then it will be lost.
+		// We handle this by writing the task back to persistence with a higher taskID.
+		// This will allow subsequent tasks to make progress, and hopefully by the time this task is picked-up
+		// again the underlying reason for failing to start will be resolved.
+		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
+		// re-written to persistence frequently.
+		backlog := tr.tlMgr.taskReader.backlogFor(task)
+		_, err = tr.tlMgr.executeWithRetry(func() (interface{}, error) {
+			wf := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
+			return backlog.taskWriter.appendTask(wf, task)
+		})
+
+		if err != nil {
+			// OK, we also failed to write to persistence.
+			// This should only happen in very extreme cases where persistence is completely down.
+			// We still can't lose the old task so we just unload the entire task list
+			tr.logger().Error("Failed to complete task",
+				tag.Error(err),
+				tag.WorkflowTaskListName(tr.tlMgr.taskListID.name),
+				tag.WorkflowTaskListType(tr.tlMgr.taskListID.taskType))
+			tr.tlMgr.Stop()
+			return
+		}
+		backlog.Signal()
+	}
+	ackLevel := tr.ackManager.AckItem(task.TaskID)
+	tr.taskGC.Run(ackLevel)
+}
+
+func (tr *taskReader) signalDispatcher() {
+	select {
+	case tr.dispatchC <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
 }
 
 func (tr *taskReader) isTaskAddedRecently(lastAddTime time.Time) bool {
@@ -51,6 +51,7 @@ type (
 	// taskWriter writes tasks sequentially to persistence
 	taskWriter struct {
 		tlMgr        *taskListManagerImpl
+		db           *taskListDB
 		config       *taskListConfig
 		taskListID   *taskListID
 		appendCh     chan *writeTaskRequest
@@ -65,9 +66,10 @@ type (
 // errShutdown indicates that the task list is shutting down
 var errShutdown = errors.New("task list shutting down")
 
-func newTaskWriter(tlMgr *taskListManagerImpl) *taskWriter {
+func newTaskWriter(tlMgr *taskListManagerImpl, db *taskListDB) *taskWriter {
 	return &taskWriter{
 		tlMgr:      tlMgr,
+		db:         db,
 		config:     tlMgr.config,
 		taskListID: tlMgr.taskListID,
 		stopCh:     make(chan struct{}),
@@ -131,7 +133,7 @@ func (w *taskWriter) allocTaskIDs(count int) ([]int64, error) {
 	for i := 0; i < count; i++ {
 		if w.taskIDBlock.start > w.taskIDBlock.end {
 			// we ran out of current allocation block
-			newBlock, err := w.tlMgr.allocTaskIDBlock(w.taskIDBlock.end)
+			newBlock, err := w.tlMgr.allocTaskIDBlock(w.db, w.taskIDBlock.end)
 			if err != nil {
 				return nil, err
 			}
@@ -172,7 +174,7 @@ writerLoop:
 					maxReadLevel = taskIDs[i]
 				}
 
-				r, err := w.tlMgr.db.CreateTasks(tasks)
+				r, err := w.db.CreateTasks(tasks)
 				switch err.(type) {
 				case nil:
 					// Do nothing
@@ -97,6 +97,16 @@ func (tn *qualifiedTaskListName) Parent(degree int) string {
 	return tn.mkName(pid)
 }
 
+// backlogName returns the name of the task list persisting one of the fairness backlogs of a priority
+// level of this task list. Its last segment is not a partition ID, so no poller or task can be routed to it.
+func (tn *qualifiedTaskListName) backlogName(priority int32, fairnessBacklog int) string {
+	name := fmt.Sprintf("%v%v/%v/priority%v", common.ReservedTaskListPrefix, tn.baseName, tn.partition, priority)
+	if fairnessBacklog == 0 {
+		return name
+	}
+	return fmt.Sprintf("%v/fair%v", name, fairnessBacklog)
+}
+
 func (tn *qualifiedTaskListName) mkName(partition int) string {
 	if partition == 0 {
 		return tn.baseName
@@ -23,31 +23,53 @@ package archiver
 import (
 	"context"
 	"errors"
+	"math/rand"
 
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
 
 	"github.com/uber/cadence/common"
 	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
-	uploadHistoryActivityFnName     = "uploadHistoryActivity"
-	deleteHistoryActivityFnName     = "deleteHistoryActivity"
-	archiveVisibilityActivityFnName = "archiveVisibilityActivity"
+	uploadHistoryActivityFnName                = "uploadHistoryActivity"
+	deleteHistoryActivityFnName                = "deleteHistoryActivity"
+	archiveVisibilityActivityFnName            = "archiveVisibilityActivity"
+	sampleHistoryForVerificationActivityFnName = "sampleHistoryForVerificationActivity"
+	verifyHistoryActivityFnName                = "verifyHistoryActivity"
+
+	verifyHistoryPageSize = 250
 )
 
 var (
 	errUploadNonRetriable            = errors.New("upload non-retriable error")
 	errDeleteNonRetriable            = errors.New("delete non-retriable error")
 	errArchiveVisibilityNonRetriable = errors.New("archive visibility non-retriable error")
+	errVerifyNonRetriable            = errors.New("verify non-retriable error")
+
+	errArchivedHistoryNotFound       = errors.New("archived history not found")
+	errArchivedHistoryEventIDGap     = errors.New("archived history has a gap in event IDs")
+	errArchivedHistoryCountMismatch  = errors.New("archived history event count does not match the count recorded at close")
+	errArchivedHistoryStatusMismatch = errors.New("archived history last event does not match the close status recorded at close")
 
 	uploadHistoryActivityNonRetryableErrors = []string{"cadenceInternal:Panic", errUploadNonRetriable.Error()}
 	deleteHistoryActivityNonRetryableErrors = []string{"cadenceInternal:Panic", errDeleteNonRetriable.Error()}
+	verifyHistoryActivityNonRetryableErrors = []string{
+		"cadenceInternal:Panic",
+		errVerifyNonRetriable.Error(),
+		errArchivedHistoryNotFound.Error(),
+		errArchivedHistoryEventIDGap.Error(),
+		errArchivedHistoryCountMismatch.Error(),
+		errArchivedHistoryStatusMismatch.Error(),
+	}
 )
 
 func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err error) {
@@ -175,3 +197,89 @@ func archiveVisibilityActivity(ctx context.Context, request ArchiveRequest) (err
 	logger.Error(carchiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason("got retryable error from visibility archiver"), tag.Error(err))
 	return err
 }
+
+// sampleHistoryForVerificationActivity decides whether an archived history should have its integrity verified,
+// sampled histories are handed over to the verification workflow which deletes them once verified
+func sampleHistoryForVerificationActivity(ctx context.Context, request ArchiveRequest) (bool, error) {
+	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
+	if rand.Float64() >= container.Config.BlobIntegrityCheckProbability(dynamicconfig.DomainFilter(request.DomainName)) {
+		return false, nil
+	}
+
+	workflowOptions := cclient.StartWorkflowOptions{
+		ID:                              verificationWorkflowID(request.DomainID),
+		TaskList:                        decisionTaskList,
+		ExecutionStartToCloseTimeout:    workflowStartToCloseTimeout,
+		DecisionTaskStartToCloseTimeout: workflowTaskStartToCloseTimeout,
+		WorkflowIDReusePolicy:           cclient.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	cadenceClient := cclient.NewClient(container.PublicClient, common.SystemLocalDomainName, &cclient.Options{})
+	if _, err := cadenceClient.SignalWithStartWorkflow(ctx, verificationWorkflowID(request.DomainID), verificationSignalName, request, workflowOptions, verificationWorkflowFnName, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// verifyHistoryActivity reads an archived history back and checks it is complete and matches what was recorded at close
+func verifyHistoryActivity(ctx context.Context, request ArchiveRequest) (err error) {
+	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
+	scope := container.MetricsClient.Scope(metrics.ArchiverVerifyHistoryActivityScope, metrics.DomainTag(request.DomainName))
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if isVerifyHistoryNonRetryableError(err) {
+				scope.IncCounter(metrics.ArchiverNonRetryableErrorCount)
+			}
+			err = cadence.NewCustomError(err.Error())
+		}
+	}()
+	logger := tagLoggerWithHistoryRequest(tagLoggerWithActivityInfo(container.Logger, activity.GetInfo(ctx)), &request)
+	URI, err := carchiver.NewURI(request.URI)
+	if err != nil {
+		logger.Error("failed to get history archival uri", tag.ArchivalURI(request.URI), tag.Error(err))
+		return errVerifyNonRetriable
+	}
+	historyArchiver, err := container.ArchiverProvider.GetHistoryArchiver(URI.Scheme(), service.Worker)
+	if err != nil {
+		logger.Error("failed to get history archiver", tag.Error(err))
+		return errVerifyNonRetriable
+	}
+
+	verifier := newHistoryVerifier(&request)
+	getRequest := &carchiver.GetHistoryRequest{
+		DomainID:             request.DomainID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		CloseFailoverVersion: common.Int64Ptr(request.CloseFailoverVersion),
+		PageSize:             verifyHistoryPageSize,
+	}
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, getRequest)
+		if err != nil {
+			switch err.(type) {
+			case *types.EntityNotExistsError, *types.BadRequestError:
+				return errArchivedHistoryNotFound
+			}
+			return err
+		}
+		if err := verifier.add(resp.HistoryBatches); err != nil {
+			return err
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		getRequest.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx)
+	}
+	return verifier.finish()
+}
+
+func isVerifyHistoryNonRetryableError(err error) bool {
+	for _, reason := range verifyHistoryActivityNonRetryableErrors {
+		if err.Error() == reason {
+			return true
+		}
+	}
+	return false
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/encoded"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/zap"
@@ -40,6 +41,7 @@ import (
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
@@ -410,3 +412,80 @@ func (s *activitiesSuite) TestArchiveVisibilityActivity_Success() {
 	_, err := env.ExecuteActivity(archiveVisibilityActivity, request)
 	s.NoError(err)
 }
+
+func (s *activitiesSuite) TestSampleHistoryForVerificationActivity_NotSampled() {
+	container := &BootstrapContainer{
+		Logger:        s.logger,
+		MetricsClient: s.metricsClient,
+		Config: &Config{
+			BlobIntegrityCheckProbability: dynamicconfig.GetFloatPropertyFn(0),
+		},
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	result, err := env.ExecuteActivity(sampleHistoryForVerificationActivity, ArchiveRequest{DomainName: testDomainName})
+	s.NoError(err)
+	var sampled bool
+	s.NoError(result.Get(&sampled))
+	s.False(sampled)
+}
+
+func (s *activitiesSuite) TestVerifyHistoryActivity_Fail_HistoryNotFound() {
+	s.metricsClient.On("Scope", metrics.ArchiverVerifyHistoryActivityScope, metrics.DomainTag(testDomainName)).Return(s.metricsScope).Once()
+	s.metricsScope.On("IncCounter", metrics.ArchiverNonRetryableErrorCount).Once()
+	s.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, service.Worker).Return(s.historyArchiver, nil)
+	_, err := s.executeVerifyHistoryActivity()
+	s.Equal(errArchivedHistoryNotFound.Error(), err.Error())
+}
+
+func (s *activitiesSuite) TestVerifyHistoryActivity_Fail_RetriableError() {
+	s.metricsClient.On("Scope", metrics.ArchiverVerifyHistoryActivityScope, metrics.DomainTag(testDomainName)).Return(s.metricsScope).Once()
+	s.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("some retryable error")).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, service.Worker).Return(s.historyArchiver, nil)
+	_, err := s.executeVerifyHistoryActivity()
+	s.Equal("some retryable error", err.Error())
+}
+
+func (s *activitiesSuite) TestVerifyHistoryActivity_Success() {
+	s.metricsClient.On("Scope", metrics.ArchiverVerifyHistoryActivityScope, metrics.DomainTag(testDomainName)).Return(s.metricsScope).Once()
+	s.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *carchiver.GetHistoryRequest) bool {
+		return request.NextPageToken == nil && *request.CloseFailoverVersion == testCloseFailoverVersion
+	})).Return(&carchiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{{ID: 1}, {ID: 2}}}},
+		NextPageToken:  []byte{1},
+	}, nil).Once()
+	s.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *carchiver.GetHistoryRequest) bool {
+		return request.NextPageToken != nil
+	})).Return(&carchiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{{Events: []*types.HistoryEvent{{ID: 3, EventType: types.EventTypeWorkflowExecutionCompleted.Ptr()}}}},
+	}, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, service.Worker).Return(s.historyArchiver, nil)
+	_, err := s.executeVerifyHistoryActivity()
+	s.NoError(err)
+}
+
+func (s *activitiesSuite) executeVerifyHistoryActivity() (encoded.Value, error) {
+	container := &BootstrapContainer{
+		Logger:           s.logger,
+		MetricsClient:    s.metricsClient,
+		ArchiverProvider: s.archiverProvider,
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	request := ArchiveRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		NextEventID:          4,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		URI:                  testArchivalURI,
+		HistoryCloseStatus:   types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}
+	return env.ExecuteActivity(verifyHistoryActivity, request)
+}
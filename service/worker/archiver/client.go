@@ -67,6 +67,9 @@ type (
 		NextEventID          int64
 		CloseFailoverVersion int64
 		URI                  string // should be historyURI, but keep the existing name for backward compatibility
+		VerificationAttempt  int    // number of times history was re-archived after failing integrity verification
+		// HistoryCloseStatus is the close status of the workflow, the last archived event is verified against it
+		HistoryCloseStatus *types.WorkflowExecutionCloseStatus
 
 		// visibility archival
 		WorkflowTypeName   string
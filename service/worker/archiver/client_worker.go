@@ -68,6 +68,8 @@ type (
 		ArchivalsPerIteration           dynamicconfig.IntPropertyFn
 		TimeLimitPerArchivalIteration   dynamicconfig.DurationPropertyFn
 		AllowArchivingIncompleteHistory dynamicconfig.BoolPropertyFn
+		BlobIntegrityCheckProbability   dynamicconfig.FloatPropertyFn
+		VerificationInterval            dynamicconfig.DurationPropertyFn
 	}
 
 	contextKey int
@@ -96,6 +98,9 @@ func init() {
 	activity.RegisterWithOptions(uploadHistoryActivity, activity.RegisterOptions{Name: uploadHistoryActivityFnName})
 	activity.RegisterWithOptions(deleteHistoryActivity, activity.RegisterOptions{Name: deleteHistoryActivityFnName})
 	activity.RegisterWithOptions(archiveVisibilityActivity, activity.RegisterOptions{Name: archiveVisibilityActivityFnName})
+	workflow.RegisterWithOptions(verificationWorkflow, workflow.RegisterOptions{Name: verificationWorkflowFnName})
+	activity.RegisterWithOptions(sampleHistoryForVerificationActivity, activity.RegisterOptions{Name: sampleHistoryForVerificationActivityFnName})
+	activity.RegisterWithOptions(verifyHistoryActivity, activity.RegisterOptions{Name: verifyHistoryActivityFnName})
 }
 
 // NewClientWorker returns a new ClientWorker
@@ -155,6 +155,12 @@ func (h *handler) handleHistoryRequest(ctx workflow.Context, request *ArchiveReq
 	}
 	uploadSW.Stop()
 
+	if err == nil && h.sampleForVerification(ctx, request, logger) {
+		// the verification workflow deletes the history once its archived copy has been verified
+		sw.Stop()
+		return
+	}
+
 	lao := workflow.LocalActivityOptions{
 		ScheduleToCloseTimeout: 1 * time.Minute,
 		RetryPolicy: &cadence.RetryPolicy{
@@ -177,6 +183,31 @@ func (h *handler) handleHistoryRequest(ctx workflow.Context, request *ArchiveReq
 	sw.Stop()
 }
 
+func (h *handler) sampleForVerification(ctx workflow.Context, request *ArchiveRequest, logger log.Logger) bool {
+	if workflow.GetVersion(ctx, verificationChangeID, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		return false
+	}
+
+	lao := workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			ExpirationInterval: time.Minute,
+		},
+	}
+	localActCtx := workflow.WithLocalActivityOptions(ctx, lao)
+	var sampled bool
+	if err := workflow.ExecuteLocalActivity(localActCtx, sampleHistoryForVerificationActivity, *request).Get(localActCtx, &sampled); err != nil {
+		logger.Warn("failed to hand history over to verification, will move on to deleting history without verifying", tag.Error(err))
+		return false
+	}
+	if sampled {
+		h.metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverVerificationSampledCount)
+	}
+	return sampled
+}
+
 func (h *handler) handleVisibilityRequest(ctx workflow.Context, request *ArchiveRequest) {
 	sw := h.metricsClient.StartTimer(metrics.ArchiverScope, metrics.ArchiverHandleVisibilityRequestLatency)
 	logger := tagLoggerWithVisibilityRequest(h.logger, request)
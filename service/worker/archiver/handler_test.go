@@ -108,6 +108,37 @@ func (s *handlerSuite) TestHandleHistoryRequest_UploadSuccess() {
 
 	env := s.NewTestWorkflowEnvironment()
 	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(sampleHistoryForVerificationActivityFnName, mock.Anything, mock.Anything).Return(false, nil)
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(handleHistoryRequestWorkflow, ArchiveRequest{})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *handlerSuite) TestHandleHistoryRequest_SampledForVerification() {
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount).Once()
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverVerificationSampledCount).Once()
+
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(sampleHistoryForVerificationActivityFnName, mock.Anything, mock.Anything).Return(true, nil)
+	env.ExecuteWorkflow(handleHistoryRequestWorkflow, ArchiveRequest{})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *handlerSuite) TestHandleHistoryRequest_SampleForVerificationFails() {
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount).Once()
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverDeleteSuccessCount).Once()
+	handlerTestLogger.On("Warn", mock.Anything, mock.Anything).Once()
+
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(sampleHistoryForVerificationActivityFnName, mock.Anything, mock.Anything).Return(false, cadence.NewCustomError("failed to signal"))
 	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
 	env.ExecuteWorkflow(handleHistoryRequestWorkflow, ArchiveRequest{})
 
@@ -123,6 +154,7 @@ func (s *handlerSuite) TestHandleHistoryRequest_DeleteFails_NonRetryableError()
 
 	env := s.NewTestWorkflowEnvironment()
 	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(sampleHistoryForVerificationActivityFnName, mock.Anything, mock.Anything).Return(false, nil)
 	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(func(context.Context, ArchiveRequest) error {
 		return cadence.NewCustomError(errDeleteNonRetriable.Error())
 	})
@@ -139,6 +171,7 @@ func (s *handlerSuite) TestHandleHistoryRequest_DeleteFailsThenSucceeds() {
 
 	env := s.NewTestWorkflowEnvironment()
 	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(sampleHistoryForVerificationActivityFnName, mock.Anything, mock.Anything).Return(false, nil)
 	firstRun := true
 	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(func(context.Context, ArchiveRequest) error {
 		if firstRun {
@@ -192,6 +225,7 @@ func (s *handlerSuite) TestRunArchiver() {
 
 	env := s.NewTestWorkflowEnvironment()
 	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(sampleHistoryForVerificationActivityFnName, mock.Anything, mock.Anything).Return(false, nil)
 	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
 	env.OnActivity(archiveVisibilityActivityFnName, mock.Anything, mock.Anything).Return(nil)
 	env.ExecuteWorkflow(startAndFinishArchiverWorkflow, concurrency, numRequests)
@@ -0,0 +1,301 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// historyVerifier checks the pages of an archived history as they are read back
+	historyVerifier struct {
+		request     *ArchiveRequest
+		nextEventID int64
+		lastEvent   *types.HistoryEvent
+	}
+)
+
+const (
+	verificationWorkflowIDPrefix = "cadence-archival-verification"
+	verificationSignalName       = "cadence-archival-verification-signal"
+	verificationWorkflowFnName   = "archivalVerificationWorkflow"
+	verificationChangeID         = "archival-verification"
+
+	// maxVerificationAttempts is the number of times a history is verified, and re-archived after
+	// failing verification, before verification gives up on it. The history is then kept for an
+	// operator to look into, as its archived copy can't be trusted.
+	maxVerificationAttempts   = 3
+	verificationConcurrency   = 10
+	verificationsPerIteration = 1000
+	// maxVerificationCarryover bounds the requests carried over to the next run of a verification workflow,
+	// which keeps the continue as new input small, the requests beyond it are verified in the current run
+	// and only the ones failing verification are carried over on top of it
+	maxVerificationCarryover = verificationsPerIteration
+)
+
+var closeEventTypes = map[types.WorkflowExecutionCloseStatus]types.EventType{
+	types.WorkflowExecutionCloseStatusCompleted:      types.EventTypeWorkflowExecutionCompleted,
+	types.WorkflowExecutionCloseStatusFailed:         types.EventTypeWorkflowExecutionFailed,
+	types.WorkflowExecutionCloseStatusCanceled:       types.EventTypeWorkflowExecutionCanceled,
+	types.WorkflowExecutionCloseStatusTerminated:     types.EventTypeWorkflowExecutionTerminated,
+	types.WorkflowExecutionCloseStatusContinuedAsNew: types.EventTypeWorkflowExecutionContinuedAsNew,
+	types.WorkflowExecutionCloseStatusTimedOut:       types.EventTypeWorkflowExecutionTimedOut,
+}
+
+func newHistoryVerifier(request *ArchiveRequest) *historyVerifier {
+	return &historyVerifier{
+		request:     request,
+		nextEventID: common.FirstEventID,
+	}
+}
+
+func (v *historyVerifier) add(batches []*types.History) error {
+	for _, batch := range batches {
+		for _, event := range batch.Events {
+			if event.ID != v.nextEventID {
+				return errArchivedHistoryEventIDGap
+			}
+			v.nextEventID++
+			v.lastEvent = event
+		}
+	}
+	return nil
+}
+
+func (v *historyVerifier) finish() error {
+	if v.lastEvent == nil {
+		return errArchivedHistoryNotFound
+	}
+	if v.nextEventID != v.request.NextEventID {
+		return errArchivedHistoryCountMismatch
+	}
+	// requests sent before the close status was recorded can only be checked for completeness
+	if v.request.HistoryCloseStatus == nil {
+		return nil
+	}
+	if closeEventType, ok := closeEventTypes[*v.request.HistoryCloseStatus]; !ok || v.lastEvent.GetEventType() != closeEventType {
+		return errArchivedHistoryStatusMismatch
+	}
+	return nil
+}
+
+// verificationWorkflowID returns the ID of the verification workflow of a domain, each domain gets its own
+// workflow so that the histories sampled in one domain don't hold back the verification of the others
+func verificationWorkflowID(domainID string) string {
+	return fmt.Sprintf("%s-%s", verificationWorkflowIDPrefix, domainID)
+}
+
+func verificationWorkflow(ctx workflow.Context, carryover []ArchiveRequest) error {
+	return verificationWorkflowHelper(ctx, globalLogger, globalMetricsClient, globalConfig, carryover)
+}
+
+// verificationWorkflowHelper periodically verifies the archived histories sampled by the archival workflow.
+// Histories which pass verification are deleted, the others are queued up to be re-archived and verified
+// again in the next iteration. Histories are never deleted after failing verification.
+func verificationWorkflowHelper(
+	ctx workflow.Context,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	config *Config,
+	carryover []ArchiveRequest,
+) error {
+	metricsClient = NewReplayMetricsClient(metricsClient, ctx)
+	workflowInfo := workflow.GetInfo(ctx)
+	logger = logger.WithTags(
+		tag.WorkflowID(workflowInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(workflowInfo.WorkflowExecution.RunID),
+		tag.WorkflowType(workflowInfo.WorkflowType.Name))
+	logger = loggerimpl.NewReplayLogger(logger, ctx, false)
+
+	var interval time.Duration
+	_ = workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		return config.VerificationInterval()
+	}).Get(&interval)
+	if err := workflow.Sleep(ctx, interval); err != nil {
+		return err
+	}
+
+	signalCh := workflow.GetSignalChannel(ctx, verificationSignalName)
+	pending := carryover
+	for {
+		var request ArchiveRequest
+		if ok := signalCh.ReceiveAsync(&request); !ok {
+			break
+		}
+		pending = append(pending, request)
+	}
+	if len(pending) == 0 {
+		logger.Info("archival verification workflow stopping because there is nothing to verify")
+		return nil
+	}
+
+	var next, reArchive []ArchiveRequest
+	if len(pending) > verificationsPerIteration {
+		pending, next = pending[:verificationsPerIteration], pending[verificationsPerIteration:]
+	}
+	processConcurrently(ctx, pending, func(ctx workflow.Context, request ArchiveRequest) {
+		if retry := verifyHistory(ctx, logger, metricsClient, request); retry != nil {
+			reArchive = append(reArchive, *retry)
+		}
+	})
+
+	for {
+		var request ArchiveRequest
+		if ok := signalCh.ReceiveAsync(&request); !ok {
+			break
+		}
+		next = append(next, request)
+	}
+
+	// histories to re-archive are carried over first, as they are known not to be archived properly
+	next = append(reArchive, next...)
+	if len(next) > maxVerificationCarryover {
+		var spilled []ArchiveRequest
+		next, spilled = next[:maxVerificationCarryover], next[maxVerificationCarryover:]
+		logger.Warn("archival verification is falling behind, verifying histories which are not carried over now", tag.Counter(len(spilled)))
+		var spilledReArchive []ArchiveRequest
+		processConcurrently(ctx, spilled, func(ctx workflow.Context, request ArchiveRequest) {
+			metricsClient.Scope(metrics.ArchiverVerificationWorkflowScope, metrics.DomainTag(request.DomainName)).
+				IncCounter(metrics.ArchiverVerificationSpilledCount)
+			if retry := verifyHistory(ctx, logger, metricsClient, request); retry != nil {
+				spilledReArchive = append(spilledReArchive, *retry)
+			}
+		})
+		// the histories failing verification are put back on the queue, as they must not be deleted
+		next = append(next, spilledReArchive...)
+	}
+	ctx = workflow.WithExecutionStartToCloseTimeout(ctx, workflowStartToCloseTimeout)
+	ctx = workflow.WithWorkflowTaskStartToCloseTimeout(ctx, workflowTaskStartToCloseTimeout)
+	return workflow.NewContinueAsNewError(ctx, verificationWorkflowFnName, next)
+}
+
+// processConcurrently calls process for each of the requests, with at most verificationConcurrency of them in flight
+func processConcurrently(ctx workflow.Context, requests []ArchiveRequest, process func(workflow.Context, ArchiveRequest)) {
+	requestCh := workflow.NewBufferedChannel(ctx, len(requests))
+	for _, request := range requests {
+		requestCh.Send(ctx, request)
+	}
+	requestCh.Close()
+	doneCh := workflow.NewChannel(ctx)
+	for i := 0; i < verificationConcurrency; i++ {
+		workflow.Go(ctx, func(ctx workflow.Context) {
+			var request ArchiveRequest
+			for requestCh.Receive(ctx, &request) {
+				process(ctx, request)
+			}
+			doneCh.Send(ctx, nil)
+		})
+	}
+	for i := 0; i < verificationConcurrency; i++ {
+		doneCh.Receive(ctx, nil)
+	}
+}
+
+// verifyHistory verifies a single archived history, re-archiving it first if it failed a previous verification.
+// It returns the request to re-archive in the next iteration if the archived history is still not valid.
+func verifyHistory(
+	ctx workflow.Context,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	request ArchiveRequest,
+) *ArchiveRequest {
+	logger = tagLoggerWithHistoryRequest(logger, &request)
+	scope := metricsClient.Scope(metrics.ArchiverVerificationWorkflowScope, metrics.DomainTag(request.DomainName))
+	if request.VerificationAttempt > 0 {
+		scope.IncCounter(metrics.ArchiverVerificationReArchiveCount)
+		uploadCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			ScheduleToStartTimeout: time.Minute,
+			StartToCloseTimeout:    time.Minute,
+			RetryPolicy: &cadence.RetryPolicy{
+				InitialInterval:          time.Second,
+				BackoffCoefficient:       2.0,
+				ExpirationInterval:       5 * time.Minute,
+				NonRetriableErrorReasons: uploadHistoryActivityNonRetryableErrors,
+			},
+		})
+		if err := workflow.ExecuteActivity(uploadCtx, uploadHistoryActivityFnName, request).Get(uploadCtx, nil); err != nil {
+			logger.Error("failed to re-archive history", tag.Error(err))
+		}
+	}
+
+	verifyCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       30 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          time.Second,
+			BackoffCoefficient:       2.0,
+			ExpirationInterval:       5 * time.Minute,
+			NonRetriableErrorReasons: verifyHistoryActivityNonRetryableErrors,
+		},
+	})
+	err := workflow.ExecuteActivity(verifyCtx, verifyHistoryActivityFnName, request).Get(verifyCtx, nil)
+	if err != nil {
+		scope.IncCounter(metrics.ArchiverVerificationFailedCount)
+		request.VerificationAttempt++
+		if request.VerificationAttempt < maxVerificationAttempts {
+			logger.Warn("archived history failed verification, will re-archive", tag.Error(err), tag.Attempt(int32(request.VerificationAttempt)))
+			return &request
+		}
+		logger.Error("archived history failed verification too many times, history is kept for an operator to look into", tag.Error(err))
+		scope.IncCounter(metrics.ArchiverVerificationGaveUpCount)
+		return nil
+	}
+
+	scope.IncCounter(metrics.ArchiverVerificationSuccessCount)
+	deleteHistory(ctx, logger, metricsClient, request)
+	return nil
+}
+
+// deleteHistory deletes a history which is done with verification
+func deleteHistory(
+	ctx workflow.Context,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	request ArchiveRequest,
+) {
+	deleteCtx := workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          time.Second,
+			BackoffCoefficient:       2.0,
+			ExpirationInterval:       5 * time.Minute,
+			NonRetriableErrorReasons: deleteHistoryActivityNonRetryableErrors,
+		},
+	})
+	if err := workflow.ExecuteLocalActivity(deleteCtx, deleteHistoryActivity, request).Get(deleteCtx, nil); err != nil {
+		logger.Error("deleting history failed, this means zombie histories are left", tag.Error(err))
+		metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverDeleteFailedAllRetriesCount)
+	} else {
+		metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverDeleteSuccessCount)
+	}
+}
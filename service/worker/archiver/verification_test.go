@@ -0,0 +1,198 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type verificationSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestVerificationSuite(t *testing.T) {
+	suite.Run(t, new(verificationSuite))
+}
+
+func (s *verificationSuite) SetupSuite() {
+	workflow.Register(verificationWorkflowTest)
+}
+
+func (s *verificationSuite) TestHistoryVerifier() {
+	history := []*types.History{
+		{Events: []*types.HistoryEvent{{ID: 1}, {ID: 2}}},
+		{Events: []*types.HistoryEvent{{ID: 3, EventType: types.EventTypeWorkflowExecutionCompleted.Ptr()}}},
+	}
+	testCases := []struct {
+		msg     string
+		request ArchiveRequest
+		history []*types.History
+		err     error
+	}{
+		{
+			msg:     "valid history",
+			request: ArchiveRequest{NextEventID: 4, HistoryCloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr()},
+			history: history,
+		},
+		{
+			msg:     "close status not recorded",
+			request: ArchiveRequest{NextEventID: 4},
+			history: history,
+		},
+		{
+			msg:     "empty history",
+			request: ArchiveRequest{NextEventID: 4},
+			err:     errArchivedHistoryNotFound,
+		},
+		{
+			msg:     "gap in event IDs",
+			request: ArchiveRequest{NextEventID: 4},
+			history: []*types.History{{Events: []*types.HistoryEvent{{ID: 1}, {ID: 3}}}},
+			err:     errArchivedHistoryEventIDGap,
+		},
+		{
+			msg:     "missing events",
+			request: ArchiveRequest{NextEventID: 5},
+			history: history,
+			err:     errArchivedHistoryCountMismatch,
+		},
+		{
+			msg:     "close status mismatch",
+			request: ArchiveRequest{NextEventID: 4, HistoryCloseStatus: types.WorkflowExecutionCloseStatusTimedOut.Ptr()},
+			history: history,
+			err:     errArchivedHistoryStatusMismatch,
+		},
+	}
+	for _, tc := range testCases {
+		verifier := newHistoryVerifier(&tc.request)
+		err := verifier.add(tc.history)
+		if err == nil {
+			err = verifier.finish()
+		}
+		s.Equal(tc.err, err, tc.msg)
+	}
+}
+
+func (s *verificationSuite) TestVerificationWorkflow_NothingToVerify() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(verificationWorkflowTest, nil)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *verificationSuite) TestVerificationWorkflow_Verified() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(verificationSignalName, ArchiveRequest{RunID: "run-id"})
+	}, time.Second)
+	env.ExecuteWorkflow(verificationWorkflowTest, nil)
+
+	env.AssertExpectations(s.T())
+	s.Empty(s.continuedAsNewWith(env))
+}
+
+func (s *verificationSuite) TestVerificationWorkflow_FailedVerification() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(cadence.NewCustomError(errArchivedHistoryCountMismatch.Error())).Once()
+	env.ExecuteWorkflow(verificationWorkflowTest, []ArchiveRequest{{RunID: "run-id"}})
+
+	env.AssertExpectations(s.T())
+	env.AssertNotCalled(s.T(), deleteHistoryActivityFnName, mock.Anything, mock.Anything)
+	s.Equal([]ArchiveRequest{{RunID: "run-id", VerificationAttempt: 1}}, s.continuedAsNewWith(env))
+}
+
+func (s *verificationSuite) TestVerificationWorkflow_ReArchived() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.ExecuteWorkflow(verificationWorkflowTest, []ArchiveRequest{{RunID: "run-id", VerificationAttempt: 1}})
+
+	env.AssertExpectations(s.T())
+	s.Empty(s.continuedAsNewWith(env))
+}
+
+func (s *verificationSuite) TestVerificationWorkflow_GiveUp() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(cadence.NewCustomError(errArchivedHistoryNotFound.Error())).Once()
+	env.ExecuteWorkflow(verificationWorkflowTest, []ArchiveRequest{{RunID: "run-id", VerificationAttempt: maxVerificationAttempts - 1}})
+
+	env.AssertExpectations(s.T())
+	// the history is kept as its archived copy is not valid
+	env.AssertNotCalled(s.T(), deleteHistoryActivityFnName, mock.Anything, mock.Anything)
+	s.Empty(s.continuedAsNewWith(env))
+}
+
+func (s *verificationSuite) TestVerificationWorkflow_CarryoverCapped() {
+	var carryover []ArchiveRequest
+	for i := 0; i != verificationsPerIteration+maxVerificationCarryover+3; i++ {
+		carryover = append(carryover, ArchiveRequest{RunID: fmt.Sprintf("run-id-%d", i)})
+	}
+	env := s.NewTestWorkflowEnvironment()
+	// the requests which don't fit in the next run are verified now, the ones failing verification are carried over as well
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(cadence.NewCustomError(errArchivedHistoryCountMismatch.Error())).Times(verificationsPerIteration)
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil).Times(maxVerificationCarryover)
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(cadence.NewCustomError(errArchivedHistoryCountMismatch.Error())).Times(3)
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil).Times(maxVerificationCarryover)
+	env.ExecuteWorkflow(verificationWorkflowTest, carryover)
+
+	env.AssertExpectations(s.T())
+	next := s.continuedAsNewWith(env)
+	s.Len(next, maxVerificationCarryover+3)
+	for _, request := range next {
+		s.Equal(1, request.VerificationAttempt, "histories to re-archive are carried over first")
+	}
+}
+
+func (s *verificationSuite) continuedAsNewWith(env *testsuite.TestWorkflowEnvironment) []ArchiveRequest {
+	s.True(env.IsWorkflowCompleted())
+	continueAsNewErr, ok := env.GetWorkflowError().(*workflow.ContinueAsNewError)
+	s.True(ok, "Called ContinueAsNew")
+	s.Len(continueAsNewErr.Args(), 1)
+	next, ok := continueAsNewErr.Args()[0].([]ArchiveRequest)
+	s.True(ok)
+	return next
+}
+
+func verificationWorkflowTest(ctx workflow.Context, carryover []ArchiveRequest) error {
+	config := &Config{
+		VerificationInterval: dynamicconfig.GetDurationPropertyFn(time.Minute),
+	}
+	return verificationWorkflowHelper(ctx, loggerimpl.NewNopLogger(), metrics.NewNoopMetricsClient(), config, carryover)
+}
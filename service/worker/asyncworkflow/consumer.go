@@ -0,0 +1,352 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package asyncworkflow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/asyncworkflow"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Consumer drains the async workflow queues and forwards the queued start and
+	// signal with start requests to the history service
+	Consumer struct {
+		config        *Config
+		queues        []string
+		client        messaging.Client
+		historyClient history.Client
+		domainCache   cache.DomainCache
+		logger        log.Logger
+		metricsClient metrics.Client
+
+		isStarted  int32
+		isStopped  int32
+		consumers  []messaging.Consumer
+		producers  []messaging.Producer
+		shutdownWG sync.WaitGroup
+		shutdownCh chan struct{}
+	}
+
+	// Config contains all configs for the async workflow consumer
+	Config struct {
+		Concurrency      dynamicconfig.IntPropertyFn
+		MaxRetryDuration dynamicconfig.DurationPropertyFn
+	}
+)
+
+const (
+	historyRequestTimeout = 10 * time.Second
+	requeueTimeout        = 5 * time.Second
+	retryInitialInterval  = 100 * time.Millisecond
+)
+
+var (
+	errUnknownRequestType = &types.BadRequestError{Message: "unknown async workflow request type"}
+)
+
+// NewConsumer creates a new Consumer for the given queues
+func NewConsumer(
+	config *Config,
+	queues []string,
+	client messaging.Client,
+	historyClient history.Client,
+	domainCache cache.DomainCache,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *Consumer {
+	return &Consumer{
+		config:        config,
+		queues:        queues,
+		client:        client,
+		historyClient: historyClient,
+		domainCache:   domainCache,
+		logger:        logger.WithTags(tag.ComponentAsyncWorkflowConsumer),
+		metricsClient: metricsClient,
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// Start starts consuming from all the queues
+func (c *Consumer) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.isStarted, 0, 1) {
+		return nil
+	}
+
+	c.logger.Info("Async workflow consumer state changed", tag.LifeCycleStarting)
+	for _, queue := range c.queues {
+		consumer, err := c.client.NewConsumer(queue, getConsumerName(queue))
+		if err != nil {
+			c.logger.Info("Async workflow consumer state changed", tag.LifeCycleStartFailed, tag.Error(err))
+			c.stopConsumers()
+			return err
+		}
+		// messages interrupted by shutdown are published back to the queue they were read from
+		producer, err := c.client.NewProducer(queue)
+		if err != nil {
+			c.logger.Info("Async workflow consumer state changed", tag.LifeCycleStartFailed, tag.Error(err))
+			c.stopConsumers()
+			return err
+		}
+		if err := consumer.Start(); err != nil {
+			c.logger.Info("Async workflow consumer state changed", tag.LifeCycleStartFailed, tag.Error(err))
+			c.stopConsumers()
+			return err
+		}
+		c.consumers = append(c.consumers, consumer)
+		c.producers = append(c.producers, producer)
+	}
+
+	for i, consumer := range c.consumers {
+		c.shutdownWG.Add(1)
+		go c.consumerPump(consumer, c.producers[i])
+	}
+
+	c.logger.Info("Async workflow consumer state changed", tag.LifeCycleStarted)
+	return nil
+}
+
+// Stop stops consuming from all the queues
+func (c *Consumer) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.isStopped, 0, 1) {
+		return
+	}
+
+	c.logger.Info("Async workflow consumer state changed", tag.LifeCycleStopping)
+	defer c.logger.Info("Async workflow consumer state changed", tag.LifeCycleStopped)
+
+	if atomic.LoadInt32(&c.isStarted) == 1 {
+		close(c.shutdownCh)
+	}
+
+	if success := common.AwaitWaitGroup(&c.shutdownWG, time.Minute); !success {
+		c.logger.Info("Async workflow consumer state changed", tag.LifeCycleStopTimedout)
+		return
+	}
+	for _, producer := range c.producers {
+		if closeable, ok := producer.(messaging.CloseableProducer); ok {
+			if err := closeable.Close(); err != nil {
+				c.logger.Error("Failed to close async workflow producer.", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (c *Consumer) stopConsumers() {
+	for _, consumer := range c.consumers {
+		consumer.Stop()
+	}
+	c.consumers = nil
+	c.producers = nil
+}
+
+func (c *Consumer) consumerPump(consumer messaging.Consumer, producer messaging.Producer) {
+	defer c.shutdownWG.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var workerWG sync.WaitGroup
+	for workerID := 0; workerID < c.config.Concurrency(); workerID++ {
+		workerWG.Add(1)
+		go c.messageProcessLoop(ctx, consumer, producer, &workerWG)
+	}
+
+	<-c.shutdownCh
+	// Consumer is shutting down, abort in-flight retries and close the underlying consumer
+	cancel()
+	consumer.Stop()
+
+	c.logger.Info("Async workflow consumer pump shutting down.")
+	if success := common.AwaitWaitGroup(&workerWG, 10*time.Second); !success {
+		c.logger.Warn("Async workflow consumer timed out on worker shutdown.")
+	}
+}
+
+func (c *Consumer) messageProcessLoop(
+	ctx context.Context,
+	consumer messaging.Consumer,
+	producer messaging.Producer,
+	workerWG *sync.WaitGroup,
+) {
+	defer workerWG.Done()
+
+	for msg := range consumer.Messages() {
+		sw := c.metricsClient.StartTimer(metrics.AsyncWorkflowConsumerScope, metrics.AsyncWorkflowConsumerProcessLatency)
+		err := c.process(ctx, msg)
+		sw.Stop()
+		if err != nil {
+			c.metricsClient.IncCounter(metrics.AsyncWorkflowConsumerScope, metrics.AsyncWorkflowConsumerFailures)
+			if ctx.Err() != nil {
+				// shutting down, hand the message to the next consumer of the queue
+				c.requeue(producer, msg)
+				continue
+			}
+			if err := msg.Nack(); err != nil {
+				c.logger.Error("Failed to nack async workflow message.", tag.Error(err))
+			}
+			continue
+		}
+		c.metricsClient.IncCounter(metrics.AsyncWorkflowConsumerScope, metrics.AsyncWorkflowConsumerSuccess)
+		if err := msg.Ack(); err != nil {
+			c.logger.Error("Failed to ack async workflow message.", tag.Error(err))
+		}
+	}
+}
+
+// requeue publishes a message whose processing was interrupted by shutdown back to its queue
+// and acks the original. Not every messaging implementation redelivers un-acked messages, so
+// the message is moved to the DLQ if it can't be requeued rather than being dropped.
+func (c *Consumer) requeue(producer messaging.Producer, msg messaging.Message) {
+	message, err := asyncworkflow.DecodeMessage(msg.Value())
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), requeueTimeout)
+		err = producer.Publish(ctx, message)
+		cancel()
+	}
+	if err != nil {
+		c.logger.Error("Failed to requeue async workflow message, moving it to the DLQ.", tag.Error(err))
+		if err := msg.Nack(); err != nil {
+			c.logger.Error("Failed to nack async workflow message.", tag.Error(err))
+		}
+		return
+	}
+	if err := msg.Ack(); err != nil {
+		c.logger.Error("Failed to ack async workflow message.", tag.Error(err))
+	}
+}
+
+func (c *Consumer) process(ctx context.Context, msg messaging.Message) error {
+	logger := c.logger.WithTags(tag.KafkaPartition(msg.Partition()), tag.KafkaOffset(msg.Offset()))
+
+	message, err := asyncworkflow.DecodeMessage(msg.Value())
+	if err != nil {
+		logger.Error("Failed to decode async workflow message.", tag.Error(err))
+		return err
+	}
+
+	var op func(ctx context.Context) error
+	switch message.Type {
+	case asyncworkflow.RequestTypeStartWorkflowExecution:
+		op, err = c.startWorkflowExecutionOp(message)
+	case asyncworkflow.RequestTypeSignalWithStartWorkflowExecution:
+		op, err = c.signalWithStartWorkflowExecutionOp(message)
+	default:
+		err = errUnknownRequestType
+	}
+	if err != nil {
+		logger.Error("Failed to process async workflow message.", tag.Error(err))
+		return err
+	}
+
+	// the retry policy is built per message so that changes to the max retry duration apply without a restart
+	retryPolicy := backoff.NewExponentialRetryPolicy(retryInitialInterval)
+	retryPolicy.SetExpirationInterval(c.config.MaxRetryDuration())
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(retryPolicy),
+		backoff.WithRetryableError(common.IsServiceTransientError),
+	)
+	err = throttleRetry.Do(ctx, func() error {
+		ctx, cancel := context.WithTimeout(ctx, historyRequestTimeout)
+		defer cancel()
+		return op(ctx)
+	})
+	if err != nil {
+		logger.Error("Failed to forward async workflow request to history.",
+			tag.WorkflowID(message.PartitionKey),
+			tag.Error(err))
+	}
+	return err
+}
+
+func (c *Consumer) startWorkflowExecutionOp(message *asyncworkflow.Message) (func(ctx context.Context) error, error) {
+	request, err := message.GetStartWorkflowExecutionRequest()
+	if err != nil {
+		return nil, err
+	}
+	domainID, err := c.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	requestTime := message.GetRequestTime(time.Now())
+	return func(ctx context.Context) error {
+		// the backoff is calculated from the time the request was accepted, and history schedules
+		// the first decision relative to its own clock, so the time spent in the queue is deducted
+		histRequest := common.CreateHistoryStartWorkflowRequest(domainID, request, requestTime)
+		histRequest.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(
+			deductElapsedSeconds(histRequest.GetFirstDecisionTaskBackoffSeconds(), requestTime))
+		_, err := c.historyClient.StartWorkflowExecution(ctx, histRequest)
+		return err
+	}, nil
+}
+
+func (c *Consumer) signalWithStartWorkflowExecutionOp(message *asyncworkflow.Message) (func(ctx context.Context) error, error) {
+	request, err := message.GetSignalWithStartWorkflowExecutionRequest()
+	if err != nil {
+		return nil, err
+	}
+	domainID, err := c.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	requestTime := message.GetRequestTime(time.Now())
+	var delayStartSeconds int32
+	if request.DelayStartSeconds != nil {
+		delayStartSeconds = *request.DelayStartSeconds
+	}
+	return func(ctx context.Context) error {
+		// history calculates the start backoff from its own clock, so the time spent in the queue
+		// is deducted from the start delay to keep the first decision where it would have been
+		if delayStartSeconds > 0 {
+			request.DelayStartSeconds = common.Int32Ptr(deductElapsedSeconds(delayStartSeconds, requestTime))
+		}
+		_, err := c.historyClient.SignalWithStartWorkflowExecution(ctx, &types.HistorySignalWithStartWorkflowExecutionRequest{
+			DomainUUID:             domainID,
+			SignalWithStartRequest: request,
+		})
+		return err
+	}, nil
+}
+
+// deductElapsedSeconds returns what is left of a backoff that started at the request time
+func deductElapsedSeconds(backoffSeconds int32, requestTime time.Time) int32 {
+	remaining := backoffSeconds - int32(time.Since(requestTime)/time.Second)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+func getConsumerName(queue string) string {
+	return fmt.Sprintf("%s-consumer", queue)
+}
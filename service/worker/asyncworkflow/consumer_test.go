@@ -0,0 +1,325 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package asyncworkflow
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/asyncworkflow"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/messaging/memory"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+const (
+	testQueue    = "test-queue"
+	testDomainID = "test-domain-id"
+)
+
+func newTestConsumer(
+	t *testing.T,
+	client messaging.Client,
+) (*Consumer, *history.MockClient) {
+	return newTestConsumerWithConfig(t, client, &Config{
+		Concurrency:      dynamicconfig.GetIntPropertyFn(2),
+		MaxRetryDuration: dynamicconfig.GetDurationPropertyFn(time.Second),
+	})
+}
+
+func newTestConsumerWithConfig(
+	t *testing.T,
+	client messaging.Client,
+	config *Config,
+) (*Consumer, *history.MockClient) {
+	controller := gomock.NewController(t)
+	historyClient := history.NewMockClient(controller)
+	domainCache := cache.NewMockDomainCache(controller)
+	domainCache.EXPECT().GetDomainID(gomock.Any()).Return(testDomainID, nil).AnyTimes()
+
+	consumer := NewConsumer(
+		config,
+		[]string{testQueue},
+		client,
+		historyClient,
+		domainCache,
+		log.NewNoop(),
+		metrics.NewNoopMetricsClient(),
+	)
+	return consumer, historyClient
+}
+
+func publish(t *testing.T, client messaging.Client, message *asyncworkflow.Message) {
+	producer, err := client.NewProducer(testQueue)
+	require.NoError(t, err)
+	require.NoError(t, producer.Publish(context.Background(), message))
+}
+
+func TestConsumer_StartWorkflowExecution(t *testing.T) {
+	client := memory.NewClient()
+	consumer, historyClient := newTestConsumer(t, client)
+
+	request := testdata.StartWorkflowExecutionRequest
+	done := make(chan struct{})
+	gomock.InOrder(
+		historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(nil, &types.InternalServiceError{Message: "transient"}),
+		historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *types.HistoryStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+				assert.Equal(t, testDomainID, req.DomainUUID)
+				assert.Equal(t, &request, req.StartRequest)
+				close(done)
+				return &types.StartWorkflowExecutionResponse{}, nil
+			}),
+	)
+
+	message, err := asyncworkflow.NewStartWorkflowExecutionMessage(&request, time.Now())
+	require.NoError(t, err)
+	publish(t, client, message)
+
+	require.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not forwarded to history")
+	}
+}
+
+func TestConsumer_SignalWithStartWorkflowExecution(t *testing.T) {
+	client := memory.NewClient()
+	consumer, historyClient := newTestConsumer(t, client)
+
+	request := testdata.SignalWithStartWorkflowExecutionRequest
+	done := make(chan struct{})
+	historyClient.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *types.HistorySignalWithStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			assert.Equal(t, testDomainID, req.DomainUUID)
+			assert.Equal(t, &request, req.SignalWithStartRequest)
+			close(done)
+			return &types.StartWorkflowExecutionResponse{}, nil
+		})
+
+	message, err := asyncworkflow.NewSignalWithStartWorkflowExecutionMessage(&request, time.Now())
+	require.NoError(t, err)
+	publish(t, client, message)
+
+	require.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not forwarded to history")
+	}
+}
+
+func TestConsumer_StartWorkflowExecution_DeductsQueueTimeFromBackoff(t *testing.T) {
+	client := memory.NewClient()
+	consumer, historyClient := newTestConsumer(t, client)
+
+	request := testdata.StartWorkflowExecutionRequest
+	request.CronSchedule = ""
+	request.DelayStartSeconds = common.Int32Ptr(60)
+	request.JitterStartSeconds = nil
+	requestTime := time.Now().Add(-30 * time.Second)
+	done := make(chan struct{})
+	historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *types.HistoryStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			assert.InDelta(t, 30, req.GetFirstDecisionTaskBackoffSeconds(), 1)
+			// the retry expiration is anchored at the request time rather than the consume time
+			expiration := requestTime.Add(time.Duration(request.RetryPolicy.GetExpirationIntervalInSeconds()+60) * time.Second)
+			assert.InDelta(t, expiration.UnixNano(), req.GetExpirationTimestamp(), float64(time.Second))
+			close(done)
+			return &types.StartWorkflowExecutionResponse{}, nil
+		})
+
+	message, err := asyncworkflow.NewStartWorkflowExecutionMessage(&request, requestTime)
+	require.NoError(t, err)
+	publish(t, client, message)
+
+	require.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not forwarded to history")
+	}
+}
+
+func TestConsumer_SignalWithStartWorkflowExecution_DeductsQueueTimeFromDelay(t *testing.T) {
+	client := memory.NewClient()
+	consumer, historyClient := newTestConsumer(t, client)
+
+	request := testdata.SignalWithStartWorkflowExecutionRequest
+	request.DelayStartSeconds = common.Int32Ptr(60)
+	done := make(chan struct{})
+	historyClient.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *types.HistorySignalWithStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			require.NotNil(t, req.SignalWithStartRequest.DelayStartSeconds)
+			assert.InDelta(t, 30, *req.SignalWithStartRequest.DelayStartSeconds, 1)
+			close(done)
+			return &types.StartWorkflowExecutionResponse{}, nil
+		})
+
+	message, err := asyncworkflow.NewSignalWithStartWorkflowExecutionMessage(&request, time.Now().Add(-30*time.Second))
+	require.NoError(t, err)
+	publish(t, client, message)
+
+	require.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not forwarded to history")
+	}
+}
+
+func TestConsumer_ReadsMaxRetryDurationPerMessage(t *testing.T) {
+	client := memory.NewClient()
+	var reads int32
+	consumer, historyClient := newTestConsumerWithConfig(t, client, &Config{
+		Concurrency: dynamicconfig.GetIntPropertyFn(1),
+		MaxRetryDuration: func(opts ...dynamicconfig.FilterOption) time.Duration {
+			atomic.AddInt32(&reads, 1)
+			return time.Second
+		},
+	})
+	assert.Zero(t, atomic.LoadInt32(&reads))
+
+	request := testdata.StartWorkflowExecutionRequest
+	var wg sync.WaitGroup
+	wg.Add(2)
+	historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *types.HistoryStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			wg.Done()
+			return &types.StartWorkflowExecutionResponse{}, nil
+		}).Times(2)
+
+	for i := 0; i < 2; i++ {
+		message, err := asyncworkflow.NewStartWorkflowExecutionMessage(&request, time.Now())
+		require.NoError(t, err)
+		publish(t, client, message)
+	}
+
+	require.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("requests were not forwarded to history")
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&reads))
+}
+
+func TestConsumer_NonRetryableErrorMovesMessageToDLQ(t *testing.T) {
+	client := memory.NewClient()
+	consumer, historyClient := newTestConsumer(t, client)
+
+	request := testdata.StartWorkflowExecutionRequest
+	historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, &types.BadRequestError{Message: "bad request"}).Times(1)
+
+	message, err := asyncworkflow.NewStartWorkflowExecutionMessage(&request, time.Now())
+	require.NoError(t, err)
+	publish(t, client, message)
+
+	dlq, err := client.NewConsumer(testQueue+"-dlq", "test-dlq-consumer")
+	require.NoError(t, err)
+	require.NoError(t, dlq.Start())
+	defer dlq.Stop()
+
+	require.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	select {
+	case msg := <-dlq.Messages():
+		decoded, err := asyncworkflow.DecodeMessage(msg.Value())
+		require.NoError(t, err)
+		assert.Equal(t, message, decoded)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not moved to the DLQ")
+	}
+}
+
+func TestConsumer_ShutdownRequeuesInFlightMessage(t *testing.T) {
+	client := memory.NewClient()
+	consumer, historyClient := newTestConsumer(t, client)
+
+	request := testdata.StartWorkflowExecutionRequest
+	started := make(chan struct{})
+	historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *types.HistoryStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).Times(1)
+
+	message, err := asyncworkflow.NewStartWorkflowExecutionMessage(&request, time.Now())
+	require.NoError(t, err)
+	publish(t, client, message)
+
+	require.NoError(t, consumer.Start())
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not forwarded to history")
+	}
+	consumer.Stop()
+
+	next, err := client.NewConsumer(testQueue, "test-next-consumer")
+	require.NoError(t, err)
+	require.NoError(t, next.Start())
+	defer next.Stop()
+
+	select {
+	case msg := <-next.Messages():
+		decoded, err := asyncworkflow.DecodeMessage(msg.Value())
+		require.NoError(t, err)
+		assert.Equal(t, message, decoded)
+	case <-time.After(5 * time.Second):
+		t.Fatal("in-flight message was not requeued on shutdown")
+	}
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -77,10 +78,14 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeReplicate is batch type for replicating workflows
 	BatchTypeReplicate = "replicate"
+	// BatchTypePause is batch type for pausing workflows
+	BatchTypePause = "pause"
+	// BatchTypeUnpause is batch type for unpausing workflows
+	BatchTypeUnpause = "unpause"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypePause, BatchTypeUnpause}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -119,7 +124,7 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: reset,terminate
+		// Supporting: terminate,cancel,signal,replicate,pause,unpause
 		BatchType string
 
 		// Below are all optional
@@ -224,9 +229,7 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypePause, BatchTypeUnpause:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -273,6 +276,11 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 		adminClient = batcher.clientBean.GetRemoteAdminClient(batchParams.ReplicateParams.TargetCluster)
 	}
+	var historyClient history.Client
+	if batchParams.BatchType == BatchTypePause || batchParams.BatchType == BatchTypeUnpause {
+		// pause and unpause are only exposed by history service
+		historyClient = batcher.clientBean.GetHistoryClient()
+	}
 
 	domainResp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{
 		Name: &batchParams.DomainName,
@@ -307,7 +315,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	taskCh := make(chan taskDetail, batchParams.PageSize)
 	respCh := make(chan error, batchParams.PageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, adminClient)
+		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, adminClient, historyClient)
 	}
 
 	for {
@@ -380,6 +388,7 @@ func startTaskProcessor(
 	limiter *rate.Limiter,
 	client frontend.Client,
 	adminClient admin.Client,
+	historyClient history.Client,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	for {
@@ -447,6 +456,38 @@ func startTaskProcessor(
 							RemoteCluster: batchParams.ReplicateParams.SourceCluster,
 						})
 					})
+			case BatchTypePause:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return historyClient.PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{
+							DomainUUID: domainID,
+							PauseRequest: &types.PauseWorkflowExecutionRequest{
+								Domain: batchParams.DomainName,
+								WorkflowExecution: &types.WorkflowExecution{
+									WorkflowID: workflowID,
+									RunID:      runID,
+								},
+								Reason:   batchParams.Reason,
+								Identity: BatchWFTypeName,
+							},
+						})
+					})
+			case BatchTypeUnpause:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return historyClient.UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{
+							DomainUUID: domainID,
+							UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+								Domain: batchParams.DomainName,
+								WorkflowExecution: &types.WorkflowExecution{
+									WorkflowID: workflowID,
+									RunID:      runID,
+								},
+								Reason:   batchParams.Reason,
+								Identity: BatchWFTypeName,
+							},
+						})
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -77,6 +77,7 @@ func newESProcessorAndStart(config *Config, client es.GenericClient, processorNa
 		BulkActions:   config.ESProcessorBulkActions(),
 		BulkSize:      config.ESProcessorBulkSize(),
 		FlushInterval: config.ESProcessorFlushInterval(),
+		Timeout:       config.ESProcessorBulkTimeout(),
 		Backoff:       es.NewExponentialBackoff(esProcessorInitialRetryInterval, esProcessorMaxRetryInterval),
 		BeforeFunc:    p.bulkBeforeAction,
 		AfterFunc:     p.bulkAfterAction,
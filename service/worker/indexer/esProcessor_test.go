@@ -76,6 +76,7 @@ func (s *esProcessorSuite) SetupTest() {
 		ESProcessorBulkActions:   dynamicconfig.GetIntPropertyFn(10),
 		ESProcessorBulkSize:      dynamicconfig.GetIntPropertyFn(2 << 20),
 		ESProcessorFlushInterval: dynamicconfig.GetDurationPropertyFn(1 * time.Minute),
+		ESProcessorBulkTimeout:   dynamicconfig.GetDurationPropertyFn(30 * time.Second),
 	}
 	s.mockMetricClient = &mmocks.Client{}
 	s.mockBulkProcessor = &esMocks.GenericBulkProcessor{}
@@ -109,6 +110,7 @@ func (s *esProcessorSuite) TestNewESProcessorAndStart() {
 		ESProcessorBulkActions:   dynamicconfig.GetIntPropertyFn(10),
 		ESProcessorBulkSize:      dynamicconfig.GetIntPropertyFn(2 << 20),
 		ESProcessorFlushInterval: dynamicconfig.GetDurationPropertyFn(1 * time.Minute),
+		ESProcessorBulkTimeout:   dynamicconfig.GetDurationPropertyFn(30 * time.Second),
 	}
 	processorName := "test-processor"
 
@@ -118,6 +120,7 @@ func (s *esProcessorSuite) TestNewESProcessorAndStart() {
 		s.Equal(config.ESProcessorBulkActions(), input.BulkActions)
 		s.Equal(config.ESProcessorBulkSize(), input.BulkSize)
 		s.Equal(config.ESProcessorFlushInterval(), input.FlushInterval)
+		s.Equal(config.ESProcessorBulkTimeout(), input.Timeout)
 		s.NotNil(input.Backoff)
 		s.NotNil(input.AfterFunc)
 		return true
@@ -52,6 +52,7 @@ type (
 		ESProcessorBulkActions   dynamicconfig.IntPropertyFn // max number of requests in bulk
 		ESProcessorBulkSize      dynamicconfig.IntPropertyFn // max total size of bytes in bulk
 		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
+		ESProcessorBulkTimeout   dynamicconfig.DurationPropertyFn // timeout of a bulk request
 		ValidSearchAttributes    dynamicconfig.MapPropertyFn
 	}
 )
@@ -0,0 +1,359 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// copyHeartbeatDetails is the progress of the copy activity, a retry restarts from the table being copied
+	copyHeartbeatDetails struct {
+		TableIndex int
+		Rows       int64
+	}
+)
+
+// PrepareMoveActivity returns the DB shard storing the history shard without changing the DB shard map,
+// the history shard is skipped if it is already stored in the target DB shard
+func PrepareMoveActivity(ctx context.Context, params *ShardActivityParams) (*StartMoveResult, error) {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.TargetDBShardID >= resharder.db.GetTotalNumDatabases() {
+		return nil, cadence.NewCustomError(errMsgInvalidTargetDBShard, params.TargetDBShardID)
+	}
+
+	shardMap, err := resharder.db.RefreshDBShardMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &StartMoveResult{
+		SourceDBShardID: shardMap.GetDBShardID(params.HistoryShardID, resharder.db.GetTotalNumDBShards()),
+		Version:         shardMap.Version,
+	}
+	if move, ok := shardMap.GetMove(params.HistoryShardID); ok {
+		if move.DBShardID != params.TargetDBShardID {
+			return nil, cadence.NewCustomError(errMsgShardAlreadyMoving, params.HistoryShardID)
+		}
+	} else if result.SourceDBShardID == params.TargetDBShardID {
+		result.Skipped = true
+	}
+	return result, nil
+}
+
+// StartMoveActivity freezes the history shard in the DB shard map, requests to the history shard
+// are rejected until the move is completed or aborted
+func StartMoveActivity(ctx context.Context, params *ShardActivityParams) (*StartMoveResult, error) {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.TargetDBShardID >= resharder.db.GetTotalNumDatabases() {
+		return nil, cadence.NewCustomError(errMsgInvalidTargetDBShard, params.TargetDBShardID)
+	}
+
+	result := &StartMoveResult{}
+	shardMap, err := resharder.updateDBShardMap(ctx, func(shardMap *sqlplugin.DBShardMap) (*sqlplugin.DBShardMap, error) {
+		result.SourceDBShardID = shardMap.GetDBShardID(params.HistoryShardID, resharder.db.GetTotalNumDBShards())
+		if _, ok := shardMap.GetMove(params.HistoryShardID); !ok && result.SourceDBShardID == params.TargetDBShardID {
+			result.Skipped = true
+			return shardMap, nil
+		}
+		next, err := shardMap.StartMove(params.HistoryShardID, params.TargetDBShardID)
+		if err != nil {
+			return nil, cadence.NewCustomError(errMsgShardAlreadyMoving, err.Error())
+		}
+		return next, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Version = shardMap.Version
+	return result, nil
+}
+
+// CloseShardActivity closes the history shard in the history service and bumps its range ID,
+// so that any write of a previous owner of the history shard fails
+func CloseShardActivity(ctx context.Context, params *ShardActivityParams) error {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return err
+	}
+	if err := resharder.clientBean.GetHistoryClient().CloseShard(ctx, &types.CloseShardRequest{
+		ShardID: int32(params.HistoryShardID),
+	}); err != nil {
+		return err
+	}
+
+	row, err := resharder.db.SelectFromShards(ctx, &sqlplugin.ShardsFilter{ShardID: int64(params.HistoryShardID)})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// the history shard has never been acquired, there is nothing to fence
+			return nil
+		}
+		return err
+	}
+	row.RangeID++
+	_, err = resharder.db.UpdateShards(ctx, row)
+	return err
+}
+
+// CopyShardActivity makes the rows of the history shard in the target DB shard equal to the source DB shard.
+// The bulk copy runs while the history shard is still served, so that the delta copy run after closing it
+// only writes the rows changed in the meantime. The tables with an auto increment column can't be compared
+// row by row, they are small and only copied by the delta copy.
+func CopyShardActivity(ctx context.Context, params *ShardActivityParams) (*CopyShardResult, error) {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.DeltaCopy {
+		if err := resharder.ensureMoving(ctx, params); err != nil {
+			return nil, err
+		}
+	}
+
+	var details copyHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			resharder.logger.Warn("failed to get the heartbeat details of the copy activity", tag.Error(err))
+			details = copyHeartbeatDetails{}
+		}
+	}
+	for ; details.TableIndex < len(sqlplugin.HistoryShardTables); details.TableIndex++ {
+		activity.RecordHeartbeat(ctx, details)
+		table := sqlplugin.HistoryShardTables[details.TableIndex]
+		if table.AutoIncrementColumn != "" {
+			if !params.DeltaCopy {
+				continue
+			}
+			if _, err := resharder.db.DeleteHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{
+				Table:          table.Name,
+				HistoryShardID: params.HistoryShardID,
+				DBShardID:      params.TargetDBShardID,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		filter := &sqlplugin.HistoryShardRowsFilter{
+			Table:           table.Name,
+			HistoryShardID:  params.HistoryShardID,
+			DBShardID:       params.SourceDBShardID,
+			TargetDBShardID: params.TargetDBShardID,
+			PageSize:        params.CopyPageSize,
+		}
+		for {
+			page, err := resharder.db.SyncHistoryShardRows(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			details.Rows += int64(page.Written)
+			if page.LastKey == nil {
+				break
+			}
+			filter.AfterKey = page.LastKey
+			activity.RecordHeartbeat(ctx, details)
+		}
+	}
+	return &CopyShardResult{Rows: details.Rows}, nil
+}
+
+// VerifyShardActivity checks that every table of the history shard has the same number of rows
+// in the source and target DB shards
+func VerifyShardActivity(ctx context.Context, params *ShardActivityParams) (*CopyShardResult, error) {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := resharder.ensureMoving(ctx, params); err != nil {
+		return nil, err
+	}
+
+	result := &CopyShardResult{}
+	for _, table := range sqlplugin.HistoryShardTables {
+		sourceRows, err := resharder.db.CountHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{
+			Table:          table.Name,
+			HistoryShardID: params.HistoryShardID,
+			DBShardID:      params.SourceDBShardID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		targetRows, err := resharder.db.CountHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{
+			Table:          table.Name,
+			HistoryShardID: params.HistoryShardID,
+			DBShardID:      params.TargetDBShardID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if sourceRows != targetRows {
+			return nil, cadence.NewCustomError(errMsgVerificationFailed, fmt.Sprintf(
+				"table %v has %v rows in DB shard %v and %v rows in DB shard %v",
+				table.Name, sourceRows, params.SourceDBShardID, targetRows, params.TargetDBShardID,
+			))
+		}
+		result.Rows += sourceRows
+		activity.RecordHeartbeat(ctx, result.Rows)
+	}
+	return result, nil
+}
+
+// CompleteMoveActivity assigns the history shard to the target DB shard in the DB shard map
+// and returns the new version of the map
+func CompleteMoveActivity(ctx context.Context, params *ShardActivityParams) (int64, error) {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return 0, err
+	}
+	shardMap, err := resharder.updateDBShardMap(ctx, func(shardMap *sqlplugin.DBShardMap) (*sqlplugin.DBShardMap, error) {
+		if _, ok := shardMap.GetMove(params.HistoryShardID); !ok &&
+			shardMap.GetDBShardID(params.HistoryShardID, resharder.db.GetTotalNumDBShards()) == params.TargetDBShardID {
+			// the move is already completed by a previous attempt
+			return shardMap, nil
+		}
+		next, err := shardMap.CompleteMove(params.HistoryShardID)
+		if err != nil {
+			return nil, cadence.NewCustomError(errMsgShardNotMoving, err.Error())
+		}
+		return next, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return shardMap.Version, nil
+}
+
+// AbortMoveActivity unfreezes the history shard in the DB shard map, it keeps being served from the source DB shard
+func AbortMoveActivity(ctx context.Context, params *ShardActivityParams) error {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = resharder.updateDBShardMap(ctx, func(shardMap *sqlplugin.DBShardMap) (*sqlplugin.DBShardMap, error) {
+		return shardMap.AbortMove(params.HistoryShardID), nil
+	})
+	return err
+}
+
+// ReopenShardActivity makes the history service acquire the history shard again by describing its transfer queue
+func ReopenShardActivity(ctx context.Context, params *ShardActivityParams) error {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = resharder.clientBean.GetHistoryClient().DescribeQueue(ctx, &types.DescribeQueueRequest{
+		ShardID:     int32(params.HistoryShardID),
+		ClusterName: resharder.clusterName,
+		Type:        common.Int32Ptr(int32(common.TaskTypeTransfer)),
+	})
+	return err
+}
+
+// DeleteSourceDataActivity deletes the rows of a moved history shard from the source DB shard
+func DeleteSourceDataActivity(ctx context.Context, params *ShardActivityParams) error {
+	resharder, err := getResharder(ctx)
+	if err != nil {
+		return err
+	}
+	shardMap, err := resharder.db.RefreshDBShardMap(ctx)
+	if err != nil {
+		return err
+	}
+	_, moving := shardMap.GetMove(params.HistoryShardID)
+	if moving || shardMap.GetDBShardID(params.HistoryShardID, resharder.db.GetTotalNumDBShards()) == params.SourceDBShardID {
+		return cadence.NewCustomError(errMsgSourceStillInUse, params.SourceDBShardID)
+	}
+
+	for _, table := range sqlplugin.HistoryShardTables {
+		if _, err := resharder.db.DeleteHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{
+			Table:          table.Name,
+			HistoryShardID: params.HistoryShardID,
+			DBShardID:      params.SourceDBShardID,
+		}); err != nil {
+			return err
+		}
+		activity.RecordHeartbeat(ctx, table.Name)
+	}
+	return nil
+}
+
+func getResharder(ctx context.Context) (*Resharder, error) {
+	resharder, ok := ctx.Value(resharderContextKey).(*Resharder)
+	if !ok || resharder.db == nil {
+		return nil, cadence.NewCustomError(errMsgResharderNotConfigured)
+	}
+	return resharder, nil
+}
+
+// updateDBShardMap applies the update to the latest DB shard map and stores the result as the next version,
+// a concurrent update of the map fails the attempt so that it is retried on top of the new version
+func (r *Resharder) updateDBShardMap(
+	ctx context.Context,
+	update func(*sqlplugin.DBShardMap) (*sqlplugin.DBShardMap, error),
+) (*sqlplugin.DBShardMap, error) {
+	shardMap, err := r.db.RefreshDBShardMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	next, err := update(shardMap)
+	if err != nil || next == shardMap {
+		return next, err
+	}
+
+	row, err := next.ToRow()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.db.InsertIntoDBShardMaps(ctx, row); err != nil {
+		if r.db.IsDupEntryError(err) {
+			return nil, fmt.Errorf("%v: version %v", errMsgDBShardMapConflict, next.Version)
+		}
+		return nil, err
+	}
+	r.logger.Info("updated DB shard map", tag.Number(next.Version))
+	return r.db.RefreshDBShardMap(ctx)
+}
+
+// ensureMoving checks that the history shard is frozen in the DB shard map,
+// rows must not be moved while the history shard can still be written
+func (r *Resharder) ensureMoving(ctx context.Context, params *ShardActivityParams) error {
+	shardMap, err := r.db.RefreshDBShardMap(ctx)
+	if err != nil {
+		return err
+	}
+	if move, ok := shardMap.GetMove(params.HistoryShardID); !ok || move.DBShardID != params.TargetDBShardID {
+		return cadence.NewCustomError(errMsgShardNotMoving, params.HistoryShardID)
+	}
+	return nil
+}
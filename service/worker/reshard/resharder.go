@@ -0,0 +1,127 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the DB resharder
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+		// ClusterName is the name of the current cluster, it is used to reopen the moved history shards
+		ClusterName string
+		// DB is the sharded SQL database of the default store, it is closed when the resharder stops
+		DB sqlplugin.DB
+	}
+
+	// Resharder is the background sub-system that moves history shards between the databases
+	// of a sharded SQL store while the cluster keeps serving the other shards.
+	// It is also the context object that get's passed around within the reshard activities
+	Resharder struct {
+		svcClient     workflowserviceclient.Interface
+		clientBean    client.Bean
+		clusterName   string
+		db            sqlplugin.DB
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+		worker        worker.Worker
+	}
+)
+
+// New returns a new instance of the DB resharder
+func New(params *BootstrapParams) *Resharder {
+	return &Resharder{
+		svcClient:     params.ServiceClient,
+		clientBean:    params.ClientBean,
+		clusterName:   params.ClusterName,
+		db:            params.DB,
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentDBResharder),
+	}
+}
+
+// Start starts the worker for reshard workflows
+func (r *Resharder) Start() error {
+	ctx := context.WithValue(context.Background(), resharderContextKey, r)
+	workerOpts := worker.Options{
+		MetricsScope:              r.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	reshardWorker := worker.New(r.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	reshardWorker.RegisterWorkflowWithOptions(ReshardWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	registerActivities(reshardWorker)
+	r.worker = reshardWorker
+	return reshardWorker.Start()
+}
+
+// Stop stops the worker and closes the database
+func (r *Resharder) Stop() {
+	if r.worker != nil {
+		r.worker.Stop()
+	}
+	if err := r.db.Close(); err != nil {
+		r.logger.Warn("failed to close the database", tag.Error(err))
+	}
+}
+
+type activityRegistry interface {
+	RegisterActivityWithOptions(a interface{}, options activity.RegisterOptions)
+}
+
+func registerActivities(registry activityRegistry) {
+	registry.RegisterActivityWithOptions(PrepareMoveActivity, activity.RegisterOptions{Name: prepareMoveActivityName})
+	registry.RegisterActivityWithOptions(StartMoveActivity, activity.RegisterOptions{Name: startMoveActivityName})
+	registry.RegisterActivityWithOptions(CloseShardActivity, activity.RegisterOptions{Name: closeShardActivityName})
+	registry.RegisterActivityWithOptions(CopyShardActivity, activity.RegisterOptions{Name: copyShardActivityName})
+	registry.RegisterActivityWithOptions(VerifyShardActivity, activity.RegisterOptions{Name: verifyShardActivityName})
+	registry.RegisterActivityWithOptions(CompleteMoveActivity, activity.RegisterOptions{Name: completeMoveActivityName})
+	registry.RegisterActivityWithOptions(AbortMoveActivity, activity.RegisterOptions{Name: abortMoveActivityName})
+	registry.RegisterActivityWithOptions(ReopenShardActivity, activity.RegisterOptions{Name: reopenShardActivityName})
+	registry.RegisterActivityWithOptions(DeleteSourceDataActivity, activity.RegisterOptions{Name: deleteSourceDataActivityName})
+}
@@ -0,0 +1,378 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	resharderContextKey contextKey = "resharderContext"
+
+	// TaskListName is the task list of the reshard workflow
+	TaskListName = "cadence-sys-db-reshard-tasklist"
+	// WorkflowTypeName is the workflow type name of the reshard workflow
+	WorkflowTypeName = "cadence-sys-db-reshard-workflow"
+	// WorkflowID will be reused to ensure only one reshard is running
+	WorkflowID = "cadence-sys-db-reshard"
+
+	prepareMoveActivityName      = "cadence-sys-db-reshard-prepareMove-activity"
+	startMoveActivityName        = "cadence-sys-db-reshard-startMove-activity"
+	closeShardActivityName       = "cadence-sys-db-reshard-closeShard-activity"
+	copyShardActivityName        = "cadence-sys-db-reshard-copyShard-activity"
+	verifyShardActivityName      = "cadence-sys-db-reshard-verifyShard-activity"
+	completeMoveActivityName     = "cadence-sys-db-reshard-completeMove-activity"
+	abortMoveActivityName        = "cadence-sys-db-reshard-abortMove-activity"
+	reopenShardActivityName      = "cadence-sys-db-reshard-reopenShard-activity"
+	deleteSourceDataActivityName = "cadence-sys-db-reshard-deleteSourceData-activity"
+
+	// QueryType for reshard workflow
+	QueryType = "state"
+
+	// DefaultCopyPageSize is the default number of rows copied to the target database in one page
+	DefaultCopyPageSize = 500
+
+	// shardsPerRun is the number of history shards after which the workflow continues as new with a checkpoint
+	shardsPerRun = 100
+
+	errMsgParamsIsNil            = "params is nil"
+	errMsgInvalidShardRange      = "invalid history shard range"
+	errMsgInvalidTargetDBShard   = "invalid target DB shard"
+	errMsgShardAlreadyMoving     = "history shard is already being moved to another DB shard"
+	errMsgShardNotMoving         = "history shard is not being moved"
+	errMsgVerificationFailed     = "copied rows do not match the source rows"
+	errMsgSourceStillInUse       = "source DB shard is still in use by the history shard"
+	errMsgDBShardMapConflict     = "DB shard map was updated concurrently"
+	errMsgResharderNotConfigured = "DB resharder is not configured"
+)
+
+// phases of the move of a history shard
+const (
+	// PhasePrepareMove finds the DB shard storing the history shard
+	PhasePrepareMove = "prepareMove"
+	// PhaseBulkCopy copies the rows of the history shard to the target DB shard while the history shard is served
+	PhaseBulkCopy = "bulkCopy"
+	// PhaseStartMove freezes the history shard in the DB shard map
+	PhaseStartMove = "startMove"
+	// PhaseCloseShard closes the history shard in the history service and fences its owner
+	PhaseCloseShard = "closeShard"
+	// PhaseDeltaCopy copies the rows of the history shard changed since the bulk copy to the target DB shard
+	PhaseDeltaCopy = "deltaCopy"
+	// PhaseVerify compares the rows of the history shard in the source and target DB shards
+	PhaseVerify = "verify"
+	// PhaseCompleteMove assigns the history shard to the target DB shard in the DB shard map
+	PhaseCompleteMove = "completeMove"
+	// PhaseReopenShard reopens the history shard in the history service
+	PhaseReopenShard = "reopenShard"
+	// PhaseDeleteSourceData deletes the rows of the history shard from the source DB shard
+	PhaseDeleteSourceData = "deleteSourceData"
+	// PhaseCompleted means all the history shards are moved, the report is final
+	PhaseCompleted = "completed"
+)
+
+type (
+	// ReshardParams is the arg for the reshard workflow
+	ReshardParams struct {
+		// FirstHistoryShardID and LastHistoryShardID is the inclusive range of history shards to move
+		FirstHistoryShardID int
+		LastHistoryShardID  int
+		// TargetDBShardID is the index of the database in multipleDatabasesConfig to move the history shards to
+		TargetDBShardID int
+		// CopyPageSize is the number of rows copied to the target database in one page
+		CopyPageSize int
+		// DeleteSourceData deletes the rows of each moved history shard from the source database
+		DeleteSourceData bool
+		// Checkpoint is set by the workflow when it continues as new, it must be empty when starting a reshard
+		Checkpoint *Checkpoint
+	}
+
+	// Checkpoint is the progress of a reshard that is carried over when the workflow continues as new
+	Checkpoint struct {
+		// NextHistoryShardID is the next history shard to move
+		NextHistoryShardID int
+		Report             *ReshardReport
+	}
+
+	// ReshardReport is the result of the reshard workflow
+	ReshardReport struct {
+		// Moved is the number of history shards moved to the target DB shard
+		Moved int
+		// Skipped is the number of history shards that were already in the target DB shard
+		Skipped int
+		// CopiedRows is the number of rows written to the target DB shard
+		CopiedRows int64
+		// DeltaRows is the part of CopiedRows written while the history shards were closed
+		DeltaRows int64
+		// DBShardMapVersion is the version of the DB shard map after the last move
+		DBShardMapVersion int64
+	}
+
+	// ShardActivityParams is the params for the activities moving a history shard
+	ShardActivityParams struct {
+		HistoryShardID  int
+		SourceDBShardID int
+		TargetDBShardID int
+		CopyPageSize    int
+		// DeltaCopy is set for the copy run after the history shard is closed
+		DeltaCopy bool
+	}
+
+	// StartMoveResult is the result of the prepare and start move activities
+	StartMoveResult struct {
+		// Skipped is true if the history shard is already in the target DB shard
+		Skipped         bool
+		SourceDBShardID int
+		Version         int64
+	}
+
+	// CopyShardResult is the result of the copy and verify activities, the number of rows written
+	// to the target DB shard by a copy or the number of rows compared by a verification
+	CopyShardResult struct {
+		Rows int64
+	}
+
+	// QueryResult for reshard progress
+	QueryResult struct {
+		FirstHistoryShardID   int
+		LastHistoryShardID    int
+		TargetDBShardID       int
+		CurrentHistoryShardID int
+		Phase                 string
+		Report                *ReshardReport
+	}
+)
+
+// ReshardWorkflow is the workflow that moves a range of history shards to the target DB shard one by one.
+// The rows of every history shard are bulk copied while it keeps serving, then it is frozen in the DB shard map
+// and closed in history, so that no writes can happen while the rows changed during the bulk copy are copied
+// and verified, then it is assigned to the target DB shard and reopened.
+// The other history shards keep serving during the whole reshard.
+func ReshardWorkflow(ctx workflow.Context, params *ReshardParams) (*ReshardReport, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+
+	checkpoint := params.Checkpoint
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{
+			NextHistoryShardID: params.FirstHistoryShardID,
+			Report:             &ReshardReport{},
+		}
+	}
+	phase := PhasePrepareMove
+	if err := workflow.SetQueryHandler(ctx, QueryType, func() (*QueryResult, error) {
+		return &QueryResult{
+			FirstHistoryShardID:   params.FirstHistoryShardID,
+			LastHistoryShardID:    params.LastHistoryShardID,
+			TargetDBShardID:       params.TargetDBShardID,
+			CurrentHistoryShardID: checkpoint.NextHistoryShardID,
+			Phase:                 phase,
+			Report:                checkpoint.Report,
+		}, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for shards := 0; checkpoint.NextHistoryShardID <= params.LastHistoryShardID; shards++ {
+		if shards >= shardsPerRun {
+			params.Checkpoint = checkpoint
+			return nil, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+		}
+		if err := moveShard(ctx, params, checkpoint, func(p string) { phase = p }); err != nil {
+			return nil, err
+		}
+		checkpoint.NextHistoryShardID++
+	}
+	phase = PhaseCompleted
+	return checkpoint.Report, nil
+}
+
+// moveShard moves the next history shard of the checkpoint to the target DB shard.
+// Only the delta copy, verification and flip of the DB shard map happen while the history shard is closed.
+// The move is aborted if the history shard can't be copied or verified, or if the workflow is cancelled
+// before the move is completed, so that the history shard is served from the source DB shard again.
+func moveShard(ctx workflow.Context, params *ReshardParams, checkpoint *Checkpoint, setPhase func(string)) error {
+	activityParams := &ShardActivityParams{
+		HistoryShardID:  checkpoint.NextHistoryShardID,
+		TargetDBShardID: params.TargetDBShardID,
+		CopyPageSize:    params.CopyPageSize,
+	}
+	shardMapCtx := workflow.WithActivityOptions(ctx, getShardMapActivityOptions())
+	copyCtx := workflow.WithActivityOptions(ctx, getCopyActivityOptions())
+
+	setPhase(PhasePrepareMove)
+	var prepared StartMoveResult
+	if err := workflow.ExecuteActivity(shardMapCtx, PrepareMoveActivity, activityParams).Get(ctx, &prepared); err != nil {
+		return err
+	}
+	if prepared.Skipped {
+		checkpoint.Report.Skipped++
+		return nil
+	}
+	activityParams.SourceDBShardID = prepared.SourceDBShardID
+
+	setPhase(PhaseBulkCopy)
+	var bulkCopied CopyShardResult
+	if err := workflow.ExecuteActivity(copyCtx, CopyShardActivity, activityParams).Get(ctx, &bulkCopied); err != nil {
+		return err
+	}
+
+	setPhase(PhaseStartMove)
+	var started StartMoveResult
+	if err := workflow.ExecuteActivity(shardMapCtx, StartMoveActivity, activityParams).Get(ctx, &started); err != nil {
+		return err
+	}
+	if started.Skipped {
+		checkpoint.Report.Skipped++
+		return nil
+	}
+
+	abort := func(cause error) error {
+		disconnectedCtx, _ := workflow.NewDisconnectedContext(ctx)
+		if err := workflow.ExecuteActivity(
+			workflow.WithActivityOptions(disconnectedCtx, getShardMapActivityOptions()), AbortMoveActivity, activityParams,
+		).Get(disconnectedCtx, nil); err != nil {
+			workflow.GetLogger(ctx).Error("failed to abort the move of the history shard")
+			return err
+		}
+		// reopening is best effort, the history shard is acquired anyway by the next request routed to it
+		_ = workflow.ExecuteActivity(
+			workflow.WithActivityOptions(disconnectedCtx, getShardActivityOptions()), ReopenShardActivity, activityParams,
+		).Get(disconnectedCtx, nil)
+		return cause
+	}
+	if started.SourceDBShardID != prepared.SourceDBShardID {
+		// the history shard was moved by someone else during the bulk copy
+		return abort(errors.New(errMsgDBShardMapConflict))
+	}
+
+	setPhase(PhaseCloseShard)
+	shardCtx := workflow.WithActivityOptions(ctx, getShardActivityOptions())
+	if err := workflow.ExecuteActivity(shardCtx, CloseShardActivity, activityParams).Get(ctx, nil); err != nil {
+		return abort(err)
+	}
+
+	setPhase(PhaseDeltaCopy)
+	activityParams.DeltaCopy = true
+	var deltaCopied CopyShardResult
+	if err := workflow.ExecuteActivity(copyCtx, CopyShardActivity, activityParams).Get(ctx, &deltaCopied); err != nil {
+		return abort(err)
+	}
+
+	setPhase(PhaseVerify)
+	if err := workflow.ExecuteActivity(copyCtx, VerifyShardActivity, activityParams).Get(ctx, nil); err != nil {
+		return abort(err)
+	}
+
+	setPhase(PhaseCompleteMove)
+	var version int64
+	if err := workflow.ExecuteActivity(shardMapCtx, CompleteMoveActivity, activityParams).Get(ctx, &version); err != nil {
+		return abort(err)
+	}
+	checkpoint.Report.Moved++
+	checkpoint.Report.CopiedRows += bulkCopied.Rows + deltaCopied.Rows
+	checkpoint.Report.DeltaRows += deltaCopied.Rows
+	checkpoint.Report.DBShardMapVersion = version
+
+	setPhase(PhaseReopenShard)
+	if err := workflow.ExecuteActivity(shardCtx, ReopenShardActivity, activityParams).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	if params.DeleteSourceData {
+		setPhase(PhaseDeleteSourceData)
+		if err := workflow.ExecuteActivity(copyCtx, DeleteSourceDataActivity, activityParams).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateParams(params *ReshardParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if params.FirstHistoryShardID < 0 || params.FirstHistoryShardID > params.LastHistoryShardID {
+		return errors.New(errMsgInvalidShardRange)
+	}
+	if params.TargetDBShardID < 0 {
+		return errors.New(errMsgInvalidTargetDBShard)
+	}
+	if params.CopyPageSize <= 0 {
+		params.CopyPageSize = DefaultCopyPageSize
+	}
+	return nil
+}
+
+func getShardMapActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    30 * time.Second,
+			ExpirationInterval: 10 * time.Minute,
+			NonRetriableErrorReasons: []string{
+				errMsgInvalidTargetDBShard,
+				errMsgShardAlreadyMoving,
+				errMsgShardNotMoving,
+				errMsgResharderNotConfigured,
+			},
+		},
+	}
+}
+
+func getShardActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    2 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+		},
+	}
+}
+
+func getCopyActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          5 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       2 * time.Hour,
+			NonRetriableErrorReasons: []string{errMsgShardNotMoving, errMsgVerificationFailed, errMsgSourceStillInUse},
+		},
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+	sqliteschema "github.com/uber/cadence/schema/sqlite"
+)
+
+const (
+	testClusterName = "test-cluster"
+)
+
+type reshardWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+
+	controller   *gomock.Controller
+	mockResource *resource.Test
+	db           sqlplugin.DB
+	databases    []string
+}
+
+func TestReshardWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(reshardWorkflowTestSuite))
+}
+
+func (s *reshardWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(ReshardWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	registerActivities(s.workflowEnv)
+	registerActivities(s.activityEnv)
+
+	s.controller = gomock.NewController(s.T())
+	s.mockResource = resource.NewTest(s.controller, metrics.Worker)
+}
+
+func (s *reshardWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.controller.Finish()
+	if s.db != nil {
+		s.NoError(s.db.Close())
+		for _, name := range s.databases {
+			adminDB, err := sql.NewSQLAdminDB(s.newSQLConfig(name))
+			s.NoError(err)
+			s.NoError(adminDB.DropDatabase(name))
+		}
+	}
+}
+
+func (s *reshardWorkflowTestSuite) TestValidateParams() {
+	s.Error(validateParams(nil))
+	s.Error(validateParams(&ReshardParams{FirstHistoryShardID: -1}))
+	s.Error(validateParams(&ReshardParams{FirstHistoryShardID: 2, LastHistoryShardID: 1}))
+	s.Error(validateParams(&ReshardParams{TargetDBShardID: -1}))
+	params := &ReshardParams{LastHistoryShardID: 1}
+	s.NoError(validateParams(params))
+	s.Equal(DefaultCopyPageSize, params.CopyPageSize)
+}
+
+func (s *reshardWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReshardParams{FirstHistoryShardID: 1})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *reshardWorkflowTestSuite) TestWorkflow_Success() {
+	// history shard 1 is already in the target DB shard
+	s.workflowEnv.OnActivity(prepareMoveActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params *ShardActivityParams) (*StartMoveResult, error) {
+			return &StartMoveResult{Skipped: params.HistoryShardID == 1, SourceDBShardID: 0, Version: int64(params.HistoryShardID)}, nil
+		}).Times(3)
+	s.workflowEnv.OnActivity(startMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveResult{SourceDBShardID: 0}, nil).Times(2)
+	s.workflowEnv.OnActivity(closeShardActivityName, mock.Anything, mock.Anything).Return(nil).Times(2)
+	// the bulk copy writes most of the rows before the history shard is closed
+	s.workflowEnv.OnActivity(copyShardActivityName, mock.Anything, mock.MatchedBy(func(params *ShardActivityParams) bool {
+		return !params.DeltaCopy
+	})).Return(&CopyShardResult{Rows: 5}, nil).Times(2)
+	s.workflowEnv.OnActivity(copyShardActivityName, mock.Anything, mock.MatchedBy(func(params *ShardActivityParams) bool {
+		return params.DeltaCopy
+	})).Return(&CopyShardResult{Rows: 1}, nil).Times(2)
+	s.workflowEnv.OnActivity(verifyShardActivityName, mock.Anything, mock.Anything).Return(&CopyShardResult{Rows: 5}, nil).Times(2)
+	s.workflowEnv.OnActivity(completeMoveActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params *ShardActivityParams) (int64, error) {
+			return int64(params.HistoryShardID + 10), nil
+		}).Times(2)
+	s.workflowEnv.OnActivity(reopenShardActivityName, mock.Anything, mock.Anything).Return(nil).Times(2)
+	s.workflowEnv.OnActivity(deleteSourceDataActivityName, mock.Anything, mock.MatchedBy(func(params *ShardActivityParams) bool {
+		return params.SourceDBShardID == 0 && params.TargetDBShardID == 2
+	})).Return(nil).Times(2)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReshardParams{
+		FirstHistoryShardID: 0,
+		LastHistoryShardID:  2,
+		TargetDBShardID:     2,
+		DeleteSourceData:    true,
+	})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var report ReshardReport
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Equal(ReshardReport{Moved: 2, Skipped: 1, CopiedRows: 12, DeltaRows: 2, DBShardMapVersion: 12}, report)
+
+	value, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var result QueryResult
+	s.NoError(value.Get(&result))
+	s.Equal(PhaseCompleted, result.Phase)
+	s.Equal(3, result.CurrentHistoryShardID)
+}
+
+func (s *reshardWorkflowTestSuite) TestWorkflow_VerificationFailed() {
+	s.workflowEnv.OnActivity(prepareMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveResult{SourceDBShardID: 0}, nil).Once()
+	s.workflowEnv.OnActivity(startMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveResult{SourceDBShardID: 0}, nil).Once()
+	s.workflowEnv.OnActivity(closeShardActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+	s.workflowEnv.OnActivity(copyShardActivityName, mock.Anything, mock.Anything).Return(&CopyShardResult{Rows: 5}, nil).Twice()
+	s.workflowEnv.OnActivity(verifyShardActivityName, mock.Anything, mock.Anything).Return(nil, cadence.NewCustomError(errMsgVerificationFailed)).Once()
+	// the move is aborted so that the history shard is served from the source DB shard again
+	s.workflowEnv.OnActivity(abortMoveActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+	s.workflowEnv.OnActivity(reopenShardActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReshardParams{FirstHistoryShardID: 3, LastHistoryShardID: 4, TargetDBShardID: 2})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	err := s.workflowEnv.GetWorkflowError()
+	s.Error(err)
+	var customErr *cadence.CustomError
+	s.True(errors.As(err, &customErr))
+	s.Equal(errMsgVerificationFailed, customErr.Reason())
+}
+
+func (s *reshardWorkflowTestSuite) TestWorkflow_BulkCopyFailed() {
+	s.workflowEnv.OnActivity(prepareMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveResult{SourceDBShardID: 0}, nil).Once()
+	// the history shard is not frozen yet, so there is nothing to abort
+	s.workflowEnv.OnActivity(copyShardActivityName, mock.Anything, mock.Anything).Return(nil, cadence.NewCustomError(errMsgShardNotMoving)).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReshardParams{FirstHistoryShardID: 3, LastHistoryShardID: 3, TargetDBShardID: 2})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *reshardWorkflowTestSuite) TestWorkflow_SourceChangedDuringBulkCopy() {
+	s.workflowEnv.OnActivity(prepareMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveResult{SourceDBShardID: 0}, nil).Once()
+	s.workflowEnv.OnActivity(copyShardActivityName, mock.Anything, mock.Anything).Return(&CopyShardResult{Rows: 5}, nil).Once()
+	s.workflowEnv.OnActivity(startMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveResult{SourceDBShardID: 1}, nil).Once()
+	s.workflowEnv.OnActivity(abortMoveActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+	s.workflowEnv.OnActivity(reopenShardActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReshardParams{FirstHistoryShardID: 3, LastHistoryShardID: 3, TargetDBShardID: 2})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	err := s.workflowEnv.GetWorkflowError()
+	s.Error(err)
+	s.Contains(err.Error(), errMsgDBShardMapConflict)
+}
+
+func (s *reshardWorkflowTestSuite) TestWorkflow_ContinueAsNew() {
+	s.workflowEnv.OnActivity(prepareMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveResult{Skipped: true}, nil).Times(shardsPerRun)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReshardParams{FirstHistoryShardID: 0, LastHistoryShardID: shardsPerRun, TargetDBShardID: 1})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	err := s.workflowEnv.GetWorkflowError()
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.True(errors.As(err, &continueAsNewErr))
+}
+
+func (s *reshardWorkflowTestSuite) TestActivities_MoveShard() {
+	ctx := context.Background()
+	s.setupDB()
+	historyShardID := 4
+	s.Equal(0, s.db.GetDBShardIDFromHistoryShardID(historyShardID))
+	_, err := s.db.InsertIntoShards(ctx, &sqlplugin.ShardsRow{ShardID: int64(historyShardID), RangeID: 3, Data: []byte("shard"), DataEncoding: "json"})
+	s.NoError(err)
+	_, err = s.db.InsertIntoBufferedEvents(ctx, []sqlplugin.BufferedEventsRow{{
+		ShardID:      historyShardID,
+		DomainID:     serialization.MustParseUUID(uuid.New()),
+		WorkflowID:   "wid",
+		RunID:        serialization.MustParseUUID(uuid.New()),
+		Data:         []byte("event"),
+		DataEncoding: "json",
+	}})
+	s.NoError(err)
+
+	params := &ShardActivityParams{HistoryShardID: historyShardID, TargetDBShardID: 2, CopyPageSize: 1}
+	value, err := s.activityEnv.ExecuteActivity(prepareMoveActivityName, params)
+	s.NoError(err)
+	var prepared StartMoveResult
+	s.NoError(value.Get(&prepared))
+	s.Equal(StartMoveResult{SourceDBShardID: 0, Version: 0}, prepared)
+	params.SourceDBShardID = prepared.SourceDBShardID
+
+	// the bulk copy skips the buffered events, they are only copied once the history shard is closed
+	value, err = s.activityEnv.ExecuteActivity(copyShardActivityName, params)
+	s.NoError(err)
+	var copied CopyShardResult
+	s.NoError(value.Get(&copied))
+	s.Equal(int64(1), copied.Rows)
+
+	value, err = s.activityEnv.ExecuteActivity(startMoveActivityName, params)
+	s.NoError(err)
+	var started StartMoveResult
+	s.NoError(value.Get(&started))
+	s.Equal(StartMoveResult{SourceDBShardID: 0, Version: 1}, started)
+
+	s.mockResource.HistoryClient.EXPECT().CloseShard(gomock.Any(), &types.CloseShardRequest{ShardID: int32(historyShardID)}).Return(nil)
+	_, err = s.activityEnv.ExecuteActivity(closeShardActivityName, params)
+	s.NoError(err)
+	shard, err := s.db.SelectFromShards(ctx, &sqlplugin.ShardsFilter{ShardID: int64(historyShardID)})
+	s.NoError(err)
+	s.Equal(int64(4), shard.RangeID)
+
+	// the delta copy replaces the shard row updated by closing the history shard and copies the buffered events
+	params.DeltaCopy = true
+	value, err = s.activityEnv.ExecuteActivity(copyShardActivityName, params)
+	s.NoError(err)
+	s.NoError(value.Get(&copied))
+	s.Equal(int64(2), copied.Rows)
+
+	value, err = s.activityEnv.ExecuteActivity(verifyShardActivityName, params)
+	s.NoError(err)
+	var verified CopyShardResult
+	s.NoError(value.Get(&verified))
+	s.Equal(int64(2), verified.Rows)
+
+	// the source rows must not be deleted before the move is completed
+	_, err = s.activityEnv.ExecuteActivity(deleteSourceDataActivityName, params)
+	s.Error(err)
+
+	value, err = s.activityEnv.ExecuteActivity(completeMoveActivityName, params)
+	s.NoError(err)
+	var version int64
+	s.NoError(value.Get(&version))
+	s.Equal(int64(2), version)
+	s.Equal(2, s.db.GetDBShardIDFromHistoryShardID(historyShardID))
+	// completing the move again is a no-op
+	_, err = s.activityEnv.ExecuteActivity(completeMoveActivityName, params)
+	s.NoError(err)
+
+	s.mockResource.HistoryClient.EXPECT().DescribeQueue(gomock.Any(), &types.DescribeQueueRequest{
+		ShardID:     int32(historyShardID),
+		ClusterName: testClusterName,
+		Type:        common.Int32Ptr(int32(common.TaskTypeTransfer)),
+	}).Return(&types.DescribeQueueResponse{}, nil)
+	_, err = s.activityEnv.ExecuteActivity(reopenShardActivityName, params)
+	s.NoError(err)
+
+	_, err = s.activityEnv.ExecuteActivity(deleteSourceDataActivityName, params)
+	s.NoError(err)
+	count, err := s.db.CountHistoryShardRows(ctx, &sqlplugin.HistoryShardRowsFilter{Table: "buffered_events", HistoryShardID: historyShardID, DBShardID: 0})
+	s.NoError(err)
+	s.Zero(count)
+	shard, err = s.db.SelectFromShards(ctx, &sqlplugin.ShardsFilter{ShardID: int64(historyShardID)})
+	s.NoError(err)
+	s.Equal(int64(4), shard.RangeID)
+}
+
+func (s *reshardWorkflowTestSuite) TestStartMoveActivity_Skipped() {
+	s.setupDB()
+	value, err := s.activityEnv.ExecuteActivity(prepareMoveActivityName, &ShardActivityParams{HistoryShardID: 3, TargetDBShardID: 1})
+	s.NoError(err)
+	var prepared StartMoveResult
+	s.NoError(value.Get(&prepared))
+	s.True(prepared.Skipped)
+	value, err = s.activityEnv.ExecuteActivity(startMoveActivityName, &ShardActivityParams{HistoryShardID: 3, TargetDBShardID: 1})
+	s.NoError(err)
+	var started StartMoveResult
+	s.NoError(value.Get(&started))
+	s.True(started.Skipped)
+	s.Zero(s.db.GetDBShardMap().Version)
+}
+
+func (s *reshardWorkflowTestSuite) TestStartMoveActivity_InvalidTarget() {
+	s.setupDB()
+	_, err := s.activityEnv.ExecuteActivity(startMoveActivityName, &ShardActivityParams{HistoryShardID: 3, TargetDBShardID: 3})
+	s.Error(err)
+	s.Contains(err.Error(), errMsgInvalidTargetDBShard)
+}
+
+func (s *reshardWorkflowTestSuite) TestAbortMoveActivity() {
+	s.setupDB()
+	params := &ShardActivityParams{HistoryShardID: 3, TargetDBShardID: 2}
+	_, err := s.activityEnv.ExecuteActivity(startMoveActivityName, params)
+	s.NoError(err)
+	// the history shard is already being moved to DB shard 2
+	_, err = s.activityEnv.ExecuteActivity(startMoveActivityName, &ShardActivityParams{HistoryShardID: 3, TargetDBShardID: 0})
+	s.Error(err)
+	_, err = s.activityEnv.ExecuteActivity(prepareMoveActivityName, &ShardActivityParams{HistoryShardID: 3, TargetDBShardID: 0})
+	s.Error(err)
+
+	_, err = s.activityEnv.ExecuteActivity(abortMoveActivityName, params)
+	s.NoError(err)
+	_, moving := s.db.GetDBShardMap().GetMove(3)
+	s.False(moving)
+	s.Equal(1, s.db.GetDBShardIDFromHistoryShardID(3))
+	params.DeltaCopy = true
+	_, err = s.activityEnv.ExecuteActivity(copyShardActivityName, params)
+	s.Error(err)
+	s.Contains(err.Error(), errMsgShardNotMoving)
+}
+
+func (s *reshardWorkflowTestSuite) TestActivities_NotConfigured() {
+	_, err := s.activityEnv.ExecuteActivity(startMoveActivityName, &ShardActivityParams{})
+	s.Error(err)
+	s.Contains(err.Error(), errMsgResharderNotConfigured)
+}
+
+// setupDB creates a sharded sqlite database with two DB shards and a third database only used by the DB shard map
+func (s *reshardWorkflowTestSuite) setupDB() {
+	schema, err := fs.ReadFile(sqliteschema.SchemaFS, "cadence/schema.sql")
+	s.Require().NoError(err)
+	var databases []config.MultipleDatabasesConfigEntry
+	for i := 0; i < 3; i++ {
+		name := "reshard_test_" + uuid.New()
+		adminDB, err := sql.NewSQLAdminDB(s.newSQLConfig(name))
+		s.Require().NoError(err)
+		s.Require().NoError(adminDB.ExecSchemaOperationQuery(context.Background(), string(schema)))
+		s.databases = append(s.databases, name)
+		databases = append(databases, config.MultipleDatabasesConfigEntry{DatabaseName: name})
+	}
+
+	cfg := s.newSQLConfig("")
+	cfg.NumShards = 2
+	cfg.UseMultipleDatabases = true
+	cfg.MultipleDatabasesConfig = databases
+	s.db, err = sql.NewSQLDB(cfg)
+	s.Require().NoError(err)
+
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), resharderContextKey, &Resharder{
+			clientBean:  s.mockResource.ClientBean,
+			clusterName: testClusterName,
+			db:          s.db,
+			logger:      log.NewNoop(),
+		}),
+	})
+}
+
+func (s *reshardWorkflowTestSuite) newSQLConfig(databaseName string) *config.SQL {
+	return &config.SQL{
+		PluginName:        sqlite.PluginName,
+		DatabaseName:      databaseName,
+		ConnectAttributes: map[string]string{"mode": "memory"},
+	}
+}
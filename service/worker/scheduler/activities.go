@@ -0,0 +1,121 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/cadence"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	_nonRetriableReason = "non-retriable-error"
+
+	identity = "cadence-scheduler"
+)
+
+// StartWorkflowActivity starts the workflow of a schedule for a scheduled time
+func StartWorkflowActivity(ctx context.Context, params StartWorkflowActivityParams) (*RunRecord, error) {
+	workflowID := getScheduledWorkflowID(params.Action.WorkflowIDPrefix, params.ScheduledTime)
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              params.Domain,
+		WorkflowID:                          workflowID,
+		WorkflowType:                        &types.WorkflowType{Name: params.Action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: params.Action.TaskList},
+		Input:                               params.Action.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(params.Action.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(params.Action.TaskStartToCloseTimeoutSeconds),
+		Identity:                            identity,
+		// the request ID is derived from the scheduled workflow so that retries of this activity are deduplicated
+		RequestID: uuid.NewSHA1(uuid.NameSpaceURL, []byte(params.Domain+"/"+params.ScheduleID+"/"+workflowID)).String(),
+	}
+
+	run := &RunRecord{
+		ScheduledTime: params.ScheduledTime,
+		StartedTime:   time.Now(),
+		WorkflowID:    workflowID,
+	}
+	resp, err := getFrontendClient(ctx).StartWorkflowExecution(ctx, request)
+	switch err := err.(type) {
+	case nil:
+		run.RunID = resp.GetRunID()
+		return run, nil
+	case *types.WorkflowExecutionAlreadyStartedError:
+		run.RunID = err.RunID
+		return run, nil
+	case *types.BadRequestError, *types.EntityNotExistsError:
+		return nil, cadence.NewCustomError(_nonRetriableReason, err.Error())
+	default:
+		return nil, err
+	}
+}
+
+// IsWorkflowRunningActivity returns whether a workflow started by a schedule is still running
+func IsWorkflowRunningActivity(ctx context.Context, params WorkflowActivityParams) (bool, error) {
+	resp, err := getFrontendClient(ctx).DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: params.Domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: params.WorkflowID,
+			RunID:      params.RunID,
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	return resp.GetWorkflowExecutionInfo().CloseStatus == nil, nil
+}
+
+// CancelWorkflowActivity requests cancellation of a workflow started by a schedule
+func CancelWorkflowActivity(ctx context.Context, params WorkflowActivityParams) error {
+	err := getFrontendClient(ctx).RequestCancelWorkflowExecution(ctx, &types.RequestCancelWorkflowExecutionRequest{
+		Domain: params.Domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: params.WorkflowID,
+			RunID:      params.RunID,
+		},
+		Identity:  identity,
+		RequestID: uuid.NewSHA1(uuid.NameSpaceURL, []byte(params.Domain+"/"+params.WorkflowID+"/"+params.RunID)).String(),
+	})
+	switch err.(type) {
+	case nil, *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError:
+		return nil
+	default:
+		return err
+	}
+}
+
+func getScheduledWorkflowID(prefix string, scheduledTime time.Time) string {
+	return prefix + "-" + scheduledTime.UTC().Format(time.RFC3339)
+}
+
+func getFrontendClient(ctx context.Context) frontend.Client {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	return scheduler.clientBean.GetFrontendClient()
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+const (
+	errMsgCronScheduleIsEmpty   = "cron schedule is empty"
+	errMsgCronScheduleIsInvalid = "cron schedule is invalid"
+	errMsgEndTimeBeforeStart    = "end time is before start time"
+	errMsgJitterIsNegative      = "jitter is negative"
+)
+
+// ScheduleSpec describes when the workflows of a schedule are started
+type ScheduleSpec struct {
+	// CronSchedule is a standard cron expression, it is evaluated in UTC
+	CronSchedule string
+	// StartTime is the earliest time a workflow can be scheduled at, optional
+	StartTime time.Time
+	// EndTime is the latest time a workflow can be scheduled at, optional
+	EndTime time.Time
+	// Jitter is the upper bound of the random delay added to every scheduled time, optional
+	Jitter time.Duration
+}
+
+func (s *ScheduleSpec) validate() error {
+	if s.CronSchedule == "" {
+		return errors.New(errMsgCronScheduleIsEmpty)
+	}
+	if _, err := cron.ParseStandard(s.CronSchedule); err != nil {
+		return errors.New(errMsgCronScheduleIsInvalid)
+	}
+	if !s.StartTime.IsZero() && !s.EndTime.IsZero() && s.EndTime.Before(s.StartTime) {
+		return errors.New(errMsgEndTimeBeforeStart)
+	}
+	if s.Jitter < 0 {
+		return errors.New(errMsgJitterIsNegative)
+	}
+	return nil
+}
+
+// next returns the first scheduled time after the given time,
+// or zero time if the schedule has no more scheduled time
+func (s *ScheduleSpec) next(after time.Time) time.Time {
+	schedule, err := cron.ParseStandard(s.CronSchedule)
+	if err != nil {
+		return time.Time{}
+	}
+	if !s.StartTime.IsZero() && after.Before(s.StartTime) {
+		// cron schedule has a resolution of one second, so that StartTime itself can be scheduled
+		after = s.StartTime.Add(-time.Second)
+	}
+	next := schedule.Next(after.UTC())
+	if next.IsZero() || (!s.EndTime.IsZero() && next.After(s.EndTime)) {
+		return time.Time{}
+	}
+	return next
+}
+
+// between returns up to limit scheduled times in the range (start, end]
+func (s *ScheduleSpec) between(start time.Time, end time.Time, limit int) []time.Time {
+	var result []time.Time
+	for t := s.next(start); !t.IsZero() && !t.After(end) && len(result) < limit; t = s.next(t) {
+		result = append(result, t)
+	}
+	return result
+}
+
+// count returns the number of scheduled times in the range (start, end]. Schedules with
+// a constant delay are counted without iterating over every scheduled time
+func (s *ScheduleSpec) count(start time.Time, end time.Time) int64 {
+	first := s.next(start)
+	if first.IsZero() || first.After(end) {
+		return 0
+	}
+	if !s.EndTime.IsZero() && s.EndTime.Before(end) {
+		end = s.EndTime
+	}
+	schedule, err := cron.ParseStandard(s.CronSchedule)
+	if err != nil {
+		return 0
+	}
+	if constantDelay, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		// every scheduled time after the first one is a whole number of delays later
+		return int64(end.Sub(first)/constantDelay.Delay) + 1
+	}
+	var count int64
+	for t := first; !t.IsZero() && !t.After(end); t = s.next(t) {
+		count++
+	}
+	return count
+}
+
+// jitter returns the delay added to a scheduled time. The delay is derived from the
+// schedule ID and the scheduled time instead of a random source, so that replaying
+// the schedule workflow always computes the same value
+func (s *ScheduleSpec) jitter(scheduleID string, scheduledTime time.Time) time.Duration {
+	if s.Jitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write([]byte(scheduleID))
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(scheduledTime.UnixNano()))
+	h.Write(b[:])
+	return time.Duration(h.Sum64() % uint64(s.Jitter))
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testTime = time.Date(2021, 1, 1, 0, 0, 30, 0, time.UTC)
+
+func TestScheduleSpec_Validate(t *testing.T) {
+	spec := &ScheduleSpec{}
+	assert.Error(t, spec.validate())
+	spec.CronSchedule = "invalid"
+	assert.Error(t, spec.validate())
+	spec.CronSchedule = "*/5 * * * *"
+	assert.NoError(t, spec.validate())
+	spec.StartTime = testTime
+	spec.EndTime = testTime.Add(-time.Hour)
+	assert.Error(t, spec.validate())
+	spec.EndTime = testTime.Add(time.Hour)
+	assert.NoError(t, spec.validate())
+	spec.Jitter = -time.Second
+	assert.Error(t, spec.validate())
+}
+
+func TestScheduleSpec_Next(t *testing.T) {
+	spec := &ScheduleSpec{CronSchedule: "*/5 * * * *"}
+	assert.Equal(t, time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC), spec.next(testTime))
+
+	spec.StartTime = time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC)
+	assert.Equal(t, spec.StartTime, spec.next(testTime))
+
+	spec.EndTime = time.Date(2021, 1, 1, 1, 7, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2021, 1, 1, 1, 5, 0, 0, time.UTC), spec.next(spec.StartTime))
+	assert.True(t, spec.next(time.Date(2021, 1, 1, 1, 5, 0, 0, time.UTC)).IsZero())
+}
+
+func TestScheduleSpec_Between(t *testing.T) {
+	spec := &ScheduleSpec{CronSchedule: "0 * * * *"}
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	times := spec.between(start, end, MaxBackfillRuns)
+	assert.Equal(t, []time.Time{
+		time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 2, 0, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 3, 0, 0, 0, time.UTC),
+	}, times)
+	assert.Len(t, spec.between(start, end, 2), 2)
+	assert.Empty(t, spec.between(end, start, MaxBackfillRuns))
+}
+
+func TestScheduleSpec_Count(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	spec := &ScheduleSpec{CronSchedule: "30 * * * *"}
+	assert.Equal(t, int64(24), spec.count(start, end))
+	assert.Equal(t, int64(0), spec.count(end, start))
+	spec.EndTime = time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, int64(12), spec.count(start, end))
+
+	spec = &ScheduleSpec{CronSchedule: "@every 90s"}
+	assert.Equal(t, int64(960), spec.count(start, end))
+	assert.Equal(t, int64(len(spec.between(start, end, 1000))), spec.count(start, end))
+	spec.StartTime = time.Date(2021, 1, 1, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, int64(len(spec.between(start, end, 1000))), spec.count(start, end))
+}
+
+func TestScheduleSpec_Jitter(t *testing.T) {
+	spec := &ScheduleSpec{CronSchedule: "* * * * *"}
+	assert.Zero(t, spec.jitter("schedule", testTime))
+
+	spec.Jitter = time.Minute
+	jitter := spec.jitter("schedule", testTime)
+	assert.True(t, jitter >= 0 && jitter < time.Minute)
+	assert.Equal(t, jitter, spec.jitter("schedule", testTime))
+	assert.NotEqual(t, jitter, spec.jitter("another-schedule", testTime))
+}
@@ -0,0 +1,649 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	schedulerContextKey contextKey = "schedulerContext"
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName is the workflow type of schedule workflows
+	WorkflowTypeName              = "cadence-sys-schedule-workflow"
+	startWorkflowActivityName     = "cadence-sys-schedule-startWorkflow-activity"
+	isWorkflowRunningActivityName = "cadence-sys-schedule-isWorkflowRunning-activity"
+	cancelWorkflowActivityName    = "cadence-sys-schedule-cancelWorkflow-activity"
+	// WorkflowIDPrefix is the prefix of the workflow ID of schedule workflows
+	WorkflowIDPrefix = "cadence-sys-schedule:"
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+
+	// QueryTypeDescribe is the query type to describe a schedule
+	QueryTypeDescribe = "describe"
+	// UpdateSignal is the signal name for updating a schedule
+	UpdateSignal = "update"
+	// PauseSignal is the signal name for pausing a schedule
+	PauseSignal = "pause"
+	// UnpauseSignal is the signal name for unpausing a schedule
+	UnpauseSignal = "unpause"
+	// BackfillSignal is the signal name for back-filling a schedule
+	BackfillSignal = "backfill"
+	// DeleteSignal is the signal name for deleting a schedule
+	DeleteSignal = "delete"
+
+	// DefaultCatchUpWindow is the default value for SchedulePolicies.CatchUpWindow
+	DefaultCatchUpWindow = time.Minute * 10
+	// MaxBackfillRuns is the max number of scheduled times a single backfill request can add
+	MaxBackfillRuns = 1000
+	// DefaultMaxBufferedRuns is the default value for SchedulePolicies.MaxBufferedRuns
+	DefaultMaxBufferedRuns = 1000
+
+	// runningWorkflowCheckInterval is the interval to check whether the last started workflow
+	// is closed, while a buffered run is waiting for it
+	runningWorkflowCheckInterval = time.Minute
+	// maxIterationsPerRun is the number of iterations before the schedule workflow continues as new
+	maxIterationsPerRun = 500
+	// numNextRunTimes is the number of upcoming scheduled times returned in the schedule description
+	numNextRunTimes = 5
+
+	errMsgDomainIsEmpty           = "domain is empty"
+	errMsgScheduleIDIsEmpty       = "schedule ID is empty"
+	errMsgWorkflowTypeIsEmpty     = "workflow type is empty"
+	errMsgTaskListIsEmpty         = "tasklist is empty"
+	errMsgInvalidOverlapPolicy    = "invalid overlap policy"
+	errMsgCatchUpWindowIsNegative = "catch up window is negative"
+	errMsgMaxBufferedRunsNegative = "max buffered runs is negative"
+)
+
+const (
+	// OverlapPolicySkip doesn't start a workflow if the previous one is still running
+	OverlapPolicySkip = "skip"
+	// OverlapPolicyBufferOne starts a workflow after the previous one is closed,
+	// at most one workflow is buffered
+	OverlapPolicyBufferOne = "buffer-one"
+	// OverlapPolicyCancelOther requests cancellation of the previous workflow and starts a new one
+	OverlapPolicyCancelOther = "cancel-other"
+	// OverlapPolicyAllowAll always starts a workflow, regardless of the previous one
+	OverlapPolicyAllowAll = "allow-all"
+)
+
+// AllOverlapPolicies is the overlap policies we supported
+var AllOverlapPolicies = []string{OverlapPolicySkip, OverlapPolicyBufferOne, OverlapPolicyCancelOther, OverlapPolicyAllowAll}
+
+type (
+	// StartWorkflowAction describes the workflow started by a schedule
+	StartWorkflowAction struct {
+		WorkflowType string
+		TaskList     string
+		Input        []byte
+		// WorkflowIDPrefix is the prefix of the workflow ID of the started workflows,
+		// it is followed by the scheduled time. Default to the schedule ID
+		WorkflowIDPrefix                    string
+		ExecutionStartToCloseTimeoutSeconds int32
+		TaskStartToCloseTimeoutSeconds      int32
+	}
+
+	// SchedulePolicies defines how a schedule deals with overlapping and missed runs
+	SchedulePolicies struct {
+		// OverlapPolicy is applied when a workflow is scheduled while the previous one is still running.
+		// Default to OverlapPolicySkip
+		OverlapPolicy string
+		// CatchUpWindow is how late a scheduled time can be started, e.g. after the scheduler
+		// was unavailable. Scheduled times missed by more than this window are skipped.
+		// Default to DefaultCatchUpWindow
+		CatchUpWindow time.Duration
+		// MaxBufferedRuns is the max number of scheduled times waiting to be started. Scheduled
+		// times arriving while the buffer is full are dropped and counted in ScheduleState.DroppedRuns.
+		// Default to DefaultMaxBufferedRuns
+		MaxBufferedRuns int
+	}
+
+	// RunRecord is a workflow started by a schedule
+	RunRecord struct {
+		ScheduledTime time.Time
+		StartedTime   time.Time
+		WorkflowID    string
+		RunID         string
+	}
+
+	// BufferedRun is a scheduled time waiting to be started
+	BufferedRun struct {
+		ScheduledTime time.Time
+		OverlapPolicy string
+	}
+
+	// ScheduleState is the mutable state of a schedule
+	ScheduleState struct {
+		Paused bool
+		// Notes is the reason of the last pause or unpause
+		Notes string
+		// LastProcessedTime is the latest scheduled time which has been started or skipped
+		LastProcessedTime time.Time
+		// LastRun is the latest workflow started by the schedule
+		LastRun *RunRecord
+		// BufferedRuns are started in order, once their overlap policy allows it
+		BufferedRuns []BufferedRun
+		TotalRuns    int64
+		SkippedRuns  int64
+		// DroppedRuns is the number of scheduled times dropped because the buffer was full
+		DroppedRuns int64
+	}
+
+	// ScheduleParams is the input of schedule workflow, it is carried over when the workflow continues as new
+	ScheduleParams struct {
+		Domain     string
+		ScheduleID string
+		Spec       ScheduleSpec
+		Action     StartWorkflowAction
+		Policies   SchedulePolicies
+		State      ScheduleState
+	}
+
+	// UpdateRequest is the payload of UpdateSignal, nil fields are left unchanged
+	UpdateRequest struct {
+		Spec     *ScheduleSpec
+		Action   *StartWorkflowAction
+		Policies *SchedulePolicies
+	}
+
+	// PauseRequest is the payload of PauseSignal and UnpauseSignal
+	PauseRequest struct {
+		Reason string
+	}
+
+	// BackfillRequest is the payload of BackfillSignal. All the scheduled times
+	// in the range (StartTime, EndTime] are started with the given overlap policy
+	BackfillRequest struct {
+		StartTime time.Time
+		EndTime   time.Time
+		// OverlapPolicy default to the overlap policy of the schedule
+		OverlapPolicy string
+	}
+
+	// ScheduleDescription is the result of QueryTypeDescribe
+	ScheduleDescription struct {
+		Domain       string
+		ScheduleID   string
+		Spec         ScheduleSpec
+		Action       StartWorkflowAction
+		Policies     SchedulePolicies
+		State        ScheduleState
+		NextRunTimes []time.Time
+	}
+
+	// StartWorkflowActivityParams is the parameters of StartWorkflowActivity
+	StartWorkflowActivityParams struct {
+		Domain        string
+		ScheduleID    string
+		Action        StartWorkflowAction
+		ScheduledTime time.Time
+	}
+
+	// WorkflowActivityParams is the parameters of activities operating on a started workflow
+	WorkflowActivityParams struct {
+		Domain     string
+		WorkflowID string
+		RunID      string
+	}
+
+	scheduleWorkflow struct {
+		ctx     workflow.Context
+		params  *ScheduleParams
+		logger  *zap.Logger
+		deleted bool
+	}
+)
+
+// GetWorkflowID returns the workflow ID of the schedule workflow for a schedule
+func GetWorkflowID(domain string, scheduleID string) string {
+	return WorkflowIDPrefix + domain + ":" + scheduleID
+}
+
+// ParseWorkflowID returns the domain and schedule ID of a schedule workflow ID
+func ParseWorkflowID(workflowID string) (domain string, scheduleID string, ok bool) {
+	if !strings.HasPrefix(workflowID, WorkflowIDPrefix) {
+		return "", "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(workflowID, WorkflowIDPrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+var (
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          time.Second,
+		BackoffCoefficient:       2,
+		MaximumInterval:          time.Minute,
+		ExpirationInterval:       time.Minute * 10,
+		NonRetriableErrorReasons: []string{_nonRetriableReason},
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+// ScheduleWorkflow is the workflow that runs a schedule. It starts a workflow in the
+// target domain for every scheduled time, until the schedule is deleted
+func ScheduleWorkflow(ctx workflow.Context, params ScheduleParams) error {
+	params = setDefaultParams(params)
+	if err := validateParams(params); err != nil {
+		return err
+	}
+	if params.State.LastProcessedTime.IsZero() {
+		params.State.LastProcessedTime = workflow.Now(ctx)
+	}
+
+	s := &scheduleWorkflow{
+		ctx:    workflow.WithActivityOptions(ctx, activityOptions),
+		params: &params,
+		logger: workflow.GetLogger(ctx).With(zap.String("schedule-id", params.ScheduleID)),
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeDescribe, s.describe); err != nil {
+		return err
+	}
+
+	for i := 0; i < maxIterationsPerRun; i++ {
+		s.processScheduledTimes()
+		s.processBufferedRuns()
+		s.waitForNextEvent()
+		if s.deleted {
+			s.logger.Info("schedule is deleted")
+			return nil
+		}
+	}
+
+	// drain the signals before continuing as new so that none of them gets lost
+	for s.handleSignals(workflow.NewSelector(ctx), false) {
+	}
+	if s.deleted {
+		return nil
+	}
+	return workflow.NewContinueAsNewError(ctx, WorkflowTypeName, *s.params)
+}
+
+// processScheduledTimes buffers the scheduled times which are due,
+// and skips the ones missed by more than the catch up window
+func (s *scheduleWorkflow) processScheduledTimes() {
+	state := &s.params.State
+	if state.Paused {
+		return
+	}
+	now := workflow.Now(s.ctx)
+	s.skipScheduledTimesOutOfCatchUpWindow(now)
+	for t := s.params.Spec.next(state.LastProcessedTime); !t.IsZero(); t = s.params.Spec.next(t) {
+		due := t.Add(s.params.Spec.jitter(s.params.ScheduleID, t))
+		if due.After(now) {
+			break
+		}
+		state.LastProcessedTime = t
+		if now.Sub(due) > s.params.Policies.CatchUpWindow {
+			s.logger.Warn("skipped scheduled time out of catch up window", zap.Time("scheduled-time", t))
+			state.SkippedRuns++
+			continue
+		}
+		s.bufferRun(t, s.params.Policies.OverlapPolicy)
+	}
+}
+
+// skipScheduledTimesOutOfCatchUpWindow moves LastProcessedTime straight to the start of
+// the catch up window, so that a schedule resumed after a long outage doesn't buffer
+// every missed scheduled time. Every scheduled time in the skipped range counts as a skipped run
+func (s *scheduleWorkflow) skipScheduledTimesOutOfCatchUpWindow(now time.Time) {
+	state := &s.params.State
+	// a scheduled time can only be due within the catch up window if it is later
+	// than the start of the window minus the max jitter
+	gap := now.Sub(state.LastProcessedTime)
+	window := s.params.Policies.CatchUpWindow
+	if gap <= window || gap-window <= s.params.Spec.Jitter {
+		return
+	}
+	cutoff := now.Add(-(window + s.params.Spec.Jitter))
+	first := s.params.Spec.next(state.LastProcessedTime)
+	if first.IsZero() || !first.Before(cutoff) {
+		return
+	}
+	// scheduled times have a resolution of one second, so that a scheduled
+	// time equal to the cutoff is still processed
+	skippedUntil := cutoff.Add(-time.Nanosecond)
+	skipped := s.params.Spec.count(state.LastProcessedTime, skippedUntil)
+	s.logger.Warn("skipped scheduled times out of catch up window",
+		zap.Time("first-scheduled-time", first),
+		zap.Time("skipped-until", cutoff),
+		zap.Int64("skipped-runs", skipped),
+	)
+	state.SkippedRuns += skipped
+	state.LastProcessedTime = skippedUntil
+}
+
+func (s *scheduleWorkflow) bufferRun(scheduledTime time.Time, overlapPolicy string) {
+	state := &s.params.State
+	if overlapPolicy == OverlapPolicyBufferOne {
+		for _, run := range state.BufferedRuns {
+			if run.OverlapPolicy == OverlapPolicyBufferOne {
+				// one run is already waiting for the running workflow
+				state.SkippedRuns++
+				return
+			}
+		}
+	}
+	if len(state.BufferedRuns) >= s.params.Policies.MaxBufferedRuns {
+		s.logger.Warn("dropped scheduled time, too many buffered runs",
+			zap.Time("scheduled-time", scheduledTime),
+			zap.Int("max-buffered-runs", s.params.Policies.MaxBufferedRuns),
+		)
+		state.DroppedRuns++
+		return
+	}
+	state.BufferedRuns = append(state.BufferedRuns, BufferedRun{
+		ScheduledTime: scheduledTime,
+		OverlapPolicy: overlapPolicy,
+	})
+}
+
+// processBufferedRuns applies the overlap policy of every buffered run in order. Runs which
+// have to wait for the last started workflow to close stay buffered, without holding back
+// the runs after them
+func (s *scheduleWorkflow) processBufferedRuns() {
+	state := &s.params.State
+	runs := state.BufferedRuns
+	state.BufferedRuns = nil
+	// the last run is checked once until another run is started
+	checked, running := false, false
+	for i, run := range runs {
+		if run.OverlapPolicy != OverlapPolicyAllowAll {
+			if !checked {
+				var err error
+				if running, err = s.isLastRunRunning(); err != nil {
+					// keep the runs buffered, they are checked again after runningWorkflowCheckInterval
+					s.logger.Warn("failed to check whether last run is running", zap.Error(err))
+					state.BufferedRuns = append(state.BufferedRuns, runs[i:]...)
+					return
+				}
+				checked = true
+			}
+			if running {
+				switch run.OverlapPolicy {
+				case OverlapPolicySkip:
+					state.SkippedRuns++
+					continue
+				case OverlapPolicyBufferOne:
+					// wait for the last run to close
+					state.BufferedRuns = append(state.BufferedRuns, run)
+					continue
+				case OverlapPolicyCancelOther:
+					s.cancelLastRun()
+				}
+			}
+		}
+		s.startRun(run.ScheduledTime)
+		checked = false
+	}
+}
+
+func (s *scheduleWorkflow) isLastRunRunning() (bool, error) {
+	lastRun := s.params.State.LastRun
+	if lastRun == nil {
+		return false, nil
+	}
+	var running bool
+	err := workflow.ExecuteActivity(s.ctx, isWorkflowRunningActivityName, WorkflowActivityParams{
+		Domain:     s.params.Domain,
+		WorkflowID: lastRun.WorkflowID,
+		RunID:      lastRun.RunID,
+	}).Get(s.ctx, &running)
+	return running, err
+}
+
+func (s *scheduleWorkflow) cancelLastRun() {
+	lastRun := s.params.State.LastRun
+	err := workflow.ExecuteActivity(s.ctx, cancelWorkflowActivityName, WorkflowActivityParams{
+		Domain:     s.params.Domain,
+		WorkflowID: lastRun.WorkflowID,
+		RunID:      lastRun.RunID,
+	}).Get(s.ctx, nil)
+	if err != nil {
+		s.logger.Warn("failed to cancel last run", zap.Error(err))
+	}
+}
+
+func (s *scheduleWorkflow) startRun(scheduledTime time.Time) {
+	var run RunRecord
+	err := workflow.ExecuteActivity(s.ctx, startWorkflowActivityName, StartWorkflowActivityParams{
+		Domain:        s.params.Domain,
+		ScheduleID:    s.params.ScheduleID,
+		Action:        s.params.Action,
+		ScheduledTime: scheduledTime,
+	}).Get(s.ctx, &run)
+	if err != nil {
+		s.logger.Error("failed to start scheduled workflow", zap.Time("scheduled-time", scheduledTime), zap.Error(err))
+		s.params.State.SkippedRuns++
+		return
+	}
+	s.params.State.LastRun = &run
+	s.params.State.TotalRuns++
+}
+
+// waitForNextEvent blocks until the next scheduled time is due, a buffered run
+// needs to be checked again or a signal is received
+func (s *scheduleWorkflow) waitForNextEvent() {
+	timerCtx, cancelTimer := workflow.WithCancel(s.ctx)
+	defer cancelTimer()
+
+	selector := workflow.NewSelector(s.ctx)
+	now := workflow.Now(s.ctx)
+	var wakeup time.Time
+	if !s.params.State.Paused {
+		if t := s.params.Spec.next(s.params.State.LastProcessedTime); !t.IsZero() {
+			wakeup = t.Add(s.params.Spec.jitter(s.params.ScheduleID, t))
+		}
+	}
+	if len(s.params.State.BufferedRuns) > 0 {
+		if check := now.Add(runningWorkflowCheckInterval); wakeup.IsZero() || check.Before(wakeup) {
+			wakeup = check
+		}
+	}
+	if !wakeup.IsZero() {
+		selector.AddFuture(workflow.NewTimer(timerCtx, wakeup.Sub(now)), func(workflow.Future) {})
+	}
+	s.handleSignals(selector, true)
+}
+
+// handleSignals waits on the selector for one event and applies the signal if
+// a signal is received. Returns whether a signal was handled
+func (s *scheduleWorkflow) handleSignals(selector workflow.Selector, block bool) bool {
+	handled := false
+	state := &s.params.State
+	selector.AddReceive(workflow.GetSignalChannel(s.ctx, UpdateSignal), func(c workflow.Channel, more bool) {
+		var request UpdateRequest
+		c.Receive(s.ctx, &request)
+		handled = true
+		s.update(request)
+	})
+	selector.AddReceive(workflow.GetSignalChannel(s.ctx, PauseSignal), func(c workflow.Channel, more bool) {
+		var request PauseRequest
+		c.Receive(s.ctx, &request)
+		handled = true
+		state.Paused = true
+		state.Notes = request.Reason
+	})
+	selector.AddReceive(workflow.GetSignalChannel(s.ctx, UnpauseSignal), func(c workflow.Channel, more bool) {
+		var request PauseRequest
+		c.Receive(s.ctx, &request)
+		handled = true
+		if state.Paused {
+			// scheduled times missed while paused are not started
+			state.LastProcessedTime = workflow.Now(s.ctx)
+		}
+		state.Paused = false
+		state.Notes = request.Reason
+	})
+	selector.AddReceive(workflow.GetSignalChannel(s.ctx, BackfillSignal), func(c workflow.Channel, more bool) {
+		var request BackfillRequest
+		c.Receive(s.ctx, &request)
+		handled = true
+		s.backfill(request)
+	})
+	selector.AddReceive(workflow.GetSignalChannel(s.ctx, DeleteSignal), func(c workflow.Channel, more bool) {
+		c.Receive(s.ctx, nil)
+		handled = true
+		s.deleted = true
+	})
+	if !block {
+		selector.AddDefault(func() {})
+	}
+	selector.Select(s.ctx)
+	return handled
+}
+
+func (s *scheduleWorkflow) update(request UpdateRequest) {
+	updated := *s.params
+	if request.Spec != nil {
+		updated.Spec = *request.Spec
+	}
+	if request.Action != nil {
+		updated.Action = *request.Action
+	}
+	if request.Policies != nil {
+		updated.Policies = *request.Policies
+	}
+	updated = setDefaultParams(updated)
+	if err := validateParams(updated); err != nil {
+		s.logger.Warn("ignored invalid schedule update", zap.Error(err))
+		return
+	}
+	*s.params = updated
+}
+
+func (s *scheduleWorkflow) backfill(request BackfillRequest) {
+	overlapPolicy := request.OverlapPolicy
+	if overlapPolicy == "" {
+		overlapPolicy = s.params.Policies.OverlapPolicy
+	}
+	if !isValidOverlapPolicy(overlapPolicy) {
+		s.logger.Warn("ignored backfill with invalid overlap policy", zap.String("overlap-policy", overlapPolicy))
+		return
+	}
+	for _, t := range s.params.Spec.between(request.StartTime, request.EndTime, MaxBackfillRuns) {
+		s.bufferRun(t, overlapPolicy)
+	}
+}
+
+func (s *scheduleWorkflow) describe() (*ScheduleDescription, error) {
+	from := workflow.Now(s.ctx)
+	if s.params.State.LastProcessedTime.After(from) {
+		from = s.params.State.LastProcessedTime
+	}
+	var nextRunTimes []time.Time
+	if !s.params.State.Paused {
+		for t := s.params.Spec.next(from); !t.IsZero() && len(nextRunTimes) < numNextRunTimes; t = s.params.Spec.next(t) {
+			nextRunTimes = append(nextRunTimes, t.Add(s.params.Spec.jitter(s.params.ScheduleID, t)))
+		}
+	}
+	return &ScheduleDescription{
+		Domain:       s.params.Domain,
+		ScheduleID:   s.params.ScheduleID,
+		Spec:         s.params.Spec,
+		Action:       s.params.Action,
+		Policies:     s.params.Policies,
+		State:        s.params.State,
+		NextRunTimes: nextRunTimes,
+	}, nil
+}
+
+func setDefaultParams(params ScheduleParams) ScheduleParams {
+	if params.Policies.OverlapPolicy == "" {
+		params.Policies.OverlapPolicy = OverlapPolicySkip
+	}
+	if params.Policies.CatchUpWindow == 0 {
+		params.Policies.CatchUpWindow = DefaultCatchUpWindow
+	}
+	if params.Policies.MaxBufferedRuns == 0 {
+		params.Policies.MaxBufferedRuns = DefaultMaxBufferedRuns
+	}
+	if params.Action.WorkflowIDPrefix == "" {
+		params.Action.WorkflowIDPrefix = params.ScheduleID
+	}
+	if params.Action.ExecutionStartToCloseTimeoutSeconds <= 0 {
+		params.Action.ExecutionStartToCloseTimeoutSeconds = int32(InfiniteDuration.Seconds())
+	}
+	if params.Action.TaskStartToCloseTimeoutSeconds <= 0 {
+		params.Action.TaskStartToCloseTimeoutSeconds = 10
+	}
+	return params
+}
+
+// ValidateParams returns an error if the schedule is rejected by ScheduleWorkflow,
+// the optional fields which are not set are filled with their default values first
+func ValidateParams(params ScheduleParams) error {
+	return validateParams(setDefaultParams(params))
+}
+
+func validateParams(params ScheduleParams) error {
+	if params.Domain == "" {
+		return errors.New(errMsgDomainIsEmpty)
+	}
+	if params.ScheduleID == "" {
+		return errors.New(errMsgScheduleIDIsEmpty)
+	}
+	if params.Action.WorkflowType == "" {
+		return errors.New(errMsgWorkflowTypeIsEmpty)
+	}
+	if params.Action.TaskList == "" {
+		return errors.New(errMsgTaskListIsEmpty)
+	}
+	if !isValidOverlapPolicy(params.Policies.OverlapPolicy) {
+		return fmt.Errorf("%v: %v", errMsgInvalidOverlapPolicy, params.Policies.OverlapPolicy)
+	}
+	if params.Policies.CatchUpWindow < 0 {
+		return errors.New(errMsgCatchUpWindowIsNegative)
+	}
+	if params.Policies.MaxBufferedRuns < 0 {
+		return errors.New(errMsgMaxBufferedRunsNegative)
+	}
+	return params.Spec.validate()
+}
+
+func isValidOverlapPolicy(overlapPolicy string) bool {
+	for _, p := range AllOverlapPolicies {
+		if p == overlapPolicy {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,363 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type scheduleWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestScheduleWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(scheduleWorkflowTestSuite))
+}
+
+func (s *scheduleWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.SetStartTime(testTime)
+	s.workflowEnv.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(IsWorkflowRunningActivity, activity.RegisterOptions{Name: isWorkflowRunningActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+}
+
+func (s *scheduleWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestValidateParams() {
+	params := ScheduleParams{}
+	s.Error(validateParams(setDefaultParams(params)))
+	params.Domain = "domain"
+	s.Error(validateParams(setDefaultParams(params)))
+	params.ScheduleID = "schedule"
+	s.Error(validateParams(setDefaultParams(params)))
+	params.Action.WorkflowType = "workflow"
+	s.Error(validateParams(setDefaultParams(params)))
+	params.Action.TaskList = "tasklist"
+	s.Error(validateParams(setDefaultParams(params)))
+	params.Spec.CronSchedule = "* * * * *"
+	s.NoError(validateParams(setDefaultParams(params)))
+	params.Policies.OverlapPolicy = "invalid"
+	s.Error(validateParams(setDefaultParams(params)))
+	params.Policies.OverlapPolicy = OverlapPolicySkip
+	params.Policies.CatchUpWindow = -time.Second
+	s.Error(ValidateParams(params))
+	params.Policies.CatchUpWindow = 0
+	params.Policies.MaxBufferedRuns = -1
+	s.Error(ValidateParams(params))
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflowIDRoundTrip() {
+	domain, scheduleID, ok := ParseWorkflowID(GetWorkflowID("domain", "schedule:id"))
+	s.True(ok)
+	s.Equal("domain", domain)
+	s.Equal("schedule:id", scheduleID)
+
+	_, _, ok = ParseWorkflowID("random-workflow-id")
+	s.False(ok)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, ScheduleParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_StartOnSchedule() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(false, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		description := s.describe()
+		s.Equal(int64(2), description.State.TotalRuns)
+		s.Equal("schedule-2021-01-01T00:02:00Z", description.State.LastRun.WorkflowID)
+		s.Len(description.NextRunTimes, numNextRunTimes)
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Minute*2)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams("* * * * *", OverlapPolicySkip))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Equal([]time.Time{
+		time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+	}, scheduledTimes)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_SkipOverlap() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(true, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		description := s.describe()
+		s.Equal(int64(1), description.State.TotalRuns)
+		s.Equal(int64(1), description.State.SkippedRuns)
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Minute*2)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams("* * * * *", OverlapPolicySkip))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(scheduledTimes, 1)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_RetryRunningCheck() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).
+		Return(false, cadence.NewCustomError(_nonRetriableReason)).Once()
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(false, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		// the run of 00:02 stays buffered until the running check succeeds
+		description := s.describe()
+		s.Equal(int64(1), description.State.TotalRuns)
+		s.Equal(int64(0), description.State.SkippedRuns)
+		s.Len(description.State.BufferedRuns, 1)
+	}, time.Minute*2+time.Second*30)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		description := s.describe()
+		s.Equal(int64(0), description.State.SkippedRuns)
+		s.Empty(description.State.BufferedRuns)
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Minute*3+time.Second*30)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams("* * * * *", OverlapPolicySkip))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Equal([]time.Time{
+		time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 0, 3, 0, 0, time.UTC),
+	}, scheduledTimes[:3])
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_CancelOther() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(true, nil)
+	s.workflowEnv.OnActivity(cancelWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Minute*2)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams("* * * * *", OverlapPolicyCancelOther))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(scheduledTimes, 2)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_PauseAndUnpause() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(PauseSignal, PauseRequest{Reason: "maintenance"})
+	}, time.Second*10)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		description := s.describe()
+		s.True(description.State.Paused)
+		s.Equal("maintenance", description.State.Notes)
+		s.Empty(description.NextRunTimes)
+		s.workflowEnv.SignalWorkflow(UnpauseSignal, PauseRequest{})
+	}, time.Minute*3)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Minute*4)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams("* * * * *", OverlapPolicySkip))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	// scheduled times missed while paused are not started
+	s.Equal([]time.Time{time.Date(2021, 1, 1, 0, 4, 0, 0, time.UTC)}, scheduledTimes)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_SkipOutOfCatchUpWindow() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		description := s.describe()
+		// every scheduled time of the last year but the ones in the catch up window is skipped at once,
+		// from 2020-01-01 00:01 to 2020-12-31 23:58
+		s.Equal(int64(366*24*60-2), description.State.SkippedRuns)
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Second*10)
+
+	params := s.newParams("* * * * *", OverlapPolicyAllowAll)
+	params.Policies.CatchUpWindow = time.Minute * 2
+	params.State.LastProcessedTime = testTime.AddDate(-1, 0, 0)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Equal([]time.Time{
+		time.Date(2020, 12, 31, 23, 59, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, scheduledTimes)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Backfill() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(BackfillSignal, BackfillRequest{
+			StartTime:     time.Date(2020, 12, 31, 21, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			OverlapPolicy: OverlapPolicyAllowAll,
+		})
+	}, time.Second*10)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams("0 * * * *", OverlapPolicySkip))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Equal([]time.Time{
+		time.Date(2020, 12, 31, 22, 0, 0, 0, time.UTC),
+		time.Date(2020, 12, 31, 23, 0, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, scheduledTimes)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_BufferOneDoesNotBlockLaterRuns() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(true, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(BackfillSignal, BackfillRequest{
+			StartTime:     time.Date(2020, 12, 31, 23, 57, 0, 0, time.UTC),
+			EndTime:       time.Date(2020, 12, 31, 23, 59, 0, 0, time.UTC),
+			OverlapPolicy: OverlapPolicyAllowAll,
+		})
+	}, time.Minute*2+time.Second*30)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		description := s.describe()
+		// the run of 00:02 is still waiting, the run of 00:03 is skipped as one run is already waiting
+		s.Equal([]BufferedRun{{
+			ScheduledTime: time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+			OverlapPolicy: OverlapPolicyBufferOne,
+		}}, description.State.BufferedRuns)
+		s.Equal(int64(1), description.State.SkippedRuns)
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Minute*3+time.Second*30)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams("* * * * *", OverlapPolicyBufferOne))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	// the backfilled runs are started while the buffer-one run keeps waiting
+	s.Equal([]time.Time{
+		time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC),
+		time.Date(2020, 12, 31, 23, 58, 0, 0, time.UTC),
+		time.Date(2020, 12, 31, 23, 59, 0, 0, time.UTC),
+	}, scheduledTimes)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_DropRunsOverMaxBufferedRuns() {
+	var scheduledTimes []time.Time
+	s.mockStartWorkflowActivity(&scheduledTimes)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(BackfillSignal, BackfillRequest{
+			StartTime:     time.Date(2020, 12, 31, 23, 54, 0, 0, time.UTC),
+			EndTime:       time.Date(2020, 12, 31, 23, 59, 0, 0, time.UTC),
+			OverlapPolicy: OverlapPolicyAllowAll,
+		})
+	}, time.Second*10)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		description := s.describe()
+		s.Equal(int64(3), description.State.DroppedRuns)
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Second*30)
+
+	params := s.newParams("* * * * *", OverlapPolicyAllowAll)
+	params.Policies.MaxBufferedRuns = 2
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Equal([]time.Time{
+		time.Date(2020, 12, 31, 23, 55, 0, 0, time.UTC),
+		time.Date(2020, 12, 31, 23, 56, 0, 0, time.UTC),
+	}, scheduledTimes[:2])
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Update() {
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(UpdateSignal, UpdateRequest{Spec: &ScheduleSpec{CronSchedule: "0 * * * *"}})
+	}, time.Second*10)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		description := s.describe()
+		s.Equal("0 * * * *", description.Spec.CronSchedule)
+		s.Equal(time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC), description.NextRunTimes[0])
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, time.Minute*5)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams("* * * * *", OverlapPolicySkip))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *scheduleWorkflowTestSuite) newParams(cronSchedule string, overlapPolicy string) ScheduleParams {
+	return ScheduleParams{
+		Domain:     "domain",
+		ScheduleID: "schedule",
+		Spec:       ScheduleSpec{CronSchedule: cronSchedule},
+		Action: StartWorkflowAction{
+			WorkflowType: "workflow",
+			TaskList:     "tasklist",
+		},
+		Policies: SchedulePolicies{
+			OverlapPolicy: overlapPolicy,
+			CatchUpWindow: InfiniteDuration,
+		},
+	}
+}
+
+func (s *scheduleWorkflowTestSuite) mockStartWorkflowActivity(scheduledTimes *[]time.Time) {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params StartWorkflowActivityParams) (*RunRecord, error) {
+			*scheduledTimes = append(*scheduledTimes, params.ScheduledTime.UTC())
+			return &RunRecord{
+				ScheduledTime: params.ScheduledTime,
+				WorkflowID:    getScheduledWorkflowID(params.Action.WorkflowIDPrefix, params.ScheduledTime),
+				RunID:         "run",
+			}, nil
+		},
+	)
+}
+
+func (s *scheduleWorkflowTestSuite) describe() *ScheduleDescription {
+	value, err := s.workflowEnv.QueryWorkflow(QueryTypeDescribe)
+	s.NoError(err)
+	var description ScheduleDescription
+	s.NoError(value.Get(&description))
+	return &description
+}
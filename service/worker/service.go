@@ -33,22 +33,27 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/archiver"
+	"github.com/uber/cadence/service/worker/asyncworkflow"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/service/worker/esanalyzer"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 	"github.com/uber/cadence/service/worker/replicator"
+	"github.com/uber/cadence/service/worker/reshard"
 	"github.com/uber/cadence/service/worker/scanner"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scheduler"
 	"github.com/uber/cadence/service/worker/shadower"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
 	"github.com/uber/cadence/service/worker/watchdog"
 )
 
@@ -57,6 +62,10 @@ type (
 	// 1. Replicator: Handles applying replication tasks generated by remote clusters.
 	// 2. Indexer: Handles uploading of visibility records to elastic search.
 	// 3. Archiver: Handles archival of workflow histories.
+	// 4. AsyncWorkflowConsumer: Forwards queued async start requests to history.
+	// 5. Scheduler: Runs the schedule workflows which start workflows on a schedule.
+	// 6. VisibilityMigrator: Copies visibility records between the db and ElasticSearch stores and verifies them.
+	// 7. Resharder: Moves history shards between the databases of a sharded SQL store.
 	Service struct {
 		resource.Resource
 
@@ -74,6 +83,7 @@ type (
 		BatcherCfg                          *batcher.Config
 		ESAnalyzerCfg                       *esanalyzer.Config
 		WatchdogConfig                      *watchdog.Config
+		AsyncWorkflowConsumerCfg            *asyncworkflow.Config
 		failoverManagerCfg                  *failovermanager.Config
 		ThrottledLogRPS                     dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicconfig.IntPropertyFn
@@ -86,6 +96,17 @@ type (
 		DomainReplicationMaxRetryDuration   dynamicconfig.DurationPropertyFn
 		EnableESAnalyzer                    dynamicconfig.BoolPropertyFn
 		EnableWatchDog                      dynamicconfig.BoolPropertyFn
+		EnableAsyncWorkflowConsumer         dynamicconfig.BoolPropertyFn
+		EnableScheduler                     dynamicconfig.BoolPropertyFn
+		EnableVisibilityMigration           dynamicconfig.BoolPropertyFn
+		EnableDBReshard                     dynamicconfig.BoolPropertyFn
+
+		// below are the visibility configs needed by the visibility migrator
+		EnableReadVisibilityFromES      dynamicconfig.BoolPropertyFnWithDomainFilter
+		AdvancedVisibilityWritingMode   dynamicconfig.StringPropertyFn
+		EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
+		ESIndexMaxResultWindow          dynamicconfig.IntPropertyFn
+		ValidSearchAttributes           dynamicconfig.MapPropertyFn
 	}
 )
 
@@ -96,15 +117,25 @@ func NewService(
 
 	serviceConfig := NewConfig(params)
 
+	resourceConfig := &service.Config{
+		PersistenceMaxQPS:       serviceConfig.PersistenceMaxQPS,
+		PersistenceGlobalMaxQPS: serviceConfig.PersistenceGlobalMaxQPS,
+		ThrottledLoggerMaxRPS:   serviceConfig.ThrottledLogRPS,
+		// worker service doesn't need visibility config as it never call visibilityManager API,
+		// except for the visibility migrator which reads from and writes to both stores
+	}
+	if serviceConfig.EnableVisibilityMigration() {
+		resourceConfig.EnableReadVisibilityFromES = serviceConfig.EnableReadVisibilityFromES
+		resourceConfig.AdvancedVisibilityWritingMode = serviceConfig.AdvancedVisibilityWritingMode
+		resourceConfig.EnableReadDBVisibilityFromClosedExecutionV2 = serviceConfig.EnableReadFromClosedExecutionV2
+		resourceConfig.ESIndexMaxResultWindow = serviceConfig.ESIndexMaxResultWindow
+		resourceConfig.ValidSearchAttributes = serviceConfig.ValidSearchAttributes
+	}
+
 	serviceResource, err := resource.New(
 		params,
 		service.Worker,
-		&service.Config{
-			PersistenceMaxQPS:       serviceConfig.PersistenceMaxQPS,
-			PersistenceGlobalMaxQPS: serviceConfig.PersistenceGlobalMaxQPS,
-			ThrottledLoggerMaxRPS:   serviceConfig.ThrottledLogRPS,
-			// worker service doesn't need visibility config as it never call visibilityManager API
-		},
+		resourceConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -132,6 +163,8 @@ func NewConfig(params *resource.Params) *Config {
 			ArchivalsPerIteration:           dc.GetIntProperty(dynamicconfig.WorkerArchivalsPerIteration),
 			TimeLimitPerArchivalIteration:   dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration),
 			AllowArchivingIncompleteHistory: dc.GetBoolProperty(dynamicconfig.AllowArchivingIncompleteHistory),
+			BlobIntegrityCheckProbability:   dc.GetFloat64Property(dynamicconfig.WorkerBlobIntegrityCheckProbability),
+			VerificationInterval:            dc.GetDurationProperty(dynamicconfig.WorkerArchivalVerificationInterval),
 		},
 		ScannerCfg: &scanner.Config{
 			ScannerPersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS),
@@ -177,11 +210,24 @@ func NewConfig(params *resource.Params) *Config {
 		WatchdogConfig: &watchdog.Config{
 			CorruptWorkflowWatchdogPause: dc.GetBoolProperty(dynamicconfig.CorruptWorkflowWatchdogPause),
 		},
+		AsyncWorkflowConsumerCfg: &asyncworkflow.Config{
+			Concurrency:      dc.GetIntProperty(dynamicconfig.AsyncWorkflowConsumerConcurrency),
+			MaxRetryDuration: dc.GetDurationProperty(dynamicconfig.AsyncWorkflowConsumerMaxRetryDuration),
+		},
 		EnableBatcher:                       dc.GetBoolProperty(dynamicconfig.EnableBatcher),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicconfig.EnableESAnalyzer),
 		EnableWatchDog:                      dc.GetBoolProperty(dynamicconfig.EnableWatchDog),
+		EnableAsyncWorkflowConsumer:         dc.GetBoolProperty(dynamicconfig.EnableAsyncWorkflowConsumer),
+		EnableScheduler:                     dc.GetBoolProperty(dynamicconfig.EnableScheduler),
+		EnableVisibilityMigration:           dc.GetBoolProperty(dynamicconfig.EnableVisibilityMigration),
+		EnableDBReshard:                     dc.GetBoolProperty(dynamicconfig.EnableDBReshard),
+		EnableReadVisibilityFromES:          dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableReadVisibilityFromES),
+		AdvancedVisibilityWritingMode:       dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode),
+		EnableReadFromClosedExecutionV2:     dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2),
+		ESIndexMaxResultWindow:              dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow),
+		ValidSearchAttributes:               dc.GetMapProperty(dynamicconfig.ValidSearchAttributes),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicconfig.EnableFailoverManager),
 		EnableWorkflowShadower:              dc.GetBoolProperty(dynamicconfig.EnableWorkflowShadower),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS),
@@ -199,6 +245,7 @@ func NewConfig(params *resource.Params) *Config {
 			ESProcessorBulkActions:   dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkActions),
 			ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize),
 			ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval),
+			ESProcessorBulkTimeout:   dc.GetDurationProperty(dynamicconfig.WorkerESProcessorBulkTimeout),
 			ValidSearchAttributes:    dc.GetMapProperty(dynamicconfig.ValidSearchAttributes),
 		}
 	}
@@ -248,6 +295,19 @@ func (s *Service) Start() {
 		s.ensureDomainExists(common.ShadowerLocalDomainName)
 		s.startWorkflowShadower()
 	}
+	if s.config.EnableAsyncWorkflowConsumer() && s.GetAsyncWorkflowQueueClient() != nil {
+		s.startAsyncWorkflowConsumer()
+	}
+	if s.config.EnableScheduler() {
+		s.ensureDomainExists(common.SchedulerLocalDomainName)
+		s.startScheduler()
+	}
+	if s.config.EnableVisibilityMigration() && s.GetVisibilityManager() != nil {
+		s.startVisibilityMigrator()
+	}
+	if s.config.EnableDBReshard() {
+		s.startResharder()
+	}
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -334,6 +394,58 @@ func (s *Service) startBatcher() {
 	}
 }
 
+func (s *Service) startScheduler() {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := scheduler.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
+func (s *Service) startVisibilityMigrator() {
+	params := &visibilitymigration.BootstrapParams{
+		ServiceClient:     s.params.PublicClient,
+		MetricsClient:     s.GetMetricsClient(),
+		Logger:            s.GetLogger(),
+		TallyScope:        s.params.MetricScope,
+		ClientBean:        s.GetClientBean(),
+		DomainCache:       s.GetDomainCache(),
+		VisibilityManager: s.GetVisibilityManager(),
+	}
+	if err := visibilitymigration.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting visibility migrator", tag.Error(err))
+	}
+}
+
+func (s *Service) startResharder() {
+	store, ok := s.params.PersistenceConfig.DataStores[s.params.PersistenceConfig.DefaultStore]
+	if !ok || store.SQL == nil || !store.SQL.UseMultipleDatabases {
+		s.GetLogger().Warn("DB resharder is enabled but the default store is not a sharded SQL store")
+		return
+	}
+	db, err := sql.NewSQLDB(store.SQL)
+	if err != nil {
+		s.GetLogger().Fatal("error creating the database of the DB resharder", tag.Error(err))
+	}
+	params := &reshard.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+		ClusterName:   s.GetClusterMetadata().GetCurrentClusterName(),
+		DB:            db,
+	}
+	if err := reshard.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting DB resharder", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config:     *s.config.ScannerCfg,
@@ -392,6 +504,22 @@ func (s *Service) startIndexer() {
 	}
 }
 
+func (s *Service) startAsyncWorkflowConsumer() {
+	consumer := asyncworkflow.NewConsumer(
+		s.config.AsyncWorkflowConsumerCfg,
+		s.params.AsyncWorkflowQueueConfig.Queues,
+		s.GetAsyncWorkflowQueueClient(),
+		s.GetHistoryClient(),
+		s.GetDomainCache(),
+		s.GetLogger(),
+		s.GetMetricsClient(),
+	)
+	if err := consumer.Start(); err != nil {
+		consumer.Stop()
+		s.GetLogger().Fatal("fail to start async workflow consumer", tag.Error(err))
+	}
+}
+
 func (s *Service) startArchiver() {
 	bc := &archiver.BootstrapContainer{
 		PublicClient:     s.GetSDKClient(),
@@ -486,6 +614,8 @@ func getDomainID(domain string) string {
 		domainID = common.BatcherDomainID
 	case common.ShadowerLocalDomainName:
 		domainID = common.ShadowerDomainID
+	case common.SchedulerLocalDomainName:
+		domainID = common.SchedulerDomainID
 	}
 	return domainID
 }
@@ -0,0 +1,455 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	domainsPageSize = 200
+	// defaultOpenWorkflowTimeoutSeconds is used as the ttl of the copied open records
+	// when the workflow timeout is not returned by describe workflow execution
+	defaultOpenWorkflowTimeoutSeconds = int64(365 * 24 * 3600)
+)
+
+type (
+	// recordsHeartbeatDetails is the progress of the copy and verify activities
+	recordsHeartbeatDetails struct {
+		Pages         int
+		NextPageToken []byte
+		Copy          CopyRecordsResult
+		Verify        VerifyRecordsResult
+	}
+
+	// recordsActivityContext contains the resolved dependencies of the copy and verify activities
+	recordsActivityContext struct {
+		migrator    *Migrator
+		domainEntry *cache.DomainCacheEntry
+		source      persistence.VisibilityManager
+		target      persistence.VisibilityManager
+		rateLimiter quotas.Limiter
+		logger      log.Logger
+	}
+)
+
+// GetDomainsActivity returns the names of the domains to migrate, all the domains are returned if none is given
+func GetDomainsActivity(ctx context.Context, domains []string) ([]string, error) {
+	migrator := ctx.Value(visibilityMigratorContextKey).(*Migrator)
+	if len(domains) > 0 {
+		for _, domain := range domains {
+			if _, err := migrator.domainCache.GetDomain(domain); err != nil {
+				if _, ok := err.(*types.EntityNotExistsError); ok {
+					return nil, cadence.NewCustomError(errMsgDomainNotExists, domain)
+				}
+				return nil, err
+			}
+		}
+		return domains, nil
+	}
+
+	feClient := migrator.clientBean.GetFrontendClient()
+	var res []string
+	var token []byte
+	for more := true; more; more = len(token) > 0 {
+		resp, err := feClient.ListDomains(ctx, &types.ListDomainsRequest{
+			PageSize:      domainsPageSize,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range resp.GetDomains() {
+			res = append(res, domain.GetDomainInfo().GetName())
+		}
+		token = resp.GetNextPageToken()
+		activity.RecordHeartbeat(ctx, len(res))
+	}
+	return res, nil
+}
+
+// CopyRecordsActivity reads up to MaxPages pages of open or closed records from the source store
+// and writes them to the target store
+func CopyRecordsActivity(ctx context.Context, params *RecordsActivityParams) (*CopyRecordsResult, error) {
+	actCtx, err := newRecordsActivityContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	details := getHeartbeatDetails(ctx, params)
+	err = actCtx.forEachRecord(ctx, params, details, func(record *types.WorkflowExecutionInfo) error {
+		copied, err := actCtx.copyRecord(ctx, params.Closed, record)
+		if err != nil {
+			return err
+		}
+		if copied {
+			details.Copy.Copied++
+		} else {
+			details.Copy.Skipped++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	details.Copy.NextPageToken = details.NextPageToken
+	return &details.Copy, nil
+}
+
+// VerifyRecordsActivity reads up to MaxPages pages of open or closed records from the source store
+// and checks that each of them is present and identical in the target store
+func VerifyRecordsActivity(ctx context.Context, params *RecordsActivityParams) (*VerifyRecordsResult, error) {
+	actCtx, err := newRecordsActivityContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	details := getHeartbeatDetails(ctx, params)
+	err = actCtx.forEachRecord(ctx, params, details, func(record *types.WorkflowExecutionInfo) error {
+		diff, err := actCtx.verifyRecord(ctx, params.Closed, record)
+		if err != nil {
+			return err
+		}
+		if diff == nil {
+			details.Verify.Verified++
+			return nil
+		}
+		switch diff.Reason {
+		case DiffReasonMissing:
+			details.Verify.Missing++
+		default:
+			details.Verify.Mismatched++
+		}
+		if len(details.Verify.Diffs) < maxReportedDiffs {
+			details.Verify.Diffs = append(details.Verify.Diffs, *diff)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	details.Verify.NextPageToken = details.NextPageToken
+	return &details.Verify, nil
+}
+
+func newRecordsActivityContext(ctx context.Context, params *RecordsActivityParams) (*recordsActivityContext, error) {
+	migrator := ctx.Value(visibilityMigratorContextKey).(*Migrator)
+	source := migrator.getVisibilityManager(params.SourceStore)
+	if source == nil {
+		return nil, cadence.NewCustomError(errMsgStoreNotConfigured, params.SourceStore)
+	}
+	target := migrator.getVisibilityManager(params.TargetStore)
+	if target == nil {
+		return nil, cadence.NewCustomError(errMsgStoreNotConfigured, params.TargetStore)
+	}
+	domainEntry, err := migrator.domainCache.GetDomain(params.Domain)
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil, cadence.NewCustomError(errMsgDomainNotExists, params.Domain)
+		}
+		return nil, err
+	}
+	rps := params.RPS
+	if rps <= 0 {
+		rps = DefaultRPS
+	}
+	return &recordsActivityContext{
+		migrator:    migrator,
+		domainEntry: domainEntry,
+		source:      source,
+		target:      target,
+		rateLimiter: quotas.NewSimpleRateLimiter(rps),
+		logger:      migrator.logger.WithTags(tag.WorkflowDomainName(params.Domain)),
+	}, nil
+}
+
+// getHeartbeatDetails returns the progress of the previous attempt if it's working on the same pages
+func getHeartbeatDetails(ctx context.Context, params *RecordsActivityParams) *recordsHeartbeatDetails {
+	details := &recordsHeartbeatDetails{NextPageToken: params.NextPageToken}
+	if !activity.HasHeartbeatDetails(ctx) {
+		return details
+	}
+	var prev recordsHeartbeatDetails
+	if err := activity.GetHeartbeatDetails(ctx, &prev); err != nil || prev.Pages == 0 {
+		return details
+	}
+	return &prev
+}
+
+// forEachRecord calls fn for every record in the pages of the source store, starting from the page in details.
+// The progress is heartbeated after each page, so a retried activity resumes from the last completed page.
+func (c *recordsActivityContext) forEachRecord(
+	ctx context.Context,
+	params *RecordsActivityParams,
+	details *recordsHeartbeatDetails,
+	fn func(*types.WorkflowExecutionInfo) error,
+) error {
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	maxPages := params.MaxPages
+	if maxPages <= 0 {
+		maxPages = defaultPagesPerActivity
+	}
+
+	for details.Pages < maxPages {
+		request := &persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:    c.domainEntry.GetInfo().ID,
+			Domain:        params.Domain,
+			EarliestTime:  0,
+			LatestTime:    params.LatestTime,
+			PageSize:      pageSize,
+			NextPageToken: details.NextPageToken,
+		}
+		var resp *persistence.ListWorkflowExecutionsResponse
+		var err error
+		if params.Closed {
+			resp, err = c.source.ListClosedWorkflowExecutions(ctx, request)
+		} else {
+			resp, err = c.source.ListOpenWorkflowExecutions(ctx, request)
+		}
+		if err != nil {
+			c.logger.Error("Failed to list visibility records from source store", tag.Error(err))
+			return err
+		}
+
+		for _, record := range resp.Executions {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+			if err := fn(record); err != nil {
+				return err
+			}
+		}
+
+		details.Pages++
+		details.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, *details)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// copyRecord writes the record to the target store, it returns false if the record is skipped
+func (c *recordsActivityContext) copyRecord(ctx context.Context, closed bool, record *types.WorkflowExecutionInfo) (bool, error) {
+	domainInfo := c.domainEntry.GetInfo()
+	execution := *record.GetExecution()
+	searchAttributes := record.GetSearchAttributes().GetIndexedFields()
+
+	if closed {
+		retentionSeconds := int64(c.domainEntry.GetRetentionDays(execution.GetWorkflowID())) * int64(time.Hour*24/time.Second)
+		err := c.target.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
+			DomainUUID:         domainInfo.ID,
+			Domain:             domainInfo.Name,
+			Execution:          execution,
+			WorkflowTypeName:   record.GetType().GetName(),
+			StartTimestamp:     record.GetStartTime(),
+			ExecutionTimestamp: record.GetExecutionTime(),
+			CloseTimestamp:     record.GetCloseTime(),
+			Status:             record.GetCloseStatus(),
+			HistoryLength:      record.HistoryLength,
+			RetentionSeconds:   retentionSeconds,
+			Memo:               record.Memo,
+			TaskList:           record.TaskList,
+			IsCron:             record.IsCron,
+			UpdateTimestamp:    record.GetUpdateTime(),
+			SearchAttributes:   searchAttributes,
+		})
+		return err == nil, err
+	}
+
+	workflowTimeout, err := c.getWorkflowTimeout(ctx, domainInfo.Name, execution)
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// the workflow is closed or deleted since it was listed,
+			// its closed record will be copied in the closed records phase
+			return false, nil
+		}
+		return false, err
+	}
+	err = c.target.RecordWorkflowExecutionStarted(ctx, &persistence.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:         domainInfo.ID,
+		Domain:             domainInfo.Name,
+		Execution:          execution,
+		WorkflowTypeName:   record.GetType().GetName(),
+		StartTimestamp:     record.GetStartTime(),
+		ExecutionTimestamp: record.GetExecutionTime(),
+		WorkflowTimeout:    workflowTimeout,
+		Memo:               record.Memo,
+		TaskList:           record.TaskList,
+		IsCron:             record.IsCron,
+		UpdateTimestamp:    record.GetUpdateTime(),
+		SearchAttributes:   searchAttributes,
+	})
+	return err == nil, err
+}
+
+// getWorkflowTimeout returns the execution timeout of an open workflow, which is used as the ttl of its open record
+func (c *recordsActivityContext) getWorkflowTimeout(ctx context.Context, domain string, execution types.WorkflowExecution) (int64, error) {
+	resp, err := c.migrator.clientBean.GetFrontendClient().DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    domain,
+		Execution: &execution,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.ExecutionConfiguration == nil || resp.ExecutionConfiguration.ExecutionStartToCloseTimeoutSeconds == nil {
+		return defaultOpenWorkflowTimeoutSeconds, nil
+	}
+	return int64(*resp.ExecutionConfiguration.ExecutionStartToCloseTimeoutSeconds), nil
+}
+
+// verifyRecord returns the diff between the source record and the target store, or nil if the record is verified
+func (c *recordsActivityContext) verifyRecord(ctx context.Context, closed bool, record *types.WorkflowExecutionInfo) (*RecordDiff, error) {
+	execution := record.GetExecution()
+	if closed {
+		target, err := c.getClosedRecord(ctx, execution)
+		if err != nil {
+			return nil, err
+		}
+		if target == nil {
+			return newRecordDiff(execution, DiffReasonMissing, ""), nil
+		}
+		if details := compareClosedRecords(record, target); details != "" {
+			return newRecordDiff(execution, DiffReasonMismatch, details), nil
+		}
+		return nil, nil
+	}
+
+	resp, err := c.target.ListOpenWorkflowExecutionsByWorkflowID(ctx, &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:   c.domainEntry.GetInfo().ID,
+			Domain:       c.domainEntry.GetInfo().Name,
+			EarliestTime: record.GetStartTime(),
+			LatestTime:   record.GetStartTime(),
+			PageSize:     DefaultPageSize,
+		},
+		WorkflowID: execution.GetWorkflowID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range resp.Executions {
+		if target.GetExecution().GetRunID() == execution.GetRunID() {
+			if details := compareOpenRecords(record, target); details != "" {
+				return newRecordDiff(execution, DiffReasonMismatch, details), nil
+			}
+			return nil, nil
+		}
+	}
+
+	// the workflow may have been closed since it was listed from the source store
+	target, err := c.getClosedRecord(ctx, execution)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return newRecordDiff(execution, DiffReasonMissing, ""), nil
+	}
+	return nil, nil
+}
+
+// getClosedRecord returns the closed record from the target store, or nil if it doesn't exist
+func (c *recordsActivityContext) getClosedRecord(ctx context.Context, execution *types.WorkflowExecution) (*types.WorkflowExecutionInfo, error) {
+	resp, err := c.target.GetClosedWorkflowExecution(ctx, &persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: c.domainEntry.GetInfo().ID,
+		Domain:     c.domainEntry.GetInfo().Name,
+		Execution:  *execution,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.Execution, nil
+}
+
+func compareOpenRecords(source, target *types.WorkflowExecutionInfo) string {
+	if source.GetType().GetName() != target.GetType().GetName() {
+		return fmt.Sprintf("workflow type %v != %v", source.GetType().GetName(), target.GetType().GetName())
+	}
+	if source.GetStartTime() != target.GetStartTime() {
+		return fmt.Sprintf("start time %v != %v", source.GetStartTime(), target.GetStartTime())
+	}
+	if source.TaskList != target.TaskList {
+		return fmt.Sprintf("task list %v != %v", source.TaskList, target.TaskList)
+	}
+	if !isMemoEqual(source.Memo, target.Memo) {
+		return "memo"
+	}
+	return ""
+}
+
+func compareClosedRecords(source, target *types.WorkflowExecutionInfo) string {
+	if details := compareOpenRecords(source, target); details != "" {
+		return details
+	}
+	if source.GetCloseTime() != target.GetCloseTime() {
+		return fmt.Sprintf("close time %v != %v", source.GetCloseTime(), target.GetCloseTime())
+	}
+	if source.GetCloseStatus() != target.GetCloseStatus() {
+		return fmt.Sprintf("close status %v != %v", source.GetCloseStatus(), target.GetCloseStatus())
+	}
+	if source.HistoryLength != target.HistoryLength {
+		return fmt.Sprintf("history length %v != %v", source.HistoryLength, target.HistoryLength)
+	}
+	return ""
+}
+
+func isMemoEqual(source, target *types.Memo) bool {
+	sourceFields := source.GetFields()
+	targetFields := target.GetFields()
+	if len(sourceFields) != len(targetFields) {
+		return false
+	}
+	for key, value := range sourceFields {
+		if !bytes.Equal(value, targetFields[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func newRecordDiff(execution *types.WorkflowExecution, reason, details string) *RecordDiff {
+	return &RecordDiff{
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+		Reason:     reason,
+		Details:    details,
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the visibility migrator
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+		// DomainCache is used to resolve the domains being migrated
+		DomainCache cache.DomainCache
+		// VisibilityManager is the dual visibility manager that wraps both the db and the ElasticSearch stores
+		VisibilityManager persistence.VisibilityManager
+	}
+
+	// Migrator is the background sub-system that copies the visibility records
+	// from one visibility store to the other and verifies the copied records.
+	// It is also the context object that get's passed around within the migration activities
+	Migrator struct {
+		svcClient         workflowserviceclient.Interface
+		clientBean        client.Bean
+		domainCache       cache.DomainCache
+		visibilityManager persistence.VisibilityManager
+		metricsClient     metrics.Client
+		tallyScope        tally.Scope
+		logger            log.Logger
+		worker            worker.Worker
+	}
+)
+
+// New returns a new instance of the visibility migrator
+func New(params *BootstrapParams) *Migrator {
+	return &Migrator{
+		svcClient:         params.ServiceClient,
+		clientBean:        params.ClientBean,
+		domainCache:       params.DomainCache,
+		visibilityManager: params.VisibilityManager,
+		metricsClient:     params.MetricsClient,
+		tallyScope:        params.TallyScope,
+		logger:            params.Logger.WithTags(tag.ComponentVisibilityMigrator),
+	}
+}
+
+// Start starts the worker for visibility migration workflows
+func (m *Migrator) Start() error {
+	ctx := context.WithValue(context.Background(), visibilityMigratorContextKey, m)
+	workerOpts := worker.Options{
+		MetricsScope:              m.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	migrationWorker := worker.New(m.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	migrationWorker.RegisterWorkflowWithOptions(MigrationWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	migrationWorker.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	migrationWorker.RegisterActivityWithOptions(CopyRecordsActivity, activity.RegisterOptions{Name: copyRecordsActivityName})
+	migrationWorker.RegisterActivityWithOptions(VerifyRecordsActivity, activity.RegisterOptions{Name: verifyRecordsActivityName})
+	m.worker = migrationWorker
+	return migrationWorker.Start()
+}
+
+// Stop stops the worker
+func (m *Migrator) Stop() {
+	if m.worker != nil {
+		m.worker.Stop()
+	}
+}
+
+// getVisibilityManager returns the visibility manager of the given store
+func (m *Migrator) getVisibilityManager(store string) persistence.VisibilityManager {
+	return persistence.GetVisibilityManagerForStore(m.visibilityManager, store)
+}
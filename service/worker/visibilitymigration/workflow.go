@@ -0,0 +1,407 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	visibilityMigratorContextKey contextKey = "visibilityMigratorContext"
+
+	// TaskListName is the task list of the visibility migration workflow
+	TaskListName = "cadence-sys-visibility-migration-tasklist"
+	// WorkflowTypeName is the workflow type name of the visibility migration workflow
+	WorkflowTypeName = "cadence-sys-visibility-migration-workflow"
+	// WorkflowID will be reused to ensure only one migration is running
+	WorkflowID = "cadence-sys-visibility-migration"
+
+	getDomainsActivityName    = "cadence-sys-visibility-migration-getDomains-activity"
+	copyRecordsActivityName   = "cadence-sys-visibility-migration-copyRecords-activity"
+	verifyRecordsActivityName = "cadence-sys-visibility-migration-verifyRecords-activity"
+
+	// QueryType for visibility migration workflow
+	QueryType = "state"
+
+	// DefaultPageSize is the default number of records read from the source store in one page
+	DefaultPageSize = 1000
+	// DefaultRPS is the default number of records written to or verified against the target store per second
+	DefaultRPS = 100
+	// DefaultVerificationDelay is the default wait time between copying and verifying the records,
+	// it gives the asynchronous ElasticSearch indexing time to catch up
+	DefaultVerificationDelay = time.Minute
+
+	defaultPagesPerActivity = 10
+	// maxReportedDiffs is the max number of diffs kept in the report of each domain
+	maxReportedDiffs = 100
+	// activitiesPerRun is the number of activities after which the workflow continues as new with a checkpoint
+	activitiesPerRun = 500
+
+	errMsgParamsIsNil        = "params is nil"
+	errMsgInvalidStore       = "source and target store must be one of db and es"
+	errMsgTargetSameAsSource = "target store is the same as source store"
+	errMsgStoreNotConfigured = "visibility store is not configured"
+	errMsgDomainNotExists    = "domain does not exist"
+	errMsgInvalidPhase       = "invalid migration phase"
+)
+
+// phases of the migration, every phase goes through all the domains before moving to the next one
+const (
+	// PhaseCopyOpen copies the open records
+	PhaseCopyOpen = "copyOpen"
+	// PhaseCopyClosed copies the closed records
+	PhaseCopyClosed = "copyClosed"
+	// PhaseVerifyOpen verifies the open records
+	PhaseVerifyOpen = "verifyOpen"
+	// PhaseVerifyClosed verifies the closed records
+	PhaseVerifyClosed = "verifyClosed"
+	// PhaseCompleted means the migration is done, the report is final
+	PhaseCompleted = "completed"
+)
+
+// reasons of the diffs in the verification report
+const (
+	// DiffReasonMissing means the record is not found in the target store
+	DiffReasonMissing = "missing"
+	// DiffReasonMismatch means the record in the target store is different from the source store
+	DiffReasonMismatch = "mismatch"
+)
+
+type (
+	// MigrationParams is the arg for the visibility migration workflow
+	MigrationParams struct {
+		// SourceStore is the store to read the records from, one of db and es
+		SourceStore string
+		// TargetStore is the store to write the records to, one of db and es
+		TargetStore string
+		// Domains to migrate, all domains are migrated if empty
+		Domains []string
+		// PageSize is the number of records read from the source store in one page
+		PageSize int
+		// RPS is the number of records written to or verified against the target store per second
+		RPS int
+		// VerifyOnly skips the copy phases and only produces the verification report
+		VerifyOnly bool
+		// VerificationDelay is the wait time between copying and verifying the records
+		VerificationDelay time.Duration
+		// Checkpoint is set by the workflow when it continues as new, it must be empty when starting a migration
+		Checkpoint *Checkpoint
+	}
+
+	// Checkpoint is the progress of a migration that is carried over when the workflow continues as new
+	Checkpoint struct {
+		// Domains are the resolved domains to migrate
+		Domains []string
+		// LatestTime is the upper bound of the record time range, records after it are expected to be dual written
+		LatestTime int64
+		Phase      string
+		// DomainIndex is the index of the domain being processed in the current phase
+		DomainIndex   int
+		NextPageToken []byte
+		Report        *MigrationReport
+	}
+
+	// MigrationReport is the result of the visibility migration workflow
+	MigrationReport struct {
+		Domains []*DomainReport
+	}
+
+	// DomainReport is the migration and verification report of a domain
+	DomainReport struct {
+		Domain         string
+		CopiedOpen     int64
+		CopiedClosed   int64
+		Skipped        int64
+		VerifiedOpen   int64
+		VerifiedClosed int64
+		Missing        int64
+		Mismatched     int64
+		// Diffs contains up to maxReportedDiffs records that are missing or mismatched in the target store
+		Diffs []RecordDiff
+	}
+
+	// RecordDiff is a record that is missing or mismatched in the target store
+	RecordDiff struct {
+		WorkflowID string
+		RunID      string
+		Reason     string
+		Details    string `json:",omitempty"`
+	}
+
+	// RecordsActivityParams is the params for the copy and verify activities
+	RecordsActivityParams struct {
+		SourceStore   string
+		TargetStore   string
+		Domain        string
+		Closed        bool
+		LatestTime    int64
+		PageSize      int
+		RPS           int
+		MaxPages      int
+		NextPageToken []byte
+	}
+
+	// CopyRecordsResult is the result of the copy activity
+	CopyRecordsResult struct {
+		Copied        int64
+		Skipped       int64
+		NextPageToken []byte
+	}
+
+	// VerifyRecordsResult is the result of the verify activity
+	VerifyRecordsResult struct {
+		Verified      int64
+		Missing       int64
+		Mismatched    int64
+		Diffs         []RecordDiff
+		NextPageToken []byte
+	}
+
+	// QueryResult for visibility migration progress
+	QueryResult struct {
+		SourceStore   string
+		TargetStore   string
+		Phase         string
+		TotalDomains  int
+		CurrentDomain string `json:",omitempty"`
+		Report        *MigrationReport
+	}
+)
+
+// MigrationWorkflow is the workflow that copies the visibility records of the given domains from the source store
+// to the target store, then verifies that every record of the source store is present in the target store.
+// The verification report tells whether it's safe to switch the read mode to the target store.
+func MigrationWorkflow(ctx workflow.Context, params *MigrationParams) (*MigrationReport, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+
+	checkpoint := params.Checkpoint
+	if checkpoint == nil {
+		ao := workflow.WithActivityOptions(ctx, getGetDomainsActivityOptions())
+		var domains []string
+		if err := workflow.ExecuteActivity(ao, GetDomainsActivity, params.Domains).Get(ctx, &domains); err != nil {
+			return nil, err
+		}
+		checkpoint = newCheckpoint(domains, workflow.Now(ctx), params.VerifyOnly)
+	}
+
+	if err := workflow.SetQueryHandler(ctx, QueryType, func() (*QueryResult, error) {
+		result := &QueryResult{
+			SourceStore:  params.SourceStore,
+			TargetStore:  params.TargetStore,
+			Phase:        checkpoint.Phase,
+			TotalDomains: len(checkpoint.Domains),
+			Report:       checkpoint.Report,
+		}
+		if checkpoint.DomainIndex < len(checkpoint.Domains) && checkpoint.Phase != PhaseCompleted {
+			result.CurrentDomain = checkpoint.Domains[checkpoint.DomainIndex]
+		}
+		return result, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getRecordsActivityOptions())
+	for activities := 0; checkpoint.Phase != PhaseCompleted; activities++ {
+		if activities >= activitiesPerRun {
+			params.Checkpoint = checkpoint
+			return nil, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+		}
+
+		if checkpoint.DomainIndex >= len(checkpoint.Domains) {
+			checkpoint.Phase = nextPhase(checkpoint.Phase)
+			checkpoint.DomainIndex = 0
+			checkpoint.NextPageToken = nil
+			if checkpoint.Phase == PhaseVerifyOpen && !params.VerifyOnly && params.VerificationDelay > 0 {
+				if err := workflow.Sleep(ctx, params.VerificationDelay); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		report := checkpoint.Report.Domains[checkpoint.DomainIndex]
+		activityParams := &RecordsActivityParams{
+			SourceStore:   params.SourceStore,
+			TargetStore:   params.TargetStore,
+			Domain:        report.Domain,
+			Closed:        checkpoint.Phase == PhaseCopyClosed || checkpoint.Phase == PhaseVerifyClosed,
+			LatestTime:    checkpoint.LatestTime,
+			PageSize:      params.PageSize,
+			RPS:           params.RPS,
+			MaxPages:      defaultPagesPerActivity,
+			NextPageToken: checkpoint.NextPageToken,
+		}
+		var nextPageToken []byte
+		switch checkpoint.Phase {
+		case PhaseCopyOpen, PhaseCopyClosed:
+			var result CopyRecordsResult
+			if err := workflow.ExecuteActivity(ao, CopyRecordsActivity, activityParams).Get(ctx, &result); err != nil {
+				return nil, err
+			}
+			report.addCopyResult(activityParams.Closed, &result)
+			nextPageToken = result.NextPageToken
+		case PhaseVerifyOpen, PhaseVerifyClosed:
+			var result VerifyRecordsResult
+			if err := workflow.ExecuteActivity(ao, VerifyRecordsActivity, activityParams).Get(ctx, &result); err != nil {
+				return nil, err
+			}
+			report.addVerifyResult(activityParams.Closed, &result)
+			nextPageToken = result.NextPageToken
+		default:
+			return nil, errors.New(errMsgInvalidPhase)
+		}
+
+		checkpoint.NextPageToken = nextPageToken
+		if len(nextPageToken) == 0 {
+			checkpoint.DomainIndex++
+		}
+	}
+	return checkpoint.Report, nil
+}
+
+// HasDiffs returns whether any record is missing or mismatched in the target store
+func (r *MigrationReport) HasDiffs() bool {
+	for _, report := range r.Domains {
+		if report.Missing > 0 || report.Mismatched > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *DomainReport) addCopyResult(closed bool, result *CopyRecordsResult) {
+	if closed {
+		r.CopiedClosed += result.Copied
+	} else {
+		r.CopiedOpen += result.Copied
+	}
+	r.Skipped += result.Skipped
+}
+
+func (r *DomainReport) addVerifyResult(closed bool, result *VerifyRecordsResult) {
+	if closed {
+		r.VerifiedClosed += result.Verified
+	} else {
+		r.VerifiedOpen += result.Verified
+	}
+	r.Missing += result.Missing
+	r.Mismatched += result.Mismatched
+	for _, diff := range result.Diffs {
+		if len(r.Diffs) >= maxReportedDiffs {
+			break
+		}
+		r.Diffs = append(r.Diffs, diff)
+	}
+}
+
+func newCheckpoint(domains []string, now time.Time, verifyOnly bool) *Checkpoint {
+	report := &MigrationReport{}
+	for _, domain := range domains {
+		report.Domains = append(report.Domains, &DomainReport{Domain: domain})
+	}
+	phase := PhaseCopyOpen
+	if verifyOnly {
+		phase = PhaseVerifyOpen
+	}
+	return &Checkpoint{
+		Domains:    domains,
+		LatestTime: now.UnixNano(),
+		Phase:      phase,
+		Report:     report,
+	}
+}
+
+func nextPhase(phase string) string {
+	switch phase {
+	case PhaseCopyOpen:
+		return PhaseCopyClosed
+	case PhaseCopyClosed:
+		return PhaseVerifyOpen
+	case PhaseVerifyOpen:
+		return PhaseVerifyClosed
+	default:
+		return PhaseCompleted
+	}
+}
+
+func validateParams(params *MigrationParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if !isValidStore(params.SourceStore) || !isValidStore(params.TargetStore) {
+		return errors.New(errMsgInvalidStore)
+	}
+	if params.SourceStore == params.TargetStore {
+		return errors.New(errMsgTargetSameAsSource)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultPageSize
+	}
+	if params.RPS <= 0 {
+		params.RPS = DefaultRPS
+	}
+	return nil
+}
+
+func isValidStore(store string) bool {
+	return store == persistence.VisibilityStoreDB || store == persistence.VisibilityStoreES
+}
+
+func getGetDomainsActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          2 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          time.Minute,
+			ExpirationInterval:       10 * time.Minute,
+			NonRetriableErrorReasons: []string{errMsgDomainNotExists},
+		},
+	}
+}
+
+func getRecordsActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    30 * time.Minute,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          5 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       time.Hour,
+			NonRetriableErrorReasons: []string{errMsgStoreNotConfigured, errMsgDomainNotExists},
+		},
+	}
+}
@@ -0,0 +1,412 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain"
+)
+
+type visibilityMigrationWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+
+	controller      *gomock.Controller
+	mockResource    *resource.Test
+	mockDomainCache *cache.MockDomainCache
+	dbManager       *mocks.VisibilityManager
+	esManager       *mocks.VisibilityManager
+}
+
+func TestVisibilityMigrationWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(visibilityMigrationWorkflowTestSuite))
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(MigrationWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CopyRecordsActivity, activity.RegisterOptions{Name: copyRecordsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(VerifyRecordsActivity, activity.RegisterOptions{Name: verifyRecordsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(CopyRecordsActivity, activity.RegisterOptions{Name: copyRecordsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(VerifyRecordsActivity, activity.RegisterOptions{Name: verifyRecordsActivityName})
+
+	s.controller = gomock.NewController(s.T())
+	s.mockResource = resource.NewTest(s.controller, metrics.Worker)
+	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
+	s.dbManager = &mocks.VisibilityManager{}
+	s.esManager = &mocks.VisibilityManager{}
+	s.setVisibilityManager(persistence.NewVisibilityDualManager(s.dbManager, s.esManager, nil, nil, log.NewNoop()))
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.dbManager.AssertExpectations(s.T())
+	s.esManager.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestValidateParams() {
+	s.Error(validateParams(nil))
+	params := &MigrationParams{}
+	s.Error(validateParams(params))
+	params.SourceStore = persistence.VisibilityStoreDB
+	s.Error(validateParams(params))
+	params.TargetStore = persistence.VisibilityStoreDB
+	s.Error(validateParams(params))
+	params.TargetStore = "unknown"
+	s.Error(validateParams(params))
+	params.TargetStore = persistence.VisibilityStoreES
+	s.NoError(validateParams(params))
+	s.Equal(DefaultPageSize, params.PageSize)
+	s.Equal(DefaultRPS, params.RPS)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestWorkflow_GetDomainsActivityError() {
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(nil, errors.New("mockErr"))
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Equal("mockErr", s.workflowEnv.GetWorkflowError().Error())
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestWorkflow_Success() {
+	domains := []string{"d1", "d2"}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(copyRecordsActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params *RecordsActivityParams) (*CopyRecordsResult, error) {
+			// every domain has two pages of records
+			if len(params.NextPageToken) == 0 {
+				return &CopyRecordsResult{Copied: 2, NextPageToken: []byte("token")}, nil
+			}
+			return &CopyRecordsResult{Copied: 1, Skipped: 1}, nil
+		})
+	s.workflowEnv.OnActivity(verifyRecordsActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params *RecordsActivityParams) (*VerifyRecordsResult, error) {
+			if params.Domain == "d2" && params.Closed {
+				return &VerifyRecordsResult{
+					Verified: 2,
+					Missing:  1,
+					Diffs:    []RecordDiff{{WorkflowID: "wid", RunID: "rid", Reason: DiffReasonMissing}},
+				}, nil
+			}
+			return &VerifyRecordsResult{Verified: 3}, nil
+		})
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams())
+	var report MigrationReport
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Len(report.Domains, 2)
+	s.Equal(DomainReport{
+		Domain:         "d1",
+		CopiedOpen:     3,
+		CopiedClosed:   3,
+		Skipped:        2,
+		VerifiedOpen:   3,
+		VerifiedClosed: 3,
+	}, *report.Domains[0])
+	s.Equal(DomainReport{
+		Domain:         "d2",
+		CopiedOpen:     3,
+		CopiedClosed:   3,
+		Skipped:        2,
+		VerifiedOpen:   3,
+		VerifiedClosed: 2,
+		Missing:        1,
+		Diffs:          []RecordDiff{{WorkflowID: "wid", RunID: "rid", Reason: DiffReasonMissing}},
+	}, *report.Domains[1])
+	s.True(report.HasDiffs())
+
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var res QueryResult
+	s.NoError(queryResult.Get(&res))
+	s.Equal(PhaseCompleted, res.Phase)
+	s.Equal(2, res.TotalDomains)
+	s.Empty(res.CurrentDomain)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestWorkflow_VerifyOnly() {
+	params := s.newParams()
+	params.VerifyOnly = true
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return([]string{"d1"}, nil)
+	s.workflowEnv.OnActivity(verifyRecordsActivityName, mock.Anything, mock.Anything).Return(&VerifyRecordsResult{Verified: 1}, nil).Twice()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	var report MigrationReport
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Equal(DomainReport{Domain: "d1", VerifiedOpen: 1, VerifiedClosed: 1}, *report.Domains[0])
+	s.False(report.HasDiffs())
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestWorkflow_ResumeFromCheckpoint() {
+	params := s.newParams()
+	params.Checkpoint = newCheckpoint([]string{"d1", "d2"}, time.Now(), false)
+	params.Checkpoint.Phase = PhaseVerifyClosed
+	params.Checkpoint.DomainIndex = 1
+	s.workflowEnv.OnActivity(verifyRecordsActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, activityParams *RecordsActivityParams) (*VerifyRecordsResult, error) {
+			s.Equal("d2", activityParams.Domain)
+			s.True(activityParams.Closed)
+			s.Equal(params.Checkpoint.LatestTime, activityParams.LatestTime)
+			return &VerifyRecordsResult{Verified: 1}, nil
+		}).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	var report MigrationReport
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Equal(int64(1), report.Domains[1].VerifiedClosed)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestGetDomainsActivity() {
+	env := s.prepareTestActivityEnv()
+	s.mockResource.FrontendClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(&types.ListDomainsResponse{
+		Domains: []*types.DescribeDomainResponse{
+			{DomainInfo: &types.DomainInfo{Name: "d1"}},
+			{DomainInfo: &types.DomainInfo{Name: "d2"}},
+		},
+	}, nil)
+
+	actResult, err := env.ExecuteActivity(getDomainsActivityName, []string(nil))
+	s.NoError(err)
+	var result []string
+	s.NoError(actResult.Get(&result))
+	s.Equal([]string{"d1", "d2"}, result)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestGetDomainsActivity_DomainNotExists() {
+	env := s.prepareTestActivityEnv()
+	s.mockDomainCache.EXPECT().GetDomain("d1").Return(nil, &types.EntityNotExistsError{})
+
+	_, err := env.ExecuteActivity(getDomainsActivityName, []string{"d1"})
+	s.Error(err)
+	s.Contains(err.Error(), errMsgDomainNotExists)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestCopyRecordsActivity_Closed() {
+	env := s.prepareTestActivityEnv()
+	s.expectGetDomain()
+	records := []*types.WorkflowExecutionInfo{s.newRecord("wid1", true), s.newRecord("wid2", true)}
+	s.dbManager.On("ListClosedWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: records,
+	}, nil).Once()
+	s.esManager.On("RecordWorkflowExecutionClosed", mock.Anything, mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.DomainUUID == testDomainID &&
+			request.HistoryLength == 10 &&
+			request.RetentionSeconds == int64(time.Hour*24/time.Second) &&
+			request.Status == types.WorkflowExecutionCloseStatusCompleted
+	})).Return(nil).Twice()
+
+	actResult, err := env.ExecuteActivity(copyRecordsActivityName, s.newActivityParams(true))
+	s.NoError(err)
+	var result CopyRecordsResult
+	s.NoError(actResult.Get(&result))
+	s.Equal(int64(2), result.Copied)
+	s.Empty(result.NextPageToken)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestCopyRecordsActivity_Open() {
+	env := s.prepareTestActivityEnv()
+	s.expectGetDomain()
+	s.dbManager.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions:    []*types.WorkflowExecutionInfo{s.newRecord("wid1", false), s.newRecord("wid2", false)},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: &types.WorkflowExecutionConfiguration{
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		},
+	}, nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	s.esManager.On("RecordWorkflowExecutionStarted", mock.Anything, mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionStartedRequest) bool {
+		return request.Execution.WorkflowID == "wid1" && request.WorkflowTimeout == 100
+	})).Return(nil).Once()
+
+	params := s.newActivityParams(false)
+	params.MaxPages = 1
+	actResult, err := env.ExecuteActivity(copyRecordsActivityName, params)
+	s.NoError(err)
+	var result CopyRecordsResult
+	s.NoError(actResult.Get(&result))
+	s.Equal(int64(1), result.Copied)
+	s.Equal(int64(1), result.Skipped)
+	s.Equal([]byte("token"), result.NextPageToken)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestCopyRecordsActivity_StoreNotConfigured() {
+	s.setVisibilityManager(persistence.NewVisibilityDualManager(s.dbManager, nil, nil, nil, log.NewNoop()))
+	env := s.prepareTestActivityEnv()
+
+	_, err := env.ExecuteActivity(copyRecordsActivityName, s.newActivityParams(true))
+	s.Error(err)
+	s.Contains(err.Error(), errMsgStoreNotConfigured)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestVerifyRecordsActivity_Closed() {
+	env := s.prepareTestActivityEnv()
+	s.expectGetDomain()
+	verified := s.newRecord("verified", true)
+	missing := s.newRecord("missing", true)
+	mismatched := s.newRecord("mismatched", true)
+	s.dbManager.On("ListClosedWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{verified, missing, mismatched},
+	}, nil).Once()
+	s.expectGetClosedRecord("verified", &persistence.GetClosedWorkflowExecutionResponse{Execution: s.newRecord("verified", true)}, nil)
+	s.expectGetClosedRecord("missing", nil, &types.EntityNotExistsError{})
+	target := s.newRecord("mismatched", true)
+	target.HistoryLength = 5
+	s.expectGetClosedRecord("mismatched", &persistence.GetClosedWorkflowExecutionResponse{Execution: target}, nil)
+
+	actResult, err := env.ExecuteActivity(verifyRecordsActivityName, s.newActivityParams(true))
+	s.NoError(err)
+	var result VerifyRecordsResult
+	s.NoError(actResult.Get(&result))
+	s.Equal(int64(1), result.Verified)
+	s.Equal(int64(1), result.Missing)
+	s.Equal(int64(1), result.Mismatched)
+	s.Equal([]RecordDiff{
+		{WorkflowID: "missing", RunID: "missing-run", Reason: DiffReasonMissing},
+		{WorkflowID: "mismatched", RunID: "mismatched-run", Reason: DiffReasonMismatch, Details: "history length 10 != 5"},
+	}, result.Diffs)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) TestVerifyRecordsActivity_Open() {
+	env := s.prepareTestActivityEnv()
+	s.expectGetDomain()
+	s.dbManager.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{s.newRecord("open", false), s.newRecord("closed", false)},
+	}, nil).Once()
+	s.esManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) bool {
+		return request.WorkflowID == "open"
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{s.newRecord("open", false)},
+	}, nil).Once()
+	s.esManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) bool {
+		return request.WorkflowID == "closed"
+	})).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+	// the workflow is closed after it's listed from the source store
+	s.expectGetClosedRecord("closed", &persistence.GetClosedWorkflowExecutionResponse{Execution: s.newRecord("closed", true)}, nil)
+
+	actResult, err := env.ExecuteActivity(verifyRecordsActivityName, s.newActivityParams(false))
+	s.NoError(err)
+	var result VerifyRecordsResult
+	s.NoError(actResult.Get(&result))
+	s.Equal(int64(2), result.Verified)
+	s.Empty(result.Diffs)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) newParams() *MigrationParams {
+	return &MigrationParams{
+		SourceStore: persistence.VisibilityStoreDB,
+		TargetStore: persistence.VisibilityStoreES,
+	}
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) newActivityParams(closed bool) *RecordsActivityParams {
+	return &RecordsActivityParams{
+		SourceStore: persistence.VisibilityStoreDB,
+		TargetStore: persistence.VisibilityStoreES,
+		Domain:      testDomainName,
+		Closed:      closed,
+		LatestTime:  time.Now().UnixNano(),
+		PageSize:    10,
+		RPS:         1000,
+	}
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) newRecord(workflowID string, closed bool) *types.WorkflowExecutionInfo {
+	record := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: workflowID, RunID: workflowID + "-run"},
+		Type:      &types.WorkflowType{Name: "test-workflow-type"},
+		StartTime: common.Int64Ptr(1000),
+		TaskList:  "test-tasklist",
+	}
+	if closed {
+		record.CloseTime = common.Int64Ptr(2000)
+		record.CloseStatus = types.WorkflowExecutionCloseStatusCompleted.Ptr()
+		record.HistoryLength = 10
+	}
+	return record
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) expectGetDomain() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomainName},
+		&persistence.DomainConfig{Retention: 1},
+		"active",
+	)
+	s.mockDomainCache.EXPECT().GetDomain(testDomainName).Return(domainEntry, nil)
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) expectGetClosedRecord(workflowID string, resp *persistence.GetClosedWorkflowExecutionResponse, err error) {
+	s.esManager.On("GetClosedWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.GetClosedWorkflowExecutionRequest) bool {
+		return request.Execution.WorkflowID == workflowID
+	})).Return(resp, err).Once()
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) setVisibilityManager(visibilityManager persistence.VisibilityManager) {
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), visibilityMigratorContextKey, &Migrator{
+			clientBean:        s.mockResource.ClientBean,
+			domainCache:       s.mockDomainCache,
+			visibilityManager: visibilityManager,
+			logger:            log.NewNoop(),
+		}),
+	})
+}
+
+func (s *visibilityMigrationWorkflowTestSuite) prepareTestActivityEnv() *testsuite.TestActivityEnvironment {
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	return s.activityEnv
+}
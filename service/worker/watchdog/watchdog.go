@@ -108,7 +108,7 @@ func (wd *WatchDog) Start() error {
 func (wd *WatchDog) StartWorkflow(ctx context.Context) {
 	initWorkflow(wd)
 	go workercommon.StartWorkflowWithRetry(watchdogWFTypeName, startUpDelay, wd.resource, func(client cclient.Client) error {
-		_, err := client.StartWorkflow(ctx, wfOptions, watchdogWFTypeName)
+		_, err := client.StartWorkflow(ctx, wfOptions, watchdogWFTypeName, WorkflowParams{})
 		switch err.(type) {
 		case *shared.WorkflowExecutionAlreadyStartedError:
 			return nil
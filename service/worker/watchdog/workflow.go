@@ -30,21 +30,24 @@ import (
 	"go.uber.org/cadence/workflow"
 	"go.uber.org/zap"
 
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
+	cwatchdog "github.com/uber/cadence/common/watchdog"
 )
 
 const (
 	// workflow constants
-	WatchdogWFID       = "cadence-sys-watchdog"
 	taskListName       = "cadence-sys-tl-watchdog"
 	watchdogWFTypeName = "cadence-sys-watchdog-workflow"
 
 	// activities
 	handleCorruptedWorkflowActivity = "cadence-sys-watchdog-handle-corrupted-workflow"
 
-	// signals
-	CorruptWorkflowWatchdogChannelName = "CorruptWorkflowWatchdogChannelName"
+	// maxRecentChecksumFailures is the number of checksum failures kept for cwatchdog.ChecksumFailuresQueryType
+	maxRecentChecksumFailures = 100
+	// maxSignalsPerRun is the number of signals handled before the workflow continues as new
+	maxSignalsPerRun = 1000
 )
 
 type (
@@ -52,9 +55,9 @@ type (
 		watchdog *WatchDog
 	}
 
-	CorruptWFRequest struct {
-		Workflow   types.WorkflowExecution
-		DomainName string
+	// WorkflowParams is the input of the watchdog workflow, it carries the state over continue as new
+	WorkflowParams struct {
+		RecentChecksumFailures []cwatchdog.ChecksumFailureReport
 	}
 )
 
@@ -73,7 +76,7 @@ var (
 	}
 
 	wfOptions = cclient.StartWorkflowOptions{
-		ID:                           WatchdogWFID,
+		ID:                           cwatchdog.WatchdogWFID,
 		TaskList:                     taskListName,
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyTerminateIfRunning,
 		ExecutionStartToCloseTimeout: 24 * 365 * time.Hour, // 1 year
@@ -89,29 +92,99 @@ func initWorkflow(wd *WatchDog) {
 	activity.RegisterWithOptions(w.handleCorruptedWorkflow, activity.RegisterOptions{Name: handleCorruptedWorkflowActivity})
 }
 
-// workflowFunc is the workflow that performs actions for WatchDog
-func (w *Workflow) workflowFunc(ctx workflow.Context) error {
-	requestCh := workflow.GetSignalChannel(ctx, CorruptWorkflowWatchdogChannelName)
+// workflowFunc is the workflow that performs actions for WatchDog,
+// it continues as new after maxSignalsPerRun signals to keep its history bounded
+func (w *Workflow) workflowFunc(ctx workflow.Context, params WorkflowParams) error {
+	requestCh := workflow.GetSignalChannel(ctx, cwatchdog.CorruptWorkflowWatchdogChannelName)
+	checksumFailureCh := workflow.GetSignalChannel(ctx, cwatchdog.ChecksumFailureWatchdogChannelName)
 	logger := w.watchdog.logger
 
-	for {
-		var request CorruptWFRequest
-		if more := requestCh.Receive(ctx, &request); !more {
-			logger.Info("Corrupt workflow channel closed")
-			return cadence.NewCustomError("signal_channel_closed")
-		}
+	recentChecksumFailures := params.RecentChecksumFailures
+	if err := workflow.SetQueryHandler(ctx, cwatchdog.ChecksumFailuresQueryType, func() ([]cwatchdog.ChecksumFailureReport, error) {
+		return recentChecksumFailures, nil
+	}); err != nil {
+		return err
+	}
 
+	handleCorruptWorkflow := func(request cwatchdog.CorruptWFRequest) {
 		if w.watchdog.config.CorruptWorkflowWatchdogPause() {
 			logger.Warn("Corrupt workflow execution is paused. Enable to continue processing")
-			continue
+			return
 		}
 		opt := workflow.WithActivityOptions(ctx, handleCorruptWorkflowOptions)
 		_ = workflow.ExecuteActivity(opt, handleCorruptedWorkflowActivity, request).Get(ctx, nil)
 	}
+	handleChecksumFailure := func(report cwatchdog.ChecksumFailureReport) {
+		recentChecksumFailures = append(recentChecksumFailures, report)
+		if len(recentChecksumFailures) > maxRecentChecksumFailures {
+			recentChecksumFailures = recentChecksumFailures[len(recentChecksumFailures)-maxRecentChecksumFailures:]
+		}
+		if !workflow.IsReplaying(ctx) {
+			w.watchdog.scopedMetricClient.Tagged(
+				metrics.DomainTag(report.DomainName),
+			).IncCounter(metrics.WatchDogNumChecksumFailureReported)
+			logger.Warn("Watchdog received mutable state checksum failure",
+				tag.WorkflowDomainName(report.DomainName),
+				tag.WorkflowID(report.Workflow.GetWorkflowID()),
+				tag.WorkflowRunID(report.Workflow.GetRunID()),
+				tag.ShardID(report.ShardID),
+				tag.Counter(len(report.Diffs)),
+			)
+		}
+	}
+
+	var channelClosed bool
+	var signalCount int
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(requestCh, func(c workflow.Channel, more bool) {
+		var request cwatchdog.CorruptWFRequest
+		if !c.Receive(ctx, &request) {
+			logger.Info("Corrupt workflow channel closed")
+			channelClosed = true
+			return
+		}
+		signalCount++
+		handleCorruptWorkflow(request)
+	})
+	selector.AddReceive(checksumFailureCh, func(c workflow.Channel, more bool) {
+		var report cwatchdog.ChecksumFailureReport
+		if !c.Receive(ctx, &report) {
+			logger.Info("Checksum failure channel closed")
+			channelClosed = true
+			return
+		}
+		signalCount++
+		handleChecksumFailure(report)
+	})
+
+	for !channelClosed && signalCount < maxSignalsPerRun {
+		selector.Select(ctx)
+	}
+	if channelClosed {
+		return cadence.NewCustomError("signal_channel_closed")
+	}
+
+	// drain the signals before continuing as new so that none of them gets lost
+	for drained := false; !drained; {
+		drained = true
+		var request cwatchdog.CorruptWFRequest
+		if requestCh.ReceiveAsync(&request) {
+			drained = false
+			handleCorruptWorkflow(request)
+		}
+		var report cwatchdog.ChecksumFailureReport
+		if checksumFailureCh.ReceiveAsync(&report) {
+			drained = false
+			handleChecksumFailure(report)
+		}
+	}
+	return workflow.NewContinueAsNewError(ctx, watchdogWFTypeName, WorkflowParams{
+		RecentChecksumFailures: recentChecksumFailures,
+	})
 }
 
 // handleCorruptedWorkflowActivity is activity to handle corrupted workflows in DB
-func (w *Workflow) handleCorruptedWorkflow(ctx context.Context, request *CorruptWFRequest) error {
+func (w *Workflow) handleCorruptedWorkflow(ctx context.Context, request *cwatchdog.CorruptWFRequest) error {
 	logger := activity.GetLogger(ctx).With(
 		zap.String("DomainName", request.DomainName),
 		zap.String("WorkflowID", request.Workflow.GetWorkflowID()),
@@ -28,7 +28,9 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/service/worker/reshard"
 	"github.com/uber/cadence/service/worker/scanner/executions"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
 )
 
 func newAdminWorkflowCommands() []cli.Command {
@@ -96,6 +98,32 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminRefreshWorkflowTasks(c)
 			},
 		},
+		{
+			Name:    "tasks",
+			Aliases: []string{"tk"},
+			Usage:   "List the pending history queue tasks of a workflow, or retry or remove one of them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.Int64Flag{
+					Name:  FlagRetryTask,
+					Usage: "taskID of a task waiting in the redispatch backoff to retry immediately",
+				},
+				cli.Int64Flag{
+					Name:  FlagRemoveTask,
+					Usage: "taskID of a task to remove",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListWorkflowTasks(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -143,6 +171,14 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminMaintainCorruptWorkflow(c)
 			},
 		},
+		{
+			Name:    "checksum_failures",
+			Aliases: []string{"cf"},
+			Usage:   "List recent mutable state checksum failures reported to the watchdog workflow",
+			Action: func(c *cli.Context) {
+				AdminListChecksumFailures(c)
+			},
+		},
 	}
 }
 
@@ -952,6 +988,81 @@ func newDBCommands() []cli.Command {
 				AdminDBDataDecodeThrift(c)
 			},
 		},
+		{
+			Name:        "reshard",
+			Aliases:     []string{"rs"},
+			Usage:       "Move history shards between the databases of a sharded SQL store",
+			Subcommands: newAdminDBReshardCommands(),
+		},
+	}
+}
+
+func newAdminDBReshardCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "start reshard workflow",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagLowerShardBound,
+					Usage: "First history shard to move",
+				},
+				cli.IntFlag{
+					Name:  FlagUpperShardBound,
+					Usage: "Last history shard to move (inclusive)",
+				},
+				cli.IntFlag{
+					Name:  FlagTargetDBShard,
+					Usage: "Index of the database in multipleDatabasesConfig to move the history shards to",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Optional number of rows copied to the target database in one page",
+					Value: reshard.DefaultCopyPageSize,
+				},
+				cli.BoolFlag{
+					Name:  FlagDeleteSourceData,
+					Usage: "Optional to delete the rows of every moved history shard from the source database",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Optional reshard workflow timeout in seconds",
+					Value: defaultDBReshardWorkflowTimeoutInSeconds,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBReshardStart(c)
+			},
+		},
+		{
+			Name:    "status",
+			Aliases: []string{"st"},
+			Usage:   "show the progress of reshard workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional reshard workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBReshardStatus(c)
+			},
+		},
+		{
+			Name:    "abort",
+			Aliases: []string{"a"},
+			Usage:   "abort reshard workflow, the history shard being moved is served from its source database again",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional reshard workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBReshardAbort(c)
+			},
+		},
 	}
 }
 
@@ -1272,3 +1383,97 @@ func newAdminConfigStoreCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminVisibilityCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:        "migrate",
+			Aliases:     []string{"mig"},
+			Usage:       "Migrate visibility records between db and ElasticSearch",
+			Subcommands: newAdminVisibilityMigrationCommands(),
+		},
+	}
+}
+
+func newAdminVisibilityMigrationCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "start visibility migration workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceStore,
+					Usage: "Visibility store to copy the records from, db or es",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetStore,
+					Usage: "Visibility store to copy the records to, db or es",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagMigrationDomains,
+					Usage: "Optional domains to migrate, eg d1,d2..,dn. All domains are migrated if not provided",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Optional number of records read from the source store in one page",
+					Value: visibilitymigration.DefaultPageSize,
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Optional number of records written to or verified against the target store per second",
+					Value: visibilitymigration.DefaultRPS,
+				},
+				cli.IntFlag{
+					Name:  FlagVerificationDelay,
+					Usage: "Optional wait time in seconds between copying and verifying the records",
+					Value: int(visibilitymigration.DefaultVerificationDelay / time.Second),
+				},
+				cli.BoolFlag{
+					Name:  FlagVerifyOnly,
+					Usage: "Optional to only verify the records of the source store against the target store without copying",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Optional visibility migration workflow timeout in seconds",
+					Value: defaultVisibilityMigrationWorkflowTimeoutInSeconds,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVisibilityMigrationStart(c)
+			},
+		},
+		{
+			Name:    "status",
+			Aliases: []string{"st"},
+			Usage:   "show the progress and the verification report of visibility migration workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional visibility migration workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVisibilityMigrationStatus(c)
+			},
+		},
+		{
+			Name:    "abort",
+			Aliases: []string{"a"},
+			Usage:   "abort visibility migration workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional visibility migration workflow runID, default is latest runID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Optional reason why abort",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVisibilityMigrationAbort(c)
+			},
+		},
+	}
+}
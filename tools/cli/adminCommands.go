@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"strconv"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/common/watchdog"
 )
 
 const (
@@ -210,6 +212,42 @@ func AdminMaintainCorruptWorkflow(c *cli.Context) error {
 	return err
 }
 
+// AdminListChecksumFailures lists the recent mutable state checksum failures kept by the watchdog workflow
+func AdminListChecksumFailures(c *cli.Context) {
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	queryResp, err := client.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: common.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: watchdog.WatchdogWFID,
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: watchdog.ChecksumFailuresQueryType,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to query watchdog workflow", err)
+	}
+	if queryResp.GetQueryResult() == nil {
+		ErrorAndExit("QueryResult has no value", nil)
+	}
+	var reports []watchdog.ChecksumFailureReport
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &reports); err != nil {
+		ErrorAndExit("Unable to deserialize QueryResult", err)
+	}
+	if len(reports) == 0 {
+		fmt.Println("No checksum failures reported")
+		return
+	}
+	// most recent failures first
+	for i, j := 0, len(reports)-1; i < j; i, j = i+1, j-1 {
+		reports[i], reports[j] = reports[j], reports[i]
+	}
+	prettyPrintJSONObject(reports)
+}
+
 // AdminDeleteWorkflow delete a workflow execution for admin
 func AdminDeleteWorkflow(c *cli.Context) {
 	domain := getRequiredGlobalOption(c, FlagDomain)
@@ -576,6 +614,131 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 	}
 }
 
+type workflowTaskRow struct {
+	Queue          string `header:"Queue"`
+	TaskID         int64  `header:"Task ID"`
+	TaskType       int32  `header:"Task Type"`
+	VisibilityTime string `header:"Visibility Time"`
+	Cluster        string `header:"Cluster"`
+	State          string `header:"State"`
+	Loaded         bool   `header:"Loaded"`
+	Attempt        int32  `header:"Attempt"`
+	RedispatchTime string `header:"Redispatch Time"`
+}
+
+// AdminListWorkflowTasks lists the pending history queue tasks of a workflow,
+// or retries or removes one of them
+func AdminListWorkflowTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	if c.IsSet(FlagRetryTask) && c.IsSet(FlagRemoveTask) {
+		ErrorAndExit(fmt.Sprintf("Only one of %s and %s can be specified", FlagRetryTask, FlagRemoveTask), nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListWorkflowTasks(ctx, &types.ListWorkflowTasksRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to list workflow tasks", err)
+	}
+
+	switch {
+	case c.IsSet(FlagRetryTask):
+		task := findWorkflowTask(resp, c.Int64(FlagRetryTask))
+		err = adminClient.RetryTask(ctx, &types.RetryTaskRequest{
+			ShardID:             resp.GetShardID(),
+			Type:                task.Type,
+			TaskID:              task.GetTaskID(),
+			VisibilityTimestamp: task.VisibilityTimestamp,
+			ClusterName:         task.GetClusterName(),
+		})
+		if err != nil {
+			ErrorAndExit("Retry task has failed", err)
+		}
+		fmt.Println("Retry task succeeded")
+	case c.IsSet(FlagRemoveTask):
+		task := findWorkflowTask(resp, c.Int64(FlagRemoveTask))
+		err = adminClient.RemoveTask(ctx, &types.RemoveTaskRequest{
+			ShardID:             resp.GetShardID(),
+			Type:                task.Type,
+			TaskID:              task.GetTaskID(),
+			VisibilityTimestamp: task.VisibilityTimestamp,
+			ClusterName:         task.GetClusterName(),
+		})
+		if err != nil {
+			ErrorAndExit("Remove task has failed", err)
+		}
+		fmt.Println("Remove task succeeded")
+	default:
+		fmt.Printf("Shard ID: %v\n", resp.GetShardID())
+		if resp.GetTruncated() {
+			fmt.Println("The shard has too many pending tasks to scan, only the tasks found in the scanned ones are listed")
+		}
+		if len(resp.GetTasks()) == 0 {
+			fmt.Println("No pending tasks")
+			return
+		}
+		table := make([]workflowTaskRow, 0, len(resp.GetTasks()))
+		for _, task := range resp.GetTasks() {
+			row := workflowTaskRow{
+				Queue:          queueTypeName(task.Type),
+				TaskID:         task.GetTaskID(),
+				TaskType:       task.TaskType,
+				VisibilityTime: convertTime(task.GetVisibilityTimestamp(), false),
+				Cluster:        task.GetClusterName(),
+				State:          task.GetState().String(),
+				Loaded:         task.Loaded,
+				Attempt:        task.Attempt,
+			}
+			if task.RedispatchTimestamp != nil {
+				row.RedispatchTime = convertTime(*task.RedispatchTimestamp, false)
+			}
+			table = append(table, row)
+		}
+		RenderTable(os.Stdout, table, RenderOptions{Color: true, Border: true})
+	}
+}
+
+func findWorkflowTask(resp *types.ListWorkflowTasksResponse, taskID int64) *types.QueueTaskInfo {
+	for _, task := range resp.GetTasks() {
+		if task.GetTaskID() == taskID {
+			return task
+		}
+	}
+	if resp.GetTruncated() {
+		ErrorAndExit(fmt.Sprintf("Task %v is not found in the scanned pending tasks of the shard", taskID), nil)
+	}
+	ErrorAndExit(fmt.Sprintf("Task %v is not a pending task of the workflow", taskID), nil)
+	return nil
+}
+
+func queueTypeName(queueType *int32) string {
+	if queueType == nil {
+		return ""
+	}
+	switch common.TaskType(*queueType) {
+	case common.TaskTypeTransfer:
+		return "transfer"
+	case common.TaskTypeTimer:
+		return "timer"
+	case common.TaskTypeReplication:
+		return "replication"
+	case common.TaskTypeCrossCluster:
+		return "cross-cluster"
+	}
+	return strconv.Itoa(int(*queueType))
+}
+
 // AdminResetQueue resets task processing queue states
 func AdminResetQueue(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -0,0 +1,153 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/reshard"
+)
+
+const (
+	defaultDBReshardWorkflowTimeoutInSeconds = 7 * 24 * 3600
+)
+
+// AdminDBReshardStart starts the reshard workflow
+func AdminDBReshardStart(c *cli.Context) {
+	params := reshard.ReshardParams{
+		FirstHistoryShardID: getRequiredIntOption(c, FlagLowerShardBound),
+		LastHistoryShardID:  getRequiredIntOption(c, FlagUpperShardBound),
+		TargetDBShardID:     getRequiredIntOption(c, FlagTargetDBShard),
+		CopyPageSize:        c.Int(FlagPageSize),
+		DeleteSourceData:    c.Bool(FlagDeleteSourceData),
+	}
+	if params.FirstHistoryShardID > params.LastHistoryShardID {
+		ErrorAndExit("lower_shard_bound is greater than upper_shard_bound", nil)
+	}
+	workflowTimeout := c.Int(FlagExecutionTimeout)
+	if workflowTimeout <= 0 {
+		workflowTimeout = defaultDBReshardWorkflowTimeoutInSeconds
+	}
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		common.MemoKeyForOperator: getOperator(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize memo", err)
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		ErrorAndExit("Failed to serialize reshard params", err)
+	}
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		RequestID:                           uuid.New(),
+		WorkflowID:                          reshard.WorkflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: reshard.TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(workflowTimeout)),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: reshard.WorkflowTypeName},
+	}
+	wf, err := client.StartWorkflowExecution(tcCtx, request)
+	if err != nil {
+		ErrorAndExit("Failed to start reshard workflow", err)
+	}
+	fmt.Println("Reshard workflow started")
+	fmt.Println("wid: " + reshard.WorkflowID)
+	fmt.Println("rid: " + wf.GetRunID())
+}
+
+// AdminDBReshardStatus shows the progress of the reshard workflow
+func AdminDBReshardStatus(c *cli.Context) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	execution := &types.WorkflowExecution{
+		WorkflowID: reshard.WorkflowID,
+		RunID:      getRunID(c),
+	}
+	descResp, err := client.DescribeWorkflowExecution(tcCtx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    common.SystemLocalDomainName,
+		Execution: execution,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe reshard workflow", err)
+	}
+	if closeStatus := descResp.GetWorkflowExecutionInfo().CloseStatus; closeStatus != nil {
+		fmt.Printf("Reshard workflow is closed with status %v\n", closeStatus.String())
+	} else {
+		fmt.Println("Reshard workflow is running")
+	}
+
+	queryResp, err := client.QueryWorkflow(tcCtx, &types.QueryWorkflowRequest{
+		Domain:    common.SystemLocalDomainName,
+		Execution: execution,
+		Query: &types.WorkflowQuery{
+			QueryType: reshard.QueryType,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to query reshard workflow", err)
+	}
+	if queryResp.GetQueryResult() == nil {
+		ErrorAndExit("QueryResult has no value", nil)
+	}
+	var queryResult reshard.QueryResult
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &queryResult); err != nil {
+		ErrorAndExit("Unable to deserialize QueryResult", err)
+	}
+	prettyPrintJSONObject(queryResult)
+}
+
+// AdminDBReshardAbort cancels the reshard workflow, unlike terminating it this lets the workflow
+// abort the move of the current history shard so that it is served from the source database again
+func AdminDBReshardAbort(c *cli.Context) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	err := client.RequestCancelWorkflowExecution(tcCtx, &types.RequestCancelWorkflowExecutionRequest{
+		Domain: common.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: reshard.WorkflowID,
+			RunID:      getRunID(c),
+		},
+		Identity:  getCliIdentity(),
+		RequestID: uuid.New(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to abort reshard workflow", err)
+	}
+	fmt.Println("Reshard abort requested, the move of the current history shard is rolled back")
+}
@@ -23,6 +23,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/urfave/cli"
@@ -43,6 +44,10 @@ type (
 		StartID   int64 `header:"Lease Start TaskID"`
 		EndID     int64 `header:"Lease End TaskID"`
 	}
+	TaskListPriorityBacklogRow struct {
+		Priority int32 `header:"Priority"`
+		Backlog  int64 `header:"Backlog"`
+	}
 )
 
 // AdminDescribeTaskList displays poller and status information of task list.
@@ -119,4 +124,18 @@ func printTaskListStatus(taskListStatus *types.TaskListStatus) {
 		EndID:     taskListStatus.GetTaskIDBlock().GetEndID(),
 	}}
 	RenderTable(os.Stdout, table, RenderOptions{Color: true})
+
+	backlogByPriority := taskListStatus.GetBacklogCountHintByPriority()
+	if len(backlogByPriority) == 0 {
+		return
+	}
+	priorityTable := make([]TaskListPriorityBacklogRow, 0, len(backlogByPriority))
+	for priority, backlog := range backlogByPriority {
+		priorityTable = append(priorityTable, TaskListPriorityBacklogRow{Priority: priority, Backlog: backlog})
+	}
+	sort.Slice(priorityTable, func(i, j int) bool {
+		return priorityTable[i].Priority > priorityTable[j].Priority
+	})
+	fmt.Printf("\n")
+	RenderTable(os.Stdout, priorityTable, RenderOptions{Color: true})
 }
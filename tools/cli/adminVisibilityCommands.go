@@ -0,0 +1,168 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
+)
+
+const (
+	defaultVisibilityMigrationAbortReason              = "Visibility migration aborted through admin CLI"
+	defaultVisibilityMigrationWorkflowTimeoutInSeconds = 30 * 24 * 3600
+)
+
+// AdminVisibilityMigrationStart starts the visibility migration workflow
+func AdminVisibilityMigrationStart(c *cli.Context) {
+	params := visibilitymigration.MigrationParams{
+		SourceStore:       getRequiredOption(c, FlagSourceStore),
+		TargetStore:       getRequiredOption(c, FlagTargetStore),
+		Domains:           c.StringSlice(FlagMigrationDomains),
+		PageSize:          c.Int(FlagPageSize),
+		RPS:               c.Int(FlagRPS),
+		VerificationDelay: time.Duration(c.Int(FlagVerificationDelay)) * time.Second,
+		VerifyOnly:        c.Bool(FlagVerifyOnly),
+	}
+	if params.SourceStore == params.TargetStore {
+		ErrorAndExit("target_store is same as source_store", nil)
+	}
+	workflowTimeout := c.Int(FlagExecutionTimeout)
+	if workflowTimeout <= 0 {
+		workflowTimeout = defaultVisibilityMigrationWorkflowTimeoutInSeconds
+	}
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		common.MemoKeyForOperator: getOperator(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize memo", err)
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		ErrorAndExit("Failed to serialize visibility migration params", err)
+	}
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		RequestID:                           uuid.New(),
+		WorkflowID:                          visibilitymigration.WorkflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: visibilitymigration.TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(workflowTimeout)),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: visibilitymigration.WorkflowTypeName},
+	}
+	wf, err := client.StartWorkflowExecution(tcCtx, request)
+	if err != nil {
+		ErrorAndExit("Failed to start visibility migration workflow", err)
+	}
+	fmt.Println("Visibility migration workflow started")
+	fmt.Println("wid: " + visibilitymigration.WorkflowID)
+	fmt.Println("rid: " + wf.GetRunID())
+}
+
+// AdminVisibilityMigrationStatus shows the progress and the verification report of the visibility migration workflow
+func AdminVisibilityMigrationStatus(c *cli.Context) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	execution := &types.WorkflowExecution{
+		WorkflowID: visibilitymigration.WorkflowID,
+		RunID:      getRunID(c),
+	}
+	descResp, err := client.DescribeWorkflowExecution(tcCtx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    common.SystemLocalDomainName,
+		Execution: execution,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe visibility migration workflow", err)
+	}
+	if closeStatus := descResp.GetWorkflowExecutionInfo().CloseStatus; closeStatus != nil {
+		fmt.Printf("Visibility migration workflow is closed with status %v\n", closeStatus.String())
+	} else {
+		fmt.Println("Visibility migration workflow is running")
+	}
+
+	queryResp, err := client.QueryWorkflow(tcCtx, &types.QueryWorkflowRequest{
+		Domain:    common.SystemLocalDomainName,
+		Execution: execution,
+		Query: &types.WorkflowQuery{
+			QueryType: visibilitymigration.QueryType,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to query visibility migration workflow", err)
+	}
+	if queryResp.GetQueryResult() == nil {
+		ErrorAndExit("QueryResult has no value", nil)
+	}
+	var queryResult visibilitymigration.QueryResult
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &queryResult); err != nil {
+		ErrorAndExit("Unable to deserialize QueryResult", err)
+	}
+	prettyPrintJSONObject(queryResult)
+	if queryResult.Phase != visibilitymigration.PhaseCompleted || queryResult.Report == nil {
+		return
+	}
+	if queryResult.Report.HasDiffs() {
+		fmt.Println("Some records are missing or mismatched in the target store, it's not safe to switch the read mode yet")
+	} else {
+		fmt.Println("All records are verified in the target store")
+	}
+}
+
+// AdminVisibilityMigrationAbort aborts the visibility migration workflow
+func AdminVisibilityMigrationAbort(c *cli.Context) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	reason := c.String(FlagReason)
+	if len(reason) == 0 {
+		reason = defaultVisibilityMigrationAbortReason
+	}
+	err := client.TerminateWorkflowExecution(tcCtx, &types.TerminateWorkflowExecutionRequest{
+		Domain: common.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: visibilitymigration.WorkflowID,
+			RunID:      getRunID(c),
+		},
+		Reason:   reason,
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to abort visibility migration workflow", err)
+	}
+	fmt.Println("Visibility migration aborted")
+}
@@ -181,6 +181,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "visibility",
+					Aliases:     []string{"vis"},
+					Usage:       "Run admin operation on visibility",
+					Subcommands: newAdminVisibilityCommands(),
+				},
 			},
 		},
 		{
@@ -189,6 +195,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence cluster",
 			Subcommands: newClusterCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sch"},
+			Usage:       "Operate cadence schedule",
+			Subcommands: newScheduleCommands(),
+		},
 	}
 
 	// set builder if not customized
@@ -21,6 +21,9 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -31,12 +34,15 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/watchdog"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 type cliAppSuite struct {
@@ -623,6 +629,136 @@ func (s *cliAppSuite) TestAdminFailover() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminVisibilityMigration() {
+	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "vis", "migrate", "start", "--source_store", "db", "--target_store", "es", "--domains", "d1"})
+	s.Nil(err)
+
+	s.serverFrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	err = s.app.Run([]string{"", "admin", "vis", "migrate", "abort"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDBReshard() {
+	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "db", "reshard", "start", "--lower_shard_bound", "0", "--upper_shard_bound", "3", "--target_db_shard", "2"})
+	s.Nil(err)
+
+	s.serverFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	err = s.app.Run([]string{"", "admin", "db", "reshard", "abort"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListChecksumFailures() {
+	result, err := json.Marshal([]watchdog.ChecksumFailureReport{
+		{
+			Workflow:   types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+			DomainName: domainName,
+			Mismatch:   "checksum mismatch error",
+			Diffs:      []watchdog.ChecksumFieldDiff{{Field: "NextEventID", Before: "10", After: "12"}},
+		},
+	})
+	s.NoError(err)
+	s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{
+		QueryResult: result,
+	}, nil)
+	err = s.app.Run([]string{"", "admin", "workflow", "checksum_failures"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListWorkflowTasks() {
+	resp := &types.ListWorkflowTasksResponse{
+		ShardID: 1,
+		Tasks: []*types.QueueTaskInfo{
+			{
+				Type:                common.Int32Ptr(int32(common.TaskTypeTimer)),
+				TaskID:              10,
+				VisibilityTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+				ClusterName:         "active",
+				State:               types.QueueTaskStateBackoff.Ptr(),
+				Loaded:              true,
+				Attempt:             3,
+				RedispatchTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			},
+		},
+	}
+	s.serverAdminClient.EXPECT().ListWorkflowTasks(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "workflow", "tasks", "--wid", "wid"})
+	s.Nil(err)
+
+	s.serverAdminClient.EXPECT().ListWorkflowTasks(gomock.Any(), gomock.Any()).Return(resp, nil)
+	s.serverAdminClient.EXPECT().RetryTask(gomock.Any(), &types.RetryTaskRequest{
+		ShardID:             1,
+		Type:                resp.Tasks[0].Type,
+		TaskID:              10,
+		VisibilityTimestamp: resp.Tasks[0].VisibilityTimestamp,
+		ClusterName:         "active",
+	}).Return(nil)
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "workflow", "tasks", "--wid", "wid", "--retry_task", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule() {
+	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "create", "--sid", "sid", "--cron", "* * * * *", "-wt", "wt", "-tl", "tl"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule_Failed_InvalidCron() {
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "--sid", "sid", "--cron", "invalid", "-wt", "wt", "-tl", "tl"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestCreateSchedule_Failed_InvalidPolicies() {
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "--sid", "sid", "--cron", "* * * * *", "-wt", "wt", "-tl", "tl", "--op", "invalid"})
+	s.Equal(1, errorCode)
+	errorCode = s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "--sid", "sid", "--cron", "* * * * *", "-wt", "wt", "-tl", "tl", "--cuw", "-10"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUpdateSchedule() {
+	result, err := json.Marshal(scheduler.ScheduleDescription{
+		Domain:     domainName,
+		ScheduleID: "sid",
+		Spec:       scheduler.ScheduleSpec{CronSchedule: "* * * * *"},
+		Action:     scheduler.StartWorkflowAction{WorkflowType: "wt", TaskList: "tl"},
+	})
+	s.NoError(err)
+	s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{
+		QueryResult: result,
+	}, nil).Times(3)
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err = s.app.Run([]string{"", "--do", domainName, "schedule", "update", "--sid", "sid", "--cron", "0 * * * *"})
+	s.Nil(err)
+
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "update", "--sid", "sid", "--cron", "invalid"})
+	s.Equal(1, errorCode)
+	errorCode = s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "update", "--sid", "sid", "--op", "invalid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestListSchedules() {
+	resp := &types.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{
+				Execution: &types.WorkflowExecution{WorkflowID: scheduler.GetWorkflowID(domainName, "sid")},
+				StartTime: common.Int64Ptr(time.Now().UnixNano()),
+			},
+		},
+	}
+	s.serverFrontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.ListWorkflowExecutionsRequest, _ ...yarpc.CallOption) (*types.ListWorkflowExecutionsResponse, error) {
+			s.Equal(common.SchedulerLocalDomainName, request.GetDomain())
+			s.Contains(request.GetQuery(), fmt.Sprintf("CustomDomain = '%v'", domainName))
+			return resp, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "list"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -637,6 +773,27 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestListTaskListPartitions() {
+	resp := &types.ListTaskListPartitionsResponse{
+		DecisionTaskListPartitions: []*types.TaskListPartitionMetadata{
+			{Key: "test-taskList", OwnerHostName: "host1"},
+			{Key: "/__cadence_sys/test-taskList/1", OwnerHostName: "host2"},
+		},
+	}
+	describeResp := &types.DescribeTaskListResponse{
+		PartitionConfig: &types.TaskListPartitionConfig{
+			Version:            2,
+			NumReadPartitions:  2,
+			NumWritePartitions: 1,
+		},
+	}
+	s.serverFrontendClient.EXPECT().ListTaskListPartitions(gomock.Any(), gomock.Any()).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(&types.DescribeTaskListResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "list-partition", "-tl", "test-taskList"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
@@ -789,13 +946,13 @@ func (s *cliAppSuite) TestAnyToString_DecodeMapValues() {
 	execution := &types.WorkflowExecutionInfo{
 		Memo: &types.Memo{Fields: fields},
 	}
-	s.Equal("{HistoryLength:0, Memo:{Fields:map{TestKey:testValue}}, IsCron:false}", anyToString(execution, true, 0))
+	s.Equal("{HistoryLength:0, Memo:{Fields:map{TestKey:testValue}}, IsCron:false, Paused:false}", anyToString(execution, true, 0))
 
 	fields["TestKey2"] = []byte(`anotherTestValue`)
 	execution.Memo = &types.Memo{Fields: fields}
 	got := anyToString(execution, true, 0)
-	expected := got == "{HistoryLength:0, Memo:{Fields:map{TestKey2:anotherTestValue, TestKey:testValue}}, IsCron:false}" ||
-		got == "{HistoryLength:0, Memo:{Fields:map{TestKey:testValue, TestKey2:anotherTestValue}}, IsCron:false}"
+	expected := got == "{HistoryLength:0, Memo:{Fields:map{TestKey2:anotherTestValue, TestKey:testValue}}, IsCron:false, Paused:false}" ||
+		got == "{HistoryLength:0, Memo:{Fields:map{TestKey:testValue, TestKey2:anotherTestValue}}, IsCron:false, Paused:false}"
 	s.True(expected)
 }
 
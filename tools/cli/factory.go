@@ -44,6 +44,7 @@ import (
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
@@ -115,6 +116,7 @@ func (b *clientFactory) ServerFrontendClient(c *cli.Context) frontend.Client {
 			apiv1.NewWorkflowAPIYARPCClient(clientConfig),
 			apiv1.NewWorkerAPIYARPCClient(clientConfig),
 			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
+			frontendv1.NewWorkflowAPIYARPCClient(clientConfig),
 		)
 	}
 	return frontend.NewThriftClient(serverFrontend.New(clientConfig))
@@ -223,6 +223,8 @@ const (
 	FlagTaskID                            = "task_id"
 	FlagTaskType                          = "task_type"
 	FlagTaskVisibilityTimestamp           = "task_timestamp"
+	FlagRetryTask                         = "retry_task"
+	FlagRemoveTask                        = "remove_task"
 	FlagQueueType                         = "queue_type"
 	FlagStartingRPS                       = "starting_rps"
 	FlagRPS                               = "rps"
@@ -284,6 +286,7 @@ const (
 	FlagBucketSize                        = "bucket_size"
 	DelayStartSeconds                     = "delay_start_seconds"
 	JitterStartSeconds                    = "jitter_start_seconds"
+	FlagPriority                          = "priority"
 	FlagConnectionAttributes              = "conn_attrs"
 	FlagJWT                               = "jwt"
 	FlagJWTPrivateKey                     = "jwt-private-key"
@@ -294,6 +297,26 @@ const (
 	FlagTransport                         = "transport"
 	FlagTransportWithAlias                = FlagTransport + ", t"
 	FlagFormat                            = "format"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sid"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", op"
+	FlagCatchUpWindow                     = "catch_up_window_seconds"
+	FlagCatchUpWindowWithAlias            = FlagCatchUpWindow + ", cuw"
+	FlagMaxBufferedRuns                   = "max_buffered_runs"
+	FlagMaxBufferedRunsWithAlias          = FlagMaxBufferedRuns + ", mbr"
+	FlagJitter                            = "jitter_seconds"
+	FlagStartTime                         = "start_time"
+	FlagEndTime                           = "end_time"
+	FlagWorkflowIDPrefix                  = "workflow_id_prefix"
+	FlagWorkflowIDPrefixWithAlias         = FlagWorkflowIDPrefix + ", wip"
+	FlagSourceStore                       = "source_store"
+	FlagTargetStore                       = "target_store"
+	FlagMigrationDomains                  = "domains"
+	FlagVerificationDelay                 = "verification_delay_seconds"
+	FlagVerifyOnly                        = "verify_only"
+	FlagTargetDBShard                     = "target_db_shard"
+	FlagDeleteSourceData                  = "delete_source_data"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -477,6 +500,10 @@ func getFlagsForStart() []cli.Flag {
 			Name:  JitterStartSeconds,
 			Usage: "Optional workflow start jitter in seconds. If set, workflow start will be jittered between 0-n seconds (after delay)",
 		},
+		cli.IntFlag{
+			Name:  FlagPriority,
+			Usage: "Optional workflow priority, higher values are dispatched first from the task list backlog. Not supported by signal with start",
+		},
 	}
 }
 
@@ -0,0 +1,262 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/service/worker/scheduler"
+)
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule which starts a workflow on a cron schedule",
+			Flags: append(
+				getFlagsForScheduleSpec(),
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID, unique within the domain",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "WorkflowTypeName of the scheduled workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList of the scheduled workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Optional input for the scheduled workflows, in JSON format",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Optional execution start to close timeout in seconds of the scheduled workflows",
+				},
+				cli.IntFlag{
+					Name:  FlagDecisionTimeoutWithAlias,
+					Value: defaultDecisionTimeoutInSeconds,
+					Usage: "Decision task start to close timeout in seconds of the scheduled workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDPrefixWithAlias,
+					Usage: "Optional prefix of the WorkflowID of the scheduled workflows, followed by the scheduled time. Default to the ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Value: scheduler.OverlapPolicySkip,
+					Usage: "Policy when the previous workflow is still running. Policies supported: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+				},
+				cli.IntFlag{
+					Name:  FlagCatchUpWindowWithAlias,
+					Value: int(scheduler.DefaultCatchUpWindow.Seconds()),
+					Usage: "Scheduled times missed by more than this window in seconds are skipped",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxBufferedRunsWithAlias,
+					Value: scheduler.DefaultMaxBufferedRuns,
+					Usage: "Max number of scheduled times waiting to be started, scheduled times over it are dropped",
+				},
+			),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update a schedule, only the provided options are changed",
+			Flags: append(
+				getFlagsForScheduleSpec(),
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "WorkflowTypeName of the scheduled workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList of the scheduled workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the scheduled workflows, in JSON format",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Execution start to close timeout in seconds of the scheduled workflows",
+				},
+				cli.IntFlag{
+					Name:  FlagDecisionTimeoutWithAlias,
+					Usage: "Decision task start to close timeout in seconds of the scheduled workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDPrefixWithAlias,
+					Usage: "Prefix of the WorkflowID of the scheduled workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Policy when the previous workflow is still running. Policies supported: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+				},
+				cli.IntFlag{
+					Name:  FlagCatchUpWindowWithAlias,
+					Usage: "Scheduled times missed by more than this window in seconds are skipped",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxBufferedRunsWithAlias,
+					Usage: "Max number of scheduled times waiting to be started, scheduled times over it are dropped",
+				},
+			),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule, no workflow is started until the schedule is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to pause the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseSchedule(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule, the scheduled times missed while paused are not started",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to unpause the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Start the workflows of all the scheduled times in a time range",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Start of the time range (exclusive), supported formats are '2006-01-02T15:04:05Z', time range or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "End of the time range (inclusive), supported formats are '2006-01-02T15:04:05Z', time range or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional policy for the back-filled workflows, default to the policy of the schedule. Policies supported: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+				},
+			},
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, the started workflows are not affected",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a domain",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListSchedules(c)
+			},
+		},
+	}
+}
+
+func getFlagsForScheduleSpec() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagCronSchedule,
+			Usage: "Cron schedule of the workflows, in standard cron format and evaluated in UTC",
+		},
+		cli.StringFlag{
+			Name:  FlagStartTime,
+			Usage: "Optional earliest time a workflow can be scheduled at, in format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagEndTime,
+			Usage: "Optional latest time a workflow can be scheduled at, in format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.IntFlag{
+			Name:  FlagJitter,
+			Usage: "Optional upper bound in seconds of the random delay added to every scheduled time",
+		},
+	}
+}
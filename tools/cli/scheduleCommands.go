@@ -0,0 +1,364 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	params := scheduler.ScheduleParams{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       getScheduleSpec(c),
+		Action: scheduler.StartWorkflowAction{
+			WorkflowType:                        getRequiredOption(c, FlagWorkflowType),
+			TaskList:                            getRequiredOption(c, FlagTaskList),
+			Input:                               []byte(processJSONInput(c)),
+			WorkflowIDPrefix:                    c.String(FlagWorkflowIDPrefix),
+			ExecutionStartToCloseTimeoutSeconds: int32(c.Int(FlagExecutionTimeout)),
+			TaskStartToCloseTimeoutSeconds:      int32(c.Int(FlagDecisionTimeout)),
+		},
+		Policies: scheduler.SchedulePolicies{
+			OverlapPolicy:   c.String(FlagOverlapPolicy),
+			CatchUpWindow:   time.Duration(c.Int(FlagCatchUpWindow)) * time.Second,
+			MaxBufferedRuns: c.Int(FlagMaxBufferedRuns),
+		},
+	}
+	if err := scheduler.ValidateParams(params); err != nil {
+		ErrorAndExit("Invalid schedule.", err)
+		return
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		ErrorAndExit("Failed to serialize schedule.", err)
+	}
+	searchAttributes, err := serializeSearchAttributes(map[string]interface{}{
+		"CustomDomain": domain,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to encode schedule search attributes.", err)
+	}
+
+	reusePolicy := types.WorkflowIDReusePolicyAllowDuplicate
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = serviceClient.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SchedulerLocalDomainName,
+		WorkflowID:                          scheduler.GetWorkflowID(domain, scheduleID),
+		WorkflowType:                        &types.WorkflowType{Name: scheduler.WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: scheduler.TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(scheduler.InfiniteDuration.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Identity:                            getCliIdentity(),
+		RequestID:                           uuid.New(),
+		WorkflowIDReusePolicy:               &reusePolicy,
+		SearchAttributes:                    searchAttributes,
+	})
+	if err != nil {
+		if _, ok := err.(*types.WorkflowExecutionAlreadyStartedError); ok {
+			ErrorAndExit(fmt.Sprintf("Schedule %v already exists.", scheduleID), nil)
+		}
+		ErrorAndExit("Create schedule failed.", err)
+	}
+	fmt.Println("Create schedule succeeded.")
+}
+
+// DescribeSchedule describes a schedule
+func DescribeSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	prettyPrintJSONObject(describeSchedule(c, domain, scheduleID))
+}
+
+// UpdateSchedule updates the spec, action or policies of a schedule
+func UpdateSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	// fields which are not provided are kept as is
+	current := describeSchedule(c, domain, scheduleID)
+	request := scheduler.UpdateRequest{}
+	if c.IsSet(FlagCronSchedule) || c.IsSet(FlagStartTime) || c.IsSet(FlagEndTime) || c.IsSet(FlagJitter) {
+		spec := current.Spec
+		if c.IsSet(FlagCronSchedule) {
+			spec.CronSchedule = c.String(FlagCronSchedule)
+		}
+		if c.IsSet(FlagStartTime) {
+			spec.StartTime = parseScheduleTime(c.String(FlagStartTime))
+		}
+		if c.IsSet(FlagEndTime) {
+			spec.EndTime = parseScheduleTime(c.String(FlagEndTime))
+		}
+		if c.IsSet(FlagJitter) {
+			spec.Jitter = time.Duration(c.Int(FlagJitter)) * time.Second
+		}
+		request.Spec = &spec
+	}
+	if c.IsSet(FlagWorkflowType) || c.IsSet(FlagTaskList) || c.IsSet(FlagInput) || c.IsSet(FlagExecutionTimeout) ||
+		c.IsSet(FlagDecisionTimeout) || c.IsSet(FlagWorkflowIDPrefix) {
+		action := current.Action
+		if c.IsSet(FlagWorkflowType) {
+			action.WorkflowType = c.String(FlagWorkflowType)
+		}
+		if c.IsSet(FlagTaskList) {
+			action.TaskList = c.String(FlagTaskList)
+		}
+		if c.IsSet(FlagInput) {
+			action.Input = []byte(processJSONInput(c))
+		}
+		if c.IsSet(FlagExecutionTimeout) {
+			action.ExecutionStartToCloseTimeoutSeconds = int32(c.Int(FlagExecutionTimeout))
+		}
+		if c.IsSet(FlagDecisionTimeout) {
+			action.TaskStartToCloseTimeoutSeconds = int32(c.Int(FlagDecisionTimeout))
+		}
+		if c.IsSet(FlagWorkflowIDPrefix) {
+			action.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
+		}
+		request.Action = &action
+	}
+	if c.IsSet(FlagOverlapPolicy) || c.IsSet(FlagCatchUpWindow) || c.IsSet(FlagMaxBufferedRuns) {
+		policies := current.Policies
+		if c.IsSet(FlagOverlapPolicy) {
+			policies.OverlapPolicy = c.String(FlagOverlapPolicy)
+		}
+		if c.IsSet(FlagCatchUpWindow) {
+			policies.CatchUpWindow = time.Duration(c.Int(FlagCatchUpWindow)) * time.Second
+		}
+		if c.IsSet(FlagMaxBufferedRuns) {
+			policies.MaxBufferedRuns = c.Int(FlagMaxBufferedRuns)
+		}
+		request.Policies = &policies
+	}
+	if request.Spec == nil && request.Action == nil && request.Policies == nil {
+		ErrorAndExit("Nothing to update.", nil)
+	}
+	if err := validateScheduleUpdate(current, request); err != nil {
+		ErrorAndExit("Invalid schedule update.", err)
+		return
+	}
+
+	signalSchedule(c, domain, scheduleID, scheduler.UpdateSignal, request)
+	fmt.Println("Update schedule succeeded.")
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	signalSchedule(c, domain, scheduleID, scheduler.PauseSignal, scheduler.PauseRequest{Reason: c.String(FlagReason)})
+	fmt.Println("Pause schedule succeeded.")
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	signalSchedule(c, domain, scheduleID, scheduler.UnpauseSignal, scheduler.PauseRequest{Reason: c.String(FlagReason)})
+	fmt.Println("Unpause schedule succeeded.")
+}
+
+// BackfillSchedule starts the workflows of all the scheduled times in a time range
+func BackfillSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	request := scheduler.BackfillRequest{
+		StartTime:     parseScheduleTime(getRequiredOption(c, FlagStartTime)),
+		EndTime:       parseScheduleTime(getRequiredOption(c, FlagEndTime)),
+		OverlapPolicy: c.String(FlagOverlapPolicy),
+	}
+	if !request.EndTime.After(request.StartTime) {
+		ErrorAndExit("End time must be after start time.", nil)
+	}
+
+	signalSchedule(c, domain, scheduleID, scheduler.BackfillSignal, request)
+	fmt.Println("Backfill schedule succeeded.")
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	signalSchedule(c, domain, scheduleID, scheduler.DeleteSignal, nil)
+	fmt.Println("Delete schedule succeeded.")
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	pageSize := c.Int(FlagPageSize)
+
+	table := []ScheduleRow{}
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		response, err := serviceClient.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+			Domain:        common.SchedulerLocalDomainName,
+			PageSize:      int32(pageSize),
+			NextPageToken: nextPageToken,
+			Query: fmt.Sprintf("WorkflowType = '%v' AND CustomDomain = '%v' AND CloseTime = missing",
+				scheduler.WorkflowTypeName, domain),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("List schedules failed.", err)
+		}
+		for _, execution := range response.Executions {
+			_, scheduleID, ok := scheduler.ParseWorkflowID(execution.GetExecution().GetWorkflowID())
+			if !ok {
+				continue
+			}
+			table = append(table, ScheduleRow{
+				ScheduleID: scheduleID,
+				StartTime:  time.Unix(0, execution.GetStartTime()),
+			})
+		}
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	RenderTable(os.Stdout, table, RenderOptions{Color: true, Border: true})
+}
+
+// ScheduleRow is a row of the schedule list table
+type ScheduleRow struct {
+	ScheduleID string    `header:"Schedule ID"`
+	StartTime  time.Time `header:"Create Time"`
+}
+
+func getScheduleSpec(c *cli.Context) scheduler.ScheduleSpec {
+	spec := scheduler.ScheduleSpec{
+		CronSchedule: getRequiredOption(c, FlagCronSchedule),
+		Jitter:       time.Duration(c.Int(FlagJitter)) * time.Second,
+	}
+	if c.IsSet(FlagStartTime) {
+		spec.StartTime = parseScheduleTime(c.String(FlagStartTime))
+	}
+	if c.IsSet(FlagEndTime) {
+		spec.EndTime = parseScheduleTime(c.String(FlagEndTime))
+	}
+	return spec
+}
+
+// validateScheduleUpdate validates the schedule as it would be after the update, the schedule
+// workflow ignores invalid updates so they must be rejected before the signal is sent
+func validateScheduleUpdate(current *scheduler.ScheduleDescription, request scheduler.UpdateRequest) error {
+	params := scheduler.ScheduleParams{
+		Domain:     current.Domain,
+		ScheduleID: current.ScheduleID,
+		Spec:       current.Spec,
+		Action:     current.Action,
+		Policies:   current.Policies,
+	}
+	if request.Spec != nil {
+		params.Spec = *request.Spec
+	}
+	if request.Action != nil {
+		params.Action = *request.Action
+	}
+	if request.Policies != nil {
+		params.Policies = *request.Policies
+	}
+	return scheduler.ValidateParams(params)
+}
+
+func parseScheduleTime(timeStr string) time.Time {
+	return time.Unix(0, parseTime(timeStr, 0)).UTC()
+}
+
+func describeSchedule(c *cli.Context, domain, scheduleID string) *scheduler.ScheduleDescription {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := serviceClient.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: common.SchedulerLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: scheduler.GetWorkflowID(domain, scheduleID),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: scheduler.QueryTypeDescribe,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Describe schedule failed.", err)
+	}
+
+	var description scheduler.ScheduleDescription
+	if err := json.Unmarshal(response.QueryResult, &description); err != nil {
+		ErrorAndExit("Failed to deserialize schedule.", err)
+	}
+	return &description
+}
+
+func signalSchedule(c *cli.Context, domain, scheduleID, signalName string, payload interface{}) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	var input []byte
+	if payload != nil {
+		var err error
+		if input, err = json.Marshal(payload); err != nil {
+			ErrorAndExit("Failed to serialize signal input.", err)
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain: common.SchedulerLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: scheduler.GetWorkflowID(domain, scheduleID),
+		},
+		SignalName: signalName,
+		Input:      input,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			ErrorAndExit(fmt.Sprintf("Schedule %v does not exist.", scheduleID), nil)
+		}
+		ErrorAndExit(fmt.Sprintf("Failed to send %v signal to schedule.", signalName), err)
+	}
+}
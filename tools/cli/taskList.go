@@ -46,7 +46,7 @@ func newTaskListCommands() []cli.Command {
 		{
 			Name:    "list-partition",
 			Aliases: []string{"lp"},
-			Usage:   "List all the tasklist partitions and the hostname for partitions.",
+			Usage:   "List all the tasklist partitions, the hostname for partitions and the partition config.",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagTaskListWithAlias,
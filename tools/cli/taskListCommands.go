@@ -22,6 +22,7 @@ package cli
 
 import (
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/uber/cadence/common/types"
@@ -40,6 +41,12 @@ type (
 		DecisionPartition string `header:"Decision Task List Partition"`
 		Host              string `header:"Host"`
 	}
+	TaskListPartitionConfigRow struct {
+		TaskListType       string `header:"Task List Type"`
+		Version            string `header:"Partition Config Version"`
+		NumReadPartitions  string `header:"Read Partitions"`
+		NumWritePartitions string `header:"Write Partitions"`
+	}
 )
 
 // DescribeTaskList show pollers info of a given tasklist
@@ -95,6 +102,40 @@ func ListTaskListPartitions(c *cli.Context) {
 	if len(response.ActivityTaskListPartitions) > 0 {
 		printTaskListPartitions("Activity", response.ActivityTaskListPartitions)
 	}
+
+	table := []TaskListPartitionConfigRow{}
+	for _, taskListType := range []types.TaskListType{types.TaskListTypeDecision, types.TaskListTypeActivity} {
+		taskListType := taskListType
+		describeResponse, err := frontendClient.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
+			Domain:       domain,
+			TaskList:     &types.TaskList{Name: taskList},
+			TaskListType: &taskListType,
+		})
+		if err != nil {
+			ErrorAndExit("Operation DescribeTaskList failed.", err)
+		}
+		table = append(table, newTaskListPartitionConfigRow(taskListType, describeResponse.GetPartitionConfig()))
+	}
+	RenderTable(os.Stdout, table, RenderOptions{Color: true})
+}
+
+// newTaskListPartitionConfigRow returns the row for the partition config persisted by the adaptive scaler.
+// Without a persisted config, partitions of the task list are configured through dynamic config
+func newTaskListPartitionConfigRow(taskListType types.TaskListType, partitionConfig *types.TaskListPartitionConfig) TaskListPartitionConfigRow {
+	if partitionConfig == nil {
+		return TaskListPartitionConfigRow{
+			TaskListType:       taskListType.String(),
+			Version:            "N/A",
+			NumReadPartitions:  "dynamic config",
+			NumWritePartitions: "dynamic config",
+		}
+	}
+	return TaskListPartitionConfigRow{
+		TaskListType:       taskListType.String(),
+		Version:            strconv.FormatInt(partitionConfig.GetVersion(), 10),
+		NumReadPartitions:  strconv.Itoa(int(partitionConfig.GetNumReadPartitions())),
+		NumWritePartitions: strconv.Itoa(int(partitionConfig.GetNumWritePartitions())),
+	}
 }
 
 func printTaskListPollers(pollers []*types.PollerInfo, taskListType types.TaskListType) {
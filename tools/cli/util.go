@@ -408,6 +408,18 @@ func ColorEvent(e *types.HistoryEvent) string {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		data = e.EventType.String()
 
+	case types.EventTypeWorkflowExecutionUpdateRequested:
+		data = e.EventType.String()
+
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		data = color.GreenString(e.EventType.String())
+
+	case types.EventTypeWorkflowExecutionPaused:
+		data = color.MagentaString(e.EventType.String())
+
+	case types.EventTypeWorkflowExecutionUnpaused:
+		data = e.EventType.String()
+
 	default:
 		data = e.EventType.String()
 	}
@@ -537,6 +549,18 @@ func getEventAttributes(e *types.HistoryEvent) interface{} {
 	case types.EventTypeExternalWorkflowExecutionSignaled:
 		data = e.ExternalWorkflowExecutionSignaledEventAttributes
 
+	case types.EventTypeWorkflowExecutionUpdateRequested:
+		data = e.WorkflowExecutionUpdateRequestedEventAttributes
+
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		data = e.WorkflowExecutionUpdateCompletedEventAttributes
+
+	case types.EventTypeWorkflowExecutionPaused:
+		data = e.WorkflowExecutionPausedEventAttributes
+
+	case types.EventTypeWorkflowExecutionUnpaused:
+		data = e.WorkflowExecutionUnpausedEventAttributes
+
 	default:
 		data = e
 	}
@@ -337,6 +337,10 @@ func constructStartWorkflowRequest(c *cli.Context) *types.StartWorkflowExecution
 		startRequest.JitterStartSeconds = common.Int32Ptr(int32(c.Int(JitterStartSeconds)))
 	}
 
+	if c.IsSet(FlagPriority) {
+		startRequest.Priority = int32(c.Int(FlagPriority))
+	}
+
 	headerFields := processHeader(c)
 	if len(headerFields) != 0 {
 		startRequest.Header = &types.Header{Fields: headerFields}
@@ -30,8 +30,10 @@ import (
 	"github.com/uber/cadence/common/config"
 	mysql_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
 	postgres_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+	sqlite_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
 	"github.com/uber/cadence/schema/mysql"
 	"github.com/uber/cadence/schema/postgres"
+	"github.com/uber/cadence/schema/sqlite"
 	cliflag "github.com/uber/cadence/tools/common/flag"
 	"github.com/uber/cadence/tools/common/schema"
 )
@@ -50,6 +52,8 @@ func VerifyCompatibleVersion(
 			expectedVersion = mysql.Version
 		case postgres_db.PluginName:
 			expectedVersion = postgres.Version
+		case sqlite_db.PluginName:
+			expectedVersion = sqlite.Version
 		}
 		err := CheckCompatibleVersion(*ds.SQL, expectedVersion)
 		if err != nil {
@@ -64,6 +68,8 @@ func VerifyCompatibleVersion(
 			expectedVersion = mysql.VisibilityVersion
 		case postgres_db.PluginName:
 			expectedVersion = postgres.VisibilityVersion
+		case sqlite_db.PluginName:
+			expectedVersion = sqlite.VisibilityVersion
 		}
 		err := CheckCompatibleVersion(*ds.SQL, expectedVersion)
 		if err != nil {
@@ -216,12 +222,15 @@ func parseConnectConfig(cli *cli.Context) (*config.SQL, error) {
 
 // ValidateConnectConfig validates params
 func ValidateConnectConfig(cfg *config.SQL) error {
-	host, _, err := net.SplitHostPort(cfg.ConnectAddr)
-	if err != nil {
-		return schema.NewConfigError("invalid host and port " + cfg.ConnectAddr)
-	}
-	if len(host) == 0 {
-		return schema.NewConfigError("missing sql endpoint argument " + flag(schema.CLIOptEndpoint))
+	// sqlite is embedded, the database name is the path to the database file
+	if cfg.PluginName != sqlite_db.PluginName {
+		host, _, err := net.SplitHostPort(cfg.ConnectAddr)
+		if err != nil {
+			return schema.NewConfigError("invalid host and port " + cfg.ConnectAddr)
+		}
+		if len(host) == 0 {
+			return schema.NewConfigError("missing sql endpoint argument " + flag(schema.CLIOptEndpoint))
+		}
 	}
 	if cfg.DatabaseName == "" {
 		return schema.NewConfigError("missing " + flag(schema.CLIOptDatabase) + " argument")